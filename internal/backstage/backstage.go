@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package backstage writes Backstage catalog API entities that reference
+// generated specifications.
+package backstage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TechDocsAnnotation points Backstage TechDocs at the entity's documentation.
+const TechDocsAnnotation = "backstage.io/techdocs-ref"
+
+// Entity describes the API entity to write.
+type Entity struct {
+	// Name is the entity's metadata.name
+	Name string
+
+	// Title is the entity's display title
+	Title string
+
+	// Description is the entity description
+	Description string
+
+	// Owner is the owning group or user
+	Owner string
+
+	// Lifecycle is the API lifecycle (experimental, production, deprecated)
+	Lifecycle string
+
+	// System is the system the API belongs to
+	System string
+
+	// Definition is the spec path, relative to the catalog file
+	Definition string
+
+	// TechDocsRef is the techdocs-ref annotation value (e.g., dir:.)
+	TechDocsRef string
+}
+
+// invalidNameChars matches characters not allowed in entity names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Name derives a valid entity name from an API title.
+func Name(title string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	if name == "" {
+		name = "api"
+	}
+	return name
+}
+
+// WriteFile creates or updates the catalog file at path. An existing API
+// entity with the same name is updated in place, keeping fields and
+// comments it does not manage; otherwise the entity is appended as a new
+// document.
+func WriteFile(path string, entity Entity) error {
+	var docs []*yaml.Node
+
+	existing, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		docs, err = decodeAll(existing)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	target := findEntity(docs, entity.Name)
+	if target == nil {
+		target = &yaml.Node{Kind: yaml.MappingNode}
+		docs = append(docs, &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{target}})
+	}
+	apply(target, entity)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		if err := enc.Encode(doc); err != nil {
+			return fmt.Errorf("failed to encode catalog entity: %w", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to encode catalog entity: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeAll parses every document in a multi-document YAML stream.
+func decodeAll(data []byte) ([]*yaml.Node, error) {
+	var docs []*yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+			return nil, err
+		}
+		docs = append(docs, &doc)
+	}
+}
+
+// findEntity returns the mapping of the API entity named name.
+func findEntity(docs []*yaml.Node, name string) *yaml.Node {
+	for _, doc := range docs {
+		if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+			continue
+		}
+		root := doc.Content[0]
+		if root.Kind != yaml.MappingNode {
+			continue
+		}
+		if scalar(root, "kind") != "API" {
+			continue
+		}
+		if metadata := child(root, "metadata"); metadata != nil && scalar(metadata, "name") == name {
+			return root
+		}
+	}
+	return nil
+}
+
+// apply sets the fields managed by api2spec on an entity mapping.
+func apply(root *yaml.Node, e Entity) {
+	setScalar(root, "apiVersion", "backstage.io/v1alpha1")
+	setScalar(root, "kind", "API")
+
+	metadata := ensureMapping(root, "metadata")
+	setScalar(metadata, "name", e.Name)
+	setOptional(metadata, "title", e.Title)
+	setOptional(metadata, "description", e.Description)
+	if e.TechDocsRef != "" {
+		setScalar(ensureMapping(metadata, "annotations"), TechDocsAnnotation, e.TechDocsRef)
+	}
+
+	spec := ensureMapping(root, "spec")
+	setScalar(spec, "type", "openapi")
+	setScalar(spec, "lifecycle", e.Lifecycle)
+	setScalar(spec, "owner", e.Owner)
+	setOptional(spec, "system", e.System)
+	setScalar(ensureMapping(spec, "definition"), "$text", e.Definition)
+}
+
+// child returns the value node for key in a mapping, or nil.
+func child(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// scalar returns the scalar value for key in a mapping, or "".
+func scalar(mapping *yaml.Node, key string) string {
+	if node := child(mapping, key); node != nil && node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return ""
+}
+
+// ensureMapping returns the mapping for key, creating or replacing it if needed.
+func ensureMapping(mapping *yaml.Node, key string) *yaml.Node {
+	if node := child(mapping, key); node != nil {
+		if node.Kind != yaml.MappingNode {
+			*node = yaml.Node{Kind: yaml.MappingNode}
+		}
+		return node
+	}
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key}, node)
+	return node
+}
+
+// setScalar sets key to a string value, keeping the key's position and comments.
+func setScalar(mapping *yaml.Node, key, value string) {
+	if node := child(mapping, key); node != nil {
+		node.Kind, node.Tag, node.Value, node.Content = yaml.ScalarNode, "!!str", value, nil
+		return
+	}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
+
+// setOptional sets key when value is non-empty, leaving existing values otherwise.
+func setOptional(mapping *yaml.Node, key, value string) {
+	if value != "" {
+		setScalar(mapping, key, value)
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package backstage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "orders-api", Name("Orders API"))
+	assert.Equal(t, "acme-billing-v2", Name("  ACME / Billing (v2) "))
+	assert.Equal(t, "api", Name("!!!"))
+	assert.Len(t, Name("a very long title that keeps going and going well past the sixty three limit"), 63)
+}
+
+func TestWriteFile_New(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog-info.yaml")
+
+	err := WriteFile(path, Entity{
+		Name:        "orders-api",
+		Title:       "Orders API",
+		Description: "Order management",
+		Owner:       "team-orders",
+		Lifecycle:   "production",
+		Definition:  "./openapi.yaml",
+		TechDocsRef: "dir:.",
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: backstage.io/v1alpha1
+kind: API
+metadata:
+  name: orders-api
+  title: Orders API
+  description: Order management
+  annotations:
+    backstage.io/techdocs-ref: dir:.
+spec:
+  type: openapi
+  lifecycle: production
+  owner: team-orders
+  definition:
+    $text: ./openapi.yaml
+`, string(data))
+}
+
+func TestWriteFile_PatchExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog-info.yaml")
+	existing := `# Service catalog
+apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: orders
+spec:
+  type: service
+  owner: team-orders
+  providesApis:
+    - orders-api
+---
+apiVersion: backstage.io/v1alpha1
+kind: API
+metadata:
+  name: orders-api
+  tags:
+    - rest # public
+spec:
+  type: openapi
+  lifecycle: experimental
+  owner: team-orders
+  definition:
+    $text: ./old.yaml
+`
+	require.NoError(t, os.WriteFile(path, []byte(existing), 0644))
+
+	err := WriteFile(path, Entity{
+		Name:       "orders-api",
+		Owner:      "team-platform",
+		Lifecycle:  "production",
+		Definition: "./openapi.yaml",
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `# Service catalog
+apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: orders
+spec:
+  type: service
+  owner: team-orders
+  providesApis:
+    - orders-api
+---
+apiVersion: backstage.io/v1alpha1
+kind: API
+metadata:
+  name: orders-api
+  tags:
+    - rest # public
+spec:
+  type: openapi
+  lifecycle: production
+  owner: team-platform
+  definition:
+    $text: ./openapi.yaml
+`, string(data))
+}
+
+func TestWriteFile_AppendsMissingEntity(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog-info.yaml")
+	existing := `apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: orders
+`
+	require.NoError(t, os.WriteFile(path, []byte(existing), 0644))
+
+	require.NoError(t, WriteFile(path, Entity{Name: "orders-api", Owner: "team", Lifecycle: "production", Definition: "./openapi.yaml"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	docs, err := decodeAll(data)
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	assert.Equal(t, "Component", scalar(docs[0].Content[0], "kind"))
+	assert.Equal(t, "API", scalar(docs[1].Content[0], "kind"))
+}
+
+func TestWriteFile_InvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog-info.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("kind: [unclosed"), 0644))
+
+	err := WriteFile(path, Entity{Name: "api"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse")
+}
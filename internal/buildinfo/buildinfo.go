@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package buildinfo describes an api2spec build: its version, the
+// tree-sitter grammars compiled into it and the framework plugins it
+// registers, so that CI pipelines can pin and audit the extractor that
+// produced a spec.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+)
+
+// Info is the build information of the running binary.
+type Info struct {
+	// Version is the semantic version of the build
+	Version string `json:"version"`
+
+	// Commit is the git commit the binary was built from
+	Commit string `json:"commit"`
+
+	// BuildDate is when the binary was built
+	BuildDate string `json:"buildDate"`
+
+	// GoVersion is the Go toolchain that built the binary
+	GoVersion string `json:"goVersion"`
+
+	// Platform is the OS/architecture of the binary
+	Platform string `json:"platform"`
+
+	// Grammars are the tree-sitter grammars compiled in
+	Grammars []Grammar `json:"grammars"`
+
+	// Plugins are the registered framework plugins, sorted by name
+	Plugins []Plugin `json:"plugins"`
+}
+
+// Grammar is a tree-sitter grammar and the module version it comes from.
+type Grammar struct {
+	// Language is the language the grammar parses
+	Language string `json:"language"`
+
+	// Module is the Go module providing the grammar
+	Module string `json:"module,omitempty"`
+
+	// Version is the module version
+	Version string `json:"version,omitempty"`
+
+	// Sum is the go.sum checksum of the module
+	Sum string `json:"sum,omitempty"`
+}
+
+// Plugin is a registered framework plugin.
+type Plugin struct {
+	// Name is the plugin identifier
+	Name string `json:"name"`
+
+	// Version is the plugin version, when the plugin reports one
+	Version string `json:"version,omitempty"`
+}
+
+// Read returns the build information of the running binary. version,
+// commit and date are the values set with ldflags; those left at their
+// defaults ("dev" and "unknown") are taken from the module version and VCS
+// stamps the Go toolchain records, as in binaries built with go install.
+func Read(version, commit, date string) Info {
+	bi, _ := debug.ReadBuildInfo()
+	info := fromBuildInfo(bi, version, commit, date)
+	for _, name := range plugins.List() {
+		plugin := Plugin{Name: name}
+		if provider, ok := plugins.Get(name).(plugins.InfoProvider); ok {
+			plugin.Version = provider.Info().Version
+		}
+		info.Plugins = append(info.Plugins, plugin)
+	}
+	return info
+}
+
+// fromBuildInfo fills in the version, VCS stamps and grammar modules from
+// bi, which may be nil.
+func fromBuildInfo(bi *debug.BuildInfo, version, commit, date string) Info {
+	info := Info{
+		Version:   version,
+		Commit:    commit,
+		BuildDate: date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		Plugins:   []Plugin{},
+	}
+
+	var deps []*debug.Module
+	if bi != nil {
+		deps = bi.Deps
+		if info.Version == "dev" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+		var modified bool
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "unknown" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildDate == "unknown" {
+					info.BuildDate = setting.Value
+				}
+			case "vcs.modified":
+				modified = setting.Value == "true"
+			}
+		}
+		if modified && commit == "unknown" && info.Commit != "unknown" {
+			info.Commit += "-dirty"
+		}
+	}
+
+	for _, g := range parser.Grammars {
+		grammar := Grammar{Language: g.Language}
+		if mod := moduleOf(deps, g.Package); mod != nil {
+			if mod.Replace != nil {
+				mod = mod.Replace
+			}
+			grammar.Module = mod.Path
+			grammar.Version = mod.Version
+			grammar.Sum = mod.Sum
+		}
+		info.Grammars = append(info.Grammars, grammar)
+	}
+	return info
+}
+
+// moduleOf returns the module of deps that provides pkg, or nil.
+func moduleOf(deps []*debug.Module, pkg string) *debug.Module {
+	var found *debug.Module
+	for _, mod := range deps {
+		if pkg != mod.Path && !strings.HasPrefix(pkg, mod.Path+"/") {
+			continue
+		}
+		if found == nil || len(mod.Path) > len(found.Path) {
+			found = mod
+		}
+	}
+	return found
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package buildinfo
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/parser"
+)
+
+func TestFromBuildInfo(t *testing.T) {
+	bi := &debug.BuildInfo{
+		Main: debug.Module{Path: "github.com/api2spec/api2spec", Version: "v1.4.0"},
+		Deps: []*debug.Module{
+			{Path: "github.com/smacker/go-tree-sitter", Version: "v0.0.0-20240827094217-dd81d9e9be82", Sum: "h1:abc="},
+			{Path: "github.com/spf13/cobra", Version: "v1.10.2"},
+		},
+		Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "0123abcd"},
+			{Key: "vcs.time", Value: "2026-10-01T12:00:00Z"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	}
+
+	info := fromBuildInfo(bi, "dev", "unknown", "unknown")
+
+	assert.Equal(t, "v1.4.0", info.Version)
+	assert.Equal(t, "0123abcd-dirty", info.Commit)
+	assert.Equal(t, "2026-10-01T12:00:00Z", info.BuildDate)
+	require.Len(t, info.Grammars, len(parser.Grammars))
+	for _, grammar := range info.Grammars {
+		assert.Equal(t, "github.com/smacker/go-tree-sitter", grammar.Module, grammar.Language)
+		assert.Equal(t, "v0.0.0-20240827094217-dd81d9e9be82", grammar.Version, grammar.Language)
+		assert.Equal(t, "h1:abc=", grammar.Sum, grammar.Language)
+	}
+}
+
+func TestFromBuildInfo_LDFlags(t *testing.T) {
+	bi := &debug.BuildInfo{
+		Main: debug.Module{Path: "github.com/api2spec/api2spec", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{
+				Path:    "github.com/smacker/go-tree-sitter",
+				Version: "v0.0.0-20240827094217-dd81d9e9be82",
+				Replace: &debug.Module{Path: "github.com/example/go-tree-sitter", Version: "v0.1.0"},
+			},
+		},
+		Settings: []debug.BuildSetting{{Key: "vcs.revision", Value: "0123abcd"}},
+	}
+
+	info := fromBuildInfo(bi, "v1.5.0", "fedc9876", "2026-10-02")
+
+	assert.Equal(t, "v1.5.0", info.Version)
+	assert.Equal(t, "fedc9876", info.Commit)
+	assert.Equal(t, "2026-10-02", info.BuildDate)
+	assert.Equal(t, "github.com/example/go-tree-sitter", info.Grammars[0].Module)
+	assert.Equal(t, "v0.1.0", info.Grammars[0].Version)
+}
+
+func TestFromBuildInfo_Nil(t *testing.T) {
+	info := fromBuildInfo(nil, "dev", "unknown", "unknown")
+
+	assert.Equal(t, "dev", info.Version)
+	assert.Equal(t, "unknown", info.Commit)
+	require.Len(t, info.Grammars, len(parser.Grammars))
+	assert.Empty(t, info.Grammars[0].Module)
+}
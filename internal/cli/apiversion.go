@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/vcs"
+)
+
+// resolveAPIVersion returns the info.version of the spec and the source it
+// came from: flag when set, else the first of the openapi.info.versionFrom
+// sources that yields one. The source is empty when the configured static
+// version applies. tree, when set, is the revision the manifests and tags
+// are read at.
+func resolveAPIVersion(flag string, cfg *config.Config, root string, tree *vcs.Tree) (string, string) {
+	if flag != "" {
+		return flag, "--api-version"
+	}
+	sources := cfg.OpenAPI.Info.VersionFrom
+	for _, source := range sources {
+		if version := readAPIVersion(source, root, tree); version != "" {
+			return version, source
+		}
+	}
+	if len(sources) > 0 {
+		printWarning("No version found in %s; using openapi.info.version %s", strings.Join(sources, ", "), cfg.OpenAPI.Info.Version)
+	}
+	return cfg.OpenAPI.Info.Version, ""
+}
+
+// readAPIVersion reads the version from one openapi.info.versionFrom
+// source, or returns "".
+func readAPIVersion(source, root string, tree *vcs.Tree) string {
+	if source == "git-tag" {
+		ref := "HEAD"
+		if tree != nil {
+			ref = tree.Commit
+		}
+		tag, err := vcs.LatestTag(root, ref)
+		if err != nil {
+			return ""
+		}
+		// Drop prefixes such as v in v1.2.0 or release- in release-1.2.0
+		if i := strings.IndexAny(tag, "0123456789"); i > 0 && strings.ContainsAny(tag[i-1:i], "v-/") {
+			tag = tag[i:]
+		}
+		return tag
+	}
+
+	var data []byte
+	var err error
+	if tree != nil {
+		data, err = tree.ReadFile(source)
+	} else {
+		data, err = os.ReadFile(filepath.Join(root, source))
+	}
+	if err != nil {
+		return ""
+	}
+
+	if source == "VERSION" {
+		line, _, _ := bufio.NewReader(bytes.NewReader(data)).ReadLine()
+		return strings.TrimSpace(string(line))
+	}
+	// package.json and composer.json
+	var manifest struct {
+		Version string `json:"version"`
+	}
+	if json.Unmarshal(data, &manifest) != nil {
+		return ""
+	}
+	return strings.TrimSpace(manifest.Version)
+}
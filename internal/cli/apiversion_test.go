@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/vcs"
+)
+
+func TestResolveAPIVersion(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	cfg := config.Default()
+	cfg.OpenAPI.Info.VersionFrom = []string{"package.json", "composer.json", "VERSION"}
+
+	// Nothing to read falls back to the static version
+	version, source := resolveAPIVersion("", cfg, dir, nil)
+	assert.Equal(t, "1.0.0", version)
+	assert.Empty(t, source)
+
+	write("VERSION", " 3.1.0 \nreleased\n")
+	write("composer.json", `{"name": "acme/api"}`)
+	version, source = resolveAPIVersion("", cfg, dir, nil)
+	assert.Equal(t, "3.1.0", version)
+	assert.Equal(t, "VERSION", source)
+
+	// Earlier sources take precedence
+	write("package.json", `{"name": "api", "version": "2.4.0"}`)
+	version, source = resolveAPIVersion("", cfg, dir, nil)
+	assert.Equal(t, "2.4.0", version)
+	assert.Equal(t, "package.json", source)
+
+	// The flag overrides every source
+	version, source = resolveAPIVersion("9.0.0", cfg, dir, nil)
+	assert.Equal(t, "9.0.0", version)
+	assert.Equal(t, "--api-version", source)
+}
+
+func TestResolveAPIVersion_GitTag(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "-q")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"version": "1.0.0"}`), 0o644))
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+	run("tag", "v1.0.0")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"version": "1.1.0"}`), 0o644))
+	run("commit", "-q", "-am", "bump")
+	run("tag", "release-1.1.0")
+
+	cfg := config.Default()
+	cfg.OpenAPI.Info.VersionFrom = []string{"git-tag"}
+	version, source := resolveAPIVersion("", cfg, dir, nil)
+	assert.Equal(t, "1.1.0", version)
+	assert.Equal(t, "git-tag", source)
+
+	// --at reads the tag and manifests of the revision
+	tree, err := vcs.ReadTree(dir, "v1.0.0")
+	require.NoError(t, err)
+	version, _ = resolveAPIVersion("", cfg, dir, tree)
+	assert.Equal(t, "1.0.0", version)
+
+	cfg.OpenAPI.Info.VersionFrom = []string{"package.json"}
+	version, _ = resolveAPIVersion("", cfg, dir, tree)
+	assert.Equal(t, "1.0.0", version)
+}
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,9 +13,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/lint"
 	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/plugins/declarative"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -103,6 +108,18 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	printVerbose("  Paths: %s", strings.Join(paths, ", "))
 	printVerbose("  Spec file: %s", cfg.Output)
 
+	// Each service of a split repository is compared with its own spec
+	if cfg.Generation.Services.Strategy == "split" {
+		diffResult, err := diffGeneratedServices(cmd, cfg, paths)
+		if err != nil {
+			if checkCI {
+				os.Exit(ExitCodeCheckError)
+			}
+			return err
+		}
+		return reportCheck(diffResult)
+	}
+
 	// Check if spec file exists
 	if _, err := os.Stat(cfg.Output); os.IsNotExist(err) {
 		printError("Spec file not found: %s", cfg.Output)
@@ -123,7 +140,7 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	}
 
 	// Generate spec from current code
-	generatedSpec, err := generateSpecFromCode(cfg, paths)
+	generatedSpec, err := generateSpecFromCode(cmd, cfg, paths)
 	if err != nil {
 		if checkCI {
 			os.Exit(ExitCodeCheckError)
@@ -141,6 +158,12 @@ func runCheck(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to compare specs: %w", err)
 	}
 
+	return reportCheck(diffResult)
+}
+
+// reportCheck prints the differences between the spec files and the
+// implementation. In CI mode it exits with the check exit codes.
+func reportCheck(diffResult *openapi.DiffResult) error {
 	// Apply ignore patterns
 	diffResult = applyIgnorePatterns(diffResult, checkIgnore)
 
@@ -193,23 +216,91 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// generateSpecFromCode generates an OpenAPI spec from the source code.
-func generateSpecFromCode(cfg *config.Config, paths []string) (*types.OpenAPI, error) {
+// generateSpecFromCode generates an OpenAPI spec from the source code. It
+// gives up on Ctrl-C or when --timeout elapses.
+func generateSpecFromCode(cmd *cobra.Command, cfg *config.Config, paths []string) (*types.OpenAPI, error) {
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	doc, err := generateSpecFromCodeContext(ctx, cfg, paths)
+	return doc, contextError(ctx, err)
+}
+
+// generateSpecFromCodeContext is generateSpecFromCode with an explicit
+// context that cancels scanning and extraction.
+func generateSpecFromCodeContext(ctx context.Context, cfg *config.Config, paths []string) (*types.OpenAPI, error) {
 	// Determine project root for framework detection
 	projectRoot, err := filepath.Abs(".")
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine project root: %w", err)
 	}
 
-	// Get or detect framework plugin
+	result, err := extractSpec(ctx, cfg, plugins.Global(), projectRoot, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range result.fileDiagnostics {
+		printWarning("%s: %s", d.File, d.Message)
+	}
+	if err := stitchExternalSpecs(ctx, cfg, result.doc); err != nil {
+		return nil, err
+	}
+	return result.doc, nil
+}
+
+// diffGeneratedServices generates the spec of each service of a split
+// repository and compares it with the one at its output.
+func diffGeneratedServices(cmd *cobra.Command, cfg *config.Config, paths []string) (*openapi.DiffResult, error) {
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	projectRoot, err := filepath.Abs(".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine project root: %w", err)
+	}
+	result, err := extractSpec(ctx, cfg, plugins.Global(), projectRoot, paths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate spec from code: %w", contextError(ctx, err))
+	}
+	return diffServiceSpecs(result.services)
+}
+
+// extraction is a spec extracted from source code with what was found
+// along the way.
+type extraction struct {
+	doc             *types.OpenAPI
+	framework       string
+	routes          int
+	schemas         int
+	fileDiagnostics []parser.FileDiagnostic
+	warnings        []lint.Warning
+
+	// services are the specs of the services of a split repository
+	services []serviceSpec
+}
+
+// extractSpec scans paths, relative to the project root, and builds the
+// spec of the routes and schemas found. Lint warnings are collected
+// rather than printed.
+func extractSpec(ctx context.Context, cfg *config.Config, registry *plugins.Registry, projectRoot string, paths []string) (*extraction, error) {
+	if err := declarative.RegisterIn(registry, cfg.FrameworkDefinitions); err != nil {
+		return nil, fmt.Errorf("failed to load framework definitions: %w", err)
+	}
+
+	typemap.Set(cfg.Generation.TypeMappings)
+
+	// Get or detect framework plugin; services name their own
 	var plugin plugins.FrameworkPlugin
-	if cfg.Framework == "" || cfg.Framework == "auto" {
-		plugin, err = plugins.Detect(projectRoot)
+	var err error
+	if cfg.Generation.Services.Strategy != "" {
+		printVerbose("Extracting services with the %s strategy", cfg.Generation.Services.Strategy)
+	} else if cfg.Framework == "" || cfg.Framework == "auto" {
+		plugin, err = registry.Detect(projectRoot)
 		if err != nil {
 			printVerbose("Framework detection failed: %v", err)
 		}
 	} else {
-		plugin = plugins.Get(cfg.Framework)
+		plugin = registry.Get(cfg.Framework)
 		if plugin == nil {
 			return nil, fmt.Errorf("unknown framework %q", cfg.Framework)
 		}
@@ -217,19 +308,22 @@ func generateSpecFromCode(cfg *config.Config, paths []string) (*types.OpenAPI, e
 
 	// Scan for source files
 	scannerCfg := scanner.Config{
-		IncludePatterns: cfg.Source.Include,
-		ExcludePatterns: cfg.Source.Exclude,
+		IncludePatterns:    cfg.Source.Include,
+		ExcludePatterns:    cfg.Source.Exclude,
+		FollowSymlinks:     cfg.Source.FollowSymlinks,
+		LanguageExtensions: cfg.Source.LanguageExtensions,
+		LanguageOverrides:  cfg.Source.LanguageOverrides,
 	}
 
 	var files []scanner.SourceFile
 	for _, path := range paths {
-		absPath, err := filepath.Abs(path)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve path %s: %w", path, err)
+		absPath := path
+		if !filepath.IsAbs(absPath) {
+			absPath = filepath.Join(projectRoot, path)
 		}
 		scannerCfg.BasePath = absPath
 		s := scanner.New(scannerCfg)
-		pathFiles, err := s.Scan()
+		pathFiles, err := s.ScanContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan path %s: %w", path, err)
 		}
@@ -239,37 +333,73 @@ func generateSpecFromCode(cfg *config.Config, paths []string) (*types.OpenAPI, e
 	printVerbose("Scanned %d source files", len(files))
 
 	// Extract routes and schemas
+	result := &extraction{}
 	var routes []types.Route
 	var schemas []types.Schema
 
-	if plugin != nil {
+	if cfg.Generation.Services.Strategy != "" {
+		extractions, err := extractServices(ctx, cfg, registry, files, projectRoot, nil)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(extractions))
+		for i, ex := range extractions {
+			names[i] = ex.plugin.Name()
+			result.warnings = append(result.warnings, lint.Diagnostics(ex.routes)...)
+		}
+		result.framework = strings.Join(names, ", ")
+		result.fileDiagnostics = parser.Diagnostics()
+
+		if cfg.Generation.Services.Strategy == "split" {
+			if result.services, err = buildServiceSpecs(cfg, projectRoot, extractions); err != nil {
+				return nil, err
+			}
+		} else {
+			routes, schemas = joinServices(extractions)
+		}
+	} else if plugin != nil {
+		result.framework = plugin.Name()
 		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "routes-only" {
-			extractedRoutes, err := plugin.ExtractRoutes(files)
+			extractedRoutes, err := plugins.ExtractRoutes(ctx, plugin, files)
 			if err != nil {
 				return nil, fmt.Errorf("failed to extract routes: %w", err)
 			}
 			routes = extractedRoutes
+			markRoutes(cfg, routes, files, projectRoot, nil)
+
+			result.warnings = lint.Diagnostics(routes)
+			if cfg.Generation.Lint.PathParams {
+				result.warnings = append(result.warnings, lint.PathParams(routes, files)...)
+			}
+			if cfg.Generation.Lint.DuplicateRoutes {
+				result.warnings = append(result.warnings, lint.DuplicateRoutes(routes, plugin.Name())...)
+			}
 		}
 
 		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "schemas-only" {
-			extractedSchemas, err := plugin.ExtractSchemas(files)
+			extractedSchemas, err := plugins.ExtractSchemas(ctx, plugin, files)
 			if err != nil {
 				return nil, fmt.Errorf("failed to extract schemas: %w", err)
 			}
 			schemas = extractedSchemas
 		}
+
+		result.fileDiagnostics = parser.Diagnostics()
 	}
 
 	printVerbose("Found %d routes and %d schemas", len(routes), len(schemas))
 
 	// Build OpenAPI spec
-	builder := openapi.NewBuilder(cfg)
+	builder := withTenantHost(newBuilder(cfg, projectRoot), cfg, files)
 	doc, err := builder.Build(routes, schemas)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build OpenAPI spec: %w", err)
 	}
 
-	return doc, nil
+	result.doc = doc
+	result.routes = len(routes)
+	result.schemas = len(schemas)
+	return result, nil
 }
 
 // applyIgnorePatterns filters out changes that match ignore patterns.
@@ -5,11 +5,14 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/buildinfo"
 )
 
 // executeCommand runs a command and returns output and error.
@@ -124,6 +127,31 @@ func TestVersionCommand(t *testing.T) {
 	assert.Contains(t, output, "OS/Arch")
 }
 
+func TestVersionCommand_JSON(t *testing.T) {
+	defer func() { versionJSON = false }()
+	output, err := executeCommand(rootCmd, "version", "--json")
+	require.NoError(t, err)
+
+	var info buildinfo.Info
+	require.NoError(t, json.Unmarshal([]byte(output), &info))
+	assert.NotEmpty(t, info.Version)
+	assert.NotEmpty(t, info.Grammars)
+	assert.Contains(t, info.Plugins, buildinfo.Plugin{Name: "chi", Version: "1.0.0"})
+}
+
+func TestRootCommand_VersionFlag(t *testing.T) {
+	defer func() { showVersion, rootJSON = false, false }()
+	// Flags keep their values between executions; undo an earlier --help
+	rootCmd.InitDefaultHelpFlag()
+	require.NoError(t, rootCmd.Flags().Set("help", "false"))
+	output, err := executeCommand(rootCmd, "--version", "--json")
+	require.NoError(t, err)
+
+	var info buildinfo.Info
+	require.NoError(t, json.Unmarshal([]byte(output), &info))
+	assert.NotEmpty(t, info.Plugins)
+}
+
 func TestInitCommand_Help(t *testing.T) {
 	output, err := executeCommand(rootCmd, "init", "--help")
 	require.NoError(t, err)
@@ -143,6 +171,10 @@ func TestGenerateCommand_Help(t *testing.T) {
 	assert.Contains(t, output, "--dry-run")
 	assert.Contains(t, output, "--include")
 	assert.Contains(t, output, "--exclude")
+	assert.Contains(t, output, "--source-links")
+	assert.Contains(t, output, "--manifest")
+	assert.Contains(t, output, "--sign")
+	assert.Contains(t, output, "--backstage")
 }
 
 func TestCheckCommand_Help(t *testing.T) {
@@ -182,6 +214,34 @@ func TestPrintCommand_Help(t *testing.T) {
 	assert.Contains(t, output, "Print the OpenAPI specification")
 }
 
+func TestPublishCommand_Help(t *testing.T) {
+	output, err := executeCommand(rootCmd, "publish", "--help")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Publish uploads the generated specification")
+	assert.Contains(t, output, "--target")
+	assert.Contains(t, output, "--url")
+	assert.Contains(t, output, "--header")
+	assert.Contains(t, output, "--dry-run")
+}
+
+func TestPactCommand_Help(t *testing.T) {
+	output, err := executeCommand(rootCmd, "pact", "--help")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Pact verifies that every interaction")
+	assert.Contains(t, output, "--spec")
+}
+
+func TestTypesCommand_Help(t *testing.T) {
+	output, err := executeCommand(rootCmd, "types", "--help")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Types generates type definitions")
+	assert.Contains(t, output, "--lang")
+	assert.Contains(t, output, "--zod")
+}
+
 func TestGetVersionInfo(t *testing.T) {
 	info := GetVersionInfo()
 	assert.Contains(t, info, "api2spec")
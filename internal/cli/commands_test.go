@@ -4,14 +4,23 @@
 package cli
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/api2spec/api2spec/internal/config"
 	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
 )
 
 func TestApplyIgnorePatterns(t *testing.T) {
@@ -266,6 +275,68 @@ func TestDiffCommand_TwoNonExistentFiles(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to read spec file")
 }
 
+func TestSchemaDiffCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	spec := func(props string) string {
+		return `openapi: 3.0.3
+info:
+  title: Users
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+` + props
+	}
+	from, to := filepath.Join(tmpDir, "old.yaml"), filepath.Join(tmpDir, "new.yaml")
+	require.NoError(t, os.WriteFile(from, []byte(spec("        id:\n          type: integer\n")), 0o644))
+	require.NoError(t, os.WriteFile(to, []byte(spec("        id:\n          type: string\n")), 0o644))
+
+	oldFrom, oldTo := schemaDiffFrom, schemaDiffTo
+	defer func() { schemaDiffFrom, schemaDiffTo = oldFrom, oldTo }()
+	schemaDiffFrom, schemaDiffTo = from, to
+
+	require.NoError(t, runSchemaDiff(schemaDiffCmd, []string{"User"}))
+
+	err := runSchemaDiff(schemaDiffCmd, []string{"Order"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `schema "Order" not found`)
+}
+
+func TestCompatCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	spec := func(props string) string {
+		return `openapi: 3.0.3
+info:
+  title: Users
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+` + props
+	}
+	previous, current := filepath.Join(tmpDir, "v1.yaml"), filepath.Join(tmpDir, "v2.yaml")
+	require.NoError(t, os.WriteFile(previous, []byte(spec("        score:\n          type: integer\n")), 0o644))
+	require.NoError(t, os.WriteFile(current, []byte(spec("        score:\n          type: number\n")), 0o644))
+
+	oldAgainst, oldMode := compatAgainst, compatMode
+	defer func() { compatAgainst, compatMode = oldAgainst, oldMode }()
+	compatAgainst = previous
+
+	compatMode = "backward"
+	require.NoError(t, runCompat(compatCmd, []string{current}))
+
+	compatMode = "forward"
+	err := runCompat(compatCmd, []string{current})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not forward compatible")
+}
+
 func TestCheckCommand_NoSpecFile(t *testing.T) {
 	// Create a temporary directory with no spec file
 	tmpDir := t.TempDir()
@@ -290,6 +361,263 @@ func TestCheckCommand_NoSpecFile(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPublishCommand_URL(t *testing.T) {
+	var gotMethod, gotKey string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotKey = r.Header.Get("X-Api-Key")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	content := "openapi: 3.0.3\n"
+	require.NoError(t, os.WriteFile("openapi.yaml", []byte(content), 0o644))
+	t.Setenv("SPEC_STORE_KEY", "s3cret")
+
+	// Reset global flags
+	oldCfgFile, oldOutput := cfgFile, output
+	oldURL, oldHeaders := publishURL, publishHeaders
+	defer func() {
+		cfgFile, output = oldCfgFile, oldOutput
+		publishURL, publishHeaders = oldURL, oldHeaders
+	}()
+	cfgFile, output = "", ""
+	publishURL = srv.URL + "/specs/api.yaml"
+	publishHeaders = []string{"X-Api-Key: ${SPEC_STORE_KEY}"}
+
+	err := runPublish(publishCmd, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, gotMethod)
+	assert.Equal(t, "s3cret", gotKey)
+	assert.Equal(t, content, string(gotBody))
+
+	publishHeaders = []string{"no-colon"}
+	err = runPublish(publishCmd, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid header")
+}
+
+func TestPublishCommand_NoTargets(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	oldCfgFile, oldURL := cfgFile, publishURL
+	defer func() {
+		cfgFile, publishURL = oldCfgFile, oldURL
+	}()
+	cfgFile, publishURL = "", ""
+
+	err := runPublish(publishCmd, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no publish targets configured")
+}
+
+func TestPactCommand_Spec(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	spec := `openapi: 3.0.3
+info:
+  title: Users
+  version: 1.0.0
+paths:
+  /users/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: OK
+`
+	require.NoError(t, os.WriteFile("openapi.yaml", []byte(spec), 0o644))
+	require.NoError(t, os.WriteFile("web.json", []byte(`{
+  "consumer": {"name": "web"},
+  "provider": {"name": "users"},
+  "interactions": [{
+    "description": "get user",
+    "request": {"method": "GET", "path": "/users/1"},
+    "response": {"status": 200}
+  }]
+}`), 0o644))
+	require.NoError(t, os.WriteFile("mobile.json", []byte(`{
+  "consumer": {"name": "mobile"},
+  "provider": {"name": "users"},
+  "interactions": [{
+    "description": "delete user",
+    "request": {"method": "DELETE", "path": "/users/1"},
+    "response": {"status": 204}
+  }]
+}`), 0o644))
+
+	oldSpec := pactSpec
+	defer func() { pactSpec = oldSpec }()
+	pactSpec = "openapi.yaml"
+
+	require.NoError(t, runPact(pactCmd, []string{"web.json"}))
+
+	err := runPact(pactCmd, []string{"."})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 consumer interactions")
+
+	err = runPact(pactCmd, []string{"missing"})
+	assert.Error(t, err)
+}
+
+func TestTypesCommand_File(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	spec := `openapi: 3.0.3
+info:
+  title: Users
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+`
+	require.NoError(t, os.WriteFile("openapi.yaml", []byte(spec), 0o644))
+
+	oldOutput, oldLang, oldZod := output, typesLang, typesZod
+	defer func() { output, typesLang, typesZod = oldOutput, oldLang, oldZod }()
+	output, typesLang, typesZod = "web/api.ts", "ts", true
+
+	require.NoError(t, runTypes(typesCmd, []string{"openapi.yaml"}))
+
+	data, err := os.ReadFile("web/api.ts")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "export interface User {\n  id: number;\n}")
+	assert.Contains(t, string(data), "export const UserSchema: z.ZodType<User>")
+
+	output, typesLang, typesPackage = "events/users.proto", "proto", "acme.users.v1"
+	defer func() { typesPackage = "" }()
+	require.NoError(t, runTypes(typesCmd, []string{"openapi.yaml"}))
+
+	data, err = os.ReadFile("events/users.proto")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "package acme.users.v1;")
+	assert.Contains(t, string(data), "message User {\n  int64 id = 1;\n}")
+
+	typesLang = "swift"
+	err = runTypes(typesCmd, []string{"openapi.yaml"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported language")
+}
+
+func TestWriteBackstageEntity(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tmpDir)
+
+	cfg := config.Default()
+	cfg.Output = "docs/openapi.yaml"
+	cfg.Generation.Backstage.Owner = "team-orders"
+	doc := &types.OpenAPI{Info: types.Info{Title: "Orders API", Description: "Order management"}}
+
+	require.NoError(t, writeBackstageEntity(cfg, doc))
+
+	data, err := os.ReadFile("catalog-info.yaml")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "name: orders-api")
+	assert.Contains(t, string(data), "owner: team-orders")
+	assert.Contains(t, string(data), "$text: ./docs/openapi.yaml")
+}
+
+func TestOptimizeSize(t *testing.T) {
+	newDoc := func() *types.OpenAPI {
+		return &types.OpenAPI{
+			OpenAPI: "3.0.3",
+			Info:    types.Info{Title: "Orders API", Version: "1.0.0"},
+			Paths: map[string]types.PathItem{
+				"/orders": {Get: &types.Operation{
+					Description: "Lists the orders of the current customer",
+					Responses:   map[string]types.Response{"200": {Description: "OK"}},
+				}},
+			},
+		}
+	}
+
+	cfg := config.Default()
+	cfg.Generation.OptimizeSize.Enabled = true
+	doc := newDoc()
+	require.NoError(t, optimizeSize(cfg, doc))
+	assert.Empty(t, doc.Paths["/orders"].Get.Description)
+
+	cfg = config.Default()
+	cfg.Generation.OptimizeSize.Budget = "100B"
+	doc = newDoc()
+	err := optimizeSize(cfg, doc)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds the 100B budget")
+	assert.NotEmpty(t, doc.Paths["/orders"].Get.Description)
+}
+
+func TestTimer_Write(t *testing.T) {
+	tmpDir := t.TempDir()
+	source := filepath.Join(tmpDir, "main.go")
+	files := []scanner.SourceFile{{Path: source, Language: "go", Content: []byte("package main\n")}}
+
+	timings := newTimer()
+	timings.scanned(files, time.Now())
+
+	goParser := parser.NewGoParser()
+	for i := 0; i < 2; i++ {
+		_, err := goParser.ParseSource(source, string(files[0].Content))
+		require.NoError(t, err)
+	}
+	timings.extractedRoutes("chi", 3, time.Now())
+	timings.extractedSchemas("chi", 1, time.Now())
+
+	reportPath := filepath.Join(tmpDir, "timings.json")
+	require.NoError(t, timings.write(reportPath, tmpDir, files))
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	var report timingReport
+	require.NoError(t, json.Unmarshal(data, &report))
+
+	assert.Equal(t, 1, report.Scan.Files)
+	require.Len(t, report.Plugins, 1)
+	assert.Equal(t, "chi", report.Plugins[0].Name)
+	assert.Equal(t, 3, report.Plugins[0].Routes)
+	assert.Equal(t, 1, report.Plugins[0].Schemas)
+	require.Len(t, report.Files, 1)
+	assert.Equal(t, "main.go", report.Files[0].Path)
+	assert.Equal(t, "go", report.Files[0].Language)
+	assert.Equal(t, 2, report.Files[0].Parses)
+	assert.Equal(t, 1, report.Reparses)
+}
+
+func TestTimer_Nil(t *testing.T) {
+	var timings *timer
+	timings.scanned(nil, time.Now())
+	timings.extractedRoutes("chi", 1, time.Now())
+	assert.NoError(t, timings.write("unused.json", ".", nil))
+}
+
 func TestWatchCommand_InvalidPath(t *testing.T) {
 	// Create a watcher with a non-existent path
 	tmpDir := t.TempDir()
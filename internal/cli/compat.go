@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	compatAgainst string
+	compatMode    string
+)
+
+var compatCmd = &cobra.Command{
+	Use:   "compat [spec]",
+	Short: "Check schema evolution compatibility with a previous spec",
+	Long: `Compat applies schema registry evolution rules to the component schemas
+of the spec extracted from the source code, or of the given spec file,
+compared with a previous spec.
+
+  backward  the new schemas read data written with the previous ones:
+            no removed schemas or fields, no narrowed types (number ->
+            integer, removed enum values, no longer nullable) and no new
+            required fields
+  forward   the previous schemas read data written with the new ones:
+            no widened types and no removed required fields
+  full      both
+
+Violations are reported with the JSON pointer of the schema or property
+and, for extracted specs, the source declaration. The command fails when
+there are any.
+
+Example:
+  api2spec compat --against previous.yaml                  # Backward, extracted spec
+  api2spec compat --against v1.yaml --mode full v2.yaml    # Two spec files`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCompat,
+}
+
+func init() {
+	compatCmd.Flags().StringVar(&compatAgainst, "against", "", "previous spec file (default: the output file)")
+	compatCmd.Flags().StringVar(&compatMode, "mode", openapi.CompatBackward, "compatibility mode: "+strings.Join(openapi.CompatModes, ", "))
+}
+
+func runCompat(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if output != "" {
+		cfg.Output = output
+	}
+	if framework != "" {
+		cfg.Framework = framework
+	}
+
+	against := compatAgainst
+	if against == "" {
+		against = cfg.Output
+	}
+	previous, err := openapi.ReadFile(against)
+	if err != nil {
+		return fmt.Errorf("failed to read spec file %s: %w", against, err)
+	}
+
+	var current *types.OpenAPI
+	label := "<generated>"
+	if len(args) > 0 {
+		label = args[0]
+		if current, err = openapi.ReadFile(label); err != nil {
+			return fmt.Errorf("failed to read spec file %s: %w", label, err)
+		}
+	} else if current, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths); err != nil {
+		return fmt.Errorf("failed to generate spec from code: %w", err)
+	}
+
+	violations, err := openapi.CheckCompatibility(previous, current, compatMode)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		printInfo("%s is %s compatible with %s", label, compatMode, against)
+		return nil
+	}
+	for _, v := range violations {
+		printError("%s", v)
+	}
+	return fmt.Errorf("%s is not %s compatible with %s: %d violations", label, compatMode, against, len(violations))
+}
@@ -90,7 +90,7 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		}
 		labelA = cfg.Output
 
-		specB, err = generateSpecFromCode(cfg, cfg.Source.Paths)
+		specB, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
 		if err != nil {
 			return fmt.Errorf("failed to generate spec from code: %w", err)
 		}
@@ -106,7 +106,7 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		}
 		labelA = args[0]
 
-		specB, err = generateSpecFromCode(cfg, cfg.Source.Paths)
+		specB, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
 		if err != nil {
 			return fmt.Errorf("failed to generate spec from code: %w", err)
 		}
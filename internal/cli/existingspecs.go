@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// expandExisting expands the glob patterns among the --existing values.
+// Plain paths are kept even if they do not exist yet.
+func expandExisting(values []string) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		matches := []string{value}
+		if strings.ContainsAny(value, "*?[") {
+			var err error
+			matches, err = filepath.Glob(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --existing pattern %q: %w", value, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("--existing pattern %q matches no files", value)
+			}
+		}
+		for _, path := range matches {
+			path = filepath.Clean(path)
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths, nil
+}
+
+// selectExistingSpecs returns the configured existing specs for paths, in
+// the order given. Every path needs routing rules in the config.
+func selectExistingSpecs(specs []config.ExistingSpecConfig, paths []string) ([]config.ExistingSpecConfig, error) {
+	var selected []config.ExistingSpecConfig
+	for _, path := range paths {
+		found := false
+		for _, spec := range specs {
+			if filepath.Clean(spec.Path) == path {
+				selected = append(selected, spec)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no routing rules for %s: add it to generation.existingSpecs with the paths or tags it receives", path)
+		}
+	}
+	return selected, nil
+}
+
+// existingSpecSubset returns the operations an existing spec receives.
+func existingSpecSubset(spec config.ExistingSpecConfig) openapi.Subset {
+	return openapi.Subset{Paths: spec.Paths, Tags: spec.Tags}
+}
+
+// writeExistingSpecs merges the operations of doc into the selected
+// maintained specs their path and tag rules route them to, and writes
+// each one. The spec without rules receives the operations no other spec
+// of the config selects.
+func writeExistingSpecs(cfg *config.Config, doc *types.OpenAPI, selected []config.ExistingSpecConfig) error {
+	var routed []openapi.Subset
+	catchAll := false
+	for _, spec := range cfg.Generation.ExistingSpecs {
+		if subset := existingSpecSubset(spec); !subset.IsEmpty() {
+			routed = append(routed, subset)
+		} else {
+			catchAll = true
+		}
+	}
+	if !catchAll {
+		for _, op := range openapi.Operations(openapi.Exclude(doc, routed)) {
+			printWarning("%s matches no generation.existingSpecs rules and is not written", op)
+		}
+	}
+
+	merger := openapi.NewMerger(mergeOptions(cfg))
+	writer := openapi.NewWriter()
+	for _, spec := range selected {
+		subset := existingSpecSubset(spec)
+		generated := doc
+		if subset.IsEmpty() {
+			generated = openapi.Exclude(doc, routed)
+		}
+
+		existing := openapi.Skeleton(doc)
+		if _, err := os.Stat(spec.Path); err == nil {
+			printVerbose("Merging with existing spec: %s", spec.Path)
+			existing, err = openapi.ReadFile(spec.Path)
+			if err != nil {
+				return fmt.Errorf("failed to read existing spec %s: %w", spec.Path, err)
+			}
+		} else {
+			printVerbose("No existing spec found at %s, creating new", spec.Path)
+		}
+
+		merged, err := merger.MergeSubset(existing, generated, subset)
+		if err != nil {
+			return fmt.Errorf("failed to merge into %s: %w", spec.Path, err)
+		}
+
+		output := spec.OutputPath()
+		if cfg.Generation.OperationHashes {
+			openapi.StampHashes(merged)
+			reportChangedOperations(merged, output)
+		}
+
+		format := formatForPath(output, cfg.Format)
+		if generateDryRun {
+			var content string
+			if format == "json" {
+				content, err = writer.ToJSON(merged)
+			} else {
+				content, err = writer.ToYAML(merged)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to serialize spec: %w", err)
+			}
+			if err := previewSpec(output, content); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := writer.WriteFile(merged, output, format); err != nil {
+			return fmt.Errorf("failed to write spec: %w", err)
+		}
+		printInfo("OpenAPI specification written to: %s (%d operations)", output, len(openapi.Operations(merged)))
+	}
+	return nil
+}
+
+// formatForPath returns the spec format for a file extension, or fallback
+// if the extension names neither.
+func formatForPath(path, fallback string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".yaml", ".yml":
+		return "yaml"
+	}
+	return fallback
+}
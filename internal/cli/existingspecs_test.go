@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestExpandExisting(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"public.yaml", "internal.yaml"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("openapi: 3.0.3\n"), 0o644))
+	}
+
+	paths, err := expandExisting([]string{filepath.Join(dir, "*.yaml"), filepath.Join(dir, "public.yaml"), "new.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "internal.yaml"), filepath.Join(dir, "public.yaml"), "new.yaml"}, paths)
+
+	_, err = expandExisting([]string{filepath.Join(dir, "*.json")})
+	assert.Error(t, err)
+}
+
+func TestSelectExistingSpecs(t *testing.T) {
+	specs := []config.ExistingSpecConfig{
+		{Path: "specs/public.yaml", Tags: []string{"public"}},
+		{Path: "./specs/internal.yaml"},
+	}
+
+	selected, err := selectExistingSpecs(specs, []string{"specs/internal.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, specs[1:], selected)
+
+	_, err = selectExistingSpecs(specs, []string{"specs/other.yaml"})
+	assert.ErrorContains(t, err, "no routing rules for specs/other.yaml")
+}
+
+func TestWriteExistingSpecs(t *testing.T) {
+	dir := t.TempDir()
+	public := filepath.Join(dir, "public.yaml")
+	internal := filepath.Join(dir, "internal.yaml")
+	require.NoError(t, os.WriteFile(public, []byte(`openapi: 3.0.3
+info:
+  title: Public API
+  version: "1.0"
+paths:
+  /users:
+    get:
+      tags: [public]
+      summary: List users
+`), 0o644))
+
+	cfg := config.Default()
+	cfg.Generation.ExistingSpecs = []config.ExistingSpecConfig{
+		{Path: public, Tags: []string{"public"}},
+		{Path: internal},
+	}
+	doc := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "API", Version: "1.0"},
+		Paths: map[string]types.PathItem{
+			"/users":       {Get: &types.Operation{Tags: []string{"public"}}, Post: &types.Operation{Tags: []string{"public"}}},
+			"/admin/stats": {Get: &types.Operation{}},
+		},
+	}
+
+	require.NoError(t, writeExistingSpecs(cfg, doc, cfg.Generation.ExistingSpecs))
+
+	written, err := openapi.ReadFile(public)
+	require.NoError(t, err)
+	assert.Equal(t, "Public API", written.Info.Title)
+	assert.Equal(t, []string{"GET /users", "POST /users"}, openapi.Operations(written))
+	assert.Equal(t, "List users", written.Paths["/users"].Get.Summary)
+
+	written, err = openapi.ReadFile(internal)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /admin/stats"}, openapi.Operations(written))
+}
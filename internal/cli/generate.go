@@ -4,21 +4,38 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/api2spec/api2spec/internal/backstage"
 	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/lint"
+	"github.com/api2spec/api2spec/internal/manifest"
 	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	_ "github.com/api2spec/api2spec/internal/plugins/actix"   // Register actix plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/adonis"  // Register adonis plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/aiohttp" // Register aiohttp plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/aspnet"  // Register aspnet plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/axum"    // Register axum plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/bun"     // Register bun plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/cakephp" // Register cakephp plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/chi"     // Register chi plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/codeigniter" // Register codeigniter plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/crow"    // Register crow plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/dartfrog" // Register dartfrog plugin
+	"github.com/api2spec/api2spec/internal/plugins/declarative"
 	_ "github.com/api2spec/api2spec/internal/plugins/drf"     // Register drf plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/drogon"  // Register drogon plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/echo"    // Register echo plugin
@@ -26,40 +43,86 @@ import (
 	_ "github.com/api2spec/api2spec/internal/plugins/express" // Register express plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/fastapi" // Register fastapi plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/fastify" // Register fastify plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/feathers" // Register feathers plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/fiber"   // Register fiber plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/fastendpoints" // Register fastendpoints plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/flask"   // Register flask plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/fresh"   // Register fresh plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/gin"     // Register gin plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/gleam"   // Register gleam plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/hapi"    // Register hapi plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/hono"    // Register hono plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/javalin"   // Register javalin plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/jaxrs"   // Register jaxrs plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/koa"     // Register koa plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/ktor"    // Register ktor plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/laravel"    // Register laravel plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/mezzio"     // Register mezzio plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/micro"      // Register micro plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/micronaut" // Register micronaut plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/moleculer" // Register moleculer plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/nancy"     // Register nancy plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/nestjs"    // Register nestjs plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/oak"       // Register oak plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/oatpp"     // Register oatpp plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/phoenix"  // Register phoenix plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/play"     // Register play plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/polka"    // Register polka plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/rails"   // Register rails plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/restify" // Register restify plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/rocket"  // Register rocket plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/routingcontrollers" // Register routingcontrollers plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/sails"   // Register sails plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/sanic"   // Register sanic plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/sinatra" // Register sinatra plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/spring"  // Register spring plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/slim"    // Register slim plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/starlette" // Register starlette plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/symfony" // Register symfony plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/tapir"   // Register tapir plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/tornado" // Register tornado plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/vapor"   // Register vapor plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/vertx"   // Register vertx plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/wordpress" // Register wordpress plugin
 	_ "github.com/api2spec/api2spec/internal/plugins/servant" // Register servant plugin
+	_ "github.com/api2spec/api2spec/internal/plugins/shelf"   // Register shelf plugin
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/sourcemap"
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/vcs"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
 var (
-	generateMode    string
-	generateMerge   bool
-	generateDryRun  bool
-	generateInclude []string
-	generateExclude []string
+	generateMode          string
+	generateMerge         bool
+	generateDryRun        bool
+	generateInclude       []string
+	generateExclude       []string
+	generateSourceLinks   bool
+	generateManifest      bool
+	generateSourceMap     bool
+	generateDecisions     bool
+	generateSign          string
+	generateSignKey       string
+	generateBackstage     bool
+	generatePruneUnused   bool
+	generateInlineDepth   int
+	generateInfra         bool
+	generatePruneExisting bool
+	generateExisting      []string
+	generateReview        bool
+	generateTimings       string
+	generateLossyReport   string
+	generateOnlyPaths     []string
+	generateOnlyTags      []string
+	generateVariant       string
+	generateAt            string
+	generateAtCommit      string
+	generateOptimizeSize  bool
+	generatePartition     bool
+	generateAPIVersion    string
+	generateVersionSource string
 )
 
 var generateCmd = &cobra.Command{
@@ -84,7 +147,25 @@ Example:
   api2spec generate ./cmd ./internal          # Generate from specific paths
   api2spec generate --mode routes-only        # Generate routes only
   api2spec generate --merge                   # Merge with existing spec
-  api2spec generate --dry-run                 # Preview without writing
+  api2spec generate --existing api/spec.yaml  # Merge into a hand-maintained spec
+  api2spec generate --existing 'specs/*.yaml' # Merge into specs routed by generation.existingSpecs
+  api2spec generate --dry-run                 # Diff the changes without writing
+  api2spec generate --source-links            # Link operations to source lines
+  api2spec generate --manifest --sign cosign  # Write a signed checksum manifest
+  api2spec generate --source-map              # Map schema properties to their declarations
+  api2spec generate --backstage               # Register the spec in catalog-info.yaml
+  api2spec generate --merge --prune-unused    # Drop generated schemas no operation uses
+  api2spec generate --include-infrastructure  # Keep /health, /metrics and similar endpoints
+  api2spec generate --review                  # Exclude, rename or tag operations first
+  api2spec generate --timings timings.json    # Report where generation time goes
+  api2spec generate --lossy-report lossy.json # List types documented as object or string
+  api2spec generate --only-path '/users/**'   # Regenerate one area of the spec
+  api2spec generate --only-tag billing        # Regenerate one tag's operations
+  api2spec generate --at v1.4.0 -o v1.4.yaml  # Reconstruct the spec of a past release
+  api2spec generate --variant beta            # Spec of the routes enabled in generation.variants beta
+  api2spec generate --partition-by-segment    # users.yaml, orders.yaml, ... indexed by the output
+  api2spec generate --optimize-size           # Shrink the spec for gateway size limits
+  api2spec generate --api-version 2.3.0       # Set info.version, overriding openapi.info.versionFrom
   api2spec generate --framework chi           # Use chi plugin explicitly`,
 	RunE: runGenerate,
 }
@@ -92,9 +173,31 @@ Example:
 func init() {
 	generateCmd.Flags().StringVarP(&generateMode, "mode", "m", "full", "generation mode: full, routes-only, schemas-only")
 	generateCmd.Flags().BoolVar(&generateMerge, "merge", false, "merge with existing spec file")
-	generateCmd.Flags().BoolVar(&generateDryRun, "dry-run", false, "preview output without writing to file")
+	generateCmd.Flags().StringSliceVar(&generateExisting, "existing", nil, "spec to merge into (implies --merge; default: a spec loaded by OpenAPI middleware, or the output file); repeat or use a glob to update several generation.existingSpecs")
+	generateCmd.Flags().BoolVar(&generateDryRun, "dry-run", false, "print a diff of the changes to the spec file without writing anything")
 	generateCmd.Flags().StringSliceVarP(&generateInclude, "include", "i", nil, "glob patterns to include")
 	generateCmd.Flags().StringSliceVarP(&generateExclude, "exclude", "e", nil, "glob patterns to exclude")
+	generateCmd.Flags().BoolVar(&generateSourceLinks, "source-links", false, "link each operation's externalDocs to its source line on the git host")
+	generateCmd.Flags().BoolVar(&generateManifest, "manifest", false, "write a SHA-256 checksum manifest next to the spec")
+	generateCmd.Flags().BoolVar(&generateSourceMap, "source-map", false, "write a source map from schema properties to their source declarations next to the spec")
+	generateCmd.Flags().BoolVar(&generateDecisions, "decisions", false, "write a log of the plugin claiming each file, the heuristics that fired and the defaults assumed next to the spec")
+	generateCmd.Flags().StringVar(&generateSign, "sign", "", "sign the manifest with cosign or minisign (implies --manifest)")
+	generateCmd.Flags().StringVar(&generateSignKey, "sign-key", "", "private key for --sign")
+	generateCmd.Flags().BoolVar(&generateBackstage, "backstage", false, "create or update a Backstage catalog-info.yaml API entity for the spec")
+	generateCmd.Flags().BoolVar(&generatePruneUnused, "prune-unused", false, "remove component schemas no operation references")
+	generateCmd.Flags().IntVar(&generateInlineDepth, "max-inline-depth", 0, "move inline objects nested more than this many objects deep into named components (generation.maxInlineDepth)")
+	generateCmd.Flags().BoolVar(&generateInfra, "include-infrastructure", false, "keep health check and metrics endpoints (generation.infrastructure.patterns) in the spec")
+	generateCmd.Flags().BoolVar(&generateReview, "review", false, "review extracted operations interactively and save the decisions to the config")
+	generateCmd.Flags().StringVar(&generateTimings, "timings", "", "write a JSON report of scan, parse and extraction times to this file (- for stdout)")
+	generateCmd.Flags().StringVar(&generateLossyReport, "lossy-report", "", "write a JSON list of the types documented as object or string because they could not be resolved to this file (- for stdout)")
+	generateCmd.Flags().StringSliceVar(&generateOnlyPaths, "only-path", nil, "regenerate only operations whose path matches these globs, merged into the existing spec")
+	generateCmd.Flags().StringSliceVar(&generateOnlyTags, "only-tag", nil, "regenerate only operations with these tags, merged into the existing spec")
+	generateCmd.Flags().StringVar(&generateAt, "at", "", "extract from the source files as of this git commit, branch or tag, without checking it out")
+	generateCmd.Flags().StringVar(&generateVariant, "variant", "", "generate the spec of a generation.variants entry, keeping only routes its build tags and environment enable")
+	generateCmd.Flags().BoolVar(&generatePruneExisting, "prune-existing", false, "with --prune-unused, also remove unused schemas that exist only in the merged spec")
+	generateCmd.Flags().BoolVar(&generateOptimizeSize, "optimize-size", false, "strip descriptions and examples, merge identical schemas and report the spec size per section (generation.optimizeSize)")
+	generateCmd.Flags().BoolVar(&generatePartition, "partition-by-segment", false, "write one spec per top-level path segment, with the output as their index (generation.partitionBySegment)")
+	generateCmd.Flags().StringVar(&generateAPIVersion, "api-version", "", "info.version of the spec, overriding openapi.info.version and versionFrom")
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
@@ -111,6 +214,53 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	if generateMerge {
 		cfg.Generation.Merge = true
 	}
+	existingSpecs := cfg.Generation.ExistingSpecs
+	if len(generateExisting) > 0 {
+		cfg.Generation.Merge = true
+		paths, err := expandExisting(generateExisting)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 1 && len(existingSpecs) == 0 {
+			cfg.Generation.Existing = paths[0]
+		} else if existingSpecs, err = selectExistingSpecs(existingSpecs, paths); err != nil {
+			return err
+		}
+	}
+	if generateSourceLinks {
+		cfg.Generation.SourceLinks.Enabled = true
+	}
+	if generateManifest {
+		cfg.Generation.Manifest.Enabled = true
+	}
+	if generateSourceMap {
+		cfg.Generation.SourceMap.Enabled = true
+	}
+	if generateDecisions {
+		cfg.Generation.Decisions.Enabled = true
+	}
+	if generateSign != "" {
+		cfg.Generation.Manifest.Enabled = true
+		cfg.Generation.Manifest.Signer = generateSign
+	}
+	if generateSignKey != "" {
+		cfg.Generation.Manifest.Key = generateSignKey
+	}
+	if generateInlineDepth > 0 {
+		cfg.Generation.MaxInlineDepth = generateInlineDepth
+	}
+	if generateInfra {
+		cfg.Generation.Infrastructure.Include = true
+	}
+	if generateBackstage {
+		cfg.Generation.Backstage.Enabled = true
+	}
+	if generateOptimizeSize {
+		cfg.Generation.OptimizeSize.Enabled = true
+	}
+	if generatePartition {
+		cfg.Generation.PartitionBySegment = true
+	}
 	if len(generateInclude) > 0 {
 		cfg.Source.Include = generateInclude
 	}
@@ -138,6 +288,16 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	var variant *config.VariantConfig
+	if generateVariant != "" {
+		if variant = cfg.Generation.Variant(generateVariant); variant == nil {
+			return fmt.Errorf("unknown variant %q; define it under generation.variants", generateVariant)
+		}
+		if output == "" {
+			cfg.Output = variant.OutputPath(cfg.Output)
+		}
+	}
+
 	printVerbose("Configuration:")
 	printVerbose("  Framework: %s", cfg.Framework)
 	printVerbose("  Mode: %s", cfg.Generation.Mode)
@@ -155,9 +315,23 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to determine project root: %w", err)
 	}
 
-	// Get or detect framework plugin
+	if err := declarative.Register(cfg.FrameworkDefinitions); err != nil {
+		return fmt.Errorf("failed to load framework definitions: %w", err)
+	}
+
+	typemap.Set(cfg.Generation.TypeMappings)
+
+	var decisionLog *decisions.Log
+	if cfg.Generation.Decisions.Enabled {
+		decisionLog = decisions.New(cfg.Output, projectRoot)
+	}
+
+	// Get or detect framework plugin; services name their own
 	var plugin plugins.FrameworkPlugin
-	if cfg.Framework == "" || cfg.Framework == "auto" {
+	if cfg.Generation.Services.Strategy != "" {
+		printVerbose("Generating services with the %s strategy", cfg.Generation.Services.Strategy)
+		decisionLog.Chose("", decisions.SourceServices, detectedPlugins(decisionLog, projectRoot))
+	} else if cfg.Framework == "" || cfg.Framework == "auto" {
 		printVerbose("Auto-detecting framework...")
 		plugin, err = plugins.Detect(projectRoot)
 		if err != nil {
@@ -166,6 +340,7 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 			printInfo("Use --framework to specify a framework or ensure go.mod contains framework imports")
 		} else {
 			printInfo("Detected framework: %s", plugin.Name())
+			decisionLog.Chose(plugin.Name(), decisions.SourceDetected, detectedPlugins(decisionLog, projectRoot))
 		}
 	} else {
 		plugin = plugins.Get(cfg.Framework)
@@ -173,15 +348,48 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("unknown framework %q. Available: %s", cfg.Framework, strings.Join(plugins.List(), ", "))
 		}
 		printVerbose("Using framework: %s", plugin.Name())
+		decisionLog.Chose(plugin.Name(), decisions.SourceConfigured, detectedPlugins(decisionLog, projectRoot))
 	}
 
 	// Create scanner with config
 	scannerCfg := scanner.Config{
-		IncludePatterns: cfg.Source.Include,
-		ExcludePatterns: cfg.Source.Exclude,
+		IncludePatterns:    cfg.Source.Include,
+		ExcludePatterns:    cfg.Source.Exclude,
+		FollowSymlinks:     cfg.Source.FollowSymlinks,
+		LanguageExtensions: cfg.Source.LanguageExtensions,
+		LanguageOverrides:  cfg.Source.LanguageOverrides,
+	}
+
+	// Scanning and extraction stop on Ctrl-C or when --timeout elapses
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	var timings *timer
+	if generateTimings != "" {
+		timings = newTimer()
+	}
+
+	// Read the source tree of a past revision instead of the working tree
+	var tree *vcs.Tree
+	generateAtCommit = ""
+	if generateAt != "" {
+		if tree, err = vcs.ReadTree(projectRoot, generateAt); err != nil {
+			return fmt.Errorf("failed to read revision: %w", err)
+		}
+		generateAtCommit = tree.Commit
+		printInfo("Extracting from %s (commit %s)", generateAt, tree.Commit)
+	}
+
+	// Source info.version from the flag or the configured sources
+	generateVersionSource = ""
+	if version, source := resolveAPIVersion(generateAPIVersion, cfg, projectRoot, tree); source != "" {
+		cfg.OpenAPI.Info.Version = version
+		generateVersionSource = source
+		printVerbose("API version %s (from %s)", version, source)
 	}
 
 	// Scan for source files
+	scanStart := time.Now()
 	var files []scanner.SourceFile
 	for _, path := range paths {
 		absPath, err := filepath.Abs(path)
@@ -189,13 +397,19 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to resolve path %s: %w", path, err)
 		}
 		scannerCfg.BasePath = absPath
-		s := scanner.New(scannerCfg)
-		pathFiles, err := s.Scan()
+		var pathFiles []scanner.SourceFile
+		if tree != nil {
+			pathFiles, err = scanRevision(ctx, scannerCfg, tree, projectRoot)
+		} else {
+			pathFiles, err = scanner.New(scannerCfg).ScanContext(ctx)
+		}
 		if err != nil {
-			return fmt.Errorf("failed to scan path %s: %w", path, err)
+			return fmt.Errorf("failed to scan path %s: %w", path, contextError(ctx, err))
 		}
 		files = append(files, pathFiles...)
 	}
+	timings.scanned(files, scanStart)
+	decisionLog.Scanned(files)
 
 	// Print discovered files in verbose mode
 	printVerbose("Discovered %d source files:", len(files))
@@ -223,71 +437,233 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	// Extract routes and schemas using plugin
 	var routes []types.Route
 	var schemas []types.Schema
+	var unparsed []string
 
-	if plugin != nil {
+	if cfg.Generation.Services.Strategy != "" {
+		extractions, err := extractServices(ctx, cfg, plugins.Global(), files, projectRoot, decisionLog)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+		for _, ex := range extractions {
+			printLintWarnings(projectRoot, lint.Diagnostics(ex.routes))
+		}
+		diagnostics := parser.Diagnostics()
+		unparsed = unparsedFiles(diagnostics)
+		decisionLog.Unparsed(unparsed)
+		printLintWarnings(projectRoot, lint.FileDiagnostics(diagnostics))
+
+		if cfg.Generation.Services.Strategy == "split" {
+			cancel()
+			specs, err := buildServiceSpecs(cfg, projectRoot, extractions)
+			if err != nil {
+				return err
+			}
+			return writeServiceSpecs(cfg, specs, generateDryRun)
+		}
+		routes, schemas = joinServices(extractions)
+		printInfo("Found %d routes and %d schemas in %d services", len(routes), len(schemas), len(extractions))
+	} else if plugin != nil {
 		printInfo("Extracting routes and schemas using %s plugin...", plugin.Name())
 
 		// Extract routes (if mode allows)
 		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "routes-only" {
-			extractedRoutes, err := plugin.ExtractRoutes(files)
+			start := time.Now()
+			extractedRoutes, err := plugins.ExtractRoutes(ctx, plugin, files)
 			if err != nil {
-				return fmt.Errorf("failed to extract routes: %w", err)
+				return fmt.Errorf("failed to extract routes: %w", contextError(ctx, err))
 			}
 			routes = extractedRoutes
+			markRoutes(cfg, routes, files, projectRoot, decisionLog)
+			if variant != nil {
+				routes = selectVariant(routes, files, variant)
+			}
+			timings.extractedRoutes(plugin.Name(), len(routes), start)
 			printInfo("Found %d routes", len(routes))
 
 			for _, r := range routes {
 				printVerbose("  %s %s -> %s", r.Method, r.Path, r.Handler)
 			}
+
+			printLintWarnings(projectRoot, lint.Diagnostics(routes))
+			if cfg.Generation.Lint.PathParams {
+				printLintWarnings(projectRoot, lint.PathParams(routes, files))
+			}
+			if cfg.Generation.Lint.DuplicateRoutes {
+				printLintWarnings(projectRoot, lint.DuplicateRoutes(routes, plugin.Name()))
+			}
+			if naming := cfg.Generation.Lint.Naming; naming != (config.NamingConfig{}) {
+				printLintWarnings(projectRoot, lint.Naming(routes, lint.NamingRules{
+					KebabCase:       naming.KebabCase,
+					PluralResources: naming.PluralResources,
+					NoVerbs:         naming.NoVerbs,
+					VersionPrefix:   naming.VersionPrefix,
+				}))
+			}
 		}
 
 		// Extract schemas (if mode allows)
 		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "schemas-only" {
-			extractedSchemas, err := plugin.ExtractSchemas(files)
+			start := time.Now()
+			extractedSchemas, err := plugins.ExtractSchemas(ctx, plugin, files)
 			if err != nil {
-				return fmt.Errorf("failed to extract schemas: %w", err)
+				return fmt.Errorf("failed to extract schemas: %w", contextError(ctx, err))
 			}
 			schemas = extractedSchemas
+			timings.extractedSchemas(plugin.Name(), len(schemas), start)
 			printInfo("Found %d schemas", len(schemas))
 
 			for _, s := range schemas {
 				printVerbose("  %s", s.Title)
 			}
 		}
+		decisionLog.Claim(plugin.Name(), plugin.Extensions(), files, routes)
+
+		diagnostics := parser.Diagnostics()
+		unparsed = unparsedFiles(diagnostics)
+		decisionLog.Unparsed(unparsed)
+		printLintWarnings(projectRoot, lint.FileDiagnostics(diagnostics))
 	} else {
 		printInfo("No plugin available - generating empty specification")
 	}
+	cancel()
+
+	// Reviewing reads the terminal, so Ctrl-C must reach it again
+	if generateReview && plugin != nil && (cfg.Generation.Mode == "full" || cfg.Generation.Mode == "routes-only") {
+		if err := reviewOperations(cfg, projectRoot, routes); err != nil {
+			return err
+		}
+	}
 
 	// Create OpenAPI builder
-	builder := openapi.NewBuilder(cfg)
+	buildStart := time.Now()
+	builder := withTenantHost(newBuilder(cfg, projectRoot), cfg, files)
 
 	doc, err := builder.Build(routes, schemas)
 	if err != nil {
 		return fmt.Errorf("failed to build OpenAPI spec: %w", err)
 	}
+	timings.built(buildStart)
+	if cfg.Generation.MethodBodies == openapi.MethodBodiesWarn {
+		for _, op := range openapi.MethodBodies(doc) {
+			printWarning("%s has a request body, which has no defined meaning for GET, HEAD and DELETE (generation.methodBodies: document, query or drop)", op)
+		}
+	}
+	reportUnresolvedSchemas(doc)
+	reportDefaultResponses(routes)
+	if err := checkExtractionQuality(cfg.Generation.FailOn, measureExtraction(files, unparsed, routes, doc)); err != nil {
+		return fmt.Errorf("%w (spec not written)", err)
+	}
+
+	// Schemas that came from the code, as opposed to the hand-maintained spec
+	generated := make(map[string]bool)
+	if doc.Components != nil {
+		for name := range doc.Components.Schemas {
+			generated[name] = true
+		}
+	}
 
-	// Handle merge if requested
-	if cfg.Generation.Merge {
-		if _, err := os.Stat(cfg.Output); err == nil {
-			printVerbose("Merging with existing spec: %s", cfg.Output)
-			existing, err := openapi.ReadFile(cfg.Output)
+	// Regenerate only the selected operations of the existing spec
+	subset := openapi.Subset{Paths: generateOnlyPaths, Tags: generateOnlyTags}
+	if !subset.IsEmpty() && len(existingSpecs) > 0 {
+		return fmt.Errorf("--only-path and --only-tag cannot be combined with generation.existingSpecs")
+	}
+	if !subset.IsEmpty() {
+		existingPath := existingSpecPath(cfg, files, projectRoot)
+		existing, err := openapi.ReadFile(existingPath)
+		if err != nil {
+			return fmt.Errorf("--only-path and --only-tag update an existing spec: %w", err)
+		}
+		doc, err = openapi.NewMerger(mergeOptions(cfg)).MergeSubset(existing, doc, subset)
+		if err != nil {
+			return fmt.Errorf("failed to merge specs: %w", err)
+		}
+		printInfo("Updated operations matching %s in %s", subsetDescription(subset), existingPath)
+	} else if cfg.Generation.Merge && len(existingSpecs) == 0 {
+		existingPath := existingSpecPath(cfg, files, projectRoot)
+		if _, err := os.Stat(existingPath); err == nil {
+			printVerbose("Merging with existing spec: %s", existingPath)
+			existing, err := openapi.ReadFile(existingPath)
 			if err != nil {
 				return fmt.Errorf("failed to read existing spec for merge: %w", err)
 			}
-			doc, err = openapi.MergeDefault(existing, doc)
+			result, err := openapi.NewMerger(mergeOptions(cfg)).MergeWithResult(existing, doc)
 			if err != nil {
 				return fmt.Errorf("failed to merge specs: %w", err)
 			}
+			doc = result.Document
+			for _, name := range slices.Sorted(maps.Keys(result.RenamedSchemas)) {
+				printInfo("Kept schema name %s for renamed %s", result.RenamedSchemas[name], name)
+			}
 		} else {
-			printVerbose("No existing spec found at %s, creating new", cfg.Output)
+			printVerbose("No existing spec found at %s, creating new", existingPath)
+		}
+	}
+
+	if filled := openapi.FillResponses(doc, cfg.Generation.DefaultResponse); len(filled) > 0 {
+		printWarning("%d operations had no responses; added generation.defaultResponse", len(filled))
+		for _, op := range filled {
+			printVerbose("  %s", op)
 		}
 	}
 
+	pruneInlinedSchemas(doc, schemas)
+
+	if cfg.Generation.Lint.UnusedSchemas || generatePruneUnused {
+		reportUnusedSchemas(doc, generated)
+	}
+
+	if cfg.Generation.Lint.Examples {
+		for _, mismatch := range openapi.ValidateExamples(doc) {
+			printWarning("example does not match its schema: %s", mismatch)
+		}
+	}
+
+	if complexity := cfg.Generation.Lint.Complexity; complexity.Enabled {
+		for _, issue := range openapi.Complexity(doc, openapi.ComplexityOptions{
+			MaxProperties: complexity.MaxProperties,
+			MaxDepth:      complexity.MaxDepth,
+			Pagination:    complexity.Pagination,
+		}) {
+			printWarning("%s", issue)
+		}
+	}
+
+	// Extraction is over: fetching is bounded by the client timeout only
+	if err := stitchExternalSpecs(context.Background(), cfg, doc); err != nil {
+		return fmt.Errorf("%w (spec not written)", err)
+	}
+
+	if len(cfg.Policy.Rules) > 0 {
+		if err := checkPolicy(cfg, doc); err != nil {
+			return fmt.Errorf("%w (spec not written)", err)
+		}
+	}
+
+	if err := timings.write(generateTimings, projectRoot, files); err != nil {
+		return err
+	}
+	if err := reportLossyConversions(generateLossyReport, projectRoot, doc, routes); err != nil {
+		return err
+	}
+
+	if err := optimizeSize(cfg, doc); err != nil {
+		return fmt.Errorf("%w (spec not written)", err)
+	}
+
+	if len(existingSpecs) > 0 {
+		return writeExistingSpecs(cfg, doc, existingSpecs)
+	}
+
+	if cfg.Generation.OperationHashes {
+		openapi.StampHashes(doc)
+		reportChangedOperations(doc, cfg.Output)
+	}
+
 	// Write output
 	writer := openapi.NewWriter()
 
 	if generateDryRun {
-		// Print to stdout
+		// Preview the changes to the spec file
 		var output string
 		if cfg.Format == "json" {
 			output, err = writer.ToJSON(doc)
@@ -297,15 +673,578 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to serialize spec: %w", err)
 		}
-		fmt.Print(output)
-		return nil
+		return previewSpec(cfg.Output, output)
 	}
 
 	// Write to file
-	if err := writer.WriteFile(doc, cfg.Output, cfg.Format); err != nil {
+	if cfg.Generation.PartitionBySegment {
+		if err := writePartitions(cfg, doc); err != nil {
+			return err
+		}
+	} else if err := writer.WriteFile(doc, cfg.Output, cfg.Format); err != nil {
 		return fmt.Errorf("failed to write spec: %w", err)
 	}
 
 	printInfo("OpenAPI specification written to: %s", cfg.Output)
+
+	if err := writeProfiles(cfg, doc); err != nil {
+		return err
+	}
+
+	if cfg.Generation.SourceMap.Enabled {
+		if err := writeSourceMap(cfg, projectRoot, doc); err != nil {
+			return err
+		}
+	}
+	if decisionLog != nil {
+		if err := writeDecisions(cfg, decisionLog); err != nil {
+			return err
+		}
+	}
+	if cfg.Generation.Manifest.Enabled {
+		if err := writeManifest(cfg); err != nil {
+			return err
+		}
+	}
+	if cfg.Generation.Backstage.Enabled {
+		if err := writeBackstageEntity(cfg, doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeOptions returns the options to merge into existing specs with.
+func mergeOptions(cfg *config.Config) openapi.MergeOptions {
+	opts := openapi.DefaultMergeOptions()
+	opts.Frozen = openapi.FrozenContracts{
+		Operations: cfg.Generation.Frozen.Operations,
+		Schemas:    cfg.Generation.Frozen.Schemas,
+	}
+	opts.KeepGeneratedVersion = generateVersionSource != ""
+	return opts
+}
+
+// existingSpecPath returns the spec to merge into: the configured one, else
+// a spec the project loads into OpenAPI middleware, else the output file.
+func existingSpecPath(cfg *config.Config, files []scanner.SourceFile, root string) string {
+	if cfg.Generation.Existing != "" {
+		return cfg.Generation.Existing
+	}
+	if refs := openapi.FindSpecReferences(files, root); len(refs) > 0 {
+		ref := refs[0]
+		path := ref.Path
+		if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+		source := ref.SourceFile
+		if rel, err := filepath.Rel(root, source); err == nil && !strings.HasPrefix(rel, "..") {
+			source = rel
+		}
+		printInfo("Merging with %s, loaded by %s in %s:%d", path, ref.Middleware, source, ref.SourceLine)
+		return ref.Path
+	}
+	return cfg.Output
+}
+
+// writeProfiles writes a filtered and redacted copy of the spec, with its
+// own info and servers, for each configured output profile. The format
+// follows the profile's file extension, falling back to the format of the
+// main spec.
+func writeProfiles(cfg *config.Config, doc *types.OpenAPI) error {
+	writer := openapi.NewWriter()
+	for _, profile := range cfg.Generation.Profiles {
+		redacted, removed, err := openapi.Redact(doc, openapi.RedactOptions{
+			InternalPaths:   profile.InternalPaths,
+			SensitiveFields: profile.SensitiveFields,
+			StripExtensions: profile.StripExtensions,
+			Include:         openapi.Subset{Paths: profile.Paths, Tags: profile.Tags},
+			KeepFlagged:     profile.Internal,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to redact %s profile: %w", profile.Name, err)
+		}
+		openapi.ApplyProfile(redacted, profile)
+		openapi.RefreshCodeSamples(redacted)
+		for _, op := range removed.Operations {
+			printVerbose("  [%s] removed operation %s", profile.Name, op)
+		}
+		for _, field := range removed.Fields {
+			printVerbose("  [%s] removed field %s", profile.Name, field)
+		}
+		for _, schema := range removed.Schemas {
+			printVerbose("  [%s] removed schema %s", profile.Name, schema)
+		}
+
+		format := formatForPath(profile.Output, cfg.Format)
+		if err := writer.WriteFile(redacted, profile.Output, format); err != nil {
+			return fmt.Errorf("failed to write %s profile: %w", profile.Name, err)
+		}
+		printInfo("Profile %q written to: %s (removed %d operations, %d fields, %d schemas)",
+			profile.Name, profile.Output, len(removed.Operations), len(removed.Fields), len(removed.Schemas))
+	}
+	return nil
+}
+
+// writePartitions writes one spec per first path segment into a directory
+// named after the output, e.g. openapi/users.yaml, and the output as their
+// index.
+func writePartitions(cfg *config.Config, doc *types.OpenAPI) error {
+	ext := filepath.Ext(cfg.Output)
+	if ext == "" {
+		ext = "." + cfg.Format
+	}
+	dir := strings.TrimSuffix(filepath.Base(cfg.Output), filepath.Ext(cfg.Output))
+	partitions, index, err := openapi.PartitionBySegment(doc, func(name string) string {
+		return dir + "/" + name + ext
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := openapi.NewWriter()
+	for _, part := range partitions {
+		path := filepath.Join(filepath.Dir(cfg.Output), dir, part.Name+ext)
+		if err := writer.WriteFile(part.Doc, path, cfg.Format); err != nil {
+			return fmt.Errorf("failed to write %s partition: %w", part.Name, err)
+		}
+		printVerbose("  Partition %s written to: %s (%d paths)", part.Name, path, len(part.Doc.Paths))
+	}
+	if err := writer.WriteFile(index, cfg.Output, cfg.Format); err != nil {
+		return fmt.Errorf("failed to write spec index: %w", err)
+	}
+	printInfo("Partitioned %d paths into %d specs under %s", len(doc.Paths), len(partitions), filepath.Join(filepath.Dir(cfg.Output), dir))
+	return nil
+}
+
+// subsetDescription describes the operations a partial generation selects.
+func subsetDescription(subset openapi.Subset) string {
+	var parts []string
+	if len(subset.Paths) > 0 {
+		parts = append(parts, "paths "+strings.Join(subset.Paths, ", "))
+	}
+	if len(subset.Tags) > 0 {
+		parts = append(parts, "tags "+strings.Join(subset.Tags, ", "))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// scanRevision scans the files of tree, rooted at root, below the base path
+// of cfg.
+func scanRevision(ctx context.Context, cfg scanner.Config, tree *vcs.Tree, root string) ([]scanner.SourceFile, error) {
+	rel, err := filepath.Rel(root, cfg.BasePath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%s is outside the project directory", cfg.BasePath)
+	}
+	rel = filepath.ToSlash(rel)
+
+	// Names relative to the base path, mapped to their path in the tree
+	treeNames := make(map[string]string)
+	var names []string
+	for _, name := range tree.Files() {
+		var scanName string
+		switch {
+		case rel == ".":
+			scanName = name
+		case name == rel:
+			cfg.BasePath = filepath.Dir(cfg.BasePath)
+			scanName = path.Base(name)
+		case strings.HasPrefix(name, rel+"/"):
+			scanName = strings.TrimPrefix(name, rel+"/")
+		default:
+			continue
+		}
+		treeNames[scanName] = name
+		names = append(names, scanName)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("path does not exist at %s: %s", generateAt, rel)
+	}
+
+	return scanner.New(cfg).ScanTree(ctx, names, func(name string) ([]byte, error) {
+		return tree.ReadFile(treeNames[name])
+	})
+}
+
+// selectVariant keeps the routes registered under the build tags and
+// environment of variant.
+func selectVariant(routes []types.Route, files []scanner.SourceFile, variant *config.VariantConfig) []types.Route {
+	plugins.MarkConditions(routes, files)
+	selected, excluded := plugins.SelectVariant(routes, variant.BuildTags, variant.EnvMap())
+	if len(excluded) > 0 {
+		printInfo("Variant %s: excluded %d routes", variant.Name, len(excluded))
+	}
+	for _, r := range excluded {
+		conditions := make([]string, len(r.Conditions))
+		for i, c := range r.Conditions {
+			conditions[i] = c.String()
+		}
+		printVerbose("  %s %s (%s)", r.Method, r.Path, strings.Join(conditions, ", "))
+	}
+	return selected
+}
+
+// reportChangedOperations compares the operation hashes of doc with those
+// of the spec last written to output and reports the operations that changed.
+func reportChangedOperations(doc *types.OpenAPI, output string) {
+	previous, err := openapi.ReadFile(output)
+	if err != nil {
+		return
+	}
+	changed := openapi.ChangedOperations(previous, doc)
+	if len(changed) == 0 {
+		printInfo("No operations changed since the last generation")
+		return
+	}
+	printInfo("%d operations changed since the last generation", len(changed))
+	for _, op := range changed {
+		printVerbose("  %s", op)
+	}
+}
+
+// reportUnresolvedSchemas warns about the component schemas stubbed for
+// references to types that were not extracted, and the references that
+// could not be stubbed.
+func reportUnresolvedSchemas(doc *types.OpenAPI) {
+	for _, ref := range openapi.DanglingRefs(doc) {
+		if !openapi.ValidComponentName(ref.Schema) {
+			printWarning("schema %s is not a valid component name and was not stubbed", ref)
+		}
+	}
+	if doc.Components == nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(doc.Components.Schemas)) {
+		if unresolved, _ := doc.Components.Schemas[name].Extensions[openapi.ExtUnresolved].(bool); unresolved {
+			printWarning("schema %s is referenced but was not extracted; stubbed with %s (set generation.danglingRefs: fail to stop instead)", name, openapi.ExtUnresolved)
+		}
+	}
+}
+
+// reportDefaultResponses counts the routes without an inferred response,
+// which are documented with the default responses.
+func reportDefaultResponses(routes []types.Route) {
+	count := 0
+	for _, route := range routes {
+		if len(route.Responses) == 0 {
+			count++
+		}
+	}
+	if count > 0 {
+		printInfo("%d of %d routes have no inferred response; documented with the default responses", count, len(routes))
+	}
+}
+
+// pruneInlinedSchemas removes the components a previous generation wrote
+// for schemas now annotated api2spec:visibility inline, once the merged
+// spec no longer references them.
+func pruneInlinedSchemas(doc *types.OpenAPI, schemas []types.Schema) {
+	inline := make(map[string]bool)
+	for i := range schemas {
+		if openapi.SchemaVisibility(&schemas[i]) == openapi.VisibilityInline {
+			inline[schemas[i].Title] = true
+		}
+	}
+	var prune []string
+	for _, name := range openapi.UnusedSchemas(doc) {
+		if inline[name] {
+			prune = append(prune, name)
+		}
+	}
+	openapi.PruneSchemas(doc, prune)
+}
+
+// reportUnusedSchemas warns about component schemas no operation references
+// and, with --prune-unused, removes them. Schemas that exist only in the
+// merged hand-maintained spec are kept unless --prune-existing is set.
+func reportUnusedSchemas(doc *types.OpenAPI, generated map[string]bool) {
+	var prune []string
+	for _, name := range openapi.UnusedSchemas(doc) {
+		switch {
+		case !generatePruneUnused:
+			printWarning("schema %s is not referenced by any operation", name)
+		case generated[name] || generatePruneExisting:
+			prune = append(prune, name)
+		default:
+			printWarning("schema %s is not referenced by any operation (kept: only in the existing spec; use --prune-existing to remove)", name)
+		}
+	}
+	if len(prune) > 0 {
+		openapi.PruneSchemas(doc, prune)
+		printInfo("Pruned %d unused schemas: %s", len(prune), strings.Join(prune, ", "))
+	}
+}
+
+// optimizeSize strips descriptions and examples and merges identical
+// schemas when size optimization is enabled, then reports the size of each
+// section of the spec and fails if it exceeds the configured budget.
+func optimizeSize(cfg *config.Config, doc *types.OpenAPI) error {
+	opts := cfg.Generation.OptimizeSize
+	budget, err := opts.BudgetBytes()
+	if err != nil {
+		return err
+	}
+	if !opts.Enabled && budget == 0 {
+		return nil
+	}
+
+	if opts.Enabled {
+		openapi.StripDocs(doc)
+		if merged := openapi.DedupeSchemas(doc); len(merged) > 0 {
+			printInfo("Merged %d duplicate schemas: %s", len(merged), strings.Join(merged, ", "))
+		}
+	}
+
+	size, err := openapi.MeasureSize(doc, cfg.Format)
+	if err != nil {
+		return fmt.Errorf("failed to measure spec size: %w", err)
+	}
+	printInfo("Spec size: %s", formatBytes(size.Total))
+	for _, section := range size.Sections {
+		printInfo("  %-20s %s", section.Name, formatBytes(section.Bytes))
+	}
+	if budget > 0 && size.Total > budget {
+		return fmt.Errorf("spec size %s exceeds the %s budget (generation.optimizeSize.budget)", formatBytes(size.Total), opts.Budget)
+	}
 	return nil
 }
+
+// formatBytes formats a size in bytes with decimal units.
+func formatBytes(n int) string {
+	switch {
+	case n >= 1000*1000:
+		return fmt.Sprintf("%.2f MB", float64(n)/(1000*1000))
+	case n >= 1000:
+		return fmt.Sprintf("%.1f KB", float64(n)/1000)
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
+
+// writeBackstageEntity creates or updates the Backstage API entity that
+// points at the written spec.
+func writeBackstageEntity(cfg *config.Config, doc *types.OpenAPI) error {
+	bs := cfg.Generation.Backstage
+
+	definition, err := filepath.Rel(filepath.Dir(bs.Path), cfg.Output)
+	if err != nil {
+		definition = cfg.Output
+	}
+	definition = filepath.ToSlash(definition)
+	if !strings.HasPrefix(definition, ".") && !filepath.IsAbs(definition) {
+		definition = "./" + definition
+	}
+
+	name := bs.Name
+	if name == "" {
+		name = backstage.Name(doc.Info.Title)
+	}
+
+	entity := backstage.Entity{
+		Name:        name,
+		Title:       doc.Info.Title,
+		Description: doc.Info.Description,
+		Owner:       bs.Owner,
+		Lifecycle:   bs.Lifecycle,
+		System:      bs.System,
+		Definition:  definition,
+		TechDocsRef: bs.TechDocsRef,
+	}
+	if err := backstage.WriteFile(bs.Path, entity); err != nil {
+		return fmt.Errorf("failed to write Backstage entity: %w", err)
+	}
+
+	printInfo("Backstage API entity %q written to: %s", name, bs.Path)
+	return nil
+}
+
+// printLintWarnings prints lint warnings with file paths relative to root.
+func printLintWarnings(root string, warnings []lint.Warning) {
+	relative := func(file string) string {
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			return rel
+		}
+		return file
+	}
+	for _, w := range warnings {
+		w.File = relative(w.File)
+		for i := range w.Related {
+			w.Related[i].File = relative(w.Related[i].File)
+		}
+		printWarning("%s", w)
+	}
+}
+
+// writeManifest records the written spec's checksum in a manifest beside
+// it and signs the manifest when a signer is configured.
+func writeManifest(cfg *config.Config) error {
+	commit := generateAtCommit
+	if commit == "" {
+		var err error
+		if commit, err = vcs.Head("."); err != nil {
+			printVerbose("Could not determine source commit: %v", err)
+		}
+	}
+
+	m, err := manifest.New(cfg.Output, "api2spec "+Version, commit)
+	if err != nil {
+		return fmt.Errorf("failed to create manifest: %w", err)
+	}
+	manifestPath := manifest.PathFor(cfg.Output)
+	if err := m.Write(manifestPath); err != nil {
+		return err
+	}
+	printInfo("Checksum manifest written to: %s", manifestPath)
+
+	if signer := cfg.Generation.Manifest.Signer; signer != "" {
+		sigPath, err := manifest.Sign(signer, cfg.Generation.Manifest.Key, manifestPath)
+		if err != nil {
+			return fmt.Errorf("failed to sign manifest: %w", err)
+		}
+		printInfo("Manifest signature written to: %s", sigPath)
+	}
+	return nil
+}
+
+// writeSourceMap records where each generated schema and property was
+// declared in a source map beside the spec.
+func writeSourceMap(cfg *config.Config, root string, doc *types.OpenAPI) error {
+	m := sourcemap.New(cfg.Output, root, doc)
+	path := sourcemap.PathFor(cfg.Output)
+	if err := m.Write(path); err != nil {
+		return err
+	}
+	printInfo("Source map written to: %s", path)
+	return nil
+}
+
+// writeDecisions writes the decisions log of the run beside the spec.
+func writeDecisions(cfg *config.Config, decisionLog *decisions.Log) error {
+	path := decisions.PathFor(cfg.Output)
+	if err := decisionLog.Write(path); err != nil {
+		return err
+	}
+	printInfo("Decisions log written to: %s", path)
+	return nil
+}
+
+// detectedPlugins returns the plugins whose detection matches the project
+// when decisions are logged.
+func detectedPlugins(decisionLog *decisions.Log, projectRoot string) []string {
+	if decisionLog == nil {
+		return nil
+	}
+	var names []string
+	for _, plugin := range plugins.DetectAll(projectRoot) {
+		names = append(names, plugin.Name())
+	}
+	return names
+}
+
+// markRoutes applies the route-marking plugins enabled in cfg to routes:
+// webhook receivers, request headers, conditional requests, CORS policies,
+// raw request bodies, path parameter examples, timeouts, OAuth scopes,
+// lifecycle and streaming annotations and per-path servers. The routes
+// each one changes are recorded in decisionLog, which may be nil.
+func markRoutes(cfg *config.Config, routes []types.Route, files []scanner.SourceFile, projectRoot string, decisionLog *decisions.Log) {
+	decisionLog.Mark("webhookReceivers", routes, func() { plugins.MarkWebhookReceivers(routes, files) })
+	if cfg.Generation.RequestHeaders {
+		decisionLog.Mark("requestHeaders", routes, func() { plugins.MarkRequestHeaders(routes, files) })
+	}
+	if cfg.Generation.ConditionalRequests {
+		decisionLog.Mark("conditionalRequests", routes, func() { plugins.MarkConditionalRequests(routes, files) })
+	}
+	if cfg.Generation.CORS {
+		decisionLog.Mark("cors", routes, func() { plugins.MarkCORS(routes, files) })
+	}
+	if cfg.Generation.RawBodies {
+		decisionLog.Mark("rawBodies", routes, func() { plugins.MarkRawBodies(routes, files) })
+	}
+	if cfg.Generation.InferEnums {
+		decisionLog.Mark("inferEnums", routes, func() { plugins.MarkEnums(routes, files) })
+	}
+	if cfg.Generation.ParameterExamples.Enabled {
+		decisionLog.Mark("parameterExamples", routes, func() { plugins.MarkParameterExamples(routes, exampleFiles(cfg, projectRoot)) })
+	}
+	if cfg.Generation.Timeouts {
+		decisionLog.Mark("timeouts", routes, func() { plugins.MarkTimeouts(routes, files, projectRoot) })
+	}
+	if cfg.Generation.Scopes {
+		decisionLog.Mark("scopes", routes, func() { plugins.MarkScopes(routes, files) })
+	}
+	if cfg.Generation.Lifecycle.Annotations {
+		decisionLog.Mark("lifecycle", routes, func() { plugins.MarkLifecycle(routes, files) })
+	}
+	if cfg.Generation.Streaming.Annotations {
+		decisionLog.Mark("streaming", routes, func() { plugins.MarkStreaming(routes, files) })
+	}
+	decisionLog.Mark("pathServers", routes, func() { plugins.AssignServers(routes, files, projectRoot) })
+}
+
+// exampleExtensions are the extensions of the test, HTTP and seed files
+// parameter examples are taken from.
+var exampleExtensions = []string{
+	".go", ".ts", ".js", ".mjs", ".py", ".rb", ".php", ".java", ".kt", ".cs", ".rs", ".ex", ".exs",
+	".http", ".rest", ".json", ".yaml", ".yml", ".sql", ".csv",
+}
+
+// exampleFiles returns the files under projectRoot matching
+// generation.parameterExamples.files. Source excludes do not apply, since
+// they usually exclude tests.
+func exampleFiles(cfg *config.Config, projectRoot string) []scanner.SourceFile {
+	files, err := scanner.New(scanner.Config{
+		BasePath:        projectRoot,
+		IncludePatterns: cfg.Generation.ParameterExamples.Files,
+		ExcludePatterns: []string{"vendor/**", "node_modules/**", ".git/**", "dist/**", "build/**", "target/**"},
+		FollowSymlinks:  cfg.Source.FollowSymlinks,
+		Extensions:      exampleExtensions,
+	}).Scan()
+	if err != nil {
+		printVerbose("Skipping parameter examples: %v", err)
+		return nil
+	}
+	return files
+}
+
+// withTenantHost passes the per-tenant host detected in files, if any, to
+// builder.
+func withTenantHost(builder *openapi.Builder, cfg *config.Config, files []scanner.SourceFile) *openapi.Builder {
+	if !cfg.Generation.Tenancy.Detect {
+		return builder
+	}
+	host := plugins.DetectTenantHost(files)
+	if host == nil {
+		return builder
+	}
+	printVerbose("Tenant host %s from %s at %s:%d", host.Host, host.Source, host.File, host.Line)
+
+	return builder.WithTenantHost(openapi.HostTemplate{
+		Host: host.Host,
+		Variables: map[string]types.ServerVariable{
+			host.Variable: {Enum: host.Values, Description: "Tenant subdomain, resolved by " + host.Source},
+		},
+	})
+}
+
+// newBuilder creates an OpenAPI builder for cfg, linking operations to
+// their source lines when generation.sourceLinks is enabled.
+func newBuilder(cfg *config.Config, projectRoot string) *openapi.Builder {
+	builder := openapi.NewBuilder(cfg)
+
+	links := cfg.Generation.SourceLinks
+	if !links.Enabled {
+		return builder
+	}
+
+	linker, err := vcs.Detect(projectRoot, links.Remote, links.URLTemplate)
+	if err != nil {
+		printInfo("Source links disabled: %v", err)
+		return builder
+	}
+	if generateAtCommit != "" {
+		linker.Commit = generateAtCommit
+	}
+	printVerbose("Linking operations to %s at %s", linker.Template, linker.Commit)
+
+	return builder.WithSourceLinks(linker.URL)
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/pkg/golden"
+	"github.com/api2spec/api2spec/pkg/golden/goldentest"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// TestGolden regenerates every fixture under testdata/golden with the
+// registered plugin named after its framework and diffs it against the
+// checked-in openapi.yaml. Run with API2SPEC_UPDATE_GOLDEN=1 to refresh the
+// golden files after an intentional extraction change.
+func TestGolden(t *testing.T) {
+	goldentest.Run(t, "testdata/golden", func(f golden.Fixture) (*types.OpenAPI, error) {
+		plugin := plugins.Get(f.Framework)
+		if plugin == nil {
+			return nil, fmt.Errorf("plugin %q is not registered", f.Framework)
+		}
+		return golden.Generate(f, plugin)
+	})
+}
@@ -24,28 +24,38 @@ var (
 	initTitle       string
 	initVersion     string
 	initDescription string
+	initGitignore   bool
+	initGitHub      bool
 )
 
+// githubWorkflowPath is where init writes the spec freshness workflow.
+var githubWorkflowPath = filepath.Join(".github", "workflows", "api2spec.yml")
+
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize a new api2spec configuration file",
 	Long: `Initialize a new api2spec configuration file in the current directory.
 
-This command creates an api2spec.yaml file with sensible defaults
-that you can customize for your project.
+This command creates a .api2spec.yaml file with sensible defaults
+that you can customize for your project. If a config file already
+exists, --force overwrites it in place.
 
 Features:
-  - Auto-detects your web framework from go.mod
+  - Auto-detects your web frameworks from project files
   - Infers API title from module name
   - Detects common entry point patterns
   - Sets up appropriate exclude patterns
+  - Adds api2spec's scratch files to .gitignore
+  - Optionally writes a GitHub Actions workflow that fails when the
+    committed spec is out of date
 
 Example:
   api2spec init                         # Auto-detect framework and create config
   api2spec init --framework gin         # Create config for Gin framework
   api2spec init --force                 # Overwrite existing config
   api2spec init --interactive           # Interactive mode with prompts
-  api2spec init --title "My API"        # Set custom API title`,
+  api2spec init --title "My API"        # Set custom API title
+  api2spec init --github-actions        # Also write a spec freshness workflow`,
 	RunE: runInit,
 }
 
@@ -56,14 +66,22 @@ func init() {
 	initCmd.Flags().StringVar(&initTitle, "title", "", "API title for OpenAPI info")
 	initCmd.Flags().StringVar(&initVersion, "version", "", "API version for OpenAPI info")
 	initCmd.Flags().StringVar(&initDescription, "description", "", "API description for OpenAPI info")
+	initCmd.Flags().BoolVar(&initGitignore, "gitignore", true, "add api2spec's scratch files to .gitignore")
+	initCmd.Flags().BoolVar(&initGitHub, "github-actions", false, "write a GitHub Actions workflow that checks the spec is up to date")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
-	configFile := "api2spec.yaml"
+	existing := config.FindFile()
+	configFile := initConfigFile(existing)
 
 	// Check if config file already exists
-	if _, err := os.Stat(configFile); err == nil && !initForce {
-		return fmt.Errorf("config file %s already exists, use --force to overwrite", configFile)
+	if existing != "" && !initForce {
+		return fmt.Errorf("config file %s already exists, use --force to overwrite", existing)
+	}
+	if initGitHub && !initForce {
+		if _, err := os.Stat(githubWorkflowPath); err == nil {
+			return fmt.Errorf("workflow %s already exists, use --force to overwrite", githubWorkflowPath)
+		}
 	}
 
 	// Determine project root
@@ -82,16 +100,27 @@ func runInit(cmd *cobra.Command, args []string) error {
 	}
 
 	var detectedPlugin plugins.FrameworkPlugin
+	var otherFrameworks []string
 	if fw == "" {
 		printVerbose("Auto-detecting framework...")
-		detectedPlugin, err = plugins.Detect(projectRoot)
-		if err != nil {
-			printVerbose("Framework detection failed: %v", err)
+		detected := plugins.DetectAll(projectRoot)
+		switch len(detected) {
+		case 0:
 			printInfo("No framework auto-detected. Using 'auto' mode.")
 			fw = "auto"
-		} else {
+		case 1:
+			detectedPlugin = detected[0]
 			fw = detectedPlugin.Name()
 			printInfo("Detected framework: %s", fw)
+		default:
+			detectedPlugin = detected[0]
+			fw = detectedPlugin.Name()
+			names := make([]string, len(detected))
+			for i, p := range detected {
+				names[i] = p.Name()
+			}
+			otherFrameworks = names[1:]
+			printInfo("Detected frameworks: %s (configured %s, edit framework to change)", strings.Join(names, ", "), fw)
 		}
 	} else {
 		// Validate framework using plugins registry
@@ -156,7 +185,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Build YAML with comments
-	output := buildConfigYAML(cfg)
+	output := buildConfigYAML(cfg, otherFrameworks...)
 
 	// Write config file
 	if err := os.WriteFile(configFile, []byte(output), 0644); err != nil {
@@ -168,9 +197,101 @@ func runInit(cmd *cobra.Command, args []string) error {
 	printVerbose("Output: %s", cfg.Output)
 	printVerbose("Paths: %s", strings.Join(cfg.Source.Paths, ", "))
 
+	if initGitignore {
+		added, err := updateGitignore(projectRoot, gitignoreEntries(cfg))
+		if err != nil {
+			return fmt.Errorf("failed to update .gitignore: %w", err)
+		}
+		if len(added) > 0 {
+			printInfo("Added %s to .gitignore", strings.Join(added, ", "))
+		}
+	}
+
+	if initGitHub {
+		if err := os.MkdirAll(filepath.Dir(githubWorkflowPath), 0755); err != nil {
+			return fmt.Errorf("failed to create workflow directory: %w", err)
+		}
+		if err := os.WriteFile(githubWorkflowPath, []byte(buildGitHubWorkflow(cfg)), 0644); err != nil {
+			return fmt.Errorf("failed to write workflow: %w", err)
+		}
+		printInfo("Created %s", githubWorkflowPath)
+	}
+
 	return nil
 }
 
+// initConfigFile returns the config file init writes. An existing YAML
+// config is overwritten in place; an existing JSON config is shadowed by a
+// YAML file of the same name, which Load prefers.
+func initConfigFile(existing string) string {
+	if existing == "" {
+		return ".api2spec.yaml"
+	}
+	return strings.TrimSuffix(existing, filepath.Ext(existing)) + ".yaml"
+}
+
+// generatedSpecPath returns the scratch file a fresh spec is generated into
+// when comparing it against the committed output.
+func generatedSpecPath(output string) string {
+	ext := filepath.Ext(output)
+	return strings.TrimSuffix(output, ext) + ".generated" + ext
+}
+
+// gitignoreEntries returns the .gitignore patterns for files api2spec writes
+// that should not be committed.
+func gitignoreEntries(cfg *config.Config) []string {
+	return []string{"/" + filepath.ToSlash(filepath.Clean(generatedSpecPath(cfg.Output)))}
+}
+
+// updateGitignore appends the entries missing from the project's .gitignore
+// and returns them. Nothing is written outside a git repository unless a
+// .gitignore already exists.
+func updateGitignore(projectRoot string, entries []string) ([]string, error) {
+	path := filepath.Join(projectRoot, ".gitignore")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		if _, err := os.Stat(filepath.Join(projectRoot, ".git")); err != nil {
+			return nil, nil
+		}
+	}
+
+	existing := make(map[string]bool)
+	for _, line := range strings.Split(string(content), "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+
+	var added []string
+	for _, entry := range entries {
+		if !existing[entry] && !existing[strings.TrimPrefix(entry, "/")] {
+			added = append(added, entry)
+		}
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.Write(content)
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		b.WriteString("\n")
+	}
+	if len(content) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("# api2spec\n")
+	for _, entry := range added {
+		b.WriteString(entry + "\n")
+	}
+
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
 // projectInfo holds information detected from the project.
 type projectInfo struct {
 	Title       string
@@ -311,15 +432,63 @@ func interactiveInit(cfg *config.Config) (*config.Config, error) {
 	return cfg, nil
 }
 
-// buildConfigYAML builds a YAML config with helpful comments.
-func buildConfigYAML(cfg *config.Config) string {
+// buildConfigYAML builds a YAML config with helpful comments. Frameworks
+// detected besides cfg.Framework are listed in the header.
+func buildConfigYAML(cfg *config.Config, otherFrameworks ...string) string {
 	// First, marshal to get the base YAML
 	data, _ := yaml.Marshal(cfg)
 
 	// Add header comment
 	header := `# api2spec configuration file
 # https://github.com/api2spec/api2spec
+`
+	if len(otherFrameworks) > 0 {
+		header += fmt.Sprintf("#\n# Also detected: %s. Set framework to generate from one of these instead.\n", strings.Join(otherFrameworks, ", "))
+	}
+	return header + "\n" + string(data)
+}
 
+// buildGitHubWorkflow builds a GitHub Actions workflow that fails when the
+// committed spec no longer matches the code, and uploads a fresh spec for
+// review when it does.
+func buildGitHubWorkflow(cfg *config.Config) string {
+	generated := filepath.ToSlash(generatedSpecPath(cfg.Output))
+	return `# Checks that the committed OpenAPI spec matches the code.
+# Generated by api2spec init; run 'api2spec generate' to update the spec.
+
+name: API spec
+
+on:
+  push:
+    branches: [main]
+  pull_request:
+
+jobs:
+  spec:
+    name: Check OpenAPI spec is up to date
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+
+      - uses: actions/setup-go@v5
+        with:
+          go-version: stable
+
+      - name: Install api2spec
+        run: go install github.com/api2spec/api2spec@latest
+
+      - name: Check spec
+        run: api2spec check --ci
+
+      - name: Generate fresh spec
+        if: failure()
+        run: api2spec generate --output ` + generated + `
+
+      - name: Upload fresh spec
+        if: failure()
+        uses: actions/upload-artifact@v4
+        with:
+          name: openapi-spec
+          path: ` + generated + `
 `
-	return header + string(data)
 }
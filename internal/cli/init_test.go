@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"github.com/api2spec/api2spec/internal/config"
 )
@@ -147,4 +148,79 @@ func TestBuildConfigYAML(t *testing.T) {
 	assert.Contains(t, yaml, "# api2spec configuration file")
 	assert.Contains(t, yaml, "framework: chi")
 	assert.Contains(t, yaml, "output: openapi.yaml")
+	assert.NotContains(t, yaml, "Also detected")
+
+	yaml = buildConfigYAML(cfg, "gin", "echo")
+	assert.Contains(t, yaml, "# Also detected: gin, echo.")
+	assert.Contains(t, yaml, "framework: chi")
+}
+
+func TestInitConfigFile(t *testing.T) {
+	assert.Equal(t, ".api2spec.yaml", initConfigFile(""))
+	assert.Equal(t, "api2spec.yaml", initConfigFile("api2spec.yaml"))
+	assert.Equal(t, ".api2spec.yaml", initConfigFile(".api2spec.json"))
+}
+
+func TestGitignoreEntries(t *testing.T) {
+	cfg := config.Default()
+	cfg.Output = "docs/openapi.json"
+
+	assert.Equal(t, []string{"/docs/openapi.generated.json"}, gitignoreEntries(cfg))
+}
+
+func TestUpdateGitignore(t *testing.T) {
+	t.Run("appends missing entries", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, ".gitignore")
+		require.NoError(t, os.WriteFile(path, []byte("node_modules/\nopenapi.generated.yaml"), 0644))
+
+		added, err := updateGitignore(dir, []string{"/openapi.generated.yaml", "/scratch.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/scratch.yaml"}, added)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "node_modules/\nopenapi.generated.yaml\n\n# api2spec\n/scratch.yaml\n", string(content))
+
+		added, err = updateGitignore(dir, []string{"/scratch.yaml"})
+		require.NoError(t, err)
+		assert.Empty(t, added)
+	})
+
+	t.Run("creates gitignore in git repository", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0755))
+
+		added, err := updateGitignore(dir, []string{"/openapi.generated.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/openapi.generated.yaml"}, added)
+
+		content, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+		require.NoError(t, err)
+		assert.Equal(t, "# api2spec\n/openapi.generated.yaml\n", string(content))
+	})
+
+	t.Run("skips directories outside git", func(t *testing.T) {
+		dir := t.TempDir()
+
+		added, err := updateGitignore(dir, []string{"/openapi.generated.yaml"})
+		require.NoError(t, err)
+		assert.Empty(t, added)
+		assert.NoFileExists(t, filepath.Join(dir, ".gitignore"))
+	})
+}
+
+func TestBuildGitHubWorkflow(t *testing.T) {
+	cfg := config.Default()
+	cfg.Output = "api/openapi.yaml"
+
+	workflow := buildGitHubWorkflow(cfg)
+
+	assert.Contains(t, workflow, "run: api2spec check --ci")
+	assert.Contains(t, workflow, "run: api2spec generate --output api/openapi.generated.yaml")
+	assert.Contains(t, workflow, "path: api/openapi.generated.yaml")
+
+	var parsed map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(workflow), &parsed))
+	assert.Contains(t, parsed, "jobs")
 }
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// maxLossyWarnings bounds the lossy conversions listed in the summary;
+// --lossy-report has them all.
+const maxLossyWarnings = 10
+
+// lossyReport is the machine-readable list of lossy type conversions,
+// written by --lossy-report.
+type lossyReport struct {
+	// Conversions lists the conversions sorted by file and line
+	Conversions []openapi.LossyConversion `json:"conversions"`
+}
+
+// reportLossyConversions summarizes the types documented with generic
+// schemas because they could not be resolved, and writes them all as JSON
+// to path, or to stdout when path is "-", when path is set. File paths are
+// made relative to root.
+func reportLossyConversions(path, root string, doc *types.OpenAPI, routes []types.Route) error {
+	conversions := openapi.LossyConversions(doc, routes)
+	for i := range conversions {
+		if rel, err := filepath.Rel(root, conversions[i].File); err == nil && !strings.HasPrefix(rel, "..") {
+			conversions[i].File = filepath.ToSlash(rel)
+		}
+	}
+
+	if len(conversions) > 0 {
+		printWarning("%d types could not be resolved and were documented with generic schemas:", len(conversions))
+		for i, c := range conversions {
+			if i == maxLossyWarnings {
+				printWarning("  ... and %d more (--lossy-report lists them all)", len(conversions)-i)
+				break
+			}
+			printWarning("  %s", c)
+		}
+	}
+
+	if path == "" {
+		return nil
+	}
+	if conversions == nil {
+		conversions = []openapi.LossyConversion{}
+	}
+	data, err := json.MarshalIndent(lossyReport{Conversions: conversions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode lossy conversion report: %w", err)
+	}
+	data = append(data, '\n')
+
+	if path == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write lossy conversion report: %w", err)
+	}
+	printInfo("Lossy conversion report written to: %s", path)
+	return nil
+}
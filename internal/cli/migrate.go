@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/migrate"
+)
+
+var migrateConfigOut string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <spec>",
+	Short: "Report what adopting api2spec would lose from a swaggo or NestJS spec",
+	Long: `Migrate compares a spec generated from annotations by swaggo or
+@nestjs/swagger with the spec api2spec extracts from the current source
+code, and reports the summaries, descriptions, operationIds, tags,
+responses, parameters and schema documentation the extraction lacks.
+
+Each finding says how it can be kept:
+  config  the suggested config preserves it (info, servers, security
+          schemes, tag descriptions, operationId and tag overrides)
+  merge   merging generation into the annotated spec preserves it
+  lost    api2spec would drop it
+
+The suggested config is printed, or written with --config-out for review
+before copying it into .api2spec.yaml.
+
+Example:
+  api2spec migrate docs/swagger.yaml              # Report against a swaggo spec
+  api2spec migrate swagger.json --config-out suggested.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMigrate,
+}
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateConfigOut, "config-out", "", "write the suggested config to this file instead of printing it")
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	spec, err := migrate.ReadSpec(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read spec file: %w", err)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if framework != "" {
+		cfg.Framework = framework
+	}
+	generated, err := generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
+	if err != nil {
+		return fmt.Errorf("failed to generate spec: %w", err)
+	}
+
+	report := migrate.Compare(spec, generated)
+	printInfo("Comparing %s spec %s with the extracted spec", report.Source, args[0])
+	printInfo("%d operations matched, %d not extracted from code, %d only extracted", report.Matched, report.Unmatched, report.Extracted)
+
+	for _, preserve := range []migrate.Preservation{migrate.PreserveNone, migrate.PreserveMerge, migrate.PreserveConfig} {
+		if report.Count(preserve) == 0 {
+			continue
+		}
+		printInfo("")
+		printInfo("%s:", migrateHeading(preserve))
+		for _, f := range report.Findings {
+			if f.Preserve == preserve {
+				printInfo("  %s", f)
+			}
+		}
+	}
+	if spec.Swagger2 && report.Count(migrate.PreserveNone) > 0 {
+		printInfo("")
+		printInfo("Swagger 2.0 specs cannot be merged; generating OpenAPI 3 with swag v2 (--v3.1) lets merging keep more")
+	}
+
+	suggested, err := report.SuggestedConfig()
+	if err != nil {
+		return err
+	}
+	if suggested == "" {
+		printInfo("")
+		printInfo("No config needed: nothing can be preserved that generation does not already produce")
+		return nil
+	}
+	if migrateConfigOut != "" {
+		if err := os.WriteFile(migrateConfigOut, []byte(suggested), 0o644); err != nil {
+			return fmt.Errorf("failed to write suggested config: %w", err)
+		}
+		printInfo("")
+		printInfo("Suggested config written to: %s", migrateConfigOut)
+		return nil
+	}
+	printInfo("")
+	printInfo("Suggested config:")
+	fmt.Print(suggested)
+	return nil
+}
+
+// migrateHeading titles the findings preserved in the given way.
+func migrateHeading(preserve migrate.Preservation) string {
+	switch preserve {
+	case migrate.PreserveConfig:
+		return "Preserved by the suggested config"
+	case migrate.PreserveMerge:
+		return "Preserved by merging into the annotated spec"
+	default:
+		return "Lost"
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/pact"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var pactSpec string
+
+var pactCmd = &cobra.Command{
+	Use:   "pact <pact files or directories...>",
+	Short: "Verify Pact consumer contracts against the specification",
+	Long: `Pact verifies that every interaction in Pact consumer contracts can be
+satisfied by the specification: the path and method exist, the response
+status is documented, required parameters are sent, and request and
+response bodies are compatible with the declared schemas.
+
+By default the specification is generated from the current source code;
+use --spec to verify against an existing file instead. The command fails
+and lists the consumers that would break when any interaction is not
+satisfiable.
+
+Example:
+  api2spec pact ./pacts                        # Verify all contracts in a directory
+  api2spec pact web-users.json --spec api.yaml # Verify against an existing spec`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runPact,
+}
+
+func init() {
+	pactCmd.Flags().StringVar(&pactSpec, "spec", "", "verify against this spec file instead of generating one")
+}
+
+func runPact(cmd *cobra.Command, args []string) error {
+	contracts, err := pact.Load(args)
+	if err != nil {
+		return err
+	}
+	if len(contracts) == 0 {
+		return fmt.Errorf("no pact contracts found in %s", strings.Join(args, ", "))
+	}
+
+	var doc *types.OpenAPI
+	if pactSpec != "" {
+		doc, err = openapi.ReadFile(pactSpec)
+		if err != nil {
+			return fmt.Errorf("failed to read spec file: %w", err)
+		}
+	} else {
+		cfg, err := config.Load(cfgFile)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		if framework != "" {
+			cfg.Framework = framework
+		}
+		doc, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
+		if err != nil {
+			return fmt.Errorf("failed to generate spec: %w", err)
+		}
+	}
+
+	var interactions int
+	for _, c := range contracts {
+		interactions += len(c.Interactions)
+	}
+	printVerbose("Verifying %d interactions from %d contracts", interactions, len(contracts))
+
+	failures := pact.Verify(doc, contracts)
+	if len(failures) == 0 {
+		printInfo("All %d interactions from %d contracts are satisfied by the spec", interactions, len(contracts))
+		return nil
+	}
+
+	for _, consumer := range pact.BrokenConsumers(failures) {
+		printInfo("Consumer %s:", consumer)
+		for _, f := range failures {
+			if f.Consumer == consumer {
+				printInfo("  ✗ %s (%s): %s", f.Interaction, f.Request, f.Reason)
+			}
+		}
+		printInfo("")
+	}
+
+	broken := pact.BrokenConsumers(failures)
+	printError("%d consumers would break: %s", len(broken), strings.Join(broken, ", "))
+	return fmt.Errorf("spec does not satisfy %d consumer interactions", len(failures))
+}
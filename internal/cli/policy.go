@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/policy"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var policyFailOn string
+
+var policyCmd = &cobra.Command{
+	Use:   "policy [spec]",
+	Short: "Check the API against the governance rules in the config",
+	Long: `Policy evaluates the rules under policy.rules in the config against the
+spec extracted from the source code, or against the given spec file.
+
+Each rule applies to operations, parameters or schemas. Its assert
+expression must hold for every subject its optional when expression
+selects. Expressions are a small subset of CEL:
+
+  method == "POST"                    comparisons: == != < <= > >=
+  "422" in responses                  membership in a list
+  path.startsWith("/api")             startsWith, endsWith, contains, matches
+  size(description) > 0 && !deprecated
+
+Suppressions in the config, or an x-policy-ignore extension on an
+operation or schema, exempt it from rules.
+
+The command fails when a violation is at least as severe as policy.failOn
+(default error). Generate checks the same rules before writing the spec.
+
+Example:
+  api2spec policy                     # Check the extracted spec
+  api2spec policy openapi.yaml        # Check an existing spec file
+  api2spec policy --fail-on warning   # Fail on warnings too`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPolicy,
+}
+
+func init() {
+	policyCmd.Flags().StringVar(&policyFailOn, "fail-on", "", "least severe violation that fails the check: error, warning, info or never (default: policy.failOn)")
+}
+
+func runPolicy(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if framework != "" {
+		cfg.Framework = framework
+	}
+	if policyFailOn != "" {
+		cfg.Policy.FailOn = policyFailOn
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	if len(cfg.Policy.Rules) == 0 {
+		printInfo("No policy rules configured; add them under policy.rules")
+		return nil
+	}
+
+	var doc *types.OpenAPI
+	if len(args) > 0 {
+		if doc, err = openapi.ReadFile(args[0]); err != nil {
+			return fmt.Errorf("failed to read spec file: %w", err)
+		}
+	} else if doc, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths); err != nil {
+		return fmt.Errorf("failed to generate spec: %w", err)
+	}
+
+	return checkPolicy(cfg, doc)
+}
+
+// checkPolicy evaluates the policy rules against doc, prints the violations
+// and fails if one is at least as severe as policy.failOn.
+func checkPolicy(cfg *config.Config, doc *types.OpenAPI) error {
+	result, err := policy.Evaluate(doc, cfg.Policy.Rules, cfg.Policy.Suppressions)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range result.Violations {
+		switch v.Severity {
+		case policy.SeverityError:
+			printError("%s", v)
+		case policy.SeverityWarning:
+			printWarning("%s", v)
+		default:
+			printInfo("%s", v)
+		}
+	}
+	errors, warnings := result.Count(policy.SeverityError), result.Count(policy.SeverityWarning)
+	infos := len(result.Violations) - errors - warnings
+	if len(result.Violations) > 0 || result.Suppressed > 0 {
+		printInfo("Policy: %d errors, %d warnings, %d info (%d suppressed)", errors, warnings, infos, result.Suppressed)
+	} else {
+		printVerbose("Policy: %d rules passed", len(cfg.Policy.Rules))
+	}
+
+	if n := result.Failures(cfg.Policy.FailOn); n > 0 {
+		return fmt.Errorf("policy check failed: %d violations at or above %s", n, cfg.Policy.FailOn)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ANSI colors for unified diff lines
+const (
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[31m"
+	ansiGreen = "\033[32m"
+	ansiCyan  = "\033[36m"
+	ansiReset = "\033[0m"
+)
+
+// previewSpec prints the unified diff between the spec file at path and the
+// content generate would write to it, writing nothing. A missing file is
+// diffed as empty.
+func previewSpec(path, content string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read existing spec: %w", err)
+	}
+	if string(existing) == content {
+		printInfo("No changes to %s", path)
+		return nil
+	}
+
+	diff, added, removed := specDiff(path, string(existing), content, err == nil, stdoutIsTerminal())
+	fmt.Print(diff)
+	printInfo("%s: %d lines added, %d removed (not written)", path, added, removed)
+	return nil
+}
+
+// specDiff returns the unified diff from before to after with 3 lines of
+// context, and the number of lines it adds and removes.
+func specDiff(path, before, after string, exists, color bool) (string, int, int) {
+	from := "a/" + path
+	if !exists {
+		from = "/dev/null"
+	}
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: from,
+		ToFile:   "b/" + path,
+		Context:  3,
+	})
+
+	var b strings.Builder
+	added, removed := 0, 0
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		code := ""
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			code = ansiBold
+		case strings.HasPrefix(line, "@@"):
+			code = ansiCyan
+		case strings.HasPrefix(line, "+"):
+			code = ansiGreen
+			added++
+		case strings.HasPrefix(line, "-"):
+			code = ansiRed
+			removed++
+		}
+		if color && code != "" {
+			line = code + strings.TrimSuffix(line, "\n") + ansiReset + "\n"
+		}
+		b.WriteString(line)
+	}
+	return b.String(), added, removed
+}
+
+// splitLines splits content into lines that keep their newline.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// stdoutIsTerminal reports whether stdout is a terminal that accepts color.
+func stdoutIsTerminal() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	fileInfo, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return (fileInfo.Mode() & os.ModeCharDevice) != 0
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecDiff(t *testing.T) {
+	before := "openapi: 3.0.3\npaths:\n  /users:\n    get: {}\n"
+	after := "openapi: 3.0.3\npaths:\n  /users:\n    get: {}\n    post: {}\n  /orders:\n    get: {}\n"
+
+	diff, added, removed := specDiff("openapi.yaml", before, after, true, false)
+	assert.Equal(t, `--- a/openapi.yaml
++++ b/openapi.yaml
+@@ -2,3 +2,6 @@
+ paths:
+   /users:
+     get: {}
++    post: {}
++  /orders:
++    get: {}
+`, diff)
+	assert.Equal(t, 3, added)
+	assert.Equal(t, 0, removed)
+
+	colored, _, _ := specDiff("openapi.yaml", before, after, true, true)
+	assert.Contains(t, colored, "\033[32m+    post: {}\033[0m\n")
+	assert.Contains(t, colored, "\033[36m@@ -2,3 +2,6 @@\033[0m\n")
+}
+
+func TestSpecDiff_NewFile(t *testing.T) {
+	diff, added, removed := specDiff("openapi.yaml", "", "openapi: 3.0.3\n", false, false)
+	assert.Equal(t, "--- /dev/null\n+++ b/openapi.yaml\n@@ -0,0 +1 @@\n+openapi: 3.0.3\n", diff)
+	assert.Equal(t, 1, added)
+	assert.Equal(t, 0, removed)
+}
@@ -75,7 +75,7 @@ func runPrint(cmd *cobra.Command, args []string) error {
 		// Generate spec from code
 		printVerbose("Generating spec from source code...")
 
-		spec, err = generateSpecFromCode(cfg, cfg.Source.Paths)
+		spec, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
 		if err != nil {
 			return fmt.Errorf("failed to generate spec: %w", err)
 		}
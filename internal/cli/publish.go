@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/publish"
+)
+
+var (
+	publishTargets []string
+	publishURL     string
+	publishHeaders []string
+	publishDryRun  bool
+)
+
+var publishCmd = &cobra.Command{
+	Use:   "publish [file]",
+	Short: "Publish the OpenAPI specification to API registries",
+	Long: `Publish uploads the generated specification to the registries configured
+under publish.targets, or to an ad-hoc HTTP endpoint given with --url.
+
+Target types:
+  swaggerhub   SwaggerHub (owner, api, version, token)
+  stoplight    Stoplight (id, token)
+  readme       ReadMe (id, version, token)
+  apigee       Apigee Registry (owner, api, version, id, token)
+  http         Generic HTTP upload (url, method, headers, token)
+
+Token, URL, and header values may reference environment variables as ${VAR}.
+
+Example:
+  api2spec publish                                  # Publish to all configured targets
+  api2spec publish --target swaggerhub              # Publish to one target by name or type
+  api2spec publish openapi.json --dry-run           # Show requests without sending
+  api2spec publish --url https://specs.example.com/api.yaml \
+    --header 'Authorization: Bearer ${SPEC_TOKEN}'  # Ad-hoc HTTP PUT`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPublish,
+}
+
+func init() {
+	publishCmd.Flags().StringSliceVarP(&publishTargets, "target", "t", nil, "publish only to targets with these names or types")
+	publishCmd.Flags().StringVar(&publishURL, "url", "", "publish with an HTTP PUT to this URL instead of configured targets")
+	publishCmd.Flags().StringArrayVarP(&publishHeaders, "header", "H", nil, "header for --url as 'Name: value' (repeatable)")
+	publishCmd.Flags().BoolVar(&publishDryRun, "dry-run", false, "print the requests without sending them")
+}
+
+func runPublish(cmd *cobra.Command, args []string) error {
+	// Load config
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if output != "" {
+		cfg.Output = output
+	}
+
+	specPath := cfg.Output
+	if len(args) > 0 {
+		specPath = args[0]
+	}
+
+	targets, err := selectPublishTargets(cfg)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to read spec: %w", err)
+	}
+	spec := publish.Spec{Path: specPath, Content: content}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	client := &http.Client{Timeout: 60 * time.Second}
+
+	var failed int
+	for _, target := range targets {
+		if publishDryRun {
+			req, err := publish.NewRequest(ctx, target, spec)
+			if err != nil {
+				return fmt.Errorf("%s: %w", target.Label(), err)
+			}
+			printInfo("%s: %s %s (%d bytes)", target.Label(), req.Method, req.URL.Redacted(), len(content))
+			continue
+		}
+
+		printVerbose("Publishing %s to %s...", specPath, target.Label())
+		if err := publish.Publish(ctx, client, target, spec); err != nil {
+			printError("%v", err)
+			failed++
+			continue
+		}
+		printInfo("Published %s to %s", specPath, target.Label())
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to publish to %d of %d targets", failed, len(targets))
+	}
+	return nil
+}
+
+// selectPublishTargets returns the ad-hoc --url target or the configured
+// targets filtered by --target.
+func selectPublishTargets(cfg *config.Config) ([]publish.Target, error) {
+	if publishURL != "" {
+		target := publish.Target{
+			Name:    "url",
+			Type:    publish.TypeHTTP,
+			URL:     publishURL,
+			Headers: make(map[string]string),
+		}
+		for _, header := range publishHeaders {
+			name, value, ok := strings.Cut(header, ":")
+			if !ok {
+				return nil, fmt.Errorf("invalid header %q, expected 'Name: value'", header)
+			}
+			target.Headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+		return []publish.Target{target}, nil
+	}
+
+	if len(cfg.Publish.Targets) == 0 {
+		return nil, fmt.Errorf("no publish targets configured; add publish.targets to the config or use --url")
+	}
+
+	var targets []publish.Target
+	for _, target := range cfg.Publish.Targets {
+		if len(publishTargets) == 0 || slices.Contains(publishTargets, target.Name) || slices.Contains(publishTargets, target.Type) {
+			targets = append(targets, target)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no publish targets match %s", strings.Join(publishTargets, ", "))
+	}
+
+	for _, target := range targets {
+		if err := target.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", target.Label(), err)
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// extractionQuality counts the gaps that make a spec thinner than the code
+// it was extracted from.
+type extractionQuality struct {
+	files         int
+	unparsedFiles int
+
+	schemas        int
+	unresolvedRefs int
+
+	routes                 int
+	routesWithoutResponses int
+}
+
+// unparsedFiles returns the files the parsers could not handle: those with
+// syntax errors and those whose traversal budget ran out.
+func unparsedFiles(diagnostics []parser.FileDiagnostic) []string {
+	files := make(map[string]bool)
+	for _, file := range parser.UnparsedFiles() {
+		files[file] = true
+	}
+	for _, d := range diagnostics {
+		files[d.File] = true
+	}
+	return slices.Sorted(maps.Keys(files))
+}
+
+// measureExtraction counts the unparsed files, the references to schemas
+// that were not extracted, whether stubbed or left dangling, and the routes
+// without an extracted response.
+func measureExtraction(files []scanner.SourceFile, unparsed []string, routes []types.Route, doc *types.OpenAPI) extractionQuality {
+	q := extractionQuality{
+		files:          len(files),
+		unparsedFiles:  len(unparsed),
+		unresolvedRefs: len(openapi.DanglingRefs(doc)),
+		routes:         len(routes),
+	}
+	if doc.Components != nil {
+		q.schemas = len(doc.Components.Schemas)
+		for _, schema := range doc.Components.Schemas {
+			if unresolved, _ := schema.Extensions[openapi.ExtUnresolved].(bool); unresolved {
+				q.unresolvedRefs++
+			}
+		}
+	}
+	for _, route := range routes {
+		if len(route.Responses) == 0 {
+			q.routesWithoutResponses++
+		}
+	}
+	return q
+}
+
+// checkExtractionQuality fails when the extraction passes any of the
+// generation.failOn thresholds, listing each one passed.
+func checkExtractionQuality(failOn config.FailOnConfig, q extractionQuality) error {
+	checks := []struct {
+		field     string
+		value     string
+		count     int
+		total     int
+		described string
+	}{
+		{"unparsedFiles", failOn.UnparsedFiles, q.unparsedFiles, q.files, "source files could not be parsed"},
+		{"unresolvedRefs", failOn.UnresolvedRefs, q.unresolvedRefs, q.schemas, "schema references point at schemas that were not extracted"},
+		{"routesWithoutResponses", failOn.RoutesWithoutResponses, q.routesWithoutResponses, q.routes, "routes have no extracted response"},
+	}
+
+	var failures []string
+	for _, check := range checks {
+		threshold, err := config.ParseThreshold(check.value)
+		if err != nil {
+			return err
+		}
+		if threshold == nil {
+			continue
+		}
+		printVerbose("Extraction quality: %d of %d %s (generation.failOn.%s: %s)", check.count, check.total, check.described, check.field, threshold)
+		if threshold.Exceeded(check.count, check.total) {
+			failures = append(failures, fmt.Sprintf("%d of %d %s (generation.failOn.%s: %s)", check.count, check.total, check.described, check.field, threshold))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("extraction quality below thresholds: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMeasureExtraction(t *testing.T) {
+	parser.UnparsedFiles()
+	files := make([]scanner.SourceFile, 20)
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", Responses: map[string]types.Response{"200": {Description: "OK"}}},
+		{Method: "POST", Path: "/users"},
+	}
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/orders": {Get: &types.Operation{Responses: map[string]types.Response{
+				"200": {Content: map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/Order"}}}},
+			}}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":    {Type: "object"},
+			"Account": {Extensions: types.Extensions{openapi.ExtUnresolved: true}},
+		}},
+	}
+	unparsed := unparsedFiles([]parser.FileDiagnostic{{File: "deep.ts", Message: "traversal budget exhausted"}})
+
+	q := measureExtraction(files, unparsed, routes, doc)
+
+	assert.Equal(t, extractionQuality{
+		files:                  20,
+		unparsedFiles:          1,
+		schemas:                2,
+		unresolvedRefs:         2,
+		routes:                 2,
+		routesWithoutResponses: 1,
+	}, q)
+}
+
+func TestCheckExtractionQuality(t *testing.T) {
+	q := extractionQuality{files: 100, unparsedFiles: 6, schemas: 10, unresolvedRefs: 0, routes: 40, routesWithoutResponses: 10}
+
+	assert.NoError(t, checkExtractionQuality(config.FailOnConfig{}, q))
+	assert.NoError(t, checkExtractionQuality(config.FailOnConfig{
+		UnparsedFiles:          "10%",
+		UnresolvedRefs:         "0",
+		RoutesWithoutResponses: "10",
+	}, q))
+
+	err := checkExtractionQuality(config.FailOnConfig{
+		UnparsedFiles:          "5%",
+		UnresolvedRefs:         "0",
+		RoutesWithoutResponses: "20%",
+	}, q)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "6 of 100 source files could not be parsed (generation.failOn.unparsedFiles: 5%)")
+	assert.Contains(t, err.Error(), "10 of 40 routes have no extracted response (generation.failOn.routesWithoutResponses: 20%)")
+	assert.NotContains(t, err.Error(), "unresolvedRefs")
+}
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// errReviewAborted is returned when the user quits a review without saving.
+var errReviewAborted = errors.New("review aborted, nothing written")
+
+const untaggedGroup = "(untagged)"
+
+const reviewHelp = `Commands:
+  x <n...>              toggle exclude (numbers or ranges, e.g. x 2 4-6)
+  r <n> <operationId>   rename an operation (- restores the extracted id)
+  t <n...> <tag,...>    set tags (- restores the extracted tags)
+  l                     list operations
+  w                     save decisions and write the spec
+  q                     quit without writing
+`
+
+// reviewOperations reviews the extracted routes interactively and saves the
+// resulting operation overrides to the config file, unless this is a dry run.
+func reviewOperations(cfg *config.Config, projectRoot string, routes []types.Route) error {
+	if !isTerminal() {
+		return fmt.Errorf("--review requires an interactive terminal")
+	}
+
+	ops, err := reviewRoutes(os.Stdin, os.Stdout, projectRoot, routes, cfg.Generation.Operations)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(ops, cfg.Generation.Operations) {
+		return nil
+	}
+	cfg.Generation.Operations = ops
+
+	if generateDryRun {
+		printInfo("Dry run - review decisions not saved")
+		return nil
+	}
+
+	path := cfgFile
+	if path == "" {
+		path = initConfigFile(config.FindFile())
+	}
+	if err := config.SaveOperations(path, ops); err != nil {
+		return err
+	}
+	printInfo("Saved %d operation overrides to %s", len(ops), path)
+	return nil
+}
+
+// reviewSession lets the user exclude, rename and retag extracted
+// operations. Decisions are kept as operation overrides keyed by method and
+// path, so they apply to later runs as well.
+type reviewSession struct {
+	root   string
+	routes []types.Route
+	order  []string
+	ops    map[string]config.OperationConfig
+	out    io.Writer
+}
+
+// reviewRoutes runs an interactive review of routes and returns the updated
+// operation overrides. Overrides for operations not among routes are kept.
+func reviewRoutes(in io.Reader, out io.Writer, root string, routes []types.Route, existing []config.OperationConfig) ([]config.OperationConfig, error) {
+	s := &reviewSession{
+		root: root,
+		ops:  make(map[string]config.OperationConfig),
+		out:  out,
+	}
+	for _, op := range existing {
+		s.order = append(s.order, op.Operation)
+		s.ops[op.Operation] = op
+	}
+
+	// Number routes once, in their initial grouping, so numbers stay stable
+	// while the user retags them
+	s.routes = append(s.routes, routes...)
+	sort.SliceStable(s.routes, func(i, j int) bool {
+		gi, gj := s.group(s.routes[i]), s.group(s.routes[j])
+		if gi != gj {
+			return groupLess(gi, gj)
+		}
+		if s.routes[i].Path != s.routes[j].Path {
+			return s.routes[i].Path < s.routes[j].Path
+		}
+		return s.routes[i].Method < s.routes[j].Method
+	})
+
+	fmt.Fprintf(out, "Review %d operations before writing the spec.\n\n", len(s.routes))
+	s.list()
+	fmt.Fprint(out, "\n"+reviewHelp)
+
+	reader := bufio.NewReader(in)
+	for {
+		fmt.Fprint(out, "> ")
+		line, err := reader.ReadString('\n')
+		if err != nil && line == "" {
+			if err == io.EOF {
+				fmt.Fprintln(out)
+				return nil, errReviewAborted
+			}
+			return nil, err
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "w":
+			return s.result(), nil
+		case "q":
+			return nil, errReviewAborted
+		case "l":
+			s.list()
+		case "h", "?":
+			fmt.Fprint(out, reviewHelp)
+		case "x":
+			err = s.exclude(fields[1:])
+		case "r":
+			err = s.rename(fields[1:])
+		case "t":
+			err = s.tag(fields[1:])
+		default:
+			err = fmt.Errorf("unknown command %q, h for help", fields[0])
+		}
+		if err != nil {
+			fmt.Fprintf(out, "%v\n", err)
+		}
+	}
+}
+
+// list prints the operations grouped by tag with their source locations.
+func (s *reviewSession) list() {
+	groups := make(map[string][]int)
+	var names []string
+	for i, route := range s.routes {
+		group := s.group(route)
+		if _, ok := groups[group]; !ok {
+			names = append(names, group)
+		}
+		groups[group] = append(groups[group], i)
+	}
+	sort.Slice(names, func(i, j int) bool { return groupLess(names[i], names[j]) })
+
+	for _, name := range names {
+		fmt.Fprintln(s.out, name)
+		for _, i := range groups[name] {
+			route := s.routes[i]
+			op := s.ops[config.OperationKey(route.Method, route.Path)]
+
+			line := fmt.Sprintf("  %3d  %-7s %s", i+1, strings.ToUpper(route.Method), route.Path)
+			if op.OperationID != "" {
+				line += "  -> " + op.OperationID
+			} else if route.OperationID != "" {
+				line += "  " + route.OperationID
+			}
+			if op.Exclude {
+				line += "  [excluded]"
+			}
+			if loc := s.location(route); loc != "" {
+				line += "  " + loc
+			}
+			fmt.Fprintln(s.out, line)
+		}
+	}
+}
+
+func (s *reviewSession) exclude(args []string) error {
+	indexes, err := s.indexes(args)
+	if err != nil {
+		return err
+	}
+	if len(indexes) == 0 {
+		return fmt.Errorf("usage: x <n...>")
+	}
+	for _, i := range indexes {
+		s.update(s.routes[i], func(op *config.OperationConfig) { op.Exclude = !op.Exclude })
+	}
+	return nil
+}
+
+func (s *reviewSession) rename(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: r <n> <operationId>")
+	}
+	indexes, err := s.indexes(args[:1])
+	if err != nil {
+		return err
+	}
+	id := args[1]
+	if id == "-" {
+		id = ""
+	}
+	s.update(s.routes[indexes[0]], func(op *config.OperationConfig) { op.OperationID = id })
+	return nil
+}
+
+func (s *reviewSession) tag(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: t <n...> <tag,...>")
+	}
+	indexes, err := s.indexes(args[:len(args)-1])
+	if err != nil {
+		return err
+	}
+	var tags []string
+	if value := args[len(args)-1]; value != "-" {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	for _, i := range indexes {
+		s.update(s.routes[i], func(op *config.OperationConfig) { op.Tags = tags })
+	}
+	return nil
+}
+
+// update applies fn to the override of route, creating it if needed.
+func (s *reviewSession) update(route types.Route, fn func(op *config.OperationConfig)) {
+	key := config.OperationKey(route.Method, route.Path)
+	op, ok := s.ops[key]
+	if !ok {
+		op = config.OperationConfig{Operation: key}
+		s.order = append(s.order, key)
+	}
+	fn(&op)
+	s.ops[key] = op
+}
+
+// result returns the overrides in the order they were first recorded,
+// dropping those that no longer change anything.
+func (s *reviewSession) result() []config.OperationConfig {
+	var ops []config.OperationConfig
+	for _, key := range s.order {
+		op := s.ops[key]
+		if op.Exclude || op.OperationID != "" || len(op.Tags) > 0 {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// indexes parses operation numbers and ranges into route indexes.
+func (s *reviewSession) indexes(args []string) ([]int, error) {
+	var indexes []int
+	for _, arg := range args {
+		first, last, isRange := strings.Cut(arg, "-")
+		if !isRange {
+			last = first
+		}
+		from, err1 := strconv.Atoi(first)
+		to, err2 := strconv.Atoi(last)
+		if err1 != nil || err2 != nil || from < 1 || to > len(s.routes) || from > to {
+			return nil, fmt.Errorf("invalid operation number %q, expected 1-%d", arg, len(s.routes))
+		}
+		for n := from; n <= to; n++ {
+			indexes = append(indexes, n-1)
+		}
+	}
+	return indexes, nil
+}
+
+// group returns the tag an operation is listed under.
+func (s *reviewSession) group(route types.Route) string {
+	tags := route.Tags
+	if op, ok := s.ops[config.OperationKey(route.Method, route.Path)]; ok && len(op.Tags) > 0 {
+		tags = op.Tags
+	}
+	if len(tags) == 0 {
+		return untaggedGroup
+	}
+	return tags[0]
+}
+
+// location returns the route's source location relative to the project root.
+func (s *reviewSession) location(route types.Route) string {
+	if route.SourceFile == "" {
+		return ""
+	}
+	file := route.SourceFile
+	if rel, err := filepath.Rel(s.root, file); err == nil && !strings.HasPrefix(rel, "..") {
+		file = rel
+	}
+	if route.SourceLine > 0 {
+		return fmt.Sprintf("%s:%d", file, route.SourceLine)
+	}
+	return file
+}
+
+// groupLess orders tag groups by name with untagged operations last.
+func groupLess(a, b string) bool {
+	if a == untaggedGroup || b == untaggedGroup {
+		return b == untaggedGroup && a != untaggedGroup
+	}
+	return a < b
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func reviewTestRoutes(root string) []types.Route {
+	return []types.Route{
+		{Method: "GET", Path: "/users", OperationID: "listUsers", Tags: []string{"users"}, SourceFile: filepath.Join(root, "routes", "users.ts"), SourceLine: 4},
+		{Method: "GET", Path: "/health", SourceFile: filepath.Join(root, "app.ts"), SourceLine: 10},
+		{Method: "POST", Path: "/users", Tags: []string{"users"}, SourceFile: filepath.Join(root, "routes", "users.ts"), SourceLine: 9},
+		{Method: "GET", Path: "/admin/stats", Tags: []string{"admin"}},
+	}
+}
+
+func TestReviewRoutes_ListsGroupedByTag(t *testing.T) {
+	root := t.TempDir()
+	var out bytes.Buffer
+
+	_, err := reviewRoutes(strings.NewReader("w\n"), &out, root, reviewTestRoutes(root), nil)
+	require.NoError(t, err)
+
+	listing := out.String()
+	assert.Contains(t, listing, "Review 4 operations")
+	assert.Contains(t, listing, "admin\n    1  GET     /admin/stats\n")
+	assert.Contains(t, listing, "users\n    2  GET     /users  listUsers  routes/users.ts:4\n    3  POST    /users  routes/users.ts:9\n")
+	assert.Contains(t, listing, "(untagged)\n    4  GET     /health  app.ts:10\n")
+}
+
+func TestReviewRoutes_RecordsDecisions(t *testing.T) {
+	root := t.TempDir()
+	existing := []config.OperationConfig{
+		{Operation: "DELETE /legacy", Exclude: true},
+		{Operation: "GET /admin/stats", OperationID: "stats"},
+	}
+	input := strings.Join([]string{
+		"x 1 4",          // exclude admin stats and health
+		"x 4",            // include health again
+		"r 2 getUsers",   // rename
+		"t 2-3 accounts", // retag both user operations
+		"r 1 -",          // drop the existing rename
+		"x 9",
+		"bogus",
+		"l",
+		"w",
+	}, "\n") + "\n"
+	var out bytes.Buffer
+
+	ops, err := reviewRoutes(strings.NewReader(input), &out, root, reviewTestRoutes(root), existing)
+	require.NoError(t, err)
+
+	assert.Equal(t, []config.OperationConfig{
+		{Operation: "DELETE /legacy", Exclude: true},
+		{Operation: "GET /admin/stats", Exclude: true},
+		{Operation: "GET /users", OperationID: "getUsers", Tags: []string{"accounts"}},
+		{Operation: "POST /users", Tags: []string{"accounts"}},
+	}, ops)
+	assert.Contains(t, out.String(), `invalid operation number "9", expected 1-4`)
+	assert.Contains(t, out.String(), `unknown command "bogus"`)
+	assert.Contains(t, out.String(), "accounts\n    2  GET     /users  -> getUsers")
+	assert.Contains(t, out.String(), "/admin/stats  [excluded]")
+}
+
+func TestReviewRoutes_Quit(t *testing.T) {
+	root := t.TempDir()
+
+	for _, input := range []string{"x 1\nq\n", "x 1\n"} {
+		ops, err := reviewRoutes(strings.NewReader(input), &bytes.Buffer{}, root, reviewTestRoutes(root), nil)
+		assert.ErrorIs(t, err, errReviewAborted)
+		assert.Nil(t, ops)
+	}
+}
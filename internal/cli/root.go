@@ -5,8 +5,13 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -19,6 +24,10 @@ var (
 	framework string
 	verbose   bool
 	quiet     bool
+	timeout   time.Duration
+
+	showVersion bool
+	rootJSON    bool
 )
 
 // rootCmd represents the base command when called without any subcommands.
@@ -36,8 +45,17 @@ Example:
   api2spec init --framework chi        # Initialize a new config file
   api2spec check --strict              # Validate routes and spec
   api2spec watch                       # Watch for changes and regenerate`,
-	SilenceUsage:  true,
-	SilenceErrors: true,
+	Args:              cobra.NoArgs,
+	SilenceUsage:      true,
+	SilenceErrors:     true,
+	PersistentPreRun:  startVersionCheck,
+	PersistentPostRun: printVersionNotice,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if showVersion {
+			return printVersion(cmd, rootJSON)
+		}
+		return cmd.Help()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -54,6 +72,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&framework, "framework", "", "web framework: chi, gin, echo, fiber, gorilla, stdlib")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress non-error output")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "abort source analysis after this long, e.g. 2m (default: no limit)")
+
+	rootCmd.Flags().BoolVar(&showVersion, "version", false, "print the version information")
+	rootCmd.Flags().BoolVar(&rootJSON, "json", false, "with --version, print the build information as JSON")
 
 	// Add subcommands
 	rootCmd.AddCommand(versionCmd)
@@ -63,6 +85,15 @@ func init() {
 	rootCmd.AddCommand(diffCmd)
 	rootCmd.AddCommand(watchCmd)
 	rootCmd.AddCommand(printCmd)
+	rootCmd.AddCommand(publishCmd)
+	rootCmd.AddCommand(pactCmd)
+	rootCmd.AddCommand(typesCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(policyCmd)
+	rootCmd.AddCommand(schemaDiffCmd)
+	rootCmd.AddCommand(compatCmd)
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(upgradeCmd)
 }
 
 // GetConfigFile returns the config file path from the flag.
@@ -95,6 +126,38 @@ func IsQuiet() bool {
 	return quiet
 }
 
+// commandContext returns the context long-running work in cmd should use.
+// It is cancelled on Ctrl-C or SIGTERM and when --timeout elapses.
+func commandContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	if timeout <= 0 {
+		return ctx, stop
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// contextError replaces err with a readable message when it was caused by
+// ctx being cancelled or timing out.
+func contextError(ctx context.Context, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("timed out after %s", timeout)
+	case errors.Is(ctx.Err(), context.Canceled):
+		return fmt.Errorf("interrupted")
+	}
+	return err
+}
+
 // printInfo prints a message if not in quiet mode.
 func printInfo(format string, args ...interface{}) {
 	if !quiet {
@@ -109,6 +172,13 @@ func printVerbose(format string, args ...interface{}) {
 	}
 }
 
+// printWarning prints a warning message to stderr if not in quiet mode.
+func printWarning(format string, args ...interface{}) {
+	if !quiet {
+		fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+	}
+}
+
 // printError prints an error message.
 func printError(format string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	schemaDiffFrom  string
+	schemaDiffTo    string
+	schemaDiffColor bool
+)
+
+var schemaDiffCmd = &cobra.Command{
+	Use:   "schema-diff <schema>",
+	Short: "Show the field-level changes to one component schema",
+	Long: `Schema-diff compares one component schema between two specs and lists
+its added, removed and retyped properties and requiredness changes.
+Properties of allOf parts and nested inline objects are included.
+
+The old spec defaults to the output file and the new spec to the one
+generated from the current source code.
+
+Example:
+  api2spec schema-diff User                      # Output file vs generated
+  api2spec schema-diff User --from old.yaml      # old.yaml vs generated
+  api2spec schema-diff User --from v1.yaml --to v2.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSchemaDiff,
+}
+
+func init() {
+	schemaDiffCmd.Flags().StringVar(&schemaDiffFrom, "from", "", "old spec file (default: the output file)")
+	schemaDiffCmd.Flags().StringVar(&schemaDiffTo, "to", "", "new spec file (default: generated from the source code)")
+	schemaDiffCmd.Flags().BoolVar(&schemaDiffColor, "color", true, "enable colored output")
+}
+
+func runSchemaDiff(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if output != "" {
+		cfg.Output = output
+	}
+	if framework != "" {
+		cfg.Framework = framework
+	}
+
+	labelA := schemaDiffFrom
+	if labelA == "" {
+		labelA = cfg.Output
+	}
+	specA, err := openapi.ReadFile(labelA)
+	if err != nil {
+		return fmt.Errorf("failed to read spec file %s: %w", labelA, err)
+	}
+
+	var specB *types.OpenAPI
+	labelB := schemaDiffTo
+	if labelB != "" {
+		if specB, err = openapi.ReadFile(labelB); err != nil {
+			return fmt.Errorf("failed to read spec file %s: %w", labelB, err)
+		}
+	} else {
+		labelB = "<generated>"
+		if specB, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths); err != nil {
+			return fmt.Errorf("failed to generate spec from code: %w", err)
+		}
+	}
+
+	name := args[0]
+	changes, err := openapi.DiffSchema(name, specA, specB)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		printInfo("No changes to schema %s between %s and %s", name, labelA, labelB)
+		return nil
+	}
+
+	fmt.Printf("--- %s\n", labelA)
+	fmt.Printf("+++ %s\n", labelB)
+	fmt.Printf("schema %s: %d changes\n", name, len(changes))
+	for _, change := range changes {
+		printDiffLine(change.Type, change.Property+": "+change.Description, schemaDiffColor)
+	}
+	return nil
+}
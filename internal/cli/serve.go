@@ -0,0 +1,456 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	serveListen       string
+	serveAllowPaths   []string
+	serveMaxUpload    int64
+	serveMaxExtracted int64
+)
+
+// maxArchiveEntries bounds the entries extracted from an uploaded tarball.
+const maxArchiveEntries = 100_000
+
+// errArchiveTooLarge is returned when an extracted tarball exceeds its
+// limits.
+var errArchiveTooLarge = errors.New("archive too large")
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run api2spec as an HTTP service for CI bots and platform tooling",
+	Long: `Serve runs a long-lived HTTP service that extracts specs on request,
+so platform teams can host api2spec instead of invoking the CLI per repo.
+
+  POST /generate   Content-Type: application/x-tar or application/gzip
+                   A tarball of the repository (GitHub-style archives with a
+                   single top-level directory are unwrapped)
+  POST /generate   Content-Type: application/json
+                   {"path": "/srv/repos/billing"}: a repository on the
+                   server, which must be below an --allow-path root
+  GET  /healthz    Liveness check
+
+The repository's own api2spec config is used, confined to the repository:
+the configs it extends, its source paths and its framework definitions
+must lie inside it, and its external specs are not fetched. The framework
+query parameter (or "framework" JSON field) overrides its framework. The
+response is JSON:
+
+  {"spec": {...}, "framework": "chi", "routes": 12, "schemas": 8,
+   "diagnostics": [{"file": "api/users.go", "line": 42, "message": "..."}]}
+
+Errors are returned as {"error": "..."}. Requests are processed one at a
+time; --timeout limits each of them.
+
+Example:
+  api2spec serve --listen :8080
+  api2spec serve --allow-path /srv/repos
+  curl --data-binary @repo.tar.gz -H 'Content-Type: application/gzip' localhost:8080/generate`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveListen, "listen", ":8080", "address to listen on")
+	serveCmd.Flags().StringSliceVar(&serveAllowPaths, "allow-path", nil, "directories below which repository paths may be requested (default: path requests are rejected)")
+	serveCmd.Flags().Int64Var(&serveMaxUpload, "max-upload", 256<<20, "largest accepted tarball in bytes, before decompression")
+	serveCmd.Flags().Int64Var(&serveMaxExtracted, "max-extracted", 1<<30, "largest accepted repository in bytes, after decompression")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	srv := &http.Server{
+		Addr:              serveListen,
+		Handler:           newServeHandler(serveAllowPaths, serveMaxUpload, serveMaxExtracted),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdown, done := context.WithTimeout(context.Background(), 5*time.Second)
+		defer done()
+		_ = srv.Shutdown(shutdown)
+	}()
+
+	printInfo("Listening on %s", serveListen)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// serveResponse is the body of a successful /generate response.
+type serveResponse struct {
+	Spec        *types.OpenAPI    `json:"spec"`
+	Framework   string            `json:"framework,omitempty"`
+	Routes      int               `json:"routes"`
+	Schemas     int               `json:"schemas"`
+	Diagnostics []serveDiagnostic `json:"diagnostics"`
+}
+
+// serveDiagnostic is a problem found in the source, at a path relative to
+// the repository root.
+type serveDiagnostic struct {
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// serveError is an error with the HTTP status it is reported with.
+type serveError struct {
+	status int
+	err    error
+}
+
+func (e *serveError) Error() string { return e.err.Error() }
+
+func badRequest(format string, args ...any) error {
+	return &serveError{http.StatusBadRequest, fmt.Errorf(format, args...)}
+}
+
+// newServeHandler returns the handler of the serve command. Tarballs are
+// limited to maxUpload bytes and extract to at most maxExtracted.
+func newServeHandler(allowPaths []string, maxUpload, maxExtracted int64) http.Handler {
+	// Extraction uses process-wide state (type mappings, declarative
+	// plugins, parser diagnostics), so requests take turns
+	var mu sync.Mutex
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "ok\n")
+	})
+	mux.HandleFunc("/generate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeServeError(w, &serveError{http.StatusMethodNotAllowed, errors.New("use POST")})
+			return
+		}
+
+		start := time.Now()
+		root, frameworkName, cleanup, err := serveRepository(r, allowPaths, maxUpload, maxExtracted)
+		if err != nil {
+			writeServeError(w, err)
+			return
+		}
+		defer cleanup()
+
+		mu.Lock()
+		resp, err := serveGenerate(r.Context(), root, frameworkName)
+		mu.Unlock()
+		if err != nil {
+			writeServeError(w, err)
+			return
+		}
+		printVerbose("%s %s: %d routes in %s", r.Method, r.URL.Path, resp.Routes, time.Since(start).Round(time.Millisecond))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	return mux
+}
+
+// serveRepository returns the repository directory a /generate request
+// refers to, extracting an uploaded tarball into a temporary directory that
+// cleanup removes.
+func serveRepository(r *http.Request, allowPaths []string, maxUpload, maxExtracted int64) (root, frameworkName string, cleanup func(), err error) {
+	cleanup = func() {}
+	frameworkName = r.URL.Query().Get("framework")
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	switch mediaType {
+	case "application/json":
+		var req struct {
+			Path      string `json:"path"`
+			Framework string `json:"framework"`
+		}
+		if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&req); err != nil {
+			return "", "", cleanup, badRequest("invalid JSON body: %v", err)
+		}
+		if req.Framework != "" {
+			frameworkName = req.Framework
+		}
+		root, err = allowedPath(req.Path, allowPaths)
+		return root, frameworkName, cleanup, err
+
+	case "application/x-tar", "application/tar", "application/gzip", "application/x-gzip", "application/tar+gzip":
+		dir, err := os.MkdirTemp("", "api2spec-serve-")
+		if err != nil {
+			return "", "", cleanup, err
+		}
+		cleanup = func() { os.RemoveAll(dir) }
+
+		body := http.MaxBytesReader(nil, r.Body, maxUpload)
+		if err := extractTarball(body, dir, mediaType != "application/x-tar" && mediaType != "application/tar", maxExtracted); err != nil {
+			cleanup()
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return "", "", func() {}, &serveError{http.StatusRequestEntityTooLarge, fmt.Errorf("tarball exceeds %d bytes", maxUpload)}
+			}
+			if errors.Is(err, errArchiveTooLarge) {
+				return "", "", func() {}, &serveError{http.StatusRequestEntityTooLarge, err}
+			}
+			return "", "", func() {}, badRequest("invalid tarball: %v", err)
+		}
+		return archiveRoot(dir), frameworkName, cleanup, nil
+	}
+	return "", "", cleanup, &serveError{http.StatusUnsupportedMediaType, fmt.Errorf("unsupported content type %q; send a tarball or a JSON path", mediaType)}
+}
+
+// allowedPath resolves a requested repository path, which must be a
+// directory below one of the allowed roots.
+func allowedPath(path string, allowPaths []string) (string, error) {
+	if path == "" {
+		return "", badRequest("path is required")
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", badRequest("invalid path: %v", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+	for _, allowed := range allowPaths {
+		allowedAbs, err := filepath.Abs(allowed)
+		if err != nil {
+			continue
+		}
+		if resolved, err := filepath.EvalSymlinks(allowedAbs); err == nil {
+			allowedAbs = resolved
+		}
+		rel, err := filepath.Rel(allowedAbs, abs)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+				return "", badRequest("%s is not a directory", path)
+			}
+			return abs, nil
+		}
+	}
+	return "", &serveError{http.StatusForbidden, fmt.Errorf("%s is not below an allowed path", path)}
+}
+
+// extractTarball extracts the regular files and directories of a tar
+// stream into dir. Entries escaping dir and links are skipped. It fails
+// with errArchiveTooLarge once the files exceed maxSize bytes or the
+// archive has more than maxArchiveEntries entries.
+func extractTarball(r io.Reader, dir string, gzipped bool, maxSize int64) error {
+	if gzipped {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	remaining := maxSize
+	for entries := 0; ; entries++ {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if entries == maxArchiveEntries {
+			return fmt.Errorf("%w: more than %d entries", errArchiveTooLarge, maxArchiveEntries)
+		}
+
+		name := filepath.FromSlash(header.Name)
+		if !filepath.IsLocal(name) || gitMetadata(name) {
+			continue
+		}
+		target := filepath.Join(dir, name)
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			// Copy one byte past the budget to tell a file that fills it
+			// from one that exceeds it
+			n, err := io.Copy(f, io.LimitReader(tr, remaining+1))
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+			remaining -= n
+			if remaining < 0 {
+				return fmt.Errorf("%w: more than %d bytes extracted", errArchiveTooLarge, maxSize)
+			}
+		}
+	}
+}
+
+// gitMetadata reports whether an archive entry belongs to a .git
+// directory, which is not extracted: source links run git in the
+// repository, and an uploaded git config could make it run commands.
+func gitMetadata(name string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(name), "/") {
+		if part == ".git" {
+			return true
+		}
+	}
+	return false
+}
+
+// archiveRoot returns the single top-level directory of an extracted
+// archive, as in GitHub tarballs, or dir itself.
+func archiveRoot(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err == nil && len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(dir, entries[0].Name())
+	}
+	return dir
+}
+
+// serveGenerate extracts the spec of the repository at root using its own
+// config.
+func serveGenerate(ctx context.Context, root, frameworkName string) (*serveResponse, error) {
+	cfg, err := config.LoadConfined(root)
+	if err != nil {
+		return nil, badRequest("failed to load config: %v", err)
+	}
+	if frameworkName != "" {
+		cfg.Framework = frameworkName
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, badRequest("invalid configuration: %v", err)
+	}
+	notes, err := confineConfig(cfg, root)
+	if err != nil {
+		return nil, badRequest("invalid configuration: %v", err)
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Framework definitions register plugins for this request only
+	result, err := extractSpec(ctx, cfg, plugins.Global().Clone(), root, cfg.Source.Paths)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, &serveError{http.StatusGatewayTimeout, fmt.Errorf("timed out after %s", timeout)}
+		}
+		return nil, err
+	}
+
+	relative := func(file string) string {
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel)
+		}
+		return file
+	}
+	diagnostics := []serveDiagnostic{}
+	for _, note := range notes {
+		diagnostics = append(diagnostics, serveDiagnostic{Message: note})
+	}
+	for _, d := range result.fileDiagnostics {
+		diagnostics = append(diagnostics, serveDiagnostic{File: relative(d.File), Message: d.Message})
+	}
+	for _, w := range result.warnings {
+		diagnostics = append(diagnostics, serveDiagnostic{File: relative(w.File), Line: w.Line, Message: w.Message})
+	}
+
+	return &serveResponse{
+		Spec:        result.doc,
+		Framework:   result.framework,
+		Routes:      result.routes,
+		Schemas:     result.schemas,
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+// confineConfig restricts cfg, the config of the repository at root, to
+// the repository: source paths and framework definitions must lie inside
+// it, and external specs, which would be fetched from wherever the config
+// says, are dropped. It returns a note for each dropped setting.
+func confineConfig(cfg *config.Config, root string) ([]string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var notes []string
+	if len(cfg.Generation.ExternalSpecs) > 0 {
+		cfg.Generation.ExternalSpecs = nil
+		notes = append(notes, "generation.externalSpecs is not supported by serve and was ignored")
+	}
+	for _, path := range cfg.Source.Paths {
+		if !filepath.IsLocal(filepath.FromSlash(path)) {
+			return nil, fmt.Errorf("source path %s is outside the repository", path)
+		}
+	}
+
+	definitions := make([]string, len(cfg.FrameworkDefinitions))
+	for i, pattern := range cfg.FrameworkDefinitions {
+		if !filepath.IsLocal(filepath.FromSlash(pattern)) {
+			return nil, fmt.Errorf("framework definition %s is outside the repository", pattern)
+		}
+		definitions[i] = filepath.Join(resolvedRoot, filepath.FromSlash(pattern))
+		matches, err := filepath.Glob(definitions[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid framework definition pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			resolved, err := filepath.EvalSymlinks(match)
+			if err != nil {
+				return nil, err
+			}
+			if rel, err := filepath.Rel(resolvedRoot, resolved); err != nil || !filepath.IsLocal(rel) {
+				return nil, fmt.Errorf("framework definition %s is outside the repository", match)
+			}
+		}
+	}
+	cfg.FrameworkDefinitions = definitions
+	return notes, nil
+}
+
+// writeServeError writes err as a JSON error response.
+func writeServeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var se *serveError
+	if errors.As(err, &se) {
+		status = se.status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
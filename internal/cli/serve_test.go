@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+)
+
+const serveMainGo = `package main
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func main() {
+	r := chi.NewRouter()
+	r.Get("/users", listUsers)
+	r.Get("/users/{id}", getUser)
+	http.ListenAndServe(":8080", r)
+}
+`
+
+// serveTarball returns a gzipped tarball of files.
+func serveTarball(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return &buf
+}
+
+func TestServe_Tarball(t *testing.T) {
+	buf := serveTarball(t, map[string]string{
+		"repo-main/go.mod":  "module example.com/repo\n\ngo 1.22\n\nrequire github.com/go-chi/chi/v5 v5.0.0\n",
+		"repo-main/main.go": serveMainGo,
+		"../escape.go":      "package escape\n",
+	})
+
+	srv := httptest.NewServer(newServeHandler(nil, 1<<20, 1<<20))
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL+"/generate?framework=chi", "application/gzip", buf)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Spec struct {
+			Paths map[string]any `json:"paths"`
+		} `json:"spec"`
+		Framework   string            `json:"framework"`
+		Routes      int               `json:"routes"`
+		Diagnostics []serveDiagnostic `json:"diagnostics"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "chi", body.Framework)
+	assert.Equal(t, 2, body.Routes)
+	assert.Contains(t, body.Spec.Paths, "/users/{id}")
+	assert.NotNil(t, body.Diagnostics)
+}
+
+func TestServe_Path(t *testing.T) {
+	allowed := t.TempDir()
+	repo := filepath.Join(allowed, "billing")
+	require.NoError(t, os.MkdirAll(repo, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "main.go"), []byte(serveMainGo), 0o644))
+
+	srv := httptest.NewServer(newServeHandler([]string{allowed}, 1<<20, 1<<20))
+	defer srv.Close()
+
+	post := func(body string) *http.Response {
+		resp, err := http.Post(srv.URL+"/generate", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := post(`{"path": "` + filepath.ToSlash(repo) + `", "framework": "chi"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = post(`{"path": "` + filepath.ToSlash(t.TempDir()) + `"}`)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	var errBody map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&errBody))
+	assert.Contains(t, errBody["error"], "not below an allowed path")
+
+	resp = post(`{"path": "` + filepath.ToSlash(filepath.Join(allowed, "..")) + `"}`)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, err := http.Post(srv.URL+"/generate", "text/plain", strings.NewReader("x"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "/healthz")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServe_ConfinedConfig(t *testing.T) {
+	srv := httptest.NewServer(newServeHandler(nil, 1<<20, 1<<20))
+	defer srv.Close()
+
+	post := func(files map[string]string) (int, map[string]any) {
+		resp, err := http.Post(srv.URL+"/generate", "application/gzip", serveTarball(t, files))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	// Framework definitions are registered per request, so the same
+	// repository can be uploaded again
+	repo := map[string]string{
+		"api2spec.yaml": "framework: bottle-serve\nframeworkDefinitions: [frameworks/*.yaml]\n" +
+			"generation:\n  externalSpecs:\n    - prefix: /payments\n      source: http://169.254.169.254/latest\n",
+		"frameworks/bottle.yaml": "name: bottle-serve\nlanguage: python\nextensions: [.py]\nroutes:\n  - call: '@app\\.(get|post)\\('\n    method: 1\n",
+		"app.py":                 "@app.get('/users')\ndef users():\n    pass\n",
+	}
+	for range 2 {
+		status, body := post(repo)
+		require.Equal(t, http.StatusOK, status, body["error"])
+		assert.Equal(t, "bottle-serve", body["framework"])
+		assert.EqualValues(t, 1, body["routes"])
+		assert.Contains(t, body["diagnostics"], map[string]any{"message": "generation.externalSpecs is not supported by serve and was ignored"})
+	}
+	assert.Nil(t, plugins.Get("bottle-serve"))
+
+	for name, config := range map[string]string{
+		"extends file":     "extends: /etc/passwd\n",
+		"extends url":      "extends: http://169.254.169.254/latest/base.yaml\n",
+		"source path":      "source:\n  paths: [/etc]\n",
+		"definitions":      "frameworkDefinitions: [/etc/*.yaml]\n",
+		"relative escapes": "frameworkDefinitions: [../*.yaml]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			status, body := post(map[string]string{"api2spec.yaml": config, "main.go": serveMainGo})
+			assert.Equal(t, http.StatusBadRequest, status, body)
+		})
+	}
+}
+
+func TestServe_DecompressionLimit(t *testing.T) {
+	srv := httptest.NewServer(newServeHandler(nil, 1<<20, 1<<20))
+	defer srv.Close()
+
+	// Compresses to a few kilobytes, well under the upload limit
+	buf := serveTarball(t, map[string]string{
+		"main.go":  serveMainGo,
+		"blob.bin": strings.Repeat("\x00", 2<<20),
+	})
+	require.Less(t, buf.Len(), 1<<20)
+
+	resp, err := http.Post(srv.URL+"/generate", "application/gzip", buf)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	var body map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Contains(t, body["error"], "bytes extracted")
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// languageNames spell the languages whose name is not the capitalized
+// language identifier.
+var languageNames = map[string]string{
+	"csharp":     "CSharp",
+	"javascript": "JavaScript",
+	"php":        "PHP",
+	"typescript": "TypeScript",
+}
+
+// service is one service of a multi-language repository.
+type service struct {
+	config.ServiceConfig
+	plugin plugins.FrameworkPlugin
+}
+
+// serviceExtraction holds the files, routes and schemas of one service.
+type serviceExtraction struct {
+	service
+	files   []scanner.SourceFile
+	routes  []types.Route
+	schemas []types.Schema
+}
+
+// serviceSpec is the spec of one service under the split strategy.
+type serviceSpec struct {
+	name   string
+	output string
+	doc    *types.OpenAPI
+}
+
+// resolveServices returns the services configured in cfg or, when none
+// are, one service per framework of registry detected in the project, of
+// a distinct language, named after the language.
+func resolveServices(cfg *config.Config, registry *plugins.Registry, projectRoot string) ([]service, error) {
+	var services []service
+	for _, sc := range cfg.Generation.Services.List {
+		plugin := registry.Get(sc.Framework)
+		if plugin == nil {
+			return nil, fmt.Errorf("unknown framework %q for service %s. Available: %s", sc.Framework, sc.Name, strings.Join(registry.List(), ", "))
+		}
+		services = append(services, service{ServiceConfig: sc, plugin: plugin})
+	}
+	if len(services) > 0 {
+		return services, nil
+	}
+
+	languages := make(map[string]bool)
+	for _, plugin := range registry.DetectAll(projectRoot) {
+		language := pluginLanguages(plugin)[0]
+		if language == "" || languages[language] {
+			continue
+		}
+		languages[language] = true
+		name := languageNames[language]
+		if name == "" {
+			name = strings.ToUpper(language[:1]) + language[1:]
+		}
+		printVerbose("Service %s: detected framework %s", name, plugin.Name())
+		services = append(services, service{
+			ServiceConfig: config.ServiceConfig{Name: name, Framework: plugin.Name()},
+			plugin:        plugin,
+		})
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no framework detected; list the services under generation.services.list")
+	}
+	return services, nil
+}
+
+// pluginLanguages returns the languages of the files plugin handles, in
+// the order of its extensions. It always returns at least one entry, which
+// is empty when no extension has a known language.
+func pluginLanguages(plugin plugins.FrameworkPlugin) []string {
+	var languages []string
+	for _, ext := range plugin.Extensions() {
+		if language := scanner.DetectLanguage("file" + ext); language != "" {
+			languages = append(languages, language)
+		}
+	}
+	if len(languages) == 0 {
+		return []string{""}
+	}
+	return languages
+}
+
+// selectFiles returns the files of the service's languages that match its
+// paths.
+func (s service) selectFiles(files []scanner.SourceFile, projectRoot string) []scanner.SourceFile {
+	languages := make(map[string]bool)
+	for _, language := range pluginLanguages(s.plugin) {
+		languages[language] = true
+	}
+	var selected []scanner.SourceFile
+	for _, f := range files {
+		if !languages[f.Language] {
+			continue
+		}
+		if len(s.Paths) > 0 {
+			rel, err := filepath.Rel(projectRoot, f.Path)
+			if err != nil || !matchesAnyGlob(filepath.ToSlash(rel), s.Paths) {
+				continue
+			}
+		}
+		selected = append(selected, f)
+	}
+	return selected
+}
+
+// matchesAnyGlob reports whether path matches one of patterns.
+func matchesAnyGlob(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := doublestar.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// extractServices extracts the routes and schemas of every service of
+// the repository from its own files with its own framework plugin,
+// recording the files each claims in decisionLog, which may be nil.
+func extractServices(ctx context.Context, cfg *config.Config, registry *plugins.Registry, files []scanner.SourceFile, projectRoot string, decisionLog *decisions.Log) ([]serviceExtraction, error) {
+	services, err := resolveServices(cfg, registry, projectRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	extractions := make([]serviceExtraction, 0, len(services))
+	for _, s := range services {
+		ex := serviceExtraction{service: s, files: s.selectFiles(files, projectRoot)}
+		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "routes-only" {
+			ex.routes, err = plugins.ExtractRoutes(ctx, s.plugin, ex.files)
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract routes of service %s: %w", s.Name, err)
+			}
+			markRoutes(cfg, ex.routes, ex.files, projectRoot, decisionLog)
+		}
+		if cfg.Generation.Mode == "full" || cfg.Generation.Mode == "schemas-only" {
+			ex.schemas, err = plugins.ExtractSchemas(ctx, s.plugin, ex.files)
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract schemas of service %s: %w", s.Name, err)
+			}
+		}
+		decisionLog.Claim(s.plugin.Name(), s.plugin.Extensions(), ex.files, ex.routes)
+		printInfo("Service %s (%s): %d routes and %d schemas in %d files", s.Name, s.plugin.Name(), len(ex.routes), len(ex.schemas), len(ex.files))
+		extractions = append(extractions, ex)
+	}
+	return extractions, nil
+}
+
+// joinServices returns the routes and schemas of every service for one
+// spec: component schemas are prefixed with the service name and
+// operations name their service in x-service.
+func joinServices(extractions []serviceExtraction) ([]types.Route, []types.Schema) {
+	var routes []types.Route
+	var schemas []types.Schema
+	for _, ex := range extractions {
+		openapi.NamespaceSchemas(ex.Name, ex.routes, ex.schemas)
+		for i := range ex.routes {
+			if ex.routes[i].Extensions == nil {
+				ex.routes[i].Extensions = make(types.Extensions)
+			}
+			ex.routes[i].Extensions[openapi.ExtService] = ex.Name
+		}
+		routes = append(routes, ex.routes...)
+		schemas = append(schemas, ex.schemas...)
+	}
+	return routes, schemas
+}
+
+// buildServiceSpecs builds one spec per service, each listing the specs of
+// the other services in x-linked-specs. Source links point into the
+// repository at projectRoot.
+func buildServiceSpecs(cfg *config.Config, projectRoot string, extractions []serviceExtraction) ([]serviceSpec, error) {
+	specs := make([]serviceSpec, 0, len(extractions))
+	for _, ex := range extractions {
+		doc, err := withTenantHost(newBuilder(cfg, projectRoot), cfg, ex.files).Build(ex.routes, ex.schemas)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build OpenAPI spec of service %s: %w", ex.Name, err)
+		}
+		specs = append(specs, serviceSpec{name: ex.Name, output: ex.OutputPath(cfg.Output), doc: doc})
+	}
+
+	for _, spec := range specs {
+		var linked []openapi.LinkedSpec
+		for _, other := range specs {
+			if other.name == spec.name {
+				continue
+			}
+			url, err := filepath.Rel(filepath.Dir(spec.output), other.output)
+			if err != nil {
+				url = other.output
+			}
+			linked = append(linked, openapi.LinkedSpec{Name: other.name, URL: filepath.ToSlash(url)})
+		}
+		if len(linked) == 0 {
+			continue
+		}
+		if spec.doc.Extensions == nil {
+			spec.doc.Extensions = make(types.Extensions)
+		}
+		spec.doc.Extensions[openapi.ExtLinkedSpecs] = linked
+	}
+	return specs, nil
+}
+
+// writeServiceSpecs writes the spec of each service to its output, merged
+// with the spec already there when generation.merge is set. With dryRun it
+// prints the changes instead.
+func writeServiceSpecs(cfg *config.Config, specs []serviceSpec, dryRun bool) error {
+	writer := openapi.NewWriter()
+	for _, spec := range specs {
+		doc := spec.doc
+		if cfg.Generation.Merge {
+			if _, err := os.Stat(spec.output); err == nil {
+				existing, err := openapi.ReadFile(spec.output)
+				if err != nil {
+					return fmt.Errorf("failed to read existing spec for merge: %w", err)
+				}
+				if doc, err = openapi.MergeDefault(existing, doc); err != nil {
+					return fmt.Errorf("failed to merge specs: %w", err)
+				}
+			}
+		}
+		if cfg.Generation.OperationHashes {
+			openapi.StampHashes(doc)
+		}
+
+		format := formatForPath(spec.output, cfg.Format)
+		if dryRun {
+			var content string
+			var err error
+			if format == "json" {
+				content, err = writer.ToJSON(doc)
+			} else {
+				content, err = writer.ToYAML(doc)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to serialize spec: %w", err)
+			}
+			if err := previewSpec(spec.output, content); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := writer.WriteFile(doc, spec.output, format); err != nil {
+			return fmt.Errorf("failed to write spec: %w", err)
+		}
+		printInfo("OpenAPI specification of service %s written to: %s (%d operations)", spec.name, spec.output, len(openapi.Operations(doc)))
+	}
+	return nil
+}
+
+// diffServiceSpecs compares the spec generated for each service with the
+// one at its output, combining the differences of all services.
+func diffServiceSpecs(specs []serviceSpec) (*openapi.DiffResult, error) {
+	combined := &openapi.DiffResult{}
+	var summaries []string
+	for _, spec := range specs {
+		existing, err := openapi.ReadFile(spec.output)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read spec of service %s: %w", spec.name, err)
+		}
+		result, err := openapi.NewDiffer().Diff(existing, spec.doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare specs of service %s: %w", spec.name, err)
+		}
+		if result.IsEmpty() {
+			continue
+		}
+		combined.PathChanges = append(combined.PathChanges, result.PathChanges...)
+		combined.SchemaChanges = append(combined.SchemaChanges, result.SchemaChanges...)
+		combined.HasBreakingChanges = combined.HasBreakingChanges || result.HasBreakingChanges
+		summaries = append(summaries, spec.name+" ("+spec.output+"): "+result.Summary)
+	}
+	combined.Summary = strings.Join(summaries, "\n")
+	return combined, nil
+}
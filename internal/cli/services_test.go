@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestResolveServices_Configured(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.Services.List = []config.ServiceConfig{{Name: "Api", Framework: "gin"}}
+
+	services, err := resolveServices(cfg, plugins.Global(), t.TempDir())
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, "gin", services[0].plugin.Name())
+
+	cfg.Generation.Services.List = []config.ServiceConfig{{Name: "Api", Framework: "unknown"}}
+	_, err = resolveServices(cfg, plugins.Global(), t.TempDir())
+	assert.ErrorContains(t, err, `unknown framework "unknown" for service Api`)
+}
+
+func TestService_SelectFiles(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "/repo/api/main.go", Language: "go"},
+		{Path: "/repo/bff/server.ts", Language: "typescript"},
+		{Path: "/repo/bff/legacy.js", Language: "javascript"},
+		{Path: "/repo/tools/gen.ts", Language: "typescript"},
+	}
+	gin := service{plugin: plugins.Get("gin")}
+	bff := service{ServiceConfig: config.ServiceConfig{Paths: []string{"bff/**"}}, plugin: plugins.Get("express")}
+
+	assert.Equal(t, files[:1], gin.selectFiles(files, "/repo"))
+	assert.Equal(t, files[1:3], bff.selectFiles(files, "/repo"))
+}
+
+func TestJoinServices(t *testing.T) {
+	userRef := func() *types.Schema { return &types.Schema{Ref: "#/components/schemas/User"} }
+	extraction := func(name, path string) serviceExtraction {
+		return serviceExtraction{
+			service: service{ServiceConfig: config.ServiceConfig{Name: name}},
+			routes: []types.Route{{Method: "GET", Path: path, Responses: map[string]types.Response{
+				"200": {Content: map[string]types.MediaType{"application/json": {Schema: userRef()}}},
+			}}},
+			schemas: []types.Schema{{Title: "User", Type: "object"}},
+		}
+	}
+
+	routes, schemas := joinServices([]serviceExtraction{extraction("Go", "/users"), extraction("Bff", "/me")})
+
+	require.Len(t, routes, 2)
+	assert.Equal(t, "Bff", routes[1].Extensions[openapi.ExtService])
+	assert.Equal(t, "#/components/schemas/Bff.User", routes[1].Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, []string{"Go.User", "Bff.User"}, []string{schemas[0].Title, schemas[1].Title})
+}
+
+func TestBuildServiceSpecs(t *testing.T) {
+	cfg := config.Default()
+	cfg.Output = "openapi.yaml"
+	extractions := []serviceExtraction{
+		{service: service{ServiceConfig: config.ServiceConfig{Name: "Api"}}},
+		{service: service{ServiceConfig: config.ServiceConfig{Name: "Bff", Output: "bff/openapi.yaml"}}},
+	}
+
+	specs, err := buildServiceSpecs(cfg, t.TempDir(), extractions)
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, "openapi.api.yaml", specs[0].output)
+	assert.Equal(t, []openapi.LinkedSpec{{Name: "Bff", URL: "bff/openapi.yaml"}}, specs[0].doc.Extensions[openapi.ExtLinkedSpecs])
+	assert.Equal(t, []openapi.LinkedSpec{{Name: "Api", URL: "../openapi.api.yaml"}}, specs[1].doc.Extensions[openapi.ExtLinkedSpecs])
+}
+
+func TestPlugins_TreeSitterExtractionCancels(t *testing.T) {
+	names := []string{
+		"actix", "adonis", "aiohttp", "axum", "bun", "drf", "elysia", "express", "fastapi", "fastify",
+		"feathers", "flask", "fresh", "hapi", "hono", "koa", "micro", "moleculer", "nestjs", "oak",
+		"polka", "restify", "rocket", "routingcontrollers", "sails", "sanic", "starlette", "tornado", "vapor",
+	}
+	files := []scanner.SourceFile{
+		{Path: "app.ts", Language: "typescript", Content: []byte("app.get('/users', handler)\n")},
+		{Path: "app.py", Language: "python", Content: []byte("@app.get('/users')\ndef users(): pass\n")},
+		{Path: "main.rs", Language: "rust", Content: []byte("#[get(\"/users\")]\nfn users() {}\n")},
+		{Path: "routes.swift", Language: "swift", Content: []byte("app.get(\"users\") { req in \"\" }\n")},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			plugin := plugins.Get(name)
+			require.NotNil(t, plugin)
+			ce, ok := plugin.(plugins.ContextExtractor)
+			require.True(t, ok, "%s does not implement plugins.ContextExtractor", name)
+
+			_, err := ce.ExtractRoutesContext(ctx, files)
+			assert.ErrorIs(t, err, context.Canceled)
+			_, err = ce.ExtractSchemasContext(ctx, files)
+			assert.ErrorIs(t, err, context.Canceled)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// externalSpecClient fetches remote external specs.
+var externalSpecClient = &http.Client{Timeout: 30 * time.Second}
+
+// stitchExternalSpecs hands the path prefixes of generation.externalSpecs
+// over to the specs owning them, replacing the operations of doc there.
+func stitchExternalSpecs(ctx context.Context, cfg *config.Config, doc *types.OpenAPI) error {
+	for i, spec := range cfg.Generation.ExternalSpecs {
+		namespace := spec.Namespace
+		if namespace == "" {
+			namespace = openapi.ExternalNamespace(spec.Prefix)
+		}
+		if namespace == "" {
+			return fmt.Errorf("generation.externalSpecs[%d]: set a namespace for prefix %s", i, spec.Prefix)
+		}
+
+		data, err := readExternalSpec(ctx, spec.Source)
+		if err != nil {
+			return err
+		}
+		external, err := openapi.ParseExternal(data, namespace)
+		if err != nil {
+			return fmt.Errorf("external spec %s: %w", spec.Source, err)
+		}
+
+		replaced := openapi.Stitch(doc, external, spec.Prefix, namespace)
+		printVerbose("Stitched %d paths of %s under %s", len(external.Paths), spec.Source, spec.Prefix)
+		for _, op := range replaced {
+			printVerbose("  %s is owned by %s", op, spec.Source)
+		}
+	}
+	return nil
+}
+
+// readExternalSpec reads an external spec from a URL or a file.
+func readExternalSpec(ctx context.Context, source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "https://") && !strings.HasPrefix(source, "http://") {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read external spec: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid external spec URL %s: %w", source, err)
+	}
+	resp, err := externalSpecClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch external spec: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch external spec %s: %s", source, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch external spec %s: %w", source, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestStitchExternalSpecs(t *testing.T) {
+	search := filepath.Join(t.TempDir(), "search.yaml")
+	require.NoError(t, os.WriteFile(search, []byte(`
+openapi: 3.0.3
+info: {title: Search, version: 1.0.0}
+paths:
+  /:
+    get:
+      operationId: search
+      responses:
+        "200":
+          description: Results
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Results'}
+components:
+  schemas:
+    Results: {type: array, items: {type: string}}
+`), 0o644))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openapi.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"openapi": "3.0.3", "info": {"title": "Payments", "version": "2.0.0"}, "paths": {"/charges": {"post": {"responses": {"201": {"description": "Created"}}}}}}`))
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.Generation.ExternalSpecs = []config.ExternalSpecConfig{
+		{Prefix: "/search", Source: search},
+		{Prefix: "/payments", Source: server.URL + "/openapi.json", Namespace: "Pay"},
+	}
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users":  {Get: &types.Operation{}},
+		"/search": {Get: &types.Operation{OperationID: "proxySearch"}},
+	}}
+
+	require.NoError(t, stitchExternalSpecs(context.Background(), cfg, doc))
+	assert.Len(t, doc.Paths, 3)
+	assert.Equal(t, "search", doc.Paths["/search"].Get.OperationID)
+	assert.Equal(t, "#/components/schemas/Search.Results", doc.Paths["/search"].Get.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, doc.Components.Schemas, "Search.Results")
+	assert.NotNil(t, doc.Paths["/payments/charges"].Post)
+
+	cfg.Generation.ExternalSpecs = []config.ExternalSpecConfig{{Prefix: "/payments", Source: server.URL + "/missing.json"}}
+	assert.ErrorContains(t, stitchExternalSpecs(context.Background(), cfg, doc), "404")
+}
@@ -0,0 +1,28 @@
+use actix_web::{delete, get, post, web, App, HttpResponse, HttpServer};
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize)]
+pub struct User {
+    pub id: u64,
+    pub name: String,
+}
+
+#[get("/users")]
+async fn list_users() -> HttpResponse {
+    HttpResponse::Ok().finish()
+}
+
+#[post("/users")]
+async fn create_user(body: web::Json<User>) -> HttpResponse {
+    HttpResponse::Created().finish()
+}
+
+#[get("/users/{id}")]
+async fn get_user(path: web::Path<u64>) -> HttpResponse {
+    HttpResponse::Ok().finish()
+}
+
+#[delete("/users/{id}")]
+async fn delete_user(path: web::Path<u64>) -> HttpResponse {
+    HttpResponse::NoContent().finish()
+}
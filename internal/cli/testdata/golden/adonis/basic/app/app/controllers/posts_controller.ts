@@ -0,0 +1,27 @@
+import type { HttpContext } from '@adonisjs/core/http'
+import { createPostValidator, listPostsValidator } from '#validators/post'
+
+export default class PostsController {
+  async index({ request }: HttpContext) {
+    const filters = await request.validateUsing(listPostsValidator)
+    return []
+  }
+
+  async store({ request }: HttpContext) {
+    const payload = await request.validateUsing(createPostValidator)
+    return payload
+  }
+
+  async show({ params }: HttpContext) {
+    return {}
+  }
+
+  async update({ request }: HttpContext) {
+    const payload = await request.validateUsing(createPostValidator)
+    return payload
+  }
+
+  async related({ params }: HttpContext) {
+    return []
+  }
+}
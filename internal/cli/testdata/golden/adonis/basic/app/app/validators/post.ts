@@ -0,0 +1,17 @@
+import vine from '@vinejs/vine'
+
+export const createPostValidator = vine.compile(
+  vine.object({
+    title: vine.string().trim().minLength(6).maxLength(120),
+    body: vine.string(),
+    status: vine.enum(['draft', 'published']),
+    tags: vine.array(vine.string()).optional(),
+  })
+)
+
+export const listPostsValidator = vine.compile(
+  vine.object({
+    page: vine.number().min(1).optional(),
+    search: vine.string().optional(),
+  })
+)
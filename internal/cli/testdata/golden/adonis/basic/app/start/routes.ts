@@ -0,0 +1,14 @@
+import router from '@adonisjs/core/services/router'
+
+const PostsController = () => import('#controllers/posts_controller')
+
+router.get('/', async () => {
+  return { hello: 'world' }
+})
+
+router
+  .group(() => {
+    router.resource('posts', PostsController).apiOnly().except(['destroy'])
+    router.get('/posts/:id/related', [PostsController, 'related']).where('id', router.matchers.number())
+  })
+  .prefix('/api/v1')
@@ -0,0 +1,45 @@
+using Microsoft.AspNetCore.Mvc;
+
+namespace MyApp.Controllers
+{
+    [ApiController]
+    [Route("api/[controller]")]
+    public class UsersController : ControllerBase
+    {
+        [HttpGet]
+        public IActionResult GetUsers()
+        {
+            return Ok(new List<User>());
+        }
+
+        [HttpGet("{id}")]
+        public IActionResult GetUser(int id)
+        {
+            return Ok(new User());
+        }
+
+        [HttpPost]
+        public IActionResult CreateUser([FromBody] CreateUserDto user)
+        {
+            return Created("", new User());
+        }
+
+        [HttpPut("{id}")]
+        public IActionResult UpdateUser(int id, [FromBody] UpdateUserDto user)
+        {
+            return Ok(new User());
+        }
+
+        [HttpDelete("{id}")]
+        public IActionResult DeleteUser(int id)
+        {
+            return NoContent();
+        }
+
+        [HttpPatch("{id}/status")]
+        public IActionResult UpdateUserStatus(int id, [FromBody] StatusDto status)
+        {
+            return Ok(new User());
+        }
+    }
+}
@@ -0,0 +1,16 @@
+use axum::{routing::get, Router};
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize)]
+pub struct User {
+    pub id: u64,
+    pub name: String,
+    pub email: Option<String>,
+}
+
+#[tokio::main]
+async fn main() {
+    let app = Router::new()
+        .route("/users", get(list_users).post(create_user))
+        .route("/users/:id", get(get_user).delete(delete_user));
+}
@@ -0,0 +1,39 @@
+import type { CreateTodo, Todo } from "./types";
+
+const todos: Todo[] = [];
+
+async function createTodo(req: Request) {
+  const input: CreateTodo = await req.json();
+  const todo: Todo = { id: todos.length + 1, done: false, ...input };
+  todos.push(todo);
+  return Response.json(todo, { status: 201 });
+}
+
+const pages = new Bun.FileSystemRouter({
+  style: "nextjs",
+  dir: "./pages",
+});
+
+export default {
+  port: 3000,
+  routes: {
+    "/health": new Response("OK"),
+    "/api/todos": {
+      GET: () => Response.json(todos),
+      POST: createTodo,
+    },
+    "/api/todos/:id": {
+      GET: (req) => Response.json(todos.find((t) => t.id === Number(req.params.id))),
+      DELETE: () => new Response(null, { status: 204 }),
+    },
+    "/*": new Response("Not Found", { status: 404 }),
+  },
+  async fetch(req: Request) {
+    const match = pages.match(req);
+    if (!match) {
+      return new Response("Not Found", { status: 404 });
+    }
+    const mod = await import(match.filePath);
+    return mod.default(req);
+  },
+};
@@ -0,0 +1,3 @@
+export default function Home() {
+  return new Response("<h1>Todos</h1>", { headers: { "Content-Type": "text/html" } });
+}
@@ -0,0 +1,3 @@
+export function GET(req: Request) {
+  return Response.json({ url: req.url });
+}
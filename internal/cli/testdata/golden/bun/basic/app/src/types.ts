@@ -0,0 +1,10 @@
+export interface Todo {
+  id: number;
+  title: string;
+  done: boolean;
+}
+
+export interface CreateTodo {
+  title: string;
+  done?: boolean;
+}
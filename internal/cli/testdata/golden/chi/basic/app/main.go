@@ -0,0 +1,23 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// User is a registered user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email,omitempty"`
+}
+
+func main() {
+	r := chi.NewRouter()
+	r.Get("/users", ListUsers)
+	r.Post("/users", CreateUser)
+	r.Get("/users/{id}", GetUser)
+	r.Delete("/users/{id}", DeleteUser)
+	http.ListenAndServe(":8080", r)
+}
@@ -0,0 +1,17 @@
+import 'package:json_annotation/json_annotation.dart';
+
+part 'user.g.dart';
+
+@JsonSerializable()
+class User {
+  const User({required this.id, required this.name, this.email});
+
+  factory User.fromJson(Map<String, dynamic> json) => _$UserFromJson(json);
+
+  final int id;
+  final String name;
+  @JsonKey(name: 'email_address')
+  final String? email;
+
+  Map<String, dynamic> toJson() => _$UserToJson(this);
+}
@@ -0,0 +1,5 @@
+import 'package:dart_frog/dart_frog.dart';
+
+Handler middleware(Handler handler) {
+  return handler.use(requestLogger());
+}
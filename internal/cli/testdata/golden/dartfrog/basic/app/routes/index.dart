@@ -0,0 +1,5 @@
+import 'package:dart_frog/dart_frog.dart';
+
+Response onRequest(RequestContext context) {
+  return Response(body: 'Welcome to Dart Frog!');
+}
@@ -0,0 +1,20 @@
+import 'dart:io';
+
+import 'package:basic/models/user.dart';
+import 'package:dart_frog/dart_frog.dart';
+
+Future<Response> onRequest(RequestContext context, String id) async {
+  switch (context.request.method) {
+    case HttpMethod.get:
+      return Response.json(body: {'id': id});
+    case HttpMethod.put:
+      final user = User.fromJson(
+        await context.request.json() as Map<String, dynamic>,
+      );
+      return Response.json(body: user.toJson());
+    case HttpMethod.delete:
+      return Response(statusCode: HttpStatus.noContent);
+    default:
+      return Response(statusCode: HttpStatus.methodNotAllowed);
+  }
+}
@@ -0,0 +1,23 @@
+import 'dart:io';
+
+import 'package:basic/models/user.dart';
+import 'package:dart_frog/dart_frog.dart';
+
+Future<Response> onRequest(RequestContext context) async {
+  return switch (context.request.method) {
+    HttpMethod.get => _list(context),
+    HttpMethod.post => _create(context),
+    _ => Future.value(Response(statusCode: HttpStatus.methodNotAllowed)),
+  };
+}
+
+Future<Response> _list(RequestContext context) async {
+  return Response.json(body: <User>[]);
+}
+
+Future<Response> _create(RequestContext context) async {
+  final user = User.fromJson(
+    await context.request.json() as Map<String, dynamic>,
+  );
+  return Response.json(body: user.toJson(), statusCode: HttpStatus.created);
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// User is a registered user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email,omitempty"`
+}
+
+func main() {
+	r := echo.New()
+	r.GET("/users", ListUsers)
+	r.POST("/users", CreateUser)
+	r.GET("/users/:id", GetUser)
+	r.DELETE("/users/:id", DeleteUser)
+}
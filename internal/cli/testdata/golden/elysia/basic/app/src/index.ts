@@ -0,0 +1,10 @@
+import { Elysia } from 'elysia'
+
+const app = new Elysia()
+  .group('/api', app => app
+    .get('/users', () => [])
+    .get('/users/:id', () => ({}))
+    .post('/users', () => ({}))
+  )
+
+export default app
@@ -0,0 +1,16 @@
+import express from 'express'
+import { z } from 'zod'
+
+const app = express()
+
+export const CreateUserSchema = z.object({
+  name: z.string().min(1),
+  email: z.string().email(),
+})
+
+app.get('/users', (req, res) => res.json([]))
+app.post('/users', validate(CreateUserSchema), (req, res) => res.status(201).json({}))
+app.get('/users/:id', (req, res) => res.json({}))
+app.delete('/users/:id', (req, res) => res.sendStatus(204))
+
+export default app
@@ -0,0 +1,17 @@
+import express, { Request, Response } from 'express'
+
+interface Order {
+  id: number
+  total: number
+}
+
+interface OrderQuery {
+  status?: string
+}
+
+const app = express()
+
+app.get('/orders', (req: Request<{}, Order[], any, OrderQuery>, res) => res.json([]))
+app.get('/orders/:id', (req: Request<{ id: number }>, res: Response<Order>) => res.json({ id: 1, total: 0 }))
+
+export default app
@@ -0,0 +1,25 @@
+from fastapi import FastAPI
+from pydantic import BaseModel
+
+app = FastAPI()
+
+
+class User(BaseModel):
+    id: int
+    name: str
+    email: str | None = None
+
+
+@app.get("/users")
+async def list_users():
+    return []
+
+
+@app.post("/users", status_code=201)
+async def create_user(user: User):
+    return user
+
+
+@app.get("/users/{user_id}")
+async def get_user(user_id: int):
+    return {}
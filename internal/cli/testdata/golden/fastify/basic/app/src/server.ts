@@ -0,0 +1,32 @@
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+
+fastify.get('/users', async (request, reply) => {
+  return []
+})
+
+fastify.route({
+  method: 'POST',
+  url: '/users',
+  schema: {
+    body: {
+      type: 'object',
+      properties: {
+        name: { type: 'string' },
+        email: { type: 'string' }
+      },
+      required: ['name']
+    }
+  },
+  handler: async (request, reply) => {
+    reply.code(201)
+    return {}
+  }
+})
+
+fastify.get('/users/:id', async (request, reply) => {
+  return {}
+})
+
+export default fastify
@@ -0,0 +1,16 @@
+import { feathers } from '@feathersjs/feathers'
+import { koa, rest, bodyParser } from '@feathersjs/koa'
+import { message } from './services/messages/messages'
+
+class StatsService {
+  async find() {
+    return { messages: 0 }
+  }
+}
+
+export const app = koa(feathers())
+
+app.use(bodyParser())
+app.configure(rest())
+app.use('/stats', new StatsService())
+app.configure(message)
@@ -0,0 +1,27 @@
+import { Type, getValidator, querySyntax } from '@feathersjs/typebox'
+import type { Static } from '@feathersjs/typebox'
+import { dataValidator, queryValidator } from '../../validators'
+
+export const messageSchema = Type.Object(
+  {
+    id: Type.Number(),
+    text: Type.String(),
+    userId: Type.Number(),
+    createdAt: Type.Optional(Type.String({ format: 'date-time' }))
+  },
+  { $id: 'Message', additionalProperties: false }
+)
+export type Message = Static<typeof messageSchema>
+
+export const messageDataSchema = Type.Pick(messageSchema, ['text'], { $id: 'MessageData' })
+export type MessageData = Static<typeof messageDataSchema>
+export const messageDataValidator = getValidator(messageDataSchema, dataValidator)
+
+export const messagePatchSchema = Type.Partial(messageSchema, { $id: 'MessagePatch' })
+export const messagePatchValidator = getValidator(messagePatchSchema, dataValidator)
+
+export const messageQuerySchema = Type.Intersect([
+  querySyntax(Type.Pick(messageSchema, ['id', 'userId'])),
+  Type.Object({ search: Type.Optional(Type.String()) })
+])
+export const messageQueryValidator = getValidator(messageQuerySchema, queryValidator)
@@ -0,0 +1,23 @@
+import { hooks as schemaHooks } from '@feathersjs/schema'
+import { MemoryService } from '@feathersjs/memory'
+import type { Application } from '../../declarations'
+import type { Message, MessageData } from './messages.schema'
+import { messageDataValidator, messagePatchValidator, messageQueryValidator } from './messages.schema'
+
+export const messagePath = 'messages'
+
+export class MessageService extends MemoryService<Message, MessageData> {}
+
+export const message = (app: Application) => {
+  app.use(messagePath, new MessageService(), {
+    methods: ['find', 'get', 'create', 'patch', 'remove']
+  })
+
+  app.service(messagePath).hooks({
+    before: {
+      all: [schemaHooks.validateQuery(messageQueryValidator)],
+      create: [schemaHooks.validateData(messageDataValidator)],
+      patch: [schemaHooks.validateData(messagePatchValidator)]
+    }
+  })
+}
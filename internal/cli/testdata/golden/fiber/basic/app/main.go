@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// User is a registered user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email,omitempty"`
+}
+
+func main() {
+	r := fiber.New()
+	r.Get("/users", ListUsers)
+	r.Post("/users", CreateUser)
+	r.Get("/users/:id", GetUser)
+	r.Delete("/users/:id", DeleteUser)
+}
@@ -0,0 +1,18 @@
+from flask import Flask, jsonify
+
+app = Flask(__name__)
+
+
+@app.route("/users", methods=["GET"])
+def list_users():
+    return jsonify([])
+
+
+@app.route("/users", methods=["POST"])
+def create_user():
+    return jsonify({}), 201
+
+
+@app.route("/users/<int:user_id>", methods=["GET"])
+def get_user(user_id):
+    return jsonify({})
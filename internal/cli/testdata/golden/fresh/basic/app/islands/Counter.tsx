@@ -0,0 +1,6 @@
+import { useSignal } from "@preact/signals";
+
+export default function Counter() {
+  const count = useSignal(0);
+  return <button onClick={() => count.value++}>{count}</button>;
+}
@@ -0,0 +1,11 @@
+import { type PageProps } from "$fresh/server.ts";
+
+export default function App({ Component }: PageProps) {
+  return (
+    <html>
+      <body>
+        <Component />
+      </body>
+    </html>
+  );
+}
@@ -0,0 +1,15 @@
+import { Handlers } from "$fresh/server.ts";
+import { CreateUser } from "../../../types.ts";
+
+export const handler: Handlers = {
+  GET(_req, ctx) {
+    return Response.json({ id: ctx.params.id });
+  },
+  async PUT(req, ctx) {
+    const input: CreateUser = await req.json();
+    return Response.json({ id: ctx.params.id, ...input });
+  },
+  DELETE(_req, _ctx) {
+    return new Response(null, { status: 204 });
+  },
+};
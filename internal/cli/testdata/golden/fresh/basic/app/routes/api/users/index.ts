@@ -0,0 +1,13 @@
+import { Handlers } from "$fresh/server.ts";
+import { CreateUser, User } from "../../../types.ts";
+
+export const handler: Handlers<User[]> = {
+  GET(_req, _ctx) {
+    return Response.json([]);
+  },
+  async POST(req, _ctx) {
+    const input = (await req.json()) as CreateUser;
+    const user: User = { id: crypto.randomUUID(), ...input };
+    return Response.json(user, { status: 201 });
+  },
+};
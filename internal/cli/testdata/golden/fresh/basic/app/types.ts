@@ -0,0 +1,10 @@
+export interface User {
+  id: string;
+  name: string;
+  email?: string;
+}
+
+export interface CreateUser {
+  name: string;
+  email?: string;
+}
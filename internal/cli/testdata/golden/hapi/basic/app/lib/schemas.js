@@ -0,0 +1,17 @@
+'use strict';
+
+const Joi = require('joi');
+
+const bookSchema = Joi.object({
+  id: Joi.number().integer().required(),
+  title: Joi.string().max(200).required(),
+  author: Joi.string(),
+  publishedAt: Joi.date(),
+}).label('Book');
+
+const createBookSchema = Joi.object({
+  title: Joi.string().max(200).required(),
+  author: Joi.string(),
+}).label('CreateBook');
+
+module.exports = { bookSchema, createBookSchema };
@@ -0,0 +1,59 @@
+'use strict';
+
+const Hapi = require('@hapi/hapi');
+const Joi = require('joi');
+const { bookSchema, createBookSchema } = require('./schemas');
+const books = require('./handlers/books');
+
+const init = async () => {
+  const server = Hapi.server({ port: 3000 });
+
+  server.route([
+    {
+      method: 'GET',
+      path: '/books',
+      options: {
+        description: 'List books',
+        tags: ['api', 'books'],
+        handler: books.list,
+        validate: {
+          query: Joi.object({
+            author: Joi.string(),
+            limit: Joi.number().integer().min(1).max(50).default(20),
+          }),
+        },
+        response: { schema: Joi.array().items(bookSchema) },
+      },
+    },
+    {
+      method: 'POST',
+      path: '/books',
+      options: {
+        id: 'createBook',
+        tags: ['api', 'books'],
+        handler: books.create,
+        validate: { payload: createBookSchema },
+        response: { status: { 201: bookSchema } },
+      },
+    },
+    {
+      method: 'GET',
+      path: '/books/{id}',
+      options: {
+        tags: ['api', 'books'],
+        handler: books.show,
+        validate: { params: Joi.object({ id: Joi.number().integer().required() }) },
+        response: { schema: bookSchema },
+      },
+    },
+    {
+      method: 'DELETE',
+      path: '/books/{id}',
+      handler: books.remove,
+    },
+  ]);
+
+  await server.start();
+};
+
+init();
@@ -0,0 +1,10 @@
+import { Hono } from 'hono'
+
+const app = new Hono()
+
+app.get('/users', (c) => c.json([]))
+app.post('/users', (c) => c.json({}, 201))
+app.get('/users/:id', (c) => c.json({}))
+app.delete('/users/:id', (c) => c.body(null, 204))
+
+export default app
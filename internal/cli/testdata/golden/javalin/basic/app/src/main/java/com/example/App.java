@@ -0,0 +1,25 @@
+package com.example;
+
+import io.javalin.Javalin;
+
+import static io.javalin.apibuilder.ApiBuilder.*;
+
+public class App {
+    public static void main(String[] args) {
+        Javalin app = Javalin.create(config -> {
+            config.router.apiBuilder(() -> {
+                path("users", () -> {
+                    get(UserController::listUsers);
+                    post(UserController::createUser);
+                    path("{id}", () -> {
+                        get(UserController::getUser);
+                        delete(UserController::deleteUser);
+                    });
+                });
+            });
+        });
+
+        app.get("/health", ctx -> ctx.result("ok"));
+        app.start(7070);
+    }
+}
@@ -0,0 +1,22 @@
+package com.example;
+
+import io.javalin.http.Context;
+
+public class UserController {
+    public static void listUsers(Context ctx) {
+        ctx.json(List.of());
+    }
+
+    public static void getUser(Context ctx) {
+        ctx.json(new User(Long.parseLong(ctx.pathParam("id")), "Ada", "ada@example.com"));
+    }
+
+    public static void createUser(Context ctx) {
+        CreateUserRequest req = ctx.bodyAsClass(CreateUserRequest.class);
+        ctx.status(201).json(req);
+    }
+
+    public static void deleteUser(Context ctx) {
+        ctx.status(204);
+    }
+}
@@ -0,0 +1,3 @@
+package com.example;
+
+public record Order(Long id, Long userId, double total) {}
@@ -0,0 +1,24 @@
+package com.example;
+
+import jakarta.ws.rs.*;
+import java.util.List;
+
+public class OrderResource {
+
+    private final Long userId;
+
+    public OrderResource(Long userId) {
+        this.userId = userId;
+    }
+
+    @GET
+    public List<Order> listOrders(@HeaderParam("X-Tenant") String tenant) {
+        return List.of();
+    }
+
+    @GET
+    @Path("{orderId}")
+    public Order getOrder(@PathParam("orderId") Long orderId) {
+        return null;
+    }
+}
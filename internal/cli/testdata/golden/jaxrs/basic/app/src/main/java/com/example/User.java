@@ -0,0 +1,3 @@
+package com.example;
+
+public record User(Long id, String name, String email) {}
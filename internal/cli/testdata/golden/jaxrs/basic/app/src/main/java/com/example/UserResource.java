@@ -0,0 +1,37 @@
+package com.example;
+
+import jakarta.ws.rs.*;
+import jakarta.ws.rs.core.MediaType;
+import java.util.List;
+
+@Path("/users")
+@Produces(MediaType.APPLICATION_JSON)
+@Consumes(MediaType.APPLICATION_JSON)
+public class UserResource {
+
+    @GET
+    public List<User> listUsers(@QueryParam("page") @DefaultValue("1") int page) {
+        return List.of();
+    }
+
+    @GET
+    @Path("{id: \\d+}")
+    public User getUser(@PathParam("id") Long id) {
+        return null;
+    }
+
+    @POST
+    public User createUser(User user) {
+        return user;
+    }
+
+    @DELETE
+    @Path("{id}")
+    public void deleteUser(@PathParam("id") Long id) {
+    }
+
+    @Path("{id}/orders")
+    public OrderResource orders(@PathParam("id") Long id) {
+        return new OrderResource(id);
+    }
+}
@@ -0,0 +1,13 @@
+import Koa from 'koa'
+import Router from '@koa/router'
+
+const app = new Koa()
+const api = new Router({ prefix: '/api' })
+
+api.get('/users', (ctx) => { ctx.body = [] })
+api.get('/users/:id', (ctx) => { ctx.body = {} })
+api.post('/users', (ctx) => { ctx.body = {} })
+
+app.use(api.routes())
+
+export default app
@@ -0,0 +1 @@
+dependencies { implementation("io.ktor:ktor-server-core:2.3.0") }
@@ -0,0 +1,19 @@
+package com.example
+
+import io.ktor.server.application.*
+import io.ktor.server.response.*
+import io.ktor.server.routing.*
+
+fun Application.module() {
+    routing {
+        get("/users") {
+            call.respond(listOf<String>())
+        }
+        get("/users/{id}") {
+            call.respond("user")
+        }
+        post("/users") {
+            call.respond("created")
+        }
+    }
+}
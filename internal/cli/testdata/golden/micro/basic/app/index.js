@@ -0,0 +1,24 @@
+const { send, json } = require('micro');
+const UrlPattern = require('url-pattern');
+
+const itemPattern = new UrlPattern('/items/:id');
+
+module.exports = async (req, res) => {
+  const { pathname } = new URL(req.url, 'http://localhost');
+
+  if (pathname === '/items' && req.method === 'GET') {
+    return send(res, 200, await listItems(req));
+  }
+
+  if (pathname === '/items' && req.method === 'POST') {
+    const item = await json(req);
+    return send(res, 201, await createItem(req, item));
+  }
+
+  const match = itemPattern.match(pathname);
+  if (match && req.method === 'GET') {
+    return send(res, 200, await getItem(req, match.id));
+  }
+
+  return send(res, 404, { error: 'Not found' });
+};
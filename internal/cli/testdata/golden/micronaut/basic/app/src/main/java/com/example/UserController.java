@@ -0,0 +1,22 @@
+package com.example;
+
+import io.micronaut.http.annotation.*;
+
+@Controller("/users")
+public class UserController {
+
+    @Get
+    public List<User> list() {
+        return List.of();
+    }
+
+    @Get("/{id}")
+    public User show(Long id) {
+        return null;
+    }
+
+    @Post
+    public User save(@Body User user) {
+        return user;
+    }
+}
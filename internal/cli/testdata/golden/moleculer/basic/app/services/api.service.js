@@ -0,0 +1,23 @@
+const ApiGateway = require("moleculer-web");
+
+module.exports = {
+  name: "api",
+  mixins: [ApiGateway],
+  settings: {
+    port: 3000,
+    path: "/api",
+    routes: [
+      {
+        path: "/",
+        aliases: {
+          "GET greet/:name": "greeter.welcome",
+          "POST upload": "multipart:files.save"
+        }
+      },
+      {
+        path: "/v2",
+        autoAliases: true
+      }
+    ]
+  }
+};
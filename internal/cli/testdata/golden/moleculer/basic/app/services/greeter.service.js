@@ -0,0 +1,14 @@
+module.exports = {
+  name: "greeter",
+  actions: {
+    welcome: {
+      params: {
+        name: "string",
+        lang: { type: "enum", values: ["en", "de"], optional: true }
+      },
+      handler(ctx) {
+        return `Welcome, ${ctx.params.name}`;
+      }
+    }
+  }
+};
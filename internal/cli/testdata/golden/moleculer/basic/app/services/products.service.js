@@ -0,0 +1,34 @@
+module.exports = {
+  name: "products",
+  actions: {
+    list: {
+      rest: "GET /",
+      params: { page: "number|optional", pageSize: "number|optional" },
+      handler(ctx) {}
+    },
+    get: {
+      rest: "GET /:id",
+      params: { id: "string" },
+      handler(ctx) {}
+    },
+    create: {
+      rest: "POST /",
+      params: {
+        name: "string",
+        price: { type: "number", positive: true },
+        tags: { type: "array", items: "string", optional: true }
+      },
+      handler(ctx) {}
+    },
+    update: {
+      rest: "PUT /:id",
+      params: { id: "string", name: "string|optional", price: "number|optional" },
+      handler(ctx) {}
+    },
+    remove: {
+      rest: "DELETE /:id",
+      params: { id: "string" },
+      handler(ctx) {}
+    }
+  }
+};
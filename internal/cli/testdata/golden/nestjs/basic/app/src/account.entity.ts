@@ -0,0 +1,19 @@
+import { Exclude, Expose } from 'class-transformer';
+
+export class AccountEntity {
+  id: number;
+
+  @Expose({ name: 'display_name' })
+  displayName: string;
+
+  @Exclude()
+  password: string;
+
+  @Expose({ groups: ['admin'] })
+  lastLoginIp?: string;
+
+  @Expose()
+  get label(): string {
+    return `#${this.id} ${this.displayName}`;
+  }
+}
@@ -0,0 +1,17 @@
+import { ClassSerializerInterceptor, Controller, Get, Param, SerializeOptions, UseInterceptors } from '@nestjs/common';
+import { AccountEntity } from './account.entity';
+
+@Controller('accounts')
+@UseInterceptors(ClassSerializerInterceptor)
+export class AccountsController {
+  @Get()
+  async findAll(): Promise<AccountEntity[]> {
+    return [];
+  }
+
+  @Get(':id')
+  @SerializeOptions({ groups: ['admin'] })
+  async findOne(@Param('id') id: string): Promise<AccountEntity> {
+    return new AccountEntity();
+  }
+}
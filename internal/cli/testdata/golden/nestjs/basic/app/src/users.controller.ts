@@ -0,0 +1,29 @@
+import { Body, Controller, Delete, Get, Param, Post } from '@nestjs/common';
+
+export class CreateUserDto {
+  name: string;
+  email?: string;
+}
+
+@Controller('users')
+export class UsersController {
+  @Get()
+  findAll() {
+    return [];
+  }
+
+  @Get(':id')
+  findOne(@Param('id') id: string) {
+    return {};
+  }
+
+  @Post()
+  create(@Body() dto: CreateUserDto) {
+    return {};
+  }
+
+  @Delete(':id')
+  remove(@Param('id') id: string) {
+    return;
+  }
+}
@@ -0,0 +1,16 @@
+import { Application, Router } from "@oak/oak";
+import { booksRouter } from "./routes/books.ts";
+
+const router = new Router();
+router.get("/health", (ctx) => {
+  ctx.response.body = { status: "ok" };
+});
+
+const api = new Router({ prefix: "/api" });
+api.use("/books", booksRouter.routes(), booksRouter.allowedMethods());
+
+const app = new Application();
+app.use(router.routes());
+app.use(api.routes());
+
+await app.listen({ port: 8000 });
@@ -0,0 +1,22 @@
+import { Router, type RouterContext } from "@oak/oak";
+import type { CreateBook } from "../types.ts";
+
+export const booksRouter = new Router();
+
+booksRouter
+  .get("/", (ctx) => {
+    ctx.response.body = [];
+  })
+  .get("/:id", (ctx) => {
+    ctx.response.body = { id: ctx.params.id };
+  })
+  .post("/", createBook)
+  .delete("/:id", (ctx) => {
+    ctx.response.status = 204;
+  });
+
+async function createBook(ctx: RouterContext<"/">) {
+  const book: CreateBook = await ctx.request.body.json();
+  ctx.response.status = 201;
+  ctx.response.body = book;
+}
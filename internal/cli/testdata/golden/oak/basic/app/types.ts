@@ -0,0 +1,5 @@
+export interface CreateBook {
+  title: string;
+  author: string;
+  year?: number;
+}
@@ -0,0 +1,11 @@
+defmodule AppWeb.Router do
+  use AppWeb, :router
+
+  scope "/api", AppWeb do
+    pipe_through :api
+
+    get "/users", UserController, :index
+    get "/users/:id", UserController, :show
+    post "/users", UserController, :create
+  end
+end
@@ -0,0 +1,3 @@
+defp deps do
+  [{:phoenix, "~> 1.7"}]
+end
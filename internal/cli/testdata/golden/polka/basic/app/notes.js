@@ -0,0 +1,10 @@
+const polka = require('polka');
+
+const app = polka();
+
+app.get('/', listNotes);
+app.post('/', createNote);
+app.get('/:id', showNote);
+app.delete('/:id', deleteNote);
+
+module.exports = app;
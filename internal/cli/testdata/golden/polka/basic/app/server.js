@@ -0,0 +1,7 @@
+const polka = require('polka');
+const notes = require('./notes');
+
+polka()
+  .use('/notes', notes)
+  .get('/version', (req, res) => res.end('1.0.0'))
+  .listen(3000);
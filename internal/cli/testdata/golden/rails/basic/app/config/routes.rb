@@ -0,0 +1,4 @@
+Rails.application.routes.draw do
+  resources :users, only: [:index, :show, :create]
+  get '/health', to: 'health#show'
+end
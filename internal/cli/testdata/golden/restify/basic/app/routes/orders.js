@@ -0,0 +1,10 @@
+'use strict';
+
+const { Router } = require('restify-router');
+const router = new Router();
+
+router.get('', listOrders);
+router.post('', createOrder);
+router.patch('/:orderId', updateOrder);
+
+module.exports = router;
@@ -0,0 +1,16 @@
+'use strict';
+
+const restify = require('restify');
+const orders = require('./routes/orders');
+
+const server = restify.createServer({ name: 'shop' });
+server.use(restify.plugins.bodyParser());
+
+server.get({ path: '/products', version: '1.0.0' }, listProductsV1);
+server.get({ path: '/products', version: '2.0.0' }, listProductsV2);
+server.get({ path: '/products/:sku', name: 'getProduct' }, getProduct);
+server.del('/products/:sku', removeProduct);
+
+orders.applyRoutes(server, '/orders');
+
+server.listen(8080);
@@ -0,0 +1,24 @@
+#[macro_use] extern crate rocket;
+
+use rocket::serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize)]
+pub struct User {
+    pub id: u64,
+    pub name: String,
+}
+
+#[get("/users")]
+fn list_users() -> &'static str {
+    "[]"
+}
+
+#[get("/users/<id>")]
+fn get_user(id: u64) -> &'static str {
+    "{}"
+}
+
+#[post("/users", data = "<user>")]
+fn create_user(user: Json<User>) -> &'static str {
+    "{}"
+}
@@ -0,0 +1,27 @@
+import { JsonController, Get, Post, Delete, Param, QueryParam, Body, HttpCode, OnUndefined } from 'routing-controllers';
+import { CreateUserDto, User } from './user';
+
+@JsonController('/users')
+export class UserController {
+  @Get('/')
+  getAll(@QueryParam('limit') limit: number): Promise<User[]> {
+    return this.users.find({ take: limit });
+  }
+
+  @Get('/:id')
+  @OnUndefined(404)
+  getOne(@Param('id') id: number): Promise<User> {
+    return this.users.findOne(id);
+  }
+
+  @Post('/')
+  @HttpCode(201)
+  create(@Body() user: CreateUserDto): Promise<User> {
+    return this.users.save(user);
+  }
+
+  @Delete('/:id')
+  async remove(@Param('id') id: number): Promise<void> {
+    await this.users.delete(id);
+  }
+}
@@ -0,0 +1,15 @@
+import { IsEmail, IsOptional } from 'class-validator';
+
+export interface User {
+  id: number;
+  email: string;
+  name?: string;
+}
+
+export class CreateUserDto {
+  @IsEmail()
+  email: string;
+
+  @IsOptional()
+  name: string;
+}
@@ -0,0 +1,18 @@
+module.exports = {
+  friendlyName: 'Signup',
+
+  inputs: {
+    emailAddress: { type: 'string', required: true, isEmail: true },
+    password: { type: 'string', required: true, minLength: 8 },
+    fullName: { type: 'string', required: true },
+  },
+
+  exits: {
+    success: { statusCode: 201, description: 'The account was created.' },
+    emailAlreadyInUse: { statusCode: 409, description: 'The email address is already in use.' },
+  },
+
+  fn: async function (inputs) {
+    return User.create(inputs).fetch();
+  },
+};
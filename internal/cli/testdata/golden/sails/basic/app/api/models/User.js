@@ -0,0 +1,7 @@
+module.exports = {
+  attributes: {
+    emailAddress: { type: 'string', required: true, unique: true, isEmail: true, maxLength: 200 },
+    fullName: { type: 'string', required: true, maxLength: 120 },
+    role: { type: 'string', isIn: ['admin', 'member'], defaultsTo: 'member' },
+  },
+};
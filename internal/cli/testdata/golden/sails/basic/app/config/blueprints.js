@@ -0,0 +1,4 @@
+module.exports.blueprints = {
+  shortcuts: false,
+  prefix: '/api/v1',
+};
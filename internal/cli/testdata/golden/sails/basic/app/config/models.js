@@ -0,0 +1,7 @@
+module.exports.models = {
+  attributes: {
+    createdAt: { type: 'number', autoCreatedAt: true },
+    updatedAt: { type: 'number', autoUpdatedAt: true },
+    id: { type: 'number', autoIncrement: true },
+  },
+};
@@ -0,0 +1,4 @@
+module.exports.routes = {
+  '/': { view: 'pages/homepage' },
+  'POST /api/v1/signup': { action: 'user/signup' },
+};
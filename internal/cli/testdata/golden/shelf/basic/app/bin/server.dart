@@ -0,0 +1,13 @@
+import 'package:basic/users_api.dart';
+import 'package:shelf/shelf.dart';
+import 'package:shelf/shelf_io.dart' as io;
+import 'package:shelf_router/shelf_router.dart';
+
+void main() async {
+  final users = UsersApi();
+  final app = Router()
+    ..get('/health', (Request request) => Response.ok('ok'))
+    ..mount('/api/users', users.router);
+
+  await io.serve(app, 'localhost', 8080);
+}
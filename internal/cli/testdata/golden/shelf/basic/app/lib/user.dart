@@ -0,0 +1,15 @@
+class User {
+  User({required this.id, required this.name, this.email});
+
+  factory User.fromJson(Map<String, dynamic> json) => User(
+        id: json['id'] as int,
+        name: json['name'] as String,
+        email: json['email'] as String?,
+      );
+
+  final int id;
+  final String name;
+  final String? email;
+
+  Map<String, dynamic> toJson() => {'id': id, 'name': name, 'email': email};
+}
@@ -0,0 +1,28 @@
+import 'dart:convert';
+
+import 'package:shelf/shelf.dart';
+import 'package:shelf_router/shelf_router.dart';
+
+import 'user.dart';
+
+class UsersApi {
+  Router get router {
+    final router = Router();
+    router.get('/', _listUsers);
+    router.get('/<id|[0-9]+>', _getUser);
+    router.post('/', _createUser);
+    router.delete('/<id>', _deleteUser);
+    return router;
+  }
+
+  Response _listUsers(Request request) => Response.ok('[]');
+
+  Response _getUser(Request request, String id) => Response.ok('{}');
+
+  Future<Response> _createUser(Request request) async {
+    final user = User.fromJson(jsonDecode(await request.readAsString()));
+    return Response.ok(jsonEncode(user.toJson()));
+  }
+
+  Response _deleteUser(Request request, String id) => Response(204);
+}
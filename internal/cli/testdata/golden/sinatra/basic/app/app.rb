@@ -0,0 +1,14 @@
+require 'sinatra'
+
+get '/users' do
+  [].to_json
+end
+
+get '/users/:id' do
+  {}.to_json
+end
+
+post '/users' do
+  status 201
+  {}.to_json
+end
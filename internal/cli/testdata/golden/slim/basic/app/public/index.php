@@ -0,0 +1,19 @@
+<?php
+
+use Slim\Factory\AppFactory;
+
+$app = AppFactory::create();
+
+$app->get('/users', function ($request, $response) {
+    return $response;
+});
+
+$app->get('/users/{id}', function ($request, $response, $args) {
+    return $response;
+});
+
+$app->post('/users', function ($request, $response) {
+    return $response->withStatus(201);
+});
+
+$app->run();
@@ -0,0 +1,23 @@
+<?php
+
+namespace App\Controller;
+
+use Symfony\Component\Routing\Annotation\Route;
+
+class UserController
+{
+    #[Route('/users', methods: ['GET'])]
+    public function list()
+    {
+    }
+
+    #[Route('/users/{id}', methods: ['GET'])]
+    public function show(int $id)
+    {
+    }
+
+    #[Route('/users', methods: ['POST'])]
+    public function create()
+    {
+    }
+}
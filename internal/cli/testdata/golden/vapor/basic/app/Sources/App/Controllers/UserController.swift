@@ -0,0 +1,30 @@
+import Vapor
+
+struct UserController: RouteCollection {
+    func boot(routes: RoutesBuilder) throws {
+        let users = routes.grouped("users")
+        users.get(use: index)
+        users.post(use: create)
+        users.group(":userID") { user in
+            user.get(use: show)
+            user.delete(use: delete)
+        }
+    }
+
+    func index(req: Request) async throws -> [User] {
+        return []
+    }
+
+    func create(req: Request) async throws -> User {
+        let input = try req.content.decode(CreateUser.self)
+        return User(id: UUID(), name: input.name, email: input.email)
+    }
+
+    func show(req: Request) async throws -> User {
+        throw Abort(.notFound)
+    }
+
+    func delete(req: Request) async throws -> HTTPStatus {
+        return .noContent
+    }
+}
@@ -0,0 +1,12 @@
+import Vapor
+
+struct User: Content {
+    var id: UUID?
+    var name: String
+    var email: String
+}
+
+struct CreateUser: Content {
+    var name: String
+    var email: String
+}
@@ -0,0 +1,10 @@
+import Vapor
+
+func routes(_ app: Application) throws {
+    app.get("health") { req -> String in
+        "ok"
+    }
+
+    let api = app.grouped("api")
+    try api.register(collection: UserController())
+}
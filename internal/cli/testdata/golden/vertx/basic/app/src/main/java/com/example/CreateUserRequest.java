@@ -0,0 +1,3 @@
+package com.example;
+
+public record CreateUserRequest(String name, String email) {}
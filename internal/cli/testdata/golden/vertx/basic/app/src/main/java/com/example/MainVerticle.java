@@ -0,0 +1,38 @@
+package com.example;
+
+import io.vertx.core.AbstractVerticle;
+import io.vertx.ext.web.Router;
+import io.vertx.ext.web.RoutingContext;
+import io.vertx.ext.web.handler.BodyHandler;
+
+public class MainVerticle extends AbstractVerticle {
+    @Override
+    public void start() {
+        Router router = Router.router(vertx);
+        Router api = Router.router(vertx);
+
+        router.route().handler(BodyHandler.create());
+        router.route("/api/*").subRouter(api);
+
+        api.get("/users").handler(this::listUsers);
+        api.get("/users/:id").handler(this::getUser);
+        api.post("/users").handler(this::createUser);
+        api.delete("/users/:id").handler(ctx -> ctx.response().setStatusCode(204).end());
+
+        vertx.createHttpServer().requestHandler(router).listen(8080);
+    }
+
+    private void listUsers(RoutingContext ctx) {
+        ctx.json(List.of());
+    }
+
+    private void getUser(RoutingContext ctx) {
+        ctx.json(new User(Long.parseLong(ctx.pathParam("id")), "Ada", "ada@example.com"));
+    }
+
+    private void createUser(RoutingContext ctx) {
+        CreateUserRequest req = ctx.body().asPojo(CreateUserRequest.class);
+        ctx.response().setStatusCode(201);
+        ctx.json(req);
+    }
+}
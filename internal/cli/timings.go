@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+// timingReport is the machine-readable record of where a generate run spent
+// its time, written by --timings.
+type timingReport struct {
+	// TotalMs is the wall time of the whole run
+	TotalMs float64 `json:"totalMs"`
+
+	// Scan covers source file discovery
+	Scan scanTiming `json:"scan"`
+
+	// Plugins covers route and schema extraction per plugin
+	Plugins []pluginTiming `json:"plugins"`
+
+	// BuildMs is the time spent assembling the OpenAPI document
+	BuildMs float64 `json:"buildMs"`
+
+	// Files lists every parsed file, slowest first
+	Files []fileTiming `json:"files"`
+
+	// Reparses counts parses of files that had already been parsed in the
+	// run, which a parse cache would have served
+	Reparses int `json:"reparses"`
+}
+
+type scanTiming struct {
+	Files int     `json:"files"`
+	Bytes int     `json:"bytes"`
+	Ms    float64 `json:"ms"`
+}
+
+type pluginTiming struct {
+	Name      string  `json:"name"`
+	Routes    int     `json:"routes"`
+	RoutesMs  float64 `json:"routesMs"`
+	Schemas   int     `json:"schemas"`
+	SchemasMs float64 `json:"schemasMs"`
+}
+
+type fileTiming struct {
+	Path     string  `json:"path"`
+	Language string  `json:"language,omitempty"`
+	Bytes    int     `json:"bytes"`
+	Parses   int     `json:"parses"`
+	ParseMs  float64 `json:"parseMs"`
+}
+
+// timer accumulates a timing report over a generate run. A nil timer
+// records nothing, so call sites need not check whether --timings is set.
+type timer struct {
+	start  time.Time
+	report timingReport
+}
+
+// newTimer starts timing a run and enables per-file parse timing.
+func newTimer() *timer {
+	parser.EnableTiming()
+	parser.Timings()
+	return &timer{start: time.Now()}
+}
+
+// scanned records the discovered files and how long discovery took.
+func (t *timer) scanned(files []scanner.SourceFile, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.report.Scan.Ms = millis(time.Since(start))
+	t.report.Scan.Files = len(files)
+	for _, f := range files {
+		t.report.Scan.Bytes += len(f.Content)
+	}
+}
+
+// plugin returns the timing entry for the named plugin, adding it if new.
+func (t *timer) plugin(name string) *pluginTiming {
+	for i := range t.report.Plugins {
+		if t.report.Plugins[i].Name == name {
+			return &t.report.Plugins[i]
+		}
+	}
+	t.report.Plugins = append(t.report.Plugins, pluginTiming{Name: name})
+	return &t.report.Plugins[len(t.report.Plugins)-1]
+}
+
+// extractedRoutes records a plugin's route extraction that began at start.
+func (t *timer) extractedRoutes(name string, routes int, start time.Time) {
+	if t == nil {
+		return
+	}
+	p := t.plugin(name)
+	p.Routes = routes
+	p.RoutesMs = millis(time.Since(start))
+}
+
+// extractedSchemas records a plugin's schema extraction that began at start.
+func (t *timer) extractedSchemas(name string, schemas int, start time.Time) {
+	if t == nil {
+		return
+	}
+	p := t.plugin(name)
+	p.Schemas = schemas
+	p.SchemasMs = millis(time.Since(start))
+}
+
+// built records the OpenAPI build that began at start.
+func (t *timer) built(start time.Time) {
+	if t == nil {
+		return
+	}
+	t.report.BuildMs = millis(time.Since(start))
+}
+
+// write finishes the report and writes it as JSON to path, or to stdout
+// when path is "-". File paths are made relative to root.
+func (t *timer) write(path, root string, files []scanner.SourceFile) error {
+	if t == nil {
+		return nil
+	}
+	t.report.TotalMs = millis(time.Since(t.start))
+
+	byPath := make(map[string]scanner.SourceFile, len(files))
+	for _, f := range files {
+		byPath[f.Path] = f
+	}
+	t.report.Files = []fileTiming{}
+	for _, ft := range parser.Timings() {
+		entry := fileTiming{
+			Path:    ft.File,
+			Parses:  ft.Parses,
+			ParseMs: millis(ft.Duration),
+		}
+		if f, ok := byPath[ft.File]; ok {
+			entry.Language = f.Language
+			entry.Bytes = len(f.Content)
+		}
+		if rel, err := filepath.Rel(root, ft.File); err == nil && !strings.HasPrefix(rel, "..") {
+			entry.Path = filepath.ToSlash(rel)
+		}
+		t.report.Files = append(t.report.Files, entry)
+		t.report.Reparses += ft.Parses - 1
+	}
+	// Slowest first, so the files worth looking at lead the list
+	sort.SliceStable(t.report.Files, func(i, j int) bool {
+		return t.report.Files[i].ParseMs > t.report.Files[j].ParseMs
+	})
+
+	data, err := json.MarshalIndent(t.report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode timing report: %w", err)
+	}
+	data = append(data, '\n')
+
+	if path == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write timing report: %w", err)
+	}
+	printInfo("Timing report written to: %s", path)
+	return nil
+}
+
+// millis converts d to fractional milliseconds.
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
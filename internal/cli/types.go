@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/typegen"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	typesLang    string
+	typesZod     bool
+	typesPackage string
+)
+
+var typesCmd = &cobra.Command{
+	Use:   "types [spec file]",
+	Short: "Generate TypeScript, protobuf, or Avro types from the specification",
+	Long: `Types generates type definitions from the component schemas of the
+specification, so frontend packages in the same repository can consume the
+API's payload types without a separate code generation step.
+
+The proto and avro languages mirror REST payloads for event streams. Parts
+of a schema the target cannot represent (validation constraints, anyOf,
+nested arrays, and so on) are approximated and reported as warnings.
+
+If a file is provided, types are generated from that spec. Otherwise the
+spec is generated from the current source code. Output goes to stdout
+unless --output is set.
+
+Supported languages: ` + strings.Join(typegen.Languages, ", ") + `
+
+Example:
+  api2spec types --lang ts -o web/src/api.ts   # TypeScript interfaces
+  api2spec types --zod -o web/src/api.ts       # Interfaces plus zod schemas
+  api2spec types openapi.yaml                  # From an existing spec
+  api2spec types --lang proto --package acme.orders.v1 -o orders.proto
+  api2spec types --lang avro -o orders.avsc`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTypes,
+}
+
+func init() {
+	typesCmd.Flags().StringVarP(&typesLang, "lang", "l", typegen.LangTypeScript, "target language: "+strings.Join(typegen.Languages, ", "))
+	typesCmd.Flags().BoolVar(&typesZod, "zod", false, "also generate zod schemas (TypeScript only)")
+	typesCmd.Flags().StringVar(&typesPackage, "package", "", "proto package or Avro namespace (default: api)")
+}
+
+func runTypes(cmd *cobra.Command, args []string) error {
+	var doc *types.OpenAPI
+	var err error
+
+	if len(args) > 0 {
+		printVerbose("Reading spec from: %s", args[0])
+		doc, err = openapi.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", args[0], err)
+		}
+	} else {
+		cfg, err := config.Load(cfgFile)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		if framework != "" {
+			cfg.Framework = framework
+		}
+		printVerbose("Generating spec from source code...")
+		doc, err = generateSpecFromCode(cmd, cfg, cfg.Source.Paths)
+		if err != nil {
+			return fmt.Errorf("failed to generate spec: %w", err)
+		}
+	}
+
+	code, losses, err := typegen.Generate(doc, typesLang, typegen.Options{Zod: typesZod, Package: typesPackage})
+	if err != nil {
+		return err
+	}
+	for _, loss := range losses {
+		printWarning("lossy conversion: %s", loss)
+	}
+
+	if output == "" {
+		fmt.Print(code)
+		return nil
+	}
+
+	if dir := filepath.Dir(output); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(output, []byte(code), 0644); err != nil {
+		return fmt.Errorf("failed to write types: %w", err)
+	}
+	printInfo("Types written to %s", output)
+	return nil
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/release"
+)
+
+// Environment variables of the release lookup.
+const (
+	// releasesURLEnv overrides the releases endpoint, e.g. for a mirror
+	releasesURLEnv = "API2SPEC_RELEASES_URL"
+
+	// noUpdateNoticeEnv disables the new-version notice
+	noUpdateNoticeEnv = "API2SPEC_NO_UPDATE_NOTICE"
+)
+
+var (
+	upgradeCheck         bool
+	upgradeVersion       string
+	upgradeForce         bool
+	upgradeAllowUnsigned bool
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade api2spec to the latest release",
+	Long: `Replace the api2spec binary with the latest release, or the one given
+with --version.
+
+The release archive is checked against the release's checksums, whose
+cosign signature is verified first, so cosign must be installed. Binaries
+installed with Homebrew are upgraded with brew instead.
+
+Interactive runs print a notice on stderr when a newer release exists,
+looked up at most once a day. The notice is never shown in CI (when CI is
+set), with --quiet, when stderr is not a terminal or when
+API2SPEC_NO_UPDATE_NOTICE is set. API2SPEC_RELEASES_URL points both the
+upgrade and the notice at a mirror of the GitHub releases API.
+
+Example:
+  api2spec upgrade                    # Install the latest release
+  api2spec upgrade --check            # Only report whether one is available
+  api2spec upgrade --version v1.4.0   # Install a specific release`,
+	Args: cobra.NoArgs,
+	RunE: runUpgrade,
+}
+
+func init() {
+	upgradeCmd.Flags().BoolVar(&upgradeCheck, "check", false, "only report whether a newer release is available")
+	upgradeCmd.Flags().StringVar(&upgradeVersion, "version", "", "install this release instead of the latest")
+	upgradeCmd.Flags().BoolVar(&upgradeForce, "force", false, "install even when the release is not newer, or over a development build")
+	upgradeCmd.Flags().BoolVar(&upgradeAllowUnsigned, "allow-unsigned", false, "install releases without a checksum signature, checking the checksum only")
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	ctx, cancel := commandContext(cmd)
+	defer cancel()
+
+	client := release.NewClient(os.Getenv(releasesURLEnv))
+	r, err := client.Get(ctx, upgradeVersion)
+	if err != nil {
+		return contextError(ctx, fmt.Errorf("failed to look up release: %w", err))
+	}
+
+	newer := release.Newer(r.Version, Version)
+	if upgradeCheck {
+		if newer {
+			printInfo("api2spec %s is available (you have %s): %s", r.Version, Version, r.URL)
+		} else {
+			printInfo("api2spec %s is the latest release (you have %s)", r.Version, Version)
+		}
+		return nil
+	}
+	if !newer && !upgradeForce && upgradeVersion == "" {
+		if Version == "dev" {
+			return fmt.Errorf("this is a development build; use --force to replace it with %s", r.Version)
+		}
+		printInfo("api2spec %s is up to date", Version)
+		return nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the api2spec binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	if strings.Contains(filepath.ToSlash(exe), "/Cellar/") {
+		return fmt.Errorf("api2spec was installed with Homebrew; run \"brew upgrade api2spec\" instead")
+	}
+
+	name := release.ArchiveName(r.Version, runtime.GOOS, runtime.GOARCH)
+	asset, ok := r.Asset(name)
+	if !ok {
+		return fmt.Errorf("release %s has no archive for %s/%s (%s)", r.Version, runtime.GOOS, runtime.GOARCH, name)
+	}
+	printVerbose("Downloading %s", asset.URL)
+	archive, err := client.Download(ctx, asset.URL)
+	if err != nil {
+		return contextError(ctx, err)
+	}
+
+	err = client.Verify(ctx, r, name, archive, upgradeAllowUnsigned)
+	if errors.Is(err, release.ErrNoSignature) && upgradeAllowUnsigned {
+		printWarning("release %s is not signed; checked its checksum only", r.Version)
+	} else if err != nil {
+		return contextError(ctx, err)
+	}
+
+	binary, err := release.ExtractBinary(archive, name)
+	if err != nil {
+		return err
+	}
+	if err := release.Replace(exe, binary); err != nil {
+		return err
+	}
+	printInfo("Upgraded api2spec %s to %s at %s", Version, r.Version, exe)
+	return nil
+}
+
+// versionCheck delivers the latest release version for the new-version
+// notice, when one was started.
+var versionCheck chan string
+
+// startVersionCheck looks up the latest release for the new-version notice
+// in the background, from the cache unless it is a day old.
+func startVersionCheck(cmd *cobra.Command, args []string) {
+	if !versionNoticeEnabled(cmd) {
+		return
+	}
+	cachePath, err := release.CachePath()
+	if err != nil {
+		return
+	}
+	versionCheck = make(chan string, 1)
+	latest, stale := release.Cached(cachePath)
+	if !stale {
+		versionCheck <- latest
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		latest, _ := release.NewClient(os.Getenv(releasesURLEnv)).Refresh(ctx, cachePath)
+		versionCheck <- latest
+	}()
+}
+
+// printVersionNotice prints a notice on stderr when the lookup started by
+// startVersionCheck found a newer release, waiting for it briefly at most.
+func printVersionNotice(cmd *cobra.Command, args []string) {
+	if versionCheck == nil {
+		return
+	}
+	select {
+	case latest := <-versionCheck:
+		if release.Newer(latest, Version) {
+			fmt.Fprintf(os.Stderr, "\napi2spec %s is available (you have %s); run \"api2spec upgrade\" to update.\n", latest, Version)
+		}
+	case <-time.After(time.Second):
+	}
+}
+
+// versionNoticeEnabled reports whether cmd may print the new-version
+// notice: never in CI, quiet or non-interactive runs, for development
+// builds or when disabled with API2SPEC_NO_UPDATE_NOTICE.
+func versionNoticeEnabled(cmd *cobra.Command) bool {
+	if quiet || Version == "dev" || cmd == upgradeCmd {
+		return false
+	}
+	if os.Getenv("CI") != "" || os.Getenv(noUpdateNoticeEnv) != "" {
+		return false
+	}
+	fileInfo, err := os.Stderr.Stat()
+	if err != nil {
+		return false
+	}
+	return (fileInfo.Mode() & os.ModeCharDevice) != 0
+}
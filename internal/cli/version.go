@@ -4,10 +4,12 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
-	"runtime"
 
 	"github.com/spf13/cobra"
+
+	"github.com/api2spec/api2spec/internal/buildinfo"
 )
 
 // Version information set via ldflags during build.
@@ -22,19 +24,55 @@ var (
 	BuildDate = "unknown"
 )
 
+var versionJSON bool
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version information",
-	Long:  `Print the version, commit hash, build date, and Go version.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		cmd.Printf("api2spec %s\n", Version)
-		cmd.Printf("  Commit:     %s\n", Commit)
-		cmd.Printf("  Build Date: %s\n", BuildDate)
-		cmd.Printf("  Go Version: %s\n", runtime.Version())
-		cmd.Printf("  OS/Arch:    %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	Long: `Print the version, commit hash, build date, and Go version.
+
+With --json, print the build information as JSON, including the versions
+of the tree-sitter grammars compiled in and the registered framework
+plugins, so that CI pipelines can pin and audit the extractor that
+produced a spec. "api2spec --version --json" prints the same.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return printVersion(cmd, versionJSON)
 	},
 }
 
+func init() {
+	versionCmd.Flags().BoolVar(&versionJSON, "json", false, "print the build information, grammar versions and plugins as JSON")
+}
+
+// printVersion prints the build information, as JSON when asJSON is set.
+func printVersion(cmd *cobra.Command, asJSON bool) error {
+	info := buildinfo.Read(Version, Commit, BuildDate)
+	if asJSON {
+		data, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode build information: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	cmd.Printf("api2spec %s\n", info.Version)
+	cmd.Printf("  Commit:     %s\n", info.Commit)
+	cmd.Printf("  Build Date: %s\n", info.BuildDate)
+	cmd.Printf("  Go Version: %s\n", info.GoVersion)
+	cmd.Printf("  OS/Arch:    %s\n", info.Platform)
+	modules := make(map[string]bool)
+	for _, grammar := range info.Grammars {
+		module := grammar.Module + " " + grammar.Version
+		if grammar.Version != "" && !modules[module] {
+			modules[module] = true
+			cmd.Printf("  Grammars:   %s\n", module)
+		}
+	}
+	cmd.Printf("  Plugins:    %d\n", len(info.Plugins))
+	return nil
+}
+
 // GetVersionInfo returns formatted version information.
 func GetVersionInfo() string {
 	return fmt.Sprintf("api2spec %s (commit: %s, built: %s)", Version, Commit, BuildDate)
@@ -20,8 +20,11 @@ import (
 
 	"github.com/api2spec/api2spec/internal/config"
 	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/plugins/declarative"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -104,7 +107,7 @@ func (w *Watcher) Watch(ctx context.Context) error {
 	}
 
 	// Run initial generation
-	if err := w.regenerate(); err != nil {
+	if err := w.regenerate(ctx); err != nil {
 		printError("Initial generation failed: %v", err)
 	}
 
@@ -140,7 +143,7 @@ func (w *Watcher) Watch(ctx context.Context) error {
 				debounceTimer.Stop()
 			}
 			debounceTimer = time.AfterFunc(w.debounce, func() {
-				if err := w.regenerate(); err != nil {
+				if err := w.regenerate(ctx); err != nil {
 					printError("Regeneration failed: %v", err)
 				}
 			})
@@ -227,18 +230,29 @@ func (w *Watcher) shouldWatch(path string) bool {
 	return true
 }
 
-// regenerate runs the spec generation.
-func (w *Watcher) regenerate() error {
+// regenerate runs the spec generation. Each run is limited by --timeout and
+// abandoned when ctx is cancelled.
+func (w *Watcher) regenerate(ctx context.Context) (err error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	defer func() { err = contextError(ctx, err) }()
+
 	printInfo("Regenerating specification...")
 	start := time.Now()
 
 	// Scan for source files
 	scannerCfg := scanner.Config{
-		IncludePatterns: w.cfg.Source.Include,
-		ExcludePatterns: w.cfg.Source.Exclude,
+		IncludePatterns:    w.cfg.Source.Include,
+		ExcludePatterns:    w.cfg.Source.Exclude,
+		FollowSymlinks:     w.cfg.Source.FollowSymlinks,
+		LanguageExtensions: w.cfg.Source.LanguageExtensions,
+		LanguageOverrides:  w.cfg.Source.LanguageOverrides,
 	}
 
 	var files []scanner.SourceFile
@@ -249,7 +263,7 @@ func (w *Watcher) regenerate() error {
 		}
 		scannerCfg.BasePath = absPath
 		s := scanner.New(scannerCfg)
-		pathFiles, err := s.Scan()
+		pathFiles, err := s.ScanContext(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to scan path %s: %w", path, err)
 		}
@@ -265,26 +279,62 @@ func (w *Watcher) regenerate() error {
 	var routes []types.Route
 	var schemas []types.Schema
 
-	if w.plugin != nil {
+	if strategy := w.cfg.Generation.Services.Strategy; strategy != "" {
+		projectRoot, err := filepath.Abs(".")
+		if err != nil {
+			return fmt.Errorf("failed to determine project root: %w", err)
+		}
+		extractions, err := extractServices(ctx, w.cfg, plugins.Global(), files, projectRoot, nil)
+		if err != nil {
+			return err
+		}
+		for _, d := range parser.Diagnostics() {
+			printWarning("%s: %s", d.File, d.Message)
+		}
+
+		if strategy == "split" {
+			specs, err := buildServiceSpecs(w.cfg, projectRoot, extractions)
+			if err != nil {
+				return err
+			}
+			if err := writeServiceSpecs(w.cfg, specs, false); err != nil {
+				return err
+			}
+			printInfo("Specifications of %d services regenerated in %v",
+				len(specs), time.Since(start).Round(time.Millisecond))
+			w.regenerated()
+			return nil
+		}
+		routes, schemas = joinServices(extractions)
+	} else if w.plugin != nil {
 		if w.cfg.Generation.Mode == "full" || w.cfg.Generation.Mode == "routes-only" {
-			extractedRoutes, err := w.plugin.ExtractRoutes(files)
+			extractedRoutes, err := plugins.ExtractRoutes(ctx, w.plugin, files)
 			if err != nil {
 				return fmt.Errorf("failed to extract routes: %w", err)
 			}
 			routes = extractedRoutes
+			projectRoot, err := filepath.Abs(".")
+			if err != nil {
+				return fmt.Errorf("failed to determine project root: %w", err)
+			}
+			markRoutes(w.cfg, routes, files, projectRoot, nil)
 		}
 
 		if w.cfg.Generation.Mode == "full" || w.cfg.Generation.Mode == "schemas-only" {
-			extractedSchemas, err := w.plugin.ExtractSchemas(files)
+			extractedSchemas, err := plugins.ExtractSchemas(ctx, w.plugin, files)
 			if err != nil {
 				return fmt.Errorf("failed to extract schemas: %w", err)
 			}
 			schemas = extractedSchemas
 		}
+
+		for _, d := range parser.Diagnostics() {
+			printWarning("%s: %s", d.File, d.Message)
+		}
 	}
 
 	// Build OpenAPI spec
-	builder := openapi.NewBuilder(w.cfg)
+	builder := withTenantHost(newBuilder(w.cfg, "."), w.cfg, files)
 	doc, err := builder.Build(routes, schemas)
 	if err != nil {
 		return fmt.Errorf("failed to build OpenAPI spec: %w", err)
@@ -304,6 +354,14 @@ func (w *Watcher) regenerate() error {
 		}
 	}
 
+	if err := stitchExternalSpecs(context.Background(), w.cfg, doc); err != nil {
+		return err
+	}
+
+	if w.cfg.Generation.OperationHashes {
+		openapi.StampHashes(doc)
+	}
+
 	// Write output
 	writer := openapi.NewWriter()
 	if err := writer.WriteFile(doc, w.cfg.Output, w.cfg.Format); err != nil {
@@ -314,6 +372,12 @@ func (w *Watcher) regenerate() error {
 	printInfo("Specification regenerated in %v: %s (%d routes, %d schemas)",
 		elapsed.Round(time.Millisecond), w.cfg.Output, len(routes), len(schemas))
 
+	w.regenerated()
+	return nil
+}
+
+// regenerated records a regeneration and runs the on-change command.
+func (w *Watcher) regenerated() {
 	w.lastRegen = time.Now()
 
 	// Run on-change command if configured
@@ -322,8 +386,6 @@ func (w *Watcher) regenerate() error {
 			printError("On-change command failed: %v", err)
 		}
 	}
-
-	return nil
 }
 
 // runOnChangeCmd executes the on-change command.
@@ -390,9 +452,17 @@ func runWatch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to determine project root: %w", err)
 	}
 
+	if err := declarative.Register(cfg.FrameworkDefinitions); err != nil {
+		return fmt.Errorf("failed to load framework definitions: %w", err)
+	}
+
+	typemap.Set(cfg.Generation.TypeMappings)
+
 	// Get or detect framework plugin
 	var plugin plugins.FrameworkPlugin
-	if cfg.Framework == "" || cfg.Framework == "auto" {
+	if cfg.Generation.Services.Strategy != "" {
+		printVerbose("Watching services with the %s strategy", cfg.Generation.Services.Strategy)
+	} else if cfg.Framework == "" || cfg.Framework == "auto" {
 		plugin, err = plugins.Detect(projectRoot)
 		if err != nil {
 			printVerbose("Framework detection failed: %v", err)
@@ -7,11 +7,23 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"mime"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/spf13/viper"
+
+	"github.com/api2spec/api2spec/internal/policy"
+	"github.com/api2spec/api2spec/internal/publish"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 )
 
 // Config represents the api2spec configuration.
@@ -36,6 +48,21 @@ type Config struct {
 
 	// Watch contains file watching configuration
 	Watch WatchConfig `mapstructure:"watch" yaml:"watch" json:"watch"`
+
+	// Publish contains registry publishing configuration
+	Publish PublishConfig `mapstructure:"publish" yaml:"publish,omitempty" json:"publish,omitempty"`
+
+	// Policy contains API governance rules checked by generate and policy
+	Policy PolicyConfig `mapstructure:"policy" yaml:"policy,omitempty" json:"policy,omitempty"`
+
+	// Extends lists base configs, URLs or paths relative to this file,
+	// that this config overrides, e.g. an organization-wide ruleset; a
+	// #sha256=<hex> suffix pins the content of a base
+	Extends []string `mapstructure:"extends" yaml:"extends,omitempty" json:"extends,omitempty"`
+
+	// FrameworkDefinitions are globs of YAML files that describe simple
+	// frameworks declaratively; each is available under its own name
+	FrameworkDefinitions []string `mapstructure:"frameworkDefinitions" yaml:"frameworkDefinitions,omitempty" json:"frameworkDefinitions,omitempty"`
 }
 
 // OpenAPIConfig contains OpenAPI specification configuration.
@@ -67,6 +94,11 @@ type InfoConfig struct {
 	// Version is the API version
 	Version string `mapstructure:"version" yaml:"version" json:"version"`
 
+	// VersionFrom lists the sources to read the version from, in order of
+	// precedence: git-tag, package.json, composer.json or VERSION. Version
+	// applies when none yields one; --api-version overrides them all.
+	VersionFrom []string `mapstructure:"versionFrom" yaml:"versionFrom,omitempty" json:"versionFrom,omitempty"`
+
 	// TermsOfService is the URL to terms of service
 	TermsOfService string `mapstructure:"termsOfService" yaml:"termsOfService" json:"termsOfService"`
 
@@ -105,6 +137,21 @@ type ServerConfig struct {
 
 	// Description is the server description
 	Description string `mapstructure:"description" yaml:"description" json:"description"`
+
+	// Variables describe the {name} variables of the URL
+	Variables map[string]ServerVariableConfig `mapstructure:"variables" yaml:"variables,omitempty" json:"variables,omitempty"`
+}
+
+// ServerVariableConfig describes a server URL variable.
+type ServerVariableConfig struct {
+	// Default is the value used when none is given
+	Default string `mapstructure:"default" yaml:"default" json:"default"`
+
+	// Enum lists the allowed values
+	Enum []string `mapstructure:"enum" yaml:"enum,omitempty" json:"enum,omitempty"`
+
+	// Description is the variable description
+	Description string `mapstructure:"description" yaml:"description,omitempty" json:"description,omitempty"`
 }
 
 // TagConfig contains tag configuration.
@@ -156,6 +203,17 @@ type SourceConfig struct {
 
 	// Exclude is a list of glob patterns to exclude
 	Exclude []string `mapstructure:"exclude" yaml:"exclude" json:"exclude"`
+
+	// FollowSymlinks descends into symlinked directories such as pnpm
+	// packages or bazel output trees
+	FollowSymlinks bool `mapstructure:"followSymlinks" yaml:"followSymlinks,omitempty" json:"followSymlinks,omitempty"`
+
+	// LanguageExtensions maps languages to nonstandard extensions or suffixes
+	// (e.g., php: [".phtml"], rust: [".rs.in"])
+	LanguageExtensions map[string][]string `mapstructure:"languageExtensions" yaml:"languageExtensions,omitempty" json:"languageExtensions,omitempty"`
+
+	// LanguageOverrides force a language for files matching a glob
+	LanguageOverrides []scanner.LanguageOverride `mapstructure:"languageOverrides" yaml:"languageOverrides,omitempty" json:"languageOverrides,omitempty"`
 }
 
 // GenerationConfig contains generation behavior configuration.
@@ -166,11 +224,895 @@ type GenerationConfig struct {
 	// Merge determines whether to merge with existing spec
 	Merge bool `mapstructure:"merge" yaml:"merge" json:"merge"`
 
+	// Existing is the spec to merge into; by default a spec loaded by OpenAPI
+	// middleware in the project, or the output file
+	Existing string `mapstructure:"existing" yaml:"existing,omitempty" json:"existing,omitempty"`
+
+	// ExistingSpecs are maintained specs the generated operations are
+	// partitioned into by path and tag rules, each merged and written
+	// separately instead of the single output
+	ExistingSpecs []ExistingSpecConfig `mapstructure:"existingSpecs" yaml:"existingSpecs,omitempty" json:"existingSpecs,omitempty"`
+
+	// ExternalSpecs hand path prefixes over to specs owned elsewhere, which
+	// are stitched into the output in place of the generated operations
+	ExternalSpecs []ExternalSpecConfig `mapstructure:"externalSpecs" yaml:"externalSpecs,omitempty" json:"externalSpecs,omitempty"`
+
+	// Frozen selects operations and schemas, besides those marked x-frozen
+	// in the existing spec, whose contract the merge refuses to change
+	Frozen FrozenConfig `mapstructure:"frozen" yaml:"frozen" json:"frozen"`
+
 	// StrictMode enables strict validation during generation
 	StrictMode bool `mapstructure:"strictMode" yaml:"strictMode" json:"strictMode"`
 
 	// DefaultResponses is a list of default response codes to include
 	DefaultResponses []string `mapstructure:"defaultResponses" yaml:"defaultResponses" json:"defaultResponses"`
+
+	// DefaultResponse is the response operations get when none was inferred
+	// and defaultResponses lists no codes, so the spec always validates
+	DefaultResponse DefaultResponseConfig `mapstructure:"defaultResponse" yaml:"defaultResponse" json:"defaultResponse"`
+
+	// SourceLinks adds per-operation externalDocs links to the handler source
+	SourceLinks SourceLinksConfig `mapstructure:"sourceLinks" yaml:"sourceLinks" json:"sourceLinks"`
+
+	// Manifest writes a checksum manifest (and optional signature) beside the spec
+	Manifest ManifestConfig `mapstructure:"manifest" yaml:"manifest" json:"manifest"`
+
+	// SourceMap writes a sidecar map from schema properties to their declarations
+	SourceMap SourceMapConfig `mapstructure:"sourceMap" yaml:"sourceMap" json:"sourceMap"`
+
+	// Decisions writes a log of the plugin claiming each file, the
+	// heuristics that fired and the defaults plugins assumed
+	Decisions DecisionsConfig `mapstructure:"decisions" yaml:"decisions" json:"decisions"`
+
+	// Backstage writes a Backstage catalog API entity referencing the spec
+	Backstage BackstageConfig `mapstructure:"backstage" yaml:"backstage" json:"backstage"`
+
+	// SDKGrouping adds per-operation grouping extensions for SDK generators
+	SDKGrouping SDKGroupingConfig `mapstructure:"sdkGrouping" yaml:"sdkGrouping" json:"sdkGrouping"`
+
+	// Lint enables correctness checks over the extracted routes
+	Lint LintConfig `mapstructure:"lint" yaml:"lint" json:"lint"`
+
+	// FailOn fails generation when extraction quality degrades past its
+	// thresholds
+	FailOn FailOnConfig `mapstructure:"failOn" yaml:"failOn,omitempty" json:"failOn,omitempty"`
+
+	// Profiles write redacted copies of the spec, such as a public spec
+	// without internal routes and sensitive fields
+	Profiles []ProfileConfig `mapstructure:"profiles" yaml:"profiles,omitempty" json:"profiles,omitempty"`
+
+	// Variants are per-environment specs holding the routes registered under
+	// their build tags and environment, selected with generate --variant
+	Variants []VariantConfig `mapstructure:"variants" yaml:"variants,omitempty" json:"variants,omitempty"`
+
+	// Services generate the services of a multi-language repository, such
+	// as a Go backend and a TypeScript BFF, together
+	Services ServicesConfig `mapstructure:"services" yaml:"services" json:"services"`
+
+	// Operations override extracted operations, as recorded by generate --review
+	Operations []OperationConfig `mapstructure:"operations" yaml:"operations,omitempty" json:"operations,omitempty"`
+
+	// Wildcards is how catch-all and regex routes are represented: template
+	// keeps them as a {path} parameter marked x-wildcard, exclude drops them
+	Wildcards string `mapstructure:"wildcards" yaml:"wildcards" json:"wildcards"`
+
+	// MethodAliases is how one handler registered under alias methods (PUT
+	// and PATCH, GET and HEAD) is documented: keep documents every method,
+	// collapse documents the first with the others in x-aliases
+	MethodAliases string `mapstructure:"methodAliases" yaml:"methodAliases" json:"methodAliases"`
+
+	// DanglingRefs is how references to component schemas that were not
+	// extracted are handled: stub adds an empty schema marked x-unresolved,
+	// fail stops generation listing them, ignore leaves them dangling
+	DanglingRefs string `mapstructure:"danglingRefs" yaml:"danglingRefs" json:"danglingRefs"`
+
+	// WebhookReceivers is where endpoints receiving third-party webhooks
+	// (Stripe, GitHub, ...) go: mark keeps them in paths with
+	// x-webhook-receiver, separate moves them under x-webhook-receivers,
+	// exclude drops them
+	WebhookReceivers string `mapstructure:"webhookReceivers" yaml:"webhookReceivers" json:"webhookReceivers"`
+
+	// MethodBodies is how request bodies of GET, HEAD and DELETE routes are
+	// documented: warn documents them with a warning, document documents
+	// them quietly, query documents their properties as query parameters,
+	// drop leaves them out
+	MethodBodies string `mapstructure:"methodBodies" yaml:"methodBodies" json:"methodBodies"`
+
+	// PathServers emits per-path servers when routers are served from
+	// different addresses (several listen calls, Compose services)
+	PathServers bool `mapstructure:"pathServers" yaml:"pathServers" json:"pathServers"`
+
+	// RequestHeaders documents the Idempotency-Key and request ID headers
+	// of idempotency and correlation middleware, marking operations that
+	// accept an idempotency key x-idempotent
+	RequestHeaders bool `mapstructure:"requestHeaders" yaml:"requestHeaders" json:"requestHeaders"`
+
+	// ConditionalRequests documents the ETag/Last-Modified headers and 304
+	// responses of routes behind ETag middleware or checking If-None-Match
+	ConditionalRequests bool `mapstructure:"conditionalRequests" yaml:"conditionalRequests" json:"conditionalRequests"`
+
+	// CORS documents the policies of CORS middleware and configuration in
+	// x-cors, for the whole API or per route group
+	CORS bool `mapstructure:"cors" yaml:"cors" json:"cors"`
+
+	// RawBodies documents the request body of handlers that read the raw
+	// body and parse it into a type, bypassing validation middleware
+	RawBodies bool `mapstructure:"rawBodies" yaml:"rawBodies" json:"rawBodies"`
+
+	// InferEnums documents an enum for string parameters and body
+	// properties the handler compares against a finite set of literals,
+	// noting each inference in a diagnostic
+	InferEnums bool `mapstructure:"inferEnums" yaml:"inferEnums" json:"inferEnums"`
+
+	// ParameterExamples takes examples of path parameters from the requests
+	// in tests and from seed data
+	ParameterExamples ParameterExamplesConfig `mapstructure:"parameterExamples" yaml:"parameterExamples" json:"parameterExamples"`
+
+	// Timeouts documents in x-timeout-ms the request timeouts set by timeout
+	// middleware and interceptors, API clients and gateway configuration
+	Timeouts bool `mapstructure:"timeouts" yaml:"timeouts" json:"timeouts"`
+
+	// Scopes documents the OAuth scopes checked by scope middleware and
+	// decorators in the security requirements of their operations
+	Scopes bool `mapstructure:"scopes" yaml:"scopes" json:"scopes"`
+
+	// SLAs document the service levels of the operations they select in
+	// x-sla, overriding detected timeouts when they set one
+	SLAs []SLAConfig `mapstructure:"slas" yaml:"slas,omitempty" json:"slas,omitempty"`
+
+	// Lifecycle documents the release stage and audience of operations in
+	// x-lifecycle and x-badges, from code annotations and path rules
+	Lifecycle LifecycleConfig `mapstructure:"lifecycle" yaml:"lifecycle" json:"lifecycle"`
+
+	// Streaming documents streaming and long-polling operations in
+	// x-streaming with their streamed media type, from code annotations and
+	// path rules
+	Streaming StreamingConfig `mapstructure:"streaming" yaml:"streaming" json:"streaming"`
+
+	// OptimizeSize shrinks the spec for gateways that limit its size and
+	// checks it against a size budget
+	OptimizeSize OptimizeSizeConfig `mapstructure:"optimizeSize" yaml:"optimizeSize" json:"optimizeSize"`
+
+	// PartitionBySegment writes one spec per first path segment next to
+	// the output, which becomes an index referencing them
+	PartitionBySegment bool `mapstructure:"partitionBySegment" yaml:"partitionBySegment" json:"partitionBySegment"`
+
+	// Infrastructure classifies health check and metrics endpoints, which
+	// are left out of the spec unless included
+	Infrastructure InfrastructureConfig `mapstructure:"infrastructure" yaml:"infrastructure" json:"infrastructure"`
+
+	// Tenancy templates the server host per tenant, as in
+	// https://{tenant}.api.example.com
+	Tenancy TenancyConfig `mapstructure:"tenancy" yaml:"tenancy" json:"tenancy"`
+
+	// AccessModes marks server-generated properties (id, created_at, ...)
+	// readOnly and password properties writeOnly
+	AccessModes bool `mapstructure:"accessModes" yaml:"accessModes" json:"accessModes"`
+
+	// SchemaVariants splits schemas used by both requests and responses into
+	// <Name>Create and <Name>Response variants when their properties differ
+	SchemaVariants bool `mapstructure:"schemaVariants" yaml:"schemaVariants" json:"schemaVariants"`
+
+	// StrictObjects sets additionalProperties: false on object schemas that
+	// declare their properties and allow no others
+	StrictObjects bool `mapstructure:"strictObjects" yaml:"strictObjects" json:"strictObjects"`
+
+	// MaxInlineDepth moves inline objects nested more than this many objects
+	// deep into components named after their property path; 0 keeps them
+	// inline
+	MaxInlineDepth int `mapstructure:"maxInlineDepth" yaml:"maxInlineDepth,omitempty" json:"maxInlineDepth,omitempty"`
+
+	// ParameterCase renames path and query parameters to one naming style,
+	// camel or snake, keeping the source name in x-original-name; empty
+	// keeps the names as extracted
+	ParameterCase string `mapstructure:"parameterCase" yaml:"parameterCase,omitempty" json:"parameterCase,omitempty"`
+
+	// PathOrder orders the paths of the emitted spec: alphabetical, tag,
+	// source (as defined in the code) or crud (collections before items);
+	// empty is alphabetical
+	PathOrder string `mapstructure:"pathOrder" yaml:"pathOrder,omitempty" json:"pathOrder,omitempty"`
+
+	// Envelope declares the { data, meta, errors } envelope middleware puts
+	// around responses
+	Envelope EnvelopeConfig `mapstructure:"envelope" yaml:"envelope" json:"envelope"`
+
+	// CodeSamples are the languages of the x-codeSamples requests added to
+	// each operation for Redoc and Scalar: curl, javascript, python or go
+	CodeSamples []string `mapstructure:"codeSamples" yaml:"codeSamples,omitempty" json:"codeSamples,omitempty"`
+
+	// Links adds response links to the operations on the item a response
+	// identifies, such as from POST /users to GET /users/{id} via its id
+	Links bool `mapstructure:"links" yaml:"links" json:"links"`
+
+	// OperationHashes stamps each operation with an x-spec-hash of its
+	// content so changed operations can be detected between runs
+	OperationHashes bool `mapstructure:"operationHashes" yaml:"operationHashes" json:"operationHashes"`
+
+	// TypeMappings override the schema of source types (e.g., decimal.Decimal
+	// as a decimal string or Money as a shared schema) in every language
+	TypeMappings []typemap.Mapping `mapstructure:"typeMappings" yaml:"typeMappings,omitempty" json:"typeMappings,omitempty"`
+
+	// PathParams override the schema of path parameters by name, or by a
+	// glob such as *Id, in every operation
+	PathParams map[string]PathParamConfig `mapstructure:"pathParams" yaml:"pathParams,omitempty" json:"pathParams,omitempty"`
+
+	// MountPrefixes prefixes the tags and operationIds of routes registered
+	// on routers mounted under a path, such as an admin router under
+	// /admin, to keep them apart from the main API's
+	MountPrefixes MountPrefixesConfig `mapstructure:"mountPrefixes" yaml:"mountPrefixes" json:"mountPrefixes"`
+}
+
+// MountPrefixesConfig selects what is prefixed with the mount point of
+// mounted routers.
+type MountPrefixesConfig struct {
+	// Tags become <mount>/<tag> (e.g., admin/users)
+	Tags bool `mapstructure:"tags" yaml:"tags" json:"tags"`
+
+	// OperationIDs become <mount><OperationId> (e.g., adminListUsers)
+	OperationIDs bool `mapstructure:"operationIds" yaml:"operationIds" json:"operationIds"`
+}
+
+// PathParamConfig is the schema of the path parameters it is named after.
+type PathParamConfig struct {
+	// Type is the OpenAPI type (e.g., integer)
+	Type string `mapstructure:"type" yaml:"type" json:"type"`
+
+	// Format is the OpenAPI format (e.g., uuid, int64)
+	Format string `mapstructure:"format" yaml:"format,omitempty" json:"format,omitempty"`
+
+	// Pattern is a regular expression string values match
+	Pattern string `mapstructure:"pattern" yaml:"pattern,omitempty" json:"pattern,omitempty"`
+
+	// Enum lists the allowed values
+	Enum []string `mapstructure:"enum" yaml:"enum,omitempty" json:"enum,omitempty"`
+}
+
+// OperationConfig excludes, renames or retags one extracted operation.
+type OperationConfig struct {
+	// Operation is the method and path of the route (e.g., GET /users/{id})
+	Operation string `mapstructure:"operation" yaml:"operation" json:"operation"`
+
+	// Exclude leaves the operation out of the spec
+	Exclude bool `mapstructure:"exclude" yaml:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// OperationID replaces the extracted operationId
+	OperationID string `mapstructure:"operationId" yaml:"operationId,omitempty" json:"operationId,omitempty"`
+
+	// Tags replace the extracted tags
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Produces are the media types the success responses are negotiated
+	// in (e.g., application/json, application/xml, text/csv), replacing the
+	// extracted ones
+	Produces []string `mapstructure:"produces" yaml:"produces,omitempty" json:"produces,omitempty"`
+}
+
+// Operation returns the override for a route, or nil.
+func (g *GenerationConfig) Operation(method, path string) *OperationConfig {
+	key := OperationKey(method, path)
+	for i := range g.Operations {
+		if g.Operations[i].Operation == key {
+			return &g.Operations[i]
+		}
+	}
+	return nil
+}
+
+// OperationKey returns the key operation overrides are matched by.
+func OperationKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// ProfileConfig configures an output profile, such as a partner, internal
+// or admin API, written from the same extraction as the main spec. Unless
+// the profile is internal, operations marked x-internal, properties marked
+// x-sensitive or x-pii, and those extensions themselves are removed from
+// its output.
+type ProfileConfig struct {
+	// Name identifies the profile (e.g., public)
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Output is the path the profile spec is written to
+	Output string `mapstructure:"output" yaml:"output" json:"output"`
+
+	// Info overrides the fields of the main spec's info it sets
+	Info InfoConfig `mapstructure:"info" yaml:"info,omitempty" json:"info,omitempty"`
+
+	// Servers replace the servers of the main spec when set
+	Servers []ServerConfig `mapstructure:"servers" yaml:"servers,omitempty" json:"servers,omitempty"`
+
+	// Paths are glob patterns of the paths to keep (e.g., /partners/**);
+	// empty keeps every path
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Tags are the tags of the operations to keep; empty keeps every
+	// operation
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Internal keeps the operations and properties flagged x-internal,
+	// x-sensitive or x-pii, for specs published inside the organization
+	Internal bool `mapstructure:"internal" yaml:"internal,omitempty" json:"internal,omitempty"`
+
+	// InternalPaths are glob patterns of paths to remove (e.g., /admin/**)
+	InternalPaths []string `mapstructure:"internalPaths" yaml:"internalPaths,omitempty" json:"internalPaths,omitempty"`
+
+	// SensitiveFields are glob patterns of property names to remove (e.g., password, *Token)
+	SensitiveFields []string `mapstructure:"sensitiveFields" yaml:"sensitiveFields,omitempty" json:"sensitiveFields,omitempty"`
+
+	// StripExtensions are additional extensions to remove (e.g., x-go-package)
+	StripExtensions []string `mapstructure:"stripExtensions" yaml:"stripExtensions,omitempty" json:"stripExtensions,omitempty"`
+}
+
+// VariantConfig describes one build or deployment environment of the API.
+// Routes behind a //go:build constraint or an environment variable check
+// appear in the variant only when its tags and environment satisfy them.
+type VariantConfig struct {
+	// Name identifies the variant (e.g., beta)
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Output is the path the variant spec is written to; defaults to the
+	// main output with the name before the extension (openapi.beta.yaml)
+	Output string `mapstructure:"output" yaml:"output,omitempty" json:"output,omitempty"`
+
+	// BuildTags are the Go build tags set for the variant (e.g., beta)
+	BuildTags []string `mapstructure:"buildTags" yaml:"buildTags,omitempty" json:"buildTags,omitempty"`
+
+	// Env are KEY=VALUE environment variables set for the variant (e.g.,
+	// ENABLE_BETA=true); variables not listed are unset
+	Env []string `mapstructure:"env" yaml:"env,omitempty" json:"env,omitempty"`
+}
+
+// OutputPath returns where the variant spec is written, given the main output.
+func (v VariantConfig) OutputPath(output string) string {
+	if v.Output != "" {
+		return v.Output
+	}
+	ext := filepath.Ext(output)
+	return strings.TrimSuffix(output, ext) + "." + v.Name + ext
+}
+
+// EnvMap returns the variant environment as a map.
+func (v VariantConfig) EnvMap() map[string]string {
+	env := make(map[string]string, len(v.Env))
+	for _, entry := range v.Env {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			env[key] = value
+		}
+	}
+	return env
+}
+
+// Variant returns the variant with the given name, or nil.
+func (g *GenerationConfig) Variant(name string) *VariantConfig {
+	for i := range g.Variants {
+		if g.Variants[i].Name == name {
+			return &g.Variants[i]
+		}
+	}
+	return nil
+}
+
+// ServicesConfig chooses how the services of a multi-language repository
+// are generated.
+type ServicesConfig struct {
+	// Strategy is namespace to generate one spec whose component schemas
+	// are prefixed by service (Api.User, Bff.User), split to generate one
+	// spec per service linked through x-linked-specs, or empty to generate
+	// the detected framework only
+	Strategy string `mapstructure:"strategy" yaml:"strategy,omitempty" json:"strategy,omitempty"`
+
+	// List are the services; without it every detected framework of a
+	// distinct language is a service named after the language (Go,
+	// TypeScript)
+	List []ServiceConfig `mapstructure:"list" yaml:"list,omitempty" json:"list,omitempty"`
+}
+
+// ServiceConfig describes one service of a multi-language repository.
+type ServiceConfig struct {
+	// Name prefixes the service's component schemas (e.g., Bff)
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Framework is the service's framework plugin (e.g., express)
+	Framework string `mapstructure:"framework" yaml:"framework" json:"framework"`
+
+	// Paths are glob patterns of the service's source files, relative to
+	// the project root (e.g., bff/**); empty selects every file of the
+	// framework's language
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Output is where the split strategy writes the service's spec;
+	// defaults to the main output with the lowercase name before the
+	// extension (openapi.bff.yaml)
+	Output string `mapstructure:"output" yaml:"output,omitempty" json:"output,omitempty"`
+}
+
+// OutputPath returns where the service spec is written, given the main output.
+func (s ServiceConfig) OutputPath(output string) string {
+	if s.Output != "" {
+		return s.Output
+	}
+	ext := filepath.Ext(output)
+	return strings.TrimSuffix(output, ext) + "." + strings.ToLower(s.Name) + ext
+}
+
+// TenancyConfig configures per-tenant server hosts.
+type TenancyConfig struct {
+	// Detect finds the host in subdomain routing (Route::domain, mux Host,
+	// NestJS host) and tenancy middleware (stancl/tenancy, apartment,
+	// django-tenants, Finbuckle)
+	Detect bool `mapstructure:"detect" yaml:"detect" json:"detect"`
+
+	// Host is the host template, such as {tenant}.api.example.com; it
+	// overrides the detected host
+	Host string `mapstructure:"host" yaml:"host,omitempty" json:"host,omitempty"`
+
+	// Values enumerate the tenants
+	Values []string `mapstructure:"values" yaml:"values,omitempty" json:"values,omitempty"`
+
+	// Default is the tenant used when none is given (default: the first value)
+	Default string `mapstructure:"default" yaml:"default,omitempty" json:"default,omitempty"`
+
+	// Description describes the host variable
+	Description string `mapstructure:"description" yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+// InfrastructureConfig classifies infrastructure endpoints such as health
+// checks, readiness probes and metrics.
+type InfrastructureConfig struct {
+	// Patterns are glob patterns of infrastructure paths (e.g., **/health/**)
+	Patterns []string `mapstructure:"patterns" yaml:"patterns" json:"patterns"`
+
+	// Tag replaces the tags of included infrastructure operations; empty
+	// keeps their tags
+	Tag string `mapstructure:"tag" yaml:"tag" json:"tag"`
+
+	// Include keeps infrastructure operations in the spec
+	Include bool `mapstructure:"include" yaml:"include" json:"include"`
+}
+
+// defaultInfrastructurePaths are the paths of common health check,
+// readiness, liveness and metrics endpoints.
+var defaultInfrastructurePaths = []string{
+	"**/health/**", "**/healthz", "**/healthcheck", "**/ready", "**/readyz", "**/readiness",
+	"**/live", "**/livez", "**/liveness", "**/ping", "**/metrics", "/actuator/**",
+}
+
+// EnvelopeConfig describes a response envelope added by middleware or
+// interceptors.
+type EnvelopeConfig struct {
+	// Mode is wrap to document payloads inside the envelope, unwrap to
+	// document the payload of handlers that return the envelope type, or
+	// empty to leave responses as extracted
+	Mode string `mapstructure:"mode" yaml:"mode,omitempty" json:"mode,omitempty"`
+
+	// Field is the envelope property holding the payload
+	Field string `mapstructure:"field" yaml:"field" json:"field"`
+
+	// Schema is the component schema of the envelope: with wrap it adds the
+	// other envelope properties (meta, errors), with unwrap it is a pattern
+	// of the envelope schemas to unwrap (e.g., ApiResponse*)
+	Schema string `mapstructure:"schema" yaml:"schema,omitempty" json:"schema,omitempty"`
+
+	// Statuses are the response codes the envelope applies to (e.g., 2XX, 400)
+	Statuses []string `mapstructure:"statuses" yaml:"statuses" json:"statuses"`
+
+	// ExcludePaths are glob patterns of paths served without the envelope
+	ExcludePaths []string `mapstructure:"excludePaths" yaml:"excludePaths,omitempty" json:"excludePaths,omitempty"`
+}
+
+// ExistingSpecConfig routes generated operations into one maintained spec.
+// A spec without paths or tags receives the operations no other spec selects.
+type ExistingSpecConfig struct {
+	// Path is the maintained spec to merge into (e.g., specs/public.yaml)
+	Path string `mapstructure:"path" yaml:"path" json:"path"`
+
+	// Output is where the merged spec is written; defaults to Path
+	Output string `mapstructure:"output" yaml:"output,omitempty" json:"output,omitempty"`
+
+	// Paths are glob patterns of the paths this spec receives (e.g., /api/**)
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Tags are the operation tags this spec receives (e.g., public)
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// OutputPath returns where the merged spec is written.
+func (s ExistingSpecConfig) OutputPath() string {
+	if s.Output != "" {
+		return s.Output
+	}
+	return s.Path
+}
+
+// ExternalSpecConfig is a spec owned by another team or a vendor that the
+// paths under a prefix are taken from.
+type ExternalSpecConfig struct {
+	// Prefix is the path prefix the spec owns (e.g., /payments)
+	Prefix string `mapstructure:"prefix" yaml:"prefix" json:"prefix"`
+
+	// Source is the URL or file path of the spec
+	Source string `mapstructure:"source" yaml:"source" json:"source"`
+
+	// Namespace prefixes the names of the spec's schemas and security
+	// schemes (e.g., Payments.Charge); defaults to the prefix in PascalCase
+	Namespace string `mapstructure:"namespace" yaml:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// FailOnConfig sets the extraction quality thresholds past which
+// generation fails. Each is a count (e.g., 10) or a percentage (e.g., 5%);
+// empty disables the check.
+type FailOnConfig struct {
+	// UnparsedFiles is the most source files, or percentage of them, that
+	// may have syntax the parsers could not handle
+	UnparsedFiles string `mapstructure:"unparsedFiles" yaml:"unparsedFiles,omitempty" json:"unparsedFiles,omitempty"`
+
+	// UnresolvedRefs is the most schema references, or percentage of the
+	// component schemas, whose target was not extracted
+	UnresolvedRefs string `mapstructure:"unresolvedRefs" yaml:"unresolvedRefs,omitempty" json:"unresolvedRefs,omitempty"`
+
+	// RoutesWithoutResponses is the most routes, or percentage of them,
+	// for which no response was extracted
+	RoutesWithoutResponses string `mapstructure:"routesWithoutResponses" yaml:"routesWithoutResponses,omitempty" json:"routesWithoutResponses,omitempty"`
+}
+
+// Threshold is a limit on a count, absolute or relative to a total.
+type Threshold struct {
+	// Count is the largest count allowed
+	Count int
+
+	// Percent is the largest percentage of the total allowed, when
+	// Relative is set
+	Percent float64
+
+	// Relative makes the threshold a percentage
+	Relative bool
+}
+
+// ParseThreshold parses a count (10) or a percentage (5%). It returns nil
+// for an empty value.
+func ParseThreshold(value string) (*Threshold, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if percent, ok := strings.CutSuffix(value, "%"); ok {
+		p, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid threshold %q (expected a count or a percentage such as 5%%)", value)
+		}
+		return &Threshold{Percent: p, Relative: true}, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid threshold %q (expected a count or a percentage such as 5%%)", value)
+	}
+	return &Threshold{Count: n}, nil
+}
+
+// Exceeded reports whether count of total passes the threshold.
+func (t *Threshold) Exceeded(count, total int) bool {
+	if t == nil {
+		return false
+	}
+	if !t.Relative {
+		return count > t.Count
+	}
+	return total > 0 && float64(count)*100 > t.Percent*float64(total)
+}
+
+// String formats the threshold as it is configured.
+func (t *Threshold) String() string {
+	if t.Relative {
+		return strconv.FormatFloat(t.Percent, 'f', -1, 64) + "%"
+	}
+	return strconv.Itoa(t.Count)
+}
+
+// LintConfig selects the correctness checks run during generation.
+type LintConfig struct {
+	// PathParams warns when a handler reads path parameters its route does not declare
+	PathParams bool `mapstructure:"pathParams" yaml:"pathParams" json:"pathParams"`
+
+	// DuplicateRoutes warns when a method and path are registered more than once or shadowed
+	DuplicateRoutes bool `mapstructure:"duplicateRoutes" yaml:"duplicateRoutes" json:"duplicateRoutes"`
+
+	// UnusedSchemas warns about component schemas that no operation references
+	UnusedSchemas bool `mapstructure:"unusedSchemas" yaml:"unusedSchemas" json:"unusedSchemas"`
+
+	// Examples warns about examples that do not match their schema, such
+	// as hand-written examples kept by a merge after the schema changed
+	Examples bool `mapstructure:"examples" yaml:"examples" json:"examples"`
+
+	// Complexity warns about operations with very large or deeply nested
+	// bodies and list responses without pagination
+	Complexity ComplexityConfig `mapstructure:"complexity" yaml:"complexity" json:"complexity"`
+
+	// Naming warns about route paths that break API naming conventions
+	Naming NamingConfig `mapstructure:"naming" yaml:"naming" json:"naming"`
+}
+
+// NamingConfig selects the path naming conventions routes are linted against.
+type NamingConfig struct {
+	// KebabCase requires literal path segments to be lowercase kebab-case
+	KebabCase bool `mapstructure:"kebabCase" yaml:"kebabCase" json:"kebabCase"`
+
+	// PluralResources requires collection segments before an identifier to be plural
+	PluralResources bool `mapstructure:"pluralResources" yaml:"pluralResources" json:"pluralResources"`
+
+	// NoVerbs rejects segments starting with a CRUD verb such as get or create
+	NoVerbs bool `mapstructure:"noVerbs" yaml:"noVerbs" json:"noVerbs"`
+
+	// VersionPrefix requires routes to share one version prefix layout
+	VersionPrefix bool `mapstructure:"versionPrefix" yaml:"versionPrefix" json:"versionPrefix"`
+}
+
+// ComplexityConfig configures the operation size and complexity report.
+type ComplexityConfig struct {
+	// Enabled reports operations exceeding the thresholds after generation
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// MaxProperties is the most properties a request or response body may
+	// declare, counting nested objects (0 disables the check)
+	MaxProperties int `mapstructure:"maxProperties" yaml:"maxProperties" json:"maxProperties"`
+
+	// MaxDepth is the deepest a request or response body may nest objects
+	// (0 disables the check)
+	MaxDepth int `mapstructure:"maxDepth" yaml:"maxDepth" json:"maxDepth"`
+
+	// Pagination reports GET operations returning lists without pagination
+	Pagination bool `mapstructure:"pagination" yaml:"pagination" json:"pagination"`
+}
+
+// SDKGroupingConfig configures operation grouping extensions derived from
+// the controller or module that defines each route.
+type SDKGroupingConfig struct {
+	// Enabled adds the grouping extensions to every operation with a known source file
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Extensions lists the extensions to emit (default x-go-package, x-ts-module)
+	Extensions []string `mapstructure:"extensions" yaml:"extensions" json:"extensions"`
+}
+
+// ParameterExamplesConfig configures the path parameter examples taken
+// from tests and seed data.
+type ParameterExamplesConfig struct {
+	// Enabled searches the files for parameter values
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Files are glob patterns of the test, HTTP and seed files searched,
+	// relative to the project root
+	Files []string `mapstructure:"files" yaml:"files" json:"files"`
+}
+
+// defaultParameterExampleFiles are the test, HTTP and seed files searched
+// for parameter examples by default.
+var defaultParameterExampleFiles = []string{
+	"**/*_test.go",
+	"**/*.test.ts",
+	"**/*.spec.ts",
+	"**/*.test.js",
+	"**/*.spec.js",
+	"**/test_*.py",
+	"**/*_test.py",
+	"**/*_spec.rb",
+	"**/*Test.java",
+	"**/*Test.kt",
+	"**/*Tests.cs",
+	"**/*.http",
+	"**/seeds/**",
+	"**/seeders/**",
+	"**/fixtures/**",
+	"**/seed.*",
+	"**/seeds.*",
+}
+
+// SLAConfig documents the service level of the operations it selects.
+type SLAConfig struct {
+	// Paths are glob patterns of the paths covered (e.g., /payments/**);
+	// empty covers every path
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Tags are the tags of the operations covered; empty covers every
+	// operation
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Availability is the availability objective (e.g., 99.95%)
+	Availability string `mapstructure:"availability" yaml:"availability,omitempty" json:"availability,omitempty"`
+
+	// LatencyMs is the response time objective in milliseconds
+	LatencyMs int `mapstructure:"latencyMs" yaml:"latencyMs,omitempty" json:"latencyMs,omitempty"`
+
+	// TimeoutMs is the request timeout in milliseconds, replacing the one
+	// detected from the code
+	TimeoutMs int `mapstructure:"timeoutMs" yaml:"timeoutMs,omitempty" json:"timeoutMs,omitempty"`
+}
+
+// LifecycleConfig configures the lifecycle of operations.
+type LifecycleConfig struct {
+	// Annotations reads @beta, @partner and api2spec:lifecycle comments
+	// above route definitions
+	Annotations bool `mapstructure:"annotations" yaml:"annotations" json:"annotations"`
+
+	// Rules set the lifecycle of the operations they select that code
+	// annotations leave unset
+	Rules []LifecycleRule `mapstructure:"rules" yaml:"rules,omitempty" json:"rules,omitempty"`
+}
+
+// LifecycleRule sets the lifecycle of the operations it selects.
+type LifecycleRule struct {
+	// Paths are glob patterns of the paths covered (e.g., /v2/**); empty
+	// covers every path
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Tags are the tags of the operations covered; empty covers every
+	// operation
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Stage is alpha, beta or ga
+	Stage string `mapstructure:"stage" yaml:"stage,omitempty" json:"stage,omitempty"`
+
+	// Audience is internal, partner or public
+	Audience string `mapstructure:"audience" yaml:"audience,omitempty" json:"audience,omitempty"`
+}
+
+// LifecycleStages and LifecycleAudiences are the values of a lifecycle.
+var (
+	LifecycleStages    = []string{"alpha", "beta", "ga"}
+	LifecycleAudiences = []string{"internal", "partner", "public"}
+)
+
+// FrozenConfig selects frozen contracts of the existing spec.
+type FrozenConfig struct {
+	// Operations are glob patterns of "METHOD /path" or "/path" (e.g.,
+	// "GET /v1/users/**")
+	Operations []string `mapstructure:"operations" yaml:"operations,omitempty" json:"operations,omitempty"`
+
+	// Schemas are glob patterns of component schema names
+	Schemas []string `mapstructure:"schemas" yaml:"schemas,omitempty" json:"schemas,omitempty"`
+}
+
+// StreamingConfig configures streaming operations.
+type StreamingConfig struct {
+	// Annotations reads @streaming, @sse, @long-poll and api2spec:streaming
+	// comments above route definitions
+	Annotations bool `mapstructure:"annotations" yaml:"annotations" json:"annotations"`
+
+	// Rules mark the operations they select that code annotations leave
+	// unmarked
+	Rules []StreamingRule `mapstructure:"rules" yaml:"rules,omitempty" json:"rules,omitempty"`
+}
+
+// StreamingRule marks the operations it selects as streaming.
+type StreamingRule struct {
+	// Paths are glob patterns of the paths covered (e.g., /events/**);
+	// empty covers every path
+	Paths []string `mapstructure:"paths" yaml:"paths,omitempty" json:"paths,omitempty"`
+
+	// Tags are the tags of the operations covered; empty covers every
+	// operation
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Mode is stream (chunked), sse or long-poll
+	Mode string `mapstructure:"mode" yaml:"mode" json:"mode"`
+
+	// MediaType is the content type of the streamed response (default
+	// application/octet-stream for stream and text/event-stream for sse)
+	MediaType string `mapstructure:"mediaType" yaml:"mediaType,omitempty" json:"mediaType,omitempty"`
+}
+
+// StreamingModes are the modes of a streaming operation.
+var StreamingModes = []string{"stream", "sse", "long-poll"}
+
+// OptimizeSizeConfig configures the spec size optimization.
+type OptimizeSizeConfig struct {
+	// Enabled strips descriptions and examples and merges structurally
+	// identical schemas before the spec is written
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Budget is the largest spec size allowed (e.g., 6MB, 512KiB);
+	// generation fails when the written spec would exceed it
+	Budget string `mapstructure:"budget" yaml:"budget,omitempty" json:"budget,omitempty"`
+}
+
+// sizeUnits are the multipliers of the size units accepted in budgets.
+var sizeUnits = map[string]int{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+}
+
+// BudgetBytes returns the budget in bytes, or 0 when no budget is set.
+func (o OptimizeSizeConfig) BudgetBytes() (int, error) {
+	budget := strings.TrimSpace(o.Budget)
+	if budget == "" {
+		return 0, nil
+	}
+	number := strings.TrimRight(budget, "BbGgIiKkMm ")
+	multiplier, ok := sizeUnits[strings.ToUpper(strings.TrimSpace(budget[len(number):]))]
+	value, err := strconv.ParseFloat(number, 64)
+	if !ok || err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid size %q (expected a size such as 6MB or 512KiB)", o.Budget)
+	}
+	return int(value * float64(multiplier)), nil
+}
+
+// BackstageConfig configures the Backstage catalog-info.yaml API entity.
+type BackstageConfig struct {
+	// Enabled creates or updates the catalog file after generation
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Path is the catalog file to write (default catalog-info.yaml)
+	Path string `mapstructure:"path" yaml:"path" json:"path"`
+
+	// Name is the entity name (default derived from openapi.info.title)
+	Name string `mapstructure:"name" yaml:"name,omitempty" json:"name,omitempty"`
+
+	// Owner is the owning group or user
+	Owner string `mapstructure:"owner" yaml:"owner" json:"owner"`
+
+	// Lifecycle is the API lifecycle (default production)
+	Lifecycle string `mapstructure:"lifecycle" yaml:"lifecycle" json:"lifecycle"`
+
+	// System is the system the API belongs to
+	System string `mapstructure:"system" yaml:"system,omitempty" json:"system,omitempty"`
+
+	// TechDocsRef sets the backstage.io/techdocs-ref annotation (e.g., dir:.)
+	TechDocsRef string `mapstructure:"techdocsRef" yaml:"techdocsRef,omitempty" json:"techdocsRef,omitempty"`
+}
+
+// ManifestConfig configures the integrity manifest of the generated spec.
+type ManifestConfig struct {
+	// Enabled writes <output>.manifest.json with the spec's SHA-256 digest
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Signer signs the manifest with an external tool (cosign, minisign)
+	Signer string `mapstructure:"signer" yaml:"signer,omitempty" json:"signer,omitempty"`
+
+	// Key is the signer's private key (keyless cosign or the minisign default when empty)
+	Key string `mapstructure:"key" yaml:"key,omitempty" json:"key,omitempty"`
+}
+
+// DefaultResponseConfig configures the response of operations with none
+// inferred.
+type DefaultResponseConfig struct {
+	// Status is the status code, e.g. 200, 2XX or default
+	Status string `mapstructure:"status" yaml:"status" json:"status"`
+
+	// Description is the description template; {method}, {path} and
+	// {operationId} are replaced with the operation's
+	Description string `mapstructure:"description" yaml:"description" json:"description"`
+
+	// Schema documents an empty application/json schema
+	Schema bool `mapstructure:"schema" yaml:"schema" json:"schema"`
+}
+
+// SourceMapConfig configures the schema source map of the generated spec.
+type SourceMapConfig struct {
+	// Enabled writes <output>.sourcemap.json mapping schema pointers to source lines
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+}
+
+// DecisionsConfig configures the decisions log of a generate run.
+type DecisionsConfig struct {
+	// Enabled writes <output>.decisions.json next to the spec
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+}
+
+// SourceLinksConfig configures operation links to the hosted source code.
+type SourceLinksConfig struct {
+	// Enabled turns on externalDocs source links
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Remote is the git remote used to derive the hosting URL
+	Remote string `mapstructure:"remote" yaml:"remote,omitempty" json:"remote,omitempty"`
+
+	// URLTemplate overrides the derived URL; supports {sha}, {path}, and {line}
+	URLTemplate string `mapstructure:"urlTemplate" yaml:"urlTemplate,omitempty" json:"urlTemplate,omitempty"`
 }
 
 // WatchConfig contains file watching configuration.
@@ -185,6 +1127,25 @@ type WatchConfig struct {
 	OnChange string `mapstructure:"onChange" yaml:"onChange" json:"onChange"`
 }
 
+// PublishConfig contains registry publishing configuration.
+type PublishConfig struct {
+	// Targets are the registries the spec is published to
+	Targets []publish.Target `mapstructure:"targets" yaml:"targets" json:"targets"`
+}
+
+// PolicyConfig contains API governance rules.
+type PolicyConfig struct {
+	// Rules are the expressions every operation, parameter or schema must satisfy
+	Rules []policy.Rule `mapstructure:"rules" yaml:"rules,omitempty" json:"rules,omitempty"`
+
+	// Suppressions exempt operations or schemas from rules
+	Suppressions []policy.Suppression `mapstructure:"suppressions" yaml:"suppressions,omitempty" json:"suppressions,omitempty"`
+
+	// FailOn is the least severe violation that fails the run (error,
+	// warning, info or never)
+	FailOn string `mapstructure:"failOn" yaml:"failOn,omitempty" json:"failOn,omitempty"`
+}
+
 // configFileNames is the list of config file names to search for (in order).
 var configFileNames = []string{
 	"api2spec.yaml",
@@ -241,6 +1202,12 @@ var supportedFormats = []string{
 	"json",
 }
 
+// supportedSigners is the list of supported manifest signers.
+var supportedSigners = []string{
+	"cosign",
+	"minisign",
+}
+
 // supportedModes is the list of supported generation modes.
 var supportedModes = []string{
 	"full",
@@ -248,8 +1215,80 @@ var supportedModes = []string{
 	"schemas-only",
 }
 
-// ErrConfigNotFound is returned when no config file is found.
-var ErrConfigNotFound = errors.New("config file not found")
+// supportedWildcards is the list of supported catch-all route policies.
+var supportedWildcards = []string{
+	"template",
+	"exclude",
+}
+
+// supportedMethodAliases is the list of supported method alias policies.
+var supportedMethodAliases = []string{
+	"keep",
+	"collapse",
+}
+
+// VersionSources are the sources openapi.info.versionFrom can name.
+var VersionSources = []string{
+	"git-tag",
+	"package.json",
+	"composer.json",
+	"VERSION",
+}
+
+// supportedDanglingRefs is the list of supported dangling reference policies.
+var supportedDanglingRefs = []string{
+	"stub",
+	"fail",
+	"ignore",
+}
+
+// supportedWebhookReceivers is the list of supported webhook receiver policies.
+var supportedWebhookReceivers = []string{
+	"mark",
+	"separate",
+	"exclude",
+}
+
+// supportedMethodBodies is the list of supported GET/HEAD/DELETE body policies.
+var supportedMethodBodies = []string{
+	"warn",
+	"document",
+	"query",
+	"drop",
+}
+
+// supportedSchemaTypes is the list of OpenAPI types a type mapping may use.
+var supportedSchemaTypes = []string{
+	"string",
+	"number",
+	"integer",
+	"boolean",
+	"object",
+	"array",
+}
+
+// supportedCodeSampleLanguages is the list of languages of code samples.
+var supportedCodeSampleLanguages = []string{
+	"curl",
+	"javascript",
+	"python",
+	"go",
+}
+
+// serviceNameRegex matches service names, which prefix component names.
+var serviceNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// prefixesOverlap reports whether one path prefix contains the other.
+func prefixesOverlap(a, b string) bool {
+	a, b = strings.TrimSuffix(a, "/")+"/", strings.TrimSuffix(b, "/")+"/"
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+// responseStatus matches a response status code, range or default.
+var responseStatus = regexp.MustCompile(`^([1-5][0-9][0-9]|[1-5]XX|default)$`)
+
+// ErrConfigNotFound is returned when no config file is found.
+var ErrConfigNotFound = errors.New("config file not found")
 
 // ValidationError represents a configuration validation error.
 type ValidationError struct {
@@ -298,7 +1337,7 @@ func Default() *Config {
 		},
 		Source: SourceConfig{
 			Paths:   []string{"."},
-			Include: []string{"**/*.go", "**/*.ts", "**/*.js", "**/*.py", "**/*.rs", "**/*.java", "**/*.kt", "**/*.rb", "**/*.php", "**/*.ex", "**/*.exs", "**/*.cs", "**/*.gleam", "**/*.cpp", "**/*.hpp", "**/*.h", "**/*.cc", "**/*.cxx", "**/*.scala", "**/*.swift", "**/*.hs", "**/*.yaml", "**/*.yml"},
+			Include: []string{"**/*.go", "**/*.ts", "**/*.tsx", "**/*.js", "**/*.py", "**/*.rs", "**/*.java", "**/*.kt", "**/*.rb", "**/*.php", "**/*.ex", "**/*.exs", "**/*.cs", "**/*.gleam", "**/*.cpp", "**/*.hpp", "**/*.h", "**/*.cc", "**/*.cxx", "**/*.scala", "**/*.swift", "**/*.hs", "**/*.dart", "**/*.yaml", "**/*.yml"},
 			Exclude: []string{
 				"vendor/**",
 				"**/*_test.go",
@@ -308,7 +1347,8 @@ func Default() *Config {
 				"dist/**",
 				"dist-newstyle/**", // Haskell (Cabal) build artifacts
 				"build/**",
-				".build/**", // Swift Package Manager build artifacts
+				".build/**",     // Swift Package Manager build artifacts
+				".dart_tool/**", // Dart and Dart Frog build artifacts
 				"target/**",
 				"**/*.pb.go",
 				"**/mock*.go",
@@ -320,12 +1360,87 @@ func Default() *Config {
 			Merge:            false,
 			StrictMode:       false,
 			DefaultResponses: []string{"200", "400", "500"},
+			DefaultResponse: DefaultResponseConfig{
+				Status:      "200",
+				Description: "Successful response",
+			},
+			SourceLinks: SourceLinksConfig{
+				Remote: "origin",
+			},
+			Backstage: BackstageConfig{
+				Path:      "catalog-info.yaml",
+				Owner:     "unknown",
+				Lifecycle: "production",
+			},
+			SDKGrouping: SDKGroupingConfig{
+				Extensions: []string{"x-go-package", "x-ts-module"},
+			},
+			Lint: LintConfig{
+				PathParams:      true,
+				DuplicateRoutes: true,
+				UnusedSchemas:   true,
+				Examples:        true,
+				Complexity: ComplexityConfig{
+					MaxProperties: 50,
+					MaxDepth:      5,
+					Pagination:    true,
+				},
+			},
+			Wildcards:           "template",
+			MethodAliases:       "keep",
+			DanglingRefs:        "stub",
+			WebhookReceivers:    "mark",
+			MethodBodies:        "warn",
+			PathServers:         true,
+			RequestHeaders:      true,
+			ConditionalRequests: true,
+			CORS:                true,
+			RawBodies:           true,
+			Timeouts:            true,
+			Scopes:              true,
+			AccessModes:         true,
+			Links:               true,
+			ParameterExamples: ParameterExamplesConfig{
+				Enabled: true,
+				Files:   defaultParameterExampleFiles,
+			},
+			Lifecycle: LifecycleConfig{
+				Annotations: true,
+			},
+			Streaming: StreamingConfig{
+				Annotations: true,
+			},
+			Tenancy: TenancyConfig{
+				Detect: true,
+			},
+			Infrastructure: InfrastructureConfig{
+				Patterns: slices.Clone(defaultInfrastructurePaths),
+				Tag:      "infrastructure",
+			},
+			Envelope: EnvelopeConfig{
+				Field:    "data",
+				Statuses: []string{"2XX"},
+			},
 		},
 		Watch: WatchConfig{
 			Enabled:  false,
 			Debounce: 500,
 		},
+		Policy: PolicyConfig{
+			FailOn: "error",
+		},
+	}
+}
+
+// FindFile returns the name of the config file Load uses in the current
+// directory, or "" if there is none.
+func FindFile() string {
+	for _, name := range configFileNames {
+		if _, err := os.Stat(name); err == nil {
+			return name
+		}
 	}
+	return ""
 }
 
 // Load loads the configuration from a file.
@@ -337,6 +1452,12 @@ func Default() *Config {
 //
 // If configPath is provided, it will use that path instead.
 func Load(configPath string) (*Config, error) {
+	return load(configPath, "")
+}
+
+// load loads the configuration from a file. When root is set, the file and
+// the base configs it extends must be files inside root.
+func load(configPath, root string) (*Config, error) {
 	v := viper.New()
 
 	// Set defaults
@@ -347,18 +1468,12 @@ func Load(configPath string) (*Config, error) {
 		v.SetConfigFile(configPath)
 	} else {
 		// Search for config files in order
-		found := false
-		for _, name := range configFileNames {
-			if _, err := os.Stat(name); err == nil {
-				v.SetConfigFile(name)
-				found = true
-				break
-			}
-		}
-		if !found {
+		name := FindFile()
+		if name == "" {
 			// Return default config if no file found
 			return Default(), nil
 		}
+		v.SetConfigFile(name)
 	}
 
 	if err := v.ReadInConfig(); err != nil {
@@ -369,6 +1484,12 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	if extends := v.GetStringSlice("extends"); len(extends) > 0 {
+		if err := applyBases(v, extends, root); err != nil {
+			return nil, err
+		}
+	}
+
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
@@ -377,6 +1498,33 @@ func Load(configPath string) (*Config, error) {
 	return &cfg, nil
 }
 
+// applyBases rebuilds v from the defaults, the base configs the config
+// file extends, in order, and the config file itself, so that the file
+// overrides its bases and later bases override earlier ones. When root is
+// set, the bases must be files inside it.
+func applyBases(v *viper.Viper, extends []string, root string) error {
+	file := v.ConfigFileUsed()
+	bases, err := loadBases(extends, file, []string{file}, root)
+	if err != nil {
+		return fmt.Errorf("failed to extend config: %w", err)
+	}
+
+	local := viper.New()
+	local.SetConfigFile(file)
+	if err := local.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	for _, settings := range bases {
+		if err := v.MergeConfigMap(settings); err != nil {
+			return fmt.Errorf("failed to extend config: %w", err)
+		}
+	}
+	if err := v.MergeConfigMap(local.AllSettings()); err != nil {
+		return fmt.Errorf("failed to extend config: %w", err)
+	}
+	return nil
+}
+
 // LoadFromPath loads the configuration from a specific directory.
 func LoadFromPath(dir string) (*Config, error) {
 	for _, name := range configFileNames {
@@ -388,6 +1536,28 @@ func LoadFromPath(dir string) (*Config, error) {
 	return Default(), nil
 }
 
+// LoadConfined loads the configuration of an untrusted repository in dir,
+// such as one uploaded to the serve command: the config file and the base
+// configs it extends must be files inside dir, and remote bases are
+// rejected.
+func LoadConfined(dir string) (*Config, error) {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+	for _, name := range configFileNames {
+		path := filepath.Join(root, name)
+		if _, err := os.Lstat(path); err != nil {
+			continue
+		}
+		if err := confinePath(path, root); err != nil {
+			return nil, fmt.Errorf("config file %w", err)
+		}
+		return load(path, root)
+	}
+	return Default(), nil
+}
+
 // setDefaults sets the default values for viper.
 func setDefaults(v *viper.Viper) {
 	v.SetDefault("framework", "auto")
@@ -397,7 +1567,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("openapi.info.title", "API")
 	v.SetDefault("openapi.info.version", "1.0.0")
 	v.SetDefault("source.paths", []string{"."})
-	v.SetDefault("source.include", []string{"**/*.go", "**/*.ts", "**/*.js", "**/*.py", "**/*.rs", "**/*.java", "**/*.kt", "**/*.rb", "**/*.php", "**/*.ex", "**/*.exs", "**/*.cs", "**/*.gleam", "**/*.cpp", "**/*.hpp", "**/*.h", "**/*.cc", "**/*.cxx", "**/*.scala", "**/*.swift", "**/*.hs", "**/*.yaml", "**/*.yml"})
+	v.SetDefault("source.include", []string{"**/*.go", "**/*.ts", "**/*.tsx", "**/*.js", "**/*.py", "**/*.rs", "**/*.java", "**/*.kt", "**/*.rb", "**/*.php", "**/*.ex", "**/*.exs", "**/*.cs", "**/*.gleam", "**/*.cpp", "**/*.hpp", "**/*.h", "**/*.cc", "**/*.cxx", "**/*.scala", "**/*.swift", "**/*.hs", "**/*.dart", "**/*.yaml", "**/*.yml"})
 	v.SetDefault("source.exclude", []string{
 		"vendor/**",
 		"**/*_test.go",
@@ -407,26 +1577,84 @@ func setDefaults(v *viper.Viper) {
 		"dist/**",
 		"dist-newstyle/**", // Haskell (Cabal) build artifacts
 		"build/**",
-		".build/**", // Swift Package Manager build artifacts
+		".build/**",     // Swift Package Manager build artifacts
+		".dart_tool/**", // Dart and Dart Frog build artifacts
 		"target/**",
 		"**/*.pb.go",
 		"**/mock*.go",
 		"**/mocks/**",
 	})
+	v.SetDefault("source.followSymlinks", false)
 	v.SetDefault("generation.mode", "full")
 	v.SetDefault("generation.merge", false)
 	v.SetDefault("generation.strictMode", false)
 	v.SetDefault("generation.defaultResponses", []string{"200", "400", "500"})
+	v.SetDefault("generation.defaultResponse.status", "200")
+	v.SetDefault("generation.defaultResponse.description", "Successful response")
+	v.SetDefault("generation.sourceLinks.enabled", false)
+	v.SetDefault("generation.sourceLinks.remote", "origin")
+	v.SetDefault("generation.manifest.enabled", false)
+	v.SetDefault("generation.sourceMap.enabled", false)
+	v.SetDefault("generation.backstage.enabled", false)
+	v.SetDefault("generation.backstage.path", "catalog-info.yaml")
+	v.SetDefault("generation.backstage.owner", "unknown")
+	v.SetDefault("generation.backstage.lifecycle", "production")
+	v.SetDefault("generation.sdkGrouping.enabled", false)
+	v.SetDefault("generation.sdkGrouping.extensions", []string{"x-go-package", "x-ts-module"})
+	v.SetDefault("generation.lint.pathParams", true)
+	v.SetDefault("generation.lint.duplicateRoutes", true)
+	v.SetDefault("generation.lint.unusedSchemas", true)
+	v.SetDefault("generation.lint.examples", true)
+	v.SetDefault("generation.lint.complexity.enabled", false)
+	v.SetDefault("generation.lint.complexity.maxProperties", 50)
+	v.SetDefault("generation.lint.complexity.maxDepth", 5)
+	v.SetDefault("generation.lint.complexity.pagination", true)
+	v.SetDefault("generation.lint.naming.kebabCase", false)
+	v.SetDefault("generation.lint.naming.pluralResources", false)
+	v.SetDefault("generation.lint.naming.noVerbs", false)
+	v.SetDefault("generation.lint.naming.versionPrefix", false)
+	v.SetDefault("generation.pathServers", true)
+	v.SetDefault("generation.requestHeaders", true)
+	v.SetDefault("generation.conditionalRequests", true)
+	v.SetDefault("generation.cors", true)
+	v.SetDefault("generation.rawBodies", true)
+	v.SetDefault("generation.parameterExamples.enabled", true)
+	v.SetDefault("generation.parameterExamples.files", defaultParameterExampleFiles)
+	v.SetDefault("generation.timeouts", true)
+	v.SetDefault("generation.scopes", true)
+	v.SetDefault("generation.lifecycle.annotations", true)
+	v.SetDefault("generation.streaming.annotations", true)
+	v.SetDefault("generation.tenancy.detect", true)
+	v.SetDefault("generation.infrastructure.patterns", defaultInfrastructurePaths)
+	v.SetDefault("generation.infrastructure.tag", "infrastructure")
+	v.SetDefault("generation.infrastructure.include", false)
+	v.SetDefault("generation.accessModes", true)
+	v.SetDefault("generation.schemaVariants", false)
+	v.SetDefault("generation.links", true)
+	v.SetDefault("generation.strictObjects", false)
+	v.SetDefault("generation.operationHashes", false)
+	v.SetDefault("generation.inferEnums", false)
+	v.SetDefault("generation.mountPrefixes.tags", false)
+	v.SetDefault("generation.mountPrefixes.operationIds", false)
+	v.SetDefault("generation.envelope.field", "data")
+	v.SetDefault("generation.envelope.statuses", []string{"2XX"})
+	v.SetDefault("generation.wildcards", "template")
+	v.SetDefault("generation.methodAliases", "keep")
+	v.SetDefault("generation.danglingRefs", "stub")
+	v.SetDefault("generation.webhookReceivers", "mark")
+	v.SetDefault("generation.methodBodies", "warn")
 	v.SetDefault("watch.enabled", false)
 	v.SetDefault("watch.debounce", 500)
+	v.SetDefault("policy.failOn", "error")
 }
 
 // Validate validates the configuration.
 func (c *Config) Validate() error {
 	var errs ValidationErrors
 
-	// Validate framework
-	if c.Framework != "" && !contains(supportedFrameworks, c.Framework) {
+	// Validate framework; names from framework definitions are only known
+	// once the definitions are loaded, so those are checked on lookup
+	if c.Framework != "" && len(c.FrameworkDefinitions) == 0 && !contains(supportedFrameworks, c.Framework) {
 		errs = append(errs, ValidationError{
 			Field:   "framework",
 			Message: fmt.Sprintf("unsupported framework %q, must be one of: %s", c.Framework, strings.Join(supportedFrameworks, ", ")),
@@ -449,6 +1677,499 @@ func (c *Config) Validate() error {
 		})
 	}
 
+	// Validate wildcard policy
+	if c.Generation.Wildcards != "" && !contains(supportedWildcards, c.Generation.Wildcards) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.wildcards",
+			Message: fmt.Sprintf("unsupported wildcards policy %q, must be one of: %s", c.Generation.Wildcards, strings.Join(supportedWildcards, ", ")),
+		})
+	}
+
+	// Validate method alias policy
+	if c.Generation.MethodAliases != "" && !contains(supportedMethodAliases, c.Generation.MethodAliases) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.methodAliases",
+			Message: fmt.Sprintf("unsupported methodAliases policy %q, must be one of: %s", c.Generation.MethodAliases, strings.Join(supportedMethodAliases, ", ")),
+		})
+	}
+
+	// Validate dangling reference policy
+	if c.Generation.DanglingRefs != "" && !contains(supportedDanglingRefs, c.Generation.DanglingRefs) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.danglingRefs",
+			Message: fmt.Sprintf("unsupported danglingRefs policy %q, must be one of: %s", c.Generation.DanglingRefs, strings.Join(supportedDanglingRefs, ", ")),
+		})
+	}
+
+	// Validate webhook receiver policy
+	if c.Generation.WebhookReceivers != "" && !contains(supportedWebhookReceivers, c.Generation.WebhookReceivers) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.webhookReceivers",
+			Message: fmt.Sprintf("unsupported webhookReceivers policy %q, must be one of: %s", c.Generation.WebhookReceivers, strings.Join(supportedWebhookReceivers, ", ")),
+		})
+	}
+
+	// Validate GET/HEAD/DELETE body policy
+	if c.Generation.MethodBodies != "" && !contains(supportedMethodBodies, c.Generation.MethodBodies) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.methodBodies",
+			Message: fmt.Sprintf("unsupported methodBodies policy %q, must be one of: %s", c.Generation.MethodBodies, strings.Join(supportedMethodBodies, ", ")),
+		})
+	}
+
+	// Validate complexity thresholds
+	if complexity := c.Generation.Lint.Complexity; complexity.MaxProperties < 0 || complexity.MaxDepth < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "generation.lint.complexity",
+			Message: "maxProperties and maxDepth must not be negative",
+		})
+	}
+
+	// Validate source link template
+	if tmpl := c.Generation.SourceLinks.URLTemplate; tmpl != "" && !strings.Contains(tmpl, "{path}") {
+		errs = append(errs, ValidationError{
+			Field:   "generation.sourceLinks.urlTemplate",
+			Message: fmt.Sprintf("template %q must contain a {path} placeholder", tmpl),
+		})
+	}
+
+	// Validate manifest signer
+	if signer := c.Generation.Manifest.Signer; signer != "" && !contains(supportedSigners, signer) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.manifest.signer",
+			Message: fmt.Sprintf("unsupported signer %q, must be one of: %s", signer, strings.Join(supportedSigners, ", ")),
+		})
+	}
+
+	// Validate SDK grouping extensions
+	for i, ext := range c.Generation.SDKGrouping.Extensions {
+		if !strings.HasPrefix(ext, "x-") {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.sdkGrouping.extensions[%d]", i),
+				Message: fmt.Sprintf("extension %q must start with x-", ext),
+			})
+		}
+	}
+
+	// Validate parameter example files
+	for i, pattern := range c.Generation.ParameterExamples.Files {
+		if !doublestar.ValidatePattern(pattern) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.parameterExamples.files[%d]", i),
+				Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+			})
+		}
+	}
+
+	// Validate service levels
+	for i, sla := range c.Generation.SLAs {
+		field := fmt.Sprintf("generation.slas[%d]", i)
+		if sla.Availability == "" && sla.LatencyMs == 0 && sla.TimeoutMs == 0 {
+			errs = append(errs, ValidationError{Field: field, Message: "availability, latencyMs or timeoutMs is required"})
+		}
+		if sla.Availability != "" {
+			percent, err := strconv.ParseFloat(strings.TrimSuffix(sla.Availability, "%"), 64)
+			if err != nil || percent <= 0 || percent > 100 {
+				errs = append(errs, ValidationError{
+					Field:   field + ".availability",
+					Message: fmt.Sprintf("invalid availability %q (expected a percentage such as 99.9%%)", sla.Availability),
+				})
+			}
+		}
+		if sla.LatencyMs < 0 {
+			errs = append(errs, ValidationError{Field: field + ".latencyMs", Message: "latencyMs must not be negative"})
+		}
+		if sla.TimeoutMs < 0 {
+			errs = append(errs, ValidationError{Field: field + ".timeoutMs", Message: "timeoutMs must not be negative"})
+		}
+		for j, pattern := range sla.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+	}
+
+	// Validate lifecycle rules
+	for i, rule := range c.Generation.Lifecycle.Rules {
+		field := fmt.Sprintf("generation.lifecycle.rules[%d]", i)
+		if rule.Stage == "" && rule.Audience == "" {
+			errs = append(errs, ValidationError{Field: field, Message: "stage or audience is required"})
+		}
+		if rule.Stage != "" && !slices.Contains(LifecycleStages, rule.Stage) {
+			errs = append(errs, ValidationError{
+				Field:   field + ".stage",
+				Message: fmt.Sprintf("invalid stage %q (expected %s)", rule.Stage, strings.Join(LifecycleStages, ", ")),
+			})
+		}
+		if rule.Audience != "" && !slices.Contains(LifecycleAudiences, rule.Audience) {
+			errs = append(errs, ValidationError{
+				Field:   field + ".audience",
+				Message: fmt.Sprintf("invalid audience %q (expected %s)", rule.Audience, strings.Join(LifecycleAudiences, ", ")),
+			})
+		}
+		for j, pattern := range rule.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+	}
+
+	// Validate frozen contracts
+	for i, pattern := range c.Generation.Frozen.Operations {
+		path := pattern
+		if _, after, ok := strings.Cut(pattern, " "); ok {
+			path = after
+		}
+		if !strings.HasPrefix(path, "/") || !doublestar.ValidatePattern(path) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.frozen.operations[%d]", i),
+				Message: fmt.Sprintf("invalid operation pattern %q (expected \"METHOD /path\" or \"/path\")", pattern),
+			})
+		}
+	}
+	for i, pattern := range c.Generation.Frozen.Schemas {
+		if !doublestar.ValidatePattern(pattern) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.frozen.schemas[%d]", i),
+				Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+			})
+		}
+	}
+
+	// Validate streaming rules
+	for i, rule := range c.Generation.Streaming.Rules {
+		field := fmt.Sprintf("generation.streaming.rules[%d]", i)
+		if !slices.Contains(StreamingModes, rule.Mode) {
+			errs = append(errs, ValidationError{
+				Field:   field + ".mode",
+				Message: fmt.Sprintf("invalid mode %q (expected %s)", rule.Mode, strings.Join(StreamingModes, ", ")),
+			})
+		}
+		if rule.MediaType != "" {
+			if _, _, err := mime.ParseMediaType(rule.MediaType); err != nil {
+				errs = append(errs, ValidationError{
+					Field:   field + ".mediaType",
+					Message: fmt.Sprintf("invalid media type %q: %v", rule.MediaType, err),
+				})
+			}
+		}
+		for j, pattern := range rule.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+	}
+
+	// Validate output profiles
+	profileNames := make(map[string]bool)
+	for i, profile := range c.Generation.Profiles {
+		field := fmt.Sprintf("generation.profiles[%d]", i)
+		if profile.Name == "" {
+			errs = append(errs, ValidationError{Field: field + ".name", Message: "profile name is required"})
+		} else if profileNames[profile.Name] {
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("duplicate profile %q", profile.Name)})
+		}
+		profileNames[profile.Name] = true
+		if profile.Output == "" {
+			errs = append(errs, ValidationError{Field: field + ".output", Message: "profile output path is required"})
+		} else if profile.Output == c.Output {
+			errs = append(errs, ValidationError{Field: field + ".output", Message: "profile output must differ from the main output"})
+		}
+		for j, ext := range profile.StripExtensions {
+			if !strings.HasPrefix(ext, "x-") {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.stripExtensions[%d]", field, j),
+					Message: fmt.Sprintf("extension %q must start with x-", ext),
+				})
+			}
+		}
+		for j, pattern := range profile.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+		for j, server := range profile.Servers {
+			if server.URL == "" {
+				errs = append(errs, ValidationError{Field: fmt.Sprintf("%s.servers[%d].url", field, j), Message: "server URL is required"})
+			}
+		}
+	}
+
+	// Validate spec variants
+	variantNames := make(map[string]bool)
+	for i, variant := range c.Generation.Variants {
+		field := fmt.Sprintf("generation.variants[%d]", i)
+		if variant.Name == "" {
+			errs = append(errs, ValidationError{Field: field + ".name", Message: "variant name is required"})
+		} else if variantNames[variant.Name] {
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("duplicate variant %q", variant.Name)})
+		}
+		variantNames[variant.Name] = true
+		for j, entry := range variant.Env {
+			if key, _, ok := strings.Cut(entry, "="); !ok || key == "" {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.env[%d]", field, j),
+					Message: fmt.Sprintf("environment entry %q must be KEY=VALUE", entry),
+				})
+			}
+		}
+	}
+
+	// Validate multi-language services
+	switch c.Generation.Services.Strategy {
+	case "", "namespace", "split":
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "generation.services.strategy",
+			Message: fmt.Sprintf("invalid services strategy %q, must be namespace or split", c.Generation.Services.Strategy),
+		})
+	}
+	serviceNames := make(map[string]bool)
+	for i, service := range c.Generation.Services.List {
+		field := fmt.Sprintf("generation.services.list[%d]", i)
+		switch {
+		case !serviceNameRegex.MatchString(service.Name):
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("service name %q must be a letter followed by letters, digits or underscores", service.Name)})
+		case serviceNames[service.Name]:
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("duplicate service %q", service.Name)})
+		}
+		serviceNames[service.Name] = true
+		if service.Framework == "" {
+			errs = append(errs, ValidationError{Field: field + ".framework", Message: "framework is required"})
+		} else if len(c.FrameworkDefinitions) == 0 && !contains(supportedFrameworks, service.Framework) {
+			errs = append(errs, ValidationError{Field: field + ".framework", Message: fmt.Sprintf("unsupported framework %q", service.Framework)})
+		}
+		for j, pattern := range service.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+	}
+
+	// Validate parameter naming style
+	switch c.Generation.ParameterCase {
+	case "", "camel", "snake":
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "generation.parameterCase",
+			Message: fmt.Sprintf("invalid parameter case %q, must be camel or snake", c.Generation.ParameterCase),
+		})
+	}
+
+	for i, language := range c.Generation.CodeSamples {
+		if !contains(supportedCodeSampleLanguages, language) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.codeSamples[%d]", i),
+				Message: fmt.Sprintf("unsupported code sample language %q, must be one of: %s", language, strings.Join(supportedCodeSampleLanguages, ", ")),
+			})
+		}
+	}
+
+	if c.Generation.MaxInlineDepth < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "generation.maxInlineDepth",
+			Message: "max inline depth must not be negative",
+		})
+	}
+
+	// Validate extraction quality thresholds
+	failOn := c.Generation.FailOn
+	for _, threshold := range []struct{ field, value string }{
+		{"unparsedFiles", failOn.UnparsedFiles},
+		{"unresolvedRefs", failOn.UnresolvedRefs},
+		{"routesWithoutResponses", failOn.RoutesWithoutResponses},
+	} {
+		if _, err := ParseThreshold(threshold.value); err != nil {
+			errs = append(errs, ValidationError{Field: "generation.failOn." + threshold.field, Message: err.Error()})
+		}
+	}
+
+	if _, err := c.Generation.OptimizeSize.BudgetBytes(); err != nil {
+		errs = append(errs, ValidationError{
+			Field:   "generation.optimizeSize.budget",
+			Message: err.Error(),
+		})
+	}
+
+	// Validate path ordering
+	switch c.Generation.PathOrder {
+	case "", "alphabetical", "tag", "source", "crud":
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "generation.pathOrder",
+			Message: fmt.Sprintf("invalid path order %q, must be alphabetical, tag, source, or crud", c.Generation.PathOrder),
+		})
+	}
+
+	// Validate default response
+	if status := c.Generation.DefaultResponse.Status; status != "" && !responseStatus.MatchString(status) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.defaultResponse.status",
+			Message: fmt.Sprintf("invalid status %q, must be a status code, a range such as 2XX, or default", status),
+		})
+	}
+
+	// Validate response envelope
+	envelope := c.Generation.Envelope
+	switch envelope.Mode {
+	case "", "wrap", "unwrap":
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "generation.envelope.mode",
+			Message: fmt.Sprintf("invalid envelope mode %q, must be wrap or unwrap", envelope.Mode),
+		})
+	}
+	if envelope.Mode != "" && envelope.Field == "" {
+		errs = append(errs, ValidationError{Field: "generation.envelope.field", Message: "payload field is required"})
+	}
+	if envelope.Mode == "unwrap" && envelope.Schema == "" {
+		errs = append(errs, ValidationError{Field: "generation.envelope.schema", Message: "unwrap needs the envelope schema name or pattern"})
+	}
+
+	// Validate tenancy
+	tenancy := c.Generation.Tenancy
+	if tenancy.Host != "" && !strings.Contains(tenancy.Host, "{") {
+		errs = append(errs, ValidationError{
+			Field:   "generation.tenancy.host",
+			Message: fmt.Sprintf("host %q has no {variable}", tenancy.Host),
+		})
+	}
+	if tenancy.Default != "" && len(tenancy.Values) > 0 && !slices.Contains(tenancy.Values, tenancy.Default) {
+		errs = append(errs, ValidationError{
+			Field:   "generation.tenancy.default",
+			Message: fmt.Sprintf("default %q is not one of the values", tenancy.Default),
+		})
+	}
+
+	// Validate infrastructure classification
+	for i, pattern := range c.Generation.Infrastructure.Patterns {
+		if !doublestar.ValidatePattern(pattern) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("generation.infrastructure.patterns[%d]", i),
+				Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+			})
+		}
+	}
+
+	// Validate existing spec routing
+	catchAll := false
+	outputs := make(map[string]bool)
+	for i, spec := range c.Generation.ExistingSpecs {
+		field := fmt.Sprintf("generation.existingSpecs[%d]", i)
+		if spec.Path == "" {
+			errs = append(errs, ValidationError{Field: field + ".path", Message: "existing spec path is required"})
+			continue
+		}
+		if outputs[spec.OutputPath()] {
+			errs = append(errs, ValidationError{Field: field + ".output", Message: fmt.Sprintf("duplicate output %q", spec.OutputPath())})
+		}
+		outputs[spec.OutputPath()] = true
+		if len(spec.Paths) == 0 && len(spec.Tags) == 0 {
+			if catchAll {
+				errs = append(errs, ValidationError{Field: field, Message: "only one existing spec may omit paths and tags"})
+			}
+			catchAll = true
+		}
+		for j, pattern := range spec.Paths {
+			if !doublestar.ValidatePattern(pattern) {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("%s.paths[%d]", field, j),
+					Message: fmt.Sprintf("invalid glob pattern %q", pattern),
+				})
+			}
+		}
+	}
+
+	// Validate external specs
+	for i, spec := range c.Generation.ExternalSpecs {
+		field := fmt.Sprintf("generation.externalSpecs[%d]", i)
+		if !strings.HasPrefix(spec.Prefix, "/") {
+			errs = append(errs, ValidationError{Field: field + ".prefix", Message: fmt.Sprintf("prefix %q must start with /", spec.Prefix)})
+		}
+		if spec.Source == "" {
+			errs = append(errs, ValidationError{Field: field + ".source", Message: "external spec source is required"})
+		}
+		if spec.Namespace != "" && !serviceNameRegex.MatchString(spec.Namespace) {
+			errs = append(errs, ValidationError{Field: field + ".namespace", Message: fmt.Sprintf("namespace %q must be a letter followed by letters, digits or underscores", spec.Namespace)})
+		}
+		for j, other := range c.Generation.ExternalSpecs[:i] {
+			if prefixesOverlap(spec.Prefix, other.Prefix) {
+				errs = append(errs, ValidationError{Field: field + ".prefix", Message: fmt.Sprintf("prefix %q overlaps generation.externalSpecs[%d] prefix %q", spec.Prefix, j, other.Prefix)})
+			}
+		}
+	}
+
+	// Validate operation overrides
+	operations := make(map[string]bool)
+	for i, op := range c.Generation.Operations {
+		field := fmt.Sprintf("generation.operations[%d].operation", i)
+		method, path, ok := strings.Cut(op.Operation, " ")
+		switch {
+		case !ok || method == "" || method != strings.ToUpper(method) || !strings.HasPrefix(path, "/"):
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("operation %q must be a method and path, e.g. GET /users", op.Operation)})
+		case operations[op.Operation]:
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("duplicate operation %q", op.Operation)})
+		}
+		operations[op.Operation] = true
+		for j, mediaType := range op.Produces {
+			if kind, subtype, ok := strings.Cut(mediaType, "/"); !ok || kind == "" || subtype == "" || strings.ContainsAny(mediaType, " ,") {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("generation.operations[%d].produces[%d]", i, j),
+					Message: fmt.Sprintf("invalid media type %q, e.g. application/json", mediaType),
+				})
+			}
+		}
+	}
+
+	// Validate type mappings
+	mapped := make(map[string]bool)
+	for i, m := range c.Generation.TypeMappings {
+		field := fmt.Sprintf("generation.typeMappings[%d]", i)
+		switch {
+		case m.Name == "":
+			errs = append(errs, ValidationError{Field: field + ".name", Message: "name is required"})
+		case mapped[m.Name]:
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("duplicate type mapping %q", m.Name)})
+		case (m.Type == "") == (m.Ref == ""):
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("type mapping %q must set exactly one of type or ref", m.Name)})
+		case m.Type != "" && !contains(supportedSchemaTypes, m.Type):
+			errs = append(errs, ValidationError{Field: field + ".type", Message: fmt.Sprintf("unsupported type %q, must be one of: %s", m.Type, strings.Join(supportedSchemaTypes, ", "))})
+		case m.Ref != "" && m.Format != "":
+			errs = append(errs, ValidationError{Field: field + ".format", Message: fmt.Sprintf("type mapping %q cannot set a format with ref", m.Name)})
+		}
+		mapped[m.Name] = true
+	}
+
+	// Validate path parameter schemas
+	for _, name := range slices.Sorted(maps.Keys(c.Generation.PathParams)) {
+		param := c.Generation.PathParams[name]
+		field := "generation.pathParams." + name
+		if _, err := path.Match(name, ""); err != nil {
+			errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("invalid glob pattern %q", name)})
+		}
+		if !contains(supportedSchemaTypes, param.Type) {
+			errs = append(errs, ValidationError{Field: field + ".type", Message: fmt.Sprintf("unsupported type %q, must be one of: %s", param.Type, strings.Join(supportedSchemaTypes, ", "))})
+		}
+		if _, err := regexp.Compile(param.Pattern); err != nil {
+			errs = append(errs, ValidationError{Field: field + ".pattern", Message: fmt.Sprintf("invalid pattern %q: %v", param.Pattern, err)})
+		}
+	}
+
 	// Validate OpenAPI version
 	if c.OpenAPI.Version != "" {
 		if c.OpenAPI.Version != "3.0.3" && c.OpenAPI.Version != "3.1.0" {
@@ -459,6 +2180,74 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate policy rules
+	rules := make(map[string]bool)
+	for i, rule := range c.Policy.Rules {
+		field := fmt.Sprintf("policy.rules[%d]", i)
+		if err := rule.Validate(); err != nil {
+			errs = append(errs, ValidationError{Field: field, Message: err.Error()})
+		} else if rules[rule.Name] {
+			errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("duplicate rule %q", rule.Name)})
+		}
+		rules[rule.Name] = true
+	}
+	for i, sup := range c.Policy.Suppressions {
+		if sup.Rule != "*" && !rules[sup.Rule] {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("policy.suppressions[%d].rule", i),
+				Message: fmt.Sprintf("unknown rule %q", sup.Rule),
+			})
+		}
+	}
+	if failOn := c.Policy.FailOn; failOn != "never" && !policy.ValidSeverity(failOn) {
+		errs = append(errs, ValidationError{
+			Field:   "policy.failOn",
+			Message: fmt.Sprintf("invalid severity %q, must be error, warning, info or never", failOn),
+		})
+	}
+
+	// Validate publish targets
+	for i, target := range c.Publish.Targets {
+		if err := target.Validate(); err != nil {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("publish.targets[%d]", i),
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Validate language mappings
+	for lang, exts := range c.Source.LanguageExtensions {
+		if !scanner.IsKnownLanguage(lang) {
+			errs = append(errs, ValidationError{
+				Field:   "source.languageExtensions." + lang,
+				Message: fmt.Sprintf("unknown language %q", lang),
+			})
+		}
+		for _, ext := range exts {
+			if !strings.HasPrefix(ext, ".") {
+				errs = append(errs, ValidationError{
+					Field:   "source.languageExtensions." + lang,
+					Message: fmt.Sprintf("extension %q must start with a dot", ext),
+				})
+			}
+		}
+	}
+	for i, override := range c.Source.LanguageOverrides {
+		if override.Pattern == "" {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("source.languageOverrides[%d].pattern", i),
+				Message: "pattern is required",
+			})
+		}
+		if !scanner.IsKnownLanguage(override.Language) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("source.languageOverrides[%d].language", i),
+				Message: fmt.Sprintf("unknown language %q", override.Language),
+			})
+		}
+	}
+
 	// Validate watch debounce
 	if c.Watch.Debounce < 0 {
 		errs = append(errs, ValidationError{
@@ -482,6 +2271,15 @@ func (c *Config) Validate() error {
 		})
 	}
 
+	for i, source := range c.OpenAPI.Info.VersionFrom {
+		if !contains(VersionSources, source) {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("openapi.info.versionFrom[%d]", i),
+				Message: fmt.Sprintf("unsupported version source %q, must be one of: %s", source, strings.Join(VersionSources, ", ")),
+			})
+		}
+	}
+
 	if len(errs) > 0 {
 		return errs
 	}
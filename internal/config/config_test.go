@@ -10,6 +10,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/policy"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 )
 
 func TestDefault(t *testing.T) {
@@ -23,6 +27,10 @@ func TestDefault(t *testing.T) {
 	assert.Equal(t, "1.0.0", cfg.OpenAPI.Info.Version)
 	assert.Equal(t, "full", cfg.Generation.Mode)
 	assert.False(t, cfg.Generation.Merge)
+	assert.False(t, cfg.Generation.SourceLinks.Enabled)
+	assert.Equal(t, "origin", cfg.Generation.SourceLinks.Remote)
+	assert.Equal(t, "catalog-info.yaml", cfg.Generation.Backstage.Path)
+	assert.Equal(t, "production", cfg.Generation.Backstage.Lifecycle)
 	assert.False(t, cfg.Watch.Enabled)
 	assert.Equal(t, 500, cfg.Watch.Debounce)
 }
@@ -228,6 +236,564 @@ func TestValidate_InvalidMode(t *testing.T) {
 	assert.Equal(t, "generation.mode", valErrs[0].Field)
 }
 
+func TestValidate_InvalidWildcards(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "template", cfg.Generation.Wildcards)
+	cfg.Generation.Wildcards = "ignore"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.wildcards", valErrs[0].Field)
+}
+
+func TestValidate_InvalidMethodAliases(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "keep", cfg.Generation.MethodAliases)
+	cfg.Generation.MethodAliases = "merge"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.methodAliases", valErrs[0].Field)
+}
+
+func TestValidate_InvalidDanglingRefs(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "stub", cfg.Generation.DanglingRefs)
+	cfg.Generation.DanglingRefs = "warn"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.danglingRefs", valErrs[0].Field)
+}
+
+func TestValidate_DefaultResponseStatus(t *testing.T) {
+	cfg := Default()
+	for _, status := range []string{"200", "2XX", "default"} {
+		cfg.Generation.DefaultResponse.Status = status
+		assert.NoError(t, cfg.Validate(), status)
+	}
+
+	cfg.Generation.DefaultResponse.Status = "ok"
+	var valErrs ValidationErrors
+	require.ErrorAs(t, cfg.Validate(), &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.defaultResponse.status", valErrs[0].Field)
+}
+
+func TestValidate_ParameterExamples(t *testing.T) {
+	cfg := Default()
+	assert.True(t, cfg.Generation.ParameterExamples.Enabled)
+	assert.Contains(t, cfg.Generation.ParameterExamples.Files, "**/*_test.go")
+	cfg.Generation.ParameterExamples.Files = []string{"**/seeds/**", "tests/[a"}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.parameterExamples.files[1]", valErrs[0].Field)
+}
+
+func TestValidate_SLAs(t *testing.T) {
+	cfg := Default()
+	assert.True(t, cfg.Generation.Timeouts)
+	cfg.Generation.SLAs = []SLAConfig{
+		{Paths: []string{"/payments/**"}, Availability: "99.95%", LatencyMs: 300},
+		{Tags: []string{"reports"}, TimeoutMs: 30000},
+		{Paths: []string{"/[a"}, Availability: "101%"},
+		{LatencyMs: -1},
+		{Tags: []string{"admin"}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 4)
+	assert.Equal(t, "generation.slas[2].availability", valErrs[0].Field)
+	assert.Equal(t, "generation.slas[2].paths[0]", valErrs[1].Field)
+	assert.Equal(t, "generation.slas[3].latencyMs", valErrs[2].Field)
+	assert.Equal(t, "generation.slas[4]", valErrs[3].Field)
+}
+
+func TestValidate_LifecycleRules(t *testing.T) {
+	cfg := Default()
+	assert.True(t, cfg.Generation.Lifecycle.Annotations)
+	cfg.Generation.Lifecycle.Rules = []LifecycleRule{
+		{Paths: []string{"/v2/**"}, Stage: "beta"},
+		{Tags: []string{"partners"}, Audience: "partner"},
+		{Stage: "preview", Audience: "everyone"},
+		{Paths: []string{"/[a"}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 4)
+	assert.Equal(t, "generation.lifecycle.rules[2].stage", valErrs[0].Field)
+	assert.Equal(t, "generation.lifecycle.rules[2].audience", valErrs[1].Field)
+	assert.Equal(t, "generation.lifecycle.rules[3]", valErrs[2].Field)
+	assert.Equal(t, "generation.lifecycle.rules[3].paths[0]", valErrs[3].Field)
+}
+
+func TestValidate_StreamingRules(t *testing.T) {
+	cfg := Default()
+	assert.True(t, cfg.Generation.Streaming.Annotations)
+	cfg.Generation.Streaming.Rules = []StreamingRule{
+		{Paths: []string{"/events/**"}, Mode: "sse"},
+		{Tags: []string{"exports"}, Mode: "stream", MediaType: "application/x-ndjson"},
+		{Mode: "websocket", MediaType: "not a media type"},
+		{Paths: []string{"/[a"}, Mode: "long-poll"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 3)
+	assert.Equal(t, "generation.streaming.rules[2].mode", valErrs[0].Field)
+	assert.Equal(t, "generation.streaming.rules[2].mediaType", valErrs[1].Field)
+	assert.Equal(t, "generation.streaming.rules[3].paths[0]", valErrs[2].Field)
+}
+
+func TestValidate_Frozen(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Frozen = FrozenConfig{
+		Operations: []string{"GET /v1/users/**", "/v1/orders/*", "users", "POST /[a"},
+		Schemas:    []string{"Order*", "[a"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 3)
+	assert.Equal(t, "generation.frozen.operations[2]", valErrs[0].Field)
+	assert.Equal(t, "generation.frozen.operations[3]", valErrs[1].Field)
+	assert.Equal(t, "generation.frozen.schemas[1]", valErrs[2].Field)
+}
+
+func TestValidate_PathParams(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.PathParams = map[string]PathParamConfig{
+		"id":   {Type: "integer"},
+		"uuid": {Type: "string", Format: "uuid", Pattern: "^[0-9a-f-]{36}$"},
+		"slug": {Type: "text", Pattern: "("},
+		"[id":  {Type: "string"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 3)
+	assert.Equal(t, "generation.pathParams.[id", valErrs[0].Field)
+	assert.Equal(t, "generation.pathParams.slug.type", valErrs[1].Field)
+	assert.Equal(t, "generation.pathParams.slug.pattern", valErrs[2].Field)
+}
+
+func TestOptimizeSizeConfig_BudgetBytes(t *testing.T) {
+	tests := []struct {
+		budget string
+		want   int
+		ok     bool
+	}{
+		{"", 0, true},
+		{"6MB", 6000000, true},
+		{"6 mb", 6000000, true},
+		{"1.5MiB", 1572864, true},
+		{"512KiB", 524288, true},
+		{"2048", 2048, true},
+		{"100B", 100, true},
+		{"6XB", 0, false},
+		{"MB", 0, false},
+		{"-1MB", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := OptimizeSizeConfig{Budget: tt.budget}.BudgetBytes()
+		assert.Equal(t, tt.ok, err == nil, tt.budget)
+		assert.Equal(t, tt.want, got, tt.budget)
+	}
+}
+
+func TestValidate_OptimizeSizeBudget(t *testing.T) {
+	cfg := Default()
+	assert.False(t, cfg.Generation.OptimizeSize.Enabled)
+	cfg.Generation.OptimizeSize.Budget = "six megabytes"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.optimizeSize.budget", valErrs[0].Field)
+}
+
+func TestParseThreshold(t *testing.T) {
+	threshold, err := ParseThreshold("5%")
+	require.NoError(t, err)
+	assert.Equal(t, "5%", threshold.String())
+	assert.False(t, threshold.Exceeded(5, 100))
+	assert.True(t, threshold.Exceeded(6, 100))
+	assert.False(t, threshold.Exceeded(0, 0))
+
+	threshold, err = ParseThreshold("0")
+	require.NoError(t, err)
+	assert.Equal(t, "0", threshold.String())
+	assert.False(t, threshold.Exceeded(0, 10))
+	assert.True(t, threshold.Exceeded(1, 10))
+
+	threshold, err = ParseThreshold("")
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+	assert.False(t, threshold.Exceeded(100, 100))
+
+	for _, value := range []string{"-1", "ten", "150%", "1.5"} {
+		_, err := ParseThreshold(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestLoad_FailOnThresholds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api2spec.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`generation:
+  failOn:
+    unparsedFiles: 5%
+    unresolvedRefs: 0
+    routesWithoutResponses: 10
+`), 0o644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, FailOnConfig{UnparsedFiles: "5%", UnresolvedRefs: "0", RoutesWithoutResponses: "10"}, cfg.Generation.FailOn)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Generation.FailOn.UnresolvedRefs = "none"
+	err = cfg.Validate()
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.failOn.unresolvedRefs", valErrs[0].Field)
+}
+
+func TestValidate_InvalidWebhookReceivers(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "mark", cfg.Generation.WebhookReceivers)
+	cfg.Generation.WebhookReceivers = "hide"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.webhookReceivers", valErrs[0].Field)
+}
+
+func TestValidate_InvalidMethodBodies(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "warn", cfg.Generation.MethodBodies)
+	cfg.Generation.MethodBodies = "ignore"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.methodBodies", valErrs[0].Field)
+}
+
+func TestValidate_NegativeComplexityThresholds(t *testing.T) {
+	cfg := Default()
+	assert.False(t, cfg.Generation.Lint.Complexity.Enabled)
+	cfg.Generation.Lint.Complexity.MaxDepth = -1
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.lint.complexity", valErrs[0].Field)
+}
+
+func TestValidate_Envelope(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "data", cfg.Generation.Envelope.Field)
+	cfg.Generation.Envelope.Mode = "unwrap"
+	cfg.Generation.Envelope.Field = ""
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 2)
+	assert.Equal(t, "generation.envelope.field", valErrs[0].Field)
+	assert.Equal(t, "generation.envelope.schema", valErrs[1].Field)
+
+	cfg.Generation.Envelope = EnvelopeConfig{Mode: "strip", Field: "data"}
+	err = cfg.Validate()
+	require.ErrorAs(t, err, &valErrs)
+	assert.Equal(t, "generation.envelope.mode", valErrs[0].Field)
+}
+
+func TestValidate_ParameterCase(t *testing.T) {
+	cfg := Default()
+	assert.Empty(t, cfg.Generation.ParameterCase)
+	cfg.Generation.ParameterCase = "snake"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.ParameterCase = "kebab"
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.parameterCase", valErrs[0].Field)
+}
+
+func TestValidate_InfrastructurePatterns(t *testing.T) {
+	cfg := Default()
+	assert.Contains(t, cfg.Generation.Infrastructure.Patterns, "**/health/**")
+	assert.False(t, cfg.Generation.Infrastructure.Include)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.Infrastructure.Patterns = []string{"/status", "/internal/[a-"}
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.infrastructure.patterns[1]", valErrs[0].Field)
+}
+
+func TestValidate_CodeSamples(t *testing.T) {
+	cfg := Default()
+	assert.Empty(t, cfg.Generation.CodeSamples)
+	cfg.Generation.CodeSamples = []string{"curl", "javascript", "python", "go"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.CodeSamples = []string{"curl", "ruby"}
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.codeSamples[1]", valErrs[0].Field)
+}
+
+func TestValidate_MaxInlineDepth(t *testing.T) {
+	cfg := Default()
+	assert.Zero(t, cfg.Generation.MaxInlineDepth)
+	cfg.Generation.MaxInlineDepth = 2
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.MaxInlineDepth = -1
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.maxInlineDepth", valErrs[0].Field)
+}
+
+func TestValidate_Tenancy(t *testing.T) {
+	cfg := Default()
+	assert.True(t, cfg.Generation.Tenancy.Detect)
+	cfg.Generation.Tenancy.Host = "{tenant}.api.example.com"
+	cfg.Generation.Tenancy.Values = []string{"acme", "globex"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.Tenancy.Host = "api.example.com"
+	cfg.Generation.Tenancy.Default = "initech"
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 2)
+	assert.Equal(t, "generation.tenancy.host", valErrs[0].Field)
+	assert.Equal(t, "generation.tenancy.default", valErrs[1].Field)
+}
+
+func TestValidate_PathOrder(t *testing.T) {
+	cfg := Default()
+	assert.Empty(t, cfg.Generation.PathOrder)
+	cfg.Generation.PathOrder = "crud"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Generation.PathOrder = "random"
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.pathOrder", valErrs[0].Field)
+}
+
+func TestValidate_Variants(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Variants = []VariantConfig{
+		{Name: "beta", BuildTags: []string{"beta"}, Env: []string{"ENABLE_BETA=true", "EMPTY="}},
+		{Name: "beta"},
+		{Env: []string{"NODE_ENV"}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 3)
+	assert.Equal(t, "generation.variants[1].name", valErrs[0].Field)
+	assert.Equal(t, "generation.variants[2].name", valErrs[1].Field)
+	assert.Equal(t, "generation.variants[2].env[0]", valErrs[2].Field)
+
+	beta := cfg.Generation.Variant("beta")
+	require.NotNil(t, beta)
+	assert.Equal(t, "openapi.beta.yaml", beta.OutputPath("openapi.yaml"))
+	assert.Equal(t, map[string]string{"ENABLE_BETA": "true", "EMPTY": ""}, beta.EnvMap())
+	assert.Nil(t, cfg.Generation.Variant("stable"))
+}
+
+func TestValidate_Services(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Services.Strategy = "split"
+	cfg.Generation.Services.List = []ServiceConfig{
+		{Name: "Api", Framework: "gin", Paths: []string{"api/**"}},
+		{Name: "Bff", Framework: "express", Output: "bff/openapi.yaml"},
+	}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, "openapi.api.yaml", cfg.Generation.Services.List[0].OutputPath("openapi.yaml"))
+	assert.Equal(t, "bff/openapi.yaml", cfg.Generation.Services.List[1].OutputPath("openapi.yaml"))
+
+	cfg.Generation.Services.Strategy = "merge"
+	cfg.Generation.Services.List = append(cfg.Generation.Services.List,
+		ServiceConfig{Name: "Api", Framework: "flask"},
+		ServiceConfig{Name: "web-app", Paths: []string{"web/{a,b"}},
+	)
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 5)
+	assert.Equal(t, "generation.services.strategy", valErrs[0].Field)
+	assert.Equal(t, "generation.services.list[2].name", valErrs[1].Field)
+	assert.Equal(t, "generation.services.list[3].name", valErrs[2].Field)
+	assert.Equal(t, "generation.services.list[3].framework", valErrs[3].Field)
+	assert.Equal(t, "generation.services.list[3].paths[0]", valErrs[4].Field)
+}
+
+func TestValidate_ExistingSpecs(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.ExistingSpecs = []ExistingSpecConfig{
+		{Path: "specs/public.yaml", Paths: []string{"/api/**"}, Tags: []string{"public"}},
+		{Path: "specs/internal.yaml"},
+		{Path: "specs/admin.yaml", Output: "specs/internal.yaml", Paths: []string{"/admin/{a,b"}},
+		{Path: "specs/rest.yaml"},
+		{Tags: []string{"billing"}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	var fields []string
+	for _, e := range valErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"generation.existingSpecs[2].output",
+		"generation.existingSpecs[2].paths[0]",
+		"generation.existingSpecs[3]",
+		"generation.existingSpecs[4].path",
+	}, fields)
+}
+
+func TestValidate_Policy(t *testing.T) {
+	cfg := Default()
+	assert.Equal(t, "error", cfg.Policy.FailOn)
+	cfg.Policy.Rules = []policy.Rule{
+		{Name: "post-422", When: `method == "POST"`, Assert: `"422" in responses`},
+		{Name: "post-422", Assert: "true"},
+		{Name: "bad", Assert: `owner == "me"`},
+	}
+	cfg.Policy.Suppressions = []policy.Suppression{
+		{Rule: "post-422", Operations: []string{"/legacy/**"}},
+		{Rule: "*"},
+		{Rule: "missing"},
+	}
+	cfg.Policy.FailOn = "fatal"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	var fields []string
+	for _, e := range valErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"policy.rules[1].name",
+		"policy.rules[2]",
+		"policy.suppressions[2].rule",
+		"policy.failOn",
+	}, fields)
+}
+
+func TestValidate_TypeMappings(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.TypeMappings = []typemap.Mapping{
+		{Name: "decimal.Decimal", Type: "string", Format: "decimal"},
+		{Name: "Money", Ref: "Money"},
+		{Name: "Carbon", Type: "datetime"},
+		{Name: "Money", Ref: "SharedMoney"},
+		{Name: "Cents"},
+		{Type: "integer"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 4)
+	assert.Equal(t, "generation.typeMappings[2].type", valErrs[0].Field)
+	assert.Equal(t, "generation.typeMappings[3].name", valErrs[1].Field)
+	assert.Equal(t, "generation.typeMappings[4]", valErrs[2].Field)
+	assert.Equal(t, "generation.typeMappings[5].name", valErrs[3].Field)
+}
+
 func TestValidate_InvalidOpenAPIVersion(t *testing.T) {
 	cfg := Default()
 	cfg.OpenAPI.Version = "2.0"
@@ -254,6 +820,153 @@ func TestValidate_NegativeDebounce(t *testing.T) {
 	assert.Equal(t, "watch.debounce", valErrs[0].Field)
 }
 
+func TestValidate_LanguageMappings(t *testing.T) {
+	cfg := Default()
+	cfg.Source.LanguageExtensions = map[string][]string{
+		"php":    {".phtml"},
+		"rust":   {"rs.in"},
+		"smarty": {".tpl"},
+	}
+	cfg.Source.LanguageOverrides = []scanner.LanguageOverride{
+		{Pattern: "legacy/**/*.inc", Language: "php"},
+		{Pattern: "", Language: "cobol"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 4)
+}
+
+func TestValidate_SourceLinkTemplate(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.SourceLinks.URLTemplate = "https://code.example/{sha}"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.sourceLinks.urlTemplate", valErrs[0].Field)
+
+	cfg.Generation.SourceLinks.URLTemplate = "https://code.example/{sha}/{path}#{line}"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_ManifestSigner(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Manifest.Signer = "gpg"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.manifest.signer", valErrs[0].Field)
+
+	cfg.Generation.Manifest.Signer = "minisign"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_SDKGroupingExtensions(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.SDKGrouping.Extensions = []string{"x-go-package", "python-module"}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "generation.sdkGrouping.extensions[1]", valErrs[0].Field)
+
+	cfg.Generation.SDKGrouping.Extensions = []string{"x-python-module"}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_Profiles(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Profiles = []ProfileConfig{
+		{Name: "public", Output: "openapi.public.yaml", StripExtensions: []string{"x-go-package"}},
+		{Name: "public", Output: cfg.Output, StripExtensions: []string{"internal"}},
+		{},
+		{Name: "partner", Output: "openapi.partner.yaml", Paths: []string{"/partners/[a"}, Servers: []ServerConfig{{Description: "Partners"}}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	var fields []string
+	for _, e := range valErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"generation.profiles[1].name",
+		"generation.profiles[1].output",
+		"generation.profiles[1].stripExtensions[0]",
+		"generation.profiles[2].name",
+		"generation.profiles[2].output",
+		"generation.profiles[3].paths[0]",
+		"generation.profiles[3].servers[0].url",
+	}, fields)
+
+	cfg.Generation.Profiles = cfg.Generation.Profiles[:1]
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_Operations(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Operations = []OperationConfig{
+		{Operation: "GET /users", Exclude: true},
+		{Operation: "GET /users", OperationID: "listUsers"},
+		{Operation: "get /users"},
+		{Operation: "/users"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	var fields []string
+	for _, e := range valErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"generation.operations[1].operation",
+		"generation.operations[2].operation",
+		"generation.operations[3].operation",
+	}, fields)
+
+	cfg.Generation.Operations = cfg.Generation.Operations[:1]
+	assert.NoError(t, cfg.Validate())
+	assert.True(t, cfg.Generation.Operation("get", "/users").Exclude)
+	assert.Nil(t, cfg.Generation.Operation("POST", "/users"))
+}
+
+func TestValidate_OperationProduces(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.Operations = []OperationConfig{
+		{Operation: "GET /reports", Produces: []string{"application/json", "text/csv"}},
+		{Operation: "GET /exports", Produces: []string{"csv", "text/csv, application/xml"}},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 2)
+	assert.Equal(t, "generation.operations[1].produces[0]", valErrs[0].Field)
+	assert.Equal(t, "generation.operations[1].produces[1]", valErrs[1].Field)
+}
+
 func TestValidate_MissingTitle(t *testing.T) {
 	cfg := Default()
 	cfg.OpenAPI.Info.Title = ""
@@ -280,6 +993,19 @@ func TestValidate_MissingVersion(t *testing.T) {
 	assert.Equal(t, "openapi.info.version", valErrs[0].Field)
 }
 
+func TestValidate_VersionFrom(t *testing.T) {
+	cfg := Default()
+	cfg.OpenAPI.Info.VersionFrom = []string{"git-tag", "VERSION", "pom.xml"}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	assert.Len(t, valErrs, 1)
+	assert.Equal(t, "openapi.info.versionFrom[2]", valErrs[0].Field)
+}
+
 func TestValidate_MultipleErrors(t *testing.T) {
 	cfg := Default()
 	cfg.Framework = "invalid"
@@ -345,6 +1071,70 @@ output: stdlib-api.yaml
 	assert.Equal(t, "stdlib-api.yaml", cfg.Output)
 }
 
+func TestLoadFromPath_LanguageMappings(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+source:
+  languageExtensions:
+    php: [".phtml"]
+    rust: [".rs.in"]
+  languageOverrides:
+    - pattern: "Legacy/**/*.inc"
+      language: php
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "api2spec.yaml"), []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	cfg, err := LoadFromPath(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{"php": {".phtml"}, "rust": {".rs.in"}}, cfg.Source.LanguageExtensions)
+	require.Len(t, cfg.Source.LanguageOverrides, 1)
+	assert.Equal(t, "Legacy/**/*.inc", cfg.Source.LanguageOverrides[0].Pattern)
+	assert.Equal(t, "php", cfg.Source.LanguageOverrides[0].Language)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadFromPath_PublishTargets(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+publish:
+  targets:
+    - name: hub
+      type: swaggerhub
+      token: ${SWAGGERHUB_API_KEY}
+      owner: acme
+      api: orders
+    - type: http
+      url: https://specs.example.com/orders.yaml
+      headers:
+        X-Api-Key: ${SPEC_STORE_KEY}
+    - type: stoplight
+      token: abc
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "api2spec.yaml"), []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	cfg, err := LoadFromPath(tmpDir)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Publish.Targets, 3)
+	assert.Equal(t, "hub", cfg.Publish.Targets[0].Name)
+	assert.Equal(t, "${SWAGGERHUB_API_KEY}", cfg.Publish.Targets[0].Token)
+	assert.Equal(t, "https://specs.example.com/orders.yaml", cfg.Publish.Targets[1].URL)
+	assert.Len(t, cfg.Publish.Targets[1].Headers, 1)
+
+	err = cfg.Validate()
+	require.Error(t, err)
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	require.Len(t, valErrs, 1)
+	assert.Equal(t, "publish.targets[2]", valErrs[0].Field)
+	assert.Contains(t, valErrs[0].Message, "requires id")
+}
+
 func TestLoadFromPath_NoConfig(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -354,3 +1144,28 @@ func TestLoadFromPath_NoConfig(t *testing.T) {
 	// Should return default config
 	assert.Equal(t, "auto", cfg.Framework)
 }
+
+func TestValidate_ExternalSpecs(t *testing.T) {
+	cfg := Default()
+	cfg.Generation.ExternalSpecs = []ExternalSpecConfig{
+		{Prefix: "/payments", Source: "https://payments.example.com/openapi.yaml"},
+		{Prefix: "search", Source: "specs/search.yaml", Namespace: "search-v1"},
+		{Prefix: "/payments/refunds/"},
+	}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+
+	var valErrs ValidationErrors
+	require.ErrorAs(t, err, &valErrs)
+	var fields []string
+	for _, e := range valErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"generation.externalSpecs[1].prefix",
+		"generation.externalSpecs[1].namespace",
+		"generation.externalSpecs[2].source",
+		"generation.externalSpecs[2].prefix",
+	}, fields)
+}
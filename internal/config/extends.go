@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// CacheDirEnv overrides the directory remote base configs are cached in.
+const CacheDirEnv = "API2SPEC_CACHE_DIR"
+
+// maxExtendsDepth bounds chains of base configs extending each other.
+const maxExtendsDepth = 8
+
+// maxBaseSize bounds the size of a remote base config.
+const maxBaseSize = 1 << 20
+
+// extendsClient fetches remote base configs.
+var extendsClient = &http.Client{Timeout: 30 * time.Second}
+
+// baseRef is a base config named in extends: a URL or a path, optionally
+// pinned to the SHA-256 checksum of its content with a #sha256=<hex>
+// suffix.
+type baseRef struct {
+	location string
+	checksum string
+}
+
+// parseBaseRef splits the checksum pin off an extends entry.
+func parseBaseRef(entry string) (baseRef, error) {
+	location, fragment, _ := strings.Cut(strings.TrimSpace(entry), "#")
+	ref := baseRef{location: location}
+	if location == "" {
+		return ref, fmt.Errorf("empty extends entry")
+	}
+	if fragment == "" {
+		return ref, nil
+	}
+	checksum, ok := strings.CutPrefix(fragment, "sha256=")
+	if _, err := hex.DecodeString(checksum); !ok || err != nil || len(checksum) != sha256.Size*2 {
+		return ref, fmt.Errorf("invalid checksum pin %q in %s (expected #sha256=<64 hex digits>)", fragment, location)
+	}
+	ref.checksum = strings.ToLower(checksum)
+	return ref, nil
+}
+
+func (r baseRef) remote() bool {
+	return strings.HasPrefix(r.location, "https://") || strings.HasPrefix(r.location, "http://")
+}
+
+// resolve makes a relative base path absolute against the config that
+// names it, a file path or a URL.
+func (r baseRef) resolve(from string) baseRef {
+	if r.remote() || filepath.IsAbs(r.location) {
+		return r
+	}
+	if u, err := url.Parse(from); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		u.Path = path.Join(path.Dir(u.Path), r.location)
+		r.location = u.String()
+		return r
+	}
+	r.location = filepath.Join(filepath.Dir(from), r.location)
+	return r
+}
+
+// loadBases returns the settings of the base configs a config extends, in
+// order, each preceded by the bases it extends in turn. from is the path or
+// URL of the config naming them. When root is set, bases must be files
+// inside it.
+func loadBases(entries []string, from string, seen []string, root string) ([]map[string]any, error) {
+	var settings []map[string]any
+	for _, entry := range entries {
+		ref, err := parseBaseRef(entry)
+		if err != nil {
+			return nil, err
+		}
+		ref = ref.resolve(from)
+		for _, location := range seen {
+			if location == ref.location {
+				return nil, fmt.Errorf("extends cycle: %s", strings.Join(append(seen, ref.location), " -> "))
+			}
+		}
+		if len(seen) >= maxExtendsDepth {
+			return nil, fmt.Errorf("extends chain deeper than %d configs at %s", maxExtendsDepth, ref.location)
+		}
+
+		if root != "" {
+			if err := confineBase(ref, root); err != nil {
+				return nil, err
+			}
+		}
+		data, err := readBase(ref)
+		if err != nil {
+			return nil, err
+		}
+		v := viper.New()
+		v.SetConfigType(configType(ref.location))
+		if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+			return nil, fmt.Errorf("failed to parse base config %s: %w", ref.location, err)
+		}
+
+		parents, err := loadBases(v.GetStringSlice("extends"), ref.location, append(slices.Clip(seen), ref.location), root)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, parents...)
+		settings = append(settings, v.AllSettings())
+	}
+	return settings, nil
+}
+
+// confineBase checks that a base config is a file inside root, so that
+// the config of an untrusted repository can neither read other files nor
+// make requests.
+func confineBase(ref baseRef, root string) error {
+	if ref.remote() {
+		return fmt.Errorf("remote base config %s is not allowed", ref.location)
+	}
+	return confinePath(ref.location, root)
+}
+
+// confinePath checks that path, with symlinks resolved, lies inside root.
+func confinePath(path, root string) error {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if rel, err := filepath.Rel(root, resolved); err != nil || !filepath.IsLocal(rel) {
+		return fmt.Errorf("%s is outside %s", path, root)
+	}
+	return nil
+}
+
+// configType returns the viper config type of a config file or URL.
+func configType(location string) string {
+	if u, err := url.Parse(location); err == nil && u.Scheme != "" {
+		location = u.Path
+	}
+	if strings.EqualFold(path.Ext(location), ".json") {
+		return "json"
+	}
+	return "yaml"
+}
+
+// readBase returns the content of a base config. Remote configs are cached;
+// a pinned config is read from the cache while its content matches the
+// pin, and an unpinned one falls back to the cache when it cannot be
+// fetched.
+func readBase(ref baseRef) ([]byte, error) {
+	if !ref.remote() {
+		data, err := os.ReadFile(ref.location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read base config: %w", err)
+		}
+		return data, verifyBase(ref, data)
+	}
+
+	cached := cachePath(ref.location)
+	if ref.checksum != "" && cached != "" {
+		if data, err := os.ReadFile(cached); err == nil && verifyBase(ref, data) == nil {
+			return data, nil
+		}
+	}
+
+	data, fetchErr := fetchBase(ref.location)
+	if fetchErr != nil {
+		if cached == "" {
+			return nil, fetchErr
+		}
+		data, err := os.ReadFile(cached)
+		if err != nil {
+			return nil, fetchErr
+		}
+		return data, verifyBase(ref, data)
+	}
+	if err := verifyBase(ref, data); err != nil {
+		return nil, err
+	}
+	if cached != "" {
+		if err := os.MkdirAll(filepath.Dir(cached), 0o755); err == nil {
+			_ = os.WriteFile(cached, data, 0o644)
+		}
+	}
+	return data, nil
+}
+
+// fetchBase downloads a remote base config.
+func fetchBase(location string) ([]byte, error) {
+	resp, err := extendsClient.Get(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch base config: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch base config %s: %s", location, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBaseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch base config %s: %w", location, err)
+	}
+	if len(data) > maxBaseSize {
+		return nil, fmt.Errorf("base config %s is larger than %d bytes", location, maxBaseSize)
+	}
+	return data, nil
+}
+
+// verifyBase checks the content of a base config against its pin.
+func verifyBase(ref baseRef, data []byte) error {
+	if ref.checksum == "" {
+		return nil
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != ref.checksum {
+		return fmt.Errorf("base config %s has checksum sha256=%s, not the pinned sha256=%s", ref.location, got, ref.checksum)
+	}
+	return nil
+}
+
+// cachePath returns where a remote base config is cached, or "" when there
+// is no cache directory.
+func cachePath(location string) string {
+	dir := os.Getenv(CacheDirEnv)
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(userDir, "api2spec")
+	}
+	sum := sha256.Sum256([]byte(location))
+	return filepath.Join(dir, "extends", hex.EncodeToString(sum[:])+"."+configType(location))
+}
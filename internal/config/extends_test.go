@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orgBase = `
+openapi:
+  info:
+    title: Org API
+    contact:
+      name: Platform Team
+  tags:
+    - name: billing
+generation:
+  maxInlineDepth: 3
+  strictObjects: true
+policy:
+  failOn: warning
+`
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeConfig(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoad_ExtendsFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "shared"), 0o755))
+	writeConfig(t, dir, "shared/root.yaml", "generation:\n  strictObjects: false\n  pathOrder: crud\n")
+	writeConfig(t, dir, "shared/base.yaml", "extends: root.yaml\n"+orgBase)
+	path := writeConfig(t, dir, "api2spec.yaml", `
+extends: shared/base.yaml
+openapi:
+  info:
+    title: Orders API
+generation:
+  maxInlineDepth: 1
+`)
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"shared/base.yaml"}, cfg.Extends)
+	assert.Equal(t, "Orders API", cfg.OpenAPI.Info.Title)
+	assert.Equal(t, "Platform Team", cfg.OpenAPI.Info.Contact.Name)
+	assert.Equal(t, "billing", cfg.OpenAPI.Tags[0].Name)
+	assert.Equal(t, 1, cfg.Generation.MaxInlineDepth)
+	assert.True(t, cfg.Generation.StrictObjects)
+	assert.Equal(t, "crud", cfg.Generation.PathOrder)
+	assert.Equal(t, "warning", cfg.Policy.FailOn)
+	assert.Equal(t, "openapi.yaml", cfg.Output)
+}
+
+func TestLoad_ExtendsURL(t *testing.T) {
+	t.Setenv(CacheDirEnv, t.TempDir())
+	up := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(orgBase))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	pinned := writeConfig(t, dir, "pinned.yaml", "extends: "+server.URL+"/api2spec-base.yaml#sha256="+checksum(orgBase)+"\n")
+	unpinned := writeConfig(t, dir, "unpinned.yaml", "extends: ["+server.URL+"/api2spec-base.yaml]\n")
+
+	cfg, err := Load(pinned)
+	require.NoError(t, err)
+	assert.Equal(t, "Org API", cfg.OpenAPI.Info.Title)
+
+	// Both are served from the cache while the server is down
+	up = false
+	cfg, err = Load(pinned)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.Generation.MaxInlineDepth)
+	cfg, err = Load(unpinned)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.Generation.MaxInlineDepth)
+}
+
+func TestLoad_ExtendsChecksumMismatch(t *testing.T) {
+	t.Setenv(CacheDirEnv, t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(orgBase + "framework: gin\n"))
+	}))
+	defer server.Close()
+
+	path := writeConfig(t, t.TempDir(), "api2spec.yaml", "extends: "+server.URL+"/base.yaml#sha256="+checksum(orgBase)+"\n")
+
+	_, err := Load(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not the pinned sha256="+checksum(orgBase))
+}
+
+func TestLoad_ExtendsTooLarge(t *testing.T) {
+	t.Setenv(CacheDirEnv, t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(orgBase + "# " + strings.Repeat("x", maxBaseSize) + "\n"))
+	}))
+	defer server.Close()
+
+	path := writeConfig(t, t.TempDir(), "api2spec.yaml", "extends: "+server.URL+"/base.yaml\n")
+
+	_, err := Load(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is larger than 1048576 bytes")
+}
+
+func TestLoad_ExtendsErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, "a.yaml", "extends: b.yaml\n")
+	writeConfig(t, dir, "b.yaml", "extends: a.yaml\n")
+	cycle := writeConfig(t, dir, "cycle.yaml", "extends: a.yaml\n")
+	badPin := writeConfig(t, dir, "pin.yaml", "extends: a.yaml#md5=abc\n")
+	missing := writeConfig(t, dir, "missing.yaml", "extends: nowhere.yaml\n")
+
+	_, err := Load(cycle)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "extends cycle")
+
+	_, err = Load(badPin)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid checksum pin")
+
+	_, err = Load(missing)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read base config")
+}
+
+func TestLoadConfined(t *testing.T) {
+	parent := t.TempDir()
+	secret := writeConfig(t, parent, "secret.yaml", orgBase)
+
+	dir := filepath.Join(parent, "repo")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	writeConfig(t, dir, "base.yaml", orgBase)
+	writeConfig(t, dir, "api2spec.yaml", "extends: base.yaml\n")
+	cfg, err := LoadConfined(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "Org API", cfg.OpenAPI.Info.Title)
+
+	for _, tt := range []struct{ name, extends, err string }{
+		{"absolute", secret, "outside"},
+		{"relative", "../secret.yaml", "outside"},
+		{"url", "https://config.example.com/base.yaml", "not allowed"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(parent, tt.name)
+			require.NoError(t, os.Mkdir(dir, 0o755))
+			writeConfig(t, dir, "api2spec.yaml", "extends: "+tt.extends+"\n")
+			_, err := LoadConfined(dir)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("symlinked config", func(t *testing.T) {
+		dir := filepath.Join(parent, "symlinked")
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		if err := os.Symlink(secret, filepath.Join(dir, "api2spec.yaml")); err != nil {
+			t.Skip("symlinks not supported")
+		}
+		_, err := LoadConfined(dir)
+		assert.ErrorContains(t, err, "outside")
+	})
+}
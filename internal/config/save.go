@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SaveOperations writes operation overrides to generation.operations of the
+// config file at path, creating the file if needed. The rest of the file,
+// including comments in YAML files, is left as it is.
+func SaveOperations(path string, ops []OperationConfig) error {
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if filepath.Ext(path) == ".json" {
+		content, err = setJSONOperations(content, ops)
+	} else {
+		content, err = setYAMLOperations(content, ops)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+func setJSONOperations(content []byte, ops []OperationConfig) ([]byte, error) {
+	doc := make(map[string]any)
+	if len(bytes.TrimSpace(content)) > 0 {
+		if err := json.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+	}
+
+	generation, _ := doc["generation"].(map[string]any)
+	if generation == nil {
+		generation = make(map[string]any)
+		doc["generation"] = generation
+	}
+	if len(ops) == 0 {
+		delete(generation, "operations")
+	} else {
+		generation["operations"] = ops
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func setYAMLOperations(content []byte, ops []OperationConfig) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config is not a mapping")
+	}
+
+	generation := mappingValue(root, "generation")
+	if generation == nil {
+		generation = &yaml.Node{Kind: yaml.MappingNode}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "generation"}, generation)
+	}
+
+	var value yaml.Node
+	if err := value.Encode(ops); err != nil {
+		return nil, err
+	}
+	setMappingValue(generation, "operations", &value, len(ops) > 0)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mappingValue returns the value of key in a YAML mapping, or nil.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue replaces, adds or (when keep is false) removes key in a
+// YAML mapping.
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node, keep bool) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != key {
+			continue
+		}
+		if keep {
+			mapping.Content[i+1] = value
+		} else {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+		}
+		return
+	}
+	if keep {
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+	}
+}
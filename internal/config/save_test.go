@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveOperations_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".api2spec.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`# api2spec configuration file
+framework: express
+generation:
+  mode: full # keep this comment
+`), 0644))
+
+	ops := []OperationConfig{
+		{Operation: "GET /internal/metrics", Exclude: true},
+		{Operation: "GET /users/{id}", OperationID: "getUser", Tags: []string{"users"}},
+	}
+	require.NoError(t, SaveOperations(path, ops))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# api2spec configuration file")
+	assert.Contains(t, string(content), "mode: full # keep this comment")
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "express", cfg.Framework)
+	assert.Equal(t, ops, cfg.Generation.Operations)
+
+	// Saving no overrides removes the key
+	require.NoError(t, SaveOperations(path, nil))
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "operations")
+}
+
+func TestSaveOperations_NewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".api2spec.yaml")
+	ops := []OperationConfig{{Operation: "DELETE /users/{id}", Exclude: true}}
+
+	require.NoError(t, SaveOperations(path, ops))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, ops, cfg.Generation.Operations)
+}
+
+func TestSaveOperations_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api2spec.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"framework": "gin", "generation": {"mode": "full"}}`), 0644))
+
+	ops := []OperationConfig{{Operation: "POST /users", Tags: []string{"accounts", "users"}}}
+	require.NoError(t, SaveOperations(path, ops))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "gin", cfg.Framework)
+	assert.Equal(t, "full", cfg.Generation.Mode)
+	assert.Equal(t, ops, cfg.Generation.Operations)
+}
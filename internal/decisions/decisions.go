@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package decisions records the decisions a generate run makes, such as the
+// plugin that claimed each file, the heuristics that marked each route and
+// the defaults plugins assumed, so users can find out why a route was or
+// wasn't extracted.
+package decisions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Suffix is appended to the spec path to name its decisions log.
+const Suffix = ".decisions.json"
+
+// Version is the decisions log format version.
+const Version = 1
+
+// File outcomes.
+const (
+	// OutcomeRoutes means routes were extracted from the file
+	OutcomeRoutes = "routes"
+
+	// OutcomeNoRoutes means a plugin claimed the file but found no routes
+	OutcomeNoRoutes = "no-routes"
+
+	// OutcomeUnclaimed means no plugin handles the file's extension
+	OutcomeUnclaimed = "unclaimed"
+
+	// OutcomeUnparsed means the parsers could not handle the file
+	OutcomeUnparsed = "unparsed"
+)
+
+// Framework sources.
+const (
+	// SourceConfigured means the framework was set in the config or flags
+	SourceConfigured = "configured"
+
+	// SourceDetected means the framework was detected in the project
+	SourceDetected = "detected"
+
+	// SourceServices means each service names its own framework
+	SourceServices = "services"
+)
+
+// Log is the decisions log of a generate run.
+type Log struct {
+	// Version is the decisions log format version
+	Version int `json:"version"`
+
+	// Spec is the spec file name, relative to the log
+	Spec string `json:"spec"`
+
+	// Framework is how the framework plugin was chosen
+	Framework Framework `json:"framework"`
+
+	// Files lists every scanned file and the plugin that claimed it
+	Files []File `json:"files"`
+
+	// Heuristics lists the route-marking heuristics that changed routes
+	Heuristics []Heuristic `json:"heuristics"`
+
+	// Assumptions lists the defaults plugins fell back to
+	Assumptions []Assumption `json:"assumptions"`
+
+	root  string
+	files map[string]int
+}
+
+// Framework records how the framework plugin was chosen.
+type Framework struct {
+	// Name is the chosen plugin, empty when services choose their own
+	Name string `json:"name,omitempty"`
+
+	// Source is configured, detected or services
+	Source string `json:"source"`
+
+	// Detected lists every plugin whose detection matched the project
+	Detected []string `json:"detected,omitempty"`
+}
+
+// File records what became of a scanned file.
+type File struct {
+	// Path is the file path, relative to the project root
+	Path string `json:"path"`
+
+	// Language is the detected language
+	Language string `json:"language,omitempty"`
+
+	// Plugin is the plugin that claimed the file
+	Plugin string `json:"plugin,omitempty"`
+
+	// Outcome is routes, no-routes, unclaimed or unparsed
+	Outcome string `json:"outcome"`
+
+	// Routes is the number of routes extracted from the file
+	Routes int `json:"routes,omitempty"`
+
+	// Reason is why the claiming plugin skipped the file, if it did
+	Reason string `json:"reason,omitempty"`
+}
+
+// Heuristic records the routes a route-marking heuristic changed.
+type Heuristic struct {
+	// Name is the heuristic, e.g. requestHeaders or cors
+	Name string `json:"name"`
+
+	// Routes are the changed routes, as METHOD /path (file:line)
+	Routes []string `json:"routes"`
+}
+
+// Assumption is a default a plugin fell back to, such as the variable names
+// it assumed an application instance has.
+type Assumption struct {
+	// Plugin is the plugin that made the assumption
+	Plugin string `json:"plugin"`
+
+	// File is the file the assumption was made for
+	File string `json:"file,omitempty"`
+
+	// Message describes the assumption
+	Message string `json:"message"`
+}
+
+// PathFor returns the decisions log path for a spec file.
+func PathFor(specPath string) string {
+	return specPath + Suffix
+}
+
+// New starts the decisions log of a run writing specPath and enables
+// recording assumptions. Files under root are recorded relative to it.
+func New(specPath, root string) *Log {
+	Enable()
+	Assumptions()
+	Skips()
+	return &Log{
+		Version:     Version,
+		Spec:        filepath.Base(specPath),
+		Files:       []File{},
+		Heuristics:  []Heuristic{},
+		Assumptions: []Assumption{},
+		root:        root,
+		files:       make(map[string]int),
+	}
+}
+
+// Chose records the framework plugin chosen for the run and how, with the
+// plugins whose detection matched the project.
+func (l *Log) Chose(name, source string, detected []string) {
+	if l == nil {
+		return
+	}
+	l.Framework = Framework{Name: name, Source: source, Detected: detected}
+}
+
+// Scanned records the scanned files, unclaimed until a plugin claims them.
+func (l *Log) Scanned(files []scanner.SourceFile) {
+	if l == nil {
+		return
+	}
+	for _, f := range files {
+		l.file(f.Path).Language = f.Language
+	}
+}
+
+// Claim records the files among files that plugin handles by extension,
+// with the number of routes extracted from each.
+func (l *Log) Claim(plugin string, extensions []string, files []scanner.SourceFile, routes []types.Route) {
+	if l == nil {
+		return
+	}
+	counts := make(map[string]int)
+	for _, r := range routes {
+		counts[r.SourceFile]++
+	}
+	for _, f := range files {
+		if !handles(extensions, f.Path) {
+			continue
+		}
+		entry := l.file(f.Path)
+		entry.Language = f.Language
+		entry.Plugin = plugin
+		entry.Routes = counts[f.Path]
+		entry.Outcome = OutcomeNoRoutes
+		if entry.Routes > 0 {
+			entry.Outcome = OutcomeRoutes
+		}
+	}
+}
+
+// Unparsed records files the parsers could not handle.
+func (l *Log) Unparsed(paths []string) {
+	if l == nil {
+		return
+	}
+	for _, path := range paths {
+		l.file(path).Outcome = OutcomeUnparsed
+	}
+}
+
+// Mark runs a route-marking heuristic and records the routes it changed.
+func (l *Log) Mark(name string, routes []types.Route, mark func()) {
+	if l == nil {
+		mark()
+		return
+	}
+	before := make([]string, len(routes))
+	for i := range routes {
+		before[i] = fingerprint(routes[i])
+	}
+	mark()
+
+	h := Heuristic{Name: name}
+	for i := range routes {
+		if fingerprint(routes[i]) != before[i] {
+			h.Routes = append(h.Routes, l.describe(routes[i]))
+		}
+	}
+	if len(h.Routes) > 0 {
+		l.Heuristics = append(l.Heuristics, h)
+	}
+}
+
+// Write collects the assumptions and skips recorded since New and writes
+// the log as indented JSON.
+func (l *Log) Write(path string) error {
+	for _, a := range Assumptions() {
+		a.File = l.relative(a.File)
+		l.Assumptions = append(l.Assumptions, a)
+	}
+	for _, s := range Skips() {
+		if entry := l.file(s.File); entry.Plugin == s.Plugin || entry.Plugin == "" {
+			entry.Reason = s.Message
+		}
+	}
+	sort.Slice(l.Files, func(i, j int) bool {
+		return l.Files[i].Path < l.Files[j].Path
+	})
+	for i, f := range l.Files {
+		l.files[f.Path] = i
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode decisions log: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write decisions log %s: %w", path, err)
+	}
+	return nil
+}
+
+// file returns the entry of the file at path, adding an unclaimed one if
+// new.
+func (l *Log) file(path string) *File {
+	path = l.relative(path)
+	if i, ok := l.files[path]; ok {
+		return &l.Files[i]
+	}
+	l.files[path] = len(l.Files)
+	l.Files = append(l.Files, File{Path: path, Outcome: OutcomeUnclaimed})
+	return &l.Files[len(l.Files)-1]
+}
+
+// describe formats a route as METHOD /path (file:line).
+func (l *Log) describe(r types.Route) string {
+	s := r.Method + " " + r.Path
+	if r.SourceFile != "" {
+		s += fmt.Sprintf(" (%s:%d)", l.relative(r.SourceFile), r.SourceLine)
+	}
+	return s
+}
+
+// relative returns file relative to the project root when it lies inside it.
+func (l *Log) relative(file string) string {
+	if l.root != "" && filepath.IsAbs(file) {
+		if rel, err := filepath.Rel(l.root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+	}
+	return filepath.ToSlash(file)
+}
+
+// handles reports whether path has one of extensions.
+func handles(extensions []string, path string) bool {
+	ext := filepath.Ext(path)
+	for _, e := range extensions {
+		if strings.EqualFold(e, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// fingerprint encodes a route to detect changes to it.
+func fingerprint(r types.Route) string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+var (
+	assumptionsMu      sync.Mutex
+	assumptionsEnabled bool
+	assumptions        []Assumption
+	skips              []Assumption
+)
+
+// Enable starts recording assumptions and skips. Recording is off by
+// default so ordinary runs do not keep them.
+func Enable() {
+	assumptionsMu.Lock()
+	defer assumptionsMu.Unlock()
+	assumptionsEnabled = true
+}
+
+// Assume records that plugin fell back to a default for file.
+func Assume(plugin, file, message string) {
+	assumptionsMu.Lock()
+	defer assumptionsMu.Unlock()
+	if !assumptionsEnabled {
+		return
+	}
+	a := Assumption{Plugin: plugin, File: file, Message: message}
+	for _, existing := range assumptions {
+		if existing == a {
+			return
+		}
+	}
+	assumptions = append(assumptions, a)
+}
+
+// Assumptions returns the assumptions recorded since the last call and
+// clears them.
+func Assumptions() []Assumption {
+	assumptionsMu.Lock()
+	defer assumptionsMu.Unlock()
+	a := assumptions
+	assumptions = nil
+	return a
+}
+
+// Skip records that plugin skipped file without looking for routes, with
+// the reason.
+func Skip(plugin, file, reason string) {
+	assumptionsMu.Lock()
+	defer assumptionsMu.Unlock()
+	if !assumptionsEnabled {
+		return
+	}
+	skips = append(skips, Assumption{Plugin: plugin, File: file, Message: reason})
+}
+
+// Skips returns the skips recorded since the last call and clears them.
+func Skips() []Assumption {
+	assumptionsMu.Lock()
+	defer assumptionsMu.Unlock()
+	s := skips
+	skips = nil
+	return s
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package decisions
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestLog_Files(t *testing.T) {
+	root := t.TempDir()
+	server := filepath.Join(root, "src", "server.js")
+	util := filepath.Join(root, "src", "util.js")
+	broken := filepath.Join(root, "src", "broken.js")
+	readme := filepath.Join(root, "main.go")
+
+	l := New(filepath.Join(root, "openapi.yaml"), root)
+	files := []scanner.SourceFile{
+		{Path: server, Language: "javascript"},
+		{Path: util, Language: "javascript"},
+		{Path: broken, Language: "javascript"},
+		{Path: readme, Language: "go"},
+	}
+	l.Scanned(files)
+	l.Claim("express", []string{".js", ".ts"}, files, []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: server},
+		{Method: "POST", Path: "/users", SourceFile: server},
+	})
+	l.Unparsed([]string{broken})
+	Skip("express", util, "no Express import")
+	require.NoError(t, l.Write(PathFor(filepath.Join(root, "openapi.yaml"))))
+
+	assert.Equal(t, []File{
+		{Path: "main.go", Language: "go", Outcome: OutcomeUnclaimed},
+		{Path: "src/broken.js", Language: "javascript", Plugin: "express", Outcome: OutcomeUnparsed},
+		{Path: "src/server.js", Language: "javascript", Plugin: "express", Outcome: OutcomeRoutes, Routes: 2},
+		{Path: "src/util.js", Language: "javascript", Plugin: "express", Outcome: OutcomeNoRoutes, Reason: "no Express import"},
+	}, l.Files)
+}
+
+func TestLog_Mark(t *testing.T) {
+	l := New("openapi.yaml", "")
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "orders.js", SourceLine: 3},
+		{Method: "POST", Path: "/orders", SourceFile: "orders.js", SourceLine: 4},
+	}
+
+	l.Mark("requestHeaders", routes, func() {
+		routes[1].Parameters = append(routes[1].Parameters, types.Parameter{Name: "Idempotency-Key", In: "header"})
+	})
+	l.Mark("cors", routes, func() {})
+
+	assert.Equal(t, []Heuristic{{Name: "requestHeaders", Routes: []string{"POST /orders (orders.js:4)"}}}, l.Heuristics)
+
+	var nilLog *Log
+	ran := false
+	nilLog.Mark("cors", routes, func() { ran = true })
+	assert.True(t, ran, "a nil log still runs the heuristic")
+}
+
+func TestAssume(t *testing.T) {
+	Assumptions()
+	Assume("fastify", "server.js", "recorded before the run starts")
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "openapi.yaml")
+	l := New(specPath, dir)
+	l.Chose("fastify", SourceDetected, []string{"fastify", "express"})
+	Assume("fastify", filepath.Join(dir, "server.js"), "assumed app")
+	Assume("fastify", filepath.Join(dir, "server.js"), "assumed app")
+
+	path := PathFor(specPath)
+	assert.Equal(t, filepath.Join(dir, "openapi.yaml.decisions.json"), path)
+	require.NoError(t, l.Write(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var written Log
+	require.NoError(t, json.Unmarshal(data, &written))
+	assert.Equal(t, Version, written.Version)
+	assert.Equal(t, "openapi.yaml", written.Spec)
+	assert.Equal(t, Framework{Name: "fastify", Source: SourceDetected, Detected: []string{"fastify", "express"}}, written.Framework)
+	assert.Equal(t, []Assumption{{Plugin: "fastify", File: "server.js", Message: "assumed app"}}, written.Assumptions)
+	assert.Empty(t, Assumptions(), "writing collects the recorded assumptions")
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Diagnostics returns the extraction diagnostics plugins attached to routes
+// as warnings at each route's source location.
+func Diagnostics(routes []types.Route) []Warning {
+	var warnings []Warning
+	for _, route := range routes {
+		for _, message := range route.Diagnostics {
+			warnings = append(warnings, Warning{
+				File:    route.SourceFile,
+				Line:    route.SourceLine,
+				Message: route.Method + " " + route.Path + ": " + message,
+			})
+		}
+	}
+	return warnings
+}
+
+// FileDiagnostics returns the diagnostics parsers recorded for whole files,
+// such as exhausted traversal budgets, as warnings.
+func FileDiagnostics(diagnostics []parser.FileDiagnostic) []Warning {
+	warnings := make([]Warning, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		warnings = append(warnings, Warning{File: d.File, Message: d.Message})
+	}
+	return warnings
+}
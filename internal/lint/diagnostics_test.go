@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDiagnostics(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: "app.js", SourceLine: 3},
+		{
+			Method:      "GET",
+			Path:        "/{prefix}/orders",
+			SourceFile:  "app.js",
+			SourceLine:  7,
+			Diagnostics: []string{"path expression ${prefix} could not be resolved; emitted as a path parameter"},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"app.js:7: GET /{prefix}/orders: path expression ${prefix} could not be resolved; emitted as a path parameter",
+	}, messages(Diagnostics(routes)))
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// orderedFrameworks match routes in registration order, so an earlier
+// parameterized route shadows a later literal one. Radix-tree routers
+// (chi, gin, fastify, ...) prefer literal segments and are not affected.
+var orderedFrameworks = map[string]bool{
+	"express": true,
+	"koa":     true,
+	"hono":    true,
+	"sinatra": true,
+	"rails":   true,
+}
+
+// DuplicateRoutes reports method and path combinations registered at more
+// than one source location, treating paths that differ only in parameter
+// names as the same route. For frameworks that match in registration order,
+// it also reports routes shadowed by an earlier, more general route in the
+// same file, such as GET /users/me registered after GET /users/{id}.
+func DuplicateRoutes(routes []types.Route, framework string) []Warning {
+	var warnings []Warning
+
+	first := make(map[string]types.Route)
+	for _, route := range routes {
+		key := strings.ToUpper(route.Method) + " " + normalizePath(route.Path)
+		earlier, seen := first[key]
+		if !seen {
+			first[key] = route
+			continue
+		}
+		if sameLocation(earlier, route) || (earlier.SourceFile == "" && route.SourceFile == "") {
+			continue
+		}
+
+		message := fmt.Sprintf("%s %s is already registered", route.Method, route.Path)
+		if earlier.Path != route.Path {
+			message = fmt.Sprintf("%s %s conflicts with %s %s", route.Method, route.Path, earlier.Method, earlier.Path)
+		}
+		warnings = append(warnings, Warning{
+			File:    route.SourceFile,
+			Line:    route.SourceLine,
+			Message: message,
+			Related: []Location{{File: earlier.SourceFile, Line: earlier.SourceLine}},
+		})
+	}
+
+	if orderedFrameworks[framework] {
+		warnings = append(warnings, shadowedRoutes(routes)...)
+	}
+	return warnings
+}
+
+// shadowedRoutes reports routes unreachable because an earlier route in the
+// same file matches every request they would.
+func shadowedRoutes(routes []types.Route) []Warning {
+	var warnings []Warning
+	for i, route := range routes {
+		if route.SourceFile == "" {
+			continue
+		}
+		for _, earlier := range routes[:i] {
+			if earlier.SourceFile != route.SourceFile || earlier.SourceLine > route.SourceLine {
+				continue
+			}
+			if !sameMethod(earlier.Method, route.Method) || normalizePath(earlier.Path) == normalizePath(route.Path) {
+				continue
+			}
+			if covers(earlier.Path, route.Path) {
+				warnings = append(warnings, Warning{
+					File:    route.SourceFile,
+					Line:    route.SourceLine,
+					Message: fmt.Sprintf("%s %s is unreachable: %s %s is registered earlier and matches it first", route.Method, route.Path, earlier.Method, earlier.Path),
+					Related: []Location{{File: earlier.SourceFile, Line: earlier.SourceLine}},
+				})
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// sameLocation reports whether two routes come from the same registration.
+func sameLocation(a, b types.Route) bool {
+	return a.SourceFile == b.SourceFile && a.SourceLine == b.SourceLine
+}
+
+// sameMethod reports whether a route registered for method a also handles
+// method b; ALL and * handle every method.
+func sameMethod(a, b string) bool {
+	a, b = strings.ToUpper(a), strings.ToUpper(b)
+	return a == b || a == "ALL" || a == "*"
+}
+
+// normalizePath replaces parameter names with {} and drops a trailing slash.
+func normalizePath(path string) string {
+	segments := splitPath(path)
+	for i, segment := range segments {
+		if isParam(segment) {
+			segments[i] = "{}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// covers reports whether every request path matching pattern b also
+// matches pattern a.
+func covers(a, b string) bool {
+	as, bs := splitPath(a), splitPath(b)
+	for i, segment := range as {
+		if isWildcard(segment) {
+			return true
+		}
+		if i >= len(bs) {
+			return false
+		}
+		if isParam(segment) {
+			if isWildcard(bs[i]) {
+				return false
+			}
+			continue
+		}
+		if segment != bs[i] {
+			return false
+		}
+	}
+	return len(as) == len(bs)
+}
+
+// splitPath splits a path into its non-empty segments.
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// isParam reports whether a segment is a single-segment parameter.
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") && !isWildcard(segment)
+}
+
+// isWildcard reports whether a segment matches the rest of the path.
+func isWildcard(segment string) bool {
+	return segment == "*" || strings.HasSuffix(segment, "*}") || strings.HasSuffix(segment, "+}")
+}
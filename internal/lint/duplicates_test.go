@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDuplicateRoutes(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: "users.go", SourceLine: 10},
+		{Method: "GET", Path: "/users/{id}", SourceFile: "users.go", SourceLine: 11},
+		{Method: "POST", Path: "/users", SourceFile: "users.go", SourceLine: 12},
+		{Method: "GET", Path: "/users/", SourceFile: "legacy.go", SourceLine: 40},
+		{Method: "GET", Path: "/users/{userID}", SourceFile: "legacy.go", SourceLine: 41},
+		// The same registration reported twice is not a duplicate
+		{Method: "POST", Path: "/users", SourceFile: "users.go", SourceLine: 12},
+		{Method: "GET", Path: "/health"},
+		{Method: "GET", Path: "/health"},
+	}
+
+	assert.Equal(t, []string{
+		"legacy.go:40: GET /users/ conflicts with GET /users (see users.go:10)",
+		"legacy.go:41: GET /users/{userID} conflicts with GET /users/{id} (see users.go:11)",
+	}, messages(DuplicateRoutes(routes, "chi")))
+}
+
+func TestDuplicateRoutes_Shadowed(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{id}", SourceFile: "app.js", SourceLine: 3},
+		{Method: "GET", Path: "/users/me", SourceFile: "app.js", SourceLine: 4},
+		{Method: "POST", Path: "/users/me", SourceFile: "app.js", SourceLine: 5},
+		{Method: "ALL", Path: "/files/{path*}", SourceFile: "app.js", SourceLine: 6},
+		{Method: "DELETE", Path: "/files/tmp/cache", SourceFile: "app.js", SourceLine: 7},
+		{Method: "GET", Path: "/teams/me", SourceFile: "teams.js", SourceLine: 1},
+		{Method: "GET", Path: "/teams/{id}", SourceFile: "teams.js", SourceLine: 2},
+		// Routes in other files depend on mount order and are not compared
+		{Method: "GET", Path: "/users/admin", SourceFile: "admin.js", SourceLine: 1},
+	}
+
+	assert.Equal(t, []string{
+		"app.js:4: GET /users/me is unreachable: GET /users/{id} is registered earlier and matches it first (see app.js:3)",
+		"app.js:7: DELETE /files/tmp/cache is unreachable: ALL /files/{path*} is registered earlier and matches it first (see app.js:6)",
+	}, messages(DuplicateRoutes(routes, "express")))
+
+	// Radix routers prefer literal segments
+	assert.Empty(t, DuplicateRoutes(routes, "gin"))
+}
+
+func TestCovers(t *testing.T) {
+	assert.True(t, covers("/users/{id}", "/users/me"))
+	assert.True(t, covers("/users/{id}", "/users/{userId}"))
+	assert.True(t, covers("/*", "/anything/at/all"))
+	assert.False(t, covers("/users/me", "/users/{id}"))
+	assert.False(t, covers("/users/{id}", "/users/{id}/posts"))
+	assert.False(t, covers("/users/{id}", "/users/{rest*}"))
+}
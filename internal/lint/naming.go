@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// NamingRules selects the path naming conventions Naming enforces.
+type NamingRules struct {
+	// KebabCase requires literal path segments to be lowercase kebab-case
+	KebabCase bool
+
+	// PluralResources requires collection segments followed by an
+	// identifier parameter, such as users in /users/{id}, to be plural
+	PluralResources bool
+
+	// NoVerbs rejects segments that start with a CRUD verb, such as
+	// /getUsers or /users/{id}/delete, since the HTTP method says that
+	NoVerbs bool
+
+	// VersionPrefix requires versioned routes to share one version prefix
+	// layout, such as /api/v1, and unversioned routes to use it when most
+	// routes do
+	VersionPrefix bool
+}
+
+var (
+	kebabSegmentRegex   = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	versionSegmentRegex = regexp.MustCompile(`^[vV]\d+(?:\.\d+)*$`)
+)
+
+// crudVerbs are lowercase words that name the operation an HTTP method
+// already states.
+var crudVerbs = map[string]bool{
+	"get":      true,
+	"set":      true,
+	"create":   true,
+	"update":   true,
+	"delete":   true,
+	"remove":   true,
+	"add":      true,
+	"fetch":    true,
+	"retrieve": true,
+	"list":     true,
+	"edit":     true,
+	"modify":   true,
+	"save":     true,
+	"insert":   true,
+}
+
+// pluralNouns are lowercase plurals and uncountable nouns not ending in s.
+var pluralNouns = map[string]bool{
+	"people":    true,
+	"children":  true,
+	"men":       true,
+	"women":     true,
+	"data":      true,
+	"metadata":  true,
+	"media":     true,
+	"criteria":  true,
+	"info":      true,
+	"feedback":  true,
+	"staff":     true,
+	"software":  true,
+	"equipment": true,
+	"me":        true,
+}
+
+// Naming reports route paths that break the selected naming conventions.
+// Each problem is reported once per path, at the first route that has it.
+func Naming(routes []types.Route, rules NamingRules) []Warning {
+	var warnings []Warning
+	reported := make(map[string]bool)
+	report := func(route types.Route, message string) {
+		key := route.Path + "\x00" + message
+		if reported[key] {
+			return
+		}
+		reported[key] = true
+		warnings = append(warnings, Warning{File: route.SourceFile, Line: route.SourceLine, Message: message})
+	}
+
+	for _, route := range routes {
+		segments := splitPath(route.Path)
+		for i, segment := range segments {
+			if isParam(segment) || isWildcard(segment) || versionSegmentRegex.MatchString(segment) {
+				continue
+			}
+			name := segmentName(segment)
+			if name == "" {
+				continue
+			}
+
+			if rules.KebabCase && !kebabSegmentRegex.MatchString(name) {
+				message := fmt.Sprintf("segment %q in %s is not kebab-case", segment, route.Path)
+				if kebab := toKebab(name); kebabSegmentRegex.MatchString(kebab) {
+					message += fmt.Sprintf(" (%s)", kebab)
+				}
+				report(route, message)
+			}
+
+			words := segmentWords(name)
+			if rules.NoVerbs && len(words) > 0 && crudVerbs[words[0]] {
+				report(route, fmt.Sprintf("segment %q in %s starts with the verb %q; let the HTTP method name the operation", segment, route.Path, words[0]))
+			}
+
+			if rules.PluralResources && len(words) > 0 && i+1 < len(segments) && isParam(segments[i+1]) {
+				if noun := words[len(words)-1]; !isPlural(noun) {
+					report(route, fmt.Sprintf("resource %q in %s should be plural (%s)", segment, route.Path, pluralize(noun)))
+				}
+			}
+		}
+	}
+
+	if rules.VersionPrefix {
+		for _, w := range versionPrefixWarnings(routes) {
+			report(w.route, w.message)
+		}
+	}
+	return warnings
+}
+
+type routeMessage struct {
+	route   types.Route
+	message string
+}
+
+// versionPrefixWarnings reports versioned routes whose prefix layout differs
+// from the most common one, and unversioned routes when most routes are
+// versioned.
+func versionPrefixWarnings(routes []types.Route) []routeMessage {
+	layouts := make([]string, len(routes))
+	counts := make(map[string]int)
+	unversioned := 0
+	for i, route := range routes {
+		layouts[i] = versionLayout(route.Path)
+		if layouts[i] == "" {
+			unversioned++
+		} else {
+			counts[layouts[i]]++
+		}
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+
+	common := make([]string, 0, len(counts))
+	for layout := range counts {
+		common = append(common, layout)
+	}
+	sort.Slice(common, func(a, b int) bool {
+		if counts[common[a]] != counts[common[b]] {
+			return counts[common[a]] > counts[common[b]]
+		}
+		return common[a] < common[b]
+	})
+	expected := common[0]
+	versioned := len(routes) - unversioned
+
+	var messages []routeMessage
+	for i, route := range routes {
+		switch {
+		case layouts[i] == expected:
+		case layouts[i] == "":
+			if versioned > unversioned {
+				messages = append(messages, routeMessage{route, fmt.Sprintf("%s has no version prefix; most routes use %s", route.Path, expected)})
+			}
+		default:
+			messages = append(messages, routeMessage{route, fmt.Sprintf("%s uses version prefix %s; most routes use %s", route.Path, layouts[i], expected)})
+		}
+	}
+	return messages
+}
+
+// versionLayout returns a path's segments up to its version segment, with
+// the version number replaced by N, or "" if the path is unversioned.
+func versionLayout(path string) string {
+	segments := splitPath(path)
+	for i, segment := range segments {
+		if versionSegmentRegex.MatchString(segment) {
+			return "/" + strings.Join(append(segments[:i:i], "vN"), "/")
+		}
+	}
+	return ""
+}
+
+// segmentName strips a leading dot and file extension from a segment, so
+// .well-known and openapi.json are checked as well-known and openapi.
+func segmentName(segment string) string {
+	segment = strings.TrimPrefix(segment, ".")
+	if idx := strings.LastIndex(segment, "."); idx > 0 {
+		segment = segment[:idx]
+	}
+	return segment
+}
+
+// segmentWords splits a camelCase, snake_case or kebab-case segment into
+// lowercase words.
+func segmentWords(segment string) []string {
+	var words []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+
+	runes := []rune(segment)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+	return words
+}
+
+// toKebab converts a segment to kebab-case.
+func toKebab(segment string) string {
+	return strings.Join(segmentWords(segment), "-")
+}
+
+// isPlural reports whether a lowercase noun is plural or uncountable.
+func isPlural(noun string) bool {
+	if pluralNouns[noun] {
+		return true
+	}
+	return strings.HasSuffix(noun, "s") && !strings.HasSuffix(noun, "ss")
+}
+
+// pluralize returns the regular English plural of a lowercase noun.
+func pluralize(noun string) string {
+	switch {
+	case strings.HasSuffix(noun, "y") && len(noun) > 1 && !strings.ContainsRune("aeiou", rune(noun[len(noun)-2])):
+		return noun[:len(noun)-1] + "ies"
+	case strings.HasSuffix(noun, "s"), strings.HasSuffix(noun, "x"), strings.HasSuffix(noun, "z"),
+		strings.HasSuffix(noun, "ch"), strings.HasSuffix(noun, "sh"):
+		return noun + "es"
+	default:
+		return noun + "s"
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestNaming(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/api/v1/users", SourceFile: "users.go", SourceLine: 10},
+		{Method: "GET", Path: "/api/v1/user/{id}", SourceFile: "users.go", SourceLine: 11},
+		{Method: "PUT", Path: "/api/v1/user/{id}", SourceFile: "users.go", SourceLine: 12},
+		{Method: "GET", Path: "/api/v1/userProfiles", SourceFile: "users.go", SourceLine: 13},
+		{Method: "POST", Path: "/api/v1/createOrder", SourceFile: "orders.go", SourceLine: 5},
+		{Method: "POST", Path: "/api/v1/orders/{id}/delete", SourceFile: "orders.go", SourceLine: 6},
+		{Method: "GET", Path: "/api/v2/categories/{id}", SourceFile: "orders.go", SourceLine: 7},
+		{Method: "GET", Path: "/api/v1/people/{id}", SourceFile: "people.go", SourceLine: 3},
+		{Method: "GET", Path: "/v1/status", SourceFile: "health.go", SourceLine: 2},
+		{Method: "GET", Path: "/users/me", SourceFile: "legacy.go", SourceLine: 8},
+		{Method: "GET", Path: "/.well-known/openapi.json", SourceFile: "docs.go", SourceLine: 1},
+	}
+
+	assert.Equal(t, []string{
+		`users.go:11: resource "user" in /api/v1/user/{id} should be plural (users)`,
+		`users.go:13: segment "userProfiles" in /api/v1/userProfiles is not kebab-case (user-profiles)`,
+		`orders.go:5: segment "createOrder" in /api/v1/createOrder is not kebab-case (create-order)`,
+		`orders.go:5: segment "createOrder" in /api/v1/createOrder starts with the verb "create"; let the HTTP method name the operation`,
+		`orders.go:6: segment "delete" in /api/v1/orders/{id}/delete starts with the verb "delete"; let the HTTP method name the operation`,
+		`health.go:2: /v1/status uses version prefix /vN; most routes use /api/vN`,
+		`legacy.go:8: /users/me has no version prefix; most routes use /api/vN`,
+		`docs.go:1: /.well-known/openapi.json has no version prefix; most routes use /api/vN`,
+	}, messages(Naming(routes, NamingRules{KebabCase: true, PluralResources: true, NoVerbs: true, VersionPrefix: true})))
+
+	assert.Empty(t, Naming(routes, NamingRules{}))
+}
+
+func TestNaming_VersionPrefixMostlyUnversioned(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users"},
+		{Method: "GET", Path: "/orders"},
+		{Method: "GET", Path: "/v2/reports"},
+	}
+
+	assert.Empty(t, Naming(routes, NamingRules{VersionPrefix: true}))
+}
+
+func TestSegmentWords(t *testing.T) {
+	assert.Equal(t, []string{"get", "user", "profiles"}, segmentWords("getUserProfiles"))
+	assert.Equal(t, []string{"html", "parser"}, segmentWords("HTMLParser"))
+	assert.Equal(t, []string{"order", "items"}, segmentWords("order_items"))
+	assert.Equal(t, []string{"line", "items"}, segmentWords("line-items"))
+}
+
+func TestPluralize(t *testing.T) {
+	assert.Equal(t, "categories", pluralize("category"))
+	assert.Equal(t, "keys", pluralize("key"))
+	assert.Equal(t, "addresses", pluralize("address"))
+	assert.Equal(t, "users", pluralize("user"))
+}
@@ -0,0 +1,450 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package lint runs correctness checks over extracted routes and the source
+// code that defines them.
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Warning is a likely bug found in the source, reported at its location.
+type Warning struct {
+	// File is the source file of the route
+	File string
+
+	// Line is the line of the route definition
+	Line int
+
+	// Message describes the problem
+	Message string
+
+	// Related are other source locations involved, such as the earlier
+	// registration of a duplicate route
+	Related []Location
+}
+
+// Location is a position in a source file.
+type Location struct {
+	File string
+	Line int
+}
+
+// String formats the location as "file:line".
+func (l Location) String() string {
+	if l.Line > 0 {
+		return fmt.Sprintf("%s:%d", l.File, l.Line)
+	}
+	return l.File
+}
+
+// String formats the warning as "file:line: message (see file:line)".
+func (w Warning) String() string {
+	s := Location{File: w.File, Line: w.Line}.String() + ": " + w.Message
+	if len(w.Related) > 0 {
+		related := make([]string, 0, len(w.Related))
+		for _, loc := range w.Related {
+			related = append(related, loc.String())
+		}
+		s += " (see " + strings.Join(related, ", ") + ")"
+	}
+	return s
+}
+
+var (
+	templateParamRegex = regexp.MustCompile(`\{([^}:]+)(?::[^}]*)?\}`)
+
+	// Path parameter reads in handler bodies, by language family
+	goParamReads = []*regexp.Regexp{
+		regexp.MustCompile(`URLParam\(\s*\w+\s*,\s*"([^"]+)"`),       // chi
+		regexp.MustCompile(`\.Param\(\s*"([^"]+)"`),                  // gin, echo
+		regexp.MustCompile(`\.Params(?:Int)?\(\s*"([^"]+)"`),         // fiber
+		regexp.MustCompile(`\bVars\(\s*\w+\s*\)\[\s*"([^"]+)"\s*\]`), // gorilla/mux
+		regexp.MustCompile(`\bvars\[\s*"([^"]+)"\s*\]`),              // gorilla/mux via variable
+		regexp.MustCompile(`\.PathValue\(\s*"([^"]+)"`),              // net/http
+	}
+	jsParamReads = []*regexp.Regexp{
+		regexp.MustCompile(`\bparams\??\.([A-Za-z_$][\w$]*)(\s*\()?`),
+		regexp.MustCompile(`\bparams\??\.?\[\s*['"]([^'"]+)['"]\s*\]`),
+		regexp.MustCompile(`\.param\(\s*['"]([^'"]+)['"]`), // hono, express
+		regexp.MustCompile(`@Param\(\s*['"]([^'"]+)['"]`),  // nestjs
+	}
+	jsDestructure   = regexp.MustCompile(`\{([^{}]*)\}\s*(?::[^=;]+)?=\s*(?:await\s+)?[\w.?]*\bparams\b`)
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_$][\w$]*$`)
+	pyParamReads    = []*regexp.Regexp{
+		regexp.MustCompile(`\b(?:match_info|path_params|kwargs)\[\s*['"]([^'"]+)['"]\s*\]`),
+		regexp.MustCompile(`\b(?:match_info|path_params|kwargs)\.get\(\s*['"]([^'"]+)['"]`),
+	}
+)
+
+// PathParams compares the path parameters each route declares with the
+// parameters its handler reads, and warns when a handler reads a parameter
+// the path does not declare (e.g. the route has {userId} but the handler
+// reads params.id) or, for Python, when the handler signature lacks an
+// argument for a declared parameter.
+//
+// Handlers are located in the route's source file, either by handler name
+// or inline at the route definition. Go, JavaScript, TypeScript, and Python
+// handlers are checked; other languages are skipped.
+func PathParams(routes []types.Route, files []scanner.SourceFile) []Warning {
+	byPath := make(map[string]*scanner.SourceFile, len(files))
+	for i := range files {
+		byPath[files[i].Path] = &files[i]
+	}
+
+	var warnings []Warning
+	for _, route := range routes {
+		file := byPath[route.SourceFile]
+		if file == nil {
+			continue
+		}
+		declared := declaredParams(route)
+		warn := func(format string, args ...any) {
+			warnings = append(warnings, Warning{
+				File:    route.SourceFile,
+				Line:    route.SourceLine,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+
+		src := util.NormalizeNewlines(string(file.Content))
+		switch file.Language {
+		case "go", "javascript", "typescript":
+			body := braceHandlerBody(src, file.Language, route)
+			if body == "" {
+				continue
+			}
+			for _, name := range readParams(body, file.Language) {
+				if !declared[name] {
+					warn("handler reads path parameter %q but %s %s declares %s", name, route.Method, route.Path, describe(declared))
+				}
+			}
+		case "python":
+			signature, body, ok := pythonHandler(src, route)
+			if !ok {
+				continue
+			}
+			// Methods of class-based views receive parameters by
+			// framework-specific names (DRF's pk), so only functions are checked
+			if !strings.Contains(signature, "**") && !strings.ContainsAny(route.Handler, ".") {
+				args := pythonArgs(signature)
+				for _, name := range sortedKeys(declared) {
+					if !args[name] {
+						warn("handler has no argument for path parameter %q of %s %s", name, route.Method, route.Path)
+					}
+				}
+			}
+			for _, name := range readParams(body, file.Language) {
+				if !declared[name] {
+					warn("handler reads path parameter %q but %s %s declares %s", name, route.Method, route.Path, describe(declared))
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// declaredParams returns the path parameters of a route's template and
+// declared parameters.
+func declaredParams(route types.Route) map[string]bool {
+	declared := make(map[string]bool)
+	for _, match := range templateParamRegex.FindAllStringSubmatch(route.Path, -1) {
+		declared[strings.TrimSuffix(match[1], "*")] = true
+	}
+	for _, param := range route.Parameters {
+		if param.In == "path" {
+			declared[param.Name] = true
+		}
+	}
+	return declared
+}
+
+// describe lists declared parameters for a message.
+func describe(declared map[string]bool) string {
+	if len(declared) == 0 {
+		return "no path parameters"
+	}
+	return strings.Join(sortedKeys(declared), ", ")
+}
+
+// readParams returns the sorted, distinct path parameter names read in body.
+func readParams(body, language string) []string {
+	var patterns []*regexp.Regexp
+	switch language {
+	case "go":
+		patterns = goParamReads
+	case "javascript", "typescript":
+		patterns = jsParamReads
+	case "python":
+		patterns = pyParamReads
+	}
+
+	names := make(map[string]bool)
+	for _, re := range patterns {
+		for _, match := range re.FindAllStringSubmatch(body, -1) {
+			// params.hasOwnProperty(...) is a method call, not a read
+			if len(match) > 2 && match[2] != "" {
+				continue
+			}
+			names[match[1]] = true
+		}
+	}
+	if language == "javascript" || language == "typescript" {
+		for _, match := range jsDestructure.FindAllStringSubmatch(body, -1) {
+			for _, field := range strings.Split(match[1], ",") {
+				// { id: userId = 1, ...rest } reads "id"
+				field = strings.TrimSpace(field)
+				if field == "" || strings.HasPrefix(field, "...") {
+					continue
+				}
+				field = strings.TrimSpace(strings.SplitN(strings.SplitN(field, "=", 2)[0], ":", 2)[0])
+				names[strings.Trim(field, `'"`)] = true
+			}
+		}
+	}
+	return sortedKeys(names)
+}
+
+// sortedKeys returns the keys of a set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// handlerName returns the bare function name of a route handler such as
+// "h.GetUser", "UsersController@show", or "users::get".
+func handlerName(handler string) string {
+	if i := strings.LastIndexAny(handler, ".@#:"); i >= 0 {
+		handler = handler[i+1:]
+	}
+	if !identifierRegex.MatchString(handler) {
+		return ""
+	}
+	return handler
+}
+
+// braceHandlerBody returns the source of a route's handler in a
+// brace-delimited language: the named handler's function if it is defined
+// in the file, otherwise the route definition with its inline handler.
+func braceHandlerBody(src, language string, route types.Route) string {
+	if name := handlerName(route.Handler); name != "" {
+		var patterns []string
+		if language == "go" {
+			patterns = []string{`func\s+(?:\([^)]*\)\s*)?` + name + `\s*\(`}
+		} else {
+			patterns = []string{
+				`function\s*\*?\s*` + name + `\s*\(`,
+				`(?:const|let|var)\s+` + name + `\s*(?::[^=]+)?=`,
+				`(?m)^\s*(?:(?:public|private|protected|static|async)\s+)*` + name + `\s*\([^)]*\)\s*(?::[^{]+)?\{`,
+			}
+		}
+		for _, pattern := range patterns {
+			if loc := regexp.MustCompile(pattern).FindStringIndex(src); loc != nil {
+				if body := blockFrom(src, loc[0]); body != "" {
+					return body
+				}
+			}
+		}
+	}
+
+	start := lineOffset(src, route.SourceLine)
+	if start < 0 {
+		return ""
+	}
+	open := strings.IndexByte(src[start:], '(')
+	if open < 0 {
+		return ""
+	}
+	end := matching(src, start+open)
+	if end < 0 {
+		return ""
+	}
+	call := src[start : end+1]
+
+	// A handler passed by name (router.get("/:id", getUser)) is looked up
+	// when the call itself reads no parameters
+	if len(readParams(call, language)) == 0 && route.Handler == "" {
+		if m := regexp.MustCompile(`,\s*([A-Za-z_$][\w$]*)\s*\)$`).FindStringSubmatch(call); m != nil {
+			named := route
+			named.Handler = m[1]
+			named.SourceLine = 0
+			return braceHandlerBody(src, language, named)
+		}
+	}
+	return call
+}
+
+// blockFrom returns the text from start through the end of the first
+// brace-delimited block after it.
+func blockFrom(src string, start int) string {
+	for i := start; i < len(src); i++ {
+		switch src[i] {
+		case '(':
+			// Skip the parameter list, which may contain braces
+			end := matching(src, i)
+			if end < 0 {
+				return ""
+			}
+			i = end
+		case '{':
+			end := matching(src, i)
+			if end < 0 {
+				return ""
+			}
+			return src[start : end+1]
+		case ';':
+			return ""
+		}
+	}
+	return ""
+}
+
+// matching returns the index of the bracket closing the one at open,
+// skipping string literals and line comments, or -1.
+func matching(src string, open int) int {
+	closer := map[byte]byte{'(': ')', '{': '}', '[': ']'}[src[open]]
+	depth := 0
+	for i := open; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '"', '\'', '`':
+			i = skipString(src, i)
+		case '/':
+			if i+1 < len(src) && src[i+1] == '/' {
+				for i < len(src) && src[i] != '\n' {
+					i++
+				}
+			}
+		case src[open]:
+			depth++
+		case closer:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// skipString returns the index of the quote closing the string at i.
+func skipString(src string, i int) int {
+	quote := src[i]
+	for j := i + 1; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			j++
+		case quote:
+			return j
+		case '\n':
+			if quote != '`' {
+				return j
+			}
+		}
+	}
+	return len(src) - 1
+}
+
+// lineOffset returns the byte offset of a 1-based line, or -1.
+func lineOffset(src string, line int) int {
+	if line < 1 {
+		return -1
+	}
+	offset := 0
+	for n := 1; n < line; n++ {
+		next := strings.IndexByte(src[offset:], '\n')
+		if next < 0 {
+			return -1
+		}
+		offset += next + 1
+	}
+	return offset
+}
+
+// pythonHandler returns the signature and body of a Python route handler:
+// the named function, or the first function defined at or after the route
+// line (below its decorators).
+func pythonHandler(src string, route types.Route) (signature, body string, ok bool) {
+	start := -1
+	if name := handlerName(route.Handler); name != "" {
+		if loc := regexp.MustCompile(`(?m)^[ \t]*(?:async\s+)?def\s+` + name + `\s*\(`).FindStringIndex(src); loc != nil {
+			start = loc[0]
+		}
+	}
+	if start < 0 {
+		offset := lineOffset(src, route.SourceLine)
+		if offset < 0 {
+			return "", "", false
+		}
+		loc := regexp.MustCompile(`(?m)^[ \t]*(?:async\s+)?def\s+\w+\s*\(`).FindStringIndex(src[offset:])
+		if loc == nil {
+			return "", "", false
+		}
+		start = offset + loc[0]
+	}
+
+	open := start + strings.IndexByte(src[start:], '(')
+	end := matching(src, open)
+	if end < 0 {
+		return "", "", false
+	}
+	signature = src[open+1 : end]
+
+	// The body is every following line indented deeper than the def
+	indent := len(src[start:]) - len(strings.TrimLeft(src[start:], " \t"))
+	lines := strings.Split(src[end:], "\n")
+	var bodyLines []string
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed != "" && len(line)-len(trimmed) <= indent {
+			break
+		}
+		bodyLines = append(bodyLines, line)
+	}
+	return signature, strings.Join(bodyLines, "\n"), true
+}
+
+// pythonArgs returns the argument names of a Python signature.
+func pythonArgs(signature string) map[string]bool {
+	args := make(map[string]bool)
+	depth := 0
+	var current strings.Builder
+	flush := func() {
+		arg := strings.TrimSpace(current.String())
+		current.Reset()
+		arg = strings.TrimLeft(arg, "*")
+		if i := strings.IndexAny(arg, ":="); i >= 0 {
+			arg = arg[:i]
+		}
+		if arg = strings.TrimSpace(arg); arg != "" && arg != "/" {
+			args[arg] = true
+		}
+	}
+	for _, r := range signature {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				flush()
+				continue
+			}
+		}
+		current.WriteRune(r)
+	}
+	flush()
+	return args
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func messages(warnings []Warning) []string {
+	result := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		result = append(result, w.String())
+	}
+	return result
+}
+
+func TestPathParams_JavaScript(t *testing.T) {
+	src := `const app = require('express')();
+
+app.get('/users/:userId', (req, res) => {
+  const id = req.params.id;
+  res.json({ id, own: req.params.hasOwnProperty('x') });
+});
+
+app.get('/orders/:orderId', getOrder);
+
+function getOrder(req, res) {
+  const { orderId, ...rest } = req.params;
+  res.json({ orderId });
+}
+
+app.get('/items/:itemId', async (c) => c.json({ id: c.req.param('item_id') }));
+`
+	files := []scanner.SourceFile{{Path: "/app/app.js", Language: "javascript", Content: []byte(src)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{userId}", SourceFile: "/app/app.js", SourceLine: 3},
+		{Method: "GET", Path: "/orders/{orderId}", SourceFile: "/app/app.js", SourceLine: 8},
+		{Method: "GET", Path: "/items/{itemId}", SourceFile: "/app/app.js", SourceLine: 15},
+		{Method: "GET", Path: "/elsewhere", SourceFile: "/app/other.js", SourceLine: 1},
+	}
+
+	assert.Equal(t, []string{
+		`/app/app.js:3: handler reads path parameter "id" but GET /users/{userId} declares userId`,
+		`/app/app.js:15: handler reads path parameter "item_id" but GET /items/{itemId} declares itemId`,
+	}, messages(PathParams(routes, files)))
+}
+
+func TestPathParams_Go(t *testing.T) {
+	src := `package main
+
+func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	render(w, map[string]interface{}{"id": id})
+}
+
+func main() {
+	r.Get("/users/{userID}", h.GetUser)
+	r.Get("/posts/{postID}", func(w http.ResponseWriter, r *http.Request) {
+		_ = chi.URLParam(r, "postID") // not "{id}"
+	})
+	e.GET("/teams/:team", func(c echo.Context) error {
+		return c.String(200, c.Param("org")+"}")
+	})
+}
+`
+	files := []scanner.SourceFile{{Path: "main.go", Language: "go", Content: []byte(src)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{userID}", Handler: "h.GetUser", SourceFile: "main.go", SourceLine: 9},
+		{Method: "GET", Path: "/posts/{postID}", SourceFile: "main.go", SourceLine: 10},
+		{Method: "GET", Path: "/teams/{team}", SourceFile: "main.go", SourceLine: 13},
+	}
+
+	assert.Equal(t, []string{
+		`main.go:9: handler reads path parameter "id" but GET /users/{userID} declares userID`,
+		`main.go:13: handler reads path parameter "org" but GET /teams/{team} declares team`,
+	}, messages(PathParams(routes, files)))
+}
+
+func TestPathParams_Python(t *testing.T) {
+	src := `from fastapi import FastAPI
+
+app = FastAPI()
+
+@app.get("/users/{user_id}")
+def get_user(id: int):
+    return {"id": id}
+
+@app.get("/posts/{post_id}")
+async def get_post(post_id: int, q: Optional[str] = None):
+    return {}
+
+async def get_item(request):
+    return web.json_response({"id": request.match_info["id"]})
+
+class UserViewSet(ViewSet):
+    def retrieve(self, request, pk=None):
+        pass
+`
+	files := []scanner.SourceFile{{Path: "main.py", Language: "python", Content: []byte(src)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{user_id}", Handler: "get_user", SourceFile: "main.py", SourceLine: 5},
+		{Method: "GET", Path: "/posts/{post_id}", SourceFile: "main.py", SourceLine: 9},
+		{Method: "GET", Path: "/items/{item_id}", Handler: "get_item", SourceFile: "main.py", SourceLine: 13},
+		{Method: "GET", Path: "/users/{id}", Handler: "UserViewSet.retrieve", SourceFile: "main.py", SourceLine: 16},
+	}
+
+	assert.Equal(t, []string{
+		`main.py:5: handler has no argument for path parameter "user_id" of GET /users/{user_id}`,
+		`main.py:13: handler has no argument for path parameter "item_id" of GET /items/{item_id}`,
+		`main.py:13: handler reads path parameter "id" but GET /items/{item_id} declares item_id`,
+	}, messages(PathParams(routes, files)))
+}
+
+func TestPathParams_PythonCRLF(t *testing.T) {
+	src := strings.ReplaceAll(`@app.get("/users/{user_id}")
+def get_user(user_id: int):
+    user = load(user_id)
+
+    return request.path_params["id"]
+`, "\n", "\r\n")
+	files := []scanner.SourceFile{{Path: "main.py", Language: "python", Content: []byte(src)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{user_id}", Handler: "get_user", SourceFile: "main.py", SourceLine: 1},
+	}
+
+	assert.Equal(t, []string{
+		`main.py:1: handler reads path parameter "id" but GET /users/{user_id} declares user_id`,
+	}, messages(PathParams(routes, files)))
+}
+
+func TestPathParams_SkipsOtherLanguages(t *testing.T) {
+	files := []scanner.SourceFile{{Path: "Api.java", Language: "java", Content: []byte(`@GetMapping("/users/{id}")`)}}
+	routes := []types.Route{{Method: "GET", Path: "/users/{id}", SourceFile: "Api.java", SourceLine: 1}}
+
+	assert.Empty(t, PathParams(routes, files))
+}
+
+func TestPythonArgs(t *testing.T) {
+	args := pythonArgs("self, user_id: int, *, q: Dict[str, int] = {}, db=Depends(get_db), /")
+	require.Len(t, args, 4)
+	assert.True(t, args["user_id"])
+	assert.True(t, args["q"])
+	assert.True(t, args["db"])
+}
+
+func TestHandlerName(t *testing.T) {
+	assert.Equal(t, "GetUser", handlerName("h.GetUser"))
+	assert.Equal(t, "show", handlerName("UsersController@show"))
+	assert.Equal(t, "get", handlerName("users::get"))
+	assert.Equal(t, "", handlerName("func literal"))
+}
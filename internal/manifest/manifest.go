@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package manifest writes integrity manifests for generated specifications
+// and optionally signs them with cosign or minisign.
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// Suffix is appended to the spec path to name its manifest file.
+const Suffix = ".manifest.json"
+
+// Supported signers.
+const (
+	SignerCosign   = "cosign"
+	SignerMinisign = "minisign"
+)
+
+// Manifest records the checksum of a generated specification together with
+// the generator and source revision that produced it.
+type Manifest struct {
+	// Generator is the api2spec version that produced the spec
+	Generator string `json:"generator"`
+
+	// Commit is the source revision the spec was generated from
+	Commit string `json:"commit,omitempty"`
+
+	// Files lists the checksummed artifacts, relative to the manifest
+	Files []File `json:"files"`
+}
+
+// File is a checksummed artifact.
+type File struct {
+	// Path is the artifact path relative to the manifest
+	Path string `json:"path"`
+
+	// SHA256 is the hex-encoded SHA-256 digest of the artifact
+	SHA256 string `json:"sha256"`
+
+	// Size is the artifact size in bytes
+	Size int64 `json:"size"`
+}
+
+// PathFor returns the manifest path for a spec file.
+func PathFor(specPath string) string {
+	return specPath + Suffix
+}
+
+// New checksums the spec at specPath and returns its manifest.
+// The output is deterministic so regenerating an unchanged spec yields
+// an identical manifest.
+func New(specPath, generator, commit string) (*Manifest, error) {
+	digest, size, err := checksum(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{
+		Generator: generator,
+		Commit:    commit,
+		Files: []File{
+			{
+				Path:   filepath.Base(specPath),
+				SHA256: digest,
+				Size:   size,
+			},
+		},
+	}, nil
+}
+
+// Write writes the manifest as indented JSON.
+func (m *Manifest) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest %s: %w", path, err)
+	}
+	return nil
+}
+
+// Sign signs the file at path with the given signer and returns the path
+// of the detached signature. key is the signer's private key; when empty,
+// cosign signs keylessly and minisign uses its default key.
+func Sign(signer, key, path string) (string, error) {
+	var sigPath string
+	var args []string
+
+	switch signer {
+	case SignerCosign:
+		sigPath = path + ".sig"
+		args = []string{"sign-blob", "--yes", "--output-signature", sigPath}
+		if key != "" {
+			args = append(args, "--key", key)
+		}
+		args = append(args, path)
+	case SignerMinisign:
+		sigPath = path + ".minisig"
+		args = []string{"-S", "-m", path, "-x", sigPath}
+		if key != "" {
+			args = append(args, "-s", key)
+		}
+	default:
+		return "", fmt.Errorf("unsupported signer %q, must be %s or %s", signer, SignerCosign, SignerMinisign)
+	}
+
+	if _, err := exec.LookPath(signer); err != nil {
+		return "", fmt.Errorf("%s not found in PATH: %w", signer, err)
+	}
+
+	cmd := exec.Command(signer, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s failed to sign %s: %w", signer, path, err)
+	}
+	return sigPath, nil
+}
+
+// checksum returns the hex SHA-256 digest and size of the file at path.
+func checksum(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_WriteAndVerify(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "openapi.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte("openapi: 3.0.3\n"), 0644))
+
+	m, err := New(specPath, "1.2.3", "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", m.Generator)
+	assert.Equal(t, "abc123", m.Commit)
+	require.Len(t, m.Files, 1)
+	assert.Equal(t, "openapi.yaml", m.Files[0].Path)
+	assert.Equal(t, int64(15), m.Files[0].Size)
+	assert.Equal(t, "faa4988e76ddd0d66e9c95e3d7da6faecc44be96bf988ac35b22dd39213ff509", m.Files[0].SHA256)
+
+	manifestPath := PathFor(specPath)
+	assert.Equal(t, filepath.Join(dir, "openapi.yaml.manifest.json"), manifestPath)
+	require.NoError(t, m.Write(manifestPath))
+
+	first, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	again, err := New(specPath, "1.2.3", "abc123")
+	require.NoError(t, err)
+	require.NoError(t, again.Write(manifestPath))
+	second, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "manifest should be deterministic")
+	assert.Contains(t, string(first), `"sha256": "faa4988e76ddd0d66e9c95e3d7da6faecc44be96bf988ac35b22dd39213ff509"`)
+}
+
+func TestNew_MissingSpec(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.yaml"), "dev", "")
+	assert.Error(t, err)
+}
+
+func TestSign_UnsupportedSigner(t *testing.T) {
+	_, err := Sign("gpg", "", "openapi.yaml.manifest.json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported signer")
+}
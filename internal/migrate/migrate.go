@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package migrate compares a spec produced by an annotation-based generator
+// (swaggo, @nestjs/swagger) with api2spec's extraction and reports the
+// metadata adopting api2spec would lose, with config that preserves it.
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Source is the tool that generated a spec.
+type Source string
+
+const (
+	SourceSwaggo  Source = "swaggo"
+	SourceNestJS  Source = "nestjs"
+	SourceUnknown Source = "unknown"
+)
+
+// Spec is a spec to migrate from, with the Swagger 2.0 fields that have no
+// place in an OpenAPI 3 document.
+type Spec struct {
+	// Path is the file the spec was read from
+	Path string
+
+	// Doc is the spec's operations, schemas and metadata
+	Doc *types.OpenAPI
+
+	// Swagger2 reports whether the spec is a Swagger 2.0 document
+	Swagger2 bool
+
+	// BasePath prefixes every Swagger 2.0 path
+	BasePath string
+
+	// Servers are the OpenAPI 3 servers, or those derived from a Swagger
+	// 2.0 host and schemes; the basePath is part of the extracted paths
+	Servers []types.Server
+
+	// SecuritySchemes are the OpenAPI 3 security schemes, or those derived
+	// from Swagger 2.0 securityDefinitions
+	SecuritySchemes map[string]types.SecurityScheme
+
+	// Schemas are the component schemas, or Swagger 2.0 definitions
+	Schemas map[string]*types.Schema
+}
+
+// swagger2Fields are the Swagger 2.0 fields read besides the OpenAPI 3 ones.
+type swagger2Fields struct {
+	Swagger             string                          `json:"swagger" yaml:"swagger"`
+	Host                string                          `json:"host" yaml:"host"`
+	BasePath            string                          `json:"basePath" yaml:"basePath"`
+	Schemes             []string                        `json:"schemes" yaml:"schemes"`
+	SecurityDefinitions map[string]types.SecurityScheme `json:"securityDefinitions" yaml:"securityDefinitions"`
+	Definitions         map[string]*types.Schema        `json:"definitions" yaml:"definitions"`
+}
+
+// ReadSpec reads an OpenAPI 3 or Swagger 2.0 spec.
+func ReadSpec(path string) (*Spec, error) {
+	doc, err := openapi.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	var v2 swagger2Fields
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &v2)
+	} else {
+		err = yaml.Unmarshal(data, &v2)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	spec := &Spec{Path: path, Doc: doc, Servers: doc.Servers}
+	if doc.Components != nil {
+		spec.SecuritySchemes = doc.Components.SecuritySchemes
+		spec.Schemas = doc.Components.Schemas
+	}
+	if v2.Swagger != "" {
+		spec.Swagger2 = true
+		spec.BasePath = strings.TrimSuffix(v2.BasePath, "/")
+		spec.SecuritySchemes = convertSecurityDefinitions(v2.SecurityDefinitions)
+		spec.Schemas = v2.Definitions
+		if v2.Host != "" {
+			schemes := v2.Schemes
+			if len(schemes) == 0 {
+				schemes = []string{"https"}
+			}
+			for _, scheme := range schemes {
+				spec.Servers = append(spec.Servers, types.Server{URL: scheme + "://" + v2.Host})
+			}
+		}
+	}
+	return spec, nil
+}
+
+// convertSecurityDefinitions converts Swagger 2.0 basic auth to the OpenAPI
+// 3 http scheme; apiKey and oauth2 keep their type.
+func convertSecurityDefinitions(definitions map[string]types.SecurityScheme) map[string]types.SecurityScheme {
+	if len(definitions) == 0 {
+		return nil
+	}
+	schemes := make(map[string]types.SecurityScheme, len(definitions))
+	for name, scheme := range definitions {
+		if scheme.Type == "basic" {
+			scheme.Type = "http"
+			scheme.Scheme = "basic"
+		}
+		schemes[name] = scheme
+	}
+	return schemes
+}
+
+var (
+	// nestOperationIDRegex matches @nestjs/swagger's default operationIds
+	nestOperationIDRegex = regexp.MustCompile(`^\w+Controller_\w+$`)
+
+	// qualifiedSchemaRegex matches swaggo's package-qualified schema names
+	qualifiedSchemaRegex = regexp.MustCompile(`^\w+(?:[./]\w+)*\.\w+$`)
+)
+
+// DetectSource identifies the generator of a spec: swaggo qualifies schema
+// names with their Go package (handler.User), and @nestjs/swagger names
+// operations Controller_method.
+func DetectSource(spec *Spec) Source {
+	for _, item := range spec.Doc.Paths {
+		for _, op := range operations(item) {
+			if nestOperationIDRegex.MatchString(op.operation.OperationID) {
+				return SourceNestJS
+			}
+		}
+	}
+	for name := range spec.Schemas {
+		if qualifiedSchemaRegex.MatchString(name) {
+			return SourceSwaggo
+		}
+	}
+	if spec.Swagger2 {
+		return SourceSwaggo
+	}
+	return SourceUnknown
+}
+
+// methodOperation is an operation and its method.
+type methodOperation struct {
+	method    string
+	operation *types.Operation
+}
+
+// operations returns the operations of a path item in a fixed method order.
+func operations(item types.PathItem) []methodOperation {
+	var ops []methodOperation
+	for _, op := range []methodOperation{
+		{"GET", item.Get}, {"PUT", item.Put}, {"POST", item.Post}, {"DELETE", item.Delete},
+		{"OPTIONS", item.Options}, {"HEAD", item.Head}, {"PATCH", item.Patch}, {"TRACE", item.Trace},
+	} {
+		if op.operation != nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const swaggoSpec = `swagger: "2.0"
+info:
+  title: Orders API
+  version: "1.0"
+host: api.example.com
+basePath: /api/v1
+schemes: [https]
+securityDefinitions:
+  ApiKeyAuth:
+    type: apiKey
+    in: header
+    name: Authorization
+paths:
+  /orders/{id}:
+    get:
+      summary: Get an order
+      operationId: getOrder
+      tags: [orders]
+      parameters:
+        - name: id
+          in: path
+          required: true
+          type: integer
+        - name: expand
+          in: query
+          description: Related objects to include
+          type: string
+      responses:
+        "200":
+          description: OK
+        "404":
+          description: Order not found
+  /legacy:
+    get:
+      summary: Legacy endpoint
+      responses:
+        "200":
+          description: OK
+definitions:
+  handler.Order:
+    type: object
+    description: A customer order
+    properties:
+      id:
+        type: integer
+`
+
+func writeSpec(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestReadSpec_Swagger2(t *testing.T) {
+	spec, err := ReadSpec(writeSpec(t, "swagger.yaml", swaggoSpec))
+	require.NoError(t, err)
+
+	assert.True(t, spec.Swagger2)
+	assert.Equal(t, "/api/v1", spec.BasePath)
+	assert.Equal(t, []types.Server{{URL: "https://api.example.com"}}, spec.Servers)
+	assert.Equal(t, "apiKey", spec.SecuritySchemes["ApiKeyAuth"].Type)
+	assert.Contains(t, spec.Schemas, "handler.Order")
+	assert.Equal(t, SourceSwaggo, DetectSource(spec))
+}
+
+func TestDetectSource_NestJS(t *testing.T) {
+	spec := &Spec{Doc: &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users": {Get: &types.Operation{OperationID: "UsersController_findAll"}},
+	}}}
+	assert.Equal(t, SourceNestJS, DetectSource(spec))
+
+	spec.Doc.Paths["/users"].Get.OperationID = "listUsers"
+	assert.Equal(t, SourceUnknown, DetectSource(spec))
+}
+
+func TestCompare_Swagger2(t *testing.T) {
+	spec, err := ReadSpec(writeSpec(t, "swagger.yaml", swaggoSpec))
+	require.NoError(t, err)
+
+	generated := &types.OpenAPI{
+		Info: types.Info{Title: "API", Version: "1.0"},
+		Paths: map[string]types.PathItem{
+			"/api/v1/orders/{orderId}": {Get: &types.Operation{
+				OperationID: "getApiV1OrdersOrderId",
+				Tags:        []string{"orders"},
+				Parameters:  []types.Parameter{{Name: "orderId", In: "path"}},
+				Responses:   map[string]types.Response{"200": {Description: "OK"}},
+			}},
+			"/api/v1/health": {Get: &types.Operation{}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"Order": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "integer"}}},
+		}},
+	}
+
+	report := Compare(spec, generated)
+	assert.Equal(t, 1, report.Matched)
+	assert.Equal(t, 1, report.Unmatched)
+	assert.Equal(t, 1, report.Extracted)
+
+	var findings []string
+	for _, f := range report.Findings {
+		findings = append(findings, f.String())
+	}
+	assert.Equal(t, []string{
+		`info: title "Orders API" (config)`,
+		`servers: url "https://api.example.com" (config)`,
+		`securitySchemes: ApiKeyAuth "apiKey" (config)`,
+		`GET /api/v1/legacy: operation "Legacy endpoint" (lost)`,
+		`GET /api/v1/orders/{id}: summary "Get an order" (lost)`,
+		`GET /api/v1/orders/{id}: operationId "getOrder" (config)`,
+		`GET /api/v1/orders/{id}: query parameter expand "Related objects to include" (lost)`,
+		`GET /api/v1/orders/{id}: response 404 "Order not found" (lost)`,
+		`schema handler.Order: description "A customer order" (lost)`,
+	}, findings)
+
+	suggested, err := report.SuggestedConfig()
+	require.NoError(t, err)
+	assert.Equal(t, `openapi:
+  info:
+    title: Orders API
+  servers:
+    - url: https://api.example.com
+  security:
+    schemes:
+      ApiKeyAuth:
+        type: apiKey
+        name: Authorization
+        in: header
+generation:
+  operations:
+    - operation: GET /api/v1/orders/{orderId}
+      operationId: getOrder
+`, suggested)
+}
+
+func TestCompare_Merge(t *testing.T) {
+	spec := &Spec{
+		Path: "openapi.json",
+		Doc: &types.OpenAPI{Paths: map[string]types.PathItem{
+			"/users/{id}": {Get: &types.Operation{
+				Summary:     "Get a user",
+				Description: "Returns one user",
+				Security:    []map[string][]string{{"bearer": {}}},
+				Responses:   map[string]types.Response{"200": {Description: "The user"}, "404": {Description: "Not found"}},
+			}},
+		}},
+	}
+	generated := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users/{id}": {Get: &types.Operation{
+			Summary:   "Get user",
+			Responses: map[string]types.Response{"200": {}},
+		}},
+	}}
+
+	report := Compare(spec, generated)
+
+	var findings []string
+	for _, f := range report.Findings {
+		findings = append(findings, f.String())
+	}
+	assert.Equal(t, []string{
+		`GET /users/{id}: summary "Get a user" (lost)`,
+		`GET /users/{id}: description "Returns one user" (merge)`,
+		`GET /users/{id}: security "bearer" (merge)`,
+		`GET /users/{id}: response 200 description "The user" (merge)`,
+		`GET /users/{id}: response 404 "Not found" (merge)`,
+	}, findings)
+
+	suggested, err := report.SuggestedConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "generation:\n  merge: true\n  existing: openapi.json\n", suggested)
+}
@@ -0,0 +1,542 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Preservation is how annotated metadata can survive the migration.
+type Preservation string
+
+const (
+	// PreserveConfig means the suggested config keeps it
+	PreserveConfig Preservation = "config"
+
+	// PreserveMerge means merging generation into the existing spec keeps it
+	PreserveMerge Preservation = "merge"
+
+	// PreserveNone means it would be lost
+	PreserveNone Preservation = "lost"
+)
+
+// Finding is annotated metadata api2spec's extraction does not reproduce.
+type Finding struct {
+	// Location is the operation (GET /users), schema (schema User) or
+	// document section (info, servers, ...) the metadata belongs to
+	Location string
+
+	// Field names the metadata (summary, operationId, response 404, ...)
+	Field string
+
+	// Value is the annotated value
+	Value string
+
+	// Preserve is how the metadata can be kept
+	Preserve Preservation
+}
+
+// String formats the finding as "location: field "value" (preserve)".
+func (f Finding) String() string {
+	s := f.Location + ": " + f.Field
+	if f.Value != "" {
+		s += fmt.Sprintf(" %q", f.Value)
+	}
+	return s + " (" + string(f.Preserve) + ")"
+}
+
+// Report is the result of comparing an annotated spec with api2spec's
+// extraction.
+type Report struct {
+	// Source is the generator of the annotated spec
+	Source Source
+
+	// Matched counts the annotated operations api2spec also extracts
+	Matched int
+
+	// Unmatched counts the annotated operations api2spec does not extract
+	Unmatched int
+
+	// Extracted counts the operations only api2spec extracts
+	Extracted int
+
+	// Findings are the annotated metadata the extraction lacks
+	Findings []Finding
+
+	suggestion suggestion
+}
+
+// Count returns the number of findings preserved in the given way.
+func (r *Report) Count(preserve Preservation) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Preserve == preserve {
+			n++
+		}
+	}
+	return n
+}
+
+// suggestion is the config that preserves the findings it can.
+type suggestion struct {
+	OpenAPI    *openAPISuggestion    `yaml:"openapi,omitempty"`
+	Generation *generationSuggestion `yaml:"generation,omitempty"`
+}
+
+type openAPISuggestion struct {
+	Info     *infoSuggestion     `yaml:"info,omitempty"`
+	Servers  []serverSuggestion  `yaml:"servers,omitempty"`
+	Tags     []tagSuggestion     `yaml:"tags,omitempty"`
+	Security *securitySuggestion `yaml:"security,omitempty"`
+}
+
+type infoSuggestion struct {
+	Title       string `yaml:"title,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version,omitempty"`
+}
+
+type serverSuggestion struct {
+	URL         string `yaml:"url"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type tagSuggestion struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type securitySuggestion struct {
+	Schemes map[string]schemeSuggestion `yaml:"schemes,omitempty"`
+	Default []string                    `yaml:"default,omitempty"`
+}
+
+type schemeSuggestion struct {
+	Type         string `yaml:"type"`
+	Name         string `yaml:"name,omitempty"`
+	In           string `yaml:"in,omitempty"`
+	Scheme       string `yaml:"scheme,omitempty"`
+	BearerFormat string `yaml:"bearerFormat,omitempty"`
+	Description  string `yaml:"description,omitempty"`
+}
+
+type generationSuggestion struct {
+	Merge      bool                  `yaml:"merge,omitempty"`
+	Existing   string                `yaml:"existing,omitempty"`
+	Operations []operationSuggestion `yaml:"operations,omitempty"`
+}
+
+type operationSuggestion struct {
+	Operation   string   `yaml:"operation"`
+	OperationID string   `yaml:"operationId,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+}
+
+// SuggestedConfig returns the .api2spec.yaml fragment that preserves the
+// config and merge findings, or "" if there are none.
+func (r *Report) SuggestedConfig() (string, error) {
+	if r.suggestion.OpenAPI == nil && r.suggestion.Generation == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(r.suggestion); err != nil {
+		return "", fmt.Errorf("failed to marshal suggested config: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal suggested config: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// Compare reports the metadata in spec that the generated document lacks.
+func Compare(spec *Spec, generated *types.OpenAPI) *Report {
+	c := &comparison{
+		spec:      spec,
+		generated: generated,
+		report:    &Report{Source: DetectSource(spec)},
+		// A Swagger 2.0 document cannot be merged into OpenAPI 3 output
+		mergeable: !spec.Swagger2,
+	}
+	c.info()
+	c.servers()
+	c.securitySchemes()
+	c.tags()
+	c.operations()
+	c.schemas()
+	return c.report
+}
+
+// comparison accumulates a Report.
+type comparison struct {
+	spec      *Spec
+	generated *types.OpenAPI
+	report    *Report
+	mergeable bool
+}
+
+func (c *comparison) add(location, field, value string, preserve Preservation) {
+	if preserve == PreserveMerge {
+		if !c.mergeable {
+			preserve = PreserveNone
+		} else {
+			generation := c.generation()
+			generation.Merge = true
+			generation.Existing = c.spec.Path
+		}
+	}
+	c.report.Findings = append(c.report.Findings, Finding{Location: location, Field: field, Value: value, Preserve: preserve})
+}
+
+// fillable returns PreserveMerge when the generated value is empty, since
+// merging only fills in what generation leaves out.
+func fillable(generated string) Preservation {
+	if generated == "" {
+		return PreserveMerge
+	}
+	return PreserveNone
+}
+
+func (c *comparison) openAPI() *openAPISuggestion {
+	if c.report.suggestion.OpenAPI == nil {
+		c.report.suggestion.OpenAPI = &openAPISuggestion{}
+	}
+	return c.report.suggestion.OpenAPI
+}
+
+func (c *comparison) generation() *generationSuggestion {
+	if c.report.suggestion.Generation == nil {
+		c.report.suggestion.Generation = &generationSuggestion{}
+	}
+	return c.report.suggestion.Generation
+}
+
+func (c *comparison) info() {
+	annotated, generated := c.spec.Doc.Info, c.generated.Info
+	var info infoSuggestion
+	if annotated.Title != "" && annotated.Title != generated.Title {
+		info.Title = annotated.Title
+		c.add("info", "title", annotated.Title, PreserveConfig)
+	}
+	if annotated.Description != "" && annotated.Description != generated.Description {
+		info.Description = annotated.Description
+		c.add("info", "description", annotated.Description, PreserveConfig)
+	}
+	if annotated.Version != "" && annotated.Version != generated.Version {
+		info.Version = annotated.Version
+		c.add("info", "version", annotated.Version, PreserveConfig)
+	}
+	if info != (infoSuggestion{}) {
+		c.openAPI().Info = &info
+	}
+}
+
+func (c *comparison) servers() {
+	generated := make(map[string]bool)
+	for _, server := range c.generated.Servers {
+		generated[server.URL] = true
+	}
+	for _, server := range c.spec.Servers {
+		if generated[server.URL] {
+			continue
+		}
+		c.add("servers", "url", server.URL, PreserveConfig)
+		c.openAPI().Servers = append(c.openAPI().Servers, serverSuggestion{URL: server.URL, Description: server.Description})
+	}
+}
+
+func (c *comparison) securitySchemes() {
+	var generated map[string]types.SecurityScheme
+	if c.generated.Components != nil {
+		generated = c.generated.Components.SecuritySchemes
+	}
+
+	names := make([]string, 0, len(c.spec.SecuritySchemes))
+	for name := range c.spec.SecuritySchemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := generated[name]; ok {
+			continue
+		}
+		scheme := c.spec.SecuritySchemes[name]
+		// Config has no place for OAuth flows or OpenID Connect discovery
+		if scheme.Type != "apiKey" && scheme.Type != "http" {
+			c.add("securitySchemes", name, scheme.Type, PreserveNone)
+			continue
+		}
+		c.add("securitySchemes", name, scheme.Type, PreserveConfig)
+		security := c.security()
+		security.Schemes[name] = schemeSuggestion{
+			Type:         scheme.Type,
+			Name:         scheme.Name,
+			In:           scheme.In,
+			Scheme:       scheme.Scheme,
+			BearerFormat: scheme.BearerFormat,
+			Description:  scheme.Description,
+		}
+	}
+
+	if len(c.generated.Security) == 0 {
+		for _, requirement := range c.spec.Doc.Security {
+			for name := range requirement {
+				if _, ok := c.spec.SecuritySchemes[name]; ok {
+					c.add("security", "default", name, PreserveConfig)
+					c.security().Default = append(c.security().Default, name)
+				}
+			}
+		}
+	}
+}
+
+func (c *comparison) security() *securitySuggestion {
+	openAPI := c.openAPI()
+	if openAPI.Security == nil {
+		openAPI.Security = &securitySuggestion{Schemes: make(map[string]schemeSuggestion)}
+	}
+	return openAPI.Security
+}
+
+func (c *comparison) tags() {
+	generated := make(map[string]string)
+	for _, tag := range c.generated.Tags {
+		generated[tag.Name] = tag.Description
+	}
+	for _, tag := range c.spec.Doc.Tags {
+		if tag.Description == "" || generated[tag.Name] == tag.Description {
+			continue
+		}
+		c.add("tags", tag.Name, tag.Description, PreserveConfig)
+		c.openAPI().Tags = append(c.openAPI().Tags, tagSuggestion{Name: tag.Name, Description: tag.Description})
+	}
+}
+
+// operationKey identifies an operation regardless of parameter names.
+func operationKey(method, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = "{}"
+		}
+	}
+	return method + " /" + strings.Join(segments, "/")
+}
+
+// generatedOperation is an extracted operation and the path it is on.
+type generatedOperation struct {
+	path      string
+	operation *types.Operation
+	matched   bool
+}
+
+func (c *comparison) operations() {
+	generated := make(map[string]*generatedOperation)
+	for path, item := range c.generated.Paths {
+		for _, op := range operations(item) {
+			generated[operationKey(op.method, path)] = &generatedOperation{path: path, operation: op.operation}
+		}
+	}
+
+	for _, path := range openapi.SortedPaths(c.spec.Doc.Paths) {
+		fullPath := c.spec.BasePath + path
+		for _, op := range operations(c.spec.Doc.Paths[path]) {
+			location := op.method + " " + fullPath
+			match, ok := generated[operationKey(op.method, fullPath)]
+			if !ok {
+				c.report.Unmatched++
+				c.add(location, "operation", op.operation.Summary, PreserveNone)
+				continue
+			}
+			match.matched = true
+			c.report.Matched++
+			c.operation(location, config.OperationKey(op.method, match.path), op.operation, match.operation)
+		}
+	}
+
+	for _, op := range generated {
+		if !op.matched {
+			c.report.Extracted++
+		}
+	}
+}
+
+func (c *comparison) operation(location, key string, annotated, generated *types.Operation) {
+	if annotated.Summary != "" && annotated.Summary != generated.Summary {
+		c.add(location, "summary", annotated.Summary, fillable(generated.Summary))
+	}
+	if annotated.Description != "" && annotated.Description != generated.Description {
+		c.add(location, "description", annotated.Description, fillable(generated.Description))
+	}
+
+	override := operationSuggestion{Operation: key}
+	if annotated.OperationID != "" && annotated.OperationID != generated.OperationID {
+		override.OperationID = annotated.OperationID
+		c.add(location, "operationId", annotated.OperationID, PreserveConfig)
+	}
+	if len(annotated.Tags) > 0 && !sameTags(annotated.Tags, generated.Tags) {
+		override.Tags = annotated.Tags
+		c.add(location, "tags", strings.Join(annotated.Tags, ", "), PreserveConfig)
+	}
+	if override.OperationID != "" || len(override.Tags) > 0 {
+		c.generation().Operations = append(c.generation().Operations, override)
+	}
+
+	if annotated.Deprecated && !generated.Deprecated {
+		c.add(location, "deprecated", "", PreserveNone)
+	}
+	if len(annotated.Security) > 0 && len(generated.Security) == 0 {
+		c.add(location, "security", requirementNames(annotated.Security), PreserveMerge)
+	}
+
+	for _, param := range annotated.Parameters {
+		// Path parameters match with the path; Swagger 2.0 body and form
+		// parameters are request bodies in OpenAPI 3
+		if param.In == "path" || param.In == "body" || param.In == "formData" {
+			continue
+		}
+		field := fmt.Sprintf("%s parameter %s", param.In, param.Name)
+		match := findParameter(generated.Parameters, param)
+		switch {
+		case match == nil && len(generated.Parameters) == 0:
+			c.add(location, field, param.Description, PreserveMerge)
+		case match == nil:
+			c.add(location, field, param.Description, PreserveNone)
+		case param.Description != "" && param.Description != match.Description:
+			c.add(location, field+" description", param.Description, fillable(match.Description))
+		}
+	}
+
+	codes := make([]string, 0, len(annotated.Responses))
+	for code := range annotated.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		response := annotated.Responses[code]
+		match, ok := generated.Responses[code]
+		switch {
+		case !ok:
+			// Merging keeps documented responses the code does not produce
+			c.add(location, "response "+code, response.Description, PreserveMerge)
+		case response.Description != "" && response.Description != match.Description:
+			c.add(location, "response "+code+" description", response.Description, fillable(match.Description))
+		}
+	}
+}
+
+func (c *comparison) schemas() {
+	var generated map[string]*types.Schema
+	if c.generated.Components != nil {
+		generated = c.generated.Components.Schemas
+	}
+
+	names := make([]string, 0, len(c.spec.Schemas))
+	for name := range c.spec.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		annotated := c.spec.Schemas[name]
+		if annotated == nil {
+			continue
+		}
+		location := "schema " + name
+
+		// swaggo qualifies names with their package; api2spec does not
+		generatedName := name
+		match, ok := generated[name]
+		if !ok {
+			generatedName = name[strings.LastIndexAny(name, "./")+1:]
+			match, ok = generated[generatedName]
+		}
+		if !ok || match == nil {
+			c.add(location, "schema", annotated.Description, PreserveNone)
+			continue
+		}
+
+		// Merging matches schemas by name
+		preserve := func(generated string) Preservation {
+			if generatedName != name {
+				return PreserveNone
+			}
+			return fillable(generated)
+		}
+		if annotated.Description != "" && annotated.Description != match.Description {
+			c.add(location, "description", annotated.Description, preserve(match.Description))
+		}
+
+		props := make([]string, 0, len(annotated.Properties))
+		for prop := range annotated.Properties {
+			props = append(props, prop)
+		}
+		sort.Strings(props)
+		for _, prop := range props {
+			description := ""
+			if annotated.Properties[prop] != nil {
+				description = annotated.Properties[prop].Description
+			}
+			generatedProp, ok := match.Properties[prop]
+			switch {
+			case !ok:
+				c.add(location, "property "+prop, description, preserve(""))
+			case description != "" && (generatedProp == nil || description != generatedProp.Description):
+				generatedDescription := ""
+				if generatedProp != nil {
+					generatedDescription = generatedProp.Description
+				}
+				c.add(location, "property "+prop+" description", description, preserve(generatedDescription))
+			}
+		}
+	}
+}
+
+// findParameter returns the parameter in params with param's name and location.
+func findParameter(params []types.Parameter, param types.Parameter) *types.Parameter {
+	for i := range params {
+		if params[i].Name == param.Name && params[i].In == param.In {
+			return &params[i]
+		}
+	}
+	return nil
+}
+
+// sameTags reports whether two tag lists hold the same tags.
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool, len(a))
+	for _, tag := range a {
+		seen[tag] = true
+	}
+	for _, tag := range b {
+		if !seen[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// requirementNames lists the scheme names of security requirements.
+func requirementNames(requirements []map[string][]string) string {
+	var names []string
+	for _, requirement := range requirements {
+		for name := range requirement {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// serverGeneratedFields are property names whose values the server assigns,
+// such as primary keys and ORM timestamps.
+var serverGeneratedFields = map[string]bool{
+	"id": true, "_id": true,
+	"created_at": true, "createdAt": true,
+	"updated_at": true, "updatedAt": true,
+	"deleted_at": true, "deletedAt": true,
+}
+
+// secretFields are property names clients send but the server never returns.
+var secretFields = map[string]bool{
+	"password": true, "password_confirmation": true, "passwordConfirmation": true,
+	"current_password": true, "currentPassword": true,
+	"new_password": true, "newPassword": true,
+}
+
+// inputSchemaPrefixes and inputSchemaSuffixes name request DTOs, whose
+// identifiers and timestamps are client-supplied (e.g., UpdateUserRequest).
+var (
+	inputSchemaPrefixes = []string{"Create", "Update", "Patch", "New"}
+	inputSchemaSuffixes = []string{"Request", "Input", "Params", "Payload", "Body", "Command", "Form"}
+)
+
+// InferAccessModes marks the server-generated properties of a schema
+// readOnly and its password properties writeOnly, so one schema serves
+// both requests and responses. Request DTOs keep their identifiers
+// writable, and properties already marked either way are left alone.
+func InferAccessModes(name string, schema *types.Schema) {
+	input := isInputSchema(name)
+	for prop, propSchema := range schema.Properties {
+		if propSchema == nil || propSchema.Ref != "" || propSchema.ReadOnly || propSchema.WriteOnly {
+			continue
+		}
+		switch {
+		case secretFields[prop]:
+			propSchema.WriteOnly = true
+		case serverGeneratedFields[prop] && !input:
+			propSchema.ReadOnly = true
+		}
+	}
+}
+
+// isInputSchema reports whether a schema name looks like a request DTO.
+func isInputSchema(name string) bool {
+	for _, prefix := range inputSchemaPrefixes {
+		if len(name) > len(prefix) && strings.HasPrefix(name, prefix) && isUpper(name[len(prefix)]) {
+			return true
+		}
+	}
+	for _, suffix := range inputSchemaSuffixes {
+		if len(name) > len(suffix) && strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUpper reports whether c is an ASCII upper-case letter.
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
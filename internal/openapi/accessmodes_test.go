@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestInferAccessModes(t *testing.T) {
+	newSchema := func() *types.Schema {
+		return &types.Schema{
+			Type: "object",
+			Properties: map[string]*types.Schema{
+				"id":        {Type: "integer"},
+				"createdAt": {Type: "string", Format: "date-time"},
+				"password":  {Type: "string"},
+				"name":      {Type: "string"},
+				"_id":       {Type: "string", WriteOnly: true},
+				"updatedAt": {Ref: "#/components/schemas/Timestamp"},
+			},
+		}
+	}
+
+	user := newSchema()
+	InferAccessModes("User", user)
+	assert.True(t, user.Properties["id"].ReadOnly)
+	assert.True(t, user.Properties["createdAt"].ReadOnly)
+	assert.True(t, user.Properties["password"].WriteOnly)
+	assert.False(t, user.Properties["name"].ReadOnly)
+	assert.False(t, user.Properties["_id"].ReadOnly, "explicit writeOnly is kept")
+	assert.False(t, user.Properties["updatedAt"].ReadOnly, "references are left alone")
+
+	for _, name := range []string{"UpdateUserRequest", "CreateUser", "UserInput"} {
+		input := newSchema()
+		InferAccessModes(name, input)
+		assert.False(t, input.Properties["id"].ReadOnly, name)
+		assert.True(t, input.Properties["password"].WriteOnly, name)
+	}
+
+	// Names that merely start with a verb are not request DTOs
+	news := newSchema()
+	InferAccessModes("Newsletter", news)
+	assert.True(t, news.Properties["id"].ReadOnly)
+}
+
+func TestBuilder_Build_AccessModesDisabled(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.AccessModes = false
+
+	doc, err := NewBuilder(cfg).Build(nil, []types.Schema{{
+		Title:      "User",
+		Type:       "object",
+		Properties: map[string]*types.Schema{"id": {Type: "integer"}},
+	}})
+	require.NoError(t, err)
+	assert.False(t, doc.Components.Schemas["User"].Properties["id"].ReadOnly)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtAliases lists the other methods an operation is registered under.
+const ExtAliases = "x-aliases"
+
+// methodAliases are groups of methods frameworks register one handler
+// under, such as PUT and PATCH for a Laravel resource's update; the first
+// method of a group is the one documented.
+var methodAliases = [][]string{
+	{"PUT", "PATCH"},
+	{"GET", "HEAD"},
+}
+
+// CollapseMethodAliases returns routes with the registrations of one
+// handler on one path under alias methods (PUT and PATCH, GET and HEAD)
+// collapsed into the route of the group's first method, which lists the
+// other methods in x-aliases. Routes without a handler name are the same
+// registration when they share a source line.
+func CollapseMethodAliases(routes []types.Route) []types.Route {
+	// Routes of one registration, by alias group
+	registrations := make(map[string][]int)
+	var keys []string
+	for i, route := range routes {
+		group := aliasGroup(route.Method)
+		if group < 0 {
+			continue
+		}
+		registration := route.Handler
+		if registration == "" {
+			if route.SourceFile == "" {
+				continue
+			}
+			registration = fmt.Sprintf("%s:%d", route.SourceFile, route.SourceLine)
+		}
+		key := fmt.Sprintf("%d %s %s", group, route.Path, registration)
+		if _, ok := registrations[key]; !ok {
+			keys = append(keys, key)
+		}
+		registrations[key] = append(registrations[key], i)
+	}
+
+	dropped := make(map[int]bool)
+	aliases := make(map[int][]string)
+	for _, key := range keys {
+		indices := registrations[key]
+		if len(indices) < 2 {
+			continue
+		}
+		group := methodAliases[aliasGroup(routes[indices[0]].Method)]
+		primary := slices.MinFunc(indices, func(a, b int) int {
+			return slices.Index(group, strings.ToUpper(routes[a].Method)) - slices.Index(group, strings.ToUpper(routes[b].Method))
+		})
+		for _, i := range indices {
+			method := strings.ToUpper(routes[i].Method)
+			if i == primary || method == strings.ToUpper(routes[primary].Method) {
+				continue
+			}
+			dropped[i] = true
+			if !slices.Contains(aliases[primary], method) {
+				aliases[primary] = append(aliases[primary], method)
+			}
+		}
+	}
+	if len(dropped) == 0 {
+		return routes
+	}
+
+	collapsed := make([]types.Route, 0, len(routes)-len(dropped))
+	for i, route := range routes {
+		if dropped[i] {
+			continue
+		}
+		if methods, ok := aliases[i]; ok {
+			// Extensions may be shared with other routes
+			extensions := maps.Clone(route.Extensions)
+			if extensions == nil {
+				extensions = make(types.Extensions)
+			}
+			extensions[ExtAliases] = methods
+			route.Extensions = extensions
+		}
+		collapsed = append(collapsed, route)
+	}
+	return collapsed
+}
+
+// aliasGroup returns the index of the alias group of method, or -1.
+func aliasGroup(method string) int {
+	method = strings.ToUpper(method)
+	return slices.IndexFunc(methodAliases, func(group []string) bool {
+		return slices.Contains(group, method)
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestCollapseMethodAliases(t *testing.T) {
+	shared := types.Extensions{"x-source": "resource"}
+	routes := []types.Route{
+		{Method: "PATCH", Path: "/photos/{photo}", Handler: "PhotoController@update", Extensions: shared},
+		{Method: "PUT", Path: "/photos/{photo}", Handler: "PhotoController@update", Extensions: shared},
+		{Method: "DELETE", Path: "/photos/{photo}", Handler: "PhotoController@destroy"},
+		// Different handlers are different operations
+		{Method: "PUT", Path: "/users/{id}", Handler: "replaceUser"},
+		{Method: "PATCH", Path: "/users/{id}", Handler: "patchUser"},
+		// Inline handlers registered on one line
+		{Method: "GET", Path: "/status", SourceFile: "app.py", SourceLine: 12},
+		{Method: "HEAD", Path: "/status", SourceFile: "app.py", SourceLine: 12},
+	}
+
+	collapsed := CollapseMethodAliases(routes)
+
+	require.Len(t, collapsed, 5)
+	assert.Equal(t, "PUT", collapsed[0].Method)
+	assert.Equal(t, []string{"PATCH"}, collapsed[0].Extensions[ExtAliases])
+	assert.Equal(t, "resource", collapsed[0].Extensions["x-source"])
+	assert.NotContains(t, shared, ExtAliases)
+	assert.Equal(t, "DELETE", collapsed[1].Method)
+	assert.Equal(t, []string{"PUT", "PATCH"}, []string{collapsed[2].Method, collapsed[3].Method})
+	assert.Nil(t, collapsed[2].Extensions)
+	assert.Equal(t, "GET", collapsed[4].Method)
+	assert.Equal(t, []string{"HEAD"}, collapsed[4].Extensions[ExtAliases])
+}
+
+func TestCollapseMethodAliases_None(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", Handler: "listUsers"},
+		{Method: "POST", Path: "/users", Handler: "listUsers"},
+	}
+
+	assert.Equal(t, routes, CollapseMethodAliases(routes))
+}
@@ -6,6 +6,8 @@ package openapi
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -16,6 +18,12 @@ import (
 // Builder constructs OpenAPI specifications from routes and schemas.
 type Builder struct {
 	config *config.Config
+
+	// sourceLink returns the URL of a route's source location, if set
+	sourceLink func(file string, line int) string
+
+	// tenantHost is the per-tenant host detected in the source code, if any
+	tenantHost *HostTemplate
 }
 
 // NewBuilder creates a new OpenAPI builder with the given configuration.
@@ -25,6 +33,20 @@ func NewBuilder(cfg *config.Config) *Builder {
 	}
 }
 
+// WithSourceLinks sets the function used to link each operation's
+// externalDocs to the source line where its route is defined.
+func (b *Builder) WithSourceLinks(link func(file string, line int) string) *Builder {
+	b.sourceLink = link
+	return b
+}
+
+// WithTenantHost sets the per-tenant host detected in the source code,
+// used unless the configuration names one.
+func (b *Builder) WithTenantHost(host HostTemplate) *Builder {
+	b.tenantHost = &host
+	return b
+}
+
 // Build creates an OpenAPI document from routes and schemas.
 func (b *Builder) Build(routes []types.Route, schemas []types.Schema) (*types.OpenAPI, error) {
 	doc := &types.OpenAPI{
@@ -35,16 +57,82 @@ func (b *Builder) Build(routes []types.Route, schemas []types.Schema) (*types.Op
 		Tags:    b.buildTags(),
 	}
 
+	// Template the server host per tenant
+	if host := b.hostTemplate(); host != nil {
+		TemplateServers(doc, *host)
+	}
+
+	// Document a handler registered under PUT and PATCH once
+	if b.config.Generation.MethodAliases == "collapse" {
+		routes = CollapseMethodAliases(routes)
+	}
+
 	// Build paths from routes
 	if err := b.buildPaths(doc, routes); err != nil {
 		return nil, fmt.Errorf("failed to build paths: %w", err)
 	}
 
+	// Declare each parameter once per path item and operation
+	DedupeParameters(doc)
+
+	// Document a CORS policy covering every operation once
+	HoistCORS(doc)
+
 	// Build components from schemas
 	if len(schemas) > 0 {
-		doc.Components = b.buildComponents(schemas)
+		var inline []string
+		doc.Components, inline = b.buildComponents(schemas)
+		if b.config.Generation.SchemaVariants {
+			SplitVariants(doc)
+		}
+		InlineSchemas(doc, inline)
 	}
 
+	// Document request bodies of GET, HEAD and DELETE by policy
+	ApplyMethodBodies(doc, b.config.Generation.MethodBodies)
+
+	// Keep deeply nested inline objects out of operations
+	if depth := b.config.Generation.MaxInlineDepth; depth > 0 {
+		ExtractDeepSchemas(doc, depth)
+	}
+
+	// Give parameters from every language the same naming style
+	if style := b.config.Generation.ParameterCase; style != "" {
+		NormalizeParameterNames(doc, style)
+	}
+
+	// Give path parameters their configured schemas
+	ApplyPathParams(doc, b.config.Generation.PathParams)
+
+	// Document the response envelope added or stripped by middleware
+	if env := b.config.Generation.Envelope; env.Mode != "" {
+		ApplyEnvelope(doc, EnvelopeOptions{
+			Mode:         env.Mode,
+			Field:        env.Field,
+			Schema:       env.Schema,
+			Statuses:     env.Statuses,
+			ExcludePaths: env.ExcludePaths,
+		})
+	}
+
+	// Link responses to the operations on the items they identify
+	if b.config.Generation.Links {
+		envelope := ""
+		if b.config.Generation.Envelope.Mode == EnvelopeWrap {
+			envelope = b.config.Generation.Envelope.Field
+		}
+		InferLinks(doc, envelope)
+	}
+
+	// Document service levels and override detected timeouts
+	ApplySLAs(doc, b.config.Generation.SLAs)
+
+	// Document the release stage and audience of operations
+	ApplyLifecycle(doc, b.config.Generation.Lifecycle.Rules)
+
+	// Document streaming and long-polling operations
+	ApplyStreaming(doc, b.config.Generation.Streaming.Rules)
+
 	// Add security if configured
 	if len(b.config.OpenAPI.Security.Schemes) > 0 {
 		doc.Security = b.buildSecurity()
@@ -54,9 +142,58 @@ func (b *Builder) Build(routes []types.Route, schemas []types.Schema) (*types.Op
 		doc.Components.SecuritySchemes = b.buildSecuritySchemes()
 	}
 
+	// Show ready-to-copy requests in Redoc and Scalar
+	if languages := b.config.Generation.CodeSamples; len(languages) > 0 {
+		AddCodeSamples(doc, languages)
+	}
+
+	// Keep references to schemas that were not extracted from dangling;
+	// routes-only specs have no schemas to reference
+	if policy := b.config.Generation.DanglingRefs; policy != DanglingRefsIgnore && b.config.Generation.Mode != "routes-only" {
+		dangling := DanglingRefs(doc)
+		if policy == DanglingRefsFail && len(dangling) > 0 {
+			missing := make([]string, len(dangling))
+			for i, ref := range dangling {
+				missing[i] = ref.String()
+			}
+			return nil, fmt.Errorf("references to missing schemas:\n  %s", strings.Join(missing, "\n  "))
+		}
+		StubDanglingRefs(doc, dangling)
+	}
+
+	if order := b.config.Generation.PathOrder; order != "" {
+		OrderPaths(doc, order, b.sourceOrder(routes))
+	}
+
 	return doc, nil
 }
 
+// sourceOrder returns the paths of routes ordered by the file and line
+// that define them.
+func (b *Builder) sourceOrder(routes []types.Route) []string {
+	sorted := append([]types.Route(nil), routes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].SourceFile != sorted[j].SourceFile {
+			return sorted[i].SourceFile < sorted[j].SourceFile
+		}
+		return sorted[i].SourceLine < sorted[j].SourceLine
+	})
+
+	var order []string
+	seen := make(map[string]bool, len(sorted))
+	for _, route := range sorted {
+		path := route.Path
+		if style := b.config.Generation.ParameterCase; style != "" {
+			path = convertTemplate(path, style)
+		}
+		if !seen[path] {
+			seen[path] = true
+			order = append(order, path)
+		}
+	}
+	return order
+}
+
 // buildInfo constructs the Info object from configuration.
 func (b *Builder) buildInfo() types.Info {
 	info := types.Info{
@@ -88,16 +225,110 @@ func (b *Builder) buildInfo() types.Info {
 
 // buildServers constructs the servers list from configuration.
 func (b *Builder) buildServers() []types.Server {
-	servers := make([]types.Server, 0, len(b.config.OpenAPI.Servers))
-	for _, s := range b.config.OpenAPI.Servers {
-		servers = append(servers, types.Server{
+	return serversFromConfig(b.config.OpenAPI.Servers)
+}
+
+// ApplyProfile overrides the info fields profile sets and replaces the
+// servers of doc when profile lists any.
+func ApplyProfile(doc *types.OpenAPI, profile config.ProfileConfig) {
+	info := profile.Info
+	if info.Title != "" {
+		doc.Info.Title = info.Title
+	}
+	if info.Description != "" {
+		doc.Info.Description = info.Description
+	}
+	if info.Version != "" {
+		doc.Info.Version = info.Version
+	}
+	if info.TermsOfService != "" {
+		doc.Info.TermsOfService = info.TermsOfService
+	}
+	if info.Contact != (config.ContactConfig{}) {
+		doc.Info.Contact = &types.Contact{
+			Name:  info.Contact.Name,
+			URL:   info.Contact.URL,
+			Email: info.Contact.Email,
+		}
+	}
+	if info.License.Name != "" {
+		doc.Info.License = &types.License{
+			Name: info.License.Name,
+			URL:  info.License.URL,
+		}
+	}
+	if len(profile.Servers) > 0 {
+		doc.Servers = serversFromConfig(profile.Servers)
+	}
+}
+
+// serversFromConfig converts configured servers to spec servers.
+func serversFromConfig(configured []config.ServerConfig) []types.Server {
+	servers := make([]types.Server, 0, len(configured))
+	for _, s := range configured {
+		server := types.Server{
 			URL:         s.URL,
 			Description: s.Description,
-		})
+		}
+		for name, v := range s.Variables {
+			if server.Variables == nil {
+				server.Variables = make(map[string]types.ServerVariable)
+			}
+			server.Variables[name] = types.ServerVariable{
+				Default:     v.Default,
+				Enum:        v.Enum,
+				Description: v.Description,
+			}
+		}
+		servers = append(servers, server)
 	}
 	return servers
 }
 
+// hostTemplate returns the configured or detected per-tenant host, with
+// the configured values of its tenant variable, or nil.
+func (b *Builder) hostTemplate() *HostTemplate {
+	tenancy := b.config.Generation.Tenancy
+	var host HostTemplate
+	switch {
+	case tenancy.Host != "":
+		host = HostTemplate{Host: tenancy.Host}
+	case tenancy.Detect && b.tenantHost != nil:
+		host = *b.tenantHost
+	default:
+		return nil
+	}
+
+	m := serverVariableRegex.FindStringSubmatch(host.Host)
+	if m == nil {
+		return nil
+	}
+	name := m[1]
+	v := host.Variables[name]
+	if len(tenancy.Values) > 0 {
+		v.Enum = tenancy.Values
+	}
+	switch {
+	case tenancy.Default != "":
+		v.Default = tenancy.Default
+	case len(v.Enum) > 0 && !slices.Contains(v.Enum, v.Default):
+		v.Default = v.Enum[0]
+	case v.Default == "":
+		v.Default = name
+	}
+	if tenancy.Description != "" {
+		v.Description = tenancy.Description
+	}
+
+	variables := make(map[string]types.ServerVariable, len(host.Variables)+1)
+	for k, existing := range host.Variables {
+		variables[k] = existing
+	}
+	variables[name] = v
+	host.Variables = variables
+	return &host
+}
+
 // buildTags constructs the tags list from configuration.
 func (b *Builder) buildTags() []types.Tag {
 	tags := make([]types.Tag, 0, len(b.config.OpenAPI.Tags))
@@ -112,13 +343,51 @@ func (b *Builder) buildTags() []types.Tag {
 
 // buildPaths constructs paths from routes.
 func (b *Builder) buildPaths(doc *types.OpenAPI, routes []types.Route) error {
+	infra := b.config.Generation.Infrastructure
 	for _, route := range routes {
-		pathItem, exists := doc.Paths[route.Path]
+		override := b.config.Generation.Operation(route.Method, route.Path)
+		if override != nil && override.Exclude {
+			continue
+		}
+		infrastructure := IsInfrastructure(route.Path, infra.Patterns)
+		if infrastructure && !infra.Include {
+			continue
+		}
+		if b.config.Generation.Wildcards == "exclude" && route.Extensions["x-wildcard"] == true {
+			continue
+		}
+
+		// Webhook receivers can be kept apart from consumer-facing paths
+		paths := doc.Paths
+		if receiver, ok := route.Extensions["x-webhook-receiver"]; ok && receiver != false {
+			switch b.config.Generation.WebhookReceivers {
+			case "exclude":
+				continue
+			case "separate":
+				paths = webhookReceivers(doc)
+			}
+		}
+
+		pathItem, exists := paths[route.Path]
 		if !exists {
 			pathItem = types.PathItem{}
 		}
 
 		operation := b.routeToOperation(route)
+		prefixMount(operation, route, b.config.Generation.MountPrefixes)
+		if infrastructure && infra.Tag != "" {
+			operation.Tags = []string{infra.Tag}
+			addInfrastructureTag(doc, infra.Tag)
+		}
+		if override != nil {
+			if override.OperationID != "" {
+				operation.OperationID = override.OperationID
+			}
+			if len(override.Tags) > 0 {
+				operation.Tags = override.Tags
+			}
+			NegotiateContent(operation, override.Produces)
+		}
 
 		switch strings.ToUpper(route.Method) {
 		case "GET":
@@ -141,12 +410,66 @@ func (b *Builder) buildPaths(doc *types.OpenAPI, routes []types.Route) error {
 			return fmt.Errorf("unsupported HTTP method: %s", route.Method)
 		}
 
-		doc.Paths[route.Path] = pathItem
+		paths[route.Path] = pathItem
+	}
+
+	// Operations served from the same address share a path-level override
+	receivers, _ := doc.Extensions[ExtWebhookReceivers].(map[string]types.PathItem)
+	for _, paths := range []map[string]types.PathItem{doc.Paths, receivers} {
+		for path, item := range paths {
+			hoistServers(&item)
+			paths[path] = item
+		}
 	}
 
 	return nil
 }
 
+// hoistServers moves servers shared by every operation of a path item to
+// the path item.
+func hoistServers(item *types.PathItem) {
+	var shared []types.Server
+	for _, slot := range operationSlots(item) {
+		op := *slot.op
+		if op == nil {
+			continue
+		}
+		if len(op.Servers) == 0 || (shared != nil && !reflect.DeepEqual(shared, op.Servers)) {
+			return
+		}
+		shared = op.Servers
+	}
+	if shared == nil {
+		return
+	}
+
+	item.Servers = shared
+	for _, slot := range operationSlots(item) {
+		if *slot.op != nil {
+			(*slot.op).Servers = nil
+		}
+	}
+}
+
+// ExtWebhookReceivers is the root section webhook receivers are moved to
+// when generation.webhookReceivers is separate.
+const ExtWebhookReceivers = "x-webhook-receivers"
+
+// webhookReceivers returns the x-webhook-receivers section of doc, a paths
+// object for the endpoints that receive third-party webhooks, creating it
+// on first use.
+func webhookReceivers(doc *types.OpenAPI) map[string]types.PathItem {
+	if receivers, ok := doc.Extensions[ExtWebhookReceivers].(map[string]types.PathItem); ok {
+		return receivers
+	}
+	receivers := make(map[string]types.PathItem)
+	if doc.Extensions == nil {
+		doc.Extensions = make(types.Extensions)
+	}
+	doc.Extensions[ExtWebhookReceivers] = receivers
+	return receivers
+}
+
 // routeToOperation converts a Route to an OpenAPI Operation.
 func (b *Builder) routeToOperation(route types.Route) *types.Operation {
 	op := &types.Operation{
@@ -157,6 +480,10 @@ func (b *Builder) routeToOperation(route types.Route) *types.Operation {
 		Deprecated:  route.Deprecated,
 	}
 
+	if b.config.Generation.PathServers {
+		op.Servers = route.Servers
+	}
+
 	// Copy parameters
 	if len(route.Parameters) > 0 {
 		op.Parameters = make([]types.Parameter, len(route.Parameters))
@@ -176,19 +503,61 @@ func (b *Builder) routeToOperation(route types.Route) *types.Operation {
 		}
 	} else {
 		// Add default responses based on config
-		op.Responses = b.buildDefaultResponses()
+		op.Responses = b.buildDefaultResponses(route)
 	}
 
-	// Copy security
+	// Document every media type the handler negotiates
+	NegotiateContent(op, route.Produces)
+
+	// Document conditional requests
+	AddConditionalRequests(op, route.Method, route.Validators)
+
+	// Copy security, or require the OAuth scopes the route checks
 	if len(route.Security) > 0 {
 		op.Security = route.Security
+	} else if len(route.Scopes) > 0 {
+		op.Security = b.scopeSecurity(route.Scopes)
+	}
+
+	// Link to the handler source
+	if b.sourceLink != nil && route.SourceFile != "" {
+		if url := b.sourceLink(route.SourceFile, route.SourceLine); url != "" {
+			op.ExternalDocs = &types.ExternalDocs{
+				Description: "Source",
+				URL:         url,
+			}
+		}
+	}
+
+	// Copy extensions
+	if len(route.Extensions) > 0 {
+		op.Extensions = make(types.Extensions, len(route.Extensions))
+		for key, value := range route.Extensions {
+			op.Extensions[key] = value
+		}
+	}
+
+	// Group operations by controller/module for SDK generators; explicit
+	// route extensions take precedence
+	if grouping := b.config.Generation.SDKGrouping; grouping.Enabled && route.SourceFile != "" {
+		if group := SDKGroup(route.SourceFile); group != "" {
+			for _, ext := range grouping.Extensions {
+				if _, ok := op.Extensions[ext]; ok {
+					continue
+				}
+				if op.Extensions == nil {
+					op.Extensions = make(types.Extensions)
+				}
+				op.Extensions[ext] = groupValue(ext, group)
+			}
+		}
 	}
 
 	return op
 }
 
 // buildDefaultResponses creates default responses based on configuration.
-func (b *Builder) buildDefaultResponses() map[string]types.Response {
+func (b *Builder) buildDefaultResponses(route types.Route) map[string]types.Response {
 	responses := make(map[string]types.Response)
 
 	for _, code := range b.config.Generation.DefaultResponses {
@@ -234,19 +603,21 @@ func (b *Builder) buildDefaultResponses() map[string]types.Response {
 
 	// Ensure at least one response exists
 	if len(responses) == 0 {
-		responses["200"] = types.Response{
-			Description: "Successful response",
-		}
+		status, resp := DefaultResponse(b.config.Generation.DefaultResponse, route.Method, route.Path, route.OperationID)
+		responses[status] = resp
 	}
 
 	return responses
 }
 
-// buildComponents constructs the Components object from schemas.
-func (b *Builder) buildComponents(schemas []types.Schema) *types.Components {
+// buildComponents constructs the Components object from schemas, applying
+// their api2spec: directives. It also returns the names of the schemas
+// whose visibility is inline.
+func (b *Builder) buildComponents(schemas []types.Schema) (*types.Components, []string) {
 	components := &types.Components{
 		Schemas: make(map[string]*types.Schema),
 	}
+	var inline []string
 
 	for i := range schemas {
 		schema := schemas[i]
@@ -255,10 +626,19 @@ func (b *Builder) buildComponents(schemas []types.Schema) *types.Components {
 			// Generate a name if not provided
 			name = fmt.Sprintf("Schema%d", i+1)
 		}
+		if ApplyDirectives(&schema) == VisibilityInline {
+			inline = append(inline, name)
+		}
+		if b.config.Generation.AccessModes {
+			InferAccessModes(name, &schema)
+		}
 		components.Schemas[name] = &schema
 	}
+	if b.config.Generation.StrictObjects {
+		CloseObjects(components.Schemas)
+	}
 
-	return components
+	return components, inline
 }
 
 // buildSecurity constructs the global security requirements.
@@ -277,6 +657,33 @@ func (b *Builder) buildSecurity() []map[string][]string {
 	return security
 }
 
+// scopeSecurity constructs the security requirements of a route checking
+// OAuth scopes: one per scope set and configured oauth2 or openIdConnect
+// scheme, or an oauth2 scheme when none is configured.
+func (b *Builder) scopeSecurity(scopes [][]string) []map[string][]string {
+	var schemes []string
+	for name, cfg := range b.config.OpenAPI.Security.Schemes {
+		if cfg.Type == "oauth2" || cfg.Type == "openIdConnect" {
+			schemes = append(schemes, name)
+		}
+	}
+	sort.Strings(schemes)
+	if len(schemes) == 0 {
+		schemes = []string{"oauth2"}
+	}
+
+	security := make([]map[string][]string, 0, len(scopes)*len(schemes))
+	for _, set := range scopes {
+		for _, name := range schemes {
+			security = append(security, map[string][]string{
+				name: set,
+			})
+		}
+	}
+
+	return security
+}
+
 // buildSecuritySchemes constructs security scheme definitions.
 func (b *Builder) buildSecuritySchemes() map[string]types.SecurityScheme {
 	schemes := make(map[string]types.SecurityScheme)
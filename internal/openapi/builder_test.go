@@ -4,6 +4,7 @@
 package openapi
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -93,6 +94,184 @@ func TestBuilder_Build_WithRoutes(t *testing.T) {
 	assert.Equal(t, "id", userPath.Get.Parameters[0].Name)
 }
 
+func TestBuilder_Build_OperationOverrides(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.Infrastructure.Include = true
+	cfg.Generation.Operations = []config.OperationConfig{
+		{Operation: "GET /internal/metrics", Exclude: true},
+		{Operation: "GET /users/{id}", OperationID: "getUser", Tags: []string{"accounts"}},
+	}
+
+	routes := []types.Route{
+		{Method: "GET", Path: "/internal/metrics"},
+		{Method: "get", Path: "/users/{id}", OperationID: "getUsersId", Tags: []string{"users"}},
+		{Method: "DELETE", Path: "/users/{id}", OperationID: "deleteUsersId", Tags: []string{"users"}},
+	}
+
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	assert.NotContains(t, doc.Paths, "/internal/metrics")
+	item := doc.Paths["/users/{id}"]
+	require.NotNil(t, item.Get)
+	assert.Equal(t, "getUser", item.Get.OperationID)
+	assert.Equal(t, []string{"accounts"}, item.Get.Tags)
+	require.NotNil(t, item.Delete)
+	assert.Equal(t, "deleteUsersId", item.Delete.OperationID)
+	assert.Equal(t, []string{"users"}, item.Delete.Tags)
+}
+
+func TestBuilder_Build_Infrastructure(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/healthz", Tags: []string{"ops"}},
+		{Method: "GET", Path: "/api/v1/health/db"},
+		{Method: "GET", Path: "/metrics"},
+		{Method: "GET", Path: "/users"},
+	}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Len(t, doc.Paths, 1)
+	assert.Contains(t, doc.Paths, "/users")
+
+	cfg := config.Default()
+	cfg.Generation.Infrastructure.Include = true
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Len(t, doc.Paths, 4)
+	assert.Equal(t, []string{"infrastructure"}, doc.Paths["/healthz"].Get.Tags)
+	assert.Equal(t, []string{"infrastructure"}, doc.Paths["/metrics"].Get.Tags)
+	assert.Empty(t, doc.Paths["/users"].Get.Tags)
+	require.Len(t, doc.Tags, 1)
+	assert.Equal(t, "infrastructure", doc.Tags[0].Name)
+}
+
+func TestBuilder_Build_Wildcards(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/files/{path}", Extensions: types.Extensions{"x-wildcard": true}},
+		{Method: "GET", Path: "/files"},
+	}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	require.Contains(t, doc.Paths, "/files/{path}")
+	assert.Equal(t, true, doc.Paths["/files/{path}"].Get.Extensions["x-wildcard"])
+
+	cfg := config.Default()
+	cfg.Generation.Wildcards = "exclude"
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, doc.Paths, "/files/{path}")
+	assert.Contains(t, doc.Paths, "/files")
+}
+
+func TestBuilder_Build_WebhookReceivers(t *testing.T) {
+	routes := []types.Route{
+		{Method: "POST", Path: "/webhooks/stripe", Extensions: types.Extensions{"x-webhook-receiver": "stripe"}},
+		{Method: "POST", Path: "/orders"},
+	}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	require.Contains(t, doc.Paths, "/webhooks/stripe")
+	assert.Equal(t, "stripe", doc.Paths["/webhooks/stripe"].Post.Extensions["x-webhook-receiver"])
+	assert.Nil(t, doc.Extensions)
+
+	cfg := config.Default()
+	cfg.Generation.WebhookReceivers = "separate"
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, doc.Paths, "/webhooks/stripe")
+	assert.Contains(t, doc.Paths, "/orders")
+	receivers, ok := doc.Extensions[ExtWebhookReceivers].(map[string]types.PathItem)
+	require.True(t, ok)
+	require.Contains(t, receivers, "/webhooks/stripe")
+	assert.NotNil(t, receivers["/webhooks/stripe"].Post)
+
+	cfg.Generation.WebhookReceivers = "exclude"
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, doc.Paths, "/webhooks/stripe")
+	assert.Nil(t, doc.Extensions)
+}
+
+func TestBuilder_Build_PathServers(t *testing.T) {
+	orders := []types.Server{{URL: "http://localhost:8081"}}
+	billing := []types.Server{{URL: "http://localhost:8082"}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", Servers: orders},
+		{Method: "POST", Path: "/orders", Servers: orders},
+		{Method: "GET", Path: "/mixed", Servers: orders},
+		{Method: "POST", Path: "/mixed", Servers: billing},
+		{Method: "GET", Path: "/health"},
+	}
+
+	cfg := config.Default()
+	cfg.Generation.Infrastructure.Include = true
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	// Operations sharing an address get a path-level override
+	assert.Equal(t, orders, doc.Paths["/orders"].Servers)
+	assert.Nil(t, doc.Paths["/orders"].Get.Servers)
+	assert.Nil(t, doc.Paths["/orders"].Post.Servers)
+
+	assert.Nil(t, doc.Paths["/mixed"].Servers)
+	assert.Equal(t, orders, doc.Paths["/mixed"].Get.Servers)
+	assert.Equal(t, billing, doc.Paths["/mixed"].Post.Servers)
+
+	assert.Nil(t, doc.Paths["/health"].Servers)
+
+	cfg.Generation.PathServers = false
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Nil(t, doc.Paths["/orders"].Servers)
+	assert.Nil(t, doc.Paths["/mixed"].Get.Servers)
+}
+
+func TestBuilder_Build_Envelope(t *testing.T) {
+	routes := []types.Route{{
+		Method: "GET",
+		Path:   "/users",
+		Responses: map[string]types.Response{"200": {
+			Description: "OK",
+			Content:     map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/User"}}},
+		}},
+	}}
+
+	cfg := config.Default()
+	cfg.Generation.Envelope.Mode = "wrap"
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	schema := doc.Paths["/users"].Get.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, []string{"data"}, schema.Required)
+	assert.Equal(t, "#/components/schemas/User", schema.Properties["data"].Ref)
+
+	// The extracted route is left as it was
+	assert.Equal(t, "#/components/schemas/User", routes[0].Responses["200"].Content["application/json"].Schema.Ref)
+}
+
+func TestBuilder_Build_ParameterCase(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{user_id}", Parameters: []types.Parameter{{Name: "user_id", In: "path", Required: true}}},
+		{Method: "DELETE", Path: "/users/{userId}", Parameters: []types.Parameter{{Name: "userId", In: "path", Required: true}}},
+	}
+
+	cfg := config.Default()
+	cfg.Generation.ParameterCase = "camel"
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	require.Len(t, doc.Paths, 1)
+	item := doc.Paths["/users/{userId}"]
+	require.NotNil(t, item.Get)
+	require.NotNil(t, item.Delete)
+	assert.Equal(t, "userId", item.Get.Parameters[0].Name)
+	assert.Equal(t, "user_id", item.Get.Parameters[0].Extensions[ExtOriginalName])
+	assert.Nil(t, item.Delete.Parameters[0].Extensions)
+}
+
 func TestBuilder_Build_WithSchemas(t *testing.T) {
 	cfg := config.Default()
 
@@ -183,6 +362,29 @@ func TestBuilder_Build_WithSecurity(t *testing.T) {
 	assert.Len(t, doc.Security, 1)
 }
 
+func TestBuilder_Build_RouteScopes(t *testing.T) {
+	routes := []types.Route{
+		{Method: "POST", Path: "/orders", Scopes: [][]string{{"orders:write"}}},
+		{Method: "DELETE", Path: "/orders/{id}", Scopes: [][]string{{"orders:admin"}, {"admin"}}},
+		{Method: "GET", Path: "/orders", Scopes: [][]string{{"orders:read"}}, Security: []map[string][]string{{"apiKey": {}}}},
+	}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string][]string{{"oauth2": {"orders:write"}}}, doc.Paths["/orders"].Post.Security)
+	assert.Equal(t, []map[string][]string{{"oauth2": {"orders:admin"}}, {"oauth2": {"admin"}}}, doc.Paths["/orders/{id}"].Delete.Security)
+	assert.Equal(t, []map[string][]string{{"apiKey": {}}}, doc.Paths["/orders"].Get.Security, "explicit security is kept")
+
+	cfg := config.Default()
+	cfg.OpenAPI.Security.Schemes = map[string]config.SecuritySchemeConfig{
+		"bearerAuth": {Type: "http", Scheme: "bearer"},
+		"auth0":      {Type: "oauth2"},
+	}
+	doc, err = NewBuilder(cfg).Build(routes[:1], nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string][]string{{"auth0": {"orders:write"}}}, doc.Paths["/orders"].Post.Security, "configured OAuth schemes are required")
+}
+
 func TestBuilder_Build_WithContact(t *testing.T) {
 	cfg := config.Default()
 	cfg.OpenAPI.Info.Contact = config.ContactConfig{
@@ -356,6 +558,73 @@ func TestBuilder_Build_DeprecatedRoute(t *testing.T) {
 	assert.True(t, doc.Paths["/old-endpoint"].Get.Deprecated)
 }
 
+func TestBuilder_Build_SourceLinks(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.Infrastructure.Include = true
+
+	routes := []types.Route{
+		{
+			Method:     "GET",
+			Path:       "/users",
+			SourceFile: "routes/users.go",
+			SourceLine: 12,
+		},
+		{
+			Method: "GET",
+			Path:   "/health",
+		},
+	}
+
+	builder := NewBuilder(cfg).WithSourceLinks(func(file string, line int) string {
+		return fmt.Sprintf("https://github.com/acme/api/blob/abc123/%s#L%d", file, line)
+	})
+	doc, err := builder.Build(routes, nil)
+
+	require.NoError(t, err)
+	users := doc.Paths["/users"].Get
+	require.NotNil(t, users.ExternalDocs)
+	assert.Equal(t, "Source", users.ExternalDocs.Description)
+	assert.Equal(t, "https://github.com/acme/api/blob/abc123/routes/users.go#L12", users.ExternalDocs.URL)
+	assert.Nil(t, doc.Paths["/health"].Get.ExternalDocs)
+}
+
+func TestBuilder_Build_SDKGrouping(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.SDKGrouping.Enabled = true
+	cfg.Generation.Infrastructure.Include = true
+
+	routes := []types.Route{
+		{Method: "GET", Path: "/user-profiles", SourceFile: "src/user-profiles/user-profiles.controller.ts"},
+		{
+			Method:     "GET",
+			Path:       "/orders",
+			SourceFile: "internal/handlers/orders.go",
+			Extensions: types.Extensions{"x-go-package": "billing"},
+		},
+		{Method: "GET", Path: "/health", SourceFile: "routes.go"},
+		{Method: "GET", Path: "/version"},
+	}
+
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	profiles := doc.Paths["/user-profiles"].Get
+	assert.Equal(t, "userprofiles", profiles.Extensions["x-go-package"])
+	assert.Equal(t, "userProfiles", profiles.Extensions["x-ts-module"])
+
+	orders := doc.Paths["/orders"].Get
+	assert.Equal(t, "billing", orders.Extensions["x-go-package"])
+	assert.Equal(t, "orders", orders.Extensions["x-ts-module"])
+
+	assert.Empty(t, doc.Paths["/health"].Get.Extensions)
+	assert.Empty(t, doc.Paths["/version"].Get.Extensions)
+
+	cfg.Generation.SDKGrouping.Enabled = false
+	doc, err = NewBuilder(cfg).Build(routes[:1], nil)
+	require.NoError(t, err)
+	assert.Empty(t, doc.Paths["/user-profiles"].Get.Extensions)
+}
+
 func TestSchemaRef(t *testing.T) {
 	ref := SchemaRef("User")
 	assert.Equal(t, "#/components/schemas/User", ref.Ref)
@@ -384,3 +653,76 @@ func TestSortedSchemas(t *testing.T) {
 	sorted := SortedSchemas(schemas)
 	assert.Equal(t, []string{"Admin", "Comment", "Post", "User"}, sorted)
 }
+
+func TestApplyProfile(t *testing.T) {
+	doc := &types.OpenAPI{
+		Info:    types.Info{Title: "API", Description: "Everything", Version: "1.0.0"},
+		Servers: []types.Server{{URL: "https://api.example.com"}},
+	}
+
+	ApplyProfile(doc, config.ProfileConfig{Name: "internal"})
+	assert.Equal(t, "API", doc.Info.Title)
+	assert.Equal(t, []types.Server{{URL: "https://api.example.com"}}, doc.Servers)
+
+	ApplyProfile(doc, config.ProfileConfig{
+		Name: "partner",
+		Info: config.InfoConfig{
+			Title:   "Partner API",
+			Contact: config.ContactConfig{Email: "partners@example.com"},
+		},
+		Servers: []config.ServerConfig{{URL: "https://partners.example.com", Description: "Partners"}},
+	})
+	assert.Equal(t, "Partner API", doc.Info.Title)
+	assert.Equal(t, "Everything", doc.Info.Description)
+	assert.Equal(t, "1.0.0", doc.Info.Version)
+	require.NotNil(t, doc.Info.Contact)
+	assert.Equal(t, "partners@example.com", doc.Info.Contact.Email)
+	assert.Nil(t, doc.Info.License)
+	assert.Equal(t, []types.Server{{URL: "https://partners.example.com", Description: "Partners"}}, doc.Servers)
+}
+
+func TestBuilder_Build_DanglingRefs(t *testing.T) {
+	routes := []types.Route{{
+		Method:      "POST",
+		Path:        "/users",
+		RequestBody: &types.RequestBody{Content: map[string]types.MediaType{"application/json": {Schema: SchemaRef("CreateUserDto")}}},
+	}}
+	schemas := []types.Schema{{Title: "User", Type: "object"}}
+
+	cfg := config.Default()
+	doc, err := NewBuilder(cfg).Build(routes, schemas)
+	require.NoError(t, err)
+	require.Contains(t, doc.Components.Schemas, "CreateUserDto")
+	assert.Equal(t, true, doc.Components.Schemas["CreateUserDto"].Extensions[ExtUnresolved])
+
+	cfg.Generation.DanglingRefs = DanglingRefsFail
+	_, err = NewBuilder(cfg).Build(routes, schemas)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CreateUserDto (referenced from #/paths/~1users/post/requestBody/content/application~1json/schema)")
+
+	cfg.Generation.DanglingRefs = DanglingRefsIgnore
+	doc, err = NewBuilder(cfg).Build(routes, schemas)
+	require.NoError(t, err)
+	assert.NotContains(t, doc.Components.Schemas, "CreateUserDto")
+}
+
+func TestBuilder_Build_SchemaDirectives(t *testing.T) {
+	routes := []types.Route{{
+		Method:    "GET",
+		Path:      "/users",
+		Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{"application/json": {Schema: SchemaRef("User")}}}},
+	}}
+	schemas := []types.Schema{
+		{Title: "User", Type: "object", Description: "A user.\napi2spec:owner accounts", Properties: map[string]*types.Schema{"address": SchemaRef("Address")}},
+		{Title: "Address", Type: "object", Description: "api2spec:visibility inline", Properties: map[string]*types.Schema{"city": {Type: "string"}}},
+	}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, schemas)
+	require.NoError(t, err)
+
+	assert.NotContains(t, doc.Components.Schemas, "Address")
+	user := doc.Components.Schemas["User"]
+	assert.Equal(t, "A user.", user.Description)
+	assert.Equal(t, "accounts", user.Extensions[ExtOwner])
+	assert.Contains(t, user.Properties["address"].Properties, "city")
+}
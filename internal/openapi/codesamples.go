@@ -0,0 +1,526 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtCodeSamples lists ready-to-copy requests for an operation, as
+// rendered by Redoc and Scalar.
+const ExtCodeSamples = "x-codeSamples"
+
+// CodeSample is a request for an operation in one language.
+type CodeSample struct {
+	// Lang is the language, used for syntax highlighting
+	Lang string `json:"lang" yaml:"lang"`
+
+	// Label names the sample's client in the renderer's tabs
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
+
+	// Source is the code of the request
+	Source string `json:"source" yaml:"source"`
+}
+
+// codeSampleWriters write the samples of each supported language.
+var codeSampleWriters = map[string]func(req sampleRequest) CodeSample{
+	"curl":       curlSample,
+	"javascript": fetchSample,
+	"python":     requestsSample,
+	"go":         goSample,
+}
+
+// codeSampleLanguages maps the labels of the samples each writer produces
+// back to its language.
+var codeSampleLanguages = map[string]string{
+	"curl":     "curl",
+	"fetch":    "javascript",
+	"requests": "python",
+	"net/http": "go",
+}
+
+// maxSampleDepth bounds the nesting of sample bodies built from
+// self-referencing schemas.
+const maxSampleDepth = 8
+
+// sampleRequest is the request the code samples of an operation send.
+type sampleRequest struct {
+	method  string
+	url     string
+	headers [][2]string
+
+	// body is the indented JSON request body, empty when there is none
+	body string
+}
+
+// AddCodeSamples sets x-codeSamples on every operation of doc, with a
+// request in each of languages (curl, javascript, python, go). Requests go
+// to the first server, fill in path parameters with their examples, and
+// send required query and header parameters, the credentials of the
+// operation's security scheme and a JSON body built from its request
+// schema.
+func AddCodeSamples(doc *types.OpenAPI, languages []string) {
+	forEachSampleOperation(doc, func(*types.Operation) []string { return languages })
+}
+
+// RefreshCodeSamples rebuilds the x-codeSamples of the operations of doc
+// that have them, in the same languages, so they match doc after it was
+// changed, e.g. redacted.
+func RefreshCodeSamples(doc *types.OpenAPI) {
+	forEachSampleOperation(doc, sampleLanguages)
+}
+
+// forEachSampleOperation sets x-codeSamples on every operation of doc, in
+// the languages returned for it.
+func forEachSampleOperation(doc *types.OpenAPI, languagesOf func(op *types.Operation) []string) {
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			languages := languagesOf(op)
+			if len(languages) == 0 {
+				continue
+			}
+			req := newSampleRequest(doc, path, &item, slot.method, op)
+			var samples []CodeSample
+			for _, language := range languages {
+				if write, ok := codeSampleWriters[language]; ok {
+					samples = append(samples, write(req))
+				}
+			}
+			if len(samples) == 0 {
+				continue
+			}
+			if op.Extensions == nil {
+				op.Extensions = make(types.Extensions)
+			}
+			op.Extensions[ExtCodeSamples] = samples
+		}
+	}
+}
+
+// sampleLanguages returns the languages of the code samples op has.
+func sampleLanguages(op *types.Operation) []string {
+	value, ok := op.Extensions[ExtCodeSamples]
+	if !ok {
+		return nil
+	}
+	// Samples read back from a document are no longer CodeSample values
+	var samples []CodeSample
+	data, err := json.Marshal(value)
+	if err != nil || json.Unmarshal(data, &samples) != nil {
+		return nil
+	}
+	var languages []string
+	for _, sample := range samples {
+		if language, ok := codeSampleLanguages[sample.Label]; ok {
+			languages = append(languages, language)
+		}
+	}
+	return languages
+}
+
+// newSampleRequest builds the request sent to call op.
+func newSampleRequest(doc *types.OpenAPI, path string, item *types.PathItem, method string, op *types.Operation) sampleRequest {
+	var schemas map[string]*types.Schema
+	if doc.Components != nil {
+		schemas = doc.Components.Schemas
+	}
+	req := sampleRequest{method: method}
+
+	query := url.Values{}
+	for _, param := range operationParameters(item, op) {
+		switch param.In {
+		case "path":
+			if value, ok := parameterExample(param); ok {
+				path = strings.ReplaceAll(path, "{"+param.Name+"}", url.PathEscape(fmt.Sprint(value)))
+			}
+		case "query":
+			if param.Required {
+				query.Add(param.Name, parameterValue(param, schemas))
+			}
+		case "header":
+			if param.Required {
+				req.headers = append(req.headers, [2]string{param.Name, parameterValue(param, schemas)})
+			}
+		}
+	}
+	credentials(doc, op, &req, query)
+
+	req.url = strings.TrimSuffix(serverURL(doc, item, op), "/") + path
+	if len(query) > 0 {
+		req.url += "?" + query.Encode()
+	}
+
+	if op.RequestBody != nil {
+		for _, mediaType := range slices.Sorted(maps.Keys(op.RequestBody.Content)) {
+			if !strings.Contains(mediaType, "json") {
+				continue
+			}
+			media := op.RequestBody.Content[mediaType]
+			value := media.Example
+			if value == nil {
+				value = sampleValue(media.Schema, schemas, 0)
+			}
+			if value == nil {
+				break
+			}
+			if body, err := json.MarshalIndent(value, "", "  "); err == nil {
+				req.body = string(body)
+				req.headers = append(req.headers, [2]string{"Content-Type", mediaType})
+			}
+			break
+		}
+	}
+	return req
+}
+
+// operationParameters returns the parameters of op and those of its path
+// item it does not override.
+func operationParameters(item *types.PathItem, op *types.Operation) []types.Parameter {
+	params := slices.Clone(op.Parameters)
+	for _, param := range item.Parameters {
+		overridden := slices.ContainsFunc(op.Parameters, func(p types.Parameter) bool {
+			return p.Name == param.Name && p.In == param.In
+		})
+		if !overridden {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// serverURL returns the URL of the server op is served from, with its
+// variables set to their defaults.
+func serverURL(doc *types.OpenAPI, item *types.PathItem, op *types.Operation) string {
+	servers := op.Servers
+	if len(servers) == 0 {
+		servers = item.Servers
+	}
+	if len(servers) == 0 {
+		servers = doc.Servers
+	}
+	if len(servers) == 0 {
+		return "http://localhost"
+	}
+	server := servers[0]
+	u := server.URL
+	for name, variable := range server.Variables {
+		u = strings.ReplaceAll(u, "{"+name+"}", variable.Default)
+	}
+	if !strings.Contains(u, "://") {
+		u = "http://localhost" + u
+	}
+	return u
+}
+
+// credentials adds the credentials of the first security requirement of
+// op, or of doc when op has none, as placeholders to replace.
+func credentials(doc *types.OpenAPI, op *types.Operation, req *sampleRequest, query url.Values) {
+	requirements := op.Security
+	if requirements == nil {
+		requirements = doc.Security
+	}
+	if len(requirements) == 0 || doc.Components == nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(requirements[0])) {
+		scheme, ok := doc.Components.SecuritySchemes[name]
+		if !ok {
+			continue
+		}
+		switch {
+		case scheme.Type == "apiKey" && scheme.In == "header":
+			req.headers = append(req.headers, [2]string{scheme.Name, "YOUR_API_KEY"})
+		case scheme.Type == "apiKey" && scheme.In == "query":
+			query.Set(scheme.Name, "YOUR_API_KEY")
+		case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "basic"):
+			req.headers = append(req.headers, [2]string{"Authorization", "Basic YOUR_CREDENTIALS"})
+		case scheme.Type == "http" || scheme.Type == "oauth2" || scheme.Type == "openIdConnect":
+			req.headers = append(req.headers, [2]string{"Authorization", "Bearer YOUR_TOKEN"})
+		}
+	}
+}
+
+// parameterExample returns the example of param or of its schema.
+func parameterExample(param types.Parameter) (any, bool) {
+	if param.Example != nil {
+		return param.Example, true
+	}
+	if s := param.Schema; s != nil {
+		switch {
+		case s.Example != nil:
+			return s.Example, true
+		case len(s.Enum) > 0:
+			return s.Enum[0], true
+		case s.Default != nil:
+			return s.Default, true
+		}
+	}
+	return nil, false
+}
+
+// parameterValue returns the value a sample sends for param.
+func parameterValue(param types.Parameter, schemas map[string]*types.Schema) string {
+	value, ok := parameterExample(param)
+	if !ok {
+		value = sampleValue(param.Schema, schemas, 0)
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// sampleValue returns a value schema accepts: its example, first enum
+// value or default, or a placeholder of its type. Read-only properties are
+// left out, since samples are requests.
+func sampleValue(schema *types.Schema, schemas map[string]*types.Schema, depth int) any {
+	schema = resolveSchema(schema, schemas)
+	if schema == nil || depth > maxSampleDepth {
+		return nil
+	}
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.OneOf) > 0:
+		return sampleValue(schema.OneOf[0], schemas, depth+1)
+	case len(schema.AnyOf) > 0:
+		return sampleValue(schema.AnyOf[0], schemas, depth+1)
+	}
+
+	if len(schema.AllOf) > 0 || len(schema.Properties) > 0 || schema.Type == "object" {
+		object := make(map[string]any)
+		for _, part := range schema.AllOf {
+			if fields, ok := sampleValue(part, schemas, depth+1).(map[string]any); ok {
+				for name, value := range fields {
+					object[name] = value
+				}
+			}
+		}
+		for name, prop := range schema.Properties {
+			if resolved := resolveSchema(prop, schemas); resolved == nil || resolved.ReadOnly {
+				continue
+			}
+			object[name] = sampleValue(prop, schemas, depth+1)
+		}
+		if len(schema.Properties) == 0 && schema.AdditionalProperties != nil {
+			object["key"] = sampleValue(schema.AdditionalProperties, schemas, depth+1)
+		}
+		return object
+	}
+
+	switch schema.Type {
+	case "array":
+		if schema.Items == nil {
+			return []any{}
+		}
+		return []any{sampleValue(schema.Items, schemas, depth+1)}
+	case "integer":
+		if schema.Minimum != nil {
+			return int64(*schema.Minimum)
+		}
+		return 0
+	case "number":
+		if schema.Minimum != nil {
+			return *schema.Minimum
+		}
+		return 0
+	case "boolean":
+		return true
+	case "string":
+		return sampleString(schema.Format)
+	}
+	return nil
+}
+
+// sampleString returns a placeholder string of format.
+func sampleString(format string) string {
+	switch format {
+	case "date-time":
+		return "2026-01-01T00:00:00Z"
+	case "date":
+		return "2026-01-01"
+	case "time":
+		return "12:00:00"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "uri", "url":
+		return "https://example.com"
+	case "hostname":
+		return "example.com"
+	case "ipv4":
+		return "192.0.2.1"
+	case "ipv6":
+		return "2001:db8::1"
+	case "byte":
+		return "c3RyaW5n"
+	}
+	return "string"
+}
+
+// curlSample writes req as a curl command.
+func curlSample(req sampleRequest) CodeSample {
+	var b strings.Builder
+	b.WriteString("curl")
+	if req.method != "GET" {
+		b.WriteString(" -X " + req.method)
+	}
+	b.WriteString(" " + shellQuote(req.url))
+	for _, header := range req.headers {
+		b.WriteString(" \\\n  -H " + shellQuote(header[0]+": "+header[1]))
+	}
+	if req.body != "" {
+		b.WriteString(" \\\n  -d " + shellQuote(req.body))
+	}
+	return CodeSample{Lang: "Shell", Label: "curl", Source: b.String()}
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// fetchSample writes req as a JavaScript fetch call.
+func fetchSample(req sampleRequest) CodeSample {
+	var b strings.Builder
+	b.WriteString("const response = await fetch(" + strconv.Quote(req.url))
+	if req.method != "GET" || len(req.headers) > 0 || req.body != "" {
+		b.WriteString(", {\n")
+		b.WriteString("  method: " + strconv.Quote(req.method) + ",\n")
+		if len(req.headers) > 0 {
+			b.WriteString("  headers: {\n")
+			for _, header := range req.headers {
+				b.WriteString("    " + strconv.Quote(header[0]) + ": " + strconv.Quote(header[1]) + ",\n")
+			}
+			b.WriteString("  },\n")
+		}
+		if req.body != "" {
+			b.WriteString("  body: JSON.stringify(" + indent(req.body, "  ") + "),\n")
+		}
+		b.WriteString("}")
+	}
+	b.WriteString(");\nconst data = await response.json();")
+	return CodeSample{Lang: "JavaScript", Label: "fetch", Source: b.String()}
+}
+
+// requestsSample writes req as a Python requests call.
+func requestsSample(req sampleRequest) CodeSample {
+	var args []string
+	args = append(args, strconv.Quote(req.url))
+	if len(req.headers) > 0 {
+		var headers []string
+		for _, header := range req.headers {
+			headers = append(headers, "        "+strconv.Quote(header[0])+": "+strconv.Quote(header[1])+",")
+		}
+		args = append(args, "headers={\n"+strings.Join(headers, "\n")+"\n    }")
+	}
+	if req.body != "" {
+		var value any
+		if err := json.Unmarshal([]byte(req.body), &value); err == nil {
+			args = append(args, "json="+pythonLiteral(value, "    "))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("import requests\n\n")
+	method := strings.ToLower(req.method)
+	if len(args) == 1 {
+		b.WriteString("response = requests." + method + "(" + args[0] + ")")
+	} else {
+		b.WriteString("response = requests." + method + "(\n")
+		for _, arg := range args {
+			b.WriteString("    " + arg + ",\n")
+		}
+		b.WriteString(")")
+	}
+	b.WriteString("\ndata = response.json()")
+	return CodeSample{Lang: "Python", Label: "requests", Source: b.String()}
+}
+
+// pythonLiteral writes a decoded JSON value as a Python literal, nesting
+// lines under prefix.
+func pythonLiteral(value any, prefix string) string {
+	switch v := value.(type) {
+	case nil:
+		return "None"
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case string:
+		return strconv.Quote(v)
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			b.WriteString(prefix + "    " + strconv.Quote(key) + ": " + pythonLiteral(v[key], prefix+"    ") + ",\n")
+		}
+		b.WriteString(prefix + "}")
+		return b.String()
+	case []any:
+		if len(v) == 0 {
+			return "[]"
+		}
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, item := range v {
+			b.WriteString(prefix + "    " + pythonLiteral(item, prefix+"    ") + ",\n")
+		}
+		b.WriteString(prefix + "]")
+		return b.String()
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// goSample writes req as a Go net/http request.
+func goSample(req sampleRequest) CodeSample {
+	var b strings.Builder
+	body := "nil"
+	if req.body != "" {
+		literal := "`" + req.body + "`"
+		if strings.Contains(req.body, "`") {
+			literal = strconv.Quote(req.body)
+		}
+		b.WriteString("body := strings.NewReader(" + literal + ")\n")
+		body = "body"
+	}
+	method := "http.Method" + req.method[:1] + strings.ToLower(req.method[1:])
+	b.WriteString("req, err := http.NewRequest(" + method + ", " + strconv.Quote(req.url) + ", " + body + ")\n")
+	b.WriteString("if err != nil {\n\tlog.Fatal(err)\n}\n")
+	for _, header := range req.headers {
+		b.WriteString("req.Header.Set(" + strconv.Quote(header[0]) + ", " + strconv.Quote(header[1]) + ")\n")
+	}
+	b.WriteString("resp, err := http.DefaultClient.Do(req)\n")
+	b.WriteString("if err != nil {\n\tlog.Fatal(err)\n}\n")
+	b.WriteString("defer resp.Body.Close()")
+	return CodeSample{Lang: "Go", Label: "net/http", Source: b.String()}
+}
+
+// indent prefixes every line of s but the first with prefix.
+func indent(s, prefix string) string {
+	return strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func codeSampleDoc() *types.OpenAPI {
+	return &types.OpenAPI{
+		Servers:  []types.Server{{URL: "https://{region}.api.example.com/v1", Variables: map[string]types.ServerVariable{"region": {Default: "eu"}}}},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+		Paths: map[string]types.PathItem{
+			"/teams/{teamId}/users": {
+				Parameters: []types.Parameter{{Name: "teamId", In: "path", Required: true, Schema: &types.Schema{Type: "string", Example: "acme"}}},
+				Post: &types.Operation{
+					Parameters: []types.Parameter{
+						{Name: "notify", In: "query", Required: true, Schema: &types.Schema{Type: "boolean"}},
+						{Name: "page", In: "query", Schema: &types.Schema{Type: "integer"}},
+					},
+					RequestBody: &types.RequestBody{Content: map[string]types.MediaType{
+						"application/json": {Schema: SchemaRef("User")},
+					}},
+				},
+			},
+			"/users/{id}": {
+				Get: &types.Operation{
+					Parameters: []types.Parameter{{Name: "id", In: "path", Required: true, Schema: &types.Schema{Type: "integer"}}},
+					Security:   []map[string][]string{},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User": {Type: "object", Properties: map[string]*types.Schema{
+					"id":    {Type: "integer", ReadOnly: true},
+					"email": {Type: "string", Format: "email"},
+					"roles": {Type: "array", Items: &types.Schema{Type: "string", Enum: []interface{}{"admin", "member"}}},
+				}},
+			},
+			SecuritySchemes: map[string]types.SecurityScheme{"bearerAuth": {Type: "http", Scheme: "bearer"}},
+		},
+	}
+}
+
+func TestAddCodeSamples(t *testing.T) {
+	doc := codeSampleDoc()
+
+	AddCodeSamples(doc, []string{"curl", "javascript", "python", "go"})
+
+	samples, ok := doc.Paths["/teams/{teamId}/users"].Post.Extensions[ExtCodeSamples].([]CodeSample)
+	require.True(t, ok)
+	require.Len(t, samples, 4)
+	assert.Equal(t, []string{"Shell", "JavaScript", "Python", "Go"}, []string{samples[0].Lang, samples[1].Lang, samples[2].Lang, samples[3].Lang})
+
+	assert.Equal(t, `curl -X POST 'https://eu.api.example.com/v1/teams/acme/users?notify=true' \
+  -H 'Authorization: Bearer YOUR_TOKEN' \
+  -H 'Content-Type: application/json' \
+  -d '{
+  "email": "user@example.com",
+  "roles": [
+    "admin"
+  ]
+}'`, samples[0].Source)
+
+	assert.Equal(t, `const response = await fetch("https://eu.api.example.com/v1/teams/acme/users?notify=true", {
+  method: "POST",
+  headers: {
+    "Authorization": "Bearer YOUR_TOKEN",
+    "Content-Type": "application/json",
+  },
+  body: JSON.stringify({
+    "email": "user@example.com",
+    "roles": [
+      "admin"
+    ]
+  }),
+});
+const data = await response.json();`, samples[1].Source)
+
+	assert.Equal(t, `import requests
+
+response = requests.post(
+    "https://eu.api.example.com/v1/teams/acme/users?notify=true",
+    headers={
+        "Authorization": "Bearer YOUR_TOKEN",
+        "Content-Type": "application/json",
+    },
+    json={
+        "email": "user@example.com",
+        "roles": [
+            "admin",
+        ],
+    },
+)
+data = response.json()`, samples[2].Source)
+
+	assert.Contains(t, samples[3].Source, "req, err := http.NewRequest(http.MethodPost, \"https://eu.api.example.com/v1/teams/acme/users?notify=true\", body)")
+	assert.Contains(t, samples[3].Source, "req.Header.Set(\"Authorization\", \"Bearer YOUR_TOKEN\")")
+}
+
+func TestCodeSampleLanguages(t *testing.T) {
+	for language, write := range codeSampleWriters {
+		assert.Equal(t, language, codeSampleLanguages[write(sampleRequest{method: "GET", url: "https://api.example.com"}).Label])
+	}
+}
+
+func TestAddCodeSamples_Placeholders(t *testing.T) {
+	doc := codeSampleDoc()
+
+	AddCodeSamples(doc, []string{"curl", "python"})
+
+	samples := doc.Paths["/users/{id}"].Get.Extensions[ExtCodeSamples].([]CodeSample)
+	// Path parameters without examples and public operations stay as is
+	assert.Equal(t, "curl 'https://eu.api.example.com/v1/users/{id}'", samples[0].Source)
+	assert.Equal(t, "import requests\n\nresponse = requests.get(\"https://eu.api.example.com/v1/users/{id}\")\ndata = response.json()", samples[1].Source)
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Compatibility modes, named after those of schema registries.
+const (
+	// CompatBackward requires the current schemas to read data written
+	// with the previous ones
+	CompatBackward = "backward"
+
+	// CompatForward requires the previous schemas to read data written
+	// with the current ones
+	CompatForward = "forward"
+
+	// CompatFull requires both
+	CompatFull = "full"
+)
+
+// CompatModes lists the supported compatibility modes.
+var CompatModes = []string{CompatBackward, CompatForward, CompatFull}
+
+// Schema evolution rules.
+const (
+	RuleRemovedSchema        = "removed-schema"
+	RuleRemovedField         = "removed-field"
+	RuleNewRequiredField     = "new-required-field"
+	RuleRemovedRequiredField = "removed-required-field"
+	RuleTypeNarrowed         = "type-narrowed"
+	RuleTypeWidened          = "type-widened"
+	RuleTypeChanged          = "type-changed"
+)
+
+// CompatViolation is a schema change that breaks a compatibility mode.
+type CompatViolation struct {
+	// Rule is the evolution rule broken
+	Rule string
+
+	// Pointer is the JSON pointer to the schema or property in the spec
+	// that introduces the change (e.g., #/components/schemas/User/properties/email)
+	Pointer string
+
+	// Message states the change (e.g., integer -> string)
+	Message string
+
+	// Source is the declaration of the schema or property, when known
+	Source *types.SourceLocation
+}
+
+// String formats the violation as pointer: message [rule] (file:line).
+func (v CompatViolation) String() string {
+	s := fmt.Sprintf("%s: %s [%s]", v.Pointer, v.Message, v.Rule)
+	if v.Source != nil && v.Source.File != "" {
+		if v.Source.Line > 0 {
+			s += fmt.Sprintf(" (%s:%d)", v.Source.File, v.Source.Line)
+		} else {
+			s += " (" + v.Source.File + ")"
+		}
+	}
+	return s
+}
+
+// CheckCompatibility applies the schema evolution rules of mode to the
+// component schemas of current, compared with those of previous by name.
+// Backward compatibility forbids removed schemas and fields, narrowed types
+// and new required fields; forward compatibility forbids widened types and
+// removing required fields; full forbids both. Inline objects and array
+// items are checked property by property; referenced schemas on their own.
+func CheckCompatibility(previous, current *types.OpenAPI, mode string) ([]CompatViolation, error) {
+	if !slices.Contains(CompatModes, mode) {
+		return nil, fmt.Errorf("unknown compatibility mode %q (expected one of %s)", mode, strings.Join(CompatModes, ", "))
+	}
+	c := compatCheck{
+		previous: componentSchemas(previous),
+		current:  componentSchemas(current),
+		backward: mode != CompatForward,
+		forward:  mode != CompatBackward,
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.previous)) {
+		pointer := "#/components/schemas/" + escapePointer(name)
+		schema, ok := c.current[name]
+		if !ok {
+			c.add(true, false, RuleRemovedSchema, pointer, c.previous[name].Source, "schema removed")
+			continue
+		}
+		c.schema(pointer, c.previous[name], schema)
+	}
+	return c.violations, nil
+}
+
+type compatCheck struct {
+	previous, current map[string]*types.Schema
+	backward, forward bool
+	violations        []CompatViolation
+}
+
+// add records a violation of rule when the mode checks the compatibility
+// the change breaks: backward, forward or both.
+func (c *compatCheck) add(backward, forward bool, rule, pointer string, source *types.SourceLocation, format string, args ...any) {
+	if !(backward && c.backward || forward && c.forward) {
+		return
+	}
+	c.violations = append(c.violations, CompatViolation{Rule: rule, Pointer: pointer, Message: fmt.Sprintf(format, args...), Source: source})
+}
+
+// schema compares a schema present in both specs.
+func (c *compatCheck) schema(pointer string, previous, current *types.Schema) {
+	if previous == nil || current == nil || !c.value(pointer, previous, current) {
+		return
+	}
+	switch {
+	case previous.Ref != "" || current.Ref != "":
+	case len(previous.Properties)+len(current.Properties)+len(previous.AllOf)+len(current.AllOf) > 0:
+		c.object(pointer, previous, current)
+	case previous.Items != nil && current.Items != nil:
+		c.schema(pointer+"/items", previous.Items, current.Items)
+	}
+}
+
+// object compares the properties of two object schemas.
+func (c *compatCheck) object(pointer string, previous, current *types.Schema) {
+	prevProps, prevRequired := flattenObject(c.previous, previous)
+	curProps, curRequired := flattenObject(c.current, current)
+
+	for _, name := range slices.Sorted(maps.Keys(prevProps)) {
+		prop := pointer + "/properties/" + escapePointer(name)
+		curProp, ok := curProps[name]
+		if !ok {
+			c.add(true, false, RuleRemovedField, prop, prevProps[name].Source, "field removed")
+			if prevRequired[name] {
+				c.add(false, true, RuleRemovedRequiredField, prop, prevProps[name].Source, "required field removed")
+			}
+			continue
+		}
+		source := compatSource(prevProps[name], curProp)
+		switch {
+		case curRequired[name] && !prevRequired[name]:
+			c.add(true, false, RuleNewRequiredField, prop, source, "field now required")
+		case prevRequired[name] && !curRequired[name]:
+			c.add(false, true, RuleRemovedRequiredField, prop, source, "field no longer required")
+		}
+		c.schema(prop, prevProps[name], curProp)
+	}
+	for _, name := range slices.Sorted(maps.Keys(curProps)) {
+		if _, ok := prevProps[name]; !ok && curRequired[name] {
+			prop := pointer + "/properties/" + escapePointer(name)
+			c.add(true, false, RuleNewRequiredField, prop, curProps[name].Source, "required field added")
+		}
+	}
+}
+
+// value compares the values two schemas accept: their type, format, enum
+// and nullability. It reports whether their types match, so that their
+// properties or items can be compared.
+func (c *compatCheck) value(pointer string, previous, current *types.Schema) bool {
+	source := compatSource(previous, current)
+	prevType, curType := typeLabel(previous), typeLabel(current)
+	switch {
+	case prevType == curType, previous.Type == "array" && current.Type == "array":
+	case previous.Ref != "" || current.Ref != "":
+		c.add(true, true, RuleTypeChanged, pointer, source, "%s -> %s", prevType, curType)
+	default:
+		switch typeWidth(previous, current) {
+		case 1:
+			c.add(false, true, RuleTypeWidened, pointer, source, "%s -> %s", prevType, curType)
+		case -1:
+			c.add(true, false, RuleTypeNarrowed, pointer, source, "%s -> %s", prevType, curType)
+		default:
+			c.add(true, true, RuleTypeChanged, pointer, source, "%s -> %s", prevType, curType)
+		}
+		return false
+	}
+
+	if previous.Nullable != current.Nullable {
+		if current.Nullable {
+			c.add(false, true, RuleTypeWidened, pointer, source, "now nullable")
+		} else {
+			c.add(true, false, RuleTypeNarrowed, pointer, source, "no longer nullable")
+		}
+	}
+	switch {
+	case len(previous.Enum) == 0 && len(current.Enum) > 0:
+		c.add(true, false, RuleTypeNarrowed, pointer, source, "now restricted to enum values")
+	case len(previous.Enum) > 0 && len(current.Enum) == 0:
+		c.add(false, true, RuleTypeWidened, pointer, source, "no longer restricted to enum values")
+	default:
+		added, removed := enumChanges(previous.Enum, current.Enum)
+		if len(removed) > 0 {
+			c.add(true, false, RuleTypeNarrowed, pointer, source, "enum values removed: %s", strings.Join(removed, ", "))
+		}
+		if len(added) > 0 {
+			c.add(false, true, RuleTypeWidened, pointer, source, "enum values added: %s", strings.Join(added, ", "))
+		}
+	}
+	return true
+}
+
+// typeWidth returns 1 when current accepts more values than previous, -1
+// when fewer, and 0 when neither accepts all values of the other. Types
+// compare as any > number > integer, and a format narrows its type.
+func typeWidth(previous, current *types.Schema) int {
+	prev, cur := valueType(previous), valueType(current)
+	switch {
+	case prev == cur:
+		if previous.Format == "" {
+			return -1
+		}
+		if current.Format == "" {
+			return 1
+		}
+	case cur == "":
+		return 1
+	case prev == "":
+		return -1
+	case prev == "integer" && cur == "number":
+		return 1
+	case prev == "number" && cur == "integer":
+		return -1
+	}
+	return 0
+}
+
+// valueType returns the type a schema accepts, "" for any value.
+func valueType(s *types.Schema) string {
+	if s.Type == "" && len(s.OneOf)+len(s.AnyOf)+len(s.AllOf) > 0 {
+		return "composed"
+	}
+	return s.Type
+}
+
+// compatSource returns the declaration of current, else of previous.
+func compatSource(previous, current *types.Schema) *types.SourceLocation {
+	if current.Source != nil {
+		return current.Source
+	}
+	return previous.Source
+}
+
+// escapePointer encodes a JSON pointer reference token (RFC 6901).
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func compatTestDocs() (*types.OpenAPI, *types.OpenAPI) {
+	previous := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"User": {
+			Type:     "object",
+			Required: []string{"id", "email"},
+			Properties: map[string]*types.Schema{
+				"id":      {Type: "integer"},
+				"email":   {Type: "string"},
+				"score":   {Type: "number"},
+				"age":     {Type: "integer"},
+				"role":    {Type: "string", Enum: []any{"admin", "member"}},
+				"address": {Type: "object", Properties: map[string]*types.Schema{"city": {Type: "string"}}},
+				"tags":    {Type: "array", Items: &types.Schema{Type: "string"}},
+			},
+		},
+		"Legacy": {Type: "object"},
+	}}}
+	current := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"User": {
+			Type:     "object",
+			Required: []string{"id", "name"},
+			Properties: map[string]*types.Schema{
+				"id":      {Type: "string", Source: &types.SourceLocation{File: "user.go", Line: 12}},
+				"email":   {Type: "string"},
+				"name":    {Type: "string"},
+				"score":   {Type: "integer"},
+				"age":     {Type: "number"},
+				"role":    {Type: "string", Enum: []any{"admin", "guest"}},
+				"address": {Type: "object", Properties: map[string]*types.Schema{}},
+				"tags":    {Type: "array", Items: &types.Schema{Type: "string", Format: "uuid"}},
+			},
+		},
+		"Added": {Type: "object"},
+	}}}
+	return previous, current
+}
+
+func TestCheckCompatibility_Backward(t *testing.T) {
+	previous, current := compatTestDocs()
+
+	violations, err := CheckCompatibility(previous, current, CompatBackward)
+	require.NoError(t, err)
+
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.Rule+" "+v.Pointer+" "+v.Message)
+	}
+	assert.Equal(t, []string{
+		"removed-schema #/components/schemas/Legacy schema removed",
+		"removed-field #/components/schemas/User/properties/address/properties/city field removed",
+		"type-changed #/components/schemas/User/properties/id integer -> string",
+		"type-narrowed #/components/schemas/User/properties/role enum values removed: member",
+		"type-narrowed #/components/schemas/User/properties/score number -> integer",
+		"type-narrowed #/components/schemas/User/properties/tags/items string -> string(uuid)",
+		"new-required-field #/components/schemas/User/properties/name required field added",
+	}, got)
+	assert.Equal(t, "#/components/schemas/User/properties/id: integer -> string [type-changed] (user.go:12)", violations[2].String())
+}
+
+func TestCheckCompatibility_Forward(t *testing.T) {
+	previous, current := compatTestDocs()
+
+	violations, err := CheckCompatibility(previous, current, CompatForward)
+	require.NoError(t, err)
+
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.Rule+" "+v.Pointer+" "+v.Message)
+	}
+	assert.Equal(t, []string{
+		"type-widened #/components/schemas/User/properties/age integer -> number",
+		"removed-required-field #/components/schemas/User/properties/email field no longer required",
+		"type-changed #/components/schemas/User/properties/id integer -> string",
+		"type-widened #/components/schemas/User/properties/role enum values added: guest",
+	}, got)
+}
+
+func TestCheckCompatibility_Full(t *testing.T) {
+	previous, current := compatTestDocs()
+
+	violations, err := CheckCompatibility(previous, current, CompatFull)
+	require.NoError(t, err)
+	assert.Len(t, violations, 10)
+
+	none, err := CheckCompatibility(previous, previous, CompatFull)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	_, err = CheckCompatibility(previous, current, "transitive")
+	assert.ErrorContains(t, err, `unknown compatibility mode "transitive"`)
+}
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ComplexityOptions sets the thresholds Complexity reports operations at.
+// A zero threshold disables its check.
+type ComplexityOptions struct {
+	// MaxProperties is the most properties a request or response body may
+	// declare, counting nested objects and array items
+	MaxProperties int
+
+	// MaxDepth is the deepest a request or response body may nest objects
+	MaxDepth int
+
+	// Pagination reports GET operations returning a list without
+	// pagination parameters or fields
+	Pagination bool
+}
+
+// ComplexityIssue is a design problem found in one operation.
+type ComplexityIssue struct {
+	Method  string
+	Path    string
+	Message string
+}
+
+// String formats the issue as "METHOD /path: message".
+func (i ComplexityIssue) String() string {
+	return i.Method + " " + i.Path + ": " + i.Message
+}
+
+// paginationParams are lowercase query parameter names that page a list.
+var paginationParams = map[string]bool{
+	"limit": true, "offset": true, "page": true, "per_page": true, "perpage": true,
+	"page_size": true, "pagesize": true, "size": true, "cursor": true, "after": true,
+	"before": true, "first": true, "last": true, "skip": true, "take": true,
+	"page_token": true, "pagetoken": true, "starting_after": true, "ending_before": true,
+}
+
+// paginationFields are lowercase response envelope properties that page a list.
+var paginationFields = map[string]bool{
+	"next": true, "next_cursor": true, "nextcursor": true, "cursor": true, "page": true,
+	"total": true, "total_count": true, "totalcount": true, "has_more": true, "hasmore": true,
+	"next_page": true, "nextpage": true, "next_page_token": true, "nextpagetoken": true,
+	"links": true, "pagination": true, "page_info": true, "pageinfo": true, "meta": true,
+}
+
+// listFields are lowercase envelope properties that hold a response's list.
+var listFields = map[string]bool{
+	"data": true, "items": true, "results": true, "records": true,
+	"entries": true, "list": true, "content": true, "values": true,
+}
+
+// Complexity reports operations whose request or success response bodies
+// exceed the property count or nesting depth thresholds, and GET operations
+// that return unpaginated lists. Issues are sorted by path and method.
+func Complexity(doc *types.OpenAPI, opts ComplexityOptions) []ComplexityIssue {
+	if doc == nil {
+		return nil
+	}
+
+	var schemas map[string]*types.Schema
+	if doc.Components != nil {
+		schemas = doc.Components.Schemas
+	}
+
+	var issues []ComplexityIssue
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			report := func(format string, args ...any) {
+				issues = append(issues, ComplexityIssue{Method: slot.method, Path: path, Message: fmt.Sprintf(format, args...)})
+			}
+
+			if op.RequestBody != nil {
+				for _, message := range bodyComplexity("request body", bodySchema(op.RequestBody.Content), schemas, opts) {
+					report("%s", message)
+				}
+			}
+
+			for _, code := range successCodes(op.Responses) {
+				schema := bodySchema(op.Responses[code].Content)
+				for _, message := range bodyComplexity(code+" response", schema, schemas, opts) {
+					report("%s", message)
+				}
+				if opts.Pagination && slot.method == "GET" && !hasPaginationParams(item, op) {
+					if field, unpaginated := unpaginatedList(schema, schemas); unpaginated {
+						if field == "" {
+							report("%s response is an array without pagination parameters", code)
+						} else {
+							report("%s response lists %s without pagination parameters or fields", code, field)
+						}
+					}
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// bodyComplexity returns the thresholds a body schema exceeds.
+func bodyComplexity(name string, schema *types.Schema, schemas map[string]*types.Schema, opts ComplexityOptions) []string {
+	if schema == nil {
+		return nil
+	}
+
+	m := &schemaMeasure{schemas: schemas, visiting: make(map[string]bool)}
+	m.measure(schema, 0)
+
+	var messages []string
+	if opts.MaxProperties > 0 && m.properties > opts.MaxProperties {
+		messages = append(messages, fmt.Sprintf("%s has %d properties (max %d)", name, m.properties, opts.MaxProperties))
+	}
+	if opts.MaxDepth > 0 && m.depth > opts.MaxDepth {
+		messages = append(messages, fmt.Sprintf("%s nests objects %d levels deep (max %d)", name, m.depth, opts.MaxDepth))
+	}
+	return messages
+}
+
+// schemaMeasure counts the properties and object nesting depth of a schema,
+// following component references. A schema that refers back to itself is
+// measured once along each path.
+type schemaMeasure struct {
+	schemas    map[string]*types.Schema
+	visiting   map[string]bool
+	properties int
+	depth      int
+}
+
+func (m *schemaMeasure) measure(schema *types.Schema, depth int) {
+	if schema == nil {
+		return
+	}
+	if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+		if m.visiting[name] {
+			return
+		}
+		m.visiting[name] = true
+		m.measure(m.schemas[name], depth)
+		delete(m.visiting, name)
+		return
+	}
+
+	// Composed schemas describe the same object as their parent
+	for _, composed := range [][]*types.Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, sub := range composed {
+			m.measure(sub, depth)
+		}
+	}
+
+	if len(schema.Properties) > 0 {
+		depth++
+		if depth > m.depth {
+			m.depth = depth
+		}
+		m.properties += len(schema.Properties)
+		for _, prop := range schema.Properties {
+			m.measure(prop, depth)
+		}
+	}
+	m.measure(schema.Items, depth)
+	m.measure(schema.AdditionalProperties, depth)
+}
+
+// resolveSchema follows component references to the schema they name.
+func resolveSchema(schema *types.Schema, schemas map[string]*types.Schema) *types.Schema {
+	for i := 0; schema != nil && schema.Ref != "" && i < 10; i++ {
+		name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix)
+		if !ok {
+			return nil
+		}
+		schema = schemas[name]
+	}
+	return schema
+}
+
+// unpaginatedList reports whether a response is a list without paging: a
+// bare array, or an envelope such as {"data": [...]} with no paging fields
+// beside its list. field names the envelope's list property.
+func unpaginatedList(schema *types.Schema, schemas map[string]*types.Schema) (field string, ok bool) {
+	schema = resolveSchema(schema, schemas)
+	if schema == nil {
+		return "", false
+	}
+	if schema.Type == "array" {
+		return "", true
+	}
+
+	var lists []string
+	for name, prop := range schema.Properties {
+		if paginationFields[strings.ToLower(name)] {
+			return "", false
+		}
+		if !listFields[strings.ToLower(name)] {
+			continue
+		}
+		if prop := resolveSchema(prop, schemas); prop != nil && prop.Type == "array" {
+			lists = append(lists, name)
+		}
+	}
+	if len(lists) != 1 {
+		return "", false
+	}
+	return lists[0], true
+}
+
+// hasPaginationParams reports whether an operation or its path item takes a
+// query parameter that pages a list.
+func hasPaginationParams(item types.PathItem, op *types.Operation) bool {
+	for _, params := range [][]types.Parameter{item.Parameters, op.Parameters} {
+		for _, param := range params {
+			if param.In == "query" && paginationParams[strings.ToLower(param.Name)] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bodySchema returns the JSON schema of a body, or that of its first media
+// type.
+func bodySchema(content map[string]types.MediaType) *types.Schema {
+	if media, ok := content["application/json"]; ok {
+		return media.Schema
+	}
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+	for _, mediaType := range mediaTypes {
+		if schema := content[mediaType].Schema; schema != nil {
+			return schema
+		}
+	}
+	return nil
+}
+
+// successCodes returns the sorted 2xx response codes of an operation.
+func successCodes(responses map[string]types.Response) []string {
+	var codes []string
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func jsonBody(schema *types.Schema) map[string]types.MediaType {
+	return map[string]types.MediaType{"application/json": {Schema: schema}}
+}
+
+func TestComplexity(t *testing.T) {
+	ref := func(name string) *types.Schema { return &types.Schema{Ref: schemaRefPrefix + name} }
+	str := &types.Schema{Type: "string"}
+
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				// Bare array without pagination
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: jsonBody(&types.Schema{Type: "array", Items: ref("User")})},
+					},
+				},
+				// Too many properties
+				Post: &types.Operation{
+					RequestBody: &types.RequestBody{Content: jsonBody(&types.Schema{
+						Type:       "object",
+						Properties: map[string]*types.Schema{"a": str, "b": str, "c": str, "d": str},
+					})},
+					Responses: map[string]types.Response{"201": {Description: "Created"}},
+				},
+			},
+			"/users/{id}": {
+				// Single resource containing an array is not a list
+				Get: &types.Operation{
+					Responses: map[string]types.Response{"200": {Content: jsonBody(ref("User"))}},
+				},
+			},
+			"/orders": {
+				// Envelope without pagination fields
+				Get: &types.Operation{
+					Responses: map[string]types.Response{"200": {Content: jsonBody(&types.Schema{
+						Type:       "object",
+						Properties: map[string]*types.Schema{"data": {Type: "array", Items: str}},
+					})}},
+				},
+			},
+			"/invoices": {
+				// Paginated by query parameter
+				Get: &types.Operation{
+					Parameters: []types.Parameter{{Name: "limit", In: "query"}},
+					Responses: map[string]types.Response{
+						"200": {Content: jsonBody(&types.Schema{Type: "array", Items: str})},
+					},
+				},
+			},
+			"/payments": {
+				// Paginated by envelope field
+				Get: &types.Operation{
+					Responses: map[string]types.Response{"200": {Content: jsonBody(&types.Schema{
+						Type: "object",
+						Properties: map[string]*types.Schema{
+							"items":       {Type: "array", Items: str},
+							"next_cursor": str,
+						},
+					})}},
+				},
+			},
+			"/tree": {
+				// Deep nesting, including a self-referencing schema
+				Put: &types.Operation{
+					RequestBody: &types.RequestBody{Content: jsonBody(&types.Schema{
+						Type: "object",
+						Properties: map[string]*types.Schema{"a": {
+							Type: "object",
+							Properties: map[string]*types.Schema{"b": {
+								Type:       "object",
+								Properties: map[string]*types.Schema{"node": ref("Node")},
+							}},
+						}},
+					})},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User": {
+					Type: "object",
+					Properties: map[string]*types.Schema{
+						"name":  str,
+						"roles": {Type: "array", Items: str},
+					},
+				},
+				"Node": {
+					Type:       "object",
+					Properties: map[string]*types.Schema{"child": ref("Node")},
+				},
+			},
+		},
+	}
+
+	issues := Complexity(doc, ComplexityOptions{MaxProperties: 3, MaxDepth: 3, Pagination: true})
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	assert.Equal(t, []string{
+		"GET /orders: 200 response lists data without pagination parameters or fields",
+		"PUT /tree: request body has 4 properties (max 3)",
+		"PUT /tree: request body nests objects 4 levels deep (max 3)",
+		"GET /users: 200 response is an array without pagination parameters",
+		"POST /users: request body has 4 properties (max 3)",
+	}, got)
+}
+
+func TestComplexity_Disabled(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: jsonBody(&types.Schema{Type: "array", Items: &types.Schema{Type: "string"}})},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Empty(t, Complexity(doc, ComplexityOptions{}))
+	assert.Empty(t, Complexity(nil, ComplexityOptions{Pagination: true}))
+}
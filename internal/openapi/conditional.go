@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// AddConditionalRequests documents the conditional requests an operation
+// answers with the given validators. Successful responses get the ETag and
+// Last-Modified headers. GET and HEAD operations accept If-None-Match and
+// If-Modified-Since and may answer 304 Not Modified; other operations
+// accept If-Match and If-Unmodified-Since and may answer 412 Precondition
+// Failed.
+func AddConditionalRequests(op *types.Operation, method string, validators []string) {
+	if op == nil || len(validators) == 0 {
+		return
+	}
+	etag := slices.Contains(validators, types.ValidatorETag)
+	lastModified := slices.Contains(validators, types.ValidatorLastModified)
+	str := func() *types.Schema { return &types.Schema{Type: "string"} }
+
+	for code, resp := range op.Responses {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		headers := make(map[string]types.Header, len(resp.Headers)+2)
+		for name, h := range resp.Headers {
+			headers[name] = h
+		}
+		if _, ok := headers["ETag"]; etag && !ok {
+			headers["ETag"] = types.Header{Description: "Version of the resource, for conditional requests", Schema: str()}
+		}
+		if _, ok := headers["Last-Modified"]; lastModified && !ok {
+			headers["Last-Modified"] = types.Header{Description: "Time the resource last changed, for conditional requests", Schema: str()}
+		}
+		resp.Headers = headers
+		op.Responses[code] = resp
+	}
+
+	read := strings.EqualFold(method, "GET") || strings.EqualFold(method, "HEAD")
+	header := func(name, description string) {
+		for _, p := range op.Parameters {
+			if p.In == "header" && strings.EqualFold(p.Name, name) {
+				return
+			}
+		}
+		op.Parameters = append(op.Parameters, types.Parameter{Name: name, In: "header", Description: description, Schema: str()})
+	}
+	switch {
+	case read && etag:
+		header("If-None-Match", "ETag of the cached representation; 304 is returned if it is still current")
+	case !read && etag:
+		header("If-Match", "ETag the resource must still have for the request to apply; 412 is returned otherwise")
+	}
+	switch {
+	case read && lastModified:
+		header("If-Modified-Since", "Time of the cached representation; 304 is returned if the resource has not changed since")
+	case !read && lastModified:
+		header("If-Unmodified-Since", "Time the resource must not have changed since for the request to apply; 412 is returned otherwise")
+	}
+
+	if op.Responses == nil {
+		op.Responses = make(map[string]types.Response)
+	}
+	if read {
+		if _, ok := op.Responses["304"]; !ok {
+			op.Responses["304"] = types.Response{Description: "Not modified"}
+		}
+	} else if _, ok := op.Responses["412"]; !ok {
+		op.Responses["412"] = types.Response{Description: "Precondition failed"}
+	}
+}
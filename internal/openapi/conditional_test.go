@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestAddConditionalRequests(t *testing.T) {
+	shared := map[string]types.Header{"Cache-Control": {Description: "Caching"}}
+	op := &types.Operation{Responses: map[string]types.Response{
+		"200": {Description: "OK", Headers: shared},
+		"404": {Description: "Not found"},
+	}}
+
+	AddConditionalRequests(op, "GET", []string{types.ValidatorETag, types.ValidatorLastModified})
+
+	headers := op.Responses["200"].Headers
+	assert.Len(t, headers, 3)
+	assert.Contains(t, headers, "ETag")
+	assert.Contains(t, headers, "Last-Modified")
+	assert.Len(t, shared, 1)
+	assert.Nil(t, op.Responses["404"].Headers)
+	assert.Equal(t, "Not modified", op.Responses["304"].Description)
+	require.Len(t, op.Parameters, 2)
+	assert.Equal(t, "If-None-Match", op.Parameters[0].Name)
+	assert.Equal(t, "If-Modified-Since", op.Parameters[1].Name)
+
+	write := &types.Operation{
+		Parameters: []types.Parameter{{Name: "if-match", In: "header", Required: true}},
+		Responses:  map[string]types.Response{"204": {Description: "Updated"}},
+	}
+	AddConditionalRequests(write, "PUT", []string{types.ValidatorETag})
+	require.Len(t, write.Parameters, 1)
+	assert.True(t, write.Parameters[0].Required)
+	assert.Contains(t, write.Responses, "412")
+	assert.NotContains(t, write.Responses, "304")
+}
+
+func TestBuilder_Build_Validators(t *testing.T) {
+	cfg := config.Default()
+	routes := []types.Route{{Method: "GET", Path: "/articles", Validators: []string{types.ValidatorETag}}}
+
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	op := doc.Paths["/articles"].Get
+	require.NotNil(t, op)
+	assert.Contains(t, op.Responses, "200")
+	assert.Contains(t, op.Responses, "304")
+	assert.Contains(t, op.Responses["200"].Headers, "ETag")
+}
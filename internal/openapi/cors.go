@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtCORS documents a CORS policy, of the whole API at the root of the
+// document or of an operation overriding it.
+const ExtCORS = "x-cors"
+
+// HoistCORS moves the CORS policy shared by operations to the root of doc
+// when every operation has one: the most common policy becomes the API's,
+// and only operations with a different policy keep their own. It reports
+// whether a policy was hoisted.
+func HoistCORS(doc *types.OpenAPI) bool {
+	var ops []*types.Operation
+	var policies []any
+	var counts []int
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			policy, ok := op.Extensions[ExtCORS]
+			if !ok {
+				return false
+			}
+			ops = append(ops, op)
+			i := slices.IndexFunc(policies, func(p any) bool { return reflect.DeepEqual(p, policy) })
+			if i < 0 {
+				policies, counts = append(policies, policy), append(counts, 0)
+				i = len(policies) - 1
+			}
+			counts[i]++
+		}
+	}
+	if len(ops) == 0 {
+		return false
+	}
+
+	common := 0
+	for i, n := range counts {
+		if n > counts[common] {
+			common = i
+		}
+	}
+	for _, op := range ops {
+		if reflect.DeepEqual(op.Extensions[ExtCORS], policies[common]) {
+			delete(op.Extensions, ExtCORS)
+		}
+	}
+	if doc.Extensions == nil {
+		doc.Extensions = make(types.Extensions)
+	}
+	doc.Extensions[ExtCORS] = policies[common]
+	return true
+}
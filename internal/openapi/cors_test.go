@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestHoistCORS(t *testing.T) {
+	public := types.CORS{AllowOrigins: []string{"*"}}
+	partners := types.CORS{AllowOrigins: []string{"https://partner.example.com"}}
+	withPolicy := func(policy types.CORS) *types.Operation {
+		return &types.Operation{Extensions: types.Extensions{ExtCORS: policy}}
+	}
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users":    {Get: withPolicy(public), Post: withPolicy(public)},
+		"/partners": {Get: withPolicy(partners)},
+	}}
+
+	assert.True(t, HoistCORS(doc))
+	assert.Equal(t, public, doc.Extensions[ExtCORS])
+	assert.NotContains(t, doc.Paths["/users"].Get.Extensions, ExtCORS)
+	assert.Equal(t, partners, doc.Paths["/partners"].Get.Extensions[ExtCORS])
+}
+
+func TestHoistCORS_PartialCoverage(t *testing.T) {
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/api/users": {Get: &types.Operation{Extensions: types.Extensions{ExtCORS: types.CORS{AllowOrigins: []string{"*"}}}}},
+		"/dashboard": {Get: &types.Operation{}},
+	}}
+
+	assert.False(t, HoistCORS(doc))
+	assert.Nil(t, doc.Extensions)
+	assert.Contains(t, doc.Paths["/api/users"].Get.Extensions, ExtCORS)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// DedupeParameters removes the parameters of doc declared twice by name and
+// location, which fails validation. Within one list the first declaration
+// is kept, completed with the description, schema and example of the later
+// ones. An operation parameter redeclaring a path item parameter with the
+// same contract is dropped in favor of the shared one; one that changes the
+// contract overrides it, as OpenAPI specifies, and is kept.
+func DedupeParameters(doc *types.OpenAPI) {
+	if doc == nil {
+		return
+	}
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		changed := false
+		if params, ok := dedupeParameterList(item.Parameters); ok {
+			item.Parameters, changed = params, true
+		}
+		// Whether item.Parameters may be modified in place
+		owned := changed
+
+		shared := make(map[string]int, len(item.Parameters))
+		for i, param := range item.Parameters {
+			shared[param.Name+":"+param.In] = i
+		}
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil || len(op.Parameters) == 0 {
+				continue
+			}
+			params, modified := dedupeParameterList(op.Parameters)
+			if !modified {
+				params = op.Parameters
+			}
+			kept := make([]types.Parameter, 0, len(params))
+			for _, param := range params {
+				i, ok := shared[param.Name+":"+param.In]
+				if !ok || contractJSON(param) != contractJSON(item.Parameters[i]) {
+					kept = append(kept, param)
+					continue
+				}
+				// The shared declaration takes over what documents it
+				if !owned {
+					item.Parameters = append([]types.Parameter(nil), item.Parameters...)
+					owned, changed = true, true
+				}
+				completeParameter(&item.Parameters[i], param)
+				modified = true
+			}
+			if !modified {
+				continue
+			}
+			// Operations may be shared with the documents merged into doc
+			copied := *op
+			copied.Parameters = kept
+			if len(kept) == 0 {
+				copied.Parameters = nil
+			}
+			*slot.op = &copied
+			changed = true
+		}
+		if changed {
+			doc.Paths[path] = item
+		}
+	}
+}
+
+// dedupeParameterList returns params without repeated name and location
+// pairs, reporting whether any was repeated.
+func dedupeParameterList(params []types.Parameter) ([]types.Parameter, bool) {
+	index := make(map[string]int, len(params))
+	var result []types.Parameter
+	for _, param := range params {
+		key := param.Name + ":" + param.In
+		first, ok := index[key]
+		if !ok {
+			index[key] = len(result)
+			result = append(result, param)
+			continue
+		}
+		completeParameter(&result[first], param)
+	}
+	if len(result) == len(params) {
+		return params, false
+	}
+	return result, true
+}
+
+// completeParameter fills what param lacks from other, a redeclaration.
+func completeParameter(param *types.Parameter, other types.Parameter) {
+	if param.Description == "" {
+		param.Description = other.Description
+	}
+	if param.Schema == nil {
+		param.Schema = other.Schema
+	}
+	if param.Example == nil {
+		param.Example = other.Example
+	}
+	param.Required = param.Required || other.Required
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestBuilder_Build_DedupesParameters(t *testing.T) {
+	routes := []types.Route{{
+		Method: "GET",
+		Path:   "/users/{id}",
+		Parameters: []types.Parameter{
+			{Name: "id", In: "path", Required: true},
+			{Name: "expand", In: "query", Schema: &types.Schema{Type: "string"}},
+			{Name: "id", In: "path", Required: true, Description: "User ID", Schema: &types.Schema{Type: "integer"}},
+			{Name: "id", In: "query", Schema: &types.Schema{Type: "string"}},
+		},
+	}}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+
+	params := doc.Paths["/users/{id}"].Get.Parameters
+	require.Len(t, params, 3)
+	assert.Equal(t, types.Parameter{Name: "id", In: "path", Required: true, Description: "User ID", Schema: &types.Schema{Type: "integer"}}, params[0])
+	assert.Equal(t, "expand", params[1].Name)
+	assert.Equal(t, "query", params[2].In)
+}
+
+func TestMerger_DedupesPathParameters(t *testing.T) {
+	existing := parseFrozen(t, `
+openapi: 3.0.3
+info: {title: Shop, version: 1.0.0}
+paths:
+  /users/{id}:
+    parameters:
+      - {name: id, in: path, required: true, schema: {type: integer}}
+    get:
+      responses:
+        "200": {description: OK}
+    delete:
+      responses:
+        "204": {description: No Content}
+`)
+	generated := parseFrozen(t, `
+openapi: 3.0.3
+info: {title: Shop, version: 1.0.0}
+paths:
+  /users/{id}:
+    get:
+      parameters:
+        - {name: id, in: path, required: true, description: User ID, schema: {type: integer}}
+        - {name: fields, in: query, schema: {type: string}}
+      responses:
+        "200": {description: OK}
+    delete:
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string, format: uuid}}
+      responses:
+        "204": {description: No Content}
+`)
+	generatedGet := generated.Paths["/users/{id}"].Get
+
+	result, err := NewMerger(DefaultMergeOptions()).MergeWithResult(existing, generated)
+	require.NoError(t, err)
+
+	item := result.Document.Paths["/users/{id}"]
+	require.Len(t, item.Parameters, 1)
+	assert.Equal(t, "User ID", item.Parameters[0].Description)
+
+	// The redeclaration is dropped; the override is kept
+	require.Len(t, item.Get.Parameters, 1)
+	assert.Equal(t, "fields", item.Get.Parameters[0].Name)
+	require.Len(t, item.Delete.Parameters, 1)
+	assert.Equal(t, "uuid", item.Delete.Parameters[0].Schema.Format)
+
+	// The merged documents are left as they were
+	assert.Len(t, generatedGet.Parameters, 2)
+	assert.Empty(t, existing.Paths["/users/{id}"].Parameters[0].Description)
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Envelope modes.
+const (
+	EnvelopeWrap   = "wrap"
+	EnvelopeUnwrap = "unwrap"
+)
+
+// EnvelopeOptions describes the envelope middleware or interceptors put
+// around response payloads, such as { data, meta, errors }.
+type EnvelopeOptions struct {
+	// Mode is wrap (document payloads inside the envelope) or unwrap
+	// (document the payload of handlers returning the envelope type)
+	Mode string
+
+	// Field is the envelope property holding the payload (default data)
+	Field string
+
+	// Schema names the component schema of the envelope. With wrap it
+	// contributes the other envelope properties through allOf; with unwrap
+	// it is a pattern of the envelope schemas to unwrap (e.g., ApiResponse*)
+	Schema string
+
+	// Statuses are the response codes the envelope applies to; 2XX-style
+	// wildcards match a class (default 2XX)
+	Statuses []string
+
+	// ExcludePaths are glob patterns of paths without the envelope
+	ExcludePaths []string
+}
+
+// ApplyEnvelope wraps or unwraps the JSON response schemas of doc and
+// returns the number of responses it changed. Responses that already
+// reference the envelope schema are left alone when wrapping.
+func ApplyEnvelope(doc *types.OpenAPI, opts EnvelopeOptions) int {
+	if doc == nil || (opts.Mode != EnvelopeWrap && opts.Mode != EnvelopeUnwrap) {
+		return 0
+	}
+	if opts.Field == "" {
+		opts.Field = "data"
+	}
+	if len(opts.Statuses) == 0 {
+		opts.Statuses = []string{"2XX"}
+	}
+	var schemas map[string]*types.Schema
+	if doc.Components != nil {
+		schemas = doc.Components.Schemas
+	}
+
+	changed := 0
+	for p, item := range doc.Paths {
+		if matchesAny(opts.ExcludePaths, p) {
+			continue
+		}
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			for code, resp := range op.Responses {
+				if !statusMatches(opts.Statuses, code) {
+					continue
+				}
+				// Content may be shared with the route it was built from
+				content := make(map[string]types.MediaType, len(resp.Content))
+				modified := false
+				for mediaType, media := range resp.Content {
+					if media.Schema != nil && strings.Contains(mediaType, "json") {
+						var schema *types.Schema
+						if opts.Mode == EnvelopeWrap {
+							schema = wrapPayload(schemas, media.Schema, opts)
+						} else {
+							schema = unwrapPayload(schemas, media.Schema, opts)
+						}
+						if schema != nil {
+							media.Schema = schema
+							modified = true
+						}
+					}
+					content[mediaType] = media
+				}
+				if modified {
+					resp.Content = content
+					op.Responses[code] = resp
+					changed++
+				}
+			}
+		}
+	}
+	return changed
+}
+
+// wrapPayload returns payload inside the envelope, or nil if it already
+// references the envelope schema.
+func wrapPayload(schemas map[string]*types.Schema, payload *types.Schema, opts EnvelopeOptions) *types.Schema {
+	if name, ok := strings.CutPrefix(payload.Ref, schemaRefPrefix); ok && name == opts.Schema {
+		return nil
+	}
+
+	wrapper := &types.Schema{
+		Type:       "object",
+		Properties: map[string]*types.Schema{opts.Field: payload},
+		Required:   []string{opts.Field},
+	}
+	if _, ok := schemas[opts.Schema]; !ok {
+		return wrapper
+	}
+	return &types.Schema{AllOf: []*types.Schema{{Ref: schemaRefPrefix + opts.Schema}, wrapper}}
+}
+
+// unwrapPayload returns the payload of an envelope schema, or nil if schema
+// is not an envelope.
+func unwrapPayload(schemas map[string]*types.Schema, schema *types.Schema, opts EnvelopeOptions) *types.Schema {
+	name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix)
+	if !ok {
+		return nil
+	}
+	if matched, _ := path.Match(opts.Schema, name); !matched {
+		return nil
+	}
+	return envelopeField(schemas, schema, opts.Field)
+}
+
+// envelopeField returns the schema of the payload field of an object
+// schema, following a component reference and allOf parts.
+func envelopeField(schemas map[string]*types.Schema, schema *types.Schema, field string) *types.Schema {
+	seen := make(map[string]bool)
+	var find func(s *types.Schema) *types.Schema
+	find = func(s *types.Schema) *types.Schema {
+		if s == nil {
+			return nil
+		}
+		if name, ok := strings.CutPrefix(s.Ref, schemaRefPrefix); ok {
+			if seen[name] {
+				return nil
+			}
+			seen[name] = true
+			return find(schemas[name])
+		}
+		if prop, ok := s.Properties[field]; ok {
+			return prop
+		}
+		for _, part := range s.AllOf {
+			if prop := find(part); prop != nil {
+				return prop
+			}
+		}
+		return nil
+	}
+	return find(schema)
+}
+
+// statusMatches reports whether a response code matches one of statuses,
+// where 2XX matches every 2xx code.
+func statusMatches(statuses []string, code string) bool {
+	for _, status := range statuses {
+		if strings.EqualFold(status, code) {
+			return true
+		}
+		if len(status) == 3 && len(code) == 3 && strings.EqualFold(status[1:], "XX") && status[0] == code[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny reports whether p matches one of the glob patterns.
+func matchesAny(patterns []string, p string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := doublestar.Match(pattern, p); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func jsonResponse(schema *types.Schema) types.Response {
+	return types.Response{Content: map[string]types.MediaType{"application/json": {Schema: schema}}}
+}
+
+func responseSchema(doc *types.OpenAPI, path, code string) *types.Schema {
+	return doc.Paths[path].Get.Responses[code].Content["application/json"].Schema
+}
+
+func TestApplyEnvelope_Wrap(t *testing.T) {
+	user := &types.Schema{Ref: "#/components/schemas/User"}
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Responses: map[string]types.Response{
+				"200": jsonResponse(user),
+				"404": jsonResponse(&types.Schema{Ref: "#/components/schemas/Error"}),
+			}}},
+			"/health":  {Get: &types.Operation{Responses: map[string]types.Response{"200": jsonResponse(&types.Schema{Type: "string"})}}},
+			"/wrapped": {Get: &types.Operation{Responses: map[string]types.Response{"200": jsonResponse(&types.Schema{Ref: "#/components/schemas/Envelope"})}}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":     {Type: "object"},
+			"Envelope": {Type: "object", Properties: map[string]*types.Schema{"meta": {Type: "object"}}},
+		}},
+	}
+
+	changed := ApplyEnvelope(doc, EnvelopeOptions{Mode: EnvelopeWrap, Schema: "Envelope", ExcludePaths: []string{"/health"}})
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, &types.Schema{AllOf: []*types.Schema{
+		{Ref: "#/components/schemas/Envelope"},
+		{Type: "object", Properties: map[string]*types.Schema{"data": user}, Required: []string{"data"}},
+	}}, responseSchema(doc, "/users", "200"))
+	assert.Equal(t, "#/components/schemas/Error", responseSchema(doc, "/users", "404").Ref)
+	assert.Equal(t, "string", responseSchema(doc, "/health", "200").Type)
+	assert.Equal(t, "#/components/schemas/Envelope", responseSchema(doc, "/wrapped", "200").Ref)
+
+	// Without an envelope schema only the payload field is documented
+	doc.Paths["/users"].Get.Responses["200"] = jsonResponse(user)
+	ApplyEnvelope(doc, EnvelopeOptions{Mode: EnvelopeWrap, Field: "result", Statuses: []string{"200", "4XX"}})
+	assert.Equal(t, &types.Schema{
+		Type:       "object",
+		Properties: map[string]*types.Schema{"result": user},
+		Required:   []string{"result"},
+	}, responseSchema(doc, "/users", "200"))
+	assert.Equal(t, "#/components/schemas/Error", responseSchema(doc, "/users", "404").Properties["result"].Ref)
+}
+
+func TestApplyEnvelope_Unwrap(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Responses: map[string]types.Response{
+				"200": jsonResponse(&types.Schema{Ref: "#/components/schemas/ApiResponseUser"}),
+				"201": jsonResponse(&types.Schema{Ref: "#/components/schemas/User"}),
+			}}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"ApiResponseUser": {AllOf: []*types.Schema{
+				{Ref: "#/components/schemas/ApiResponseBase"},
+				{Type: "object", Properties: map[string]*types.Schema{"data": {Ref: "#/components/schemas/User"}}},
+			}},
+			"ApiResponseBase": {Type: "object", Properties: map[string]*types.Schema{"meta": {Type: "object"}}},
+			"User":            {Type: "object"},
+		}},
+	}
+
+	changed := ApplyEnvelope(doc, EnvelopeOptions{Mode: EnvelopeUnwrap, Schema: "ApiResponse*"})
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, "#/components/schemas/User", responseSchema(doc, "/users", "200").Ref)
+	assert.Equal(t, "#/components/schemas/User", responseSchema(doc, "/users", "201").Ref)
+}
+
+func TestStatusMatches(t *testing.T) {
+	assert.True(t, statusMatches([]string{"2XX"}, "201"))
+	assert.True(t, statusMatches([]string{"2xx"}, "200"))
+	assert.True(t, statusMatches([]string{"default"}, "default"))
+	assert.False(t, statusMatches([]string{"2XX"}, "400"))
+	assert.False(t, statusMatches([]string{"200"}, "201"))
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// maxExampleDepth bounds schema recursion for self-referencing components.
+const maxExampleDepth = 32
+
+// uuidPattern matches a UUID in its canonical textual form.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ExampleMismatch is an example that does not match its schema.
+type ExampleMismatch struct {
+	// Pointer is the JSON pointer of the example
+	Pointer string
+
+	// Reasons state how the example differs from the schema (e.g.,
+	// example.id is string but the schema declares integer)
+	Reasons []string
+}
+
+// String formats the mismatch as "pointer: reasons".
+func (m ExampleMismatch) String() string {
+	return m.Pointer + ": " + strings.Join(m.Reasons, "; ")
+}
+
+// ValidateExamples checks every example of doc against its schema: those
+// of schemas and properties, parameters and request and response bodies.
+// Hand-written examples kept by a merge go stale when the code changes the
+// schema; each mismatch is reported with the example's JSON pointer.
+// Examples of unresolvable references and external values are not
+// checked.
+func ValidateExamples(doc *types.OpenAPI) []ExampleMismatch {
+	c := &exampleChecker{schemas: componentSchemas(doc)}
+
+	w := &schemaWalker{visit: func(pointer string, schema *types.Schema) {
+		if schema.Example != nil {
+			c.check(pointer+"/example", schema, schema.Example)
+		}
+	}}
+	w.document(doc)
+
+	for _, path := range SortedPaths(doc.Paths) {
+		c.pathItem("#/paths/"+escapePointer(path), doc.Paths[path])
+	}
+	if comp := doc.Components; comp != nil {
+		for _, name := range slices.Sorted(maps.Keys(comp.Responses)) {
+			c.content("#/components/responses/"+escapePointer(name)+"/content", comp.Responses[name].Content)
+		}
+		for _, name := range slices.Sorted(maps.Keys(comp.Parameters)) {
+			c.parameter("#/components/parameters/"+escapePointer(name), comp.Parameters[name])
+		}
+		for _, name := range slices.Sorted(maps.Keys(comp.RequestBodies)) {
+			c.content("#/components/requestBodies/"+escapePointer(name)+"/content", comp.RequestBodies[name].Content)
+		}
+	}
+	return c.mismatches
+}
+
+// exampleChecker collects the examples that do not match their schema.
+type exampleChecker struct {
+	schemas    map[string]*types.Schema
+	mismatches []ExampleMismatch
+}
+
+func (c *exampleChecker) pathItem(pointer string, item types.PathItem) {
+	for i, param := range item.Parameters {
+		c.parameter(pointer+"/parameters/"+strconv.Itoa(i), param)
+	}
+	for _, slot := range operationSlots(&item) {
+		op := *slot.op
+		if op == nil {
+			continue
+		}
+		opPointer := pointer + "/" + strings.ToLower(slot.method)
+		for i, param := range op.Parameters {
+			c.parameter(opPointer+"/parameters/"+strconv.Itoa(i), param)
+		}
+		if op.RequestBody != nil {
+			c.content(opPointer+"/requestBody/content", op.RequestBody.Content)
+		}
+		for _, code := range slices.Sorted(maps.Keys(op.Responses)) {
+			c.content(opPointer+"/responses/"+code+"/content", op.Responses[code].Content)
+		}
+		for _, name := range slices.Sorted(maps.Keys(op.Callbacks)) {
+			callback := op.Callbacks[name]
+			for _, expr := range slices.Sorted(maps.Keys(callback)) {
+				c.pathItem(opPointer+"/callbacks/"+escapePointer(name)+"/"+escapePointer(expr), callback[expr])
+			}
+		}
+	}
+}
+
+func (c *exampleChecker) parameter(pointer string, param types.Parameter) {
+	if param.Example != nil {
+		c.check(pointer+"/example", param.Schema, param.Example)
+	}
+}
+
+func (c *exampleChecker) content(pointer string, content map[string]types.MediaType) {
+	for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+		media := content[mediaType]
+		mediaPointer := pointer + "/" + escapePointer(mediaType)
+		if media.Example != nil {
+			c.check(mediaPointer+"/example", media.Schema, media.Example)
+		}
+		for _, name := range slices.Sorted(maps.Keys(media.Examples)) {
+			if value := media.Examples[name].Value; value != nil {
+				c.check(mediaPointer+"/examples/"+escapePointer(name)+"/value", media.Schema, value)
+			}
+		}
+	}
+}
+
+// check records a mismatch when example does not match schema.
+func (c *exampleChecker) check(pointer string, schema *types.Schema, example any) {
+	// Normalize YAML-decoded values to their JSON form
+	data, err := json.Marshal(example)
+	if err != nil {
+		return
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return
+	}
+	if reasons := c.value(schema, value, "example", 0); len(reasons) > 0 {
+		c.mismatches = append(c.mismatches, ExampleMismatch{Pointer: pointer, Reasons: reasons})
+	}
+}
+
+// value returns how value differs from schema; path names value in the
+// reasons.
+func (c *exampleChecker) value(schema *types.Schema, value any, path string, depth int) []string {
+	schema = c.resolve(schema)
+	if schema == nil || depth > maxExampleDepth {
+		return nil
+	}
+
+	if alternatives := append(slices.Clone(schema.OneOf), schema.AnyOf...); len(alternatives) > 0 {
+		for _, alt := range alternatives {
+			if len(c.value(alt, value, path, depth+1)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%s matches none of the %d alternatives of the schema", path, len(alternatives))}
+	}
+
+	if value == nil {
+		if schema.Nullable || schema.Type == "" || schema.Type == "null" {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s is null but the schema declares a non-nullable %s", path, schema.Type)}
+	}
+	if actual := exampleType(value); !exampleTypeMatches(schema.Type, actual) {
+		return []string{fmt.Sprintf("%s is %s but the schema declares %s", path, actual, schema.Type)}
+	}
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(allowed any) bool {
+		return fmt.Sprint(allowed) == fmt.Sprint(value)
+	}) {
+		return []string{fmt.Sprintf("%s value %v is not one of the schema's enum values", path, value)}
+	}
+
+	var reasons []string
+	switch typed := value.(type) {
+	case string:
+		if !formatMatches(schema.Format, typed) {
+			reasons = append(reasons, fmt.Sprintf("%s %q is not a valid %s", path, typed, schema.Format))
+		}
+	case []any:
+		for i, item := range typed {
+			reasons = append(reasons, c.value(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), depth+1)...)
+		}
+	case map[string]any:
+		for _, name := range schema.Required {
+			prop := c.resolve(schema.Properties[name])
+			// Request examples omit read-only and response examples
+			// write-only properties
+			if _, ok := typed[name]; !ok && (prop == nil || (!prop.ReadOnly && !prop.WriteOnly)) {
+				reasons = append(reasons, fmt.Sprintf("%s is missing required property %s", path, name))
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(typed)) {
+			propPath := path + "." + name
+			if prop, ok := schema.Properties[name]; ok {
+				reasons = append(reasons, c.value(prop, typed[name], propPath, depth+1)...)
+				continue
+			}
+			if schema.AdditionalProperties.IsFalse() {
+				reasons = append(reasons, fmt.Sprintf("%s is not a property of the schema", propPath))
+			} else if schema.AdditionalProperties != nil {
+				reasons = append(reasons, c.value(schema.AdditionalProperties, typed[name], propPath, depth+1)...)
+			}
+		}
+	}
+	return reasons
+}
+
+// resolve follows component references and flattens allOf into a single
+// schema. It returns nil for unresolvable references, which are not
+// checked.
+func (c *exampleChecker) resolve(schema *types.Schema) *types.Schema {
+	for depth := 0; schema != nil && schema.Ref != ""; depth++ {
+		name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix)
+		if !ok || depth >= maxExampleDepth {
+			return nil
+		}
+		schema = c.schemas[name]
+	}
+	if schema == nil || len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := *schema
+	merged.AllOf = nil
+	merged.Properties = maps.Clone(schema.Properties)
+	if merged.Properties == nil {
+		merged.Properties = make(map[string]*types.Schema)
+	}
+	merged.Required = slices.Clone(schema.Required)
+	for _, part := range schema.AllOf {
+		part = c.resolve(part)
+		if part == nil {
+			continue
+		}
+		if merged.Type == "" {
+			merged.Type = part.Type
+		}
+		maps.Copy(merged.Properties, part.Properties)
+		merged.Required = append(merged.Required, part.Required...)
+	}
+	return &merged
+}
+
+// exampleType names the JSON type of a decoded value.
+func exampleType(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "null"
+	}
+}
+
+// exampleTypeMatches reports whether a value of JSON type actual fits the
+// declared schema type.
+func exampleTypeMatches(schemaType, actual string) bool {
+	switch schemaType {
+	case "":
+		return true
+	case "number":
+		return actual == "number" || actual == "integer"
+	default:
+		return schemaType == actual
+	}
+}
+
+// formatMatches reports whether s is valid in format. Only date, date-time
+// and uuid are checked.
+func formatMatches(format, s string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	case "uuid":
+		return uuidPattern.MatchString(s)
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestValidateExamples(t *testing.T) {
+	spec := `
+openapi: 3.0.3
+info: {title: Shop, version: 1.0.0}
+paths:
+  /users/{id}:
+    get:
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: integer}, example: abc}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/User"}
+              example: {id: 7, name: Ada, role: owner, createdAt: "2024-01-01"}
+              examples:
+                valid:
+                  value: {id: 7, name: Ada, role: admin, createdAt: "2024-01-01T10:00:00Z", tags: [a]}
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: "#/components/schemas/User"}
+            example: {name: Ada, tags: [1]}
+      responses:
+        "204": {description: No Content}
+components:
+  schemas:
+    User:
+      type: object
+      required: [id, name]
+      additionalProperties: false
+      properties:
+        id: {type: integer, readOnly: true, example: 7}
+        name: {type: string, example: 42}
+        role: {type: string, enum: [admin, member]}
+        createdAt: {type: string, format: date-time}
+        tags: {type: array, items: {type: string}}
+      example: {id: 1, name: Ada, nickname: ada}
+`
+	var doc types.OpenAPI
+	require.NoError(t, yaml.Unmarshal([]byte(spec), &doc))
+
+	var got []string
+	for _, m := range ValidateExamples(&doc) {
+		got = append(got, m.String())
+	}
+	assert.Equal(t, []string{
+		"#/components/schemas/User/example: example.nickname is not a property of the schema",
+		"#/components/schemas/User/properties/name/example: example is integer but the schema declares string",
+		"#/paths/~1users~1{id}/get/parameters/0/example: example is string but the schema declares integer",
+		`#/paths/~1users~1{id}/get/responses/200/content/application~1json/example: example.createdAt "2024-01-01" is not a valid date-time; example.role value owner is not one of the schema's enum values`,
+		"#/paths/~1users~1{id}/put/requestBody/content/application~1json/example: example.tags[0] is integer but the schema declares string",
+	}, got)
+}
+
+func TestValidateExamples_Composition(t *testing.T) {
+	doc := &types.OpenAPI{
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"Base": {Type: "object", Required: []string{"id"}, Properties: map[string]*types.Schema{"id": {Type: "string", Format: "uuid"}}},
+				"Pet": {
+					AllOf:   []*types.Schema{{Ref: "#/components/schemas/Base"}, {Properties: map[string]*types.Schema{"age": {Type: "integer"}}}},
+					Example: map[string]any{"age": 3},
+				},
+				"Id": {
+					OneOf:   []*types.Schema{{Type: "integer"}, {Type: "string", Format: "uuid"}},
+					Example: "123e4567-e89b-12d3-a456-426614174000",
+				},
+				"Stub": {Ref: "#/components/schemas/Missing", Example: 1},
+			},
+		},
+	}
+
+	mismatches := ValidateExamples(doc)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "#/components/schemas/Pet/example", mismatches[0].Pointer)
+	assert.Equal(t, []string{"example is missing required property id"}, mismatches[0].Reasons)
+}
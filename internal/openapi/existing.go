@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+// SpecReference is an existing spec file that the project loads into
+// OpenAPI middleware such as a validator or swagger-ui.
+type SpecReference struct {
+	// Path is the absolute path of the spec file
+	Path string
+
+	// Middleware is the package that loads the spec
+	Middleware string
+
+	// SourceFile is the file that references the spec
+	SourceFile string
+
+	// SourceLine is the line of the reference
+	SourceLine int
+}
+
+// specMiddleware lists packages that serve or validate against an existing
+// spec file, by the import or module name that identifies them.
+var specMiddleware = []string{
+	"express-openapi-validator",
+	"swagger-ui-express",
+	"express-openapi",
+	"@fastify/swagger",
+	"fastify-swagger",
+	"fastify-openapi-glue",
+	"openapi-backend",
+	"koa2-swagger-ui",
+	"connexion",
+}
+
+var specFileRegex = regexp.MustCompile("['\"`]((?:\\$\\{[^}]*\\}/)?[^'\"`\\s]+\\.(?:ya?ml|json))['\"`]")
+
+// FindSpecReferences returns the existing specs that source files load into
+// OpenAPI middleware, in file order. Paths are resolved relative to the
+// referencing file and then to root, and only files that parse as OpenAPI
+// documents are returned.
+func FindSpecReferences(files []scanner.SourceFile, root string) []SpecReference {
+	var refs []SpecReference
+	seen := make(map[string]bool)
+
+	for _, file := range files {
+		middleware := fileMiddleware(file.Content)
+		if middleware == "" {
+			continue
+		}
+
+		for _, match := range specFileRegex.FindAllSubmatchIndex(file.Content, -1) {
+			literal := string(file.Content[match[2]:match[3]])
+			path := resolveSpecPath(literal, filepath.Dir(file.Path), root)
+			if path == "" || seen[path] {
+				continue
+			}
+			seen[path] = true
+			refs = append(refs, SpecReference{
+				Path:       path,
+				Middleware: middleware,
+				SourceFile: file.Path,
+				SourceLine: 1 + strings.Count(string(file.Content[:match[0]]), "\n"),
+			})
+		}
+	}
+	return refs
+}
+
+// fileMiddleware returns the spec middleware a file imports, if any.
+func fileMiddleware(content []byte) string {
+	text := string(content)
+	for _, name := range specMiddleware {
+		for _, quote := range []string{"'", `"`} {
+			if strings.Contains(text, quote+name+quote) {
+				return name
+			}
+		}
+		// Python imports are not quoted
+		if name == "connexion" && (strings.Contains(text, "import connexion") || strings.Contains(text, "from connexion")) {
+			return name
+		}
+	}
+	return ""
+}
+
+// resolveSpecPath resolves a spec file literal to an absolute path of an
+// OpenAPI document, or returns "".
+func resolveSpecPath(literal, dir, root string) string {
+	name := filepath.Base(literal)
+	if name == "package.json" || strings.HasPrefix(name, "tsconfig") || name == "package-lock.json" {
+		return ""
+	}
+
+	// ${__dirname}/openapi.yaml and similar template prefixes
+	relativeToFile := true
+	if strings.HasPrefix(literal, "${") {
+		prefix := literal[:strings.Index(literal, "}")+1]
+		relativeToFile = strings.Contains(prefix, "__dirname")
+		literal = strings.TrimPrefix(literal[len(prefix):], "/")
+	}
+
+	var candidates []string
+	switch {
+	case filepath.IsAbs(literal):
+		candidates = []string{literal}
+	case relativeToFile:
+		candidates = []string{filepath.Join(dir, literal), filepath.Join(root, literal)}
+	default:
+		candidates = []string{filepath.Join(root, literal)}
+	}
+
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err != nil || info.IsDir() {
+			continue
+		}
+		doc, err := ReadFile(candidate)
+		if err != nil || doc.OpenAPI == "" {
+			continue
+		}
+		if abs, err := filepath.Abs(candidate); err == nil {
+			return abs
+		}
+	}
+	return ""
+}
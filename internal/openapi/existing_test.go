@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+func TestFindSpecReferences(t *testing.T) {
+	root := t.TempDir()
+	spec := "openapi: 3.0.3\ninfo:\n  title: Pets\n  version: 1.0.0\npaths: {}\n"
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src", "api"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "api", "openapi.yaml"), []byte(spec), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "swagger.json"), []byte(`{"openapi": "3.0.3", "info": {"title": "Pets", "version": "1"}}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "config.json"), []byte(`{"port": 3000}`), 0o644))
+
+	files := []scanner.SourceFile{
+		{
+			Path: filepath.Join(root, "src", "plain.js"),
+			Content: []byte(`const data = require('../swagger.json')
+`),
+		},
+		{
+			Path: filepath.Join(root, "src", "app.js"),
+			Content: []byte(`const OpenApiValidator = require('express-openapi-validator')
+const settings = require('../config.json')
+const pkg = require('../package.json')
+
+app.use(OpenApiValidator.middleware({
+  apiSpec: path.join(__dirname, 'api/openapi.yaml'),
+}))
+`),
+		},
+		{
+			Path: filepath.Join(root, "src", "docs.ts"),
+			Content: []byte("import swaggerUi from 'swagger-ui-express'\n" +
+				"const doc = JSON.parse(fs.readFileSync('swagger.json', 'utf8'))\n" +
+				"const again = YAML.load(`${__dirname}/api/openapi.yaml`)\n"),
+		},
+	}
+
+	refs := FindSpecReferences(files, root)
+	require.Len(t, refs, 2)
+
+	assert.Equal(t, filepath.Join(root, "src", "api", "openapi.yaml"), refs[0].Path)
+	assert.Equal(t, "express-openapi-validator", refs[0].Middleware)
+	assert.Equal(t, filepath.Join(root, "src", "app.js"), refs[0].SourceFile)
+	assert.Equal(t, 6, refs[0].SourceLine)
+
+	// Relative to the working directory, as fs.readFileSync resolves it
+	assert.Equal(t, filepath.Join(root, "swagger.json"), refs[1].Path)
+	assert.Equal(t, "swagger-ui-express", refs[1].Middleware)
+}
+
+func TestFindSpecReferences_Connexion(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "spec.yml"), []byte("openapi: 3.1.0\ninfo: {title: x, version: '1'}\n"), 0o644))
+
+	refs := FindSpecReferences([]scanner.SourceFile{{
+		Path:    filepath.Join(root, "app.py"),
+		Content: []byte("import connexion\napp = connexion.App(__name__)\napp.add_api(\"spec.yml\")\n"),
+	}}, root)
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, "connexion", refs[0].Middleware)
+	assert.Equal(t, 3, refs[0].SourceLine)
+}
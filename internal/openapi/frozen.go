@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtFrozen marks an operation or component schema whose contract the
+// merge must not change.
+const ExtFrozen = "x-frozen"
+
+// FrozenContracts selects the operations and component schemas of the
+// existing document, besides those marked x-frozen, whose contract the
+// merge must not change. Component schemas a frozen operation references
+// are frozen with it.
+type FrozenContracts struct {
+	// Operations are glob patterns of "METHOD /path" or "/path" (e.g.,
+	// "GET /v1/users/**")
+	Operations []string
+
+	// Schemas are glob patterns of component schema names
+	Schemas []string
+}
+
+// FrozenChange is a change the generated document makes to a frozen
+// contract.
+type FrozenChange struct {
+	// Contract is "METHOD /path" or "schema Name"
+	Contract string
+
+	// Description states the change (e.g., response 200 changed)
+	Description string
+}
+
+// FrozenError is returned by the merge when the generated document changes
+// frozen contracts.
+type FrozenError struct {
+	Changes []FrozenChange
+}
+
+// Error lists the changes, one per line.
+func (e *FrozenError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "extraction would change %d frozen contract(s); update the code or unfreeze them (%s, generation.frozen):", len(e.Changes), ExtFrozen)
+	for _, change := range e.Changes {
+		sb.WriteString("\n  " + change.Contract + ": " + change.Description)
+	}
+	return sb.String()
+}
+
+// frozenSet holds the frozen operations, as "METHOD path", and component
+// schemas of a document.
+type frozenSet struct {
+	operations map[string]bool
+	schemas    map[string]bool
+}
+
+// frozen returns the frozen contracts of existing.
+func (f FrozenContracts) frozen(existing *types.OpenAPI) frozenSet {
+	set := frozenSet{operations: make(map[string]bool), schemas: make(map[string]bool)}
+	schemas := componentSchemas(existing)
+	refs := &refCollector{schemas: schemas, used: make(map[string]bool)}
+	for _, path := range SortedPaths(existing.Paths) {
+		item := existing.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil || !f.operation(path, slot.method, op) {
+				continue
+			}
+			set.operations[slot.method+" "+path] = true
+			for _, param := range item.Parameters {
+				refs.schema(param.Schema)
+			}
+			refs.operation(op)
+		}
+	}
+	for name, schema := range schemas {
+		if refs.used[name] || (schema != nil && schema.Extensions[ExtFrozen] == true) || matchesAny(f.Schemas, name) {
+			set.schemas[name] = true
+		}
+	}
+	return set
+}
+
+// operation reports whether the operation at method and path is frozen.
+func (f FrozenContracts) operation(path, method string, op *types.Operation) bool {
+	if op.Extensions[ExtFrozen] == true {
+		return true
+	}
+	for _, pattern := range f.Operations {
+		target := method + " " + path
+		if strings.HasPrefix(pattern, "/") {
+			target = path
+		}
+		if matched, _ := doublestar.Match(pattern, target); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFrozen returns the frozen contracts of existing, or a FrozenError
+// when generated changes them. selected limits the operations the merge
+// updates, and the schemas to those they reference; nil selects all.
+func (m *Merger) checkFrozen(existing, generated *types.OpenAPI, selected func(path string, op *types.Operation) bool) (frozenSet, error) {
+	set := m.options.Frozen.frozen(existing)
+	var changes []FrozenChange
+
+	generatedSchemas := componentSchemas(generated)
+	refs := &refCollector{schemas: generatedSchemas, used: make(map[string]bool)}
+	for _, path := range SortedPaths(existing.Paths) {
+		item, generatedItem := existing.Paths[path], generated.Paths[path]
+		generatedSlots := operationSlots(&generatedItem)
+		for i, slot := range operationSlots(&item) {
+			op, generatedOp := *slot.op, *generatedSlots[i].op
+			if op == nil || !set.operations[slot.method+" "+path] {
+				continue
+			}
+			if selected != nil && !selected(path, op) && !selected(path, generatedOp) {
+				continue
+			}
+			contract := slot.method + " " + path
+			if generatedOp == nil {
+				changes = append(changes, FrozenChange{Contract: contract, Description: "removed from the code"})
+				continue
+			}
+			refs.operation(generatedOp)
+			for _, description := range operationContractChanges(item.Parameters, generatedItem.Parameters, op, generatedOp) {
+				changes = append(changes, FrozenChange{Contract: contract, Description: description})
+			}
+		}
+	}
+	if selected != nil {
+		// Subset merges only update the schemas selected operations use
+		for path, item := range generated.Paths {
+			for _, slot := range operationSlots(&item) {
+				if op := *slot.op; op != nil && selected(path, op) {
+					refs.operation(op)
+				}
+			}
+		}
+	}
+
+	existingSchemas := componentSchemas(existing)
+	for _, name := range slices.Sorted(maps.Keys(set.schemas)) {
+		generatedSchema, ok := generatedSchemas[name]
+		if !ok || (selected != nil && !refs.used[name]) {
+			continue
+		}
+		if contractJSON(existingSchemas[name]) == contractJSON(generatedSchema) {
+			continue
+		}
+		description := "changed"
+		if properties, err := DiffSchema(name, existing, generated); err == nil && len(properties) > 0 {
+			var parts []string
+			for _, p := range properties {
+				if p.Type == DiffTypeModified {
+					parts = append(parts, p.Property+" "+p.Description)
+				} else {
+					parts = append(parts, p.Property+" "+string(p.Type))
+				}
+			}
+			description = strings.Join(parts, ", ")
+		}
+		changes = append(changes, FrozenChange{Contract: "schema " + name, Description: description})
+	}
+
+	if len(changes) > 0 {
+		return set, &FrozenError{Changes: changes}
+	}
+	return set, nil
+}
+
+// keepFrozen restores the frozen operations and schemas of existing in
+// merged, so their documentation stays as written too.
+func keepFrozen(existing, merged *types.OpenAPI, set frozenSet) {
+	for path, item := range existing.Paths {
+		mergedItem, ok := merged.Paths[path]
+		if !ok {
+			continue
+		}
+		mergedSlots := operationSlots(&mergedItem)
+		restored := false
+		for i, slot := range operationSlots(&item) {
+			if *slot.op != nil && set.operations[slot.method+" "+path] {
+				*mergedSlots[i].op = *slot.op
+				restored = true
+			}
+		}
+		if restored {
+			merged.Paths[path] = mergedItem
+		}
+	}
+	if len(set.schemas) == 0 || merged.Components == nil || merged.Components.Schemas == nil {
+		return
+	}
+	existingSchemas := componentSchemas(existing)
+	for name := range set.schemas {
+		if schema, ok := existingSchemas[name]; ok {
+			merged.Components.Schemas[name] = schema
+		}
+	}
+}
+
+// operationContractChanges describes how operation b, with path item
+// parameters bParams, changes the contract of a.
+func operationContractChanges(aParams, bParams []types.Parameter, a, b *types.Operation) []string {
+	var changes []string
+
+	params := func(pathParams []types.Parameter, op *types.Operation) map[string]types.Parameter {
+		byKey := make(map[string]types.Parameter)
+		for _, param := range append(slices.Clone(pathParams), op.Parameters...) {
+			byKey[param.Name+" in "+param.In] = param
+		}
+		return byKey
+	}
+	changes = append(changes, mapContractChanges("parameter", params(aParams, a), params(bParams, b))...)
+
+	var aBody, bBody any
+	if a.RequestBody != nil {
+		aBody = a.RequestBody
+	}
+	if b.RequestBody != nil {
+		bBody = b.RequestBody
+	}
+	if contractJSON(aBody) != contractJSON(bBody) {
+		changes = append(changes, "request body changed")
+	}
+
+	changes = append(changes, mapContractChanges("response", a.Responses, b.Responses)...)
+
+	if contractJSON(a.Security) != contractJSON(b.Security) {
+		changes = append(changes, "security changed")
+	}
+	return changes
+}
+
+// mapContractChanges describes the entries of b added, removed or changed
+// from a, in key order.
+func mapContractChanges[V any](kind string, a, b map[string]V) []string {
+	keys := slices.Sorted(maps.Keys(a))
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []string
+	for _, key := range keys {
+		aValue, inA := a[key]
+		bValue, inB := b[key]
+		switch {
+		case !inB:
+			changes = append(changes, kind+" "+key+" removed")
+		case !inA:
+			changes = append(changes, kind+" "+key+" added")
+		case contractJSON(aValue) != contractJSON(bValue):
+			changes = append(changes, kind+" "+key+" changed")
+		}
+	}
+	return changes
+}
+
+// docKeys are the fields that document a contract without changing it.
+var docKeys = map[string]bool{
+	"description": true, "summary": true, "title": true, "example": true,
+	"examples": true, "externalDocs": true, "tags": true, "links": true,
+}
+
+// nameKeys are the fields mapping names, such as property names, which are
+// part of the contract whatever they are called.
+var nameKeys = map[string]bool{
+	"properties": true, "content": true, "headers": true, "responses": true,
+	"mapping": true, "callbacks": true,
+}
+
+// contractJSON returns the serialized form of v without documentation and
+// x- extensions, which only changes when the contract does.
+func contractJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var tree any
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return ""
+	}
+	data, _ = json.Marshal(stripDocs(tree, false))
+	return string(data)
+}
+
+// stripDocs removes documentation fields from a decoded JSON tree; names
+// marks a map keyed by names rather than fields.
+func stripDocs(v any, names bool) any {
+	switch typed := v.(type) {
+	case map[string]any:
+		stripped := make(map[string]any, len(typed))
+		for key, value := range typed {
+			if !names && (docKeys[key] || strings.HasPrefix(key, "x-")) {
+				continue
+			}
+			stripped[key] = stripDocs(value, !names && nameKeys[key])
+		}
+		return stripped
+	case []any:
+		stripped := make([]any, len(typed))
+		for i, value := range typed {
+			stripped[i] = stripDocs(value, false)
+		}
+		return stripped
+	}
+	return v
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const frozenExisting = `
+openapi: 3.0.3
+info: {title: Shop, version: 1.0.0}
+paths:
+  /users/{id}:
+    get:
+      x-frozen: true
+      summary: Fetch a user
+      description: Hand-written
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: integer}}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/User"}
+  /orders:
+    get:
+      responses:
+        "200": {description: OK}
+components:
+  schemas:
+    User:
+      type: object
+      description: A customer
+      properties:
+        id: {type: integer}
+        name: {type: string}
+    Order:
+      type: object
+      properties:
+        id: {type: integer}
+`
+
+func parseFrozen(t *testing.T, spec string) *types.OpenAPI {
+	t.Helper()
+	var doc types.OpenAPI
+	require.NoError(t, yaml.Unmarshal([]byte(spec), &doc))
+	return &doc
+}
+
+func TestMerger_Frozen(t *testing.T) {
+	existing := parseFrozen(t, frozenExisting)
+	generated := parseFrozen(t, `
+openapi: 3.0.3
+info: {title: Shop, version: 1.0.0}
+paths:
+  /users/{id}:
+    get:
+      summary: Get user
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+        - {name: expand, in: query, schema: {type: string}}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/User"}
+        "404": {description: Not Found}
+  /orders:
+    get:
+      responses:
+        "200": {description: OK}
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        id: {type: integer}
+        email: {type: string}
+    Order:
+      type: object
+      properties:
+        id: {type: string}
+`)
+
+	_, err := NewMerger(DefaultMergeOptions()).MergeWithResult(existing, generated)
+	var frozenErr *FrozenError
+	require.ErrorAs(t, err, &frozenErr)
+	assert.Equal(t, []FrozenChange{
+		{Contract: "GET /users/{id}", Description: "parameter expand in query added"},
+		{Contract: "GET /users/{id}", Description: "parameter id in path changed"},
+		{Contract: "GET /users/{id}", Description: "response 404 added"},
+		{Contract: "schema User", Description: "email added, name removed"},
+	}, frozenErr.Changes)
+	assert.Contains(t, err.Error(), "extraction would change 4 frozen contract(s)")
+	assert.Contains(t, err.Error(), "\n  schema User: email added, name removed")
+}
+
+func TestMerger_FrozenConfig(t *testing.T) {
+	existing := parseFrozen(t, frozenExisting)
+	generated := parseFrozen(t, frozenExisting)
+	delete(generated.Paths, "/orders")
+	generated.Components.Schemas["Order"].Properties["total"] = &types.Schema{Type: "number"}
+
+	opts := DefaultMergeOptions()
+	opts.Frozen = FrozenContracts{Operations: []string{"GET /orders"}, Schemas: []string{"Ord*"}}
+	_, err := NewMerger(opts).MergeWithResult(existing, generated)
+	var frozenErr *FrozenError
+	require.ErrorAs(t, err, &frozenErr)
+	require.Len(t, frozenErr.Changes, 2)
+	assert.Equal(t, FrozenChange{Contract: "GET /orders", Description: "removed from the code"}, frozenErr.Changes[0])
+	assert.Equal(t, "schema Order", frozenErr.Changes[1].Contract)
+
+	// Path patterns cover every method
+	opts.Frozen = FrozenContracts{Operations: []string{"/ord*"}}
+	_, err = NewMerger(opts).MergeWithResult(existing, generated)
+	require.ErrorAs(t, err, &frozenErr)
+	assert.Equal(t, []FrozenChange{{Contract: "GET /orders", Description: "removed from the code"}}, frozenErr.Changes)
+}
+
+func TestMerger_FrozenDocumentation(t *testing.T) {
+	existing := parseFrozen(t, frozenExisting)
+	generated := parseFrozen(t, frozenExisting)
+	op := generated.Paths["/users/{id}"].Get
+	op.Summary = "Get user"
+	op.Description = ""
+	delete(op.Extensions, ExtFrozen)
+	generated.Components.Schemas["User"].Description = ""
+	generated.Components.Schemas["User"].Properties["name"].Example = "Ada"
+
+	result, err := NewMerger(DefaultMergeOptions()).MergeWithResult(existing, generated)
+	require.NoError(t, err)
+
+	// Frozen contracts are kept as written
+	merged := result.Document.Paths["/users/{id}"].Get
+	assert.Equal(t, "Fetch a user", merged.Summary)
+	assert.Equal(t, "Hand-written", merged.Description)
+	assert.Equal(t, true, merged.Extensions[ExtFrozen])
+	assert.Equal(t, "A customer", result.Document.Components.Schemas["User"].Description)
+	assert.Nil(t, result.Document.Components.Schemas["User"].Properties["name"].Example)
+}
+
+func TestMerger_FrozenSubset(t *testing.T) {
+	existing := parseFrozen(t, frozenExisting)
+	generated := parseFrozen(t, frozenExisting)
+	generated.Paths["/users/{id}"].Get.Responses["404"] = types.Response{Description: "Not Found"}
+	generated.Paths["/orders"].Get.Summary = "List orders"
+
+	// Only the selected operations are checked
+	merged, err := NewMerger(DefaultMergeOptions()).MergeSubset(existing, generated, Subset{Paths: []string{"/orders"}})
+	require.NoError(t, err)
+	assert.Equal(t, "List orders", merged.Paths["/orders"].Get.Summary)
+	assert.NotContains(t, merged.Paths["/users/{id}"].Get.Responses, "404")
+
+	_, err = NewMerger(DefaultMergeOptions()).MergeSubset(existing, generated, Subset{Paths: []string{"/users/**"}})
+	var frozenErr *FrozenError
+	require.ErrorAs(t, err, &frozenErr)
+	assert.Equal(t, []FrozenChange{{Contract: "GET /users/{id}", Description: "response 404 added"}}, frozenErr.Changes)
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"path"
+	"strings"
+	"unicode"
+)
+
+// Well-known SDK grouping extensions and the naming convention of their values.
+const (
+	ExtGoPackage = "x-go-package"
+	ExtTSModule  = "x-ts-module"
+)
+
+// controllerSuffixes are stripped from source file names to find the
+// controller or module name, e.g. users.controller.ts or UserController.java.
+var controllerSuffixes = []string{
+	".controller", ".controllers", ".routes", ".router", ".handler", ".handlers", ".resource", ".api",
+	"_controller", "_controllers", "_routes", "_router", "_handler", "_handlers", "_resource", "_api", "_views",
+	"controller", "controllers", "routes", "router", "handler", "handlers", "resource", "endpoints",
+}
+
+// genericFileNames do not name a controller; the directory name is used instead.
+var genericFileNames = map[string]bool{
+	"": true, "index": true, "main": true, "app": true, "server": true, "api": true,
+	"mod": true, "lib": true, "views": true, "urls": true, "__init__": true, "init": true,
+}
+
+// SDKGroup derives the controller or module name of a route from the file
+// that defines it: users.controller.ts, users_controller.rb,
+// UserController.java, and handlers/users.go all yield "users" or "user".
+// Generic file names such as index.ts or routes.go use their directory.
+func SDKGroup(sourceFile string) string {
+	file := path.Clean(strings.ReplaceAll(sourceFile, "\\", "/"))
+	dir, base := path.Split(file)
+	name := strings.TrimSuffix(base, path.Ext(base))
+
+	for _, suffix := range controllerSuffixes {
+		if len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+			name = name[:len(name)-len(suffix)]
+			break
+		}
+		if strings.EqualFold(name, suffix) {
+			name = ""
+			break
+		}
+	}
+
+	for genericFileNames[strings.ToLower(name)] && dir != "" {
+		dir = strings.TrimSuffix(dir, "/")
+		dir, name = path.Split(dir)
+		if genericFileNames[strings.ToLower(name)] || isGroupDirectory(name) {
+			name = ""
+		}
+	}
+	if genericFileNames[strings.ToLower(name)] {
+		return ""
+	}
+	return name
+}
+
+// isGroupDirectory reports whether a directory holds many controllers rather
+// than being one.
+func isGroupDirectory(name string) bool {
+	switch strings.ToLower(name) {
+	case "controllers", "handlers", "routes", "routers", "resources", "endpoints", "src", "internal", "app", "api", ".":
+		return true
+	}
+	return false
+}
+
+// groupValue formats a group name for an extension: a Go package name for
+// x-go-package, a camelCase module name for x-ts-module, and the name as
+// derived for any other extension.
+func groupValue(ext, group string) string {
+	words := groupWords(group)
+	switch ext {
+	case ExtGoPackage:
+		return strings.Join(words, "")
+	case ExtTSModule:
+		for i := 1; i < len(words); i++ {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+		return strings.Join(words, "")
+	default:
+		return group
+	}
+}
+
+// groupWords splits a name into lowercase words at punctuation and case changes.
+func groupWords(name string) []string {
+	var words []string
+	var current []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && len(current) > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			words = append(words, string(current))
+			current = nil
+		}
+		current = append(current, unicode.ToLower(r))
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
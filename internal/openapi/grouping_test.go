@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSDKGroup(t *testing.T) {
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"src/users/users.controller.ts", "users"},
+		{"app/controllers/admin/orders_controller.rb", "orders"},
+		{"src/main/java/com/acme/UserController.java", "User"},
+		{"internal/handlers/users.go", "users"},
+		{"internal/handlers/billing/handler.go", "billing"},
+		{"src/routes/invoices/index.ts", "invoices"},
+		{"app/api/v1/payments.py", "payments"},
+		{`src\Http\Controllers\PostController.php`, "Post"},
+		{"routes/index.js", ""},
+		{"main.go", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			assert.Equal(t, tt.want, SDKGroup(tt.file))
+		})
+	}
+}
+
+func TestGroupValue(t *testing.T) {
+	assert.Equal(t, "userprofile", groupValue(ExtGoPackage, "UserProfile"))
+	assert.Equal(t, "userProfile", groupValue(ExtTSModule, "user_profile"))
+	assert.Equal(t, "userProfiles", groupValue(ExtTSModule, "user-profiles"))
+	assert.Equal(t, "user_profile", groupValue("x-python-module", "user_profile"))
+}
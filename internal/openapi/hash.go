@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtSpecHash holds the content hash of an operation.
+const ExtSpecHash = "x-spec-hash"
+
+// StampHashes sets x-spec-hash on every operation of doc. The hash covers
+// the operation, the parameters of its path item and the component schemas
+// it references, so it changes whenever the operation's contract does.
+func StampHashes(doc *types.OpenAPI) {
+	if doc == nil {
+		return
+	}
+	for path, item := range doc.Paths {
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			hash := OperationHash(doc, item.Parameters, op)
+			if op.Extensions == nil {
+				op.Extensions = make(types.Extensions)
+			}
+			op.Extensions[ExtSpecHash] = hash
+		}
+		doc.Paths[path] = item
+	}
+}
+
+// OperationHash returns the content hash of op, ignoring any x-spec-hash it
+// already carries.
+func OperationHash(doc *types.OpenAPI, pathParams []types.Parameter, op *types.Operation) string {
+	content := *op
+	if _, ok := op.Extensions[ExtSpecHash]; ok {
+		content.Extensions = make(types.Extensions, len(op.Extensions))
+		for key, value := range op.Extensions {
+			if key != ExtSpecHash {
+				content.Extensions[key] = value
+			}
+		}
+	}
+
+	var schemas map[string]*types.Schema
+	if doc.Components != nil && len(doc.Components.Schemas) > 0 {
+		r := &refCollector{schemas: doc.Components.Schemas, used: make(map[string]bool)}
+		for _, param := range pathParams {
+			r.schema(param.Schema)
+		}
+		r.operation(op)
+		schemas = make(map[string]*types.Schema, len(r.used))
+		for name := range r.used {
+			if schema, ok := doc.Components.Schemas[name]; ok {
+				schemas[name] = schema
+			}
+		}
+	}
+
+	// Encoding sorts map keys, so equal content always hashes the same
+	data, err := json.Marshal(struct {
+		Parameters []types.Parameter        `json:"parameters,omitempty"`
+		Operation  types.Operation          `json:"operation"`
+		Schemas    map[string]*types.Schema `json:"schemas,omitempty"`
+	}{pathParams, content, schemas})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// ChangedOperations returns "METHOD path" for each operation of doc whose
+// x-spec-hash differs from the same operation in previous, including
+// operations previous lacks, sorted by path and method.
+func ChangedOperations(previous, doc *types.OpenAPI) []string {
+	var changed []string
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		var before types.PathItem
+		if previous != nil {
+			before = previous.Paths[path]
+		}
+		beforeSlots := operationSlots(&before)
+		for i, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			old := *beforeSlots[i].op
+			if old == nil || specHash(old) != specHash(op) {
+				changed = append(changed, slot.method+" "+path)
+			}
+		}
+	}
+	return changed
+}
+
+// specHash returns the x-spec-hash stamped on op, or "" if there is none.
+func specHash(op *types.Operation) string {
+	hash, _ := op.Extensions[ExtSpecHash].(string)
+	return hash
+}
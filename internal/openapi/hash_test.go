@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func hashDoc() *types.OpenAPI {
+	return &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{Responses: map[string]types.Response{"200": {Description: "OK"}}},
+				Post: &types.Operation{
+					RequestBody: &types.RequestBody{Content: map[string]types.MediaType{
+						"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/User"}},
+					}},
+				},
+			},
+			"/orders": {Get: &types.Operation{Summary: "List orders"}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User": {Type: "object", Properties: map[string]*types.Schema{"name": {Type: "string"}}},
+		}},
+	}
+}
+
+func TestStampHashes(t *testing.T) {
+	doc := hashDoc()
+	StampHashes(doc)
+
+	hash := specHash(doc.Paths["/users"].Post)
+	assert.Len(t, hash, 16)
+	assert.NotEqual(t, hash, specHash(doc.Paths["/users"].Get))
+
+	// Stamping again keeps the hash stable
+	StampHashes(doc)
+	assert.Equal(t, hash, specHash(doc.Paths["/users"].Post))
+
+	// Changing a referenced schema changes only the operations using it
+	changed := hashDoc()
+	changed.Components.Schemas["User"].Properties["email"] = &types.Schema{Type: "string"}
+	StampHashes(changed)
+	assert.NotEqual(t, hash, specHash(changed.Paths["/users"].Post))
+	assert.Equal(t, specHash(doc.Paths["/users"].Get), specHash(changed.Paths["/users"].Get))
+}
+
+func TestStampHashes_SurvivesRoundTrip(t *testing.T) {
+	doc := hashDoc()
+	StampHashes(doc)
+
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	require.NoError(t, NewWriter().WriteFile(doc, path, "yaml"))
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+
+	StampHashes(read)
+	assert.Empty(t, ChangedOperations(doc, read))
+}
+
+func TestChangedOperations(t *testing.T) {
+	previous := hashDoc()
+	StampHashes(previous)
+
+	doc := hashDoc()
+	doc.Paths["/orders"].Get.Summary = "List all orders"
+	doc.Paths["/orders"] = types.PathItem{Get: doc.Paths["/orders"].Get, Delete: &types.Operation{}}
+	StampHashes(doc)
+
+	assert.Equal(t, []string{"GET /orders", "DELETE /orders"}, ChangedOperations(previous, doc))
+	assert.Len(t, ChangedOperations(nil, doc), 4)
+}
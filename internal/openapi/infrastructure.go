@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// IsInfrastructure reports whether path is an infrastructure endpoint, such
+// as a health check or metrics endpoint, matching one of patterns.
+func IsInfrastructure(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := doublestar.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// addInfrastructureTag declares tag on doc unless it already is.
+func addInfrastructureTag(doc *types.OpenAPI, tag string) {
+	for _, t := range doc.Tags {
+		if t.Name == tag {
+			return
+		}
+	}
+	doc.Tags = append(doc.Tags, types.Tag{
+		Name:        tag,
+		Description: "Health checks, readiness probes and metrics",
+	})
+}
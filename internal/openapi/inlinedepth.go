@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// nonAlphanumeric separates the words of property names and paths.
+var nonAlphanumeric = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// ExtractDeepSchemas moves inline object schemas nested more than
+// maxDepth objects deep in request bodies, responses and component schemas
+// into components named after their property path, e.g. the address of a
+// createOrder request's shipping property becomes
+// CreateOrderRequestShippingAddress. Extracted schemas are limited in turn.
+// It returns the number of components added.
+func ExtractDeepSchemas(doc *types.OpenAPI, maxDepth int) int {
+	if doc == nil || maxDepth < 1 {
+		return 0
+	}
+	if doc.Components == nil {
+		doc.Components = &types.Components{}
+	}
+	if doc.Components.Schemas == nil {
+		doc.Components.Schemas = make(map[string]*types.Schema)
+	}
+	e := &depthExtractor{
+		schemas:   doc.Components.Schemas,
+		maxDepth:  maxDepth,
+		extracted: make(map[*types.Schema]string),
+	}
+
+	for _, name := range SortedSchemas(e.schemas) {
+		e.schemas[name] = e.limit(e.schemas[name], name, 0)
+	}
+
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			base := pascalName(op.OperationID)
+			if base == "" {
+				base = pascalName(strings.ToLower(slot.method) + " " + path)
+			}
+			if op.RequestBody != nil {
+				e.limitContent(op.RequestBody.Content, base+"Request")
+			}
+			for status, resp := range op.Responses {
+				name := base + "Response"
+				if !strings.HasPrefix(status, "2") {
+					name += pascalName(status)
+				}
+				e.limitContent(resp.Content, name)
+			}
+		}
+	}
+	return e.added
+}
+
+// depthExtractor holds the state of ExtractDeepSchemas.
+type depthExtractor struct {
+	schemas  map[string]*types.Schema
+	maxDepth int
+
+	// extracted maps extracted schemas to their component name, so shared
+	// schemas become one component
+	extracted map[*types.Schema]string
+	added     int
+}
+
+// limitContent limits the schemas of a request or response body.
+func (e *depthExtractor) limitContent(content map[string]types.MediaType, name string) {
+	for mediaType, media := range content {
+		if limited := e.limit(media.Schema, name, 0); limited != media.Schema {
+			media.Schema = limited
+			content[mediaType] = media
+		}
+	}
+}
+
+// limit returns schema with the objects nested depth objects or more below
+// it replaced by references. Schemas may be shared, so changes are made to
+// copies.
+func (e *depthExtractor) limit(schema *types.Schema, name string, depth int) *types.Schema {
+	if schema == nil || schema.Ref != "" || schema.Bool != nil {
+		return schema
+	}
+	isObject := len(schema.Properties) > 0
+	if isObject && depth >= e.maxDepth {
+		return &types.Schema{Ref: schemaRefPrefix + e.extract(schema, name)}
+	}
+
+	childDepth := depth
+	if isObject {
+		childDepth++
+	}
+	copied := *schema
+	changed := false
+	child := func(s *types.Schema, name string, depth int) *types.Schema {
+		limited := e.limit(s, name, depth)
+		if limited != s {
+			changed = true
+		}
+		return limited
+	}
+
+	if len(schema.Properties) > 0 {
+		copied.Properties = make(map[string]*types.Schema, len(schema.Properties))
+		for prop, s := range schema.Properties {
+			copied.Properties[prop] = child(s, name+pascalName(prop), childDepth)
+		}
+	}
+	copied.Items = child(schema.Items, name+"Item", depth)
+	copied.AdditionalProperties = child(schema.AdditionalProperties, name+"Value", childDepth)
+	parts := func(schemas []*types.Schema, suffix string) []*types.Schema {
+		if len(schemas) == 0 {
+			return schemas
+		}
+		result := make([]*types.Schema, len(schemas))
+		for i, s := range schemas {
+			partName := name
+			if suffix != "" {
+				partName += suffix + strconv.Itoa(i+1)
+			}
+			result[i] = child(s, partName, depth)
+		}
+		return result
+	}
+	copied.AllOf = parts(schema.AllOf, "")
+	copied.OneOf = parts(schema.OneOf, "Option")
+	copied.AnyOf = parts(schema.AnyOf, "Option")
+
+	if !changed {
+		return schema
+	}
+	return &copied
+}
+
+// extract adds schema as a component and returns its name. A name taken by
+// a different schema gets a numeric suffix.
+func (e *depthExtractor) extract(schema *types.Schema, name string) string {
+	if existing, ok := e.extracted[schema]; ok {
+		return existing
+	}
+	limited := e.limit(schema, name, 0)
+
+	candidate := name
+	for i := 2; ; i++ {
+		existing, ok := e.schemas[candidate]
+		if !ok {
+			e.schemas[candidate] = limited
+			e.added++
+			break
+		}
+		if reflect.DeepEqual(existing, limited) {
+			break
+		}
+		candidate = name + strconv.Itoa(i)
+	}
+	e.extracted[schema] = candidate
+	return candidate
+}
+
+// pascalName joins the words of s in PascalCase.
+func pascalName(s string) string {
+	var b strings.Builder
+	for _, part := range nonAlphanumeric.Split(s, -1) {
+		for _, word := range splitWords(part) {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
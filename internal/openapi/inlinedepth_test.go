@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func object(props map[string]*types.Schema) *types.Schema {
+	return &types.Schema{Type: "object", Properties: props}
+}
+
+func TestExtractDeepSchemas(t *testing.T) {
+	str := &types.Schema{Type: "string"}
+	geo := object(map[string]*types.Schema{"lat": {Type: "number"}, "lng": {Type: "number"}})
+	address := object(map[string]*types.Schema{"street": str, "geo": geo})
+	body := object(map[string]*types.Schema{
+		"shipping": object(map[string]*types.Schema{"address": address}),
+		"lines": {Type: "array", Items: object(map[string]*types.Schema{
+			"sku":     str,
+			"options": object(map[string]*types.Schema{"color": str}),
+		})},
+	})
+	content := func(s *types.Schema) map[string]types.MediaType {
+		return map[string]types.MediaType{"application/json": {Schema: s}}
+	}
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/orders": {Post: &types.Operation{
+			OperationID: "createOrder",
+			RequestBody: &types.RequestBody{Content: content(body)},
+			Responses: map[string]types.Response{
+				"201": {Content: content(object(map[string]*types.Schema{"address": address}))},
+			},
+		}},
+	}}
+
+	added := ExtractDeepSchemas(doc, 2)
+
+	schemas := doc.Components.Schemas
+	assert.Equal(t, 3, added)
+	require.Contains(t, schemas, "CreateOrderRequestShippingAddress")
+	require.Contains(t, schemas, "CreateOrderRequestLinesItemOptions")
+	require.Contains(t, schemas, "CreateOrderResponseAddressGeo")
+
+	// Extracted schemas start again at depth 1
+	assert.Same(t, address, schemas["CreateOrderRequestShippingAddress"])
+
+	op := doc.Paths["/orders"].Post
+	limited := op.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, schemaRefPrefix+"CreateOrderRequestShippingAddress", limited.Properties["shipping"].Properties["address"].Ref)
+	assert.Equal(t, schemaRefPrefix+"CreateOrderRequestLinesItemOptions", limited.Properties["lines"].Items.Properties["options"].Ref)
+
+	// The response keeps address inline at depth 2 and extracts its geo
+	response := op.Responses["201"].Content["application/json"].Schema
+	assert.Empty(t, response.Properties["address"].Ref)
+	assert.Equal(t, schemaRefPrefix+"CreateOrderResponseAddressGeo", response.Properties["address"].Properties["geo"].Ref)
+
+	// The route's schemas are not modified
+	assert.Empty(t, address.Properties["geo"].Ref)
+	assert.Same(t, address, body.Properties["shipping"].Properties["address"])
+}
+
+func TestExtractDeepSchemas_Components(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"User": object(map[string]*types.Schema{
+			"profile": object(map[string]*types.Schema{"bio": {Type: "string"}}),
+		}),
+		"UserProfile": {Type: "string"},
+	}}}
+
+	assert.Equal(t, 1, ExtractDeepSchemas(doc, 1))
+	assert.Equal(t, schemaRefPrefix+"UserProfile2", doc.Components.Schemas["User"].Properties["profile"].Ref)
+	assert.Equal(t, "string", doc.Components.Schemas["UserProfile"].Type)
+
+	assert.Zero(t, ExtractDeepSchemas(doc, 0))
+}
+
+func TestPascalName(t *testing.T) {
+	assert.Equal(t, "CreateOrder", pascalName("createOrder"))
+	assert.Equal(t, "GetUsersId", pascalName("get /users/{id}"))
+	assert.Equal(t, "ShippingAddress", pascalName("shipping_address"))
+	assert.Equal(t, "400", pascalName("400"))
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Operation lifecycle extensions.
+const (
+	// ExtLifecycle documents the release stage and audience of the operation
+	ExtLifecycle = "x-lifecycle"
+
+	// ExtBadges lists the badges docs portals such as Redoc show next to
+	// the operation
+	ExtBadges = "x-badges"
+)
+
+// Badge is an entry of the x-badges extension.
+type Badge struct {
+	// Name is the badge text
+	Name string `json:"name" yaml:"name"`
+
+	// Color is the badge color
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+// lifecycleBadges are the badges of each stage and audience.
+var lifecycleBadges = map[string]Badge{
+	"alpha":    {Name: "Alpha", Color: "red"},
+	"beta":     {Name: "Beta", Color: "orange"},
+	"ga":       {Name: "GA", Color: "green"},
+	"internal": {Name: "Internal", Color: "gray"},
+	"partner":  {Name: "Partner", Color: "purple"},
+	"public":   {Name: "Public", Color: "blue"},
+}
+
+// ApplyLifecycle documents the lifecycle of every operation in x-lifecycle
+// and x-badges. Annotations found in the code win; rules set the stage and
+// audience they leave unset, a later matching rule overriding an earlier
+// one.
+func ApplyLifecycle(doc *types.OpenAPI, rules []config.LifecycleRule) {
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			annotated, _ := op.Extensions[ExtLifecycle].(types.Lifecycle)
+			var lifecycle types.Lifecycle
+			for _, rule := range rules {
+				if !(Subset{Paths: rule.Paths, Tags: rule.Tags}).Matches(path, op) {
+					continue
+				}
+				if rule.Stage != "" {
+					lifecycle.Stage = rule.Stage
+				}
+				if rule.Audience != "" {
+					lifecycle.Audience = rule.Audience
+				}
+			}
+			if annotated.Stage != "" {
+				lifecycle.Stage = annotated.Stage
+			}
+			if annotated.Audience != "" {
+				lifecycle.Audience = annotated.Audience
+			}
+			if lifecycle == (types.Lifecycle{}) {
+				continue
+			}
+
+			var badges []Badge
+			for _, value := range []string{lifecycle.Stage, lifecycle.Audience} {
+				if badge, ok := lifecycleBadges[value]; ok {
+					badges = append(badges, badge)
+				}
+			}
+			if op.Extensions == nil {
+				op.Extensions = make(types.Extensions)
+			}
+			op.Extensions[ExtLifecycle] = lifecycle
+			op.Extensions[ExtBadges] = badges
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplyLifecycle(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/v2/orders": {
+				Get:  &types.Operation{Tags: []string{"orders"}},
+				Post: &types.Operation{Extensions: types.Extensions{ExtLifecycle: types.Lifecycle{Stage: "alpha"}}},
+			},
+			"/partners": {
+				Get: &types.Operation{Tags: []string{"partners"}},
+			},
+			"/users": {
+				Get: &types.Operation{},
+			},
+		},
+	}
+
+	ApplyLifecycle(doc, []config.LifecycleRule{
+		{Paths: []string{"/v2/**"}, Stage: "beta"},
+		{Paths: []string{"/v2/**"}, Audience: "public"},
+		{Tags: []string{"partners"}, Audience: "partner"},
+	})
+
+	orders := doc.Paths["/v2/orders"]
+	assert.Equal(t, types.Lifecycle{Stage: "beta", Audience: "public"}, orders.Get.Extensions[ExtLifecycle])
+	assert.Equal(t, []Badge{{Name: "Beta", Color: "orange"}, {Name: "Public", Color: "blue"}}, orders.Get.Extensions[ExtBadges])
+	assert.Equal(t, types.Lifecycle{Stage: "alpha", Audience: "public"}, orders.Post.Extensions[ExtLifecycle], "annotations win over rules")
+	assert.Equal(t, []Badge{{Name: "Partner", Color: "purple"}}, doc.Paths["/partners"].Get.Extensions[ExtBadges])
+	assert.Nil(t, doc.Paths["/users"].Get.Extensions)
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// InferLinks adds links from the successful responses of operations to the
+// operations on the item their response identifies, such as from the 201
+// response of POST /users to GET /users/{id}: the item's path parameter is
+// read from the response field of the same name, or from id, and the
+// parameters the paths share from the request. envelope is the property
+// holding wrapped payloads, if any. It returns the number of links added;
+// existing links are kept.
+func InferLinks(doc *types.OpenAPI, envelope string) int {
+	if doc == nil {
+		return 0
+	}
+	var schemas map[string]*types.Schema
+	if doc.Components != nil {
+		schemas = doc.Components.Schemas
+	}
+
+	added := 0
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		targets := linkTargets(doc, path)
+		if len(targets) == 0 {
+			continue
+		}
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			for _, code := range slices.Sorted(maps.Keys(op.Responses)) {
+				resp := op.Responses[code]
+				if !strings.HasPrefix(code, "2") || code == "204" {
+					continue
+				}
+				body := jsonResponseSchema(resp)
+				if body == nil {
+					continue
+				}
+				for _, target := range targets {
+					pointer := identifierPointer(schemas, body, target.param, collectionName(path), envelope)
+					if pointer == "" {
+						continue
+					}
+					name, link := target.link(pointer)
+					if _, ok := resp.Links[name]; ok {
+						continue
+					}
+					if resp.Links == nil {
+						resp.Links = make(map[string]types.Link)
+					}
+					resp.Links[name] = link
+					added++
+				}
+				op.Responses[code] = resp
+			}
+		}
+	}
+	return added
+}
+
+// linkTarget is an operation on an item of a collection.
+type linkTarget struct {
+	method string
+	path   string
+	op     *types.Operation
+
+	// param is the path parameter identifying the item
+	param string
+
+	// shared are the path parameters of the collection
+	shared []string
+}
+
+// linkTargets returns the operations on the items of the collection at
+// path, whose paths add one parameter to it.
+func linkTargets(doc *types.OpenAPI, path string) []linkTarget {
+	var targets []linkTarget
+	for _, candidate := range SortedPaths(doc.Paths) {
+		rest, ok := strings.CutPrefix(candidate, strings.TrimSuffix(path, "/")+"/")
+		if !ok || len(rest) < 3 || rest[0] != '{' || strings.IndexByte(rest, '}') != len(rest)-1 {
+			continue
+		}
+		item := doc.Paths[candidate]
+		for _, slot := range operationSlots(&item) {
+			if *slot.op == nil {
+				continue
+			}
+			targets = append(targets, linkTarget{
+				method: slot.method,
+				path:   candidate,
+				op:     *slot.op,
+				param:  rest[1 : len(rest)-1],
+				shared: pathParams(path),
+			})
+		}
+	}
+	return targets
+}
+
+// link returns the name and the link to the target from a response whose
+// item identifier is at pointer.
+func (t linkTarget) link(pointer string) (string, types.Link) {
+	link := types.Link{
+		Parameters:  map[string]interface{}{t.param: "$response.body#" + pointer},
+		Description: fmt.Sprintf("The %s returned in the response can be used as the %s parameter in %s %s.", strings.TrimPrefix(pointer, "/"), t.param, t.method, t.path),
+	}
+	for _, param := range t.shared {
+		link.Parameters[param] = "$request.path." + param
+	}
+
+	name := t.op.OperationID
+	if name != "" {
+		link.OperationID = name
+	} else {
+		name = strings.ToLower(t.method) + pascalName(t.path)
+		escaped := strings.ReplaceAll(strings.ReplaceAll(t.path, "~", "~0"), "/", "~1")
+		link.OperationRef = "#/paths/" + escaped + "/" + strings.ToLower(t.method)
+	}
+	return name, link
+}
+
+// pathParams returns the names of the parameters of a path template.
+func pathParams(path string) []string {
+	var params []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params = append(params, segment[1:len(segment)-1])
+		}
+	}
+	return params
+}
+
+// collectionName returns the last literal segment of path, such as users
+// for /teams/{teamId}/users.
+func collectionName(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(segments[i], "{") {
+			return segments[i]
+		}
+	}
+	return ""
+}
+
+// jsonResponseSchema returns the JSON schema of a response, or nil.
+func jsonResponseSchema(resp types.Response) *types.Schema {
+	for _, mediaType := range slices.Sorted(maps.Keys(resp.Content)) {
+		if strings.Contains(mediaType, "json") && resp.Content[mediaType].Schema != nil {
+			return resp.Content[mediaType].Schema
+		}
+	}
+	return nil
+}
+
+// identifierPointer returns the JSON pointer to the field of a response
+// body that holds param, the identifier of an item of collection: a field
+// named like param (userId, user_id), or id when param is id or the
+// collection's singular followed by Id. Payloads wrapped in envelope are
+// searched too. It returns "" when the body has no such field.
+func identifierPointer(schemas map[string]*types.Schema, body *types.Schema, param, collection, envelope string) string {
+	if resolved := resolveSchema(body, schemas); resolved == nil || resolved.Type == "array" {
+		return ""
+	}
+	if field := identifierField(schemas, body, param, collection); field != "" {
+		return "/" + field
+	}
+	if envelope == "" {
+		return ""
+	}
+	payload := envelopeField(schemas, body, envelope)
+	if resolved := resolveSchema(payload, schemas); resolved == nil || resolved.Type == "array" {
+		return ""
+	}
+	if field := identifierField(schemas, payload, param, collection); field != "" {
+		return "/" + envelope + "/" + field
+	}
+	return ""
+}
+
+// identifierField returns the property of an object schema holding param.
+func identifierField(schemas map[string]*types.Schema, schema *types.Schema, param, collection string) string {
+	key := identifierKey(param)
+	candidates := []string{key}
+	if key == "id" || key == identifierKey(singular(collection))+"id" {
+		candidates = append(candidates, "id")
+	}
+	for _, candidate := range candidates {
+		for _, name := range objectProperties(schemas, schema) {
+			if identifierKey(name) == candidate {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// identifierKey folds the case and separators of a name, so that userId
+// and user_id compare equal.
+func identifierKey(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// objectProperties returns the sorted property names of an object schema,
+// following component references and allOf parts.
+func objectProperties(schemas map[string]*types.Schema, schema *types.Schema) []string {
+	seen := make(map[string]bool)
+	names := make(map[string]bool)
+	var collect func(s *types.Schema)
+	collect = func(s *types.Schema) {
+		if s == nil {
+			return
+		}
+		if name, ok := strings.CutPrefix(s.Ref, schemaRefPrefix); ok {
+			if seen[name] {
+				return
+			}
+			seen[name] = true
+			collect(schemas[name])
+			return
+		}
+		for name := range s.Properties {
+			names[name] = true
+		}
+		for _, part := range s.AllOf {
+			collect(part)
+		}
+	}
+	collect(schema)
+	return slices.Sorted(maps.Keys(names))
+}
+
+// singular returns the singular of an English collection name.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "xes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return name[:len(name)-1]
+	}
+	return name
+}
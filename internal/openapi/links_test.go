@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestInferLinks(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/teams/{teamId}/users": {
+				Get:  &types.Operation{Responses: map[string]types.Response{"200": jsonResponse(&types.Schema{Type: "array", Items: SchemaRef("User")})}},
+				Post: &types.Operation{Responses: map[string]types.Response{"201": jsonResponse(SchemaRef("User"))}},
+			},
+			"/teams/{teamId}/users/{userId}": {
+				Get:   &types.Operation{OperationID: "getUser"},
+				Patch: &types.Operation{},
+			},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "string"}, "name": {Type: "string"}}},
+		}},
+	}
+
+	assert.Equal(t, 2, InferLinks(doc, ""))
+
+	links := doc.Paths["/teams/{teamId}/users"].Post.Responses["201"].Links
+	require.Len(t, links, 2)
+	assert.Equal(t, types.Link{
+		OperationID: "getUser",
+		Parameters:  map[string]interface{}{"userId": "$response.body#/id", "teamId": "$request.path.teamId"},
+		Description: "The id returned in the response can be used as the userId parameter in GET /teams/{teamId}/users/{userId}.",
+	}, links["getUser"])
+	assert.Equal(t, "#/paths/~1teams~1{teamId}~1users~1{userId}/patch", links["patchTeamsTeamIdUsersUserId"].OperationRef)
+
+	// Lists identify no single item
+	assert.Empty(t, doc.Paths["/teams/{teamId}/users"].Get.Responses["200"].Links)
+}
+
+func TestInferLinks_Envelope(t *testing.T) {
+	wrapped := &types.Schema{Type: "object", Properties: map[string]*types.Schema{
+		"data": {Type: "object", Properties: map[string]*types.Schema{"order_id": {Type: "integer"}}},
+	}}
+	existing := types.Link{OperationID: "getOrder", Description: "Written by hand"}
+	resp := jsonResponse(wrapped)
+	resp.Links = map[string]types.Link{"getOrder": existing}
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/orders":           {Post: &types.Operation{Responses: map[string]types.Response{"201": resp}}},
+		"/orders/{orderId}": {Get: &types.Operation{OperationID: "getOrder"}, Delete: &types.Operation{OperationID: "cancelOrder"}},
+	}}
+
+	assert.Equal(t, 1, InferLinks(doc, "data"))
+
+	links := doc.Paths["/orders"].Post.Responses["201"].Links
+	assert.Equal(t, existing, links["getOrder"])
+	assert.Equal(t, map[string]interface{}{"orderId": "$response.body#/data/order_id"}, links["cancelOrder"].Parameters)
+}
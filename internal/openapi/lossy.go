@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// LossyConversion is a source type that a converter could not resolve and
+// documented with a generic schema instead, such as an unknown Python
+// generic, a Rust custom type or a TypeScript alias that was not extracted.
+type LossyConversion struct {
+	// File is the source file of the declaration, when known
+	File string `json:"file,omitempty"`
+
+	// Line is the 1-based line of the declaration, when known
+	Line int `json:"line,omitempty"`
+
+	// Type is the original type expression
+	Type string `json:"type"`
+
+	// Schema is what the type was documented as: object, string, or the
+	// reference to a component stubbed with x-unresolved
+	Schema string `json:"schema"`
+
+	// Location is the JSON pointer of the schema, or the operation and
+	// part of it for route schemas (e.g., GET /users query parameter sort)
+	Location string `json:"location"`
+}
+
+// String formats the conversion as file:line: type documented as schema
+// (location).
+func (c LossyConversion) String() string {
+	where := c.File
+	if where != "" && c.Line > 0 {
+		where += ":" + strconv.Itoa(c.Line)
+	}
+	if where != "" {
+		where += ": "
+	}
+	return fmt.Sprintf("%s%s documented as %s (%s)", where, c.Type, c.Schema, c.Location)
+}
+
+// LossyConversions returns the lossy type conversions in the component
+// schemas of doc and in the parameters, request bodies and responses of
+// routes, sorted by file and line. Schemas are located by their nearest
+// source declaration, and route schemas by the route's.
+func LossyConversions(doc *types.OpenAPI, routes []types.Route) []LossyConversion {
+	c := &lossyCollector{unresolved: make(map[string]bool)}
+	if doc != nil && doc.Components != nil {
+		for name, schema := range doc.Components.Schemas {
+			if unresolved, _ := schema.Extensions[ExtUnresolved].(bool); unresolved {
+				c.unresolved[name] = true
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(doc.Components.Schemas)) {
+			c.schema(schemaRefPrefix+escapePointer(name), doc.Components.Schemas[name], nil)
+		}
+	}
+
+	for _, route := range routes {
+		op := route.Method + " " + route.Path
+		src := &types.SourceLocation{File: route.SourceFile, Line: route.SourceLine}
+		for _, param := range route.Parameters {
+			c.schema(op+" "+param.In+" parameter "+param.Name, param.Schema, src)
+		}
+		if route.RequestBody != nil {
+			for _, mediaType := range slices.Sorted(maps.Keys(route.RequestBody.Content)) {
+				c.schema(op+" request body", route.RequestBody.Content[mediaType].Schema, src)
+			}
+		}
+		for _, code := range slices.Sorted(maps.Keys(route.Responses)) {
+			content := route.Responses[code].Content
+			for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+				c.schema(op+" response "+code, content[mediaType].Schema, src)
+			}
+		}
+	}
+
+	slices.SortStableFunc(c.found, func(a, b LossyConversion) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+	})
+	return c.found
+}
+
+// lossyCollector gathers lossy conversions.
+type lossyCollector struct {
+	// unresolved holds the component schemas stubbed with x-unresolved
+	unresolved map[string]bool
+
+	found []LossyConversion
+}
+
+// schema records the lossy conversions of schema, found at location, and
+// its subschemas. src is the nearest enclosing source declaration.
+func (c *lossyCollector) schema(location string, schema *types.Schema, src *types.SourceLocation) {
+	if schema == nil {
+		return
+	}
+	if schema.Source != nil {
+		src = schema.Source
+	}
+
+	var file string
+	var line int
+	if src != nil {
+		file, line = src.File, src.Line
+	}
+	if schema.Fallback != "" {
+		c.found = append(c.found, LossyConversion{File: file, Line: line, Type: schema.Fallback, Schema: schema.Type, Location: location})
+	} else if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok && c.unresolved[name] {
+		typ := name
+		if src != nil && src.Type != "" {
+			typ = src.Type
+		}
+		c.found = append(c.found, LossyConversion{File: file, Line: line, Type: typ, Schema: schema.Ref, Location: location})
+	}
+
+	sub := func(suffix string) string {
+		if strings.HasPrefix(location, "#/") {
+			return location + "/" + suffix
+		}
+		return location + " " + suffix
+	}
+	c.schema(sub("items"), schema.Items, src)
+	c.schema(sub("additionalProperties"), schema.AdditionalProperties, src)
+	c.schema(sub("not"), schema.Not, src)
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		c.schema(sub("properties/"+escapePointer(name)), schema.Properties[name], src)
+	}
+	for i, part := range schema.AllOf {
+		c.schema(sub("allOf/"+strconv.Itoa(i)), part, src)
+	}
+	for i, part := range schema.OneOf {
+		c.schema(sub("oneOf/"+strconv.Itoa(i)), part, src)
+	}
+	for i, part := range schema.AnyOf {
+		c.schema(sub("anyOf/"+strconv.Itoa(i)), part, src)
+	}
+}
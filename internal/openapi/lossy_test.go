@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestLossyConversions(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Order": {
+			Type:   "object",
+			Source: &types.SourceLocation{File: "models.py", Line: 3},
+			Properties: map[string]*types.Schema{
+				"total": {Type: "object", Fallback: "Money"},
+				"lines": {Type: "array", Items: &types.Schema{Type: "object", Fallback: "OrderLine[T]"}},
+				"id":    {Type: "string"},
+			},
+		},
+		"User": {
+			Type:   "object",
+			Source: &types.SourceLocation{File: "src/user.ts", Line: 1},
+			Properties: map[string]*types.Schema{
+				"role": {Ref: "#/components/schemas/Role", Source: &types.SourceLocation{File: "src/user.ts", Line: 4, Type: "RoleAlias"}},
+				"team": {Ref: "#/components/schemas/Team"},
+			},
+		},
+		"Role": {Extensions: types.Extensions{ExtUnresolved: true}},
+		"Team": {Type: "object"},
+	}}}
+	routes := []types.Route{{
+		Method:     "GET",
+		Path:       "/orders",
+		SourceFile: "handlers.rs",
+		SourceLine: 12,
+		Parameters: []types.Parameter{{Name: "cursor", In: "query", Schema: &types.Schema{Type: "object", Fallback: "Cursor"}}},
+		Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{
+			"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/Role"}},
+		}}},
+	}}
+
+	conversions := LossyConversions(doc, routes)
+
+	assert.Equal(t, []LossyConversion{
+		{File: "handlers.rs", Line: 12, Type: "Cursor", Schema: "object", Location: "GET /orders query parameter cursor"},
+		{File: "handlers.rs", Line: 12, Type: "Role", Schema: "#/components/schemas/Role", Location: "GET /orders response 200"},
+		{File: "models.py", Line: 3, Type: "OrderLine[T]", Schema: "object", Location: "#/components/schemas/Order/properties/lines/items"},
+		{File: "models.py", Line: 3, Type: "Money", Schema: "object", Location: "#/components/schemas/Order/properties/total"},
+		{File: "src/user.ts", Line: 4, Type: "RoleAlias", Schema: "#/components/schemas/Role", Location: "#/components/schemas/User/properties/role"},
+	}, conversions)
+	assert.Equal(t, "models.py:3: Money documented as object (#/components/schemas/Order/properties/total)", conversions[3].String())
+	assert.Empty(t, LossyConversions(nil, nil))
+}
@@ -52,6 +52,19 @@ type MergeOptions struct {
 
 	// PreserveSecurity preserves security from the existing document.
 	PreserveSecurity bool
+
+	// DetectRenames keeps the existing name of generated schemas identical
+	// to an existing schema under another name.
+	DetectRenames bool
+
+	// Frozen selects contracts, besides those marked x-frozen, the merge
+	// refuses to change.
+	Frozen FrozenContracts
+
+	// KeepGeneratedVersion uses the generated info.version even when
+	// PreserveInfo keeps the rest of the existing info, for versions read
+	// from a tag, manifest or flag.
+	KeepGeneratedVersion bool
 }
 
 // DefaultMergeOptions returns the default merge options.
@@ -66,6 +79,7 @@ func DefaultMergeOptions() MergeOptions {
 		PreserveInfo:            true,
 		PreserveServers:         true,
 		PreserveSecurity:        true,
+		DetectRenames:           true,
 	}
 }
 
@@ -91,6 +105,10 @@ type MergeResult struct {
 
 	// UpdatedSchemas is a list of schemas that were updated.
 	UpdatedSchemas []string
+
+	// RenamedSchemas maps generated schema names to the existing names they
+	// were detected as renames of and kept.
+	RenamedSchemas map[string]string
 }
 
 // Merger handles merging OpenAPI documents.
@@ -140,10 +158,20 @@ func (m *Merger) MergeWithResult(existing, generated *types.OpenAPI) (*MergeResu
 		return result, nil
 	}
 
+	if m.options.DetectRenames {
+		result.RenamedSchemas = RenameMovedSchemas(existing, generated)
+	}
+
+	frozen, err := m.checkFrozen(existing, generated, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Start with the generated document as the base
 	merged := &types.OpenAPI{
-		OpenAPI: generated.OpenAPI,
-		Info:    generated.Info,
+		OpenAPI:   generated.OpenAPI,
+		Info:      generated.Info,
+		PathOrder: generated.PathOrder,
 	}
 
 	// Merge info
@@ -171,6 +199,26 @@ func (m *Merger) MergeWithResult(existing, generated *types.OpenAPI) (*MergeResu
 		merged.ExternalDocs = generated.ExternalDocs
 	}
 
+	// Keep hand-written extensions; generated ones replace theirs
+	for key, value := range existing.Extensions {
+		if _, ok := generated.Extensions[key]; ok {
+			continue
+		}
+		if merged.Extensions == nil {
+			merged.Extensions = make(types.Extensions)
+		}
+		merged.Extensions[key] = value
+	}
+	for key, value := range generated.Extensions {
+		if merged.Extensions == nil {
+			merged.Extensions = make(types.Extensions)
+		}
+		merged.Extensions[key] = value
+	}
+
+	DedupeParameters(merged)
+	keepFrozen(existing, merged, frozen)
+
 	result.Document = merged
 	return result, nil
 }
@@ -180,6 +228,9 @@ func (m *Merger) mergeInfo(existing, generated types.Info) types.Info {
 	if m.options.PreserveInfo {
 		// Keep existing info but update version if generated has one
 		result := existing
+		if m.options.KeepGeneratedVersion && generated.Version != "" {
+			result.Version = generated.Version
+		}
 
 		// Always use generated title if it's not empty and existing is default
 		if generated.Title != "" && (existing.Title == "" || existing.Title == "API") {
@@ -277,6 +328,11 @@ func (m *Merger) mergePathItem(existing, generated types.PathItem) types.PathIte
 	// Merge path-level parameters
 	result.Parameters = m.mergeParameters(existing.Parameters, generated.Parameters)
 
+	// Preserve hand-written path-level servers
+	if m.options.PreserveServers && len(existing.Servers) > 0 && len(generated.Servers) == 0 {
+		result.Servers = existing.Servers
+	}
+
 	return result
 }
 
@@ -328,6 +384,17 @@ func (m *Merger) mergeOperation(existing, generated *types.Operation) *types.Ope
 		result.Security = existing.Security
 	}
 
+	// Keep hand-written extensions the generator did not produce
+	if len(existing.Extensions) > 0 {
+		result.Extensions = make(types.Extensions, len(existing.Extensions)+len(generated.Extensions))
+		for key, value := range existing.Extensions {
+			result.Extensions[key] = value
+		}
+		for key, value := range generated.Extensions {
+			result.Extensions[key] = value
+		}
+	}
+
 	return &result
 }
 
@@ -424,6 +491,16 @@ func (m *Merger) mergeResponses(existing, generated map[string]types.Response) m
 				}
 			}
 
+			// Preserve links written by hand
+			for name, link := range existResp.Links {
+				if _, ok := merged.Links[name]; !ok {
+					if merged.Links == nil {
+						merged.Links = make(map[string]types.Link)
+					}
+					merged.Links[name] = link
+				}
+			}
+
 			result[code] = merged
 		} else {
 			// Keep existing response codes not in generated
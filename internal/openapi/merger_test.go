@@ -96,6 +96,25 @@ func TestMerger_Merge_PreserveInfo(t *testing.T) {
 	assert.Equal(t, "2.0.0", result.Info.Version)
 }
 
+func TestMerger_Merge_KeepGeneratedVersion(t *testing.T) {
+	existing := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "Original API", Version: "2.0.0"},
+	}
+	generated := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "Generated API", Version: "2.1.0"},
+	}
+
+	opts := DefaultMergeOptions()
+	opts.KeepGeneratedVersion = true
+	result, err := NewMerger(opts).Merge(existing, generated)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Original API", result.Info.Title)
+	assert.Equal(t, "2.1.0", result.Info.Version)
+}
+
 func TestMerger_Merge_PreserveServers(t *testing.T) {
 	existing := &types.OpenAPI{
 		OpenAPI: "3.0.3",
@@ -551,6 +570,42 @@ func TestMerger_MergeResponses_PreserveDescription(t *testing.T) {
 	assert.Equal(t, "Internal server error", responses["500"].Description)
 }
 
+func TestMerger_MergeResponses_PreserveLinks(t *testing.T) {
+	manual := types.Link{OperationID: "listUserOrders", Parameters: map[string]interface{}{"userId": "$response.body#/id"}}
+	inferred := types.Link{OperationID: "getUser", Parameters: map[string]interface{}{"id": "$response.body#/id"}}
+	existing := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Post: &types.Operation{
+					Responses: map[string]types.Response{
+						"201": {Description: "Created", Links: map[string]types.Link{"listUserOrders": manual}},
+					},
+				},
+			},
+		},
+	}
+
+	generated := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Post: &types.Operation{
+					Responses: map[string]types.Response{
+						"201": {Description: "Created", Links: map[string]types.Link{"getUser": inferred}},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := NewMerger(DefaultMergeOptions()).Merge(existing, generated)
+
+	require.NoError(t, err)
+	links := result.Paths["/users"].Post.Responses["201"].Links
+	assert.Equal(t, map[string]types.Link{"listUserOrders": manual, "getUser": inferred}, links)
+}
+
 func TestMerger_MergeTags_Combine(t *testing.T) {
 	existing := &types.OpenAPI{
 		OpenAPI: "3.0.3",
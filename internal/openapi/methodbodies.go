@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Policies for request bodies of methods whose bodies have no defined
+// semantics (generation.methodBodies).
+const (
+	// MethodBodiesWarn documents the bodies and warns about them
+	MethodBodiesWarn = "warn"
+
+	// MethodBodiesDocument documents the bodies, as RFC 9110 allows
+	MethodBodiesDocument = "document"
+
+	// MethodBodiesQuery documents the properties of the bodies as query
+	// parameters instead
+	MethodBodiesQuery = "query"
+
+	// MethodBodiesDrop leaves the bodies out
+	MethodBodiesDrop = "drop"
+)
+
+// BodylessMethods are the methods a request body has no defined semantics
+// for.
+var BodylessMethods = []string{"GET", "HEAD", "DELETE"}
+
+// MethodBodies returns the operations of doc with a request body on one of
+// the BodylessMethods, as METHOD /path.
+func MethodBodies(doc *types.OpenAPI) []string {
+	var operations []string
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			if op := *slot.op; op != nil && op.RequestBody != nil && slices.Contains(BodylessMethods, slot.method) {
+				operations = append(operations, slot.method+" "+path)
+			}
+		}
+	}
+	return operations
+}
+
+// ApplyMethodBodies applies policy to the request bodies of the
+// BodylessMethods operations of doc: query moves the properties of object
+// bodies to query parameters, drop removes the bodies.
+func ApplyMethodBodies(doc *types.OpenAPI, policy string) {
+	if policy != MethodBodiesQuery && policy != MethodBodiesDrop {
+		return
+	}
+	schemas := componentSchemas(doc)
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil || op.RequestBody == nil || !slices.Contains(BodylessMethods, slot.method) {
+				continue
+			}
+			if policy == MethodBodiesQuery {
+				op.Parameters = append(op.Parameters, bodyQueryParameters(op, schemas)...)
+			}
+			op.RequestBody = nil
+		}
+		doc.Paths[path] = item
+	}
+}
+
+// bodyQueryParameters returns the query parameters documenting the request
+// body of op: one per property of an object body, else the body as one
+// form-exploded parameter. Parameters op already has are skipped.
+func bodyQueryParameters(op *types.Operation, schemas map[string]*types.Schema) []types.Parameter {
+	body := bodySchema(op.RequestBody.Content)
+	if body == nil {
+		return nil
+	}
+	existing := make(map[string]bool)
+	for _, param := range op.Parameters {
+		if param.In == "query" {
+			existing[param.Name] = true
+		}
+	}
+
+	resolved := body
+	if name, ok := strings.CutPrefix(body.Ref, "#/components/schemas/"); ok && schemas[name] != nil {
+		resolved = schemas[name]
+	}
+	if len(resolved.Properties) == 0 {
+		if existing["query"] {
+			return nil
+		}
+		explode := true
+		return []types.Parameter{{Name: "query", In: "query", Required: op.RequestBody.Required, Style: "form", Explode: &explode, Schema: body}}
+	}
+
+	var params []types.Parameter
+	for _, name := range slices.Sorted(maps.Keys(resolved.Properties)) {
+		if existing[name] {
+			continue
+		}
+		property := resolved.Properties[name]
+		params = append(params, types.Parameter{
+			Name:        name,
+			In:          "query",
+			Description: property.Description,
+			Required:    slices.Contains(resolved.Required, name),
+			Schema:      property,
+		})
+	}
+	return params
+}
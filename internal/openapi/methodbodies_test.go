@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func methodBodyRoutes() ([]types.Route, []types.Schema) {
+	jsonBody := func(schema *types.Schema) *types.RequestBody {
+		return &types.RequestBody{Required: true, Content: map[string]types.MediaType{"application/json": {Schema: schema}}}
+	}
+	routes := []types.Route{
+		{
+			Method:      "GET",
+			Path:        "/search",
+			Parameters:  []types.Parameter{{Name: "q", In: "query", Schema: &types.Schema{Type: "string"}}},
+			RequestBody: jsonBody(&types.Schema{Ref: "#/components/schemas/SearchFilter"}),
+		},
+		{Method: "DELETE", Path: "/items", RequestBody: jsonBody(&types.Schema{Type: "array", Items: &types.Schema{Type: "string"}})},
+		{Method: "POST", Path: "/items", RequestBody: jsonBody(&types.Schema{Ref: "#/components/schemas/SearchFilter"})},
+	}
+	schemas := []types.Schema{{
+		Title: "SearchFilter",
+		Type:  "object",
+		Properties: map[string]*types.Schema{
+			"q":     {Type: "string"},
+			"limit": {Type: "integer", Description: "Page size"},
+			"tags":  {Type: "array", Items: &types.Schema{Type: "string"}},
+		},
+		Required: []string{"limit"},
+	}}
+	return routes, schemas
+}
+
+func TestMethodBodies(t *testing.T) {
+	routes, schemas := methodBodyRoutes()
+	doc, err := NewBuilder(config.Default()).Build(routes, schemas)
+	require.NoError(t, err)
+
+	// warn, the default, documents the bodies
+	assert.Equal(t, []string{"DELETE /items", "GET /search"}, MethodBodies(doc))
+	assert.NotNil(t, doc.Paths["/search"].Get.RequestBody)
+}
+
+func TestApplyMethodBodies_Query(t *testing.T) {
+	routes, schemas := methodBodyRoutes()
+	cfg := config.Default()
+	cfg.Generation.MethodBodies = MethodBodiesQuery
+	doc, err := NewBuilder(cfg).Build(routes, schemas)
+	require.NoError(t, err)
+	assert.Empty(t, MethodBodies(doc))
+
+	search := doc.Paths["/search"].Get
+	assert.Nil(t, search.RequestBody)
+	require.Len(t, search.Parameters, 3)
+	assert.Equal(t, "q", search.Parameters[0].Name)
+	assert.Equal(t, types.Parameter{Name: "limit", In: "query", Description: "Page size", Required: true, Schema: &types.Schema{Type: "integer", Description: "Page size"}}, search.Parameters[1])
+	assert.Equal(t, "tags", search.Parameters[2].Name)
+
+	// Bodies without properties become one form-exploded parameter
+	del := doc.Paths["/items"].Delete
+	assert.Nil(t, del.RequestBody)
+	require.Len(t, del.Parameters, 1)
+	assert.Equal(t, "query", del.Parameters[0].Name)
+	assert.Equal(t, "form", del.Parameters[0].Style)
+	assert.Equal(t, "array", del.Parameters[0].Schema.Type)
+
+	assert.NotNil(t, doc.Paths["/items"].Post.RequestBody)
+}
+
+func TestApplyMethodBodies_Drop(t *testing.T) {
+	routes, schemas := methodBodyRoutes()
+	cfg := config.Default()
+	cfg.Generation.MethodBodies = MethodBodiesDrop
+	doc, err := NewBuilder(cfg).Build(routes, schemas)
+	require.NoError(t, err)
+
+	assert.Nil(t, doc.Paths["/search"].Get.RequestBody)
+	assert.Len(t, doc.Paths["/search"].Get.Parameters, 1)
+	assert.Nil(t, doc.Paths["/items"].Delete.RequestBody)
+	assert.NotNil(t, doc.Paths["/items"].Post.RequestBody)
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// mountSegments returns the literal segments of a mount path, without its
+// parameters: /tenants/{id}/admin has tenants and admin.
+func mountSegments(mount string) []string {
+	var segments []string
+	for _, segment := range strings.Split(mount, "/") {
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// mountName returns the camelCase name of a mount path that operationIds
+// are prefixed with (e.g., adminPanel for /admin-panel).
+func mountName(mount string) string {
+	var sb strings.Builder
+	for _, segment := range mountSegments(mount) {
+		words := strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if sb.Len() == 0 {
+				sb.WriteString(strings.ToLower(word[:1]) + word[1:])
+			} else {
+				sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+			}
+		}
+	}
+	return sb.String()
+}
+
+// prefixMount prefixes the tags and operationId of op, built from a route
+// registered on a mounted router, as selected by cfg. Names the plugin
+// generated from the full path are replaced by those generated from the path
+// below the mount first. Tags named after a segment of the mount and
+// operationIds already starting with its name are kept.
+func prefixMount(op *types.Operation, route types.Route, cfg config.MountPrefixesConfig) {
+	mount := route.Mount
+	segments := mountSegments(mount)
+	if len(segments) == 0 {
+		return
+	}
+	if cfg.Tags && len(op.Tags) > 0 {
+		base := op.Tags
+		if len(route.MountTags) > 0 && slices.Equal(op.Tags, route.Tags) {
+			base = route.MountTags
+		}
+		prefix := strings.Join(segments, "/")
+		tags := make([]string, len(base))
+		for i, tag := range base {
+			tags[i] = tag
+			if !slices.Contains(segments, tag) {
+				tags[i] = prefix + "/" + tag
+			}
+		}
+		op.Tags = tags
+	}
+	if cfg.OperationIDs && route.MountOperationID != "" && op.OperationID == route.OperationID {
+		op.OperationID = route.MountOperationID
+	}
+	name := mountName(mount)
+	if cfg.OperationIDs && op.OperationID != "" && name != "" && !strings.HasPrefix(strings.ToLower(op.OperationID), strings.ToLower(name)) {
+		op.OperationID = name + strings.ToUpper(op.OperationID[:1]) + op.OperationID[1:]
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestBuilder_Build_MountPrefixes(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", OperationID: "listUsers", Tags: []string{"users"}},
+		{Method: "GET", Path: "/admin/users", OperationID: "listUsers", Tags: []string{"users"}, Mount: "/admin"},
+		{Method: "GET", Path: "/admin/stats", OperationID: "adminStats", Tags: []string{"admin"}, Mount: "/admin"},
+		{Method: "GET", Path: "/tenants/{id}/back-office/users", OperationID: "listUsers", Tags: []string{"users"}, Mount: "/tenants/{id}/back-office"},
+	}
+
+	// Off by default
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "listUsers", doc.Paths["/admin/users"].Get.OperationID)
+
+	cfg := config.Default()
+	cfg.Generation.MountPrefixes = config.MountPrefixesConfig{Tags: true, OperationIDs: true}
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+
+	users := doc.Paths["/users"].Get
+	assert.Equal(t, "listUsers", users.OperationID)
+	assert.Equal(t, []string{"users"}, users.Tags)
+
+	admin := doc.Paths["/admin/users"].Get
+	assert.Equal(t, "adminListUsers", admin.OperationID)
+	assert.Equal(t, []string{"admin/users"}, admin.Tags)
+
+	// Names already carrying the mount are kept
+	stats := doc.Paths["/admin/stats"].Get
+	assert.Equal(t, "adminStats", stats.OperationID)
+	assert.Equal(t, []string{"admin"}, stats.Tags)
+
+	tenant := doc.Paths["/tenants/{id}/back-office/users"].Get
+	assert.Equal(t, "tenantsBackOfficeListUsers", tenant.OperationID)
+	assert.Equal(t, []string{"tenants/back-office/users"}, tenant.Tags)
+}
+
+func TestBuilder_Build_MountPrefixesGeneratedNames(t *testing.T) {
+	// app.use('/admin', admin); admin.get('/users')
+	routes := []types.Route{{
+		Method:           "GET",
+		Path:             "/admin/users",
+		OperationID:      "getAdminUsers",
+		Tags:             []string{"admin"},
+		Mount:            "/admin",
+		MountOperationID: "getUsers",
+		MountTags:        []string{"users"},
+	}}
+
+	doc, err := NewBuilder(config.Default()).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "getAdminUsers", doc.Paths["/admin/users"].Get.OperationID)
+	assert.Equal(t, []string{"admin"}, doc.Paths["/admin/users"].Get.Tags)
+
+	cfg := config.Default()
+	cfg.Generation.MountPrefixes = config.MountPrefixesConfig{Tags: true, OperationIDs: true}
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	op := doc.Paths["/admin/users"].Get
+	assert.Equal(t, "adminGetUsers", op.OperationID)
+	assert.Equal(t, []string{"admin/users"}, op.Tags)
+
+	cfg.Generation.MountPrefixes = config.MountPrefixesConfig{Tags: true}
+	doc, err = NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	op = doc.Paths["/admin/users"].Get
+	assert.Equal(t, "getAdminUsers", op.OperationID)
+	assert.Equal(t, []string{"admin/users"}, op.Tags)
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Extensions linking the services of a multi-language repository.
+const (
+	// ExtService names the service an operation belongs to
+	ExtService = "x-service"
+
+	// ExtLinkedSpecs lists the specs of the repository's other services
+	ExtLinkedSpecs = "x-linked-specs"
+)
+
+// LinkedSpec points at the spec of another service of the repository.
+type LinkedSpec struct {
+	// Name is the service name
+	Name string `json:"name" yaml:"name"`
+
+	// URL is the spec's path relative to the linking spec
+	URL string `json:"url" yaml:"url"`
+}
+
+// NamespaceSchemas prefixes the titles of schemas with namespace and a dot,
+// so that User becomes Api.User, and updates the references routes and
+// schemas make to them. Services of one repository can then share a spec
+// without their schemas colliding.
+func NamespaceSchemas(namespace string, routes []types.Route, schemas []types.Schema) {
+	r := &refRenamer{renames: make(map[string]string, len(schemas)), seen: make(map[*types.Schema]bool)}
+	for i := range schemas {
+		if title := schemas[i].Title; title != "" {
+			r.renames[title] = namespace + "." + title
+			schemas[i].Title = r.renames[title]
+		}
+	}
+	for i := range schemas {
+		r.children(&schemas[i])
+	}
+	for i := range routes {
+		route := &routes[i]
+		for _, param := range route.Parameters {
+			r.schema(param.Schema)
+		}
+		if route.RequestBody != nil {
+			r.content(route.RequestBody.Content)
+		}
+		for _, resp := range route.Responses {
+			for _, header := range resp.Headers {
+				r.schema(header.Schema)
+			}
+			r.content(resp.Content)
+		}
+	}
+}
+
+// refRenamer points references to component schemas at their new names.
+type refRenamer struct {
+	renames map[string]string
+	seen    map[*types.Schema]bool
+}
+
+func (r *refRenamer) content(content map[string]types.MediaType) {
+	for _, media := range content {
+		r.schema(media.Schema)
+	}
+}
+
+func (r *refRenamer) schema(schema *types.Schema) {
+	// Plugins may share schemas between routes
+	if schema == nil || r.seen[schema] {
+		return
+	}
+	r.seen[schema] = true
+	if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+		if renamed, ok := r.renames[name]; ok {
+			schema.Ref = schemaRefPrefix + renamed
+		}
+	}
+	r.children(schema)
+}
+
+func (r *refRenamer) children(schema *types.Schema) {
+	r.schema(schema.Items)
+	r.schema(schema.AdditionalProperties)
+	r.schema(schema.Not)
+	for _, prop := range schema.Properties {
+		r.schema(prop)
+	}
+	for _, parts := range [][]*types.Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, part := range parts {
+			r.schema(part)
+		}
+	}
+	if schema.Discriminator != nil {
+		for value, ref := range schema.Discriminator.Mapping {
+			// Mapping values may name a schema instead of referencing it
+			if renamed, ok := r.renames[ref]; ok {
+				schema.Discriminator.Mapping[value] = renamed
+			} else if name, ok := strings.CutPrefix(ref, schemaRefPrefix); ok && r.renames[name] != "" {
+				schema.Discriminator.Mapping[value] = schemaRefPrefix + r.renames[name]
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestNamespaceSchemas(t *testing.T) {
+	user := &types.Schema{Ref: "#/components/schemas/User"}
+	schemas := []types.Schema{
+		{Title: "User", Type: "object", Properties: map[string]*types.Schema{
+			"address": {Ref: "#/components/schemas/Address"},
+		}},
+		{Title: "Address", Type: "object"},
+		{Title: "Pet", OneOf: []*types.Schema{{Ref: "#/components/schemas/Cat"}}, Discriminator: &types.Discriminator{
+			PropertyName: "kind",
+			Mapping:      map[string]string{"cat": "#/components/schemas/Cat", "dog": "Dog"},
+		}},
+		{Title: "Cat"},
+		{Title: "Dog"},
+	}
+	routes := []types.Route{
+		{
+			Method:      "POST",
+			Path:        "/users",
+			RequestBody: &types.RequestBody{Content: map[string]types.MediaType{"application/json": {Schema: user}}},
+			Responses:   map[string]types.Response{"201": {Content: map[string]types.MediaType{"application/json": {Schema: user}}}},
+		},
+		{
+			Method:    "GET",
+			Path:      "/users",
+			Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{"application/json": {Schema: &types.Schema{Type: "array", Items: &types.Schema{Ref: "#/components/schemas/User"}}}}}},
+		},
+		{
+			Method:    "GET",
+			Path:      "/external",
+			Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/Order"}}}}},
+		},
+	}
+
+	NamespaceSchemas("Bff", routes, schemas)
+
+	assert.Equal(t, "Bff.User", schemas[0].Title)
+	assert.Equal(t, "#/components/schemas/Bff.Address", schemas[0].Properties["address"].Ref)
+	// Shared schemas are renamed once
+	assert.Equal(t, "#/components/schemas/Bff.User", user.Ref)
+	assert.Equal(t, "#/components/schemas/Bff.User", routes[1].Responses["200"].Content["application/json"].Schema.Items.Ref)
+	// References to schemas of other services are kept
+	assert.Equal(t, "#/components/schemas/Order", routes[2].Responses["200"].Content["application/json"].Schema.Ref)
+
+	assert.Equal(t, "#/components/schemas/Bff.Cat", schemas[2].OneOf[0].Ref)
+	assert.Equal(t, map[string]string{"cat": "#/components/schemas/Bff.Cat", "dog": "Bff.Dog"}, schemas[2].Discriminator.Mapping)
+}
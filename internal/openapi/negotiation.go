@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// NegotiateContent documents the success responses of op in each of
+// mediaTypes, the media types its handler negotiates with the Accept
+// header. Media types a response lacks share the schema of its JSON
+// content, else of its first; responses without content list the media
+// types without a schema. Error responses and 204 No Content are left as
+// they are.
+func NegotiateContent(op *types.Operation, mediaTypes []string) {
+	if op == nil || len(mediaTypes) == 0 {
+		return
+	}
+	for code, resp := range op.Responses {
+		if !strings.HasPrefix(code, "2") || code == "204" || code == "205" {
+			continue
+		}
+		var shared *types.Schema
+		if mt, ok := resp.Content["application/json"]; ok {
+			shared = mt.Schema
+		} else if len(resp.Content) > 0 {
+			shared = resp.Content[slices.Sorted(maps.Keys(resp.Content))[0]].Schema
+		}
+
+		content := make(map[string]types.MediaType, len(mediaTypes))
+		for _, mediaType := range mediaTypes {
+			if mt, ok := resp.Content[mediaType]; ok {
+				content[mediaType] = mt
+			} else {
+				content[mediaType] = types.MediaType{Schema: shared}
+			}
+		}
+		resp.Content = content
+		op.Responses[code] = resp
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestNegotiateContent(t *testing.T) {
+	report := &types.Schema{Ref: "#/components/schemas/Report"}
+	problem := &types.Schema{Ref: "#/components/schemas/Problem"}
+	op := &types.Operation{
+		Responses: map[string]types.Response{
+			"200": {Content: map[string]types.MediaType{"application/json": {Schema: report}}},
+			"202": {Description: "Accepted"},
+			"204": {Description: "No Content"},
+			"404": {Content: map[string]types.MediaType{"application/json": {Schema: problem}}},
+		},
+	}
+
+	NegotiateContent(op, []string{"application/json", "application/xml", "text/csv"})
+
+	assert.Equal(t, map[string]types.MediaType{
+		"application/json": {Schema: report},
+		"application/xml":  {Schema: report},
+		"text/csv":         {Schema: report},
+	}, op.Responses["200"].Content)
+	assert.Equal(t, map[string]types.MediaType{
+		"application/json": {},
+		"application/xml":  {},
+		"text/csv":         {},
+	}, op.Responses["202"].Content)
+	assert.Nil(t, op.Responses["204"].Content)
+	assert.Equal(t, map[string]types.MediaType{"application/json": {Schema: problem}}, op.Responses["404"].Content)
+}
+
+func TestNegotiateContent_DropsUnlisted(t *testing.T) {
+	schema := &types.Schema{Type: "string"}
+	op := &types.Operation{
+		Responses: map[string]types.Response{
+			"200": {Content: map[string]types.MediaType{"application/json": {Schema: schema}}},
+		},
+	}
+
+	NegotiateContent(op, []string{"text/csv"})
+
+	assert.Equal(t, map[string]types.MediaType{"text/csv": {Schema: schema}}, op.Responses["200"].Content)
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// StripDocs removes the descriptions and examples of doc, which gateways
+// do not need to route requests. The info description and the response
+// descriptions, which OpenAPI requires, are kept.
+func StripDocs(doc *types.OpenAPI) {
+	if doc == nil {
+		return
+	}
+	w := &schemaWalker{visit: func(_ string, schema *types.Schema) {
+		schema.Description = ""
+		schema.Example = nil
+	}}
+	w.document(doc)
+
+	for path, item := range doc.Paths {
+		item.Description = ""
+		stripParameters(item.Parameters)
+		for _, slot := range operationSlots(&item) {
+			if op := *slot.op; op != nil {
+				stripOperation(op)
+			}
+		}
+		doc.Paths[path] = item
+	}
+	for i := range doc.Tags {
+		doc.Tags[i].Description = ""
+	}
+
+	c := doc.Components
+	if c == nil {
+		return
+	}
+	c.Examples = nil
+	for name, resp := range c.Responses {
+		c.Responses[name] = stripResponse(resp)
+	}
+	for name, param := range c.Parameters {
+		param.Description = ""
+		param.Example = nil
+		c.Parameters[name] = param
+	}
+	for name, body := range c.RequestBodies {
+		body.Description = ""
+		stripContent(body.Content)
+		c.RequestBodies[name] = body
+	}
+	for name, header := range c.Headers {
+		header.Description = ""
+		c.Headers[name] = header
+	}
+}
+
+func stripOperation(op *types.Operation) {
+	op.Description = ""
+	stripParameters(op.Parameters)
+	if op.RequestBody != nil {
+		op.RequestBody.Description = ""
+		stripContent(op.RequestBody.Content)
+	}
+	for code, resp := range op.Responses {
+		op.Responses[code] = stripResponse(resp)
+	}
+	for _, callback := range op.Callbacks {
+		for _, item := range callback {
+			for _, slot := range operationSlots(&item) {
+				if cb := *slot.op; cb != nil {
+					stripOperation(cb)
+				}
+			}
+		}
+	}
+}
+
+func stripParameters(params []types.Parameter) {
+	for i := range params {
+		params[i].Description = ""
+		params[i].Example = nil
+	}
+}
+
+func stripResponse(resp types.Response) types.Response {
+	for name, header := range resp.Headers {
+		header.Description = ""
+		resp.Headers[name] = header
+	}
+	stripContent(resp.Content)
+	return resp
+}
+
+func stripContent(content map[string]types.MediaType) {
+	for mediaType, mt := range content {
+		mt.Example = nil
+		mt.Examples = nil
+		content[mediaType] = mt
+	}
+}
+
+// DedupeSchemas merges structurally identical component schemas into the
+// first by name, pointing references to the others at it, and replaces
+// inline objects identical to a component schema with a reference to it.
+// Stubs of unresolved schemas are left apart. It returns the names of the
+// removed schemas, sorted.
+func DedupeSchemas(doc *types.OpenAPI) []string {
+	if doc == nil || doc.Components == nil {
+		return nil
+	}
+	var removed []string
+	for {
+		merged := mergeDuplicateSchemas(doc)
+		replaced := referenceInlineCopies(doc)
+		if len(merged) == 0 && replaced == 0 {
+			break
+		}
+		removed = append(removed, merged...)
+	}
+	slices.Sort(removed)
+	return removed
+}
+
+// mergeDuplicateSchemas removes the component schemas identical to one
+// sorting before them and returns their names.
+func mergeDuplicateSchemas(doc *types.OpenAPI) []string {
+	schemas := doc.Components.Schemas
+	canonical := make(map[string]string)
+	renamed := make(map[string]string)
+	for _, name := range slices.Sorted(maps.Keys(schemas)) {
+		key, ok := schemaKey(schemas[name])
+		if !ok {
+			continue
+		}
+		if first, ok := canonical[key]; ok {
+			renamed[name] = first
+		} else {
+			canonical[key] = name
+		}
+	}
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	renameSchemaRefs(doc, renamed)
+
+	names := slices.Collect(maps.Keys(renamed))
+	for _, name := range names {
+		delete(schemas, name)
+	}
+	return names
+}
+
+// referenceInlineCopies replaces inline objects identical to a component
+// schema with a reference to it and returns how many it replaced.
+func referenceInlineCopies(doc *types.OpenAPI) int {
+	schemas := doc.Components.Schemas
+	components := make(map[string]string)
+	tops := make(map[string]bool, len(schemas))
+	for _, name := range slices.Sorted(maps.Keys(schemas)) {
+		tops[schemaRefPrefix+escapePointer(name)] = true
+		if len(schemas[name].Properties) == 0 {
+			continue
+		}
+		if key, ok := schemaKey(schemas[name]); ok {
+			if _, seen := components[key]; !seen {
+				components[key] = name
+			}
+		}
+	}
+	if len(components) == 0 {
+		return 0
+	}
+
+	replaced := 0
+	w := &schemaWalker{visit: func(pointer string, schema *types.Schema) {
+		if tops[pointer] || len(schema.Properties) == 0 {
+			return
+		}
+		key, ok := schemaKey(schema)
+		if !ok {
+			return
+		}
+		if name, ok := components[key]; ok {
+			*schema = types.Schema{Ref: schemaRefPrefix + name}
+			replaced++
+		}
+	}}
+	w.document(doc)
+	return replaced
+}
+
+// schemaKey returns the serialized form of schema, which identical schemas
+// share, or false for stubs of unresolved schemas.
+func schemaKey(schema *types.Schema) (string, bool) {
+	if schema == nil {
+		return "", false
+	}
+	if _, ok := schema.Extensions[ExtUnresolved]; ok {
+		return "", false
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// SectionSize is the serialized size of a part of a spec.
+type SectionSize struct {
+	// Name is the section (paths, components.schemas, components or other)
+	Name string
+
+	// Bytes is the size the section adds to the spec
+	Bytes int
+}
+
+// SpecSize is the serialized size of a spec and of its sections.
+type SpecSize struct {
+	// Total is the size of the whole spec in bytes
+	Total int
+
+	// Sections break the total down by section
+	Sections []SectionSize
+}
+
+// MeasureSize returns the size of doc serialized in format (json or yaml),
+// broken down into its paths, component schemas, other components and
+// everything else. Each section is measured as the bytes the spec loses
+// without it.
+func MeasureSize(doc *types.OpenAPI, format string) (SpecSize, error) {
+	size := func(d *types.OpenAPI) (int, error) {
+		w := NewWriter()
+		var out string
+		var err error
+		if format == "json" {
+			out, err = w.ToJSON(d)
+		} else {
+			out, err = w.ToYAML(d)
+		}
+		return len(out), err
+	}
+
+	total, err := size(doc)
+	if err != nil {
+		return SpecSize{}, err
+	}
+
+	noPaths := *doc
+	noPaths.Paths = nil
+	noPaths.PathOrder = nil
+	withoutPaths, err := size(&noPaths)
+	if err != nil {
+		return SpecSize{}, err
+	}
+
+	withoutSchemas, withoutComponents := total, total
+	if doc.Components != nil {
+		components := *doc.Components
+		components.Schemas = nil
+		noSchemas := *doc
+		noSchemas.Components = &components
+		if withoutSchemas, err = size(&noSchemas); err != nil {
+			return SpecSize{}, err
+		}
+		noComponents := *doc
+		noComponents.Components = nil
+		if withoutComponents, err = size(&noComponents); err != nil {
+			return SpecSize{}, err
+		}
+	}
+
+	paths := total - withoutPaths
+	schemas := total - withoutSchemas
+	components := withoutSchemas - withoutComponents
+	return SpecSize{
+		Total: total,
+		Sections: []SectionSize{
+			{Name: "paths", Bytes: paths},
+			{Name: "components.schemas", Bytes: schemas},
+			{Name: "components", Bytes: components},
+			{Name: "other", Bytes: total - paths - schemas - components},
+		},
+	}, nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestStripDocs(t *testing.T) {
+	doc := &types.OpenAPI{
+		Info: types.Info{Title: "API", Description: "The API"},
+		Paths: map[string]types.PathItem{
+			"/users/{id}": {
+				Parameters: []types.Parameter{{Name: "id", In: "path", Description: "User ID", Example: "42"}},
+				Get: &types.Operation{
+					Summary:     "Get a user",
+					Description: "Returns the user with the given ID",
+					Responses: map[string]types.Response{
+						"200": {
+							Description: "OK",
+							Content: map[string]types.MediaType{
+								"application/json": {
+									Schema:  &types.Schema{Ref: "#/components/schemas/User"},
+									Example: map[string]any{"id": "42"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User": {
+					Type:        "object",
+					Description: "A user",
+					Properties: map[string]*types.Schema{
+						"id": {Type: "string", Description: "The ID", Example: "42"},
+					},
+				},
+			},
+		},
+		Tags: []types.Tag{{Name: "users", Description: "User accounts"}},
+	}
+
+	StripDocs(doc)
+
+	assert.Equal(t, "The API", doc.Info.Description)
+	item := doc.Paths["/users/{id}"]
+	assert.Equal(t, types.Parameter{Name: "id", In: "path"}, item.Parameters[0])
+	assert.Equal(t, "Get a user", item.Get.Summary)
+	assert.Empty(t, item.Get.Description)
+	assert.Equal(t, types.Response{
+		Description: "OK",
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/User"}},
+		},
+	}, item.Get.Responses["200"])
+	assert.Equal(t, &types.Schema{
+		Type:       "object",
+		Properties: map[string]*types.Schema{"id": {Type: "string"}},
+	}, doc.Components.Schemas["User"])
+	assert.Empty(t, doc.Tags[0].Description)
+}
+
+func TestDedupeSchemas(t *testing.T) {
+	address := func() *types.Schema {
+		return &types.Schema{
+			Type:       "object",
+			Properties: map[string]*types.Schema{"city": {Type: "string"}},
+		}
+	}
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/orders": {
+				Post: &types.Operation{
+					RequestBody: &types.RequestBody{Content: map[string]types.MediaType{
+						"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/ShippingAddress"}},
+					}},
+					Responses: map[string]types.Response{
+						"201": {Content: map[string]types.MediaType{
+							"application/json": {Schema: address()},
+						}},
+					},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"Address":         address(),
+				"ShippingAddress": address(),
+				"Customer": {
+					Type: "object",
+					Properties: map[string]*types.Schema{
+						"address": {Ref: "#/components/schemas/ShippingAddress"},
+					},
+				},
+				"Client": {
+					Type: "object",
+					Properties: map[string]*types.Schema{
+						"address": address(),
+					},
+				},
+				"Pet": {
+					OneOf: []*types.Schema{{Ref: "#/components/schemas/Customer"}},
+					Discriminator: &types.Discriminator{
+						PropertyName: "kind",
+						Mapping:      map[string]string{"customer": "#/components/schemas/Customer", "client": "Customer"},
+					},
+				},
+				"Missing":     {Extensions: types.Extensions{ExtUnresolved: true}},
+				"AlsoMissing": {Extensions: types.Extensions{ExtUnresolved: true}},
+			},
+		},
+	}
+
+	removed := DedupeSchemas(doc)
+
+	assert.Equal(t, []string{"Customer", "ShippingAddress"}, removed)
+	op := doc.Paths["/orders"].Post
+	assert.Equal(t, "#/components/schemas/Address", op.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Address", op.Responses["201"].Content["application/json"].Schema.Ref)
+	schemas := doc.Components.Schemas
+	require.Contains(t, schemas, "Client")
+	assert.Equal(t, "#/components/schemas/Address", schemas["Client"].Properties["address"].Ref)
+	assert.Equal(t, "#/components/schemas/Client", schemas["Pet"].OneOf[0].Ref)
+	assert.Equal(t, map[string]string{"customer": "#/components/schemas/Client", "client": "Client"}, schemas["Pet"].Discriminator.Mapping)
+	assert.Contains(t, schemas, "Missing")
+	assert.Contains(t, schemas, "AlsoMissing")
+}
+
+func TestMeasureSize(t *testing.T) {
+	doc := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "API", Version: "1.0.0"},
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Responses: map[string]types.Response{"200": {Description: "OK"}}}},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{"User": {Type: "object"}},
+			SecuritySchemes: map[string]types.SecurityScheme{
+				"bearer": {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+
+	for _, format := range []string{"json", "yaml"} {
+		size, err := MeasureSize(doc, format)
+		require.NoError(t, err)
+
+		var out string
+		if format == "json" {
+			out, err = NewWriter().ToJSON(doc)
+		} else {
+			out, err = NewWriter().ToYAML(doc)
+		}
+		require.NoError(t, err)
+		assert.Equal(t, len(out), size.Total, format)
+
+		sum := 0
+		for _, section := range size.Sections {
+			assert.Positive(t, section.Bytes, "%s %s", format, section.Name)
+			sum += section.Bytes
+		}
+		assert.Equal(t, size.Total, sum, format)
+	}
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Parameter naming styles.
+const (
+	ParameterCaseCamel = "camel"
+	ParameterCaseSnake = "snake"
+)
+
+// ExtOriginalName records the name a parameter has in the source code when
+// it is renamed to the spec's naming style.
+const ExtOriginalName = "x-original-name"
+
+// identifierName matches parameter names made of letters, digits,
+// underscores and hyphens; names like filter[status] are left alone.
+var identifierName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// NormalizeParameterNames renames the path and query parameters of doc to
+// style (camel or snake), rewriting path templates to match and recording
+// the source name in x-original-name. Paths that become identical, such as
+// /users/{user_id} and /users/{userId} from services written in different
+// languages, are merged; for a method both define, the operation of the
+// path already in the style wins. It returns the number of parameters
+// renamed.
+func NormalizeParameterNames(doc *types.OpenAPI, style string) int {
+	if doc == nil || (style != ParameterCaseCamel && style != ParameterCaseSnake) {
+		return 0
+	}
+
+	renamed := 0
+	rename := func(params []types.Parameter) []types.Parameter {
+		var result []types.Parameter
+		for i, p := range params {
+			if p.In != "path" && p.In != "query" {
+				continue
+			}
+			name := ConvertCase(p.Name, style)
+			if name == p.Name {
+				continue
+			}
+			// Parameters may be shared with the route they were built from
+			if result == nil {
+				result = append([]types.Parameter(nil), params...)
+			}
+			ext := make(types.Extensions, len(p.Extensions)+1)
+			for k, v := range p.Extensions {
+				ext[k] = v
+			}
+			if _, ok := ext[ExtOriginalName]; !ok {
+				ext[ExtOriginalName] = p.Name
+			}
+			p.Name = name
+			p.Extensions = ext
+			result[i] = p
+			renamed++
+		}
+		if result == nil {
+			return params
+		}
+		return result
+	}
+
+	// Paths already in the style come first, so their operations win
+	var order, converted []string
+	for _, p := range SortedPaths(doc.Paths) {
+		if convertTemplate(p, style) == p {
+			order = append(order, p)
+		} else {
+			converted = append(converted, p)
+		}
+	}
+	order = append(order, converted...)
+
+	paths := make(map[string]types.PathItem, len(doc.Paths))
+	for _, p := range order {
+		item := doc.Paths[p]
+		item.Parameters = rename(item.Parameters)
+		for _, slot := range operationSlots(&item) {
+			if op := *slot.op; op != nil {
+				copied := *op
+				copied.Parameters = rename(op.Parameters)
+				*slot.op = &copied
+			}
+		}
+
+		key := convertTemplate(p, style)
+		existing, ok := paths[key]
+		if !ok {
+			paths[key] = item
+			continue
+		}
+		existingSlots := operationSlots(&existing)
+		for i, slot := range operationSlots(&item) {
+			if *slot.op != nil && *existingSlots[i].op == nil {
+				*existingSlots[i].op = *slot.op
+			}
+		}
+		paths[key] = existing
+	}
+	doc.Paths = paths
+
+	if doc.Components != nil {
+		for key, p := range doc.Components.Parameters {
+			doc.Components.Parameters[key] = rename([]types.Parameter{p})[0]
+		}
+	}
+	return renamed
+}
+
+// convertTemplate converts the {param} segments of a path template.
+func convertTemplate(path, style string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(path, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(path[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		b.WriteString(path[:start+1])
+		b.WriteString(ConvertCase(path[start+1:end], style))
+		path = path[end:]
+	}
+	b.WriteString(path)
+	return b.String()
+}
+
+// ConvertCase converts an identifier to camel or snake case, splitting it
+// into words at underscores, hyphens and case changes (userID, user_id and
+// user-id all become userId or user_id). Names that are not plain
+// identifiers are returned unchanged.
+func ConvertCase(name, style string) string {
+	if !identifierName.MatchString(name) {
+		return name
+	}
+	words := splitWords(name)
+	switch style {
+	case ParameterCaseCamel:
+		for i, w := range words {
+			w = strings.ToLower(w)
+			if i > 0 {
+				w = strings.ToUpper(w[:1]) + w[1:]
+			}
+			words[i] = w
+		}
+		return strings.Join(words, "")
+	case ParameterCaseSnake:
+		for i, w := range words {
+			words[i] = strings.ToLower(w)
+		}
+		return strings.Join(words, "_")
+	}
+	return name
+}
+
+// splitWords splits an identifier into its words.
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		boundary := i == len(runes) || runes[i] == '_' || runes[i] == '-'
+		if !boundary && i > start {
+			prev, r := runes[i-1], runes[i]
+			// fooBar, foo2Bar, and the end of an acronym: IDToken
+			boundary = unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+			if boundary {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+			continue
+		}
+		if boundary {
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+		}
+	}
+	return words
+}
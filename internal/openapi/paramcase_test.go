@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestConvertCase(t *testing.T) {
+	tests := []struct {
+		name, camel, snake string
+	}{
+		{"user_id", "userId", "user_id"},
+		{"userId", "userId", "user_id"},
+		{"userID", "userId", "user_id"},
+		{"user-id", "userId", "user_id"},
+		{"IDToken", "idToken", "id_token"},
+		{"page2Size", "page2Size", "page2_size"},
+		{"id", "id", "id"},
+		{"filter[status]", "filter[status]", "filter[status]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.camel, ConvertCase(tt.name, ParameterCaseCamel))
+			assert.Equal(t, tt.snake, ConvertCase(tt.name, ParameterCaseSnake))
+		})
+	}
+}
+
+func TestNormalizeParameterNames(t *testing.T) {
+	goParams := []types.Parameter{
+		{Name: "userId", In: "path", Required: true},
+		{Name: "X-Request-ID", In: "header"},
+	}
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users/{user_id}": {
+			Get: &types.Operation{OperationID: "getUser", Parameters: []types.Parameter{
+				{Name: "user_id", In: "path", Required: true},
+				{Name: "include_posts", In: "query"},
+			}},
+		},
+		"/users/{userId}": {
+			Get:    &types.Operation{OperationID: "fetchUser", Parameters: goParams},
+			Delete: &types.Operation{OperationID: "deleteUser", Parameters: goParams},
+		},
+	}}
+
+	renamed := NormalizeParameterNames(doc, ParameterCaseSnake)
+
+	// userId is renamed in both operations sharing goParams
+	assert.Equal(t, 2, renamed)
+	require.Len(t, doc.Paths, 1)
+	item := doc.Paths["/users/{user_id}"]
+	require.NotNil(t, item.Get)
+	assert.Equal(t, "getUser", item.Get.OperationID)
+	require.NotNil(t, item.Delete)
+	assert.Equal(t, []types.Parameter{
+		{Name: "user_id", In: "path", Required: true, Extensions: types.Extensions{ExtOriginalName: "userId"}},
+		{Name: "X-Request-ID", In: "header"},
+	}, item.Delete.Parameters)
+	assert.Equal(t, "include_posts", item.Get.Parameters[1].Name)
+
+	// The route's parameters are not modified
+	assert.Equal(t, "userId", goParams[0].Name)
+	assert.Nil(t, goParams[0].Extensions)
+}
+
+func TestNormalizeParameterNames_Camel(t *testing.T) {
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/orders/{order_id}/items": {
+			Parameters: []types.Parameter{{Name: "order_id", In: "path", Required: true}},
+			Get: &types.Operation{Parameters: []types.Parameter{
+				{Name: "page_size", In: "query", Extensions: types.Extensions{ExtOriginalName: "per_page"}},
+			}},
+		},
+	}}
+
+	assert.Equal(t, 2, NormalizeParameterNames(doc, ParameterCaseCamel))
+	item, ok := doc.Paths["/orders/{orderId}/items"]
+	require.True(t, ok)
+	assert.Equal(t, "orderId", item.Parameters[0].Name)
+	assert.Equal(t, "order_id", item.Parameters[0].Extensions[ExtOriginalName])
+	assert.Equal(t, "pageSize", item.Get.Parameters[0].Name)
+	assert.Equal(t, "per_page", item.Get.Parameters[0].Extensions[ExtOriginalName])
+
+	assert.Zero(t, NormalizeParameterNames(doc, ""))
+}
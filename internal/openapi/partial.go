@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Subset selects the operations a partial generation regenerates. An
+// operation is selected when its path matches one of Paths and it has one
+// of Tags; an empty list selects everything.
+type Subset struct {
+	// Paths are glob patterns of paths (e.g., /users/**)
+	Paths []string
+
+	// Tags are operation tags (e.g., billing)
+	Tags []string
+}
+
+// IsEmpty reports whether the subset selects the whole document.
+func (s Subset) IsEmpty() bool {
+	return len(s.Paths) == 0 && len(s.Tags) == 0
+}
+
+// Matches reports whether the subset selects op on path.
+func (s Subset) Matches(path string, op *types.Operation) bool {
+	if op == nil {
+		return false
+	}
+	return s.matchesPath(path) && s.matchesTags(op.Tags)
+}
+
+func (s Subset) matchesPath(path string) bool {
+	if len(s.Paths) == 0 {
+		return true
+	}
+	for _, pattern := range s.Paths {
+		if matched, _ := doublestar.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Subset) matchesTags(tags []string) bool {
+	if len(s.Tags) == 0 {
+		return true
+	}
+	for _, want := range s.Tags {
+		for _, tag := range tags {
+			if tag == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MergeSubset updates the operations of existing that subset selects from
+// generated and leaves every other path, operation and component as it is.
+// Selected operations the code no longer defines are removed (or deprecated
+// with MarkRemovedAsDeprecated), and the component schemas the regenerated
+// operations reference are added or updated.
+func (m *Merger) MergeSubset(existing, generated *types.OpenAPI, subset Subset) (*types.OpenAPI, error) {
+	if existing == nil {
+		return generated, nil
+	}
+	if generated == nil {
+		return existing, nil
+	}
+	if m.options.DetectRenames {
+		RenameMovedSchemas(existing, generated)
+	}
+	frozen, err := m.checkFrozen(existing, generated, func(path string, op *types.Operation) bool {
+		return subset.Matches(path, op)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *existing
+	merged.PathOrder = generated.PathOrder
+	merged.Paths = make(map[string]types.PathItem, len(existing.Paths))
+	for path, item := range existing.Paths {
+		merged.Paths[path] = item
+	}
+
+	var generatedSchemas map[string]*types.Schema
+	if generated.Components != nil {
+		generatedSchemas = generated.Components.Schemas
+	}
+	refs := &refCollector{schemas: generatedSchemas, used: make(map[string]bool)}
+	usedTags := make(map[string]bool)
+
+	paths := make(map[string]bool, len(existing.Paths)+len(generated.Paths))
+	for path := range existing.Paths {
+		paths[path] = true
+	}
+	for path := range generated.Paths {
+		paths[path] = true
+	}
+	for path := range paths {
+		item := merged.Paths[path]
+		generatedItem := generated.Paths[path]
+		existingSlots, generatedSlots := operationSlots(&item), operationSlots(&generatedItem)
+
+		changed := false
+		for i, slot := range existingSlots {
+			existingOp, generatedOp := *slot.op, *generatedSlots[i].op
+			if !subset.Matches(path, existingOp) && !subset.Matches(path, generatedOp) {
+				continue
+			}
+			*slot.op = m.mergeOperation(existingOp, generatedOp)
+			changed = true
+			if generatedOp != nil {
+				refs.operation(generatedOp)
+				for _, tag := range generatedOp.Tags {
+					usedTags[tag] = true
+				}
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		if len(generatedItem.Servers) > 0 {
+			item.Servers = generatedItem.Servers
+		}
+		if hasOperations(item) {
+			merged.Paths[path] = item
+		} else {
+			delete(merged.Paths, path)
+		}
+	}
+
+	if len(refs.used) > 0 {
+		components := types.Components{}
+		if existing.Components != nil {
+			components = *existing.Components
+		}
+		schemas := make(map[string]*types.Schema, len(components.Schemas)+len(refs.used))
+		for name, schema := range components.Schemas {
+			schemas[name] = schema
+		}
+		for name := range refs.used {
+			if schema, ok := generatedSchemas[name]; ok {
+				schemas[name] = m.mergeSchema(schemas[name], schema)
+			}
+		}
+		components.Schemas = schemas
+		merged.Components = &components
+	}
+
+	// Declare tags the regenerated operations introduced
+	declared := make(map[string]bool, len(existing.Tags))
+	for _, tag := range existing.Tags {
+		declared[tag.Name] = true
+	}
+	merged.Tags = append([]types.Tag(nil), existing.Tags...)
+	for _, tag := range generated.Tags {
+		if usedTags[tag.Name] && !declared[tag.Name] {
+			merged.Tags = append(merged.Tags, tag)
+		}
+	}
+
+	DedupeParameters(&merged)
+	keepFrozen(existing, &merged, frozen)
+
+	return &merged, nil
+}
+
+// hasOperations reports whether a path item has any operation.
+func hasOperations(item types.PathItem) bool {
+	for _, slot := range operationSlots(&item) {
+		if *slot.op != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestSubset_Matches(t *testing.T) {
+	billing := &types.Operation{Tags: []string{"billing"}}
+	untagged := &types.Operation{}
+
+	paths := Subset{Paths: []string{"/users/**"}}
+	assert.True(t, paths.Matches("/users", untagged))
+	assert.True(t, paths.Matches("/users/{id}/posts", untagged))
+	assert.False(t, paths.Matches("/orders", untagged))
+	assert.False(t, paths.Matches("/users", nil))
+
+	tags := Subset{Tags: []string{"billing"}}
+	assert.True(t, tags.Matches("/invoices", billing))
+	assert.False(t, tags.Matches("/invoices", untagged))
+
+	both := Subset{Paths: []string{"/users/**"}, Tags: []string{"billing"}}
+	assert.False(t, both.Matches("/invoices", billing))
+	assert.True(t, both.Matches("/users/{id}/invoices", billing))
+}
+
+func TestMerger_MergeSubset(t *testing.T) {
+	userRef := &types.Schema{Ref: schemaRefPrefix + "User"}
+	existing := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{Summary: "List users", Description: "Hand-written"},
+			},
+			"/users/{id}/avatar": {
+				Delete: &types.Operation{Summary: "Removed from code"},
+			},
+			"/orders": {
+				Get: &types.Operation{Summary: "Stale but out of scope"},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User":  {Type: "object", Description: "A user"},
+				"Order": {Type: "object", Description: "Hand-written order"},
+			},
+		},
+		Tags: []types.Tag{{Name: "orders", Description: "Orders"}},
+	}
+	generated := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{Summary: "List users", Tags: []string{"users"}, Responses: map[string]types.Response{
+					"200": {Content: map[string]types.MediaType{"application/json": {Schema: userRef}}},
+				}},
+				Post: &types.Operation{Summary: "Create user", Tags: []string{"users"}},
+			},
+			"/orders": {
+				Get: &types.Operation{Summary: "List orders"},
+				Post: &types.Operation{Summary: "Create order", Responses: map[string]types.Response{
+					"201": {Content: map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: schemaRefPrefix + "Order"}}}},
+				}},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User":  {Type: "object", Properties: map[string]*types.Schema{"name": {Type: "string"}}},
+				"Order": {Type: "object"},
+			},
+		},
+		Tags: []types.Tag{{Name: "users"}, {Name: "orders"}},
+	}
+
+	merged, err := NewMerger(DefaultMergeOptions()).MergeSubset(existing, generated, Subset{Paths: []string{"/users/**"}})
+	require.NoError(t, err)
+
+	// Selected operations are regenerated, keeping hand-written descriptions
+	require.NotNil(t, merged.Paths["/users"].Get)
+	assert.Equal(t, "Hand-written", merged.Paths["/users"].Get.Description)
+	assert.Equal(t, []string{"users"}, merged.Paths["/users"].Get.Tags)
+	assert.NotNil(t, merged.Paths["/users"].Post)
+	assert.NotContains(t, merged.Paths, "/users/{id}/avatar")
+
+	// Everything else is untouched
+	assert.Equal(t, "Stale but out of scope", merged.Paths["/orders"].Get.Summary)
+	assert.Nil(t, merged.Paths["/orders"].Post)
+	assert.Equal(t, "Hand-written order", merged.Components.Schemas["Order"].Description)
+	assert.Contains(t, existing.Paths, "/users/{id}/avatar")
+
+	// Referenced schemas are updated and new tags declared
+	assert.Equal(t, "A user", merged.Components.Schemas["User"].Description)
+	assert.Contains(t, merged.Components.Schemas["User"].Properties, "name")
+	assert.Equal(t, []types.Tag{{Name: "orders", Description: "Orders"}, {Name: "users"}}, merged.Tags)
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Exclude returns a copy of doc without the operations any of subsets
+// selects. Paths left without operations are dropped.
+func Exclude(doc *types.OpenAPI, subsets []Subset) *types.OpenAPI {
+	if doc == nil {
+		return nil
+	}
+	result := *doc
+	result.Paths = make(map[string]types.PathItem, len(doc.Paths))
+	for path, item := range doc.Paths {
+		for _, slot := range operationSlots(&item) {
+			for _, subset := range subsets {
+				if subset.Matches(path, *slot.op) {
+					*slot.op = nil
+					break
+				}
+			}
+		}
+		if hasOperations(item) {
+			result.Paths[path] = item
+		}
+	}
+	return &result
+}
+
+// Operations returns "METHOD path" for each operation of doc, sorted by
+// path and method.
+func Operations(doc *types.OpenAPI) []string {
+	if doc == nil {
+		return nil
+	}
+	var ops []string
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			if *slot.op != nil {
+				ops = append(ops, slot.method+" "+path)
+			}
+		}
+	}
+	return ops
+}
+
+// Skeleton returns a copy of doc without paths, tags and component schemas,
+// the base to merge selected operations into when a spec does not exist yet.
+func Skeleton(doc *types.OpenAPI) *types.OpenAPI {
+	result := *doc
+	result.Paths = map[string]types.PathItem{}
+	result.Tags = nil
+	if doc.Components != nil {
+		components := *doc.Components
+		components.Schemas = nil
+		result.Components = &components
+	}
+	return &result
+}
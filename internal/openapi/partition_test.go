@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestExclude(t *testing.T) {
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users":       {Get: &types.Operation{Tags: []string{"public"}}, Post: &types.Operation{}},
+		"/admin/stats": {Get: &types.Operation{}},
+		"/orders":      {Get: &types.Operation{}},
+	}}
+
+	rest := Exclude(doc, []Subset{{Tags: []string{"public"}}, {Paths: []string{"/admin/**"}}})
+	assert.Equal(t, []string{"GET /orders", "POST /users"}, Operations(rest))
+
+	// The original document is unchanged
+	assert.Equal(t, []string{"GET /admin/stats", "GET /orders", "GET /users", "POST /users"}, Operations(doc))
+}
+
+func TestSkeleton(t *testing.T) {
+	doc := &types.OpenAPI{
+		Info:  types.Info{Title: "API", Version: "1.0"},
+		Paths: map[string]types.PathItem{"/users": {Get: &types.Operation{}}},
+		Tags:  []types.Tag{{Name: "users"}},
+		Components: &types.Components{
+			Schemas:         map[string]*types.Schema{"User": {Type: "object"}},
+			SecuritySchemes: map[string]types.SecurityScheme{"bearer": {Type: "http"}},
+		},
+	}
+
+	skeleton := Skeleton(doc)
+	assert.Equal(t, "API", skeleton.Info.Title)
+	assert.Empty(t, skeleton.Paths)
+	assert.Empty(t, skeleton.Tags)
+	assert.Empty(t, skeleton.Components.Schemas)
+	assert.Contains(t, skeleton.Components.SecuritySchemes, "bearer")
+	assert.Contains(t, doc.Components.Schemas, "User")
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Path ordering strategies.
+const (
+	PathOrderAlphabetical = "alphabetical"
+	PathOrderTag          = "tag"
+	PathOrderSource       = "source"
+	PathOrderCRUD         = "crud"
+)
+
+// OrderPaths sets the order doc's paths are emitted in:
+//
+//   - alphabetical: sorted by path (the default)
+//   - tag: grouped by the first tag of each path's operations, in the order
+//     of the document's tags, then alphabetically within a group
+//   - source: in sourceOrder, the order routes are defined in the source
+//   - crud: by resource, with a collection before its items and static
+//     segments before parameters (/users, /users/me, /users/{id})
+func OrderPaths(doc *types.OpenAPI, strategy string, sourceOrder []string) {
+	if doc == nil {
+		return
+	}
+	switch strategy {
+	case PathOrderTag:
+		doc.PathOrder = tagOrder(doc)
+	case PathOrderSource:
+		doc.PathOrder = append([]string(nil), sourceOrder...)
+	case PathOrderCRUD:
+		doc.PathOrder = SortedPaths(doc.Paths)
+		sort.SliceStable(doc.PathOrder, func(i, j int) bool {
+			return crudLess(doc.PathOrder[i], doc.PathOrder[j])
+		})
+	default:
+		doc.PathOrder = nil
+	}
+}
+
+// tagOrder groups the paths of doc by the first tag of their first
+// operation. Declared tags come first in declaration order, then other tags
+// alphabetically, then untagged paths.
+func tagOrder(doc *types.OpenAPI) []string {
+	rank := make(map[string]int, len(doc.Tags))
+	for i, tag := range doc.Tags {
+		if _, ok := rank[tag.Name]; !ok {
+			rank[tag.Name] = i
+		}
+	}
+
+	paths := SortedPaths(doc.Paths)
+	tags := make(map[string]string, len(paths))
+	for _, p := range paths {
+		item := doc.Paths[p]
+		for _, slot := range operationSlots(&item) {
+			if op := *slot.op; op != nil && len(op.Tags) > 0 {
+				tags[p] = op.Tags[0]
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		a, b := tags[paths[i]], tags[paths[j]]
+		if a == b {
+			return false
+		}
+		if a == "" || b == "" {
+			return b == ""
+		}
+		rankA, declaredA := rank[a]
+		rankB, declaredB := rank[b]
+		switch {
+		case declaredA && declaredB:
+			return rankA < rankB
+		case declaredA != declaredB:
+			return declaredA
+		}
+		return a < b
+	})
+	return paths
+}
+
+// crudLess compares paths segment by segment; a path sorts before the
+// paths below it, and static segments sort before parameters.
+func crudLess(a, b string) bool {
+	segsA := strings.Split(strings.Trim(a, "/"), "/")
+	segsB := strings.Split(strings.Trim(b, "/"), "/")
+	for i := 0; i < len(segsA) && i < len(segsB); i++ {
+		sa, sb := segsA[i], segsB[i]
+		if sa == sb {
+			continue
+		}
+		paramA, paramB := strings.HasPrefix(sa, "{"), strings.HasPrefix(sb, "{")
+		if paramA != paramB {
+			return paramB
+		}
+		return sa < sb
+	}
+	return len(segsA) < len(segsB)
+}
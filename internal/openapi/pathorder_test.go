@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func pathOrderDoc() *types.OpenAPI {
+	op := func(tags ...string) *types.Operation {
+		return &types.Operation{Tags: tags, Responses: map[string]types.Response{"200": {Description: "OK"}}}
+	}
+	return &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "Test", Version: "1.0.0"},
+		Paths: map[string]types.PathItem{
+			"/users/{id}":        {Get: op("users")},
+			"/users":             {Get: op("users")},
+			"/users/me":          {Get: op("users")},
+			"/orders/{id}/items": {Get: op("orders")},
+			"/orders":            {Post: op("orders")},
+			"/health":            {Get: op()},
+			"/admin":             {Get: op("admin")},
+		},
+		Tags: []types.Tag{{Name: "users"}, {Name: "orders"}},
+	}
+}
+
+func TestOrderPaths(t *testing.T) {
+	tests := []struct {
+		strategy string
+		want     []string
+	}{
+		{PathOrderAlphabetical, []string{"/admin", "/health", "/orders", "/orders/{id}/items", "/users", "/users/me", "/users/{id}"}},
+		{PathOrderTag, []string{"/users", "/users/me", "/users/{id}", "/orders", "/orders/{id}/items", "/admin", "/health"}},
+		{PathOrderCRUD, []string{"/admin", "/health", "/orders", "/orders/{id}/items", "/users", "/users/me", "/users/{id}"}},
+		{PathOrderSource, []string{"/users", "/users/{id}", "/health", "/admin", "/orders", "/orders/{id}/items", "/users/me"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			doc := pathOrderDoc()
+			OrderPaths(doc, tt.strategy, []string{"/users", "/users/{id}", "/gone", "/health"})
+			assert.Equal(t, tt.want, doc.OrderedPaths())
+		})
+	}
+}
+
+func TestCRUDLess(t *testing.T) {
+	assert.True(t, crudLess("/", "/users"))
+	assert.True(t, crudLess("/users", "/users/{id}"))
+	assert.True(t, crudLess("/users/me", "/users/{id}"))
+	assert.True(t, crudLess("/users/{id}", "/users/{id}/posts"))
+	assert.True(t, crudLess("/users/{id}/posts", "/users_archive"))
+	assert.False(t, crudLess("/users/{id}", "/users"))
+}
+
+func TestWriter_PathOrder(t *testing.T) {
+	doc := pathOrderDoc()
+	doc.Extensions = types.Extensions{"x-owner": "platform"}
+	OrderPaths(doc, PathOrderCRUD, nil)
+	doc.PathOrder = []string{"/users/{id}", "/users"}
+
+	w := NewWriter()
+	yamlOut, err := w.ToYAML(doc)
+	require.NoError(t, err)
+	jsonOut, err := w.ToJSON(doc)
+	require.NoError(t, err)
+
+	for _, out := range []struct{ text, key string }{{yamlOut, "%s:"}, {jsonOut, `"%s":`}} {
+		index := func(key string) int { return strings.Index(out.text, fmt.Sprintf(out.key, key)) }
+		item, users, admin := index("/users/{id}"), index("/users"), index("/admin")
+		require.True(t, item > 0 && users > 0 && admin > 0, out.text)
+		assert.Less(t, item, users)
+		assert.Less(t, users, admin)
+		assert.Less(t, index("info"), index("paths"))
+		assert.Contains(t, out.text, "x-owner")
+	}
+
+	// Ordered output reads back to the same document
+	var back types.OpenAPI
+	require.NoError(t, yaml.Unmarshal([]byte(yamlOut), &back))
+	assert.Equal(t, doc.Paths, back.Paths)
+	assert.Equal(t, "platform", back.Extensions["x-owner"])
+}
+
+func TestBuilder_Build_PathOrder(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.PathOrder = PathOrderSource
+	cfg.Generation.ParameterCase = ParameterCaseCamel
+
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{user_id}", SourceFile: "users.go", SourceLine: 20},
+		{Method: "GET", Path: "/users", SourceFile: "users.go", SourceLine: 10},
+		{Method: "GET", Path: "/accounts", SourceFile: "users.go", SourceLine: 30},
+		{Method: "GET", Path: "/orders", SourceFile: "orders.go", SourceLine: 5},
+	}
+	doc, err := NewBuilder(cfg).Build(routes, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/orders", "/users", "/users/{userId}", "/accounts"}, doc.OrderedPaths())
+
+	// The generated order survives a merge with the existing spec
+	opts := DefaultMergeOptions()
+	opts.MarkRemovedAsDeprecated = true
+	existing := &types.OpenAPI{Paths: map[string]types.PathItem{"/legacy": {Get: &types.Operation{}}}}
+	merged, err := NewMerger(opts).Merge(existing, doc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/orders", "/users", "/users/{userId}", "/accounts", "/legacy"}, merged.OrderedPaths())
+}
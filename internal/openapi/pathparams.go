@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ApplyPathParams replaces the schema of the path parameters of doc named
+// in overrides. Names match case-insensitively; an exact name takes
+// precedence over globs such as *Id, which are tried in sorted order. It
+// returns the number of parameters changed.
+func ApplyPathParams(doc *types.OpenAPI, overrides map[string]config.PathParamConfig) int {
+	if len(overrides) == 0 {
+		return 0
+	}
+	exact := make(map[string]config.PathParamConfig, len(overrides))
+	var globs []string
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		if strings.ContainsAny(name, "*?[") {
+			globs = append(globs, name)
+		} else {
+			exact[strings.ToLower(name)] = overrides[name]
+		}
+	}
+	lookup := func(name string) (config.PathParamConfig, bool) {
+		if override, ok := exact[strings.ToLower(name)]; ok {
+			return override, true
+		}
+		for _, glob := range globs {
+			if ok, _ := path.Match(strings.ToLower(glob), strings.ToLower(name)); ok {
+				return overrides[glob], true
+			}
+		}
+		return config.PathParamConfig{}, false
+	}
+
+	changed := 0
+	apply := func(params []types.Parameter) {
+		for i := range params {
+			if params[i].In != "path" {
+				continue
+			}
+			if override, ok := lookup(params[i].Name); ok {
+				params[i].Schema = pathParamSchema(override)
+				changed++
+			}
+		}
+	}
+	for _, p := range SortedPaths(doc.Paths) {
+		item := doc.Paths[p]
+		apply(item.Parameters)
+		for _, slot := range operationSlots(&item) {
+			if op := *slot.op; op != nil {
+				apply(op.Parameters)
+			}
+		}
+	}
+	return changed
+}
+
+// pathParamSchema returns the schema an override stands for.
+func pathParamSchema(override config.PathParamConfig) *types.Schema {
+	schema := &types.Schema{
+		Type:    override.Type,
+		Format:  override.Format,
+		Pattern: override.Pattern,
+	}
+	for _, value := range override.Enum {
+		// Enum values of numeric parameters are numbers
+		if n, err := strconv.ParseFloat(value, 64); err == nil && (override.Type == "integer" || override.Type == "number") {
+			schema.Enum = append(schema.Enum, n)
+		} else {
+			schema.Enum = append(schema.Enum, value)
+		}
+	}
+	return schema
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplyPathParams(t *testing.T) {
+	str := func() *types.Schema { return &types.Schema{Type: "string"} }
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users/{id}": {
+				Parameters: []types.Parameter{{Name: "id", In: "path", Required: true, Schema: str()}},
+				Get: &types.Operation{Parameters: []types.Parameter{
+					{Name: "id", In: "query", Schema: str()},
+				}},
+			},
+			"/orders/{orderId}/items/{uuid}": {
+				Get: &types.Operation{Parameters: []types.Parameter{
+					{Name: "orderId", In: "path", Required: true, Description: "The order", Schema: str()},
+					{Name: "uuid", In: "path", Required: true, Schema: str()},
+				}},
+			},
+			"/reports/{kind}": {
+				Get: &types.Operation{Parameters: []types.Parameter{
+					{Name: "kind", In: "path", Required: true, Schema: str()},
+					{Name: "page", In: "path", Required: true, Schema: str()},
+				}},
+			},
+		},
+	}
+
+	changed := ApplyPathParams(doc, map[string]config.PathParamConfig{
+		"id":   {Type: "integer", Format: "int64"},
+		"*id":  {Type: "integer"},
+		"uuid": {Type: "string", Format: "uuid"},
+		"kind": {Type: "string", Enum: []string{"daily", "weekly"}},
+	})
+
+	assert.Equal(t, 4, changed)
+	users := doc.Paths["/users/{id}"]
+	assert.Equal(t, &types.Schema{Type: "integer", Format: "int64"}, users.Parameters[0].Schema)
+	assert.Equal(t, "string", users.Get.Parameters[0].Schema.Type, "query parameters keep their schema")
+	items := doc.Paths["/orders/{orderId}/items/{uuid}"].Get.Parameters
+	assert.Equal(t, &types.Schema{Type: "integer"}, items[0].Schema, "globs match case-insensitively")
+	assert.Equal(t, "The order", items[0].Description)
+	assert.Equal(t, &types.Schema{Type: "string", Format: "uuid"}, items[1].Schema)
+	reports := doc.Paths["/reports/{kind}"].Get.Parameters
+	assert.Equal(t, []interface{}{"daily", "weekly"}, reports[0].Schema.Enum)
+	assert.Equal(t, "string", reports[1].Schema.Type)
+}
@@ -0,0 +1,433 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Extensions that flag internal operations and sensitive schemas. They are
+// always removed from redacted output.
+const (
+	ExtInternal  = "x-internal"
+	ExtSensitive = "x-sensitive"
+	ExtPII       = "x-pii"
+)
+
+// RedactOptions selects what Redact removes beyond flagged operations and
+// properties.
+type RedactOptions struct {
+	// InternalPaths are glob patterns of paths to remove entirely
+	InternalPaths []string
+
+	// SensitiveFields are glob patterns of property and parameter names to
+	// remove, matched case-insensitively
+	SensitiveFields []string
+
+	// StripExtensions are additional extensions to remove from operations
+	// and schemas
+	StripExtensions []string
+
+	// Include selects the operations to keep; an empty subset keeps every
+	// operation
+	Include Subset
+
+	// KeepFlagged keeps the operations and properties flagged x-internal,
+	// x-sensitive or x-pii, and the flags themselves
+	KeepFlagged bool
+}
+
+// Redaction lists what Redact removed.
+type Redaction struct {
+	// Operations are the removed operations as "METHOD /path"
+	Operations []string
+
+	// Fields are the removed properties as "Schema.property" and
+	// parameters as "METHOD /path parameter"
+	Fields []string
+
+	// Schemas are the component schemas that became unused or are
+	// internal
+	Schemas []string
+}
+
+// Redact returns a copy of doc with internal operations, sensitive fields
+// and internal extensions removed, for publishing outside the organization,
+// keeping only the operations opts.Include selects. Component schemas
+// flagged x-internal are removed with the properties and operations whose
+// bodies reference them. Component schemas and tags that only the removed
+// operations used are removed as well, and code samples are rebuilt; doc
+// itself is not modified.
+func Redact(doc *types.OpenAPI, opts RedactOptions) (*types.OpenAPI, *Redaction, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to copy spec: %w", err)
+	}
+	var redacted types.OpenAPI
+	if err := json.Unmarshal(data, &redacted); err != nil {
+		return nil, nil, fmt.Errorf("failed to copy spec: %w", err)
+	}
+
+	r := &redactor{opts: opts, result: &Redaction{}, internal: make(map[string]bool), schemas: componentSchemas(&redacted)}
+	for name, schema := range componentSchemas(&redacted) {
+		if schema != nil && r.flagged(schema.Extensions, ExtInternal) {
+			r.internal[name] = true
+		}
+	}
+	wasUnused := make(map[string]bool)
+	for _, name := range UnusedSchemas(&redacted) {
+		wasUnused[name] = true
+	}
+	usedTags := operationTags(&redacted)
+
+	for _, path := range SortedPaths(redacted.Paths) {
+		item := redacted.Paths[path]
+		internalPath := r.internalPath(path)
+		remaining := 0
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			if internalPath || r.flagged(op.Extensions, ExtInternal) || r.exposesInternal(op) || !r.opts.Include.Matches(path, op) {
+				r.result.Operations = append(r.result.Operations, slot.method+" "+path)
+				*slot.op = nil
+				continue
+			}
+			remaining++
+			op.Parameters = r.parameters(slot.method+" "+path, op.Parameters)
+			r.stripExtensions(op.Extensions)
+			r.operationExamples(op)
+			r.operationSchemas(slot.method+" "+path, op)
+		}
+		if remaining == 0 {
+			delete(redacted.Paths, path)
+			continue
+		}
+		item.Parameters = r.parameters(path, item.Parameters)
+		redacted.Paths[path] = item
+	}
+
+	if redacted.Components != nil {
+		names := make([]string, 0, len(redacted.Components.Schemas))
+		for name := range redacted.Components.Schemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			r.schema(name, redacted.Components.Schemas[name])
+		}
+		for _, example := range redacted.Components.Examples {
+			r.example(example.Value, nil)
+		}
+
+		var pruned []string
+		for _, name := range UnusedSchemas(&redacted) {
+			if !wasUnused[name] || r.internal[name] {
+				pruned = append(pruned, name)
+			}
+		}
+		PruneSchemas(&redacted, pruned)
+		r.result.Schemas = pruned
+	}
+
+	remainingTags := operationTags(&redacted)
+	var tags []types.Tag
+	for _, tag := range redacted.Tags {
+		if usedTags[tag.Name] && !remainingTags[tag.Name] {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	redacted.Tags = tags
+
+	// Samples must not send removed parameters or fields
+	RefreshCodeSamples(&redacted)
+
+	return &redacted, r.result, nil
+}
+
+// redactor removes sensitive fields and extensions while recording what it
+// removed.
+type redactor struct {
+	opts   RedactOptions
+	result *Redaction
+
+	// internal are the component schemas flagged x-internal
+	internal map[string]bool
+
+	// schemas are the component schemas examples are checked against
+	schemas map[string]*types.Schema
+}
+
+// internalPath reports whether path matches one of the internal path globs.
+func (r *redactor) internalPath(path string) bool {
+	for _, pattern := range r.opts.InternalPaths {
+		if matched, _ := doublestar.Match(pattern, path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveName reports whether a property or parameter name matches one of
+// the sensitive field globs.
+func (r *redactor) sensitiveName(name string) bool {
+	for _, pattern := range r.opts.SensitiveFields {
+		if matched, _ := filepath.Match(strings.ToLower(pattern), strings.ToLower(name)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitive reports whether the named property or parameter should be removed.
+func (r *redactor) sensitive(name string, schema *types.Schema) bool {
+	if r.sensitiveName(name) {
+		return true
+	}
+	return schema != nil && (r.flagged(schema.Extensions, ExtSensitive) || r.flagged(schema.Extensions, ExtPII) ||
+		r.flagged(schema.Extensions, ExtInternal) || r.internalRef(schema))
+}
+
+// internalRef reports whether schema, or the items of an array schema,
+// references an internal component schema.
+func (r *redactor) internalRef(schema *types.Schema) bool {
+	for ; schema != nil; schema = schema.Items {
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok && r.internal[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// exposesInternal reports whether the request or a response body of op is
+// an internal component schema.
+func (r *redactor) exposesInternal(op *types.Operation) bool {
+	if op.RequestBody != nil {
+		for _, media := range op.RequestBody.Content {
+			if r.internalRef(media.Schema) {
+				return true
+			}
+		}
+	}
+	for _, resp := range op.Responses {
+		for _, media := range resp.Content {
+			if r.internalRef(media.Schema) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// flagged reports whether an extension flags what it is on for removal.
+func (r *redactor) flagged(ext types.Extensions, key string) bool {
+	return !r.opts.KeepFlagged && flagged(ext, key)
+}
+
+func (r *redactor) parameters(context string, params []types.Parameter) []types.Parameter {
+	if len(params) == 0 {
+		return params
+	}
+	kept := make([]types.Parameter, 0, len(params))
+	for _, param := range params {
+		if r.sensitive(param.Name, param.Schema) {
+			r.result.Fields = append(r.result.Fields, context+" "+param.Name)
+			continue
+		}
+		r.example(param.Example, param.Schema)
+		r.schema(context+" "+param.Name, param.Schema)
+		kept = append(kept, param)
+	}
+	return kept
+}
+
+func (r *redactor) operationSchemas(context string, op *types.Operation) {
+	if op.RequestBody != nil {
+		for _, media := range op.RequestBody.Content {
+			r.schema(context+" request", media.Schema)
+		}
+	}
+	for code, resp := range op.Responses {
+		for _, media := range resp.Content {
+			r.schema(context+" "+code, media.Schema)
+		}
+		for _, header := range resp.Headers {
+			r.schema(context+" "+code, header.Schema)
+		}
+	}
+}
+
+// operationExamples removes sensitive fields from the request and response
+// examples of op, before their schemas lose them.
+func (r *redactor) operationExamples(op *types.Operation) {
+	var content []map[string]types.MediaType
+	if op.RequestBody != nil {
+		content = append(content, op.RequestBody.Content)
+	}
+	for _, resp := range op.Responses {
+		content = append(content, resp.Content)
+	}
+	for _, media := range content {
+		for _, m := range media {
+			r.example(m.Example, m.Schema)
+			for _, example := range m.Examples {
+				r.example(example.Value, m.Schema)
+			}
+		}
+	}
+}
+
+// example removes the sensitive fields of an example value in place. Keys
+// are checked against the properties of schema, when known, and otherwise
+// by name only.
+func (r *redactor) example(value any, schema *types.Schema) {
+	schema = r.resolve(schema)
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			var prop *types.Schema
+			if schema != nil {
+				prop = schema.Properties[key]
+			}
+			if r.sensitive(key, prop) {
+				delete(v, key)
+				continue
+			}
+			r.example(field, prop)
+		}
+	case []any:
+		var items *types.Schema
+		if schema != nil {
+			items = schema.Items
+		}
+		for _, item := range v {
+			r.example(item, items)
+		}
+	}
+}
+
+// resolve follows a component schema reference; cyclic or unknown
+// references resolve to nil.
+func (r *redactor) resolve(schema *types.Schema) *types.Schema {
+	for hops := 0; schema != nil && schema.Ref != ""; hops++ {
+		name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix)
+		if !ok || hops == len(r.schemas) {
+			return nil
+		}
+		schema = r.schemas[name]
+	}
+	return schema
+}
+
+// schema removes sensitive properties from schema and its subschemas. name
+// prefixes the recorded property paths.
+func (r *redactor) schema(name string, schema *types.Schema) {
+	if schema == nil {
+		return
+	}
+	r.stripExtensions(schema.Extensions)
+	r.example(schema.Example, schema)
+
+	props := make([]string, 0, len(schema.Properties))
+	for prop := range schema.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+	for _, prop := range props {
+		propSchema := schema.Properties[prop]
+		if !r.sensitive(prop, propSchema) {
+			r.schema(name+"."+prop, propSchema)
+			continue
+		}
+		r.result.Fields = append(r.result.Fields, name+"."+prop)
+		delete(schema.Properties, prop)
+		schema.Required = removeString(schema.Required, prop)
+	}
+
+	r.schema(name+"[]", schema.Items)
+	r.schema(name, schema.AdditionalProperties)
+	r.schema(name, schema.Not)
+	for _, parts := range [][]*types.Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, part := range parts {
+			r.schema(name, part)
+		}
+	}
+}
+
+func (r *redactor) stripExtensions(ext types.Extensions) {
+	keys := r.opts.StripExtensions
+	if !r.opts.KeepFlagged {
+		keys = append([]string{ExtInternal, ExtSensitive, ExtPII}, keys...)
+	}
+	for _, key := range keys {
+		delete(ext, key)
+	}
+}
+
+// flagged reports whether an extension is present and not false.
+func flagged(ext types.Extensions, key string) bool {
+	value, ok := ext[key]
+	if !ok || value == nil {
+		return false
+	}
+	if b, isBool := value.(bool); isBool {
+		return b
+	}
+	return true
+}
+
+// operationSlot addresses one operation of a path item.
+type operationSlot struct {
+	method string
+	op     **types.Operation
+}
+
+// operationSlots returns the operations of item in a stable method order.
+func operationSlots(item *types.PathItem) []operationSlot {
+	return []operationSlot{
+		{"GET", &item.Get},
+		{"PUT", &item.Put},
+		{"POST", &item.Post},
+		{"DELETE", &item.Delete},
+		{"OPTIONS", &item.Options},
+		{"HEAD", &item.Head},
+		{"PATCH", &item.Patch},
+		{"TRACE", &item.Trace},
+	}
+}
+
+// operationTags returns the set of tags used by the operations of doc.
+func operationTags(doc *types.OpenAPI) map[string]bool {
+	tags := make(map[string]bool)
+	for _, item := range doc.Paths {
+		for _, slot := range operationSlots(&item) {
+			if *slot.op != nil {
+				for _, tag := range (*slot.op).Tags {
+					tags[tag] = true
+				}
+			}
+		}
+	}
+	return tags
+}
+
+func removeString(values []string, value string) []string {
+	var result []string
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func redactTestDoc() *types.OpenAPI {
+	jsonResponse := func(schema *types.Schema) map[string]types.Response {
+		return map[string]types.Response{"200": {Description: "OK", Content: map[string]types.MediaType{
+			"application/json": {Schema: schema},
+		}}}
+	}
+	return &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "Test", Version: "1.0.0"},
+		Tags:    []types.Tag{{Name: "users"}, {Name: "admin"}, {Name: "unused"}},
+		Paths: map[string]types.PathItem{
+			"/users/{id}": {
+				Get: &types.Operation{
+					Tags:       []string{"users"},
+					Parameters: []types.Parameter{{Name: "apiToken", In: "query", Schema: &types.Schema{Type: "string"}}},
+					Responses:  jsonResponse(SchemaRef("User")),
+					Extensions: types.Extensions{"x-go-package": "users"},
+				},
+				Delete: &types.Operation{
+					Tags:       []string{"admin"},
+					Responses:  jsonResponse(nil),
+					Extensions: types.Extensions{ExtInternal: true},
+				},
+			},
+			"/admin/stats": {
+				Get: &types.Operation{Tags: []string{"admin"}, Responses: jsonResponse(SchemaRef("Stats"))},
+			},
+			"/health": {
+				Get: &types.Operation{Responses: jsonResponse(nil), Extensions: types.Extensions{ExtInternal: false}},
+			},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User": {
+				Type:     "object",
+				Required: []string{"name", "ssn"},
+				Example:  map[string]any{"name": "Ada", "ssn": "123-45-6789"},
+				Properties: map[string]*types.Schema{
+					"name":         {Type: "string"},
+					"ssn":          {Type: "string", Extensions: types.Extensions{ExtPII: "ssn"}},
+					"passwordHash": {Type: "string"},
+					"address":      SchemaRef("Address"),
+				},
+			},
+			"Address": {Type: "object", Properties: map[string]*types.Schema{"street": {Type: "string"}}},
+			"Stats":   {Type: "object", Properties: map[string]*types.Schema{"users": {Type: "integer"}}},
+			"Draft":   {Type: "object"},
+		}},
+	}
+}
+
+func TestRedact(t *testing.T) {
+	doc := redactTestDoc()
+
+	redacted, removed, err := Redact(doc, RedactOptions{
+		InternalPaths:   []string{"/admin/**"},
+		SensitiveFields: []string{"password*", "*token"},
+		StripExtensions: []string{"x-go-package"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /admin/stats", "DELETE /users/{id}"}, removed.Operations)
+	assert.Equal(t, []string{"GET /users/{id} apiToken", "User.passwordHash", "User.ssn"}, removed.Fields)
+	assert.Equal(t, []string{"Stats"}, removed.Schemas)
+
+	assert.NotContains(t, redacted.Paths, "/admin/stats")
+	assert.Contains(t, redacted.Paths, "/health")
+	item := redacted.Paths["/users/{id}"]
+	assert.Nil(t, item.Delete)
+	require.NotNil(t, item.Get)
+	assert.Empty(t, item.Get.Parameters)
+	assert.Empty(t, item.Get.Extensions)
+	assert.Empty(t, redacted.Paths["/health"].Get.Extensions)
+
+	user := redacted.Components.Schemas["User"]
+	assert.Equal(t, []string{"name"}, user.Required)
+	assert.Equal(t, map[string]any{"name": "Ada"}, user.Example)
+	assert.Contains(t, user.Properties, "address")
+	assert.Contains(t, redacted.Components.Schemas, "Address")
+	// Schemas unused before redaction are left alone
+	assert.Contains(t, redacted.Components.Schemas, "Draft")
+
+	// Tags only used by removed operations go with them
+	assert.Equal(t, []types.Tag{{Name: "users"}, {Name: "unused"}}, redacted.Tags)
+
+	// The original document is unchanged
+	assert.NotNil(t, doc.Paths["/users/{id}"].Delete)
+	assert.Contains(t, doc.Components.Schemas["User"].Properties, "ssn")
+	assert.Equal(t, "users", doc.Paths["/users/{id}"].Get.Extensions["x-go-package"])
+}
+
+func TestRedact_Examples(t *testing.T) {
+	doc := redactTestDoc()
+	doc.Paths["/users"] = types.PathItem{Post: &types.Operation{
+		Parameters: []types.Parameter{{Name: "filter", In: "query", Example: map[string]any{"name": "Ada", "password": "hunter2"}}},
+		RequestBody: &types.RequestBody{Content: map[string]types.MediaType{"application/json": {
+			Schema:  SchemaRef("User"),
+			Example: map[string]any{"name": "Ada", "password": "hunter2", "ssn": "123-45-6789"},
+			Examples: map[string]types.Example{"nested": {Value: map[string]any{
+				"users": []any{map[string]any{"name": "Ada", "Password": "hunter2"}},
+			}}},
+		}}},
+		Responses: map[string]types.Response{"201": {Content: map[string]types.MediaType{"application/json": {
+			Schema:  &types.Schema{Type: "array", Items: SchemaRef("User")},
+			Example: []any{map[string]any{"name": "Ada", "ssn": "123-45-6789"}},
+		}}}},
+	}}
+
+	redacted, _, err := Redact(doc, RedactOptions{SensitiveFields: []string{"password"}})
+	require.NoError(t, err)
+
+	post := redacted.Paths["/users"].Post
+	assert.Equal(t, map[string]any{"name": "Ada"}, post.Parameters[0].Example)
+	media := post.RequestBody.Content["application/json"]
+	// Flagged properties go by schema, sensitive names anywhere
+	assert.Equal(t, map[string]any{"name": "Ada"}, media.Example)
+	assert.Equal(t, map[string]any{"users": []any{map[string]any{"name": "Ada"}}}, media.Examples["nested"].Value)
+	assert.Equal(t, []any{map[string]any{"name": "Ada"}}, post.Responses["201"].Content["application/json"].Example)
+
+	// The original examples are unchanged
+	assert.Contains(t, doc.Paths["/users"].Post.RequestBody.Content["application/json"].Example, "password")
+}
+
+func TestRedact_CodeSamples(t *testing.T) {
+	doc := redactTestDoc()
+	doc.Servers = []types.Server{{URL: "https://api.example.com"}}
+	doc.Paths["/login"] = types.PathItem{Post: &types.Operation{
+		Parameters: []types.Parameter{{Name: "X-Api-Token", In: "header", Required: true, Schema: &types.Schema{Type: "string"}}},
+		RequestBody: &types.RequestBody{Content: map[string]types.MediaType{"application/json": {
+			Example: map[string]any{"name": "ada", "password": "hunter2"},
+		}}},
+	}}
+	AddCodeSamples(doc, []string{"curl", "python"})
+
+	redacted, _, err := Redact(doc, RedactOptions{SensitiveFields: []string{"password", "*token"}})
+	require.NoError(t, err)
+
+	samples, ok := redacted.Paths["/login"].Post.Extensions[ExtCodeSamples].([]CodeSample)
+	require.True(t, ok)
+	require.Len(t, samples, 2)
+	assert.Equal(t, []string{"Shell", "Python"}, []string{samples[0].Lang, samples[1].Lang})
+	for _, sample := range samples {
+		assert.Contains(t, sample.Source, `"name": "ada"`)
+		assert.NotContains(t, sample.Source, "hunter2")
+		assert.NotContains(t, sample.Source, "X-Api-Token")
+	}
+}
+
+func TestRedact_NoOptions(t *testing.T) {
+	redacted, removed, err := Redact(redactTestDoc(), RedactOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"DELETE /users/{id}"}, removed.Operations)
+	assert.Equal(t, []string{"User.ssn"}, removed.Fields)
+	assert.Empty(t, removed.Schemas)
+	assert.Equal(t, "users", redacted.Paths["/users/{id}"].Get.Extensions["x-go-package"])
+}
+
+func TestRedact_Include(t *testing.T) {
+	redacted, removed, err := Redact(redactTestDoc(), RedactOptions{Include: Subset{Tags: []string{"users"}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /admin/stats", "GET /health", "DELETE /users/{id}"}, removed.Operations)
+	assert.Equal(t, []string{"/users/{id}"}, SortedPaths(redacted.Paths))
+	assert.Equal(t, []string{"Stats"}, removed.Schemas)
+}
+
+func TestRedact_KeepFlagged(t *testing.T) {
+	redacted, removed, err := Redact(redactTestDoc(), RedactOptions{
+		InternalPaths:   []string{"/admin/**"},
+		StripExtensions: []string{"x-go-package"},
+		KeepFlagged:     true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /admin/stats"}, removed.Operations)
+	assert.Empty(t, removed.Fields)
+	item := redacted.Paths["/users/{id}"]
+	require.NotNil(t, item.Delete)
+	assert.Equal(t, true, item.Delete.Extensions[ExtInternal])
+	assert.Empty(t, item.Get.Extensions)
+	assert.Equal(t, "ssn", redacted.Components.Schemas["User"].Properties["ssn"].Extensions[ExtPII])
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtUnresolved marks a component schema stubbed for a reference whose
+// target was not extracted.
+const ExtUnresolved = "x-unresolved"
+
+// Dangling reference handling modes.
+const (
+	// DanglingRefsStub adds an empty schema marked x-unresolved for each
+	// missing target
+	DanglingRefsStub = "stub"
+
+	// DanglingRefsFail fails generation listing the missing targets
+	DanglingRefsFail = "fail"
+
+	// DanglingRefsIgnore leaves dangling references as they are
+	DanglingRefsIgnore = "ignore"
+)
+
+// componentNameRegex matches the names the OpenAPI specification allows
+// as component keys.
+var componentNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// ValidComponentName reports whether name may be used as a component key.
+func ValidComponentName(name string) bool {
+	return componentNameRegex.MatchString(name)
+}
+
+// DanglingRef is a component schema that is referenced but not defined.
+type DanglingRef struct {
+	// Schema is the name of the missing schema
+	Schema string
+
+	// Pointers are the JSON pointers of the references to it
+	Pointers []string
+}
+
+// String formats the reference as the schema followed by where it is
+// referenced from.
+func (d DanglingRef) String() string {
+	return fmt.Sprintf("%s (referenced from %s)", d.Schema, strings.Join(d.Pointers, ", "))
+}
+
+// DanglingRefs returns the component schemas that references in doc point
+// at but doc does not define, sorted by name. Plugins produce them when a
+// referenced type, such as a NestJS @Body() DTO or a Fastify schema
+// identifier, was not extracted.
+func DanglingRefs(doc *types.OpenAPI) []DanglingRef {
+	if doc == nil {
+		return nil
+	}
+	refs := make(map[string][]string)
+	w := &schemaWalker{visit: func(pointer string, schema *types.Schema) {
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+			refs[name] = append(refs[name], pointer)
+		}
+		if schema.Discriminator == nil {
+			return
+		}
+		for _, value := range slices.Sorted(maps.Keys(schema.Discriminator.Mapping)) {
+			ref := schema.Discriminator.Mapping[value]
+			// Mapping values may name a schema instead of referencing it
+			if !strings.Contains(ref, "/") {
+				ref = schemaRefPrefix + ref
+			}
+			if name, ok := strings.CutPrefix(ref, schemaRefPrefix); ok {
+				refs[name] = append(refs[name], pointer+"/discriminator/mapping/"+escapePointer(value))
+			}
+		}
+	}}
+	w.document(doc)
+
+	schemas := componentSchemas(doc)
+	var dangling []DanglingRef
+	for _, name := range slices.Sorted(maps.Keys(refs)) {
+		if _, ok := schemas[name]; !ok {
+			dangling = append(dangling, DanglingRef{Schema: name, Pointers: refs[name]})
+		}
+	}
+	return dangling
+}
+
+// StubDanglingRefs adds an empty component schema marked x-unresolved for
+// each dangling reference, so that the spec stays valid. References to
+// names that are not valid component keys, such as list[User], are left
+// dangling.
+func StubDanglingRefs(doc *types.OpenAPI, dangling []DanglingRef) {
+	if len(dangling) == 0 {
+		return
+	}
+	if doc.Components == nil {
+		doc.Components = &types.Components{}
+	}
+	if doc.Components.Schemas == nil {
+		doc.Components.Schemas = make(map[string]*types.Schema)
+	}
+	for _, ref := range dangling {
+		if !ValidComponentName(ref.Schema) {
+			continue
+		}
+		doc.Components.Schemas[ref.Schema] = &types.Schema{
+			Description: "Not extracted from the source code",
+			Extensions:  types.Extensions{ExtUnresolved: true},
+		}
+	}
+}
+
+// schemaWalker visits every schema of a document with its JSON pointer,
+// parents before their subschemas. References are not followed; every
+// component is visited on its own.
+type schemaWalker struct {
+	visit func(pointer string, schema *types.Schema)
+}
+
+func (w *schemaWalker) document(doc *types.OpenAPI) {
+	for _, path := range SortedPaths(doc.Paths) {
+		w.pathItem("#/paths/"+escapePointer(path), doc.Paths[path])
+	}
+	c := doc.Components
+	if c == nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Schemas)) {
+		w.schema(schemaRefPrefix+escapePointer(name), c.Schemas[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Responses)) {
+		w.response("#/components/responses/"+escapePointer(name), c.Responses[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Parameters)) {
+		w.schema("#/components/parameters/"+escapePointer(name)+"/schema", c.Parameters[name].Schema)
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.RequestBodies)) {
+		w.content("#/components/requestBodies/"+escapePointer(name)+"/content", c.RequestBodies[name].Content)
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Headers)) {
+		w.schema("#/components/headers/"+escapePointer(name)+"/schema", c.Headers[name].Schema)
+	}
+}
+
+func (w *schemaWalker) pathItem(pointer string, item types.PathItem) {
+	for i, param := range item.Parameters {
+		w.schema(pointer+"/parameters/"+strconv.Itoa(i)+"/schema", param.Schema)
+	}
+	for _, slot := range operationSlots(&item) {
+		if op := *slot.op; op != nil {
+			w.operation(pointer+"/"+strings.ToLower(slot.method), op)
+		}
+	}
+}
+
+func (w *schemaWalker) operation(pointer string, op *types.Operation) {
+	for i, param := range op.Parameters {
+		w.schema(pointer+"/parameters/"+strconv.Itoa(i)+"/schema", param.Schema)
+	}
+	if op.RequestBody != nil {
+		w.content(pointer+"/requestBody/content", op.RequestBody.Content)
+	}
+	for _, code := range slices.Sorted(maps.Keys(op.Responses)) {
+		w.response(pointer+"/responses/"+code, op.Responses[code])
+	}
+	for _, name := range slices.Sorted(maps.Keys(op.Callbacks)) {
+		callback := op.Callbacks[name]
+		for _, expr := range slices.Sorted(maps.Keys(callback)) {
+			w.pathItem(pointer+"/callbacks/"+escapePointer(name)+"/"+escapePointer(expr), callback[expr])
+		}
+	}
+}
+
+func (w *schemaWalker) response(pointer string, resp types.Response) {
+	for _, name := range slices.Sorted(maps.Keys(resp.Headers)) {
+		w.schema(pointer+"/headers/"+escapePointer(name)+"/schema", resp.Headers[name].Schema)
+	}
+	w.content(pointer+"/content", resp.Content)
+}
+
+func (w *schemaWalker) content(pointer string, content map[string]types.MediaType) {
+	for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+		w.schema(pointer+"/"+escapePointer(mediaType)+"/schema", content[mediaType].Schema)
+	}
+}
+
+func (w *schemaWalker) schema(pointer string, schema *types.Schema) {
+	if schema == nil {
+		return
+	}
+	w.visit(pointer, schema)
+	w.schema(pointer+"/items", schema.Items)
+	w.schema(pointer+"/additionalProperties", schema.AdditionalProperties)
+	w.schema(pointer+"/not", schema.Not)
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		w.schema(pointer+"/properties/"+escapePointer(name), schema.Properties[name])
+	}
+	for i, part := range schema.AllOf {
+		w.schema(pointer+"/allOf/"+strconv.Itoa(i), part)
+	}
+	for i, part := range schema.OneOf {
+		w.schema(pointer+"/oneOf/"+strconv.Itoa(i), part)
+	}
+	for i, part := range schema.AnyOf {
+		w.schema(pointer+"/anyOf/"+strconv.Itoa(i), part)
+	}
+}
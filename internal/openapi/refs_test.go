@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func danglingTestDoc() *types.OpenAPI {
+	return &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Post: &types.Operation{
+					RequestBody: &types.RequestBody{Content: map[string]types.MediaType{
+						"application/json": {Schema: SchemaRef("CreateUserDto")},
+					}},
+					Responses: map[string]types.Response{"201": {Content: map[string]types.MediaType{
+						"application/json": {Schema: SchemaRef("User")},
+					}}},
+				},
+			},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User": {Type: "object", Properties: map[string]*types.Schema{
+				"address": SchemaRef("Address"),
+				"pets":    {Type: "array", Items: SchemaRef("Pet")},
+			}},
+			"Pet": {
+				OneOf:         []*types.Schema{SchemaRef("Cat")},
+				Discriminator: &types.Discriminator{PropertyName: "kind", Mapping: map[string]string{"cat": "Cat", "dog": "#/components/schemas/Dog"}},
+			},
+			"Cat": {Type: "object"},
+		}},
+	}
+}
+
+func TestDanglingRefs(t *testing.T) {
+	dangling := DanglingRefs(danglingTestDoc())
+
+	assert.Equal(t, []DanglingRef{
+		{Schema: "Address", Pointers: []string{"#/components/schemas/User/properties/address"}},
+		{Schema: "CreateUserDto", Pointers: []string{"#/paths/~1users/post/requestBody/content/application~1json/schema"}},
+		{Schema: "Dog", Pointers: []string{"#/components/schemas/Pet/discriminator/mapping/dog"}},
+	}, dangling)
+	assert.Equal(t, "Address (referenced from #/components/schemas/User/properties/address)", dangling[0].String())
+}
+
+func TestStubDanglingRefs(t *testing.T) {
+	doc := danglingTestDoc()
+
+	StubDanglingRefs(doc, DanglingRefs(doc))
+
+	require.Contains(t, doc.Components.Schemas, "CreateUserDto")
+	assert.Equal(t, true, doc.Components.Schemas["CreateUserDto"].Extensions[ExtUnresolved])
+	assert.Contains(t, doc.Components.Schemas, "Address")
+	assert.Contains(t, doc.Components.Schemas, "Dog")
+	assert.Empty(t, DanglingRefs(doc))
+}
+
+func TestStubDanglingRefs_InvalidName(t *testing.T) {
+	doc := &types.OpenAPI{
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"Page": {Properties: map[string]*types.Schema{
+				"items": {Ref: "#/components/schemas/list[User]"},
+				"owner": {Ref: "#/components/schemas/User"},
+			}},
+		}},
+	}
+
+	StubDanglingRefs(doc, DanglingRefs(doc))
+
+	assert.Contains(t, doc.Components.Schemas, "User")
+	assert.NotContains(t, doc.Components.Schemas, "list[User]")
+	assert.Equal(t, []DanglingRef{
+		{Schema: "list[User]", Pointers: []string{"#/components/schemas/Page/properties/items"}},
+	}, DanglingRefs(doc))
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// RenameMovedSchemas finds the component schemas of generated that are
+// structurally identical to a schema of existing under another name, as
+// when a type is renamed in the source, and gives them their existing name
+// back, pointing references at it. A schema is only renamed when it matches
+// exactly one existing schema and no other generated schema matches that
+// one. It returns the renames, from generated to existing name.
+func RenameMovedSchemas(existing, generated *types.OpenAPI) map[string]string {
+	if existing == nil || generated == nil || existing.Components == nil || generated.Components == nil {
+		return nil
+	}
+	existingSchemas, generatedSchemas := existing.Components.Schemas, generated.Components.Schemas
+
+	// Only schemas missing from the other document can have been renamed
+	candidates := func(schemas, other map[string]*types.Schema) map[string][]string {
+		byHash := make(map[string][]string)
+		for _, name := range slices.Sorted(maps.Keys(schemas)) {
+			if _, ok := other[name]; ok {
+				continue
+			}
+			if hash, ok := structuralHash(schemas[name]); ok {
+				byHash[hash] = append(byHash[hash], name)
+			}
+		}
+		return byHash
+	}
+	removed := candidates(existingSchemas, generatedSchemas)
+	added := candidates(generatedSchemas, existingSchemas)
+
+	renames := make(map[string]string)
+	for hash, names := range added {
+		if olds := removed[hash]; len(names) == 1 && len(olds) == 1 {
+			renames[names[0]] = olds[0]
+		}
+	}
+	if len(renames) == 0 {
+		return nil
+	}
+
+	for name, old := range renames {
+		schema := generatedSchemas[name]
+		if schema.Title == name {
+			schema.Title = old
+		}
+		delete(generatedSchemas, name)
+		generatedSchemas[old] = schema
+	}
+	renameSchemaRefs(generated, renames)
+	return renames
+}
+
+// structuralHash returns the serialized form of schema without its title,
+// description and example, which a renamed schema may not keep, or false
+// for stubs of unresolved schemas.
+func structuralHash(schema *types.Schema) (string, bool) {
+	if schema == nil {
+		return "", false
+	}
+	if _, ok := schema.Extensions[ExtUnresolved]; ok {
+		return "", false
+	}
+	shape := *schema
+	shape.Title, shape.Description, shape.Example = "", "", nil
+	data, err := json.Marshal(shape)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// renameSchemaRefs points the references of doc to the component schemas
+// named in renames, and discriminator mappings naming them, at their new
+// names.
+func renameSchemaRefs(doc *types.OpenAPI, renames map[string]string) {
+	w := &schemaWalker{visit: func(_ string, schema *types.Schema) {
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+			if renamed, ok := renames[name]; ok {
+				schema.Ref = schemaRefPrefix + renamed
+			}
+		}
+		if schema.Discriminator == nil {
+			return
+		}
+		for value, ref := range schema.Discriminator.Mapping {
+			// Mapping values may name a schema instead of referencing it
+			name, isRef := strings.CutPrefix(ref, schemaRefPrefix)
+			renamed, ok := renames[name]
+			if !ok {
+				continue
+			}
+			if isRef {
+				renamed = schemaRefPrefix + renamed
+			}
+			schema.Discriminator.Mapping[value] = renamed
+		}
+	}}
+	w.document(doc)
+}
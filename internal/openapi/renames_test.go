@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMergeWithResult_RenamedSchemas(t *testing.T) {
+	user := func(title string) *types.Schema {
+		return &types.Schema{
+			Type:       "object",
+			Title:      title,
+			Properties: map[string]*types.Schema{"id": {Type: "string"}, "name": {Type: "string"}},
+			Required:   []string{"id"},
+		}
+	}
+	response := func(name string) map[string]types.Response {
+		return map[string]types.Response{
+			"200": {
+				Description: "OK",
+				Content: map[string]types.MediaType{
+					"application/json": {Schema: &types.Schema{Type: "array", Items: &types.Schema{Ref: schemaRefPrefix + name}}},
+				},
+			},
+		}
+	}
+	tag := func() *types.Schema {
+		return &types.Schema{Type: "object", Properties: map[string]*types.Schema{"label": {Type: "string"}}}
+	}
+
+	existing := &types.OpenAPI{
+		Paths: map[string]types.PathItem{"/users": {Get: &types.Operation{Responses: response("User")}}},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":  user("User"),
+			"TagA":  tag(),
+			"TagB":  tag(),
+			"Audit": {Type: "object", Properties: map[string]*types.Schema{"at": {Type: "string"}}},
+		}},
+	}
+	existing.Components.Schemas["User"].Description = "A person with an account"
+	generated := &types.OpenAPI{
+		Paths: map[string]types.PathItem{"/users": {Get: &types.Operation{Responses: response("UserDto")}}},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"UserDto": user("UserDto"),
+			"Label":   tag(),
+			"Event":   {Type: "object", Properties: map[string]*types.Schema{"at": {Type: "integer"}}},
+		}},
+	}
+
+	result, err := NewMerger(DefaultMergeOptions()).MergeWithResult(existing, generated)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"UserDto": "User"}, result.RenamedSchemas, "ambiguous and changed schemas are not renames")
+	schemas := result.Document.Components.Schemas
+	require.Contains(t, schemas, "User")
+	assert.NotContains(t, schemas, "UserDto")
+	assert.Equal(t, "User", schemas["User"].Title)
+	assert.Equal(t, "A person with an account", schemas["User"].Description)
+	assert.Contains(t, schemas, "Label")
+	assert.Contains(t, schemas, "Event")
+	content := result.Document.Paths["/users"].Get.Responses["200"].Content["application/json"]
+	assert.Equal(t, schemaRefPrefix+"User", content.Schema.Items.Ref)
+	assert.Contains(t, result.UpdatedSchemas, "User")
+	assert.NotContains(t, result.AddedSchemas, "UserDto")
+}
+
+func TestMergeWithResult_RenamesDisabled(t *testing.T) {
+	existing := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"User": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "string"}}},
+	}}}
+	generated := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"UserDto": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "string"}}},
+	}}}
+
+	options := DefaultMergeOptions()
+	options.DetectRenames = false
+	result, err := NewMerger(options).MergeWithResult(existing, generated)
+	require.NoError(t, err)
+
+	assert.Empty(t, result.RenamedSchemas)
+	assert.Contains(t, result.Document.Components.Schemas, "UserDto")
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// DefaultResponse returns the status and response of an operation with no
+// inferred response, as configured by generation.defaultResponse.
+func DefaultResponse(cfg config.DefaultResponseConfig, method, path, operationID string) (string, types.Response) {
+	status := cfg.Status
+	if status == "" {
+		status = "200"
+	}
+	description := strings.NewReplacer(
+		"{method}", strings.ToUpper(method),
+		"{path}", path,
+		"{operationId}", operationID,
+	).Replace(cfg.Description)
+	if strings.TrimSpace(description) == "" {
+		// Responses must have a description
+		description = "Successful response"
+	}
+
+	resp := types.Response{Description: description}
+	if cfg.Schema {
+		resp.Content = map[string]types.MediaType{
+			"application/json": {Schema: &types.Schema{}},
+		}
+	}
+	return status, resp
+}
+
+// FillResponses gives the default response to every operation of doc left
+// without responses, for instance by a merge or an operation override, and
+// returns those operations as METHOD /path.
+func FillResponses(doc *types.OpenAPI, cfg config.DefaultResponseConfig) []string {
+	if doc == nil {
+		return nil
+	}
+	var filled []string
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil || len(op.Responses) > 0 {
+				continue
+			}
+			status, resp := DefaultResponse(cfg, slot.method, path, op.OperationID)
+			op.Responses = map[string]types.Response{status: resp}
+			filled = append(filled, slot.method+" "+path)
+		}
+		doc.Paths[path] = item
+	}
+	return filled
+}
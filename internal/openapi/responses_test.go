@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDefaultResponse(t *testing.T) {
+	status, resp := DefaultResponse(config.DefaultResponseConfig{}, "get", "/users", "listUsers")
+	assert.Equal(t, "200", status)
+	assert.Equal(t, types.Response{Description: "Successful response"}, resp, "responses always have a description")
+
+	status, resp = DefaultResponse(config.DefaultResponseConfig{
+		Status:      "2XX",
+		Description: "{method} {path} ({operationId})",
+		Schema:      true,
+	}, "get", "/users", "listUsers")
+	assert.Equal(t, "2XX", status)
+	assert.Equal(t, "GET /users (listUsers)", resp.Description)
+	assert.Equal(t, &types.Schema{}, resp.Content["application/json"].Schema)
+}
+
+func TestFillResponses(t *testing.T) {
+	doc := &types.OpenAPI{Paths: map[string]types.PathItem{
+		"/users": {
+			Get:  &types.Operation{OperationID: "listUsers"},
+			Post: &types.Operation{Responses: map[string]types.Response{"201": {Description: "Created"}}},
+		},
+		"/health": {Head: &types.Operation{OperationID: "checkHealth"}},
+	}}
+
+	filled := FillResponses(doc, config.DefaultResponseConfig{Status: "204", Description: "{operationId} succeeded"})
+
+	assert.Equal(t, []string{"HEAD /health", "GET /users"}, filled)
+	assert.Equal(t, map[string]types.Response{"204": {Description: "listUsers succeeded"}}, doc.Paths["/users"].Get.Responses)
+	assert.Equal(t, map[string]types.Response{"201": {Description: "Created"}}, doc.Paths["/users"].Post.Responses)
+	assert.Equal(t, map[string]types.Response{"204": {Description: "checkHealth succeeded"}}, doc.Paths["/health"].Head.Responses)
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// PropertyChange is a field-level change to a component schema.
+type PropertyChange struct {
+	Type DiffType
+
+	// Property is the dotted path of the property; nested inline objects
+	// and array items add segments (e.g., address.city, tags[].name)
+	Property string
+
+	// Description states the change (e.g., string -> integer, now required)
+	Description string
+}
+
+// DiffSchema compares the component schema name in two documents property
+// by property. Properties of allOf parts are compared as if declared
+// directly. A schema missing from one document compares as empty.
+func DiffSchema(name string, a, b *types.OpenAPI) ([]PropertyChange, error) {
+	aSchemas, bSchemas := componentSchemas(a), componentSchemas(b)
+	aSchema, bSchema := aSchemas[name], bSchemas[name]
+	if aSchema == nil && bSchema == nil {
+		return nil, fmt.Errorf("schema %q not found in either spec", name)
+	}
+
+	d := schemaDiff{a: aSchemas, b: bSchemas}
+	d.object("", aSchema, bSchema)
+	return d.changes, nil
+}
+
+func componentSchemas(doc *types.OpenAPI) map[string]*types.Schema {
+	if doc == nil || doc.Components == nil {
+		return nil
+	}
+	return doc.Components.Schemas
+}
+
+type schemaDiff struct {
+	a, b    map[string]*types.Schema
+	changes []PropertyChange
+}
+
+func (d *schemaDiff) add(diffType DiffType, property, format string, args ...any) {
+	d.changes = append(d.changes, PropertyChange{Type: diffType, Property: property, Description: fmt.Sprintf(format, args...)})
+}
+
+// object compares the properties of two object schemas under prefix.
+func (d *schemaDiff) object(prefix string, a, b *types.Schema) {
+	aProps, aRequired := flattenObject(d.a, a)
+	bProps, bRequired := flattenObject(d.b, b)
+
+	names := make([]string, 0, len(aProps)+len(bProps))
+	for name := range aProps {
+		names = append(names, name)
+	}
+	for name := range bProps {
+		if _, ok := aProps[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		aProp, bProp := aProps[name], bProps[name]
+		property := prefix + name
+		switch {
+		case aProp == nil:
+			d.add(DiffTypeAdded, property, "%s%s", typeLabel(bProp), requiredLabel(bRequired[name]))
+		case bProp == nil:
+			d.add(DiffTypeRemoved, property, "%s%s", typeLabel(aProp), requiredLabel(aRequired[name]))
+		default:
+			if aRequired[name] != bRequired[name] {
+				if bRequired[name] {
+					d.add(DiffTypeModified, property, "now required")
+				} else {
+					d.add(DiffTypeModified, property, "no longer required")
+				}
+			}
+			d.property(property, aProp, bProp)
+		}
+	}
+}
+
+// property compares a property present in both schemas.
+func (d *schemaDiff) property(property string, a, b *types.Schema) {
+	if aType, bType := typeLabel(a), typeLabel(b); aType != bType {
+		d.add(DiffTypeModified, property, "%s -> %s", aType, bType)
+		return
+	}
+	if a.Nullable != b.Nullable {
+		if b.Nullable {
+			d.add(DiffTypeModified, property, "now nullable")
+		} else {
+			d.add(DiffTypeModified, property, "no longer nullable")
+		}
+	}
+	if added, removed := enumChanges(a.Enum, b.Enum); len(added)+len(removed) > 0 {
+		var parts []string
+		if len(added) > 0 {
+			parts = append(parts, "added "+strings.Join(added, ", "))
+		}
+		if len(removed) > 0 {
+			parts = append(parts, "removed "+strings.Join(removed, ", "))
+		}
+		d.add(DiffTypeModified, property, "enum %s", strings.Join(parts, "; "))
+	}
+
+	// Inline objects are compared property by property; referenced schemas
+	// are diffed on their own
+	switch {
+	case a.Ref == "" && len(a.Properties)+len(b.Properties) > 0:
+		d.object(property+".", a, b)
+	case a.Items != nil && b.Items != nil && a.Items.Ref == "" && len(a.Items.Properties)+len(b.Items.Properties) > 0:
+		d.object(property+"[].", a.Items, b.Items)
+	}
+}
+
+// flattenObject returns the properties and required set of an object
+// schema, including those of its allOf parts.
+func flattenObject(schemas map[string]*types.Schema, schema *types.Schema) (map[string]*types.Schema, map[string]bool) {
+	props := make(map[string]*types.Schema)
+	required := make(map[string]bool)
+	seen := make(map[string]bool)
+
+	var walk func(s *types.Schema)
+	walk = func(s *types.Schema) {
+		if s == nil {
+			return
+		}
+		if name, ok := strings.CutPrefix(s.Ref, schemaRefPrefix); ok {
+			if !seen[name] {
+				seen[name] = true
+				walk(schemas[name])
+			}
+			return
+		}
+		for name, prop := range s.Properties {
+			props[name] = prop
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+		for _, part := range s.AllOf {
+			walk(part)
+		}
+	}
+	walk(schema)
+	return props, required
+}
+
+// typeLabel describes the type of a property: a referenced schema name,
+// array<item>, or the type with its format (e.g., string(date-time)).
+func typeLabel(s *types.Schema) string {
+	if s == nil {
+		return "any"
+	}
+	if name, ok := strings.CutPrefix(s.Ref, schemaRefPrefix); ok {
+		return name
+	}
+	if s.Ref != "" {
+		return s.Ref
+	}
+	switch {
+	case s.Type == "array":
+		return "array<" + typeLabel(s.Items) + ">"
+	case s.Type == "" && len(s.OneOf) > 0:
+		return "oneOf"
+	case s.Type == "" && len(s.AnyOf) > 0:
+		return "anyOf"
+	case s.Type == "" && len(s.AllOf) > 0:
+		return "allOf"
+	case s.Type == "":
+		return "any"
+	case s.Format != "":
+		return s.Type + "(" + s.Format + ")"
+	}
+	return s.Type
+}
+
+func requiredLabel(required bool) string {
+	if required {
+		return ", required"
+	}
+	return ""
+}
+
+// enumChanges returns the enum values added and removed between a and b.
+func enumChanges(a, b []interface{}) (added, removed []string) {
+	format := func(values []interface{}) map[string]bool {
+		set := make(map[string]bool, len(values))
+		for _, v := range values {
+			set[fmt.Sprint(v)] = true
+		}
+		return set
+	}
+	aSet, bSet := format(a), format(b)
+	for v := range bSet {
+		if !aSet[v] {
+			added = append(added, v)
+		}
+	}
+	for v := range aSet {
+		if !bSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDiffSchema(t *testing.T) {
+	old := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Base": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "integer"}}, Required: []string{"id"}},
+		"User": {AllOf: []*types.Schema{
+			{Ref: "#/components/schemas/Base"},
+			{
+				Type: "object",
+				Properties: map[string]*types.Schema{
+					"age":      {Type: "string"},
+					"email":    {Type: "string"},
+					"legacyId": {Type: "integer"},
+					"role":     {Type: "string", Enum: []interface{}{"admin", "guest"}},
+					"address": {Type: "object", Properties: map[string]*types.Schema{
+						"city": {Type: "string"},
+					}},
+					"tags": {Type: "array", Items: &types.Schema{Ref: "#/components/schemas/Tag"}},
+				},
+			},
+		}},
+	}}}
+	current := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Base": {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "integer"}}, Required: []string{"id"}},
+		"User": {
+			Type: "object",
+			Properties: map[string]*types.Schema{
+				"id":       {Type: "integer"},
+				"age":      {Type: "integer"},
+				"email":    {Type: "string", Nullable: true},
+				"nickname": {Type: "string"},
+				"role":     {Type: "string", Enum: []interface{}{"admin", "member"}},
+				"address": {Type: "object", Properties: map[string]*types.Schema{
+					"city": {Type: "string"},
+					"zip":  {Type: "string"},
+				}},
+				"tags": {Type: "array", Items: &types.Schema{Ref: "#/components/schemas/Label"}},
+			},
+			Required: []string{"id", "email"},
+		},
+	}}}
+
+	changes, err := DiffSchema("User", old, current)
+	require.NoError(t, err)
+	assert.Equal(t, []PropertyChange{
+		{Type: DiffTypeAdded, Property: "address.zip", Description: "string"},
+		{Type: DiffTypeModified, Property: "age", Description: "string -> integer"},
+		{Type: DiffTypeModified, Property: "email", Description: "now required"},
+		{Type: DiffTypeModified, Property: "email", Description: "now nullable"},
+		{Type: DiffTypeRemoved, Property: "legacyId", Description: "integer"},
+		{Type: DiffTypeAdded, Property: "nickname", Description: "string"},
+		{Type: DiffTypeModified, Property: "role", Description: "enum added member; removed guest"},
+		{Type: DiffTypeModified, Property: "tags", Description: "array<Tag> -> array<Label>"},
+	}, changes)
+}
+
+func TestDiffSchema_Missing(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Order": {Type: "object", Properties: map[string]*types.Schema{"total": {Type: "number", Format: "double"}}, Required: []string{"total"}},
+	}}}
+
+	changes, err := DiffSchema("Order", &types.OpenAPI{}, doc)
+	require.NoError(t, err)
+	assert.Equal(t, []PropertyChange{{Type: DiffTypeAdded, Property: "total", Description: "number(double), required"}}, changes)
+
+	_, err = DiffSchema("User", doc, doc)
+	assert.Error(t, err)
+}
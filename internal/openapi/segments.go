@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// RootSegment names the partition of the paths without a static first
+// segment, such as / or /{tenant}.
+const RootSegment = "root"
+
+// unsafeFileChars matches the characters of a path segment not kept in a
+// partition file name.
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Partition is the part of a spec holding the operations under one
+// top-level path segment.
+type Partition struct {
+	// Name is the segment, e.g. users, or RootSegment
+	Name string
+
+	// Doc is a complete spec with the partition's operations and the
+	// schemas and tags they use
+	Doc *types.OpenAPI
+}
+
+// PartitionBySegment splits doc into one spec per first path segment after
+// the prefix every path shares, such as /api/v1, so /api/v1/users/{id}
+// belongs to the users partition. It also returns the index: doc without
+// operations, whose path items reference the partition files named by
+// file, keeping the schemas no operation uses. Partitions are sorted by
+// name; doc itself is not modified.
+func PartitionBySegment(doc *types.OpenAPI, file func(name string) string) ([]Partition, *types.OpenAPI, error) {
+	groups := make(map[string][]string)
+	prefix := sharedPrefix(slices.Collect(maps.Keys(doc.Paths)))
+	for _, path := range SortedPaths(doc.Paths) {
+		name := RootSegment
+		segments := pathSegments(path)[prefix:]
+		if len(segments) > 0 && !strings.HasPrefix(segments[0], "{") {
+			name = unsafeFileChars.ReplaceAllString(segments[0], "-")
+		}
+		groups[name] = append(groups[name], path)
+	}
+
+	index := Skeleton(doc)
+	index.Tags = doc.Tags
+	for _, name := range UnusedSchemas(doc) {
+		if index.Components.Schemas == nil {
+			index.Components.Schemas = make(map[string]*types.Schema)
+		}
+		index.Components.Schemas[name] = doc.Components.Schemas[name]
+	}
+
+	var partitions []Partition
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		include := Subset{}
+		for _, path := range groups[name] {
+			include.Paths = append(include.Paths, escapeGlob(path))
+			index.Paths[path] = types.PathItem{Ref: file(name) + "#/paths/" + escapePointer(path)}
+		}
+		part, _, err := Redact(doc, RedactOptions{Include: include, KeepFlagged: true})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to partition %s: %w", name, err)
+		}
+		PruneSchemas(part, UnusedSchemas(part))
+		partitions = append(partitions, Partition{Name: name, Doc: part})
+	}
+	return partitions, index, nil
+}
+
+// sharedPrefix returns the number of leading static segments every path
+// shares, leaving at least one segment to partition by.
+func sharedPrefix(paths []string) int {
+	if len(paths) < 2 {
+		return 0
+	}
+	first := pathSegments(paths[0])
+	n := len(first) - 1
+	for _, path := range paths[1:] {
+		segments := pathSegments(path)
+		n = min(n, len(segments)-1)
+		for i := 0; i < n; i++ {
+			if segments[i] != first[i] {
+				n = i
+				break
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if strings.HasPrefix(first[i], "{") {
+			return i
+		}
+	}
+	return max(n, 0)
+}
+
+// pathSegments returns the non-empty segments of path.
+func pathSegments(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// escapeGlob escapes the glob metacharacters of path, so it matches itself.
+func escapeGlob(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if strings.ContainsRune(`*?[]{}\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// joinPartitions replaces the path items of doc referencing another file,
+// as in an index written by PartitionBySegment, with the items they
+// reference, adding the schemas and tags of the referenced files that doc
+// lacks. Relative files are resolved against dir.
+func joinPartitions(doc *types.OpenAPI, dir string) error {
+	files := make(map[string]*types.OpenAPI)
+	for _, path := range SortedPaths(doc.Paths) {
+		file, pointer, ok := strings.Cut(doc.Paths[path].Ref, "#/paths/")
+		if !ok || file == "" {
+			continue
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		part, ok := files[file]
+		if !ok {
+			var err error
+			if part, err = ReadFile(file); err != nil {
+				return fmt.Errorf("failed to resolve %s: %w", doc.Paths[path].Ref, err)
+			}
+			files[file] = part
+			joinComponents(doc, part)
+		}
+		name := strings.ReplaceAll(strings.ReplaceAll(pointer, "~1", "/"), "~0", "~")
+		item, ok := part.Paths[name]
+		if !ok {
+			return fmt.Errorf("failed to resolve %s: no path %s in %s", doc.Paths[path].Ref, name, file)
+		}
+		doc.Paths[path] = item
+	}
+	return nil
+}
+
+// joinComponents adds the schemas and tags of part that doc lacks to doc.
+func joinComponents(doc, part *types.OpenAPI) {
+	for _, tag := range part.Tags {
+		if !slices.ContainsFunc(doc.Tags, func(t types.Tag) bool { return t.Name == tag.Name }) {
+			doc.Tags = append(doc.Tags, tag)
+		}
+	}
+	if part.Components == nil {
+		return
+	}
+	for name, schema := range part.Components.Schemas {
+		if doc.Components == nil {
+			doc.Components = &types.Components{}
+		}
+		if doc.Components.Schemas == nil {
+			doc.Components.Schemas = make(map[string]*types.Schema)
+		}
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			doc.Components.Schemas[name] = schema
+		}
+	}
+}
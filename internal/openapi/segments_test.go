@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func partitionedDoc() *types.OpenAPI {
+	ok := func(schema string) map[string]types.Response {
+		return map[string]types.Response{"200": {
+			Description: "OK",
+			Content:     map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: schemaRefPrefix + schema}}},
+		}}
+	}
+	return &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: "Shop", Version: "1.0.0"},
+		Paths: map[string]types.PathItem{
+			"/api/v1/users":                {Get: &types.Operation{Tags: []string{"users"}, Responses: ok("User")}},
+			"/api/v1/users/{id}":           {Get: &types.Operation{Tags: []string{"users"}, Responses: ok("User")}},
+			"/api/v1/orders/{id}":          {Get: &types.Operation{Tags: []string{"orders"}, Responses: ok("Order")}},
+			"/api/v1/{tenant}/settings":    {Get: &types.Operation{Responses: ok("Settings")}},
+			"/api/v1/orders/{id}/customer": {Get: &types.Operation{Tags: []string{"orders"}, Responses: ok("User")}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":     {Type: "object", Properties: map[string]*types.Schema{"id": {Type: "string"}}},
+			"Order":    {Type: "object", Properties: map[string]*types.Schema{"customer": {Ref: schemaRefPrefix + "User"}}},
+			"Settings": {Type: "object"},
+			"Legacy":   {Type: "object"},
+		}},
+		Tags: []types.Tag{{Name: "users"}, {Name: "orders"}},
+	}
+}
+
+func TestPartitionBySegment(t *testing.T) {
+	doc := partitionedDoc()
+
+	partitions, index, err := PartitionBySegment(doc, func(name string) string { return "openapi/" + name + ".yaml" })
+	require.NoError(t, err)
+
+	require.Len(t, partitions, 3)
+	assert.Equal(t, []string{"orders", RootSegment, "users"}, []string{partitions[0].Name, partitions[1].Name, partitions[2].Name})
+
+	orders := partitions[0].Doc
+	assert.Equal(t, []string{"/api/v1/orders/{id}", "/api/v1/orders/{id}/customer"}, SortedPaths(orders.Paths))
+	assert.ElementsMatch(t, []string{"Order", "User"}, slices.Collect(maps.Keys(orders.Components.Schemas)))
+	assert.Equal(t, []types.Tag{{Name: "orders"}}, orders.Tags)
+	assert.Equal(t, "Shop", orders.Info.Title)
+
+	assert.Equal(t, []string{"/api/v1/{tenant}/settings"}, SortedPaths(partitions[1].Doc.Paths))
+	assert.ElementsMatch(t, []string{"User"}, slices.Collect(maps.Keys(partitions[2].Doc.Components.Schemas)))
+
+	assert.Equal(t, types.PathItem{Ref: "openapi/users.yaml#/paths/~1api~1v1~1users~1{id}"}, index.Paths["/api/v1/users/{id}"])
+	assert.Equal(t, []string{"Legacy"}, slices.Collect(maps.Keys(index.Components.Schemas)), "the index keeps schemas no operation uses")
+	assert.Len(t, doc.Paths, 5, "the document is not modified")
+	assert.Len(t, doc.Components.Schemas, 4)
+}
+
+func TestReadFile_JoinsPartitions(t *testing.T) {
+	doc := partitionedDoc()
+	dir := t.TempDir()
+	partitions, index, err := PartitionBySegment(doc, func(name string) string { return "openapi/" + name + ".yaml" })
+	require.NoError(t, err)
+
+	writer := NewWriter()
+	for _, part := range partitions {
+		require.NoError(t, writer.WriteFile(part.Doc, filepath.Join(dir, "openapi", part.Name+".yaml"), "yaml"))
+	}
+	require.NoError(t, writer.WriteFile(index, filepath.Join(dir, "openapi.yaml"), "yaml"))
+
+	joined, err := ReadFile(filepath.Join(dir, "openapi.yaml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, SortedPaths(doc.Paths), SortedPaths(joined.Paths))
+	assert.Equal(t, schemaRefPrefix+"Order", joined.Paths["/api/v1/orders/{id}"].Get.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.ElementsMatch(t, slices.Collect(maps.Keys(doc.Components.Schemas)), slices.Collect(maps.Keys(joined.Components.Schemas)))
+	assert.ElementsMatch(t, doc.Tags, joined.Tags)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Operation service level extensions.
+const (
+	// ExtTimeout is the time in milliseconds after which a request to the
+	// operation times out
+	ExtTimeout = "x-timeout-ms"
+
+	// ExtSLA documents the service level objectives of the operation
+	ExtSLA = "x-sla"
+)
+
+// SLA is the value of the x-sla extension.
+type SLA struct {
+	// Availability is the availability objective (e.g., 99.95%)
+	Availability string `json:"availability,omitempty" yaml:"availability,omitempty"`
+
+	// LatencyMs is the response time objective in milliseconds
+	LatencyMs int `json:"latencyMs,omitempty" yaml:"latencyMs,omitempty"`
+}
+
+// ApplySLAs documents the service levels of rules on the operations they
+// select, as x-sla and x-timeout-ms. Every matching rule applies in order,
+// so a later rule overrides the fields an earlier one sets; a rule's
+// timeout replaces the one detected from the code.
+func ApplySLAs(doc *types.OpenAPI, rules []config.SLAConfig) {
+	if len(rules) == 0 {
+		return
+	}
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			var sla SLA
+			timeout := 0
+			for _, rule := range rules {
+				if !(Subset{Paths: rule.Paths, Tags: rule.Tags}).Matches(path, op) {
+					continue
+				}
+				if rule.Availability != "" {
+					sla.Availability = rule.Availability
+				}
+				if rule.LatencyMs > 0 {
+					sla.LatencyMs = rule.LatencyMs
+				}
+				if rule.TimeoutMs > 0 {
+					timeout = rule.TimeoutMs
+				}
+			}
+			if sla == (SLA{}) && timeout == 0 {
+				continue
+			}
+			if op.Extensions == nil {
+				op.Extensions = make(types.Extensions)
+			}
+			if sla != (SLA{}) {
+				op.Extensions[ExtSLA] = sla
+			}
+			if timeout > 0 {
+				op.Extensions[ExtTimeout] = timeout
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplySLAs(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/payments": {
+				Post: &types.Operation{Tags: []string{"payments"}, Extensions: types.Extensions{ExtTimeout: 5000}},
+			},
+			"/reports/{id}": {
+				Get: &types.Operation{Tags: []string{"reports"}, Extensions: types.Extensions{ExtTimeout: 5000}},
+			},
+			"/users": {
+				Get: &types.Operation{Tags: []string{"users"}},
+			},
+		},
+	}
+
+	ApplySLAs(doc, []config.SLAConfig{
+		{Availability: "99.9%"},
+		{Paths: []string{"/payments"}, Availability: "99.99%", LatencyMs: 300},
+		{Tags: []string{"reports"}, TimeoutMs: 30000},
+	})
+
+	assert.Equal(t, SLA{Availability: "99.99%", LatencyMs: 300}, doc.Paths["/payments"].Post.Extensions[ExtSLA])
+	assert.Equal(t, 5000, doc.Paths["/payments"].Post.Extensions[ExtTimeout])
+	assert.Equal(t, SLA{Availability: "99.9%"}, doc.Paths["/reports/{id}"].Get.Extensions[ExtSLA])
+	assert.Equal(t, 30000, doc.Paths["/reports/{id}"].Get.Extensions[ExtTimeout])
+	assert.Equal(t, types.Extensions{ExtSLA: SLA{Availability: "99.9%"}}, doc.Paths["/users"].Get.Extensions)
+}
+
+func TestApplySLAs_NoMatch(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{}},
+		},
+	}
+
+	ApplySLAs(doc, []config.SLAConfig{{Paths: []string{"/payments/**"}, LatencyMs: 300}})
+
+	assert.Nil(t, doc.Paths["/users"].Get.Extensions)
+}
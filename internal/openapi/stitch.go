@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// maxInlineDepth bounds the component references inlined into each other
+// while namespacing an external spec, against reference cycles.
+const maxInlineDepth = 32
+
+// ExternalNamespace returns the default namespace of the components of an
+// external spec owning prefix: Payments for /payments, BillingV2 for
+// /billing/v2.
+func ExternalNamespace(prefix string) string {
+	name := mountName(prefix)
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// ParseExternal decodes an external spec, isolating its components under
+// namespace: its schemas and security schemes become namespace.Name, and
+// the responses, parameters, request bodies, headers, examples, links and
+// callbacks it references are inlined where they are used. Everything else
+// is kept verbatim.
+func ParseExternal(data []byte, namespace string) (*types.OpenAPI, error) {
+	var tree map[string]any
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	if tree == nil {
+		return nil, fmt.Errorf("empty spec")
+	}
+	if _, ok := tree["swagger"]; ok {
+		return nil, fmt.Errorf("swagger 2.0 specs are not supported; convert the spec to OpenAPI 3 first")
+	}
+
+	components, _ := tree["components"].(map[string]any)
+	n := &externalNamespacer{
+		renames:    make(map[string]string),
+		components: components,
+		inlining:   make(map[string]bool),
+	}
+	for _, kind := range []string{"schemas", "securitySchemes"} {
+		entries, _ := components[kind].(map[string]any)
+		renamed := make(map[string]any, len(entries))
+		for name, entry := range entries {
+			n.renames["#/components/"+kind+"/"+name] = "#/components/" + kind + "/" + namespace + "." + name
+			renamed[namespace+"."+name] = entry
+		}
+		if entries != nil {
+			components[kind] = renamed
+		}
+	}
+
+	tree = n.node(tree, "", 0).(map[string]any)
+	if components != nil {
+		kept := make(map[string]any)
+		for _, kind := range []string{"schemas", "securitySchemes"} {
+			if entries, ok := tree["components"].(map[string]any)[kind]; ok {
+				kept[kind] = entries
+			}
+		}
+		tree["components"] = kept
+	}
+
+	data, err := yaml.Marshal(tree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spec: %w", err)
+	}
+	return Parse(data, ".yaml")
+}
+
+// externalNamespacer rewrites the references of an external spec.
+type externalNamespacer struct {
+	// renames maps the references of renamed components to their new ones
+	renames map[string]string
+
+	components map[string]any
+
+	// inlining holds the references being inlined, against cycles
+	inlining map[string]bool
+}
+
+// node returns value, found under key, with its references rewritten.
+func (n *externalNamespacer) node(value any, key string, depth int) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			if renamed, ok := n.renames[ref]; ok {
+				v["$ref"] = renamed
+				return v
+			}
+			if target := n.target(ref); target != nil && !n.inlining[ref] && depth < maxInlineDepth {
+				n.inlining[ref] = true
+				defer delete(n.inlining, ref)
+				return n.node(deepCopy(target), key, depth+1)
+			}
+			return v
+		}
+		result := make(map[string]any, len(v))
+		for k, child := range v {
+			result[k] = n.node(child, k, depth)
+		}
+		if key == "discriminator" {
+			if mapping, ok := result["mapping"].(map[string]any); ok {
+				for value, target := range mapping {
+					if ref, ok := target.(string); ok {
+						mapping[value] = n.mappingTarget(ref)
+					}
+				}
+			}
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, child := range v {
+			result[i] = n.node(child, key, depth)
+		}
+		if key == "security" {
+			for i, requirement := range result {
+				if names, ok := requirement.(map[string]any); ok {
+					result[i] = n.requirement(names)
+				}
+			}
+		}
+		return result
+	}
+	return value
+}
+
+// target returns the component a reference to another kind than schemas
+// and security schemes points at, or nil.
+func (n *externalNamespacer) target(ref string) any {
+	rest, ok := strings.CutPrefix(ref, "#/components/")
+	if !ok {
+		return nil
+	}
+	kind, name, ok := strings.Cut(rest, "/")
+	if !ok {
+		return nil
+	}
+	entries, _ := n.components[kind].(map[string]any)
+	return entries[name]
+}
+
+// mappingTarget rewrites a discriminator mapping value, a reference or a
+// schema name.
+func (n *externalNamespacer) mappingTarget(ref string) string {
+	if renamed, ok := n.renames[ref]; ok {
+		return renamed
+	}
+	if renamed, ok := n.renames["#/components/schemas/"+ref]; ok {
+		return strings.TrimPrefix(renamed, "#/components/schemas/")
+	}
+	return ref
+}
+
+// requirement renames the security schemes of a security requirement.
+func (n *externalNamespacer) requirement(names map[string]any) map[string]any {
+	result := make(map[string]any, len(names))
+	for name, scopes := range names {
+		if renamed, ok := n.renames["#/components/securitySchemes/"+name]; ok {
+			name = strings.TrimPrefix(renamed, "#/components/securitySchemes/")
+		}
+		result[name] = scopes
+	}
+	return result
+}
+
+// deepCopy copies a decoded YAML value.
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, child := range v {
+			result[k] = deepCopy(child)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, child := range v {
+			result[i] = deepCopy(child)
+		}
+		return result
+	}
+	return value
+}
+
+// ownsPath reports whether path is prefix or below it.
+func ownsPath(prefix, path string) bool {
+	return prefix == "/" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// Stitch hands the paths under prefix over to external, a spec decoded
+// with ParseExternal: the operations of doc there are replaced by the paths
+// of external, placed under prefix unless they already are, and the
+// components of namespace are replaced by those of external. Operations
+// without security requirements get those of the external spec. The
+// replaced operations of doc are returned as METHOD /path.
+func Stitch(doc, external *types.OpenAPI, prefix, namespace string) []string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		prefix = "/"
+	}
+
+	var replaced []string
+	for _, path := range SortedPaths(doc.Paths) {
+		if !ownsPath(prefix, path) {
+			continue
+		}
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			if *slot.op != nil {
+				replaced = append(replaced, slot.method+" "+path)
+			}
+		}
+		delete(doc.Paths, path)
+	}
+
+	if len(external.Paths) > 0 && doc.Paths == nil {
+		doc.Paths = make(map[string]types.PathItem, len(external.Paths))
+	}
+	for _, path := range SortedPaths(external.Paths) {
+		item := external.Paths[path]
+		if len(external.Security) > 0 {
+			for _, slot := range operationSlots(&item) {
+				if op := *slot.op; op != nil && op.Security == nil {
+					op.Security = external.Security
+				}
+			}
+		}
+		if !ownsPath(prefix, path) {
+			path = strings.TrimSuffix(prefix+path, "/")
+		}
+		doc.Paths[path] = item
+	}
+
+	if doc.Components != nil {
+		for name := range doc.Components.Schemas {
+			if strings.HasPrefix(name, namespace+".") {
+				delete(doc.Components.Schemas, name)
+			}
+		}
+		for name := range doc.Components.SecuritySchemes {
+			if strings.HasPrefix(name, namespace+".") {
+				delete(doc.Components.SecuritySchemes, name)
+			}
+		}
+	}
+	if components := external.Components; components != nil && (len(components.Schemas) > 0 || len(components.SecuritySchemes) > 0) {
+		if doc.Components == nil {
+			doc.Components = &types.Components{}
+		}
+		if len(components.Schemas) > 0 && doc.Components.Schemas == nil {
+			doc.Components.Schemas = make(map[string]*types.Schema, len(components.Schemas))
+		}
+		maps.Copy(doc.Components.Schemas, components.Schemas)
+		if len(components.SecuritySchemes) > 0 && doc.Components.SecuritySchemes == nil {
+			doc.Components.SecuritySchemes = make(map[string]types.SecurityScheme, len(components.SecuritySchemes))
+		}
+		maps.Copy(doc.Components.SecuritySchemes, components.SecuritySchemes)
+	}
+
+	for _, tag := range external.Tags {
+		if !slices.ContainsFunc(doc.Tags, func(t types.Tag) bool { return t.Name == tag.Name }) {
+			doc.Tags = append(doc.Tags, tag)
+		}
+	}
+	return replaced
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const stitchExternal = `
+openapi: 3.0.3
+info:
+  title: Payments
+  version: 2.1.0
+servers:
+  - url: https://api.payments.example.com
+security:
+  - apiKey: []
+tags:
+  - name: charges
+paths:
+  /charges:
+    get:
+      tags: [charges]
+      operationId: listCharges
+      x-rate-limit: 100
+      responses:
+        "200":
+          description: Charges
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Charge'
+        "404":
+          $ref: '#/components/responses/NotFound'
+  /charges/{id}:
+    parameters:
+      - $ref: '#/components/parameters/ChargeID'
+    get:
+      security:
+        - oauth: [charges:read]
+      responses:
+        "200":
+          description: Charge
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Payment'
+components:
+  schemas:
+    Charge:
+      type: object
+      properties:
+        amount:
+          $ref: '#/components/schemas/Money'
+    Money:
+      type: integer
+    Payment:
+      oneOf:
+        - $ref: '#/components/schemas/Charge'
+      discriminator:
+        propertyName: kind
+        mapping:
+          charge: '#/components/schemas/Charge'
+          refund: Money
+  responses:
+    NotFound:
+      description: Not found
+  parameters:
+    ChargeID:
+      name: id
+      in: path
+      required: true
+      schema:
+        type: string
+  securitySchemes:
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-Api-Key
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            charges:read: Read charges
+`
+
+func TestExternalNamespace(t *testing.T) {
+	assert.Equal(t, "Payments", ExternalNamespace("/payments"))
+	assert.Equal(t, "BillingV2", ExternalNamespace("/billing/v2/"))
+	assert.Equal(t, "ThirdParty", ExternalNamespace("/third-party/{tenant}"))
+	assert.Equal(t, "", ExternalNamespace("/"))
+}
+
+func TestParseExternal(t *testing.T) {
+	external, err := ParseExternal([]byte(stitchExternal), "Payments")
+	require.NoError(t, err)
+
+	require.NotNil(t, external.Components)
+	assert.ElementsMatch(t, []string{"Payments.Charge", "Payments.Money", "Payments.Payment"}, keys(external.Components.Schemas))
+	assert.Contains(t, external.Components.SecuritySchemes, "Payments.apiKey")
+	assert.Contains(t, external.Components.SecuritySchemes, "Payments.oauth")
+	assert.Empty(t, external.Components.Responses)
+	assert.Empty(t, external.Components.Parameters)
+
+	charge := external.Components.Schemas["Payments.Charge"]
+	assert.Equal(t, "#/components/schemas/Payments.Money", charge.Properties["amount"].Ref)
+	payment := external.Components.Schemas["Payments.Payment"]
+	assert.Equal(t, "#/components/schemas/Payments.Charge", payment.OneOf[0].Ref)
+	assert.Equal(t, map[string]string{
+		"charge": "#/components/schemas/Payments.Charge",
+		"refund": "Payments.Money",
+	}, payment.Discriminator.Mapping)
+
+	list := external.Paths["/charges"].Get
+	assert.Equal(t, "#/components/schemas/Payments.Charge", list.Responses["200"].Content["application/json"].Schema.Items.Ref)
+	assert.Equal(t, "Not found", list.Responses["404"].Description)
+	assert.Equal(t, 100, list.Extensions["x-rate-limit"])
+	assert.Equal(t, []map[string][]string{{"Payments.apiKey": {}}}, external.Security)
+
+	item := external.Paths["/charges/{id}"]
+	require.Len(t, item.Parameters, 1)
+	assert.Equal(t, "id", item.Parameters[0].Name)
+	assert.Equal(t, []map[string][]string{{"Payments.oauth": {"charges:read"}}}, item.Get.Security)
+}
+
+func TestParseExternal_Swagger2(t *testing.T) {
+	_, err := ParseExternal([]byte("swagger: \"2.0\"\npaths: {}\n"), "Payments")
+	assert.ErrorContains(t, err, "swagger 2.0")
+}
+
+func TestStitch(t *testing.T) {
+	external, err := ParseExternal([]byte(stitchExternal), "Payments")
+	require.NoError(t, err)
+
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users":            {Get: &types.Operation{OperationID: "listUsers"}},
+			"/payments/charges": {Post: &types.Operation{OperationID: "proxyCharges"}},
+			"/payments-legacy":  {Get: &types.Operation{OperationID: "legacy"}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":             {Type: "object"},
+			"Payments.Removed": {Type: "object"},
+		}},
+		Tags: []types.Tag{{Name: "users"}},
+	}
+
+	replaced := Stitch(doc, external, "/payments/", "Payments")
+
+	assert.Equal(t, []string{"POST /payments/charges"}, replaced)
+	assert.ElementsMatch(t, []string{"/users", "/payments-legacy", "/payments/charges", "/payments/charges/{id}"}, SortedPaths(doc.Paths))
+	charges := doc.Paths["/payments/charges"]
+	assert.Nil(t, charges.Post)
+	require.NotNil(t, charges.Get)
+	assert.Equal(t, "listCharges", charges.Get.OperationID)
+	assert.Equal(t, []map[string][]string{{"Payments.apiKey": {}}}, charges.Get.Security)
+	assert.Equal(t, []map[string][]string{{"Payments.oauth": {"charges:read"}}}, doc.Paths["/payments/charges/{id}"].Get.Security)
+
+	assert.ElementsMatch(t, []string{"User", "Payments.Charge", "Payments.Money", "Payments.Payment"}, keys(doc.Components.Schemas))
+	assert.Len(t, doc.Components.SecuritySchemes, 2)
+	assert.Equal(t, []types.Tag{{Name: "users"}, {Name: "charges"}}, doc.Tags)
+}
+
+// keys returns the keys of a map in any order.
+func keys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ExtStreaming documents how the operation streams its response: chunked,
+// as Server-Sent Events or by long polling.
+const ExtStreaming = "x-streaming"
+
+// streamingMediaTypes are the default media types of the streaming modes;
+// long polling keeps the response as it is.
+var streamingMediaTypes = map[string]string{
+	"stream": "application/octet-stream",
+	"sse":    "text/event-stream",
+}
+
+// jsonStreams are the media types of streams of JSON documents.
+var jsonStreams = map[string]bool{
+	"application/x-ndjson":    true,
+	"application/jsonl":       true,
+	"application/json-seq":    true,
+	"application/stream+json": true,
+}
+
+// ApplyStreaming documents streaming and long-polling operations in
+// x-streaming, giving streamed success responses their media type instead
+// of JSON. Annotations found in the code win over rules, a later matching
+// rule overriding an earlier one; Server-Sent Events endpoints detected by
+// the framework plugins stream in sse mode.
+func ApplyStreaming(doc *types.OpenAPI, rules []config.StreamingRule) {
+	for _, path := range SortedPaths(doc.Paths) {
+		item := doc.Paths[path]
+		for _, slot := range operationSlots(&item) {
+			op := *slot.op
+			if op == nil {
+				continue
+			}
+			streaming, annotated := op.Extensions[ExtStreaming].(types.Streaming)
+			if !annotated {
+				for _, rule := range rules {
+					if (Subset{Paths: rule.Paths, Tags: rule.Tags}).Matches(path, op) {
+						streaming = types.Streaming{Mode: rule.Mode, MediaType: rule.MediaType}
+					}
+				}
+			}
+			if streaming.Mode == "" && op.Extensions["x-sse"] == true {
+				streaming.Mode = "sse"
+			}
+			if streaming.Mode == "" {
+				continue
+			}
+			if streaming.MediaType == "" {
+				streaming.MediaType = streamingMediaTypes[streaming.Mode]
+			}
+			if streaming.MediaType != "" {
+				streamResponse(op, streaming.MediaType)
+			}
+			if op.Extensions == nil {
+				op.Extensions = make(types.Extensions)
+			}
+			op.Extensions[ExtStreaming] = streaming
+		}
+	}
+}
+
+// streamResponse documents the success responses of op as streams of
+// mediaType. Streams of JSON documents, such as application/x-ndjson, keep
+// the JSON schema as that of each document; other streams are strings.
+func streamResponse(op *types.Operation, mediaType string) {
+	if op.Responses == nil {
+		op.Responses = make(map[string]types.Response)
+	}
+	codes := successCodes(op.Responses)
+	if len(codes) == 0 {
+		op.Responses["200"] = types.Response{Description: "Successful response"}
+		codes = []string{"200"}
+	}
+	for _, code := range codes {
+		if code == "204" {
+			continue
+		}
+		resp := op.Responses[code]
+		schema := &types.Schema{Type: "string"}
+		if json := resp.Content["application/json"]; jsonStreams[mediaType] && json.Schema != nil {
+			schema = json.Schema
+		}
+		resp.Content = map[string]types.MediaType{mediaType: {Schema: schema}}
+		op.Responses[code] = resp
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplyStreaming(t *testing.T) {
+	order := &types.Schema{Ref: "#/components/schemas/Order"}
+	jsonResponse := func() map[string]types.Response {
+		return map[string]types.Response{
+			"200": {Description: "OK", Content: map[string]types.MediaType{"application/json": {Schema: order}}},
+			"404": {Description: "Not found"},
+		}
+	}
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/orders/export": {
+				Get: &types.Operation{Responses: jsonResponse()},
+			},
+			"/orders/download": {
+				Get: &types.Operation{
+					Responses:  jsonResponse(),
+					Extensions: types.Extensions{ExtStreaming: types.Streaming{Mode: "stream"}},
+				},
+			},
+			"/orders/next": {
+				Get: &types.Operation{Tags: []string{"polling"}, Responses: jsonResponse()},
+			},
+			"/events": {
+				Get: &types.Operation{Extensions: types.Extensions{"x-sse": true}},
+			},
+			"/orders": {
+				Get: &types.Operation{Responses: jsonResponse()},
+			},
+		},
+	}
+
+	ApplyStreaming(doc, []config.StreamingRule{
+		{Paths: []string{"/orders/*"}, Mode: "stream"},
+		{Paths: []string{"/orders/export"}, Mode: "stream", MediaType: "application/x-ndjson"},
+		{Tags: []string{"polling"}, Mode: "long-poll"},
+	})
+
+	export := doc.Paths["/orders/export"].Get
+	assert.Equal(t, types.Streaming{Mode: "stream", MediaType: "application/x-ndjson"}, export.Extensions[ExtStreaming])
+	assert.Equal(t, map[string]types.MediaType{"application/x-ndjson": {Schema: order}}, export.Responses["200"].Content, "streams of JSON keep the document schema")
+	assert.Empty(t, export.Responses["404"].Content)
+
+	download := doc.Paths["/orders/download"].Get
+	assert.Equal(t, types.Streaming{Mode: "stream", MediaType: "application/octet-stream"}, download.Extensions[ExtStreaming], "annotations win over rules")
+	assert.Equal(t, map[string]types.MediaType{"application/octet-stream": {Schema: &types.Schema{Type: "string"}}}, download.Responses["200"].Content)
+
+	next := doc.Paths["/orders/next"].Get
+	assert.Equal(t, types.Streaming{Mode: "long-poll"}, next.Extensions[ExtStreaming])
+	assert.Contains(t, next.Responses["200"].Content, "application/json", "long polling keeps the response")
+
+	events := doc.Paths["/events"].Get
+	assert.Equal(t, types.Streaming{Mode: "sse", MediaType: "text/event-stream"}, events.Extensions[ExtStreaming])
+	assert.Contains(t, events.Responses["200"].Content, "text/event-stream")
+
+	assert.Nil(t, doc.Paths["/orders"].Get.Extensions)
+}
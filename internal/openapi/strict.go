@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// CloseObjects sets additionalProperties: false on the component schemas,
+// and the inline object schemas nested in them, that declare properties.
+// Dictionaries, compositions, and schemas other components extend through
+// allOf are left open, since closing them would reject the properties the
+// other parts contribute.
+func CloseObjects(schemas map[string]*types.Schema) {
+	extended := make(map[string]bool)
+	for _, schema := range schemas {
+		for _, part := range schema.AllOf {
+			if part == nil {
+				continue
+			}
+			if name, ok := strings.CutPrefix(part.Ref, schemaRefPrefix); ok {
+				extended[name] = true
+			}
+		}
+	}
+
+	for name, schema := range schemas {
+		if !extended[name] {
+			closeObject(schema)
+		}
+	}
+}
+
+// closeObject closes schema and its nested inline objects.
+func closeObject(schema *types.Schema) {
+	if schema == nil || schema.Ref != "" || schema.Bool != nil {
+		return
+	}
+	if len(schema.Properties) > 0 && schema.AdditionalProperties == nil &&
+		len(schema.AllOf) == 0 && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0 {
+		schema.AdditionalProperties = types.BoolSchema(false)
+	}
+
+	for _, prop := range schema.Properties {
+		closeObject(prop)
+	}
+	closeObject(schema.Items)
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func strictSchemas() []types.Schema {
+	return []types.Schema{
+		{
+			Title: "User",
+			Type:  "object",
+			Properties: map[string]*types.Schema{
+				"name":    {Type: "string"},
+				"address": {Type: "object", Properties: map[string]*types.Schema{"city": {Type: "string"}}},
+				"labels":  {Type: "object", AdditionalProperties: &types.Schema{Type: "string"}},
+			},
+		},
+		{
+			Title: "Pet",
+			Type:  "object",
+			Properties: map[string]*types.Schema{
+				"name": {Type: "string"},
+			},
+		},
+		{
+			Title: "Dog",
+			AllOf: []*types.Schema{
+				{Ref: "#/components/schemas/Pet"},
+				{Type: "object", Properties: map[string]*types.Schema{"breed": {Type: "string"}}},
+			},
+		},
+	}
+}
+
+func TestBuilder_Build_StrictObjects(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.StrictObjects = true
+
+	doc, err := NewBuilder(cfg).Build(nil, strictSchemas())
+	require.NoError(t, err)
+
+	schemas := doc.Components.Schemas
+	user := schemas["User"]
+	assert.True(t, user.AdditionalProperties.IsFalse())
+	assert.True(t, user.Properties["address"].AdditionalProperties.IsFalse())
+	assert.Equal(t, "string", user.Properties["labels"].AdditionalProperties.Type, "dictionaries stay open")
+	assert.Nil(t, schemas["Pet"].AdditionalProperties, "allOf bases stay open")
+	assert.Nil(t, schemas["Dog"].AdditionalProperties)
+
+	writer := NewWriter()
+	yamlOut, err := writer.ToYAML(doc)
+	require.NoError(t, err)
+	assert.Contains(t, yamlOut, "additionalProperties: false")
+
+	jsonOut, err := writer.ToJSON(doc)
+	require.NoError(t, err)
+	assert.Contains(t, jsonOut, `"additionalProperties": false`)
+
+	// Boolean schemas survive a round trip through both formats
+	for _, name := range []string{"openapi.yaml", "openapi.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		format := "yaml"
+		if filepath.Ext(name) == ".json" {
+			format = "json"
+		}
+		require.NoError(t, writer.WriteFile(doc, path, format))
+
+		read, err := ReadFile(path)
+		require.NoError(t, err, name)
+		readUser := read.Components.Schemas["User"]
+		assert.True(t, readUser.AdditionalProperties.IsFalse(), name)
+		assert.Equal(t, "string", readUser.Properties["labels"].AdditionalProperties.Type, name)
+	}
+}
+
+func TestBuilder_Build_StrictObjectsDisabled(t *testing.T) {
+	doc, err := NewBuilder(config.Default()).Build(nil, strictSchemas())
+	require.NoError(t, err)
+	assert.Nil(t, doc.Components.Schemas["User"].AdditionalProperties)
+}
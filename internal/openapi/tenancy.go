@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// serverVariableRegex matches the {name} variables of a server URL.
+var serverVariableRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// HostTemplate is a server host that varies per tenant.
+type HostTemplate struct {
+	// Host is the host template, such as {tenant}.api.example.com; a bare
+	// {tenant} is prefixed to the hosts of the existing servers
+	Host string
+
+	// Variables describe the host's variables
+	Variables map[string]types.ServerVariable
+}
+
+// TemplateServers applies a per-tenant host to the servers of doc. A
+// server on the template's domain, or every public server when the
+// template has no domain, gets the templated host; a template with a
+// domain no server uses is added as the first server. Variables are added
+// to every server using them. It reports whether any server was templated.
+func TemplateServers(doc *types.OpenAPI, host HostTemplate) bool {
+	if doc == nil || !strings.Contains(host.Host, "{") {
+		return false
+	}
+	end := strings.LastIndex(host.Host, "}") + 1
+	prefix, domain := host.Host[:end], strings.TrimPrefix(host.Host[end:], ".")
+
+	templated := false
+	for i, server := range doc.Servers {
+		if strings.Contains(server.URL, "{") {
+			templated = templated || strings.Contains(server.URL, host.Host)
+			continue
+		}
+		u, err := url.Parse(server.URL)
+		if err != nil || u.Host == "" || isLocalHost(u.Hostname()) {
+			continue
+		}
+		if domain != "" && u.Hostname() != domain {
+			continue
+		}
+		doc.Servers[i].URL = strings.Replace(server.URL, "//"+u.Host, "//"+prefix+"."+u.Host, 1)
+		templated = true
+	}
+	if !templated && domain != "" {
+		doc.Servers = append([]types.Server{{
+			URL:         "https://" + host.Host,
+			Description: "Tenant-specific host",
+		}}, doc.Servers...)
+		templated = true
+	}
+
+	for i, server := range doc.Servers {
+		for _, m := range serverVariableRegex.FindAllStringSubmatch(server.URL, -1) {
+			name := m[1]
+			if _, ok := server.Variables[name]; ok {
+				continue
+			}
+			v, ok := host.Variables[name]
+			if !ok {
+				v = types.ServerVariable{Default: name}
+			}
+			if doc.Servers[i].Variables == nil {
+				doc.Servers[i].Variables = make(map[string]types.ServerVariable)
+			}
+			doc.Servers[i].Variables[name] = v
+		}
+	}
+	return templated
+}
+
+// isLocalHost reports whether host is localhost or an IP address, which
+// have no tenant subdomains.
+func isLocalHost(host string) bool {
+	return host == "localhost" || strings.HasSuffix(host, ".localhost") || net.ParseIP(host) != nil
+}
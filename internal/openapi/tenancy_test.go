@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestTemplateServers(t *testing.T) {
+	tenant := map[string]types.ServerVariable{"tenant": {Default: "acme"}}
+
+	doc := &types.OpenAPI{Servers: []types.Server{
+		{URL: "https://api.example.com/v1"},
+		{URL: "http://localhost:8080"},
+	}}
+	assert.True(t, TemplateServers(doc, HostTemplate{Host: "{tenant}", Variables: tenant}))
+	assert.Equal(t, "https://{tenant}.api.example.com/v1", doc.Servers[0].URL)
+	assert.Equal(t, tenant, doc.Servers[0].Variables)
+	assert.Equal(t, "http://localhost:8080", doc.Servers[1].URL)
+	assert.Nil(t, doc.Servers[1].Variables)
+
+	// A server on the template's domain is templated, others are kept
+	doc = &types.OpenAPI{Servers: []types.Server{
+		{URL: "https://example.com:8443"},
+		{URL: "https://status.example.org"},
+	}}
+	assert.True(t, TemplateServers(doc, HostTemplate{Host: "{tenant}.example.com", Variables: tenant}))
+	assert.Equal(t, "https://{tenant}.example.com:8443", doc.Servers[0].URL)
+	assert.Equal(t, "https://status.example.org", doc.Servers[1].URL)
+
+	// Without a server on the domain, the host is added first
+	doc = &types.OpenAPI{Servers: []types.Server{{URL: "http://localhost:3000"}}}
+	assert.True(t, TemplateServers(doc, HostTemplate{Host: "{account}.example.com"}))
+	require.Len(t, doc.Servers, 2)
+	assert.Equal(t, "https://{account}.example.com", doc.Servers[0].URL)
+	assert.Equal(t, types.ServerVariable{Default: "account"}, doc.Servers[0].Variables["account"])
+
+	// Without a domain or a public server, nothing is templated
+	doc = &types.OpenAPI{Servers: []types.Server{{URL: "http://127.0.0.1:3000"}}}
+	assert.False(t, TemplateServers(doc, HostTemplate{Host: "{tenant}"}))
+}
+
+func TestBuilder_Build_Tenancy(t *testing.T) {
+	cfg := config.Default()
+	cfg.OpenAPI.Servers = []config.ServerConfig{
+		{URL: "https://api.example.com"},
+		{URL: "https://{region}.example.net", Variables: map[string]config.ServerVariableConfig{
+			"region": {Default: "eu", Enum: []string{"eu", "us"}},
+		}},
+	}
+	cfg.Generation.Tenancy.Values = []string{"acme", "globex"}
+
+	detected := HostTemplate{Host: "{tenant}", Variables: map[string]types.ServerVariable{
+		"tenant": {Description: "Tenant subdomain, resolved by apartment"},
+	}}
+	doc, err := NewBuilder(cfg).WithTenantHost(detected).Build(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://{tenant}.api.example.com", doc.Servers[0].URL)
+	assert.Equal(t, types.ServerVariable{
+		Enum:        []string{"acme", "globex"},
+		Default:     "acme",
+		Description: "Tenant subdomain, resolved by apartment",
+	}, doc.Servers[0].Variables["tenant"])
+	assert.Equal(t, types.ServerVariable{Default: "eu", Enum: []string{"eu", "us"}}, doc.Servers[1].Variables["region"])
+
+	// The configured host overrides the detected one, and detection can be off
+	cfg.Generation.Tenancy.Host = "{org}.api.example.com"
+	doc, err = NewBuilder(cfg).WithTenantHost(detected).Build(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://{org}.api.example.com", doc.Servers[0].URL)
+
+	cfg.Generation.Tenancy.Host = ""
+	cfg.Generation.Tenancy.Detect = false
+	doc, err = NewBuilder(cfg).WithTenantHost(detected).Build(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com", doc.Servers[0].URL)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// UnusedSchemas returns the sorted names of component schemas that no
+// operation references, directly or through other schemas. Schemas used by
+// other reusable components (responses, parameters, request bodies, headers
+// and callbacks) count as referenced.
+func UnusedSchemas(doc *types.OpenAPI) []string {
+	if doc == nil || doc.Components == nil || len(doc.Components.Schemas) == 0 {
+		return nil
+	}
+
+	r := &refCollector{schemas: doc.Components.Schemas, used: make(map[string]bool)}
+	for _, item := range doc.Paths {
+		r.pathItem(item)
+	}
+	c := doc.Components
+	for _, resp := range c.Responses {
+		r.response(resp)
+	}
+	for _, param := range c.Parameters {
+		r.schema(param.Schema)
+	}
+	for _, body := range c.RequestBodies {
+		r.content(body.Content)
+	}
+	for _, header := range c.Headers {
+		r.schema(header.Schema)
+	}
+	for _, callback := range c.Callbacks {
+		for _, item := range callback {
+			r.pathItem(item)
+		}
+	}
+
+	var unused []string
+	for name := range doc.Components.Schemas {
+		if !r.used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+// PruneSchemas removes the named component schemas from doc.
+func PruneSchemas(doc *types.OpenAPI, names []string) {
+	if doc == nil || doc.Components == nil {
+		return
+	}
+	for _, name := range names {
+		delete(doc.Components.Schemas, name)
+	}
+}
+
+// refCollector records the component schemas reachable from the parts of a
+// document it visits.
+type refCollector struct {
+	schemas map[string]*types.Schema
+	used    map[string]bool
+}
+
+func (r *refCollector) pathItem(item types.PathItem) {
+	for _, param := range item.Parameters {
+		r.schema(param.Schema)
+	}
+	for _, op := range []*types.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch, item.Trace} {
+		if op != nil {
+			r.operation(op)
+		}
+	}
+}
+
+func (r *refCollector) operation(op *types.Operation) {
+	for _, param := range op.Parameters {
+		r.schema(param.Schema)
+	}
+	if op.RequestBody != nil {
+		r.content(op.RequestBody.Content)
+	}
+	for _, resp := range op.Responses {
+		r.response(resp)
+	}
+	for _, callback := range op.Callbacks {
+		for _, item := range callback {
+			r.pathItem(item)
+		}
+	}
+}
+
+func (r *refCollector) response(resp types.Response) {
+	for _, header := range resp.Headers {
+		r.schema(header.Schema)
+	}
+	r.content(resp.Content)
+}
+
+func (r *refCollector) content(content map[string]types.MediaType) {
+	for _, media := range content {
+		r.schema(media.Schema)
+	}
+}
+
+func (r *refCollector) schema(schema *types.Schema) {
+	if schema == nil {
+		return
+	}
+	r.ref(schema.Ref)
+	r.schema(schema.Items)
+	r.schema(schema.AdditionalProperties)
+	r.schema(schema.Not)
+	for _, prop := range schema.Properties {
+		r.schema(prop)
+	}
+	for _, parts := range [][]*types.Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, part := range parts {
+			r.schema(part)
+		}
+	}
+	if schema.Discriminator != nil {
+		for _, ref := range schema.Discriminator.Mapping {
+			// Mapping values may name a schema instead of referencing it
+			if !strings.Contains(ref, "/") {
+				ref = schemaRefPrefix + ref
+			}
+			r.ref(ref)
+		}
+	}
+}
+
+// ref marks the component a reference points at, and everything it
+// references in turn, as used.
+func (r *refCollector) ref(ref string) {
+	if !strings.HasPrefix(ref, schemaRefPrefix) {
+		return
+	}
+	name := strings.TrimPrefix(ref, schemaRefPrefix)
+	if r.used[name] {
+		return
+	}
+	r.used[name] = true
+	r.schema(r.schemas[name])
+}
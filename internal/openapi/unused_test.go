@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestUnusedSchemas(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: map[string]types.MediaType{
+							"application/json": {Schema: &types.Schema{Type: "array", Items: SchemaRef("User")}},
+						}},
+					},
+				},
+				Post: &types.Operation{
+					Parameters: []types.Parameter{{Name: "X-Trace", In: "header", Schema: SchemaRef("TraceID")}},
+					RequestBody: &types.RequestBody{Content: map[string]types.MediaType{
+						"application/json": {Schema: SchemaRef("CreateUser")},
+					}},
+				},
+			},
+			"/pets": {
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: map[string]types.MediaType{
+							"application/json": {Schema: SchemaRef("Pet")},
+						}},
+					},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User":       {Type: "object", Properties: map[string]*types.Schema{"address": SchemaRef("Address")}},
+				"Address":    {Type: "object"},
+				"CreateUser": {AllOf: []*types.Schema{SchemaRef("User")}},
+				"TraceID":    {Type: "string"},
+				"Pet": {
+					OneOf:         []*types.Schema{SchemaRef("Cat")},
+					Discriminator: &types.Discriminator{PropertyName: "kind", Mapping: map[string]string{"dog": "Dog"}},
+				},
+				"Cat":        {Type: "object"},
+				"Dog":        {Type: "object"},
+				"Error":      {Type: "object"},
+				"LegacyUser": {Type: "object", Properties: map[string]*types.Schema{"error": SchemaRef("Error")}},
+				"Cycle":      {Type: "object", Properties: map[string]*types.Schema{"next": SchemaRef("Cycle")}},
+			},
+			Responses: map[string]types.Response{
+				"NotFound": {Content: map[string]types.MediaType{
+					"application/problem+json": {Schema: SchemaRef("Error")},
+				}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"Cycle", "LegacyUser"}, UnusedSchemas(doc))
+
+	PruneSchemas(doc, []string{"Cycle"})
+	assert.NotContains(t, doc.Components.Schemas, "Cycle")
+	assert.Equal(t, []string{"LegacyUser"}, UnusedSchemas(doc))
+}
+
+func TestUnusedSchemas_NoComponents(t *testing.T) {
+	assert.Nil(t, UnusedSchemas(&types.OpenAPI{}))
+	assert.Nil(t, UnusedSchemas(nil))
+	PruneSchemas(&types.OpenAPI{}, []string{"User"})
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Suffixes of the request and response variants of a component schema.
+const (
+	RequestVariantSuffix  = "Create"
+	ResponseVariantSuffix = "Response"
+)
+
+// SplitVariants separates component schemas that operations use both as a
+// request body and in a response, but whose properties differ between the
+// two (readOnly or writeOnly properties). Requests then reference a
+// <Name>Create variant without the readOnly properties and responses a
+// <Name>Response variant without the writeOnly ones. The original schema is
+// dropped unless something else still references it. Variant names that
+// already exist are left untouched.
+func SplitVariants(doc *types.OpenAPI) {
+	if doc == nil || doc.Components == nil || len(doc.Components.Schemas) == 0 {
+		return
+	}
+
+	requests := make(map[string]bool)
+	responses := make(map[string]bool)
+	forEachOperation(doc, func(op *types.Operation) {
+		if op.RequestBody != nil {
+			collectContentRefs(op.RequestBody.Content, requests)
+		}
+		for _, resp := range op.Responses {
+			collectContentRefs(resp.Content, responses)
+		}
+	})
+
+	schemas := doc.Components.Schemas
+	renames := make(map[string]bool)
+	for name := range requests {
+		schema := schemas[name]
+		if !responses[name] || schema == nil || !hasAccessModes(schema) {
+			continue
+		}
+		requestName := name + RequestVariantSuffix
+		responseName := name + ResponseVariantSuffix
+		if schemas[requestName] != nil || schemas[responseName] != nil {
+			continue
+		}
+		schemas[requestName] = schemaVariant(schema, requestName, func(p *types.Schema) bool { return p.ReadOnly })
+		schemas[responseName] = schemaVariant(schema, responseName, func(p *types.Schema) bool { return p.WriteOnly })
+		renames[name] = true
+	}
+	if len(renames) == 0 {
+		return
+	}
+
+	forEachOperation(doc, func(op *types.Operation) {
+		if op.RequestBody != nil {
+			renameContentRefs(op.RequestBody.Content, renames, RequestVariantSuffix)
+		}
+		for _, resp := range op.Responses {
+			renameContentRefs(resp.Content, renames, ResponseVariantSuffix)
+		}
+	})
+
+	// Keep originals that other schemas or components still reference
+	for _, name := range UnusedSchemas(doc) {
+		if renames[name] {
+			delete(schemas, name)
+		}
+	}
+}
+
+// forEachOperation calls fn for every operation in doc.
+func forEachOperation(doc *types.OpenAPI, fn func(op *types.Operation)) {
+	for _, item := range doc.Paths {
+		for _, op := range []*types.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch, item.Trace} {
+			if op != nil {
+				fn(op)
+			}
+		}
+	}
+}
+
+// collectContentRefs records the component schemas that media types
+// reference directly or as array items.
+func collectContentRefs(content map[string]types.MediaType, names map[string]bool) {
+	for _, media := range content {
+		schema := media.Schema
+		for schema != nil && schema.Ref == "" && schema.Items != nil {
+			schema = schema.Items
+		}
+		if schema == nil {
+			continue
+		}
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+			names[name] = true
+		}
+	}
+}
+
+// renameContentRefs points references to renamed schemas at their variant.
+func renameContentRefs(content map[string]types.MediaType, renames map[string]bool, suffix string) {
+	for mediaType, media := range content {
+		if renamed := renameRef(media.Schema, renames, suffix); renamed != media.Schema {
+			media.Schema = renamed
+			content[mediaType] = media
+		}
+	}
+}
+
+// renameRef returns schema with a reference to a renamed schema, directly
+// or as array items, pointed at its variant. Schemas may be shared between
+// requests and responses, so changes are made to a copy.
+func renameRef(schema *types.Schema, renames map[string]bool, suffix string) *types.Schema {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref == "" && schema.Items != nil {
+		items := renameRef(schema.Items, renames, suffix)
+		if items == schema.Items {
+			return schema
+		}
+		copied := *schema
+		copied.Items = items
+		return &copied
+	}
+	name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix)
+	if !ok || !renames[name] {
+		return schema
+	}
+	copied := *schema
+	copied.Ref = schemaRefPrefix + name + suffix
+	return &copied
+}
+
+// hasAccessModes reports whether any property is readOnly or writeOnly.
+func hasAccessModes(schema *types.Schema) bool {
+	for _, prop := range schema.Properties {
+		if prop != nil && (prop.ReadOnly || prop.WriteOnly) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaVariant copies schema under a new title without the properties
+// drop reports.
+func schemaVariant(schema *types.Schema, title string, drop func(*types.Schema) bool) *types.Schema {
+	variant := *schema
+	variant.Title = title
+	variant.Properties = make(map[string]*types.Schema, len(schema.Properties))
+	variant.Required = nil
+
+	for name, prop := range schema.Properties {
+		if prop != nil && drop(prop) {
+			continue
+		}
+		variant.Properties[name] = prop
+	}
+	for _, name := range schema.Required {
+		if _, ok := variant.Properties[name]; ok {
+			variant.Required = append(variant.Required, name)
+		}
+	}
+	return &variant
+}
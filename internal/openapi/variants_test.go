@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func jsonContent(schema *types.Schema) map[string]types.MediaType {
+	return map[string]types.MediaType{"application/json": {Schema: schema}}
+}
+
+func variantRoutes() []types.Route {
+	user := &types.Schema{Ref: "#/components/schemas/User"}
+	return []types.Route{
+		{
+			Method:      "POST",
+			Path:        "/users",
+			RequestBody: &types.RequestBody{Content: jsonContent(user)},
+			Responses:   map[string]types.Response{"201": {Description: "Created", Content: jsonContent(user)}},
+		},
+		{
+			Method: "GET",
+			Path:   "/users",
+			Responses: map[string]types.Response{"200": {
+				Description: "OK",
+				Content:     jsonContent(&types.Schema{Type: "array", Items: user}),
+			}},
+		},
+	}
+}
+
+func variantSchemas() []types.Schema {
+	return []types.Schema{{
+		Title: "User",
+		Type:  "object",
+		Properties: map[string]*types.Schema{
+			"id":       {Type: "integer"},
+			"name":     {Type: "string"},
+			"password": {Type: "string"},
+		},
+		Required: []string{"id", "name", "password"},
+	}}
+}
+
+func TestBuilder_Build_SchemaVariants(t *testing.T) {
+	cfg := config.Default()
+	cfg.Generation.SchemaVariants = true
+
+	doc, err := NewBuilder(cfg).Build(variantRoutes(), variantSchemas())
+	require.NoError(t, err)
+
+	schemas := doc.Components.Schemas
+	assert.NotContains(t, schemas, "User")
+
+	create := schemas["UserCreate"]
+	require.NotNil(t, create)
+	assert.Equal(t, "UserCreate", create.Title)
+	assert.NotContains(t, create.Properties, "id")
+	assert.Contains(t, create.Properties, "password")
+	assert.Equal(t, []string{"name", "password"}, create.Required)
+
+	response := schemas["UserResponse"]
+	require.NotNil(t, response)
+	assert.Contains(t, response.Properties, "id")
+	assert.NotContains(t, response.Properties, "password")
+	assert.Equal(t, []string{"id", "name"}, response.Required)
+
+	post := doc.Paths["/users"].Post
+	assert.Equal(t, "#/components/schemas/UserCreate", post.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/UserResponse", post.Responses["201"].Content["application/json"].Schema.Ref)
+	list := doc.Paths["/users"].Get.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/UserResponse", list.Items.Ref)
+}
+
+func TestBuilder_Build_SchemaVariantsDisabled(t *testing.T) {
+	doc, err := NewBuilder(config.Default()).Build(variantRoutes(), variantSchemas())
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.Components.Schemas, "User")
+	assert.NotContains(t, doc.Components.Schemas, "UserCreate")
+}
+
+func TestSplitVariants(t *testing.T) {
+	t.Run("keeps referenced originals", func(t *testing.T) {
+		doc := &types.OpenAPI{
+			Paths: map[string]types.PathItem{
+				"/users": {Post: &types.Operation{
+					RequestBody: &types.RequestBody{Content: jsonContent(&types.Schema{Ref: "#/components/schemas/User"})},
+					Responses:   map[string]types.Response{"200": {Content: jsonContent(&types.Schema{Ref: "#/components/schemas/User"})}},
+				}},
+				"/teams": {Get: &types.Operation{
+					Responses: map[string]types.Response{"200": {Content: jsonContent(&types.Schema{Ref: "#/components/schemas/Team"})}},
+				}},
+			},
+			Components: &types.Components{Schemas: map[string]*types.Schema{
+				"User": {Type: "object", Properties: map[string]*types.Schema{
+					"id": {Type: "integer", ReadOnly: true},
+				}},
+				"Team": {Type: "object", Properties: map[string]*types.Schema{
+					"owner": {Ref: "#/components/schemas/User"},
+				}},
+			}},
+		}
+
+		SplitVariants(doc)
+		assert.Contains(t, doc.Components.Schemas, "User")
+		assert.Contains(t, doc.Components.Schemas, "UserCreate")
+		assert.Contains(t, doc.Components.Schemas, "UserResponse")
+	})
+
+	t.Run("skips existing variant names", func(t *testing.T) {
+		ref := &types.Schema{Ref: "#/components/schemas/User"}
+		doc := &types.OpenAPI{
+			Paths: map[string]types.PathItem{"/users": {Post: &types.Operation{
+				RequestBody: &types.RequestBody{Content: jsonContent(ref)},
+				Responses:   map[string]types.Response{"200": {Content: jsonContent(ref)}},
+			}}},
+			Components: &types.Components{Schemas: map[string]*types.Schema{
+				"User": {Type: "object", Properties: map[string]*types.Schema{
+					"id": {Type: "integer", ReadOnly: true},
+				}},
+				"UserCreate": {Type: "object"},
+			}},
+		}
+
+		SplitVariants(doc)
+		assert.Contains(t, doc.Components.Schemas, "User")
+		assert.NotContains(t, doc.Components.Schemas, "UserResponse")
+		assert.Equal(t, "#/components/schemas/User", ref.Ref)
+	})
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Visibilities a model sets with an api2spec:visibility directive in its
+// doc comment, or a @internal or @inline tag.
+const (
+	// VisibilityPublic publishes the schema as a component
+	VisibilityPublic = "public"
+
+	// VisibilityInternal flags the schema x-internal, so output profiles
+	// redact it
+	VisibilityInternal = "internal"
+
+	// VisibilityInline replaces references to the schema with copies of it
+	VisibilityInline = "inline"
+)
+
+// ExtOwner names the team owning a component schema, as set by an
+// api2spec:owner directive.
+const ExtOwner = "x-owner"
+
+// directiveLine matches an api2spec: directive line of a description, such
+// as api2spec:visibility internal or api2spec:owner payments.
+var directiveLine = regexp.MustCompile(`^api2spec:(visibility|owner)\s+(\S+)$`)
+
+// ApplyDirectives applies the api2spec: directives in the descriptions of
+// schema and its properties, and removes them from the descriptions:
+// internal visibility flags the schema or property x-internal and owner
+// sets x-owner. It returns the visibility of schema, public by default.
+func ApplyDirectives(schema *types.Schema) string {
+	visibility := VisibilityPublic
+	if schema == nil {
+		return visibility
+	}
+	description, directives := parseDirectives(schema.Description)
+	schema.Description = description
+	for _, directive := range directives {
+		switch directive[0] {
+		case "visibility":
+			visibility = directive[1]
+		case "owner":
+			setExtension(schema, ExtOwner, directive[1])
+		}
+	}
+	if visibility == VisibilityInternal {
+		setExtension(schema, ExtInternal, true)
+	}
+	for _, prop := range schema.Properties {
+		ApplyDirectives(prop)
+	}
+	return visibility
+}
+
+// SchemaVisibility returns the visibility the directives in the description
+// of schema set, public by default. Unlike ApplyDirectives it does not
+// modify schema.
+func SchemaVisibility(schema *types.Schema) string {
+	visibility := VisibilityPublic
+	_, directives := parseDirectives(schema.Description)
+	for _, directive := range directives {
+		if directive[0] == "visibility" {
+			visibility = directive[1]
+		}
+	}
+	return visibility
+}
+
+// parseDirectives returns description without its directive lines, and the
+// kind and value of each directive.
+func parseDirectives(description string) (string, [][2]string) {
+	if !strings.Contains(description, "api2spec:") {
+		return description, nil
+	}
+	var lines []string
+	var directives [][2]string
+	for _, line := range strings.Split(description, "\n") {
+		if match := directiveLine.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			directives = append(directives, [2]string{match[1], match[2]})
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), directives
+}
+
+func setExtension(schema *types.Schema, key string, value any) {
+	if schema.Extensions == nil {
+		schema.Extensions = make(types.Extensions)
+	}
+	schema.Extensions[key] = value
+}
+
+// InlineSchemas replaces the references to the named component schemas
+// with copies of them and removes the components. Schemas that reference
+// themselves, directly or through other schemas, stay components. It
+// returns the names of the inlined schemas.
+func InlineSchemas(doc *types.OpenAPI, names []string) []string {
+	schemas := componentSchemas(doc)
+	inline := make(map[string]*types.Schema, len(names))
+	for _, name := range names {
+		schema := schemas[name]
+		if schema == nil {
+			continue
+		}
+		r := &refCollector{schemas: schemas, used: make(map[string]bool)}
+		r.schema(schema)
+		if !r.used[name] {
+			inline[name] = schema
+		}
+	}
+	if len(inline) == 0 {
+		return nil
+	}
+
+	var inlined []string
+	for _, name := range names {
+		if inline[name] != nil {
+			delete(schemas, name)
+			inlined = append(inlined, name)
+		}
+	}
+	w := &schemaWalker{visit: func(_ string, schema *types.Schema) {
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok && inline[name] != nil {
+			*schema = *inline[name]
+		}
+	}}
+	w.document(doc)
+	return inlined
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplyDirectives(t *testing.T) {
+	schema := &types.Schema{
+		Type:        "object",
+		Description: "An audit trail entry.\napi2spec:visibility internal\napi2spec:owner platform",
+		Properties: map[string]*types.Schema{
+			"actor":  {Type: "string", Description: "Who acted."},
+			"secret": {Type: "string", Description: "api2spec:visibility internal"},
+		},
+	}
+
+	assert.Equal(t, VisibilityInternal, SchemaVisibility(schema))
+	assert.Equal(t, VisibilityInternal, ApplyDirectives(schema))
+	assert.Equal(t, "An audit trail entry.", schema.Description)
+	assert.Equal(t, types.Extensions{ExtInternal: true, ExtOwner: "platform"}, schema.Extensions)
+	assert.Empty(t, schema.Properties["secret"].Description)
+	assert.Equal(t, true, schema.Properties["secret"].Extensions[ExtInternal])
+	assert.Empty(t, schema.Properties["actor"].Extensions)
+
+	assert.Equal(t, VisibilityPublic, ApplyDirectives(&types.Schema{Description: "A user."}))
+}
+
+func TestInlineSchemas(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{
+				"application/json": {Schema: &types.Schema{Type: "array", Items: SchemaRef("User")}},
+			}}}}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":    {Type: "object", Properties: map[string]*types.Schema{"address": SchemaRef("Address")}},
+			"Address": {Type: "object", Properties: map[string]*types.Schema{"city": {Type: "string"}}},
+			"Node":    {Type: "object", Properties: map[string]*types.Schema{"children": {Type: "array", Items: SchemaRef("Node")}}},
+		}},
+	}
+
+	assert.Equal(t, []string{"Address"}, InlineSchemas(doc, []string{"Address", "Node"}))
+
+	assert.NotContains(t, doc.Components.Schemas, "Address")
+	// Self-referencing schemas cannot be inlined
+	assert.Contains(t, doc.Components.Schemas, "Node")
+	address := doc.Components.Schemas["User"].Properties["address"]
+	assert.Empty(t, address.Ref)
+	assert.Equal(t, "object", address.Type)
+	assert.Contains(t, address.Properties, "city")
+	assert.Empty(t, DanglingRefs(doc))
+}
+
+func TestRedact_InternalSchemas(t *testing.T) {
+	doc := &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{
+				"application/json": {Schema: SchemaRef("User")},
+			}}}}},
+			"/audit": {Get: &types.Operation{Responses: map[string]types.Response{"200": {Content: map[string]types.MediaType{
+				"application/json": {Schema: &types.Schema{Type: "array", Items: SchemaRef("AuditEntry")}},
+			}}}}},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User": {Type: "object", Properties: map[string]*types.Schema{
+				"name":    {Type: "string"},
+				"lastLog": SchemaRef("AuditEntry"),
+			}},
+			"AuditEntry": {Type: "object", Extensions: types.Extensions{ExtInternal: true}},
+		}},
+	}
+
+	redacted, removed, err := Redact(doc, RedactOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /audit"}, removed.Operations)
+	assert.Equal(t, []string{"User.lastLog"}, removed.Fields)
+	assert.Equal(t, []string{"AuditEntry"}, removed.Schemas)
+	assert.NotContains(t, redacted.Components.Schemas, "AuditEntry")
+
+	kept, removed, err := Redact(doc, RedactOptions{KeepFlagged: true})
+	require.NoError(t, err)
+	assert.Empty(t, removed.Operations)
+	assert.Contains(t, kept.Components.Schemas, "AuditEntry")
+}
@@ -123,8 +123,23 @@ func ReadFile(path string) (*types.OpenAPI, error) {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	ext := strings.ToLower(filepath.Ext(path))
+	doc, err := Parse(data, strings.ToLower(filepath.Ext(path)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Indexes written with partitions reference their path items
+	if err := joinPartitions(doc, filepath.Dir(path)); err != nil {
+		return nil, err
+	}
 
+	return doc, nil
+}
+
+// Parse decodes an OpenAPI document from data. ext is the extension of the
+// file it was read from, choosing the format; with any other value, YAML
+// and then JSON are tried.
+func Parse(data []byte, ext string) (*types.OpenAPI, error) {
 	var doc types.OpenAPI
 	switch ext {
 	case ".yaml", ".yml":
@@ -143,6 +158,5 @@ func ReadFile(path string) (*types.OpenAPI, error) {
 			}
 		}
 	}
-
 	return &doc, nil
 }
@@ -187,6 +187,73 @@ func TestWriter_ToJSON(t *testing.T) {
 	assert.Contains(t, output, `"title": "Test API"`)
 }
 
+func TestWriter_OperationExtensions(t *testing.T) {
+	writer := NewWriter()
+	doc := createTestDoc()
+	doc.Paths["/users"].Get.Extensions = types.Extensions{"x-sse": true}
+
+	yamlOut, err := writer.ToYAML(doc)
+	require.NoError(t, err)
+	assert.Contains(t, yamlOut, "x-sse: true")
+
+	jsonOut, err := writer.ToJSON(doc)
+	require.NoError(t, err)
+	assert.Contains(t, jsonOut, `"x-sse": true`)
+	assert.Less(t, strings.Index(jsonOut, `"summary"`), strings.Index(jsonOut, `"x-sse"`))
+
+	// Extensions survive a JSON round trip
+	path := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, writer.WriteFile(doc, path, "json"))
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, true, read.Paths["/users"].Get.Extensions["x-sse"])
+	assert.Equal(t, "List users", read.Paths["/users"].Get.Summary)
+}
+
+func TestWriter_DocumentExtensions(t *testing.T) {
+	writer := NewWriter()
+	doc := createTestDoc()
+	doc.Extensions = types.Extensions{"x-webhook-receivers": map[string]types.PathItem{
+		"/webhooks/stripe": {Post: &types.Operation{OperationID: "stripeWebhook"}},
+	}}
+
+	yamlOut, err := writer.ToYAML(doc)
+	require.NoError(t, err)
+	assert.Contains(t, yamlOut, "x-webhook-receivers:")
+
+	path := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, writer.WriteFile(doc, path, "json"))
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, read.Extensions, "x-webhook-receivers")
+	assert.Contains(t, read.Paths, "/users")
+}
+
+func TestWriter_SchemaExtensions(t *testing.T) {
+	writer := NewWriter()
+	doc := createTestDoc()
+	doc.Components = &types.Components{Schemas: map[string]*types.Schema{
+		"User": {
+			Type: "object",
+			Properties: map[string]*types.Schema{
+				"ssn": {Type: "string", Extensions: types.Extensions{"x-sensitive": true}},
+			},
+		},
+	}}
+
+	yamlOut, err := writer.ToYAML(doc)
+	require.NoError(t, err)
+	assert.Contains(t, yamlOut, "x-sensitive: true")
+
+	path := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, writer.WriteFile(doc, path, "json"))
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	ssn := read.Components.Schemas["User"].Properties["ssn"]
+	assert.Equal(t, true, ssn.Extensions["x-sensitive"])
+	assert.Equal(t, "string", ssn.Type)
+}
+
 func TestReadFile_YAML(t *testing.T) {
 	tmpDir := t.TempDir()
 	path := filepath.Join(tmpDir, "spec.yaml")
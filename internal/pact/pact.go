@@ -0,0 +1,410 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package pact verifies Pact consumer contracts against an OpenAPI document.
+package pact
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Contract is a Pact file: the interactions a consumer expects of a provider.
+type Contract struct {
+	// Path is the file the contract was loaded from
+	Path string
+
+	// Consumer is the consumer name
+	Consumer string
+
+	// Provider is the provider name
+	Provider string
+
+	// Interactions are the HTTP interactions the consumer relies on
+	Interactions []Interaction
+}
+
+// Interaction is a single request/response pair from a contract.
+type Interaction struct {
+	// Description describes the interaction
+	Description string
+
+	// Request is the request the consumer sends
+	Request Request
+
+	// Response is the response the consumer expects
+	Response Response
+}
+
+// Request is the consumer's request.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   any
+}
+
+// Response is the response the consumer expects.
+type Response struct {
+	Status int
+	Body   any
+}
+
+// Failure is an interaction the spec cannot satisfy.
+type Failure struct {
+	// Consumer is the consumer whose contract would break
+	Consumer string
+
+	// Interaction is the interaction description
+	Interaction string
+
+	// Request summarizes the request as "METHOD /path"
+	Request string
+
+	// Reason explains why the spec does not satisfy the interaction
+	Reason string
+}
+
+// pactFile mirrors the Pact v2/v3/v4 JSON layout.
+type pactFile struct {
+	Consumer     struct{ Name string } `json:"consumer"`
+	Provider     struct{ Name string } `json:"provider"`
+	Interactions []struct {
+		Type        string `json:"type"`
+		Description string `json:"description"`
+		Request     struct {
+			Method string          `json:"method"`
+			Path   string          `json:"path"`
+			Query  json.RawMessage `json:"query"`
+			Body   json.RawMessage `json:"body"`
+		} `json:"request"`
+		Response struct {
+			Status int             `json:"status"`
+			Body   json.RawMessage `json:"body"`
+		} `json:"response"`
+	} `json:"interactions"`
+}
+
+// Load reads contracts from files and directories. Directories are searched
+// (non-recursively) for *.json files.
+func Load(paths []string) ([]Contract, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pact path %s: %w", path, err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(matches)
+		files = append(files, matches...)
+	}
+
+	contracts := make([]Contract, 0, len(files))
+	for _, file := range files {
+		contract, err := LoadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, *contract)
+	}
+	return contracts, nil
+}
+
+// LoadFile reads a single Pact contract file.
+func LoadFile(path string) (*Contract, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pact %s: %w", path, err)
+	}
+
+	var pf pactFile
+	if err := json.Unmarshal(data, &pf); err != nil {
+		return nil, fmt.Errorf("failed to parse pact %s: %w", path, err)
+	}
+
+	contract := &Contract{
+		Path:     path,
+		Consumer: pf.Consumer.Name,
+		Provider: pf.Provider.Name,
+	}
+	for _, raw := range pf.Interactions {
+		// Pact v4 mixes HTTP with message interactions
+		if raw.Type != "" && !strings.Contains(strings.ToLower(raw.Type), "http") {
+			continue
+		}
+
+		query, err := parseQuery(raw.Request.Query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query in pact %s interaction %q: %w", path, raw.Description, err)
+		}
+		contract.Interactions = append(contract.Interactions, Interaction{
+			Description: raw.Description,
+			Request: Request{
+				Method: strings.ToUpper(raw.Request.Method),
+				Path:   raw.Request.Path,
+				Query:  query,
+				Body:   decodeBody(raw.Request.Body),
+			},
+			Response: Response{
+				Status: raw.Response.Status,
+				Body:   decodeBody(raw.Response.Body),
+			},
+		})
+	}
+	return contract, nil
+}
+
+// parseQuery accepts the v2 query string and the v3+ map of value lists.
+func parseQuery(raw json.RawMessage) (url.Values, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return url.ParseQuery(s)
+	}
+
+	var values map[string][]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	return url.Values(values), nil
+}
+
+// decodeBody decodes a JSON body, unwrapping Pact v4
+// {"content": ..., "contentType": ...} bodies.
+func decodeBody(raw json.RawMessage) any {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var body any
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil
+	}
+	if wrapper, ok := body.(map[string]any); ok {
+		if content, ok := wrapper["content"]; ok && len(wrapper) <= 3 {
+			if _, typed := wrapper["contentType"]; typed {
+				return content
+			}
+		}
+	}
+	return body
+}
+
+// Verify checks every interaction in contracts against doc and returns the
+// interactions the spec cannot satisfy.
+func Verify(doc *types.OpenAPI, contracts []Contract) []Failure {
+	matcher := newPathMatcher(doc)
+	var failures []Failure
+
+	for _, contract := range contracts {
+		for _, interaction := range contract.Interactions {
+			req := interaction.Request
+			fail := func(format string, args ...any) {
+				failures = append(failures, Failure{
+					Consumer:    contract.Consumer,
+					Interaction: interaction.Description,
+					Request:     req.Method + " " + req.Path,
+					Reason:      fmt.Sprintf(format, args...),
+				})
+			}
+
+			template, item, pathValues := matcher.match(req.Path)
+			if item == nil {
+				fail("no path in the spec matches %s", req.Path)
+				continue
+			}
+			op := operation(item, req.Method)
+			if op == nil {
+				fail("%s does not support %s", template, req.Method)
+				continue
+			}
+
+			v := &validator{components: doc.Components}
+			for _, reason := range v.parameters(op, item, pathValues, req.Query) {
+				fail("%s", reason)
+			}
+
+			if req.Body != nil {
+				schema := jsonSchema(op.RequestBody)
+				if op.RequestBody == nil {
+					fail("request body is not accepted by %s %s", req.Method, template)
+				} else if schema != nil {
+					for _, reason := range v.request(schema, req.Body, "body") {
+						fail("request %s", reason)
+					}
+				}
+			} else if op.RequestBody != nil && op.RequestBody.Required {
+				fail("request body is required by %s %s", req.Method, template)
+			}
+
+			status := strconv.Itoa(interaction.Response.Status)
+			resp, ok := lookupResponse(op.Responses, status)
+			if !ok {
+				fail("status %s is not documented for %s %s", status, req.Method, template)
+				continue
+			}
+			if interaction.Response.Body != nil {
+				if schema := jsonSchema(&types.RequestBody{Content: resp.Content}); schema != nil {
+					for _, reason := range v.response(schema, interaction.Response.Body, "body") {
+						fail("response %s", reason)
+					}
+				}
+			}
+		}
+	}
+	return failures
+}
+
+// BrokenConsumers returns the sorted, distinct consumers with failures.
+func BrokenConsumers(failures []Failure) []string {
+	seen := make(map[string]bool)
+	var consumers []string
+	for _, f := range failures {
+		if !seen[f.Consumer] {
+			seen[f.Consumer] = true
+			consumers = append(consumers, f.Consumer)
+		}
+	}
+	sort.Strings(consumers)
+	return consumers
+}
+
+// operation returns the operation for method on a path item.
+func operation(item *types.PathItem, method string) *types.Operation {
+	switch method {
+	case "GET":
+		return item.Get
+	case "PUT":
+		return item.Put
+	case "POST":
+		return item.Post
+	case "DELETE":
+		return item.Delete
+	case "OPTIONS":
+		return item.Options
+	case "HEAD":
+		return item.Head
+	case "PATCH":
+		return item.Patch
+	default:
+		return nil
+	}
+}
+
+// lookupResponse finds the response for status, falling back to the
+// NXX range and default responses.
+func lookupResponse(responses map[string]types.Response, status string) (types.Response, bool) {
+	if resp, ok := responses[status]; ok {
+		return resp, true
+	}
+	if len(status) == 3 {
+		if resp, ok := responses[status[:1]+"XX"]; ok {
+			return resp, true
+		}
+	}
+	resp, ok := responses["default"]
+	return resp, ok
+}
+
+// jsonSchema returns the JSON schema of a body, or nil if none is declared.
+func jsonSchema(body *types.RequestBody) *types.Schema {
+	if body == nil {
+		return nil
+	}
+	if mt, ok := body.Content["application/json"]; ok && mt.Schema != nil {
+		return mt.Schema
+	}
+	for mediaType, mt := range body.Content {
+		if strings.HasSuffix(mediaType, "+json") && mt.Schema != nil {
+			return mt.Schema
+		}
+	}
+	return nil
+}
+
+// pathMatcher matches concrete request paths to spec path templates.
+type pathMatcher struct {
+	entries []pathEntry
+}
+
+type pathEntry struct {
+	template string
+	item     types.PathItem
+	pattern  *regexp.Regexp
+	names    []string
+	literals int
+}
+
+var templateParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+func newPathMatcher(doc *types.OpenAPI) *pathMatcher {
+	m := &pathMatcher{}
+	for template, item := range doc.Paths {
+		var names []string
+		var pattern strings.Builder
+		pattern.WriteString("^")
+		last := 0
+		for _, loc := range templateParamRegex.FindAllStringSubmatchIndex(template, -1) {
+			pattern.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+			pattern.WriteString(`([^/]+)`)
+			names = append(names, template[loc[2]:loc[3]])
+			last = loc[1]
+		}
+		pattern.WriteString(regexp.QuoteMeta(template[last:]))
+		pattern.WriteString("/?$")
+		m.entries = append(m.entries, pathEntry{
+			template: template,
+			item:     item,
+			pattern:  regexp.MustCompile(pattern.String()),
+			names:    names,
+			literals: len(templateParamRegex.ReplaceAllString(template, "")),
+		})
+	}
+	// Prefer the most literal template (/users/me over /users/{id})
+	sort.Slice(m.entries, func(i, j int) bool {
+		if m.entries[i].literals != m.entries[j].literals {
+			return m.entries[i].literals > m.entries[j].literals
+		}
+		return m.entries[i].template < m.entries[j].template
+	})
+	return m
+}
+
+// match returns the template, path item, and path parameter values for path.
+func (m *pathMatcher) match(path string) (string, *types.PathItem, map[string]string) {
+	for i := range m.entries {
+		entry := &m.entries[i]
+		groups := entry.pattern.FindStringSubmatch(path)
+		if groups == nil {
+			continue
+		}
+		values := make(map[string]string, len(entry.names))
+		for j, name := range entry.names {
+			value, err := url.PathUnescape(groups[j+1])
+			if err != nil {
+				value = groups[j+1]
+			}
+			values[name] = value
+		}
+		return entry.template, &entry.item, values
+	}
+	return "", nil, nil
+}
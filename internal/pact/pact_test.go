@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package pact
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func testDoc() *types.OpenAPI {
+	userRef := &types.Schema{Ref: "#/components/schemas/User"}
+	return &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Paths: map[string]types.PathItem{
+			"/users": {
+				Get: &types.Operation{
+					Parameters: []types.Parameter{
+						{Name: "page", In: "query", Schema: &types.Schema{Type: "integer"}},
+						{Name: "tenant", In: "query", Required: true, Schema: &types.Schema{Type: "string"}},
+					},
+					Responses: map[string]types.Response{
+						"200": {Content: map[string]types.MediaType{
+							"application/json": {Schema: &types.Schema{Type: "array", Items: userRef}},
+						}},
+					},
+				},
+				Post: &types.Operation{
+					RequestBody: &types.RequestBody{
+						Required: true,
+						Content: map[string]types.MediaType{
+							"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/CreateUser"}},
+						},
+					},
+					Responses: map[string]types.Response{
+						"201": {Content: map[string]types.MediaType{
+							"application/json": {Schema: userRef},
+						}},
+						"4XX": {Description: "Client error"},
+					},
+				},
+			},
+			"/users/{id}": {
+				Parameters: []types.Parameter{
+					{Name: "id", In: "path", Required: true, Schema: &types.Schema{Type: "integer"}},
+				},
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: map[string]types.MediaType{
+							"application/json": {Schema: userRef},
+						}},
+						"404": {Description: "Not found"},
+					},
+				},
+			},
+			"/users/me": {
+				Get: &types.Operation{
+					Responses: map[string]types.Response{
+						"200": {Content: map[string]types.MediaType{
+							"application/json": {Schema: userRef},
+						}},
+					},
+				},
+			},
+		},
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User": {
+					Type: "object",
+					Properties: map[string]*types.Schema{
+						"id":     {Type: "integer"},
+						"name":   {Type: "string"},
+						"status": {Type: "string", Enum: []any{"active", "disabled"}},
+						"manager": {
+							AllOf: []*types.Schema{{Ref: "#/components/schemas/User"}},
+						},
+					},
+				},
+				"CreateUser": {
+					Type:     "object",
+					Required: []string{"name"},
+					Properties: map[string]*types.Schema{
+						"name":  {Type: "string"},
+						"email": {Type: "string", Nullable: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func writePact(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoad_Versions(t *testing.T) {
+	dir := t.TempDir()
+	writePact(t, dir, "web-users.json", `{
+  "consumer": {"name": "web"},
+  "provider": {"name": "users"},
+  "interactions": [{
+    "description": "list users",
+    "request": {"method": "get", "path": "/users", "query": "page=2&tenant=acme"},
+    "response": {"status": 200, "body": [{"id": 1, "name": "Ada"}]}
+  }],
+  "metadata": {"pactSpecification": {"version": "2.0.0"}}
+}`)
+	writePact(t, dir, "mobile-users.json", `{
+  "consumer": {"name": "mobile"},
+  "provider": {"name": "users"},
+  "interactions": [{
+    "type": "Synchronous/HTTP",
+    "description": "create user",
+    "request": {"method": "POST", "path": "/users", "query": {"tenant": ["acme"]},
+      "body": {"content": {"name": "Ada"}, "contentType": "application/json", "encoded": false}},
+    "response": {"status": 201}
+  }, {
+    "type": "Asynchronous/Messages",
+    "description": "user created event"
+  }]
+}`)
+	writePact(t, dir, "notes.txt", "ignored")
+
+	contracts, err := Load([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, contracts, 2)
+
+	mobile := contracts[0]
+	assert.Equal(t, "mobile", mobile.Consumer)
+	require.Len(t, mobile.Interactions, 1)
+	assert.Equal(t, "acme", mobile.Interactions[0].Request.Query.Get("tenant"))
+	assert.Equal(t, map[string]any{"name": "Ada"}, mobile.Interactions[0].Request.Body)
+
+	web := contracts[1]
+	assert.Equal(t, "web", web.Consumer)
+	assert.Equal(t, "users", web.Provider)
+	require.Len(t, web.Interactions, 1)
+	assert.Equal(t, "GET", web.Interactions[0].Request.Method)
+	assert.Equal(t, "2", web.Interactions[0].Request.Query.Get("page"))
+	assert.Equal(t, 200, web.Interactions[0].Response.Status)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, err := Load([]string{filepath.Join(t.TempDir(), "missing.json")})
+	assert.Error(t, err)
+
+	bad := writePact(t, t.TempDir(), "bad.json", "{")
+	_, err = Load([]string{bad})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse pact")
+}
+
+func TestVerify_Satisfied(t *testing.T) {
+	contracts := []Contract{{
+		Consumer: "web",
+		Interactions: []Interaction{
+			{
+				Description: "list users",
+				Request:     Request{Method: "GET", Path: "/users", Query: map[string][]string{"tenant": {"acme"}, "page": {"2"}}},
+				Response:    Response{Status: 200, Body: []any{map[string]any{"id": float64(1), "name": "Ada", "status": "active"}}},
+			},
+			{
+				Description: "create user",
+				Request:     Request{Method: "POST", Path: "/users", Body: map[string]any{"name": "Ada", "email": nil}},
+				Response:    Response{Status: 201, Body: map[string]any{"id": float64(7), "manager": map[string]any{"id": float64(1)}}},
+			},
+			{
+				Description: "invalid user",
+				Request:     Request{Method: "POST", Path: "/users", Body: map[string]any{"name": "x"}},
+				Response:    Response{Status: 422},
+			},
+			{
+				Description: "current user",
+				Request:     Request{Method: "GET", Path: "/users/me"},
+				Response:    Response{Status: 200, Body: map[string]any{"name": "Ada"}},
+			},
+			{
+				Description: "missing user",
+				Request:     Request{Method: "GET", Path: "/users/404"},
+				Response:    Response{Status: 404},
+			},
+		},
+	}}
+
+	failures := Verify(testDoc(), contracts)
+	assert.Empty(t, failures)
+	assert.Empty(t, BrokenConsumers(failures))
+}
+
+func TestVerify_Failures(t *testing.T) {
+	contracts := []Contract{
+		{
+			Consumer: "web",
+			Interactions: []Interaction{
+				{
+					Description: "orders",
+					Request:     Request{Method: "GET", Path: "/orders"},
+					Response:    Response{Status: 200},
+				},
+				{
+					Description: "delete user",
+					Request:     Request{Method: "DELETE", Path: "/users/1"},
+					Response:    Response{Status: 204},
+				},
+				{
+					Description: "user by slug",
+					Request:     Request{Method: "GET", Path: "/users/ada"},
+					Response:    Response{Status: 500},
+				},
+			},
+		},
+		{
+			Consumer: "mobile",
+			Interactions: []Interaction{
+				{
+					Description: "list without tenant",
+					Request:     Request{Method: "GET", Path: "/users", Query: map[string][]string{"page": {"two"}}},
+					Response:    Response{Status: 200, Body: []any{map[string]any{"id": "1", "avatar": "x.png", "status": "banned"}}},
+				},
+				{
+					Description: "create without name",
+					Request:     Request{Method: "POST", Path: "/users", Body: map[string]any{"email": "a@b.c"}},
+					Response:    Response{Status: 201},
+				},
+				{
+					Description: "create without body",
+					Request:     Request{Method: "POST", Path: "/users"},
+					Response:    Response{Status: 201},
+				},
+			},
+		},
+	}
+
+	failures := Verify(testDoc(), contracts)
+
+	reasons := make(map[string][]string)
+	for _, f := range failures {
+		reasons[f.Interaction] = append(reasons[f.Interaction], f.Reason)
+	}
+
+	assert.Equal(t, []string{"no path in the spec matches /orders"}, reasons["orders"])
+	assert.Equal(t, []string{"/users/{id} does not support DELETE"}, reasons["delete user"])
+	assert.Equal(t, []string{
+		`path parameter id="ada" is not a valid integer`,
+		"status 500 is not documented for GET /users/{id}",
+	}, reasons["user by slug"])
+	assert.Equal(t, []string{
+		`query parameter page="two" is not a valid integer`,
+		"required query parameter tenant is not sent",
+		"response body[0].avatar is not declared in the spec",
+		"response body[0].id is string but the spec declares integer",
+		"response body[0].status value banned is not one of the spec's enum values",
+	}, reasons["list without tenant"])
+	assert.Equal(t, []string{"request body is missing required property name"}, reasons["create without name"])
+	assert.Equal(t, []string{"request body is required by POST /users"}, reasons["create without body"])
+
+	assert.Equal(t, []string{"mobile", "web"}, BrokenConsumers(failures))
+	assert.Equal(t, "GET /users/ada", failures[2].Request)
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package pact
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// maxDepth bounds schema recursion for self-referencing components.
+const maxDepth = 32
+
+// validator checks contract values against spec schemas.
+type validator struct {
+	components *types.Components
+}
+
+// parameters checks path parameter types and that required query
+// parameters are sent.
+func (v *validator) parameters(op *types.Operation, item *types.PathItem, pathValues map[string]string, query url.Values) []string {
+	var reasons []string
+
+	params := append(append([]types.Parameter{}, item.Parameters...), op.Parameters...)
+	for _, param := range params {
+		switch param.In {
+		case "path":
+			value, ok := pathValues[param.Name]
+			if ok && !v.scalarMatches(param.Schema, value) {
+				reasons = append(reasons, fmt.Sprintf("path parameter %s=%q is not a valid %s", param.Name, value, v.resolve(param.Schema).Type))
+			}
+		case "query":
+			values, ok := query[param.Name]
+			if !ok {
+				if param.Required {
+					reasons = append(reasons, fmt.Sprintf("required query parameter %s is not sent", param.Name))
+				}
+				continue
+			}
+			for _, value := range values {
+				if !v.scalarMatches(param.Schema, value) {
+					reasons = append(reasons, fmt.Sprintf("query parameter %s=%q is not a valid %s", param.Name, value, v.resolve(param.Schema).Type))
+				}
+			}
+		}
+	}
+	return reasons
+}
+
+// scalarMatches reports whether a string parameter value fits the schema type.
+func (v *validator) scalarMatches(schema *types.Schema, value string) bool {
+	schema = v.resolve(schema)
+	if schema == nil {
+		return true
+	}
+	switch schema.Type {
+	case "integer":
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case "number":
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case "boolean":
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	}
+	return true
+}
+
+// request checks a value the consumer sends: required properties must be
+// present and types must match. Extra properties are tolerated unless the
+// schema sets additionalProperties: false.
+func (v *validator) request(schema *types.Schema, value any, path string) []string {
+	return v.check(schema, value, path, false, 0)
+}
+
+// response checks a value the consumer expects: every property it reads
+// must be declared by the spec with a matching type.
+func (v *validator) response(schema *types.Schema, value any, path string) []string {
+	return v.check(schema, value, path, true, 0)
+}
+
+func (v *validator) check(schema *types.Schema, value any, path string, expected bool, depth int) []string {
+	schema = v.resolve(schema)
+	if schema == nil || depth > maxDepth {
+		return nil
+	}
+
+	if alternatives := append(append([]*types.Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		for _, alt := range alternatives {
+			if len(v.check(alt, value, path, expected, depth+1)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%s does not match any of the %d alternatives in the spec", path, len(alternatives))}
+	}
+
+	if value == nil {
+		if schema.Nullable || schema.Type == "" || schema.Type == "null" {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s is null but the spec declares a non-nullable %s", path, schema.Type)}
+	}
+
+	if actual := jsonType(value); !typeMatches(schema.Type, value) {
+		return []string{fmt.Sprintf("%s is %s but the spec declares %s", path, actual, schema.Type)}
+	}
+
+	if len(schema.Enum) > 0 && !enumContains(schema.Enum, value) {
+		return []string{fmt.Sprintf("%s value %v is not one of the spec's enum values", path, value)}
+	}
+
+	var reasons []string
+	switch typed := value.(type) {
+	case []any:
+		if schema.Items != nil {
+			for i, item := range typed {
+				reasons = append(reasons, v.check(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), expected, depth+1)...)
+			}
+		}
+	case map[string]any:
+		if !expected {
+			for _, name := range schema.Required {
+				if _, ok := typed[name]; !ok {
+					reasons = append(reasons, fmt.Sprintf("%s is missing required property %s", path, name))
+				}
+			}
+		}
+
+		names := make([]string, 0, len(typed))
+		for name := range typed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			propPath := path + "." + name
+			if prop, ok := schema.Properties[name]; ok {
+				reasons = append(reasons, v.check(prop, typed[name], propPath, expected, depth+1)...)
+				continue
+			}
+			if schema.AdditionalProperties != nil && !schema.AdditionalProperties.IsFalse() {
+				reasons = append(reasons, v.check(schema.AdditionalProperties, typed[name], propPath, expected, depth+1)...)
+				continue
+			}
+			if (expected && len(schema.Properties) > 0) || schema.AdditionalProperties.IsFalse() {
+				reasons = append(reasons, fmt.Sprintf("%s is not declared in the spec", propPath))
+			}
+		}
+	}
+	return reasons
+}
+
+// resolve follows component references and flattens allOf into a single
+// object schema.
+func (v *validator) resolve(schema *types.Schema) *types.Schema {
+	for depth := 0; schema != nil && schema.Ref != "" && depth < maxDepth; depth++ {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		if v.components == nil || v.components.Schemas[name] == nil {
+			// Unresolvable references cannot be checked
+			return nil
+		}
+		schema = v.components.Schemas[name]
+	}
+	if schema == nil || len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := *schema
+	merged.AllOf = nil
+	merged.Properties = make(map[string]*types.Schema, len(schema.Properties))
+	for name, prop := range schema.Properties {
+		merged.Properties[name] = prop
+	}
+	for _, part := range schema.AllOf {
+		part = v.resolve(part)
+		if part == nil {
+			continue
+		}
+		if merged.Type == "" {
+			merged.Type = part.Type
+		}
+		for name, prop := range part.Properties {
+			merged.Properties[name] = prop
+		}
+		merged.Required = append(merged.Required, part.Required...)
+	}
+	return &merged
+}
+
+// jsonType names the JSON type of a decoded value.
+func jsonType(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "null"
+	}
+}
+
+// typeMatches reports whether value fits the declared schema type.
+func typeMatches(schemaType string, value any) bool {
+	actual := jsonType(value)
+	switch schemaType {
+	case "":
+		return true
+	case "number":
+		return actual == "number" || actual == "integer"
+	default:
+		return schemaType == actual
+	}
+}
+
+// enumContains reports whether value is one of the enum values.
+func enumContains(enum []any, value any) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import "strings"
+
+// visibilityTags map the JSDoc and PHPDoc tags that set the visibility of a
+// declaration to the equivalent api2spec: directive.
+var visibilityTags = map[string]string{
+	"@internal": "api2spec:visibility internal",
+	"@inline":   "api2spec:visibility inline",
+}
+
+// docCommentText returns the prose of a documentation comment: /** ... */
+// blocks, /// and //! line comments, // and # trailing comments. Comment
+// markers and leading asterisks are removed, and the text stops at the
+// first tag line (@param, @var, ...). Visibility tags (@internal) become
+// api2spec: directive lines at its end.
+func docCommentText(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "/*") {
+		raw = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(raw, "/*"), "*"), "*/")
+	}
+
+	var lines, directives []string
+	tags := false
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		for _, marker := range []string{"///", "//!", "//", "#", "*"} {
+			if strings.HasPrefix(line, marker) {
+				line = strings.TrimSpace(strings.TrimPrefix(line, marker))
+				break
+			}
+		}
+		if strings.HasPrefix(line, "@") {
+			tags = true
+			if directive, ok := visibilityTags[strings.Fields(line)[0]]; ok {
+				directives = append(directives, directive)
+			}
+		}
+		if !tags {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(append(lines, directives...), "\n"))
+}
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // CppParser provides C++ parsing capabilities using regex patterns.
@@ -151,7 +155,9 @@ var (
 
 // Parse parses C++ source code.
 func (p *CppParser) Parse(filename string, content []byte) *ParsedCppFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedCppFile{
 		Path:     filename,
 		Content:  src,
@@ -651,6 +657,11 @@ func CppTypeToOpenAPI(cppType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(cppType); ok {
+		return t, f
+	}
+
 	switch cppType {
 	case "std::string", "string", "char*", "const char*":
 		return "string", ""
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // CSharpParser provides C# parsing capabilities using regex patterns.
@@ -174,7 +178,9 @@ var (
 
 // Parse parses C# source code.
 func (p *CSharpParser) Parse(filename string, content []byte) *ParsedCSharpFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedCSharpFile{
 		Path:             filename,
 		Content:          src,
@@ -765,6 +771,11 @@ func CSharpTypeToOpenAPI(csType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(csType); ok {
+		return t, f
+	}
+
 	switch csType {
 	case "string", "String":
 		return "string", ""
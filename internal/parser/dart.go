@@ -0,0 +1,475 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package parser provides language-specific parsing capabilities.
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
+)
+
+// DartParser provides Dart parsing capabilities using regex patterns.
+type DartParser struct{}
+
+// NewDartParser creates a new Dart parser.
+func NewDartParser() *DartParser {
+	return &DartParser{}
+}
+
+// DartClass represents a Dart class definition.
+type DartClass struct {
+	// Name is the class name
+	Name string
+
+	// Annotations are the class annotations
+	Annotations []DartAnnotation
+
+	// Fields are the instance fields, or the named parameters of a
+	// freezed factory constructor
+	Fields []DartField
+
+	// Methods are the methods declared in the class body
+	Methods []DartFunction
+
+	// HasJSONMethods indicates if the class declares fromJson or toJson
+	HasJSONMethods bool
+
+	// Line is the source line number
+	Line int
+}
+
+// DartAnnotation represents an annotation such as @Route.get('/users').
+type DartAnnotation struct {
+	// Name is the annotation name, including any constructor name
+	Name string
+
+	// Arguments is the raw text between the parentheses
+	Arguments string
+}
+
+// DartField represents a class field.
+type DartField struct {
+	// Name is the field name
+	Name string
+
+	// Type is the field type without the nullable marker
+	Type string
+
+	// IsOptional indicates if the field is nullable (T?)
+	IsOptional bool
+
+	// JSONKey is the name from @JsonKey(name: ...), if any
+	JSONKey string
+
+	// Line is the source line number
+	Line int
+}
+
+// DartFunction represents a function or method definition.
+type DartFunction struct {
+	// Name is the function name
+	Name string
+
+	// ReturnType is the declared return type
+	ReturnType string
+
+	// Annotations are the function annotations
+	Annotations []DartAnnotation
+
+	// Parameters is the raw parameter list
+	Parameters string
+
+	// Body is the block or expression body
+	Body string
+
+	// Line is the source line number
+	Line int
+}
+
+// ParsedDartFile represents a parsed Dart source file.
+type ParsedDartFile struct {
+	// Path is the file path
+	Path string
+
+	// Content is the original source content
+	Content string
+
+	// Imports are the imported URIs
+	Imports []string
+
+	// Classes are the class definitions
+	Classes []DartClass
+
+	// Functions are the top-level functions
+	Functions []DartFunction
+}
+
+// Regex patterns for Dart parsing
+var (
+	// Matches import statements
+	dartImportRegex = regexp.MustCompile(`(?m)^\s*import\s+['"]([^'"]+)['"]`)
+
+	// Matches class declarations with their annotations
+	dartClassRegex = regexp.MustCompile(`(?m)^[ \t]*((?:@[\w.]+(?:\([^)]*\))?\s+)*)(?:(?:abstract|sealed|final|base|interface|mixin)\s+)*class\s+(\w+)[^{;]*\{`)
+
+	// Matches function and method declarations up to the opening parenthesis
+	dartFunctionRegex = regexp.MustCompile(`(?m)^[ \t]*((?:@[\w.]+(?:\([^)]*\))?\s+)*)(?:(?:static|external)\s+)?((?:[A-Z]\w*|void|int|double|num|bool|dynamic)(?:<[^;=(){}]*>)?\??)\s+(\w+)\s*\(`)
+
+	// Matches instance field declarations
+	dartFieldRegex = regexp.MustCompile(`(?m)^[ \t]*((?:@[\w.]+(?:\([^)]*\))?\s+)*)(?:late\s+)?(?:final\s+)?((?:[A-Z]\w*|int|double|num|bool|dynamic)(?:<[^;=(){}]*>)?\??)\s+(\w+)\s*(?:=[^;]*)?;`)
+
+	// Matches a freezed factory constructor: factory User({...}) = _User;
+	dartFreezedRegex = regexp.MustCompile(`factory\s+\w+\s*\(\s*\{`)
+
+	// Matches a named parameter of a freezed factory constructor
+	dartNamedParamRegex = regexp.MustCompile(`^((?:@[\w.]+(?:\([^)]*\))?\s+)*)(?:required\s+)?((?:@[\w.]+(?:\([^)]*\))?\s+)*)([\w<>?, ]+?)\s+(\w+)\s*(?:=.*)?$`)
+
+	// Matches a fromJson constructor or a toJson method
+	dartJSONMethodRegex = regexp.MustCompile(`\b\w+\.fromJson\s*\(\s*Map|\btoJson\s*\(\s*\)`)
+
+	// Matches a single annotation
+	dartAnnotationRegex = regexp.MustCompile(`@([\w.]+)(?:\(([^)]*)\))?`)
+
+	// Matches the name argument of @JsonKey
+	dartJSONKeyRegex = regexp.MustCompile(`@JsonKey\([^)]*name:\s*['"]([^'"]+)['"]`)
+)
+
+// Parse parses Dart source code.
+func (p *DartParser) Parse(filename string, content []byte) *ParsedDartFile {
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
+	pf := &ParsedDartFile{
+		Path:      filename,
+		Content:   src,
+		Imports:   []string{},
+		Classes:   []DartClass{},
+		Functions: []DartFunction{},
+	}
+
+	for _, match := range dartImportRegex.FindAllStringSubmatch(src, -1) {
+		pf.Imports = append(pf.Imports, match[1])
+	}
+
+	// Top-level declarations are found in a copy with nested bodies blanked
+	top := flattenDart(src)
+	for _, match := range dartClassRegex.FindAllStringSubmatchIndex(top, -1) {
+		open := match[1] - 1
+		end := dartMatchingBrace(src, open)
+		if end == -1 {
+			continue
+		}
+		class := DartClass{
+			Name:        src[match[4]:match[5]],
+			Annotations: parseDartAnnotations(src[match[2]:match[3]]),
+			Fields:      []DartField{},
+			Methods:     []DartFunction{},
+			Line:        countLines(src[:match[4]]),
+		}
+		p.parseClassBody(&class, src, open+1, end)
+		pf.Classes = append(pf.Classes, class)
+	}
+	pf.Functions = p.extractFunctions(src, top)
+
+	return pf
+}
+
+// parseClassBody extracts the members of the class body src[start:end].
+func (p *DartParser) parseClassBody(class *DartClass, src string, start, end int) {
+	body := src[start:end]
+	members := flattenDart(body)
+
+	class.HasJSONMethods = dartJSONMethodRegex.MatchString(members)
+	class.Methods = p.extractFunctions(src, strings.Repeat(" ", start)+members)
+
+	for _, match := range dartFieldRegex.FindAllStringSubmatchIndex(members, -1) {
+		annotations := body[match[2]:match[3]]
+		field := newDartField(body[match[6]:match[7]], body[match[4]:match[5]], annotations)
+		field.Line = countLines(src[:start+match[6]])
+		class.Fields = append(class.Fields, field)
+	}
+
+	// Freezed data classes declare their fields as factory parameters
+	if loc := dartFreezedRegex.FindStringIndex(members); loc != nil {
+		close := strings.Index(body[loc[1]:], "}")
+		if close != -1 {
+			for _, param := range splitDartParameters(body[loc[1] : loc[1]+close]) {
+				m := dartNamedParamRegex.FindStringSubmatch(param)
+				if m == nil {
+					continue
+				}
+				field := newDartField(m[4], m[3], m[1]+m[2])
+				field.Line = countLines(src[:start+loc[0]])
+				class.Fields = append(class.Fields, field)
+			}
+		}
+	}
+}
+
+// newDartField creates a field from its name, type and annotations.
+func newDartField(name, typeStr, annotations string) DartField {
+	typeStr = strings.TrimSpace(typeStr)
+	field := DartField{
+		Name:       name,
+		Type:       strings.TrimSuffix(typeStr, "?"),
+		IsOptional: strings.HasSuffix(typeStr, "?") || typeStr == "dynamic",
+	}
+	if m := dartJSONKeyRegex.FindStringSubmatch(annotations); m != nil {
+		field.JSONKey = m[1]
+	}
+	return field
+}
+
+// extractFunctions extracts the functions declared in flat, a copy of src
+// with nested bodies blanked.
+func (p *DartParser) extractFunctions(src, flat string) []DartFunction {
+	var functions []DartFunction
+	for _, match := range dartFunctionRegex.FindAllStringSubmatchIndex(flat, -1) {
+		open := match[1] - 1
+		closeParen := dartMatchingParen(src, open)
+		if closeParen == -1 {
+			continue
+		}
+		fn := DartFunction{
+			Name:        src[match[6]:match[7]],
+			ReturnType:  strings.TrimSpace(src[match[4]:match[5]]),
+			Annotations: parseDartAnnotations(src[match[2]:match[3]]),
+			Parameters:  src[open+1 : closeParen],
+			Line:        countLines(src[:match[6]]),
+		}
+		fn.Body = dartFunctionBody(src, closeParen+1)
+		if fn.Name != "if" && fn.Name != "for" && fn.Name != "while" && fn.Name != "switch" {
+			functions = append(functions, fn)
+		}
+	}
+	return functions
+}
+
+// dartFunctionBody returns the block or expression body following a
+// parameter list, or "" for abstract declarations.
+func dartFunctionBody(src string, pos int) string {
+	for i := pos; i < len(src); i++ {
+		switch src[i] {
+		case '{':
+			if end := dartMatchingBrace(src, i); end != -1 {
+				return src[i : end+1]
+			}
+			return ""
+		case '=':
+			if i+1 < len(src) && src[i+1] == '>' {
+				end := strings.Index(src[i:], ";")
+				if end == -1 {
+					return src[i:]
+				}
+				return src[i : i+end+1]
+			}
+		case ';':
+			return ""
+		}
+	}
+	return ""
+}
+
+// parseDartAnnotations parses a run of annotations.
+func parseDartAnnotations(src string) []DartAnnotation {
+	annotations := []DartAnnotation{}
+	for _, match := range dartAnnotationRegex.FindAllStringSubmatch(src, -1) {
+		annotations = append(annotations, DartAnnotation{Name: match[1], Arguments: match[2]})
+	}
+	return annotations
+}
+
+// splitDartParameters splits a parameter list on top-level commas.
+func splitDartParameters(src string) []string {
+	var params []string
+	depth := 0
+	start := 0
+	for i, ch := range src {
+		switch ch {
+		case '(', '<', '[', '{':
+			depth++
+		case ')', '>', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				params = append(params, strings.TrimSpace(src[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(src[start:]); last != "" {
+		params = append(params, last)
+	}
+	return params
+}
+
+// flattenDart returns a copy of src with the contents of every brace block
+// and string literal replaced by spaces, keeping offsets and line breaks, so
+// that declarations can be matched at the top level only.
+func flattenDart(src string) string {
+	out := []byte(src)
+	depth := 0
+	var quote byte
+	for i := 0; i < len(src); i++ {
+		ch := src[i]
+		if quote != 0 {
+			if ch == '\\' {
+				i++
+				continue
+			}
+			if ch == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch ch {
+		case '\'', '"':
+			quote = ch
+		case '/':
+			// Line comments may contain unbalanced braces and quotes
+			if i+1 < len(src) && src[i+1] == '/' {
+				end := strings.IndexByte(src[i:], '\n')
+				if end == -1 {
+					end = len(src) - i
+				}
+				for j := i; j < i+end; j++ {
+					out[j] = ' '
+				}
+				i += end - 1
+				continue
+			}
+		case '{':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case '}':
+			depth--
+			if depth == 0 {
+				continue
+			}
+		}
+		if depth > 0 && ch != '\n' {
+			out[i] = ' '
+		}
+	}
+	return string(out)
+}
+
+// dartMatchingBrace returns the index of the brace closing the one at pos.
+func dartMatchingBrace(src string, pos int) int {
+	return dartMatching(src, pos, '{', '}')
+}
+
+// dartMatchingParen returns the index of the parenthesis closing the one
+// at pos.
+func dartMatchingParen(src string, pos int) int {
+	return dartMatching(src, pos, '(', ')')
+}
+
+// dartMatching returns the index of the close delimiter matching the open
+// delimiter at pos, skipping string literals, or -1.
+func dartMatching(src string, pos int, open, close byte) int {
+	if pos < 0 || pos >= len(src) || src[pos] != open {
+		return -1
+	}
+	depth := 0
+	var quote byte
+	for i := pos; i < len(src); i++ {
+		ch := src[i]
+		if quote != 0 {
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch ch {
+		case '\'', '"':
+			quote = ch
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// IsSupported returns whether Dart parsing is supported.
+func (p *DartParser) IsSupported() bool {
+	return true
+}
+
+// SupportedExtensions returns the file extensions this parser handles.
+func (p *DartParser) SupportedExtensions() []string {
+	return []string{".dart"}
+}
+
+// HasAnnotation checks if the function has an annotation with the given name.
+func (f *DartFunction) HasAnnotation(name string) bool {
+	for _, a := range f.Annotations {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnnotation checks if the class has an annotation with the given name.
+func (c *DartClass) HasAnnotation(name string) bool {
+	for _, a := range c.Annotations {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DartTypeToOpenAPI converts a Dart type to an OpenAPI type.
+func DartTypeToOpenAPI(dartType string) (openAPIType string, format string) {
+	dartType = strings.TrimSuffix(strings.TrimSpace(dartType), "?")
+
+	if strings.HasPrefix(dartType, "List<") || strings.HasPrefix(dartType, "Set<") ||
+		strings.HasPrefix(dartType, "Iterable<") || dartType == "List" {
+		return "array", ""
+	}
+	if strings.HasPrefix(dartType, "Map<") || dartType == "Map" {
+		return "object", ""
+	}
+
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(dartType); ok {
+		return t, f
+	}
+
+	switch dartType {
+	case "String":
+		return "string", ""
+	case "int", "BigInt":
+		return "integer", ""
+	case "double":
+		return "number", "double"
+	case "num":
+		return "number", ""
+	case "bool":
+		return "boolean", ""
+	case "DateTime":
+		return "string", "date-time"
+	case "Uri":
+		return "string", "uri"
+	case "Uint8List":
+		return "string", "binary"
+	default:
+		return "object", ""
+	}
+}
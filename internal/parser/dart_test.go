@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dartSource = `
+import 'package:shelf/shelf.dart';
+import 'package:shelf_router/shelf_router.dart';
+
+part 'user_api.g.dart';
+
+class UserApi {
+  final UserRepository repo;
+
+  UserApi(this.repo);
+
+  @Route.get('/users/<id>')
+  Future<Response> getUser(Request request, String id) async {
+    final user = await repo.find(id);
+    if (user == null) {
+      return Response.notFound('no user {');
+    }
+    return Response.ok(jsonEncode(user));
+  }
+
+  @Route.post('/users')
+  Response create(Request request) => Response.ok('created');
+
+  Router get router => _$UserApiRouter(this);
+}
+
+@JsonSerializable()
+class User {
+  final String id;
+  @JsonKey(name: 'display_name')
+  final String displayName;
+  final int? age;
+  late List<String> tags;
+  static const table = 'users';
+
+  User(this.id, this.displayName, this.age);
+
+  factory User.fromJson(Map<String, dynamic> json) => _$UserFromJson(json);
+}
+
+@freezed
+class Order with _$Order {
+  const factory Order({
+    required String id,
+    @Default(1) int quantity,
+    double? price,
+  }) = _Order;
+}
+
+Response onRequest(RequestContext context) {
+  return Response(body: 'ok');
+}
+`
+
+func TestDartParser_Parse(t *testing.T) {
+	pf := NewDartParser().Parse("user_api.dart", []byte(dartSource))
+
+	assert.Equal(t, []string{"package:shelf/shelf.dart", "package:shelf_router/shelf_router.dart"}, pf.Imports)
+	require.Len(t, pf.Classes, 3)
+
+	api := pf.Classes[0]
+	assert.Equal(t, "UserApi", api.Name)
+	require.Len(t, api.Methods, 2)
+	get := api.Methods[0]
+	assert.Equal(t, "getUser", get.Name)
+	assert.Equal(t, "Future<Response>", get.ReturnType)
+	assert.Equal(t, "Request request, String id", get.Parameters)
+	assert.True(t, get.HasAnnotation("Route.get"))
+	assert.Equal(t, "'/users/<id>'", get.Annotations[0].Arguments)
+	assert.Equal(t, 13, get.Line)
+	assert.Contains(t, get.Body, "Response.ok(jsonEncode(user))")
+	assert.Equal(t, "=> Response.ok('created');", api.Methods[1].Body)
+
+	user := pf.Classes[1]
+	assert.True(t, user.HasAnnotation("JsonSerializable"))
+	assert.True(t, user.HasJSONMethods)
+	require.Len(t, user.Fields, 4)
+	assert.Equal(t, "displayName", user.Fields[1].Name)
+	assert.Equal(t, "display_name", user.Fields[1].JSONKey)
+	assert.Equal(t, "int", user.Fields[2].Type)
+	assert.True(t, user.Fields[2].IsOptional)
+	assert.Equal(t, "List<String>", user.Fields[3].Type)
+
+	order := pf.Classes[2]
+	require.Len(t, order.Fields, 3)
+	assert.Equal(t, "quantity", order.Fields[1].Name)
+	assert.Equal(t, "int", order.Fields[1].Type)
+	assert.True(t, order.Fields[2].IsOptional)
+
+	require.Len(t, pf.Functions, 1)
+	assert.Equal(t, "onRequest", pf.Functions[0].Name)
+	assert.Equal(t, "RequestContext context", pf.Functions[0].Parameters)
+}
+
+func TestDartTypeToOpenAPI(t *testing.T) {
+	tests := []struct {
+		dartType string
+		typ      string
+		format   string
+	}{
+		{"String", "string", ""},
+		{"int?", "integer", ""},
+		{"double", "number", "double"},
+		{"DateTime", "string", "date-time"},
+		{"List<User>", "array", ""},
+		{"Map<String, dynamic>", "object", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dartType, func(t *testing.T) {
+			typ, format := DartTypeToOpenAPI(tt.dartType)
+			assert.Equal(t, tt.typ, typ)
+			assert.Equal(t, tt.format, format)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultValue converts a default written as a source literal (e.g., 10,
+// 'draft', True, :active, []) to a value typed for the OpenAPI schemaType.
+// Expressions that are not constants, such as datetime.now or uuid4(), and
+// literals that do not fit schemaType report false. An empty schemaType
+// accepts any constant.
+func DefaultValue(literal, schemaType string) (any, bool) {
+	value, ok := literalValue(strings.TrimSpace(literal))
+	if !ok {
+		return nil, false
+	}
+	return coerceDefault(value, schemaType)
+}
+
+// TagDefault converts an unquoted default from a struct tag or annotation
+// (default:"10", @DefaultValue("draft")) to a value typed for schemaType.
+// String schemas take the value verbatim.
+func TagDefault(value, schemaType string) (any, bool) {
+	if schemaType == "string" {
+		return value, true
+	}
+	return DefaultValue(value, schemaType)
+}
+
+// literalValue parses a constant literal shared by the supported languages.
+func literalValue(s string) (any, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	if str, ok := unquoteLiteral(s); ok {
+		return str, true
+	}
+
+	switch strings.ToLower(s) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	case "none", "null", "nil", "undefined":
+		return nil, false
+	case "[]", "list()", "array()", "~w()", "~w[]":
+		return []any{}, true
+	case "{}", "dict()", "%{}":
+		return map[string]any{}, true
+	}
+
+	// Elixir atoms, e.g. an Ecto.Enum default of :draft
+	if len(s) > 1 && s[0] == ':' && isIdentifier(s[1:]) {
+		return s[1:], true
+	}
+
+	number := strings.ReplaceAll(s, "_", "")
+	if n, err := strconv.ParseInt(number, 10, 64); err == nil {
+		return n, true
+	}
+	if f, err := strconv.ParseFloat(number, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return f, true
+	}
+
+	// Lists of constants, e.g. ["a", "b"] or [1, 2]
+	if len(s) > 1 && s[0] == '[' && s[len(s)-1] == ']' {
+		var items []any
+		for _, item := range splitLiteralList(s[1 : len(s)-1]) {
+			value, ok := literalValue(item)
+			if !ok {
+				return nil, false
+			}
+			items = append(items, value)
+		}
+		return items, true
+	}
+
+	return nil, false
+}
+
+// coerceDefault checks that value fits schemaType, converting between
+// integer and number representations.
+func coerceDefault(value any, schemaType string) (any, bool) {
+	switch schemaType {
+	case "":
+		return value, true
+	case "string":
+		if _, ok := value.(string); ok {
+			return value, true
+		}
+		return nil, false
+	case "boolean":
+		if _, ok := value.(bool); ok {
+			return value, true
+		}
+		return nil, false
+	case "integer":
+		switch v := value.(type) {
+		case int64:
+			return v, true
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v), true
+			}
+		}
+		return nil, false
+	case "number":
+		switch v := value.(type) {
+		case int64:
+			return float64(v), true
+		case float64:
+			return v, true
+		}
+		return nil, false
+	case "array":
+		if _, ok := value.([]any); ok {
+			return value, true
+		}
+		return nil, false
+	case "object":
+		if _, ok := value.(map[string]any); ok {
+			return value, true
+		}
+		return nil, false
+	}
+	return value, true
+}
+
+// unquoteLiteral returns the contents of a single-, double- or backtick-quoted
+// string literal. Interpolated strings are not constants.
+func unquoteLiteral(s string) (string, bool) {
+	if len(s) < 2 {
+		return "", false
+	}
+	quote := s[0]
+	if (quote != '"' && quote != '\'' && quote != '`') || s[len(s)-1] != quote {
+		return "", false
+	}
+
+	inner := s[1 : len(s)-1]
+	if strings.Contains(inner, "${") || (quote == '"' && strings.Contains(inner, "#{")) {
+		return "", false
+	}
+	if quote == '`' {
+		return inner, true
+	}
+	// Triple-quoted Python strings
+	if strings.HasPrefix(s, `"""`) || strings.HasPrefix(s, `'''`) {
+		if len(s) < 6 {
+			return "", false
+		}
+		return s[3 : len(s)-3], true
+	}
+	if strings.ContainsRune(inner, rune(quote)) && !strings.Contains(inner, `\`+string(quote)) {
+		// Concatenations such as 'a' + 'b'
+		return "", false
+	}
+
+	replacer := strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\"`, `"`, `\n`, "\n", `\t`, "\t")
+	return replacer.Replace(inner), true
+}
+
+// splitLiteralList splits the items of a list literal at top-level commas.
+func splitLiteralList(s string) []string {
+	var items []string
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{' || c == '(':
+			depth++
+		case c == ']' || c == '}' || c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		items = append(items, last)
+	}
+	return items
+}
+
+// isIdentifier reports whether s is a non-empty run of letters, digits and
+// underscores.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
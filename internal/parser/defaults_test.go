@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultValue(t *testing.T) {
+	tests := []struct {
+		literal    string
+		schemaType string
+		expected   any
+		ok         bool
+	}{
+		{"10", "integer", int64(10), true},
+		{"1_000", "integer", int64(1000), true},
+		{"-5", "integer", int64(-5), true},
+		{"2.0", "integer", int64(2), true},
+		{"10", "number", float64(10), true},
+		{"0.5", "number", 0.5, true},
+		{"True", "boolean", true, true},
+		{"false", "boolean", false, true},
+		{"'draft'", "string", "draft", true},
+		{`"it\"s"`, "string", `it"s`, true},
+		{":active", "string", "active", true},
+		{"[]", "array", []any{}, true},
+		{"['a', 'b']", "array", []any{"a", "b"}, true},
+		{"%{}", "object", map[string]any{}, true},
+		{"None", "string", nil, false},
+		{"null", "", nil, false},
+		{"datetime.now", "string", nil, false},
+		{"uuid4()", "string", nil, false},
+		{"`${prefix}-x`", "string", nil, false},
+		{"'10'", "integer", nil, false},
+		{"10", "string", nil, false},
+		{"'a'", "", "a", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.literal+"/"+tt.schemaType, func(t *testing.T) {
+			value, ok := DefaultValue(tt.literal, tt.schemaType)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestTagDefault(t *testing.T) {
+	value, ok := TagDefault("draft", "string")
+	assert.True(t, ok)
+	assert.Equal(t, "draft", value)
+
+	value, ok = TagDefault("20", "integer")
+	assert.True(t, ok)
+	assert.Equal(t, int64(20), value)
+
+	_, ok = TagDefault("many", "integer")
+	assert.False(t, ok)
+}
+
+func TestPythonDefaultExpr(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"10", "10"},
+		{"Field(10, ge=1)", "10"},
+		{`Field(default="asc", description="order")`, `"asc"`},
+		{"Field(...)", ""},
+		{"Field(..., min_length=1)", ""},
+		{"Field(default_factory=list)", "[]"},
+		{"Query(None)", "None"},
+		{"Query(default=20, le=100)", "20"},
+		{"Field(description='x')", ""},
+		{"field(default=0)", "0"},
+		{"dataclasses.field(default_factory=dict)", "{}"},
+		{"attr.ib(factory=list)", "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PythonDefaultExpr(tt.expr))
+		})
+	}
+}
+
+func TestPythonHasDefault(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{"", false},
+		{"None", true},
+		{"Field(...)", false},
+		{"Field(description='x')", false},
+		{"Field(default=None)", true},
+		{"Field(default_factory=datetime.now)", true},
+		{"field(metadata={'a': 1})", false},
+		{"attrs.field(factory=list)", true},
+		{"attr.ib(0)", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PythonHasDefault(tt.expr))
+		})
+	}
+}
@@ -7,6 +7,9 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // ElixirParser provides Elixir parsing capabilities using regex patterns.
@@ -246,7 +249,9 @@ var (
 
 // Parse parses Elixir source code.
 func (p *ElixirParser) Parse(filename string, content []byte) *ParsedElixirFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedElixirFile{
 		Path:      filename,
 		Content:   src,
@@ -4,6 +4,7 @@
 package parser
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -191,3 +192,34 @@ end
 	assert.True(t, countField.HasDefault)
 	assert.Equal(t, "0", countField.Default)
 }
+
+func TestElixirParser_CRLF(t *testing.T) {
+	src := strings.ReplaceAll(`defmodule MyAppWeb.Router do
+  use Phoenix.Router
+
+  scope "/api", MyAppWeb do
+    get "/users", UserController, :index
+    post "/users", UserController, :create
+  end
+end
+
+defmodule MyApp.Post do
+  use Ecto.Schema
+
+  schema "posts" do
+    field :published, :boolean, default: false
+  end
+end
+`, "\n", "\r\n")
+
+	pf := NewElixirParser().Parse("router.ex", []byte(src))
+
+	require.Len(t, pf.Routes, 2)
+	assert.Equal(t, "/users", pf.Routes[0].Path)
+	assert.Equal(t, 5, pf.Routes[0].Line)
+	assert.Equal(t, 6, pf.Routes[1].Line)
+
+	require.Len(t, pf.Schemas, 1)
+	require.Len(t, pf.Schemas[0].Fields, 1)
+	assert.Equal(t, "false", pf.Schemas[0].Fields[0].Default)
+}
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // GleamParser provides Gleam parsing capabilities using regex patterns.
@@ -163,7 +167,9 @@ var (
 
 // Parse parses Gleam source code.
 func (p *GleamParser) Parse(filename string, content []byte) *ParsedGleamFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedGleamFile{
 		Path:    filename,
 		Content: src,
@@ -713,6 +719,11 @@ func GleamTypeToOpenAPI(gleamType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(gleamType); ok {
+		return t, f
+	}
+
 	switch gleamType {
 	case "String":
 		return "string", ""
@@ -13,6 +13,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // GoParser provides Go AST parsing capabilities.
@@ -74,6 +75,17 @@ type StructField struct {
 	// Description is from a doc comment
 	Description string
 
+	// ReadOnly and WriteOnly come from readonly:"true" and writeonly:"true" tags
+	ReadOnly  bool
+	WriteOnly bool
+
+	// Default is the value of a default:"..." tag
+	Default string
+
+	// Bindings maps the request binding tags of the field (form, query,
+	// uri, param, header) to the name they bind
+	Bindings map[string]string
+
 	// NestedStruct contains nested struct fields if TypeKind is KindStruct
 	NestedStruct []StructField
 
@@ -115,8 +127,11 @@ type StructDefinition struct {
 
 // ParseSource parses Go source code from a string.
 func (p *GoParser) ParseSource(filename, source string) (*ParsedFile, error) {
+	defer recordParse(filename, time.Now())
+
 	file, err := parser.ParseFile(p.fset, filename, source, parser.ParseComments)
 	if err != nil {
+		recordUnparsed(filename)
 		return nil, fmt.Errorf("failed to parse Go source: %w", err)
 	}
 
@@ -129,8 +144,11 @@ func (p *GoParser) ParseSource(filename, source string) (*ParsedFile, error) {
 
 // ParseFile parses a Go source file from disk.
 func (p *GoParser) ParseFile(path string) (*ParsedFile, error) {
+	defer recordParse(path, time.Now())
+
 	file, err := parser.ParseFile(p.fset, path, nil, parser.ParseComments)
 	if err != nil {
+		recordUnparsed(path)
 		return nil, fmt.Errorf("failed to parse Go file %s: %w", path, err)
 	}
 
@@ -290,8 +308,35 @@ func (sf *StructField) parseTag(tagValue string) {
 	if validateTag, ok := tag.Lookup("validate"); ok {
 		sf.parseValidateTag(validateTag)
 	}
+
+	// Parse swaggo-style access mode tags
+	sf.ReadOnly = tag.Get("readonly") == "true"
+	sf.WriteOnly = tag.Get("writeonly") == "true"
+
+	// Parse swaggo and creasty/defaults style default tags
+	sf.Default = tag.Get("default")
+
+	// Gin validates with the binding tag
+	if bindingTag, ok := tag.Lookup("binding"); ok {
+		sf.parseValidateTag(bindingTag)
+	}
+
+	// Parse Gin and Echo request binding tags
+	for _, key := range bindingTagKeys {
+		value, ok := tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		if sf.Bindings == nil {
+			sf.Bindings = make(map[string]string)
+		}
+		sf.Bindings[key] = strings.Split(value, ",")[0]
+	}
 }
 
+// bindingTagKeys are the struct tags that bind request parameters to fields.
+var bindingTagKeys = []string{"form", "query", "uri", "param", "header"}
+
 // parseValidateTag parses the validate struct tag.
 func (sf *StructField) parseValidateTag(tag string) {
 	parts := strings.Split(tag, ",")
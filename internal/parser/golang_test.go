@@ -377,6 +377,17 @@ func SetupRoutes(r chi.Router) {
 	assert.Len(t, getCalls, 2)
 }
 
+func TestStructField_ParseBindingTags(t *testing.T) {
+	sf := StructField{Name: "Page", ValidationTags: make(map[string]string)}
+	sf.parseTag("`form:\"page,default=1\" uri:\"-\" binding:\"required,min=1\"`")
+
+	assert.Equal(t, "page", sf.Bindings["form"])
+	assert.Equal(t, "-", sf.Bindings["uri"])
+	assert.NotContains(t, sf.Bindings, "query")
+	assert.True(t, sf.IsRequired)
+	assert.Equal(t, "1", sf.ValidationTags["min"])
+}
+
 func TestStructField_ParseValidateTag(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+// Grammar is a tree-sitter grammar compiled into the parsers.
+type Grammar struct {
+	// Language is the language the grammar parses
+	Language string
+
+	// Package is the import path of the grammar
+	Package string
+}
+
+// Grammars lists the tree-sitter grammars the parsers use; the other
+// languages are parsed with go/ast or regular expressions.
+var Grammars = []Grammar{
+	{Language: "python", Package: "github.com/smacker/go-tree-sitter/python"},
+	{Language: "rust", Package: "github.com/smacker/go-tree-sitter/rust"},
+	{Language: "swift", Package: "github.com/smacker/go-tree-sitter/swift"},
+	{Language: "typescript", Package: "github.com/smacker/go-tree-sitter/typescript/typescript"},
+}
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // HaskellParser provides Haskell parsing capabilities using regex patterns.
@@ -176,7 +180,9 @@ var (
 
 // Parse parses Haskell source code.
 func (p *HaskellParser) Parse(filename string, content []byte) *ParsedHaskellFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedHaskellFile{
 		Path:             filename,
 		Content:          src,
@@ -519,6 +525,11 @@ func HaskellTypeToOpenAPI(haskellType string) (openAPIType string, format string
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(haskellType); ok {
+		return t, f
+	}
+
 	switch haskellType {
 	case "Text", "String", "ByteString", "LazyText":
 		return "string", ""
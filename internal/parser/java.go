@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // JavaParser provides Java parsing capabilities using regex patterns.
@@ -155,8 +159,9 @@ var (
 	// Format: public record Name(Type1 field1, Type2 field2) {}
 	javaRecordRegex = regexp.MustCompile(`(?ms)((?:@\w+(?:\s*\([^)]*\))?\s*)*)\s*(public|private|protected)?\s*record\s+(\w+)\s*\(([^)]*)\)(?:\s+implements\s+([^{]+))?`)
 
-	// Matches method definitions with annotations
-	javaMethodRegex = regexp.MustCompile(`(?ms)((?:@\w+(?:\s*\([^)]*\))?\s*)*)\s*(public|private|protected)?\s*(?:static\s+)?(?:final\s+)?([\w<>,\s\[\]?]+)\s+(\w+)\s*\(([^)]*)\)`)
+	// Matches method definitions with annotations; parameters may carry
+	// annotations with arguments, such as @PathParam("id")
+	javaMethodRegex = regexp.MustCompile(`(?ms)((?:@\w+(?:\s*\([^)]*\))?\s*)*)\s*(public|private|protected)?\s*(?:static\s+)?(?:final\s+)?([\w<>,\s\[\]?]+)\s+(\w+)\s*\(((?:[^()]|\([^()]*\))*)\)`)
 
 	// Matches field definitions
 	javaFieldRegex = regexp.MustCompile(`(?m)((?:@\w+(?:\s*\([^)]*\))?\s*)*)\s*(public|private|protected)?\s*(static\s+)?(final\s+)?([\w<>,\s\[\]?]+)\s+(\w+)\s*(?:=\s*[^;]+)?;`)
@@ -164,7 +169,9 @@ var (
 
 // Parse parses Java source code.
 func (p *JavaParser) Parse(filename string, content []byte) *ParsedJavaFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedJavaFile{
 		Path:    filename,
 		Content: src,
@@ -604,22 +611,27 @@ func (p *JavaParser) extractParameters(src string) []JavaParameter {
 	return params
 }
 
-// splitJavaParameters splits a parameter string by comma, handling generics.
+// splitJavaParameters splits a parameter string by comma, handling generics
+// and annotation arguments.
 func splitJavaParameters(src string) []string {
 	var params []string
 	var current strings.Builder
 	depth := 0
+	inString := false
 
 	for _, ch := range src {
 		switch ch {
-		case '<':
+		case '"':
+			inString = !inString
+			current.WriteRune(ch)
+		case '<', '(':
 			depth++
 			current.WriteRune(ch)
-		case '>':
+		case '>', ')':
 			depth--
 			current.WriteRune(ch)
 		case ',':
-			if depth == 0 {
+			if depth == 0 && !inString {
 				params = append(params, current.String())
 				current.Reset()
 			} else {
@@ -714,6 +726,11 @@ func JavaTypeToOpenAPI(javaType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(javaType); ok {
+		return t, f
+	}
+
 	switch javaType {
 	case "String":
 		return "string", ""
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // KotlinParser provides Kotlin parsing capabilities using regex patterns.
@@ -152,7 +156,9 @@ var (
 
 // Parse parses Kotlin source code.
 func (p *KotlinParser) Parse(filename string, content []byte) *ParsedKotlinFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedKotlinFile{
 		Path:              filename,
 		Content:           src,
@@ -601,6 +607,11 @@ func KotlinTypeToOpenAPI(ktType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(ktType); ok {
+		return t, f
+	}
+
 	switch ktType {
 	case "String":
 		return "string", ""
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // PHPParser provides PHP parsing capabilities using regex patterns.
@@ -46,6 +50,16 @@ type PHPClass struct {
 	// Casts contains Eloquent $casts field type mappings
 	Casts map[string]string
 
+	// Hidden contains Eloquent $hidden field names, left out of serialization
+	Hidden []string
+
+	// Timestamps indicates the model maintains created_at and updated_at
+	Timestamps bool
+
+	// Attributes maps attribute names to their default literal from the
+	// Eloquent $attributes array
+	Attributes map[string]string
+
 	// Line is the source line number
 	Line int
 }
@@ -67,6 +81,15 @@ type PHPProperty struct {
 	// IsReadonly indicates if the property is readonly
 	IsReadonly bool
 
+	// DocType is the type from a preceding @var docblock (e.g., array<string, int>)
+	DocType string
+
+	// Default is the initializer expression (e.g., 'draft', 10, [])
+	Default string
+
+	// Description is from the docblock or a trailing comment
+	Description string
+
 	// Line is the source line number
 	Line int
 }
@@ -85,6 +108,9 @@ type PHPMethod struct {
 	// ReturnType is the return type
 	ReturnType string
 
+	// Body is the method body (between { and }), empty for abstract methods
+	Body string
+
 	// Line is the source line number
 	Line int
 }
@@ -207,7 +233,7 @@ var (
 
 	// Matches traditional property declarations (must end with ; to distinguish from constructor params)
 	// public string $name; or public ?string $name = 'default';
-	phpPropertyRegex = regexp.MustCompile(`(?m)(public|private|protected)\s+(?:(readonly)\s+)?(\??\w+(?:\s*\|\s*\w+)*)\s+\$(\w+)\s*(?:=[^;]+)?;`)
+	phpPropertyRegex = regexp.MustCompile(`(?m)(public|private|protected)\s+(?:(readonly)\s+)?(\??\w+(?:\s*\|\s*\w+)*)\s+\$(\w+)\s*(?:=\s*([^;]+))?;`)
 
 	// Matches Eloquent $fillable array
 	// protected $fillable = ['name', 'email', ...];
@@ -217,6 +243,25 @@ var (
 	// protected $casts = ['email_verified_at' => 'datetime', ...];
 	phpCastsRegex = regexp.MustCompile(`(?ms)\$casts\s*=\s*\[(.*?)\]`)
 
+	// Matches Eloquent $hidden array
+	// protected $hidden = ['password', 'remember_token'];
+	phpHiddenRegex = regexp.MustCompile(`(?ms)\$hidden\s*=\s*\[(.*?)\]`)
+
+	// Matches disabled Eloquent timestamps
+	// public $timestamps = false;
+	phpTimestampsOffRegex = regexp.MustCompile(`\$timestamps\s*=\s*false\b`)
+
+	// Matches Eloquent $attributes array
+	// protected $attributes = ['status' => 'draft'];
+	phpAttributesRegex = regexp.MustCompile(`(?ms)\$attributes\s*=\s*\[(.*?)\]\s*;`)
+
+	// Matches a constant 'key' => value pair in an array literal
+	phpLiteralPairRegex = regexp.MustCompile(`(?i)['"]([^'"]+)['"]\s*=>\s*('[^']*'|"[^"]*"|-?[\d.]+|true|false|null)`)
+
+	// Matches the type of a docblock @var tag, including generics
+	// /** @var array<string, int> */
+	phpVarDocRegex = regexp.MustCompile(`@var\s+([^\s<]+(?:<.*>)?)`)
+
 	// Matches Laravel route definitions
 	// Route::get('/path', [Controller::class, 'method'])
 	phpRouteRegex = regexp.MustCompile(`(?m)Route::(get|post|put|patch|delete|options|any)\s*\(\s*['"]([^'"]+)['"]\s*,\s*(?:\[\s*([^:]+)::class\s*,\s*['"](\w+)['"]\s*\]|['"]([^'"]+)['"])`)
@@ -235,7 +280,9 @@ var (
 
 // Parse parses PHP source code.
 func (p *PHPParser) Parse(filename string, content []byte) *ParsedPHPFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedPHPFile{
 		Path:           filename,
 		Content:        src,
@@ -341,6 +388,9 @@ func (p *PHPParser) extractClasses(src string) []PHPClass {
 			if class.IsEloquentModel {
 				class.Fillable = p.extractFillable(classBody)
 				class.Casts = p.extractCasts(classBody)
+				class.Hidden = extractQuotedList(phpHiddenRegex, classBody)
+				class.Timestamps = !phpTimestampsOffRegex.MatchString(classBody)
+				class.Attributes = extractAttributeDefaults(classBody)
 			}
 		}
 
@@ -414,6 +464,11 @@ func (p *PHPParser) extractMethods(body string, baseLineOffset int) []PHPMethod
 			method.ReturnType = body[match[8]:match[9]]
 		}
 
+		// Extract body, skipping abstract and interface methods
+		if rest := strings.TrimLeft(body[match[1]:], " \t\r\n"); strings.HasPrefix(rest, "{") {
+			method.Body = p.findClassBody(rest)
+		}
+
 		if method.Name != "" {
 			methods = append(methods, method)
 		}
@@ -549,6 +604,18 @@ func (p *PHPParser) extractProperties(body string, baseLineOffset int) []PHPProp
 			prop.Name = body[match[8]:match[9]]
 		}
 
+		// Extract default value (group 5)
+		if len(match) >= 12 && match[10] >= 0 && match[11] >= 0 {
+			prop.Default = strings.TrimSpace(body[match[10]:match[11]])
+		}
+
+		block := docBlock(body[:match[0]])
+		prop.DocType = docVarType(block)
+		prop.Description = docCommentText(block)
+		if prop.Description == "" {
+			prop.Description = trailingComment(body[match[1]:])
+		}
+
 		if prop.Name != "" {
 			props = append(props, prop)
 		}
@@ -557,6 +624,63 @@ func (p *PHPParser) extractProperties(body string, baseLineOffset int) []PHPProp
 	return props
 }
 
+// docBlock returns the docblock that ends src, if any.
+func docBlock(src string) string {
+	src = strings.TrimRight(src, " \t\r\n")
+	if !strings.HasSuffix(src, "*/") {
+		return ""
+	}
+	start := strings.LastIndex(src, "/**")
+	if start < 0 {
+		return ""
+	}
+	return src[start:]
+}
+
+// docVarType returns the @var type of a docblock, if any.
+func docVarType(block string) string {
+	if m := phpVarDocRegex.FindStringSubmatch(block); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// trailingComment returns the // or # comment on the first line of src,
+// the remainder of a statement's line.
+func trailingComment(src string) string {
+	line, _, _ := strings.Cut(src, "\n")
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "//") || strings.HasPrefix(line, "#") {
+		return docCommentText(line)
+	}
+	return ""
+}
+
+// PHPArrayValueType returns the value type of an associative array
+// docblock type such as array<string, int>. Lists (array<int>, int[]) are
+// not associative and return false.
+func PHPArrayValueType(phpType string) (string, bool) {
+	phpType = strings.TrimPrefix(strings.TrimSpace(phpType), "?")
+	if !strings.HasPrefix(phpType, "array<") || !strings.HasSuffix(phpType, ">") {
+		return "", false
+	}
+	args := phpType[len("array<") : len(phpType)-1]
+	depth := 0
+	for i, r := range args {
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				return strings.TrimSpace(args[i+1:]), true
+			}
+		}
+	}
+	return "", false
+}
+
 // extractPromotedProperties extracts constructor promoted properties from a method.
 // PHP 8+ allows: public function __construct(public string $name, private int $age)
 func (p *PHPParser) extractPromotedProperties(method PHPMethod, classLine int) []PHPProperty {
@@ -572,6 +696,7 @@ func (p *PHPParser) extractPromotedProperties(method PHPMethod, classLine int) [
 			Type:       param.Type,
 			Visibility: param.Visibility,
 			IsNullable: param.IsOptional,
+			Default:    param.Default,
 			Line:       method.Line,
 		}
 
@@ -609,9 +734,15 @@ func isEloquentModel(extends string) bool {
 
 // extractFillable extracts field names from Eloquent $fillable array.
 func (p *PHPParser) extractFillable(body string) []string {
+	return extractQuotedList(phpFillableRegex, body)
+}
+
+// extractQuotedList extracts the quoted strings of the array matched by re,
+// such as the field names of $fillable or $hidden.
+func extractQuotedList(re *regexp.Regexp, body string) []string {
 	var fields []string
 
-	match := phpFillableRegex.FindStringSubmatch(body)
+	match := re.FindStringSubmatch(body)
 	if len(match) < 2 {
 		return fields
 	}
@@ -661,6 +792,21 @@ func (p *PHPParser) extractCasts(body string) map[string]string {
 	return casts
 }
 
+// extractAttributeDefaults extracts the constant defaults of an Eloquent
+// $attributes array.
+func extractAttributeDefaults(body string) map[string]string {
+	match := phpAttributesRegex.FindStringSubmatch(body)
+	if match == nil {
+		return nil
+	}
+
+	attributes := make(map[string]string)
+	for _, m := range phpLiteralPairRegex.FindAllStringSubmatch(match[1], -1) {
+		attributes[m[1]] = m[2]
+	}
+	return attributes
+}
+
 // extractRoutes extracts Laravel route definitions.
 func (p *PHPParser) extractRoutes(src string) []PHPRoute {
 	var routes []PHPRoute
@@ -831,6 +977,11 @@ func PHPTypeToOpenAPI(phpType string) (openAPIType string, format string) {
 		return "array", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(phpType); ok {
+		return t, f
+	}
+
 	switch phpType {
 	case "string":
 		return "string", ""
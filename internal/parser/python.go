@@ -8,15 +8,23 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/python"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/pkg/types"
 )
 
 // PythonParser provides Python AST parsing capabilities using tree-sitter.
 type PythonParser struct {
 	parser *sitter.Parser
+
+	// walker enforces the traversal budget while a file is being parsed
+	walker *Walker
 }
 
 // NewPythonParser creates a new Python parser.
@@ -53,6 +61,9 @@ type ParsedPythonFile struct {
 
 	// Imports contains imported module names
 	Imports []PythonImport
+
+	// TypeAliases maps module-level type aliases to their annotation
+	TypeAliases map[string]string
 }
 
 // PythonDecoratedFunction represents a function with decorators.
@@ -97,6 +108,21 @@ type PythonDecorator struct {
 	Node *sitter.Node
 }
 
+// PythonCall represents a function call expression.
+type PythonCall struct {
+	// Name is the callee (e.g., "redirect", "flask.redirect")
+	Name string
+
+	// Arguments are the positional arguments, with string quotes removed
+	Arguments []string
+
+	// KeywordArguments are keyword arguments (e.g., code=301)
+	KeywordArguments map[string]string
+
+	// Line is the source line number
+	Line int
+}
+
 // PythonParameter represents a function parameter.
 type PythonParameter struct {
 	// Name is the parameter name
@@ -133,7 +159,8 @@ type PythonClass struct {
 	Node *sitter.Node
 }
 
-// PydanticModel represents a Pydantic model (BaseModel subclass).
+// PydanticModel represents a Pydantic model (BaseModel subclass), or a
+// dataclass or attrs class used the same way.
 type PydanticModel struct {
 	// Name is the model name
 	Name string
@@ -144,10 +171,22 @@ type PydanticModel struct {
 	// Line is the source line number
 	Line int
 
+	// File is the path of the declaring file
+	File string
+
 	// Node is the tree-sitter node
 	Node *sitter.Node
 }
 
+// Source returns where the model is declared, or nil when its file is
+// unknown.
+func (m PydanticModel) Source() *types.SourceLocation {
+	if m.File == "" {
+		return nil
+	}
+	return &types.SourceLocation{File: m.File, Line: m.Line, Type: m.Name}
+}
+
 // PydanticField represents a field in a Pydantic model.
 type PydanticField struct {
 	// Name is the field name
@@ -188,7 +227,15 @@ func (p *PythonParser) ParseSource(filename string, source string) (*ParsedPytho
 
 // Parse parses Python source code from bytes.
 func (p *PythonParser) Parse(filename string, content []byte) (*ParsedPythonFile, error) {
-	tree, err := p.parser.ParseCtx(context.Background(), nil, content)
+	return p.ParseContext(context.Background(), filename, content)
+}
+
+// ParseContext is like Parse, but abandons parsing and AST traversal once
+// ctx is done.
+func (p *PythonParser) ParseContext(ctx context.Context, filename string, content []byte) (*ParsedPythonFile, error) {
+	defer recordParse(filename, time.Now())
+
+	tree, err := parseWithin(ctx, p.parser, filename, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse Python: %w", err)
 	}
@@ -197,6 +244,9 @@ func (p *PythonParser) Parse(filename string, content []byte) (*ParsedPythonFile
 	if rootNode == nil {
 		return nil, fmt.Errorf("failed to get root node")
 	}
+	if rootNode.HasError() {
+		recordUnparsed(filename)
+	}
 
 	pf := &ParsedPythonFile{
 		Path:               filename,
@@ -209,11 +259,26 @@ func (p *PythonParser) Parse(filename string, content []byte) (*ParsedPythonFile
 		Imports:            []PythonImport{},
 	}
 
-	// Extract definitions
+	// Extract definitions within the file's traversal budget
+	p.walker = NewWalker(filename, DefaultBudget)
+	p.walker.ctx = ctx
+	defer func() {
+		p.walker.finish()
+		p.walker = nil
+	}()
+
 	pf.Imports = p.ExtractImports(rootNode, content)
 	pf.DecoratedFunctions = p.ExtractDecoratedFunctions(rootNode, content)
 	pf.Classes = p.ExtractClasses(rootNode, content)
 	pf.PydanticModels = p.ExtractPydanticModels(rootNode, content)
+	for i := range pf.PydanticModels {
+		pf.PydanticModels[i].File = filename
+	}
+	pf.TypeAliases = p.ExtractTypeAliases(rootNode, content)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	return pf, nil
 }
@@ -458,6 +523,52 @@ func (p *PythonParser) parseKeywordArgument(node *sitter.Node, content []byte) (
 	return key, value
 }
 
+// FindCalls returns the calls below node whose callee, without any module
+// qualifier, equals name: "redirect" matches both redirect() and flask.redirect().
+func (p *PythonParser) FindCalls(node *sitter.Node, content []byte, name string) []PythonCall {
+	var calls []PythonCall
+
+	p.walkNodes(node, func(n *sitter.Node) bool {
+		if n.Type() != "call" {
+			return true
+		}
+		fn := n.ChildByFieldName("function")
+		args := n.ChildByFieldName("arguments")
+		if fn == nil || args == nil {
+			return true
+		}
+
+		callee := fn.Content(content)
+		if callee != name && !strings.HasSuffix(callee, "."+name) {
+			return true
+		}
+
+		call := PythonCall{
+			Name:             callee,
+			KeywordArguments: make(map[string]string),
+			Line:             int(n.StartPoint().Row) + 1,
+		}
+		for i := 0; i < int(args.NamedChildCount()); i++ {
+			arg := args.NamedChild(i)
+			switch arg.Type() {
+			case "keyword_argument":
+				if key, value := p.parseKeywordArgument(arg, content); key != "" {
+					call.KeywordArguments[key] = value
+				}
+			case "string":
+				call.Arguments = append(call.Arguments, trimQuotes(arg.Content(content)))
+			case "comment":
+			default:
+				call.Arguments = append(call.Arguments, arg.Content(content))
+			}
+		}
+		calls = append(calls, call)
+		return true
+	})
+
+	return calls
+}
+
 // parseFunctionDef parses a function definition node.
 func (p *PythonParser) parseFunctionDef(node *sitter.Node, content []byte, fn *PythonDecoratedFunction) {
 	for i := 0; i < int(node.ChildCount()); i++ {
@@ -730,9 +841,9 @@ func (p *PythonParser) ExtractPydanticModels(rootNode *sitter.Node, content []by
 	// Start with direct BaseModel subclasses, then expand to include transitive inheritance
 	pydanticClasses := make(map[string]bool)
 
-	// First pass: find direct BaseModel subclasses
+	// First pass: find direct BaseModel subclasses and dataclasses
 	for _, cls := range classes {
-		if p.isDirectPydanticModel(cls) {
+		if p.isDirectPydanticModel(cls) || isDataclass(cls) {
 			pydanticClasses[cls.Name] = true
 		}
 	}
@@ -830,6 +941,27 @@ func (p *PythonParser) isDirectPydanticModel(cls PythonClass) bool {
 	return false
 }
 
+// dataclassDecorators are the class decorators of the standard library,
+// Pydantic, and attrs that generate fields from class annotations.
+var dataclassDecorators = map[string]bool{
+	"dataclass": true, "dataclasses.dataclass": true, "pydantic.dataclasses.dataclass": true,
+	"attr.s": true, "attr.attrs": true, "attr.dataclass": true,
+	"define": true, "frozen": true, "mutable": true,
+	"attr.define": true, "attr.frozen": true, "attr.mutable": true,
+	"attrs.define": true, "attrs.frozen": true, "attrs.mutable": true,
+}
+
+// isDataclass checks if a class is a dataclass or attrs class, which
+// FastAPI accepts as models like Pydantic ones.
+func isDataclass(cls PythonClass) bool {
+	for _, dec := range cls.Decorators {
+		if dataclassDecorators[dec.Name] {
+			return true
+		}
+	}
+	return false
+}
+
 // parsePydanticModel parses a Pydantic model from a class definition.
 func (p *PythonParser) parsePydanticModel(cls PythonClass, rootNode *sitter.Node, content []byte) *PydanticModel {
 	model := &PydanticModel{
@@ -858,13 +990,36 @@ func (p *PythonParser) parsePydanticModel(cls PythonClass, rootNode *sitter.Node
 // extractPydanticFields extracts fields from a Pydantic model class body.
 func (p *PythonParser) extractPydanticFields(node *sitter.Node, content []byte) []PydanticField {
 	var fields []PydanticField
+	var lastField *sitter.Node
 
 	p.walkNodes(node, func(n *sitter.Node) bool {
-		// Look for type annotations (field: type) or assignments (field: type = default)
-		if n.Type() == "expression_statement" {
+		switch n.Type() {
+		case "expression_statement":
+			// Look for type annotations (field: type) or assignments (field: type = default)
 			field := p.parseExpressionAsField(n, content)
+			if field != nil && isClassVariable(field.Type) {
+				return false
+			}
 			if field != nil {
 				fields = append(fields, *field)
+				lastField = n
+				return false
+			}
+
+			// An attribute docstring directly below the field documents it
+			if lastField != nil && n.NamedChildCount() == 1 && n.NamedChild(0).Type() == "string" &&
+				n.PrevNamedSibling() != nil && n.PrevNamedSibling().StartByte() == lastField.StartByte() {
+				if last := &fields[len(fields)-1]; last.Description == "" {
+					last.Description = strings.TrimSpace(trimQuotes(n.NamedChild(0).Content(content)))
+				}
+			}
+			return false
+		case "comment":
+			// A comment trailing the field on the same line documents it
+			if lastField != nil && n.StartPoint().Row == lastField.EndPoint().Row {
+				if last := &fields[len(fields)-1]; last.Description == "" {
+					last.Description = docCommentText(n.Content(content))
+				}
 			}
 			return false
 		}
@@ -874,6 +1029,20 @@ func (p *PythonParser) extractPydanticFields(node *sitter.Node, content []byte)
 	return fields
 }
 
+// isClassVariable reports whether an annotation declares a class variable
+// or init-only variable rather than a field.
+func isClassVariable(annotation string) bool {
+	for _, prefix := range []string{"ClassVar", "typing.ClassVar", "InitVar", "dataclasses.InitVar"} {
+		if annotation == prefix || strings.HasPrefix(annotation, prefix+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// pydanticDescriptionRegex matches the description keyword of Field(...).
+var pydanticDescriptionRegex = regexp.MustCompile(`\bdescription\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
 // parseExpressionAsField parses an expression statement as a Pydantic field.
 func (p *PythonParser) parseExpressionAsField(node *sitter.Node, content []byte) *PydanticField {
 	for i := 0; i < int(node.ChildCount()); i++ {
@@ -920,6 +1089,10 @@ func (p *PythonParser) parseAssignmentAsField(node *sitter.Node, content []byte)
 		return nil
 	}
 
+	if m := pydanticDescriptionRegex.FindStringSubmatch(field.Default); m != nil {
+		field.Description = m[1] + m[2]
+	}
+
 	return field
 }
 
@@ -1022,19 +1195,13 @@ func (p *PythonParser) ExtractStringLiteral(node *sitter.Node, content []byte) (
 }
 
 // walkNodes walks all nodes in the tree, calling fn for each node.
-// If fn returns false, it stops recursing into that node's children.
+// If fn returns false, it skips that node's children.
 func (p *PythonParser) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
+	if p.walker != nil {
+		p.walker.Walk(node, fn)
 		return
 	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	Walk(node, fn)
 }
 
 // WalkNodes is a public method for walking nodes.
@@ -1098,47 +1265,186 @@ func (pf *ParsedPythonFile) HasImportedName(name string) bool {
 
 // PythonTypeToOpenAPI converts a Python type to an OpenAPI type.
 func PythonTypeToOpenAPI(pyType string) (openAPIType string, format string) {
+	openAPIType, format, _ = pythonTypeToOpenAPI(pyType)
+	return openAPIType, format
+}
+
+// PythonTypeSchema converts a Python type to a schema, recording the type
+// as its Fallback when it could not be resolved (unknown generics, classes).
+func PythonTypeSchema(pyType string) *types.Schema {
+	openAPIType, format, resolved := pythonTypeToOpenAPI(pyType)
+	schema := &types.Schema{Type: openAPIType, Format: format}
+	if !resolved {
+		schema.Fallback = strings.TrimSpace(pyType)
+	}
+	return schema
+}
+
+// pythonTypeToOpenAPI converts a Python type to an OpenAPI type, reporting
+// whether it was resolved or assumed to be an object.
+func pythonTypeToOpenAPI(pyType string) (openAPIType string, format string, resolved bool) {
 	// Trim whitespace and handle Optional types
 	pyType = strings.TrimSpace(pyType)
 	pyType = strings.TrimPrefix(pyType, "Optional[")
 	pyType = strings.TrimSuffix(pyType, "]")
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(pyType); ok {
+		return t, f, true
+	}
+
 	switch pyType {
 	case "str", "string":
-		return "string", ""
+		return "string", "", true
 	case "int", "integer":
-		return "integer", ""
+		return "integer", "", true
 	case "float":
-		return "number", ""
+		return "number", "", true
 	case "bool", "boolean":
-		return "boolean", ""
+		return "boolean", "", true
 	case "datetime", "datetime.datetime":
-		return "string", "date-time"
+		return "string", "date-time", true
 	case "date", "datetime.date":
-		return "string", "date"
+		return "string", "date", true
 	case "time", "datetime.time":
-		return "string", "time"
+		return "string", "time", true
 	case "UUID", "uuid.UUID":
-		return "string", "uuid"
+		return "string", "uuid", true
 	case "bytes":
-		return "string", "binary"
+		return "string", "binary", true
 	case "Any", "any":
-		return "object", ""
+		return "object", "", true
 	case "None", "NoneType":
-		return "null", ""
+		return "null", "", true
 	case "dict", "Dict":
-		return "object", ""
+		return "object", "", true
 	default:
 		// Check for list types
 		if strings.HasPrefix(pyType, "list[") || strings.HasPrefix(pyType, "List[") {
-			return "array", ""
+			return "array", "", true
 		}
-		if strings.HasPrefix(pyType, "dict[") || strings.HasPrefix(pyType, "Dict[") {
-			return "object", ""
+		for _, prefix := range []string{"dict[", "Dict[", "Mapping[", "typing.Dict[", "typing.Mapping["} {
+			if strings.HasPrefix(pyType, prefix) {
+				return "object", "", true
+			}
 		}
 		// Assume it's a reference to another type/model
-		return "object", ""
+		return "object", "", false
+	}
+}
+
+// PythonDictValueType returns the value type of a dict[K, V], Dict[K, V]
+// or Mapping[K, V] annotation.
+func PythonDictValueType(pyType string) (string, bool) {
+	pyType = strings.TrimSpace(pyType)
+	for _, prefix := range []string{"dict[", "Dict[", "Mapping[", "typing.Dict[", "typing.Mapping["} {
+		if !strings.HasPrefix(pyType, prefix) || !strings.HasSuffix(pyType, "]") {
+			continue
+		}
+		args := pyType[len(prefix) : len(pyType)-1]
+		depth := 0
+		for i, r := range args {
+			switch r {
+			case '[', '(':
+				depth++
+			case ']', ')':
+				depth--
+			case ',':
+				if depth == 0 {
+					return strings.TrimSpace(args[i+1:]), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// pythonDefaultCallees declare a field or parameter with its default as the
+// first argument or the default keyword, e.g. Field(10, ge=1) or Query(default=None).
+var pythonDefaultCallees = []string{"Field", "Query", "Path", "Header", "Cookie", "Body", "Form",
+	"field", "dataclasses.field", "attr.ib", "attr.field", "attrs.field"}
+
+// PythonDefaultExpr returns the default expression of a parameter or field
+// default, unwrapping Pydantic and FastAPI declarations such as Field(10)
+// or Query(default="asc"), and dataclass and attrs fields such as
+// field(default=0). A default_factory of list or dict yields an empty
+// literal. Required declarations (Field(...)) return "".
+func PythonDefaultExpr(expr string) string {
+	expr = strings.TrimSpace(expr)
+	args, ok := pythonDeclarationArgs(expr)
+	if !ok {
+		return expr
+	}
+	for i, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok && isIdentifier(strings.TrimSpace(key)) {
+			switch strings.TrimSpace(key) {
+			case "default":
+				return pythonDefault(strings.TrimSpace(value))
+			case "default_factory", "factory":
+				switch strings.TrimSpace(value) {
+				case "list":
+					return "[]"
+				case "dict":
+					return "{}"
+				}
+			}
+			continue
+		}
+		if i == 0 {
+			return pythonDefault(arg)
+		}
+	}
+	return ""
+}
+
+// PythonHasDefault reports whether a field or parameter default makes the
+// value optional. Declarations without a default, such as Field(...),
+// Field(description="...") or field(metadata={...}), do not.
+func PythonHasDefault(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return false
+	}
+	args, ok := pythonDeclarationArgs(expr)
+	if !ok {
+		return true
+	}
+	for i, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok && isIdentifier(strings.TrimSpace(key)) {
+			switch strings.TrimSpace(key) {
+			case "default":
+				return pythonDefault(strings.TrimSpace(value)) != ""
+			case "default_factory", "factory":
+				return true
+			}
+			continue
+		}
+		if i == 0 {
+			return pythonDefault(arg) != ""
+		}
+	}
+	return false
+}
+
+// pythonDeclarationArgs returns the arguments of a Field(...), Query(...),
+// field(...) or similar declaration.
+func pythonDeclarationArgs(expr string) ([]string, bool) {
+	for _, callee := range pythonDefaultCallees {
+		for _, prefix := range []string{callee + "(", "fastapi." + callee + "(", "pydantic." + callee + "("} {
+			if strings.HasPrefix(expr, prefix) && strings.HasSuffix(expr, ")") {
+				return splitLiteralList(expr[len(prefix) : len(expr)-1]), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// pythonDefault maps the Ellipsis marker of required declarations to no default.
+func pythonDefault(expr string) string {
+	if expr == "..." || expr == "Ellipsis" {
+		return ""
 	}
+	return expr
 }
 
 // trimQuotes removes quotes from a string literal.
@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// PythonType is a type annotation reduced to what a schema needs once
+// aliases, NewType, Annotated, and Optional have been resolved.
+type PythonType struct {
+	// Type is the resolved annotation; for Literal it is the type of the
+	// values (str, int, float, bool) or "" when they are mixed
+	Type string
+
+	// Nullable is set by Optional[T], T | None, and Literal[..., None]
+	Nullable bool
+
+	// Literals are the values of a Literal[...] annotation
+	Literals []any
+
+	// Union are the members of a union of several non-None types
+	Union []string
+
+	// Metadata are the Annotated[T, ...] metadata expressions, e.g. Query()
+	Metadata []string
+}
+
+// typingPrefixes are the module prefixes typing constructs may carry.
+var typingPrefixes = []string{"typing.", "typing_extensions.", "t."}
+
+// typeAliasConstructs are the subscripted types whose module-level
+// assignment declares an implicit type alias.
+var typeAliasConstructs = map[string]bool{
+	"Annotated": true, "Literal": true, "Optional": true, "Union": true,
+	"List": true, "list": true, "Dict": true, "dict": true, "Set": true, "set": true,
+	"FrozenSet": true, "frozenset": true, "Tuple": true, "tuple": true,
+	"Sequence": true, "Mapping": true,
+}
+
+// ExtractTypeAliases returns the module-level type aliases of a file by
+// name: `type X = ...` statements, `X: TypeAlias = ...`, NewType("X", T),
+// and implicit aliases such as X = Literal["a", "b"] or X = int | None.
+func (p *PythonParser) ExtractTypeAliases(rootNode *sitter.Node, content []byte) map[string]string {
+	aliases := make(map[string]string)
+
+	for i := 0; i < int(rootNode.NamedChildCount()); i++ {
+		node := rootNode.NamedChild(i)
+		switch node.Type() {
+		case "type_alias_statement":
+			if node.NamedChildCount() == 2 {
+				aliases[node.NamedChild(0).Content(content)] = node.NamedChild(1).Content(content)
+			}
+		case "expression_statement":
+			if node.NamedChildCount() != 1 || node.NamedChild(0).Type() != "assignment" {
+				continue
+			}
+			assign := node.NamedChild(0)
+			left, right := assign.ChildByFieldName("left"), assign.ChildByFieldName("right")
+			if left == nil || right == nil || left.Type() != "identifier" {
+				continue
+			}
+			name := left.Content(content)
+			if annotation := assign.ChildByFieldName("type"); annotation != nil {
+				if typingName(annotation.Content(content)) == "TypeAlias" {
+					aliases[name] = right.Content(content)
+				}
+				continue
+			}
+			if target, ok := newTypeTarget(right, content); ok {
+				aliases[name] = target
+			} else if isTypeExpression(right, content) {
+				aliases[name] = right.Content(content)
+			}
+		}
+	}
+
+	return aliases
+}
+
+// newTypeTarget returns T of a NewType("Name", T) call.
+func newTypeTarget(node *sitter.Node, content []byte) (string, bool) {
+	if node.Type() != "call" {
+		return "", false
+	}
+	fn, args := node.ChildByFieldName("function"), node.ChildByFieldName("arguments")
+	if fn == nil || args == nil || typingName(fn.Content(content)) != "NewType" || args.NamedChildCount() != 2 {
+		return "", false
+	}
+	return args.NamedChild(1).Content(content), true
+}
+
+// isTypeExpression reports whether the right-hand side of an assignment is
+// a typing construct (Literal[...], list[int], ...) or a union of types.
+func isTypeExpression(node *sitter.Node, content []byte) bool {
+	switch node.Type() {
+	case "subscript":
+		value := node.ChildByFieldName("value")
+		return value != nil && typeAliasConstructs[typingName(value.Content(content))]
+	case "binary_operator":
+		op := node.ChildByFieldName("operator")
+		if op == nil || op.Type() != "|" {
+			return false
+		}
+		for _, member := range splitUnion(node.Content(content)) {
+			if _, ok := pythonBuiltinTypes[member]; !ok && member != "None" && !strings.Contains(member, "[") {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// pythonBuiltinTypes are the builtin scalar types accepted in implicit union
+// aliases.
+var pythonBuiltinTypes = map[string]struct{}{
+	"str": {}, "int": {}, "float": {}, "bool": {}, "bytes": {},
+	"list": {}, "dict": {}, "set": {}, "tuple": {},
+}
+
+// ResolvePythonType resolves an annotation through the given type aliases
+// and the typing wrappers that do not change the schema of a value.
+func ResolvePythonType(annotation string, aliases map[string]string) PythonType {
+	var t PythonType
+	current := strings.TrimSpace(annotation)
+
+	// Aliases may refer to each other; the bound guards against cycles
+	for depth := 0; depth < 16; depth++ {
+		if alias, ok := aliases[current]; ok {
+			current = strings.TrimSpace(alias)
+			continue
+		}
+
+		if members := splitUnion(current); len(members) > 1 {
+			current = t.union(members)
+			if current == "" {
+				return t
+			}
+			continue
+		}
+
+		name, args, ok := subscript(current)
+		if !ok {
+			break
+		}
+		switch typingName(name) {
+		case "Annotated":
+			if len(args) == 0 {
+				break
+			}
+			t.Metadata = append(t.Metadata, args[1:]...)
+			current = args[0]
+			continue
+		case "Optional":
+			if len(args) == 1 {
+				t.Nullable = true
+				current = args[0]
+				continue
+			}
+		case "Union":
+			current = t.union(args)
+			if current == "" {
+				return t
+			}
+			continue
+		case "Literal":
+			t.literals(args)
+			return t
+		}
+		break
+	}
+
+	t.Type = current
+	return t
+}
+
+// union records the members of a union, dropping None, and returns the only
+// remaining member or "" when several remain.
+func (t *PythonType) union(members []string) string {
+	var rest []string
+	for _, member := range members {
+		if member == "None" || member == "NoneType" {
+			t.Nullable = true
+			continue
+		}
+		rest = append(rest, member)
+	}
+	if len(rest) == 1 {
+		return rest[0]
+	}
+
+	// Unions of literals merge into one enumeration
+	merged := PythonType{Nullable: t.Nullable}
+	for _, member := range rest {
+		name, args, ok := subscript(member)
+		if !ok || typingName(name) != "Literal" {
+			merged.Literals = nil
+			break
+		}
+		merged.literals(args)
+	}
+	if len(merged.Literals) > 0 {
+		t.Type, t.Literals, t.Nullable = merged.Type, merged.Literals, merged.Nullable
+		return ""
+	}
+
+	t.Type = strings.Join(rest, " | ")
+	t.Union = rest
+	return ""
+}
+
+// literals records the values of Literal[...] arguments and their type.
+func (t *PythonType) literals(args []string) {
+	for _, arg := range args {
+		value, ok := literalValue(arg)
+		if !ok {
+			if arg == "None" {
+				t.Nullable = true
+			}
+			continue
+		}
+		t.Literals = append(t.Literals, value)
+	}
+
+	t.Type = ""
+	for i, value := range t.Literals {
+		var valueType string
+		switch value.(type) {
+		case string:
+			valueType = "str"
+		case int64:
+			valueType = "int"
+		case float64:
+			valueType = "float"
+		case bool:
+			valueType = "bool"
+		}
+		if i > 0 && valueType != t.Type {
+			t.Type = ""
+			return
+		}
+		t.Type = valueType
+	}
+}
+
+// subscript splits Name[args, ...] into the name and its arguments.
+func subscript(s string) (string, []string, bool) {
+	open := strings.Index(s, "[")
+	if open <= 0 || !strings.HasSuffix(s, "]") {
+		return "", nil, false
+	}
+	return strings.TrimSpace(s[:open]), splitLiteralList(s[open+1 : len(s)-1]), true
+}
+
+// splitUnion splits a PEP 604 union (X | Y) at top-level bars.
+func splitUnion(s string) []string {
+	var members []string
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			members = append(members, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(members, strings.TrimSpace(s[start:]))
+}
+
+// typingName strips the typing module prefix from a construct name.
+func typingName(name string) string {
+	name = strings.TrimSpace(name)
+	for _, prefix := range typingPrefixes {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			return rest
+		}
+	}
+	return name
+}
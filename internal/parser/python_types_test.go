@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPythonParser_ExtractTypeAliases(t *testing.T) {
+	source := `
+from typing import Annotated, Literal, NewType, TypeAlias
+
+UserId = NewType("UserId", int)
+Status = Literal["active", "blocked"]
+Tags: TypeAlias = list[str]
+MaybeInt = int | None
+type Score = Annotated[float, Field(ge=0)]
+LIMIT = 10
+FLAGS = READ | WRITE
+`
+	pf, err := NewPythonParser().ParseSource("types.py", source)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	assert.Equal(t, map[string]string{
+		"UserId":   "int",
+		"Status":   `Literal["active", "blocked"]`,
+		"Tags":     "list[str]",
+		"MaybeInt": "int | None",
+		"Score":    "Annotated[float, Field(ge=0)]",
+	}, pf.TypeAliases)
+}
+
+func TestResolvePythonType(t *testing.T) {
+	aliases := map[string]string{
+		"UserId": "int",
+		"Ref":    "UserId",
+		"Status": `Literal["active", "blocked"]`,
+		"Loop":   "Loop",
+	}
+
+	tests := []struct {
+		annotation string
+		want       PythonType
+	}{
+		{"str", PythonType{Type: "str"}},
+		{"Ref", PythonType{Type: "int"}},
+		{"Optional[UserId]", PythonType{Type: "int", Nullable: true}},
+		{"str | None", PythonType{Type: "str", Nullable: true}},
+		{"typing.Union[int, None]", PythonType{Type: "int", Nullable: true}},
+		{"Union[int, str]", PythonType{Type: "int | str", Union: []string{"int", "str"}}},
+		{`Annotated[str, Query(max_length=5)]`, PythonType{Type: "str", Metadata: []string{"Query(max_length=5)"}}},
+		{"Status", PythonType{Type: "str", Literals: []any{"active", "blocked"}}},
+		{"Literal[1, 2, None]", PythonType{Type: "int", Literals: []any{int64(1), int64(2)}, Nullable: true}},
+		{`Literal["a"] | Literal["b"]`, PythonType{Type: "str", Literals: []any{"a", "b"}}},
+		{`Literal["a", 1]`, PythonType{Literals: []any{"a", int64(1)}}},
+		{"list[UserId]", PythonType{Type: "list[UserId]"}},
+		{"Loop", PythonType{Type: "Loop"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.annotation, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolvePythonType(tt.annotation, aliases))
+		})
+	}
+}
+
+func TestPythonTypeSchema(t *testing.T) {
+	assert.Equal(t, "", PythonTypeSchema("Optional[int]").Fallback)
+	assert.Equal(t, "", PythonTypeSchema("dict[str, Any]").Fallback)
+
+	schema := PythonTypeSchema("OrderedDict[str, Money]")
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, "OrderedDict[str, Money]", schema.Fallback)
+}
@@ -7,6 +7,9 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // RubyParser provides Ruby parsing capabilities using regex patterns.
@@ -172,7 +175,9 @@ var (
 
 // Parse parses Ruby source code.
 func (p *RubyParser) Parse(filename string, content []byte) *ParsedRubyFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedRubyFile{
 		Path:       filename,
 		Content:    src,
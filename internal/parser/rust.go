@@ -10,14 +10,21 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/rust"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/pkg/types"
 )
 
 // RustParser provides Rust AST parsing capabilities using tree-sitter.
 type RustParser struct {
 	parser *sitter.Parser
+
+	// walker enforces the traversal budget while a file is being parsed
+	walker *Walker
 }
 
 // NewRustParser creates a new Rust parser.
@@ -49,6 +56,9 @@ type ParsedRustFile struct {
 	// Structs contains extracted struct definitions
 	Structs []RustStruct
 
+	// Enums contains extracted enum definitions
+	Enums []RustEnum
+
 	// ImplBlocks contains extracted impl blocks
 	ImplBlocks []RustImplBlock
 
@@ -133,13 +143,28 @@ type RustStruct struct {
 	// IsPublic indicates if the struct is public
 	IsPublic bool
 
+	// Description is from /// doc comments
+	Description string
+
 	// Line is the source line number
 	Line int
 
+	// File is the path of the declaring file
+	File string
+
 	// Node is the tree-sitter node
 	Node *sitter.Node
 }
 
+// Source returns where the struct is declared, or nil when its file is
+// unknown.
+func (s RustStruct) Source() *types.SourceLocation {
+	if s.File == "" {
+		return nil
+	}
+	return &types.SourceLocation{File: s.File, Line: s.Line, Type: s.Name}
+}
+
 // RustField represents a field in a struct.
 type RustField struct {
 	// Name is the field name
@@ -153,6 +178,57 @@ type RustField struct {
 
 	// IsPublic indicates if the field is public
 	IsPublic bool
+
+	// Description is from /// doc comments or a trailing // comment
+	Description string
+}
+
+// RustEnum represents an enum definition.
+type RustEnum struct {
+	// Name is the enum name
+	Name string
+
+	// Attributes are the enum attributes
+	Attributes []RustAttribute
+
+	// Variants are the enum variants
+	Variants []RustVariant
+
+	// IsPublic indicates if the enum is public
+	IsPublic bool
+
+	// Description is from /// doc comments
+	Description string
+
+	// Line is the source line number
+	Line int
+
+	// Node is the tree-sitter node
+	Node *sitter.Node
+}
+
+// RustVariant represents a variant of an enum.
+type RustVariant struct {
+	// Name is the variant name
+	Name string
+
+	// Attributes are variant attributes (like #[serde(rename = "...")])
+	Attributes []RustAttribute
+
+	// Fields are the fields of a struct variant (Variant { a: T })
+	Fields []RustField
+
+	// Types are the element types of a tuple variant (Variant(T, U))
+	Types []string
+
+	// Description is from /// doc comments
+	Description string
+}
+
+// HasDeriveAttribute checks if an enum has a specific derive attribute.
+func (e *RustEnum) HasDeriveAttribute(derive string) bool {
+	s := RustStruct{Attributes: e.Attributes}
+	return s.HasDeriveAttribute(derive)
 }
 
 // RustImplBlock represents an impl block.
@@ -207,7 +283,15 @@ func (p *RustParser) ParseSource(filename string, source string) (*ParsedRustFil
 
 // Parse parses Rust source code from bytes.
 func (p *RustParser) Parse(filename string, content []byte) (*ParsedRustFile, error) {
-	tree, err := p.parser.ParseCtx(context.Background(), nil, content)
+	return p.ParseContext(context.Background(), filename, content)
+}
+
+// ParseContext is like Parse, but abandons parsing and AST traversal once
+// ctx is done.
+func (p *RustParser) ParseContext(ctx context.Context, filename string, content []byte) (*ParsedRustFile, error) {
+	defer recordParse(filename, time.Now())
+
+	tree, err := parseWithin(ctx, p.parser, filename, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse Rust: %w", err)
 	}
@@ -216,6 +300,9 @@ func (p *RustParser) Parse(filename string, content []byte) (*ParsedRustFile, er
 	if rootNode == nil {
 		return nil, fmt.Errorf("failed to get root node")
 	}
+	if rootNode.HasError() {
+		recordUnparsed(filename)
+	}
 
 	pf := &ParsedRustFile{
 		Path:             filename,
@@ -224,18 +311,34 @@ func (p *RustParser) Parse(filename string, content []byte) (*ParsedRustFile, er
 		RootNode:         rootNode,
 		Functions:        []RustFunction{},
 		Structs:          []RustStruct{},
+		Enums:            []RustEnum{},
 		ImplBlocks:       []RustImplBlock{},
 		MacroInvocations: []RustMacroInvocation{},
 		Uses:             []RustUse{},
 	}
 
-	// Extract definitions
+	// Extract definitions within the file's traversal budget
+	p.walker = NewWalker(filename, DefaultBudget)
+	p.walker.ctx = ctx
+	defer func() {
+		p.walker.finish()
+		p.walker = nil
+	}()
+
 	pf.Uses = p.ExtractUses(rootNode, content)
 	pf.Functions = p.ExtractFunctions(rootNode, content)
 	pf.Structs = p.ExtractStructs(rootNode, content)
+	for i := range pf.Structs {
+		pf.Structs[i].File = filename
+	}
+	pf.Enums = p.ExtractEnums(rootNode, content)
 	pf.ImplBlocks = p.ExtractImplBlocks(rootNode, content)
 	pf.MacroInvocations = p.ExtractMacroInvocations(rootNode, content)
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	return pf, nil
 }
 
@@ -374,6 +477,39 @@ func (p *RustParser) collectAttributes(node *sitter.Node, content []byte) []Rust
 	return attrs
 }
 
+// rustDocComment returns the /// or /** */ doc comments above an item,
+// looking past its attributes.
+func rustDocComment(node *sitter.Node, content []byte) string {
+	var lines []string
+	for prev := node.PrevSibling(); prev != nil; prev = prev.PrevSibling() {
+		if prev.Type() == "attribute_item" {
+			continue
+		}
+		text := prev.Content(content)
+		isDoc := (prev.Type() == "line_comment" && strings.HasPrefix(text, "///") && !strings.HasPrefix(text, "////")) ||
+			(prev.Type() == "block_comment" && strings.HasPrefix(text, "/**"))
+		if !isDoc {
+			break
+		}
+		lines = append([]string{strings.TrimRight(text, "\n")}, lines...)
+	}
+	return docCommentText(strings.Join(lines, "\n"))
+}
+
+// rustTrailingComment returns a // comment following a field on its line.
+func rustTrailingComment(node *sitter.Node, content []byte) string {
+	next := node.NextSibling()
+	for next != nil && next.Type() == "," {
+		next = next.NextSibling()
+	}
+	if next != nil && next.Type() == "line_comment" && next.StartPoint().Row == node.EndPoint().Row {
+		if text := next.Content(content); !strings.HasPrefix(text, "///") {
+			return docCommentText(text)
+		}
+	}
+	return ""
+}
+
 // parseAttribute parses an attribute node.
 func (p *RustParser) parseAttribute(node *sitter.Node, content []byte) *RustAttribute {
 	attr := &RustAttribute{
@@ -503,6 +639,7 @@ func (p *RustParser) parseStruct(node *sitter.Node, content []byte) *RustStruct
 		attrs := p.collectAttributes(prevSibling, content)
 		s.Attributes = append(s.Attributes, attrs...)
 	}
+	s.Description = rustDocComment(node, content)
 
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
@@ -555,6 +692,10 @@ func (p *RustParser) parseField(node *sitter.Node, content []byte) *RustField {
 		attrs := p.collectAttributes(prevSibling, content)
 		field.Attributes = append(field.Attributes, attrs...)
 	}
+	field.Description = rustDocComment(node, content)
+	if field.Description == "" {
+		field.Description = rustTrailingComment(node, content)
+	}
 
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
@@ -580,6 +721,103 @@ func (p *RustParser) parseField(node *sitter.Node, content []byte) *RustField {
 	return field
 }
 
+// ExtractEnums extracts all enum definitions from the AST.
+func (p *RustParser) ExtractEnums(rootNode *sitter.Node, content []byte) []RustEnum {
+	var enums []RustEnum
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if node.Type() == "enum_item" {
+			e := p.parseEnum(node, content)
+			if e != nil {
+				enums = append(enums, *e)
+			}
+			return false
+		}
+		return true
+	})
+
+	return enums
+}
+
+// parseEnum parses an enum definition.
+func (p *RustParser) parseEnum(node *sitter.Node, content []byte) *RustEnum {
+	e := &RustEnum{
+		Line:       int(node.StartPoint().Row) + 1,
+		Attributes: []RustAttribute{},
+		Node:       node,
+	}
+
+	if prevSibling := node.PrevSibling(); prevSibling != nil {
+		e.Attributes = append(e.Attributes, p.collectAttributes(prevSibling, content)...)
+	}
+	e.Description = rustDocComment(node, content)
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch child.Type() {
+		case "visibility_modifier":
+			if child.Content(content) == "pub" {
+				e.IsPublic = true
+			}
+		case "type_identifier":
+			if e.Name == "" {
+				e.Name = child.Content(content)
+			}
+		case "enum_variant_list":
+			for j := 0; j < int(child.ChildCount()); j++ {
+				if variant := child.Child(j); variant.Type() == "enum_variant" {
+					if v := p.parseVariant(variant, content); v != nil {
+						e.Variants = append(e.Variants, *v)
+					}
+				}
+			}
+		}
+	}
+
+	if e.Name == "" {
+		return nil
+	}
+
+	return e
+}
+
+// parseVariant parses a single enum variant.
+func (p *RustParser) parseVariant(node *sitter.Node, content []byte) *RustVariant {
+	v := &RustVariant{
+		Attributes: []RustAttribute{},
+	}
+
+	if prevSibling := node.PrevSibling(); prevSibling != nil {
+		v.Attributes = append(v.Attributes, p.collectAttributes(prevSibling, content)...)
+	}
+	v.Description = rustDocComment(node, content)
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch child.Type() {
+		case "identifier":
+			if v.Name == "" {
+				v.Name = child.Content(content)
+			}
+		case "field_declaration_list":
+			v.Fields = p.parseFields(child, content)
+		case "ordered_field_declaration_list":
+			for j := 0; j < int(child.ChildCount()); j++ {
+				elem := child.Child(j)
+				if elem.IsNamed() && elem.Type() != "visibility_modifier" && elem.Type() != "attribute_item" {
+					v.Types = append(v.Types, elem.Content(content))
+				}
+			}
+		}
+	}
+
+	if v.Name == "" {
+		return nil
+	}
+
+	return v
+}
+
 // ExtractImplBlocks extracts all impl blocks from the AST.
 func (p *RustParser) ExtractImplBlocks(rootNode *sitter.Node, content []byte) []RustImplBlock {
 	var implBlocks []RustImplBlock
@@ -680,19 +918,13 @@ func (p *RustParser) parseMacroInvocation(node *sitter.Node, content []byte) *Ru
 }
 
 // walkNodes walks all nodes in the tree, calling fn for each node.
-// If fn returns false, it stops recursing into that node's children.
+// If fn returns false, it skips that node's children.
 func (p *RustParser) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
+	if p.walker != nil {
+		p.walker.Walk(node, fn)
 		return
 	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	Walk(node, fn)
 }
 
 // WalkNodes is a public method for walking nodes.
@@ -780,6 +1012,25 @@ func (f *RustField) GetSerdeRename() string {
 
 // RustTypeToOpenAPI converts a Rust type to an OpenAPI type.
 func RustTypeToOpenAPI(rustType string) (openAPIType string, format string) {
+	openAPIType, format, _ = rustTypeToOpenAPI(rustType)
+	return openAPIType, format
+}
+
+// RustTypeSchema converts a Rust type to a schema, recording the type as
+// its Fallback when it could not be resolved (custom types, unknown
+// generics).
+func RustTypeSchema(rustType string) *types.Schema {
+	openAPIType, format, resolved := rustTypeToOpenAPI(rustType)
+	schema := &types.Schema{Type: openAPIType, Format: format}
+	if !resolved {
+		schema.Fallback = strings.TrimSpace(rustType)
+	}
+	return schema
+}
+
+// rustTypeToOpenAPI converts a Rust type to an OpenAPI type, reporting
+// whether it was resolved or assumed to be an object.
+func rustTypeToOpenAPI(rustType string) (openAPIType string, format string, resolved bool) {
 	// Trim whitespace and handle reference types
 	rustType = strings.TrimSpace(rustType)
 	rustType = strings.TrimPrefix(rustType, "&")
@@ -788,41 +1039,46 @@ func RustTypeToOpenAPI(rustType string) (openAPIType string, format string) {
 	// Handle Option<T>
 	if strings.HasPrefix(rustType, "Option<") {
 		innerType := extractRustGenericType(rustType)
-		return RustTypeToOpenAPI(innerType)
+		return rustTypeToOpenAPI(innerType)
 	}
 
 	// Handle Vec<T>
 	if strings.HasPrefix(rustType, "Vec<") {
-		return "array", ""
+		return "array", "", true
 	}
 
 	// Handle HashMap, BTreeMap
 	if strings.HasPrefix(rustType, "HashMap<") || strings.HasPrefix(rustType, "BTreeMap<") {
-		return "object", ""
+		return "object", "", true
+	}
+
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(rustType); ok {
+		return t, f, true
 	}
 
 	switch rustType {
 	case "String", "&str", "str":
-		return "string", ""
+		return "string", "", true
 	case "i8", "i16", "i32", "i64", "isize":
-		return "integer", ""
+		return "integer", "", true
 	case "u8", "u16", "u32", "u64", "usize":
-		return "integer", ""
+		return "integer", "", true
 	case "f32", "f64":
-		return "number", ""
+		return "number", "", true
 	case "bool":
-		return "boolean", ""
+		return "boolean", "", true
 	case "Uuid", "uuid::Uuid":
-		return "string", "uuid"
+		return "string", "uuid", true
 	case "DateTime", "chrono::DateTime", "NaiveDateTime":
-		return "string", "date-time"
+		return "string", "date-time", true
 	case "NaiveDate":
-		return "string", "date"
+		return "string", "date", true
 	case "()":
-		return "", ""
+		return "", "", true
 	default:
 		// Assume it's a custom type/struct
-		return "object", ""
+		return "object", "", false
 	}
 }
 
@@ -0,0 +1,605 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// UtoipaPath is the operation metadata of a #[utoipa::path(...)] attribute.
+type UtoipaPath struct {
+	// Method is the lower-case HTTP method (get, post, ...)
+	Method string
+
+	// Path is the route path, in OpenAPI {param} syntax
+	Path string
+
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+
+	// Params are the parameters declared as ("name" = Type, In, ...) tuples
+	Params []UtoipaParam
+
+	// RequestBody is the request body type ([T] for arrays), and
+	// RequestContentType its media type when given
+	RequestBody        string
+	RequestContentType string
+
+	// Responses are the declared responses in declaration order
+	Responses []UtoipaResponse
+}
+
+// UtoipaParam is a parameter of a utoipa path operation.
+type UtoipaParam struct {
+	Name        string
+	Type        string
+	In          string // path, query, header, cookie, or "" when not given
+	Description string
+	Required    bool
+	Deprecated  bool
+}
+
+// UtoipaResponse is a response of a utoipa path operation.
+type UtoipaResponse struct {
+	// Status is the status code or range (e.g., 200, 4XX, default)
+	Status      string
+	Description string
+	Body        string
+	ContentType string
+}
+
+// ParseUtoipaPath reads a #[utoipa::path(...)] attribute. It reports false
+// for other attributes.
+func ParseUtoipaPath(attr RustAttribute) (*UtoipaPath, bool) {
+	if attr.Name != "utoipa::path" {
+		return nil, false
+	}
+
+	op := &UtoipaPath{}
+	for _, args := range attr.Arguments {
+		for _, item := range splitRustArgs(args) {
+			key, value := rustKeyValue(item)
+			switch {
+			case value == "" && isHTTPMethod(key):
+				op.Method = strings.ToLower(key)
+			case key == "path":
+				op.Path = rustString(value)
+			case key == "operation_id":
+				op.OperationID = rustString(value)
+			case key == "summary":
+				op.Summary = rustString(value)
+			case key == "description":
+				op.Description = rustString(value)
+			case key == "tag":
+				op.Tags = append(op.Tags, rustString(value))
+			case key == "tags":
+				for _, tag := range splitRustArgs(strings.Trim(value, "[]")) {
+					op.Tags = append(op.Tags, rustString(tag))
+				}
+			case key == "deprecated":
+				op.Deprecated = value == "" || value == "true"
+			case key == "request_body" && value != "":
+				op.RequestBody = value
+			}
+
+			if inner, ok := rustGroup(item, "request_body"); ok {
+				for _, arg := range splitRustArgs(inner) {
+					switch k, v := rustKeyValue(arg); k {
+					case "content":
+						op.RequestBody = v
+					case "content_type":
+						op.RequestContentType = rustString(v)
+					}
+				}
+			}
+			if inner, ok := rustGroup(item, "params"); ok {
+				for _, param := range splitRustArgs(inner) {
+					if p, ok := parseUtoipaParam(param); ok {
+						op.Params = append(op.Params, p)
+					}
+				}
+			}
+			if inner, ok := rustGroup(item, "responses"); ok {
+				for _, resp := range splitRustArgs(inner) {
+					if r, ok := parseUtoipaResponse(resp); ok {
+						op.Responses = append(op.Responses, r)
+					}
+				}
+			}
+		}
+	}
+
+	return op, true
+}
+
+// UtoipaPaths maps the names of the handler functions in files to the
+// operation metadata they declare with #[utoipa::path(...)].
+func (p *RustParser) UtoipaPaths(ctx context.Context, files []scanner.SourceFile) map[string]*UtoipaPath {
+	paths := make(map[string]*UtoipaPath)
+	for _, file := range files {
+		if file.Language != "rust" {
+			continue
+		}
+
+		pf, err := p.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, fn := range pf.Functions {
+			for _, attr := range fn.Attributes {
+				if op, ok := ParseUtoipaPath(attr); ok {
+					op.Deprecated = op.Deprecated || hasRustAttribute(fn.Attributes, "deprecated")
+					paths[fn.Name] = op
+				}
+			}
+		}
+
+		pf.Close()
+	}
+	return paths
+}
+
+// Apply overlays the operation metadata a handler declares with
+// #[utoipa::path(...)] on the route inferred from its signature. Declared
+// responses replace inferred ones.
+func (op *UtoipaPath) Apply(route *types.Route) {
+	if op.OperationID != "" {
+		route.OperationID = op.OperationID
+	}
+	if op.Summary != "" {
+		route.Summary = op.Summary
+	}
+	if op.Description != "" {
+		route.Description = op.Description
+	}
+	if len(op.Tags) > 0 {
+		route.Tags = op.Tags
+	}
+	if op.Deprecated {
+		route.Deprecated = true
+	}
+
+	for _, declared := range op.Params {
+		in := declared.In
+		if in == "" {
+			in = "query"
+			if strings.Contains(route.Path, "{"+declared.Name+"}") {
+				in = "path"
+			}
+		}
+		param := types.Parameter{
+			Name:        declared.Name,
+			In:          in,
+			Description: declared.Description,
+			Required:    declared.Required || in == "path" || (declared.Type != "" && !strings.HasPrefix(declared.Type, "Option<")),
+			Deprecated:  declared.Deprecated,
+			Schema:      RustRefSchema(declared.Type),
+		}
+
+		replaced := false
+		params := make([]types.Parameter, len(route.Parameters))
+		copy(params, route.Parameters)
+		for i := range params {
+			if params[i].Name == param.Name && params[i].In == param.In {
+				params[i] = param
+				replaced = true
+			}
+		}
+		if !replaced {
+			params = append(params, param)
+		}
+		route.Parameters = params
+	}
+
+	if op.RequestBody != "" {
+		contentType := op.RequestContentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		route.RequestBody = &types.RequestBody{
+			Required: !strings.HasPrefix(op.RequestBody, "Option<"),
+			Content: map[string]types.MediaType{
+				contentType: {Schema: RustRefSchema(op.RequestBody)},
+			},
+		}
+	}
+
+	if len(op.Responses) > 0 {
+		route.Responses = make(map[string]types.Response, len(op.Responses))
+		for _, declared := range op.Responses {
+			resp := types.Response{Description: declared.Description}
+			if resp.Description == "" {
+				code, _ := strconv.Atoi(declared.Status)
+				resp.Description = http.StatusText(code)
+			}
+			if declared.Body != "" {
+				contentType := declared.ContentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				resp.Content = map[string]types.MediaType{
+					contentType: {Schema: RustRefSchema(declared.Body)},
+				}
+			}
+			route.Responses[declared.Status] = resp
+		}
+	}
+}
+
+// hasRustAttribute reports whether attrs include an attribute named name.
+func hasRustAttribute(attrs []RustAttribute, name string) bool {
+	for _, attr := range attrs {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSchema reports whether the struct derives serde, utoipa (ToSchema) or
+// schemars (JsonSchema) traits and is documented as a component schema.
+func (s *RustStruct) IsSchema() bool {
+	return isSchemaDerive(s.HasDeriveAttribute)
+}
+
+// IsSchema reports whether the enum derives serde, utoipa (ToSchema) or
+// schemars (JsonSchema) traits and is documented as a component schema.
+func (e *RustEnum) IsSchema() bool {
+	return isSchemaDerive(e.HasDeriveAttribute)
+}
+
+// isSchemaDerive reports whether derives includes a trait that makes a type
+// a component schema.
+func isSchemaDerive(derives func(string) bool) bool {
+	return derives("Serialize") || derives("Deserialize") || derives("ToSchema") || derives("JsonSchema")
+}
+
+// parseUtoipaParam reads a ("name" = Type, Query, description = "...")
+// tuple. IntoParams types, which name no parameter, report false.
+func parseUtoipaParam(item string) (UtoipaParam, bool) {
+	item = strings.TrimSpace(item)
+	if !strings.HasPrefix(item, "(") || !strings.HasSuffix(item, ")") {
+		return UtoipaParam{}, false
+	}
+
+	var param UtoipaParam
+	for i, arg := range splitRustArgs(item[1 : len(item)-1]) {
+		key, value := rustKeyValue(arg)
+		if i == 0 {
+			param.Name = rustString(key)
+			param.Type = value
+			continue
+		}
+		switch key {
+		case "Path", "Query", "Header", "Cookie":
+			param.In = strings.ToLower(key)
+		case "description":
+			param.Description = rustString(value)
+		case "required":
+			param.Required = value == "" || value == "true"
+		case "deprecated":
+			param.Deprecated = value == "" || value == "true"
+		case "parameter_in":
+			param.In = strings.ToLower(value)
+		}
+	}
+	return param, param.Name != ""
+}
+
+// parseUtoipaResponse reads a (status = 200, description = "...", body = T)
+// tuple.
+func parseUtoipaResponse(item string) (UtoipaResponse, bool) {
+	item = strings.TrimSpace(item)
+	if !strings.HasPrefix(item, "(") || !strings.HasSuffix(item, ")") {
+		return UtoipaResponse{}, false
+	}
+
+	var resp UtoipaResponse
+	for _, arg := range splitRustArgs(item[1 : len(item)-1]) {
+		switch key, value := rustKeyValue(arg); key {
+		case "status":
+			resp.Status = utoipaStatus(value)
+		case "description":
+			resp.Description = rustString(value)
+		case "body", "content":
+			resp.Body = value
+		case "content_type":
+			resp.ContentType = rustString(value)
+		}
+	}
+	return resp, resp.Status != ""
+}
+
+// utoipaStatus normalizes a response status: 200, "4XX", StatusCode::OK,
+// NOT_FOUND or default.
+func utoipaStatus(value string) string {
+	value = rustString(value)
+	if i := strings.LastIndex(value, "::"); i >= 0 {
+		value = value[i+2:]
+	}
+	if value == "default" || value == "" {
+		return value
+	}
+	if _, err := strconv.Atoi(value); err == nil {
+		return value
+	}
+	if len(value) == 3 && strings.HasSuffix(strings.ToUpper(value), "XX") {
+		return strings.ToUpper(value)
+	}
+	for code := 100; code < 600; code++ {
+		text := http.StatusText(code)
+		if text != "" && strings.ToUpper(strings.ReplaceAll(strings.ReplaceAll(text, " ", "_"), "-", "_")) == value {
+			return strconv.Itoa(code)
+		}
+	}
+	return ""
+}
+
+// RustConstraints are the validation constraints of a Rust field declared
+// with schemars, validator, or utoipa #[schema(...)] attributes.
+type RustConstraints struct {
+	MinLength   *int
+	MaxLength   *int
+	Minimum     *float64
+	Maximum     *float64
+	Pattern     string
+	Format      string
+	Description string
+	Example     any
+	ReadOnly    bool
+	WriteOnly   bool
+	Deprecated  bool
+	Nullable    bool
+}
+
+// utoipaFormats maps utoipa KnownFormat variants to OpenAPI formats.
+var utoipaFormats = map[string]string{
+	"Int32": "int32", "Int64": "int64", "Float": "float", "Double": "double",
+	"Byte": "byte", "Binary": "binary", "Date": "date", "DateTime": "date-time",
+	"Password": "password", "Uuid": "uuid", "Ulid": "ulid", "Email": "email",
+	"Uri": "uri", "Url": "uri", "Hostname": "hostname", "Ipv4": "ipv4", "Ipv6": "ipv6",
+}
+
+// Constraints returns the validation constraints of the field.
+func (f *RustField) Constraints() RustConstraints {
+	var c RustConstraints
+	for _, attr := range f.Attributes {
+		switch attr.Name {
+		case "deprecated":
+			c.Deprecated = true
+		case "schemars", "validate", "schema":
+			for _, args := range attr.Arguments {
+				for _, item := range splitRustArgs(args) {
+					c.apply(item)
+				}
+			}
+		}
+	}
+	return c
+}
+
+// apply records one item of a schemars, validate, or schema attribute.
+func (c *RustConstraints) apply(item string) {
+	if inner, ok := rustGroup(item, "length"); ok {
+		for _, arg := range splitRustArgs(inner) {
+			key, value := rustKeyValue(arg)
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "min":
+				c.MinLength = &n
+			case "max":
+				c.MaxLength = &n
+			case "equal":
+				c.MinLength, c.MaxLength = &n, &n
+			}
+		}
+		return
+	}
+	if inner, ok := rustGroup(item, "range"); ok {
+		for _, arg := range splitRustArgs(inner) {
+			key, value := rustKeyValue(arg)
+			f, err := strconv.ParseFloat(strings.ReplaceAll(value, "_", ""), 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "min":
+				c.Minimum = &f
+			case "max":
+				c.Maximum = &f
+			}
+		}
+		return
+	}
+	if inner, ok := rustGroup(item, "regex"); ok {
+		for _, arg := range splitRustArgs(inner) {
+			if key, value := rustKeyValue(arg); key == "pattern" && isRustString(value) {
+				c.Pattern = rustString(value)
+			}
+		}
+		return
+	}
+
+	key, value := rustKeyValue(item)
+	switch key {
+	case "email":
+		c.Format = "email"
+	case "url":
+		c.Format = "uri"
+	case "regex", "pattern":
+		if isRustString(value) {
+			c.Pattern = rustString(value)
+		}
+	case "description":
+		c.Description = rustString(value)
+	case "min_length", "max_length":
+		if n, err := strconv.Atoi(value); err == nil {
+			if key == "min_length" {
+				c.MinLength = &n
+			} else {
+				c.MaxLength = &n
+			}
+		}
+	case "minimum", "maximum":
+		if f, err := strconv.ParseFloat(strings.ReplaceAll(value, "_", ""), 64); err == nil {
+			if key == "minimum" {
+				c.Minimum = &f
+			} else {
+				c.Maximum = &f
+			}
+		}
+	case "format":
+		if i := strings.LastIndex(value, "::"); i >= 0 {
+			value = value[i+2:]
+		}
+		if format, ok := utoipaFormats[value]; ok {
+			c.Format = format
+		} else if isRustString(value) {
+			c.Format = rustString(value)
+		}
+	case "example":
+		literal := value
+		if inner, ok := rustGroup(value, "json!"); ok {
+			literal = inner
+		}
+		if example, ok := DefaultValue(literal, ""); ok {
+			c.Example = example
+		}
+	case "read_only":
+		c.ReadOnly = value == "" || value == "true"
+	case "write_only":
+		c.WriteOnly = value == "" || value == "true"
+	case "deprecated":
+		c.Deprecated = value == "" || value == "true"
+	case "nullable":
+		c.Nullable = value == "" || value == "true"
+	}
+}
+
+// splitRustArgs splits attribute arguments at top-level commas, respecting
+// nested groups, generics, and string literals.
+func splitRustArgs(s string) []string {
+	var items []string
+	depth := 0
+	inString := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == '<' && i+1 < len(s) && s[i+1] != '=':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		case c == '>' && i > 0 && s[i-1] != '-' && s[i-1] != '=':
+			depth--
+		case c == ',' && depth == 0:
+			if item := strings.TrimSpace(s[start:i]); item != "" {
+				items = append(items, item)
+			}
+			start = i + 1
+		}
+	}
+	if item := strings.TrimSpace(s[start:]); item != "" {
+		items = append(items, item)
+	}
+	return items
+}
+
+// rustKeyValue splits key = value at the first top-level =. Items without
+// one return the whole item as key.
+func rustKeyValue(item string) (key, value string) {
+	depth := 0
+	inString := false
+	for i := 0; i < len(item); i++ {
+		c := item[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '(' || c == '[' || c == '{' || c == '<':
+			depth++
+		case c == ')' || c == ']' || c == '}' || c == '>':
+			depth--
+		case c == '=' && depth == 0 && (i+1 >= len(item) || item[i+1] != '='):
+			return strings.TrimSpace(item[:i]), strings.TrimSpace(item[i+1:])
+		}
+	}
+	return strings.TrimSpace(item), ""
+}
+
+// rustGroup returns the contents of name(...).
+func rustGroup(item, name string) (string, bool) {
+	item = strings.TrimSpace(item)
+	rest, ok := strings.CutPrefix(item, name)
+	if !ok {
+		return "", false
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return "", false
+	}
+	return rest[1 : len(rest)-1], true
+}
+
+// isRustString reports whether s is a string literal, plain or raw.
+func isRustString(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "r") {
+		s = strings.Trim(s[1:], "#")
+	}
+	return len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"'
+}
+
+// rustString returns the contents of a plain ("...") or raw (r"...",
+// r#"..."#) string literal, or s itself when it is not one.
+func rustString(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "r") && len(s) > 1 && (s[1] == '"' || s[1] == '#') {
+		raw := strings.Trim(s[1:], "#")
+		if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+			return raw[1 : len(raw)-1]
+		}
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strings.NewReplacer(`\"`, `"`, `\\`, `\`, `\n`, "\n").Replace(s[1 : len(s)-1])
+	}
+	return s
+}
+
+// isHTTPMethod reports whether s names an HTTP method in lower case.
+func isHTTPMethod(s string) bool {
+	switch s {
+	case "get", "post", "put", "delete", "patch", "head", "options", "trace":
+		return true
+	}
+	return false
+}
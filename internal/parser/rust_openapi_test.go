@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestParseUtoipaPath(t *testing.T) {
+	code := `
+#[utoipa::path(
+    get,
+    path = "/users/{id}",
+    operation_id = "fetchUser",
+    tag = "users",
+    params(
+        ("id" = u64, Path, description = "User id"),
+        ("verbose" = Option<bool>, Query, description = "Include details, e.g. roles"),
+        UserQuery
+    ),
+    request_body(content = UpdateUser, content_type = "application/json"),
+    responses(
+        (status = 200, description = "User found", body = User),
+        (status = NOT_FOUND, description = "No such user"),
+        (status = "5XX", body = [ApiError])
+    )
+)]
+async fn get_user() {}
+
+#[derive(Debug)]
+async fn other() {}
+`
+	pf, err := NewRustParser().Parse("handlers.rs", []byte(code))
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.Functions, 2)
+
+	var op *UtoipaPath
+	for _, attr := range pf.Functions[0].Attributes {
+		if parsed, ok := ParseUtoipaPath(attr); ok {
+			op = parsed
+		}
+	}
+	require.NotNil(t, op)
+
+	assert.Equal(t, "get", op.Method)
+	assert.Equal(t, "/users/{id}", op.Path)
+	assert.Equal(t, "fetchUser", op.OperationID)
+	assert.Equal(t, []string{"users"}, op.Tags)
+	assert.Equal(t, []UtoipaParam{
+		{Name: "id", Type: "u64", In: "path", Description: "User id"},
+		{Name: "verbose", Type: "Option<bool>", In: "query", Description: "Include details, e.g. roles"},
+	}, op.Params)
+	assert.Equal(t, "UpdateUser", op.RequestBody)
+	assert.Equal(t, "application/json", op.RequestContentType)
+	assert.Equal(t, []UtoipaResponse{
+		{Status: "200", Description: "User found", Body: "User"},
+		{Status: "404", Description: "No such user"},
+		{Status: "5XX", Body: "[ApiError]"},
+	}, op.Responses)
+
+	for _, attr := range pf.Functions[1].Attributes {
+		_, ok := ParseUtoipaPath(attr)
+		assert.False(t, ok)
+	}
+}
+
+func TestRustField_Constraints(t *testing.T) {
+	code := `
+#[derive(Deserialize, Validate, ToSchema)]
+pub struct Signup {
+    #[validate(length(min = 3, max = 32), regex(path = *NAME, pattern = "^[a-z]+$"))]
+    pub username: String,
+    #[validate(email)]
+    #[schema(example = "ada@example.com")]
+    pub email: String,
+    #[validate(range(min = 18, max = 150))]
+    pub age: u8,
+    #[schema(read_only, format = DateTime, description = "Creation time")]
+    pub created_at: String,
+    #[schemars(length(equal = 2))]
+    #[deprecated]
+    pub country: String,
+}
+`
+	pf, err := NewRustParser().Parse("models.rs", []byte(code))
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.Structs, 1)
+
+	constraints := make(map[string]RustConstraints)
+	for _, field := range pf.Structs[0].Fields {
+		constraints[field.Name] = field.Constraints()
+	}
+
+	username := constraints["username"]
+	require.NotNil(t, username.MinLength)
+	require.NotNil(t, username.MaxLength)
+	assert.Equal(t, 3, *username.MinLength)
+	assert.Equal(t, 32, *username.MaxLength)
+	assert.Equal(t, "^[a-z]+$", username.Pattern)
+
+	assert.Equal(t, "email", constraints["email"].Format)
+	assert.Equal(t, "ada@example.com", constraints["email"].Example)
+
+	age := constraints["age"]
+	require.NotNil(t, age.Minimum)
+	require.NotNil(t, age.Maximum)
+	assert.Equal(t, 18.0, *age.Minimum)
+	assert.Equal(t, 150.0, *age.Maximum)
+
+	createdAt := constraints["created_at"]
+	assert.True(t, createdAt.ReadOnly)
+	assert.Equal(t, "date-time", createdAt.Format)
+	assert.Equal(t, "Creation time", createdAt.Description)
+
+	country := constraints["country"]
+	require.NotNil(t, country.MinLength)
+	assert.Equal(t, 2, *country.MinLength)
+	assert.Equal(t, 2, *country.MaxLength)
+	assert.True(t, country.Deprecated)
+}
+
+func TestRustTypeSchema(t *testing.T) {
+	assert.Equal(t, "", RustTypeSchema("Option<Vec<String>>").Fallback)
+
+	schema := RustTypeSchema("Decimal")
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, "Decimal", schema.Fallback)
+}
+
+func TestRustParser_UtoipaPaths(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "handlers.rs", Language: "rust", Content: []byte(`
+#[utoipa::path(get, path = "/users/{id}", responses((status = 200, body = User)))]
+#[deprecated]
+async fn get_user() {}
+
+async fn health() {}
+`)},
+		{Path: "users.ts", Language: "typescript", Content: []byte("export {}")},
+	}
+
+	paths := NewRustParser().UtoipaPaths(context.Background(), files)
+
+	require.Len(t, paths, 1)
+	op := paths["get_user"]
+	require.NotNil(t, op)
+	assert.Equal(t, "/users/{id}", op.Path)
+	assert.True(t, op.Deprecated)
+}
+
+func TestUtoipaPath_Apply(t *testing.T) {
+	route := types.Route{
+		Method:      "GET",
+		Path:        "/users/{id}",
+		OperationID: "getUsersById",
+		Parameters:  []types.Parameter{{Name: "id", In: "path", Required: true, Schema: &types.Schema{Type: "string"}}},
+		Responses:   map[string]types.Response{"200": {Description: "OK"}},
+	}
+	op := &UtoipaPath{
+		OperationID: "fetchUser",
+		Tags:        []string{"users"},
+		Params: []UtoipaParam{
+			{Name: "id", Type: "u64"},
+			{Name: "verbose", Type: "Option<bool>"},
+		},
+		RequestBody: "Option<UpdateUser>",
+		Responses: []UtoipaResponse{
+			{Status: "200", Body: "User"},
+			{Status: "404", Description: "No such user"},
+		},
+	}
+
+	op.Apply(&route)
+
+	assert.Equal(t, "fetchUser", route.OperationID)
+	assert.Equal(t, []string{"users"}, route.Tags)
+	assert.Equal(t, []types.Parameter{
+		{Name: "id", In: "path", Required: true, Schema: &types.Schema{Type: "integer"}},
+		{Name: "verbose", In: "query", Schema: &types.Schema{Type: "boolean"}},
+	}, route.Parameters)
+	require.NotNil(t, route.RequestBody)
+	assert.False(t, route.RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/UpdateUser", route.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "OK", route.Responses["200"].Description)
+	assert.Equal(t, "#/components/schemas/User", route.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, types.Response{Description: "No such user"}, route.Responses["404"])
+}
+
+func TestRustTypes_IsSchema(t *testing.T) {
+	structs, enums := parseRustTypes(t, `
+#[derive(Debug, ToSchema)]
+pub struct User { pub id: u64 }
+
+#[derive(Debug, Clone)]
+pub struct State { pub db: Pool }
+
+#[derive(JsonSchema)]
+pub enum Role { Admin }
+`)
+
+	user, state := structs["User"], structs["State"]
+	assert.True(t, user.IsSchema())
+	assert.False(t, state.IsSchema())
+	require.Len(t, enums, 1)
+	assert.True(t, enums[0].IsSchema())
+}
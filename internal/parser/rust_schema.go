@@ -0,0 +1,329 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// RustStructSchema converts a serde struct to an OpenAPI schema. structs
+// holds the structs by name that #[serde(flatten)] fields may refer to.
+func RustStructSchema(s RustStruct, structs map[string]RustStruct) *types.Schema {
+	schema := &types.Schema{
+		Title:       s.Name,
+		Description: s.Description,
+		Type:        "object",
+		Properties:  make(map[string]*types.Schema),
+		Required:    []string{},
+		Source:      s.Source(),
+	}
+
+	addRustFields(schema, s, structs, map[string]bool{s.Name: true}, false)
+	if s.Serde().DenyUnknownFields {
+		schema.AdditionalProperties = types.BoolSchema(false)
+	}
+
+	return schema
+}
+
+// addRustFields adds the serialized fields of s to schema, merging the
+// fields of #[serde(flatten)] structs. Fields of an optional flattened
+// struct are not required.
+func addRustFields(schema *types.Schema, s RustStruct, structs map[string]RustStruct, visiting map[string]bool, optional bool) {
+	container := s.Serde()
+
+	for _, field := range s.Fields {
+		serde := field.Serde()
+		if serde.Skip || (serde.SkipSerializing && serde.SkipDeserializing) {
+			continue
+		}
+		if serde.Flatten {
+			flattenRustField(schema, field, structs, visiting, optional)
+			continue
+		}
+
+		// Get the field name (possibly renamed by serde)
+		fieldName := field.Name
+		if serde.Rename != "" {
+			fieldName = serde.Rename
+		} else if container.RenameAll != "" {
+			fieldName = SerdeRename(field.Name, container.RenameAll)
+		}
+
+		// Convert Rust type to OpenAPI type
+		isOptional := strings.HasPrefix(field.Type, "Option<")
+		propSchema := RustTypeSchema(field.Type)
+
+		// Handle Vec<T> types
+		if strings.HasPrefix(field.Type, "Vec<") {
+			propSchema.Type = "array"
+			innerType := extractRustGenericType(field.Type)
+			propSchema.Items = RustTypeSchema(innerType)
+		}
+
+		// Handle Option<T> types
+		if isOptional {
+			propSchema.Nullable = true
+			innerType := extractRustGenericType(field.Type)
+			innerOpenAPIType, innerFormat := RustTypeToOpenAPI(innerType)
+			propSchema.Type = innerOpenAPIType
+			propSchema.Format = innerFormat
+		}
+
+		if field.Description != "" {
+			propSchema.Description = field.Description
+		}
+		applyRustConstraints(propSchema, field.Constraints())
+
+		// Fields skipped in one direction only appear in the other
+		propSchema.WriteOnly = propSchema.WriteOnly || serde.SkipSerializing
+		propSchema.ReadOnly = propSchema.ReadOnly || serde.SkipDeserializing
+
+		schema.Properties[fieldName] = propSchema
+
+		// Defaulted fields may be missing on input, and skip_serializing_if
+		// fields on output
+		if !isOptional && !optional && !serde.Default && !container.Default && serde.SkipSerializingIf == "" {
+			schema.Required = append(schema.Required, fieldName)
+		}
+	}
+}
+
+// flattenRustField merges a #[serde(flatten)] field into schema: the fields
+// of a known struct, or additional properties for a map.
+func flattenRustField(schema *types.Schema, field RustField, structs map[string]RustStruct, visiting map[string]bool, optional bool) {
+	typeName := strings.TrimSpace(field.Type)
+	if strings.HasPrefix(typeName, "Option<") {
+		typeName = extractRustGenericType(typeName)
+		optional = true
+	}
+
+	if strings.HasPrefix(typeName, "HashMap<") || strings.HasPrefix(typeName, "BTreeMap<") {
+		schema.AdditionalProperties = &types.Schema{}
+		if args := strings.SplitN(extractRustGenericType(typeName), ",", 2); len(args) == 2 {
+			schema.AdditionalProperties = RustRefSchema(args[1])
+		}
+		return
+	}
+
+	name, _, _ := strings.Cut(typeName, "<")
+	name = rustPathName(name)
+	target, ok := structs[name]
+	if !ok || visiting[name] {
+		return
+	}
+
+	visiting[name] = true
+	addRustFields(schema, target, structs, visiting, optional)
+	delete(visiting, name)
+}
+
+// RustEnumSchema converts a Rust enum to an OpenAPI schema following its
+// serde representation: externally tagged (the default), internally tagged
+// (tag), adjacently tagged (tag and content), or untagged. Nil is returned
+// when every variant is skipped.
+func RustEnumSchema(e RustEnum, structs map[string]RustStruct) *types.Schema {
+	container := e.Serde()
+	schema := &types.Schema{
+		Title:       e.Name,
+		Description: e.Description,
+	}
+
+	var names []string
+	unitOnly := true
+	for _, v := range e.Variants {
+		if v.Serde().Skip {
+			continue
+		}
+		names = append(names, rustVariantName(v, container))
+		if len(v.Fields) > 0 || len(v.Types) > 0 {
+			unitOnly = false
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	// Enums of unit variants serialize as plain strings
+	if unitOnly && !container.Untagged {
+		values := make([]interface{}, len(names))
+		for i, name := range names {
+			values[i] = name
+		}
+		if container.Tag == "" {
+			schema.Type = "string"
+			schema.Enum = values
+			return schema
+		}
+		schema.Type = "object"
+		schema.Properties = map[string]*types.Schema{
+			container.Tag: {Type: "string", Enum: values},
+		}
+		schema.Required = []string{container.Tag}
+		return schema
+	}
+
+	for _, v := range e.Variants {
+		if v.Serde().Skip {
+			continue
+		}
+		name := rustVariantName(v, container)
+		payload := rustVariantPayload(v, structs)
+		tag := &types.Schema{Type: "string", Enum: []interface{}{name}}
+
+		var variant *types.Schema
+		switch {
+		case container.Untagged:
+			if payload == nil {
+				continue
+			}
+			variant = payload
+		case container.Tag != "" && container.Content != "":
+			variant = &types.Schema{
+				Type:       "object",
+				Properties: map[string]*types.Schema{container.Tag: tag},
+				Required:   []string{container.Tag},
+			}
+			if payload != nil {
+				variant.Properties[container.Content] = payload
+				variant.Required = append(variant.Required, container.Content)
+			}
+		case container.Tag != "":
+			switch {
+			case payload == nil:
+				variant = &types.Schema{
+					Type:       "object",
+					Properties: map[string]*types.Schema{container.Tag: tag},
+					Required:   []string{container.Tag},
+				}
+			case payload.Type == "object" && payload.Properties != nil:
+				payload.Properties[container.Tag] = tag
+				payload.Required = append([]string{container.Tag}, payload.Required...)
+				variant = payload
+			default:
+				variant = &types.Schema{AllOf: []*types.Schema{payload, {
+					Type:       "object",
+					Properties: map[string]*types.Schema{container.Tag: tag},
+					Required:   []string{container.Tag},
+				}}}
+			}
+		default:
+			if payload == nil {
+				variant = tag
+			} else {
+				variant = &types.Schema{
+					Type:       "object",
+					Properties: map[string]*types.Schema{name: payload},
+					Required:   []string{name},
+				}
+			}
+		}
+
+		if v.Description != "" && variant.Ref == "" {
+			variant.Description = v.Description
+		}
+		schema.OneOf = append(schema.OneOf, variant)
+	}
+
+	return schema
+}
+
+// rustVariantPayload returns the schema of the data a variant carries, or
+// nil for unit variants.
+func rustVariantPayload(v RustVariant, structs map[string]RustStruct) *types.Schema {
+	switch {
+	case len(v.Fields) > 0:
+		payload := &types.Schema{
+			Type:       "object",
+			Properties: make(map[string]*types.Schema),
+			Required:   []string{},
+		}
+		// A variant's own rename_all applies to its fields
+		s := RustStruct{Name: v.Name, Attributes: v.Attributes, Fields: v.Fields}
+		addRustFields(payload, s, structs, map[string]bool{}, false)
+		return payload
+	case len(v.Types) == 1:
+		return RustRefSchema(v.Types[0])
+	case len(v.Types) > 1:
+		n := len(v.Types)
+		return &types.Schema{Type: "array", MinItems: &n, MaxItems: &n}
+	}
+	return nil
+}
+
+// rustVariantName returns the serialized name of an enum variant.
+func rustVariantName(v RustVariant, container SerdeAttrs) string {
+	if rename := v.Serde().Rename; rename != "" {
+		return rename
+	}
+	if container.RenameAll != "" {
+		return SerdeRename(v.Name, container.RenameAll)
+	}
+	return v.Name
+}
+
+// RustRefSchema converts a Rust type named in an attribute or enum variant:
+// primitives, [T] and Vec<T> arrays, Option<T>, and references to component
+// schemas.
+func RustRefSchema(typeName string) *types.Schema {
+	typeName = strings.TrimSpace(typeName)
+	if inner, ok := strings.CutPrefix(typeName, "inline("); ok {
+		typeName = strings.TrimSuffix(inner, ")")
+	}
+
+	switch {
+	case typeName == "":
+		return &types.Schema{Type: "string"}
+	case strings.HasPrefix(typeName, "[") && strings.HasSuffix(typeName, "]"):
+		return &types.Schema{Type: "array", Items: RustRefSchema(typeName[1 : len(typeName)-1])}
+	case strings.HasPrefix(typeName, "Vec<"):
+		return &types.Schema{Type: "array", Items: RustRefSchema(extractRustGenericType(typeName))}
+	case strings.HasPrefix(typeName, "Option<"):
+		return RustRefSchema(extractRustGenericType(typeName))
+	}
+
+	openAPIType, format := RustTypeToOpenAPI(typeName)
+	if openAPIType == "object" && !strings.Contains(typeName, "Map<") {
+		return &types.Schema{Ref: "#/components/schemas/" + rustPathName(typeName)}
+	}
+	return &types.Schema{Type: openAPIType, Format: format}
+}
+
+// applyRustConstraints copies the schemars, validator, and utoipa
+// constraints of a field to its property schema.
+func applyRustConstraints(prop *types.Schema, c RustConstraints) {
+	if prop.Type == "array" {
+		prop.MinItems, prop.MaxItems = c.MinLength, c.MaxLength
+	} else {
+		prop.MinLength, prop.MaxLength = c.MinLength, c.MaxLength
+	}
+	prop.Minimum, prop.Maximum = c.Minimum, c.Maximum
+	if c.Pattern != "" {
+		prop.Pattern = c.Pattern
+	}
+	if c.Format != "" {
+		prop.Format = c.Format
+	}
+	if c.Description != "" {
+		prop.Description = c.Description
+	}
+	if c.Example != nil {
+		prop.Example = c.Example
+	}
+	prop.ReadOnly = prop.ReadOnly || c.ReadOnly
+	prop.WriteOnly = prop.WriteOnly || c.WriteOnly
+	prop.Deprecated = prop.Deprecated || c.Deprecated
+	prop.Nullable = prop.Nullable || c.Nullable
+}
+
+// rustPathName returns the last segment of a Rust path such as
+// models::User.
+func rustPathName(path string) string {
+	if i := strings.LastIndex(path, "::"); i >= 0 {
+		return path[i+2:]
+	}
+	return path
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// parseRustTypes parses code and returns its structs by name and its enums.
+func parseRustTypes(t *testing.T, code string) (map[string]RustStruct, []RustEnum) {
+	t.Helper()
+	pf, err := NewRustParser().Parse("models.rs", []byte(code))
+	require.NoError(t, err)
+	defer pf.Close()
+
+	structs := make(map[string]RustStruct)
+	for _, s := range pf.Structs {
+		structs[s.Name] = s
+	}
+	return structs, pf.Enums
+}
+
+func TestRustStructSchema(t *testing.T) {
+	structs, _ := parseRustTypes(t, `
+#[derive(Serialize)]
+#[serde(rename_all = "camelCase", deny_unknown_fields)]
+pub struct User {
+    /// The user id.
+    pub user_id: u64,
+    pub display_name: Option<String>,
+    pub tags: Vec<String>,
+    #[serde(skip)]
+    pub secret: String,
+    #[serde(skip_serializing)]
+    pub password: String,
+    #[serde(default)]
+    pub active: bool,
+    #[serde(flatten)]
+    pub audit: Option<Audit>,
+    #[serde(flatten)]
+    pub extra: HashMap<String, i64>,
+}
+
+#[derive(Serialize)]
+pub struct Audit {
+    pub created_by: String,
+}
+`)
+
+	schema := RustStructSchema(structs["User"], structs)
+
+	assert.Equal(t, "User", schema.Title)
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, types.BoolSchema(false), schema.AdditionalProperties)
+	assert.ElementsMatch(t, []string{"userId", "displayName", "tags", "password", "active", "created_by"}, slices.Collect(maps.Keys(schema.Properties)))
+	assert.Equal(t, []string{"userId", "tags", "password"}, schema.Required)
+
+	assert.Equal(t, "The user id.", schema.Properties["userId"].Description)
+	assert.True(t, schema.Properties["displayName"].Nullable)
+	assert.Equal(t, "string", schema.Properties["tags"].Items.Type)
+	assert.True(t, schema.Properties["password"].WriteOnly)
+}
+
+func TestRustStructSchema_FlattenedMap(t *testing.T) {
+	structs, _ := parseRustTypes(t, `
+pub struct Labels {
+    pub name: String,
+    #[serde(flatten)]
+    pub rest: BTreeMap<String, i64>,
+}
+`)
+
+	schema := RustStructSchema(structs["Labels"], structs)
+
+	assert.Equal(t, &types.Schema{Type: "integer"}, schema.AdditionalProperties)
+	assert.Equal(t, []string{"name"}, schema.Required)
+}
+
+func TestRustEnumSchema(t *testing.T) {
+	structs, enums := parseRustTypes(t, `
+#[serde(rename_all = "snake_case")]
+pub enum Status { InProgress, #[serde(rename = "gone")] Deleted, #[serde(skip)] Hidden }
+
+#[serde(tag = "type")]
+pub enum Shape { Circle { radius: f64 }, Point }
+
+#[serde(tag = "type", content = "data")]
+pub enum Event { Renamed(String), Closed }
+
+#[serde(untagged)]
+pub enum Id { Number(u64), Name(String), Unit }
+
+pub enum Command { Move(i32, i32), Say(Message), Stop }
+
+#[serde(skip)]
+pub enum Empty { #[serde(skip)] Nothing }
+`)
+	require.Len(t, enums, 6)
+	schemas := make(map[string]*types.Schema)
+	for _, e := range enums {
+		schemas[e.Name] = RustEnumSchema(e, structs)
+	}
+
+	status := schemas["Status"]
+	assert.Equal(t, "string", status.Type)
+	assert.Equal(t, []interface{}{"in_progress", "gone"}, status.Enum)
+
+	shape := schemas["Shape"]
+	require.Len(t, shape.OneOf, 2)
+	assert.Equal(t, []string{"type", "radius"}, shape.OneOf[0].Required)
+	assert.Equal(t, []interface{}{"Circle"}, shape.OneOf[0].Properties["type"].Enum)
+	assert.Equal(t, []string{"type"}, shape.OneOf[1].Required)
+
+	event := schemas["Event"]
+	require.Len(t, event.OneOf, 2)
+	assert.Equal(t, []string{"type", "data"}, event.OneOf[0].Required)
+	assert.Equal(t, "string", event.OneOf[0].Properties["data"].Type)
+	assert.Equal(t, []string{"type"}, event.OneOf[1].Required)
+
+	id := schemas["Id"]
+	require.Len(t, id.OneOf, 2)
+	assert.Equal(t, "integer", id.OneOf[0].Type)
+	assert.Equal(t, "string", id.OneOf[1].Type)
+
+	command := schemas["Command"]
+	require.Len(t, command.OneOf, 3)
+	move := command.OneOf[0].Properties["Move"]
+	assert.Equal(t, "array", move.Type)
+	assert.Equal(t, 2, *move.MinItems)
+	assert.Equal(t, "#/components/schemas/Message", command.OneOf[1].Properties["Say"].Ref)
+	assert.Equal(t, []interface{}{"Stop"}, command.OneOf[2].Enum)
+
+	assert.Nil(t, schemas["Empty"])
+}
+
+func TestRustRefSchema(t *testing.T) {
+	tests := []struct {
+		typeName string
+		want     *types.Schema
+	}{
+		{"", &types.Schema{Type: "string"}},
+		{"String", &types.Schema{Type: "string"}},
+		{"Option<i64>", &types.Schema{Type: "integer"}},
+		{"Vec<User>", &types.Schema{Type: "array", Items: &types.Schema{Ref: "#/components/schemas/User"}}},
+		{"[models::User]", &types.Schema{Type: "array", Items: &types.Schema{Ref: "#/components/schemas/User"}}},
+		{"inline(Uuid)", &types.Schema{Type: "string", Format: "uuid"}},
+		{"HashMap<String, i64>", &types.Schema{Type: "object"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			assert.Equal(t, tt.want, RustRefSchema(tt.typeName))
+		})
+	}
+}
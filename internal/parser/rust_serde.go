@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SerdeAttrs are the #[serde(...)] options of a struct, enum, field, or
+// variant that change its serialized shape.
+type SerdeAttrs struct {
+	// Rename is the serialized name (rename = "..." or rename(serialize = "..."))
+	Rename string
+
+	// RenameAll is the case convention for fields or variants
+	RenameAll string
+
+	// Tag and Content name the tag and content fields of internally and
+	// adjacently tagged enums
+	Tag     string
+	Content string
+
+	Untagged          bool
+	Default           bool
+	Flatten           bool
+	Skip              bool
+	SkipSerializing   bool
+	SkipDeserializing bool
+	DenyUnknownFields bool
+
+	// SkipSerializingIf is the predicate path that omits the field on output
+	SkipSerializingIf string
+}
+
+// Serde returns the serde container options of the struct.
+func (s *RustStruct) Serde() SerdeAttrs { return serdeAttrs(s.Attributes) }
+
+// Serde returns the serde container options of the enum.
+func (e *RustEnum) Serde() SerdeAttrs { return serdeAttrs(e.Attributes) }
+
+// Serde returns the serde options of the field.
+func (f *RustField) Serde() SerdeAttrs { return serdeAttrs(f.Attributes) }
+
+// Serde returns the serde options of the variant.
+func (v *RustVariant) Serde() SerdeAttrs { return serdeAttrs(v.Attributes) }
+
+// serdeAttrs reads the #[serde(...)] attributes in attrs.
+func serdeAttrs(attrs []RustAttribute) SerdeAttrs {
+	var s SerdeAttrs
+	for _, attr := range attrs {
+		if attr.Name != "serde" {
+			continue
+		}
+		for _, args := range attr.Arguments {
+			for _, item := range splitRustArgs(args) {
+				s.apply(item)
+			}
+		}
+	}
+	return s
+}
+
+// apply records one item of a serde attribute.
+func (s *SerdeAttrs) apply(item string) {
+	for name, target := range map[string]*string{"rename": &s.Rename, "rename_all": &s.RenameAll} {
+		if inner, ok := rustGroup(item, name); ok {
+			for _, arg := range splitRustArgs(inner) {
+				if key, value := rustKeyValue(arg); key == "serialize" {
+					*target = rustString(value)
+				}
+			}
+			return
+		}
+	}
+
+	key, value := rustKeyValue(item)
+	switch key {
+	case "rename":
+		s.Rename = rustString(value)
+	case "rename_all":
+		s.RenameAll = rustString(value)
+	case "tag":
+		s.Tag = rustString(value)
+	case "content":
+		s.Content = rustString(value)
+	case "untagged":
+		s.Untagged = true
+	case "default":
+		s.Default = true
+	case "flatten":
+		s.Flatten = true
+	case "skip":
+		s.Skip = true
+	case "skip_serializing":
+		s.SkipSerializing = true
+	case "skip_deserializing":
+		s.SkipDeserializing = true
+	case "deny_unknown_fields":
+		s.DenyUnknownFields = true
+	case "skip_serializing_if":
+		s.SkipSerializingIf = rustString(value)
+	}
+}
+
+// SerdeRename applies a serde rename_all rule (camelCase, snake_case,
+// SCREAMING-KEBAB-CASE, ...) to a field or variant name. Unknown rules
+// leave the name unchanged.
+func SerdeRename(name, rule string) string {
+	switch rule {
+	case "lowercase":
+		return strings.ToLower(name)
+	case "UPPERCASE":
+		return strings.ToUpper(name)
+	}
+
+	words := serdeWords(name)
+	switch rule {
+	case "PascalCase", "camelCase":
+		for i, w := range words {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+		joined := strings.Join(words, "")
+		if rule == "camelCase" && joined != "" {
+			joined = strings.ToLower(joined[:1]) + joined[1:]
+		}
+		return joined
+	case "snake_case":
+		return strings.Join(words, "_")
+	case "SCREAMING_SNAKE_CASE":
+		return strings.ToUpper(strings.Join(words, "_"))
+	case "kebab-case":
+		return strings.Join(words, "-")
+	case "SCREAMING-KEBAB-CASE":
+		return strings.ToUpper(strings.Join(words, "-"))
+	}
+	return name
+}
+
+// serdeWords splits a snake_case field or PascalCase variant name into
+// lower-case words.
+func serdeWords(name string) []string {
+	var words []string
+	var current []rune
+	for i, r := range name {
+		if r == '_' {
+			if len(current) > 0 {
+				words = append(words, string(current))
+			}
+			current = nil
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 && len(current) > 0 {
+			words = append(words, string(current))
+			current = nil
+		}
+		current = append(current, unicode.ToLower(r))
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
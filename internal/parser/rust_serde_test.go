@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerdeRename(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		want string
+	}{
+		{"user_id", "camelCase", "userId"},
+		{"user_id", "PascalCase", "UserId"},
+		{"user_id", "kebab-case", "user-id"},
+		{"user_id", "SCREAMING_SNAKE_CASE", "USER_ID"},
+		{"user_id", "lowercase", "user_id"},
+		{"InProgress", "snake_case", "in_progress"},
+		{"InProgress", "camelCase", "inProgress"},
+		{"InProgress", "lowercase", "inprogress"},
+		{"InProgress", "SCREAMING-KEBAB-CASE", "IN-PROGRESS"},
+		{"InProgress", "unknown", "InProgress"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.rule, func(t *testing.T) {
+			assert.Equal(t, tt.want, SerdeRename(tt.name, tt.rule))
+		})
+	}
+}
+
+func TestRustParser_ParseEnums(t *testing.T) {
+	code := `
+/// An account event.
+#[derive(Serialize)]
+#[serde(tag = "type", content = "data", rename_all = "snake_case")]
+pub enum Event {
+    /// The account was created.
+    Created { id: u64, #[serde(default)] name: String },
+    Renamed(String),
+    Moved(i32, i32),
+    #[serde(rename = "gone")]
+    Deleted,
+}
+`
+	pf, err := NewRustParser().Parse("events.rs", []byte(code))
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.Enums, 1)
+
+	e := pf.Enums[0]
+	assert.Equal(t, "Event", e.Name)
+	assert.True(t, e.IsPublic)
+	assert.True(t, e.HasDeriveAttribute("Serialize"))
+	assert.Equal(t, "An account event.", e.Description)
+
+	serde := e.Serde()
+	assert.Equal(t, "type", serde.Tag)
+	assert.Equal(t, "data", serde.Content)
+	assert.Equal(t, "snake_case", serde.RenameAll)
+
+	require.Len(t, e.Variants, 4)
+	created := e.Variants[0]
+	assert.Equal(t, "Created", created.Name)
+	assert.Equal(t, "The account was created.", created.Description)
+	require.Len(t, created.Fields, 2)
+	assert.True(t, created.Fields[1].Serde().Default)
+	assert.Equal(t, []string{"String"}, e.Variants[1].Types)
+	assert.Equal(t, []string{"i32", "i32"}, e.Variants[2].Types)
+	assert.Empty(t, e.Variants[3].Types)
+	assert.Equal(t, "gone", e.Variants[3].Serde().Rename)
+}
+
+func TestRustField_Serde(t *testing.T) {
+	code := `
+struct Page {
+    #[serde(rename(serialize = "total_count"), skip_serializing_if = "Option::is_none")]
+    total: Option<u64>,
+    #[serde(flatten)]
+    meta: Meta,
+    #[serde(skip)]
+    cache: Cache,
+}
+`
+	pf, err := NewRustParser().Parse("page.rs", []byte(code))
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.Structs, 1)
+
+	fields := pf.Structs[0].Fields
+	require.Len(t, fields, 3)
+	total := fields[0].Serde()
+	assert.Equal(t, "total_count", total.Rename)
+	assert.Equal(t, "Option::is_none", total.SkipSerializingIf)
+	assert.True(t, fields[1].Serde().Flatten)
+	assert.True(t, fields[2].Serde().Skip)
+}
@@ -7,6 +7,10 @@ package parser
 import (
 	"regexp"
 	"strings"
+	"time"
+
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 )
 
 // ScalaParser provides Scala parsing capabilities using regex patterns.
@@ -169,7 +173,9 @@ var (
 
 // Parse parses Scala source code.
 func (p *ScalaParser) Parse(filename string, content []byte) *ParsedScalaFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedScalaFile{
 		Path:           filename,
 		Content:        src,
@@ -199,7 +205,9 @@ func (p *ScalaParser) Parse(filename string, content []byte) *ParsedScalaFile {
 
 // ParsePlayRoutes parses a Play Framework routes file.
 func (p *ScalaParser) ParsePlayRoutes(filename string, content []byte) *ParsedScalaFile {
-	src := string(content)
+	defer recordParse(filename, time.Now())
+
+	src := util.NormalizeNewlines(string(content))
 	pf := &ParsedScalaFile{
 		Path:       filename,
 		Content:    src,
@@ -540,6 +548,11 @@ func ScalaTypeToOpenAPI(scalaType string) (openAPIType string, format string) {
 		return "object", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(scalaType); ok {
+		return t, f
+	}
+
 	switch scalaType {
 	case "String":
 		return "string", ""
@@ -5,64 +5,109 @@
 package parser
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/swift"
+
+	"github.com/api2spec/api2spec/internal/typemap"
 )
 
-// SwiftParser provides Swift parsing capabilities using regex patterns.
-type SwiftParser struct{}
+// SwiftParser provides Swift AST parsing capabilities using tree-sitter.
+type SwiftParser struct {
+	parser *sitter.Parser
+
+	// walker enforces the traversal budget while a file is being parsed
+	walker *Walker
+}
 
 // NewSwiftParser creates a new Swift parser.
 func NewSwiftParser() *SwiftParser {
-	return &SwiftParser{}
+	parser := sitter.NewParser()
+	parser.SetLanguage(swift.GetLanguage())
+	return &SwiftParser{
+		parser: parser,
+	}
 }
 
-// SwiftStruct represents a Swift struct definition.
+// SwiftStruct represents a Swift type declaration: a struct, class, enum,
+// actor or extension.
 type SwiftStruct struct {
-	// Name is the struct name
+	// Name is the type name
 	Name string
 
-	// Fields are the struct fields
+	// Kind is the declaration keyword ("struct", "class", "extension", ...)
+	Kind string
+
+	// Inherits lists the superclass and protocol conformances
+	Inherits []string
+
+	// Fields are the stored properties
 	Fields []SwiftField
 
-	// ConformsToContent indicates if the struct conforms to Content protocol
+	// Methods are the functions declared in the type body
+	Methods []SwiftFunction
+
+	// ConformsToContent indicates if the type conforms to Vapor's Content protocol
 	ConformsToContent bool
 
 	// Line is the source line number
 	Line int
+
+	// Node is the tree-sitter node
+	Node *sitter.Node
 }
 
-// SwiftField represents a struct field.
+// SwiftField represents a stored property.
 type SwiftField struct {
 	// Name is the field name
 	Name string
 
-	// Type is the field type
+	// Type is the field type without the optional marker
 	Type string
 
 	// IsOptional indicates if the field is optional (T?)
 	IsOptional bool
 
+	// Wrapper is the property wrapper, such as "Field" for Fluent's @Field
+	Wrapper string
+
 	// Line is the source line number
 	Line int
 }
 
-// SwiftRoute represents a route extracted from Swift web framework code.
-type SwiftRoute struct {
-	// Method is the HTTP method
-	Method string
-
-	// Path is the route path
-	Path string
+// SwiftFunction represents a function or method declaration.
+type SwiftFunction struct {
+	// Name is the function name
+	Name string
 
-	// Handler is the handler function/method name
-	Handler string
+	// Parameters are the function parameters
+	Parameters []SwiftParameter
 
-	// GroupPrefix is the route group prefix if any
-	GroupPrefix string
+	// ReturnType is the declared return type, if any
+	ReturnType string
 
 	// Line is the source line number
 	Line int
+
+	// Node is the tree-sitter node
+	Node *sitter.Node
+}
+
+// SwiftParameter represents a function parameter.
+type SwiftParameter struct {
+	// Label is the external argument label, if it differs from Name
+	Label string
+
+	// Name is the internal parameter name
+	Name string
+
+	// Type is the type annotation
+	Type string
 }
 
 // ParsedSwiftFile represents a parsed Swift source file.
@@ -71,435 +116,303 @@ type ParsedSwiftFile struct {
 	Path string
 
 	// Content is the original source content
-	Content string
+	Content []byte
+
+	// Tree is the tree-sitter parse tree
+	Tree *sitter.Tree
+
+	// RootNode is the root node of the AST
+	RootNode *sitter.Node
 
-	// Imports are the import statements
+	// Imports are the imported module names
 	Imports []string
 
-	// Structs are the extracted struct definitions
+	// Structs are the type declarations, including extensions
 	Structs []SwiftStruct
 
-	// Routes are the extracted route definitions
-	Routes []SwiftRoute
-
-	// RouteGroups are the extracted route group definitions
-	RouteGroups []SwiftRouteGroup
+	// Functions are the top-level functions
+	Functions []SwiftFunction
 }
 
-// SwiftRouteGroup represents a Vapor route group.
-type SwiftRouteGroup struct {
-	// Name is the variable name for the group
-	Name string
-
-	// Prefix is the group prefix path
-	Prefix string
-
-	// Line is the source line number
-	Line int
+// Parse parses Swift source code from bytes.
+func (p *SwiftParser) Parse(filename string, content []byte) (*ParsedSwiftFile, error) {
+	return p.ParseContext(context.Background(), filename, content)
 }
 
-// Regex patterns for Swift parsing
-var (
-	// Matches import statements
-	swiftImportRegex = regexp.MustCompile(`(?m)^import\s+(\w+)`)
-
-	// Matches struct definitions with optional protocol conformance
-	swiftStructRegex = regexp.MustCompile(`(?m)struct\s+(\w+)\s*(?::\s*([^{]+))?\s*\{`)
-
-	// Matches Vapor app.get/post/put/delete/patch routes
-	// app.get("users") { req in ... }
-	// app.post("users", ":id") { req -> User in ... }
-	vaporRouteRegex = regexp.MustCompile(`(?m)(\w+)\.(get|post|put|delete|patch|head|options)\s*\(\s*([^)]*)\s*\)\s*\{`)
-
-	// Matches Vapor grouped routes: app.grouped("api").get("users") { ... }
-	vaporGroupedRouteRegex = regexp.MustCompile(`(?m)(\w+)\.grouped\s*\(\s*"([^"]+)"\s*\)\s*\.\s*(get|post|put|delete|patch|head|options)\s*\(\s*([^)]*)\s*\)\s*\{`)
-
-	// Matches route group assignment: let users = app.grouped("users")
-	vaporRouteGroupRegex = regexp.MustCompile(`(?m)let\s+(\w+)\s*=\s*(\w+)\.grouped\s*\(\s*"([^"]+)"\s*\)`)
-
-	// Matches controller routes: routes.get("path", use: handler)
-	vaporControllerRouteRegex = regexp.MustCompile(`(?m)(\w+)\.(get|post|put|delete|patch|head|options)\s*\(\s*([^,)]*)\s*,\s*use\s*:\s*(\w+)\s*\)`)
+// ParseContext is like Parse, but abandons parsing and AST traversal once
+// ctx is done.
+func (p *SwiftParser) ParseContext(ctx context.Context, filename string, content []byte) (*ParsedSwiftFile, error) {
+	defer recordParse(filename, time.Now())
 
-	// Matches controller boot function
-	vaporBootFunctionRegex = regexp.MustCompile(`(?m)func\s+boot\s*\(\s*routes\s*:\s*RoutesBuilder\s*\)`)
-
-	// Matches Swift property: let/var name: Type
-	swiftPropertyRegex = regexp.MustCompile(`(?m)(?:let|var)\s+(\w+)\s*:\s*([^=\n]+)`)
-
-	// Matches path segments in route definitions
-	swiftPathSegmentRegex = regexp.MustCompile(`"([^"]+)"`)
+	tree, err := parseWithin(ctx, p.parser, filename, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Swift: %w", err)
+	}
 
-	// Matches path parameters like ":id", ":userId"
-	swiftPathParamRegex = regexp.MustCompile(`:(\w+)`)
-)
+	rootNode := tree.RootNode()
+	if rootNode == nil {
+		return nil, fmt.Errorf("failed to get root node")
+	}
+	if rootNode.HasError() {
+		recordUnparsed(filename)
+	}
 
-// Parse parses Swift source code.
-func (p *SwiftParser) Parse(filename string, content []byte) *ParsedSwiftFile {
-	src := string(content)
 	pf := &ParsedSwiftFile{
-		Path:        filename,
-		Content:     src,
-		Imports:     []string{},
-		Structs:     []SwiftStruct{},
-		Routes:      []SwiftRoute{},
-		RouteGroups: []SwiftRouteGroup{},
+		Path:      filename,
+		Content:   content,
+		Tree:      tree,
+		RootNode:  rootNode,
+		Imports:   []string{},
+		Structs:   []SwiftStruct{},
+		Functions: []SwiftFunction{},
 	}
 
-	// Extract imports
-	pf.Imports = p.extractImports(src)
-
-	// Extract structs
-	pf.Structs = p.extractStructs(src)
-
-	// Extract route groups first
-	pf.RouteGroups = p.extractRouteGroups(src)
+	// Extract definitions within the file's traversal budget
+	p.walker = NewWalker(filename, DefaultBudget)
+	p.walker.ctx = ctx
+	defer func() {
+		p.walker.finish()
+		p.walker = nil
+	}()
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "import_declaration":
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				if child := node.NamedChild(i); child.Type() == "identifier" {
+					pf.Imports = append(pf.Imports, child.Content(content))
+				}
+			}
+			return false
+		case "class_declaration":
+			if s := p.parseStruct(node, content); s != nil {
+				pf.Structs = append(pf.Structs, *s)
+			}
+			return true
+		case "function_declaration":
+			if parent := node.Parent(); parent != nil && parent.Type() == "source_file" {
+				if fn := p.parseFunction(node, content); fn != nil {
+					pf.Functions = append(pf.Functions, *fn)
+				}
+			}
+			return false
+		}
+		return true
+	})
 
-	// Extract routes
-	pf.Routes = p.extractRoutes(src, pf.RouteGroups)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	return pf
+	return pf, nil
 }
 
-// extractImports extracts import statements from Swift source.
-func (p *SwiftParser) extractImports(src string) []string {
-	var imports []string
-	matches := swiftImportRegex.FindAllStringSubmatch(src, -1)
-	for _, match := range matches {
-		if len(match) > 1 {
-			imports = append(imports, strings.TrimSpace(match[1]))
-		}
+// parseStruct parses a type declaration and its members.
+func (p *SwiftParser) parseStruct(node *sitter.Node, content []byte) *SwiftStruct {
+	s := &SwiftStruct{
+		Line:     int(node.StartPoint().Row) + 1,
+		Inherits: []string{},
+		Fields:   []SwiftField{},
+		Methods:  []SwiftFunction{},
+		Node:     node,
 	}
-	return imports
-}
 
-// extractStructs extracts struct definitions from Swift source.
-func (p *SwiftParser) extractStructs(src string) []SwiftStruct {
-	var structs []SwiftStruct
+	if kind := node.ChildByFieldName("declaration_kind"); kind != nil {
+		s.Kind = kind.Content(content)
+	}
+	if name := node.ChildByFieldName("name"); name != nil {
+		s.Name = name.Content(content)
+	}
+	if s.Name == "" {
+		return nil
+	}
 
-	matches := swiftStructRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 6 {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "inheritance_specifier" {
 			continue
 		}
-
-		line := countLines(src[:match[0]])
-
-		s := SwiftStruct{
-			Line:   line,
-			Fields: []SwiftField{},
-		}
-
-		// Extract struct name (group 1)
-		if match[2] >= 0 && match[3] >= 0 {
-			s.Name = src[match[2]:match[3]]
-		}
-
-		// Check protocol conformance (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			protocols := src[match[4]:match[5]]
-			s.ConformsToContent = strings.Contains(protocols, "Content")
-		}
-
-		// Find struct body and extract fields
-		structStart := match[0]
-		structBody := p.findStructBody(src[structStart:])
-		if structBody != "" {
-			s.Fields = p.extractStructFields(structBody, line)
-		}
-
-		if s.Name != "" {
-			structs = append(structs, s)
+		inherited := child.Content(content)
+		s.Inherits = append(s.Inherits, inherited)
+		if inherited == "Content" || inherited == "Vapor.Content" {
+			s.ConformsToContent = true
 		}
 	}
 
-	return structs
-}
-
-// findStructBody finds the body of a struct (between { and }).
-func (p *SwiftParser) findStructBody(src string) string {
-	openBrace := strings.Index(src, "{")
-	if openBrace == -1 {
-		return ""
-	}
-
-	depth := 0
-	for i := openBrace; i < len(src); i++ {
-		switch src[i] {
-		case '{':
-			depth++
-		case '}':
-			depth--
-			if depth == 0 {
-				return src[openBrace+1 : i]
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return s
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		switch member.Type() {
+		case "property_declaration":
+			s.Fields = append(s.Fields, p.parseProperty(member, content)...)
+		case "function_declaration":
+			if fn := p.parseFunction(member, content); fn != nil {
+				s.Methods = append(s.Methods, *fn)
 			}
 		}
 	}
-	return ""
-}
 
-// extractStructFields extracts fields from a struct body.
-func (p *SwiftParser) extractStructFields(body string, baseLineOffset int) []SwiftField {
-	var fields []SwiftField
-
-	matches := swiftPropertyRegex.FindAllStringSubmatchIndex(body, -1)
-	for _, match := range matches {
-		if len(match) < 6 {
-			continue
-		}
-
-		line := baseLineOffset + countLines(body[:match[0]])
-
-		field := SwiftField{
-			Line: line,
-		}
-
-		// Extract name (group 1)
-		if match[2] >= 0 && match[3] >= 0 {
-			field.Name = body[match[2]:match[3]]
-		}
+	return s
+}
 
-		// Extract type (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			typeStr := strings.TrimSpace(body[match[4]:match[5]])
-			field.Type = typeStr
+// parseProperty parses the stored properties of a property declaration.
+// Static and computed properties are not part of an instance's encoding
+// and yield no fields.
+func (p *SwiftParser) parseProperty(node *sitter.Node, content []byte) []SwiftField {
+	if node.ChildByFieldName("computed_value") != nil {
+		return nil
+	}
 
-			// Check if optional
-			if strings.HasSuffix(typeStr, "?") {
-				field.IsOptional = true
-				field.Type = strings.TrimSuffix(typeStr, "?")
+	var wrapper, typeStr string
+	var names []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "modifiers":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				mod := child.NamedChild(j)
+				switch mod.Type() {
+				case "property_modifier":
+					if text := mod.Content(content); text == "static" || text == "class" {
+						return nil
+					}
+				case "attribute":
+					if wrapper == "" {
+						wrapper = attributeName(mod, content)
+					}
+				}
+			}
+		case "pattern":
+			if id := child.ChildByFieldName("bound_identifier"); id != nil {
+				names = append(names, id.Content(content))
+			}
+		case "type_annotation":
+			if t := child.ChildByFieldName("name"); t != nil {
+				typeStr = t.Content(content)
 			}
 		}
+	}
 
-		if field.Name != "" {
-			fields = append(fields, field)
-		}
+	if typeStr == "" {
+		return nil
 	}
 
+	optional := strings.HasSuffix(typeStr, "?") || strings.HasSuffix(typeStr, "!")
+	typeStr = strings.TrimRight(typeStr, "?!")
+
+	fields := make([]SwiftField, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, SwiftField{
+			Name:       name,
+			Type:       typeStr,
+			IsOptional: optional,
+			Wrapper:    wrapper,
+			Line:       int(node.StartPoint().Row) + 1,
+		})
+	}
 	return fields
 }
 
-// extractRouteGroups extracts route group definitions.
-func (p *SwiftParser) extractRouteGroups(src string) []SwiftRouteGroup {
-	var groups []SwiftRouteGroup
-
-	matches := vaporRouteGroupRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 8 {
-			continue
-		}
-
-		line := countLines(src[:match[0]])
-
-		group := SwiftRouteGroup{
-			Line: line,
-		}
-
-		// Extract variable name (group 1)
-		if match[2] >= 0 && match[3] >= 0 {
-			group.Name = src[match[2]:match[3]]
-		}
-
-		// Extract prefix (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			group.Prefix = src[match[6]:match[7]]
-		}
-
-		if group.Name != "" {
-			groups = append(groups, group)
+// attributeName returns the name of an attribute such as @Field(key: "x").
+func attributeName(node *sitter.Node, content []byte) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "user_type" {
+			return child.Content(content)
 		}
 	}
-
-	return groups
+	return ""
 }
 
-// extractRoutes extracts route definitions from Swift source.
-func (p *SwiftParser) extractRoutes(src string, groups []SwiftRouteGroup) []SwiftRoute {
-	var routes []SwiftRoute
-
-	// Create a map for quick group lookup
-	groupMap := make(map[string]string)
-	for _, g := range groups {
-		groupMap[g.Name] = g.Prefix
+// parseFunction parses a function declaration.
+func (p *SwiftParser) parseFunction(node *sitter.Node, content []byte) *SwiftFunction {
+	fn := &SwiftFunction{
+		Line:       int(node.StartPoint().Row) + 1,
+		Parameters: []SwiftParameter{},
+		Node:       node,
 	}
 
-	// Extract grouped routes (app.grouped("api").get("users"))
-	routes = append(routes, p.extractGroupedRoutes(src)...)
-
-	// Extract controller routes (routes.get("path", use: handler))
-	routes = append(routes, p.extractControllerRoutes(src, groupMap)...)
-
-	// Extract simple routes (app.get("users"))
-	routes = append(routes, p.extractSimpleRoutes(src, groupMap)...)
-
-	return routes
-}
-
-// extractGroupedRoutes extracts routes with inline grouping.
-func (p *SwiftParser) extractGroupedRoutes(src string) []SwiftRoute {
-	var routes []SwiftRoute
-
-	matches := vaporGroupedRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 10 {
-			continue
-		}
-
-		line := countLines(src[:match[0]])
-
-		route := SwiftRoute{
-			Line: line,
-		}
-
-		// Extract group prefix (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			route.GroupPrefix = src[match[4]:match[5]]
-		}
-
-		// Extract HTTP method (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			route.Method = strings.ToUpper(src[match[6]:match[7]])
-		}
-
-		// Extract path segments (group 4)
-		if match[8] >= 0 && match[9] >= 0 {
-			pathStr := src[match[8]:match[9]]
-			route.Path = p.buildPath(route.GroupPrefix, pathStr)
-		} else {
-			route.Path = "/" + route.GroupPrefix
-		}
+	if name := node.ChildByFieldName("name"); name != nil {
+		fn.Name = name.Content(content)
+	}
+	if fn.Name == "" {
+		return nil
+	}
 
-		if route.Path != "" {
-			routes = append(routes, route)
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "parameter" {
+			fn.Parameters = append(fn.Parameters, parseSwiftParameter(child, content))
 		}
 	}
+	fn.ReturnType = SwiftReturnType(node, content)
 
-	return routes
+	return fn
 }
 
-// extractControllerRoutes extracts routes from controller boot functions.
-func (p *SwiftParser) extractControllerRoutes(src string, groupMap map[string]string) []SwiftRoute {
-	var routes []SwiftRoute
-
-	matches := vaporControllerRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 10 {
-			continue
-		}
-
-		line := countLines(src[:match[0]])
-
-		route := SwiftRoute{
-			Line: line,
-		}
-
-		// Extract router variable name (group 1)
-		var routerName string
-		if match[2] >= 0 && match[3] >= 0 {
-			routerName = src[match[2]:match[3]]
-		}
-
-		// Extract HTTP method (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			route.Method = strings.ToUpper(src[match[4]:match[5]])
-		}
-
-		// Extract path segments (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			pathStr := src[match[6]:match[7]]
-			prefix := groupMap[routerName]
-			route.Path = p.buildPath(prefix, pathStr)
-		}
-
-		// Extract handler name (group 4)
-		if match[8] >= 0 && match[9] >= 0 {
-			route.Handler = src[match[8]:match[9]]
-		}
-
-		if route.Path != "" {
-			routes = append(routes, route)
+// parseSwiftParameter parses a function parameter.
+func parseSwiftParameter(node *sitter.Node, content []byte) SwiftParameter {
+	var param SwiftParameter
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch node.FieldNameForChild(namedChildIndex(node, i)) {
+		case "external_name":
+			param.Label = child.Content(content)
+		case "name":
+			if child.Type() == "simple_identifier" && param.Name == "" {
+				param.Name = child.Content(content)
+			} else {
+				param.Type = child.Content(content)
+			}
 		}
 	}
-
-	return routes
+	return param
 }
 
-// extractSimpleRoutes extracts simple route definitions.
-func (p *SwiftParser) extractSimpleRoutes(src string, groupMap map[string]string) []SwiftRoute {
-	var routes []SwiftRoute
-
-	matches := vaporRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 8 {
-			continue
-		}
-
-		line := countLines(src[:match[0]])
-
-		route := SwiftRoute{
-			Line: line,
-		}
-
-		// Extract router variable name (group 1)
-		var routerName string
-		if match[2] >= 0 && match[3] >= 0 {
-			routerName = src[match[2]:match[3]]
+// namedChildIndex converts a named child index to a child index.
+func namedChildIndex(node *sitter.Node, named int) int {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).IsNamed() {
+			if named == 0 {
+				return i
+			}
+			named--
 		}
+	}
+	return -1
+}
 
-		// Skip if this looks like a grouped route (already handled)
-		if strings.Contains(src[max(0, match[0]-50):match[0]], ".grouped") {
+// SwiftReturnType returns the type after "->" in a function declaration or
+// closure signature, or "" if none is declared.
+func SwiftReturnType(node *sitter.Node, content []byte) string {
+	arrow := false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if !child.IsNamed() {
+			arrow = arrow || child.Type() == "->"
 			continue
 		}
-
-		// Extract HTTP method (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			route.Method = strings.ToUpper(src[match[4]:match[5]])
-		}
-
-		// Extract path segments (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			pathStr := src[match[6]:match[7]]
-			prefix := groupMap[routerName]
-			route.Path = p.buildPath(prefix, pathStr)
-		}
-
-		if route.Path != "" {
-			routes = append(routes, route)
+		if arrow {
+			return child.Content(content)
 		}
 	}
-
-	return routes
+	return ""
 }
 
-// buildPath builds a complete path from a prefix and path segments.
-func (p *SwiftParser) buildPath(prefix, pathStr string) string {
-	var segments []string
-
-	// Add prefix if present
-	if prefix != "" {
-		segments = append(segments, prefix)
-	}
-
-	// Extract path segments from the string
-	segmentMatches := swiftPathSegmentRegex.FindAllStringSubmatch(pathStr, -1)
-	for _, segMatch := range segmentMatches {
-		if len(segMatch) > 1 {
-			segment := segMatch[1]
-			// Convert :param to {param}
-			segment = swiftPathParamRegex.ReplaceAllString(segment, "{$1}")
-			if segment != "" {
-				segments = append(segments, segment)
-			}
-		}
-	}
-
-	if len(segments) == 0 {
-		return "/"
+// walkNodes walks all nodes in the tree, calling fn for each node.
+// If fn returns false, it skips that node's children.
+func (p *SwiftParser) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	if p.walker != nil {
+		p.walker.Walk(node, fn)
+		return
 	}
+	Walk(node, fn)
+}
 
-	path := "/" + strings.Join(segments, "/")
-	// Convert any remaining :param to {param}
-	path = swiftPathParamRegex.ReplaceAllString(path, "{$1}")
-	return path
+// WalkNodes is a public method for walking nodes.
+func (p *SwiftParser) WalkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	p.walkNodes(node, fn)
 }
 
 // IsSupported returns whether Swift parsing is supported.
@@ -512,6 +425,30 @@ func (p *SwiftParser) SupportedExtensions() []string {
 	return []string{".swift"}
 }
 
+// Close cleans up parser resources.
+func (p *SwiftParser) Close() {
+	if p.parser != nil {
+		p.parser.Close()
+	}
+}
+
+// Close cleans up the parsed file resources.
+func (pf *ParsedSwiftFile) Close() {
+	if pf.Tree != nil {
+		pf.Tree.Close()
+	}
+}
+
+// HasImport checks if the file imports a module.
+func (pf *ParsedSwiftFile) HasImport(module string) bool {
+	for _, imp := range pf.Imports {
+		if imp == module {
+			return true
+		}
+	}
+	return false
+}
+
 // SwiftTypeToOpenAPI converts a Swift type to an OpenAPI type.
 func SwiftTypeToOpenAPI(swiftType string) (openAPIType string, format string) {
 	// Trim whitespace
@@ -522,21 +459,26 @@ func SwiftTypeToOpenAPI(swiftType string) (openAPIType string, format string) {
 		swiftType = strings.TrimSuffix(swiftType, "?")
 	}
 
+	// Handle dictionary types before arrays, which share the bracket syntax
+	if strings.HasPrefix(swiftType, "Dictionary<") || strings.HasPrefix(swiftType, "[") && strings.Contains(swiftType, ":") {
+		return "object", ""
+	}
+
 	// Handle array types
 	if strings.HasPrefix(swiftType, "[") && strings.HasSuffix(swiftType, "]") {
 		return "array", ""
 	}
-	if strings.HasPrefix(swiftType, "Array<") {
+	if strings.HasPrefix(swiftType, "Array<") || strings.HasPrefix(swiftType, "Set<") {
 		return "array", ""
 	}
 
-	// Handle dictionary types
-	if strings.HasPrefix(swiftType, "Dictionary<") || strings.HasPrefix(swiftType, "[") && strings.Contains(swiftType, ":") {
-		return "object", ""
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(swiftType); ok {
+		return t, f
 	}
 
 	switch swiftType {
-	case "String":
+	case "String", "Character", "Substring":
 		return "string", ""
 	case "Int", "Int8", "Int16", "Int32", "Int64":
 		return "integer", ""
@@ -544,7 +486,7 @@ func SwiftTypeToOpenAPI(swiftType string) (openAPIType string, format string) {
 		return "integer", ""
 	case "Float":
 		return "number", "float"
-	case "Double":
+	case "Double", "Decimal", "CGFloat":
 		return "number", "double"
 	case "Bool":
 		return "boolean", ""
@@ -561,12 +503,13 @@ func SwiftTypeToOpenAPI(swiftType string) (openAPIType string, format string) {
 	}
 }
 
+// swiftColonParamRegex matches Vapor's :param path components.
+var swiftColonParamRegex = regexp.MustCompile(`:(\w+)`)
+
 // ConvertVaporPathParams converts Vapor path parameters to OpenAPI format.
 // Vapor uses :param format.
 func ConvertVaporPathParams(path string) string {
-	// Convert :param to {param}
-	colonParamRegex := regexp.MustCompile(`:(\w+)`)
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return swiftColonParamRegex.ReplaceAllString(path, "{$1}")
 }
 
 // ExtractVaporPathParams extracts parameter names from a Vapor path.
@@ -574,19 +517,14 @@ func ExtractVaporPathParams(path string) []string {
 	var params []string
 
 	// Match :param style
-	colonRegex := regexp.MustCompile(`:(\w+)`)
-	for _, match := range colonRegex.FindAllStringSubmatch(path, -1) {
-		if len(match) > 1 {
-			params = append(params, match[1])
-		}
+	for _, match := range swiftColonParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, match[1])
 	}
 
 	// Match {param} style (already OpenAPI format)
 	braceRegex := regexp.MustCompile(`\{(\w+)\}`)
 	for _, match := range braceRegex.FindAllStringSubmatch(path, -1) {
-		if len(match) > 1 {
-			params = append(params, match[1])
-		}
+		params = append(params, match[1])
 	}
 
 	return params
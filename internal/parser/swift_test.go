@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const swiftModelSource = `
+import Vapor
+import Fluent
+
+final class Todo: Model, Content {
+    static let schema = "todos"
+
+    @ID(key: .id) var id: UUID?
+    @Field(key: "title") var title: String
+    @OptionalField(key: "notes") var notes: String?
+    var tags: [String] = []
+    var summary: String { title }
+    let width, height: Double
+
+    init() {}
+
+    func describe(prefix p: String) -> String {
+        return p + title
+    }
+}
+
+extension Todo: @unchecked Sendable {}
+
+func routes(_ app: Application) throws {
+    app.get("todos") { req async throws -> [Todo] in
+        try await Todo.query(on: req.db).all()
+    }
+}
+`
+
+func TestSwiftParser_Parse(t *testing.T) {
+	p := NewSwiftParser()
+	defer p.Close()
+
+	pf, err := p.Parse("Todo.swift", []byte(swiftModelSource))
+	require.NoError(t, err)
+	defer pf.Close()
+
+	assert.Equal(t, []string{"Vapor", "Fluent"}, pf.Imports)
+	assert.True(t, pf.HasImport("Vapor"))
+
+	require.Len(t, pf.Structs, 2)
+	todo := pf.Structs[0]
+	assert.Equal(t, "Todo", todo.Name)
+	assert.Equal(t, "class", todo.Kind)
+	assert.Equal(t, []string{"Model", "Content"}, todo.Inherits)
+	assert.True(t, todo.ConformsToContent)
+	assert.Equal(t, 5, todo.Line)
+
+	names := make([]string, 0, len(todo.Fields))
+	for _, f := range todo.Fields {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"id", "title", "notes", "tags", "width", "height"}, names)
+
+	assert.Equal(t, "ID", todo.Fields[0].Wrapper)
+	assert.Equal(t, "UUID", todo.Fields[0].Type)
+	assert.True(t, todo.Fields[0].IsOptional)
+	assert.Equal(t, "[String]", todo.Fields[3].Type)
+	assert.Equal(t, "Double", todo.Fields[5].Type)
+
+	require.Len(t, todo.Methods, 1)
+	describe := todo.Methods[0]
+	assert.Equal(t, "describe", describe.Name)
+	assert.Equal(t, "String", describe.ReturnType)
+	assert.Equal(t, []SwiftParameter{{Label: "prefix", Name: "p", Type: "String"}}, describe.Parameters)
+
+	ext := pf.Structs[1]
+	assert.Equal(t, "extension", ext.Kind)
+	assert.False(t, ext.ConformsToContent)
+
+	require.Len(t, pf.Functions, 1)
+	assert.Equal(t, "routes", pf.Functions[0].Name)
+	assert.Equal(t, []SwiftParameter{{Label: "_", Name: "app", Type: "Application"}}, pf.Functions[0].Parameters)
+}
+
+func TestSwiftTypeToOpenAPI(t *testing.T) {
+	tests := []struct {
+		swiftType string
+		typ       string
+		format    string
+	}{
+		{"String", "string", ""},
+		{"Int64", "integer", ""},
+		{"Double", "number", "double"},
+		{"UUID?", "string", "uuid"},
+		{"[Todo]", "array", ""},
+		{"[String: Int]", "object", ""},
+		{"Todo", "object", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.swiftType, func(t *testing.T) {
+			typ, format := SwiftTypeToOpenAPI(tt.swiftType)
+			assert.Equal(t, tt.typ, typ)
+			assert.Equal(t, tt.format, format)
+		})
+	}
+}
+
+// TestSwiftParser_Parse_MalformedInput guards against malformed sources
+// keeping the parser busy indefinitely.
+func TestSwiftParser_Parse_MalformedInput(t *testing.T) {
+	p := NewSwiftParser()
+	defer p.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if pf, err := p.Parse("bad.swift", []byte("return &routes[i]\nreturn nil\n// comment\nconst x =\n")); err == nil {
+			pf.Close()
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(DefaultBudget.MaxDuration + 10*time.Second):
+		t.Fatal("parsing malformed Swift did not stop")
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileTiming is the time spent parsing one file, summed over every parse.
+type FileTiming struct {
+	// File is the path of the parsed file
+	File string
+
+	// Parses is how often the file was parsed; plugins that make several
+	// passes parse a file more than once
+	Parses int
+
+	// Duration is the total time spent parsing the file
+	Duration time.Duration
+}
+
+var (
+	timingMu      sync.Mutex
+	timingEnabled bool
+	timings       map[string]*FileTiming
+)
+
+// EnableTiming starts recording how long each file takes to parse. Timing
+// is off by default so ordinary runs do not pay for the bookkeeping.
+func EnableTiming() {
+	timingMu.Lock()
+	defer timingMu.Unlock()
+	timingEnabled = true
+	if timings == nil {
+		timings = make(map[string]*FileTiming)
+	}
+}
+
+// Timings returns the parse timings recorded since the last call, sorted by
+// file, and clears them.
+func Timings() []FileTiming {
+	timingMu.Lock()
+	defer timingMu.Unlock()
+
+	result := make([]FileTiming, 0, len(timings))
+	for _, t := range timings {
+		result = append(result, *t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].File < result[j].File
+	})
+	if timingEnabled {
+		timings = make(map[string]*FileTiming)
+	}
+	return result
+}
+
+// recordParse adds a parse of file that began at start. Call it deferred at
+// the top of a Parse method.
+func recordParse(file string, start time.Time) {
+	timingMu.Lock()
+	defer timingMu.Unlock()
+	if !timingEnabled {
+		return
+	}
+	t, ok := timings[file]
+	if !ok {
+		t = &FileTiming{File: file}
+		timings[file] = t
+	}
+	t.Parses++
+	t.Duration += time.Since(start)
+}
@@ -9,14 +9,20 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/typescript/typescript"
+
+	"github.com/api2spec/api2spec/internal/typemap"
 )
 
 // TypeScriptParser provides TypeScript/JavaScript AST parsing capabilities using tree-sitter.
 type TypeScriptParser struct {
 	parser *sitter.Parser
+
+	// walker enforces the traversal budget while a file is being parsed
+	walker *Walker
 }
 
 // NewTypeScriptParser creates a new TypeScript parser.
@@ -69,11 +75,17 @@ type TSInterface struct {
 	// Extends lists extended interfaces
 	Extends []string
 
+	// IndexType is the value type of a [key: string]: T index signature
+	IndexType string
+
 	// IsExported indicates if the interface is exported
 	IsExported bool
 
 	// Line is the source line number
 	Line int
+
+	// File is the path of the declaring file
+	File string
 }
 
 // TSTypeAlias represents a TypeScript type alias.
@@ -84,6 +96,13 @@ type TSTypeAlias struct {
 	// Type is the underlying type definition
 	Type string
 
+	// Properties are the members when the alias is an object type literal
+	Properties []TSProperty
+
+	// IndexType is the value type of a [key: string]: T index signature
+	// in an object type literal
+	IndexType string
+
 	// Description is from JSDoc comment
 	Description string
 
@@ -92,6 +111,9 @@ type TSTypeAlias struct {
 
 	// Line is the source line number
 	Line int
+
+	// File is the path of the declaring file
+	File string
 }
 
 // TSProperty represents a property in a TypeScript interface or type.
@@ -110,6 +132,9 @@ type TSProperty struct {
 
 	// Description is from JSDoc comment
 	Description string
+
+	// Line is the source line number
+	Line int
 }
 
 // ZodSchema represents a Zod schema variable declaration.
@@ -134,7 +159,15 @@ func (p *TypeScriptParser) ParseSource(filename string, source string) (*ParsedT
 
 // Parse parses TypeScript source code from bytes.
 func (p *TypeScriptParser) Parse(filename string, content []byte) (*ParsedTSFile, error) {
-	tree, err := p.parser.ParseCtx(context.Background(), nil, content)
+	return p.ParseContext(context.Background(), filename, content)
+}
+
+// ParseContext is like Parse, but abandons parsing and AST traversal once
+// ctx is done.
+func (p *TypeScriptParser) ParseContext(ctx context.Context, filename string, content []byte) (*ParsedTSFile, error) {
+	defer recordParse(filename, time.Now())
+
+	tree, err := parseWithin(ctx, p.parser, filename, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse TypeScript: %w", err)
 	}
@@ -143,6 +176,9 @@ func (p *TypeScriptParser) Parse(filename string, content []byte) (*ParsedTSFile
 	if rootNode == nil {
 		return nil, fmt.Errorf("failed to get root node")
 	}
+	if rootNode.HasError() {
+		recordUnparsed(filename)
+	}
 
 	pf := &ParsedTSFile{
 		Path:        filename,
@@ -155,12 +191,29 @@ func (p *TypeScriptParser) Parse(filename string, content []byte) (*ParsedTSFile
 		Exports:     []string{},
 	}
 
-	// Extract definitions
+	// Extract definitions within the file's traversal budget
+	p.walker = NewWalker(filename, DefaultBudget)
+	p.walker.ctx = ctx
+	defer func() {
+		p.walker.finish()
+		p.walker = nil
+	}()
+
 	pf.Interfaces = p.ExtractInterfaces(rootNode, content)
 	pf.TypeAliases = p.ExtractTypeAliases(rootNode, content)
+	for i := range pf.Interfaces {
+		pf.Interfaces[i].File = filename
+	}
+	for i := range pf.TypeAliases {
+		pf.TypeAliases[i].File = filename
+	}
 	pf.ZodSchemas = p.ExtractZodSchemas(rootNode, content)
 	pf.Exports = p.ExtractExports(rootNode, content)
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	return pf, nil
 }
 
@@ -180,10 +233,13 @@ func (p *TypeScriptParser) ExtractInterfaces(rootNode *sitter.Node, content []by
 	seen := make(map[int]bool)
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if isGlobalAugmentation(node) {
+			return false
+		}
 		// Check export_statement that wraps interface_declaration
 		if node.Type() == "export_statement" {
 			for i := 0; i < int(node.ChildCount()); i++ {
-				child := node.Child(i)
+				child := unwrapAmbient(node.Child(i))
 				if child.Type() == "interface_declaration" {
 					iface := p.parseInterfaceDecl(child, content)
 					if iface != nil {
@@ -215,7 +271,8 @@ func (p *TypeScriptParser) ExtractInterfaces(rootNode *sitter.Node, content []by
 // parseInterfaceDecl parses an interface_declaration node.
 func (p *TypeScriptParser) parseInterfaceDecl(node *sitter.Node, content []byte) *TSInterface {
 	iface := &TSInterface{
-		Line: int(node.StartPoint().Row) + 1,
+		Line:        int(node.StartPoint().Row) + 1,
+		Description: p.DocComment(node, content),
 	}
 
 	// Find interface name
@@ -235,6 +292,7 @@ func (p *TypeScriptParser) parseInterfaceDecl(node *sitter.Node, content []byte)
 		case "object_type", "interface_body":
 			// Extract properties
 			iface.Properties = p.extractObjectProperties(child, content)
+			iface.IndexType = p.indexSignatureType(child, content)
 		}
 	}
 
@@ -260,15 +318,48 @@ func (p *TypeScriptParser) extractObjectProperties(node *sitter.Node, content []
 	return properties
 }
 
+// indexSignatureType returns the value type of the index signature among
+// the members of an object_type or interface_body node, if any.
+func (p *TypeScriptParser) indexSignatureType(node *sitter.Node, content []byte) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		member := node.NamedChild(i)
+		if member.Type() != "index_signature" {
+			continue
+		}
+		for j := 0; j < int(member.NamedChildCount()); j++ {
+			child := member.NamedChild(j)
+			if child.Type() == "type_annotation" && child.ChildCount() > 1 {
+				return child.Child(1).Content(content)
+			}
+		}
+	}
+	return ""
+}
+
+// ExtractObjectTypeProperties extracts properties from an object_type node
+// such as the inline type literal in `Request<{ id: string }>`.
+func (p *TypeScriptParser) ExtractObjectTypeProperties(node *sitter.Node, content []byte) []TSProperty {
+	if node == nil {
+		return nil
+	}
+	return p.extractObjectProperties(node, content)
+}
+
 // parsePropertySignature parses a property_signature node.
 func (p *TypeScriptParser) parsePropertySignature(node *sitter.Node, content []byte) *TSProperty {
-	prop := &TSProperty{}
+	prop := &TSProperty{
+		Line:        int(node.StartPoint().Row) + 1,
+		Description: p.DocComment(node, content),
+	}
 
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
 		switch child.Type() {
 		case "property_identifier":
 			prop.Name = child.Content(content)
+		case "string":
+			// Quoted names such as 'x-request-id'
+			prop.Name = strings.Trim(child.Content(content), `"'`)
 		case "?":
 			prop.IsOptional = true
 		case "readonly":
@@ -285,6 +376,32 @@ func (p *TypeScriptParser) parsePropertySignature(node *sitter.Node, content []b
 	return prop
 }
 
+// DocComment returns the documentation of a declaration: the JSDoc block
+// before it (or before its export statement) or, failing that, a // comment
+// trailing it on the same line.
+func (p *TypeScriptParser) DocComment(node *sitter.Node, content []byte) string {
+	target := node
+	if parent := node.Parent(); parent != nil && parent.Type() == "export_statement" {
+		target = parent
+	}
+	if prev := target.PrevSibling(); prev != nil && prev.Type() == "comment" {
+		if text := prev.Content(content); strings.HasPrefix(text, "/**") {
+			return docCommentText(text)
+		}
+	}
+
+	next := node.NextSibling()
+	for next != nil && (next.Type() == ";" || next.Type() == ",") {
+		next = next.NextSibling()
+	}
+	if next != nil && next.Type() == "comment" && next.StartPoint().Row == node.EndPoint().Row {
+		if text := next.Content(content); strings.HasPrefix(text, "//") {
+			return docCommentText(text)
+		}
+	}
+	return ""
+}
+
 // ExtractTypeAliases extracts all type alias definitions from the AST.
 func (p *TypeScriptParser) ExtractTypeAliases(rootNode *sitter.Node, content []byte) []TSTypeAlias {
 	var typeAliases []TSTypeAlias
@@ -292,10 +409,13 @@ func (p *TypeScriptParser) ExtractTypeAliases(rootNode *sitter.Node, content []b
 	seen := make(map[int]bool)
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if isGlobalAugmentation(node) {
+			return false
+		}
 		// Check export_statement that wraps type_alias_declaration
 		if node.Type() == "export_statement" {
 			for i := 0; i < int(node.ChildCount()); i++ {
-				child := node.Child(i)
+				child := unwrapAmbient(node.Child(i))
 				if child.Type() == "type_alias_declaration" {
 					alias := p.parseTypeAliasDecl(child, content)
 					if alias != nil {
@@ -327,7 +447,8 @@ func (p *TypeScriptParser) ExtractTypeAliases(rootNode *sitter.Node, content []b
 // parseTypeAliasDecl parses a type_alias_declaration node.
 func (p *TypeScriptParser) parseTypeAliasDecl(node *sitter.Node, content []byte) *TSTypeAlias {
 	alias := &TSTypeAlias{
-		Line: int(node.StartPoint().Row) + 1,
+		Line:        int(node.StartPoint().Row) + 1,
+		Description: p.DocComment(node, content),
 	}
 
 	foundEquals := false
@@ -348,6 +469,10 @@ func (p *TypeScriptParser) parseTypeAliasDecl(node *sitter.Node, content []byte)
 			// The type value comes after the '='
 			if foundEquals && alias.Type == "" {
 				alias.Type = child.Content(content)
+				if childType == "object_type" {
+					alias.Properties = p.extractObjectProperties(child, content)
+					alias.IndexType = p.indexSignatureType(child, content)
+				}
 			}
 		}
 	}
@@ -355,6 +480,37 @@ func (p *TypeScriptParser) parseTypeAliasDecl(node *sitter.Node, content []byte)
 	return alias
 }
 
+// unwrapAmbient returns the declaration inside an ambient_declaration
+// (`export declare interface X {}` in .d.ts files), or node itself.
+func unwrapAmbient(node *sitter.Node) *sitter.Node {
+	if node.Type() != "ambient_declaration" {
+		return node
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "interface_declaration", "type_alias_declaration":
+			return child
+		}
+	}
+	return node
+}
+
+// isGlobalAugmentation reports whether node is a `declare global { ... }`
+// block. Those augment runtime globals (Express.Request, Window) rather
+// than declare API types, so their members are not extracted.
+func isGlobalAugmentation(node *sitter.Node) bool {
+	if node.Type() != "ambient_declaration" {
+		return false
+	}
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == "global" {
+			return true
+		}
+	}
+	return false
+}
+
 // ExtractZodSchemas extracts Zod schema definitions from the AST.
 func (p *TypeScriptParser) ExtractZodSchemas(rootNode *sitter.Node, content []byte) []ZodSchema {
 	var schemas []ZodSchema
@@ -414,6 +570,14 @@ func (p *TypeScriptParser) extractZodFromDeclaration(node *sitter.Node, content
 				}
 			}
 
+			// registry.register('User', z.object({...})) of
+			// @asteasolutions/zod-to-openapi returns the schema it registers
+			if valueNode != nil && strings.HasSuffix(p.GetCalleeText(valueNode, content), ".register") {
+				if args := p.GetCallArguments(valueNode, content); len(args) == 2 {
+					valueNode = args[1]
+				}
+			}
+
 			if name != "" && valueNode != nil && p.isZodCall(valueNode, content) {
 				schemas = append(schemas, ZodSchema{
 					Name: name,
@@ -485,19 +649,13 @@ func (p *TypeScriptParser) ExtractExports(rootNode *sitter.Node, content []byte)
 }
 
 // walkNodes walks all nodes in the tree, calling fn for each node.
-// If fn returns false, it stops recursing into that node's children.
+// If fn returns false, it skips that node's children.
 func (p *TypeScriptParser) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
+	if p.walker != nil {
+		p.walker.Walk(node, fn)
 		return
 	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	Walk(node, fn)
 }
 
 // IsSupported returns whether TypeScript parsing is fully implemented.
@@ -529,6 +687,11 @@ func TypeScriptTypeToOpenAPI(tsType string) (openAPIType string, format string)
 	// Trim whitespace
 	tsType = strings.TrimSpace(tsType)
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(tsType); ok {
+		return t, f
+	}
+
 	switch tsType {
 	case "string":
 		return "string", ""
@@ -737,6 +900,244 @@ func (p *TypeScriptParser) ExtractStringLiteral(node *sitter.Node, content []byt
 	return text, true
 }
 
+// ResolveStringExpression evaluates a string-valued expression such as a
+// route path. It handles string and template literals, + concatenation, and
+// references to const declarations anywhere in the file whose values are
+// themselves resolvable. Substitutions that cannot be resolved are emitted
+// as {name} placeholders and their source text is returned in unresolved; a
+// leading placeholder usually stands for a path prefix, so it gets a leading
+// slash. ok is false if node is not a string expression.
+func (p *TypeScriptParser) ResolveStringExpression(node *sitter.Node, content []byte) (value string, unresolved []string, ok bool) {
+	if node == nil {
+		return "", nil, false
+	}
+	if node.Type() == "string" || (node.Type() == "template_string" && !hasSubstitution(node)) {
+		value, ok = p.ExtractStringLiteral(node, content)
+		return value, nil, ok
+	}
+
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	r := &stringResolver{parser: p, content: content, constants: p.findStringConstants(root, content)}
+	if !r.isString(node) {
+		return "", nil, false
+	}
+	value = r.eval(node, 0)
+	if strings.HasPrefix(value, "{") && len(r.unresolved) > 0 {
+		value = "/" + value
+	}
+	return value, r.unresolved, true
+}
+
+// ResolveStringArray returns the values of an array of string
+// expressions, such as ['GET', 'POST'], possibly through a const
+// reference or an as const assertion. Elements that do not resolve fully
+// are skipped. ok is false if node is not an array expression.
+func (p *TypeScriptParser) ResolveStringArray(node *sitter.Node, content []byte) (values []string, ok bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var constants map[string]*sitter.Node
+	for depth := 0; depth <= maxConstantDepth; depth++ {
+		switch node.Type() {
+		case "parenthesized_expression", "as_expression", "satisfies_expression":
+			if node.NamedChildCount() == 0 {
+				return nil, false
+			}
+			node = node.NamedChild(0)
+			continue
+		case "identifier":
+			if constants == nil {
+				root := node
+				for root.Parent() != nil {
+					root = root.Parent()
+				}
+				constants = p.findStringConstants(root, content)
+			}
+			value, found := constants[node.Content(content)]
+			if !found {
+				return nil, false
+			}
+			node = value
+			continue
+		case "array":
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				if value, unresolved, isString := p.ResolveStringExpression(node.NamedChild(i), content); isString && len(unresolved) == 0 {
+					values = append(values, value)
+				}
+			}
+			return values, true
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// hasSubstitution reports whether a template literal contains ${...}.
+func hasSubstitution(node *sitter.Node) bool {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == "template_substitution" {
+			return true
+		}
+	}
+	return false
+}
+
+// findStringConstants returns the value nodes of const declarations by name.
+// Names declared more than once, in different scopes, are ambiguous and
+// left out.
+func (p *TypeScriptParser) findStringConstants(root *sitter.Node, content []byte) map[string]*sitter.Node {
+	constants := make(map[string]*sitter.Node)
+	ambiguous := make(map[string]bool)
+
+	p.walkNodes(root, func(n *sitter.Node) bool {
+		if n.Type() != "lexical_declaration" || n.ChildCount() == 0 || n.Child(0).Type() != "const" {
+			return true
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			decl := n.NamedChild(i)
+			if decl.Type() != "variable_declarator" {
+				continue
+			}
+			name := decl.ChildByFieldName("name")
+			value := decl.ChildByFieldName("value")
+			if name == nil || value == nil || name.Type() != "identifier" {
+				continue
+			}
+			key := name.Content(content)
+			if _, seen := constants[key]; seen {
+				ambiguous[key] = true
+			}
+			constants[key] = value
+		}
+		return true
+	})
+
+	for name := range ambiguous {
+		delete(constants, name)
+	}
+	return constants
+}
+
+// stringResolver evaluates string expressions against a file's constants.
+type stringResolver struct {
+	parser     *TypeScriptParser
+	content    []byte
+	constants  map[string]*sitter.Node
+	unresolved []string
+}
+
+// maxConstantDepth bounds how many constant references are followed, which
+// also stops reference cycles.
+const maxConstantDepth = 16
+
+// isString reports whether node is an expression that evaluates to a string.
+func (r *stringResolver) isString(node *sitter.Node) bool {
+	return r.isStringDepth(node, 0)
+}
+
+func (r *stringResolver) isStringDepth(node *sitter.Node, depth int) bool {
+	if depth > maxConstantDepth {
+		return false
+	}
+	switch node.Type() {
+	case "string", "template_string":
+		return true
+	case "parenthesized_expression", "as_expression", "satisfies_expression":
+		return node.NamedChildCount() > 0 && r.isStringDepth(node.NamedChild(0), depth)
+	case "binary_expression":
+		left, right := node.ChildByFieldName("left"), node.ChildByFieldName("right")
+		op := node.ChildByFieldName("operator")
+		return op != nil && op.Type() == "+" && left != nil && right != nil &&
+			(r.isStringDepth(left, depth) || r.isStringDepth(right, depth))
+	case "identifier":
+		value, ok := r.constants[node.Content(r.content)]
+		return ok && r.isStringDepth(value, depth+1)
+	}
+	return false
+}
+
+// eval returns the value of a string expression, substituting placeholders
+// for the parts it cannot resolve.
+func (r *stringResolver) eval(node *sitter.Node, depth int) string {
+	switch node.Type() {
+	case "string":
+		value, _ := r.parser.ExtractStringLiteral(node, r.content)
+		return value
+	case "template_string":
+		var b strings.Builder
+		for i := 0; i < int(node.ChildCount()); i++ {
+			child := node.Child(i)
+			switch child.Type() {
+			case "string_fragment", "escape_sequence":
+				b.WriteString(child.Content(r.content))
+			case "template_substitution":
+				if child.NamedChildCount() > 0 {
+					b.WriteString(r.substitute(child.NamedChild(0), depth))
+				}
+			}
+		}
+		return b.String()
+	case "parenthesized_expression", "as_expression", "satisfies_expression":
+		return r.eval(node.NamedChild(0), depth)
+	case "binary_expression":
+		return r.substitute(node.ChildByFieldName("left"), depth) + r.substitute(node.ChildByFieldName("right"), depth)
+	case "identifier":
+		return r.eval(r.constants[node.Content(r.content)], depth+1)
+	}
+	return ""
+}
+
+// substitute returns the value of an interpolated expression, or a
+// placeholder named after it if it is not a resolvable string.
+func (r *stringResolver) substitute(node *sitter.Node, depth int) string {
+	if node == nil {
+		return ""
+	}
+	if depth <= maxConstantDepth {
+		if r.isStringDepth(node, depth) {
+			return r.eval(node, depth)
+		}
+		// Numeric constants such as API_VERSION = 2
+		if node.Type() == "number" {
+			return node.Content(r.content)
+		}
+		if node.Type() == "identifier" {
+			if value, ok := r.constants[node.Content(r.content)]; ok && value.Type() == "number" {
+				return value.Content(r.content)
+			}
+		}
+	}
+
+	text := node.Content(r.content)
+	r.unresolved = append(r.unresolved, text)
+	return "{" + placeholderName(text) + "}"
+}
+
+// placeholderName derives a path parameter name from an expression, using
+// its last identifier (config.apiPrefix becomes apiPrefix).
+func placeholderName(expr string) string {
+	end := len(expr)
+	for end > 0 && !isIdentByte(expr[end-1]) {
+		end--
+	}
+	start := end
+	for start > 0 && isIdentByte(expr[start-1]) {
+		start--
+	}
+	if start == end || (expr[start] >= '0' && expr[start] <= '9') {
+		return "param"
+	}
+	return expr[start:end]
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
 // GetMemberExpressionParts returns the object and property of a member_expression.
 func (p *TypeScriptParser) GetMemberExpressionParts(node *sitter.Node, content []byte) (object, property string) {
 	if node.Type() != "member_expression" {
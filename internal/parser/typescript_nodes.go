@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Helpers for reading calls, object literals and strings in JavaScript and
+// TypeScript syntax trees.
+
+// UnwrapExpression strips `as const`, satisfies, non-null assertions and
+// parentheses from an expression.
+func UnwrapExpression(node *sitter.Node) *sitter.Node {
+	for node != nil {
+		switch node.Type() {
+		case "as_expression", "satisfies_expression", "parenthesized_expression", "non_null_expression":
+			if node.NamedChildCount() == 0 {
+				return node
+			}
+			node = node.NamedChild(0)
+		default:
+			return node
+		}
+	}
+	return node
+}
+
+// CallArguments returns the named arguments of a call, without comments.
+func CallArguments(call *sitter.Node) []*sitter.Node {
+	args := call.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+	var result []*sitter.Node
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		if arg := args.NamedChild(i); arg.Type() != "comment" {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
+
+// CalledMethod returns the method name of a member call (app.get -> get).
+func CalledMethod(call *sitter.Node, content []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != "member_expression" {
+		return ""
+	}
+	if prop := fn.ChildByFieldName("property"); prop != nil {
+		return prop.Content(content)
+	}
+	return ""
+}
+
+// CalledFunction returns the unqualified name of the function a call
+// calls (schemaHooks.validateData -> validateData).
+func CalledFunction(call *sitter.Node, content []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil {
+		return ""
+	}
+	if fn.Type() == "member_expression" {
+		return CalledMethod(call, content)
+	}
+	return fn.Content(content)
+}
+
+// ObjectPairs returns the key-value pairs of an object literal.
+func ObjectPairs(object *sitter.Node) []*sitter.Node {
+	var result []*sitter.Node
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		if child := object.NamedChild(i); child.Type() == "pair" {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// PairKey returns the unquoted key of a pair.
+func PairKey(pair *sitter.Node, content []byte) string {
+	key := pair.ChildByFieldName("key")
+	if key == nil {
+		return ""
+	}
+	return strings.Trim(key.Content(content), `"'`)
+}
+
+// ObjectProperty returns the value of a key in an object literal, or nil.
+func ObjectProperty(object *sitter.Node, key string, content []byte) *sitter.Node {
+	if object == nil {
+		return nil
+	}
+	for _, pair := range ObjectPairs(object) {
+		if PairKey(pair, content) == key {
+			return pair.ChildByFieldName("value")
+		}
+	}
+	return nil
+}
+
+// StringLiteral returns the value of a string literal, or of a template
+// string without substitutions.
+func StringLiteral(node *sitter.Node, content []byte) (string, bool) {
+	node = UnwrapExpression(node)
+	if node == nil {
+		return "", false
+	}
+	if node.Type() == "string" || (node.Type() == "template_string" && node.NamedChildCount() == 0) {
+		return StringValue(node, content), true
+	}
+	return "", false
+}
+
+// StringLiterals returns the strings of a string or array of strings.
+func StringLiterals(node *sitter.Node, content []byte) []string {
+	if node = UnwrapExpression(node); node == nil {
+		return nil
+	}
+	if node.Type() != "array" {
+		if s, ok := StringLiteral(node, content); ok {
+			return []string{s}
+		}
+		return nil
+	}
+	var values []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if s, ok := StringLiteral(node.NamedChild(i), content); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// StringValue returns the value of a string literal, or its source text.
+func StringValue(node *sitter.Node, content []byte) string {
+	text := node.Content(content)
+	if len(text) >= 2 && strings.ContainsRune(`"'`+"`", rune(text[0])) && text[len(text)-1] == text[0] {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
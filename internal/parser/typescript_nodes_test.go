@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeScriptNodeHelpers(t *testing.T) {
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	source := "server.route(/* users */ { path: '/users', 'tags': ['a', \"b\"], options: ({ auth: false } as const) })\n"
+	pf, err := parser.ParseSource("routes.ts", source)
+	require.NoError(t, err)
+	defer pf.Close()
+	content := []byte(source)
+
+	var call *sitter.Node
+	Walk(pf.RootNode, func(node *sitter.Node) bool {
+		if node.Type() == "call_expression" && call == nil {
+			call = node
+		}
+		return true
+	})
+	require.NotNil(t, call)
+	assert.Equal(t, "route", CalledMethod(call, content))
+	assert.Equal(t, "route", CalledFunction(call, content))
+
+	args := CallArguments(call)
+	require.Len(t, args, 1, "comments are not arguments")
+	object := args[0]
+	assert.Len(t, ObjectPairs(object), 3)
+	assert.Equal(t, "tags", PairKey(ObjectPairs(object)[1], content))
+
+	path, ok := StringLiteral(ObjectProperty(object, "path", content), content)
+	assert.True(t, ok)
+	assert.Equal(t, "/users", path)
+	assert.Equal(t, []string{"a", "b"}, StringLiterals(ObjectProperty(object, "tags", content), content))
+
+	options := UnwrapExpression(ObjectProperty(object, "options", content))
+	require.NotNil(t, options)
+	assert.Equal(t, "object", options.Type())
+	assert.Equal(t, "false", ObjectProperty(options, "auth", content).Content(content))
+
+	assert.Nil(t, ObjectProperty(object, "missing", content))
+	assert.Nil(t, ObjectProperty(nil, "path", content))
+	assert.Nil(t, StringLiterals(nil, content))
+}
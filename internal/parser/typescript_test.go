@@ -76,6 +76,8 @@ interface ExtendedUser extends User {
 	require.NotNil(t, user)
 	assert.Len(t, user.Properties, 4)
 	assert.False(t, user.IsExported)
+	assert.Equal(t, "test.ts", user.File)
+	assert.Equal(t, 2, user.Line)
 
 	// Check properties
 	idProp := findProperty(user.Properties, "id")
@@ -87,6 +89,7 @@ interface ExtendedUser extends User {
 	require.NotNil(t, ageProp)
 	assert.Equal(t, "number", ageProp.Type)
 	assert.True(t, ageProp.IsOptional)
+	assert.Equal(t, 6, ageProp.Line)
 
 	// Check CreateUserRequest is exported
 	createUser := findInterface(pf.Interfaces, "CreateUserRequest")
@@ -129,6 +132,144 @@ type UserList = User[];
 	assert.True(t, userID.IsExported)
 }
 
+func TestTypeScriptParser_IndexSignatures(t *testing.T) {
+	const testCode = `
+interface Counters {
+  total: number;
+  [name: string]: number;
+}
+
+type Flags = { [key: string]: boolean };
+`
+
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	pf, err := parser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	counters := findInterface(pf.Interfaces, "Counters")
+	require.NotNil(t, counters)
+	assert.Equal(t, "number", counters.IndexType)
+	assert.Len(t, counters.Properties, 1)
+
+	flags := findTypeAlias(pf.TypeAliases, "Flags")
+	require.NotNil(t, flags)
+	assert.Equal(t, "boolean", flags.IndexType)
+}
+
+func TestTypeScriptParser_DocComments(t *testing.T) {
+	const testCode = `
+/**
+ * A registered user.
+ * @public
+ */
+export interface User {
+  /** Unique identifier. */
+  id: string;
+  email: string; // primary contact address
+  // not documentation for name
+  name: string;
+}
+
+/** Paging options. */
+type Page = {
+  /**
+   * Page size.
+   * @default 20
+   */
+  size: number;
+};
+
+/**
+ * Audit trail entry.
+ * @internal
+ */
+export interface AuditEntry {
+  actor: string;
+}
+`
+
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	pf, err := parser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	user := findInterface(pf.Interfaces, "User")
+	require.NotNil(t, user)
+	assert.Equal(t, "A registered user.", user.Description)
+	require.Len(t, user.Properties, 3)
+	assert.Equal(t, "Unique identifier.", user.Properties[0].Description)
+	assert.Equal(t, "primary contact address", user.Properties[1].Description)
+	assert.Empty(t, user.Properties[2].Description)
+
+	page := findTypeAlias(pf.TypeAliases, "Page")
+	require.NotNil(t, page)
+	assert.Equal(t, "Paging options.", page.Description)
+	require.Len(t, page.Properties, 1)
+	assert.Equal(t, "Page size.", page.Properties[0].Description)
+
+	// Visibility tags become directives
+	audit := findInterface(pf.Interfaces, "AuditEntry")
+	require.NotNil(t, audit)
+	assert.Equal(t, "Audit trail entry.\napi2spec:visibility internal", audit.Description)
+}
+
+func TestTypeScriptParser_ParseDeclarationFile(t *testing.T) {
+	const testCode = `
+declare namespace API {
+  interface User {
+    id: string
+    name?: string
+  }
+}
+
+export declare interface Item {
+  sku: string
+}
+
+export declare type Price = {
+  amount: number
+  readonly currency: string
+}
+
+declare global {
+  interface Window {
+    api: unknown
+  }
+}
+
+declare function handler(): void;
+`
+
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	pf, err := parser.ParseSource("types.d.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	user := findInterface(pf.Interfaces, "User")
+	require.NotNil(t, user)
+	assert.Len(t, user.Properties, 2)
+
+	item := findInterface(pf.Interfaces, "Item")
+	require.NotNil(t, item)
+	assert.True(t, item.IsExported)
+
+	assert.Nil(t, findInterface(pf.Interfaces, "Window"))
+
+	price := findTypeAlias(pf.TypeAliases, "Price")
+	require.NotNil(t, price)
+	assert.True(t, price.IsExported)
+	require.Len(t, price.Properties, 2)
+	assert.Equal(t, "amount", price.Properties[0].Name)
+	assert.True(t, price.Properties[1].IsReadonly)
+}
+
 func TestTypeScriptParser_ExtractZodSchemas(t *testing.T) {
 	const testCode = `
 import { z } from 'zod';
@@ -456,6 +597,101 @@ func TestTypeScriptParser_ExtractStringLiteral(t *testing.T) {
 	assert.Equal(t, "/users/:id", str)
 }
 
+func TestTypeScriptParser_ResolveStringExpression(t *testing.T) {
+	const testCode = `
+const prefix = '/api'
+const VERSION = 2
+const USERS = ` + "`${prefix}/v${VERSION}/users`" + ` as const
+function handlers(router) {
+  const scoped = '/a'
+  router.get(` + "`${prefix}/users/${VERSION}/items`" + `, h)
+  router.get(USERS + '/:id', h)
+  router.get(` + "`${config.basePath}/health/${tenant()}`" + `, h)
+  router.get(` + "`/static`" + `, h)
+  router.get(ROUTE, h)
+  router.get(scoped, h)
+}
+function other() { const scoped = '/b' }
+`
+
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	pf, err := parser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	var paths []*sitter.Node
+	for _, call := range parser.FindCallExpressions(pf.RootNode, pf.Content) {
+		if args := parser.GetCallArguments(call, pf.Content); len(args) == 2 {
+			paths = append(paths, args[0])
+		}
+	}
+	require.Len(t, paths, 6)
+
+	tests := []struct {
+		value      string
+		unresolved []string
+		ok         bool
+	}{
+		{"/api/users/2/items", nil, true},
+		{"/api/v2/users/:id", nil, true},
+		{"/{basePath}/health/{tenant}", []string{"config.basePath", "tenant()"}, true},
+		{"/static", nil, true},
+		{"", nil, false},
+		// Declared in two scopes
+		{"", nil, false},
+	}
+	for i, tt := range tests {
+		value, unresolved, ok := parser.ResolveStringExpression(paths[i], pf.Content)
+		assert.Equal(t, tt.ok, ok, "path %d", i)
+		assert.Equal(t, tt.value, value, "path %d", i)
+		assert.Equal(t, tt.unresolved, unresolved, "path %d", i)
+	}
+}
+
+func TestTypeScriptParser_ResolveStringArray(t *testing.T) {
+	const testCode = `
+const READ = ['GET', 'HEAD'] as const
+const WRITE = READ
+const prefix = '/api'
+route(['POST', 'PUT'])
+route(WRITE)
+route([` + "`${prefix}/a`" + `, '/b', ` + "`${other}/c`" + `])
+route('GET')
+route(UNKNOWN)
+`
+
+	parser := NewTypeScriptParser()
+	defer parser.Close()
+
+	pf, err := parser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	var args []*sitter.Node
+	for _, call := range parser.FindCallExpressions(pf.RootNode, pf.Content) {
+		args = append(args, parser.GetCallArguments(call, pf.Content)[0])
+	}
+	require.Len(t, args, 5)
+
+	tests := []struct {
+		values []string
+		ok     bool
+	}{
+		{[]string{"POST", "PUT"}, true},
+		{[]string{"GET", "HEAD"}, true},
+		{[]string{"/api/a", "/b"}, true},
+		{nil, false},
+		{nil, false},
+	}
+	for i, tt := range tests {
+		values, ok := parser.ResolveStringArray(args[i], pf.Content)
+		assert.Equal(t, tt.ok, ok, "argument %d", i)
+		assert.Equal(t, tt.values, values, "argument %d", i)
+	}
+}
+
 func TestTypeScriptParser_GetMemberExpressionParts(t *testing.T) {
 	const testCode = `app.get('/users', handler);`
 
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+var (
+	unparsedMu sync.Mutex
+	unparsed   = make(map[string]bool)
+)
+
+// UnparsedFiles returns the files recorded since the last call whose
+// syntax the parsers could not handle, sorted, and clears them. Extraction
+// from such files is partial at best.
+func UnparsedFiles() []string {
+	unparsedMu.Lock()
+	defer unparsedMu.Unlock()
+	files := slices.Sorted(maps.Keys(unparsed))
+	clear(unparsed)
+	return files
+}
+
+// recordUnparsed records that file has syntax errors.
+func recordUnparsed(file string) {
+	unparsedMu.Lock()
+	defer unparsedMu.Unlock()
+	unparsed[file] = true
+}
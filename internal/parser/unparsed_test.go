@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnparsedFiles(t *testing.T) {
+	UnparsedFiles()
+
+	_, _ = NewGoParser().ParseSource("broken.go", "package main\nfunc main( {\n")
+	_, _ = NewGoParser().ParseSource("ok.go", "package main\n")
+	_, _ = NewTypeScriptParser().ParseSource("broken.ts", "export class {{ ;\n")
+	_, _ = NewTypeScriptParser().ParseSource("ok.ts", "export const x = 1;\n")
+	_, _ = NewPythonParser().ParseSource("broken.py", "def f(:\n")
+
+	assert.Equal(t, []string{"broken.go", "broken.py", "broken.ts"}, UnparsedFiles())
+	assert.Empty(t, UnparsedFiles())
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Budget limits how much work traversing a single file's AST may take.
+// A zero field means no limit.
+type Budget struct {
+	// MaxNodes is the number of nodes that may be visited per file
+	MaxNodes int
+
+	// MaxDuration is the time that may be spent walking a file
+	MaxDuration time.Duration
+}
+
+// DefaultBudget is the traversal budget applied to each parsed file. It is
+// far above what hand-written sources need and only trips on pathological
+// input such as large minified bundles.
+var DefaultBudget = Budget{
+	MaxNodes:    5_000_000,
+	MaxDuration: 30 * time.Second,
+}
+
+// FileDiagnostic is a problem with a file as a whole rather than with a
+// single route, such as an exhausted traversal budget.
+type FileDiagnostic struct {
+	// File is the path of the file
+	File string
+
+	// Message describes the problem
+	Message string
+}
+
+var (
+	diagnosticsMu sync.Mutex
+	diagnostics   []FileDiagnostic
+)
+
+// Diagnostics returns the file diagnostics recorded since the last call and
+// clears them.
+func Diagnostics() []FileDiagnostic {
+	diagnosticsMu.Lock()
+	defer diagnosticsMu.Unlock()
+	d := diagnostics
+	diagnostics = nil
+	return d
+}
+
+func addDiagnostic(d FileDiagnostic) {
+	diagnosticsMu.Lock()
+	defer diagnosticsMu.Unlock()
+	diagnostics = append(diagnostics, d)
+}
+
+// parseWithin parses content for file with parser, giving up once ctx is
+// done or DefaultBudget.MaxDuration has passed. A file that runs out of time
+// is skipped with a diagnostic; malformed input can keep some grammars
+// parsing indefinitely.
+func parseWithin(ctx context.Context, parser *sitter.Parser, file string, content []byte) (*sitter.Tree, error) {
+	// The parser's own limit, unlike a context deadline, leaves it reusable
+	parser.SetOperationLimit(int(DefaultBudget.MaxDuration.Microseconds()))
+
+	tree, err := parser.ParseCtx(ctx, nil, content)
+	if err == nil {
+		return tree, nil
+	}
+	// An abandoned parse would otherwise be resumed by the next one
+	parser.Reset()
+	if errors.Is(err, sitter.ErrOperationLimit) {
+		err = fmt.Errorf("parsing stopped after %s; the file was skipped", DefaultBudget.MaxDuration)
+		addDiagnostic(FileDiagnostic{File: file, Message: err.Error()})
+	}
+	return nil, err
+}
+
+// Walk visits node and its descendants in document order, calling fn for
+// each. If fn returns false, the node's children are skipped. It uses an
+// explicit stack, so arbitrarily deep trees cannot overflow the goroutine
+// stack.
+func Walk(node *sitter.Node, fn func(*sitter.Node) bool) {
+	walk(node, fn, nil)
+}
+
+// walk is Walk with an optional visit hook that may stop the traversal.
+func walk(node *sitter.Node, fn func(*sitter.Node) bool, visit func() bool) {
+	if node == nil {
+		return
+	}
+
+	stack := []*sitter.Node{node}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if visit != nil && !visit() {
+			return
+		}
+		if !fn(n) {
+			continue
+		}
+
+		// Push in reverse so the first child is visited first
+		for i := int(n.ChildCount()) - 1; i >= 0; i-- {
+			if child := n.Child(i); child != nil {
+				stack = append(stack, child)
+			}
+		}
+	}
+}
+
+// Walker walks the trees of one file within a budget shared by all its
+// traversals. Once the budget is spent, further walks visit nothing.
+type Walker struct {
+	file   string
+	budget Budget
+	start  time.Time
+	nodes  int
+	err    error
+
+	// ctx, if set, stops the walker once done
+	ctx context.Context
+}
+
+// NewWalker creates a walker for file that enforces budget.
+func NewWalker(file string, budget Budget) *Walker {
+	return &Walker{file: file, budget: budget, start: time.Now()}
+}
+
+// Walk is like the package-level Walk, but counts visited nodes against the
+// walker's budget and stops once it is exceeded.
+func (w *Walker) Walk(node *sitter.Node, fn func(*sitter.Node) bool) {
+	if w.err != nil {
+		return
+	}
+	walk(node, fn, w.visit)
+}
+
+// visit charges one node to the budget, reporting false once it is spent.
+func (w *Walker) visit() bool {
+	if w.err != nil {
+		return false
+	}
+	w.nodes++
+	if w.budget.MaxNodes > 0 && w.nodes > w.budget.MaxNodes {
+		w.err = fmt.Errorf("AST traversal stopped after %d nodes; results for this file are incomplete", w.budget.MaxNodes)
+		return false
+	}
+	// Checking the clock and context on every node is measurably slow
+	if w.nodes%1024 != 0 {
+		return true
+	}
+	if w.ctx != nil && w.ctx.Err() != nil {
+		w.err = w.ctx.Err()
+		return false
+	}
+	if w.budget.MaxDuration > 0 && time.Since(w.start) > w.budget.MaxDuration {
+		w.err = fmt.Errorf("AST traversal stopped after %s; results for this file are incomplete", w.budget.MaxDuration)
+		return false
+	}
+	return true
+}
+
+// Err returns why the walker stopped early, or nil if it stayed in budget.
+func (w *Walker) Err() error {
+	return w.err
+}
+
+// finish records a diagnostic for the walker's file if the budget ran out.
+func (w *Walker) finish() {
+	if w.err != nil && (w.ctx == nil || w.ctx.Err() == nil) {
+		addDiagnostic(FileDiagnostic{File: w.file, Message: w.err.Error()})
+	}
+}
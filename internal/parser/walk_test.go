@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package parser
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalk_DocumentOrder(t *testing.T) {
+	p := NewTypeScriptParser()
+	defer p.Close()
+
+	pf, err := p.ParseSource("order.ts", `const a = 1; const b = 2; const c = 3;`)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	var names []string
+	Walk(pf.RootNode, func(n *sitter.Node) bool {
+		if n.Type() == "variable_declarator" {
+			names = append(names, n.ChildByFieldName("name").Content(pf.Content))
+			return false
+		}
+		return true
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+}
+
+func TestWalk_DeepTree(t *testing.T) {
+	p := NewTypeScriptParser()
+	defer p.Close()
+
+	depth := 20000
+	source := "const x = " + strings.Repeat("[", depth) + strings.Repeat("]", depth) + ";"
+	pf, err := p.ParseSource("deep.ts", source)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	arrays := 0
+	Walk(pf.RootNode, func(n *sitter.Node) bool {
+		if n.Type() == "array" {
+			arrays++
+		}
+		return true
+	})
+
+	assert.Equal(t, depth, arrays)
+}
+
+func TestWalker_NodeBudget(t *testing.T) {
+	p := NewTypeScriptParser()
+	defer p.Close()
+
+	pf, err := p.ParseSource("budget.ts", `const a = 1; const b = 2; const c = 3;`)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	w := NewWalker("budget.ts", Budget{MaxNodes: 5})
+	visited := 0
+	w.Walk(pf.RootNode, func(n *sitter.Node) bool {
+		visited++
+		return true
+	})
+
+	assert.Equal(t, 5, visited)
+	require.Error(t, w.Err())
+	assert.Contains(t, w.Err().Error(), "5 nodes")
+
+	// Further walks of the same file are skipped once the budget is spent
+	w.Walk(pf.RootNode, func(n *sitter.Node) bool {
+		visited++
+		return true
+	})
+	assert.Equal(t, 5, visited)
+}
+
+func TestParse_BudgetDiagnostic(t *testing.T) {
+	saved := DefaultBudget
+	DefaultBudget = Budget{MaxNodes: 10}
+	defer func() { DefaultBudget = saved }()
+	Diagnostics()
+
+	p := NewTypeScriptParser()
+	defer p.Close()
+
+	pf, err := p.ParseSource("big.ts", `interface A { a: string; b: number; c: boolean }`)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	diagnostics := Diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "big.ts", diagnostics[0].File)
+	assert.Contains(t, diagnostics[0].Message, "incomplete")
+	assert.Empty(t, Diagnostics())
+}
+
+func TestParseContext_Cancelled(t *testing.T) {
+	p := NewTypeScriptParser()
+	defer p.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.ParseContext(ctx, "cancelled.ts", []byte(`const a = 1;`))
+	assert.Error(t, err)
+	assert.Empty(t, Diagnostics())
+}
+
+func TestParse_DeadlineDiagnostic(t *testing.T) {
+	saved := DefaultBudget
+	DefaultBudget = Budget{MaxDuration: time.Microsecond}
+	defer func() { DefaultBudget = saved }()
+	Diagnostics()
+
+	p := NewSwiftParser()
+	defer p.Close()
+
+	source := []byte(strings.Repeat("app.get(\"users\", \":id\") { req in try await handler(req) }\n", 20000))
+	_, err := p.Parse("slow.swift", source)
+	require.Error(t, err)
+
+	diagnostics := Diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "slow.swift", diagnostics[0].File)
+	assert.Contains(t, diagnostics[0].Message, "the file was skipped")
+
+	// The parser starts afresh on the next file
+	DefaultBudget = saved
+	pf, err := p.Parse("ok.swift", []byte("import Vapor\n"))
+	require.NoError(t, err)
+	defer pf.Close()
+	assert.Equal(t, []string{"Vapor"}, pf.Imports)
+}
@@ -0,0 +1,613 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package adonis provides a plugin for extracting routes from AdonisJS
+// applications: Route (v5) and router (v6) definitions with their groups
+// and resources, and the request schemas of the validators their
+// controllers apply.
+package adonis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// routerNames are the names the router is imported under: the v5 Route
+// module and the v6 router service.
+var routerNames = map[string]bool{"Route": true, "router": true}
+
+// anyMethod are the methods Route.any is documented under.
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// resourceActions are the routes Route.resource registers, in order. The
+// form actions serve HTML forms and are dropped by apiOnly().
+var resourceActions = []struct {
+	action string
+	method string
+	suffix string
+	form   bool
+}{
+	{"index", "GET", "", false},
+	{"create", "GET", "/create", true},
+	{"store", "POST", "", false},
+	{"show", "GET", "/:id", false},
+	{"edit", "GET", "/:id/edit", true},
+	{"update", "PUT", "/:id", false},
+	{"update", "PATCH", "/:id", false},
+	{"destroy", "DELETE", "/:id", false},
+}
+
+// Plugin implements the FrameworkPlugin interface for AdonisJS.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new AdonisJS plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "adonis"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "adonis",
+		Version:     "1.0.0",
+		Description: "Extracts routes from AdonisJS route files and schemas from validators",
+		SupportedFrameworks: []string{
+			"@adonisjs/core",
+		},
+	}
+}
+
+// Detect checks if AdonisJS is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["@adonisjs/core"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["@adonisjs/core"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// project holds the validators and controllers of every source file, so
+// that routes can be linked to the validators their handlers apply.
+type project struct {
+	parser *parser.TypeScriptParser
+	files  []*parser.ParsedTSFile
+	decls  map[string]declaration
+
+	// validators are the request schemas by validator name
+	validators map[string]*validator
+
+	// validated maps controller actions (UsersController.store) to the
+	// validator they apply
+	validated map[string]string
+}
+
+// ExtractRoutes parses source files and extracts route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var routes []types.Route
+	for _, pf := range pr.files {
+		e := &extraction{project: pr, file: pf.Path, content: pf.Content}
+		e.visit(pf.RootNode, "")
+		routes = append(routes, e.routes...)
+	}
+
+	return routes, nil
+}
+
+// extraction collects the routes of one file.
+type extraction struct {
+	*project
+	file    string
+	content []byte
+	routes  []types.Route
+}
+
+// visit finds the router calls under node. Calls on a group's chain, like
+// Route.group(...).prefix('/api'), are handled with the group.
+func (e *extraction) visit(node *sitter.Node, prefix string) {
+	if node.Type() == "call_expression" {
+		if base, modifiers, ok := routerChain(node, e.content); ok {
+			e.routerCall(base, modifiers, prefix)
+			return
+		}
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		e.visit(node.NamedChild(i), prefix)
+	}
+}
+
+// modifier is a call chained on a route, group or resource, like
+// .prefix('/api') or .apiOnly().
+type modifier struct {
+	name string
+	args []*sitter.Node
+}
+
+// routerChain returns the router call a chain of calls starts from, and
+// the calls chained on it in order.
+func routerChain(call *sitter.Node, content []byte) (*sitter.Node, []modifier, bool) {
+	var modifiers []modifier
+	for {
+		fn := call.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "member_expression" {
+			return nil, nil, false
+		}
+		object := fn.ChildByFieldName("object")
+		if object == nil {
+			return nil, nil, false
+		}
+		if object.Type() == "identifier" && routerNames[object.Content(content)] {
+			for i, j := 0, len(modifiers)-1; i < j; i, j = i+1, j-1 {
+				modifiers[i], modifiers[j] = modifiers[j], modifiers[i]
+			}
+			return call, modifiers, true
+		}
+		if object.Type() != "call_expression" {
+			return nil, nil, false
+		}
+		modifiers = append(modifiers, modifier{name: parser.CalledMethod(call, content), args: parser.CallArguments(call)})
+		call = object
+	}
+}
+
+// routerCall handles a router call: a route, a group or a resource.
+func (e *extraction) routerCall(call *sitter.Node, modifiers []modifier, prefix string) {
+	args := parser.CallArguments(call)
+	method := parser.CalledMethod(call, e.content)
+
+	switch method {
+	case "get", "post", "put", "patch", "delete":
+		e.route([]string{strings.ToUpper(method)}, args, modifiers, prefix, call)
+	case "any":
+		e.route(anyMethod, args, modifiers, prefix, call)
+	case "route":
+		if len(args) < 3 {
+			return
+		}
+		methods, ok := e.parser.ResolveStringArray(args[1], e.content)
+		if !ok {
+			return
+		}
+		for i := range methods {
+			methods[i] = strings.ToUpper(methods[i])
+		}
+		e.route(methods, []*sitter.Node{args[0], args[2]}, modifiers, prefix, call)
+	case "group":
+		groupPrefix := prefix
+		for _, m := range modifiers {
+			if m.name == "prefix" && len(m.args) > 0 {
+				if value, ok := e.parser.ExtractStringLiteral(m.args[0], e.content); ok {
+					groupPrefix = joinPath(groupPrefix, value)
+				}
+			}
+		}
+		// Groups take a callback in v5 and v6; v4 groups took a name first
+		for _, arg := range args {
+			if arg.Type() == "arrow_function" || arg.Type() == "function_expression" || arg.Type() == "function" {
+				if body := arg.ChildByFieldName("body"); body != nil {
+					e.visit(body, groupPrefix)
+				}
+			}
+		}
+	case "resource", "shallowResource":
+		e.resource(args, modifiers, prefix, call)
+	}
+}
+
+// route adds the routes of a route definition: route(path, handler).
+func (e *extraction) route(methods []string, args []*sitter.Node, modifiers []modifier, prefix string, call *sitter.Node) {
+	if len(args) < 2 {
+		return
+	}
+	path, ok := e.parser.ExtractStringLiteral(args[0], e.content)
+	if !ok {
+		return
+	}
+	for _, m := range modifiers {
+		if m.name == "prefix" && len(m.args) > 0 {
+			if value, ok := e.parser.ExtractStringLiteral(m.args[0], e.content); ok {
+				path = joinPath(value, path)
+			}
+		}
+	}
+
+	// v6 single action controllers are called through their handle method
+	handler := e.handlerName(args[1])
+	if handler != "" && !strings.Contains(handler, ".") {
+		handler += ".handle"
+	}
+	for _, method := range methods {
+		e.add(method, joinPath(prefix, path), handler, len(methods) > 1, modifiers, call)
+	}
+}
+
+// resource adds the routes of a resourceful controller:
+// resource('posts.comments', 'CommentsController').
+func (e *extraction) resource(args []*sitter.Node, modifiers []modifier, prefix string, call *sitter.Node) {
+	if len(args) < 2 {
+		return
+	}
+	name, ok := e.parser.ExtractStringLiteral(args[0], e.content)
+	if !ok {
+		return
+	}
+	controller := e.handlerName(args[1])
+
+	// Nested resources are mounted under their parent's :parent_id
+	var base string
+	segments := strings.Split(name, ".")
+	for i, segment := range segments {
+		base += "/" + segment
+		if i < len(segments)-1 {
+			base += "/:" + singular(segment) + "_id"
+		}
+	}
+
+	apiOnly := false
+	var only, except map[string]bool
+	for _, m := range modifiers {
+		switch m.name {
+		case "apiOnly":
+			apiOnly = true
+		case "only", "except":
+			if len(m.args) == 0 {
+				continue
+			}
+			values, ok := e.parser.ResolveStringArray(m.args[0], e.content)
+			if !ok {
+				continue
+			}
+			set := make(map[string]bool, len(values))
+			for _, v := range values {
+				set[v] = true
+			}
+			if m.name == "only" {
+				only = set
+			} else {
+				except = set
+			}
+		}
+	}
+
+	for _, ra := range resourceActions {
+		if (apiOnly && ra.form) || (only != nil && !only[ra.action]) || except[ra.action] {
+			continue
+		}
+		handler := ""
+		if controller != "" {
+			handler = controller + "." + ra.action
+		}
+		e.add(ra.method, joinPath(prefix, base+ra.suffix), handler, ra.action == "update", modifiers, call)
+	}
+}
+
+// add adds a route served by a handler, typing its path parameters from
+// where() matchers and documenting the validator the handler applies.
+func (e *extraction) add(method, path, handler string, shared bool, modifiers []modifier, call *sitter.Node) {
+	wildcard := plugins.IsCatchAll(path)
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: operationID(method, fullPath, handler, shared),
+		Tags:        inferTags(fullPath),
+		Parameters:  extractPathParams(fullPath),
+		SourceFile:  e.file,
+		SourceLine:  int(call.StartPoint().Row) + 1,
+	}
+
+	for _, m := range modifiers {
+		if m.name != "where" || len(m.args) < 2 {
+			continue
+		}
+		name, ok := e.parser.ExtractStringLiteral(m.args[0], e.content)
+		if !ok {
+			continue
+		}
+		for i := range route.Parameters {
+			if route.Parameters[i].Name == name {
+				route.Parameters[i].Schema = matcherSchema(m.args[1], e.content)
+			}
+		}
+	}
+
+	if name, ok := e.validated[controllerAction(handler)]; ok {
+		if v, ok := e.validators[name]; ok {
+			e.applyValidator(&route, name, v)
+		}
+	}
+
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	e.routes = append(e.routes, route)
+}
+
+// applyValidator documents a validator's schema: as query parameters of
+// GET, HEAD and DELETE routes, which validate the query string, and as the
+// request body of the others.
+func (e *extraction) applyValidator(route *types.Route, name string, v *validator) {
+	switch route.Method {
+	case "GET", "HEAD", "DELETE":
+		required := make(map[string]bool)
+		for _, r := range v.schema.Required {
+			required[r] = true
+		}
+		for _, prop := range sortedKeys(v.schema.Properties) {
+			route.Parameters = append(route.Parameters, types.Parameter{
+				Name:     prop,
+				In:       "query",
+				Required: required[prop],
+				Schema:   v.schema.Properties[prop],
+			})
+		}
+	default:
+		mediaType := "application/json"
+		if hasFile(v.schema) {
+			mediaType = "multipart/form-data"
+		}
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content:  map[string]types.MediaType{mediaType: {Schema: schema.SchemaRef(name)}},
+		}
+	}
+}
+
+// handlerName returns the name of a route handler: 'UsersController.index'
+// in v5, [UsersController, 'index'] in v6, or "" for inline closures.
+func (e *extraction) handlerName(node *sitter.Node) string {
+	node = unwrap(node)
+	switch node.Type() {
+	case "string":
+		value, _ := e.parser.ExtractStringLiteral(node, e.content)
+		return value
+	case "identifier":
+		return node.Content(e.content)
+	case "array":
+		if node.NamedChildCount() == 0 {
+			return ""
+		}
+		controller := node.NamedChild(0).Content(e.content)
+		action := "handle"
+		if node.NamedChildCount() > 1 {
+			if value, ok := e.parser.ExtractStringLiteral(node.NamedChild(1), e.content); ok {
+				action = value
+			}
+		}
+		return controller + "." + action
+	}
+	return ""
+}
+
+// ExtractSchemas extracts the request schemas of validators and
+// TypeScript interfaces.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, pf := range pr.files {
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+	}
+
+	for _, name := range sortedKeys(pr.validators) {
+		v := pr.validators[name]
+		s := *v.schema
+		s.Title = name
+		s.Source = &types.SourceLocation{File: v.file, Line: v.line}
+		tsExtractor.Registry().Add(name, &s)
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param or :param?.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)\??`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts AdonisJS path params (:id, :id?) to OpenAPI
+// format ({id}), and wildcards (*) to a templated {path}.
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// matcherSchema returns the schema of a where() matcher:
+// router.matchers.number() or uuid(), or a regex.
+func matcherSchema(node *sitter.Node, content []byte) *types.Schema {
+	node = unwrap(node)
+	if node.Type() == "object" {
+		if match := parser.ObjectProperty(node, "match", content); match != nil {
+			node = unwrap(match)
+		}
+	}
+	switch node.Type() {
+	case "call_expression":
+		switch parser.CalledMethod(node, content) {
+		case "number":
+			return &types.Schema{Type: "integer"}
+		case "uuid":
+			return &types.Schema{Type: "string", Format: "uuid"}
+		}
+	case "regex":
+		if pattern := node.ChildByFieldName("pattern"); pattern != nil {
+			return &types.Schema{Type: "string", Pattern: pattern.Content(content)}
+		}
+	}
+	return &types.Schema{Type: "string"}
+}
+
+// joinPath joins a path prefix and a path.
+func joinPath(prefix, path string) string {
+	joined := strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return joined
+}
+
+// singular returns the singular of a resource name, for the parameter of
+// a nested resource's parent (posts -> post_id).
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+// controllerAction returns the class and method a handler names, without
+// the namespace of v5 handlers like Admin/UsersController.index.
+func controllerAction(handler string) string {
+	if i := strings.LastIndex(handler, "/"); i >= 0 {
+		return handler[i+1:]
+	}
+	return handler
+}
+
+// operationID generates an operation ID from a handler
+// (UsersController.index -> usersIndex), or from the method and path of
+// inline handlers. shared is set for handlers serving several methods,
+// whose IDs are prefixed with the method.
+func operationID(method, path, handler string, shared bool) string {
+	var words []string
+	if controller, action, ok := strings.Cut(controllerAction(handler), "."); ok {
+		words = []string{strings.TrimSuffix(controller, "Controller"), action}
+	} else {
+		for _, part := range strings.Split(braceParamRegex.ReplaceAllString(path, "By${1}"), "/") {
+			words = append(words, strings.FieldsFunc(part, func(r rune) bool { return r == '-' || r == '_' })...)
+		}
+		shared = true
+	}
+
+	var sb strings.Builder
+	if shared {
+		sb.WriteString(strings.ToLower(method))
+	}
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		if sb.Len() == 0 {
+			sb.WriteString(strings.ToLower(word[:1]) + word[1:])
+		} else {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || part == "api" || strings.HasPrefix(part, "{") || versionRegex.MatchString(part) {
+			continue
+		}
+		return []string{part}
+	}
+	return nil
+}
+
+// versionRegex matches version path segments like v1.
+var versionRegex = regexp.MustCompile(`^v[0-9]+$`)
+
+// Register registers the AdonisJS plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
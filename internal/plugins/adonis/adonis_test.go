@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package adonis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// v5RoutesFixture is an AdonisJS 5 start/routes.ts.
+const v5RoutesFixture = `
+import Route from '@ioc:Adonis/Core/Route'
+
+Route.get('/', async () => {
+  return { hello: 'world' }
+})
+
+Route.group(() => {
+  Route.get('/users', 'UsersController.index')
+  Route.post('/users', 'UsersController.store')
+  Route.get('/users/:id', 'UsersController.show').where('id', Route.matchers.number())
+  Route.any('/webhooks/:provider?', 'WebhooksController.handle')
+
+  Route.group(() => {
+    Route.resource('posts.comments', 'CommentsController').apiOnly().only(['index', 'store', 'update'])
+  }).prefix('/v1').middleware('auth')
+}).prefix('/api')
+
+Route.resource('photos', 'PhotosController').except(['create', 'edit', 'destroy'])
+Route.route('/search', ['GET', 'POST'], 'SearchController.search')
+`
+
+// v5ValidatorFixture is a v5 validator class.
+const v5ValidatorFixture = `
+import { schema, rules } from '@ioc:Adonis/Core/Validator'
+import type { HttpContextContract } from '@ioc:Adonis/Core/HttpContext'
+
+export default class CreateUserValidator {
+  constructor(protected ctx: HttpContextContract) {}
+
+  public schema = schema.create({
+    email: schema.string({ trim: true }, [rules.email(), rules.maxLength(255)]),
+    age: schema.number.optional([rules.range(18, 120)]),
+    role: schema.enum(['admin', 'member'] as const),
+    tags: schema.array.optional().members(schema.string()),
+    avatar: schema.file.optional({ size: '2mb' }),
+  })
+
+  public messages = {}
+}
+`
+
+// v5ControllerFixture is a v5 controller applying validators.
+const v5ControllerFixture = `
+import type { HttpContextContract } from '@ioc:Adonis/Core/HttpContext'
+import { schema } from '@ioc:Adonis/Core/Validator'
+import CreateUserValidator from 'App/Validators/CreateUserValidator'
+
+const listSchema = schema.create({
+  page: schema.number.optional(),
+  search: schema.string.optional(),
+})
+
+export default class UsersController {
+  public async index({ request }: HttpContextContract) {
+    const filters = await request.validate({ schema: listSchema })
+    return []
+  }
+
+  public async store({ request }: HttpContextContract) {
+    const payload = await request.validate(CreateUserValidator)
+    return payload
+  }
+}
+`
+
+// v6Fixture is an AdonisJS 6 router with a VineJS validator.
+const v6Fixture = `
+import router from '@adonisjs/core/services/router'
+import vine from '@vinejs/vine'
+import { HttpContext } from '@adonisjs/core/http'
+
+const PostsController = () => import('#controllers/posts_controller')
+const HealthChecksController = () => import('#controllers/health_checks_controller')
+
+export const createPostValidator = vine.compile(
+  vine.object({
+    title: vine.string().trim().minLength(6),
+    slug: vine.string().optional(),
+    status: vine.enum(['draft', 'published']),
+    tags: vine.array(vine.string()),
+  })
+)
+
+export default class PostsController {
+  async store({ request }: HttpContext) {
+    const payload = await request.validateUsing(createPostValidator)
+    return payload
+  }
+}
+
+router.group(() => {
+  router.post('/posts', [PostsController, 'store'])
+  router.get('/posts/:slug', [PostsController, 'show'])
+  router.get('/status', HealthChecksController)
+}).prefix('/api')
+`
+
+func extract(t *testing.T, files ...scanner.SourceFile) []types.Route {
+	t.Helper()
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	return routes
+}
+
+func source(path, content string) scanner.SourceFile {
+	return scanner.SourceFile{Path: path, Language: "typescript", Content: []byte(content)}
+}
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"@adonisjs/core": "^6.2.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_V5(t *testing.T) {
+	routes := extract(t, source("start/routes.ts", v5RoutesFixture))
+
+	root := findRoute(routes, "GET", "/")
+	require.NotNil(t, root)
+	assert.Empty(t, root.Handler)
+	assert.Equal(t, "get", root.OperationID)
+
+	index := findRoute(routes, "GET", "/api/users")
+	require.NotNil(t, index)
+	assert.Equal(t, "UsersController.index", index.Handler)
+	assert.Equal(t, "usersIndex", index.OperationID)
+	assert.Equal(t, []string{"users"}, index.Tags)
+	assert.Equal(t, "start/routes.ts", index.SourceFile)
+
+	show := findRoute(routes, "GET", "/api/users/{id}")
+	require.NotNil(t, show)
+	require.Len(t, show.Parameters, 1)
+	assert.Equal(t, "integer", show.Parameters[0].Schema.Type)
+
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		assert.NotNil(t, findRoute(routes, method, "/api/webhooks/{provider}"), method)
+	}
+	assert.Equal(t, "postWebhooksHandle", findRoute(routes, "POST", "/api/webhooks/{provider}").OperationID)
+
+	assert.NotNil(t, findRoute(routes, "GET", "/api/v1/posts/{post_id}/comments"))
+	assert.NotNil(t, findRoute(routes, "POST", "/api/v1/posts/{post_id}/comments"))
+	update := findRoute(routes, "PATCH", "/api/v1/posts/{post_id}/comments/{id}")
+	require.NotNil(t, update)
+	assert.Equal(t, "CommentsController.update", update.Handler)
+	assert.Equal(t, "patchCommentsUpdate", update.OperationID)
+	assert.NotNil(t, findRoute(routes, "PUT", "/api/v1/posts/{post_id}/comments/{id}"))
+	assert.Nil(t, findRoute(routes, "GET", "/api/v1/posts/{post_id}/comments/{id}"), "only() restricts the resource actions")
+
+	assert.NotNil(t, findRoute(routes, "GET", "/photos"))
+	assert.NotNil(t, findRoute(routes, "GET", "/photos/{id}"))
+	assert.Nil(t, findRoute(routes, "GET", "/photos/create"), "except() drops resource actions")
+	assert.Nil(t, findRoute(routes, "DELETE", "/photos/{id}"))
+
+	assert.NotNil(t, findRoute(routes, "GET", "/search"))
+	assert.NotNil(t, findRoute(routes, "POST", "/search"))
+}
+
+func TestPlugin_ExtractRoutes_V5Validators(t *testing.T) {
+	routes := extract(t,
+		source("app/Controllers/Http/UsersController.ts", v5ControllerFixture),
+		source("start/routes.ts", v5RoutesFixture),
+		source("app/Validators/CreateUserValidator.ts", v5ValidatorFixture),
+	)
+
+	store := findRoute(routes, "POST", "/api/users")
+	require.NotNil(t, store)
+	require.NotNil(t, store.RequestBody)
+	assert.True(t, store.RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/CreateUserValidator", store.RequestBody.Content["multipart/form-data"].Schema.Ref, "file fields are uploaded as multipart")
+
+	index := findRoute(routes, "GET", "/api/users")
+	require.NotNil(t, index)
+	assert.Nil(t, index.RequestBody)
+	require.Len(t, index.Parameters, 2)
+	assert.Equal(t, "page", index.Parameters[0].Name)
+	assert.Equal(t, "query", index.Parameters[0].In)
+	assert.False(t, index.Parameters[0].Required)
+	assert.Equal(t, "number", index.Parameters[0].Schema.Type)
+}
+
+func TestPlugin_ExtractRoutes_V6(t *testing.T) {
+	routes := extract(t, source("start/routes.ts", v6Fixture))
+
+	store := findRoute(routes, "POST", "/api/posts")
+	require.NotNil(t, store)
+	assert.Equal(t, "PostsController.store", store.Handler)
+	assert.Equal(t, "postsStore", store.OperationID)
+	require.NotNil(t, store.RequestBody)
+	assert.Equal(t, "#/components/schemas/createPostValidator", store.RequestBody.Content["application/json"].Schema.Ref)
+
+	show := findRoute(routes, "GET", "/api/posts/{slug}")
+	require.NotNil(t, show)
+	assert.Nil(t, show.RequestBody)
+
+	status := findRoute(routes, "GET", "/api/status")
+	require.NotNil(t, status)
+	assert.Equal(t, "HealthChecksController.handle", status.Handler)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		source("app/Validators/CreateUserValidator.ts", v5ValidatorFixture),
+		source("start/routes.ts", v6Fixture),
+	})
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+
+	user, ok := byName["CreateUserValidator"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"email", "role"}, user.Required)
+	assert.Equal(t, "email", user.Properties["email"].Format)
+	assert.Equal(t, 255, *user.Properties["email"].MaxLength)
+	assert.Equal(t, 18.0, *user.Properties["age"].Minimum)
+	assert.Equal(t, 120.0, *user.Properties["age"].Maximum)
+	assert.Equal(t, []any{"admin", "member"}, user.Properties["role"].Enum)
+	assert.Equal(t, "string", user.Properties["tags"].Items.Type)
+	assert.Equal(t, "binary", user.Properties["avatar"].Format)
+
+	post, ok := byName["createPostValidator"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"status", "tags", "title"}, post.Required)
+	assert.Equal(t, 6, *post.Properties["title"].MinLength)
+	assert.Equal(t, []any{"draft", "published"}, post.Properties["status"].Enum)
+	assert.Equal(t, "array", post.Properties["tags"].Type)
+	assert.Equal(t, "string", post.Properties["tags"].Items.Type)
+}
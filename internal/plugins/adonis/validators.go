@@ -0,0 +1,544 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package adonis
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// maxResolveDepth bounds chains of declarations referring to each other.
+const maxResolveDepth = 16
+
+// declaration is a const declaration, kept to resolve the identifiers
+// validators refer to across files.
+type declaration struct {
+	value   *sitter.Node
+	content []byte
+}
+
+// validator is a request schema: a v5 validator class or schema.create()
+// schema, or a compiled VineJS validator.
+type validator struct {
+	schema *types.Schema
+	file   string
+	line   int
+}
+
+// collectProject parses the files and indexes their validators and the
+// validators controller actions apply. The caller closes the project's
+// files.
+func (p *Plugin) collectProject(ctx context.Context, files []scanner.SourceFile) *project {
+	pr := &project{
+		parser:     p.tsParser,
+		decls:      make(map[string]declaration),
+		validators: make(map[string]*validator),
+		validated:  make(map[string]string),
+	}
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		pr.files = append(pr.files, pf)
+
+		content := file.Content
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() == "variable_declarator" {
+				name := node.ChildByFieldName("name")
+				value := node.ChildByFieldName("value")
+				if name != nil && value != nil && name.Type() == "identifier" {
+					pr.decls[name.Content(content)] = declaration{value: value, content: content}
+				}
+			}
+			return true
+		})
+	}
+
+	for _, pf := range pr.files {
+		content := pf.Content
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			switch node.Type() {
+			case "variable_declarator":
+				name := node.ChildByFieldName("name")
+				value := node.ChildByFieldName("value")
+				if name == nil || value == nil || !isValidator(unwrap(value), content) {
+					return true
+				}
+				pr.validators[name.Content(content)] = &validator{
+					schema: pr.validatorSchema(unwrap(value), content, 0),
+					file:   pf.Path,
+					line:   int(node.StartPoint().Row) + 1,
+				}
+			case "class_declaration":
+				pr.collectClass(node, pf.Path, content, false)
+				return false
+			}
+			return true
+		})
+	}
+
+	// Controllers are indexed once every validator is known
+	for _, pf := range pr.files {
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() == "class_declaration" {
+				pr.collectClass(node, pf.Path, pf.Content, true)
+				return false
+			}
+			return true
+		})
+	}
+
+	return pr
+}
+
+// collectClass indexes a v5 validator class, whose schema property holds
+// its schema, or with controllers set, the validators a controller's
+// methods apply.
+func (pr *project) collectClass(class *sitter.Node, file string, content []byte, controllers bool) {
+	name := class.ChildByFieldName("name")
+	body := class.ChildByFieldName("body")
+	if name == nil || body == nil {
+		return
+	}
+	className := name.Content(content)
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		switch {
+		case !controllers && member.Type() == "public_field_definition":
+			property := member.ChildByFieldName("name")
+			value := member.ChildByFieldName("value")
+			if property != nil && value != nil && property.Content(content) == "schema" && isValidator(unwrap(value), content) {
+				pr.validators[className] = &validator{
+					schema: pr.validatorSchema(unwrap(value), content, 0),
+					file:   file,
+					line:   int(class.StartPoint().Row) + 1,
+				}
+			}
+		case controllers && member.Type() == "method_definition":
+			method := member.ChildByFieldName("name")
+			if method == nil {
+				continue
+			}
+			if applied := pr.appliedValidator(member, content); applied != "" {
+				pr.validated[className+"."+method.Content(content)] = applied
+			}
+		}
+	}
+}
+
+// appliedValidator returns the validator a controller method applies:
+// request.validate(CreateUserValidator), request.validate({ schema }),
+// request.validateUsing(createUserValidator) or
+// createUserValidator.validate(data).
+func (pr *project) appliedValidator(method *sitter.Node, content []byte) string {
+	var name string
+	parser.Walk(method, func(node *sitter.Node) bool {
+		if name != "" || node.Type() != "call_expression" {
+			return name == ""
+		}
+		fn := node.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "member_expression" {
+			return true
+		}
+		args := parser.CallArguments(node)
+		switch parser.CalledMethod(node, content) {
+		case "validate", "validateUsing":
+			if object := fn.ChildByFieldName("object"); object != nil && object.Type() == "identifier" {
+				if _, ok := pr.validators[object.Content(content)]; ok {
+					name = object.Content(content)
+					return false
+				}
+			}
+			if len(args) == 0 {
+				return true
+			}
+			arg := unwrap(args[0])
+			if arg.Type() == "object" {
+				if s := parser.ObjectProperty(arg, "schema", content); s != nil {
+					arg = unwrap(s)
+				}
+			}
+			if arg.Type() == "identifier" {
+				if _, ok := pr.validators[arg.Content(content)]; ok {
+					name = arg.Content(content)
+				}
+			}
+		}
+		return name == ""
+	})
+	return name
+}
+
+// isValidator reports whether an expression creates a request schema:
+// schema.create() in v5, or vine.compile() and vine.create() in v6.
+func isValidator(node *sitter.Node, content []byte) bool {
+	if node.Type() != "call_expression" {
+		return false
+	}
+	fn := node.ChildByFieldName("function")
+	if fn == nil {
+		return false
+	}
+	switch fn.Content(content) {
+	case "schema.create", "vine.compile", "vine.create":
+		return true
+	}
+	return false
+}
+
+// validatorSchema converts the object schema a validator validates.
+func (pr *project) validatorSchema(call *sitter.Node, content []byte, depth int) *types.Schema {
+	args := parser.CallArguments(call)
+	if len(args) == 0 {
+		return &types.Schema{Type: "object"}
+	}
+	arg := unwrap(args[0])
+	if arg.Type() == "object" {
+		return pr.objectSchema(arg, content, depth)
+	}
+	result, _ := pr.fieldSchema(arg, content, depth)
+	return result
+}
+
+// objectSchema converts an object of field schemas.
+func (pr *project) objectSchema(object *sitter.Node, content []byte, depth int) *types.Schema {
+	result := &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+	for _, pair := range parser.ObjectPairs(object) {
+		name := parser.PairKey(pair, content)
+		field, optional := pr.fieldSchema(pair.ChildByFieldName("value"), content, depth)
+		result.Properties[name] = field
+		if !optional {
+			result.Required = append(result.Required, name)
+		}
+	}
+	sort.Strings(result.Required)
+	return result
+}
+
+// fieldSchema converts a field schema: a v5 schema.string.optional({},
+// [rules.email()]) or a VineJS vine.string().email().optional() chain.
+// optional is set for fields that may be omitted.
+func (pr *project) fieldSchema(node *sitter.Node, content []byte, depth int) (result *types.Schema, optional bool) {
+	node = unwrap(node)
+	if depth > maxResolveDepth {
+		return &types.Schema{}, false
+	}
+	switch node.Type() {
+	case "identifier":
+		decl, ok := pr.decls[node.Content(content)]
+		if !ok {
+			return &types.Schema{}, false
+		}
+		return pr.fieldSchema(decl.value, decl.content, depth+1)
+	case "object":
+		return pr.objectSchema(node, content, depth), false
+	case "call_expression":
+	default:
+		return &types.Schema{}, false
+	}
+
+	// Walk the chain down to the builder call, collecting chained calls
+	var chain []*sitter.Node
+	call := node
+	for {
+		fn := call.ChildByFieldName("function")
+		if fn == nil {
+			return &types.Schema{}, false
+		}
+		if fn.Type() == "member_expression" {
+			if object := fn.ChildByFieldName("object"); object != nil && object.Type() == "call_expression" {
+				chain = append(chain, call)
+				call = object
+				continue
+			}
+		}
+		break
+	}
+
+	parts := strings.Split(call.ChildByFieldName("function").Content(content), ".")
+	if len(parts) < 2 {
+		return &types.Schema{}, false
+	}
+	args := parser.CallArguments(call)
+	switch parts[0] {
+	case "schema":
+		result = pr.builderSchema(parts[1], args, content, depth)
+		for _, modifier := range parts[2:] {
+			switch modifier {
+			case "optional":
+				optional = true
+			case "nullable":
+				result.Nullable = true
+			case "nullableAndOptional":
+				optional = true
+				result.Nullable = true
+			}
+		}
+		for _, arg := range args {
+			applyRules(result, unwrap(arg), content)
+		}
+	case "vine":
+		result = pr.builderSchema(parts[1], args, content, depth)
+	default:
+		return &types.Schema{}, false
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		chained := chain[i]
+		chainArgs := parser.CallArguments(chained)
+		switch name := parser.CalledMethod(chained, content); name {
+		case "members":
+			// v5 array().members(...) and object().members({...})
+			if len(chainArgs) == 0 {
+				continue
+			}
+			if result.Type == "array" {
+				result.Items, _ = pr.fieldSchema(chainArgs[0], content, depth+1)
+			} else if object := unwrap(chainArgs[0]); object.Type() == "object" {
+				members := pr.objectSchema(object, content, depth+1)
+				result.Properties = members.Properties
+				result.Required = members.Required
+			}
+		case "optional":
+			optional = true
+		case "nullable":
+			result.Nullable = true
+		case "in":
+			if len(chainArgs) > 0 {
+				result.Enum = enumValues(chainArgs[0], content)
+			}
+		default:
+			applyRule(result, name, chainArgs, content)
+		}
+	}
+
+	return result, optional
+}
+
+// builderSchema converts the builder a field chain starts from, like
+// schema.string or vine.number.
+func (pr *project) builderSchema(builder string, args []*sitter.Node, content []byte, depth int) *types.Schema {
+	switch builder {
+	case "string":
+		return &types.Schema{Type: "string"}
+	case "number":
+		return &types.Schema{Type: "number"}
+	case "boolean", "accepted":
+		return &types.Schema{Type: "boolean"}
+	case "date":
+		return &types.Schema{Type: "string", Format: "date-time"}
+	case "file":
+		return &types.Schema{Type: "string", Format: "binary"}
+	case "enum", "enumSet":
+		result := &types.Schema{Type: "string"}
+		if len(args) > 0 {
+			result.Enum = enumValues(args[0], content)
+		}
+		if builder == "enumSet" {
+			return &types.Schema{Type: "array", Items: result}
+		}
+		return result
+	case "literal":
+		if len(args) > 0 {
+			values := enumValues(args[0], content)
+			return &types.Schema{Type: literalType(values), Enum: values}
+		}
+	case "array":
+		result := &types.Schema{Type: "array"}
+		if len(args) > 0 && unwrap(args[0]).Type() == "call_expression" {
+			result.Items, _ = pr.fieldSchema(args[0], content, depth+1)
+		}
+		return result
+	case "object":
+		if len(args) > 0 {
+			if object := unwrap(args[0]); object.Type() == "object" {
+				return pr.objectSchema(object, content, depth+1)
+			}
+		}
+		return &types.Schema{Type: "object"}
+	case "create":
+		if len(args) > 0 {
+			if object := unwrap(args[0]); object.Type() == "object" {
+				return pr.objectSchema(object, content, depth+1)
+			}
+		}
+	case "compile":
+		if len(args) > 0 {
+			result, _ := pr.fieldSchema(args[0], content, depth+1)
+			return result
+		}
+	}
+	return &types.Schema{}
+}
+
+// applyRules applies the v5 rules in an array argument, like
+// [rules.email(), rules.maxLength(255)].
+func applyRules(result *types.Schema, arg *sitter.Node, content []byte) {
+	if arg.Type() != "array" {
+		return
+	}
+	for i := 0; i < int(arg.NamedChildCount()); i++ {
+		rule := unwrap(arg.NamedChild(i))
+		if rule.Type() != "call_expression" {
+			continue
+		}
+		applyRule(result, parser.CalledMethod(rule, content), parser.CallArguments(rule), content)
+	}
+}
+
+// applyRule applies a validation rule to a schema: a v5 rule or a VineJS
+// chained call.
+func applyRule(result *types.Schema, rule string, args []*sitter.Node, content []byte) {
+	number := func(i int) (float64, bool) {
+		if i >= len(args) {
+			return 0, false
+		}
+		n, err := strconv.ParseFloat(args[i].Content(content), 64)
+		return n, err == nil
+	}
+
+	switch rule {
+	case "email":
+		result.Format = "email"
+	case "url":
+		result.Format = "uri"
+	case "uuid":
+		result.Format = "uuid"
+	case "minLength":
+		if n, ok := number(0); ok {
+			length := int(n)
+			if result.Type == "array" {
+				result.MinItems = &length
+			} else {
+				result.MinLength = &length
+			}
+		}
+	case "maxLength":
+		if n, ok := number(0); ok {
+			length := int(n)
+			if result.Type == "array" {
+				result.MaxItems = &length
+			} else {
+				result.MaxLength = &length
+			}
+		}
+	case "fixedLength":
+		if n, ok := number(0); ok {
+			length := int(n)
+			result.MinLength = &length
+			result.MaxLength = &length
+		}
+	case "min":
+		if n, ok := number(0); ok {
+			result.Minimum = &n
+		}
+	case "max":
+		if n, ok := number(0); ok {
+			result.Maximum = &n
+		}
+	case "range":
+		if n, ok := number(0); ok {
+			result.Minimum = &n
+		}
+		if n, ok := number(1); ok {
+			result.Maximum = &n
+		}
+	case "withoutDecimals":
+		result.Type = "integer"
+	case "positive":
+		zero := 0.0
+		result.Minimum = &zero
+	}
+}
+
+// enumValues returns the values of an enum's choices array.
+func enumValues(node *sitter.Node, content []byte) []any {
+	node = unwrap(node)
+	if node.Type() != "array" {
+		return []any{literalValue(node, content)}
+	}
+	var values []any
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		values = append(values, literalValue(unwrap(node.NamedChild(i)), content))
+	}
+	return values
+}
+
+// literalValue returns the value of a string, number or boolean literal.
+func literalValue(node *sitter.Node, content []byte) any {
+	text := node.Content(content)
+	switch node.Type() {
+	case "number":
+		if n, err := strconv.ParseFloat(text, 64); err == nil {
+			return n
+		}
+	case "true", "false":
+		return node.Type() == "true"
+	}
+	return strings.Trim(text, `"'`+"`")
+}
+
+// literalType returns the schema type of literal values.
+func literalType(values []any) string {
+	if len(values) == 0 {
+		return "string"
+	}
+	switch values[0].(type) {
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "string"
+}
+
+// hasFile reports whether a schema has a file field, which is uploaded as
+// multipart form data.
+func hasFile(s *types.Schema) bool {
+	for _, prop := range s.Properties {
+		if prop.Format == "binary" || (prop.Items != nil && prop.Items.Format == "binary") {
+			return true
+		}
+	}
+	return false
+}
+
+// unwrap is parser.UnwrapExpression that also strips await.
+func unwrap(node *sitter.Node) *sitter.Node {
+	for node = parser.UnwrapExpression(node); node != nil && node.Type() == "await_expression" && node.NamedChildCount() > 0; {
+		node = parser.UnwrapExpression(node.NamedChild(0))
+	}
+	return node
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closeAll closes parse trees.
+func closeAll(files []*parser.ParsedTSFile) {
+	for _, pf := range files {
+		pf.Close()
+	}
+}
@@ -0,0 +1,676 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package aiohttp provides a plugin for extracting routes from aiohttp web applications.
+package aiohttp
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps aiohttp method names to their uppercase forms.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// Plugin implements the FrameworkPlugin interface for aiohttp.
+type Plugin struct {
+	pyParser *parser.PythonParser
+}
+
+// New creates a new aiohttp plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		pyParser: parser.NewPythonParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "aiohttp"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".py"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "aiohttp",
+		Version:     "1.0.0",
+		Description: "Extracts routes from aiohttp web applications",
+		SupportedFrameworks: []string{
+			"aiohttp",
+		},
+	}
+}
+
+// dependencyFiles are the files that declare Python dependencies.
+var dependencyFiles = []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile", "poetry.lock"}
+
+// serverFrameworks are the Python web frameworks whose projects commonly
+// depend on aiohttp as an HTTP client only.
+var serverFrameworks = []string{"fastapi", "flask", "django", "starlette"}
+
+// Detect checks if aiohttp is used as the web framework of the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	found := false
+	for _, name := range dependencyFiles {
+		path := filepath.Join(projectRoot, name)
+		for _, framework := range serverFrameworks {
+			if ok, _ := p.checkFileForDependency(path, framework); ok {
+				return false, nil
+			}
+		}
+		if ok, _ := p.checkFileForDependency(path, "aiohttp"); ok {
+			found = true
+		}
+	}
+	return found, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanner.Scan() {
+		line := strings.ToLower(scanner.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractRoutes parses source files and extracts aiohttp route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	views := p.collectViewClasses(ctx, files)
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, views)
+		if err != nil {
+			// Log error but continue with other files
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// collectViewClasses maps the web.View subclasses of all files to the HTTP
+// methods they handle, since routes often register imported views.
+func (p *Plugin) collectViewClasses(ctx context.Context, files []scanner.SourceFile) map[string][]string {
+	views := make(map[string][]string)
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		for _, cls := range pf.Classes {
+			if isView(cls) {
+				views[cls.Name] = viewMethods(cls)
+			}
+		}
+		pf.Close()
+	}
+	return views
+}
+
+// isView reports whether a class derives from aiohttp's web.View.
+func isView(cls parser.PythonClass) bool {
+	for _, base := range cls.Bases {
+		if base == "web.View" || base == "View" || strings.HasSuffix(base, ".web.View") {
+			return true
+		}
+	}
+	return false
+}
+
+// viewMethods returns the HTTP methods a class-based view implements.
+func viewMethods(cls parser.PythonClass) []string {
+	var methods []string
+	for _, method := range cls.Methods {
+		if _, ok := httpMethods[method.Name]; ok {
+			methods = append(methods, method.Name)
+		}
+	}
+	return methods
+}
+
+// routeFile holds the per-file state of route extraction.
+type routeFile struct {
+	content []byte
+	views   map[string][]string
+
+	// subapps maps an application variable to its parent and mount path
+	// from parent.add_subapp(path, app)
+	subapps map[string]subapp
+
+	// tables maps route tables and route lists to the application that
+	// registers them with app.add_routes(name)
+	tables map[string]string
+
+	// routeTables are the variables holding a web.RouteTableDef()
+	routeTables map[string]bool
+}
+
+// subapp is an application mounted under a path prefix.
+type subapp struct {
+	parent string
+	prefix string
+}
+
+// extractRoutesFromFile extracts routes from a single Python file.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, views map[string][]string) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	if !p.hasAiohttpImport(pf) {
+		return nil, nil
+	}
+
+	rf := &routeFile{
+		content:     file.Content,
+		views:       views,
+		subapps:     make(map[string]subapp),
+		tables:      make(map[string]string),
+		routeTables: make(map[string]bool),
+	}
+
+	calls := p.pyParser.FindCallExpressions(pf.RootNode, file.Content)
+	for _, call := range calls {
+		callee := p.pyParser.GetCalleeText(call, file.Content)
+		args, _ := callArguments(call, file.Content)
+		switch {
+		case strings.HasSuffix(callee, ".add_subapp") && len(args) == 2:
+			if prefix, ok := p.pyParser.ExtractStringLiteral(args[0], file.Content); ok {
+				rf.subapps[args[1].Content(file.Content)] = subapp{
+					parent: strings.TrimSuffix(callee, ".add_subapp"),
+					prefix: prefix,
+				}
+			}
+		case strings.HasSuffix(callee, ".add_routes") && len(args) == 1 && args[0].Type() == "identifier":
+			rf.tables[args[0].Content(file.Content)] = strings.TrimSuffix(callee, ".add_routes")
+		case lastSegment(callee) == "RouteTableDef" && call.Parent().Type() == "assignment":
+			if left := call.Parent().ChildByFieldName("left"); left != nil {
+				rf.routeTables[left.Content(file.Content)] = true
+			}
+		}
+	}
+
+	var routes []types.Route
+
+	for _, call := range calls {
+		callee := p.pyParser.GetCalleeText(call, file.Content)
+		args, _ := callArguments(call, file.Content)
+
+		// app.router.add_get("/path", handler)
+		if receiver, method, ok := strings.Cut(callee, ".router.add_"); ok {
+			routes = append(routes, p.routerRoutes(rf, method, args, rf.appPrefix(receiver, 0), call)...)
+			continue
+		}
+
+		// web.get("/path", handler) inside app.add_routes([...])
+		if method, ok := strings.CutPrefix(callee, "web."); ok {
+			if _, known := httpMethods[method]; known || method == "route" || method == "view" {
+				routes = append(routes, p.routerRoutes(rf, method, args, p.tablePrefix(rf, call), call)...)
+			}
+		}
+	}
+
+	// @routes.get("/path") on functions of a RouteTableDef
+	for _, fn := range pf.DecoratedFunctions {
+		for _, dec := range fn.Decorators {
+			table, method, ok := cutLast(dec.Name)
+			if !ok || !rf.routeTables[table] {
+				continue
+			}
+			prefix := rf.appPrefix(rf.tables[table], 0)
+			routes = append(routes, p.decoratorRoutes(rf, method, dec, prefix, fn.Name, fn.Line)...)
+		}
+	}
+
+	// @routes.view("/path") on class-based views
+	for _, cls := range pf.Classes {
+		for _, dec := range cls.Decorators {
+			table, method, ok := cutLast(dec.Name)
+			if !ok || method != "view" || !rf.routeTables[table] {
+				continue
+			}
+			prefix := rf.appPrefix(rf.tables[table], 0)
+			routes = append(routes, p.decoratorRoutes(rf, method, dec, prefix, cls.Name, cls.Line)...)
+		}
+	}
+
+	for i := range routes {
+		routes[i].SourceFile = file.Path
+	}
+
+	return routes, nil
+}
+
+// hasAiohttpImport checks if the file imports aiohttp.
+func (p *Plugin) hasAiohttpImport(pf *parser.ParsedPythonFile) bool {
+	for _, imp := range pf.Imports {
+		if strings.HasPrefix(strings.ToLower(imp.Module), "aiohttp") {
+			return true
+		}
+	}
+	return false
+}
+
+// appPrefix returns the full mount prefix of an application variable.
+func (rf *routeFile) appPrefix(app string, depth int) string {
+	mount, ok := rf.subapps[app]
+	if !ok || depth > 8 {
+		return ""
+	}
+	return combinePaths(rf.appPrefix(mount.parent, depth+1), mount.prefix)
+}
+
+// tablePrefix returns the prefix of a web.get(...) route definition from the
+// application whose add_routes call receives it, directly or through a
+// route list variable.
+func (p *Plugin) tablePrefix(rf *routeFile, node *sitter.Node) string {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Type() {
+		case "call":
+			callee := p.pyParser.GetCalleeText(parent, rf.content)
+			if app, ok := strings.CutSuffix(callee, ".add_routes"); ok {
+				return rf.appPrefix(app, 0)
+			}
+		case "assignment":
+			if left := parent.ChildByFieldName("left"); left != nil {
+				return rf.appPrefix(rf.tables[left.Content(rf.content)], 0)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// routerRoutes builds the routes of an add_<method>(path, handler) or
+// web.<method>(path, handler) call. add_route and web.route take the method
+// first; add_view and web.view register a class-based view.
+func (p *Plugin) routerRoutes(rf *routeFile, method string, args []*sitter.Node, prefix string, call *sitter.Node) []types.Route {
+	if method == "route" {
+		if len(args) < 3 {
+			return nil
+		}
+		m, ok := p.pyParser.ExtractStringLiteral(args[0], rf.content)
+		if !ok {
+			return nil
+		}
+		method, args = strings.ToLower(m), args[1:]
+	}
+	if len(args) < 2 {
+		return nil
+	}
+
+	path, ok := p.pyParser.ExtractStringLiteral(args[0], rf.content)
+	if !ok {
+		return nil
+	}
+	handler := args[1].Content(rf.content)
+	line := int(call.StartPoint().Row) + 1
+
+	return p.handlerRoutes(rf, method, combinePaths(prefix, path), handler, line)
+}
+
+// decoratorRoutes builds the routes of a RouteTableDef decorator.
+func (p *Plugin) decoratorRoutes(rf *routeFile, method string, dec parser.PythonDecorator, prefix, handler string, line int) []types.Route {
+	args := dec.Arguments
+	if method == "route" {
+		if len(args) < 2 {
+			return nil
+		}
+		method, args = strings.ToLower(args[0]), args[1:]
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return p.handlerRoutes(rf, method, combinePaths(prefix, args[0]), handler, line)
+}
+
+// handlerRoutes builds one route for a function handler, or one per
+// implemented method for a class-based view registered with view or "*".
+func (p *Plugin) handlerRoutes(rf *routeFile, method, path, handler string, line int) []types.Route {
+	if method == "view" || method == "*" {
+		var routes []types.Route
+		name := lastSegment(handler)
+		for _, m := range rf.views[name] {
+			routes = append(routes, newRoute(httpMethods[m], path, name+"."+m, line))
+		}
+		return routes
+	}
+
+	upper, ok := httpMethods[method]
+	if !ok {
+		return nil
+	}
+	return []types.Route{newRoute(upper, path, handler, line)}
+}
+
+// newRoute builds a route for an aiohttp resource path.
+func newRoute(method, path, handler string, line int) types.Route {
+	wildcard := plugins.IsCatchAll(path)
+	params := extractPathParams(path)
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, strings.ReplaceAll(lastSegment(handler), ".", "_")),
+		Tags:        inferTags(fullPath),
+		Parameters:  params,
+		SourceLine:  line,
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	return route
+}
+
+// callArguments splits the arguments of a call into positional arguments
+// and keyword arguments by name.
+func callArguments(call *sitter.Node, content []byte) ([]*sitter.Node, map[string]*sitter.Node) {
+	var args []*sitter.Node
+	kwargs := make(map[string]*sitter.Node)
+
+	list := call.ChildByFieldName("arguments")
+	if list == nil {
+		return nil, kwargs
+	}
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		arg := list.NamedChild(i)
+		switch arg.Type() {
+		case "keyword_argument":
+			name, value := arg.ChildByFieldName("name"), arg.ChildByFieldName("value")
+			if name != nil && value != nil {
+				kwargs[name.Content(content)] = value
+			}
+		case "comment":
+			continue
+		default:
+			args = append(args, arg)
+		}
+	}
+	return args, kwargs
+}
+
+// cutLast splits a dotted name at its last dot.
+func cutLast(name string) (string, string, bool) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", "", false
+	}
+	return name[:i], name[i+1:], true
+}
+
+// lastSegment returns the last segment of a dotted name such as views.UserView.
+func lastSegment(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// ExtractSchemas extracts schema definitions from Pydantic models and
+// dataclasses.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, model := range pf.PydanticModels {
+			schema := p.pydanticModelToSchema(model)
+			if schema != nil {
+				schemas = append(schemas, *schema)
+			}
+		}
+
+		pf.Close()
+	}
+
+	return schemas, nil
+}
+
+// pydanticModelToSchema converts a Pydantic model to an OpenAPI schema.
+func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema {
+	schema := &types.Schema{
+		Title:      model.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+		Source:     model.Source(),
+	}
+
+	for _, field := range model.Fields {
+		// Convert Python type to OpenAPI type
+		propSchema := parser.PythonTypeSchema(field.Type)
+
+		// Handle array types
+		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
+			propSchema.Type = "array"
+			innerType := extractGenericType(field.Type)
+			propSchema.Items = parser.PythonTypeSchema(innerType)
+		}
+
+		// Emit constant defaults typed for the property
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
+		}
+
+		if field.Description != "" {
+			propSchema.Description = field.Description
+		}
+
+		schema.Properties[field.Name] = propSchema
+
+		if !field.IsOptional && !parser.PythonHasDefault(field.Default) {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+// --- Helper Functions ---
+
+// pathParamRegex matches aiohttp path parameters like {id} or {id:\d+},
+// allowing one level of braces inside the regex as in {code:\d{3}}.
+var pathParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::((?:[^{}]|\{[^{}]*\})+))?\}`)
+
+// numericRegexes are the parameter regexes that only match integers.
+var numericRegexes = map[string]bool{`\d+`: true, `[0-9]+`: true, `\d*`: true, `[0-9]*`: true}
+
+// convertPathParams strips regexes from path parameters: {id:\d+} -> {id}.
+func convertPathParams(path string) string {
+	return pathParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters, typing numeric regexes as
+// integers.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		schema := &types.Schema{Type: "string"}
+		if numericRegexes[match[2]] {
+			schema.Type = "integer"
+		}
+
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	return params
+}
+
+// combinePaths combines a prefix and path, handling slashes correctly.
+func combinePaths(prefix, path string) string {
+	if prefix == "" {
+		if path == "" {
+			return "/"
+		}
+		return path
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if path == "" || path == "/" {
+		return prefix
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return prefix + path
+}
+
+// braceParamRegex matches OpenAPI-style path parameters like {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	// Generate from path
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// extractGenericType extracts the inner type from a generic like List[str].
+func extractGenericType(s string) string {
+	start := strings.Index(s, "[")
+	end := strings.LastIndex(s, "]")
+	if start == -1 || end == -1 || end <= start {
+		return ""
+	}
+	return strings.TrimSpace(s[start+1 : end])
+}
+
+// Register registers the aiohttp plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package aiohttp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// aiohttpAppCode is a test fixture covering router calls, route definitions,
+// route tables, class-based views, and sub-applications.
+const aiohttpAppCode = `
+from aiohttp import web
+
+from .views import OrderView
+
+routes = web.RouteTableDef()
+
+
+@routes.get("/items")
+async def list_items(request):
+    ...
+
+
+@routes.route("PUT", "/items/{id}")
+async def replace_item(request):
+    ...
+
+
+@routes.view("/carts/{id}")
+class CartView(web.View):
+    async def get(self):
+        ...
+
+    async def delete(self):
+        ...
+
+
+async def list_users(request):
+    ...
+
+
+async def get_user(request):
+    ...
+
+
+async def create_user(request):
+    ...
+
+
+def create_app():
+    app = web.Application()
+    app.router.add_get("/users", list_users)
+    app.router.add_post("/users", create_user)
+    app.router.add_route("GET", r"/users/{id:\d+}", get_user)
+    app.router.add_static("/static", "static")
+
+    api = web.Application()
+    api.add_routes([
+        web.get("/health", health),
+        web.view("/orders", OrderView),
+    ])
+    api.add_routes(routes)
+    app.add_subapp("/api/v1", api)
+    return app
+`
+
+// aiohttpViewsCode defines a view registered from another module.
+const aiohttpViewsCode = `
+from aiohttp import web
+
+
+class OrderView(web.View):
+    async def get(self):
+        ...
+
+    async def post(self):
+        ...
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "aiohttp", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements string
+		expected     bool
+	}{
+		{"aiohttp", "aiohttp==3.9.0\n", true},
+		{"client in fastapi project", "fastapi==0.110.0\naiohttp==3.9.0\n", false},
+		{"no aiohttp", "requests==2.28.0\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte(tt.requirements), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(aiohttpAppCode)},
+		{Path: "views.py", Language: "python", Content: []byte(aiohttpViewsCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	listUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, listUsers)
+	assert.Equal(t, "list_users", listUsers.Handler)
+	assert.Equal(t, "app.py", listUsers.SourceFile)
+	require.NotNil(t, findRoute(routes, "POST", "/users"))
+
+	getUser := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, getUser)
+	require.Len(t, getUser.Parameters, 1)
+	assert.Equal(t, "integer", getUser.Parameters[0].Schema.Type)
+
+	// Routes of a sub-application take its prefix
+	require.NotNil(t, findRoute(routes, "GET", "/api/v1/health"))
+	getOrders := findRoute(routes, "GET", "/api/v1/orders")
+	require.NotNil(t, getOrders)
+	assert.Equal(t, "OrderView.get", getOrders.Handler)
+	require.NotNil(t, findRoute(routes, "POST", "/api/v1/orders"))
+
+	// Route table decorators, including the table's sub-application prefix
+	listItems := findRoute(routes, "GET", "/api/v1/items")
+	require.NotNil(t, listItems)
+	assert.Equal(t, []string{"items"}, listItems.Tags)
+	require.NotNil(t, findRoute(routes, "PUT", "/api/v1/items/{id}"))
+	require.NotNil(t, findRoute(routes, "GET", "/api/v1/carts/{id}"))
+	require.NotNil(t, findRoute(routes, "DELETE", "/api/v1/carts/{id}"))
+
+	assert.Len(t, routes, 10)
+}
+
+func TestPlugin_ExtractRoutes_IgnoresNonAiohttp(t *testing.T) {
+	code := `
+from flask import Flask
+
+app.router.add_get("/users", list_users)
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+}
+
+func TestConvertPathParams(t *testing.T) {
+	assert.Equal(t, "/users/{id}", convertPathParams(`/users/{id:\d+}`))
+	assert.Equal(t, "/codes/{code}", convertPathParams(`/codes/{code:\d{3}}`))
+	assert.Equal(t, "/users/{id}", convertPathParams("/users/{id}"))
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
@@ -219,9 +219,12 @@ func (p *Plugin) extractRoutesFromController(class parser.CSharpClass, filePath
 	controllerName := strings.TrimSuffix(class.Name, "Controller")
 	baseRoute = strings.ReplaceAll(baseRoute, "[controller]", strings.ToLower(controllerName))
 
+	// Media types negotiated by every action of the controller
+	produces := producedMediaTypes(class.GetAttribute("Produces"))
+
 	// Extract routes from methods
 	for _, method := range class.Methods {
-		methodRoutes := p.extractRoutesFromMethod(method, baseRoute, controllerName, filePath)
+		methodRoutes := p.extractRoutesFromMethod(method, baseRoute, controllerName, filePath, produces)
 		routes = append(routes, methodRoutes...)
 	}
 
@@ -229,9 +232,14 @@ func (p *Plugin) extractRoutesFromController(class parser.CSharpClass, filePath
 }
 
 // extractRoutesFromMethod extracts routes from a controller method.
-func (p *Plugin) extractRoutesFromMethod(method parser.CSharpMethod, baseRoute, controllerName, filePath string) []types.Route {
+func (p *Plugin) extractRoutesFromMethod(method parser.CSharpMethod, baseRoute, controllerName, filePath string, classProduces []string) []types.Route {
 	var routes []types.Route
 
+	produces := producedMediaTypes(method.GetAttribute("Produces"))
+	if len(produces) == 0 {
+		produces = classProduces
+	}
+
 	for _, attr := range method.Attributes {
 		httpMethod, ok := httpMethods[attr.Name]
 		if !ok {
@@ -251,6 +259,7 @@ func (p *Plugin) extractRoutesFromMethod(method parser.CSharpMethod, baseRoute,
 		fullPath = strings.ReplaceAll(fullPath, "[action]", strings.ToLower(method.Name))
 
 		// Convert to OpenAPI format
+		wildcard := plugins.IsCatchAll(fullPath)
 		fullPath = convertAspNetPathParams(fullPath)
 
 		// Extract path parameters
@@ -270,7 +279,7 @@ func (p *Plugin) extractRoutesFromMethod(method parser.CSharpMethod, baseRoute,
 		// Infer tags
 		tags := []string{controllerName}
 
-		routes = append(routes, types.Route{
+		route := types.Route{
 			Method:      httpMethod,
 			Path:        fullPath,
 			Handler:     controllerName + "." + method.Name,
@@ -279,12 +288,25 @@ func (p *Plugin) extractRoutesFromMethod(method parser.CSharpMethod, baseRoute,
 			Parameters:  params,
 			SourceFile:  filePath,
 			SourceLine:  method.Line,
-		})
+			Produces:    produces,
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
 	}
 
 	return routes
 }
 
+// producedMediaTypes returns the media types of a [Produces] attribute.
+func producedMediaTypes(attr *parser.CSharpAttribute) []string {
+	if attr == nil {
+		return nil
+	}
+	return plugins.MediaTypes(strings.Join(attr.Arguments, ","))
+}
+
 // convertMinimalRoute converts a minimal API route to a types.Route.
 func (p *Plugin) convertMinimalRoute(route parser.CSharpMinimalRoute, filePath string) *types.Route {
 	fullPath := convertAspNetPathParams(route.Path)
@@ -292,7 +314,7 @@ func (p *Plugin) convertMinimalRoute(route parser.CSharpMinimalRoute, filePath s
 	operationID := generateOperationID(route.Method, fullPath, "")
 	tags := inferTags(fullPath)
 
-	return &types.Route{
+	r := &types.Route{
 		Method:      route.Method,
 		Path:        fullPath,
 		Handler:     route.Handler,
@@ -302,6 +324,11 @@ func (p *Plugin) convertMinimalRoute(route parser.CSharpMinimalRoute, filePath s
 		SourceFile:  filePath,
 		SourceLine:  route.Line,
 	}
+	if plugins.IsCatchAll(route.Path) {
+		plugins.MarkWildcard(r)
+	}
+
+	return r
 }
 
 // hasFromBodyAttribute checks if a parameter has [FromBody] attribute.
@@ -320,10 +347,11 @@ var aspnetParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::[^}]+)?
 // braceParamRegex matches OpenAPI-style path parameters like {param}.
 var braceParamRegex = regexp.MustCompile(`\{([^}:]+)\}`)
 
-// convertAspNetPathParams converts ASP.NET-style path params ({id:int}) to OpenAPI format ({id}).
+// convertAspNetPathParams converts ASP.NET-style path params ({id:int}) to OpenAPI format ({id}),
+// and catch-all params ({*slug}) to a templated {slug}.
 func convertAspNetPathParams(path string) string {
 	// Remove type constraints
-	return aspnetParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(aspnetParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
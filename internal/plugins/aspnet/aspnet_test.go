@@ -374,6 +374,46 @@ func TestPlugin_ExtractRoutes_RouteConstraints(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_Produces(t *testing.T) {
+	code := `
+using Microsoft.AspNetCore.Mvc;
+
+namespace MyApp.Controllers
+{
+    [ApiController]
+    [Route("api/reports")]
+    [Produces("application/json")]
+    public class ReportsController : ControllerBase
+    {
+        [HttpGet("summary")]
+        public IActionResult GetSummary()
+        {
+            return Ok();
+        }
+
+        [HttpGet("{id}")]
+        [Produces("application/json", "application/xml", "text/csv")]
+        public IActionResult Get(int id)
+        {
+            return Ok();
+        }
+    }
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "Controllers/ReportsController.cs", Language: "csharp", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	summary := findRoute(routes, "GET", "/api/reports/summary")
+	require.NotNil(t, summary)
+	assert.Equal(t, []string{"application/json"}, summary.Produces)
+
+	one := findRoute(routes, "GET", "/api/reports/{id}")
+	require.NotNil(t, one)
+	assert.Equal(t, []string{"application/json", "application/xml", "text/csv"}, one.Produces)
+}
+
 func TestPlugin_ExtractRoutes_IgnoresNonCSharp(t *testing.T) {
 	p := New()
 
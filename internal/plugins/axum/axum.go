@@ -6,9 +6,11 @@ package axum
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -109,14 +111,22 @@ func (p *Plugin) checkCargoForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Axum route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "rust" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -125,6 +135,35 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		routes = append(routes, fileRoutes...)
 	}
 
+	// Overlay #[utoipa::path(...)] metadata; annotated handlers registered
+	// through utoipa-axum's routes! carry their own method and path
+	paths := p.rustParser.UtoipaPaths(ctx, files)
+	matched := make(map[string]bool)
+	for i := range routes {
+		name := handlerFunction(routes[i].Handler)
+		if op, ok := paths[name]; ok {
+			op.Apply(&routes[i])
+			matched[name] = true
+		}
+	}
+	for _, name := range sortedKeys(paths) {
+		op := paths[name]
+		if matched[name] || op.Method == "" || op.Path == "" {
+			continue
+		}
+		method := strings.ToUpper(op.Method)
+		route := types.Route{
+			Method:      method,
+			Path:        op.Path,
+			Handler:     name,
+			OperationID: generateOperationID(method, op.Path, name),
+			Tags:        inferTags(op.Path),
+			Parameters:  extractPathParams(op.Path),
+		}
+		op.Apply(&route)
+		routes = append(routes, route)
+	}
+
 	// Deduplicate routes (same method + path = same route)
 	routes = deduplicateRoutes(routes)
 
@@ -149,8 +188,8 @@ func deduplicateRoutes(routes []types.Route) []types.Route {
 }
 
 // extractRoutesFromFile extracts routes from a single Rust file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.rustParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.rustParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -308,6 +347,7 @@ func (p *Plugin) parseMethodHandlers(path, methodsStr string, node *sitter.Node,
 	var routes []types.Route
 
 	// Convert Axum :param to OpenAPI {param}
+	wildcard := plugins.IsCatchAll(path)
 	fullPath := convertPathParams(path)
 	params := extractPathParams(fullPath)
 	tags := inferTags(fullPath)
@@ -341,6 +381,9 @@ func (p *Plugin) parseMethodHandlers(path, methodsStr string, node *sitter.Node,
 			Parameters:  params,
 			SourceLine:  line,
 		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
 
 		routes = append(routes, route)
 	}
@@ -348,90 +391,64 @@ func (p *Plugin) parseMethodHandlers(path, methodsStr string, node *sitter.Node,
 	return routes
 }
 
-// ExtractSchemas extracts schema definitions from Rust structs with serde.
+// ExtractSchemas extracts schema definitions from Rust structs and enums
+// with serde.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	var schemas []types.Schema
 
+	// Flattened fields may refer to structs declared in any file
+	structs := make(map[string]parser.RustStruct)
+	var derived []parser.RustStruct
+	var enums []parser.RustEnum
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "rust" {
 			continue
 		}
 
-		pf, err := p.rustParser.Parse(file.Path, file.Content)
+		pf, err := p.rustParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
 
 		for _, s := range pf.Structs {
-			// Only extract structs with Serialize or Deserialize derives
-			if s.HasDeriveAttribute("Serialize") || s.HasDeriveAttribute("Deserialize") {
-				schema := p.structToSchema(s)
-				if schema != nil {
-					schemas = append(schemas, *schema)
-				}
+			structs[s.Name] = s
+			// Only extract structs with serde, utoipa, or schemars derives
+			if s.IsSchema() {
+				derived = append(derived, s)
+			}
+		}
+		for _, e := range pf.Enums {
+			if e.IsSchema() {
+				enums = append(enums, e)
 			}
 		}
 
 		pf.Close()
 	}
 
-	return schemas, nil
-}
-
-// structToSchema converts a Rust struct to an OpenAPI schema.
-func (p *Plugin) structToSchema(s parser.RustStruct) *types.Schema {
-	schema := &types.Schema{
-		Title:      s.Name,
-		Type:       "object",
-		Properties: make(map[string]*types.Schema),
-		Required:   []string{},
-	}
-
-	for _, field := range s.Fields {
-		propSchema := &types.Schema{}
-
-		// Get the field name (possibly renamed by serde)
-		fieldName := field.Name
-		if rename := field.GetSerdeRename(); rename != "" {
-			fieldName = rename
-		}
-
-		// Convert Rust type to OpenAPI type
-		isOptional := strings.HasPrefix(field.Type, "Option<")
-		openAPIType, format := parser.RustTypeToOpenAPI(field.Type)
-		propSchema.Type = openAPIType
-		if format != "" {
-			propSchema.Format = format
-		}
-
-		// Handle Vec<T> types
-		if strings.HasPrefix(field.Type, "Vec<") {
-			propSchema.Type = "array"
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.RustTypeToOpenAPI(innerType)
-			propSchema.Items = &types.Schema{
-				Type:   innerOpenAPIType,
-				Format: innerFormat,
-			}
-		}
-
-		// Handle Option<T> types
-		if isOptional {
-			propSchema.Nullable = true
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.RustTypeToOpenAPI(innerType)
-			propSchema.Type = innerOpenAPIType
-			propSchema.Format = innerFormat
+	for _, s := range derived {
+		schema := parser.RustStructSchema(s, structs)
+		if schema != nil {
+			schemas = append(schemas, *schema)
 		}
-
-		schema.Properties[fieldName] = propSchema
-
-		if !isOptional {
-			schema.Required = append(schema.Required, fieldName)
+	}
+	for _, e := range enums {
+		schema := parser.RustEnumSchema(e, structs)
+		if schema != nil {
+			schemas = append(schemas, *schema)
 		}
 	}
 
-	return schema
+	return schemas, nil
 }
 
 // --- Helper Functions ---
@@ -442,9 +459,10 @@ var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 // braceParamRegex matches OpenAPI-style path parameters like {param}.
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts Axum-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts Axum-style path params (:id) to OpenAPI format ({id}),
+// and wildcards (*rest or {*rest}) to a templated {rest}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -568,6 +586,25 @@ func extractGenericType(s string) string {
 	return strings.TrimSpace(s[start+1 : end])
 }
 
+// handlerFunction returns the function name of a handler path such as
+// handlers::get_user.
+func handlerFunction(handler string) string {
+	if i := strings.LastIndex(handler, "::"); i >= 0 {
+		return handler[i+2:]
+	}
+	return handler
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Register registers the Axum plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
@@ -411,6 +411,273 @@ func TestPlugin_ExtractSchemas_SerdeStructs(t *testing.T) {
 	assert.Contains(t, userSchema.Properties, "email")
 }
 
+func TestPlugin_ExtractSchemas_DocComments(t *testing.T) {
+	code := `
+use serde::{Deserialize, Serialize};
+
+/// A registered account.
+#[derive(Serialize, Deserialize)]
+pub struct Account {
+    /// Unique account identifier.
+    ///
+    /// Assigned on creation.
+    #[serde(rename = "accountId")]
+    pub id: u64,
+    pub email: String, // primary contact address
+    pub name: String,
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "src/models.rs", Language: "rust", Content: []byte(code)},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	account := schemas[0]
+	assert.Equal(t, "A registered account.", account.Description)
+	assert.Equal(t, "Unique account identifier.\n\nAssigned on creation.", account.Properties["accountId"].Description)
+	assert.Equal(t, "primary contact address", account.Properties["email"].Description)
+	assert.Empty(t, account.Properties["name"].Description)
+}
+
+func TestPlugin_ExtractRoutes_UtoipaPaths(t *testing.T) {
+	code := `
+use axum::{routing::get, Json, Router};
+use utoipa_axum::{router::OpenApiRouter, routes};
+
+#[utoipa::path(
+    get,
+    path = "/users/{id}",
+    tag = "accounts",
+    summary = "Fetch a user",
+    params(
+        ("id" = u64, Path, description = "User id"),
+        ("fields" = Option<String>, Query)
+    ),
+    responses(
+        (status = 200, description = "User found", body = User),
+        (status = NOT_FOUND)
+    )
+)]
+async fn get_user(Path(id): Path<u64>) -> Json<User> {
+    Json(User::default())
+}
+
+#[utoipa::path(post, path = "/users", request_body = CreateUser, responses((status = 201, body = User)))]
+#[deprecated]
+async fn create_user(Json(user): Json<CreateUser>) -> Json<User> {
+    Json(User::default())
+}
+
+pub fn router() -> Router {
+    Router::new().route("/users/:id", get(get_user))
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "src/users.rs", Language: "rust", Content: []byte(code)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	byHandler := make(map[string]types.Route)
+	for _, r := range routes {
+		byHandler[r.Handler] = r
+	}
+
+	get := byHandler["get_user"]
+	assert.Equal(t, "/users/{id}", get.Path)
+	assert.Equal(t, "Fetch a user", get.Summary)
+	assert.Equal(t, []string{"accounts"}, get.Tags)
+	require.Len(t, get.Parameters, 2)
+	assert.Equal(t, "path", get.Parameters[0].In)
+	assert.Equal(t, "User id", get.Parameters[0].Description)
+	assert.Equal(t, "integer", get.Parameters[0].Schema.Type)
+	assert.Equal(t, "query", get.Parameters[1].In)
+	assert.False(t, get.Parameters[1].Required)
+	require.Len(t, get.Responses, 2)
+	assert.Equal(t, "#/components/schemas/User", get.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "Not Found", get.Responses["404"].Description)
+
+	// Registered through routes! rather than Router::route
+	create := byHandler["create_user"]
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "/users", create.Path)
+	assert.True(t, create.Deprecated)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Contains(t, create.Responses, "201")
+}
+
+func TestPlugin_ExtractSchemas_Constraints(t *testing.T) {
+	code := `
+use utoipa::ToSchema;
+use validator::Validate;
+
+#[derive(ToSchema, Validate)]
+pub struct CreateUser {
+    #[validate(length(min = 1, max = 64))]
+    pub name: String,
+    #[validate(email)]
+    pub email: String,
+    #[schema(minimum = 0, maximum = 130, example = 42)]
+    pub age: u32,
+    #[validate(length(max = 5))]
+    pub tags: Vec<String>,
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "src/models.rs", Language: "rust", Content: []byte(code)},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	props := schemas[0].Properties
+	require.NotNil(t, props["name"].MinLength)
+	assert.Equal(t, 1, *props["name"].MinLength)
+	assert.Equal(t, 64, *props["name"].MaxLength)
+	assert.Equal(t, "email", props["email"].Format)
+	require.NotNil(t, props["age"].Maximum)
+	assert.Equal(t, 130.0, *props["age"].Maximum)
+	assert.Equal(t, int64(42), props["age"].Example)
+	require.NotNil(t, props["tags"].MaxItems)
+	assert.Equal(t, 5, *props["tags"].MaxItems)
+	assert.Nil(t, props["tags"].MaxLength)
+}
+
+func TestPlugin_ExtractSchemas_SerdeAttributes(t *testing.T) {
+	code := `
+use serde::{Deserialize, Serialize};
+use std::collections::HashMap;
+
+#[derive(Serialize, Deserialize)]
+#[serde(rename_all = "camelCase", deny_unknown_fields)]
+pub struct User {
+    pub user_id: u64,
+    #[serde(rename = "mail")]
+    pub email_address: String,
+    #[serde(default)]
+    pub display_name: String,
+    #[serde(skip_serializing_if = "Vec::is_empty")]
+    pub roles: Vec<String>,
+    #[serde(skip_serializing)]
+    pub password: String,
+    #[serde(skip)]
+    pub cache: Cache,
+    #[serde(flatten)]
+    pub audit: Audit,
+}
+
+struct Audit {
+    created_by: String,
+    updated_by: Option<String>,
+}
+
+#[derive(Serialize)]
+pub struct Extra {
+    pub id: u64,
+    #[serde(flatten)]
+    pub rest: HashMap<String, i64>,
+}
+
+#[derive(Serialize)]
+#[serde(rename_all = "SCREAMING_SNAKE_CASE")]
+pub enum Status {
+    Active,
+    OnHold,
+}
+
+#[derive(Serialize)]
+#[serde(tag = "kind")]
+pub enum Shape {
+    Circle { radius: f64 },
+    Square(Square),
+    Empty,
+}
+
+#[derive(Serialize)]
+#[serde(tag = "t", content = "c")]
+pub enum Message {
+    Text(String),
+    Ping,
+}
+
+#[derive(Serialize)]
+pub enum Command {
+    Move { x: i32, y: i32 },
+    Stop,
+}
+
+#[derive(Serialize)]
+#[serde(untagged)]
+pub enum Id {
+    Number(u64),
+    Name(String),
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "src/models.rs", Language: "rust", Content: []byte(code)},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+	require.Len(t, byName, 7)
+
+	user := byName["User"]
+	assert.Contains(t, user.Properties, "userId")
+	assert.Contains(t, user.Properties, "mail")
+	assert.NotContains(t, user.Properties, "cache")
+	assert.True(t, user.Properties["password"].WriteOnly)
+	// Flattened fields keep the naming of the struct declaring them
+	assert.Contains(t, user.Properties, "created_by")
+	assert.NotContains(t, user.Properties, "createdBy")
+	assert.ElementsMatch(t, []string{"userId", "mail", "password", "created_by"}, user.Required)
+	assert.True(t, user.AdditionalProperties.IsFalse())
+
+	extra := byName["Extra"]
+	require.NotNil(t, extra.AdditionalProperties)
+	assert.Equal(t, "integer", extra.AdditionalProperties.Type)
+
+	status := byName["Status"]
+	assert.Equal(t, "string", status.Type)
+	assert.Equal(t, []interface{}{"ACTIVE", "ON_HOLD"}, status.Enum)
+
+	shape := byName["Shape"]
+	require.Len(t, shape.OneOf, 3)
+	assert.Equal(t, []string{"kind", "radius"}, shape.OneOf[0].Required)
+	assert.Equal(t, []interface{}{"Circle"}, shape.OneOf[0].Properties["kind"].Enum)
+	require.Len(t, shape.OneOf[1].AllOf, 2)
+	assert.Equal(t, "#/components/schemas/Square", shape.OneOf[1].AllOf[0].Ref)
+	assert.Equal(t, []string{"kind"}, shape.OneOf[2].Required)
+
+	message := byName["Message"]
+	require.Len(t, message.OneOf, 2)
+	assert.Equal(t, []string{"t", "c"}, message.OneOf[0].Required)
+	assert.Equal(t, "string", message.OneOf[0].Properties["c"].Type)
+	assert.Equal(t, []string{"t"}, message.OneOf[1].Required)
+
+	command := byName["Command"]
+	require.Len(t, command.OneOf, 2)
+	assert.Equal(t, []string{"Move"}, command.OneOf[0].Required)
+	assert.Equal(t, "object", command.OneOf[0].Properties["Move"].Type)
+	assert.Equal(t, []interface{}{"Stop"}, command.OneOf[1].Enum)
+
+	id := byName["Id"]
+	require.Len(t, id.OneOf, 2)
+	assert.Equal(t, "integer", id.OneOf[0].Type)
+	assert.Equal(t, "string", id.OneOf[1].Type)
+}
+
 func TestConvertPathParams(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -0,0 +1,698 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package bun provides a plugin for extracting routes from Bun.serve and
+// Bun.FileSystemRouter applications.
+package bun
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods are the HTTP methods a route object or module may define.
+var httpMethods = map[string]bool{
+	"GET":     true,
+	"POST":    true,
+	"PUT":     true,
+	"DELETE":  true,
+	"PATCH":   true,
+	"HEAD":    true,
+	"OPTIONS": true,
+}
+
+// Plugin implements the FrameworkPlugin interface for Bun's built-in server.
+type Plugin struct {
+	tsParser  *parser.TypeScriptParser
+	zodParser *schema.ZodParser
+}
+
+// New creates a new Bun plugin instance.
+func New() *Plugin {
+	tsParser := parser.NewTypeScriptParser()
+	return &Plugin{
+		tsParser:  tsParser,
+		zodParser: schema.NewZodParser(tsParser),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "bun"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "bun",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Bun.serve and Bun.FileSystemRouter",
+		SupportedFrameworks: []string{
+			"Bun.serve",
+			"Bun.FileSystemRouter",
+		},
+	}
+}
+
+// frameworkDependencies are server frameworks commonly run on Bun. Projects
+// depending on one are left to that framework's plugin.
+var frameworkDependencies = []string{
+	"elysia",
+	"hono",
+	"express",
+	"fastify",
+	"koa",
+	"@nestjs/core",
+	"@oakserver/oak",
+}
+
+// Detect checks if the project is a Bun project, by its bunfig.toml or
+// lockfile, that does not use a server framework.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	isBun := false
+	for _, name := range []string{"bunfig.toml", "bun.lockb", "bun.lock"} {
+		if _, err := os.Stat(filepath.Join(projectRoot, name)); err == nil {
+			isBun = true
+			break
+		}
+	}
+	if !isBun {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		return true, nil
+	}
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return true, nil
+	}
+	for _, dep := range frameworkDependencies {
+		if _, ok := pkg.Dependencies[dep]; ok {
+			return false, nil
+		}
+		if _, ok := pkg.DevDependencies[dep]; ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ExtractRoutes parses source files and extracts routes from the routes
+// object of Bun.serve and from directories served by Bun.FileSystemRouter.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+	var routerDirs []string
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, object := range p.findServeRoutes(pf.RootNode, file.Content) {
+			routes = append(routes, p.extractRoutesObject(object, pf.RootNode, file)...)
+		}
+		for _, dir := range p.findFileSystemRouters(pf.RootNode, file.Content) {
+			routerDirs = append(routerDirs, filepath.Join(filepath.Dir(file.Path), dir))
+		}
+
+		pf.Close()
+	}
+
+	for _, dir := range routerDirs {
+		for _, file := range files {
+			rel, err := filepath.Rel(dir, file.Path)
+			if err != nil || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			fileRoutes, err := p.extractFileRoutes(ctx, file, rel)
+			if err != nil {
+				continue
+			}
+			routes = append(routes, fileRoutes...)
+		}
+	}
+
+	return routes, nil
+}
+
+// findServeRoutes finds the routes objects passed to Bun.serve() or serve()
+// from "bun", or set on a default-exported server config.
+func (p *Plugin) findServeRoutes(rootNode *sitter.Node, content []byte) []*sitter.Node {
+	var configs []*sitter.Node
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "call_expression":
+			callee := p.tsParser.GetCalleeText(node, content)
+			if callee != "Bun.serve" && callee != "serve" {
+				return true
+			}
+			if args := p.tsParser.GetCallArguments(node, content); len(args) > 0 {
+				configs = append(configs, p.resolveObject(rootNode, content, args[0]))
+			}
+		case "export_statement":
+			if value := node.ChildByFieldName("value"); value != nil {
+				configs = append(configs, p.resolveObject(rootNode, content, value))
+			}
+		}
+		return true
+	})
+
+	var objects []*sitter.Node
+	for _, config := range configs {
+		if config == nil || config.Type() != "object" {
+			continue
+		}
+		if routes := p.property(config, content, "routes"); routes != nil {
+			if object := p.resolveObject(rootNode, content, routes); object != nil && object.Type() == "object" {
+				objects = append(objects, object)
+			}
+		}
+	}
+	return objects
+}
+
+// resolveObject returns node, or the value of the const it names, with
+// satisfies and as expressions unwrapped.
+func (p *Plugin) resolveObject(rootNode *sitter.Node, content []byte, node *sitter.Node) *sitter.Node {
+	if node.Type() == "identifier" {
+		if decl := p.findDeclaration(rootNode, content, node.Content(content)); decl != nil {
+			if value := decl.ChildByFieldName("value"); value != nil {
+				node = value
+			}
+		}
+	}
+	for (node.Type() == "satisfies_expression" || node.Type() == "as_expression") && node.NamedChildCount() > 0 {
+		node = node.NamedChild(0)
+	}
+	return node
+}
+
+// property returns the value of a property of an object literal.
+func (p *Plugin) property(object *sitter.Node, content []byte, name string) *sitter.Node {
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		member := object.NamedChild(i)
+		switch member.Type() {
+		case "pair":
+			if key := member.ChildByFieldName("key"); key != nil && strings.Trim(key.Content(content), `"'`) == name {
+				return member.ChildByFieldName("value")
+			}
+		case "shorthand_property_identifier":
+			if member.Content(content) == name {
+				return member
+			}
+		}
+	}
+	return nil
+}
+
+// extractRoutesObject creates routes from the entries of a routes object.
+// An entry's value is a per-method object, or a handler, Response or file
+// served for every method, which is documented as GET.
+func (p *Plugin) extractRoutesObject(object, rootNode *sitter.Node, file scanner.SourceFile) []types.Route {
+	var routes []types.Route
+
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		entry := object.NamedChild(i)
+		if entry.Type() != "pair" {
+			continue
+		}
+		key := entry.ChildByFieldName("key")
+		value := entry.ChildByFieldName("value")
+		if key == nil || value == nil {
+			continue
+		}
+		path, ok := p.tsParser.ExtractStringLiteral(key, file.Content)
+		if !ok || !strings.HasPrefix(path, "/") {
+			continue
+		}
+		wildcard := plugins.IsCatchAll(path)
+		path = convertPathParams(path)
+
+		var entryRoutes []types.Route
+		if value.Type() != "object" {
+			entryRoutes = append(entryRoutes, p.buildRoute("GET", path, value, rootNode, file))
+		} else {
+			for j := 0; j < int(value.NamedChildCount()); j++ {
+				member := value.NamedChild(j)
+				var methodKey, handler *sitter.Node
+				switch member.Type() {
+				case "pair":
+					methodKey = member.ChildByFieldName("key")
+					handler = member.ChildByFieldName("value")
+				case "method_definition":
+					methodKey = member.ChildByFieldName("name")
+					handler = member
+				}
+				if methodKey == nil || handler == nil {
+					continue
+				}
+				method := strings.Trim(methodKey.Content(file.Content), `"'`)
+				if httpMethods[method] {
+					entryRoutes = append(entryRoutes, p.buildRoute(method, path, handler, rootNode, file))
+				}
+			}
+		}
+		if wildcard {
+			markWildcards(entryRoutes)
+		}
+		routes = append(routes, entryRoutes...)
+	}
+
+	return routes
+}
+
+// markWildcards marks routes served for a catch-all path as x-wildcard.
+func markWildcards(routes []types.Route) {
+	for i := range routes {
+		plugins.MarkWildcard(&routes[i])
+	}
+}
+
+// buildRoute creates a route served by handler, which may name a function
+// declared in the file.
+func (p *Plugin) buildRoute(method, path string, handler, rootNode *sitter.Node, file scanner.SourceFile) types.Route {
+	route := types.Route{
+		Method:      method,
+		Path:        path,
+		OperationID: generateOperationID(method, path, ""),
+		Tags:        inferTags(path),
+		Parameters:  extractPathParams(path),
+		SourceFile:  file.Path,
+		SourceLine:  int(handler.StartPoint().Row) + 1,
+	}
+
+	if handler.Type() == "identifier" {
+		name := handler.Content(file.Content)
+		decl := p.findDeclaration(rootNode, file.Content, name)
+		if !isFunction(decl) {
+			// A Response, file or HTML import served as is
+			return route
+		}
+		route.Handler = name
+		route.OperationID = generateOperationID(method, path, name)
+		handler = decl
+	}
+
+	if method == "POST" || method == "PUT" || method == "PATCH" {
+		route.RequestBody = requestBody(handler.Content(file.Content))
+	}
+	return route
+}
+
+// findDeclaration returns the function declaration or variable declarator
+// declaring name.
+func (p *Plugin) findDeclaration(rootNode *sitter.Node, content []byte, name string) *sitter.Node {
+	var found *sitter.Node
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if found != nil {
+			return false
+		}
+		switch node.Type() {
+		case "function_declaration", "variable_declarator":
+			if n := node.ChildByFieldName("name"); n != nil && n.Content(content) == name {
+				found = node
+				return false
+			}
+		}
+		return true
+	})
+
+	return found
+}
+
+// isFunction reports whether a declaration declares a function.
+func isFunction(decl *sitter.Node) bool {
+	if decl == nil {
+		return false
+	}
+	if decl.Type() == "function_declaration" {
+		return true
+	}
+	value := decl.ChildByFieldName("value")
+	if value == nil {
+		return false
+	}
+	switch value.Type() {
+	case "arrow_function", "function_expression", "function":
+		return true
+	}
+	return false
+}
+
+// Regex patterns for the dir option of Bun.FileSystemRouter
+var (
+	// Matches template substitutions such as ${import.meta.dir}
+	substitutionRegex = regexp.MustCompile(`\$\{[^}]*\}`)
+
+	// Matches string literal fragments
+	stringFragmentRegex = regexp.MustCompile("[\"'`]([^\"'`]*)[\"'`]")
+)
+
+// findFileSystemRouters returns the directories of Next.js-style
+// Bun.FileSystemRouter instances, relative to the declaring file. The
+// directory may be a literal or built from import.meta.dir.
+func (p *Plugin) findFileSystemRouters(rootNode *sitter.Node, content []byte) []string {
+	var dirs []string
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if node.Type() != "new_expression" {
+			return true
+		}
+		constructor := node.ChildByFieldName("constructor")
+		if constructor == nil {
+			return true
+		}
+		if name := constructor.Content(content); name != "Bun.FileSystemRouter" && name != "FileSystemRouter" {
+			return true
+		}
+		args := node.ChildByFieldName("arguments")
+		if args == nil || args.NamedChildCount() == 0 || args.NamedChild(0).Type() != "object" {
+			return true
+		}
+		options := args.NamedChild(0)
+
+		if style := p.property(options, content, "style"); style != nil {
+			if value, _ := p.tsParser.ExtractStringLiteral(style, content); value != "nextjs" {
+				return true
+			}
+		}
+		dirNode := p.property(options, content, "dir")
+		if dirNode == nil {
+			return true
+		}
+		var fragments []string
+		for _, m := range stringFragmentRegex.FindAllStringSubmatch(substitutionRegex.ReplaceAllString(dirNode.Content(content), ""), -1) {
+			fragments = append(fragments, m[1])
+		}
+		if dir := strings.TrimPrefix(filepath.Clean(strings.Join(fragments, "/")), "/"); dir != "" && dir != "." {
+			dirs = append(dirs, dir)
+		}
+		return true
+	})
+
+	return dirs
+}
+
+// dynamicSegmentRegex matches dynamic segments: [id], [...slug] and [[...slug]].
+var dynamicSegmentRegex = regexp.MustCompile(`^\[{1,2}(?:\.\.\.)?(\w+)\]{1,2}$`)
+
+// routePath converts a file path relative to a router directory to its
+// Next.js-style route. Underscore-prefixed files are not routes.
+func routePath(rel string) (string, bool) {
+	rel = filepath.ToSlash(rel)
+	switch filepath.Ext(rel) {
+	case ".ts", ".tsx", ".js", ".jsx":
+	default:
+		return "", false
+	}
+	rel = strings.TrimSuffix(rel, filepath.Ext(rel))
+
+	var segments []string
+	for _, segment := range strings.Split(rel, "/") {
+		if strings.HasPrefix(segment, "_") {
+			return "", false
+		}
+		if m := dynamicSegmentRegex.FindStringSubmatch(segment); m != nil {
+			segment = "{" + m[1] + "}"
+		}
+		segments = append(segments, segment)
+	}
+	if segments[len(segments)-1] == "index" {
+		segments = segments[:len(segments)-1]
+	}
+
+	return "/" + strings.Join(segments, "/"), true
+}
+
+// extractFileRoutes creates the routes of a module matched by a file-system
+// router: a route per exported method function, or GET for a default export.
+func (p *Plugin) extractFileRoutes(ctx context.Context, file scanner.SourceFile, rel string) ([]types.Route, error) {
+	path, ok := routePath(rel)
+	if !ok {
+		return nil, nil
+	}
+
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	var routes []types.Route
+	var defaultExport *sitter.Node
+	for i := 0; i < int(pf.RootNode.NamedChildCount()); i++ {
+		node := pf.RootNode.NamedChild(i)
+		if node.Type() != "export_statement" {
+			continue
+		}
+		for j := 0; j < int(node.ChildCount()); j++ {
+			if node.Child(j).Type() == "default" {
+				defaultExport = node
+			}
+		}
+
+		decl := node.ChildByFieldName("declaration")
+		if decl == nil {
+			continue
+		}
+		var named []*sitter.Node
+		switch decl.Type() {
+		case "function_declaration":
+			named = append(named, decl)
+		case "lexical_declaration", "variable_declaration":
+			for k := 0; k < int(decl.NamedChildCount()); k++ {
+				if d := decl.NamedChild(k); d.Type() == "variable_declarator" {
+					named = append(named, d)
+				}
+			}
+		}
+		for _, d := range named {
+			name := d.ChildByFieldName("name")
+			if name == nil || !httpMethods[name.Content(file.Content)] {
+				continue
+			}
+			routes = append(routes, p.buildRoute(name.Content(file.Content), path, d, pf.RootNode, file))
+		}
+	}
+
+	if len(routes) == 0 && defaultExport != nil {
+		routes = append(routes, p.buildRoute("GET", path, defaultExport, pf.RootNode, file))
+	}
+	if plugins.IsCatchAll(rel) {
+		markWildcards(routes)
+	}
+
+	return routes, nil
+}
+
+// Regex patterns for request body reads in handlers
+var (
+	// Matches a typed read: const user: User = await req.json()
+	typedBodyRegex = regexp.MustCompile(`:\s*([A-Z]\w*)\s*=\s*await\s+(?:req|request)\.json\(\)`)
+
+	// Matches a cast read: (await req.json()) as User
+	castBodyRegex = regexp.MustCompile(`\b(?:req|request)\.json\(\)\)?\s+as\s+([A-Z]\w*)`)
+
+	// Matches any request body read
+	bodyReadRegex = regexp.MustCompile(`\b(?:req|request)\.(?:json|formData|text)\(\)`)
+)
+
+// requestBody infers the request body read by a handler.
+func requestBody(handler string) *types.RequestBody {
+	var bodySchema *types.Schema
+	if m := typedBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if m := castBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if bodyReadRegex.MatchString(handler) {
+		bodySchema = &types.Schema{Type: "object"}
+	} else {
+		return nil
+	}
+
+	return &types.RequestBody{
+		Required: true,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: bodySchema},
+		},
+	}
+}
+
+// walkNodes walks all nodes in the tree.
+func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	parser.Walk(node, fn)
+}
+
+// ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		for _, zs := range pf.ZodSchemas {
+			p.zodParser.ExtractAndRegister(zs.Name, zs.Node, file.Content)
+		}
+
+		pf.Close()
+	}
+
+	tsExtractor.Registry().Merge(p.zodParser.Registry())
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts Bun-style path params (:id) to OpenAPI format ({id}),
+// and wildcards (*) to a templated {path}.
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// generateOperationID generates an operation ID from method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.ReplaceAll(path, "-", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// Register registers the Bun plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package bun
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const bunServerCode = `
+import homepage from "./index.html";
+
+interface CreateUser {
+  name: string;
+  email?: string;
+}
+
+async function createUser(req: Request) {
+  const input: CreateUser = await req.json();
+  return Response.json(input, { status: 201 });
+}
+
+const router = new Bun.FileSystemRouter({
+  style: "nextjs",
+  dir: ` + "`${import.meta.dir}/pages`" + `,
+});
+
+Bun.serve({
+  port: 3000,
+  routes: {
+    "/": homepage,
+    "/health": new Response("OK"),
+    "/api/users": {
+      GET: () => Response.json([]),
+      POST: createUser,
+    },
+    "/api/users/:id": {
+      async PUT(req) {
+        const patch = (await req.json()) as CreateUser;
+        return Response.json({ id: req.params.id, ...patch });
+      },
+      DELETE: (req) => new Response(null, { status: 204 }),
+    },
+    "/api/*": Response.json({ message: "Not found" }, { status: 404 }),
+  },
+  fetch(req) {
+    const match = router.match(req);
+    return new Response("Not found", { status: 404 });
+  },
+});
+`
+
+const bunPageCode = `
+export function GET(req: Request) {
+  return Response.json({ slug: "post" });
+}
+
+export const POST = async (req: Request) => {
+  const body = await req.json();
+  return Response.json(body);
+};
+`
+
+const bunAboutCode = `
+export default function About() {
+  return new Response("about");
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "bun", p.Name())
+	assert.Contains(t, p.Extensions(), ".ts")
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected bool
+	}{
+		{"bunfig", map[string]string{"bunfig.toml": "[install]\n"}, true},
+		{"text lockfile", map[string]string{"bun.lock": "{}", "package.json": `{"dependencies": {"zod": "^3"}}`}, true},
+		{"elysia project", map[string]string{"bun.lockb": "", "package.json": `{"dependencies": {"elysia": "^1"}}`}, false},
+		{"hono dev dependency", map[string]string{"bunfig.toml": "", "package.json": `{"devDependencies": {"hono": "^4"}}`}, false},
+		{"node project", map[string]string{"package.json": `{"dependencies": {}}`}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+			}
+
+			found, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.ts", Language: "typescript", Content: []byte(bunServerCode)},
+		{Path: "/app/pages/blog/[slug].ts", Language: "typescript", Content: []byte(bunPageCode)},
+		{Path: "/app/pages/about/index.ts", Language: "typescript", Content: []byte(bunAboutCode)},
+		{Path: "/app/pages/_util.ts", Language: "typescript", Content: []byte(bunAboutCode)},
+	})
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	assert.Len(t, routes, 10)
+	for _, key := range []string{
+		"GET /",
+		"GET /health",
+		"GET /api/users",
+		"POST /api/users",
+		"PUT /api/users/{id}",
+		"DELETE /api/users/{id}",
+		"GET /blog/{slug}",
+		"POST /blog/{slug}",
+		"GET /about",
+		"GET /api/{path}",
+	} {
+		assert.Contains(t, byKey, key)
+	}
+
+	create := byKey["POST /api/users"]
+	assert.Equal(t, "createUser", create.Handler)
+	assert.Equal(t, "postCreateUser", create.OperationID)
+	assert.Equal(t, 26, create.SourceLine)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	update := byKey["PUT /api/users/{id}"]
+	assert.Equal(t, "putApiUsersByid", update.OperationID)
+	require.NotNil(t, update.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", update.RequestBody.Content["application/json"].Schema.Ref)
+	require.Len(t, update.Parameters, 1)
+
+	assert.Empty(t, byKey["GET /"].Handler)
+	assert.Equal(t, true, byKey["GET /api/{path}"].Extensions["x-wildcard"])
+	assert.Nil(t, byKey["GET /health"].Extensions)
+
+	post := byKey["POST /blog/{slug}"]
+	assert.Equal(t, "/app/pages/blog/[slug].ts", post.SourceFile)
+	require.NotNil(t, post.RequestBody)
+	assert.Equal(t, "object", post.RequestBody.Content["application/json"].Schema.Type)
+}
+
+func TestRoutePath(t *testing.T) {
+	tests := []struct {
+		rel  string
+		path string
+		ok   bool
+	}{
+		{"index.tsx", "/", true},
+		{"settings.tsx", "/settings", true},
+		{"blog/[slug].tsx", "/blog/{slug}", true},
+		{"docs/[[...slug]].tsx", "/docs/{slug}", true},
+		{"_app.tsx", "", false},
+		{"styles.css", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rel, func(t *testing.T) {
+			path, ok := routePath(tt.rel)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "/app/server.ts", Language: "typescript", Content: []byte(bunServerCode)},
+		{Path: "/app/pages/blog/[slug].ts", Language: "typescript", Content: []byte(bunPageCode)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, schemas, 1)
+	assert.Equal(t, "CreateUser", schemas[0].Title)
+}
@@ -0,0 +1,587 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package cakephp provides a plugin for extracting routes from CakePHP applications.
+package cakephp
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for CakePHP.
+type Plugin struct{}
+
+// New creates a new CakePHP plugin instance.
+func New() *Plugin {
+	return &Plugin{}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "cakephp"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".php"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "cakephp",
+		Version:     "1.0.0",
+		Description: "Extracts routes from CakePHP applications",
+		SupportedFrameworks: []string{
+			"cakephp/cakephp",
+		},
+	}
+}
+
+// Detect checks if CakePHP is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	composerPath := filepath.Join(projectRoot, "composer.json")
+	if found, _ := p.checkFileForDependency(composerPath, "cakephp/cakephp"); found {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// anyMethods are the methods a connect() route without _method is
+// documented for.
+var anyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// resourceAction is a route generated by $routes->resources().
+type resourceAction struct {
+	method string
+	action string
+	member bool
+}
+
+// resourceActions are the default resource routes, in CakePHP's order.
+var resourceActions = []resourceAction{
+	{"GET", "index", false},
+	{"POST", "add", false},
+	{"GET", "view", true},
+	{"PUT", "edit", true},
+	{"PATCH", "edit", true},
+	{"DELETE", "delete", true},
+}
+
+// Regex patterns for CakePHP route extraction
+var (
+	// Matches $routes->connect(, Router::scope(, etc.
+	cakeRouteRegex = regexp.MustCompile(`(?:\$(\w+)\s*->|Router::)\s*(connect|get|post|put|patch|delete|options|head|resources|scope|prefix|plugin)\s*\(`)
+
+	// Matches a string option like 'controller' => 'Articles'
+	cakeOptionRegex = regexp.MustCompile(`['"](\w+)['"]\s*=>\s*['"]([^'"]*)['"]`)
+
+	// Matches a list option like 'only' => ['index', 'view']
+	cakeListOptionRegex = regexp.MustCompile(`['"](only|_method)['"]\s*=>\s*\[([^\]]*)\]`)
+
+	// Matches quoted words
+	cakeQuotedRegex = regexp.MustCompile(`['"](\w+)['"]`)
+
+	// Matches a string target like 'Admin/Articles::view' or 'Blog.Posts::index'
+	cakeTargetRegex = regexp.MustCompile(`^(?:\w+\.)?(?:[\w/]+/)?(\w+)::(\w+)$`)
+
+	// Matches ->setPatterns([...]) chained after a route
+	cakePatternsRegex = regexp.MustCompile(`->\s*setPatterns\s*\(\s*\[([^\]]*)\]`)
+
+	// Matches legacy :name placeholders
+	colonParamRegex = regexp.MustCompile(`:(\w+)`)
+
+	// Matches {name} placeholders
+	braceParamRegex = regexp.MustCompile(`\{([^}:]+)\}`)
+
+	// Matches the word boundaries dasherize and underscore split on
+	camelBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// skipVars are common non-route variable names (test code, internal objects)
+// whose get/post calls are not route definitions.
+var skipVars = map[string]bool{
+	"this":     true,
+	"response": true,
+	"request":  true,
+	"client":   true,
+	"http":     true,
+}
+
+// ExtractRoutes parses source files and extracts CakePHP route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		fileRoutes := p.extractRoutesFromFile(file)
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// cakeScope is a scope, prefix, plugin, or nested resources closure and the
+// span of its arguments. Integer names the placeholders its prefix adds.
+type cakeScope struct {
+	prefix     string
+	integer    []string
+	start, end int
+}
+
+// extractRoutesFromFile extracts routes from a single PHP file.
+func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
+	var routes []types.Route
+	content := util.NormalizeNewlines(string(file.Content))
+
+	matches := cakeRouteRegex.FindAllStringSubmatchIndex(content, -1)
+
+	// Scopes apply their prefix to the routes inside their closure; a
+	// closure passed to resources() nests routes under a resource member
+	var scopes []cakeScope
+	for _, match := range matches {
+		args := splitArgs(content, match[1]-1)
+		if len(args) < 2 || !strings.Contains(args[len(args)-1].text, "function") {
+			continue
+		}
+		name, ok := phpString(args[0].text)
+		if !ok {
+			continue
+		}
+		options := ""
+		if len(args) > 2 {
+			options = args[1].text
+		}
+
+		scope := cakeScope{start: args[len(args)-1].start, end: args[len(args)-1].end}
+		switch content[match[4]:match[5]] {
+		case "scope":
+			scope.prefix = name
+		case "prefix", "plugin":
+			scope.prefix = stringOption(options, "path", "/"+dasherize(name))
+		case "resources":
+			param := singularize(underscore(name)) + "_id"
+			scope.prefix = resourcePath(name, options) + "/{" + param + "}"
+			scope.integer = []string{param}
+		default:
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+
+	for _, match := range matches {
+		call := content[match[4]:match[5]]
+		if match[2] >= 0 && skipVars[content[match[2]:match[3]]] {
+			continue
+		}
+
+		args := splitArgs(content, match[1]-1)
+		if len(args) == 0 {
+			continue
+		}
+		prefix, integer := scopePrefix(scopes, match[0])
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
+
+		var fileRoutes []types.Route
+		switch call {
+		case "scope", "prefix", "plugin":
+			continue
+		case "resources":
+			fileRoutes = p.resourceRoutes(args, prefix, integer)
+		default:
+			if len(args) < 2 {
+				continue
+			}
+			// Route patterns come from the options or a chained setPatterns()
+			patterns := integer
+			tail := content[args[len(args)-1].end:]
+			if end := strings.Index(tail, ";"); end >= 0 {
+				tail = tail[:end]
+			}
+			if m := cakePatternsRegex.FindStringSubmatch(tail); m != nil {
+				patterns = append(patterns, integerPatterns(m[1])...)
+			}
+			if len(args) > 2 {
+				patterns = append(patterns, integerPatterns(args[2].text)...)
+			}
+			fileRoutes = p.connectRoutes(call, args[0].text, args[1].text, prefix, patterns)
+		}
+
+		for i := range fileRoutes {
+			fileRoutes[i].SourceFile = file.Path
+			fileRoutes[i].SourceLine = lineNum
+		}
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes
+}
+
+// connectRoutes builds the routes of a connect() or get/post/... call.
+func (p *Plugin) connectRoutes(call, pathArg, targetArg, prefix string, integer []string) []types.Route {
+	path, ok := phpString(pathArg)
+	if !ok {
+		return nil
+	}
+
+	handler := ""
+	methods := []string{strings.ToUpper(call)}
+	if call == "connect" {
+		methods = anyMethods
+	}
+
+	if target, ok := phpString(targetArg); ok {
+		if match := cakeTargetRegex.FindStringSubmatch(target); match != nil {
+			handler = match[1] + "::" + match[2]
+		}
+	} else {
+		controller := stringOption(targetArg, "controller", "")
+		if controller != "" {
+			handler = controller + "::" + stringOption(targetArg, "action", "index")
+		}
+		if method := stringOption(targetArg, "_method", ""); method != "" {
+			methods = []string{strings.ToUpper(method)}
+		}
+		for _, match := range cakeListOptionRegex.FindAllStringSubmatch(targetArg, -1) {
+			if match[1] != "_method" {
+				continue
+			}
+			methods = nil
+			for _, method := range cakeQuotedRegex.FindAllStringSubmatch(match[2], -1) {
+				methods = append(methods, strings.ToUpper(method[1]))
+			}
+		}
+	}
+
+	fullPath := combinePaths(prefix, path)
+	wildcard := plugins.IsCatchAll(fullPath)
+	fullPath = plugins.CatchAllPath(colonParamRegex.ReplaceAllString(fullPath, "{$1}"))
+
+	var routes []types.Route
+	for _, method := range methods {
+		routes = append(routes, p.createRoute(method, fullPath, handler, integer, wildcard))
+	}
+	return routes
+}
+
+// resourceRoutes expands $routes->resources('Articles', [...]).
+func (p *Plugin) resourceRoutes(args []phpArg, prefix string, integer []string) []types.Route {
+	name, ok := phpString(args[0].text)
+	if !ok {
+		return nil
+	}
+
+	options := ""
+	if len(args) > 1 && strings.HasPrefix(args[1].text, "[") {
+		options = args[1].text
+	}
+	only := map[string]bool{}
+	if action := stringOption(options, "only", ""); action != "" {
+		only[action] = true
+	}
+	for _, match := range cakeListOptionRegex.FindAllStringSubmatch(options, -1) {
+		if match[1] != "only" {
+			continue
+		}
+		for _, action := range cakeQuotedRegex.FindAllStringSubmatch(match[2], -1) {
+			only[action[1]] = true
+		}
+	}
+
+	// Resource ids match CakePHP's default [0-9]+ pattern
+	integer = append(append([]string{}, integer...), "id")
+	basePath := combinePaths(prefix, resourcePath(name, options))
+
+	var routes []types.Route
+	for _, a := range resourceActions {
+		if len(only) > 0 && !only[a.action] {
+			continue
+		}
+		path := basePath
+		if a.member {
+			path += "/{id}"
+		}
+		routes = append(routes, p.createRoute(a.method, path, name+"::"+a.action, integer, false))
+	}
+	return routes
+}
+
+// resourcePath returns the path of a resource: its 'path' option, or the
+// controller name inflected by the 'inflect' option (underscore by default).
+func resourcePath(name, options string) string {
+	if path := stringOption(options, "path", ""); path != "" {
+		return path
+	}
+	if stringOption(options, "inflect", "underscore") == "dasherize" {
+		return "/" + dasherize(name)
+	}
+	return "/" + underscore(name)
+}
+
+// scopePrefix combines the prefixes of the scopes enclosing an offset,
+// outermost first, and the integer placeholders they add.
+func scopePrefix(scopes []cakeScope, offset int) (string, []string) {
+	prefix := ""
+	var integer []string
+	for _, s := range scopes {
+		if s.start < offset && offset < s.end {
+			prefix = combinePaths(prefix, s.prefix)
+			integer = append(integer, s.integer...)
+		}
+	}
+	return prefix, integer
+}
+
+// integerPatterns returns the placeholders an options or setPatterns()
+// array restricts to digits, like 'id' => '\d+' or 'id' => '[0-9]+'.
+func integerPatterns(s string) []string {
+	var integer []string
+	for _, match := range cakeOptionRegex.FindAllStringSubmatch(s, -1) {
+		if match[2] == `\d+` || match[2] == "[0-9]+" {
+			integer = append(integer, match[1])
+		}
+	}
+	return integer
+}
+
+// stringOption returns the value of a string option in a PHP array.
+func stringOption(s, key, fallback string) string {
+	for _, match := range cakeOptionRegex.FindAllStringSubmatch(s, -1) {
+		if match[1] == key {
+			return match[2]
+		}
+	}
+	return fallback
+}
+
+// underscore converts a CamelCase name to lower_case, like Inflector::underscore.
+func underscore(s string) string {
+	return strings.ToLower(camelBoundaryRegex.ReplaceAllString(s, "${1}_${2}"))
+}
+
+// dasherize converts a CamelCase name to lower-case, like Inflector::dasherize.
+func dasherize(s string) string {
+	return strings.ReplaceAll(underscore(s), "_", "-")
+}
+
+// singularize returns the singular of a regular English plural.
+func singularize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "ses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	case strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "ss"):
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
+
+// createRoute creates a route from the extracted information.
+func (p *Plugin) createRoute(method, path, handler string, integer []string, wildcard bool) types.Route {
+	route := types.Route{
+		Method:      method,
+		Path:        path,
+		Handler:     handler,
+		OperationID: generateOperationID(method, path, ""),
+		Tags:        inferTags(path),
+		SourceLine:  0,
+	}
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		schemaType := "string"
+		for _, name := range integer {
+			if name == match[1] {
+				schemaType = "integer"
+			}
+		}
+		route.Parameters = append(route.Parameters, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: schemaType},
+		})
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+
+	return route
+}
+
+// phpArg is a top-level call argument and its offsets.
+type phpArg struct {
+	text       string
+	start, end int
+}
+
+// splitArgs returns the top-level arguments of the call whose opening
+// parenthesis is at open.
+func splitArgs(content string, open int) []phpArg {
+	var args []phpArg
+	depth := 0
+	start := open + 1
+	var quote byte
+
+	add := func(end int) {
+		text := strings.TrimSpace(content[start:end])
+		if text != "" {
+			args = append(args, phpArg{text: text, start: start, end: end})
+		}
+	}
+
+	for i := open; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				add(i)
+				return args
+			}
+		case c == ',' && depth == 1:
+			add(i)
+			start = i + 1
+		}
+	}
+
+	return args
+}
+
+// phpString returns the value of a quoted PHP string literal.
+func phpString(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	return s[1 : len(s)-1], true
+}
+
+// combinePaths combines a prefix and path.
+func combinePaths(prefix, path string) string {
+	prefix = strings.Trim(prefix, "/")
+	path = strings.Trim(path, "/")
+
+	switch {
+	case prefix == "" && path == "":
+		return "/"
+	case prefix == "":
+		return "/" + path
+	case path == "":
+		return "/" + prefix
+	}
+	return "/" + prefix + "/" + path
+}
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions (CakePHP doesn't have standard schemas).
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	// CakePHP doesn't have a standard schema definition pattern
+	return []types.Schema{}, nil
+}
+
+// Register registers the CakePHP plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
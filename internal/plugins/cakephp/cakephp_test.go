@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package cakephp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// cakeRoutesCode is a test fixture covering connected routes, scopes,
+// prefixes, and nested resources from config/routes.php.
+const cakeRoutesCode = `<?php
+
+use Cake\Routing\Route\DashedRoute;
+use Cake\Routing\RouteBuilder;
+
+return static function (RouteBuilder $routes) {
+    $routes->setRouteClass(DashedRoute::class);
+
+    $routes->scope('/', function (RouteBuilder $builder) {
+        $builder->connect('/', ['controller' => 'Pages', 'action' => 'display', 'home']);
+        $builder->connect('/pages/*', 'Pages::display');
+        $builder->connect('/login', ['controller' => 'Users', 'action' => 'login', '_method' => ['GET', 'POST']]);
+        $builder->fallbacks();
+    });
+
+    $routes->scope('/api', function (RouteBuilder $builder) {
+        $builder->setExtensions(['json']);
+        $builder->resources('Articles', function (RouteBuilder $builder) {
+            $builder->resources('Comments', ['only' => ['index', 'view']]);
+        });
+        $builder->get('/tags/{slug}', 'Tags::view');
+        $builder->post('/users/:id/avatar', ['controller' => 'Users', 'action' => 'avatar'])
+            ->setPatterns(['id' => '\d+']);
+    });
+
+    $routes->prefix('Admin', function (RouteBuilder $builder) {
+        $builder->resources('BlogPosts', ['only' => 'index', 'inflect' => 'dasherize']);
+    });
+};
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "cakephp", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		composer string
+		expected bool
+	}{
+		{"cakephp", `{"require": {"cakephp/cakephp": "^5.0"}}`, true},
+		{"no cakephp", `{"require": {"slim/slim": "^4.0"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "composer.json"), []byte(tt.composer), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "config/routes.php", Language: "php", Content: []byte(cakeRoutesCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// connect() without _method matches any method
+	home := findRoute(routes, "GET", "/")
+	require.NotNil(t, home)
+	assert.Equal(t, "Pages::display", home.Handler)
+	assert.Equal(t, 10, home.SourceLine)
+	require.NotNil(t, findRoute(routes, "DELETE", "/"))
+
+	pages := findRoute(routes, "GET", "/pages/{path}")
+	require.NotNil(t, pages)
+	assert.Equal(t, true, pages.Extensions[plugins.WildcardExtension])
+
+	require.NotNil(t, findRoute(routes, "POST", "/login"))
+	assert.Nil(t, findRoute(routes, "DELETE", "/login"))
+
+	// Resources, with nested resources under the member path
+	articles := findRoute(routes, "GET", "/api/articles")
+	require.NotNil(t, articles)
+	assert.Equal(t, "Articles::index", articles.Handler)
+	require.NotNil(t, findRoute(routes, "POST", "/api/articles"))
+	require.NotNil(t, findRoute(routes, "PATCH", "/api/articles/{id}"))
+	require.NotNil(t, findRoute(routes, "DELETE", "/api/articles/{id}"))
+
+	comment := findRoute(routes, "GET", "/api/articles/{article_id}/comments/{id}")
+	require.NotNil(t, comment)
+	assert.Equal(t, "Comments::view", comment.Handler)
+	require.Len(t, comment.Parameters, 2)
+	assert.Equal(t, "integer", comment.Parameters[0].Schema.Type)
+	assert.Nil(t, findRoute(routes, "POST", "/api/articles/{article_id}/comments"))
+
+	tag := findRoute(routes, "GET", "/api/tags/{slug}")
+	require.NotNil(t, tag)
+	assert.Equal(t, "string", tag.Parameters[0].Schema.Type)
+
+	// Legacy :id placeholders, typed by setPatterns()
+	avatar := findRoute(routes, "POST", "/api/users/{id}/avatar")
+	require.NotNil(t, avatar)
+	assert.Equal(t, "Users::avatar", avatar.Handler)
+	require.Len(t, avatar.Parameters, 1)
+	assert.Equal(t, "integer", avatar.Parameters[0].Schema.Type)
+
+	// Prefixes are dasherized
+	posts := findRoute(routes, "GET", "/admin/blog-posts")
+	require.NotNil(t, posts)
+	assert.Equal(t, "BlogPosts::index", posts.Handler)
+
+	assert.Len(t, routes, 23)
+}
+
+func TestInflection(t *testing.T) {
+	assert.Equal(t, "blog_posts", underscore("BlogPosts"))
+	assert.Equal(t, "blog-posts", dasherize("BlogPosts"))
+	assert.Equal(t, "category", singularize("categories"))
+	assert.Equal(t, "article", singularize("articles"))
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -66,6 +67,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".go"}
 }
 
+// RouteMarkers returns the chi import paths, one of which every file
+// defining routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return chiImportPaths
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -139,6 +146,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports chi
 	if !p.hasChiImport(pf) {
+		decisions.Skip(p.Name(), file.Path, "no chi import")
 		return nil, nil
 	}
 
@@ -325,8 +333,10 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 	// Combine with prefix
 	fullPath := ctx.currentPrefix() + path
 
-	// Normalize path
+	// Normalize path, templating the catch-all (*) as {path}
 	fullPath = normalizePath(fullPath)
+	wildcard := plugins.IsCatchAll(fullPath)
+	fullPath = plugins.CatchAllPath(fullPath)
 
 	// Extract handler name if present
 	var handlerName string
@@ -352,6 +362,9 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
 
 	return route
 }
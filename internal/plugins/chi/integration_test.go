@@ -363,9 +363,10 @@ func SetupRoutes(r chi.Router) {
 	assert.Contains(t, routeMap, "GET /admin/settings")
 
 	// Test regex path params
-	filesRoute := routeMap["GET /files/{path:.*}"]
+	filesRoute := routeMap["GET /files/{path}"]
 	require.Len(t, filesRoute.Parameters, 1)
 	assert.Equal(t, "path", filesRoute.Parameters[0].Name)
+	assert.Equal(t, true, filesRoute.Extensions["x-wildcard"])
 
 	usersRoute := routeMap["GET /users/{id:[0-9]+}"]
 	require.Len(t, usersRoute.Parameters, 1)
@@ -0,0 +1,606 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package codeigniter provides a plugin for extracting routes from CodeIgniter 4 applications.
+package codeigniter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for CodeIgniter 4.
+type Plugin struct {
+	phpParser *parser.PHPParser
+}
+
+// New creates a new CodeIgniter plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		phpParser: parser.NewPHPParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "codeigniter"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".php"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "codeigniter",
+		Version:     "1.0.0",
+		Description: "Extracts routes from CodeIgniter 4 applications",
+		SupportedFrameworks: []string{
+			"codeigniter4/framework",
+		},
+	}
+}
+
+// Detect checks if CodeIgniter 4 is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	composerPath := filepath.Join(projectRoot, "composer.json")
+	if found, _ := p.checkFileForDependency(composerPath, "codeigniter4/framework"); found {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// anyMethods are the methods an add() route is documented for.
+var anyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// resourceRoute is a route generated by $routes->resource() or
+// $routes->presenter().
+type resourceRoute struct {
+	method string
+	action string
+
+	// suffix follows the resource name; {id} stands for the placeholder
+	suffix string
+}
+
+// resourceRoutes are the routes of $routes->resource('photos').
+var resourceRoutes = []resourceRoute{
+	{"GET", "index", ""},
+	{"GET", "new", "/new"},
+	{"POST", "create", ""},
+	{"GET", "show", "/{id}"},
+	{"GET", "edit", "/{id}/edit"},
+	{"PUT", "update", "/{id}"},
+	{"PATCH", "update", "/{id}"},
+	{"DELETE", "delete", "/{id}"},
+}
+
+// presenterRoutes are the routes of $routes->presenter('photos').
+var presenterRoutes = []resourceRoute{
+	{"GET", "index", ""},
+	{"GET", "show", "/show/{id}"},
+	{"GET", "new", "/new"},
+	{"POST", "create", "/create"},
+	{"GET", "edit", "/edit/{id}"},
+	{"POST", "update", "/update/{id}"},
+	{"GET", "remove", "/remove/{id}"},
+	{"POST", "delete", "/delete/{id}"},
+	{"GET", "show", "/{id}"},
+}
+
+// Regex patterns for CodeIgniter route extraction
+var (
+	// Matches $routes->get(, $routes->group(, etc.
+	ciRouteRegex = regexp.MustCompile(`\$(\w+)\s*->\s*(get|post|put|patch|delete|options|head|add|match|resource|presenter|group)\s*\(`)
+
+	// Matches a placeholder like (:num) or (:segment)
+	ciPlaceholderRegex = regexp.MustCompile(`\(:(\w+)\)`)
+
+	// Matches a handler like \App\Controllers\Users::show/$1
+	ciHandlerRegex = regexp.MustCompile(`^\\?([\w\\]+)::(\w+)((?:/\$\d+)*)`)
+
+	// Matches a callable array handler like [Users::class, 'show']
+	ciCallableRegex = regexp.MustCompile(`^\[\s*\\?([\w\\]+)::class\s*,\s*['"](\w+)((?:/\$\d+)*)['"]\s*\]`)
+
+	// Matches a string option like 'controller' => 'Photos'
+	ciOptionRegex = regexp.MustCompile(`['"](\w+)['"]\s*=>\s*['"]([^'"]*)['"]`)
+
+	// Matches a list option like 'only' => ['index', 'show']
+	ciListOptionRegex = regexp.MustCompile(`['"](only|except)['"]\s*=>\s*\[([^\]]*)\]`)
+
+	// Matches quoted words
+	ciQuotedRegex = regexp.MustCompile(`['"](\w+)['"]`)
+)
+
+// skipVars are common non-route variable names (test code, internal objects)
+// whose get/post calls are not route definitions.
+var skipVars = map[string]bool{
+	"this":     true,
+	"response": true,
+	"request":  true,
+	"client":   true,
+	"http":     true,
+}
+
+// controllerParams maps "Controller::method" to the method parameters.
+type controllerParams map[string][]parser.PHPParameter
+
+// ExtractRoutes parses source files and extracts CodeIgniter route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	// Controller method parameters name the placeholders passed to them
+	controllers := p.collectControllerParams(files)
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		fileRoutes := p.extractRoutesFromFile(file, controllers)
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// collectControllerParams indexes the parameters of controller methods.
+func (p *Plugin) collectControllerParams(files []scanner.SourceFile) controllerParams {
+	controllers := make(controllerParams)
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		pf := p.phpParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			for _, method := range class.Methods {
+				controllers[class.Name+"::"+method.Name] = method.Parameters
+			}
+		}
+	}
+
+	return controllers
+}
+
+// ciGroup is a route group closure and the span of its arguments.
+type ciGroup struct {
+	prefix     string
+	start, end int
+}
+
+// extractRoutesFromFile extracts routes from a single PHP file.
+func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile, controllers controllerParams) []types.Route {
+	var routes []types.Route
+	content := util.NormalizeNewlines(string(file.Content))
+
+	matches := ciRouteRegex.FindAllStringSubmatchIndex(content, -1)
+
+	// Groups apply their prefix to the routes inside their closure
+	var groups []ciGroup
+	for _, match := range matches {
+		if content[match[4]:match[5]] != "group" {
+			continue
+		}
+		args := splitArgs(content, match[1]-1)
+		if len(args) < 2 {
+			continue
+		}
+		prefix, _ := phpString(args[0].text)
+		last := args[len(args)-1]
+		groups = append(groups, ciGroup{prefix: prefix, start: last.start, end: last.end})
+	}
+
+	for _, match := range matches {
+		call := content[match[4]:match[5]]
+		if call == "group" || skipVars[content[match[2]:match[3]]] {
+			continue
+		}
+
+		args := splitArgs(content, match[1]-1)
+		prefix := groupPrefix(groups, match[0])
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
+
+		var fileRoutes []types.Route
+		switch call {
+		case "resource", "presenter":
+			fileRoutes = p.resourceRoutes(call, args, prefix)
+		case "match":
+			if len(args) < 3 {
+				continue
+			}
+			methods := parseMethodsList(args[0].text)
+			fileRoutes = p.verbRoutes(methods, args[1].text, args[2].text, prefix, controllers)
+		default:
+			if len(args) < 2 {
+				continue
+			}
+			methods := []string{strings.ToUpper(call)}
+			if call == "add" {
+				methods = anyMethods
+			}
+			fileRoutes = p.verbRoutes(methods, args[0].text, args[1].text, prefix, controllers)
+		}
+
+		for i := range fileRoutes {
+			fileRoutes[i].SourceFile = file.Path
+			fileRoutes[i].SourceLine = lineNum
+		}
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes
+}
+
+// verbRoutes builds the routes of a get/post/.../add/match call.
+func (p *Plugin) verbRoutes(methods []string, pathArg, handlerArg, prefix string, controllers controllerParams) []types.Route {
+	path, ok := phpString(pathArg)
+	if !ok {
+		return nil
+	}
+
+	var controller, action, passed string
+	if match := ciCallableRegex.FindStringSubmatch(handlerArg); match != nil {
+		controller, action, passed = match[1], match[2], match[3]
+	} else if handler, ok := phpString(handlerArg); ok {
+		if match := ciHandlerRegex.FindStringSubmatch(handler); match != nil {
+			controller, action, passed = match[1], match[2], match[3]
+		}
+	}
+	if idx := strings.LastIndex(controller, "\\"); idx >= 0 {
+		controller = controller[idx+1:]
+	}
+
+	handler := ""
+	if controller != "" {
+		handler = controller + "::" + action
+	}
+	fullPath, params, wildcard := convertPlaceholders(combinePaths(prefix, path), passedParams(passed, controllers[handler]))
+
+	var routes []types.Route
+	for _, method := range methods {
+		routes = append(routes, p.createRoute(method, fullPath, handler, params, wildcard))
+	}
+	return routes
+}
+
+// passedParams orders the controller parameters by the placeholders passed
+// to them: for show/$2/$1 the first placeholder fills the second parameter.
+func passedParams(passed string, params []parser.PHPParameter) map[int]parser.PHPParameter {
+	byPlaceholder := make(map[int]parser.PHPParameter)
+	for position, ref := range strings.Split(strings.TrimPrefix(passed, "/"), "/") {
+		var n int
+		if _, err := fmt.Sscanf(ref, "$%d", &n); err != nil || position >= len(params) {
+			continue
+		}
+		byPlaceholder[n] = params[position]
+	}
+	return byPlaceholder
+}
+
+// resourceRoutes expands $routes->resource('photos', [...]) and
+// $routes->presenter('photos', [...]).
+func (p *Plugin) resourceRoutes(call string, args []phpArg, prefix string) []types.Route {
+	if len(args) == 0 {
+		return nil
+	}
+	name, ok := phpString(args[0].text)
+	if !ok {
+		return nil
+	}
+
+	options := ""
+	if len(args) > 1 {
+		options = args[1].text
+	}
+	controller := toTitleCase(name)
+	placeholder := "(:segment)"
+	only, except := map[string]bool{}, map[string]bool{}
+	for _, match := range ciOptionRegex.FindAllStringSubmatch(options, -1) {
+		switch match[1] {
+		case "controller":
+			controller = match[2]
+			if idx := strings.LastIndex(controller, "\\"); idx >= 0 {
+				controller = controller[idx+1:]
+			}
+		case "placeholder":
+			placeholder = match[2]
+		case "only", "except":
+			// 'only' => 'index,show'
+			for _, action := range strings.Split(match[2], ",") {
+				if match[1] == "only" {
+					only[strings.TrimSpace(action)] = true
+				} else {
+					except[strings.TrimSpace(action)] = true
+				}
+			}
+		}
+	}
+	for _, match := range ciListOptionRegex.FindAllStringSubmatch(options, -1) {
+		for _, action := range ciQuotedRegex.FindAllStringSubmatch(match[2], -1) {
+			if match[1] == "only" {
+				only[action[1]] = true
+			} else {
+				except[action[1]] = true
+			}
+		}
+	}
+
+	table := resourceRoutes
+	if call == "presenter" {
+		table = presenterRoutes
+	}
+
+	idParam := map[int]parser.PHPParameter{1: {Name: "id"}}
+
+	var routes []types.Route
+	for _, r := range table {
+		if (len(only) > 0 && !only[r.action]) || except[r.action] {
+			continue
+		}
+		path := combinePaths(prefix, name+strings.ReplaceAll(r.suffix, "{id}", placeholder))
+		fullPath, params, wildcard := convertPlaceholders(path, idParam)
+		routes = append(routes, p.createRoute(r.method, fullPath, controller+"::"+r.action, params, wildcard))
+	}
+	return routes
+}
+
+// groupPrefix combines the prefixes of the groups enclosing an offset,
+// outermost first.
+func groupPrefix(groups []ciGroup, offset int) string {
+	prefix := ""
+	for _, g := range groups {
+		if g.start < offset && offset < g.end {
+			prefix = combinePaths(prefix, g.prefix)
+		}
+	}
+	return prefix
+}
+
+// convertPlaceholders converts CodeIgniter placeholders to templated
+// parameters named after the controller parameters they are passed to.
+// (:num) is an integer and (:any) matches the rest of the URI.
+func convertPlaceholders(path string, named map[int]parser.PHPParameter) (string, []types.Parameter, bool) {
+	var params []types.Parameter
+	wildcard := false
+	index := 0
+
+	converted := ciPlaceholderRegex.ReplaceAllStringFunc(path, func(placeholder string) string {
+		index++
+		kind := ciPlaceholderRegex.FindStringSubmatch(placeholder)[1]
+
+		name := fmt.Sprintf("param%d", index)
+		schema := &types.Schema{Type: "string"}
+		if param, ok := named[index]; ok {
+			name = param.Name
+			switch openAPIType, _ := parser.PHPTypeToOpenAPI(param.Type); openAPIType {
+			case "integer", "number", "boolean":
+				schema.Type = openAPIType
+			}
+		}
+		switch kind {
+		case "num":
+			schema.Type = "integer"
+		case "any":
+			wildcard = true
+		}
+
+		params = append(params, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+		return "{" + name + "}"
+	})
+
+	return converted, params, wildcard
+}
+
+// createRoute creates a route from the extracted information.
+func (p *Plugin) createRoute(method, path, handler string, params []types.Parameter, wildcard bool) types.Route {
+	route := types.Route{
+		Method:      method,
+		Path:        path,
+		Handler:     handler,
+		OperationID: generateOperationID(method, path, ""),
+		Tags:        inferTags(path),
+		Parameters:  params,
+		SourceLine:  0,
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	return route
+}
+
+// phpArg is a top-level call argument and its offsets.
+type phpArg struct {
+	text       string
+	start, end int
+}
+
+// splitArgs returns the top-level arguments of the call whose opening
+// parenthesis is at open.
+func splitArgs(content string, open int) []phpArg {
+	var args []phpArg
+	depth := 0
+	start := open + 1
+	var quote byte
+
+	add := func(end int) {
+		text := strings.TrimSpace(content[start:end])
+		if text != "" {
+			args = append(args, phpArg{text: text, start: start, end: end})
+		}
+	}
+
+	for i := open; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				add(i)
+				return args
+			}
+		case c == ',' && depth == 1:
+			add(i)
+			start = i + 1
+		}
+	}
+
+	return args
+}
+
+// phpString returns the value of a quoted PHP string literal.
+func phpString(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	return s[1 : len(s)-1], true
+}
+
+// parseMethodsList parses a methods list such as ['get', 'post'].
+func parseMethodsList(s string) []string {
+	var methods []string
+	for _, match := range ciQuotedRegex.FindAllStringSubmatch(s, -1) {
+		methods = append(methods, strings.ToUpper(match[1]))
+	}
+	return methods
+}
+
+// combinePaths combines a prefix and path. CodeIgniter paths are relative
+// to the site root and may omit the leading slash.
+func combinePaths(prefix, path string) string {
+	prefix = strings.Trim(prefix, "/")
+	path = strings.Trim(path, "/")
+
+	switch {
+	case prefix == "" && path == "":
+		return "/"
+	case prefix == "":
+		return "/" + path
+	case path == "":
+		return "/" + prefix
+	}
+	return "/" + prefix + "/" + path
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}:]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions (CodeIgniter doesn't have standard schemas).
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	// CodeIgniter doesn't have a standard schema definition pattern
+	return []types.Schema{}, nil
+}
+
+// Register registers the CodeIgniter plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package codeigniter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ciRoutesCode is a test fixture covering verb routes, groups, resources,
+// and presenters from app/Config/Routes.php.
+const ciRoutesCode = `<?php
+
+use App\Controllers\Users;
+
+$routes->get('/', 'Home::index');
+$routes->get('users', 'Users::index');
+$routes->get('users/(:num)', 'Users::show/$1');
+$routes->post('users', [Users::class, 'create']);
+$routes->match(['get', 'put'], 'users/(:num)/profile', 'Users::profile/$1');
+$routes->add('pages/(:any)', 'Pages::view/$1');
+
+$routes->group('api', ['filter' => 'auth'], static function ($routes) {
+    $routes->group('v1', static function ($routes) {
+        $routes->get('posts/(:segment)/comments/(:num)', 'Comments::show/$2/$1');
+    });
+    $routes->resource('photos', ['only' => ['index', 'show']]);
+});
+
+$routes->presenter('albums', ['only' => 'index,show']);
+`
+
+// ciControllerCode is a controller whose method parameters name the
+// placeholders passed to them.
+const ciControllerCode = `<?php
+
+namespace App\Controllers;
+
+class Comments extends BaseController
+{
+    public function show(int $commentId, string $postSlug)
+    {
+    }
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "codeigniter", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		composer string
+		expected bool
+	}{
+		{"codeigniter", `{"require": {"codeigniter4/framework": "^4.4"}}`, true},
+		{"no codeigniter", `{"require": {"slim/slim": "^4.0"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "composer.json"), []byte(tt.composer), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "app/Config/Routes.php", Language: "php", Content: []byte(ciRoutesCode)},
+		{Path: "app/Controllers/Comments.php", Language: "php", Content: []byte(ciControllerCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	home := findRoute(routes, "GET", "/")
+	require.NotNil(t, home)
+	assert.Equal(t, "Home::index", home.Handler)
+
+	showUser := findRoute(routes, "GET", "/users/{param1}")
+	require.NotNil(t, showUser)
+	assert.Equal(t, "Users::show", showUser.Handler)
+	assert.Equal(t, 7, showUser.SourceLine)
+	require.Len(t, showUser.Parameters, 1)
+	assert.Equal(t, "integer", showUser.Parameters[0].Schema.Type)
+
+	createUser := findRoute(routes, "POST", "/users")
+	require.NotNil(t, createUser)
+	assert.Equal(t, "Users::create", createUser.Handler)
+
+	require.NotNil(t, findRoute(routes, "GET", "/users/{param1}/profile"))
+	require.NotNil(t, findRoute(routes, "PUT", "/users/{param1}/profile"))
+
+	// add() routes match any method; (:any) is a wildcard
+	viewPage := findRoute(routes, "DELETE", "/pages/{param1}")
+	require.NotNil(t, viewPage)
+	assert.Equal(t, true, viewPage.Extensions[plugins.WildcardExtension])
+
+	// Nested groups, with placeholders named after the controller parameters
+	comment := findRoute(routes, "GET", "/api/v1/posts/{postSlug}/comments/{commentId}")
+	require.NotNil(t, comment)
+	require.Len(t, comment.Parameters, 2)
+	assert.Equal(t, "string", comment.Parameters[0].Schema.Type)
+	assert.Equal(t, "integer", comment.Parameters[1].Schema.Type)
+
+	// Resources limited by 'only'
+	photos := findRoute(routes, "GET", "/api/photos")
+	require.NotNil(t, photos)
+	assert.Equal(t, "Photos::index", photos.Handler)
+	showPhoto := findRoute(routes, "GET", "/api/photos/{id}")
+	require.NotNil(t, showPhoto)
+	assert.Equal(t, "Photos::show", showPhoto.Handler)
+	assert.Nil(t, findRoute(routes, "POST", "/api/photos"))
+
+	// Presenters limited by a comma-separated 'only'
+	require.NotNil(t, findRoute(routes, "GET", "/albums"))
+	require.NotNil(t, findRoute(routes, "GET", "/albums/show/{id}"))
+	require.NotNil(t, findRoute(routes, "GET", "/albums/{id}"))
+
+	assert.Len(t, routes, 17)
+}
+
+func TestPlugin_ExtractRoutes_Resource(t *testing.T) {
+	code := `<?php
+$routes->resource('photos', ['controller' => 'App\Gallery', 'placeholder' => '(:num)', 'except' => 'new,edit']);
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "Routes.php", Language: "php", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	var got []string
+	for _, r := range routes {
+		got = append(got, r.Method+" "+r.Path+" "+r.Handler)
+	}
+	assert.Equal(t, []string{
+		"GET /photos Gallery::index",
+		"POST /photos Gallery::create",
+		"GET /photos/{id} Gallery::show",
+		"PUT /photos/{id} Gallery::update",
+		"PATCH /photos/{id} Gallery::update",
+		"DELETE /photos/{id} Gallery::delete",
+	}, got)
+	assert.Equal(t, "integer", routes[2].Parameters[0].Schema.Type)
+}
+
+func TestConvertPlaceholders(t *testing.T) {
+	path, params, wildcard := convertPlaceholders("/blog/(:alpha)/(:hash)", nil)
+	assert.Equal(t, "/blog/{param1}/{param2}", path)
+	assert.Len(t, params, 2)
+	assert.False(t, wildcard)
+
+	path, _, wildcard = convertPlaceholders("/files/(:any)", nil)
+	assert.Equal(t, "/files/{param1}", path)
+	assert.True(t, wildcard)
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"go/build/constraint"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// braceLanguages delimit blocks with braces.
+var braceLanguages = map[string]bool{
+	"go": true, "typescript": true, "javascript": true, "csharp": true, "php": true,
+	"java": true, "kotlin": true, "cpp": true, "scala": true, "swift": true, "dart": true,
+}
+
+// envRead matches a read of an environment variable and captures its name.
+var envRead = regexp.MustCompile(`os\.Getenv\(\s*"(\w+)"\s*\)` +
+	`|process\.env\.(\w+)|process\.env\[\s*['"](\w+)['"]\s*\]` +
+	`|os\.(?:getenv|environ\.get)\(\s*['"](\w+)['"][^)]*\)|os\.environ\[\s*['"](\w+)['"]\s*\]` +
+	`|System\.getenv\(\s*"(\w+)"\s*\)|Environment\.GetEnvironmentVariable\(\s*"(\w+)"\s*\)` +
+	`|Platform\.environment\[\s*['"](\w+)['"]\s*\]|processInfo\.environment\[\s*"(\w+)"\s*\]` +
+	`|\bgetenv\(\s*['"](\w+)['"]\s*\)|\benv\(\s*['"](\w+)['"][^)]*\)`)
+
+// envCompare matches the comparison following an environment read.
+var envCompare = regexp.MustCompile(`^\s*(===?|!==?)\s*(?:"([^"]*)"|'([^']*)')`)
+
+// MarkConditions records on each route the Go build constraint of its file
+// and the environment checks of the if statements around its registration,
+// such as if os.Getenv("ENABLE_BETA") == "true" or if
+// (process.env.NODE_ENV !== 'production').
+func MarkConditions(routes []types.Route, files []scanner.SourceFile) {
+	byPath := make(map[string]scanner.SourceFile, len(files))
+	for _, f := range files {
+		byPath[f.Path] = f
+	}
+
+	lineConditions := make(map[string][][]types.Condition)
+	for i := range routes {
+		route := &routes[i]
+		f, ok := byPath[route.SourceFile]
+		if !ok {
+			continue
+		}
+		lines, ok := lineConditions[f.Path]
+		if !ok {
+			lines = fileConditions(f)
+			lineConditions[f.Path] = lines
+		}
+		if route.SourceLine > 0 && route.SourceLine < len(lines) {
+			route.Conditions = append(route.Conditions, lines[route.SourceLine]...)
+		}
+	}
+}
+
+// fileConditions returns the conditions in effect at each line of f,
+// indexed by line number.
+func fileConditions(f scanner.SourceFile) [][]types.Condition {
+	content := string(f.Content)
+	var lines [][]types.Condition
+	switch {
+	case f.Language == "python":
+		lines = indentedConditions(content)
+	case braceLanguages[f.Language]:
+		lines = bracedConditions(content)
+	default:
+		lines = make([][]types.Condition, strings.Count(content, "\n")+2)
+	}
+
+	if f.Language == "go" {
+		if expr := goBuildConstraint(content); expr != "" {
+			build := types.Condition{Build: expr}
+			for i := range lines {
+				lines[i] = append([]types.Condition{build}, lines[i]...)
+			}
+		}
+	}
+	return lines
+}
+
+// goBuildConstraint returns the //go:build expression of a Go file.
+func goBuildConstraint(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+		if constraint.IsGoBuild(line) {
+			return strings.TrimSpace(strings.TrimPrefix(line, "//go:build"))
+		}
+	}
+	return ""
+}
+
+// block is an open block and the conditions its header adds.
+type block struct {
+	conditions []types.Condition
+	guard      []types.Condition // the branch's own if condition
+	branch     string            // if, else if, else, or "" for other blocks
+}
+
+// ifChain holds the conditions of the earlier branches of an if statement,
+// so an else branch can negate them.
+type ifChain [][]types.Condition
+
+// negated returns the conditions under which no branch of the chain ran.
+// Branches guarded by several conditions cannot be negated as a conjunction
+// and are left out.
+func (c ifChain) negated() []types.Condition {
+	var result []types.Condition
+	for _, branch := range c {
+		if len(branch) == 1 {
+			negated := branch[0]
+			negated.Negate = !negated.Negate
+			result = append(result, negated)
+		}
+	}
+	return result
+}
+
+// bracedConditions tracks the brace blocks of content, skipping strings
+// and comments, and returns the conditions of the if blocks enclosing
+// each line.
+func bracedConditions(content string) [][]types.Condition {
+	lines := make([][]types.Condition, 1, strings.Count(content, "\n")+2)
+	var stack []block
+	chains := make(map[int]ifChain)
+	lineStart, prevLine := 0, ""
+
+	current := func() []types.Condition {
+		var conds []types.Condition
+		for _, b := range stack {
+			conds = append(conds, b.conditions...)
+		}
+		return conds
+	}
+	lines = append(lines, nil)
+
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; c {
+		case '\n':
+			if text := strings.TrimSpace(content[lineStart:i]); text != "" {
+				prevLine = text
+			}
+			lineStart = i + 1
+			lines = append(lines, current())
+		case '"', '\'', '`':
+			for i++; i < len(content) && content[i] != c; i++ {
+				if content[i] == '\\' {
+					i++
+				} else if content[i] == '\n' && c != '`' {
+					break
+				}
+			}
+		case '/':
+			if strings.HasPrefix(content[i:], "//") {
+				for i+1 < len(content) && content[i+1] != '\n' {
+					i++
+				}
+			} else if strings.HasPrefix(content[i:], "/*") {
+				end := strings.Index(content[i+2:], "*/")
+				if end < 0 {
+					end = len(content) - i - 4
+				}
+				// Keep line numbers in step with the comment's newlines
+				for j := i; j < i+end+4 && j < len(content); j++ {
+					if content[j] == '\n' {
+						lineStart = j + 1
+						lines = append(lines, current())
+					}
+				}
+				i += end + 3
+			}
+		case '{':
+			header := strings.TrimSpace(content[lineStart:i])
+			if header == "" {
+				header = prevLine
+			}
+			depth := len(stack)
+			b := blockFor(header, chains[depth])
+			if b.branch == "if" || b.branch == "" {
+				delete(chains, depth)
+			}
+			stack = append(stack, b)
+		case '}':
+			if len(stack) == 0 {
+				continue
+			}
+			b := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			depth := len(stack)
+			switch b.branch {
+			case "if", "else if":
+				chains[depth] = append(chains[depth], b.guard)
+			default:
+				delete(chains, depth)
+			}
+		}
+	}
+	return append(lines, current())
+}
+
+// blockFor classifies the block opened after header: an if, else if or
+// else branch of the chain, or another block.
+func blockFor(header string, chain ifChain) block {
+	header = strings.TrimSpace(strings.TrimLeft(header, "} \t"))
+	switch {
+	case header == "else":
+		return block{conditions: chain.negated(), branch: "else"}
+	case strings.HasPrefix(header, "else if"):
+		own, _ := guardConditions(strings.TrimPrefix(header, "else if"))
+		return block{conditions: append(chain.negated(), own...), guard: own, branch: "else if"}
+	case header == "if" || strings.HasPrefix(header, "if ") || strings.HasPrefix(header, "if("):
+		own, _ := guardConditions(strings.TrimPrefix(header, "if"))
+		return block{conditions: own, guard: own, branch: "if"}
+	}
+	return block{}
+}
+
+// indentedConditions tracks the indented if blocks of Python content and
+// returns the conditions of the blocks enclosing each line.
+func indentedConditions(content string) [][]types.Condition {
+	source := strings.Split(content, "\n")
+	lines := make([][]types.Condition, len(source)+2)
+
+	type pyBlock struct {
+		indent int
+		block
+	}
+	var stack []pyBlock
+	chains := make(map[int]ifChain)
+
+	for n, text := range source {
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(text) - len(strings.TrimLeft(text, " \t"))
+
+		// Blocks at or deeper than this line have ended
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if top.branch == "if" || top.branch == "else if" {
+				chains[top.indent] = append(chains[top.indent], top.guard)
+			}
+		}
+		isBranch := strings.HasPrefix(trimmed, "elif ") || strings.HasPrefix(trimmed, "else:")
+		if !isBranch {
+			delete(chains, indent)
+		}
+
+		var conds []types.Condition
+		for _, b := range stack {
+			conds = append(conds, b.conditions...)
+		}
+		lines[n+1] = conds
+
+		if !strings.HasSuffix(trimmed, ":") {
+			continue
+		}
+		header := strings.TrimSuffix(trimmed, ":")
+		switch {
+		case strings.HasPrefix(header, "if "):
+			own, _ := guardConditions(strings.TrimPrefix(header, "if "))
+			stack = append(stack, pyBlock{indent, block{conditions: own, guard: own, branch: "if"}})
+		case strings.HasPrefix(header, "elif "):
+			own, _ := guardConditions(strings.TrimPrefix(header, "elif "))
+			stack = append(stack, pyBlock{indent, block{conditions: append(chains[indent].negated(), own...), guard: own, branch: "else if"}})
+		case header == "else":
+			stack = append(stack, pyBlock{indent, block{conditions: chains[indent].negated(), branch: "else"}})
+		default:
+			stack = append(stack, pyBlock{indent, block{}})
+		}
+	}
+	return lines
+}
+
+// guardConditions parses the environment checks of an if condition. It
+// reports false for disjunctions, which cannot be represented.
+func guardConditions(expr string) ([]types.Condition, bool) {
+	if strings.Contains(expr, "||") || strings.Contains(expr, " or ") {
+		return nil, false
+	}
+
+	var conds []types.Condition
+	for _, m := range envRead.FindAllStringSubmatchIndex(expr, -1) {
+		name := ""
+		for g := 2; g < len(m); g += 2 {
+			if m[g] >= 0 {
+				name = expr[m[g]:m[g+1]]
+				break
+			}
+		}
+
+		prefix := strings.TrimRight(expr[:m[0]], " \t(")
+		negate := strings.HasSuffix(prefix, "!") || strings.HasSuffix(prefix, "not")
+		cond := types.Condition{Env: name}
+
+		if cmp := envCompare.FindStringSubmatch(expr[m[1]:]); cmp != nil {
+			value := cmp[2] + cmp[3]
+			if strings.HasPrefix(cmp[1], "!") {
+				negate = !negate
+			}
+			if value == "" {
+				// Comparing with "" checks whether the variable is unset
+				negate = !negate
+			}
+			cond.Value = value
+		}
+		cond.Negate = negate
+		conds = append(conds, cond)
+	}
+	return conds, true
+}
+
+// SelectVariant splits routes into those whose conditions hold for a
+// variant built with tags and run with env, and those excluded. GOOS,
+// GOARCH and Go version tags of the host are set as well.
+func SelectVariant(routes []types.Route, tags []string, env map[string]string) (selected, excluded []types.Route) {
+	tagSet := map[string]bool{runtime.GOOS: true, runtime.GOARCH: true, "gc": true}
+	if runtime.GOOS != "windows" && runtime.GOOS != "plan9" {
+		tagSet["unix"] = true
+	}
+	for _, tag := range tags {
+		tagSet[tag] = true
+	}
+	ok := func(tag string) bool {
+		return tagSet[tag] || strings.HasPrefix(tag, "go1.")
+	}
+
+	for _, route := range routes {
+		if conditionsHold(route.Conditions, ok, env) {
+			selected = append(selected, route)
+		} else {
+			excluded = append(excluded, route)
+		}
+	}
+	return selected, excluded
+}
+
+func conditionsHold(conds []types.Condition, tag func(string) bool, env map[string]string) bool {
+	for _, c := range conds {
+		if c.Build != "" {
+			expr, err := constraint.Parse("//go:build " + c.Build)
+			if err == nil && !expr.Eval(tag) {
+				return false
+			}
+			continue
+		}
+		value := env[c.Env]
+		holds := value != ""
+		if c.Value != "" {
+			holds = value == c.Value
+		}
+		if holds == c.Negate {
+			return false
+		}
+	}
+	return true
+}
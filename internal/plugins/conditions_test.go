@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkConditions_GoEnvGuards(t *testing.T) {
+	code := `//go:build !nobeta
+
+package main
+
+func routes(r chi.Router) {
+	r.Get("/users", listUsers)
+	if os.Getenv("ENABLE_BETA") == "true" {
+		r.Get("/beta", beta) // "{" in a comment
+	} else if os.Getenv("LEGACY") != "" {
+		r.Get("/legacy", legacy)
+	} else {
+		r.Get("/stable", stable)
+	}
+	r.Get("/health", health)
+}
+`
+	files := []scanner.SourceFile{{Path: "main.go", Language: "go", Content: []byte(code)}}
+	routes := []types.Route{
+		{Path: "/users", SourceFile: "main.go", SourceLine: 6},
+		{Path: "/beta", SourceFile: "main.go", SourceLine: 8},
+		{Path: "/legacy", SourceFile: "main.go", SourceLine: 10},
+		{Path: "/stable", SourceFile: "main.go", SourceLine: 12},
+		{Path: "/health", SourceFile: "main.go", SourceLine: 14},
+	}
+
+	MarkConditions(routes, files)
+
+	build := types.Condition{Build: "!nobeta"}
+	beta := types.Condition{Env: "ENABLE_BETA", Value: "true"}
+	legacy := types.Condition{Env: "LEGACY"}
+	assert.Equal(t, []types.Condition{build}, routes[0].Conditions)
+	assert.Equal(t, []types.Condition{build, beta}, routes[1].Conditions)
+	assert.Equal(t, []types.Condition{build, {Env: "ENABLE_BETA", Value: "true", Negate: true}, legacy}, routes[2].Conditions)
+	assert.Equal(t, []types.Condition{build, {Env: "ENABLE_BETA", Value: "true", Negate: true}, {Env: "LEGACY", Negate: true}}, routes[3].Conditions)
+	assert.Equal(t, []types.Condition{build}, routes[4].Conditions)
+}
+
+func TestMarkConditions_NodeEnv(t *testing.T) {
+	code := `const app = express()
+if (process.env.NODE_ENV !== 'production')
+{
+  app.get('/debug', debug)
+}
+if (process.env.A === '1' || process.env.B) {
+  app.get('/either', either)
+}
+app.get('/users', listUsers)
+`
+	files := []scanner.SourceFile{{Path: "app.js", Language: "javascript", Content: []byte(code)}}
+	routes := []types.Route{
+		{Path: "/debug", SourceFile: "app.js", SourceLine: 4},
+		{Path: "/either", SourceFile: "app.js", SourceLine: 7},
+		{Path: "/users", SourceFile: "app.js", SourceLine: 9},
+	}
+
+	MarkConditions(routes, files)
+
+	assert.Equal(t, []types.Condition{{Env: "NODE_ENV", Value: "production", Negate: true}}, routes[0].Conditions)
+	assert.Nil(t, routes[1].Conditions)
+	assert.Nil(t, routes[2].Conditions)
+}
+
+func TestMarkConditions_Python(t *testing.T) {
+	code := `app = Flask(__name__)
+
+if os.environ.get("ENABLE_BETA"):
+    @app.get("/beta")
+    def beta():
+        pass
+else:
+    @app.get("/stable")
+    def stable():
+        pass
+
+@app.get("/users")
+def users():
+    pass
+`
+	files := []scanner.SourceFile{{Path: "app.py", Language: "python", Content: []byte(code)}}
+	routes := []types.Route{
+		{Path: "/beta", SourceFile: "app.py", SourceLine: 4},
+		{Path: "/stable", SourceFile: "app.py", SourceLine: 8},
+		{Path: "/users", SourceFile: "app.py", SourceLine: 12},
+	}
+
+	MarkConditions(routes, files)
+
+	assert.Equal(t, []types.Condition{{Env: "ENABLE_BETA"}}, routes[0].Conditions)
+	assert.Equal(t, []types.Condition{{Env: "ENABLE_BETA", Negate: true}}, routes[1].Conditions)
+	assert.Nil(t, routes[2].Conditions)
+}
+
+func TestSelectVariant(t *testing.T) {
+	routes := []types.Route{
+		{Path: "/users"},
+		{Path: "/beta", Conditions: []types.Condition{{Env: "ENABLE_BETA", Value: "true"}}},
+		{Path: "/stable", Conditions: []types.Condition{{Env: "ENABLE_BETA", Value: "true", Negate: true}}},
+		{Path: "/pro", Conditions: []types.Condition{{Build: "pro && !oss"}}},
+		{Path: "/invalid", Conditions: []types.Condition{{Build: "(("}}},
+	}
+	paths := func(routes []types.Route) []string {
+		var result []string
+		for _, r := range routes {
+			result = append(result, r.Path)
+		}
+		return result
+	}
+
+	stable, excluded := SelectVariant(routes, nil, nil)
+	assert.Equal(t, []string{"/users", "/stable", "/invalid"}, paths(stable))
+	assert.Equal(t, []string{"/beta", "/pro"}, paths(excluded))
+
+	beta, excluded := SelectVariant(routes, []string{"pro"}, map[string]string{"ENABLE_BETA": "true"})
+	assert.Equal(t, []string{"/users", "/beta", "/pro", "/invalid"}, paths(beta))
+	assert.Equal(t, []string{"/stable"}, paths(excluded))
+}
+
+func TestCondition_String(t *testing.T) {
+	assert.Equal(t, "build:pro && !oss", types.Condition{Build: "pro && !oss"}.String())
+	assert.Equal(t, "!env:NODE_ENV=production", types.Condition{Env: "NODE_ENV", Value: "production", Negate: true}.String())
+	assert.Equal(t, "env:ENABLE_BETA", types.Condition{Env: "ENABLE_BETA"}.String())
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"context"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ContextExtractor is an optional interface for plugins that check for
+// cancellation while they extract, for example between files.
+type ContextExtractor interface {
+	// ExtractRoutesContext is like ExtractRoutes, but returns ctx's error
+	// once ctx is done.
+	ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error)
+
+	// ExtractSchemasContext is like ExtractSchemas, but returns ctx's error
+	// once ctx is done.
+	ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error)
+}
+
+// ExtractRoutes extracts routes with plugin from the files containing its
+// route markers, returning ctx's error as soon as ctx is done. Plugins that
+// do not implement ContextExtractor keep running in the background until
+// they finish, but their result is discarded.
+func ExtractRoutes(ctx context.Context, plugin FrameworkPlugin, files []scanner.SourceFile) ([]types.Route, error) {
+	files = RouteCandidates(plugin, files)
+	if ce, ok := plugin.(ContextExtractor); ok {
+		return ce.ExtractRoutesContext(ctx, files)
+	}
+	return runContext(ctx, func() ([]types.Route, error) {
+		return plugin.ExtractRoutes(files)
+	})
+}
+
+// ExtractSchemas extracts schemas with plugin, returning ctx's error as soon
+// as ctx is done. See ExtractRoutes for plugins without context support.
+func ExtractSchemas(ctx context.Context, plugin FrameworkPlugin, files []scanner.SourceFile) ([]types.Schema, error) {
+	if ce, ok := plugin.(ContextExtractor); ok {
+		return ce.ExtractSchemasContext(ctx, files)
+	}
+	return runContext(ctx, func() ([]types.Schema, error) {
+		return plugin.ExtractSchemas(files)
+	})
+}
+
+// runContext runs fn, returning early with ctx's error if ctx is done first.
+func runContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+	if ctx.Done() == nil {
+		return fn()
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := fn()
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
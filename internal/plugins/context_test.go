@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// blockingPlugin never finishes extracting routes until released.
+type blockingPlugin struct {
+	mockPlugin
+	release chan struct{}
+}
+
+func (b *blockingPlugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	<-b.release
+	return nil, nil
+}
+
+func TestExtractRoutes_Completes(t *testing.T) {
+	plugin := &mockPlugin{name: "test", routes: []types.Route{{Method: "GET", Path: "/users"}}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	routes, err := ExtractRoutes(ctx, plugin, nil)
+	require.NoError(t, err)
+	assert.Len(t, routes, 1)
+}
+
+func TestExtractRoutes_Cancelled(t *testing.T) {
+	plugin := &blockingPlugin{mockPlugin: mockPlugin{name: "slow"}, release: make(chan struct{})}
+	defer close(plugin.release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ExtractRoutes(ctx, plugin, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestExtractRoutes_CancelledWhileRunning(t *testing.T) {
+	plugin := &blockingPlugin{mockPlugin: mockPlugin{name: "slow"}, release: make(chan struct{})}
+	defer close(plugin.release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go cancel()
+
+	_, err := ExtractRoutes(ctx, plugin, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
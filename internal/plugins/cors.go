@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// CORSExtension documents the CORS policy of an operation, or of the whole
+// API when set on the document.
+const CORSExtension = "x-cors"
+
+// corsPolicy is a CORS policy configured in the source code.
+type corsPolicy struct {
+	types.CORS
+
+	// paths restricts the policy to matching route paths, if set
+	paths *regexp.Regexp
+
+	// source names the middleware or configuration the policy came from
+	source string
+
+	// file and line locate the configuration
+	file string
+	line int
+}
+
+var (
+	// corsCalls match CORS middleware calls, ending where their options
+	// begin
+	corsCalls = []hostPattern{
+		{"cors()", regexp.MustCompile(`\bcors\(\s*`)},
+		{"@fastify/cors", regexp.MustCompile(`\.register\(\s*(?:cors|fastifyCors|require\(\s*['"]@fastify/cors['"]\s*\))\s*,?\s*`)},
+		{"enableCors", regexp.MustCompile(`\.enableCors\(\s*`)},
+		{"NestFactory cors", regexp.MustCompile(`NestFactory\.create\b[^;]*?\bcors:\s*`)},
+		{"Flask-CORS", regexp.MustCompile(`\bCORS\(`)},
+		{"Go CORS middleware", regexp.MustCompile(`\b(?:cors\.(?:Options|Config)|middleware\.CORSConfig)\s*`)},
+		{"Go CORS middleware", regexp.MustCompile(`\b(?:cors\.(?:Default|AllowAll)|middleware\.CORS)\(\)`)},
+	}
+
+	// djangoCORS matches the django-cors-headers origin settings
+	djangoCORS = regexp.MustCompile(`(?m)^CORS_(?:ALLOWED_ORIGINS|ALLOWED_ORIGIN_REGEXES|ALLOW_ALL_ORIGINS|ORIGIN_WHITELIST|ORIGIN_ALLOW_ALL)\s*=`)
+
+	// corsPrefix captures the path prefix of app.use('/api', cors())
+	corsPrefix = regexp.MustCompile(`\.use\(\s*['"](/[^'"]*)['"]\s*,\s*$`)
+
+	// Option names of the supported CORS middleware, by policy field
+	corsOrigins     = corsKey("origin", "origins", "AllowedOrigins", "AllowOrigins", "allowed_origins", "CORS_ALLOWED_ORIGINS", "CORS_ORIGIN_WHITELIST")
+	corsAllOrigins  = corsKey("CORS_ALLOW_ALL_ORIGINS", "CORS_ORIGIN_ALLOW_ALL")
+	corsMethods     = corsKey("methods", "AllowedMethods", "AllowMethods", "allowed_methods", "CORS_ALLOW_METHODS")
+	corsHeaders     = corsKey("allowedHeaders", "allow_headers", "AllowedHeaders", "AllowHeaders", "allowed_headers", "CORS_ALLOW_HEADERS")
+	corsExpose      = corsKey("exposedHeaders", "expose_headers", "ExposedHeaders", "ExposeHeaders", "exposed_headers", "CORS_EXPOSE_HEADERS")
+	corsCredentials = corsKey("credentials", "supports_credentials", "AllowCredentials", "CORS_ALLOW_CREDENTIALS")
+	corsMaxAge      = corsKey("maxAge", "max_age", "MaxAge", "CORS_PREFLIGHT_MAX_AGE")
+	corsPaths       = corsKey("paths", "CORS_URLS_REGEX")
+
+	// quotedString matches a string literal
+	quotedString = regexp.MustCompile("'([^']*)'|\"([^\"]*)\"|`([^`]*)`")
+
+	// methodConstant matches Go method constants such as http.MethodGet
+	methodConstant = regexp.MustCompile(`\bMethod(Get|Head|Post|Put|Patch|Delete|Options)\b`)
+
+	// durationValue captures a number of seconds, or a Go duration
+	durationValue = regexp.MustCompile(`^(\d+)(?:\s*\*\s*time\.(Hour|Minute|Second))?`)
+)
+
+// corsKey returns a regexp matching an option named any of names, up to
+// its value.
+func corsKey(names ...string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)(?:^|[\s{,(])['"]?(?:` + strings.Join(names, "|") + `)['"]?\s*(?::|=>|=)\s*`)
+}
+
+// MarkCORS documents the CORS policies configured in files, through cors(),
+// @fastify/cors, NestJS enableCors, Flask-CORS, Go CORS middleware, Laravel
+// config/cors.php and django-cors-headers settings, as the x-cors extension
+// of the routes they cover. A policy on a route definition covers that
+// route, one in a file defining routes covers the file's routes, and one
+// elsewhere covers every route; path prefixes, Laravel paths and
+// CORS_URLS_REGEX narrow it further. The most specific policy wins.
+func MarkCORS(routes []types.Route, files []scanner.SourceFile) {
+	policies := detectCORS(files)
+	if len(policies) == 0 {
+		return
+	}
+	routeLines := make(map[string]map[int]bool)
+	for _, route := range routes {
+		if routeLines[route.SourceFile] == nil {
+			routeLines[route.SourceFile] = make(map[int]bool)
+		}
+		routeLines[route.SourceFile][route.SourceLine] = true
+	}
+
+	for i := range routes {
+		route := &routes[i]
+		best, bestScore := -1, -1
+		for j, p := range policies {
+			score := 0
+			switch {
+			case routeLines[p.file][p.line]:
+				if p.file != route.SourceFile || p.line != route.SourceLine {
+					continue
+				}
+				score = 4
+			case len(routeLines[p.file]) > 0:
+				if p.file != route.SourceFile {
+					continue
+				}
+				score = 2
+			}
+			if p.paths != nil {
+				if !p.paths.MatchString(route.Path) {
+					continue
+				}
+				score++
+			}
+			if score > bestScore {
+				best, bestScore = j, score
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions[CORSExtension] = policies[best].CORS
+	}
+}
+
+// detectCORS returns the CORS policies configured in files.
+func detectCORS(files []scanner.SourceFile) []corsPolicy {
+	var policies []corsPolicy
+	for _, f := range files {
+		content := string(f.Content)
+		if strings.HasSuffix(filepath.ToSlash(f.Path), "config/cors.php") {
+			if p, ok := parseCORS(content, false); ok {
+				p.source, p.file, p.line = "config/cors.php", f.Path, 1
+				policies = append(policies, p)
+			}
+			continue
+		}
+		if loc := djangoCORS.FindStringIndex(content); loc != nil {
+			if p, ok := parseCORS(content, false); ok {
+				p.source, p.file, p.line = "django-cors-headers", f.Path, lineAt(content, loc[0])
+				policies = append(policies, p)
+			}
+			continue
+		}
+
+		for _, call := range corsCalls {
+			for _, loc := range call.re.FindAllStringIndex(content, -1) {
+				options, ok := corsOptions(content, loc[1], call.source)
+				if !ok {
+					continue
+				}
+				p, ok := parseCORS(options, true)
+				if !ok {
+					continue
+				}
+				p.source, p.file, p.line = call.source, f.Path, lineAt(content, loc[0])
+				lineStart := strings.LastIndex(content[:loc[0]], "\n") + 1
+				if m := corsPrefix.FindStringSubmatch(content[lineStart:loc[0]]); m != nil {
+					p.paths = regexp.MustCompile(`^` + regexp.QuoteMeta(strings.TrimSuffix(m[1], "/")) + `(?:/|$)`)
+				}
+				policies = append(policies, p)
+			}
+		}
+	}
+	return policies
+}
+
+// corsOptions returns the options of a CORS middleware call whose arguments
+// start at pos: an object literal, possibly assigned to a variable passed
+// by name, or Flask-CORS keyword arguments. ok is false when the options
+// cannot be read from the source.
+func corsOptions(content string, pos int, source string) (string, bool) {
+	rest := content[pos:]
+	switch {
+	case source == "Flask-CORS":
+		return enclosed("("+rest, '(', ')'), true
+	case strings.HasSuffix(content[:pos], "()"):
+		return "", true
+	case strings.HasPrefix(rest, "{"):
+		return enclosed(rest, '{', '}'), true
+	case strings.HasPrefix(source, "Go"):
+		return "", false
+	case rest == "" || rest[0] == ')':
+		return "", true
+	case strings.HasPrefix(rest, "true"):
+		return "", true
+	}
+	name := identifierPrefix(rest)
+	if name == "" {
+		return "", false
+	}
+	decl := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\s*(?::\s*[\w.<>]+\s*)?=\s*\{`).FindStringIndex(content)
+	if decl == nil {
+		return "", false
+	}
+	return enclosed(content[decl[1]-1:], '{', '}'), true
+}
+
+// parseCORS reads a policy from options. Middleware allowing any origin by
+// default is wildcard; ok is false when the options disable CORS, or name
+// no origins for other middleware. Origins computed at runtime are left
+// out.
+func parseCORS(options string, wildcard bool) (corsPolicy, bool) {
+	var p corsPolicy
+	raw, named := corsValue(options, corsOrigins)
+	switch {
+	case raw == "false" || raw == "False":
+		return p, false
+	case named:
+		p.AllowOrigins = corsStrings(raw)
+	}
+	if raw, ok := corsValue(options, corsAllOrigins); ok && raw == "True" {
+		p.AllowOrigins, named = []string{"*"}, true
+	}
+	if !named {
+		if !wildcard {
+			return p, false
+		}
+		p.AllowOrigins = []string{"*"}
+	}
+
+	if raw, ok := corsValue(options, corsMethods); ok {
+		for _, m := range methodConstant.FindAllStringSubmatch(raw, -1) {
+			p.AllowMethods = append(p.AllowMethods, strings.ToUpper(m[1]))
+		}
+		for _, m := range corsStrings(raw) {
+			p.AllowMethods = append(p.AllowMethods, strings.ToUpper(m))
+		}
+	}
+	if raw, ok := corsValue(options, corsHeaders); ok {
+		p.AllowHeaders = corsStrings(raw)
+	}
+	if raw, ok := corsValue(options, corsExpose); ok {
+		p.ExposeHeaders = corsStrings(raw)
+	}
+	if raw, ok := corsValue(options, corsCredentials); ok {
+		p.AllowCredentials = raw == "true" || raw == "True"
+	}
+	if raw, ok := corsValue(options, corsMaxAge); ok {
+		if m := durationValue.FindStringSubmatch(raw); m != nil {
+			seconds, _ := strconv.Atoi(m[1])
+			switch m[2] {
+			case "Hour":
+				seconds *= 3600
+			case "Minute":
+				seconds *= 60
+			}
+			p.MaxAge = seconds
+		}
+	}
+
+	if raw, ok := corsValue(options, corsPaths); ok {
+		if strings.HasPrefix(raw, "[") {
+			// Laravel path globs, relative to the root
+			var globs []string
+			for _, glob := range corsStrings(raw) {
+				glob = regexp.QuoteMeta("/" + strings.TrimPrefix(glob, "/"))
+				globs = append(globs, strings.ReplaceAll(glob, `\*`, ".*"))
+			}
+			if len(globs) > 0 {
+				p.paths = regexp.MustCompile(`^(?:` + strings.Join(globs, "|") + `)$`)
+			}
+		} else if m := quotedString.FindStringSubmatch(raw); m != nil {
+			p.paths, _ = regexp.Compile(m[1] + m[2] + m[3])
+		}
+	}
+	return p, true
+}
+
+// corsValue returns the raw value of the option key matches in options.
+func corsValue(options string, key *regexp.Regexp) (string, bool) {
+	loc := key.FindStringIndex(options)
+	if loc == nil {
+		return "", false
+	}
+	rest := options[loc[1]:]
+	depth := 0
+	var quote byte
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '[' || c == '{' || c == '(':
+			depth++
+		case (c == ']' || c == '}' || c == ')') && depth > 0:
+			depth--
+		case depth == 0 && strings.IndexByte(",\n;})]", c) >= 0:
+			return strings.TrimSpace(rest[:i]), true
+		}
+	}
+	return strings.TrimSpace(rest), true
+}
+
+// corsStrings returns the strings of a raw option value: the literals of a
+// list, or the comma-separated items of a single string. true allows any
+// origin.
+func corsStrings(raw string) []string {
+	if raw == "true" {
+		return []string{"*"}
+	}
+	var values []string
+	for _, m := range quotedString.FindAllStringSubmatch(raw, -1) {
+		for _, v := range strings.Split(m[1]+m[2]+m[3], ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// enclosed returns the text inside the brackets s starts with.
+func enclosed(s string, open, close byte) string {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return s[1:i]
+			}
+		}
+	}
+	return s[1:]
+}
+
+// identifierPrefix returns the identifier s starts with.
+func identifierPrefix(s string) string {
+	end := 0
+	for end < len(s) && (s[end] == '_' || s[end] == '$' || s[end] >= 'a' && s[end] <= 'z' || s[end] >= 'A' && s[end] <= 'Z' || end > 0 && s[end] >= '0' && s[end] <= '9') {
+		end++
+	}
+	return s[:end]
+}
+
+// lineAt returns the line number of offset in content.
+func lineAt(content string, offset int) int {
+	return strings.Count(content[:offset], "\n") + 1
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDetectCORS(t *testing.T) {
+	tests := []struct {
+		name, path, code string
+		want             types.CORS
+	}{
+		{"express", "app.js", `app.use(cors({ origin: ['https://app.example.com'], methods: 'GET,POST', credentials: true, maxAge: 600 }))`,
+			types.CORS{AllowOrigins: []string{"https://app.example.com"}, AllowMethods: []string{"GET", "POST"}, AllowCredentials: true, MaxAge: 600}},
+		{"express default", "app.js", `app.use(cors())`, types.CORS{AllowOrigins: []string{"*"}}},
+		{"express variable", "app.js", "const corsOptions = {\n  origin: 'https://example.com',\n  allowedHeaders: ['Content-Type', 'Authorization'],\n}\napp.use(cors(corsOptions))",
+			types.CORS{AllowOrigins: []string{"https://example.com"}, AllowHeaders: []string{"Content-Type", "Authorization"}}},
+		{"fastify", "server.js", `fastify.register(require('@fastify/cors'), { origin: true, exposedHeaders: ['X-Total-Count'] })`,
+			types.CORS{AllowOrigins: []string{"*"}, ExposeHeaders: []string{"X-Total-Count"}}},
+		{"nestjs", "main.ts", `app.enableCors({ origin: ['https://a.example.com', 'https://b.example.com'] });`,
+			types.CORS{AllowOrigins: []string{"https://a.example.com", "https://b.example.com"}}},
+		{"nestjs factory", "main.ts", `const app = await NestFactory.create(AppModule, { cors: true });`, types.CORS{AllowOrigins: []string{"*"}}},
+		{"flask", "app.py", `CORS(app, origins=["https://example.com"], supports_credentials=True)`,
+			types.CORS{AllowOrigins: []string{"https://example.com"}, AllowCredentials: true}},
+		{"rs/cors", "main.go", "c := cors.New(cors.Options{\n\tAllowedOrigins: []string{\"https://example.com\"},\n\tAllowedMethods: []string{http.MethodGet, http.MethodPost},\n})",
+			types.CORS{AllowOrigins: []string{"https://example.com"}, AllowMethods: []string{"GET", "POST"}}},
+		{"gin", "main.go", "r.Use(cors.New(cors.Config{\n\tAllowOrigins: []string{\"https://example.com\"},\n\tMaxAge: 12 * time.Hour,\n}))",
+			types.CORS{AllowOrigins: []string{"https://example.com"}, MaxAge: 43200}},
+		{"laravel", "config/cors.php", "<?php\nreturn [\n    'paths' => ['api/*'],\n    'allowed_methods' => ['*'],\n    'allowed_origins' => ['https://example.com'],\n    'allowed_origins_patterns' => [],\n    'allowed_headers' => ['*'],\n    'supports_credentials' => false,\n];",
+			types.CORS{AllowOrigins: []string{"https://example.com"}, AllowMethods: []string{"*"}, AllowHeaders: []string{"*"}}},
+		{"django", "settings.py", "CORS_ALLOWED_ORIGINS = [\n    \"https://example.com\",\n    \"https://www.example.com\",\n]\nCORS_ALLOW_CREDENTIALS = True\n",
+			types.CORS{AllowOrigins: []string{"https://example.com", "https://www.example.com"}, AllowCredentials: true}},
+		{"django all", "settings.py", "CORS_ALLOW_ALL_ORIGINS = True\n", types.CORS{AllowOrigins: []string{"*"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := detectCORS([]scanner.SourceFile{{Path: tt.path, Content: []byte(tt.code)}})
+			require.Len(t, policies, 1)
+			assert.Equal(t, tt.want, policies[0].CORS)
+		})
+	}
+}
+
+func TestDetectCORS_Disabled(t *testing.T) {
+	policies := detectCORS([]scanner.SourceFile{{Path: "app.js", Content: []byte(`app.use(cors({ origin: false }))`)}})
+	assert.Empty(t, policies)
+}
+
+func TestMarkCORS_Scopes(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "app.js", Content: []byte("app.use(cors())\napp.use('/partners', cors({ origin: 'https://partner.example.com' }))\n")},
+		{Path: "routes/public.js", Content: []byte("router.get('/status', cors({ origin: 'https://status.example.com' }), status)\nrouter.get('/health', health)\n")},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: "routes/users.js", SourceLine: 1},
+		{Method: "GET", Path: "/partners/orders", SourceFile: "routes/partners.js", SourceLine: 1},
+		{Method: "GET", Path: "/status", SourceFile: "routes/public.js", SourceLine: 1},
+		{Method: "GET", Path: "/health", SourceFile: "routes/public.js", SourceLine: 2},
+	}
+
+	MarkCORS(routes, files)
+
+	assert.Equal(t, types.CORS{AllowOrigins: []string{"*"}}, routes[0].Extensions[CORSExtension])
+	assert.Equal(t, types.CORS{AllowOrigins: []string{"https://partner.example.com"}}, routes[1].Extensions[CORSExtension])
+	assert.Equal(t, types.CORS{AllowOrigins: []string{"https://status.example.com"}}, routes[2].Extensions[CORSExtension])
+	assert.Equal(t, types.CORS{AllowOrigins: []string{"*"}}, routes[3].Extensions[CORSExtension])
+}
+
+func TestMarkCORS_LaravelPaths(t *testing.T) {
+	files := []scanner.SourceFile{{Path: "config/cors.php", Content: []byte("<?php\nreturn [\n    'paths' => ['api/*'],\n    'allowed_origins' => ['*'],\n];")}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/api/users", SourceFile: "routes/api.php", SourceLine: 3},
+		{Method: "GET", Path: "/dashboard", SourceFile: "routes/web.php", SourceLine: 3},
+	}
+
+	MarkCORS(routes, files)
+
+	assert.Contains(t, routes[0].Extensions, CORSExtension)
+	assert.NotContains(t, routes[1].Extensions, CORSExtension)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -138,10 +140,10 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 			continue
 		}
 
-		fileRoutes := p.extractCrowRoutes(string(file.Content), file.Path)
+		fileRoutes := p.extractCrowRoutes(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, fileRoutes...)
 
-		bpRoutes := p.extractCrowBPRoutes(string(file.Content), file.Path)
+		bpRoutes := p.extractCrowBPRoutes(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, bpRoutes...)
 	}
 
@@ -390,6 +392,11 @@ func extractPathParams(openAPIPath, originalPath string) []types.Parameter {
 
 // crowTypeToOpenAPI converts a Crow type to an OpenAPI type.
 func crowTypeToOpenAPI(crowType string) (openAPIType string, format string) {
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(crowType); ok {
+		return t, f
+	}
+
 	switch crowType {
 	case "int":
 		return "integer", ""
@@ -0,0 +1,369 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package dartfrog provides a plugin for extracting routes from Dart Frog applications.
+package dartfrog
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for Dart Frog.
+type Plugin struct {
+	dartParser *parser.DartParser
+}
+
+// New creates a new Dart Frog plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		dartParser: parser.NewDartParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "dartfrog"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".dart"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "dartfrog",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Dart Frog filesystem routes",
+		SupportedFrameworks: []string{
+			"Dart Frog",
+		},
+	}
+}
+
+// Detect checks if Dart Frog is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	pubspecPath := filepath.Join(projectRoot, "pubspec.yaml")
+	if found, _ := p.checkFileForDependency(pubspecPath, "dart_frog"); found {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Regex patterns for Dart Frog extraction
+var (
+	// Matches HTTP methods handled by a route: case HttpMethod.post:
+	httpMethodRegex = regexp.MustCompile(`\bHttpMethod\.(get|post|put|delete|patch|head|options)\b`)
+
+	// Matches a model built from the request body: User.fromJson(await context.request.json())
+	requestModelRegex = regexp.MustCompile(`\b([A-Z]\w*)\.fromJson\s*\(\s*(?:await\s+)?\(?\s*(?:await\s+)?(?:context\.)?request\.json\(\)`)
+
+	// Matches a request body read: context.request.json() or .body()
+	requestBodyRegex = regexp.MustCompile(`\brequest\.(?:json|body|formData)\(\)`)
+
+	// Matches dynamic segments: [id] and catch-all segments: [...path]
+	dynamicSegmentRegex = regexp.MustCompile(`^\[(?:\.\.\.)?(\w+)\]$`)
+
+	// Matches path parameter in brace format
+	braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+)
+
+// ExtractRoutes extracts routes from files under the routes directory,
+// whose paths map to URLs and whose onRequest handlers serve them.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if file.Language != "dart" {
+			continue
+		}
+
+		path, ok := routePath(file.Path)
+		if !ok {
+			continue
+		}
+
+		pf := p.dartParser.Parse(file.Path, file.Content)
+		var onRequest *parser.DartFunction
+		for i := range pf.Functions {
+			if pf.Functions[i].Name == "onRequest" {
+				onRequest = &pf.Functions[i]
+				break
+			}
+		}
+		if onRequest == nil {
+			continue
+		}
+
+		fileRoutes := p.extractFileRoutes(pf, onRequest, path)
+		if plugins.IsCatchAll(filepath.Base(file.Path)) {
+			for i := range fileRoutes {
+				plugins.MarkWildcard(&fileRoutes[i])
+			}
+		}
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// extractFileRoutes creates a route for each HTTP method an onRequest
+// handler dispatches on, or a GET route when it handles every request.
+func (p *Plugin) extractFileRoutes(pf *parser.ParsedDartFile, onRequest *parser.DartFunction, path string) []types.Route {
+	var methods []string
+	seen := make(map[string]bool)
+	for _, m := range httpMethodRegex.FindAllStringSubmatch(pf.Content, -1) {
+		method := strings.ToUpper(m[1])
+		if !seen[method] {
+			seen[method] = true
+			methods = append(methods, method)
+		}
+	}
+	if len(methods) == 0 {
+		methods = []string{"GET"}
+	}
+
+	var requestBody *types.RequestBody
+	if m := requestModelRegex.FindStringSubmatch(pf.Content); m != nil {
+		requestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/" + m[1]}},
+			},
+		}
+	} else if requestBodyRegex.MatchString(pf.Content) {
+		requestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: &types.Schema{Type: "object"}},
+			},
+		}
+	}
+
+	var routes []types.Route
+	for _, method := range methods {
+		route := types.Route{
+			Method:      method,
+			Path:        path,
+			Handler:     onRequest.Name,
+			OperationID: generateOperationID(method, path, ""),
+			Tags:        inferTags(path),
+			Parameters:  extractPathParams(path),
+			SourceFile:  pf.Path,
+			SourceLine:  onRequest.Line,
+		}
+		if method == "POST" || method == "PUT" || method == "PATCH" {
+			route.RequestBody = requestBody
+		}
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// routePath converts a file under routes/ to its URL path. Middleware and
+// other files prefixed with an underscore do not serve routes.
+func routePath(filePath string) (string, bool) {
+	filePath = filepath.ToSlash(filePath)
+	idx := strings.LastIndex("/"+filePath, "/routes/")
+	if idx == -1 {
+		return "", false
+	}
+	rel := strings.TrimSuffix(filePath[idx+len("routes/"):], ".dart")
+
+	var segments []string
+	for _, segment := range strings.Split(rel, "/") {
+		if strings.HasPrefix(segment, "_") {
+			return "", false
+		}
+		if m := dynamicSegmentRegex.FindStringSubmatch(segment); m != nil {
+			segment = "{" + m[1] + "}"
+		}
+		segments = append(segments, segment)
+	}
+	if segments[len(segments)-1] == "index" {
+		segments = segments[:len(segments)-1]
+	}
+
+	return "/" + strings.Join(segments, "/"), true
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.ReplaceAll(cleanPath, "_", " ")
+	cleanPath = strings.ReplaceAll(cleanPath, "-", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions from JSON-serializable Dart
+// classes: json_serializable and freezed models, and classes declaring
+// fromJson or toJson.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if file.Language != "dart" {
+			continue
+		}
+
+		pf := p.dartParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			if !isModel(class) {
+				continue
+			}
+			schemas = append(schemas, *classToSchema(class))
+		}
+	}
+
+	return schemas, nil
+}
+
+// isModel reports whether a class is serialized to JSON.
+func isModel(class parser.DartClass) bool {
+	return class.HasJSONMethods ||
+		class.HasAnnotation("JsonSerializable") ||
+		class.HasAnnotation("freezed") ||
+		class.HasAnnotation("Freezed")
+}
+
+// classToSchema converts a Dart class to an OpenAPI schema.
+func classToSchema(class parser.DartClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, field := range class.Fields {
+		name := field.Name
+		if field.JSONKey != "" {
+			name = field.JSONKey
+		}
+
+		openAPIType, format := parser.DartTypeToOpenAPI(field.Type)
+		propSchema := &types.Schema{
+			Type:   openAPIType,
+			Format: format,
+		}
+		if field.IsOptional {
+			propSchema.Nullable = true
+		} else {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = propSchema
+	}
+
+	return schema
+}
+
+// Register registers the Dart Frog plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
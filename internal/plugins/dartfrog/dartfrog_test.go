@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package dartfrog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const frogIndexCode = `
+import 'package:dart_frog/dart_frog.dart';
+
+Response onRequest(RequestContext context) {
+  return Response(body: 'Welcome to Dart Frog!');
+}
+`
+
+const frogUsersCode = `
+import 'dart:io';
+
+import 'package:dart_frog/dart_frog.dart';
+
+import '../../models/user.dart';
+
+Future<Response> onRequest(RequestContext context) async {
+  return switch (context.request.method) {
+    HttpMethod.get => _list(context),
+    HttpMethod.post => _create(context),
+    _ => Future.value(Response(statusCode: HttpStatus.methodNotAllowed)),
+  };
+}
+
+Future<Response> _list(RequestContext context) async {
+  return Response.json(body: []);
+}
+
+Future<Response> _create(RequestContext context) async {
+  final user = User.fromJson(await context.request.json() as Map<String, dynamic>);
+  return Response.json(body: user.toJson(), statusCode: HttpStatus.created);
+}
+`
+
+const frogUserCode = `
+import 'package:dart_frog/dart_frog.dart';
+
+Future<Response> onRequest(RequestContext context, String id) async {
+  switch (context.request.method) {
+    case HttpMethod.get:
+      return Response.json(body: {'id': id});
+    case HttpMethod.delete:
+      return Response(statusCode: 204);
+    default:
+      return Response(statusCode: 405);
+  }
+}
+`
+
+const frogMiddlewareCode = `
+import 'package:dart_frog/dart_frog.dart';
+
+Handler middleware(Handler handler) {
+  return handler.use(requestLogger());
+}
+`
+
+const frogModelCode = `
+import 'package:json_annotation/json_annotation.dart';
+
+part 'user.g.dart';
+
+@JsonSerializable()
+class User {
+  const User({required this.id, required this.name, this.avatarUrl});
+
+  factory User.fromJson(Map<String, dynamic> json) => _$UserFromJson(json);
+
+  final int id;
+  final String name;
+  @JsonKey(name: 'avatar_url')
+  final String? avatarUrl;
+
+  Map<String, dynamic> toJson() => _$UserToJson(this);
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "dartfrog", p.Name())
+	assert.Equal(t, []string{".dart"}, p.Extensions())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+
+	found, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	pubspec := "name: my_project\ndependencies:\n  dart_frog: ^1.1.0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0o644))
+
+	found, err = New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestRoutePath(t *testing.T) {
+	tests := []struct {
+		file string
+		path string
+		ok   bool
+	}{
+		{"routes/index.dart", "/", true},
+		{"/app/routes/about.dart", "/about", true},
+		{"/app/routes/users/[id]/index.dart", "/users/{id}", true},
+		{"/app/routes/files/[...path].dart", "/files/{path}", true},
+		{"/app/routes/users/_middleware.dart", "", false},
+		{"/app/lib/user.dart", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path, ok := routePath(tt.file)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/routes/index.dart", Language: "dart", Content: []byte(frogIndexCode)},
+		{Path: "/app/routes/api/users/index.dart", Language: "dart", Content: []byte(frogUsersCode)},
+		{Path: "/app/routes/api/users/[id].dart", Language: "dart", Content: []byte(frogUserCode)},
+		{Path: "/app/routes/_middleware.dart", Language: "dart", Content: []byte(frogMiddlewareCode)},
+		{Path: "/app/lib/models/user.dart", Language: "dart", Content: []byte(frogModelCode)},
+	})
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	assert.Len(t, routes, 5)
+	for _, key := range []string{
+		"GET /",
+		"GET /api/users",
+		"POST /api/users",
+		"GET /api/users/{id}",
+		"DELETE /api/users/{id}",
+	} {
+		assert.Contains(t, byKey, key)
+	}
+
+	create := byKey["POST /api/users"]
+	assert.Equal(t, "onRequest", create.Handler)
+	assert.Equal(t, "postApiUsers", create.OperationID)
+	assert.Equal(t, []string{"users"}, create.Tags)
+	assert.Equal(t, 8, create.SourceLine)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/User", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Nil(t, byKey["GET /api/users"].RequestBody)
+
+	get := byKey["GET /api/users/{id}"]
+	assert.Equal(t, "getApiUsersByid", get.OperationID)
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "id", get.Parameters[0].Name)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "/app/routes/api/users/index.dart", Language: "dart", Content: []byte(frogUsersCode)},
+		{Path: "/app/lib/models/user.dart", Language: "dart", Content: []byte(frogModelCode)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, schemas, 1)
+	user := schemas[0]
+	assert.Equal(t, "User", user.Title)
+	assert.Equal(t, []string{"id", "name"}, user.Required)
+	assert.Contains(t, user.Properties, "avatar_url")
+	assert.True(t, user.Properties["avatar_url"].Nullable)
+}
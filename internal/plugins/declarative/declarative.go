@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package declarative provides plugins for simple convention-based
+// frameworks described by YAML definition files instead of Go code.
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for a framework
+// definition.
+type Plugin struct {
+	def *Definition
+
+	// source is the definition file the plugin was loaded from
+	source string
+}
+
+// New creates a plugin for a compiled definition.
+func New(def *Definition) *Plugin {
+	return &Plugin{def: def}
+}
+
+// Register loads the framework definitions matching the glob patterns and
+// registers each as a plugin. Loading the same file again is a no-op.
+func Register(patterns []string) error {
+	return RegisterIn(plugins.Global(), patterns)
+}
+
+// RegisterIn is Register with an explicit registry.
+func RegisterIn(registry *plugins.Registry, patterns []string) error {
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid framework definition pattern %q: %w", pattern, err)
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("no framework definitions match %q", pattern)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			def, err := Load(path)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if existing, ok := registry.Get(def.Name).(*Plugin); ok && existing.source == path {
+				continue
+			}
+			plugin := New(def)
+			plugin.source = path
+			if err := registry.Register(plugin); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return p.def.Name
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return p.def.Extensions
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	description := p.def.Description
+	if description == "" {
+		description = "Extracts routes as described by " + filepath.Base(p.source)
+	}
+	return plugins.PluginInfo{
+		Name:                p.def.Name,
+		Version:             "1.0.0",
+		Description:         description,
+		SupportedFrameworks: []string{p.def.Name},
+	}
+}
+
+// Detect checks the definition's dependency files for its markers.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range p.def.Detect.Files {
+		content, err := os.ReadFile(filepath.Join(projectRoot, name))
+		if err != nil {
+			continue
+		}
+		if len(p.def.Detect.Contains) == 0 || containsAny(string(content), p.def.Detect.Contains) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExtractRoutes finds the definition's route calls in source files.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if file.Language != p.def.Language {
+			continue
+		}
+		content := util.NormalizeNewlines(string(file.Content))
+		if len(p.def.Imports) > 0 && !containsAny(content, p.def.Imports) {
+			continue
+		}
+		routes = append(routes, p.extractFileRoutes(file.Path, content)...)
+	}
+
+	return routes, nil
+}
+
+// ExtractSchemas returns no schemas; definitions only describe routes.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return nil, nil
+}
+
+// extractFileRoutes applies every route rule to one file, in source order.
+func (p *Plugin) extractFileRoutes(path, content string) []types.Route {
+	type found struct {
+		offset int
+		route  types.Route
+	}
+	var matches []found
+
+	for _, rule := range p.def.Routes {
+		for _, m := range rule.call.FindAllStringSubmatchIndex(content, -1) {
+			args, ok := splitArgs(content[m[1]:])
+			if !ok {
+				continue
+			}
+			positional, keywords := classifyArgs(args)
+
+			if rule.PathArg >= len(positional) {
+				continue
+			}
+			routePath, ok := stringLiteral(positional[rule.PathArg])
+			if !ok {
+				continue
+			}
+
+			group := func(i int) string {
+				if i <= 0 || m[2*i] < 0 {
+					return ""
+				}
+				return content[m[2*i]:m[2*i+1]]
+			}
+
+			var methods []string
+			if name := group(rule.Method); name != "" {
+				methods = []string{name}
+			}
+			if len(methods) == 0 && rule.MethodArg != "" {
+				methods = methodArg(rule.MethodArg, positional, keywords)
+			}
+			if len(methods) == 0 && rule.DefaultMethod != "" {
+				methods = []string{rule.DefaultMethod}
+			}
+
+			line := strings.Count(content[:m[0]], "\n") + 1
+			for _, name := range methods {
+				method := p.httpMethod(name)
+				if method == "" {
+					continue
+				}
+				matches = append(matches, found{
+					offset: m[0],
+					route:  p.buildRoute(method, routePath, group(rule.Handler), path, line),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].offset < matches[j].offset
+	})
+	routes := make([]types.Route, len(matches))
+	for i, m := range matches {
+		routes[i] = m.route
+	}
+	return routes
+}
+
+// httpMethod maps a framework method name to an HTTP method, or "" if it
+// is not one.
+func (p *Plugin) httpMethod(name string) string {
+	if method, ok := p.def.Methods[strings.ToLower(name)]; ok {
+		return method
+	}
+	method := strings.ToUpper(name)
+	switch method {
+	case "GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS":
+		return method
+	}
+	return ""
+}
+
+// buildRoute creates a route with OpenAPI path parameters.
+func (p *Plugin) buildRoute(method, rawPath, handler, file string, line int) types.Route {
+	routePath, params := p.convertPath(rawPath)
+	return types.Route{
+		Method:      method,
+		Path:        routePath,
+		Handler:     handler,
+		OperationID: operationID(method, routePath, handler),
+		Tags:        inferTags(routePath),
+		Parameters:  params,
+		SourceFile:  file,
+		SourceLine:  line,
+	}
+}
+
+var (
+	colonParamRegex = regexp.MustCompile(`:([A-Za-z_][A-Za-z0-9_]*)`)
+	angleParamRegex = regexp.MustCompile(`<([^<>]+)>`)
+	braceParamRegex = regexp.MustCompile(`\{([^{}]+)\}`)
+)
+
+// converterTypes maps path converter names, as in <int:id> or {id:int}, to
+// schema types.
+var converterTypes = map[string]string{
+	"int":     "integer",
+	"integer": "integer",
+	"float":   "number",
+	"number":  "number",
+	"str":     "string",
+	"string":  "string",
+	"path":    "string",
+	"uuid":    "string",
+	"slug":    "string",
+	"any":     "string",
+	"re":      "string",
+}
+
+// convertPath rewrites the definition's parameter syntax to {name} and
+// returns the path parameters.
+func (p *Plugin) convertPath(path string) (string, []types.Parameter) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	var params []types.Parameter
+	add := func(name, typ string) string {
+		if typ == "" {
+			typ = "string"
+		}
+		params = append(params, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: typ},
+		})
+		return "{" + name + "}"
+	}
+
+	// Both <int:id> and <id:int> occur; the converter is the known half
+	split := func(inner string) string {
+		parts := strings.SplitN(inner, ":", 2)
+		if len(parts) == 1 {
+			return add(strings.TrimSpace(parts[0]), "")
+		}
+		first, second := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if typ, ok := converterTypes[first]; ok {
+			return add(second, typ)
+		}
+		return add(first, converterTypes[second])
+	}
+
+	style := p.def.Params
+	// Braces first, so the {name} written for other styles is not reread
+	if style == "" || style == "brace" {
+		path = braceParamRegex.ReplaceAllStringFunc(path, func(m string) string {
+			return split(m[1 : len(m)-1])
+		})
+	}
+	if style == "" || style == "angle" {
+		path = angleParamRegex.ReplaceAllStringFunc(path, func(m string) string {
+			return split(m[1 : len(m)-1])
+		})
+	}
+	if style == "" || style == "colon" {
+		path = colonParamRegex.ReplaceAllStringFunc(path, func(m string) string {
+			return add(m[1:], "")
+		})
+	}
+	return path, params
+}
+
+// methodArg reads the methods from the argument named by spec: a position
+// or a keyword. The value may be a string or a list of strings.
+func methodArg(spec string, positional []string, keywords map[string]string) []string {
+	var value string
+	if i, err := strconv.Atoi(spec); err == nil {
+		if i < 0 || i >= len(positional) {
+			return nil
+		}
+		value = positional[i]
+	} else {
+		var ok bool
+		if value, ok = keywords[spec]; !ok {
+			return nil
+		}
+	}
+
+	if s, ok := stringLiteral(value); ok {
+		return []string{s}
+	}
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || !strings.ContainsRune("[(", rune(value[0])) {
+		return nil
+	}
+	items, _ := splitArgs(value[1:])
+	var methods []string
+	for _, item := range items {
+		if s, ok := stringLiteral(item); ok {
+			methods = append(methods, s)
+		}
+	}
+	return methods
+}
+
+// splitArgs splits the argument list that starts just after an opening
+// parenthesis or bracket at its top-level commas. It reports false if the
+// list is not closed.
+func splitArgs(s string) ([]string, bool) {
+	var args []string
+	depth := 0
+	start := 0
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				if arg := strings.TrimSpace(s[start:i]); arg != "" {
+					args = append(args, arg)
+				}
+				return args, true
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return nil, false
+}
+
+// keywordArgRegex matches name=value and name: value arguments.
+var keywordArgRegex = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*(?:=|:)\s*([\s\S]+)$`)
+
+// classifyArgs separates positional arguments from keyword arguments.
+func classifyArgs(args []string) ([]string, map[string]string) {
+	var positional []string
+	keywords := make(map[string]string)
+	for _, arg := range args {
+		if m := keywordArgRegex.FindStringSubmatch(arg); m != nil && !strings.HasPrefix(m[2], "=") {
+			keywords[m[1]] = m[2]
+			continue
+		}
+		positional = append(positional, arg)
+	}
+	return positional, keywords
+}
+
+// stringLiteral returns the contents of a quoted string, accepting the
+// quote styles and string prefixes of the common scripting languages.
+func stringLiteral(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimLeft(s, "rRuU")
+	if len(s) < 2 {
+		return "", false
+	}
+	q := s[0]
+	if (q != '"' && q != '\'' && q != '`') || s[len(s)-1] != q {
+		return "", false
+	}
+	inner := s[1 : len(s)-1]
+	if q == '`' && strings.Contains(inner, "${") {
+		return "", false
+	}
+	return inner, true
+}
+
+func containsAny(s string, markers []string) bool {
+	for _, marker := range markers {
+		if strings.Contains(s, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// operationID builds an operation ID from the handler name, or from the
+// method and path when there is none.
+func operationID(method, path, handler string) string {
+	if handler != "" {
+		return util.ToLowerCamelCase(handler)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") {
+			segment = "By" + strings.Trim(segment, "{}")
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		}) {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
+
+// inferTags tags a route with its first literal path segment after any
+// api or version prefix.
+func inferTags(path string) []string {
+	for _, part := range strings.Split(path, "/") {
+		switch {
+		case part == "", part == "api", strings.HasPrefix(part, "{"):
+			continue
+		case len(part) > 1 && part[0] == 'v' && strings.Trim(part[1:], "0123456789") == "":
+			continue
+		}
+		return []string{part}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package declarative
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+const bottleDefinition = `
+name: bottle-test
+language: python
+extensions: [.py]
+detect:
+  files: [requirements.txt]
+  contains: [bottle]
+imports: ["import bottle", "from bottle import"]
+routes:
+  - call: '@(?:app|bottle)\.(get|post|put|delete|patch)\('
+    method: 1
+  - call: '@(?:app|bottle)\.route\('
+    methodArg: method
+    defaultMethod: GET
+`
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		yaml        string
+		errContains string
+	}{
+		{"missing name", "language: python\nroutes: [{call: x, defaultMethod: GET}]", "name is required"},
+		{"missing language", "name: x\nroutes: [{call: x, defaultMethod: GET}]", "language is required"},
+		{"no routes", "name: x\nlanguage: python", "at least one route rule"},
+		{"bad regex", "name: x\nlanguage: python\nroutes: [{call: '(', defaultMethod: GET}]", "routes[0].call"},
+		{"no method", "name: x\nlanguage: python\nroutes: [{call: 'get\\('}]", "needs method"},
+		{"missing group", "name: x\nlanguage: python\nroutes: [{call: 'get\\(', method: 1}]", "capture group"},
+		{"bad params", "name: x\nlanguage: python\nparams: dollar\nroutes: [{call: x, defaultMethod: GET}]", "params style"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	def, err := Parse([]byte(bottleDefinition))
+	require.NoError(t, err)
+	p := New(def)
+
+	source := `from bottle import Bottle
+app = Bottle()
+
+@app.get('/users')
+def list_users():
+    return []
+
+@app.post("/users")
+def create_user():
+    pass
+
+@app.route('/users/<id:int>', method=['PUT', 'PATCH'])
+def update_user(id):
+    pass
+
+@app.route('/health')
+def health():
+    return "ok"
+`
+	files := []scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(source)},
+		{Path: "other.py", Language: "python", Content: []byte("@app.get('/ignored')\n")},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 5)
+
+	assert.Equal(t, "GET", routes[0].Method)
+	assert.Equal(t, "/users", routes[0].Path)
+	assert.Equal(t, 4, routes[0].SourceLine)
+	assert.Equal(t, "getUsers", routes[0].OperationID)
+	assert.Equal(t, []string{"users"}, routes[0].Tags)
+
+	assert.Equal(t, "POST", routes[1].Method)
+
+	assert.Equal(t, "PUT", routes[2].Method)
+	assert.Equal(t, "PATCH", routes[3].Method)
+	assert.Equal(t, "/users/{id}", routes[2].Path)
+	require.Len(t, routes[2].Parameters, 1)
+	assert.Equal(t, "id", routes[2].Parameters[0].Name)
+	assert.Equal(t, "integer", routes[2].Parameters[0].Schema.Type)
+
+	assert.Equal(t, "GET", routes[4].Method)
+	assert.Equal(t, "/health", routes[4].Path)
+}
+
+func TestPlugin_ColonParamsAndMethodMap(t *testing.T) {
+	def, err := Parse([]byte(`
+name: tiny
+language: javascript
+params: colon
+methods: {del: DELETE}
+routes:
+  - call: '\brouter\.(get|del)\('
+    method: 1
+`))
+	require.NoError(t, err)
+
+	routes, err := New(def).ExtractRoutes([]scanner.SourceFile{{
+		Path:     "routes.js",
+		Language: "javascript",
+		Content:  []byte("router.get('/teas/:teaId', show)\nrouter.del(`/teas/:teaId`, remove)\nrouter.get(`/x/${y}`, dyn)\n"),
+	}})
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	assert.Equal(t, "/teas/{teaId}", routes[0].Path)
+	assert.Equal(t, "DELETE", routes[1].Method)
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	def, err := Parse([]byte(bottleDefinition))
+	require.NoError(t, err)
+	p := New(def)
+
+	dir := t.TempDir()
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte("bottle==0.12\n"), 0644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+}
+
+func TestRegister(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bottle.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(bottleDefinition), 0644))
+	defer func() { _ = plugins.Global().Unregister("bottle-test") }()
+
+	require.NoError(t, Register([]string{filepath.Join(dir, "*.yaml")}))
+	assert.True(t, plugins.Has("bottle-test"))
+
+	// Registering the same file again is a no-op
+	require.NoError(t, Register([]string{path}))
+
+	err := Register([]string{filepath.Join(dir, "missing-*.yaml")})
+	assert.Error(t, err)
+}
+
+func TestRegisterIn(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bottle.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(bottleDefinition), 0644))
+
+	// Each registry gets its own plugin, so loading the definition for one
+	// request does not collide with another
+	for range 2 {
+		registry := plugins.NewRegistry()
+		require.NoError(t, RegisterIn(registry, []string{path}))
+		assert.True(t, registry.Has("bottle-test"))
+	}
+	assert.False(t, plugins.Has("bottle-test"))
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Definition describes a convention-based framework well enough to extract
+// its routes without a Go plugin.
+//
+//	name: bottle
+//	language: python
+//	detect:
+//	  files: [requirements.txt, pyproject.toml]
+//	  contains: [bottle]
+//	imports: ["import bottle", "from bottle import"]
+//	routes:
+//	  - call: '@(?:app|bottle)\.(get|post|put|delete|patch)\('
+//	    method: 1
+//	  - call: '@(?:app|bottle)\.route\('
+//	    methodArg: method
+//	    defaultMethod: GET
+type Definition struct {
+	// Name is the plugin name used with --framework
+	Name string `yaml:"name"`
+
+	// Description is shown in plugin listings
+	Description string `yaml:"description"`
+
+	// Language is the scanner language of the files to read (e.g. python)
+	Language string `yaml:"language"`
+
+	// Extensions are the file extensions of the framework's sources
+	Extensions []string `yaml:"extensions"`
+
+	// Detect decides whether a project uses the framework
+	Detect DetectRule `yaml:"detect"`
+
+	// Imports are markers of which at least one must appear in a file for
+	// its routes to be extracted; empty means every file of the language
+	Imports []string `yaml:"imports"`
+
+	// Routes are the route registration calls to look for
+	Routes []RouteRule `yaml:"routes"`
+
+	// Params is the path parameter syntax: colon (:id), angle (<id> or
+	// <int:id>) or brace ({id}); default: all of them
+	Params string `yaml:"params"`
+
+	// Methods maps framework method names to HTTP methods (e.g. del: DELETE)
+	Methods map[string]string `yaml:"methods"`
+}
+
+// DetectRule matches a framework by the dependency files of a project.
+type DetectRule struct {
+	// Files are dependency manifests relative to the project root
+	Files []string `yaml:"files"`
+
+	// Contains are strings of which one must appear in one of the files
+	Contains []string `yaml:"contains"`
+}
+
+// RouteRule matches one style of route registration call.
+type RouteRule struct {
+	// Call is a regular expression matching the call up to and including
+	// its opening parenthesis, e.g. `app\.(get|post)\(`
+	Call string `yaml:"call"`
+
+	// Method is the capture group of Call holding the method name
+	Method int `yaml:"method"`
+
+	// MethodArg is the argument holding the method(s): a position such as
+	// "1" or a keyword such as "method" or "methods"
+	MethodArg string `yaml:"methodArg"`
+
+	// DefaultMethod is used when neither Method nor MethodArg yields one
+	DefaultMethod string `yaml:"defaultMethod"`
+
+	// PathArg is the position of the path argument (default 0)
+	PathArg int `yaml:"pathArg"`
+
+	// Handler is the capture group of Call holding the handler name
+	Handler int `yaml:"handler"`
+
+	call *regexp.Regexp
+}
+
+var supportedParamStyles = []string{"", "colon", "angle", "brace"}
+
+// Load reads and validates a framework definition file.
+func Load(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read framework definition: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates a framework definition.
+func Parse(data []byte) (*Definition, error) {
+	var def Definition
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("failed to parse framework definition: %w", err)
+	}
+	if err := def.compile(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// compile validates the definition and compiles its call patterns.
+func (d *Definition) compile() error {
+	if d.Name == "" {
+		return fmt.Errorf("framework definition: name is required")
+	}
+	if d.Language == "" {
+		return fmt.Errorf("framework definition %s: language is required", d.Name)
+	}
+	if len(d.Routes) == 0 {
+		return fmt.Errorf("framework definition %s: at least one route rule is required", d.Name)
+	}
+	valid := false
+	for _, style := range supportedParamStyles {
+		valid = valid || d.Params == style
+	}
+	if !valid {
+		return fmt.Errorf("framework definition %s: unsupported params style %q, must be one of: colon, angle, brace", d.Name, d.Params)
+	}
+
+	for i := range d.Routes {
+		rule := &d.Routes[i]
+		re, err := regexp.Compile(rule.Call)
+		if err != nil {
+			return fmt.Errorf("framework definition %s: routes[%d].call: %w", d.Name, i, err)
+		}
+		if rule.Method > re.NumSubexp() || rule.Handler > re.NumSubexp() {
+			return fmt.Errorf("framework definition %s: routes[%d] refers to a capture group %q does not have", d.Name, i, rule.Call)
+		}
+		if rule.Method == 0 && rule.MethodArg == "" && rule.DefaultMethod == "" {
+			return fmt.Errorf("framework definition %s: routes[%d] needs method, methodArg or defaultMethod", d.Name, i)
+		}
+		rule.call = re
+	}
+
+	methods := make(map[string]string, len(d.Methods))
+	for name, method := range d.Methods {
+		methods[strings.ToLower(name)] = strings.ToUpper(method)
+	}
+	d.Methods = methods
+	return nil
+}
@@ -6,6 +6,7 @@ package drf
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -53,6 +54,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".py"}
 }
 
+// RouteMarkers returns the DRF package name, which every file defining
+// routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return []string{"rest_framework"}
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -118,14 +125,22 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts DRF route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			continue
 		}
@@ -137,8 +152,8 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 }
 
 // extractRoutesFromFile extracts routes from a single Python file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.pyParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -385,14 +400,22 @@ func (p *Plugin) extractActionRoutes(method parser.PythonDecoratedFunction, dec
 
 // ExtractSchemas extracts schema definitions from DRF serializers.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	var schemas []types.Schema
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		pf, err := p.pyParser.Parse(file.Path, file.Content)
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -453,25 +476,25 @@ func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema
 		Type:       "object",
 		Properties: make(map[string]*types.Schema),
 		Required:   []string{},
+		Source:     model.Source(),
 	}
 
 	for _, field := range model.Fields {
-		propSchema := &types.Schema{}
-
-		openAPIType, format := parser.PythonTypeToOpenAPI(field.Type)
-		propSchema.Type = openAPIType
-		if format != "" {
-			propSchema.Format = format
-		}
+		propSchema := parser.PythonTypeSchema(field.Type)
 
 		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
 			propSchema.Type = "array"
 			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.PythonTypeToOpenAPI(innerType)
-			propSchema.Items = &types.Schema{
-				Type:   innerOpenAPIType,
-				Format: innerFormat,
-			}
+			propSchema.Items = parser.PythonTypeSchema(innerType)
+		}
+
+		if valueType, ok := parser.PythonDictValueType(field.Type); ok {
+			propSchema.Type = "object"
+			propSchema.AdditionalProperties = parser.PythonTypeSchema(valueType)
+		}
+
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
 		}
 
 		if field.Description != "" {
@@ -115,13 +115,17 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 // ExtractRoutes parses source files and extracts Echo route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
+	handlerBindings := make(map[string][]binding)
+
+	// Bound request structs may be declared in any file
+	structs := p.collectStructs(files)
 
 	for _, file := range files {
 		if file.Language != "go" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(file, handlerBindings, structs)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -130,11 +134,21 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		routes = append(routes, fileRoutes...)
 	}
 
+	// Named handlers may be declared in any file
+	for i := range routes {
+		name := routes[i].Handler
+		if idx := strings.LastIndex(name, "."); idx >= 0 {
+			name = name[idx+1:]
+		}
+		p.applyBindings(&routes[i], handlerBindings[name], structs)
+	}
+
 	return routes, nil
 }
 
-// extractRoutesFromFile extracts routes from a single Go file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
+// extractRoutesFromFile extracts routes from a single Go file and records the
+// request structs its handler functions bind.
+func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile, handlerBindings map[string][]binding, structs map[string]parser.StructDefinition) ([]types.Route, error) {
 	pf, err := p.goParser.ParseSource(file.Path, string(file.Content))
 	if err != nil {
 		return nil, err
@@ -153,6 +167,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 		file:        pf,
 		parser:      p.goParser,
 		prefixStack: []string{},
+		structs:     structs,
 	}
 
 	ast.Inspect(pf.AST, func(n ast.Node) bool {
@@ -164,6 +179,10 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 		if funcDecl, ok := n.(*ast.FuncDecl); ok {
 			funcRoutes := p.extractRoutesFromFunc(funcDecl, ctx)
 			routes = append(routes, funcRoutes...)
+
+			if bindings := contextBindings(funcDecl.Type, funcDecl.Body); len(bindings) > 0 {
+				handlerBindings[funcDecl.Name.Name] = bindings
+			}
 		}
 
 		return true
@@ -182,6 +201,7 @@ type extractionContext struct {
 	file        *parser.ParsedFile
 	parser      *parser.GoParser
 	prefixStack []string
+	structs     map[string]parser.StructDefinition
 }
 
 // currentPrefix returns the current route prefix.
@@ -329,6 +349,7 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 	fullPath = normalizePath(fullPath)
 
 	// Convert Echo :param syntax to OpenAPI {param} syntax
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertEchoPathParams(fullPath)
 
 	// Extract handler name if present
@@ -361,6 +382,13 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	if lit, ok := callExpr.Args[len(callExpr.Args)-1].(*ast.FuncLit); ok {
+		p.applyBindings(route, contextBindings(lit.Type, lit.Body), ctx.structs)
+	}
 
 	return route
 }
@@ -390,6 +418,7 @@ func (p *Plugin) extractRouteFromAdd(callExpr *ast.CallExpr, ctx *extractionCont
 	fullPath = normalizePath(fullPath)
 
 	// Convert Echo :param syntax to OpenAPI {param} syntax
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertEchoPathParams(fullPath)
 
 	// Extract handler name if present
@@ -416,6 +445,13 @@ func (p *Plugin) extractRouteFromAdd(callExpr *ast.CallExpr, ctx *extractionCont
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	if lit, ok := callExpr.Args[len(callExpr.Args)-1].(*ast.FuncLit); ok {
+		p.applyBindings(route, contextBindings(lit.Type, lit.Body), ctx.structs)
+	}
 
 	return route
 }
@@ -441,6 +477,258 @@ func (p *Plugin) extractHandlerName(expr ast.Expr) string {
 	}
 }
 
+// bindingKind says which part of the request a bound struct is read from.
+type bindingKind int
+
+const (
+	bindQuery bindingKind = iota
+	bindPath
+	bindHeader
+	bindBody
+	// bindAll is Context.Bind: path parameters, then the query string for
+	// GET, HEAD and DELETE requests, then the body
+	bindAll
+)
+
+// binders maps the echo.Context and echo.DefaultBinder binding methods to
+// what they bind.
+var binders = map[string]bindingKind{
+	"Bind":            bindAll,
+	"BindQueryParams": bindQuery,
+	"BindPathParams":  bindPath,
+	"BindHeaders":     bindHeader,
+	"BindBody":        bindBody,
+}
+
+// binding is a struct a handler binds request data into.
+type binding struct {
+	kind     bindingKind
+	typeName string
+}
+
+// contextBindings returns the structs a handler binds through its
+// echo.Context parameter, e.g. c.Bind(&req) or
+// (&echo.DefaultBinder{}).BindQueryParams(c, &query).
+func contextBindings(fnType *ast.FuncType, body *ast.BlockStmt) []binding {
+	ctxName := contextParam(fnType, body)
+	if ctxName == "" {
+		return nil
+	}
+
+	locals := localTypes(body)
+
+	var bindings []binding
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		kind, ok := binders[sel.Sel.Name]
+		if !ok {
+			return true
+		}
+
+		// The context is either the receiver or the binder's first argument
+		receiver, _ := sel.X.(*ast.Ident)
+		first, _ := call.Args[0].(*ast.Ident)
+		if (receiver == nil || receiver.Name != ctxName) && (first == nil || first.Name != ctxName || len(call.Args) < 2) {
+			return true
+		}
+
+		target := call.Args[len(call.Args)-1]
+		if unary, ok := target.(*ast.UnaryExpr); ok {
+			target = unary.X
+		}
+		if ident, ok := target.(*ast.Ident); ok && locals[ident.Name] != "" {
+			bindings = append(bindings, binding{kind: kind, typeName: locals[ident.Name]})
+		}
+		return true
+	})
+
+	return bindings
+}
+
+// contextParam returns the name of the echo.Context parameter of a handler,
+// or "" if it has none or no body.
+func contextParam(fnType *ast.FuncType, body *ast.BlockStmt) string {
+	if fnType == nil || body == nil || fnType.Params == nil {
+		return ""
+	}
+
+	for _, field := range fnType.Params.List {
+		sel, ok := field.Type.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Context" || len(field.Names) == 0 {
+			continue
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "echo" {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+// localTypes maps the variables a function body declares to their named
+// struct type: var q Query, q := Query{}, q := &Query{} and q := new(Query).
+func localTypes(body *ast.BlockStmt) map[string]string {
+	locals := make(map[string]string)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.ValueSpec:
+			if name := typeName(stmt.Type); name != "" {
+				for _, ident := range stmt.Names {
+					locals[ident.Name] = name
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range stmt.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || i >= len(stmt.Rhs) {
+					continue
+				}
+				if name := valueTypeName(stmt.Rhs[i]); name != "" {
+					locals[ident.Name] = name
+				}
+			}
+		}
+		return true
+	})
+	return locals
+}
+
+// valueTypeName returns the struct type of a composite literal, a pointer
+// to one, or a new(T) call.
+func valueTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return typeName(e.Type)
+	case *ast.UnaryExpr:
+		return valueTypeName(e.X)
+	case *ast.CallExpr:
+		if fn, ok := e.Fun.(*ast.Ident); ok && fn.Name == "new" && len(e.Args) == 1 {
+			return typeName(e.Args[0])
+		}
+	}
+	return ""
+}
+
+// typeName returns the name of a named type, dropping the pointer and
+// package qualifier (e.g., *dto.Query is Query).
+func typeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return typeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+// collectStructs gathers the struct definitions of all Go files.
+func (p *Plugin) collectStructs(files []scanner.SourceFile) map[string]parser.StructDefinition {
+	structs := make(map[string]parser.StructDefinition)
+	for _, file := range files {
+		if file.Language != "go" {
+			continue
+		}
+		pf, err := p.goParser.ParseSource(file.Path, string(file.Content))
+		if err != nil {
+			continue
+		}
+		for _, def := range p.goParser.ExtractStructs(pf) {
+			structs[def.Name] = def
+		}
+	}
+	return structs
+}
+
+// applyBindings documents the structs a handler binds: query tags become
+// query parameters, param tags path parameters, header tags header
+// parameters, and bodies reference the struct's schema.
+func (p *Plugin) applyBindings(route *types.Route, bindings []binding, structs map[string]parser.StructDefinition) {
+	for _, b := range bindings {
+		def, ok := structs[b.typeName]
+		if !ok {
+			continue
+		}
+
+		switch b.kind {
+		case bindQuery:
+			p.addBoundParams(route, def, "query", "query", structs, 0)
+		case bindPath:
+			p.addBoundParams(route, def, "param", "path", structs, 0)
+		case bindHeader:
+			p.addBoundParams(route, def, "header", "header", structs, 0)
+		case bindBody:
+			addBoundBody(route, def)
+		case bindAll:
+			p.addBoundParams(route, def, "param", "path", structs, 0)
+			if route.Method == "GET" || route.Method == "HEAD" || route.Method == "DELETE" {
+				p.addBoundParams(route, def, "query", "query", structs, 0)
+			} else {
+				addBoundBody(route, def)
+			}
+		}
+	}
+}
+
+// addBoundBody references the schema of a struct bound from the body.
+func addBoundBody(route *types.Route, def parser.StructDefinition) {
+	if route.RequestBody != nil {
+		return
+	}
+	route.RequestBody = &types.RequestBody{
+		Required: true,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: schema.SchemaRef(def.Name)},
+		},
+	}
+}
+
+// addBoundParams adds a parameter for each field of def bound by tag,
+// including the fields of embedded structs. Path parameters already on
+// the route take the field's type.
+func (p *Plugin) addBoundParams(route *types.Route, def parser.StructDefinition, tag, in string, structs map[string]parser.StructDefinition, depth int) {
+	if depth > 5 {
+		return
+	}
+	for _, embedded := range def.Embedded {
+		if inner, ok := structs[strings.TrimPrefix(embedded, "*")]; ok {
+			p.addBoundParams(route, inner, tag, in, structs, depth+1)
+		}
+	}
+
+	for _, field := range def.Fields {
+		name := field.Bindings[tag]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		param := types.Parameter{
+			Name:     name,
+			In:       in,
+			Required: field.IsRequired || in == "path",
+			Schema:   p.schemaExtractor.FieldSchema(field),
+		}
+
+		exists := false
+		for i := range route.Parameters {
+			if route.Parameters[i].Name == name && route.Parameters[i].In == in {
+				route.Parameters[i].Schema = param.Schema
+				exists = true
+			}
+		}
+		if !exists && in != "path" {
+			route.Parameters = append(route.Parameters, param)
+		}
+	}
+}
+
 // hasEchoImport checks if the file imports Echo.
 func (p *Plugin) hasEchoImport(pf *parser.ParsedFile) bool {
 	for _, importPath := range echoImportPaths {
@@ -672,3 +672,63 @@ func SetupRoutes(e *echo.Echo) {
 	assert.Equal(t, "/path/to/routes.go", routes[0].SourceFile)
 	assert.Greater(t, routes[0].SourceLine, 0)
 }
+
+func TestPlugin_ExtractRoutes_BoundStructs(t *testing.T) {
+	p := New()
+
+	code := `package main
+
+import "github.com/labstack/echo/v4"
+
+type ListQuery struct {
+	Limit  int    ` + "`query:\"limit\"`" + `
+	Search string ` + "`query:\"q\" validate:\"required\"`" + `
+	Ignored string
+}
+
+type UpdateUser struct {
+	ID   int    ` + "`param:\"id\"`" + `
+	Name string ` + "`json:\"name\"`" + `
+}
+
+func ListUsers(c echo.Context) error {
+	q := new(ListQuery)
+	if err := c.Bind(q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func UpdateUserHandler(c echo.Context) error {
+	var req UpdateUser
+	return c.Bind(&req)
+}
+
+func main() {
+	e := echo.New()
+	e.GET("/users", ListUsers)
+	e.PUT("/users/:id", UpdateUserHandler)
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "main.go", Language: "go", Content: []byte(code)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	list := routes[0]
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "limit", list.Parameters[0].Name)
+	assert.Equal(t, "integer", list.Parameters[0].Schema.Type)
+	assert.Equal(t, "q", list.Parameters[1].Name)
+	assert.True(t, list.Parameters[1].Required)
+	assert.Nil(t, list.RequestBody)
+
+	update := routes[1]
+	require.Len(t, update.Parameters, 1)
+	assert.Equal(t, "integer", update.Parameters[0].Schema.Type)
+	require.NotNil(t, update.RequestBody)
+	assert.Equal(t, "#/components/schemas/UpdateUser", update.RequestBody.Content["application/json"].Schema.Ref)
+}
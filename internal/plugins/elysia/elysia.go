@@ -5,6 +5,7 @@
 package elysia
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -60,6 +62,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
 }
 
+// RouteMarkers returns the Elysia module name, which every file defining
+// routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return []string{"elysia"}
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -106,14 +114,22 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Elysia route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -132,8 +148,8 @@ type elysiaInfo struct {
 }
 
 // extractRoutesFromFile extracts routes from a single TypeScript/JavaScript file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +169,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 	}
 
 	// Track Elysia instances
-	elysiaInstances := p.findElysiaInstances(pf.RootNode, file.Content)
+	elysiaInstances := p.findElysiaInstances(file.Path, pf.RootNode, file.Content)
 
 	// Track group prefixes from chained calls
 	groupPrefixes := make(map[string]string)
@@ -200,7 +216,7 @@ func (p *Plugin) hasElysiaImport(rootNode *sitter.Node, content []byte) bool {
 }
 
 // findElysiaInstances finds variables that are Elysia instances.
-func (p *Plugin) findElysiaInstances(rootNode *sitter.Node, content []byte) map[string]*elysiaInfo {
+func (p *Plugin) findElysiaInstances(path string, rootNode *sitter.Node, content []byte) map[string]*elysiaInfo {
 	instances := make(map[string]*elysiaInfo)
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
@@ -250,6 +266,7 @@ func (p *Plugin) findElysiaInstances(rootNode *sitter.Node, content []byte) map[
 	if len(instances) == 0 {
 		instances["app"] = &elysiaInfo{name: "app"}
 		instances["elysia"] = &elysiaInfo{name: "elysia"}
+		decisions.Assume(p.Name(), path, "no new Elysia() variable found; assumed routes are registered on app or elysia")
 	}
 
 	return instances
@@ -336,12 +353,8 @@ func (p *Plugin) extractRoutesFromCall(
 		return nil
 	}
 
-	// First argument should be the path
-	pathArg := args[0]
-	path := ""
-	if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-		path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-	}
+	// First argument should be the path, possibly built from constants
+	path, unresolved, _ := p.tsParser.ResolveStringExpression(args[0], content)
 
 	if path == "" {
 		return nil
@@ -353,6 +366,7 @@ func (p *Plugin) extractRoutesFromCall(
 	}
 
 	// Convert Elysia path parameters (:param) to OpenAPI format ({param})
+	wildcard := plugins.IsCatchAll(path)
 	path = convertPathParams(path)
 
 	// Extract path parameters
@@ -383,6 +397,12 @@ func (p *Plugin) extractRoutesFromCall(
 		RequestBody: requestBody,
 		SourceLine:  int(node.StartPoint().Row) + 1,
 	}
+	if len(unresolved) > 0 {
+		route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
 
 	return []types.Route{route}
 }
@@ -456,11 +476,7 @@ func (p *Plugin) extractRoutesFromGroup(
 	}
 
 	// First arg is the prefix
-	prefixArg := args[0]
-	prefix := ""
-	if prefixArg.Type() == "string" || prefixArg.Type() == "template_string" {
-		prefix, _ = p.tsParser.ExtractStringLiteral(prefixArg, content)
-	}
+	prefix, prefixUnresolved, _ := p.tsParser.ResolveStringExpression(args[0], content)
 
 	if prefix == "" {
 		return nil
@@ -483,14 +499,12 @@ func (p *Plugin) extractRoutesFromGroup(
 					// Extract route info
 					innerArgs := p.tsParser.GetCallArguments(n, content)
 					if len(innerArgs) > 0 {
-						pathArg := innerArgs[0]
-						path := ""
-						if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-							path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-						}
+						path, unresolved, _ := p.tsParser.ResolveStringExpression(innerArgs[0], content)
+						unresolved = append(append([]string{}, prefixUnresolved...), unresolved...)
 
 						if path != "" {
 							fullPath := combinePaths(prefix, path)
+							wildcard := plugins.IsCatchAll(fullPath)
 							fullPath = convertPathParams(fullPath)
 							params := extractPathParams(fullPath)
 							tags := inferTags(fullPath)
@@ -513,6 +527,12 @@ func (p *Plugin) extractRoutesFromGroup(
 								RequestBody: requestBody,
 								SourceLine:  int(n.StartPoint().Row) + 1,
 							}
+							if len(unresolved) > 0 {
+								route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+							}
+							if wildcard {
+								plugins.MarkWildcard(&route)
+							}
 							routes = append(routes, route)
 						}
 					}
@@ -713,29 +733,27 @@ func (p *Plugin) extractTypeBoxProperties(node *sitter.Node, content []byte) map
 
 // walkNodes walks all nodes in the tree.
 func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
-		return
-	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	parser.Walk(node, fn)
 }
 
 // ExtractSchemas extracts schema definitions from TypeScript interfaces and TypeBox schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	tsExtractor := schema.NewTypeScriptSchemaExtractor()
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -744,6 +762,9 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		for _, iface := range pf.Interfaces {
 			tsExtractor.ExtractAndRegister(iface)
 		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
 
 		// Extract Zod schemas (if any)
 		for _, zs := range pf.ZodSchemas {
@@ -865,9 +886,10 @@ func (p *Plugin) generateSchemaNameFromPath(path, suffix string) string {
 var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts Elysia-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts Elysia-style path params (:id) to OpenAPI format ({id}),
+// and catch-alls (*) to a templated {path}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
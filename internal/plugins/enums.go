@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const (
+	// enumLiteral matches a quoted string literal: group 1 or 2 is its value
+	enumLiteral = `(?:"([^"\\\n]*)"|'([^'\\\n]*)')`
+
+	// enumLiteralList matches a comma-separated list of literals only
+	enumLiteralList = `\s*` + enumLiteral + `(?:\s*,\s*` + enumLiteral + `)*\s*,?\s*`
+)
+
+var (
+	// enumLiterals finds the literals of a list or case clause
+	enumLiterals = regexp.MustCompile(enumLiteral)
+
+	// enumCase matches case clauses of literals only: Go's case "a", "b":,
+	// JavaScript's case 'a': and Python's case "a" | "b":
+	enumCase = regexp.MustCompile(`^\s*case\s+(` + enumLiteral + `(?:\s*[,|]\s*` + enumLiteral + `)*)\s*:`)
+)
+
+// What the literals an enum is inferred from were found in
+const (
+	enumFromComparisons = "comparisons"
+	enumFromSwitch      = "a switch"
+	enumFromMembership  = "a membership test"
+)
+
+// MarkEnums infers an enum for string parameters, and properties of inline
+// request bodies, that the handler compares against a finite set of
+// literals: if status == "open" || status == "closed", switch
+// (req.query.sort) { case 'asc': ... }, if order in ("asc", "desc") or
+// ['asc', 'desc'].includes(order). Two literals or more make an enum; a
+// diagnostic notes each inference.
+func MarkEnums(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+	}
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+	declarations := make(map[string][][]string)
+	for name, decls := range indexDeclarations(sources) {
+		key := strings.ToLower(name)
+		declarations[key] = append(declarations[key], decls...)
+	}
+
+	for i := range routes {
+		route := &routes[i]
+		windows := routeSource(route, routeLines[route.SourceFile], sources, declarations)
+		if len(windows) == 0 {
+			continue
+		}
+		infer := func(kind, name string, schema *types.Schema) *types.Schema {
+			if schema != nil && (schema.Type != "string" || len(schema.Enum) > 0 || schema.Format != "" || schema.Ref != "") {
+				return nil
+			}
+			values, source := comparedLiterals(windows, name)
+			if len(values) < 2 {
+				return nil
+			}
+			inferred := &types.Schema{Type: "string"}
+			if schema != nil {
+				copied := *schema
+				inferred = &copied
+			}
+			for _, value := range values {
+				inferred.Enum = append(inferred.Enum, value)
+			}
+			quoted := make([]string, len(values))
+			for j, value := range values {
+				quoted[j] = strconv.Quote(value)
+			}
+			route.Diagnostics = append(route.Diagnostics, fmt.Sprintf("enum of %s %s inferred from %s with %s", kind, name, source, strings.Join(quoted, ", ")))
+			return inferred
+		}
+
+		if len(route.Parameters) > 0 {
+			// Routes may share their parameters
+			params := slices.Clone(route.Parameters)
+			for j := range params {
+				if params[j].In == "path" || params[j].In == "query" || params[j].In == "header" {
+					if schema := infer(params[j].In+" parameter", params[j].Name, params[j].Schema); schema != nil {
+						params[j].Schema = schema
+					}
+				}
+			}
+			route.Parameters = params
+		}
+
+		if route.RequestBody == nil {
+			continue
+		}
+		for _, mediaType := range slices.Sorted(maps.Keys(route.RequestBody.Content)) {
+			body := route.RequestBody.Content[mediaType].Schema
+			if body == nil || len(body.Properties) == 0 {
+				continue
+			}
+			for _, name := range slices.Sorted(maps.Keys(body.Properties)) {
+				if schema := infer("body property", name, body.Properties[name]); schema != nil {
+					body.Properties[name] = schema
+				}
+			}
+		}
+	}
+}
+
+// comparedLiterals returns the distinct literals, in order, that the
+// windows compare a value named name against, and what they were found in.
+// The value is an identifier or member named name (status,
+// req.query.status) or a lookup of the quoted name (c.Query("status"),
+// request.args["status"]).
+func comparedLiterals(windows [][]string, name string) ([]string, string) {
+	quotedName := regexp.QuoteMeta(name)
+	subject := `\b(?:[\w$]+(?:\.[\w$]+)*\.)?` + quotedName + `\b(?:\s*\(\s*\))?|[\w$.]+\s*[\(\[]\s*['"]` + quotedName + `['"]\s*[\)\]]`
+	comparisons := regexp.MustCompile(`(?:(?:` + subject + `)\s*(?:===?|!==?)\s*` + enumLiteral + `|` + enumLiteral + `\s*(?:===?|!==?)\s*(?:` + subject + `))`)
+	memberships := []*regexp.Regexp{
+		// Python: status in ("a", "b")
+		regexp.MustCompile(`(?:` + subject + `)\s+(?:not\s+)?in\s+[\[\(\{](` + enumLiteralList + `)[\]\)\}]`),
+		// JavaScript: ['a', 'b'].includes(status)
+		regexp.MustCompile(`\[(` + enumLiteralList + `)\]\s*\.includes\(\s*(?:` + subject + `)\s*\)`),
+		// Go: slices.Contains([]string{"a", "b"}, status)
+		regexp.MustCompile(`slices\.Contains\(\s*\[\]string\{(` + enumLiteralList + `)\}\s*,\s*(?:` + subject + `)\s*\)`),
+	}
+	switchStart := regexp.MustCompile(`^\s*(?:switch\s*\(?\s*(?:` + subject + `)\s*\)?\s*\{|match\s+(?:` + subject + `)\s*:)`)
+
+	var values []string
+	seen := make(map[string]bool)
+	add := func(match []string) {
+		for _, value := range match {
+			if value != "" && !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+	}
+	literals := func(text string) []string {
+		var found []string
+		for _, m := range enumLiterals.FindAllStringSubmatch(text, -1) {
+			found = append(found, m[1]+m[2])
+		}
+		return found
+	}
+
+	for _, lines := range windows {
+		for n, line := range lines {
+			if switchStart.MatchString(line) {
+				add(switchCases(lines[n:]))
+				if len(values) >= 2 {
+					return values, enumFromSwitch
+				}
+			}
+		}
+	}
+	for _, lines := range windows {
+		text := strings.Join(lines, "\n")
+		for _, re := range memberships {
+			for _, m := range re.FindAllStringSubmatch(text, -1) {
+				add(literals(m[1]))
+			}
+		}
+	}
+	if len(values) >= 2 {
+		return values, enumFromMembership
+	}
+	for _, lines := range windows {
+		for _, m := range comparisons.FindAllStringSubmatch(strings.Join(lines, "\n"), -1) {
+			add(m[1:])
+		}
+	}
+	return values, enumFromComparisons
+}
+
+// switchCases returns the literals of the case clauses of the switch or
+// match statement starting lines, up to where its braces close or its
+// indentation ends.
+func switchCases(lines []string) []string {
+	var values []string
+	indent := len(lines[0]) - len(strings.TrimLeft(lines[0], " \t"))
+	braces := strings.Contains(lines[0], "{")
+	depth := 0
+	for n, line := range lines {
+		if n > 0 && !braces && strings.TrimSpace(line) != "" && len(line)-len(strings.TrimLeft(line, " \t")) <= indent {
+			break
+		}
+		if m := enumCase.FindStringSubmatch(line); m != nil && (!braces || depth == 1) {
+			for _, literal := range enumLiterals.FindAllStringSubmatch(m[1], -1) {
+				values = append(values, literal[1]+literal[2])
+			}
+		}
+		if braces {
+			depth += strings.Count(line, "{") - strings.Count(line, "}")
+			if depth <= 0 {
+				break
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func enumOf(schema *types.Schema) []any {
+	if schema == nil {
+		return nil
+	}
+	return schema.Enum
+}
+
+func TestMarkEnums_Go(t *testing.T) {
+	code := `package main
+
+func listOrders(c *gin.Context) {
+	switch c.Query("status") {
+	case "open", "pending":
+		filter = open
+	case "closed":
+		filter = closed
+	}
+	sort := c.Query("sort")
+	if !slices.Contains([]string{"asc", "desc"}, sort) {
+		return
+	}
+	if c.Query("mode") == "fast" {
+		return
+	}
+}
+`
+	files := []scanner.SourceFile{{Path: "orders.go", Content: []byte(code)}}
+	routes := []types.Route{{
+		Method:     "GET",
+		Path:       "/orders",
+		Handler:    "listOrders",
+		SourceFile: "main.go",
+		SourceLine: 1,
+		Parameters: []types.Parameter{
+			{Name: "status", In: "query", Schema: &types.Schema{Type: "string", Description: "Order status"}},
+			{Name: "sort", In: "query"},
+			{Name: "mode", In: "query", Schema: &types.Schema{Type: "string"}},
+		},
+	}}
+
+	MarkEnums(routes, files)
+
+	params := routes[0].Parameters
+	assert.Equal(t, []any{"open", "pending", "closed"}, enumOf(params[0].Schema))
+	assert.Equal(t, "Order status", params[0].Schema.Description)
+	assert.Equal(t, []any{"asc", "desc"}, enumOf(params[1].Schema))
+	assert.Equal(t, "string", params[1].Schema.Type)
+
+	// A single literal is no enum
+	assert.Nil(t, enumOf(params[2].Schema))
+
+	assert.Equal(t, []string{
+		`enum of query parameter status inferred from a switch with "open", "pending", "closed"`,
+		`enum of query parameter sort inferred from a membership test with "asc", "desc"`,
+	}, routes[0].Diagnostics)
+}
+
+func TestMarkEnums_JavaScript(t *testing.T) {
+	code := `
+router.get('/posts', (req, res) => {
+  if (!['draft', 'published'].includes(req.query.state)) {
+    return res.status(400).end()
+  }
+  if (req.query.order === 'asc' || req.query.order === 'desc' || 'random' === req.query.order) {
+    sort(req.query.order)
+  }
+})
+
+router.post('/posts', (req, res) => {
+  const { kind } = req.body
+  switch (kind) {
+    case 'link':
+      break
+    case 'text':
+      break
+  }
+})
+`
+	files := []scanner.SourceFile{{Path: "posts.js", Content: []byte(code)}}
+	shared := []types.Parameter{
+		{Name: "state", In: "query", Schema: &types.Schema{Type: "string"}},
+		{Name: "order", In: "query", Schema: &types.Schema{Type: "string"}},
+		{Name: "limit", In: "query", Schema: &types.Schema{Type: "integer"}},
+	}
+	body := &types.Schema{Type: "object", Properties: map[string]*types.Schema{"kind": {Type: "string"}}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/posts", SourceFile: "posts.js", SourceLine: 2, Parameters: shared},
+		{Method: "POST", Path: "/posts", SourceFile: "posts.js", SourceLine: 11, RequestBody: &types.RequestBody{
+			Content: map[string]types.MediaType{"application/json": {Schema: body}},
+		}},
+	}
+
+	MarkEnums(routes, files)
+
+	params := routes[0].Parameters
+	assert.Equal(t, []any{"draft", "published"}, enumOf(params[0].Schema))
+	assert.Equal(t, []any{"asc", "desc", "random"}, enumOf(params[1].Schema))
+	assert.Nil(t, enumOf(params[2].Schema))
+	// The extracted parameters are left as they were
+	assert.Nil(t, shared[0].Schema.Enum)
+
+	assert.Equal(t, []any{"link", "text"}, enumOf(body.Properties["kind"]))
+	require.Len(t, routes[1].Diagnostics, 1)
+	assert.Contains(t, routes[1].Diagnostics[0], "enum of body property kind inferred from a switch")
+}
+
+func TestMarkEnums_Python(t *testing.T) {
+	code := `
+@app.get("/reports/{period}")
+def report(period: str, fmt: str = "json"):
+    if period not in ("daily", "weekly", "monthly"):
+        raise HTTPException(404)
+    match fmt:
+        case "json" | "csv":
+            pass
+        case "xml":
+            pass
+    return build(period)
+`
+	files := []scanner.SourceFile{{Path: "reports.py", Content: []byte(code)}}
+	routes := []types.Route{{
+		Method:     "GET",
+		Path:       "/reports/{period}",
+		Handler:    "report",
+		SourceFile: "reports.py",
+		SourceLine: 2,
+		Parameters: []types.Parameter{
+			{Name: "period", In: "path", Required: true, Schema: &types.Schema{Type: "string"}},
+			{Name: "fmt", In: "query", Schema: &types.Schema{Type: "string"}},
+		},
+	}}
+
+	MarkEnums(routes, files)
+
+	assert.Equal(t, []any{"daily", "weekly", "monthly"}, enumOf(routes[0].Parameters[0].Schema))
+	assert.Equal(t, []any{"json", "csv", "xml"}, enumOf(routes[0].Parameters[1].Schema))
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	// etagMiddleware matches the registration of middleware that answers
+	// conditional GET requests, such as fiber's etag.New() or Django's
+	// ConditionalGetMiddleware
+	etagMiddleware = regexp.MustCompile(`\betag\b|conditionalget|rack::etag`)
+
+	// etagSetup matches conditional GET support enabled outside a
+	// middleware registration line
+	etagSetup = regexp.MustCompile(`shallowetagheaderfilter|\.set\(\s*['"]etag['"]\s*,\s*(?:true|['"](?:weak|strong)['"])`)
+
+	// conditionalCheck matches handlers checking conditional request
+	// headers, directly or through framework helpers
+	conditionalCheck = regexp.MustCompile(`if[-_]?none[-_]?match|if[-_]?modified[-_]?since|fresh_when|\bstale\?|checknotmodified|` +
+		`evaluatepreconditions|make_conditional|\breq(?:uest)?\.fresh\b|@(?:etag|condition|last_modified)\(`)
+
+	// etagEvidence and lastModifiedEvidence match the validators a
+	// conditional handler uses
+	etagEvidence         = regexp.MustCompile(`etag|none[-_]?match|fresh|\bstale\?|checknotmodified|preconditions|make_conditional|@condition\(`)
+	lastModifiedEvidence = regexp.MustCompile(`modified`)
+)
+
+// MarkConditionalRequests sets Route.Validators on routes that answer
+// conditional requests: GET and HEAD routes covered by ETag or conditional
+// GET middleware, and routes whose definition or handler checks
+// If-None-Match or If-Modified-Since (or uses fresh_when, @etag,
+// checkNotModified, ...). Last-Modified is included when the handler refers
+// to it.
+func MarkConditionalRequests(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(strings.ToLower(string(f.Content)), "\n")
+	}
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+	declarations := indexDeclarations(sources)
+
+	// ETag middleware, by file ("" for every route)
+	covered := make(map[string]bool)
+	forEachRegistration(files, sources, routeLines, func(f scanner.SourceFile, scope, line string) {
+		if etagMiddleware.MatchString(line) {
+			covered[scope] = true
+		}
+	})
+	for _, f := range files {
+		for _, line := range sources[f.Path] {
+			if etagSetup.MatchString(line) {
+				covered[""] = true
+			}
+		}
+	}
+
+	for i := range routes {
+		route := &routes[i]
+		method := strings.ToUpper(route.Method)
+
+		checked, etag, lastModified := false, false, false
+		for _, lines := range routeSource(route, routeLines[route.SourceFile], sources, declarations) {
+			for _, line := range lines {
+				checked = checked || conditionalCheck.MatchString(line)
+				etag = etag || etagEvidence.MatchString(line)
+				lastModified = lastModified || lastModifiedEvidence.MatchString(line)
+			}
+		}
+
+		switch {
+		case checked:
+			route.Validators = nil
+			if etag || !lastModified {
+				route.Validators = append(route.Validators, types.ValidatorETag)
+			}
+			if lastModified {
+				route.Validators = append(route.Validators, types.ValidatorLastModified)
+			}
+		case (covered[""] || covered[route.SourceFile]) && (method == "GET" || method == "HEAD"):
+			route.Validators = []string{types.ValidatorETag}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkConditionalRequests_Middleware(t *testing.T) {
+	code := `func main() {
+	app := fiber.New()
+	app.Use(etag.New())
+	app.Get("/articles", listArticles)
+	app.Post("/articles", createArticle)
+}
+`
+	files := []scanner.SourceFile{{Path: "main.go", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/articles", SourceFile: "main.go", SourceLine: 4},
+		{Method: "POST", Path: "/articles", SourceFile: "main.go", SourceLine: 5},
+	}
+
+	MarkConditionalRequests(routes, files)
+
+	assert.Equal(t, []string{types.ValidatorETag}, routes[0].Validators)
+	assert.Nil(t, routes[1].Validators)
+}
+
+func TestMarkConditionalRequests_Handlers(t *testing.T) {
+	controller := `class ArticlesController < ApplicationController
+  def show
+    @article = Article.find(params[:id])
+    fresh_when etag: @article, last_modified: @article.updated_at
+  end
+
+  def index
+    @articles = Article.all
+  end
+end
+`
+	handlers := `func getFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("If-Modified-Since") != "" {
+		w.WriteHeader(http.StatusNotModified)
+	}
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "articles_controller.rb", Content: []byte(controller)},
+		{Path: "feed.go", Content: []byte(handlers)},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/articles/{id}", Handler: "ArticlesController#show", SourceFile: "routes.rb", SourceLine: 1},
+		{Method: "GET", Path: "/articles", Handler: "ArticlesController#index", SourceFile: "routes.rb", SourceLine: 2},
+		{Method: "GET", Path: "/feed", Handler: "getFeed", SourceFile: "main.go", SourceLine: 1},
+	}
+
+	MarkConditionalRequests(routes, files)
+
+	assert.Equal(t, []string{types.ValidatorETag, types.ValidatorLastModified}, routes[0].Validators)
+	assert.Nil(t, routes[1].Validators)
+	assert.Equal(t, []string{types.ValidatorLastModified}, routes[2].Validators)
+}
@@ -5,17 +5,21 @@
 package express
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -72,6 +76,11 @@ func (p *Plugin) Info() plugins.PluginInfo {
 	}
 }
 
+// decoratorFrameworks are the decorator and dependency injection controller
+// frameworks built on Express, whose projects are left to the
+// routingcontrollers plugin.
+var decoratorFrameworks = []string{"routing-controllers", "@tsed/common", "@tsed/platform-http", "@tsed/schema", "tsoa", "inversify-express-utils", "awilix-express"}
+
 // Detect checks if Express is used in the project by looking at package.json.
 func (p *Plugin) Detect(projectRoot string) (bool, error) {
 	packageJSONPath := filepath.Join(projectRoot, "package.json")
@@ -93,6 +102,17 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 		return false, fmt.Errorf("failed to parse package.json: %w", err)
 	}
 
+	// Decorator controller projects run on Express but are left to the
+	// routingcontrollers plugin
+	for _, dep := range decoratorFrameworks {
+		if _, ok := pkg.Dependencies[dep]; ok {
+			return false, nil
+		}
+		if _, ok := pkg.DevDependencies[dep]; ok {
+			return false, nil
+		}
+	}
+
 	// Check for express in dependencies or devDependencies
 	if _, ok := pkg.Dependencies["express"]; ok {
 		return true, nil
@@ -106,12 +126,23 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Express route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	// First pass: build a map of file paths to their mount paths
-	fileMountPaths := p.buildFileMountMap(files)
+	fileMountPaths, err := p.buildFileMountMap(ctx, files)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
@@ -119,8 +150,11 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		// Get the mount path for this file (if any)
 		mountPath := fileMountPaths[file.Path]
 
-		fileRoutes, err := p.extractRoutesFromFileWithMount(file, mountPath)
+		fileRoutes, err := p.extractRoutesFromFileWithMount(ctx, file, mountPath)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			// Log error but continue with other files
 			continue
 		}
@@ -128,6 +162,8 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		routes = append(routes, fileRoutes...)
 	}
 
+	applyQueryParser(routes, detectQueryParser(files))
+
 	return routes, nil
 }
 
@@ -142,17 +178,22 @@ type importInfo struct {
 // 1. Finding all import statements to track which files are imported as which variables
 // 2. Finding all router.use('/path', variable) calls to track mount paths
 // 3. Resolving the import paths to absolute paths
-func (p *Plugin) buildFileMountMap(files []scanner.SourceFile) map[string]string {
-	// Map from absolute file path to mount path
-	fileMountPaths := make(map[string]string)
+// 4. Composing the mount paths of files that are themselves mounted, so a
+// router mounted by a sub-app gets the sub-app's prefix as well
+func (p *Plugin) buildFileMountMap(ctx context.Context, files []scanner.SourceFile) (map[string]string, error) {
+	// Map from absolute file path to the file that mounts it
+	parents := make(map[string]fileMount)
 
 	// For each file, find imports and mounts
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -161,19 +202,19 @@ func (p *Plugin) buildFileMountMap(files []scanner.SourceFile) map[string]string
 		imports := p.findImports(pf.RootNode, file.Content)
 
 		// Find router variables
-		routers := p.findRouterVariables(pf.RootNode, file.Content)
+		routers := p.findRouterVariables(file.Path, pf.RootNode, file.Content)
 
-		// Find router.use() mounts
-		mounts := p.findRouterUseMounts(pf.RootNode, file.Content, routers)
+		// Find router.use() mounts, composed within the file
+		prefixes := resolveMountPrefixes(p.findRouterMounts(pf.RootNode, file.Content, routers))
 
 		// For each import that's mounted, resolve the file path
 		fileDir := filepath.Dir(file.Path)
 		for _, imp := range imports {
-			if mountPath, ok := mounts[imp.localName]; ok {
+			if prefix, ok := prefixes[imp.localName]; ok {
 				// Resolve the import path to an absolute path
 				resolvedPath := p.resolveImportPath(fileDir, imp.sourcePath)
 				if resolvedPath != "" {
-					fileMountPaths[resolvedPath] = mountPath
+					parents[resolvedPath] = fileMount{parent: file.Path, prefix: prefix}
 				}
 			}
 		}
@@ -181,19 +222,40 @@ func (p *Plugin) buildFileMountMap(files []scanner.SourceFile) map[string]string
 		pf.Close()
 	}
 
-	return fileMountPaths
+	fileMountPaths := make(map[string]string, len(parents))
+	for path := range parents {
+		fileMountPaths[path] = resolveFileMount(parents, path, 0)
+	}
+	return fileMountPaths, nil
+}
+
+// fileMount records where a file's router is mounted.
+type fileMount struct {
+	parent string // The absolute path of the file that mounts it
+	prefix string // The mount path within the parent file (e.g., "/api/v1")
+}
+
+// resolveFileMount returns the full mount path of a file by following the
+// files that mount it. Cycles stop after as many steps as there are files.
+func resolveFileMount(parents map[string]fileMount, path string, depth int) string {
+	mount, ok := parents[path]
+	if !ok || depth > len(parents) {
+		return ""
+	}
+	return joinMountPath(resolveFileMount(parents, mount.parent, depth+1), mount.prefix)
 }
 
-// findImports finds all import statements in a file.
+// findImports finds all import statements in a file, with one entry per
+// default, named or destructured binding.
 func (p *Plugin) findImports(rootNode *sitter.Node, content []byte) []importInfo {
 	var imports []importInfo
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
-		if node.Type() == "import_statement" {
-			imp := p.parseImportStatement(node, content)
-			if imp.localName != "" && imp.sourcePath != "" {
-				imports = append(imports, imp)
-			}
+		switch node.Type() {
+		case "import_statement":
+			imports = append(imports, p.parseImportStatement(node, content)...)
+		case "variable_declarator":
+			imports = append(imports, p.parseRequireDeclarator(node, content)...)
 		}
 		return true
 	})
@@ -201,81 +263,78 @@ func (p *Plugin) findImports(rootNode *sitter.Node, content []byte) []importInfo
 	return imports
 }
 
-// parseImportStatement parses an import statement to extract local name and source.
-func (p *Plugin) parseImportStatement(node *sitter.Node, content []byte) importInfo {
-	var info importInfo
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		child := node.Child(i)
-
-		// Get the source path from the string literal
-		if child.Type() == "string" {
-			info.sourcePath = strings.Trim(child.Content(content), `"'`)
-		}
-
-		// Get the default import name
-		if child.Type() == "import_clause" {
-			for j := 0; j < int(child.ChildCount()); j++ {
-				clauseChild := child.Child(j)
-				if clauseChild.Type() == "identifier" {
-					info.localName = clauseChild.Content(content)
+// parseRequireDeclarator parses `const name = require('./path')` and
+// `const { name, other: alias } = require('./path')`.
+func (p *Plugin) parseRequireDeclarator(node *sitter.Node, content []byte) []importInfo {
+	name := node.ChildByFieldName("name")
+	value := node.ChildByFieldName("value")
+	if name == nil || value == nil || value.Type() != "call_expression" {
+		return nil
+	}
+	callee := value.Child(0)
+	if callee == nil || callee.Content(content) != "require" {
+		return nil
+	}
+	args := p.tsParser.GetCallArguments(value, content)
+	if len(args) != 1 || args[0].Type() != "string" {
+		return nil
+	}
+	source := strings.Trim(args[0].Content(content), `"'`)
+
+	switch name.Type() {
+	case "identifier":
+		return []importInfo{{localName: name.Content(content), sourcePath: source}}
+	case "object_pattern":
+		var imports []importInfo
+		for i := 0; i < int(name.NamedChildCount()); i++ {
+			binding := name.NamedChild(i)
+			switch binding.Type() {
+			case "shorthand_property_identifier_pattern":
+				imports = append(imports, importInfo{localName: binding.Content(content), sourcePath: source})
+			case "pair_pattern":
+				if local := binding.ChildByFieldName("value"); local != nil && local.Type() == "identifier" {
+					imports = append(imports, importInfo{localName: local.Content(content), sourcePath: source})
 				}
 			}
 		}
+		return imports
 	}
-
-	return info
+	return nil
 }
 
-// findRouterUseMounts finds router.use('/path', routerVar) calls and returns a map of variable names to mount paths.
-func (p *Plugin) findRouterUseMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]string {
-	mounts := make(map[string]string)
-
-	calls := p.tsParser.FindCallExpressions(rootNode, content)
-
-	for _, call := range calls {
-		callee := call.Child(0)
-		if callee == nil || callee.Type() != "member_expression" {
-			continue
-		}
-
-		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
-		if method != "use" {
-			continue
-		}
+// parseImportStatement parses an import statement to extract the local
+// names of its default and named imports and their source.
+func (p *Plugin) parseImportStatement(node *sitter.Node, content []byte) []importInfo {
+	source := node.ChildByFieldName("source")
+	if source == nil {
+		return nil
+	}
+	sourcePath := strings.Trim(source.Content(content), `"'`)
 
-		// Check if the object is an Express app or router
-		if _, ok := routers[object]; !ok {
-			if object != "app" && object != "router" {
-				continue
+	var imports []importInfo
+	p.walkNodes(node, func(n *sitter.Node) bool {
+		switch n.Type() {
+		case "import_clause":
+			// Default import
+			for i := 0; i < int(n.ChildCount()); i++ {
+				if child := n.Child(i); child.Type() == "identifier" {
+					imports = append(imports, importInfo{localName: child.Content(content), sourcePath: sourcePath})
+				}
+			}
+		case "import_specifier":
+			// Named import, possibly renamed with as
+			name := n.ChildByFieldName("alias")
+			if name == nil {
+				name = n.ChildByFieldName("name")
+			}
+			if name != nil {
+				imports = append(imports, importInfo{localName: name.Content(content), sourcePath: sourcePath})
 			}
 		}
+		return true
+	})
 
-		args := p.tsParser.GetCallArguments(call, content)
-		if len(args) < 2 {
-			continue
-		}
-
-		// First arg should be the path prefix
-		pathArg := args[0]
-		path := ""
-		if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-			path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-		}
-
-		if path == "" {
-			continue
-		}
-
-		// Second arg should be the router variable
-		routerArg := args[1]
-		if routerArg.Type() == "identifier" {
-			routerName := routerArg.Content(content)
-			mounts[routerName] = path
-		}
-	}
-
-	return mounts
+	return imports
 }
 
 // resolveImportPath resolves a relative import path to an absolute file path.
@@ -318,8 +377,8 @@ func (p *Plugin) resolveImportPath(fromDir, importPath string) string {
 }
 
 // extractRoutesFromFileWithMount extracts routes from a file with an optional mount path prefix.
-func (p *Plugin) extractRoutesFromFileWithMount(file scanner.SourceFile, mountPath string) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFileWithMount(ctx context.Context, file scanner.SourceFile, mountPath string) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -327,6 +386,7 @@ func (p *Plugin) extractRoutesFromFileWithMount(file scanner.SourceFile, mountPa
 
 	// Check if this file imports or requires Express
 	if !p.hasExpressImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no Express import")
 		return nil, nil
 	}
 
@@ -339,22 +399,29 @@ func (p *Plugin) extractRoutesFromFileWithMount(file scanner.SourceFile, mountPa
 	}
 
 	// Track router/app variables and their base paths
-	routers := p.findRouterVariables(pf.RootNode, file.Content)
+	routers := p.findRouterVariables(file.Path, pf.RootNode, file.Content)
+
+	// Track router mounting within this file (app.use('/prefix', router)),
+	// including routers mounted on mounted routers
+	routerMounts := resolveMountPrefixes(p.findRouterMounts(pf.RootNode, file.Content, routers))
 
-	// Track router mounting within this file (app.use('/prefix', router))
-	routerMounts := p.findRouterMounts(pf.RootNode, file.Content, routers)
+	// Collect interfaces and typed handler declarations for generic resolution
+	typed := p.collectFileTypes(pf)
 
 	// Find all call expressions
 	calls := p.tsParser.FindCallExpressions(pf.RootNode, file.Content)
 
 	for _, call := range calls {
-		extractedRoutes := p.extractRoutesFromCallWithMount(call, file.Content, routers, routerMounts, zodSchemas, mountPath)
+		extractedRoutes := p.extractRoutesFromCallWithMount(call, file.Content, routers, routerMounts, zodSchemas, typed, mountPath)
 		for i := range extractedRoutes {
 			extractedRoutes[i].SourceFile = file.Path
 			routes = append(routes, extractedRoutes[i])
 		}
 	}
 
+	// Path parameters resolved by app.param() and router.param() callbacks
+	applyParamHandlers(routes, p.findParamHandlers(calls, file.Content, routers, typed))
+
 	return routes, nil
 }
 
@@ -365,6 +432,7 @@ func (p *Plugin) extractRoutesFromCallWithMount(
 	routers map[string]*routerInfo,
 	routerMounts map[string]string,
 	zodSchemas map[string]*sitter.Node,
+	typed *fileTypes,
 	fileMountPath string,
 ) []types.Route {
 	// Get the callee (function being called)
@@ -374,7 +442,7 @@ func (p *Plugin) extractRoutesFromCallWithMount(
 	}
 
 	// Check for route chaining: app.route('/path').get().post()
-	if chainedRoutes := p.extractRouteChainWithMount(node, content, routers, routerMounts, zodSchemas, fileMountPath); len(chainedRoutes) > 0 {
+	if chainedRoutes := p.extractRouteChainWithMount(node, content, routers, routerMounts, zodSchemas, typed, fileMountPath); len(chainedRoutes) > 0 {
 		return chainedRoutes
 	}
 
@@ -407,22 +475,17 @@ func (p *Plugin) extractRoutesFromCallWithMount(
 		return nil
 	}
 
-	// First argument should be the path
-	pathArg := args[0]
-	path := ""
-	if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-		path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-	}
-
+	// First argument should be the path, possibly built from constants
+	path, unresolved, _ := p.tsParser.ResolveStringExpression(args[0], content)
 	if path == "" {
 		return nil
 	}
 
 	// Combine: fileMountPath + inFilePrefix + path
-	fullPath := combinePaths(fileMountPath, combinePaths(inFilePrefix, path))
+	expressPath := combinePaths(fileMountPath, combinePaths(inFilePrefix, path))
 
 	// Convert Express path parameters (:param) to OpenAPI format ({param})
-	fullPath = convertPathParams(fullPath)
+	fullPath := convertPathParams(expressPath)
 
 	// Extract path parameters
 	params := extractPathParams(fullPath)
@@ -460,10 +523,26 @@ func (p *Plugin) extractRoutesFromCallWithMount(
 		Tags:        tags,
 		Parameters:  params,
 		RequestBody: requestBody,
+		Mount:       convertPathParams(combinePaths(fileMountPath, inFilePrefix)),
 		SourceLine:  int(node.StartPoint().Row) + 1,
 	}
+	if len(unresolved) > 0 {
+		route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+	}
+
+	// The last argument is the handler; resolve its Request/Response generics
+	if len(args) > 1 {
+		if generics := p.handlerGenerics(args[len(args)-1], content, typed); generics != nil {
+			p.applyHandlerGenerics(&route, generics, content, typed)
+		}
+		fn := handlerFunction(args[len(args)-1], content, typed)
+		if fn != nil {
+			applyHandlerResponse(&route, p.inspectResponse(fn, content))
+		}
+		p.addQueryParams(&route, args[1:len(args)-1], fn, content)
+	}
 
-	return []types.Route{route}
+	return pathVariants(route, expressPath)
 }
 
 // extractRouteChainWithMount handles app.route('/path').get().post() patterns with mount path support.
@@ -473,6 +552,7 @@ func (p *Plugin) extractRouteChainWithMount(
 	_ map[string]*routerInfo,
 	routerMounts map[string]string,
 	_ map[string]*sitter.Node,
+	typed *fileTypes,
 	fileMountPath string,
 ) []types.Route {
 	// Build the chain of method calls
@@ -483,13 +563,14 @@ func (p *Plugin) extractRouteChainWithMount(
 
 	// Find the base route() call
 	var basePath string
+	var unresolved []string
 	var baseRouterName string
 	var routeCallFound bool
 
 	for i := len(chain) - 1; i >= 0; i-- {
 		item := chain[i]
 		if item.method == "route" && len(item.args) > 0 {
-			basePath, _ = p.tsParser.ExtractStringLiteral(item.args[0], content)
+			basePath, unresolved, _ = p.tsParser.ResolveStringExpression(item.args[0], content)
 			baseRouterName = item.object
 			routeCallFound = true
 			break
@@ -500,6 +581,22 @@ func (p *Plugin) extractRouteChainWithMount(
 		return nil
 	}
 
+	// The outermost call of the chain extracts every method in it
+	if parent := node.Parent(); parent != nil && parent.Type() == "member_expression" {
+		if grandparent := parent.Parent(); grandparent != nil && grandparent.Type() == "call_expression" {
+			return nil
+		}
+	}
+
+	// all() alongside method handlers is middleware run before them; on its
+	// own it handles every method
+	verbs := 0
+	for _, item := range chain {
+		if httpMethod, isHTTP := httpMethods[strings.ToLower(item.method)]; isHTTP && httpMethod != "ALL" {
+			verbs++
+		}
+	}
+
 	// Apply in-file prefix if router is mounted within this file
 	inFilePrefix := ""
 	if mount, ok := routerMounts[baseRouterName]; ok {
@@ -507,16 +604,21 @@ func (p *Plugin) extractRouteChainWithMount(
 	}
 
 	// Combine: fileMountPath + inFilePrefix + basePath
-	fullPath := combinePaths(fileMountPath, combinePaths(inFilePrefix, basePath))
-	fullPath = convertPathParams(fullPath)
+	expressPath := combinePaths(fileMountPath, combinePaths(inFilePrefix, basePath))
+	fullPath := convertPathParams(expressPath)
 	params := extractPathParams(fullPath)
 	tags := inferTags(fullPath)
 
 	var routes []types.Route
 
+	mount := convertPathParams(combinePaths(fileMountPath, inFilePrefix))
+
 	// Extract HTTP method calls from the chain
 	for _, item := range chain {
 		if httpMethod, isHTTP := httpMethods[strings.ToLower(item.method)]; isHTTP {
+			if httpMethod == "ALL" && verbs > 0 {
+				continue
+			}
 			operationID := generateOperationID(httpMethod, fullPath, "")
 			route := types.Route{
 				Method:      httpMethod,
@@ -524,9 +626,20 @@ func (p *Plugin) extractRouteChainWithMount(
 				OperationID: operationID,
 				Tags:        tags,
 				Parameters:  params,
+				Mount:       mount,
 				SourceLine:  int(node.StartPoint().Row) + 1,
 			}
-			routes = append(routes, route)
+			if len(unresolved) > 0 {
+				route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+			}
+			if len(item.args) > 0 {
+				fn := handlerFunction(item.args[len(item.args)-1], content, typed)
+				if fn != nil {
+					applyHandlerResponse(&route, p.inspectResponse(fn, content))
+				}
+				p.addQueryParams(&route, item.args[:len(item.args)-1], fn, content)
+			}
+			routes = append(routes, pathVariants(route, expressPath)...)
 		}
 	}
 
@@ -584,7 +697,7 @@ func (p *Plugin) hasExpressImport(rootNode *sitter.Node, content []byte) bool {
 }
 
 // findRouterVariables finds variables that are Express app or Router instances.
-func (p *Plugin) findRouterVariables(rootNode *sitter.Node, content []byte) map[string]*routerInfo {
+func (p *Plugin) findRouterVariables(path string, rootNode *sitter.Node, content []byte) map[string]*routerInfo {
 	routers := make(map[string]*routerInfo)
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
@@ -632,14 +745,22 @@ func (p *Plugin) findRouterVariables(rootNode *sitter.Node, content []byte) map[
 	// Default to "app" if no routers found
 	if len(routers) == 0 {
 		routers["app"] = &routerInfo{name: "app"}
+		decisions.Assume(p.Name(), path, "no express() or Router() variable found; assumed routes are registered on app")
 	}
 
 	return routers
 }
 
-// findRouterMounts finds app.use('/prefix', router) calls to track path prefixes.
-func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]string {
-	mounts := make(map[string]string)
+// mountInfo records that a router variable is mounted on another.
+type mountInfo struct {
+	parent string // The app or router it is mounted on (e.g., "app")
+	path   string // The mount path (e.g., "/api"), empty for app.use(router)
+}
+
+// findRouterMounts finds app.use('/prefix', router) and app.use(router) calls
+// and returns the parent and mount path of each mounted variable.
+func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]mountInfo {
+	mounts := make(map[string]mountInfo)
 
 	calls := p.tsParser.FindCallExpressions(rootNode, content)
 
@@ -656,39 +777,59 @@ func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers
 
 		// Check if the object is an Express app or router
 		if _, ok := routers[object]; !ok {
-			// Also check if it's a known app variable
-			if object != "app" {
+			// Also check if it's a conventional app or router name
+			if object != "app" && object != "router" {
 				continue
 			}
 		}
 
 		args := p.tsParser.GetCallArguments(call, content)
-		if len(args) < 2 {
+		if len(args) == 0 {
 			continue
 		}
 
-		// First arg should be the path prefix
-		pathArg := args[0]
-		path := ""
-		if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-			path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-		}
-
-		if path == "" {
-			continue
+		// First arg may be the path prefix
+		path, _, isPath := p.tsParser.ResolveStringExpression(args[0], content)
+		if isPath {
+			if path == "" || len(args) < 2 {
+				continue
+			}
+			args = args[1:]
 		}
 
-		// Second arg should be the router variable
-		routerArg := args[1]
+		// Next arg should be the router variable
+		routerArg := args[0]
 		if routerArg.Type() == "identifier" {
 			routerName := routerArg.Content(content)
-			mounts[routerName] = path
+			if routerName != object {
+				mounts[routerName] = mountInfo{parent: object, path: path}
+			}
 		}
 	}
 
 	return mounts
 }
 
+// resolveMountPrefixes composes mount paths transitively, so that with
+// api.use('/v1', v1) and app.use('/api', api) the prefix of v1 is /api/v1.
+func resolveMountPrefixes(mounts map[string]mountInfo) map[string]string {
+	prefixes := make(map[string]string, len(mounts))
+	for name := range mounts {
+		prefixes[name] = resolveMountPrefix(mounts, name, 0)
+	}
+	return prefixes
+}
+
+// resolveMountPrefix returns the full prefix of a mounted variable. Cycles
+// stop after as many steps as there are mounts.
+func resolveMountPrefix(mounts map[string]mountInfo, name string, depth int) string {
+	mount, ok := mounts[name]
+	if !ok || depth > len(mounts) {
+		return ""
+	}
+	return joinMountPath(resolveMountPrefix(mounts, mount.parent, depth+1), mount.path)
+}
+
 // methodCall represents a method call in a chain.
 type methodCall struct {
 	object string
@@ -902,31 +1043,548 @@ func (p *Plugin) extractZodValidatorSchema(
 
 // walkNodes walks all nodes in the tree.
 func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
+	parser.Walk(node, fn)
+}
+
+// headerSetters lists the Response methods that set a header by name.
+var headerSetters = map[string]bool{
+	"setHeader": true,
+	"set":       true,
+	"header":    true,
+	"append":    true,
+}
+
+// handlerFunction resolves a route handler argument to a function node,
+// following identifiers to functions declared in the same file.
+func handlerFunction(node *sitter.Node, content []byte, typed *fileTypes) *sitter.Node {
+	if isFunctionNode(node) {
+		return node
+	}
+	if node.Type() == "identifier" && typed != nil {
+		return typed.functions[node.Content(content)]
+	}
+	return nil
+}
+
+// isFunctionNode reports whether a node is a function expression or declaration.
+func isFunctionNode(node *sitter.Node) bool {
+	switch node.Type() {
+	case "arrow_function", "function_expression", "function", "function_declaration":
+		return true
+	}
+	return false
+}
+
+// handlerResponse summarizes what a handler does with its response object.
+type handlerResponse struct {
+	// headers are the names of headers set on the response
+	headers []string
+
+	// created reports whether the handler responds with 201 Created
+	created bool
+
+	// statuses are the status codes passed to res.status() and res.sendStatus()
+	statuses []int
+
+	// redirects are the status codes of res.redirect() calls
+	redirects []int
+
+	// contentType is the Content-Type the handler sets explicitly
+	contentType string
+
+	// streams reports whether the handler writes the body in chunks via res.write()
+	streams bool
+}
+
+// add records a response header, keeping Content-Type apart since OpenAPI
+// describes it through the response content instead.
+func (hr *handlerResponse) add(name, value string) {
+	if strings.EqualFold(name, "Content-Type") {
+		if value != "" {
+			hr.contentType = value
+		}
+		return
+	}
+	hr.headers = append(hr.headers, name)
+}
+
+// inspectResponse finds headers a handler sets on its response object via
+// res.setHeader(), res.set(), res.header(), res.append(), res.writeHead(),
+// and res.location(), whether it responds with 201 Created, the redirects it
+// issues, and whether it streams its body.
+func (p *Plugin) inspectResponse(fn *sitter.Node, content []byte) *handlerResponse {
+	resName := "res"
+	if paramsNode := fn.ChildByFieldName("parameters"); paramsNode != nil && paramsNode.NamedChildCount() > 1 {
+		param := paramsNode.NamedChild(1)
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			param = pattern
+		}
+		if param.Type() == "identifier" {
+			resName = param.Content(content)
+		}
+	}
+
+	hr := &handlerResponse{}
+
+	p.walkNodes(fn.ChildByFieldName("body"), func(n *sitter.Node) bool {
+		if n.Type() != "call_expression" {
+			return true
+		}
+		callee := n.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			return true
+		}
+		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		if object != resName && !strings.HasPrefix(object, resName+".") {
+			return true
+		}
+
+		args := p.tsParser.GetCallArguments(n, content)
+		switch {
+		case headerSetters[method] && len(args) > 0:
+			if name, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+				value := ""
+				if len(args) > 1 {
+					value, _ = p.tsParser.ExtractStringLiteral(args[1], content)
+				}
+				hr.add(name, value)
+			} else {
+				p.addHeaderObject(hr, args[0], content)
+			}
+		case method == "writeHead":
+			for _, arg := range args {
+				p.addHeaderObject(hr, arg, content)
+			}
+		case method == "write":
+			hr.streams = true
+		case method == "location":
+			hr.headers = append(hr.headers, "Location")
+		case (method == "status" || method == "sendStatus") && len(args) > 0:
+			if code, err := strconv.Atoi(args[0].Content(content)); err == nil {
+				hr.statuses = append(hr.statuses, code)
+				if code == http.StatusCreated {
+					hr.created = true
+				}
+			}
+		case method == "redirect":
+			// res.redirect([status,] path) defaults to 302 Found
+			status := http.StatusFound
+			if len(args) > 1 && args[0].Type() == "number" {
+				if code, err := strconv.Atoi(args[0].Content(content)); err == nil {
+					status = code
+				}
+			}
+			hr.redirects = append(hr.redirects, status)
+		}
+		return true
+	})
+
+	return hr
+}
+
+// addHeaderObject records the headers of an object literal such as
+// { 'Content-Type': 'text/event-stream', 'Cache-Control': 'no-cache' }.
+func (p *Plugin) addHeaderObject(hr *handlerResponse, node *sitter.Node, content []byte) {
+	if node.Type() != "object" {
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		pair := node.NamedChild(i)
+		key := pair.ChildByFieldName("key")
+		if pair.Type() != "pair" || key == nil {
+			continue
+		}
+		value := ""
+		if v := pair.ChildByFieldName("value"); v != nil {
+			value, _ = p.tsParser.ExtractStringLiteral(v, content)
+		}
+		hr.add(strings.Trim(key.Content(content), `"'`), value)
+	}
+}
+
+// applyHandlerResponse documents every status the handler responds with,
+// puts headers on the route's success response (201 when the handler
+// responds with Created, otherwise 200), and adds a response with a
+// Location header for each redirect. Server-Sent Events
+// and chunked responses are documented with their streaming content type.
+func applyHandlerResponse(route *types.Route, hr *handlerResponse) {
+	sse := hr.contentType == "text/event-stream"
+	if len(hr.headers) == 0 && len(hr.redirects) == 0 && len(hr.statuses) == 0 && !sse && !hr.streams {
 		return
 	}
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+
+	if sse || hr.streams {
+		mediaType := hr.contentType
+		if mediaType == "" {
+			mediaType = "application/octet-stream"
+		}
+		applyStreaming(route, mediaType)
+	}
+
+	for _, code := range hr.redirects {
+		status := strconv.Itoa(code)
+		if _, ok := route.Responses[status]; ok {
+			continue
+		}
+		route.Responses[status] = types.Response{
+			Description: http.StatusText(code),
+			Headers: map[string]types.Header{
+				"Location": {
+					Description: "Redirect target",
+					Schema:      &types.Schema{Type: "string"},
+				},
+			},
+		}
+	}
+
+	plugins.ApplyStatuses(route, hr.statuses)
 
-	if !fn(node) {
+	if len(hr.headers) == 0 {
 		return
 	}
 
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
+	status := strconv.Itoa(plugins.SuccessStatus(hr.statuses))
+	if hr.created {
+		status = "201"
+	}
+
+	resp, ok := route.Responses[status]
+	if !ok {
+		resp = types.Response{Description: "Successful response"}
+	}
+	if resp.Headers == nil {
+		resp.Headers = make(map[string]types.Header)
+	}
+	for _, name := range hr.headers {
+		header := types.Header{Schema: &types.Schema{Type: "string"}}
+		if strings.EqualFold(name, "Location") && hr.created {
+			header.Description = "URL of the created resource"
+		}
+		resp.Headers[name] = header
+	}
+	route.Responses[status] = resp
+}
+
+// applyStreaming documents the success response as a stream of the given
+// media type, marking Server-Sent Events endpoints with x-sse.
+func applyStreaming(route *types.Route, mediaType string) {
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	resp, ok := route.Responses["200"]
+	if !ok {
+		resp = types.Response{Description: "Successful response"}
+	}
+	resp.Content = map[string]types.MediaType{
+		mediaType: {Schema: &types.Schema{Type: "string"}},
+	}
+	route.Responses["200"] = resp
+
+	if mediaType == "text/event-stream" {
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions["x-sse"] = true
+	}
+}
+
+// typedHandler holds the generic type arguments of a typed Express handler,
+// following the positions of RequestHandler<Params, ResBody, ReqBody, Query>.
+type typedHandler struct {
+	params  *sitter.Node
+	resBody *sitter.Node
+	reqBody *sitter.Node
+	query   *sitter.Node
+}
+
+// fileTypes holds type information collected from a single source file.
+type fileTypes struct {
+	// interfaces maps interface names to their definitions
+	interfaces map[string]parser.TSInterface
+
+	// handlers maps named handler functions and variables to their generics
+	handlers map[string]*typedHandler
+
+	// functions maps named functions and function-valued variables to their nodes
+	functions map[string]*sitter.Node
+}
+
+// collectFileTypes gathers interfaces and typed handler declarations
+// (`const h: RequestHandler<...> = ...`, `function h(req: Request<...>)`).
+func (p *Plugin) collectFileTypes(pf *parser.ParsedTSFile) *fileTypes {
+	typed := &fileTypes{
+		interfaces: make(map[string]parser.TSInterface),
+		handlers:   make(map[string]*typedHandler),
+		functions:  make(map[string]*sitter.Node),
+	}
+
+	for _, iface := range pf.Interfaces {
+		typed.interfaces[iface.Name] = iface
+	}
+
+	p.walkNodes(pf.RootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "variable_declarator":
+			nameNode := node.ChildByFieldName("name")
+			if nameNode == nil || nameNode.Type() != "identifier" {
+				return true
+			}
+			name := nameNode.Content(pf.Content)
+			if value := node.ChildByFieldName("value"); value != nil && isFunctionNode(value) {
+				typed.functions[name] = value
+			}
+			if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+				if generic := annotationGeneric(typeNode); generic != nil && genericName(generic, pf.Content) == "RequestHandler" {
+					typed.handlers[name] = genericPositions(generic)
+					return true
+				}
+			}
+			if value := node.ChildByFieldName("value"); value != nil {
+				if h := p.functionGenerics(value, pf.Content); h != nil {
+					typed.handlers[name] = h
+				}
+			}
+		case "function_declaration":
+			nameNode := node.ChildByFieldName("name")
+			if nameNode == nil {
+				return true
+			}
+			typed.functions[nameNode.Content(pf.Content)] = node
+			if h := p.functionGenerics(node, pf.Content); h != nil {
+				typed.handlers[nameNode.Content(pf.Content)] = h
+			}
+		}
+		return true
+	})
+
+	return typed
+}
+
+// handlerGenerics resolves the generics of a route handler argument, which is
+// either an inline function or an identifier referring to a typed declaration.
+func (p *Plugin) handlerGenerics(node *sitter.Node, content []byte, typed *fileTypes) *typedHandler {
+	switch node.Type() {
+	case "arrow_function", "function_expression", "function":
+		return p.functionGenerics(node, content)
+	case "identifier":
+		if typed != nil {
+			return typed.handlers[node.Content(content)]
+		}
+	}
+	return nil
+}
+
+// functionGenerics reads Request<P, R, B, Q> and Response<R> annotations from
+// the first two parameters of a function node.
+func (p *Plugin) functionGenerics(node *sitter.Node, content []byte) *typedHandler {
+	paramsNode := node.ChildByFieldName("parameters")
+	if paramsNode == nil {
+		return nil
+	}
+
+	var params []*sitter.Node
+	for i := 0; i < int(paramsNode.NamedChildCount()); i++ {
+		params = append(params, paramsNode.NamedChild(i))
+	}
+
+	var handler *typedHandler
+	if len(params) > 0 {
+		if generic := annotationGeneric(params[0].ChildByFieldName("type")); generic != nil && genericName(generic, content) == "Request" {
+			handler = genericPositions(generic)
+		}
+	}
+	if len(params) > 1 {
+		if generic := annotationGeneric(params[1].ChildByFieldName("type")); generic != nil && genericName(generic, content) == "Response" {
+			if handler == nil {
+				handler = &typedHandler{}
+			}
+			if args := genericArguments(generic); len(args) > 0 && handler.resBody == nil {
+				handler.resBody = args[0]
+			}
+		}
+	}
+
+	return handler
+}
+
+// applyHandlerGenerics maps resolved generic positions onto a route: path
+// parameter types, response body, request body, and query parameters.
+func (p *Plugin) applyHandlerGenerics(route *types.Route, h *typedHandler, content []byte, typed *fileTypes) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	// Params: refine the types of path parameters already taken from the path
+	for _, prop := range p.typeProperties(h.params, content, typed) {
+		for i := range route.Parameters {
+			if route.Parameters[i].In == "path" && route.Parameters[i].Name == prop.Name {
+				route.Parameters[i].Schema = tsExtractor.TypeToSchema(prop.Type)
+			}
+		}
+	}
+
+	// ResBody: document the successful response payload
+	if bodySchema := genericSchema(h.resBody, content, tsExtractor); bodySchema != nil {
+		route.Responses = map[string]types.Response{
+			"200": {
+				Description: "Successful response",
+				Content: map[string]types.MediaType{
+					"application/json": {Schema: bodySchema},
+				},
+			},
+		}
+	}
+
+	// ReqBody: validation middleware takes precedence over the static type
+	if route.RequestBody == nil {
+		if bodySchema := genericSchema(h.reqBody, content, tsExtractor); bodySchema != nil {
+			route.RequestBody = &types.RequestBody{
+				Required: true,
+				Content: map[string]types.MediaType{
+					"application/json": {Schema: bodySchema},
+				},
+			}
+		}
+	}
+
+	// Query: each property becomes a query parameter
+	for _, prop := range p.typeProperties(h.query, content, typed) {
+		route.Parameters = append(route.Parameters, types.Parameter{
+			Name:     prop.Name,
+			In:       "query",
+			Required: !prop.IsOptional,
+			Schema:   tsExtractor.TypeToSchema(prop.Type),
+		})
 	}
 }
 
-// ExtractSchemas extracts schema definitions from Zod schemas in TypeScript files.
+// typeProperties returns the properties of an inline object type or of a
+// named interface declared in the same file.
+func (p *Plugin) typeProperties(node *sitter.Node, content []byte, typed *fileTypes) []parser.TSProperty {
+	if node == nil {
+		return nil
+	}
+	switch node.Type() {
+	case "object_type":
+		return p.tsParser.ExtractObjectTypeProperties(node, content)
+	case "type_identifier":
+		if typed != nil {
+			if iface, ok := typed.interfaces[node.Content(content)]; ok {
+				return iface.Properties
+			}
+		}
+	}
+	return nil
+}
+
+// genericSchema converts a generic type argument to a schema, ignoring
+// placeholder types such as any, unknown, never, and empty object literals.
+func genericSchema(node *sitter.Node, content []byte, tsExtractor *schema.TypeScriptSchemaExtractor) *types.Schema {
+	if node == nil {
+		return nil
+	}
+	text := strings.TrimSpace(node.Content(content))
+	switch text {
+	case "", "any", "unknown", "never", "void", "undefined", "{}", "object":
+		return nil
+	}
+	if strings.HasPrefix(text, "Record<") {
+		return &types.Schema{Type: "object"}
+	}
+	return tsExtractor.TypeToSchema(text)
+}
+
+// annotationGeneric returns the generic_type inside a type_annotation node.
+func annotationGeneric(node *sitter.Node) *sitter.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Type() == "type_annotation" && node.NamedChildCount() > 0 {
+		node = node.NamedChild(0)
+	}
+	if node.Type() != "generic_type" {
+		return nil
+	}
+	return node
+}
+
+// genericName returns the unqualified name of a generic type
+// (express.Request -> Request).
+func genericName(node *sitter.Node, content []byte) string {
+	nameNode := node.ChildByFieldName("name")
+	if nameNode == nil {
+		return ""
+	}
+	name := nameNode.Content(content)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// genericArguments returns the type argument nodes of a generic type.
+func genericArguments(node *sitter.Node) []*sitter.Node {
+	argsNode := node.ChildByFieldName("type_arguments")
+	if argsNode == nil {
+		return nil
+	}
+	var args []*sitter.Node
+	for i := 0; i < int(argsNode.NamedChildCount()); i++ {
+		args = append(args, argsNode.NamedChild(i))
+	}
+	return args
+}
+
+// genericPositions maps <Params, ResBody, ReqBody, Query> arguments by position.
+func genericPositions(node *sitter.Node) *typedHandler {
+	args := genericArguments(node)
+	h := &typedHandler{}
+	if len(args) > 0 {
+		h.params = args[0]
+	}
+	if len(args) > 1 {
+		h.resBody = args[1]
+	}
+	if len(args) > 2 {
+		h.reqBody = args[2]
+	}
+	if len(args) > 3 {
+		h.query = args[3]
+	}
+	return h
+}
+
+// ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
 	for _, file := range files {
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			continue
 		}
 
+		// Extract TypeScript interfaces (referenced by typed handler generics)
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+
 		// Extract and register Zod schemas
 		for _, zs := range pf.ZodSchemas {
 			p.zodParser.ExtractAndRegister(zs.Name, zs.Node, file.Content)
@@ -935,7 +1593,10 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		pf.Close()
 	}
 
-	return p.zodParser.Registry().ToSlice(), nil
+	// Merge Zod schemas into the registry
+	tsExtractor.Registry().Merge(p.zodParser.Registry())
+
+	return tsExtractor.Registry().ToSlice(), nil
 }
 
 // --- Helper Functions ---
@@ -945,17 +1606,140 @@ var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)(?:\([^)]*\))
 var wildcardRegex = regexp.MustCompile(`\*`)
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
+// namedWildcardRegex matches Express 5 named wildcards such as *splat.
+var namedWildcardRegex = regexp.MustCompile(`\*([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// placeholderRegex matches a {name} placeholder left for an unresolved
+// path expression.
+var placeholderRegex = regexp.MustCompile(`^\{\w+\}$`)
+
+// optionalParamRegex matches Express 4 optional parameters such as /:id?.
+var optionalParamRegex = regexp.MustCompile(`/:([a-zA-Z_][a-zA-Z0-9_]*)(\([^)]*\))?\?`)
+
 // convertPathParams converts Express-style path params (:id, *) to OpenAPI format ({id}, {path}).
+// Express 5 named wildcards (*name) become {name}, and optional groups are
+// converted with their contents present.
 func convertPathParams(path string) string {
+	variants := expandOptionalGroups(path)
+	path = variants[len(variants)-1]
+
 	// Convert :param and :param(regex) to {param}
 	result := colonParamRegex.ReplaceAllString(path, "{$1}")
 
-	// Convert * to {path} (wildcard)
+	// Convert *name and * to {name} and {path} (wildcard)
+	result = namedWildcardRegex.ReplaceAllString(result, "{$1}")
 	result = wildcardRegex.ReplaceAllString(result, "{path}")
 
 	return result
 }
 
+// expandOptionalGroups returns the paths an Express path matches with each
+// optional group, {/:id} in Express 5 or /:id? in Express 4, absent and
+// present. The last path has every group present.
+func expandOptionalGroups(path string) []string {
+	path = optionalParamRegex.ReplaceAllString(path, "{/:$1$2}")
+	variants := expandGroups(path)
+	if len(variants) == 1 {
+		return variants
+	}
+
+	for i, variant := range variants {
+		for strings.Contains(variant, "//") {
+			variant = strings.ReplaceAll(variant, "//", "/")
+		}
+		if len(variant) > 1 {
+			variant = strings.TrimSuffix(variant, "/")
+		}
+		if variant == "" {
+			variant = "/"
+		}
+		variants[i] = variant
+	}
+	return variants
+}
+
+// expandGroups expands the first optional group of a path recursively.
+// Braces inside a parameter's regex, as in :year(\d{4}), are not groups,
+// nor are {name} placeholders of unresolved path expressions.
+func expandGroups(path string) []string {
+	open, closing := -1, -1
+	depth, parens := 0, 0
+	for i := 0; i < len(path) && closing == -1; i++ {
+		switch path[i] {
+		case '(':
+			parens++
+		case ')':
+			parens--
+		case '{':
+			if parens == 0 {
+				if depth == 0 {
+					open = i
+				}
+				depth++
+			}
+		case '}':
+			if parens == 0 && depth > 0 {
+				depth--
+				if depth == 0 && !placeholderRegex.MatchString(path[open:i+1]) {
+					closing = i
+				}
+			}
+		}
+	}
+	if closing == -1 {
+		return []string{path}
+	}
+
+	without := path[:open] + path[closing+1:]
+	with := path[:open] + path[open+1:closing] + path[closing+1:]
+	return append(expandGroups(without), expandGroups(with)...)
+}
+
+// pathVariants returns a copy of route for each path its Express path
+// matches with optional groups absent or present. The route is built for
+// the path with every group present, so each copy keeps the parameters
+// its own path declares. Routes with a catch-all are marked x-wildcard.
+func pathVariants(route types.Route, expressPath string) []types.Route {
+	if plugins.IsCatchAll(expressPath) {
+		plugins.MarkWildcard(&route)
+	}
+
+	variants := expandOptionalGroups(expressPath)
+	if len(variants) == 1 {
+		setMountNames(&route)
+		return []types.Route{route}
+	}
+
+	defaultID := generateOperationID(route.Method, route.Path, "")
+	routes := make([]types.Route, 0, len(variants))
+	for _, variant := range variants {
+		path := convertPathParams(variant)
+		r := route
+		r.Path = path
+		r.Tags = inferTags(path)
+		if route.OperationID == defaultID {
+			r.OperationID = generateOperationID(route.Method, path, "")
+		}
+		r.Parameters = nil
+		for _, param := range route.Parameters {
+			if param.In != "path" || strings.Contains(path, "{"+param.Name+"}") {
+				r.Parameters = append(r.Parameters, param)
+			}
+		}
+		setMountNames(&r)
+		routes = append(routes, r)
+	}
+	return routes
+}
+
+// setMountNames records the operationId and tags of a route on a mounted
+// router as if the router were not mounted.
+func setMountNames(route *types.Route) {
+	plugins.SetMountNames(route, func(method, path string) string {
+		return generateOperationID(method, path, "")
+	}, inferTags)
+}
+
 // extractPathParams extracts path parameters from a route path.
 func extractPathParams(path string) []types.Parameter {
 	var params []types.Parameter
@@ -998,6 +1782,15 @@ func combinePaths(prefix, path string) string {
 	return prefix + path
 }
 
+// joinMountPath appends a mount path to a prefix. Unlike combinePaths, an
+// empty or root mount path leaves the prefix unchanged.
+func joinMountPath(prefix, path string) string {
+	if path == "" || path == "/" {
+		return prefix
+	}
+	return combinePaths(prefix, path)
+}
+
 // generateOperationID generates an operation ID from method and path.
 func generateOperationID(method, path, handler string) string {
 	// If we have a handler name, use it
@@ -158,6 +158,86 @@ app.all('/secret', (req, res) => res.text('secret'))
 module.exports = app
 `
 
+// expressResponseHeadersCode tests response header detection in handlers.
+const expressResponseHeadersCode = `
+const express = require('express')
+const app = express()
+
+app.get('/reports', (req, response) => {
+  response.setHeader('X-Total-Count', '42')
+  response.set({ 'Cache-Control': 'no-store', ETag: 'abc' })
+  response.json([])
+})
+
+function createReport(req, res) {
+  res.status(201).location('/reports/1').json({})
+}
+
+app.post('/reports', createReport)
+
+app.route('/reports/:id')
+  .get((req, res) => {
+    res.header('X-Report-Version', '2')
+    res.json({})
+  })
+  .delete((req, res) => res.sendStatus(204))
+`
+
+// expressRedirectCode tests redirect detection in handlers.
+const expressRedirectCode = `
+const express = require('express')
+const app = express()
+
+app.get('/old-docs', (req, res) => res.redirect(301, '/docs'))
+
+app.post('/login', (req, res) => {
+  res.redirect('/dashboard')
+})
+`
+
+// expressStreamingCode tests Server-Sent Events and chunked responses.
+const expressStreamingCode = `
+const express = require('express')
+const app = express()
+
+app.get('/events', (req, res) => {
+  res.writeHead(200, {
+    'Content-Type': 'text/event-stream',
+    'Cache-Control': 'no-cache',
+  })
+  const timer = setInterval(() => res.write('data: ping\n\n'), 1000)
+  req.on('close', () => clearInterval(timer))
+})
+
+app.get('/export', (req, res) => {
+  res.setHeader('Content-Type', 'text/csv')
+  for (const row of rows) {
+    res.write(row)
+  }
+  res.end()
+})
+`
+
+// expressMultiStatusCode tests status inference across handler branches.
+const expressMultiStatusCode = `
+const express = require('express')
+const app = express()
+
+app.get('/users/:id', (req, res) => {
+  const user = users.find(req.params.id)
+  if (!user) {
+    return res.status(404).json({ error: 'not found' })
+  }
+  res.json(user)
+})
+
+app.delete('/users/:id', (req, res) => {
+  if (!req.user) return res.sendStatus(401)
+  users.remove(req.params.id)
+  res.status(204).end()
+})
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "express", p.Name())
@@ -219,6 +299,24 @@ func TestPlugin_Detect_WithExpressDevDep(t *testing.T) {
 	assert.True(t, detected)
 }
 
+func TestPlugin_Detect_WithRoutingControllers(t *testing.T) {
+	dir := t.TempDir()
+	packageJSON := `{
+  "name": "test-app",
+  "dependencies": {
+    "express": "^4.18.0",
+    "routing-controllers": "^0.10.0"
+  }
+}`
+	err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(packageJSON), 0644)
+	require.NoError(t, err)
+
+	p := New()
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
 func TestPlugin_Detect_WithoutExpress(t *testing.T) {
 	dir := t.TempDir()
 	packageJSON := `{
@@ -347,6 +445,176 @@ func TestPlugin_ExtractRoutes_RouterMounting(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_NestedRouterMounting(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content: []byte(`
+const express = require('express')
+const app = express()
+const api = express.Router()
+const v1 = express.Router()
+const v2 = express.Router()
+
+v1.get('/users', (req, res) => res.json([]))
+v2.get('/users', (req, res) => res.json([]))
+
+api.use('/v1', v1)
+api.use(v2)
+app.use('/api', api)
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	v1Users := findRoute(routes, "GET", "/api/v1/users")
+	require.NotNil(t, v1Users)
+	assert.Equal(t, "/api/v1", v1Users.Mount)
+	v2Users := findRoute(routes, "GET", "/api/users")
+	require.NotNil(t, v2Users)
+	assert.Equal(t, "/api", v2Users.Mount)
+}
+
+func TestPlugin_ExtractRoutes_MountNames(t *testing.T) {
+	files := []scanner.SourceFile{{
+		Path:     "app.js",
+		Language: "javascript",
+		Content: []byte(`
+const express = require('express')
+const app = express()
+const admin = express.Router()
+admin.get('/users', (req, res) => res.json([]))
+app.use('/admin', admin)
+app.get('/health', (req, res) => res.json({}))
+`),
+	}}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	users := findRoute(routes, "GET", "/admin/users")
+	require.NotNil(t, users)
+	assert.Equal(t, "getAdminUsers", users.OperationID)
+	assert.Equal(t, "getUsers", users.MountOperationID)
+	assert.Equal(t, []string{"admin"}, users.Tags)
+	assert.Equal(t, []string{"users"}, users.MountTags)
+
+	health := findRoute(routes, "GET", "/health")
+	require.NotNil(t, health)
+	assert.Empty(t, health.MountOperationID)
+	assert.Empty(t, health.MountTags)
+}
+
+func TestPlugin_ExtractRoutes_NestedMountingAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+	sources := map[string]string{
+		"app.js": `
+const express = require('express')
+const api = require('./api')
+const { health } = require('./health')
+const app = express()
+app.use('/api', api)
+app.use('/health', health)
+`,
+		"health.js": `
+const express = require('express')
+const health = express.Router()
+health.get('/live', (req, res) => res.json({}))
+module.exports = { health }
+`,
+		"api.ts": `
+import express from 'express'
+import v1 from './v1'
+import { v2 } from './v2'
+const api = express.Router()
+api.use('/v1', v1)
+api.use('/v2', v2)
+export default api
+`,
+		"v2.ts": `
+import express from 'express'
+export const v2 = express.Router()
+v2.get('/ping2', (req, res) => res.json({}))
+`,
+		"v1/index.ts": `
+import express from 'express'
+import users from '../users.js'
+const router = express.Router()
+router.get('/status', (req, res) => res.json({}))
+router.use('/users', users)
+export default router
+`,
+		"users.ts": `
+import express from 'express'
+const router = express.Router()
+router.get('/:id', (req, res) => res.json({}))
+export default router
+`,
+	}
+
+	var files []scanner.SourceFile
+	for name, content := range sources {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		language := "typescript"
+		if filepath.Ext(name) == ".js" {
+			language = "javascript"
+		}
+		files = append(files, scanner.SourceFile{Path: path, Language: language, Content: []byte(content)})
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	assert.NotNil(t, findRoute(routes, "GET", "/api/v1/status"))
+	assert.NotNil(t, findRoute(routes, "GET", "/api/v1/users/{id}"))
+	assert.NotNil(t, findRoute(routes, "GET", "/api/v2/ping2"))
+	assert.NotNil(t, findRoute(routes, "GET", "/health/live"))
+}
+
+func TestPlugin_ExtractRoutes_TemplatePaths(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content: []byte(`
+const express = require('express')
+const app = express()
+const prefix = '/api'
+const VERSION = 'v2'
+
+app.get(` + "`${prefix}/users/${VERSION}/items`" + `, (req, res) => res.json([]))
+app.get(prefix + '/health', (req, res) => res.json({}))
+app.get(` + "`${config.tenantPrefix}/orders/:id`" + `, (req, res) => res.json({}))
+app.route(` + "`${prefix}/teams`" + `).get((req, res) => res.json([]))
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	items := findRoute(routes, "GET", "/api/users/v2/items")
+	require.NotNil(t, items)
+	assert.Empty(t, items.Diagnostics)
+	assert.NotNil(t, findRoute(routes, "GET", "/api/health"))
+	assert.NotNil(t, findRoute(routes, "GET", "/api/teams"))
+
+	orders := findRoute(routes, "GET", "/{tenantPrefix}/orders/{id}")
+	require.NotNil(t, orders)
+	assert.Equal(t, []string{"path expression ${config.tenantPrefix} could not be resolved; emitted as a path parameter"}, orders.Diagnostics)
+	require.Len(t, orders.Parameters, 2)
+	assert.Equal(t, "tenantPrefix", orders.Parameters[0].Name)
+}
+
 func TestPlugin_ExtractRoutes_RouteChaining(t *testing.T) {
 	p := New()
 
@@ -386,6 +654,168 @@ func TestPlugin_ExtractRoutes_RouteChaining(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_RouteChainAll(t *testing.T) {
+	code := `
+const express = require('express')
+const router = express.Router()
+
+router.route('/orders/:id')
+  .all((req, res, next) => { loadOrder(req); next() })
+  .get((req, res) => res.json(req.order))
+  .put((req, res) => res.json(req.order))
+
+router.route('/ping').all((req, res) => res.send('pong'))
+
+module.exports = router
+`
+
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "orders.js", Language: "javascript", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	var keys []string
+	for _, r := range routes {
+		keys = append(keys, r.Method+" "+r.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"GET /orders/{id}",
+		"PUT /orders/{id}",
+		"ALL /ping",
+	}, keys)
+}
+
+func TestPlugin_ExtractRoutes_ResponseHeaders(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content:  []byte(expressResponseHeadersCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// Headers set through a renamed response parameter
+	list := findRoute(routes, "GET", "/reports")
+	require.NotNil(t, list)
+	require.Contains(t, list.Responses, "200")
+	headers := list.Responses["200"].Headers
+	assert.Contains(t, headers, "X-Total-Count")
+	assert.Contains(t, headers, "Cache-Control")
+	assert.Contains(t, headers, "ETag")
+
+	// Named handler with Location on a 201 response
+	create := findRoute(routes, "POST", "/reports")
+	require.NotNil(t, create)
+	require.Contains(t, create.Responses, "201")
+	location, ok := create.Responses["201"].Headers["Location"]
+	require.True(t, ok)
+	assert.Equal(t, "string", location.Schema.Type)
+	assert.NotEmpty(t, location.Description)
+
+	// Route chains
+	get := findRoute(routes, "GET", "/reports/{id}")
+	require.NotNil(t, get)
+	require.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses["200"].Headers, "X-Report-Version")
+
+	del := findRoute(routes, "DELETE", "/reports/{id}")
+	require.NotNil(t, del)
+	assert.NotContains(t, del.Responses, "200")
+	require.Contains(t, del.Responses, "204")
+	assert.Empty(t, del.Responses["204"].Headers)
+}
+
+func TestPlugin_ExtractRoutes_Redirects(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content:  []byte(expressRedirectCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// Explicit status code
+	docs := findRoute(routes, "GET", "/old-docs")
+	require.NotNil(t, docs)
+	require.Contains(t, docs.Responses, "301")
+	assert.NotContains(t, docs.Responses, "200")
+	assert.Equal(t, "Moved Permanently", docs.Responses["301"].Description)
+	assert.Contains(t, docs.Responses["301"].Headers, "Location")
+
+	// Express defaults to 302 Found
+	login := findRoute(routes, "POST", "/login")
+	require.NotNil(t, login)
+	require.Contains(t, login.Responses, "302")
+	assert.Contains(t, login.Responses["302"].Headers, "Location")
+}
+
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content:  []byte(expressMultiStatusCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// The implicit 200 is kept alongside the error branch
+	get := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, get)
+	assert.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses, "404")
+
+	del := findRoute(routes, "DELETE", "/users/{id}")
+	require.NotNil(t, del)
+	assert.Len(t, del.Responses, 2)
+	assert.Contains(t, del.Responses, "204")
+	assert.Contains(t, del.Responses, "401")
+	assert.Empty(t, del.Responses["204"].Content)
+}
+
+func TestPlugin_ExtractRoutes_Streaming(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content:  []byte(expressStreamingCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	events := findRoute(routes, "GET", "/events")
+	require.NotNil(t, events)
+	require.Contains(t, events.Responses, "200")
+	assert.Contains(t, events.Responses["200"].Content, "text/event-stream")
+	assert.Contains(t, events.Responses["200"].Headers, "Cache-Control")
+	assert.NotContains(t, events.Responses["200"].Headers, "Content-Type")
+	assert.Equal(t, true, events.Extensions["x-sse"])
+
+	// Chunked writes keep their declared content type without x-sse
+	export := findRoute(routes, "GET", "/export")
+	require.NotNil(t, export)
+	assert.Contains(t, export.Responses["200"].Content, "text/csv")
+	assert.NotContains(t, export.Extensions, "x-sse")
+}
+
 func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	p := New()
 
@@ -510,6 +940,11 @@ func TestConvertPathParams(t *testing.T) {
 		{"/files/*", "/files/{path}"},
 		{"/static/:type/*", "/static/{type}/{path}"},
 		{"/users/:id(\\d+)", "/users/{id}"}, // Regex constraint
+		{"/users/:id?", "/users/{id}"},      // Express 4 optional param
+		{"/users{/:id}", "/users/{id}"},     // Express 5 optional group
+		{"/:file{.:ext}", "/{file}.{ext}"},
+		{"/files/*splat", "/files/{splat}"},
+		{"/archive/:year(\\d{4})", "/archive/{year}"},
 	}
 
 	for _, tt := range tests {
@@ -520,6 +955,64 @@ func TestConvertPathParams(t *testing.T) {
 	}
 }
 
+func TestExpandOptionalGroups(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"/users/:id", []string{"/users/:id"}},
+		{"/users{/:id}", []string{"/users", "/users/:id"}},
+		{"/users/:id?", []string{"/users", "/users/:id"}},
+		{"{/:lang}/docs", []string{"/docs", "/:lang/docs"}},
+		{"/users/{/:id}", []string{"/users", "/users/:id"}},
+		{"/a{/:b{/:c}}", []string{"/a", "/a/:b", "/a/:b/:c"}},
+		{"/archive/:year(\\d{4})", []string{"/archive/:year(\\d{4})"}},
+		{"/{tenant}/orders{/:id}", []string{"/{tenant}/orders", "/{tenant}/orders/:id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, expandOptionalGroups(tt.input))
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes_Express5Paths(t *testing.T) {
+	code := `
+import express from 'express'
+
+const app = express()
+
+app.get('/users{/:id}', (req, res) => res.json({}))
+app.get('/assets/*filepath', (req, res) => res.sendFile(req.params.filepath))
+app.route('/books/:id?').get((req, res) => res.json({}))
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "app.ts", Language: "typescript", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 5)
+
+	users := findRoute(routes, "GET", "/users")
+	require.NotNil(t, users)
+	assert.Empty(t, users.Parameters)
+	assert.Equal(t, "getUsers", users.OperationID)
+
+	user := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, user)
+	require.Len(t, user.Parameters, 1)
+	assert.Equal(t, "id", user.Parameters[0].Name)
+	assert.Equal(t, "getUsersByid", user.OperationID)
+
+	asset := findRoute(routes, "GET", "/assets/{filepath}")
+	require.NotNil(t, asset)
+	require.Len(t, asset.Parameters, 1)
+	assert.Equal(t, "filepath", asset.Parameters[0].Name)
+
+	assert.NotNil(t, findRoute(routes, "GET", "/books"))
+	assert.NotNil(t, findRoute(routes, "GET", "/books/{id}"))
+}
+
 func TestExtractPathParams(t *testing.T) {
 	tests := []struct {
 		path       string
@@ -686,6 +1179,314 @@ func TestPlugin_ExtractRoutes_ValidationMiddleware(t *testing.T) {
 	}
 }
 
+// expressTypedHandlersCode tests Request/Response/RequestHandler generics.
+const expressTypedHandlersCode = `
+import express, { Request, Response, RequestHandler } from 'express'
+
+interface User {
+  id: number
+  name: string
+}
+
+interface CreateUser {
+  name: string
+}
+
+interface ListQuery {
+  page?: number
+  search: string
+}
+
+const app = express()
+
+app.get('/users', (req: Request<{}, User[], any, ListQuery>, res) => res.json([]))
+
+const getUser: RequestHandler<{ id: number }, User> = async (req, res) => {
+  res.json({ id: 1, name: 'a' })
+}
+app.get('/users/:id', getUser)
+
+function createUser(req: express.Request<{}, User, CreateUser>, res: Response<User>) {
+  res.json({ id: 1, name: req.body.name })
+}
+app.post('/users', createUser)
+
+app.put('/users/:id', (req: Request<{ id: string }>, res: Response<User>) => res.json({}))
+
+export default app
+`
+
+func TestPlugin_ExtractRoutes_TypedHandlerGenerics(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(expressTypedHandlersCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 4)
+
+	// Inline Request<P, ResBody, ReqBody, Query>
+	listUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, listUsers)
+	require.Contains(t, listUsers.Responses, "200")
+	listSchema := listUsers.Responses["200"].Content["application/json"].Schema
+	require.NotNil(t, listSchema)
+	assert.Equal(t, "array", listSchema.Type)
+	assert.Equal(t, "#/components/schemas/User", listSchema.Items.Ref)
+	assert.Nil(t, listUsers.RequestBody)
+	require.Len(t, listUsers.Parameters, 2)
+	assert.Equal(t, "page", listUsers.Parameters[0].Name)
+	assert.Equal(t, "query", listUsers.Parameters[0].In)
+	assert.False(t, listUsers.Parameters[0].Required)
+	assert.Equal(t, "number", listUsers.Parameters[0].Schema.Type)
+	assert.Equal(t, "search", listUsers.Parameters[1].Name)
+	assert.True(t, listUsers.Parameters[1].Required)
+
+	// Named RequestHandler<Params, ResBody> variable
+	getUser := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, getUser)
+	require.Len(t, getUser.Parameters, 1)
+	assert.Equal(t, "number", getUser.Parameters[0].Schema.Type)
+	assert.Equal(t, "#/components/schemas/User", getUser.Responses["200"].Content["application/json"].Schema.Ref)
+
+	// Function declaration with qualified express.Request and Response<T>
+	createUser := findRoute(routes, "POST", "/users")
+	require.NotNil(t, createUser)
+	require.NotNil(t, createUser.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", createUser.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/User", createUser.Responses["200"].Content["application/json"].Schema.Ref)
+
+	// Response<T> on the second parameter supplies the response body
+	updateUser := findRoute(routes, "PUT", "/users/{id}")
+	require.NotNil(t, updateUser)
+	assert.Equal(t, "string", updateUser.Parameters[0].Schema.Type)
+	assert.Equal(t, "#/components/schemas/User", updateUser.Responses["200"].Content["application/json"].Schema.Ref)
+}
+
+// expressTypedCreatedCode types a handler that only responds with 201.
+const expressTypedCreatedCode = `
+import express, { Request } from 'express'
+
+interface User {
+  id: number
+}
+
+interface CreateUser {
+  name: string
+}
+
+const router = express.Router()
+
+router.post('/users', (req: Request<{}, User, CreateUser>, res) => { res.status(201).json({}) })
+
+export default router
+`
+
+func TestPlugin_ExtractRoutes_TypedHandlerOtherSuccess(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "users.ts",
+			Language: "typescript",
+			Content:  []byte(expressTypedCreatedCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// The typed response body belongs to the 201 the handler sends, not
+	// to an implicit 200
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.NotContains(t, create.Responses, "200")
+	require.Contains(t, create.Responses, "201")
+	assert.Equal(t, "Created", create.Responses["201"].Description)
+	assert.Equal(t, "#/components/schemas/User", create.Responses["201"].Content["application/json"].Schema.Ref)
+}
+
+// expressQueryCode tests query parameters from express-validator query()
+// chains and handler reads.
+const expressQueryCode = `
+const express = require('express')
+const { query } = require('express-validator')
+
+const app = express()
+
+app.get('/orders', [
+  query('page').optional().isInt({ min: 1 }).toInt(),
+  query('status').isIn(['open', 'closed']),
+  query('ids').optional().isArray(),
+  query('filter[createdAfter]').optional().isISO8601(),
+], (req, res) => {
+  const { sort = 'asc', limit } = req.query
+  const tags = req.query.tags.split(',')
+  res.json(find(req.query.page, req.query.filter.customer))
+})
+
+app.route('/reports')
+  .get(request => run(parseInt(request.query['year'])))
+`
+
+func TestPlugin_ExtractRoutes_QueryParams(t *testing.T) {
+	file := scanner.SourceFile{Path: "app.js", Language: "javascript", Content: []byte(expressQueryCode)}
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{file})
+	require.NoError(t, err)
+
+	orders := findRoute(routes, "GET", "/orders")
+	require.NotNil(t, orders)
+	params := make(map[string]types.Parameter)
+	var names []string
+	for _, param := range orders.Parameters {
+		assert.Equal(t, "query", param.In)
+		params[param.Name] = param
+		names = append(names, param.Name)
+	}
+	assert.Equal(t, []string{"page", "status", "ids", "filter", "tags", "sort", "limit"}, names)
+
+	assert.Equal(t, "integer", params["page"].Schema.Type)
+	assert.False(t, params["page"].Required)
+	assert.True(t, params["status"].Required, "validated fields are required unless optional()")
+	assert.Equal(t, "array", params["ids"].Schema.Type)
+	assert.Equal(t, "form", params["ids"].Style)
+	require.NotNil(t, params["ids"].Explode)
+	assert.True(t, *params["ids"].Explode)
+
+	filter := params["filter"]
+	assert.Equal(t, "deepObject", filter.Style, "qs nests bracketed keys")
+	assert.Equal(t, "object", filter.Schema.Type)
+	assert.Equal(t, "date-time", filter.Schema.Properties["createdAfter"].Format)
+	assert.Contains(t, filter.Schema.Properties, "customer", "nested reads add properties")
+	assert.False(t, params["tags"].Required)
+	assert.Equal(t, "string", params["tags"].Schema.Type, "method calls are not nested properties")
+
+	reports := findRoute(routes, "GET", "/reports")
+	require.NotNil(t, reports)
+	require.Len(t, reports.Parameters, 1)
+	assert.Equal(t, "year", reports.Parameters[0].Name)
+	assert.Equal(t, "integer", reports.Parameters[0].Schema.Type)
+}
+
+func TestPlugin_ExtractRoutes_QueryParser(t *testing.T) {
+	extract := func(setting string) *types.Route {
+		code := expressQueryCode + "\napp.set('query parser', " + setting + ")\n"
+		file := scanner.SourceFile{Path: "app.js", Language: "javascript", Content: []byte(code)}
+		routes, err := New().ExtractRoutes([]scanner.SourceFile{file})
+		require.NoError(t, err)
+		orders := findRoute(routes, "GET", "/orders")
+		require.NotNil(t, orders)
+		return orders
+	}
+
+	var names []string
+	for _, param := range extract("'simple'").Parameters {
+		names = append(names, param.Name)
+		assert.NotEqual(t, "deepObject", param.Style, "querystring does not nest keys")
+	}
+	assert.Equal(t, []string{"page", "status", "ids", "filter[createdAfter]", "filter[customer]", "tags", "sort", "limit"}, names)
+
+	assert.Empty(t, extract("false").Parameters, "req.query is empty without a parser")
+	assert.Len(t, extract("(str) => qs.parse(str, { depth: 2 })").Parameters, 7)
+}
+
+func TestPlugin_ExtractSchemas_Interfaces(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(expressTypedHandlersCode),
+		},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	names := make(map[string]bool)
+	for _, s := range schemas {
+		names[s.Title] = true
+	}
+	assert.True(t, names["User"])
+	assert.True(t, names["CreateUser"])
+	assert.True(t, names["ListQuery"])
+}
+
+func TestPlugin_ExtractSchemas_DeclarationFiles(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content: []byte(`
+import express, { Request, Response } from 'express'
+
+const app = express()
+
+app.post('/orders', (req: Request<{}, API.Order, API.CreateOrder>, res: Response<API.Order>) => {
+  res.json({})
+})
+`),
+		},
+		{
+			Path:     "types/api.d.ts",
+			Language: "typescript",
+			Content: []byte(`
+declare namespace API {
+  interface Order {
+    id: string
+    total: number
+  }
+
+  type CreateOrder = {
+    items: string[]
+    note?: string
+  }
+
+  type Status = 'open' | 'closed'
+}
+
+declare global {
+  namespace Express {
+    interface Request {
+      user?: API.Order
+    }
+  }
+}
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	createOrder := findRoute(routes, "POST", "/orders")
+	require.NotNil(t, createOrder)
+	require.NotNil(t, createOrder.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateOrder", createOrder.RequestBody.Content["application/json"].Schema.Ref)
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+	require.Contains(t, byName, "Order")
+	require.Contains(t, byName, "CreateOrder")
+	assert.Equal(t, []string{"items"}, byName["CreateOrder"].Required)
+	assert.Equal(t, "array", byName["CreateOrder"].Properties["items"].Type)
+	assert.NotContains(t, byName, "Status")
+	assert.NotContains(t, byName, "Request")
+}
+
 // Helper to find a route by method and path
 func findRoute(routes []types.Route, method, path string) *types.Route {
 	for i := range routes {
@@ -695,3 +1496,67 @@ func findRoute(routes []types.Route, method, path string) *types.Route {
 	}
 	return nil
 }
+
+func TestPlugin_ExtractRoutes_ParamHandlers(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "users.js",
+			Language: "javascript",
+			Content: []byte(`const express = require('express');
+const createError = require('http-errors');
+const router = express.Router();
+
+/** Numeric ID of the user */
+router.param('userId', async (req, res, next, id) => {
+  const user = await User.findById(parseInt(id, 10));
+  if (!user) return next(createError(404, 'User not found'));
+  req.user = user;
+  next();
+});
+
+function loadOrder(req, res, next, orderId) {
+  if (!isUUID(orderId)) return res.status(400).json({ error: 'bad id' });
+  next();
+}
+router.param(['orderId'], loadOrder);
+
+router.get('/users/:userId', (req, res) => res.json(req.user));
+router.route('/users/:userId/orders/:orderId')
+  .get((req, res) => res.json({}))
+  .delete((req, res) => res.sendStatus(204));
+router.get('/health', (req, res) => res.send('ok'));
+
+module.exports = router;
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	get := findRoute(routes, "GET", "/users/{userId}")
+	require.NotNil(t, get)
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "Numeric ID of the user", get.Parameters[0].Description)
+	assert.Equal(t, "integer", get.Parameters[0].Schema.Type)
+	assert.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses, "404")
+
+	for _, method := range []string{"GET", "DELETE"} {
+		route := findRoute(routes, method, "/users/{userId}/orders/{orderId}")
+		require.NotNil(t, route, method)
+		require.Len(t, route.Parameters, 2)
+		assert.Equal(t, "integer", route.Parameters[0].Schema.Type)
+		assert.Equal(t, "uuid", route.Parameters[1].Schema.Format)
+		assert.Contains(t, route.Responses, "400")
+		assert.Contains(t, route.Responses, "404")
+	}
+	assert.Contains(t, findRoute(routes, "DELETE", "/users/{userId}/orders/{orderId}").Responses, "204")
+	assert.NotContains(t, findRoute(routes, "DELETE", "/users/{userId}/orders/{orderId}").Responses, "200")
+
+	health := findRoute(routes, "GET", "/health")
+	require.NotNil(t, health)
+	assert.Empty(t, health.Responses)
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package express
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// paramConversions maps the calls a param callback converts or validates
+// its value with to the schema they imply, as in parseInt(id).
+var paramConversions = map[string]types.Schema{
+	"parseInt":         {Type: "integer"},
+	"parseFloat":       {Type: "number"},
+	"Number":           {Type: "number"},
+	"BigInt":           {Type: "integer"},
+	"isInt":            {Type: "integer"},
+	"isUUID":           {Type: "string", Format: "uuid"},
+	"uuidValidate":     {Type: "string", Format: "uuid"},
+	"isValidObjectId":  {Type: "string", Pattern: "^[0-9a-fA-F]{24}$"},
+	"ObjectId.isValid": {Type: "string", Pattern: "^[0-9a-fA-F]{24}$"},
+}
+
+// httpErrorStatus matches http-errors calls such as createError(404, ...):
+// group 1 is the status
+var httpErrorStatus = regexp.MustCompile(`\b(?:createError|createHttpError|httpErrors|HttpError)\(\s*(\d{3})\b`)
+
+// paramHandler is what a callback registered with app.param() or
+// router.param() tells about the routes using its parameter.
+type paramHandler struct {
+	// description is the doc comment above the registration
+	description string
+
+	// schema is implied by how the callback converts or validates the value
+	schema *types.Schema
+
+	// statuses are the error statuses the callback responds with, such as
+	// 404 when the resource the parameter names does not exist
+	statuses []int
+}
+
+// findParamHandlers returns the callbacks registered on the file's apps and
+// routers with app.param('id', loadUser) or router.param(['id'], ...), by
+// parameter name.
+func (p *Plugin) findParamHandlers(calls []*sitter.Node, content []byte, routers map[string]*routerInfo, typed *fileTypes) map[string]*paramHandler {
+	handlers := make(map[string]*paramHandler)
+	for _, call := range calls {
+		callee := call.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			continue
+		}
+		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		if method != "param" || routers[object] == nil {
+			continue
+		}
+		args := p.tsParser.GetCallArguments(call, content)
+		if len(args) != 2 {
+			continue
+		}
+		fn := handlerFunction(args[1], content, typed)
+		if fn == nil {
+			continue
+		}
+
+		var names []string
+		if args[0].Type() == "array" {
+			for i := 0; i < int(args[0].NamedChildCount()); i++ {
+				if name, ok := p.tsParser.ExtractStringLiteral(args[0].NamedChild(i), content); ok {
+					names = append(names, name)
+				}
+			}
+		} else if name, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+			names = append(names, name)
+		}
+
+		handler := p.inspectParamHandler(fn, content)
+		if stmt := call.Parent(); stmt != nil && stmt.Type() == "expression_statement" {
+			handler.description = p.tsParser.DocComment(stmt, content)
+		}
+		for _, name := range names {
+			handlers[name] = handler
+		}
+	}
+	return handlers
+}
+
+// inspectParamHandler reads the schema and error statuses of a
+// (req, res, next, value) param callback.
+func (p *Plugin) inspectParamHandler(fn *sitter.Node, content []byte) *paramHandler {
+	handler := &paramHandler{}
+	for _, code := range p.inspectResponse(fn, content).statuses {
+		if code >= 400 {
+			handler.statuses = append(handler.statuses, code)
+		}
+	}
+
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return handler
+	}
+	text := body.Content(content)
+	for _, m := range httpErrorStatus.FindAllStringSubmatch(text, -1) {
+		if code, err := strconv.Atoi(m[1]); err == nil && code >= 400 {
+			handler.statuses = append(handler.statuses, code)
+		}
+	}
+
+	paramsNode := fn.ChildByFieldName("parameters")
+	if paramsNode == nil || paramsNode.NamedChildCount() < 4 {
+		return handler
+	}
+	value := paramsNode.NamedChild(3)
+	if pattern := value.ChildByFieldName("pattern"); pattern != nil {
+		value = pattern
+	}
+	if value.Type() != "identifier" {
+		return handler
+	}
+	handler.schema = valueSchema(text, value.Content(content))
+	return handler
+}
+
+// valueSchema returns the schema implied by the first conversion or
+// validation of name in text, or nil.
+func valueSchema(text, name string) *types.Schema {
+	call := regexp.MustCompile(`([A-Za-z_$][\w$.]*)\(\s*` + regexp.QuoteMeta(name) + `\b`)
+	for _, m := range call.FindAllStringSubmatch(text, -1) {
+		for conversion, implied := range paramConversions {
+			if m[1] == conversion || strings.HasSuffix(m[1], "."+conversion) {
+				s := implied
+				return &s
+			}
+		}
+	}
+	if regexp.MustCompile(`/\^\\d\+\$/\.test\(\s*` + regexp.QuoteMeta(name) + `\b`).MatchString(text) {
+		return &types.Schema{Type: "integer"}
+	}
+	return nil
+}
+
+// applyParamHandlers enriches the path parameters of routes with what their
+// param callbacks tell: the description, the type the value is converted
+// to, and the error responses, such as 404 when nothing is found.
+func applyParamHandlers(routes []types.Route, handlers map[string]*paramHandler) {
+	if len(handlers) == 0 {
+		return
+	}
+	for i := range routes {
+		route := &routes[i]
+		// Routes of a chain share their path parameters
+		params := make([]types.Parameter, len(route.Parameters))
+		copy(params, route.Parameters)
+		for j := range params {
+			handler := handlers[params[j].Name]
+			if params[j].In != "path" || handler == nil {
+				continue
+			}
+			if params[j].Description == "" {
+				params[j].Description = handler.description
+			}
+			if s := params[j].Schema; handler.schema != nil && (s == nil || (s.Type == "string" && s.Format == "" && s.Pattern == "")) {
+				schema := *handler.schema
+				params[j].Schema = &schema
+			}
+			if len(handler.statuses) > 0 && route.Responses == nil {
+				route.Responses = make(map[string]types.Response)
+			}
+			plugins.ApplyStatuses(route, handler.statuses)
+		}
+		route.Parameters = params
+	}
+}
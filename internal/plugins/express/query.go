@@ -0,0 +1,347 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package express
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Query parsers, as set with app.set('query parser', ...).
+const (
+	// queryParserExtended is qs, the Express 4 default, which nests
+	// bracketed keys: ?filter[status]=open becomes { filter: { status } }
+	queryParserExtended = "extended"
+
+	// queryParserSimple is Node's querystring, the Express 5 default, which
+	// keeps bracketed keys as they are
+	queryParserSimple = "simple"
+
+	// queryParserNone disables query parsing, leaving req.query empty
+	queryParserNone = "none"
+)
+
+var (
+	// queryParserSetting matches app.set('query parser', value): group 1 is
+	// the value
+	queryParserSetting = regexp.MustCompile(`\.set\(\s*['"]query parser['"]\s*,\s*([^\n]*)`)
+
+	// queryDestructuring matches const { page, limit = 10 } = req.query:
+	// group 1 is the destructured properties and group 2 the request
+	queryDestructuring = regexp.MustCompile(`\{([^{}]*)\}\s*=\s*([A-Za-z_$][\w$]*)\.query\b`)
+
+	// bracketKey matches a bracketed key segment, as in filter[status]
+	bracketKey = regexp.MustCompile(`\[\s*['"]?([^\]'"]*)['"]?\s*\]`)
+)
+
+// queryTypes maps express-validator checks to the schema they imply.
+var queryTypes = map[string]types.Schema{
+	"isInt":     {Type: "integer"},
+	"isNumeric": {Type: "number"},
+	"isFloat":   {Type: "number"},
+	"isDecimal": {Type: "number"},
+	"isBoolean": {Type: "boolean"},
+	"isEmail":   {Type: "string", Format: "email"},
+	"isURL":     {Type: "string", Format: "uri"},
+	"isUUID":    {Type: "string", Format: "uuid"},
+	"isISO8601": {Type: "string", Format: "date-time"},
+	"isDate":    {Type: "string", Format: "date"},
+}
+
+// queryConversions maps the calls converting a query value to the schema
+// they imply, as in parseInt(req.query.page).
+var queryConversions = map[string]types.Schema{
+	"parseInt":   {Type: "integer"},
+	"parseFloat": {Type: "number"},
+	"Number":     {Type: "number"},
+	"Boolean":    {Type: "boolean"},
+}
+
+// detectQueryParser returns the query parser the app configures with
+// app.set('query parser', ...), defaulting to qs.
+func detectQueryParser(files []scanner.SourceFile) string {
+	for _, file := range files {
+		m := queryParserSetting.FindSubmatch(file.Content)
+		if m == nil {
+			continue
+		}
+		value := strings.TrimSpace(string(m[1]))
+		switch {
+		case strings.HasPrefix(value, "false"):
+			return queryParserNone
+		case strings.HasPrefix(value, "'extended'"), strings.HasPrefix(value, `"extended"`),
+			strings.Contains(value, "qs."), strings.Contains(value, "qs)"):
+			return queryParserExtended
+		default:
+			// 'simple', true and custom parsers not built on qs
+			return queryParserSimple
+		}
+	}
+	return queryParserExtended
+}
+
+// applyQueryParser adapts query parameters to how the query parser reads
+// them: without qs, nested keys stay flat parameters such as
+// filter[status], and with parsing disabled handlers see no parameters.
+func applyQueryParser(routes []types.Route, parser string) {
+	if parser == queryParserExtended {
+		return
+	}
+	for i := range routes {
+		var params []types.Parameter
+		for _, param := range routes[i].Parameters {
+			switch {
+			case param.In != "query":
+				params = append(params, param)
+			case parser == queryParserNone:
+			case param.Style == "deepObject" && param.Schema != nil:
+				for _, name := range slices.Sorted(maps.Keys(param.Schema.Properties)) {
+					params = append(params, types.Parameter{
+						Name:     param.Name + "[" + name + "]",
+						In:       "query",
+						Required: slices.Contains(param.Schema.Required, name),
+						Schema:   param.Schema.Properties[name],
+					})
+				}
+			default:
+				params = append(params, param)
+			}
+		}
+		routes[i].Parameters = params
+	}
+}
+
+// addQueryParams adds the query parameters declared by express-validator
+// query() chains among a route's middleware and read by its handler to the
+// route, keeping those it already has.
+func (p *Plugin) addQueryParams(route *types.Route, middleware []*sitter.Node, handler *sitter.Node, content []byte) {
+	q := &queryParams{}
+	for _, node := range middleware {
+		p.queryValidators(q, node, content)
+	}
+	if handler != nil {
+		queryReads(q, handler, content)
+	}
+
+	// Routes of a chain share their path parameters
+	route.Parameters = slices.Clip(route.Parameters)
+	for _, param := range q.params {
+		exists := false
+		for _, existing := range route.Parameters {
+			if existing.In == "query" && existing.Name == param.Name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			route.Parameters = append(route.Parameters, param)
+		}
+	}
+}
+
+// queryValidators adds the fields of the query() chains in a middleware
+// argument, or an array of them, to q.
+func (p *Plugin) queryValidators(q *queryParams, node *sitter.Node, content []byte) {
+	if node.Type() == "array" {
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			p.queryValidators(q, node.NamedChild(i), content)
+		}
+		return
+	}
+
+	// Walk query('page').optional().isInt() from the outermost call in
+	var checks []string
+	for node != nil && node.Type() == "call_expression" {
+		callee := node.ChildByFieldName("function")
+		if callee == nil {
+			return
+		}
+		if callee.Type() == "identifier" {
+			if callee.Content(content) != "query" {
+				return
+			}
+			args := p.tsParser.GetCallArguments(node, content)
+			if len(args) == 0 {
+				return
+			}
+			field, ok := p.tsParser.ExtractStringLiteral(args[0], content)
+			if !ok {
+				return
+			}
+			q.add(field, validatorSchema(checks), !slices.Contains(checks, "optional"))
+			return
+		}
+		if callee.Type() != "member_expression" {
+			return
+		}
+		if property := callee.ChildByFieldName("property"); property != nil {
+			checks = append(checks, property.Content(content))
+		}
+		node = callee.ChildByFieldName("object")
+	}
+}
+
+// validatorSchema returns the schema implied by express-validator checks;
+// isArray() makes it an array of the values the other checks describe.
+func validatorSchema(checks []string) *types.Schema {
+	schema := &types.Schema{Type: "string"}
+	for _, check := range checks {
+		if implied, ok := queryTypes[check]; ok {
+			s := implied
+			schema = &s
+			break
+		}
+	}
+	if slices.Contains(checks, "isArray") {
+		return &types.Schema{Type: "array", Items: schema}
+	}
+	return schema
+}
+
+// queryReads adds the query fields a handler reads to q: req.query.page,
+// req.query['page'], req.query.filter.status and destructured properties.
+// Values converted with parseInt() and the like are typed accordingly.
+func queryReads(q *queryParams, fn *sitter.Node, content []byte) {
+	reqName := "req"
+	if param := fn.ChildByFieldName("parameter"); param != nil && param.Type() == "identifier" {
+		// req => ...
+		reqName = param.Content(content)
+	} else if paramsNode := fn.ChildByFieldName("parameters"); paramsNode != nil && paramsNode.NamedChildCount() > 0 {
+		param := paramsNode.NamedChild(0)
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			param = pattern
+		}
+		if param.Type() == "identifier" {
+			reqName = param.Content(content)
+		}
+	}
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return
+	}
+	text := body.Content(content)
+
+	read := regexp.MustCompile(`\b` + regexp.QuoteMeta(reqName) + `\.query\??(?:\.([A-Za-z_$][\w$]*)|\[\s*['"]([^'"]+)['"]\s*\])(?:\??\.([A-Za-z_$][\w$]*)|\[\s*['"]([^'"]+)['"]\s*\])?`)
+	for _, m := range read.FindAllStringSubmatchIndex(text, -1) {
+		field := submatch(text, m, 1) + submatch(text, m, 2)
+		if property := submatch(text, m, 3) + submatch(text, m, 4); property != "" {
+			// req.query.tags.split(',') calls a method of the value
+			if !strings.HasPrefix(strings.TrimSpace(text[m[1]:]), "(") && property != "length" {
+				field += "." + property
+			}
+		}
+		q.add(field, conversionSchema(text[:m[0]]), false)
+	}
+
+	for _, m := range queryDestructuring.FindAllStringSubmatch(text, -1) {
+		if m[2] != reqName {
+			continue
+		}
+		for _, entry := range strings.Split(m[1], ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" || strings.HasPrefix(entry, "...") {
+				continue
+			}
+			name, _, _ := strings.Cut(entry, "=")
+			name, _, _ = strings.Cut(name, ":")
+			q.add(strings.TrimSpace(name), &types.Schema{Type: "string"}, false)
+		}
+	}
+}
+
+// conversionSchema returns the schema implied by the conversion call the
+// text before a query read ends with, or a string.
+func conversionSchema(before string) *types.Schema {
+	before = strings.TrimRight(before, " \t")
+	for name, implied := range queryConversions {
+		if strings.HasSuffix(before, name+"(") {
+			s := implied
+			return &s
+		}
+	}
+	return &types.Schema{Type: "string"}
+}
+
+// submatch returns group n of a FindAllStringSubmatchIndex match, or "".
+func submatch(text string, m []int, n int) string {
+	if m[2*n] < 0 {
+		return ""
+	}
+	return text[m[2*n]:m[2*n+1]]
+}
+
+// queryParams collects query parameters by name in order of appearance.
+type queryParams struct {
+	params []types.Parameter
+}
+
+// add records a query field. Bracketed and dotted fields such as
+// filter[status] or filter.status become properties of a deepObject
+// parameter, and ids[], ids[*] or ids.* an exploded form array. The first
+// declaration of a field wins, so validators take precedence over reads.
+func (q *queryParams) add(field string, schema *types.Schema, required bool) {
+	field = bracketKey.ReplaceAllStringFunc(field, func(s string) string {
+		key := bracketKey.FindStringSubmatch(s)[1]
+		if key == "" {
+			key = "*"
+		}
+		return "." + key
+	})
+	parts := strings.Split(field, ".")
+	if parts[0] == "" {
+		return
+	}
+
+	param := q.param(parts[0])
+	switch {
+	case len(parts) == 1:
+		if param.Schema == nil {
+			param.Schema = schema
+			param.Required = required
+			if schema.Type == "array" {
+				param.Style, param.Explode = "form", boolPtr(true)
+			}
+		}
+	case parts[1] == "*":
+		if param.Schema == nil || param.Schema.Type != "array" {
+			param.Schema = &types.Schema{Type: "array", Items: schema}
+			param.Required = required
+			param.Style, param.Explode = "form", boolPtr(true)
+		}
+	default:
+		if param.Schema == nil || param.Schema.Type != "object" {
+			param.Schema = &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+			param.Style, param.Explode = "deepObject", boolPtr(true)
+		}
+		if _, ok := param.Schema.Properties[parts[1]]; !ok {
+			param.Schema.Properties[parts[1]] = schema
+			if required {
+				param.Schema.Required = append(param.Schema.Required, parts[1])
+				param.Required = true
+			}
+		}
+	}
+}
+
+// param returns the parameter named name, adding it if new.
+func (q *queryParams) param(name string) *types.Parameter {
+	for i := range q.params {
+		if q.params[i].Name == name {
+			return &q.params[i]
+		}
+	}
+	q.params = append(q.params, types.Parameter{Name: name, In: "query"})
+	return &q.params[len(q.params)-1]
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -6,15 +6,19 @@ package fastapi
 
 import (
 	"bufio"
+	"context"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -123,14 +127,26 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts FastAPI route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
+	// Dependency classes and type aliases may be declared in other files
+	deps := p.collectDependencyClasses(ctx, files)
+	aliases := p.collectTypeAliases(ctx, files)
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, deps, aliases)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -149,8 +165,8 @@ type routerInfo struct {
 }
 
 // extractRoutesFromFile extracts routes from a single Python file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.pyParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, deps dependencyClasses, aliases typeAliases) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +174,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports FastAPI
 	if !p.hasFastAPIImport(pf) {
+		decisions.Skip(p.Name(), file.Path, "no FastAPI import")
 		return nil, nil
 	}
 
@@ -168,7 +185,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Extract routes from decorated functions
 	for _, fn := range pf.DecoratedFunctions {
-		fnRoutes := p.extractRoutesFromFunction(fn, file.Content, routers)
+		fnRoutes := p.extractRoutesFromFunction(fn, file.Content, routers, deps, aliases)
 		for i := range fnRoutes {
 			fnRoutes[i].SourceFile = file.Path
 			routes = append(routes, fnRoutes[i])
@@ -258,11 +275,11 @@ func (p *Plugin) extractRouterPrefix(node *sitter.Node, content []byte) string {
 }
 
 // extractRoutesFromFunction extracts routes from a decorated function.
-func (p *Plugin) extractRoutesFromFunction(fn parser.PythonDecoratedFunction, content []byte, routers map[string]*routerInfo) []types.Route {
+func (p *Plugin) extractRoutesFromFunction(fn parser.PythonDecoratedFunction, content []byte, routers map[string]*routerInfo, deps dependencyClasses, aliases typeAliases) []types.Route {
 	var routes []types.Route
 
 	for _, dec := range fn.Decorators {
-		route := p.parseRouteDecorator(dec, fn, content, routers)
+		route := p.parseRouteDecorator(dec, fn, content, routers, deps, aliases)
 		if route != nil {
 			routes = append(routes, *route)
 		}
@@ -272,7 +289,7 @@ func (p *Plugin) extractRoutesFromFunction(fn parser.PythonDecoratedFunction, co
 }
 
 // parseRouteDecorator parses a route decorator and extracts route information.
-func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.PythonDecoratedFunction, content []byte, routers map[string]*routerInfo) *types.Route {
+func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.PythonDecoratedFunction, content []byte, routers map[string]*routerInfo, deps dependencyClasses, aliases typeAliases) *types.Route {
 	// Check for @app.get, @router.post, etc.
 	parts := strings.Split(dec.Name, ".")
 	if len(parts) < 2 {
@@ -308,13 +325,14 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 	fullPath := combinePaths(prefix, path)
 
 	// FastAPI uses {param} format already, but let's ensure consistency
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = normalizePathParams(fullPath)
 
 	// Extract path parameters
 	params := extractPathParams(fullPath)
 
 	// Extract additional parameters from function signature
-	queryParams := p.extractQueryParams(fn, content)
+	queryParams := p.extractQueryParams(fn, deps, aliases)
 	params = append(params, queryParams...)
 
 	// Generate operation ID
@@ -326,9 +344,7 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 	// Check for response_model in decorator arguments
 	var responseSchema *types.Schema
 	if responseModel, ok := dec.KeywordArguments["response_model"]; ok {
-		responseSchema = &types.Schema{
-			Ref: "#/components/schemas/" + responseModel,
-		}
+		responseSchema = responseModelSchema(responseModel)
 	}
 
 	route := &types.Route{
@@ -340,6 +356,9 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 		Parameters:  params,
 		SourceLine:  fn.Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
 
 	// Add response if we have a response_model
 	if responseSchema != nil {
@@ -356,43 +375,241 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 	}
 
 	// Check for request body from typed parameters
-	requestBody := p.extractRequestBody(fn, content)
+	requestBody := p.extractRequestBody(fn, aliases)
 	if requestBody != nil {
 		route.RequestBody = requestBody
 	}
 
+	applyStatuses(route, dec, p.findRaisedStatuses(fn, content))
+	applyRedirects(route, p.findRedirects(dec, fn, content))
+
+	if mediaType := p.findStreamingMediaType(dec, fn, content); mediaType != "" {
+		applyStreaming(route, mediaType)
+	}
+
 	return route
 }
 
+// streamingResponses maps streaming response classes to their default media type.
+// EventSourceResponse comes from sse-starlette.
+var streamingResponses = map[string]string{
+	"StreamingResponse":   "application/octet-stream",
+	"EventSourceResponse": "text/event-stream",
+}
+
+// findStreamingMediaType returns the media type of a streaming path operation,
+// declared through response_class or a StreamingResponse(...) /
+// EventSourceResponse(...) call, or "" if the operation does not stream.
+func (p *Plugin) findStreamingMediaType(dec parser.PythonDecorator, fn parser.PythonDecoratedFunction, content []byte) string {
+	if class, ok := dec.KeywordArguments["response_class"]; ok {
+		parts := strings.Split(class, ".")
+		if mediaType, ok := streamingResponses[parts[len(parts)-1]]; ok {
+			return mediaType
+		}
+	}
+
+	if fn.Node == nil {
+		return ""
+	}
+
+	for _, name := range []string{"EventSourceResponse", "StreamingResponse"} {
+		for _, call := range p.pyParser.FindCalls(fn.Node, content, name) {
+			if mediaType, ok := call.KeywordArguments["media_type"]; ok {
+				return strings.Trim(mediaType, `"'`)
+			}
+			return streamingResponses[name]
+		}
+	}
+	return ""
+}
+
+// applyStreaming documents the success response as a stream of the given
+// media type, marking Server-Sent Events endpoints with x-sse.
+func applyStreaming(route *types.Route, mediaType string) {
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	resp, ok := route.Responses["200"]
+	if !ok {
+		resp = types.Response{Description: "Successful Response"}
+	}
+	resp.Content = map[string]types.MediaType{
+		mediaType: {Schema: &types.Schema{Type: "string"}},
+	}
+	route.Responses["200"] = resp
+
+	if mediaType == "text/event-stream" {
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions["x-sse"] = true
+	}
+}
+
+// findRaisedStatuses returns the status codes of HTTPException instances a
+// path operation raises, e.g. raise HTTPException(status_code=404).
+func (p *Plugin) findRaisedStatuses(fn parser.PythonDecoratedFunction, content []byte) []int {
+	if fn.Node == nil {
+		return nil
+	}
+
+	var codes []int
+	for _, call := range p.pyParser.FindCalls(fn.Node, content, "HTTPException") {
+		value, ok := call.KeywordArguments["status_code"]
+		if !ok && len(call.Arguments) > 0 {
+			value = call.Arguments[0]
+		}
+		if code := plugins.StatusCode(value); code > 0 {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// applyStatuses documents the operation's success status, taken from the
+// decorator's status_code (200 by default), next to the statuses of the
+// exceptions it raises. A 204 success carries no content.
+func applyStatuses(route *types.Route, dec parser.PythonDecorator, raised []int) {
+	success := http.StatusOK
+	if strings.HasSuffix(dec.KeywordArguments["response_class"], "RedirectResponse") {
+		success = 0
+	} else if code := plugins.StatusCode(dec.KeywordArguments["status_code"]); code > 0 {
+		success = code
+	}
+	if len(raised) == 0 && (success == http.StatusOK || success == 0) {
+		return
+	}
+
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+
+	if success > 0 {
+		resp, ok := route.Responses["200"]
+		if !ok {
+			resp = types.Response{Description: "Successful Response"}
+			if success != http.StatusOK {
+				resp.Description = http.StatusText(success)
+			}
+		}
+		if success == http.StatusNoContent {
+			resp = types.Response{Description: http.StatusText(success)}
+		}
+		delete(route.Responses, "200")
+		route.Responses[strconv.Itoa(success)] = resp
+	}
+
+	for _, code := range raised {
+		status := strconv.Itoa(code)
+		if _, ok := route.Responses[status]; !ok {
+			route.Responses[status] = types.Response{Description: http.StatusText(code)}
+		}
+	}
+}
+
+// findRedirects returns the status codes of redirects issued by a path operation,
+// either through response_class=RedirectResponse or RedirectResponse(...) calls.
+// FastAPI's RedirectResponse defaults to 307 Temporary Redirect.
+func (p *Plugin) findRedirects(dec parser.PythonDecorator, fn parser.PythonDecoratedFunction, content []byte) []int {
+	var codes []int
+
+	if strings.HasSuffix(dec.KeywordArguments["response_class"], "RedirectResponse") {
+		code := http.StatusTemporaryRedirect
+		if parsed := plugins.StatusCode(dec.KeywordArguments["status_code"]); parsed > 0 {
+			code = parsed
+		}
+		codes = append(codes, code)
+	}
+
+	if fn.Node == nil {
+		return codes
+	}
+
+	for _, call := range p.pyParser.FindCalls(fn.Node, content, "RedirectResponse") {
+		code := http.StatusTemporaryRedirect
+		value, ok := call.KeywordArguments["status_code"]
+		if !ok && len(call.Arguments) > 1 {
+			value = call.Arguments[1]
+		}
+		if parsed := plugins.StatusCode(value); parsed > 0 {
+			code = parsed
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// applyRedirects adds a 3xx response with a Location header for each redirect.
+func applyRedirects(route *types.Route, codes []int) {
+	for _, code := range codes {
+		if route.Responses == nil {
+			route.Responses = make(map[string]types.Response)
+		}
+		route.Responses[strconv.Itoa(code)] = types.Response{
+			Description: http.StatusText(code),
+			Headers: map[string]types.Header{
+				"Location": {
+					Description: "Redirect target",
+					Schema:      &types.Schema{Type: "string"},
+				},
+			},
+		}
+	}
+}
+
 // extractQueryParams extracts query parameters from function signature.
-func (p *Plugin) extractQueryParams(fn parser.PythonDecoratedFunction, _ []byte) []types.Parameter {
+// Class dependencies expand into the query parameters of their constructor.
+func (p *Plugin) extractQueryParams(fn parser.PythonDecoratedFunction, deps dependencyClasses, aliases typeAliases) []types.Parameter {
+	return p.queryParams(fn.Parameters, deps, aliases, make(map[string]bool))
+}
+
+// queryParams converts signature parameters to query parameters. seen
+// guards against dependency classes that depend on themselves.
+func (p *Plugin) queryParams(signature []parser.PythonParameter, deps dependencyClasses, aliases typeAliases, seen map[string]bool) []types.Parameter {
 	var params []types.Parameter
 
-	for _, param := range fn.Parameters {
+	for _, param := range signature {
+		// Depends() runs the dependency with the request instead of reading
+		// the parameter; classes read their constructor's parameters
+		if target, ok := dependencyTarget(param); ok {
+			if ctor, ok := deps[target]; ok && !seen[target] {
+				seen[target] = true
+				params = append(params, p.queryParams(ctor, deps, aliases, seen)...)
+				delete(seen, target)
+			}
+			continue
+		}
+
 		// Skip common non-query parameters
 		if param.Name == "self" || param.Name == "request" || param.Name == "db" ||
 			param.Name == "session" || param.Name == "background_tasks" {
 			continue
 		}
 
+		// Annotated metadata may come from a type alias
+		declared := param.Type + " " + strings.Join(parser.ResolvePythonType(param.Type, aliases).Metadata, " ")
+
 		// Check if it's a path parameter (these are handled separately)
 		// Path params are typically typed as Path(...) or have no default
-		if strings.Contains(param.Type, "Path") {
+		if strings.Contains(declared, "Path") {
 			continue
 		}
 
-		// Check if it's a query parameter (Query(...) or has default)
-		if strings.Contains(param.Type, "Query") || !param.IsRequired {
-			openAPIType, format := parser.PythonTypeToOpenAPI(param.Type)
+		// Check if it's a query parameter (Query(...) or has default).
+		// Inside a dependency class every scalar parameter is read from the query.
+		if strings.Contains(declared, "Query") || !param.IsRequired || len(seen) > 0 {
+			schema := typeSchema(param.Type, aliases)
+			// Optional parameters are expressed by Required
+			schema.Nullable = false
 
 			queryParam := types.Parameter{
 				Name:     param.Name,
 				In:       "query",
 				Required: param.IsRequired,
-				Schema: &types.Schema{
-					Type:   openAPIType,
-					Format: format,
-				},
+				Schema:   schema,
+			}
+			if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(param.Default), schema.Type); ok {
+				queryParam.Schema.Default = value
 			}
 
 			params = append(params, queryParam)
@@ -402,25 +619,192 @@ func (p *Plugin) extractQueryParams(fn parser.PythonDecoratedFunction, _ []byte)
 	return params
 }
 
+// dependencyClasses maps classes usable with Depends() to the parameters
+// of their constructor.
+type dependencyClasses map[string][]parser.PythonParameter
+
+// collectDependencyClasses indexes the constructor parameters of Pydantic
+// models and classes defining __init__ across all files. FastAPI resolves a
+// class dependency by calling it with parameters read from the request.
+func (p *Plugin) collectDependencyClasses(ctx context.Context, files []scanner.SourceFile) dependencyClasses {
+	deps := make(dependencyClasses)
+
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, model := range pf.PydanticModels {
+			params := make([]parser.PythonParameter, 0, len(model.Fields))
+			for _, field := range model.Fields {
+				params = append(params, parser.PythonParameter{
+					Name:       field.Name,
+					Type:       field.Type,
+					Default:    field.Default,
+					IsRequired: !field.IsOptional,
+				})
+			}
+			deps[model.Name] = params
+		}
+
+		for _, cls := range pf.Classes {
+			if _, ok := deps[cls.Name]; ok {
+				continue
+			}
+			for _, method := range cls.Methods {
+				if method.Name != "__init__" {
+					continue
+				}
+				var params []parser.PythonParameter
+				for _, param := range method.Parameters {
+					if param.Name != "self" && !strings.HasPrefix(param.Name, "*") {
+						params = append(params, param)
+					}
+				}
+				deps[cls.Name] = params
+			}
+		}
+
+		pf.Close()
+	}
+
+	return deps
+}
+
+// typeAliases maps type alias names to the annotation they stand for.
+type typeAliases map[string]string
+
+// collectTypeAliases gathers the module-level type aliases of all files, as
+// models and signatures may use aliases imported from other modules.
+func (p *Plugin) collectTypeAliases(ctx context.Context, files []scanner.SourceFile) typeAliases {
+	aliases := make(typeAliases)
+
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for name, annotation := range pf.TypeAliases {
+			aliases[name] = annotation
+		}
+
+		pf.Close()
+	}
+
+	return aliases
+}
+
+// typeSchema converts a type annotation to a schema, resolving aliases,
+// NewType, Annotated, Optional and unions, and turning Literal[...] into an
+// enumeration.
+func typeSchema(annotation string, aliases typeAliases) *types.Schema {
+	resolved := parser.ResolvePythonType(annotation, aliases)
+
+	var schema *types.Schema
+	switch {
+	case len(resolved.Literals) > 0:
+		openAPIType, _ := parser.PythonTypeToOpenAPI(resolved.Type)
+		if resolved.Type == "" {
+			openAPIType = ""
+		}
+		schema = &types.Schema{Type: openAPIType, Enum: resolved.Literals}
+	case len(resolved.Union) > 0:
+		schema = &types.Schema{}
+		for _, member := range resolved.Union {
+			schema.AnyOf = append(schema.AnyOf, typeSchema(member, aliases))
+		}
+	default:
+		typeName := resolved.Type
+		name, _, _ := strings.Cut(typeName, "[")
+		switch strings.TrimPrefix(name, "typing.") {
+		case "list", "List", "Sequence", "set", "Set", "frozenset", "FrozenSet":
+			schema = &types.Schema{Type: "array", Items: typeSchema(extractGenericType(typeName), aliases)}
+			if strings.Contains(strings.ToLower(name), "set") {
+				schema.UniqueItems = true
+			}
+		case "tuple", "Tuple":
+			// Only homogeneous tuple[T, ...] map to an item schema
+			schema = &types.Schema{Type: "array"}
+			if item, ok := strings.CutSuffix(extractGenericType(typeName), ", ..."); ok {
+				schema.Items = typeSchema(item, aliases)
+			}
+		default:
+			if valueType, ok := parser.PythonDictValueType(typeName); ok {
+				schema = &types.Schema{Type: "object", AdditionalProperties: typeSchema(valueType, aliases)}
+				break
+			}
+			schema = parser.PythonTypeSchema(typeName)
+		}
+	}
+
+	schema.Nullable = resolved.Nullable
+	return schema
+}
+
+// dependsRegex matches a Depends(...) or Security(...) dependency declaration.
+var dependsRegex = regexp.MustCompile(`^(?:Depends|Security)\((.*)\)$`)
+
+// annotatedDependsRegex matches Annotated[Type, Depends(...)].
+var annotatedDependsRegex = regexp.MustCompile(`^Annotated\[(.+?),\s*((?:Depends|Security)\(.*\))\s*\]$`)
+
+// dependencyTarget returns the dependency a parameter declares with
+// Depends(...), either as its default or as Annotated metadata. A bare
+// Depends() depends on the annotated class.
+func dependencyTarget(param parser.PythonParameter) (string, bool) {
+	typeName, expr := param.Type, strings.TrimSpace(param.Default)
+	if m := annotatedDependsRegex.FindStringSubmatch(typeName); m != nil {
+		typeName, expr = strings.TrimSpace(m[1]), m[2]
+	}
+
+	m := dependsRegex.FindStringSubmatch(expr)
+	if m == nil {
+		return "", false
+	}
+
+	target := strings.TrimSpace(strings.SplitN(m[1], ",", 2)[0])
+	if target == "" || strings.Contains(target, "=") {
+		target = typeName
+	}
+	if i := strings.LastIndex(target, "."); i >= 0 {
+		target = target[i+1:]
+	}
+	return target, true
+}
+
 // extractRequestBody extracts request body from function signature.
-func (p *Plugin) extractRequestBody(fn parser.PythonDecoratedFunction, _ []byte) *types.RequestBody {
+func (p *Plugin) extractRequestBody(fn parser.PythonDecoratedFunction, aliases typeAliases) *types.RequestBody {
 	for _, param := range fn.Parameters {
 		// Look for Pydantic model types (typically the request body)
 		// These are usually capitalized and not standard types
-		if param.Type == "" || strings.HasPrefix(param.Type, "Optional") {
+		resolved := parser.ResolvePythonType(param.Type, aliases)
+		if param.Type == "" || resolved.Nullable || len(resolved.Union) > 0 || len(resolved.Literals) > 0 {
 			continue
 		}
+		declared := param.Type + " " + strings.Join(resolved.Metadata, " ")
 
 		// Skip common non-body parameters
+		if _, ok := dependencyTarget(param); ok {
+			continue
+		}
 		if param.Name == "self" || param.Name == "request" || param.Name == "db" ||
 			param.Name == "session" || param.Name == "background_tasks" ||
-			strings.Contains(param.Type, "Query") || strings.Contains(param.Type, "Path") ||
-			strings.Contains(param.Type, "Header") || strings.Contains(param.Type, "Cookie") {
+			strings.Contains(declared, "Query") || strings.Contains(declared, "Path") ||
+			strings.Contains(declared, "Header") || strings.Contains(declared, "Cookie") {
 			continue
 		}
 
 		// Check if type looks like a Pydantic model (capitalized, not a builtin)
-		typeName := param.Type
+		typeName := resolved.Type
 		if strings.Contains(typeName, "[") {
 			// Handle Optional[Type], List[Type], etc.
 			typeName = extractGenericType(typeName)
@@ -456,69 +840,125 @@ func (p *Plugin) extractRequestBody(fn parser.PythonDecoratedFunction, _ []byte)
 
 // ExtractSchemas extracts schema definitions from Pydantic models.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	var schemas []types.Schema
+	excluded := make(map[string]map[string]bool)
+	aliases := p.collectTypeAliases(ctx, files)
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		pf, err := p.pyParser.Parse(file.Path, file.Content)
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
 
 		for _, model := range pf.PydanticModels {
-			schema := p.pydanticModelToSchema(model)
+			schema := p.pydanticModelToSchema(model, aliases)
 			if schema != nil {
 				schemas = append(schemas, *schema)
 			}
 		}
+		for _, fn := range pf.DecoratedFunctions {
+			for _, dec := range fn.Decorators {
+				collectResponseExclusions(dec, excluded)
+			}
+		}
 
 		pf.Close()
 	}
 
+	// Fields a route leaves out of its response_model are accepted in
+	// requests but never returned
+	for i := range schemas {
+		for name := range excluded[schemas[i].Title] {
+			if prop := schemas[i].Properties[name]; prop != nil {
+				prop.WriteOnly = true
+			}
+		}
+	}
+
 	return schemas, nil
 }
 
+// listTypePrefixes open the sequence types a response_model may be
+// declared as.
+var listTypePrefixes = []string{"list[", "List[", "typing.List[", "Sequence[", "typing.Sequence[", "set[", "Set["}
+
+// responseModelSchema returns the schema of a response_model: a reference
+// to a model, an array of the schemas of list[User] or List[User], or a
+// builtin type.
+func responseModelSchema(model string) *types.Schema {
+	model = strings.TrimSpace(model)
+	for _, prefix := range listTypePrefixes {
+		if strings.HasPrefix(model, prefix) && strings.HasSuffix(model, "]") {
+			return &types.Schema{Type: "array", Items: responseModelSchema(extractGenericType(model))}
+		}
+	}
+	if inner, ok := strings.CutPrefix(model, "Optional["); ok {
+		return responseModelSchema(strings.TrimSuffix(inner, "]"))
+	}
+	if schema := parser.PythonTypeSchema(model); schema.Fallback == "" {
+		return schema
+	}
+	return &types.Schema{Ref: "#/components/schemas/" + model[strings.LastIndex(model, ".")+1:]}
+}
+
+// responseModelNameRegex matches the model in response_model=User or List[User].
+var responseModelNameRegex = regexp.MustCompile(`(\w+)\]*\s*$`)
+
+// quotedNameRegex matches quoted field names in response_model_exclude.
+var quotedNameRegex = regexp.MustCompile(`['"]([^'"]+)['"]`)
+
+// collectResponseExclusions records the response_model_exclude fields of a
+// route decorator by response model name.
+func collectResponseExclusions(dec parser.PythonDecorator, excluded map[string]map[string]bool) {
+	model, ok := dec.KeywordArguments["response_model"]
+	if !ok {
+		return
+	}
+	exclude, ok := dec.KeywordArguments["response_model_exclude"]
+	if !ok {
+		return
+	}
+	m := responseModelNameRegex.FindStringSubmatch(model)
+	if m == nil {
+		return
+	}
+
+	for _, field := range quotedNameRegex.FindAllStringSubmatch(exclude, -1) {
+		if excluded[m[1]] == nil {
+			excluded[m[1]] = make(map[string]bool)
+		}
+		excluded[m[1]][field[1]] = true
+	}
+}
+
 // pydanticModelToSchema converts a Pydantic model to an OpenAPI schema.
-func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema {
+func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel, aliases typeAliases) *types.Schema {
 	schema := &types.Schema{
 		Title:      model.Name,
 		Type:       "object",
 		Properties: make(map[string]*types.Schema),
 		Required:   []string{},
+		Source:     model.Source(),
 	}
 
 	for _, field := range model.Fields {
-		propSchema := &types.Schema{}
-
-		// Convert Python type to OpenAPI type
-		openAPIType, format := parser.PythonTypeToOpenAPI(field.Type)
-		propSchema.Type = openAPIType
-		if format != "" {
-			propSchema.Format = format
-		}
-
-		// Handle array types
-		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
-			propSchema.Type = "array"
-			// Extract inner type
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.PythonTypeToOpenAPI(innerType)
-			propSchema.Items = &types.Schema{
-				Type:   innerOpenAPIType,
-				Format: innerFormat,
-			}
-		}
+		propSchema := typeSchema(field.Type, aliases)
 
-		// Handle Optional types
-		if strings.HasPrefix(field.Type, "Optional[") {
-			propSchema.Nullable = true
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.PythonTypeToOpenAPI(innerType)
-			propSchema.Type = innerOpenAPIType
-			propSchema.Format = innerFormat
+		// Emit constant defaults typed for the property
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
 		}
 
 		if field.Description != "" {
@@ -527,7 +967,7 @@ func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema
 
 		schema.Properties[field.Name] = propSchema
 
-		if !field.IsOptional && field.Default == "" {
+		if !propSchema.Nullable && !parser.PythonHasDefault(field.Default) {
 			schema.Required = append(schema.Required, field.Name)
 		}
 	}
@@ -177,6 +177,70 @@ async def test_head():
     return 'head'
 `
 
+// fastAPIRedirectCode is a test fixture for redirect detection.
+const fastAPIRedirectCode = `
+from fastapi import FastAPI, status
+from fastapi.responses import RedirectResponse
+
+app = FastAPI()
+
+@app.get('/docs-old', response_class=RedirectResponse, status_code=301)
+async def docs_old():
+    return '/docs'
+
+@app.get('/go/{slug}')
+async def go(slug: str):
+    return RedirectResponse(url='/target')
+
+@app.post('/logout')
+async def logout():
+    return RedirectResponse('/', status_code=status.HTTP_303_SEE_OTHER)
+`
+
+// fastAPIStreamingCode is a test fixture for streaming response detection.
+const fastAPIStreamingCode = `
+from fastapi import FastAPI
+from fastapi.responses import StreamingResponse
+from sse_starlette.sse import EventSourceResponse
+
+app = FastAPI()
+
+@app.get('/events')
+async def events():
+    return StreamingResponse(event_generator(), media_type="text/event-stream")
+
+@app.get('/ticks')
+async def ticks():
+    return EventSourceResponse(tick_generator())
+
+@app.get('/download')
+async def download():
+    return StreamingResponse(file_iterator())
+`
+
+// fastAPIMultiStatusCode is a test fixture for status inference.
+const fastAPIMultiStatusCode = `
+from fastapi import FastAPI, HTTPException, status
+
+app = FastAPI()
+
+@app.get('/items/{item_id}', response_model=Item)
+async def read_item(item_id: int):
+    if item_id not in items:
+        raise HTTPException(status_code=404, detail="Item not found")
+    return items[item_id]
+
+@app.post('/items', status_code=status.HTTP_201_CREATED)
+async def create_item(item: Item):
+    if item.name in names:
+        raise HTTPException(status.HTTP_409_CONFLICT, "Duplicate")
+    return item
+
+@app.delete('/items/{item_id}', status_code=204)
+async def delete_item(item_id: int):
+    items.pop(item_id)
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "fastapi", p.Name())
@@ -322,6 +386,44 @@ func TestPlugin_ExtractRoutes_RouterPrefix(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_ResponseModel(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "main.py",
+			Language: "python",
+			Content: []byte(fastAPIResponseModelCode + `
+@app.get('/names', response_model=list[str])
+async def get_names():
+    return []
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	getUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, getUsers)
+	schema := getUsers.Responses["200"].Content["application/json"].Schema
+	require.NotNil(t, schema)
+	assert.Equal(t, "array", schema.Type)
+	require.NotNil(t, schema.Items)
+	assert.Equal(t, "#/components/schemas/User", schema.Items.Ref)
+
+	getUser := findRoute(routes, "GET", "/users/{user_id}")
+	require.NotNil(t, getUser)
+	assert.Equal(t, "#/components/schemas/User", getUser.Responses["200"].Content["application/json"].Schema.Ref)
+
+	getNames := findRoute(routes, "GET", "/names")
+	require.NotNil(t, getNames)
+	schema = getNames.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", schema.Type)
+	require.NotNil(t, schema.Items)
+	assert.Equal(t, "string", schema.Items.Type)
+}
+
 func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	p := New()
 
@@ -350,6 +452,94 @@ func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	assert.True(t, methods["HEAD"])
 }
 
+func TestPlugin_ExtractRoutes_Redirects(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "main.py",
+			Language: "python",
+			Content:  []byte(fastAPIRedirectCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	codes := make(map[string][]string)
+	for _, r := range routes {
+		for status, resp := range r.Responses {
+			assert.Contains(t, resp.Headers, "Location", "%s %s %s", r.Method, r.Path, status)
+			codes[r.Method+" "+r.Path] = append(codes[r.Method+" "+r.Path], status)
+		}
+	}
+
+	assert.Equal(t, []string{"301"}, codes["GET /docs-old"])
+	assert.Equal(t, []string{"307"}, codes["GET /go/{slug}"])
+	assert.Equal(t, []string{"303"}, codes["POST /logout"])
+}
+
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "main.py",
+			Language: "python",
+			Content:  []byte(fastAPIMultiStatusCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// response_model stays on the 200 response
+	read := findRoute(routes, "GET", "/items/{item_id}")
+	require.NotNil(t, read)
+	require.Contains(t, read.Responses, "200")
+	assert.NotEmpty(t, read.Responses["200"].Content)
+	assert.Contains(t, read.Responses, "404")
+
+	create := findRoute(routes, "POST", "/items")
+	require.NotNil(t, create)
+	assert.Len(t, create.Responses, 2)
+	assert.Contains(t, create.Responses, "201")
+	assert.Contains(t, create.Responses, "409")
+
+	del := findRoute(routes, "DELETE", "/items/{item_id}")
+	require.NotNil(t, del)
+	require.Len(t, del.Responses, 1)
+	assert.Equal(t, "No Content", del.Responses["204"].Description)
+}
+
+func TestPlugin_ExtractRoutes_Streaming(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "main.py",
+			Language: "python",
+			Content:  []byte(fastAPIStreamingCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	for _, path := range []string{"/events", "/ticks"} {
+		route := findRoute(routes, "GET", path)
+		require.NotNil(t, route, path)
+		require.Contains(t, route.Responses, "200", path)
+		assert.Contains(t, route.Responses["200"].Content, "text/event-stream", path)
+		assert.Equal(t, true, route.Extensions["x-sse"], path)
+	}
+
+	download := findRoute(routes, "GET", "/download")
+	require.NotNil(t, download)
+	assert.Contains(t, download.Responses["200"].Content, "application/octet-stream")
+	assert.Empty(t, download.Extensions)
+}
+
 func TestPlugin_ExtractRoutes_IgnoresNonPython(t *testing.T) {
 	p := New()
 
@@ -413,6 +603,70 @@ func TestPlugin_ExtractRoutes_SourceInfo(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_ClassDependencies(t *testing.T) {
+	p := New()
+
+	deps := `
+from typing import Optional
+from pydantic import BaseModel
+
+class Pagination:
+    def __init__(self, skip: int = 0, limit: int = 100):
+        self.skip = skip
+        self.limit = limit
+
+class ItemFilter(BaseModel):
+    q: Optional[str] = None
+    category: str
+`
+	app := `
+from typing import Annotated
+from fastapi import FastAPI, Depends
+from deps import Pagination, ItemFilter
+
+app = FastAPI()
+
+@app.get("/items")
+def list_items(page: Pagination = Depends(), user: User = Depends(get_current_user)):
+    return []
+
+@app.get("/search")
+def search(filters: Annotated[ItemFilter, Depends()]):
+    return []
+`
+	files := []scanner.SourceFile{
+		{Path: "deps.py", Language: "python", Content: []byte(deps)},
+		{Path: "main.py", Language: "python", Content: []byte(app)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	params := func(r types.Route) map[string]types.Parameter {
+		byName := make(map[string]types.Parameter)
+		for _, param := range r.Parameters {
+			byName[param.Name] = param
+		}
+		return byName
+	}
+
+	items := params(routes[0])
+	require.Len(t, items, 2)
+	assert.Equal(t, "query", items["skip"].In)
+	assert.Equal(t, "integer", items["skip"].Schema.Type)
+	assert.Equal(t, int64(0), items["skip"].Schema.Default)
+	assert.Equal(t, int64(100), items["limit"].Schema.Default)
+	assert.Nil(t, routes[0].RequestBody)
+
+	search := params(routes[1])
+	require.Len(t, search, 2)
+	assert.False(t, search["q"].Required)
+	assert.True(t, search["category"].Required)
+	assert.Equal(t, "string", search["category"].Schema.Type)
+	assert.Nil(t, routes[1].RequestBody)
+}
+
 func TestPlugin_ExtractSchemas_Pydantic(t *testing.T) {
 	p := New()
 
@@ -476,6 +730,140 @@ func TestPlugin_ExtractSchemas_OptionalFields(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractSchemas_DictFields(t *testing.T) {
+	p := New()
+
+	code := `
+from typing import Dict
+from pydantic import BaseModel
+
+class Inventory(BaseModel):
+    counts: Dict[str, int]
+    labels: dict[str, str]
+`
+	files := []scanner.SourceFile{
+		{Path: "models.py", Language: "python", Content: []byte(code)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	counts := schemas[0].Properties["counts"]
+	require.NotNil(t, counts)
+	assert.Equal(t, "object", counts.Type)
+	require.NotNil(t, counts.AdditionalProperties)
+	assert.Equal(t, "integer", counts.AdditionalProperties.Type)
+	assert.Equal(t, "string", schemas[0].Properties["labels"].AdditionalProperties.Type)
+}
+
+func TestPlugin_ExtractSchemas_Defaults(t *testing.T) {
+	p := New()
+
+	code := `
+from fastapi import FastAPI, Query
+from pydantic import BaseModel, Field
+
+app = FastAPI()
+
+class Settings(BaseModel):
+    theme: str = "dark"
+    page_size: int = Field(25, ge=1)
+    ratio: float = 1
+    beta: bool = False
+    tags: List[str] = Field(default_factory=list)
+    name: str = Field(...)
+
+@app.get("/items")
+def list_items(limit: int = Query(10, le=100), order: str = "asc"):
+    return []
+`
+	files := []scanner.SourceFile{
+		{Path: "main.py", Language: "python", Content: []byte(code)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	props := schemas[0].Properties
+	assert.Equal(t, "dark", props["theme"].Default)
+	assert.Equal(t, int64(25), props["page_size"].Default)
+	assert.Equal(t, float64(1), props["ratio"].Default)
+	assert.Equal(t, false, props["beta"].Default)
+	assert.Equal(t, []any{}, props["tags"].Default)
+	assert.Nil(t, props["name"].Default)
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	defaults := make(map[string]any)
+	for _, param := range routes[0].Parameters {
+		defaults[param.Name] = param.Schema.Default
+	}
+	assert.Equal(t, int64(10), defaults["limit"])
+	assert.Equal(t, "asc", defaults["order"])
+}
+
+func TestPlugin_ExtractSchemas_FieldDescriptions(t *testing.T) {
+	code := `
+from pydantic import BaseModel, Field
+
+class Item(BaseModel):
+    name: str = Field(..., description="Display name")
+    price: float  # unit price in EUR
+    sku: str
+    """Stock keeping unit."""
+    notes: str
+`
+	files := []scanner.SourceFile{
+		{Path: "models.py", Language: "python", Content: []byte(code)},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	props := schemas[0].Properties
+	assert.Equal(t, "Display name", props["name"].Description)
+	assert.Equal(t, "unit price in EUR", props["price"].Description)
+	assert.Equal(t, "Stock keeping unit.", props["sku"].Description)
+	assert.Empty(t, props["notes"].Description)
+}
+
+func TestPlugin_ExtractSchemas_ResponseModelExclude(t *testing.T) {
+	p := New()
+
+	code := `
+from fastapi import FastAPI
+from pydantic import BaseModel
+
+app = FastAPI()
+
+class User(BaseModel):
+    id: int
+    email: str
+    password: str
+
+@app.post("/users", response_model=User, response_model_exclude={"password"})
+def create_user(user: User):
+    return user
+`
+	files := []scanner.SourceFile{
+		{Path: "main.py", Language: "python", Content: []byte(code)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	user := schemas[0]
+	require.Contains(t, user.Properties, "password")
+	assert.True(t, user.Properties["password"].WriteOnly)
+	assert.False(t, user.Properties["email"].WriteOnly)
+}
+
 func TestNormalizePathParams(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -496,6 +884,145 @@ func TestNormalizePathParams(t *testing.T) {
 	}
 }
 
+func TestPlugin_TypeAliases(t *testing.T) {
+	p := New()
+
+	aliases := `
+from typing import Annotated, Literal, NewType, TypeAlias
+from fastapi import Query
+
+UserId = NewType("UserId", int)
+Status = Literal["active", "blocked"]
+Tags: TypeAlias = list[str]
+PageSize = Annotated[int, Query(le=100)]
+`
+	app := `
+from typing import Union
+from fastapi import FastAPI
+from pydantic import BaseModel
+from aliases import PageSize, Status, Tags, UserId
+
+app = FastAPI()
+
+class User(BaseModel):
+    id: UserId
+    status: Status
+    tags: Tags
+    score: int | float
+    nickname: str | None
+
+@app.get("/users")
+def list_users(size: PageSize, status: Status = "active", ids: list[UserId] = []):
+    return []
+`
+	files := []scanner.SourceFile{
+		{Path: "aliases.py", Language: "python", Content: []byte(aliases)},
+		{Path: "main.py", Language: "python", Content: []byte(app)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Len(t, routes[0].Parameters, 3)
+
+	size := routes[0].Parameters[0]
+	assert.Equal(t, "size", size.Name)
+	assert.True(t, size.Required)
+	assert.Equal(t, "integer", size.Schema.Type)
+
+	status := routes[0].Parameters[1]
+	assert.Equal(t, "string", status.Schema.Type)
+	assert.Equal(t, []interface{}{"active", "blocked"}, status.Schema.Enum)
+	assert.Equal(t, "active", status.Schema.Default)
+
+	ids := routes[0].Parameters[2]
+	assert.Equal(t, "array", ids.Schema.Type)
+	assert.Equal(t, "integer", ids.Schema.Items.Type)
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	props := schemas[0].Properties
+	assert.Equal(t, "integer", props["id"].Type)
+	assert.Equal(t, []interface{}{"active", "blocked"}, props["status"].Enum)
+	assert.Equal(t, "array", props["tags"].Type)
+	assert.Equal(t, "string", props["tags"].Items.Type)
+	require.Len(t, props["score"].AnyOf, 2)
+	assert.Equal(t, "number", props["score"].AnyOf[1].Type)
+	assert.Equal(t, "string", props["nickname"].Type)
+	assert.True(t, props["nickname"].Nullable)
+	assert.ElementsMatch(t, []string{"id", "status", "tags", "score"}, schemas[0].Required)
+}
+
+func TestPlugin_Dataclasses(t *testing.T) {
+	p := New()
+
+	code := `
+from dataclasses import dataclass, field
+from typing import ClassVar, Optional
+import attrs
+from fastapi import FastAPI
+
+app = FastAPI()
+
+@dataclass(frozen=True)
+class Item:
+    name: str
+    price: float = 0.0
+    tags: list[str] = field(default_factory=list)
+    sku: str = field(metadata={"unit": "code"})
+    note: Optional[str] = None
+    registry: ClassVar[dict] = {}
+
+@dataclass
+class DiscountedItem(Item):
+    discount: int = 10
+
+@attrs.define
+class Order:
+    quantity: int = attrs.field(default=1)
+    item: Item
+
+class Plain:
+    value: int
+
+@app.post("/items")
+def create_item(item: Item):
+    return item
+`
+	files := []scanner.SourceFile{
+		{Path: "main.py", Language: "python", Content: []byte(code)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.NotNil(t, routes[0].RequestBody)
+	assert.Equal(t, "#/components/schemas/Item", routes[0].RequestBody.Content["application/json"].Schema.Ref)
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+	require.Len(t, byName, 3)
+
+	item := byName["Item"]
+	assert.Len(t, item.Properties, 5)
+	assert.NotContains(t, item.Properties, "registry")
+	assert.Equal(t, 0.0, item.Properties["price"].Default)
+	assert.Equal(t, []interface{}{}, item.Properties["tags"].Default)
+	assert.True(t, item.Properties["note"].Nullable)
+	assert.ElementsMatch(t, []string{"name", "sku"}, item.Required)
+
+	assert.Contains(t, byName["DiscountedItem"].Properties, "name")
+	assert.Equal(t, int64(1), byName["Order"].Properties["quantity"].Default)
+	assert.Equal(t, []string{"item"}, byName["Order"].Required)
+}
+
 func TestExtractPathParams(t *testing.T) {
 	tests := []struct {
 		path       string
@@ -17,6 +17,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -148,7 +149,7 @@ type endpointInfo struct {
 // extractRoutesFromFile extracts routes from a single C# file.
 func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	lines := strings.Split(string(file.Content), "\n")
+	lines := util.SplitLines(string(file.Content))
 
 	// Find all endpoint classes and their line ranges
 	endpoints := p.findEndpointClasses(lines)
@@ -5,17 +5,22 @@
 package fastify
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -60,6 +65,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
 }
 
+// RouteMarkers returns the Fastify module name, which every file defining
+// routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return []string{"fastify"}
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -106,14 +117,22 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Fastify route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -132,8 +151,8 @@ type fastifyInfo struct {
 }
 
 // extractRoutesFromFile extracts routes from a single TypeScript/JavaScript file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +160,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports Fastify
 	if !p.hasFastifyImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no Fastify import")
 		return nil, nil
 	}
 
@@ -153,16 +173,33 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 	}
 
 	// Track fastify instances and their prefixes
-	fastifyInstances := p.findFastifyInstances(pf.RootNode, file.Content)
+	fastifyInstances := p.findFastifyInstances(file.Path, pf.RootNode, file.Content)
 
 	// Track plugin registrations with prefixes
 	pluginPrefixes := p.findPluginPrefixes(pf.RootNode, file.Content, fastifyInstances)
 
+	// Track validators attached to instances through addHook()
+	typed := &fileTypes{
+		interfaces: make(map[string]parser.TSInterface),
+		zodSchemas: zodSchemas,
+		functions:  p.findFunctions(pf.RootNode, file.Content),
+	}
+	for _, iface := range pf.Interfaces {
+		typed.interfaces[iface.Name] = iface
+	}
+	typed.decorators = p.findDecorators(pf.RootNode, file.Content)
+	hooks := p.findHookValidations(pf.RootNode, file.Content, fastifyInstances, zodSchemas)
+	hookReplies := p.findHookReplies(pf.RootNode, file.Content, fastifyInstances, typed)
+
 	// Find all call expressions
 	calls := p.tsParser.FindCallExpressions(pf.RootNode, file.Content)
 
 	for _, call := range calls {
 		extractedRoutes := p.extractRoutesFromCall(call, file.Content, fastifyInstances, pluginPrefixes, zodSchemas)
+		if len(extractedRoutes) > 0 {
+			p.applyRequestTyping(extractedRoutes, call, file.Content, typed, hooks)
+			p.applyReplyHeaders(extractedRoutes, call, file.Content, typed, hookReplies)
+		}
 		for i := range extractedRoutes {
 			extractedRoutes[i].SourceFile = file.Path
 			routes = append(routes, extractedRoutes[i])
@@ -217,7 +254,7 @@ func (p *Plugin) hasFastifyImport(rootNode *sitter.Node, content []byte) bool {
 }
 
 // findFastifyInstances finds variables that are Fastify instances.
-func (p *Plugin) findFastifyInstances(rootNode *sitter.Node, content []byte) map[string]*fastifyInfo {
+func (p *Plugin) findFastifyInstances(path string, rootNode *sitter.Node, content []byte) map[string]*fastifyInfo {
 	instances := make(map[string]*fastifyInfo)
 
 	p.walkNodes(rootNode, func(node *sitter.Node) bool {
@@ -234,6 +271,8 @@ func (p *Plugin) findFastifyInstances(rootNode *sitter.Node, content []byte) map
 						}
 						if child.Type() == "call_expression" {
 							calleeText := p.tsParser.GetCalleeText(child, content)
+							// Fastify().withTypeProvider<T>() still yields the instance
+							calleeText = strings.TrimSuffix(calleeText, "().withTypeProvider")
 							// Check for Fastify(), fastify(), or Fastify.default()
 							if calleeText == "Fastify" || calleeText == "fastify" ||
 								calleeText == "Fastify.default" || calleeText == "fastify.default" {
@@ -269,6 +308,7 @@ func (p *Plugin) findFastifyInstances(rootNode *sitter.Node, content []byte) map
 		instances["fastify"] = &fastifyInfo{name: "fastify"}
 		instances["app"] = &fastifyInfo{name: "app"}
 		instances["server"] = &fastifyInfo{name: "server"}
+		decisions.Assume(p.Name(), path, "no Fastify() variable found; assumed routes are registered on fastify, app or server")
 	}
 
 	return instances
@@ -371,6 +411,7 @@ func (p *Plugin) extractRoutesFromCall(
 	if object == "" || method == "" {
 		return nil
 	}
+	object = instanceName(object)
 
 	// Check for fastify.route() method
 	if method == "route" {
@@ -394,18 +435,15 @@ func (p *Plugin) extractRoutesFromCall(
 		return nil
 	}
 
-	// First argument should be the path
-	pathArg := args[0]
-	path := ""
-	if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-		path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-	}
+	// First argument should be the path, possibly built from constants
+	path, unresolved, _ := p.tsParser.ResolveStringExpression(args[0], content)
 
 	if path == "" {
 		return nil
 	}
 
 	// Convert Fastify path parameters (:param) to OpenAPI format ({param})
+	wildcard := plugins.IsCatchAll(path)
 	path = convertPathParams(path)
 
 	// Extract path parameters
@@ -437,6 +475,12 @@ func (p *Plugin) extractRoutesFromCall(
 		RequestBody: requestBody,
 		SourceLine:  int(node.StartPoint().Row) + 1,
 	}
+	if len(unresolved) > 0 {
+		route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
 
 	// Add response schemas if available
 	if len(responseSchemas) > 0 {
@@ -474,6 +518,7 @@ func (p *Plugin) extractRouteFromRouteMethod(
 	}
 
 	var method, url string
+	var unresolved []string
 	var methods []string
 	var requestBody *types.RequestBody
 	var responseSchemas map[int]*types.Schema
@@ -499,19 +544,14 @@ func (p *Plugin) extractRouteFromRouteMethod(
 				if valueNode != nil {
 					if valueNode.Type() == "string" {
 						method = strings.Trim(valueNode.Content(content), `"'`)
-					} else if valueNode.Type() == "array" {
-						// Handle array of methods
-						p.walkNodes(valueNode, func(m *sitter.Node) bool {
-							if m.Type() == "string" {
-								methods = append(methods, strings.Trim(m.Content(content), `"'`))
-							}
-							return true
-						})
+					} else if values, ok := p.tsParser.ResolveStringArray(valueNode, content); ok {
+						// Handle an array of methods, possibly a shared constant
+						methods = append(methods, values...)
 					}
 				}
-			case "url":
-				if valueNode != nil && valueNode.Type() == "string" {
-					url = strings.Trim(valueNode.Content(content), `"'`)
+			case "url", "path":
+				if valueNode != nil {
+					url, unresolved, _ = p.tsParser.ResolveStringExpression(valueNode, content)
 				}
 			case "schema":
 				if valueNode != nil && valueNode.Type() == "object" {
@@ -528,6 +568,7 @@ func (p *Plugin) extractRouteFromRouteMethod(
 	}
 
 	// Convert path parameters
+	wildcard := plugins.IsCatchAll(url)
 	url = convertPathParams(url)
 	params := extractPathParams(url)
 	tags := inferTags(url)
@@ -552,6 +593,12 @@ func (p *Plugin) extractRouteFromRouteMethod(
 			RequestBody: requestBody,
 			SourceLine:  int(node.StartPoint().Row) + 1,
 		}
+		if len(unresolved) > 0 {
+			route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
 
 		if len(responseSchemas) > 0 {
 			route.Responses = make(map[string]types.Response)
@@ -804,31 +851,950 @@ func (p *Plugin) extractJSONSchemaProperties(node *sitter.Node, content []byte)
 	return props
 }
 
-// walkNodes walks all nodes in the tree.
-func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+// findFunctions maps named function declarations and function-valued
+// variables to their nodes, so handlers passed by name can be inspected.
+func (p *Plugin) findFunctions(rootNode *sitter.Node, content []byte) map[string]*sitter.Node {
+	functions := make(map[string]*sitter.Node)
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "function_declaration":
+			if nameNode := node.ChildByFieldName("name"); nameNode != nil {
+				functions[nameNode.Content(content)] = node
+			}
+		case "variable_declarator":
+			nameNode := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if nameNode != nil && value != nil && isFunctionNode(value) {
+				functions[nameNode.Content(content)] = value
+			}
+		}
+		return true
+	})
+
+	return functions
+}
+
+// validationHooks lists the lifecycle hooks that commonly run shared request validators.
+var validationHooks = map[string]bool{
+	"onRequest":     true,
+	"preValidation": true,
+	"preHandler":    true,
+}
+
+// zodParseMethods lists the Zod methods that validate a value against a schema.
+var zodParseMethods = map[string]bool{
+	"parse":          true,
+	"safeParse":      true,
+	"parseAsync":     true,
+	"safeParseAsync": true,
+}
+
+// hookValidation holds the request schemas contributed by validation hooks.
+type hookValidation struct {
+	body  *types.Schema
+	query *types.Schema
+}
+
+// fileTypes holds type information collected from a single source file.
+type fileTypes struct {
+	// interfaces maps interface names to their definitions
+	interfaces map[string]parser.TSInterface
+
+	// zodSchemas maps Zod schema names to their nodes
+	zodSchemas map[string]*sitter.Node
+
+	// functions maps named functions and function-valued variables to their nodes
+	functions map[string]*sitter.Node
+
+	// decorators maps the names of functions added with decorate() to their
+	// nodes, as in fastify.decorate('authenticate', async (request, reply) => {})
+	decorators map[string]*sitter.Node
+}
+
+// typedField is a single named field resolved from a type or Zod schema.
+type typedField struct {
+	name     string
+	schema   *types.Schema
+	required bool
+}
+
+// findHookValidations finds fastify.addHook('preValidation', validator) calls
+// and returns the request schemas they validate, keyed by instance name.
+func (p *Plugin) findHookValidations(
+	rootNode *sitter.Node,
+	content []byte,
+	instances map[string]*fastifyInfo,
+	zodSchemas map[string]*sitter.Node,
+) map[string]*hookValidation {
+	hooks := make(map[string]*hookValidation)
+
+	for _, call := range p.tsParser.FindCallExpressions(rootNode, content) {
+		callee := call.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			continue
+		}
+
+		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		if method != "addHook" {
+			continue
+		}
+		object = instanceName(object)
+		if _, ok := instances[object]; !ok {
+			continue
+		}
+
+		args := p.tsParser.GetCallArguments(call, content)
+		if len(args) < 2 {
+			continue
+		}
+		hookName, _ := p.tsParser.ExtractStringLiteral(args[0], content)
+		if !validationHooks[hookName] {
+			continue
+		}
+
+		hv := p.validatorSchemas(args[1], content, zodSchemas)
+		if hv == nil {
+			continue
+		}
+		if existing, ok := hooks[object]; ok {
+			if existing.body == nil {
+				existing.body = hv.body
+			}
+			if existing.query == nil {
+				existing.query = hv.query
+			}
+			continue
+		}
+		hooks[object] = hv
+	}
+
+	return hooks
+}
+
+// routeHookValidation reads validators from the preValidation, preHandler,
+// and onRequest keys of a route options object.
+func (p *Plugin) routeHookValidation(
+	optionsNode *sitter.Node,
+	content []byte,
+	zodSchemas map[string]*sitter.Node,
+) *hookValidation {
+	var result *hookValidation
+
+	for i := 0; i < int(optionsNode.NamedChildCount()); i++ {
+		pair := optionsNode.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		key := pair.ChildByFieldName("key")
+		value := pair.ChildByFieldName("value")
+		if key == nil || value == nil || !validationHooks[key.Content(content)] {
+			continue
+		}
+
+		hv := p.validatorSchemas(value, content, zodSchemas)
+		if hv == nil {
+			continue
+		}
+		if result == nil {
+			result = &hookValidation{}
+		}
+		if result.body == nil {
+			result.body = hv.body
+		}
+		if result.query == nil {
+			result.query = hv.query
+		}
+	}
+
+	return result
+}
+
+// validatorSchemas finds the Zod schemas a hook validates. Two forms are
+// recognized: validator factories such as validateBody(CreateUserSchema) or
+// validate(z.object({...})), and direct calls such as
+// CreateUserSchema.parse(request.body) inside the hook function.
+// Factories whose name mentions "query" validate the querystring, those that
+// mention params or headers are ignored, and all others validate the body.
+func (p *Plugin) validatorSchemas(
+	node *sitter.Node,
+	content []byte,
+	zodSchemas map[string]*sitter.Node,
+) *hookValidation {
+	hv := &hookValidation{}
+
+	assign := func(target string, s *types.Schema) {
+		switch target {
+		case "body":
+			if hv.body == nil {
+				hv.body = s
+			}
+		case "query":
+			if hv.query == nil {
+				hv.query = s
+			}
+		}
+	}
+
+	p.walkNodes(node, func(n *sitter.Node) bool {
+		if n.Type() != "call_expression" {
+			return true
+		}
+
+		callee := n.Child(0)
+		args := p.tsParser.GetCallArguments(n, content)
+
+		// CreateUserSchema.parse(request.body)
+		if callee != nil && callee.Type() == "member_expression" {
+			object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+			if _, ok := zodSchemas[object]; ok && zodParseMethods[method] && len(args) > 0 {
+				arg := args[0].Content(content)
+				if strings.HasSuffix(arg, ".body") {
+					assign("body", schema.SchemaRef(object))
+				} else if strings.HasSuffix(arg, ".query") {
+					assign("query", p.zodObjectSchema(zodSchemas[object], content))
+				}
+				return false
+			}
+		}
+
+		// validateBody(CreateUserSchema), validate(z.object({...}))
+		calleeText := p.tsParser.GetCalleeText(n, content)
+		if strings.HasPrefix(calleeText, "z.") {
+			return false
+		}
+		target := "body"
+		lower := strings.ToLower(calleeText)
+		switch {
+		case strings.Contains(lower, "query"):
+			target = "query"
+		case strings.Contains(lower, "param"), strings.Contains(lower, "header"):
+			target = ""
+		}
+
+		for _, arg := range args {
+			switch arg.Type() {
+			case "identifier":
+				name := arg.Content(content)
+				zodNode, ok := zodSchemas[name]
+				if !ok {
+					continue
+				}
+				if target == "query" {
+					assign(target, p.zodObjectSchema(zodNode, content))
+				} else {
+					assign(target, schema.SchemaRef(name))
+				}
+			case "call_expression":
+				if strings.HasPrefix(p.tsParser.GetCalleeText(arg, content), "z.") {
+					assign(target, p.zodObjectSchema(arg, content))
+				}
+			}
+		}
+		return true
+	})
+
+	if hv.body == nil && hv.query == nil {
+		return nil
+	}
+	return hv
+}
+
+// zodObjectSchema parses a Zod schema node into an inline schema.
+func (p *Plugin) zodObjectSchema(node *sitter.Node, content []byte) *types.Schema {
+	parsed, err := p.zodParser.ParseZodSchema(node, content)
+	if err != nil {
+		return nil
+	}
+	return parsed
+}
+
+// applyRequestTyping enriches routes extracted from a call with request
+// schemas declared outside the schema option: type provider generics such as
+// fastify.post<{ Body: CreateUser }>(...), route-level validation hooks, and
+// validators registered on the instance through addHook().
+// Explicit schema options always take precedence.
+func (p *Plugin) applyRequestTyping(
+	routes []types.Route,
+	call *sitter.Node,
+	content []byte,
+	typed *fileTypes,
+	hooks map[string]*hookValidation,
+) {
+	callee := call.Child(0)
+	if callee == nil || callee.Type() != "member_expression" {
+		return
+	}
+	object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+	object = instanceName(object)
+
+	// Route options are the first argument of route() and the second of shorthands
+	var optionsNode *sitter.Node
+	args := p.tsParser.GetCallArguments(call, content)
+	if method == "route" && len(args) > 0 {
+		optionsNode = args[0]
+	} else if len(args) > 1 {
+		optionsNode = args[1]
+	}
+
+	var routeHooks *hookValidation
+	if optionsNode != nil && optionsNode.Type() == "object" {
+		routeHooks = p.routeHookValidation(optionsNode, content, typed.zodSchemas)
+	}
+
+	typeArgs := call.ChildByFieldName("type_arguments")
+
+	for i := range routes {
+		// Routes from route() with several methods share one parameter slice
+		routes[i].Parameters = append([]types.Parameter(nil), routes[i].Parameters...)
+		if typeArgs != nil && typeArgs.NamedChildCount() > 0 {
+			p.applyRouteGenerics(&routes[i], typeArgs.NamedChild(0), content, typed)
+		}
+		applyHookValidation(&routes[i], routeHooks)
+		applyHookValidation(&routes[i], hooks[object])
+	}
+}
+
+// applyRouteGenerics maps the RouteGenericInterface keys (Body, Querystring,
+// Params, Headers, Reply) of a route's type argument onto the route.
+func (p *Plugin) applyRouteGenerics(route *types.Route, node *sitter.Node, content []byte, typed *fileTypes) {
+	for _, entry := range p.genericEntries(node, content, typed) {
+		switch entry.name {
+		case "Body":
+			if route.RequestBody != nil {
+				continue
+			}
+			if bodySchema := typeSchema(entry.text, typed); bodySchema != nil {
+				route.RequestBody = &types.RequestBody{
+					Required: true,
+					Content: map[string]types.MediaType{
+						"application/json": {Schema: bodySchema},
+					},
+				}
+			}
+		case "Querystring", "Headers":
+			in := "query"
+			if entry.name == "Headers" {
+				in = "header"
+			}
+			for _, field := range p.typeFields(entry.node, entry.text, content, typed) {
+				addParameter(route, types.Parameter{
+					Name:     field.name,
+					In:       in,
+					Required: field.required,
+					Schema:   field.schema,
+				})
+			}
+		case "Params":
+			for _, field := range p.typeFields(entry.node, entry.text, content, typed) {
+				for j := range route.Parameters {
+					if route.Parameters[j].In == "path" && route.Parameters[j].Name == field.name {
+						route.Parameters[j].Schema = field.schema
+					}
+				}
+			}
+		case "Reply":
+			p.applyReplyGeneric(route, entry.node, entry.text, content, typed)
+		}
+	}
+}
+
+// genericEntry is a single key of a route generic type argument.
+type genericEntry struct {
+	name string
+	text string
+	node *sitter.Node
+}
+
+// genericEntries lists the keys of an inline route generic object type or of
+// a same-file interface used as the route generic.
+func (p *Plugin) genericEntries(node *sitter.Node, content []byte, typed *fileTypes) []genericEntry {
+	var entries []genericEntry
+
+	switch node.Type() {
+	case "object_type":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			sig := node.NamedChild(i)
+			if sig.Type() != "property_signature" {
+				continue
+			}
+			nameNode := sig.ChildByFieldName("name")
+			typeNode := typeAnnotationValue(sig.ChildByFieldName("type"))
+			if nameNode == nil || typeNode == nil {
+				continue
+			}
+			entries = append(entries, genericEntry{
+				name: strings.Trim(nameNode.Content(content), `"'`),
+				text: typeNode.Content(content),
+				node: typeNode,
+			})
+		}
+	case "type_identifier":
+		if iface, ok := typed.interfaces[node.Content(content)]; ok {
+			for _, prop := range iface.Properties {
+				entries = append(entries, genericEntry{name: prop.Name, text: prop.Type})
+			}
+		}
+	}
+
+	return entries
+}
+
+// applyReplyGeneric documents the Reply generic, either as a single 200
+// response or, for status-keyed object types ({ 200: User; 404: Error }),
+// one response per status code. Responses from the schema option are kept.
+func (p *Plugin) applyReplyGeneric(route *types.Route, node *sitter.Node, text string, content []byte, typed *fileTypes) {
+	replies := make(map[string]*types.Schema)
+
+	if node != nil && node.Type() == "object_type" {
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			sig := node.NamedChild(i)
+			if sig.Type() != "property_signature" {
+				continue
+			}
+			nameNode := sig.ChildByFieldName("name")
+			typeNode := typeAnnotationValue(sig.ChildByFieldName("type"))
+			if nameNode == nil || typeNode == nil {
+				continue
+			}
+			var status int
+			if _, err := fmt.Sscanf(strings.Trim(nameNode.Content(content), `"'`), "%d", &status); err != nil || status < 100 || status > 599 {
+				replies = nil
+				break
+			}
+			if s := typeSchema(typeNode.Content(content), typed); s != nil {
+				replies[fmt.Sprintf("%d", status)] = s
+			}
+		}
+	}
+
+	if len(replies) == 0 {
+		s := typeSchema(text, typed)
+		if s == nil {
+			return
+		}
+		replies = map[string]*types.Schema{"200": s}
+	}
+
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	for status, s := range replies {
+		if _, ok := route.Responses[status]; ok {
+			continue
+		}
+		route.Responses[status] = types.Response{
+			Description: "Response " + status,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: s},
+			},
+		}
+	}
+}
+
+// typeSchema converts a generic type to a schema. Zod schemas referenced via
+// typeof or z.infer become component references; placeholder types such as
+// any, unknown, and {} yield nil.
+func typeSchema(text string, typed *fileTypes) *types.Schema {
+	text = strings.TrimSpace(text)
+	switch text {
+	case "", "any", "unknown", "never", "void", "undefined", "{}", "object":
+		return nil
+	}
+	if name := zodTypeName(text); name != "" {
+		if _, ok := typed.zodSchemas[name]; ok {
+			return schema.SchemaRef(name)
+		}
+	}
+	return schema.NewTypeScriptSchemaExtractor().TypeToSchema(text)
+}
+
+// typeFields resolves the fields of an inline object type, a same-file
+// interface, or a Zod object schema referenced via typeof or z.infer.
+func (p *Plugin) typeFields(node *sitter.Node, text string, content []byte, typed *fileTypes) []typedField {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+	text = strings.TrimSpace(text)
+
+	var props []parser.TSProperty
+	if node != nil && node.Type() == "object_type" {
+		props = p.tsParser.ExtractObjectTypeProperties(node, content)
+	} else if iface, ok := typed.interfaces[text]; ok {
+		props = iface.Properties
+	}
+	if props != nil {
+		fields := make([]typedField, 0, len(props))
+		for _, prop := range props {
+			fields = append(fields, typedField{
+				name:     prop.Name,
+				schema:   tsExtractor.TypeToSchema(prop.Type),
+				required: !prop.IsOptional,
+			})
+		}
+		return fields
+	}
+
+	zodNode, ok := typed.zodSchemas[zodTypeName(text)]
+	if !ok {
+		return nil
+	}
+	return schemaFields(p.zodObjectSchema(zodNode, content))
+}
+
+// applyHookValidation applies validator schemas to a route that does not
+// already declare them. Bodies only apply to methods that accept one.
+func applyHookValidation(route *types.Route, hv *hookValidation) {
+	if hv == nil {
+		return
+	}
+
+	if hv.body != nil && route.RequestBody == nil && acceptsBody(route.Method) {
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: hv.body},
+			},
+		}
+	}
+
+	for _, field := range schemaFields(hv.query) {
+		addParameter(route, types.Parameter{
+			Name:     field.name,
+			In:       "query",
+			Required: field.required,
+			Schema:   field.schema,
+		})
+	}
+}
+
+// headerSetters lists the Reply methods that set headers.
+var headerSetters = map[string]bool{
+	"header":  true,
+	"headers": true,
+}
+
+// applyReplyHeaders documents headers and statuses set by the route handler,
+// which is the last argument of a shorthand call or the handler option of
+// route(), and by the hooks of the route and its instance.
+func (p *Plugin) applyReplyHeaders(routes []types.Route, call *sitter.Node, content []byte, typed *fileTypes, hooks map[string]*handlerResponse) {
+	callee := call.Child(0)
+	if callee == nil || callee.Type() != "member_expression" {
+		return
+	}
+	object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+	object = instanceName(object)
+
+	args := p.tsParser.GetCallArguments(call, content)
+	if len(args) == 0 {
+		return
+	}
+
+	// Route options are the first argument of route() and the second of shorthands
+	var handler, optionsNode *sitter.Node
+	if method == "route" {
+		if args[0].Type() != "object" {
+			return
+		}
+		optionsNode = args[0]
+		for i := 0; i < int(args[0].NamedChildCount()); i++ {
+			pair := args[0].NamedChild(i)
+			if key := pair.ChildByFieldName("key"); pair.Type() == "pair" && key != nil && key.Content(content) == "handler" {
+				handler = pair.ChildByFieldName("value")
+			}
+		}
+	} else if len(args) > 1 {
+		handler = args[len(args)-1]
+		if len(args) > 2 && args[1].Type() == "object" {
+			optionsNode = args[1]
+		}
+	}
+
+	if handler != nil && handler.Type() == "identifier" {
+		handler = typed.functions[handler.Content(content)]
+	}
+	if handler != nil && isFunctionNode(handler) {
+		hr := p.inspectReply(handler, content)
+		for i := range routes {
+			applyHandlerResponse(&routes[i], hr)
+		}
+	}
+
+	// Hooks of the instance and of the route may reply early or add headers
+	hookReply := &handlerResponse{}
+	hookReply.merge(hooks[object])
+	if optionsNode != nil {
+		for i := 0; i < int(optionsNode.NamedChildCount()); i++ {
+			pair := optionsNode.NamedChild(i)
+			key := pair.ChildByFieldName("key")
+			if pair.Type() != "pair" || key == nil || !replyHooks[key.Content(content)] {
+				continue
+			}
+			for _, fn := range p.hookFunctions(pair.ChildByFieldName("value"), content, typed) {
+				hookReply.merge(p.inspectReply(fn, content))
+			}
+		}
+	}
+	for i := range routes {
+		applyHookReply(&routes[i], hookReply)
+	}
+}
+
+// replyHooks lists the lifecycle hooks that can reply early, as an
+// authentication hook replying 401 does, or add headers to every reply.
+var replyHooks = map[string]bool{
+	"onRequest":        true,
+	"preParsing":       true,
+	"preValidation":    true,
+	"preHandler":       true,
+	"preSerialization": true,
+	"onSend":           true,
+}
+
+// findHookReplies finds fastify.addHook() calls for replyHooks and returns
+// the statuses and headers their functions reply with, keyed by instance
+// name.
+func (p *Plugin) findHookReplies(
+	rootNode *sitter.Node,
+	content []byte,
+	instances map[string]*fastifyInfo,
+	typed *fileTypes,
+) map[string]*handlerResponse {
+	hooks := make(map[string]*handlerResponse)
+
+	for _, call := range p.tsParser.FindCallExpressions(rootNode, content) {
+		callee := call.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			continue
+		}
+		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		if method != "addHook" {
+			continue
+		}
+		object = instanceName(object)
+		if _, ok := instances[object]; !ok {
+			continue
+		}
+
+		args := p.tsParser.GetCallArguments(call, content)
+		if len(args) < 2 {
+			continue
+		}
+		if hookName, _ := p.tsParser.ExtractStringLiteral(args[0], content); !replyHooks[hookName] {
+			continue
+		}
+
+		for _, fn := range p.hookFunctions(args[1], content, typed) {
+			if hooks[object] == nil {
+				hooks[object] = &handlerResponse{}
+			}
+			hooks[object].merge(p.inspectReply(fn, content))
+		}
+	}
+
+	return hooks
+}
+
+// hookFunctions resolves a hook, or an array of hooks, to function nodes:
+// inline functions, functions declared in the file, and functions added
+// with decorate() referenced as fastify.authenticate.
+func (p *Plugin) hookFunctions(node *sitter.Node, content []byte, typed *fileTypes) []*sitter.Node {
 	if node == nil {
+		return nil
+	}
+	switch node.Type() {
+	case "array":
+		var fns []*sitter.Node
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			fns = append(fns, p.hookFunctions(node.NamedChild(i), content, typed)...)
+		}
+		return fns
+	case "identifier":
+		if fn := typed.functions[node.Content(content)]; fn != nil {
+			return []*sitter.Node{fn}
+		}
+	case "member_expression":
+		if property := node.ChildByFieldName("property"); property != nil {
+			if fn := typed.decorators[property.Content(content)]; fn != nil {
+				return []*sitter.Node{fn}
+			}
+		}
+	default:
+		if isFunctionNode(node) {
+			return []*sitter.Node{node}
+		}
+	}
+	return nil
+}
+
+// findDecorators finds the functions added to instances with
+// decorate('name', fn), keyed by name.
+func (p *Plugin) findDecorators(rootNode *sitter.Node, content []byte) map[string]*sitter.Node {
+	decorators := make(map[string]*sitter.Node)
+	for _, call := range p.tsParser.FindCallExpressions(rootNode, content) {
+		callee := call.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			continue
+		}
+		if _, method := p.tsParser.GetMemberExpressionParts(callee, content); method != "decorate" {
+			continue
+		}
+		args := p.tsParser.GetCallArguments(call, content)
+		if len(args) < 2 {
+			continue
+		}
+		name, ok := p.tsParser.ExtractStringLiteral(args[0], content)
+		if ok && isFunctionNode(args[1]) {
+			decorators[name] = args[1]
+		}
+	}
+	return decorators
+}
+
+// handlerResponse summarizes what a handler does with its reply object.
+type handlerResponse struct {
+	// headers are the names of headers set on the reply
+	headers []string
+
+	// created reports whether the handler replies with 201 Created
+	created bool
+
+	// statuses are the status codes passed to reply.code() and reply.status()
+	statuses []int
+
+	// redirects are the status codes of reply.redirect() calls
+	redirects []int
+
+	// contentType is the Content-Type the handler sets explicitly
+	contentType string
+
+	// streams reports whether the handler writes to the raw response in chunks
+	streams bool
+}
+
+// add records a reply header, keeping Content-Type apart since OpenAPI
+// describes it through the response content instead.
+func (hr *handlerResponse) add(name, value string) {
+	if strings.EqualFold(name, "Content-Type") {
+		if value != "" {
+			hr.contentType = value
+		}
+		return
+	}
+	hr.headers = append(hr.headers, name)
+}
+
+// merge adds the statuses and headers of other to hr.
+func (hr *handlerResponse) merge(other *handlerResponse) {
+	if other == nil {
+		return
+	}
+	hr.statuses = append(hr.statuses, other.statuses...)
+	hr.headers = append(hr.headers, other.headers...)
+}
+
+// inspectReply finds headers a handler sets via reply.header(),
+// reply.headers(), reply.type(), and reply.raw.writeHead(), the statuses
+// it replies with via reply.code(), reply.status() or reply.statusCode,
+// the redirects it issues, and whether it streams Server-Sent Events or raw
+// chunks.
+func (p *Plugin) inspectReply(fn *sitter.Node, content []byte) *handlerResponse {
+	replyName := "reply"
+	if paramsNode := fn.ChildByFieldName("parameters"); paramsNode != nil && paramsNode.NamedChildCount() > 1 {
+		param := paramsNode.NamedChild(1)
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			param = pattern
+		}
+		if param.Type() == "identifier" {
+			replyName = param.Content(content)
+		}
+	}
+
+	hr := &handlerResponse{}
+
+	addStatus := func(node *sitter.Node) {
+		if code := plugins.StatusCode(node.Content(content)); code > 0 {
+			hr.statuses = append(hr.statuses, code)
+			if code == http.StatusCreated {
+				hr.created = true
+			}
+		}
+	}
+
+	p.walkNodes(fn.ChildByFieldName("body"), func(n *sitter.Node) bool {
+		// reply.statusCode = 204
+		if n.Type() == "assignment_expression" {
+			if left := n.ChildByFieldName("left"); left != nil && left.Content(content) == replyName+".statusCode" {
+				if right := n.ChildByFieldName("right"); right != nil {
+					addStatus(right)
+				}
+			}
+			return true
+		}
+		if n.Type() != "call_expression" {
+			return true
+		}
+		callee := n.Child(0)
+		if callee == nil || callee.Type() != "member_expression" {
+			return true
+		}
+		object, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		if object != replyName && !strings.HasPrefix(object, replyName+".") {
+			return true
+		}
+
+		args := p.tsParser.GetCallArguments(n, content)
+		switch {
+		case headerSetters[method] && len(args) > 0:
+			if name, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+				value := ""
+				if len(args) > 1 {
+					value, _ = p.tsParser.ExtractStringLiteral(args[1], content)
+				}
+				hr.add(name, value)
+			} else {
+				p.addHeaderObject(hr, args[0], content)
+			}
+		case method == "type" && len(args) > 0:
+			if value, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+				hr.contentType = value
+			}
+		case method == "writeHead":
+			for _, arg := range args {
+				p.addHeaderObject(hr, arg, content)
+			}
+		case method == "write":
+			hr.streams = true
+		case method == "sse":
+			// fastify-sse-v2 and @fastify/sse
+			hr.contentType = "text/event-stream"
+		case (method == "code" || method == "status") && len(args) > 0:
+			addStatus(args[0])
+		case method == "redirect":
+			// reply.redirect(url, code) in v5, reply.redirect(code, url) in v4; 302 by default
+			status := http.StatusFound
+			for _, arg := range args {
+				if arg.Type() != "number" {
+					continue
+				}
+				if code, err := strconv.Atoi(arg.Content(content)); err == nil {
+					status = code
+				}
+			}
+			hr.redirects = append(hr.redirects, status)
+		}
+		return true
+	})
+
+	return hr
+}
+
+// addHeaderObject records the headers of an object literal such as
+// { 'Content-Type': 'text/event-stream', 'Cache-Control': 'no-cache' }.
+func (p *Plugin) addHeaderObject(hr *handlerResponse, node *sitter.Node, content []byte) {
+	if node.Type() != "object" {
 		return
 	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		pair := node.NamedChild(i)
+		key := pair.ChildByFieldName("key")
+		if pair.Type() != "pair" || key == nil {
+			continue
+		}
+		value := ""
+		if v := pair.ChildByFieldName("value"); v != nil {
+			value, _ = p.tsParser.ExtractStringLiteral(v, content)
+		}
+		hr.add(strings.Trim(key.Content(content), `"'`), value)
+	}
+}
 
-	if !fn(node) {
+// applyHookReply adds the statuses hooks reply with to a route, and the
+// headers they set to each of its success responses.
+func applyHookReply(route *types.Route, hr *handlerResponse) {
+	if len(hr.statuses) == 0 && len(hr.headers) == 0 {
+		return
+	}
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	plugins.ApplyStatuses(route, hr.statuses)
+	if len(hr.headers) == 0 {
 		return
 	}
 
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
+	success := false
+	for status, resp := range route.Responses {
+		if status[0] != '2' {
+			continue
+		}
+		success = true
+		route.Responses[status] = withHeaders(resp, hr.headers)
+	}
+	if !success {
+		route.Responses["200"] = withHeaders(types.Response{Description: "Successful response"}, hr.headers)
+	}
+}
+
+// withHeaders returns resp with string headers named names added.
+func withHeaders(resp types.Response, names []string) types.Response {
+	headers := make(map[string]types.Header, len(resp.Headers)+len(names))
+	for name, header := range resp.Headers {
+		headers[name] = header
+	}
+	for _, name := range names {
+		if _, ok := headers[name]; !ok {
+			headers[name] = types.Header{Schema: &types.Schema{Type: "string"}}
+		}
+	}
+	resp.Headers = headers
+	return resp
+}
+
+// applyStreaming documents the success response as a stream of the given
+// media type, marking Server-Sent Events endpoints with x-sse.
+func applyStreaming(route *types.Route, mediaType string) {
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	resp, ok := route.Responses["200"]
+	if !ok {
+		resp = types.Response{Description: "Successful response"}
+	}
+	resp.Content = map[string]types.MediaType{
+		mediaType: {Schema: &types.Schema{Type: "string"}},
+	}
+	route.Responses["200"] = resp
+
+	if mediaType == "text/event-stream" {
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions["x-sse"] = true
 	}
 }
 
+// walkNodes walks all nodes in the tree.
+func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	parser.Walk(node, fn)
+}
+
 // ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	tsExtractor := schema.NewTypeScriptSchemaExtractor()
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -837,6 +1803,9 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		for _, iface := range pf.Interfaces {
 			tsExtractor.ExtractAndRegister(iface)
 		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
 
 		// Extract Zod schemas (if any)
 		for _, zs := range pf.ZodSchemas {
@@ -858,9 +1827,10 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts Fastify-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts Fastify-style path params (:id) to OpenAPI format ({id}),
+// and catch-alls (*) to a templated {path}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -964,6 +1934,163 @@ func inferTags(path string) []string {
 	return []string{tagPart}
 }
 
+// typeProviderRegex matches a trailing .withTypeProvider<T>() call.
+var typeProviderRegex = regexp.MustCompile(`\.withTypeProvider(<[^>]*>)?\(\)$`)
+
+// zodInferRegex matches typeof X and z.infer<typeof X> (also z.input/z.output).
+var zodInferRegex = regexp.MustCompile(`^(?:z\.(?:infer|input|output)<\s*)?typeof\s+([A-Za-z_$][\w$]*)\s*>?$`)
+
+// instanceName strips type provider calls from a callee object, so that
+// fastify.withTypeProvider<ZodTypeProvider>() resolves to fastify.
+func instanceName(object string) string {
+	return typeProviderRegex.ReplaceAllString(object, "")
+}
+
+// zodTypeName returns the schema name referenced by typeof X or z.infer<typeof X>.
+func zodTypeName(text string) string {
+	match := zodInferRegex.FindStringSubmatch(strings.TrimSpace(text))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// typeAnnotationValue returns the type inside a type_annotation node.
+func typeAnnotationValue(node *sitter.Node) *sitter.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Type() == "type_annotation" {
+		if node.NamedChildCount() == 0 {
+			return nil
+		}
+		return node.NamedChild(0)
+	}
+	return node
+}
+
+// schemaFields lists the properties of an object schema in name order.
+func schemaFields(s *types.Schema) []typedField {
+	if s == nil || len(s.Properties) == 0 {
+		return nil
+	}
+
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]typedField, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, typedField{
+			name:     name,
+			schema:   s.Properties[name],
+			required: required[name],
+		})
+	}
+	return fields
+}
+
+// addParameter appends a parameter unless one with the same name and location exists.
+func addParameter(route *types.Route, param types.Parameter) {
+	for _, existing := range route.Parameters {
+		if existing.Name == param.Name && existing.In == param.In {
+			return
+		}
+	}
+	route.Parameters = append(route.Parameters, param)
+}
+
+// acceptsBody reports whether an HTTP method conventionally carries a request body.
+func acceptsBody(method string) bool {
+	switch method {
+	case "POST", "PUT", "PATCH":
+		return true
+	}
+	return false
+}
+
+// isFunctionNode reports whether a node is a function expression or declaration.
+func isFunctionNode(node *sitter.Node) bool {
+	switch node.Type() {
+	case "arrow_function", "function_expression", "function", "function_declaration":
+		return true
+	}
+	return false
+}
+
+// applyHandlerResponse documents every status the handler replies with,
+// puts headers on the route's success response (201 when the handler
+// replies with Created, otherwise 200), and adds a response with a
+// Location header for each redirect. Server-Sent Events
+// and chunked replies are documented with their streaming content type.
+func applyHandlerResponse(route *types.Route, hr *handlerResponse) {
+	sse := hr.contentType == "text/event-stream"
+	if len(hr.headers) == 0 && len(hr.redirects) == 0 && len(hr.statuses) == 0 && !sse && !hr.streams {
+		return
+	}
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+
+	if sse || hr.streams {
+		mediaType := hr.contentType
+		if mediaType == "" {
+			mediaType = "application/octet-stream"
+		}
+		applyStreaming(route, mediaType)
+	}
+
+	for _, code := range hr.redirects {
+		status := strconv.Itoa(code)
+		if _, ok := route.Responses[status]; ok {
+			continue
+		}
+		route.Responses[status] = types.Response{
+			Description: http.StatusText(code),
+			Headers: map[string]types.Header{
+				"Location": {
+					Description: "Redirect target",
+					Schema:      &types.Schema{Type: "string"},
+				},
+			},
+		}
+	}
+
+	plugins.ApplyStatuses(route, hr.statuses)
+
+	if len(hr.headers) == 0 {
+		return
+	}
+
+	status := "200"
+	if hr.created {
+		status = "201"
+	}
+
+	resp, ok := route.Responses[status]
+	if !ok {
+		resp = types.Response{Description: "Successful response"}
+	}
+	if resp.Headers == nil {
+		resp.Headers = make(map[string]types.Header)
+	}
+	for _, name := range hr.headers {
+		header := types.Header{Schema: &types.Schema{Type: "string"}}
+		if strings.EqualFold(name, "Location") && hr.created {
+			header.Description = "URL of the created resource"
+		}
+		resp.Headers[name] = header
+	}
+	route.Responses[status] = resp
+}
+
 // Register registers the Fastify plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
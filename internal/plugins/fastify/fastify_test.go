@@ -236,6 +236,155 @@ fastify.post('/items', async (request, reply) => {
 module.exports = fastify
 `
 
+// fastifyHookValidationCode tests validators attached through hooks.
+const fastifyHookValidationCode = `
+import Fastify from 'fastify'
+import { z } from 'zod'
+
+const CreateUserSchema = z.object({
+  name: z.string(),
+  email: z.string().email(),
+})
+
+const SearchQuery = z.object({
+  q: z.string(),
+  limit: z.number().optional(),
+})
+
+const fastify = Fastify()
+
+fastify.addHook('preValidation', validateBody(CreateUserSchema))
+fastify.addHook('preHandler', async (request) => {
+  SearchQuery.parse(request.query)
+})
+
+fastify.get('/users', async () => [])
+
+fastify.post('/users', async () => ({}))
+
+fastify.put('/users/:id', {
+  preValidation: [authenticate, validate(z.object({ name: z.string() }))],
+}, async () => ({}))
+`
+
+// fastifyTypeProviderCode tests route generics and fastify-type-provider-zod.
+const fastifyTypeProviderCode = `
+import Fastify from 'fastify'
+import { ZodTypeProvider } from 'fastify-type-provider-zod'
+import { z } from 'zod'
+
+const ItemSchema = z.object({
+  id: z.string(),
+  title: z.string(),
+})
+
+const ListQuery = z.object({
+  page: z.number(),
+  tag: z.string().optional(),
+})
+
+interface Item {
+  id: string
+  title: string
+}
+
+interface UpdateItemRoute {
+  Params: { id: string }
+  Body: Item
+}
+
+const app = Fastify().withTypeProvider<ZodTypeProvider>()
+
+app.get<{ Querystring: z.infer<typeof ListQuery>; Reply: { 200: Item[]; 404: { message: string } } }>('/items', async () => [])
+
+app.post<{ Body: typeof ItemSchema; Headers: { 'x-request-id'?: string } }>('/items', async () => ({}))
+
+app.get<{ Params: { id: number }; Reply: Item }>('/items/:id', async () => ({}))
+
+app.put<UpdateItemRoute>('/items/:id', async () => ({}))
+
+app.withTypeProvider<ZodTypeProvider>().delete('/items/:id', async () => ({}))
+`
+
+// fastifyReplyHeadersCode tests response header detection in handlers.
+const fastifyReplyHeadersCode = `
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+
+async function createItem(request, reply) {
+  reply.code(201).header('Location', '/items/1')
+  return {}
+}
+
+fastify.get('/items', async (request, res) => {
+  res.headers({ 'X-Total-Count': '10', 'Cache-Control': 'no-store' })
+  return []
+})
+
+fastify.post('/items', createItem)
+
+fastify.route({
+  method: 'GET',
+  url: '/items/:id',
+  handler: async (request, reply) => {
+    reply.header('ETag', 'abc')
+    return {}
+  },
+})
+`
+
+// fastifyRedirectCode tests redirect detection in handlers.
+const fastifyRedirectCode = `
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+
+fastify.get('/legacy', async (request, reply) => {
+  return reply.redirect('/current', 308)
+})
+
+fastify.get('/home', async (request, reply) => reply.redirect('/'))
+`
+
+// fastifyStreamingCode tests Server-Sent Events detection.
+const fastifyStreamingCode = `
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+
+fastify.get('/events', async (request, reply) => {
+  reply.raw.writeHead(200, { 'Content-Type': 'text/event-stream', 'Cache-Control': 'no-cache' })
+  reply.raw.write('data: hello\n\n')
+})
+
+fastify.get('/ticks', (request, reply) => {
+  reply.sse(source())
+})
+
+fastify.get('/plain', async () => ({ ok: true }))
+`
+
+// fastifyMultiStatusCode tests status inference across handler branches.
+const fastifyMultiStatusCode = `
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+
+fastify.get('/items/:id', async (request, reply) => {
+  const item = await db.find(request.params.id)
+  if (!item) {
+    return reply.code(404).send({ message: 'Not found' })
+  }
+  return item
+})
+
+fastify.delete('/items/:id', async (request, reply) => {
+  await db.remove(request.params.id)
+  reply.status(204).send()
+})
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "fastify", p.Name())
@@ -412,6 +561,76 @@ func TestPlugin_ExtractRoutes_RouteMethod(t *testing.T) {
 	assert.Len(t, getProductByID.Parameters, 1)
 }
 
+func TestPlugin_ExtractRoutes_RouteMethodArrays(t *testing.T) {
+	code := `
+import Fastify from 'fastify'
+
+const fastify = Fastify()
+const READ_METHODS = ['GET', 'HEAD'] as const
+
+fastify.route({
+  method: ['GET', 'POST'],
+  url: '/items',
+  handler: async () => ({}),
+})
+
+fastify.route({
+  method: READ_METHODS,
+  path: '/items/:id',
+  handler: async () => ({}),
+})
+`
+
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "app.ts", Language: "typescript", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, routes, 4)
+	assert.NotNil(t, findRoute(routes, "GET", "/items"))
+	assert.NotNil(t, findRoute(routes, "POST", "/items"))
+	assert.NotNil(t, findRoute(routes, "GET", "/items/{id}"))
+
+	head := findRoute(routes, "HEAD", "/items/{id}")
+	require.NotNil(t, head)
+	assert.Equal(t, "headItemsByid", head.OperationID)
+	require.Len(t, head.Parameters, 1)
+}
+
+func TestPlugin_ExtractRoutes_TemplatePaths(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content: []byte(`
+import Fastify from 'fastify'
+const fastify = Fastify()
+const API = '/api/v1'
+
+fastify.get(` + "`${API}/users/:id`" + `, async () => ({}))
+fastify.route({
+  method: 'GET',
+  url: ` + "`${API}/orgs/${orgSlug()}`" + `,
+  handler: async () => ({})
+})
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	users := findRoute(routes, "GET", "/api/v1/users/{id}")
+	require.NotNil(t, users)
+	assert.Empty(t, users.Diagnostics)
+
+	orgs := findRoute(routes, "GET", "/api/v1/orgs/{orgSlug}")
+	require.NotNil(t, orgs)
+	assert.Len(t, orgs.Diagnostics, 1)
+}
+
 func TestPlugin_ExtractRoutes_SchemaValidation(t *testing.T) {
 	p := New()
 
@@ -450,6 +669,294 @@ func TestPlugin_ExtractRoutes_SchemaValidation(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_HookValidation(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyHookValidationCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	assert.Len(t, routes, 3)
+
+	// Instance-level preValidation validator supplies the body
+	postUsers := findRoute(routes, "POST", "/users")
+	require.NotNil(t, postUsers)
+	require.NotNil(t, postUsers.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUserSchema", postUsers.RequestBody.Content["application/json"].Schema.Ref)
+
+	// Bodies are not applied to GET, but the query validator is
+	getUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, getUsers)
+	assert.Nil(t, getUsers.RequestBody)
+	require.Len(t, getUsers.Parameters, 2)
+	assert.Equal(t, "limit", getUsers.Parameters[0].Name)
+	assert.Equal(t, "query", getUsers.Parameters[0].In)
+	assert.False(t, getUsers.Parameters[0].Required)
+	assert.Equal(t, "q", getUsers.Parameters[1].Name)
+	assert.True(t, getUsers.Parameters[1].Required)
+
+	// Route-level hook takes precedence over the instance hook
+	putUser := findRoute(routes, "PUT", "/users/{id}")
+	require.NotNil(t, putUser)
+	require.NotNil(t, putUser.RequestBody)
+	bodySchema := putUser.RequestBody.Content["application/json"].Schema
+	require.NotNil(t, bodySchema)
+	assert.Equal(t, "object", bodySchema.Type)
+	assert.Contains(t, bodySchema.Properties, "name")
+}
+
+func TestPlugin_ExtractRoutes_TypeProviderGenerics(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyTypeProviderCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	assert.Len(t, routes, 5)
+
+	// Querystring from z.infer<typeof ...>, status-keyed Reply
+	listItems := findRoute(routes, "GET", "/items")
+	require.NotNil(t, listItems)
+	require.Len(t, listItems.Parameters, 2)
+	assert.Equal(t, "page", listItems.Parameters[0].Name)
+	assert.True(t, listItems.Parameters[0].Required)
+	assert.Equal(t, "tag", listItems.Parameters[1].Name)
+	assert.False(t, listItems.Parameters[1].Required)
+	require.Contains(t, listItems.Responses, "200")
+	require.Contains(t, listItems.Responses, "404")
+	assert.Equal(t, "array", listItems.Responses["200"].Content["application/json"].Schema.Type)
+
+	// Body from typeof, header parameters
+	createItem := findRoute(routes, "POST", "/items")
+	require.NotNil(t, createItem)
+	require.NotNil(t, createItem.RequestBody)
+	assert.Equal(t, "#/components/schemas/ItemSchema", createItem.RequestBody.Content["application/json"].Schema.Ref)
+	require.Len(t, createItem.Parameters, 1)
+	assert.Equal(t, "x-request-id", createItem.Parameters[0].Name)
+	assert.Equal(t, "header", createItem.Parameters[0].In)
+
+	// Params refine path parameter types, Reply becomes the 200 response
+	getItem := findRoute(routes, "GET", "/items/{id}")
+	require.NotNil(t, getItem)
+	require.Len(t, getItem.Parameters, 1)
+	assert.Equal(t, "number", getItem.Parameters[0].Schema.Type)
+	require.Contains(t, getItem.Responses, "200")
+	assert.Equal(t, "#/components/schemas/Item", getItem.Responses["200"].Content["application/json"].Schema.Ref)
+
+	// Route generic given as a named interface
+	updateItem := findRoute(routes, "PUT", "/items/{id}")
+	require.NotNil(t, updateItem)
+	require.NotNil(t, updateItem.RequestBody)
+	assert.Equal(t, "#/components/schemas/Item", updateItem.RequestBody.Content["application/json"].Schema.Ref)
+
+	// Inline withTypeProvider() calls resolve to the instance
+	assert.NotNil(t, findRoute(routes, "DELETE", "/items/{id}"))
+}
+
+func TestPlugin_ExtractRoutes_ReplyHeaders(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyReplyHeadersCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	assert.Len(t, routes, 3)
+
+	list := findRoute(routes, "GET", "/items")
+	require.NotNil(t, list)
+	require.Contains(t, list.Responses, "200")
+	assert.Contains(t, list.Responses["200"].Headers, "X-Total-Count")
+	assert.Contains(t, list.Responses["200"].Headers, "Cache-Control")
+
+	// Named handler replying 201 with a Location header
+	create := findRoute(routes, "POST", "/items")
+	require.NotNil(t, create)
+	require.Contains(t, create.Responses, "201")
+	assert.Contains(t, create.Responses["201"].Headers, "Location")
+
+	// handler option of route()
+	get := findRoute(routes, "GET", "/items/{id}")
+	require.NotNil(t, get)
+	require.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses["200"].Headers, "ETag")
+}
+
+func TestPlugin_ExtractRoutes_Redirects(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyRedirectCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	legacy := findRoute(routes, "GET", "/legacy")
+	require.NotNil(t, legacy)
+	require.Contains(t, legacy.Responses, "308")
+	assert.Contains(t, legacy.Responses["308"].Headers, "Location")
+
+	// Fastify defaults to 302 Found
+	home := findRoute(routes, "GET", "/home")
+	require.NotNil(t, home)
+	require.Contains(t, home.Responses, "302")
+	assert.Equal(t, "Found", home.Responses["302"].Description)
+}
+
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyMultiStatusCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	get := findRoute(routes, "GET", "/items/{id}")
+	require.NotNil(t, get)
+	assert.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses, "404")
+
+	del := findRoute(routes, "DELETE", "/items/{id}")
+	require.NotNil(t, del)
+	require.Len(t, del.Responses, 1)
+	assert.Equal(t, "No Content", del.Responses["204"].Description)
+}
+
+func TestPlugin_ExtractRoutes_CreatedReply(t *testing.T) {
+	files := []scanner.SourceFile{{
+		Path:     "app.ts",
+		Language: "typescript",
+		Content: []byte(`
+import Fastify from 'fastify'
+
+interface User { id: number; name: string }
+
+const app = Fastify()
+
+app.post<{ Reply: User }>('/users', async (request, reply) => {
+  return reply.code(201).send(await createUser(request.body))
+})
+`),
+	}}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.NotContains(t, create.Responses, "200")
+	require.Contains(t, create.Responses, "201")
+	assert.NotNil(t, create.Responses["201"].Content["application/json"].Schema)
+}
+
+// fastifyHookStatusCode tests statuses from status constants and hooks.
+const fastifyHookStatusCode = `
+import Fastify from 'fastify'
+import { StatusCodes } from 'http-status-codes'
+
+const fastify = Fastify()
+
+fastify.decorate('authenticate', async (request, reply) => {
+  if (!request.headers.authorization) {
+    return reply.code(401).send({ message: 'Unauthorized' })
+  }
+})
+
+fastify.addHook('onSend', async (request, reply, payload) => {
+  reply.header('X-Request-Id', request.id)
+  return payload
+})
+
+fastify.post('/orders', async (request, reply) => {
+  return reply.code(StatusCodes.CREATED).send(await createOrder(request.body))
+})
+
+fastify.delete('/orders/:id', { onRequest: [fastify.authenticate] }, async (request, reply) => {
+  reply.statusCode = 204
+})
+
+fastify.get('/health', async () => ({ ok: true }))
+`
+
+func TestPlugin_ExtractRoutes_HookStatuses(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "app.ts", Language: "typescript", Content: []byte(fastifyHookStatusCode)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	create := findRoute(routes, "POST", "/orders")
+	require.NotNil(t, create)
+	assert.ElementsMatch(t, []string{"201"}, keys(create.Responses), "status constants resolve to codes")
+	assert.Contains(t, create.Responses["201"].Headers, "X-Request-Id", "onSend hooks add headers to success responses")
+
+	del := findRoute(routes, "DELETE", "/orders/{id}")
+	require.NotNil(t, del)
+	assert.ElementsMatch(t, []string{"204", "401"}, keys(del.Responses), "route hooks reply early")
+	assert.Contains(t, del.Responses["204"].Headers, "X-Request-Id")
+
+	health := findRoute(routes, "GET", "/health")
+	require.NotNil(t, health)
+	assert.ElementsMatch(t, []string{"200"}, keys(health.Responses))
+	assert.Contains(t, health.Responses["200"].Headers, "X-Request-Id")
+}
+
+func TestPlugin_ExtractRoutes_Streaming(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.ts",
+			Language: "typescript",
+			Content:  []byte(fastifyStreamingCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	for _, path := range []string{"/events", "/ticks"} {
+		route := findRoute(routes, "GET", path)
+		require.NotNil(t, route, path)
+		require.Contains(t, route.Responses, "200", path)
+		assert.Contains(t, route.Responses["200"].Content, "text/event-stream", path)
+		assert.Equal(t, true, route.Extensions["x-sse"], path)
+	}
+
+	plain := findRoute(routes, "GET", "/plain")
+	require.NotNil(t, plain)
+	assert.Empty(t, plain.Extensions)
+}
+
 func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	p := New()
 
@@ -695,3 +1202,11 @@ func findRoute(routes []types.Route, method, path string) *types.Route {
 	}
 	return nil
 }
+
+func keys(responses map[string]types.Response) []string {
+	var codes []string
+	for code := range responses {
+		codes = append(codes, code)
+	}
+	return codes
+}
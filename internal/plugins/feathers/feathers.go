@@ -0,0 +1,761 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package feathers provides a plugin for extracting routes from Feathers
+// applications: the REST endpoints of the services registered with
+// app.use, and the request schemas their validation hooks check.
+package feathers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// serviceMethods are the standard service methods and the REST endpoints
+// Feathers exposes them on, in documentation order.
+var serviceMethods = []struct {
+	name   string
+	method string
+	suffix string
+	status string
+}{
+	{"find", "GET", "", "200"},
+	{"get", "GET", "/{id}", "200"},
+	{"create", "POST", "", "201"},
+	{"update", "PUT", "/{id}", "200"},
+	{"patch", "PATCH", "/{id}", "200"},
+	{"remove", "DELETE", "/{id}", "200"},
+}
+
+// Plugin implements the FrameworkPlugin interface for Feathers.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new Feathers plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "feathers"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js", ".mts", ".mjs", ".cjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "feathers",
+		Version:     "1.0.0",
+		Description: "Extracts REST routes from Feathers services and schemas from their validation hooks",
+		SupportedFrameworks: []string{
+			"@feathersjs/feathers",
+		},
+	}
+}
+
+// Detect checks if Feathers is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["@feathersjs/feathers"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["@feathersjs/feathers"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// project holds the declarations of every source file, so that service
+// paths, classes and schemas can be resolved across files.
+type project struct {
+	parser  *parser.TypeScriptParser
+	files   []*parser.ParsedTSFile
+	decls   map[string]declaration
+	classes map[string]*serviceClass
+}
+
+// serviceClass is a class that may implement a service.
+type serviceClass struct {
+	// methods are the standard service methods the class declares
+	methods map[string]bool
+
+	// adapter is set for classes extending a database adapter service,
+	// which implements every method
+	adapter bool
+
+	// typeArgs are the type arguments of the extended or implemented
+	// service: Result, Data, Params and PatchData
+	typeArgs []string
+}
+
+// collectProject parses the files and indexes their const declarations and
+// classes. The caller closes the project's files.
+func (p *Plugin) collectProject(ctx context.Context, files []scanner.SourceFile) *project {
+	pr := &project{
+		parser:  p.tsParser,
+		decls:   make(map[string]declaration),
+		classes: make(map[string]*serviceClass),
+	}
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		pr.files = append(pr.files, pf)
+
+		content := file.Content
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			switch node.Type() {
+			case "variable_declarator":
+				name := node.ChildByFieldName("name")
+				value := node.ChildByFieldName("value")
+				if name != nil && value != nil && name.Type() == "identifier" {
+					pr.decls[name.Content(content)] = declaration{
+						value:   value,
+						content: content,
+						line:    int(node.StartPoint().Row) + 1,
+						file:    file.Path,
+					}
+				}
+			case "class_declaration":
+				if name := node.ChildByFieldName("name"); name != nil {
+					pr.classes[name.Content(content)] = parseServiceClass(node, content)
+				}
+			}
+			return true
+		})
+	}
+
+	return pr
+}
+
+// parseServiceClass reads the service methods and type arguments of a class.
+func parseServiceClass(node *sitter.Node, content []byte) *serviceClass {
+	cls := &serviceClass{methods: make(map[string]bool)}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		heritage := node.NamedChild(i)
+		if heritage.Type() != "class_heritage" {
+			continue
+		}
+		parser.Walk(heritage, func(n *sitter.Node) bool {
+			switch n.Type() {
+			case "extends_clause":
+				if value := n.ChildByFieldName("value"); value != nil {
+					base := value.Content(content)
+					cls.adapter = strings.HasSuffix(base, "Service") || strings.HasSuffix(base, "Adapter")
+				}
+			case "type_arguments":
+				if cls.typeArgs == nil {
+					for j := 0; j < int(n.NamedChildCount()); j++ {
+						cls.typeArgs = append(cls.typeArgs, n.NamedChild(j).Content(content))
+					}
+				}
+				return false
+			}
+			return true
+		})
+	}
+
+	if body := node.ChildByFieldName("body"); body != nil {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			method := body.NamedChild(i)
+			if method.Type() != "method_definition" {
+				continue
+			}
+			if name := method.ChildByFieldName("name"); name != nil {
+				cls.methods[name.Content(content)] = true
+			}
+		}
+	}
+
+	return cls
+}
+
+// registration is a service registered with app.use.
+type registration struct {
+	path     string
+	class    string
+	methods  map[string]bool
+	typeArgs []string
+	file     string
+	line     int
+}
+
+// validation holds the schemas a service's validation hooks check, by
+// service method.
+type validation struct {
+	data  map[string]string
+	query map[string]string
+}
+
+// ExtractRoutes parses source files and extracts the REST routes of every
+// service registered with app.use.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var services []registration
+	hooks := make(map[string]*validation)
+	for _, pf := range pr.files {
+		content := pf.Content
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() != "call_expression" {
+				return true
+			}
+			switch parser.CalledMethod(node, content) {
+			case "use":
+				if reg := pr.parseRegistration(node, content); reg != nil {
+					reg.file = pf.Path
+					services = append(services, *reg)
+				}
+			case "hooks":
+				if path, v := pr.parseHooks(node, content); path != "" {
+					if hooks[path] == nil {
+						hooks[path] = &validation{data: make(map[string]string), query: make(map[string]string)}
+					}
+					for method, name := range v.data {
+						hooks[path].data[method] = name
+					}
+					for method, name := range v.query {
+						hooks[path].query[method] = name
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	var routes []types.Route
+	for _, reg := range services {
+		routes = append(routes, pr.serviceRoutes(reg, hooks[reg.path])...)
+	}
+
+	return routes, nil
+}
+
+// parseRegistration reads an app.use(path, service, options) call. Calls
+// mounting middleware rather than a service return nil.
+func (pr *project) parseRegistration(call *sitter.Node, content []byte) *registration {
+	args := parser.CallArguments(call)
+	if len(args) < 2 {
+		return nil
+	}
+	path, ok := pr.stringOf(args[0], content)
+	if !ok {
+		return nil
+	}
+
+	reg := &registration{
+		path: "/" + strings.Trim(path, "/"),
+		line: int(call.StartPoint().Row) + 1,
+	}
+	if !pr.serviceMethods(reg, args[1], content, 0) {
+		return nil
+	}
+
+	if len(args) > 2 {
+		options := parser.UnwrapExpression(args[2])
+		if options.Type() == "identifier" {
+			if decl, ok := pr.decls[options.Content(content)]; ok && decl.value.Type() == "object" {
+				options, content = decl.value, decl.content
+			}
+		}
+		if options.Type() == "object" {
+			if methods := parser.ObjectProperty(options, "methods", content); methods != nil {
+				if names, ok := pr.parser.ResolveStringArray(methods, content); ok {
+					reg.methods = make(map[string]bool)
+					for _, name := range names {
+						reg.methods[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	return reg
+}
+
+// serviceMethods sets the methods and types of the service an app.use
+// argument creates: a class instance, a service object or an adapter
+// factory call. It reports false for middleware.
+func (pr *project) serviceMethods(reg *registration, node *sitter.Node, content []byte, depth int) bool {
+	node = parser.UnwrapExpression(node)
+	switch node.Type() {
+	case "new_expression":
+		constructor := node.ChildByFieldName("constructor")
+		if constructor == nil {
+			return false
+		}
+		reg.class = constructor.Content(content)
+		cls, ok := pr.classes[reg.class]
+		if !ok || cls.adapter {
+			reg.methods = allMethods()
+		} else {
+			reg.methods = cls.methods
+		}
+		if ok {
+			reg.typeArgs = cls.typeArgs
+		}
+		return true
+	case "object":
+		reg.methods = make(map[string]bool)
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			member := node.NamedChild(i)
+			var name string
+			switch member.Type() {
+			case "method_definition":
+				if n := member.ChildByFieldName("name"); n != nil {
+					name = n.Content(content)
+				}
+			case "pair":
+				name = parser.PairKey(member, content)
+			}
+			reg.methods[name] = true
+		}
+		return true
+	case "call_expression":
+		// Adapter factories such as memory() or knex({ ... }); member calls
+		// such as express.static() are middleware
+		fn := node.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "identifier" {
+			return false
+		}
+		reg.methods = allMethods()
+		return true
+	case "identifier":
+		decl, ok := pr.decls[node.Content(content)]
+		if !ok || depth > maxResolveDepth {
+			return false
+		}
+		return pr.serviceMethods(reg, decl.value, decl.content, depth+1)
+	}
+	return false
+}
+
+// parseHooks reads an app.service(path).hooks({...}) call: the schemas of
+// the validateData, validateQuery and validateSchema hooks, by method.
+func (pr *project) parseHooks(call *sitter.Node, content []byte) (string, *validation) {
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != "member_expression" {
+		return "", nil
+	}
+	target := parser.UnwrapExpression(fn.ChildByFieldName("object"))
+	if target == nil || target.Type() != "call_expression" || parser.CalledMethod(target, content) != "service" {
+		return "", nil
+	}
+	targetArgs := parser.CallArguments(target)
+	args := parser.CallArguments(call)
+	if len(targetArgs) == 0 || len(args) == 0 {
+		return "", nil
+	}
+	path, ok := pr.stringOf(targetArgs[0], content)
+	if !ok {
+		return "", nil
+	}
+
+	hookObject := parser.UnwrapExpression(args[0])
+	if hookObject.Type() == "identifier" {
+		if decl, ok := pr.decls[hookObject.Content(content)]; ok {
+			hookObject, content = parser.UnwrapExpression(decl.value), decl.content
+		}
+	}
+	if hookObject.Type() != "object" {
+		return "", nil
+	}
+
+	v := &validation{data: make(map[string]string), query: make(map[string]string)}
+	collect := func(methods *sitter.Node) {
+		for _, pair := range parser.ObjectPairs(methods) {
+			method := parser.PairKey(pair, content)
+			parser.Walk(pair.ChildByFieldName("value"), func(n *sitter.Node) bool {
+				if n.Type() != "call_expression" {
+					return true
+				}
+				hookArgs := parser.CallArguments(n)
+				if len(hookArgs) == 0 || hookArgs[0].Type() != "identifier" {
+					return true
+				}
+				name := pr.validatedSchema(hookArgs[0].Content(content))
+				switch parser.CalledFunction(n, content) {
+				case "validateData", "validateSchema":
+					v.data[method] = name
+				case "validateQuery":
+					v.query[method] = name
+				}
+				return true
+			})
+		}
+	}
+	for _, pair := range parser.ObjectPairs(hookObject) {
+		switch key := parser.PairKey(pair, content); key {
+		case "before", "around":
+			if value := parser.UnwrapExpression(pair.ChildByFieldName("value")); value.Type() == "object" {
+				collect(value)
+			}
+		}
+	}
+	// Hooks registered by method name directly are around hooks
+	collect(hookObject)
+
+	return "/" + strings.Trim(path, "/"), v
+}
+
+// validatedSchema returns the schema a validator checks: the schema
+// compiled by getValidator(schema, ajv) or ajv.compile(schema), or the
+// named schema itself.
+func (pr *project) validatedSchema(name string) string {
+	decl, ok := pr.decls[name]
+	if !ok {
+		return name
+	}
+	value := parser.UnwrapExpression(decl.value)
+	if value.Type() != "call_expression" {
+		return name
+	}
+	switch parser.CalledFunction(value, decl.content) {
+	case "getValidator", "compile":
+		if args := parser.CallArguments(value); len(args) > 0 && args[0].Type() == "identifier" {
+			return args[0].Content(decl.content)
+		}
+	}
+	return name
+}
+
+// serviceRoutes returns the REST routes of a registered service.
+func (pr *project) serviceRoutes(reg registration, v *validation) []types.Route {
+	extractor := schema.NewTypeScriptSchemaExtractor()
+	typeArg := func(i int) *types.Schema {
+		if i >= len(reg.typeArgs) {
+			return nil
+		}
+		switch t := strings.TrimSpace(reg.typeArgs[i]); t {
+		case "", "any", "unknown", "Params", "ServiceParams":
+			return nil
+		default:
+			return extractor.TypeToSchema(t)
+		}
+	}
+	result := typeArg(0)
+	data := typeArg(1)
+	patch := typeArg(3)
+	if patch == nil {
+		patch = data
+	}
+
+	validated := func(hooks map[string]string, method string) string {
+		if v == nil {
+			return ""
+		}
+		if name, ok := hooks[method]; ok {
+			return name
+		}
+		return hooks["all"]
+	}
+
+	wildcard := plugins.IsCatchAll(reg.path)
+	basePath := convertPathParams(reg.path)
+	resource := resourceName(basePath)
+
+	var routes []types.Route
+	for _, sm := range serviceMethods {
+		if !reg.methods[sm.name] {
+			continue
+		}
+		route := types.Route{
+			Method:      sm.method,
+			Path:        basePath + sm.suffix,
+			Handler:     handlerName(reg, sm.name),
+			OperationID: sm.name + resource,
+			Tags:        inferTags(basePath),
+			Parameters:  extractPathParams(basePath + sm.suffix),
+			SourceFile:  reg.file,
+			SourceLine:  reg.line,
+		}
+
+		response := types.Response{Description: "Success response"}
+		if result != nil {
+			body := result
+			if sm.name == "find" {
+				body = &types.Schema{Type: "array", Items: result}
+			}
+			response.Content = map[string]types.MediaType{"application/json": {Schema: body}}
+		}
+		route.Responses = map[string]types.Response{sm.status: response}
+
+		switch sm.name {
+		case "find":
+			if name := validated(v.queryHooks(), "find"); name != "" {
+				route.Parameters = append(route.Parameters, pr.queryParameters(name)...)
+			}
+		case "create", "update", "patch":
+			body := data
+			if sm.name == "patch" {
+				body = patch
+			}
+			if name := validated(v.dataHooks(), sm.name); name != "" {
+				body = pr.namedSchema(name)
+			}
+			if body != nil {
+				route.RequestBody = &types.RequestBody{
+					Required: true,
+					Content:  map[string]types.MediaType{"application/json": {Schema: body}},
+				}
+			}
+		}
+
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+func (v *validation) dataHooks() map[string]string {
+	if v == nil {
+		return nil
+	}
+	return v.data
+}
+
+func (v *validation) queryHooks() map[string]string {
+	if v == nil {
+		return nil
+	}
+	return v.query
+}
+
+// namedSchema returns a reference to a schema component, or the schema
+// inline when it is not one.
+func (pr *project) namedSchema(name string) *types.Schema {
+	if component := pr.componentName(name); component != "" {
+		return schema.SchemaRef(component)
+	}
+	if _, ok := pr.decls[name]; !ok {
+		return nil
+	}
+	return pr.schemaOfDecl(name, 0)
+}
+
+// queryParameters returns the properties of a query schema as query
+// parameters.
+func (pr *project) queryParameters(name string) []types.Parameter {
+	query := pr.schemaOfDecl(name, 0)
+	required := make(map[string]bool)
+	for _, r := range query.Required {
+		required[r] = true
+	}
+
+	var params []types.Parameter
+	for _, prop := range sortedKeys(query.Properties) {
+		params = append(params, types.Parameter{
+			Name:     prop,
+			In:       "query",
+			Required: required[prop],
+			Schema:   query.Properties[prop],
+		})
+	}
+	return params
+}
+
+// ExtractSchemas extracts schema definitions from TypeBox and JSON schemas
+// and TypeScript interfaces.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, pf := range pr.files {
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+	}
+
+	for _, name := range sortedKeys(pr.decls) {
+		component := pr.componentName(name)
+		if component == "" {
+			continue
+		}
+		decl := pr.decls[name]
+		s := pr.schemaOfDecl(name, 0)
+		s.Title = component
+		s.Source = &types.SourceLocation{File: decl.file, Line: decl.line}
+		tsExtractor.Registry().Add(component, s)
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// stringOf resolves a string literal or a const naming one.
+func (pr *project) stringOf(node *sitter.Node, content []byte) (string, bool) {
+	node = parser.UnwrapExpression(node)
+	if node.Type() == "identifier" {
+		decl, ok := pr.decls[node.Content(content)]
+		if !ok {
+			return "", false
+		}
+		node, content = parser.UnwrapExpression(decl.value), decl.content
+	}
+	if node.Type() == "string" || (node.Type() == "template_string" && node.NamedChildCount() == 0) {
+		return parser.StringValue(node, content), true
+	}
+	return "", false
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts path params (:userId) of nested service
+// paths to OpenAPI format ({userId}).
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// allMethods returns the set of standard service methods.
+func allMethods() map[string]bool {
+	methods := make(map[string]bool, len(serviceMethods))
+	for _, sm := range serviceMethods {
+		methods[sm.name] = true
+	}
+	return methods
+}
+
+// handlerName names the handler of a service method: the service class
+// method, or the method of the service at its path.
+func handlerName(reg registration, method string) string {
+	if reg.class != "" {
+		return reg.class + "." + method
+	}
+	return strings.TrimPrefix(reg.path, "/") + "." + method
+}
+
+// resourceName returns the last static path segment in PascalCase
+// (/user-profiles -> UserProfiles), for operation IDs.
+func resourceName(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] == "" || strings.HasPrefix(segments[i], "{") {
+			continue
+		}
+		var sb strings.Builder
+		for _, word := range strings.FieldsFunc(segments[i], func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+		return sb.String()
+	}
+	return ""
+}
+
+// inferTags tags a service's routes with its last static path segment.
+func inferTags(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != "" && !strings.HasPrefix(segments[i], "{") {
+			return []string{segments[i]}
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Register registers the Feathers plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package feathers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// schemaFixture declares TypeBox schemas and validators as generated by the
+// Feathers CLI.
+const schemaFixture = `
+import { Type, getValidator, querySyntax } from '@feathersjs/typebox'
+import type { Static } from '@feathersjs/typebox'
+import { dataValidator, queryValidator } from '../../validators'
+
+export const messageSchema = Type.Object(
+  {
+    id: Type.Number(),
+    text: Type.String({ description: 'Message text' }),
+    userId: Type.Optional(Type.Number())
+  },
+  { $id: 'Message', additionalProperties: false }
+)
+export type Message = Static<typeof messageSchema>
+
+export const messageDataSchema = Type.Pick(messageSchema, ['text'], { $id: 'MessageData' })
+export const messageDataValidator = getValidator(messageDataSchema, dataValidator)
+
+export const messageQuerySchema = Type.Intersect([
+  querySyntax(Type.Pick(messageSchema, ['id', 'text'])),
+  Type.Object({ archived: Type.Optional(Type.Boolean()) })
+])
+export const messageQueryValidator = getValidator(messageQuerySchema, queryValidator)
+`
+
+// serviceFixture registers services and their validation hooks.
+const serviceFixture = `
+import { feathers } from '@feathersjs/feathers'
+import { hooks as schemaHooks } from '@feathersjs/schema'
+import { MemoryService } from '@feathersjs/memory'
+import { messageDataValidator, messageQueryValidator } from './messages.schema'
+
+export const messagePath = 'messages'
+
+export class MessageService extends MemoryService<Message, MessageData> {}
+
+class StatsService {
+  async find() { return [] }
+  async get(id: number) { return {} }
+}
+
+const app = feathers()
+app.use(messagePath, new MessageService(), { methods: ['find', 'get', 'create', 'patch'] })
+app.use('/stats', new StatsService())
+app.use('/status', { async find() { return { ok: true } } })
+app.use('/users/:userId/avatars', memory())
+app.use('/public', express.static('public'))
+
+app.service(messagePath).hooks({
+  around: { all: [] },
+  before: {
+    find: [schemaHooks.validateQuery(messageQueryValidator)],
+    create: [schemaHooks.validateData(messageDataValidator)],
+    patch: [schemaHooks.validateData(messageDataValidator)]
+  }
+})
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"@feathersjs/feathers": "^5.0.0", "@feathersjs/koa": "^5.0.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_Methods(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "src/app.ts", Language: "typescript", Content: []byte(serviceFixture)},
+	})
+	require.NoError(t, err)
+
+	var messages []string
+	for _, r := range routes {
+		if r.Tags[0] == "messages" {
+			messages = append(messages, r.Method+" "+r.Path)
+		}
+	}
+	assert.Equal(t, []string{"GET /messages", "GET /messages/{id}", "POST /messages", "PATCH /messages/{id}"}, messages, "the methods option restricts the service methods")
+
+	assert.NotNil(t, findRoute(routes, "GET", "/stats"))
+	assert.NotNil(t, findRoute(routes, "GET", "/stats/{id}"))
+	assert.Nil(t, findRoute(routes, "POST", "/stats"), "classes only expose the methods they define")
+
+	assert.NotNil(t, findRoute(routes, "GET", "/status"))
+	assert.Nil(t, findRoute(routes, "GET", "/status/{id}"))
+
+	assert.Nil(t, findRoute(routes, "GET", "/public"), "middleware is not a service")
+
+	avatar := findRoute(routes, "DELETE", "/users/{userId}/avatars/{id}")
+	require.NotNil(t, avatar, "adapter factories expose every method")
+	assert.Equal(t, "removeAvatars", avatar.OperationID)
+	assert.Len(t, avatar.Parameters, 2)
+}
+
+func TestPlugin_ExtractRoutes_Schemas(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "src/services/messages/messages.schema.ts", Language: "typescript", Content: []byte(schemaFixture)},
+		{Path: "src/app.ts", Language: "typescript", Content: []byte(serviceFixture)},
+	})
+	require.NoError(t, err)
+
+	find := findRoute(routes, "GET", "/messages")
+	require.NotNil(t, find)
+	assert.Equal(t, "findMessages", find.OperationID)
+	assert.Equal(t, "MessageService.find", find.Handler)
+	assert.Equal(t, "src/app.ts", find.SourceFile)
+	response := find.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", response.Type)
+	assert.Equal(t, "#/components/schemas/Message", response.Items.Ref)
+
+	var query []string
+	for _, param := range find.Parameters {
+		assert.Equal(t, "query", param.In)
+		assert.False(t, param.Required)
+		query = append(query, param.Name)
+	}
+	assert.Equal(t, []string{"archived", "id", "text"}, query)
+
+	create := findRoute(routes, "POST", "/messages")
+	require.NotNil(t, create)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/MessageData", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Message", create.Responses["201"].Content["application/json"].Schema.Ref)
+
+	get := findRoute(routes, "GET", "/messages/{id}")
+	require.NotNil(t, get)
+	assert.Nil(t, get.RequestBody)
+	assert.Equal(t, "#/components/schemas/Message", get.Responses["200"].Content["application/json"].Schema.Ref)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "messages.schema.ts", Language: "typescript", Content: []byte(schemaFixture)},
+	})
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+
+	message, ok := byName["Message"]
+	require.True(t, ok)
+	assert.Equal(t, "object", message.Type)
+	assert.Equal(t, []string{"id", "text"}, message.Required)
+	assert.Equal(t, "Message text", message.Properties["text"].Description)
+	assert.Contains(t, message.Properties, "userId")
+
+	data, ok := byName["MessageData"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"text"}, sortedKeys(data.Properties))
+	assert.Equal(t, []string{"text"}, data.Required)
+}
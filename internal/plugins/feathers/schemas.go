@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package feathers
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// maxResolveDepth bounds chains of schema declarations referring to each other.
+const maxResolveDepth = 16
+
+// declaration is the value of a const declaration and the source it is in.
+type declaration struct {
+	value   *sitter.Node
+	content []byte
+	line    int
+	file    string
+}
+
+// isSchema reports whether a declaration is a TypeBox or JSON schema.
+func (d declaration) isSchema() bool {
+	value := parser.UnwrapExpression(d.value)
+	switch value.Type() {
+	case "call_expression":
+		return typeBoxFunction(value, d.content) != ""
+	case "object":
+		return parser.ObjectProperty(value, "properties", d.content) != nil || parser.ObjectProperty(value, "type", d.content) != nil
+	}
+	return false
+}
+
+// schemaID returns the $id of a schema declaration: the $id option of a
+// TypeBox call or the $id key of a JSON schema.
+func (d declaration) schemaID() string {
+	value := parser.UnwrapExpression(d.value)
+	var options *sitter.Node
+	switch value.Type() {
+	case "call_expression":
+		args := parser.CallArguments(value)
+		if len(args) > 0 && args[len(args)-1].Type() == "object" {
+			options = args[len(args)-1]
+		}
+	case "object":
+		options = value
+	}
+	if options == nil {
+		return ""
+	}
+	if id := parser.ObjectProperty(options, "$id", d.content); id != nil {
+		return parser.StringValue(id, d.content)
+	}
+	return ""
+}
+
+// componentName returns the component a schema declaration is registered
+// under: its $id, or else its name. Only declarations with an $id or a
+// name ending in Schema are components.
+func (pr *project) componentName(name string) string {
+	decl, ok := pr.decls[name]
+	if !ok || !decl.isSchema() {
+		return ""
+	}
+	if id := decl.schemaID(); id != "" {
+		return id
+	}
+	if strings.HasSuffix(name, "Schema") {
+		return name
+	}
+	return ""
+}
+
+// schemaOfDecl converts the schema declared under name.
+func (pr *project) schemaOfDecl(name string, depth int) *types.Schema {
+	decl, ok := pr.decls[name]
+	if !ok || depth > maxResolveDepth {
+		return &types.Schema{}
+	}
+	result, _ := pr.schemaOf(decl.value, decl.content, depth+1)
+	return result
+}
+
+// schemaOf converts a TypeBox expression or JSON schema literal. optional
+// is set for TypeBox Optional() properties. Identifiers naming schema
+// components become references.
+func (pr *project) schemaOf(node *sitter.Node, content []byte, depth int) (result *types.Schema, optional bool) {
+	node = parser.UnwrapExpression(node)
+	switch node.Type() {
+	case "identifier":
+		name := node.Content(content)
+		if component := pr.componentName(name); component != "" {
+			return schema.SchemaRef(component), false
+		}
+		return pr.schemaOfDecl(name, depth), false
+	case "object":
+		return pr.jsonSchema(node, content, depth), false
+	case "call_expression":
+		return pr.typeBoxSchema(node, content, depth)
+	}
+	return &types.Schema{}, false
+}
+
+// resolved converts a schema expression with the identifiers it names
+// resolved rather than referenced, for the schema operations that work on
+// the properties of another schema (Pick, Omit, Partial, Intersect).
+func (pr *project) resolved(node *sitter.Node, content []byte, depth int) *types.Schema {
+	node = parser.UnwrapExpression(node)
+	if node.Type() == "identifier" {
+		return pr.schemaOfDecl(node.Content(content), depth)
+	}
+	result, _ := pr.schemaOf(node, content, depth)
+	return result
+}
+
+// typeBoxFunction returns the name of the TypeBox builder a call uses
+// (Type.Object -> Object), the querySyntax helper, or "".
+func typeBoxFunction(call *sitter.Node, content []byte) string {
+	fn := call.ChildByFieldName("function")
+	if fn == nil {
+		return ""
+	}
+	if fn.Type() == "identifier" && fn.Content(content) == "querySyntax" {
+		return "querySyntax"
+	}
+	if fn.Type() != "member_expression" {
+		return ""
+	}
+	object := fn.ChildByFieldName("object")
+	prop := fn.ChildByFieldName("property")
+	if object == nil || prop == nil || object.Content(content) != "Type" {
+		return ""
+	}
+	return prop.Content(content)
+}
+
+// typeBoxSchema converts a TypeBox builder call.
+func (pr *project) typeBoxSchema(call *sitter.Node, content []byte, depth int) (*types.Schema, bool) {
+	args := parser.CallArguments(call)
+	arg := func(i int) *sitter.Node {
+		if i < len(args) {
+			return args[i]
+		}
+		return nil
+	}
+	fn := typeBoxFunction(call, content)
+
+	// Options are the trailing object argument; Object takes its properties first
+	var options *sitter.Node
+	if n := len(args); n > 0 && args[n-1].Type() == "object" && (n > 1 || fn != "Object") {
+		options = args[n-1]
+	}
+
+	var result *types.Schema
+	switch fn {
+	case "String":
+		result = &types.Schema{Type: "string"}
+	case "Number":
+		result = &types.Schema{Type: "number"}
+	case "Integer":
+		result = &types.Schema{Type: "integer"}
+	case "Boolean":
+		result = &types.Schema{Type: "boolean"}
+	case "Null":
+		result = &types.Schema{Type: "null"}
+	case "Array":
+		result = &types.Schema{Type: "array", Items: &types.Schema{}}
+		if a := arg(0); a != nil {
+			result.Items, _ = pr.schemaOf(a, content, depth)
+		}
+	case "Object":
+		result = &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+		if a := arg(0); a != nil && a.Type() == "object" {
+			for _, pair := range parser.ObjectPairs(a) {
+				prop, opt := pr.schemaOf(pair.ChildByFieldName("value"), content, depth)
+				name := parser.PairKey(pair, content)
+				result.Properties[name] = prop
+				if !opt {
+					result.Required = append(result.Required, name)
+				}
+			}
+		}
+	case "Optional":
+		if a := arg(0); a != nil {
+			inner, _ := pr.schemaOf(a, content, depth)
+			return inner, true
+		}
+		return &types.Schema{}, true
+	case "Literal":
+		result = &types.Schema{}
+		if a := arg(0); a != nil {
+			result = literalSchema(a, content)
+		}
+	case "Union":
+		result = &types.Schema{}
+		if a := arg(0); a != nil && a.Type() == "array" {
+			result = pr.unionSchema(a, content, depth)
+		}
+	case "Ref":
+		result = &types.Schema{}
+		if a := arg(0); a != nil {
+			result, _ = pr.schemaOf(a, content, depth)
+		}
+	case "Record":
+		result = &types.Schema{Type: "object", AdditionalProperties: &types.Schema{}}
+		if a := arg(1); a != nil {
+			result.AdditionalProperties, _ = pr.schemaOf(a, content, depth)
+		}
+	case "Pick", "Omit":
+		base := arg(0)
+		keys := arg(1)
+		if base == nil || keys == nil {
+			return &types.Schema{}, false
+		}
+		names, _ := pr.parser.ResolveStringArray(keys, content)
+		result = selectProperties(pr.resolved(base, content, depth), names, fn == "Pick")
+	case "Partial":
+		result = &types.Schema{}
+		if a := arg(0); a != nil {
+			result = selectProperties(pr.resolved(a, content, depth), nil, false)
+			result.Required = nil
+		}
+	case "Intersect":
+		result = &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+		if a := arg(0); a != nil && a.Type() == "array" {
+			for i := 0; i < int(a.NamedChildCount()); i++ {
+				mergeProperties(result, pr.resolved(a.NamedChild(i), content, depth))
+			}
+		}
+	case "querySyntax":
+		// Query schemas accept the properties as filters; all are optional
+		result = &types.Schema{}
+		if a := arg(0); a != nil {
+			result = selectProperties(pr.resolved(a, content, depth), nil, false)
+			result.Required = nil
+		}
+	default:
+		result = &types.Schema{}
+	}
+
+	if options != nil {
+		applyOptions(result, options, content)
+	}
+	return result, false
+}
+
+// unionSchema converts a union: literals become an enum, other members oneOf.
+func (pr *project) unionSchema(members *sitter.Node, content []byte, depth int) *types.Schema {
+	var oneOf []*types.Schema
+	enum := &types.Schema{}
+	literals := true
+	for i := 0; i < int(members.NamedChildCount()); i++ {
+		member, _ := pr.schemaOf(members.NamedChild(i), content, depth)
+		oneOf = append(oneOf, member)
+		if len(member.Enum) != 1 || (enum.Type != "" && member.Type != enum.Type) {
+			literals = false
+			continue
+		}
+		enum.Type = member.Type
+		enum.Enum = append(enum.Enum, member.Enum[0])
+	}
+	if literals && len(enum.Enum) > 0 {
+		return enum
+	}
+	return &types.Schema{OneOf: oneOf}
+}
+
+// jsonSchema converts a JSON schema object literal. Spread querySyntax()
+// calls in properties contribute their properties.
+func (pr *project) jsonSchema(node *sitter.Node, content []byte, depth int) *types.Schema {
+	result := &types.Schema{}
+	if ref := parser.ObjectProperty(node, "$ref", content); ref != nil {
+		return schema.SchemaRef(parser.StringValue(ref, content))
+	}
+	if t := parser.ObjectProperty(node, "type", content); t != nil {
+		result.Type = parser.StringValue(t, content)
+	}
+	if format := parser.ObjectProperty(node, "format", content); format != nil {
+		result.Format = parser.StringValue(format, content)
+	}
+	if description := parser.ObjectProperty(node, "description", content); description != nil {
+		result.Description = parser.StringValue(description, content)
+	}
+	if enum := parser.ObjectProperty(node, "enum", content); enum != nil && enum.Type() == "array" {
+		for i := 0; i < int(enum.NamedChildCount()); i++ {
+			if literal := literalSchema(enum.NamedChild(i), content); len(literal.Enum) == 1 {
+				result.Enum = append(result.Enum, literal.Enum[0])
+			}
+		}
+	}
+	if items := parser.ObjectProperty(node, "items", content); items != nil {
+		result.Items, _ = pr.schemaOf(items, content, depth)
+	}
+	if props := parser.ObjectProperty(node, "properties", content); props != nil {
+		props = parser.UnwrapExpression(props)
+		result.Properties = make(map[string]*types.Schema)
+		for i := 0; i < int(props.NamedChildCount()); i++ {
+			child := props.NamedChild(i)
+			switch child.Type() {
+			case "pair":
+				result.Properties[parser.PairKey(child, content)], _ = pr.schemaOf(child.ChildByFieldName("value"), content, depth)
+			case "spread_element":
+				if child.NamedChildCount() > 0 {
+					mergeProperties(result, pr.resolved(child.NamedChild(0), content, depth))
+				}
+			}
+		}
+	}
+	if required := parser.ObjectProperty(node, "required", content); required != nil {
+		result.Required, _ = pr.parser.ResolveStringArray(required, content)
+	}
+	return result
+}
+
+// applyOptions applies the options of a TypeBox builder (format, description).
+func applyOptions(result *types.Schema, options *sitter.Node, content []byte) {
+	if format := parser.ObjectProperty(options, "format", content); format != nil {
+		result.Format = parser.StringValue(format, content)
+	}
+	if description := parser.ObjectProperty(options, "description", content); description != nil {
+		result.Description = parser.StringValue(description, content)
+	}
+}
+
+// literalSchema converts a string, number or boolean literal to a single
+// value enum.
+func literalSchema(node *sitter.Node, content []byte) *types.Schema {
+	text := node.Content(content)
+	switch node.Type() {
+	case "string":
+		return &types.Schema{Type: "string", Enum: []any{parser.StringValue(node, content)}}
+	case "number":
+		if n, err := strconv.ParseFloat(text, 64); err == nil {
+			return &types.Schema{Type: "number", Enum: []any{n}}
+		}
+	case "true", "false":
+		return &types.Schema{Type: "boolean", Enum: []any{text == "true"}}
+	}
+	return &types.Schema{}
+}
+
+// selectProperties copies an object schema keeping (pick) or dropping the
+// named properties. With pick unset and no names, all are kept.
+func selectProperties(base *types.Schema, names []string, pick bool) *types.Schema {
+	named := make(map[string]bool, len(names))
+	for _, name := range names {
+		named[name] = true
+	}
+	keep := func(name string) bool {
+		return named[name] == pick
+	}
+
+	result := &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+	for name, prop := range base.Properties {
+		if keep(name) {
+			result.Properties[name] = prop
+		}
+	}
+	for _, name := range base.Required {
+		if keep(name) {
+			result.Required = append(result.Required, name)
+		}
+	}
+	return result
+}
+
+// mergeProperties adds the properties and required list of an object
+// schema to another.
+func mergeProperties(into, from *types.Schema) {
+	if into.Properties == nil {
+		into.Properties = make(map[string]*types.Schema)
+	}
+	for name, prop := range from.Properties {
+		into.Properties[name] = prop
+	}
+	into.Required = append(into.Required, from.Required...)
+	if into.Type == "" {
+		into.Type = "object"
+	}
+}
+
+// closeAll closes parse trees.
+func closeAll(files []*parser.ParsedTSFile) {
+	for _, pf := range files {
+		pf.Close()
+	}
+}
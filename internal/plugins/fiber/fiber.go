@@ -16,6 +16,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -68,6 +69,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".go"}
 }
 
+// RouteMarkers returns the Fiber import paths, one of which every file
+// defining routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return fiberImportPaths
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -141,6 +148,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports Fiber
 	if !p.hasFiberImport(pf) {
+		decisions.Skip(p.Name(), file.Path, "no Fiber import")
 		return nil, nil
 	}
 
@@ -393,6 +401,7 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, rec
 	fullPath = normalizePath(fullPath)
 
 	// Convert Fiber :param syntax to OpenAPI {param} syntax
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertFiberPathParams(fullPath)
 
 	// Extract handler name if present
@@ -425,6 +434,9 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, rec
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
 
 	return route
 }
@@ -6,16 +6,20 @@ package flask
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -126,14 +130,22 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Flask route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -152,8 +164,8 @@ type blueprintInfo struct {
 }
 
 // extractRoutesFromFile extracts routes from a single Python file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.pyParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +173,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports Flask
 	if !p.hasFlaskImport(pf) {
+		decisions.Skip(p.Name(), file.Path, "no Flask import")
 		return nil, nil
 	}
 
@@ -273,9 +286,14 @@ func (p *Plugin) extractBlueprintUrlPrefix(node *sitter.Node, content []byte) st
 func (p *Plugin) extractRoutesFromFunction(fn parser.PythonDecoratedFunction, content []byte, blueprints map[string]*blueprintInfo) []types.Route {
 	var routes []types.Route
 
+	redirects := p.findRedirects(fn, content)
+	statuses := p.findStatuses(fn, content)
+
 	for _, dec := range fn.Decorators {
 		route := p.parseRouteDecorator(dec, fn, content, blueprints)
 		if route != nil {
+			applyRedirects(route, redirects)
+			plugins.ApplyStatuses(route, statuses)
 			routes = append(routes, *route)
 		}
 	}
@@ -348,6 +366,7 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 	params := extractPathParamsWithTypes(fullPath)
 
 	// Convert Flask path parameters to OpenAPI format
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertPathParams(fullPath)
 
 	// Generate operation ID
@@ -356,7 +375,7 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 	// Infer tags from path
 	tags := inferTags(fullPath)
 
-	return &types.Route{
+	route := &types.Route{
 		Method:      method,
 		Path:        fullPath,
 		Handler:     fn.Name,
@@ -365,6 +384,68 @@ func (p *Plugin) parseRouteDecorator(dec parser.PythonDecorator, fn parser.Pytho
 		Parameters:  params,
 		SourceLine:  fn.Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	return route
+}
+
+// findRedirects returns the status codes of redirect() calls in a view
+// function. Flask redirects with 302 Found unless a code is given.
+func (p *Plugin) findRedirects(fn parser.PythonDecoratedFunction, content []byte) []int {
+	if fn.Node == nil {
+		return nil
+	}
+
+	var codes []int
+	for _, call := range p.pyParser.FindCalls(fn.Node, content, "redirect") {
+		code := http.StatusFound
+		value, ok := call.KeywordArguments["code"]
+		if !ok && len(call.Arguments) > 1 {
+			value = call.Arguments[1]
+		}
+		if parsed := plugins.StatusCode(value); parsed > 0 {
+			code = parsed
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// findStatuses returns the status codes a view function responds with on any
+// branch: abort(404) calls and (body, status) tuple returns such as
+// `return jsonify(error), 404` or `return "", 204`.
+func (p *Plugin) findStatuses(fn parser.PythonDecoratedFunction, content []byte) []int {
+	if fn.Node == nil {
+		return nil
+	}
+
+	var codes []int
+	for _, call := range p.pyParser.FindCalls(fn.Node, content, "abort") {
+		if len(call.Arguments) == 0 {
+			continue
+		}
+		if code := plugins.StatusCode(call.Arguments[0]); code > 0 {
+			codes = append(codes, code)
+		}
+	}
+
+	p.pyParser.WalkNodes(fn.Node, func(node *sitter.Node) bool {
+		if node.Type() != "return_statement" || node.NamedChildCount() == 0 {
+			return true
+		}
+		values := node.NamedChild(0)
+		if values.Type() != "expression_list" || values.NamedChildCount() < 2 {
+			return true
+		}
+		if code := plugins.StatusCode(values.NamedChild(1).Content(content)); code > 0 {
+			codes = append(codes, code)
+		}
+		return true
+	})
+
+	return codes
 }
 
 // extractRoutesFromClass extracts routes from a MethodView class.
@@ -431,14 +512,22 @@ func (p *Plugin) findClassRoutePath(cls parser.PythonClass, _ []byte, _ map[stri
 
 // ExtractSchemas extracts schema definitions from Pydantic models.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	var schemas []types.Schema
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "python" {
 			continue
 		}
 
-		pf, err := p.pyParser.Parse(file.Path, file.Content)
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -463,28 +552,30 @@ func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema
 		Type:       "object",
 		Properties: make(map[string]*types.Schema),
 		Required:   []string{},
+		Source:     model.Source(),
 	}
 
 	for _, field := range model.Fields {
-		propSchema := &types.Schema{}
-
 		// Convert Python type to OpenAPI type
-		openAPIType, format := parser.PythonTypeToOpenAPI(field.Type)
-		propSchema.Type = openAPIType
-		if format != "" {
-			propSchema.Format = format
-		}
+		propSchema := parser.PythonTypeSchema(field.Type)
 
 		// Handle array types
 		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
 			propSchema.Type = "array"
 			// Extract inner type
 			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.PythonTypeToOpenAPI(innerType)
-			propSchema.Items = &types.Schema{
-				Type:   innerOpenAPIType,
-				Format: innerFormat,
-			}
+			propSchema.Items = parser.PythonTypeSchema(innerType)
+		}
+
+		// Handle dict types
+		if valueType, ok := parser.PythonDictValueType(field.Type); ok {
+			propSchema.Type = "object"
+			propSchema.AdditionalProperties = parser.PythonTypeSchema(valueType)
+		}
+
+		// Emit constant defaults typed for the property
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
 		}
 
 		if field.Description != "" {
@@ -730,6 +821,24 @@ func extractGenericType(s string) string {
 	return strings.TrimSpace(s[start+1 : end])
 }
 
+// applyRedirects adds a 3xx response with a Location header for each redirect.
+func applyRedirects(route *types.Route, codes []int) {
+	for _, code := range codes {
+		if route.Responses == nil {
+			route.Responses = make(map[string]types.Response)
+		}
+		route.Responses[strconv.Itoa(code)] = types.Response{
+			Description: http.StatusText(code),
+			Headers: map[string]types.Header{
+				"Location": {
+					Description: "Redirect target",
+					Schema:      &types.Schema{Type: "string"},
+				},
+			},
+		}
+	}
+}
+
 // Register registers the Flask plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
@@ -174,6 +174,42 @@ def get_users():
     return []
 `
 
+// flaskRedirectCode is a test fixture for redirect detection.
+const flaskRedirectCode = `
+from flask import Flask, redirect, url_for
+
+app = Flask(__name__)
+
+@app.route('/old-home')
+def old_home():
+    return redirect(url_for('home'), code=301)
+
+@app.route('/login', methods=['POST'])
+def login():
+    return redirect('/dashboard')
+`
+
+// flaskMultiStatusCode is a test fixture for status inference across branches.
+const flaskMultiStatusCode = `
+from flask import Flask, abort, jsonify
+
+app = Flask(__name__)
+
+@app.route('/items/<int:item_id>')
+def get_item(item_id):
+    item = db.get(item_id)
+    if item is None:
+        abort(404)
+    return jsonify(item)
+
+@app.route('/items/<int:item_id>', methods=['DELETE'])
+def delete_item(item_id):
+    if not current_user.is_admin:
+        return jsonify(error='forbidden'), 403
+    db.delete(item_id)
+    return '', 204
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "flask", p.Name())
@@ -412,6 +448,58 @@ func TestPlugin_ExtractRoutes_PathParams(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_Redirects(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.py",
+			Language: "python",
+			Content:  []byte(flaskRedirectCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	oldHome := findRoute(routes, "GET", "/old-home")
+	require.NotNil(t, oldHome)
+	require.Contains(t, oldHome.Responses, "301")
+	assert.Equal(t, "Moved Permanently", oldHome.Responses["301"].Description)
+	assert.Contains(t, oldHome.Responses["301"].Headers, "Location")
+
+	login := findRoute(routes, "POST", "/login")
+	require.NotNil(t, login)
+	require.Contains(t, login.Responses, "302")
+	assert.Contains(t, login.Responses["302"].Headers, "Location")
+}
+
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.py",
+			Language: "python",
+			Content:  []byte(flaskMultiStatusCode),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	get := findRoute(routes, "GET", "/items/{item_id}")
+	require.NotNil(t, get)
+	assert.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses, "404")
+
+	del := findRoute(routes, "DELETE", "/items/{item_id}")
+	require.NotNil(t, del)
+	assert.Len(t, del.Responses, 2)
+	assert.Contains(t, del.Responses, "204")
+	assert.Contains(t, del.Responses, "403")
+}
+
 func TestPlugin_ExtractRoutes_IgnoresNonPython(t *testing.T) {
 	p := New()
 
@@ -0,0 +1,541 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package fresh provides a plugin for extracting routes from Deno Fresh applications.
+package fresh
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// handlerMethods are the HTTP methods a Fresh handler object may define.
+var handlerMethods = map[string]bool{
+	"GET":     true,
+	"POST":    true,
+	"PUT":     true,
+	"DELETE":  true,
+	"PATCH":   true,
+	"HEAD":    true,
+	"OPTIONS": true,
+}
+
+// Plugin implements the FrameworkPlugin interface for Fresh.
+type Plugin struct {
+	tsParser  *parser.TypeScriptParser
+	zodParser *schema.ZodParser
+}
+
+// New creates a new Fresh plugin instance.
+func New() *Plugin {
+	tsParser := parser.NewTypeScriptParser()
+	return &Plugin{
+		tsParser:  tsParser,
+		zodParser: schema.NewZodParser(tsParser),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "fresh"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".tsx", ".js", ".jsx"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "fresh",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Deno Fresh filesystem routes",
+		SupportedFrameworks: []string{
+			"Fresh",
+		},
+	}
+}
+
+// freshModules are the import specifiers Fresh is published under.
+var freshModules = []string{"$fresh/", "@fresh/core", "deno.land/x/fresh"}
+
+// Detect checks if Fresh is used in the project. Deno projects declare
+// dependencies in the imports of deno.json or an import map rather than in
+// package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range []string{"deno.json", "deno.jsonc", "import_map.json"} {
+		if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, name), freshModules); found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file mentions one of the modules.
+func (p *Plugin) checkFileForDependency(path string, modules []string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, nil
+	}
+
+	content := string(data)
+	for _, module := range modules {
+		if strings.Contains(content, module) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractRoutes extracts routes from files under the routes directory,
+// whose paths map to URLs and whose handler exports serve them.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		path, ok := routePath(file.Path)
+		if !ok {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, path)
+		if err != nil {
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// methodHandler is a handler for one HTTP method.
+type methodHandler struct {
+	method string
+	node   *sitter.Node
+}
+
+// extractRoutesFromFile extracts the routes of a single route file. A
+// handler object yields a route per method; a handler function or a page
+// component alone is served on GET.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, path string) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	if override := p.findRouteOverride(pf.RootNode, file.Content); override != "" {
+		path = override
+	}
+	wildcard := plugins.IsCatchAll(path)
+	path = convertPathParams(path)
+
+	handlers, found := p.findHandlers(pf.RootNode, file.Content)
+	if !found {
+		page := p.findDefaultExport(pf.RootNode)
+		if page == nil {
+			return nil, nil
+		}
+		handlers = []methodHandler{{method: "GET", node: page}}
+	}
+
+	var routes []types.Route
+	for _, h := range handlers {
+		route := types.Route{
+			Method:      h.method,
+			Path:        path,
+			OperationID: generateOperationID(h.method, path, ""),
+			Tags:        inferTags(path),
+			Parameters:  extractPathParams(path),
+			SourceFile:  file.Path,
+			SourceLine:  int(h.node.StartPoint().Row) + 1,
+		}
+		if h.method == "POST" || h.method == "PUT" || h.method == "PATCH" {
+			route.RequestBody = requestBody(h.node.Content(file.Content))
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+// findHandlers finds the exported handler: an object of method handlers,
+// possibly wrapped in define.handlers(...) or a satisfies clause, or a
+// single function serving every method.
+func (p *Plugin) findHandlers(rootNode *sitter.Node, content []byte) ([]methodHandler, bool) {
+	var handlers []methodHandler
+	found := false
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if found {
+			return false
+		}
+		switch node.Type() {
+		case "function_declaration":
+			if name := node.ChildByFieldName("name"); name != nil && name.Content(content) == "handler" && isExported(node) {
+				handlers = []methodHandler{{method: "GET", node: node}}
+				found = true
+			}
+			return false
+		case "variable_declarator":
+			name := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if name == nil || value == nil || name.Content(content) != "handler" || !isExported(node) {
+				return false
+			}
+			found = true
+			value = unwrapHandler(value)
+			switch value.Type() {
+			case "object":
+				handlers = p.objectHandlers(value, content)
+			case "arrow_function", "function_expression", "function":
+				handlers = []methodHandler{{method: "GET", node: value}}
+			}
+			return false
+		}
+		return true
+	})
+
+	return handlers, found
+}
+
+// unwrapHandler returns the handler object or function inside
+// define.handlers(...), satisfies and as expressions, and parentheses.
+func unwrapHandler(node *sitter.Node) *sitter.Node {
+	for {
+		switch node.Type() {
+		case "satisfies_expression", "as_expression", "parenthesized_expression":
+			if node.NamedChildCount() == 0 {
+				return node
+			}
+			node = node.NamedChild(0)
+		case "call_expression":
+			args := node.ChildByFieldName("arguments")
+			if args == nil || args.NamedChildCount() == 0 {
+				return node
+			}
+			node = args.NamedChild(0)
+		default:
+			return node
+		}
+	}
+}
+
+// objectHandlers returns the method handlers of a handler object, declared
+// as methods (GET(req) {}) or properties (GET: (req) => {}).
+func (p *Plugin) objectHandlers(object *sitter.Node, content []byte) []methodHandler {
+	var handlers []methodHandler
+
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		member := object.NamedChild(i)
+		var key *sitter.Node
+		switch member.Type() {
+		case "method_definition":
+			key = member.ChildByFieldName("name")
+		case "pair":
+			key = member.ChildByFieldName("key")
+		}
+		if key == nil {
+			continue
+		}
+		method := strings.Trim(key.Content(content), `"'`)
+		if handlerMethods[method] {
+			handlers = append(handlers, methodHandler{method: method, node: member})
+		}
+	}
+
+	return handlers
+}
+
+// findDefaultExport returns the default-exported page component, if any.
+func (p *Plugin) findDefaultExport(rootNode *sitter.Node) *sitter.Node {
+	for i := 0; i < int(rootNode.NamedChildCount()); i++ {
+		node := rootNode.NamedChild(i)
+		if node.Type() != "export_statement" {
+			continue
+		}
+		for j := 0; j < int(node.ChildCount()); j++ {
+			if node.Child(j).Type() == "default" {
+				return node
+			}
+		}
+	}
+	return nil
+}
+
+// findRouteOverride returns the routeOverride pattern of an exported route
+// config, which replaces the path derived from the file name.
+func (p *Plugin) findRouteOverride(rootNode *sitter.Node, content []byte) string {
+	var override string
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if override != "" {
+			return false
+		}
+		if node.Type() != "pair" {
+			return true
+		}
+		key := node.ChildByFieldName("key")
+		value := node.ChildByFieldName("value")
+		if key != nil && value != nil && key.Content(content) == "routeOverride" {
+			override, _ = p.tsParser.ExtractStringLiteral(value, content)
+		}
+		return true
+	})
+
+	return override
+}
+
+// isExported reports whether a function or variable declarator is declared
+// by a top-level export statement.
+func isExported(node *sitter.Node) bool {
+	parent := node.Parent()
+	if parent != nil && node.Type() == "variable_declarator" {
+		parent = parent.Parent()
+	}
+	return parent != nil && parent.Type() == "export_statement"
+}
+
+// dynamicSegmentRegex matches dynamic segments: [id], [[id]] and [...path].
+var dynamicSegmentRegex = regexp.MustCompile(`^\[{1,2}(\.\.\.)?(\w+)\]{1,2}$`)
+
+// routePath converts a file under routes/ to its URL pattern. Route groups
+// in parentheses do not add a segment; underscore-prefixed files such as
+// _app, _layout and _middleware do not serve routes, nor do islands
+// colocated in (_islands) folders.
+func routePath(filePath string) (string, bool) {
+	filePath = filepath.ToSlash(filePath)
+	idx := strings.LastIndex("/"+filePath, "/routes/")
+	if idx == -1 {
+		return "", false
+	}
+	rel := filePath[idx+len("routes/"):]
+	rel = strings.TrimSuffix(rel, filepath.Ext(rel))
+
+	var segments []string
+	for _, segment := range strings.Split(rel, "/") {
+		switch {
+		case strings.HasPrefix(segment, "_"), strings.HasPrefix(segment, "(_"):
+			return "", false
+		case strings.HasPrefix(segment, "(") && strings.HasSuffix(segment, ")"):
+			continue
+		}
+		if m := dynamicSegmentRegex.FindStringSubmatch(segment); m != nil {
+			segment = ":" + m[2]
+			if m[1] != "" {
+				segment += "*"
+			}
+		}
+		segments = append(segments, segment)
+	}
+	if len(segments) > 0 && segments[len(segments)-1] == "index" {
+		segments = segments[:len(segments)-1]
+	}
+
+	return "/" + strings.Join(segments, "/"), true
+}
+
+// Regex patterns for request body reads in handlers
+var (
+	// Matches a typed read: const user: User = await req.json()
+	typedBodyRegex = regexp.MustCompile(`:\s*([A-Z]\w*)\s*=\s*await\s+[\w.]*\b(?:req|request)\.json\(\)`)
+
+	// Matches a cast read: (await req.json()) as User
+	castBodyRegex = regexp.MustCompile(`\b(?:req|request)\.json\(\)\)?\s+as\s+([A-Z]\w*)`)
+
+	// Matches any request body read
+	bodyReadRegex = regexp.MustCompile(`\b(?:req|request)\.(?:json|formData|text)\(\)`)
+)
+
+// requestBody infers the request body read by a method handler.
+func requestBody(handler string) *types.RequestBody {
+	var bodySchema *types.Schema
+	if m := typedBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if m := castBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if bodyReadRegex.MatchString(handler) {
+		bodySchema = &types.Schema{Type: "object"}
+	} else {
+		return nil
+	}
+
+	return &types.RequestBody{
+		Required: true,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: bodySchema},
+		},
+	}
+}
+
+// walkNodes walks all nodes in the tree.
+func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	parser.Walk(node, fn)
+}
+
+// ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		for _, zs := range pf.ZodSchemas {
+			p.zodParser.ExtractAndRegister(zs.Name, zs.Node, file.Content)
+		}
+
+		pf.Close()
+	}
+
+	tsExtractor.Registry().Merge(p.zodParser.Registry())
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// urlPatternParamRegex matches URLPattern named groups with an optional
+// regex and modifier: :id, :id(\d+), :id?, :path*.
+var urlPatternParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)(?:\([^)]*\))?[?*+]?`)
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts URLPattern path params (:id) to OpenAPI
+// format ({id}).
+func convertPathParams(path string) string {
+	return urlPatternParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// generateOperationID generates an operation ID from method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.ReplaceAll(path, "-", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// Register registers the Fresh plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package fresh
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const freshIndexCode = `
+export default function Home() {
+  return <h1>Welcome</h1>;
+}
+`
+
+const freshUsersCode = `
+import { Handlers } from "$fresh/server.ts";
+
+interface CreateUser {
+  name: string;
+  email?: string;
+}
+
+export const handler: Handlers = {
+  GET(_req, _ctx) {
+    return Response.json([]);
+  },
+  async POST(req, _ctx) {
+    const user = (await req.json()) as CreateUser;
+    return Response.json(user, { status: 201 });
+  },
+};
+`
+
+const freshUserCode = `
+import { define } from "../../../utils.ts";
+
+export const handler = define.handlers({
+  GET(ctx) {
+    return Response.json({ id: ctx.params.id });
+  },
+  DELETE: (_ctx) => new Response(null, { status: 204 }),
+});
+`
+
+const freshOverrideCode = `
+import { RouteConfig } from "$fresh/server.ts";
+
+export const config: RouteConfig = {
+  routeOverride: "/books/:isbn(\\d+)",
+};
+
+export const handler = (_req: Request) => new Response("book");
+`
+
+const freshLayoutCode = `
+export default function Layout({ Component }) {
+  return <div><Component /></div>;
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "fresh", p.Name())
+	assert.Contains(t, p.Extensions(), ".tsx")
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+
+	found, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	denoJSON := `{"imports": {"$fresh/": "https://deno.land/x/fresh@1.7.3/"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "deno.json"), []byte(denoJSON), 0o644))
+
+	found, err = New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestRoutePath(t *testing.T) {
+	tests := []struct {
+		file string
+		path string
+		ok   bool
+	}{
+		{"/app/routes/index.tsx", "/", true},
+		{"/app/routes/about.tsx", "/about", true},
+		{"/app/routes/blog/[slug].tsx", "/blog/:slug", true},
+		{"/app/routes/files/[...path].ts", "/files/:path*", true},
+		{"/app/routes/(marketing)/pricing.tsx", "/pricing", true},
+		{"/app/routes/_app.tsx", "", false},
+		{"/app/routes/admin/_middleware.ts", "", false},
+		{"/app/routes/(_islands)/Counter.tsx", "", false},
+		{"/app/islands/Counter.tsx", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path, ok := routePath(tt.file)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.path, path)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/routes/index.tsx", Language: "typescript", Content: []byte(freshIndexCode)},
+		{Path: "/app/routes/api/users/index.ts", Language: "typescript", Content: []byte(freshUsersCode)},
+		{Path: "/app/routes/api/users/[id].ts", Language: "typescript", Content: []byte(freshUserCode)},
+		{Path: "/app/routes/(shop)/book.ts", Language: "typescript", Content: []byte(freshOverrideCode)},
+		{Path: "/app/routes/_layout.tsx", Language: "typescript", Content: []byte(freshLayoutCode)},
+	})
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	assert.Len(t, routes, 6)
+	for _, key := range []string{
+		"GET /",
+		"GET /api/users",
+		"POST /api/users",
+		"GET /api/users/{id}",
+		"DELETE /api/users/{id}",
+		"GET /books/{isbn}",
+	} {
+		assert.Contains(t, byKey, key)
+	}
+
+	create := byKey["POST /api/users"]
+	assert.Equal(t, "postApiUsers", create.OperationID)
+	assert.Equal(t, []string{"users"}, create.Tags)
+	assert.Equal(t, 13, create.SourceLine)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	get := byKey["GET /api/users/{id}"]
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "id", get.Parameters[0].Name)
+	assert.Nil(t, get.RequestBody)
+
+	assert.Equal(t, "/app/routes/(shop)/book.ts", byKey["GET /books/{isbn}"].SourceFile)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "/app/routes/index.tsx", Language: "typescript", Content: []byte(freshIndexCode)},
+		{Path: "/app/routes/api/users/index.ts", Language: "typescript", Content: []byte(freshUsersCode)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, schemas, 1)
+	assert.Equal(t, "CreateUser", schemas[0].Title)
+}
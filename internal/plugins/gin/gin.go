@@ -8,9 +8,13 @@ import (
 	"bufio"
 	"fmt"
 	"go/ast"
+	"maps"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -121,13 +125,21 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 // ExtractRoutes parses source files and extracts Gin route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
+	handlers := &handlerFacts{
+		statuses: make(map[string][]int),
+		bindings: make(map[string][]binding),
+		vars:     make(map[string]map[string]string),
+	}
+
+	// Bound request structs may be declared in any file
+	structs := p.collectStructs(files)
 
 	for _, file := range files {
 		if file.Language != "go" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(file, handlers, structs)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -136,6 +148,13 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		routes = append(routes, fileRoutes...)
 	}
 
+	// Named handlers may be declared in any file
+	for i := range routes {
+		key := handlers.key(routes[i].Handler, handlers.vars[routes[i].SourceFile])
+		plugins.ApplyStatuses(&routes[i], handlers.statuses[key])
+		p.applyBindings(&routes[i], handlers.bindings[key], structs)
+	}
+
 	// Deduplicate routes (same method + path = same route)
 	routes = deduplicateRoutes(routes)
 
@@ -159,8 +178,137 @@ func deduplicateRoutes(routes []types.Route) []types.Route {
 	return result
 }
 
-// extractRoutesFromFile extracts routes from a single Go file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
+// handlerFacts records the response statuses and bound request structs of
+// the handlers declared across files, keyed as handlerKey returns.
+type handlerFacts struct {
+	statuses map[string][]int
+	bindings map[string][]binding
+
+	// vars maps each file to the types of the variables it declares
+	vars map[string]map[string]string
+}
+
+// key returns the key the handler of a route is recorded under. The
+// receiver type of a method value such as users.Get is taken from the
+// declaration of users in the route's file, or else from a method of that
+// name on a type named like users or on a single type.
+func (h *handlerFacts) key(handler string, vars map[string]string) string {
+	qualifier, name, qualified := strings.Cut(handler, ".")
+	if !qualified {
+		qualifier, name = "", handler
+	}
+	if typ, ok := vars[qualifier]; ok && qualified {
+		return typ + "." + name
+	}
+	if h.declared(name) {
+		return name
+	}
+
+	var candidates []string
+	for _, key := range h.keys() {
+		typ, method, ok := strings.Cut(key, ".")
+		if !ok || method != name {
+			continue
+		}
+		if strings.EqualFold(typ, qualifier) {
+			return key
+		}
+		candidates = append(candidates, key)
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return ""
+}
+
+// keys returns the keys handlers are recorded under, sorted.
+func (h *handlerFacts) keys() []string {
+	keys := slices.Collect(maps.Keys(h.statuses))
+	for key := range h.bindings {
+		if _, ok := h.statuses[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// declared reports whether a handler is recorded under key.
+func (h *handlerFacts) declared(key string) bool {
+	_, withStatuses := h.statuses[key]
+	_, withBindings := h.bindings[key]
+	return withStatuses || withBindings
+}
+
+// handlerKey returns the key the handler funcDecl declares is recorded
+// under: Type.Method for methods, the name for functions.
+func handlerKey(funcDecl *ast.FuncDecl) string {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return funcDecl.Name.Name
+	}
+	return typeName(funcDecl.Recv.List[0].Type) + "." + funcDecl.Name.Name
+}
+
+// varTypes returns the types of the variables file declares with a type,
+// a composite literal such as &UserHandler{} or a constructor such as
+// NewUserHandler(db), including function parameters.
+func varTypes(file *ast.File) map[string]string {
+	vars := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					if typ := valueType(n.Rhs[i]); typ != "" {
+						vars[ident.Name] = typ
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				typ := typeName(n.Type)
+				if typ == "" && i < len(n.Values) {
+					typ = valueType(n.Values[i])
+				}
+				if typ != "" {
+					vars[name.Name] = typ
+				}
+			}
+		case *ast.Field:
+			if typ := typeName(n.Type); typ != "" {
+				for _, name := range n.Names {
+					vars[name.Name] = typ
+				}
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+// valueType returns the type of a composite literal, or the type a NewXxx
+// constructor call returns by convention.
+func valueType(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.UnaryExpr:
+		return valueType(e.X)
+	case *ast.CompositeLit:
+		return typeName(e.Type)
+	case *ast.CallExpr:
+		if name, ok := strings.CutPrefix(typeName(e.Fun), "New"); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// extractRoutesFromFile extracts routes from a single Go file and records the
+// response statuses and bound request structs of the handler functions it
+// declares.
+func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile, handlers *handlerFacts, structs map[string]parser.StructDefinition) ([]types.Route, error) {
 	pf, err := p.goParser.ParseSource(file.Path, string(file.Content))
 	if err != nil {
 		return nil, err
@@ -170,6 +318,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 	if !p.hasGinImport(pf) {
 		return nil, nil
 	}
+	handlers.vars[file.Path] = varTypes(pf.AST)
 
 	// Find route definitions
 	var routes []types.Route
@@ -179,6 +328,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 		file:        pf,
 		parser:      p.goParser,
 		prefixStack: []string{},
+		structs:     structs,
 	}
 
 	ast.Inspect(pf.AST, func(n ast.Node) bool {
@@ -190,6 +340,13 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 		if funcDecl, ok := n.(*ast.FuncDecl); ok {
 			funcRoutes := p.extractRoutesFromFunc(funcDecl, ctx)
 			routes = append(routes, funcRoutes...)
+
+			if codes := contextStatuses(funcDecl.Type, funcDecl.Body); len(codes) > 0 {
+				handlers.statuses[handlerKey(funcDecl)] = codes
+			}
+			if bindings := contextBindings(funcDecl.Type, funcDecl.Body); len(bindings) > 0 {
+				handlers.bindings[handlerKey(funcDecl)] = bindings
+			}
 		}
 
 		return true
@@ -209,6 +366,7 @@ type extractionContext struct {
 	parser       *parser.GoParser
 	prefixStack  []string
 	groupPrefixes map[string]string // Maps variable names to their group prefixes
+	structs      map[string]parser.StructDefinition // Struct definitions across all files
 }
 
 // currentPrefix returns the current route prefix.
@@ -419,6 +577,7 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 	fullPath = normalizePath(fullPath)
 
 	// Convert Gin :param syntax to OpenAPI {param} syntax
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertGinPathParams(fullPath)
 
 	// Extract handler name if present
@@ -451,6 +610,14 @@ func (p *Plugin) extractRouteFromCall(callExpr *ast.CallExpr, method string, ctx
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	if lit, ok := callExpr.Args[len(callExpr.Args)-1].(*ast.FuncLit); ok {
+		plugins.ApplyStatuses(route, contextStatuses(lit.Type, lit.Body))
+		p.applyBindings(route, contextBindings(lit.Type, lit.Body), ctx.structs)
+	}
 
 	return route
 }
@@ -488,6 +655,7 @@ func (p *Plugin) extractRouteFromHandle(callExpr *ast.CallExpr, ctx *extractionC
 	fullPath = normalizePath(fullPath)
 
 	// Convert Gin :param syntax to OpenAPI {param} syntax
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertGinPathParams(fullPath)
 
 	// Extract handler name if present
@@ -514,6 +682,14 @@ func (p *Plugin) extractRouteFromHandle(callExpr *ast.CallExpr, ctx *extractionC
 		Parameters:  params,
 		SourceLine:  ctx.file.FileSet.Position(callExpr.Pos()).Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	if lit, ok := callExpr.Args[len(callExpr.Args)-1].(*ast.FuncLit); ok {
+		plugins.ApplyStatuses(route, contextStatuses(lit.Type, lit.Body))
+		p.applyBindings(route, contextBindings(lit.Type, lit.Body), ctx.structs)
+	}
 
 	return route
 }
@@ -539,6 +715,404 @@ func (p *Plugin) extractHandlerName(expr ast.Expr) string {
 	}
 }
 
+// statusConstants maps net/http status constants to their codes.
+var statusConstants = map[string]int{
+	"StatusOK":                    http.StatusOK,
+	"StatusCreated":               http.StatusCreated,
+	"StatusAccepted":              http.StatusAccepted,
+	"StatusNoContent":             http.StatusNoContent,
+	"StatusPartialContent":        http.StatusPartialContent,
+	"StatusMovedPermanently":      http.StatusMovedPermanently,
+	"StatusFound":                 http.StatusFound,
+	"StatusSeeOther":              http.StatusSeeOther,
+	"StatusNotModified":           http.StatusNotModified,
+	"StatusTemporaryRedirect":     http.StatusTemporaryRedirect,
+	"StatusPermanentRedirect":     http.StatusPermanentRedirect,
+	"StatusBadRequest":            http.StatusBadRequest,
+	"StatusUnauthorized":          http.StatusUnauthorized,
+	"StatusPaymentRequired":       http.StatusPaymentRequired,
+	"StatusForbidden":             http.StatusForbidden,
+	"StatusNotFound":              http.StatusNotFound,
+	"StatusMethodNotAllowed":      http.StatusMethodNotAllowed,
+	"StatusNotAcceptable":         http.StatusNotAcceptable,
+	"StatusRequestTimeout":        http.StatusRequestTimeout,
+	"StatusConflict":              http.StatusConflict,
+	"StatusGone":                  http.StatusGone,
+	"StatusPreconditionFailed":    http.StatusPreconditionFailed,
+	"StatusRequestEntityTooLarge": http.StatusRequestEntityTooLarge,
+	"StatusUnsupportedMediaType":  http.StatusUnsupportedMediaType,
+	"StatusTeapot":                http.StatusTeapot,
+	"StatusUnprocessableEntity":   http.StatusUnprocessableEntity,
+	"StatusTooManyRequests":       http.StatusTooManyRequests,
+	"StatusInternalServerError":   http.StatusInternalServerError,
+	"StatusNotImplemented":        http.StatusNotImplemented,
+	"StatusBadGateway":            http.StatusBadGateway,
+	"StatusServiceUnavailable":    http.StatusServiceUnavailable,
+	"StatusGatewayTimeout":        http.StatusGatewayTimeout,
+}
+
+// contextWriters are the gin.Context methods whose first argument is the response status.
+var contextWriters = map[string]bool{
+	"JSON":                true,
+	"IndentedJSON":        true,
+	"SecureJSON":          true,
+	"JSONP":               true,
+	"AsciiJSON":           true,
+	"PureJSON":            true,
+	"XML":                 true,
+	"YAML":                true,
+	"TOML":                true,
+	"ProtoBuf":            true,
+	"String":              true,
+	"HTML":                true,
+	"Data":                true,
+	"DataFromReader":      true,
+	"Render":              true,
+	"Redirect":            true,
+	"Status":              true,
+	"AbortWithStatus":     true,
+	"AbortWithStatusJSON": true,
+	"AbortWithError":      true,
+}
+
+// contextStatuses returns the response statuses written through the
+// *gin.Context parameter of a handler, e.g. c.JSON(http.StatusNotFound, ...).
+// It returns nil for functions that do not take a *gin.Context.
+func contextStatuses(fnType *ast.FuncType, body *ast.BlockStmt) []int {
+	ctxName := contextParam(fnType, body)
+	if ctxName == "" {
+		return nil
+	}
+
+	var codes []int
+	seen := make(map[int]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !contextWriters[sel.Sel.Name] {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); !ok || ident.Name != ctxName {
+			return true
+		}
+		if code := statusValue(call.Args[0]); code > 0 && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+		return true
+	})
+
+	return codes
+}
+
+// contextParam returns the name of the *gin.Context parameter of a handler,
+// or "" if it has none or no body.
+func contextParam(fnType *ast.FuncType, body *ast.BlockStmt) string {
+	if fnType == nil || body == nil || fnType.Params == nil {
+		return ""
+	}
+
+	for _, field := range fnType.Params.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		sel, ok := star.X.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Context" || len(field.Names) == 0 {
+			continue
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "gin" {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+// statusValue resolves an integer literal or http.StatusXxx constant.
+func statusValue(expr ast.Expr) int {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		code, err := strconv.Atoi(e.Value)
+		if err != nil {
+			return 0
+		}
+		return code
+	case *ast.SelectorExpr:
+		return statusConstants[e.Sel.Name]
+	}
+	return 0
+}
+
+// bindingKind says which part of the request a bound struct is read from.
+type bindingKind int
+
+const (
+	bindQuery bindingKind = iota
+	bindURI
+	bindHeader
+	bindBody
+	// bindForm reads the query string for GET, HEAD and DELETE requests and
+	// the body otherwise
+	bindForm
+)
+
+// contextBinders maps the gin.Context binding methods to what they bind.
+var contextBinders = map[string]bindingKind{
+	"Bind":                   bindForm,
+	"ShouldBind":             bindForm,
+	"BindQuery":              bindQuery,
+	"ShouldBindQuery":        bindQuery,
+	"BindUri":                bindURI,
+	"ShouldBindUri":          bindURI,
+	"BindHeader":             bindHeader,
+	"ShouldBindHeader":       bindHeader,
+	"BindJSON":               bindBody,
+	"ShouldBindJSON":         bindBody,
+	"BindXML":                bindBody,
+	"ShouldBindXML":          bindBody,
+	"BindYAML":               bindBody,
+	"ShouldBindYAML":         bindBody,
+	"BindTOML":               bindBody,
+	"ShouldBindTOML":         bindBody,
+	"ShouldBindBodyWith":     bindBody,
+	"ShouldBindBodyWithJSON": bindBody,
+	"BindWith":               bindForm,
+	"ShouldBindWith":         bindForm,
+	"MustBindWith":           bindForm,
+}
+
+// bindingEngines maps the binding package's engines, passed to
+// ShouldBindWith, to what they bind.
+var bindingEngines = map[string]bindingKind{
+	"Query":  bindQuery,
+	"Uri":    bindURI,
+	"Header": bindHeader,
+	"JSON":   bindBody,
+	"XML":    bindBody,
+	"YAML":   bindBody,
+	"TOML":   bindBody,
+}
+
+// binding is a struct a handler binds request data into.
+type binding struct {
+	kind     bindingKind
+	typeName string
+}
+
+// contextBindings returns the structs a handler binds through its
+// *gin.Context parameter, e.g. c.ShouldBindQuery(&query).
+func contextBindings(fnType *ast.FuncType, body *ast.BlockStmt) []binding {
+	ctxName := contextParam(fnType, body)
+	if ctxName == "" {
+		return nil
+	}
+
+	locals := localTypes(body)
+
+	var bindings []binding
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		kind, ok := contextBinders[sel.Sel.Name]
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); !ok || ident.Name != ctxName {
+			return true
+		}
+
+		// ShouldBindWith(&obj, binding.Query) names its engine
+		if len(call.Args) > 1 {
+			if engine, ok := call.Args[1].(*ast.SelectorExpr); ok {
+				if engineKind, ok := bindingEngines[engine.Sel.Name]; ok {
+					kind = engineKind
+				}
+			}
+		}
+
+		target := call.Args[0]
+		if unary, ok := target.(*ast.UnaryExpr); ok {
+			target = unary.X
+		}
+		if ident, ok := target.(*ast.Ident); ok && locals[ident.Name] != "" {
+			bindings = append(bindings, binding{kind: kind, typeName: locals[ident.Name]})
+		}
+		return true
+	})
+
+	return bindings
+}
+
+// localTypes maps the variables a function body declares to their named
+// struct type: var q Query, q := Query{}, q := &Query{} and q := new(Query).
+func localTypes(body *ast.BlockStmt) map[string]string {
+	locals := make(map[string]string)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.ValueSpec:
+			if name := typeName(stmt.Type); name != "" {
+				for _, ident := range stmt.Names {
+					locals[ident.Name] = name
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range stmt.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || i >= len(stmt.Rhs) {
+					continue
+				}
+				if name := valueTypeName(stmt.Rhs[i]); name != "" {
+					locals[ident.Name] = name
+				}
+			}
+		}
+		return true
+	})
+	return locals
+}
+
+// valueTypeName returns the struct type of a composite literal, a pointer
+// to one, or a new(T) call.
+func valueTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return typeName(e.Type)
+	case *ast.UnaryExpr:
+		return valueTypeName(e.X)
+	case *ast.CallExpr:
+		if fn, ok := e.Fun.(*ast.Ident); ok && fn.Name == "new" && len(e.Args) == 1 {
+			return typeName(e.Args[0])
+		}
+	}
+	return ""
+}
+
+// typeName returns the name of a named type, dropping the pointer, package
+// qualifier and type arguments (e.g., *dto.Query is Query).
+func typeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return typeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return typeName(e.X)
+	case *ast.IndexListExpr:
+		return typeName(e.X)
+	}
+	return ""
+}
+
+// collectStructs gathers the struct definitions of all Go files.
+func (p *Plugin) collectStructs(files []scanner.SourceFile) map[string]parser.StructDefinition {
+	structs := make(map[string]parser.StructDefinition)
+	for _, file := range files {
+		if file.Language != "go" {
+			continue
+		}
+		pf, err := p.goParser.ParseSource(file.Path, string(file.Content))
+		if err != nil {
+			continue
+		}
+		for _, def := range p.goParser.ExtractStructs(pf) {
+			structs[def.Name] = def
+		}
+	}
+	return structs
+}
+
+// applyBindings documents the structs a handler binds: form and query
+// tags become query parameters, uri tags path parameters, header tags
+// header parameters, and bodies reference the struct's schema.
+func (p *Plugin) applyBindings(route *types.Route, bindings []binding, structs map[string]parser.StructDefinition) {
+	for _, b := range bindings {
+		def, ok := structs[b.typeName]
+		if !ok {
+			continue
+		}
+
+		kind := b.kind
+		if kind == bindForm {
+			kind = bindBody
+			if route.Method == "GET" || route.Method == "HEAD" || route.Method == "DELETE" {
+				kind = bindQuery
+			}
+		}
+
+		switch kind {
+		case bindQuery:
+			p.addBoundParams(route, def, "form", "query", structs, 0)
+		case bindURI:
+			p.addBoundParams(route, def, "uri", "path", structs, 0)
+		case bindHeader:
+			p.addBoundParams(route, def, "header", "header", structs, 0)
+		case bindBody:
+			if route.RequestBody == nil {
+				route.RequestBody = &types.RequestBody{
+					Required: true,
+					Content: map[string]types.MediaType{
+						"application/json": {Schema: schema.SchemaRef(def.Name)},
+					},
+				}
+			}
+		}
+	}
+}
+
+// addBoundParams adds a parameter for each field of def bound by tag,
+// including the fields of embedded structs. Form binding falls back to the
+// field name; other tags must be present. Path parameters already on the
+// route take the field's type.
+func (p *Plugin) addBoundParams(route *types.Route, def parser.StructDefinition, tag, in string, structs map[string]parser.StructDefinition, depth int) {
+	if depth > 5 {
+		return
+	}
+	for _, embedded := range def.Embedded {
+		if inner, ok := structs[strings.TrimPrefix(embedded, "*")]; ok {
+			p.addBoundParams(route, inner, tag, in, structs, depth+1)
+		}
+	}
+
+	for _, field := range def.Fields {
+		name, ok := field.Bindings[tag]
+		if name == "-" || (!ok && tag != "form") {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		param := types.Parameter{
+			Name:     name,
+			In:       in,
+			Required: field.IsRequired || in == "path",
+			Schema:   p.schemaExtractor.FieldSchema(field),
+		}
+
+		exists := false
+		for i := range route.Parameters {
+			if route.Parameters[i].Name == name && route.Parameters[i].In == in {
+				route.Parameters[i].Schema = param.Schema
+				exists = true
+			}
+		}
+		if !exists && in != "path" {
+			route.Parameters = append(route.Parameters, param)
+		}
+	}
+}
+
 // hasGinImport checks if the file imports Gin.
 func (p *Plugin) hasGinImport(pf *parser.ParsedFile) bool {
 	for _, importPath := range ginImportPaths {
@@ -4,8 +4,10 @@
 package gin
 
 import (
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -281,6 +283,110 @@ func SetupRoutes(r *gin.Engine) {
 	assert.Equal(t, "<anonymous>", routes[0].Handler)
 }
 
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	routesSource := `package main
+
+import "github.com/gin-gonic/gin"
+
+func SetupRoutes(r *gin.Engine) {
+	r.GET("/users/:id", handlers.GetUser)
+	r.DELETE("/users/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+}
+`
+	handlersSource := `package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetUser(ctx *gin.Context) {
+	user, ok := users[ctx.Param("id")]
+	if !ok {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	}
+	ctx.JSON(http.StatusOK, user)
+}
+`
+
+	p := New()
+	files := []scanner.SourceFile{
+		{Path: "routes.go", Language: "go", Content: []byte(routesSource)},
+		{Path: "handlers/users.go", Language: "go", Content: []byte(handlersSource)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	// Handler declared in another file
+	get := routes[0]
+	assert.Equal(t, "GET", get.Method)
+	assert.Len(t, get.Responses, 2)
+	assert.Contains(t, get.Responses, "200")
+	assert.Contains(t, get.Responses, "404")
+
+	del := routes[1]
+	assert.Equal(t, "DELETE", del.Method)
+	require.Len(t, del.Responses, 1)
+	assert.Equal(t, "No Content", del.Responses["204"].Description)
+}
+
+func TestPlugin_ExtractRoutes_MethodStatuses(t *testing.T) {
+	routesSource := `package main
+
+import "github.com/gin-gonic/gin"
+
+func SetupRoutes(r *gin.Engine, orders *OrderHandler) {
+	users := NewUserHandler(db)
+	r.GET("/users/:id", users.Get)
+	r.GET("/orders/:id", orders.Get)
+	r.POST("/payments", paymentHandler.Create)
+}
+`
+	handlersSource := `package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *UserHandler) Get(c *gin.Context) {
+	if user == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+	}
+}
+
+func (h *OrderHandler) Get(c *gin.Context) {
+	c.AbortWithStatus(http.StatusPaymentRequired)
+}
+
+func (h *PaymentHandler) Create(c *gin.Context) {
+	c.AbortWithStatus(http.StatusBadRequest)
+}
+`
+
+	files := []scanner.SourceFile{
+		{Path: "routes.go", Language: "go", Content: []byte(routesSource)},
+		{Path: "handlers.go", Language: "go", Content: []byte(handlersSource)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	// Handlers that only branch to errors succeed implicitly
+	assert.ElementsMatch(t, []string{"200", "404"}, slices.Collect(maps.Keys(routes[0].Responses)))
+	assert.ElementsMatch(t, []string{"200", "402"}, slices.Collect(maps.Keys(routes[1].Responses)))
+	// A variable declared elsewhere is matched by its name
+	assert.ElementsMatch(t, []string{"200", "400"}, slices.Collect(maps.Keys(routes[2].Responses)))
+}
+
 func TestPlugin_ExtractRoutes_NoGinImport(t *testing.T) {
 	source := `package main
 
@@ -657,3 +763,85 @@ func SetupRoutes(r *gin.Engine) {
 	assert.Equal(t, "/path/to/routes.go", routes[0].SourceFile)
 	assert.Greater(t, routes[0].SourceLine, 0)
 }
+
+func TestPlugin_ExtractRoutes_BoundStructs(t *testing.T) {
+	p := New()
+
+	models := `package handlers
+
+type Paging struct {
+	Page int ` + "`form:\"page\" default:\"1\"`" + `
+}
+
+type ListQuery struct {
+	Paging
+	Search string ` + "`form:\"q\" binding:\"required\"`" + `
+	Secret string ` + "`form:\"-\"`" + `
+}
+
+type ItemURI struct {
+	ID int ` + "`uri:\"id\" binding:\"required\"`" + `
+}
+
+type CreateItem struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+`
+	handlers := `package handlers
+
+import "github.com/gin-gonic/gin"
+
+func ListItems(c *gin.Context) {
+	var query ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		return
+	}
+}
+
+func CreateItemHandler(c *gin.Context) {
+	req := &CreateItem{}
+	c.ShouldBindJSON(req)
+}
+
+func Setup(r *gin.Engine) {
+	r.GET("/items", ListItems)
+	r.POST("/items", CreateItemHandler)
+	r.GET("/items/:id", func(c *gin.Context) {
+		var uri ItemURI
+		c.ShouldBindUri(&uri)
+	})
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "handlers.go", Language: "go", Content: []byte(handlers)},
+		{Path: "models.go", Language: "go", Content: []byte(models)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	byKey := make(map[string]int)
+	for i, r := range routes {
+		byKey[r.Method+" "+r.Path] = i
+	}
+
+	list := routes[byKey["GET /items"]]
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "page", list.Parameters[0].Name)
+	assert.Equal(t, "query", list.Parameters[0].In)
+	assert.Equal(t, "integer", list.Parameters[0].Schema.Type)
+	assert.Equal(t, int64(1), list.Parameters[0].Schema.Default)
+	assert.Equal(t, "q", list.Parameters[1].Name)
+	assert.True(t, list.Parameters[1].Required)
+	assert.Nil(t, list.RequestBody)
+
+	create := routes[byKey["POST /items"]]
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateItem", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	get := routes[byKey["GET /items/{id}"]]
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "path", get.Parameters[0].In)
+	assert.Equal(t, "integer", get.Parameters[0].Schema.Type)
+}
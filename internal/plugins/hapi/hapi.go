@@ -0,0 +1,613 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package hapi provides a plugin for extracting routes from hapi
+// applications: the route configuration objects passed to server.route,
+// and the Joi schemas of their validate and response options.
+package hapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// anyMethod are the methods documented for routes matching any method ('*').
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// Plugin implements the FrameworkPlugin interface for hapi.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new hapi plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "hapi"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js", ".mts", ".mjs", ".cjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "hapi",
+		Version:     "1.0.0",
+		Description: "Extracts routes and Joi schemas from hapi route configurations",
+		SupportedFrameworks: []string{
+			"@hapi/hapi",
+			"hapi",
+		},
+	}
+}
+
+// Detect checks if hapi is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	for _, name := range []string{"@hapi/hapi", "hapi"} {
+		if _, ok := pkg.Dependencies[name]; ok {
+			return true, nil
+		}
+		if _, ok := pkg.DevDependencies[name]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// declaration is a const declared in a source file.
+type declaration struct {
+	value   *sitter.Node
+	content []byte
+	file    string
+	line    int
+}
+
+// project holds the declarations of every source file, so that route
+// options and Joi schemas can be resolved across files.
+type project struct {
+	files []*parser.ParsedTSFile
+	decls map[string]declaration
+	joi   *schema.JoiParser
+
+	// components maps the identifiers of Joi object schemas to the names
+	// of their components
+	components map[string]string
+}
+
+// collectProject parses the files, indexes their const declarations and
+// names the Joi object schemas among them as components: by their label,
+// as hapi-swagger does, or else by their identifier. The caller closes the
+// project's files.
+func (p *Plugin) collectProject(ctx context.Context, files []scanner.SourceFile) *project {
+	pr := &project{
+		decls:      make(map[string]declaration),
+		joi:        schema.NewJoiParser(p.tsParser),
+		components: make(map[string]string),
+	}
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		pr.files = append(pr.files, pf)
+
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() != "variable_declarator" {
+				return true
+			}
+			name := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if name == nil || value == nil || name.Type() != "identifier" {
+				return true
+			}
+			pr.decls[name.Content(pf.Content)] = declaration{
+				value:   parser.UnwrapExpression(value),
+				content: pf.Content,
+				file:    pf.Path,
+				line:    int(node.StartPoint().Row) + 1,
+			}
+			return true
+		})
+	}
+
+	for _, name := range sortedKeys(pr.decls) {
+		decl := pr.decls[name]
+		if !schema.IsJoiSchema(decl.value, decl.content) {
+			continue
+		}
+		s, _ := pr.joi.ParseJoiSchema(decl.value, decl.content)
+		if s.Type != "object" {
+			continue
+		}
+		component := name
+		if s.Title != "" {
+			component = s.Title
+		}
+		pr.components[name] = component
+	}
+	for name, component := range pr.components {
+		pr.joi.AddReference(name, component)
+	}
+
+	return pr
+}
+
+// resolve returns the value of an identifier declared in the project, or
+// the node itself.
+func (pr *project) resolve(node *sitter.Node, content []byte) (*sitter.Node, []byte) {
+	node = parser.UnwrapExpression(node)
+	if node != nil && node.Type() == "identifier" {
+		if decl, ok := pr.decls[node.Content(content)]; ok {
+			return decl.value, decl.content
+		}
+	}
+	return node, content
+}
+
+// ExtractRoutes parses source files and extracts routes from hapi route
+// configuration objects.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var routes []types.Route
+	for _, pf := range pr.files {
+		content := pf.Content
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() == "object" {
+				routes = append(routes, pr.parseRoute(node, content, pf.Path)...)
+			}
+			return true
+		})
+	}
+
+	return routes, nil
+}
+
+// parseRoute converts a route configuration object ({ method, path,
+// handler, options }) to routes, one per method. Objects that are not
+// route configurations return nil.
+func (pr *project) parseRoute(object *sitter.Node, content []byte, file string) []types.Route {
+	methodNode := parser.ObjectProperty(object, "method", content)
+	pathNode := parser.ObjectProperty(object, "path", content)
+	if methodNode == nil || pathNode == nil {
+		return nil
+	}
+	path, ok := pr.stringOf(pathNode, content)
+	if !ok || !strings.HasPrefix(path, "/") {
+		return nil
+	}
+
+	var options *sitter.Node
+	optionsContent := content
+	for _, key := range []string{"options", "config"} {
+		if value := parser.ObjectProperty(object, key, content); value != nil {
+			options, optionsContent = pr.resolve(value, content)
+			if options.Type() != "object" {
+				options = nil
+			}
+			break
+		}
+	}
+	handler := parser.ObjectProperty(object, "handler", content)
+	handlerContent := content
+	if handler == nil && options != nil {
+		handler = parser.ObjectProperty(options, "handler", optionsContent)
+		handlerContent = optionsContent
+	}
+	if handler == nil && options == nil {
+		return nil
+	}
+
+	methods := pr.methods(methodNode, content)
+	if len(methods) == 0 {
+		return nil
+	}
+
+	openAPIPath, wildcard := convertPath(path)
+	base := types.Route{
+		Path:       openAPIPath,
+		Handler:    handlerName(handler, handlerContent),
+		Parameters: extractPathParams(openAPIPath),
+		SourceFile: file,
+		SourceLine: int(object.StartPoint().Row) + 1,
+	}
+	if wildcard {
+		plugins.MarkWildcard(&base)
+	}
+	if options != nil {
+		pr.applyOptions(&base, options, optionsContent)
+	}
+	if len(base.Tags) == 0 {
+		base.Tags = inferTags(openAPIPath)
+	}
+
+	routes := make([]types.Route, 0, len(methods))
+	for _, method := range methods {
+		route := base
+		route.Method = method
+		if len(methods) > 1 && route.OperationID != "" {
+			route.OperationID = strings.ToLower(method) + strings.ToUpper(route.OperationID[:1]) + route.OperationID[1:]
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// methods returns the methods of a route's method option: a method, an
+// array of methods or '*'.
+func (pr *project) methods(node *sitter.Node, content []byte) []string {
+	var values []string
+	if node = parser.UnwrapExpression(node); node.Type() == "array" {
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if value, ok := pr.stringOf(node.NamedChild(i), content); ok {
+				values = append(values, value)
+			}
+		}
+	} else if value, ok := pr.stringOf(node, content); ok {
+		values = append(values, value)
+	}
+
+	var methods []string
+	for _, value := range values {
+		if value == "*" {
+			return anyMethod
+		}
+		methods = append(methods, strings.ToUpper(value))
+	}
+	return methods
+}
+
+// applyOptions documents a route from its options: description, notes,
+// tags and id, the validate options and the response schemas.
+func (pr *project) applyOptions(route *types.Route, options *sitter.Node, content []byte) {
+	if value := parser.ObjectProperty(options, "description", content); value != nil {
+		route.Summary, _ = pr.stringOf(value, content)
+	}
+	if value := parser.ObjectProperty(options, "notes", content); value != nil {
+		route.Description = strings.Join(pr.stringsOf(value, content), "\n")
+	}
+	if value := parser.ObjectProperty(options, "tags", content); value != nil {
+		for _, tag := range pr.stringsOf(value, content) {
+			// hapi-swagger documents the routes tagged api
+			if tag != "api" {
+				route.Tags = append(route.Tags, tag)
+			}
+		}
+	}
+	if value := parser.ObjectProperty(options, "id", content); value != nil {
+		route.OperationID, _ = pr.stringOf(value, content)
+	}
+
+	if validate, validateContent := pr.resolve(parser.ObjectProperty(options, "validate", content), content); validate != nil && validate.Type() == "object" {
+		pr.applyValidate(route, validate, validateContent, pr.payloadType(options, content))
+	}
+	if response, responseContent := pr.resolve(parser.ObjectProperty(options, "response", content), content); response != nil && response.Type() == "object" {
+		pr.applyResponse(route, response, responseContent)
+	}
+}
+
+// applyValidate types the route's parameters and request body from the
+// Joi schemas of its validate option.
+func (pr *project) applyValidate(route *types.Route, validate *sitter.Node, content []byte, mediaType string) {
+	if params := parser.ObjectProperty(validate, "params", content); params != nil {
+		for _, param := range pr.parameters(params, content, "path") {
+			for i := range route.Parameters {
+				if route.Parameters[i].Name == param.Name {
+					param.Required = true
+					route.Parameters[i] = param
+				}
+			}
+		}
+	}
+	for _, in := range []string{"query", "headers"} {
+		if node := parser.ObjectProperty(validate, in, content); node != nil {
+			location := in
+			if in == "headers" {
+				location = "header"
+			}
+			route.Parameters = append(route.Parameters, pr.parameters(node, content, location)...)
+		}
+	}
+	if payload := parser.ObjectProperty(validate, "payload", content); payload != nil {
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content:  map[string]types.MediaType{mediaType: {Schema: pr.schemaOf(payload, content)}},
+		}
+	}
+}
+
+// applyResponse documents the route's responses from the schema and status
+// options of its response option.
+func (pr *project) applyResponse(route *types.Route, response *sitter.Node, content []byte) {
+	responses := make(map[string]types.Response)
+	if s := parser.ObjectProperty(response, "schema", content); s != nil && s.Type() != "true" && s.Type() != "false" {
+		responses["200"] = jsonResponse("Success response", pr.schemaOf(s, content))
+	}
+	if status, statusContent := pr.resolve(parser.ObjectProperty(response, "status", content), content); status != nil && status.Type() == "object" {
+		for _, pair := range parser.ObjectPairs(status) {
+			code := parser.PairKey(pair, statusContent)
+			if value := pair.ChildByFieldName("value"); value != nil {
+				responses[code] = jsonResponse("Response "+code, pr.schemaOf(value, statusContent))
+			}
+		}
+	}
+	if len(responses) > 0 {
+		route.Responses = responses
+	}
+}
+
+// payloadType returns the media type of a route's payload: the first type
+// its payload.allow option allows, multipart/form-data for payloads with
+// the multipart option, or JSON.
+func (pr *project) payloadType(options *sitter.Node, content []byte) string {
+	payload, payloadContent := pr.resolve(parser.ObjectProperty(options, "payload", content), content)
+	if payload == nil || payload.Type() != "object" {
+		return "application/json"
+	}
+	if allow := parser.ObjectProperty(payload, "allow", payloadContent); allow != nil {
+		if allowed := pr.stringsOf(allow, payloadContent); len(allowed) > 0 {
+			return allowed[0]
+		}
+	}
+	if multipart := parser.ObjectProperty(payload, "multipart", payloadContent); multipart != nil && multipart.Type() != "false" {
+		return "multipart/form-data"
+	}
+	return "application/json"
+}
+
+// schemaOf converts a Joi schema, or a plain object of them, to a schema.
+// Joi object schemas declared as constants become references to their
+// components.
+func (pr *project) schemaOf(node *sitter.Node, content []byte) *types.Schema {
+	node = parser.UnwrapExpression(node)
+	if node.Type() == "identifier" {
+		name := node.Content(content)
+		if component, ok := pr.components[name]; ok {
+			return schema.SchemaRef(component)
+		}
+		node, content = pr.resolve(node, content)
+	}
+	s, _ := pr.joi.ParseJoiSchema(node, content)
+	return s
+}
+
+// parameters converts the keys of a Joi object schema validating params,
+// query or headers to parameters.
+func (pr *project) parameters(node *sitter.Node, content []byte, in string) []types.Parameter {
+	node, content = pr.resolve(node, content)
+	s, _ := pr.joi.ParseJoiSchema(node, content)
+
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	var params []types.Parameter
+	for _, name := range sortedKeys(s.Properties) {
+		prop := s.Properties[name]
+		params = append(params, types.Parameter{
+			Name:        name,
+			In:          in,
+			Description: prop.Description,
+			Required:    required[name] || in == "path",
+			Schema:      prop,
+		})
+	}
+	return params
+}
+
+// stringOf resolves a string literal or a const naming one.
+func (pr *project) stringOf(node *sitter.Node, content []byte) (string, bool) {
+	node, content = pr.resolve(node, content)
+	if node == nil {
+		return "", false
+	}
+	if node.Type() == "string" || (node.Type() == "template_string" && node.NamedChildCount() == 0) {
+		return parser.StringValue(node, content), true
+	}
+	return "", false
+}
+
+// stringsOf returns the strings of a string or array of strings.
+func (pr *project) stringsOf(node *sitter.Node, content []byte) []string {
+	node, content = pr.resolve(node, content)
+	if node == nil {
+		return nil
+	}
+	if node.Type() != "array" {
+		if value, ok := pr.stringOf(node, content); ok {
+			return []string{value}
+		}
+		return nil
+	}
+	var values []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if value, ok := pr.stringOf(node.NamedChild(i), content); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ExtractSchemas extracts the Joi object schemas declared as constants,
+// and TypeScript interfaces and type aliases.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	pr := p.collectProject(ctx, files)
+	defer closeAll(pr.files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, pf := range pr.files {
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+	}
+
+	for _, name := range sortedKeys(pr.components) {
+		decl := pr.decls[name]
+		s, _ := pr.joi.ParseJoiSchema(decl.value, decl.content)
+		s.Title = pr.components[name]
+		s.Source = &types.SourceLocation{File: decl.file, Line: decl.line}
+		tsExtractor.Registry().Add(s.Title, s)
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// hapiParamRegex matches hapi path parameters: {id}, optional {id?} and
+// wildcard {path*} or {path*2} segments.
+var hapiParamRegex = regexp.MustCompile(`\{(\w+)(\?|\*\d*)?\}`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPath converts a hapi path to OpenAPI format, reporting whether it
+// ends in a wildcard parameter matching any number of segments.
+func convertPath(path string) (string, bool) {
+	wildcard := false
+	for _, match := range hapiParamRegex.FindAllStringSubmatch(path, -1) {
+		if match[2] == "*" {
+			wildcard = true
+		}
+	}
+	return hapiParamRegex.ReplaceAllString(path, "{$1}"), wildcard
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// handlerName names a route handler: a function identifier or member
+// expression (UsersController.list). Inline functions and handler objects
+// have no name.
+func handlerName(node *sitter.Node, content []byte) string {
+	node = parser.UnwrapExpression(node)
+	if node == nil {
+		return ""
+	}
+	switch node.Type() {
+	case "identifier", "member_expression":
+		return node.Content(content)
+	}
+	return ""
+}
+
+// jsonResponse returns a response with a JSON schema.
+func jsonResponse(description string, s *types.Schema) types.Response {
+	return types.Response{
+		Description: description,
+		Content:     map[string]types.MediaType{"application/json": {Schema: s}},
+	}
+}
+
+// inferTags tags a route with its first static path segment.
+func inferTags(path string) []string {
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment != "" && !strings.HasPrefix(segment, "{") {
+			return []string{segment}
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closeAll closes parse trees.
+func closeAll(files []*parser.ParsedTSFile) {
+	for _, pf := range files {
+		pf.Close()
+	}
+}
+
+// Register registers the hapi plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
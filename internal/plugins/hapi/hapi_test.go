@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package hapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const serverFixture = `
+'use strict';
+
+const Hapi = require('@hapi/hapi');
+const Joi = require('joi');
+const UsersController = require('./controllers/users');
+const routes = require('./routes');
+
+const init = async () => {
+  const server = Hapi.server({ port: 3000 });
+
+  server.route({
+    method: 'GET',
+    path: '/users/{id}',
+    handler: UsersController.show,
+    options: {
+      description: 'Get a user',
+      notes: ['Returns a user by id', 'Deleted users are not returned'],
+      tags: ['api', 'users'],
+      validate: {
+        params: Joi.object({ id: Joi.number().integer().required() }),
+        headers: Joi.object({ 'x-request-id': Joi.string().guid() }).unknown(),
+      },
+      response: { schema: userSchema },
+    },
+  });
+
+  server.route({
+    method: 'POST',
+    path: '/users',
+    options: {
+      id: 'createUser',
+      handler: UsersController.create,
+      validate: { payload: createUserSchema },
+      response: {
+        status: {
+          201: userSchema,
+          409: Joi.object({ message: Joi.string() }),
+        },
+      },
+    },
+  });
+
+  server.route(routes);
+
+  await server.start();
+};
+`
+
+const routesFixture = `
+const Joi = require('joi');
+
+module.exports = [
+  {
+    method: ['PUT', 'PATCH'],
+    path: '/posts/{slug}/{revision?}',
+    config: {
+      id: 'updatePost',
+      handler: (request, h) => h.response(),
+      validate: {
+        query: {
+          draft: Joi.boolean().default(false),
+          limit: Joi.number().min(1).max(100).required(),
+        },
+        payload: Joi.object({ title: Joi.string().required() }),
+      },
+    },
+  },
+  {
+    method: 'POST',
+    path: '/uploads',
+    options: {
+      handler: uploadHandler,
+      payload: { output: 'stream', parse: true, multipart: true },
+      validate: { payload: { file: Joi.any().required() } },
+    },
+  },
+  { method: '*', path: '/proxy/{path*}', handler: { proxy: { host: 'example.com' } } },
+  { method: 'GET', path: '/assets/{parts*2}', handler: { directory: { path: 'public' } } },
+];
+`
+
+const schemasFixture = `
+const Joi = require('joi');
+
+const userSchema = Joi.object({
+  id: Joi.number().integer().required(),
+  email: Joi.string().email().required(),
+  name: Joi.string().max(100),
+}).label('User');
+
+const createUserSchema = Joi.object({
+  email: Joi.string().email().required(),
+  name: Joi.string().max(100),
+});
+
+const idSchema = Joi.number().integer();
+
+module.exports = { userSchema, createUserSchema, idSchema };
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"@hapi/hapi": "^21.3.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"hapi": "^17.0.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected, "hapi was published as hapi before v18")
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "server.js", Language: "javascript", Content: []byte(serverFixture)},
+		{Path: "schemas.js", Language: "javascript", Content: []byte(schemasFixture)},
+	})
+	require.NoError(t, err)
+
+	show := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, show)
+	assert.Equal(t, "UsersController.show", show.Handler)
+	assert.Equal(t, "Get a user", show.Summary)
+	assert.Equal(t, "Returns a user by id\nDeleted users are not returned", show.Description)
+	assert.Equal(t, []string{"users"}, show.Tags, "the api tag is dropped")
+	assert.Equal(t, "server.js", show.SourceFile)
+	require.Len(t, show.Parameters, 2)
+	assert.Equal(t, "path", show.Parameters[0].In)
+	assert.Equal(t, "integer", show.Parameters[0].Schema.Type)
+	assert.Equal(t, "x-request-id", show.Parameters[1].Name)
+	assert.Equal(t, "header", show.Parameters[1].In)
+	assert.False(t, show.Parameters[1].Required)
+	assert.Equal(t, "#/components/schemas/User", show.Responses["200"].Content["application/json"].Schema.Ref)
+
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.Equal(t, "UsersController.create", create.Handler)
+	assert.Equal(t, "createUser", create.OperationID)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/createUserSchema", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/User", create.Responses["201"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "string", create.Responses["409"].Content["application/json"].Schema.Properties["message"].Type)
+}
+
+func TestPlugin_ExtractRoutes_RouteModules(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "server.js", Language: "javascript", Content: []byte(serverFixture)},
+		{Path: "routes.js", Language: "javascript", Content: []byte(routesFixture)},
+	})
+	require.NoError(t, err)
+
+	for _, method := range []string{"PUT", "PATCH"} {
+		update := findRoute(routes, method, "/posts/{slug}/{revision}")
+		require.NotNil(t, update, method)
+		assert.Empty(t, update.Handler)
+		assert.Equal(t, "routes.js", update.SourceFile)
+		require.Len(t, update.Parameters, 4)
+		assert.Equal(t, "draft", update.Parameters[2].Name)
+		assert.Equal(t, "query", update.Parameters[2].In)
+		assert.Equal(t, false, update.Parameters[2].Schema.Default)
+		assert.True(t, update.Parameters[3].Required)
+		require.NotNil(t, update.RequestBody)
+		assert.Equal(t, []string{"title"}, update.RequestBody.Content["application/json"].Schema.Required)
+	}
+	assert.Equal(t, "putUpdatePost", findRoute(routes, "PUT", "/posts/{slug}/{revision}").OperationID)
+
+	upload := findRoute(routes, "POST", "/uploads")
+	require.NotNil(t, upload)
+	assert.Equal(t, "uploadHandler", upload.Handler)
+	require.NotNil(t, upload.RequestBody)
+	assert.Contains(t, upload.RequestBody.Content, "multipart/form-data")
+
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		proxy := findRoute(routes, method, "/proxy/{path}")
+		require.NotNil(t, proxy, method)
+		assert.Equal(t, true, proxy.Extensions["x-wildcard"])
+	}
+
+	assets := findRoute(routes, "GET", "/assets/{parts}")
+	require.NotNil(t, assets)
+	assert.Nil(t, assets.Extensions, "segment-count wildcards match a fixed number of segments")
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "schemas.js", Language: "javascript", Content: []byte(schemasFixture)},
+	})
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+
+	user, ok := byName["User"]
+	require.True(t, ok, "labels name components")
+	assert.Equal(t, []string{"id", "email"}, user.Required)
+	assert.Equal(t, "email", user.Properties["email"].Format)
+	assert.Equal(t, "schemas.js", user.Source.File)
+
+	create, ok := byName["createUserSchema"]
+	require.True(t, ok)
+	assert.Equal(t, 100, *create.Properties["name"].MaxLength)
+
+	assert.NotContains(t, byName, "idSchema", "only object schemas are components")
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// IdempotentExtension marks operations that are safe to retry because they
+// accept an idempotency key.
+const IdempotentExtension = "x-idempotent"
+
+// IdempotencyKeyHeader is the header carrying the idempotency key.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+var (
+	// middlewareRegistration matches a line registering middleware, such as
+	// r.Use(...), app.use(...), or a Django MIDDLEWARE entry
+	middlewareRegistration = regexp.MustCompile(`\buse\w*\s*\(|middleware`)
+
+	// idempotencyMiddleware matches idempotency middleware and key headers
+	idempotencyMiddleware = regexp.MustCompile(`idempotency`)
+
+	// correlationMiddleware matches request and correlation ID middleware
+	correlationMiddleware = regexp.MustCompile(`request[-_.]?id|correlation[-_.]?id|correlator|rtracer|django_guid`)
+
+	// correlationHeader matches a request or correlation ID header name
+	correlationHeader = regexp.MustCompile(`(?i)['"]((?:x-)?(?:request|correlation)-id)['"]`)
+)
+
+// MarkRequestHeaders documents the headers of idempotency-key and request
+// ID middleware. Routes whose definition or handler refers to idempotency,
+// and POST and PATCH routes covered by idempotency middleware, get an
+// optional Idempotency-Key header and x-idempotent; routes covered by
+// request or correlation ID middleware get its header.
+func MarkRequestHeaders(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(strings.ToLower(string(f.Content)), "\n")
+	}
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+	declarations := indexDeclarations(sources)
+
+	// Middleware registrations, by file ("" for every route)
+	idempotency := make(map[string]bool)
+	correlation := make(map[string]string)
+	forEachRegistration(files, sources, routeLines, func(f scanner.SourceFile, scope, line string) {
+		if idempotencyMiddleware.MatchString(line) {
+			idempotency[scope] = true
+		}
+		if m := correlationMiddleware.FindString(line); m != "" && correlation[scope] == "" {
+			correlation[scope] = correlationHeaderName(string(f.Content), m)
+		}
+	})
+
+	for i := range routes {
+		route := &routes[i]
+		method := strings.ToUpper(route.Method)
+
+		explicit := false
+		for _, lines := range routeSource(route, routeLines[route.SourceFile], sources, declarations) {
+			for _, line := range lines {
+				if idempotencyMiddleware.MatchString(line) {
+					explicit = true
+				}
+			}
+		}
+		covered := (idempotency[""] || idempotency[route.SourceFile]) && (method == "POST" || method == "PATCH")
+		if explicit || covered {
+			addHeader(route, types.Parameter{
+				Name: IdempotencyKeyHeader,
+				In:   "header",
+				Description: "Unique key that makes retrying this request safe: a repeated request " +
+					"with the same key returns the original response instead of repeating the operation.",
+				Schema: &types.Schema{Type: "string"},
+			})
+			if route.Extensions == nil {
+				route.Extensions = make(types.Extensions)
+			}
+			route.Extensions[IdempotentExtension] = true
+		}
+
+		header := correlation[route.SourceFile]
+		if header == "" {
+			header = correlation[""]
+		}
+		if header != "" {
+			addHeader(route, types.Parameter{
+				Name: header,
+				In:   "header",
+				Description: "Identifier correlating this request across services and logs; " +
+					"generated by the server when omitted.",
+				Schema: &types.Schema{Type: "string"},
+			})
+		}
+	}
+}
+
+// forEachRegistration calls fn with each line of files that registers
+// middleware and the routes it covers: scope is the file's path, or "" for
+// every route when the file defines none, such as a bootstrap file or
+// Django settings. sources are the lowercase lines of files.
+func forEachRegistration(files []scanner.SourceFile, sources map[string][]string, routeLines map[string][]int, fn func(f scanner.SourceFile, scope, line string)) {
+	for _, f := range files {
+		scope := f.Path
+		if len(routeLines[f.Path]) == 0 {
+			scope = ""
+		}
+		definitions := make(map[int]bool)
+		for _, line := range routeLines[f.Path] {
+			definitions[line] = true
+		}
+		for n, line := range sources[f.Path] {
+			// Middleware on a route definition covers only that route, and
+			// Laravel alias maps (=>) only name middleware
+			if definitions[n+1] || strings.Contains(line, "=>") || !middlewareRegistration.MatchString(line) {
+				continue
+			}
+			fn(f, scope, line)
+		}
+	}
+}
+
+// correlationHeaderName returns the header of request ID middleware: a
+// request or correlation ID header named in content, or the middleware's
+// usual header.
+func correlationHeaderName(content, middleware string) string {
+	if m := correlationHeader.FindStringSubmatch(content); m != nil {
+		return canonicalHeader(m[1])
+	}
+	switch {
+	case middleware == "django_guid":
+		return "Correlation-ID"
+	case strings.HasPrefix(middleware, "correlat"):
+		return "X-Correlation-ID"
+	}
+	return "X-Request-ID"
+}
+
+// canonicalHeader capitalizes the words of a header name, spelling ID in
+// capitals: x-request-id becomes X-Request-ID.
+func canonicalHeader(name string) string {
+	words := strings.Split(strings.ToLower(name), "-")
+	for i, w := range words {
+		if w == "id" {
+			words[i] = "ID"
+		} else if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+// addHeader adds a header parameter to route unless it already has one
+// with the same name.
+func addHeader(route *types.Route, param types.Parameter) {
+	for _, p := range route.Parameters {
+		if p.In == "header" && strings.EqualFold(p.Name, param.Name) {
+			return
+		}
+	}
+	// Plugins may share parameter slices between routes
+	route.Parameters = append(slices.Clip(route.Parameters), param)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func headerNames(route types.Route) []string {
+	var names []string
+	for _, p := range route.Parameters {
+		if p.In == "header" {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+func TestMarkRequestHeaders_FileMiddleware(t *testing.T) {
+	code := `func main() {
+	app := fiber.New()
+	app.Use(requestid.New())
+	app.Use(idempotency.New())
+	app.Get("/orders", listOrders)
+	app.Post("/orders", createOrder)
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "main.go", Content: []byte(code)},
+		{Path: "other.go", Content: []byte("func init() {}\n")},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "main.go", SourceLine: 5},
+		{Method: "POST", Path: "/orders", SourceFile: "main.go", SourceLine: 6},
+		{Method: "POST", Path: "/other", SourceFile: "other.go", SourceLine: 1},
+	}
+
+	MarkRequestHeaders(routes, files)
+
+	assert.Equal(t, []string{"X-Request-ID"}, headerNames(routes[0]))
+	assert.Nil(t, routes[0].Extensions)
+	assert.Equal(t, []string{IdempotencyKeyHeader, "X-Request-ID"}, headerNames(routes[1]))
+	assert.Equal(t, true, routes[1].Extensions[IdempotentExtension])
+	assert.Empty(t, headerNames(routes[2]))
+}
+
+func TestMarkRequestHeaders_GlobalAndRoute(t *testing.T) {
+	settings := `MIDDLEWARE = [
+    "asgi_correlation_id.CorrelationIdMiddleware",
+]
+CORRELATION_ID_HEADER = "x-correlation-id"
+`
+	urls := `path("payments/", create_payment),
+path("refunds/", create_refund),
+`
+	views := `def create_payment(request):
+    key = request.headers.get("Idempotency-Key")
+`
+	files := []scanner.SourceFile{
+		{Path: "settings.py", Content: []byte(settings)},
+		{Path: "urls.py", Content: []byte(urls)},
+		{Path: "views.py", Content: []byte(views)},
+	}
+	shared := []types.Parameter{{Name: "x-correlation-id", In: "header", Description: "Documented"}}
+	routes := []types.Route{
+		{Method: "POST", Path: "/payments/", Handler: "create_payment", SourceFile: "urls.py", SourceLine: 1},
+		{Method: "POST", Path: "/refunds/", Handler: "create_refund", SourceFile: "urls.py", SourceLine: 2, Parameters: shared},
+	}
+
+	MarkRequestHeaders(routes, files)
+
+	assert.Equal(t, []string{IdempotencyKeyHeader, "X-Correlation-ID"}, headerNames(routes[0]))
+	assert.Equal(t, true, routes[0].Extensions[IdempotentExtension])
+
+	// Documented headers are kept
+	require.Len(t, routes[1].Parameters, 1)
+	assert.Equal(t, "Documented", routes[1].Parameters[0].Description)
+	assert.Nil(t, routes[1].Extensions)
+}
+
+func TestCanonicalHeader(t *testing.T) {
+	assert.Equal(t, "X-Request-ID", canonicalHeader("x-request-id"))
+	assert.Equal(t, "Correlation-ID", canonicalHeader("CORRELATION-ID"))
+}
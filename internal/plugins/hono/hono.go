@@ -5,6 +5,7 @@
 package hono
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -60,6 +62,12 @@ func (p *Plugin) Extensions() []string {
 	return []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
 }
 
+// RouteMarkers returns the Hono module name, which every file defining
+// routes imports.
+func (p *Plugin) RouteMarkers() []string {
+	return []string{"hono"}
+}
+
 // Info returns plugin metadata.
 func (p *Plugin) Info() plugins.PluginInfo {
 	return plugins.PluginInfo{
@@ -106,14 +114,22 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Hono route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -126,8 +142,8 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 }
 
 // extractRoutesFromFile extracts routes from a single TypeScript/JavaScript file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +151,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports Hono
 	if !p.hasHonoImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no Hono import")
 		return nil, nil
 	}
 
@@ -290,18 +307,15 @@ func (p *Plugin) extractRouteFromCall(
 		return nil
 	}
 
-	// First argument should be the path
-	pathArg := args[0]
-	path := ""
-	if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-		path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-	}
+	// First argument should be the path, possibly built from constants
+	path, unresolved, _ := p.tsParser.ResolveStringExpression(args[0], content)
 
 	if path == "" {
 		return nil
 	}
 
 	// Convert Hono path parameters (:param) to OpenAPI format ({param})
+	wildcard := plugins.IsCatchAll(path)
 	path = convertPathParams(path)
 
 	// Extract path parameters
@@ -341,6 +355,12 @@ func (p *Plugin) extractRouteFromCall(
 		RequestBody: requestBody,
 		SourceLine:  int(node.StartPoint().Row) + 1,
 	}
+	if len(unresolved) > 0 {
+		route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
 
 	return route
 }
@@ -394,29 +414,27 @@ func (p *Plugin) extractValidatorSchema(
 
 // walkNodes walks all nodes in the tree.
 func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
-		return
-	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	parser.Walk(node, fn)
 }
 
 // ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	tsExtractor := schema.NewTypeScriptSchemaExtractor()
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -425,6 +443,9 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		for _, iface := range pf.Interfaces {
 			tsExtractor.ExtractAndRegister(iface)
 		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
 
 		// Extract Zod schemas (if any)
 		for _, zs := range pf.ZodSchemas {
@@ -446,9 +467,10 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts Hono-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts Hono-style path params (:id) to OpenAPI format ({id}),
+// and catch-alls (*) to a templated {path}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
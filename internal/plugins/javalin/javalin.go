@@ -0,0 +1,613 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package javalin provides a plugin for extracting routes from Javalin applications.
+package javalin
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for Javalin.
+type Plugin struct {
+	javaParser   *parser.JavaParser
+	kotlinParser *parser.KotlinParser
+}
+
+// New creates a new Javalin plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		javaParser:   parser.NewJavaParser(),
+		kotlinParser: parser.NewKotlinParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "javalin"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".java", ".kt"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "javalin",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Javalin applications",
+		SupportedFrameworks: []string{
+			"io.javalin",
+			"Javalin",
+		},
+	}
+}
+
+// Detect checks if Javalin is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range []string{"pom.xml", "build.gradle", "build.gradle.kts"} {
+		if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, name), "io.javalin"); found {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	for scanr.Scan() {
+		if strings.Contains(scanr.Text(), dep) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Regex patterns for Javalin route extraction
+var (
+	// Matches app.get("/path", handler) and Kotlin app.get("/path") { ctx -> }.
+	// Requiring a handler after the path skips HTTP client calls.
+	directRouteRegex = regexp.MustCompile(`\b\w+\s*\.\s*(get|post|put|patch|delete|head|options)\s*(\()\s*"([^"]*)"\s*(?:,|\)\s*\{)`)
+
+	// Matches ApiBuilder verbs such as get(handler) or get("{id}", handler),
+	// which are only routes inside a routes or path block
+	builderRouteRegex = regexp.MustCompile(`(?:^|[^.\w])(get|post|put|patch|delete|head|options)\s*(\()\s*(?:"([^"]*)"\s*,)?`)
+
+	// Matches ApiBuilder crud("users/{id}", controller)
+	crudRegex = regexp.MustCompile(`(?:^|[^.\w])crud\s*\(\s*"([^"]*)"`)
+
+	// Matches path("users", () -> { in Java and path("users") { in Kotlin
+	pathBlockRegex = regexp.MustCompile(`(?:^|[^.\w])path\s*\(\s*"([^"]*)"\s*(?:,\s*\(\s*\)\s*->\s*\{|\)\s*\{)`)
+
+	// Matches app.routes(() -> {, config.router.apiBuilder(() -> { and
+	// Kotlin app.routes {
+	builderBlockRegex = regexp.MustCompile(`\b(?:routes|apiBuilder)\s*(?:\(\s*\(\s*\)\s*->\s*)?\{`)
+
+	// Matches a method reference handler such as UserController::create
+	methodRefRegex = regexp.MustCompile(`([\w.]*)::(\w+)`)
+
+	// Matches ctx.bodyAsClass(User.class), ctx.bodyValidator(User::class.java)
+	// and Kotlin ctx.bodyAsClass<User>()
+	bodyClassRegex = regexp.MustCompile(`\bbody(?:AsClass|Validator|StreamAsClass)\s*(?:<\s*(\w+)\s*>|\(\s*(\w+)\s*(?:\.class|::class\.java))`)
+
+	// Matches Javalin 3/4 :param and <param> path parameters
+	colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_-]*)`)
+	angleParamRegex = regexp.MustCompile(`<([a-zA-Z_][a-zA-Z0-9_-]*)>`)
+)
+
+// ExtractRoutes parses source files and extracts Javalin route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var sources []source
+	for _, file := range files {
+		if file.Language != "java" && file.Language != "kotlin" {
+			continue
+		}
+		sources = append(sources, source{
+			path:    file.Path,
+			content: util.NormalizeNewlines(string(file.Content)),
+		})
+	}
+
+	var routes []types.Route
+	for _, src := range sources {
+		routes = append(routes, p.extractRoutesFromContent(src, sources)...)
+	}
+
+	return routes, nil
+}
+
+// source is a normalized source file.
+type source struct {
+	path    string
+	content string
+}
+
+// block is a routes or path block with the prefix it adds.
+type block struct {
+	prefix   string
+	startPos int
+	endPos   int
+}
+
+// extractRoutesFromContent extracts the routes of one file. Handlers given as
+// method references are looked up in all sources.
+func (p *Plugin) extractRoutesFromContent(src source, sources []source) []types.Route {
+	var routes []types.Route
+	content := src.content
+
+	for _, match := range directRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		method := strings.ToUpper(content[match[2]:match[3]])
+		path := content[match[6]:match[7]]
+		handler, body := handlerOf(content, match[4], sources)
+		routes = append(routes, buildRoute(method, path, handler, body, src.path, countLines(content[:match[0]])))
+	}
+
+	builders := findBlocks(content, builderBlockRegex, false)
+	if len(builders) == 0 {
+		return routes
+	}
+	paths := findBlocks(content, pathBlockRegex, true)
+
+	for _, match := range builderRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		pos := match[2]
+		if !insideAny(builders, pos) {
+			continue
+		}
+		method := strings.ToUpper(content[match[2]:match[3]])
+		path := ""
+		if match[6] >= 0 {
+			path = content[match[6]:match[7]]
+		}
+		handler, body := handlerOf(content, match[4], sources)
+		fullPath := joinPaths(containingPrefix(paths, pos), path)
+		routes = append(routes, buildRoute(method, fullPath, handler, body, src.path, countLines(content[:pos])))
+	}
+
+	// crud("users/{user-id}", controller) registers the CrudHandler methods
+	for _, match := range crudRegex.FindAllStringSubmatchIndex(content, -1) {
+		pos := match[0]
+		if !insideAny(builders, pos) {
+			continue
+		}
+		fullPath := joinPaths(containingPrefix(paths, pos), content[match[2]:match[3]])
+		line := countLines(content[:pos])
+
+		collection := fullPath
+		if idx := strings.LastIndex(fullPath, "/"); idx > 0 {
+			collection = fullPath[:idx]
+		}
+		routes = append(routes,
+			buildRoute("GET", collection, "getAll", "", src.path, line),
+			buildRoute("POST", collection, "create", "", src.path, line),
+			buildRoute("GET", fullPath, "getOne", "", src.path, line),
+			buildRoute("PATCH", fullPath, "update", "", src.path, line),
+			buildRoute("DELETE", fullPath, "delete", "", src.path, line),
+		)
+	}
+
+	return routes
+}
+
+// buildRoute creates a route; body is the handler source used to find the
+// request body class.
+func buildRoute(method, path, handler, body, filePath string, line int) types.Route {
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, handlerName(handler)),
+		Tags:        inferTags(fullPath),
+		Parameters:  extractPathParams(fullPath),
+		SourceFile:  filePath,
+		SourceLine:  line,
+	}
+
+	if match := bodyClassRegex.FindStringSubmatch(body); match != nil {
+		name := match[1]
+		if name == "" {
+			name = match[2]
+		}
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				"application/json": {
+					Schema: &types.Schema{Ref: "#/components/schemas/" + name},
+				},
+			},
+		}
+	}
+
+	return route
+}
+
+// handlerOf returns the handler name and source of the route call whose
+// argument list opens at openParen. Inline lambdas are their own source;
+// method references are resolved to the referenced method's body.
+func handlerOf(content string, openParen int, sources []source) (string, string) {
+	closeParen := matchingDelimiter(content, openParen)
+	if closeParen == -1 {
+		return "", ""
+	}
+	args := content[openParen+1 : closeParen]
+
+	// Kotlin trailing lambda: app.get("/path") { ctx -> ... }
+	rest := strings.TrimLeft(content[closeParen+1:], " \t\n")
+	if strings.HasPrefix(rest, "{") {
+		start := len(content) - len(rest)
+		if end := matchingDelimiter(content, start); end != -1 {
+			return "", content[start : end+1]
+		}
+	}
+
+	ref := lastMethodRef(args)
+	if ref == nil {
+		return "", args
+	}
+	owner := ref[1][strings.LastIndex(ref[1], ".")+1:]
+	if owner == "this" {
+		owner = ""
+	}
+	handler := ref[2]
+	if owner != "" {
+		handler = owner + "." + ref[2]
+	}
+	return handler, findMethodBody(sources, owner, ref[2])
+}
+
+// lastMethodRef returns the owner and method of the last method reference
+// in s, ignoring Kotlin class literals such as User::class.
+func lastMethodRef(s string) []string {
+	refs := methodRefRegex.FindAllStringSubmatch(s, -1)
+	for i := len(refs) - 1; i >= 0; i-- {
+		if refs[i][2] != "class" {
+			return refs[i]
+		}
+	}
+	return nil
+}
+
+// findMethodBody returns the body of the method or handler field named name,
+// looking first in the file declaring class owner.
+func findMethodBody(sources []source, owner, name string) string {
+	methodRegex := regexp.MustCompile(`[\w>\]]\s+` + regexp.QuoteMeta(name) + `\s*(?:\([^)]*\)[^{;=]*\{|=\s*[^;{]*\{)`)
+	ordered := sources
+	if owner != "" {
+		ownerRegex := regexp.MustCompile(`\b(?:class|object)\s+` + regexp.QuoteMeta(owner) + `\b`)
+		ordered = make([]source, 0, len(sources))
+		var rest []source
+		for _, src := range sources {
+			if ownerRegex.MatchString(src.content) {
+				ordered = append(ordered, src)
+			} else {
+				rest = append(rest, src)
+			}
+		}
+		ordered = append(ordered, rest...)
+	}
+	for _, src := range ordered {
+		loc := methodRegex.FindStringIndex(src.content)
+		if loc == nil {
+			continue
+		}
+		if end := matchingDelimiter(src.content, loc[1]-1); end != -1 {
+			return src.content[loc[1]-1 : end+1]
+		}
+	}
+	return ""
+}
+
+// findBlocks finds the blocks opened by the matches of re. When withPrefix is
+// set, the first capture group is the block's path prefix.
+func findBlocks(content string, re *regexp.Regexp, withPrefix bool) []block {
+	var blocks []block
+	for _, match := range re.FindAllStringSubmatchIndex(content, -1) {
+		open := match[1] - 1
+		end := matchingDelimiter(content, open)
+		if end == -1 {
+			continue
+		}
+		b := block{startPos: match[0], endPos: end}
+		if withPrefix {
+			b.prefix = content[match[2]:match[3]]
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks
+}
+
+// insideAny reports whether pos lies inside one of the blocks.
+func insideAny(blocks []block, pos int) bool {
+	for _, b := range blocks {
+		if pos > b.startPos && pos < b.endPos {
+			return true
+		}
+	}
+	return false
+}
+
+// containingPrefix joins the prefixes of all path blocks around pos.
+func containingPrefix(blocks []block, pos int) string {
+	prefix := ""
+	for _, b := range blocks {
+		if pos > b.startPos && pos < b.endPos {
+			prefix = joinPaths(prefix, b.prefix)
+		}
+	}
+	return prefix
+}
+
+// matchingDelimiter returns the position of the bracket closing the one at
+// pos, skipping string literals, or -1.
+func matchingDelimiter(content string, pos int) int {
+	if pos < 0 || pos >= len(content) {
+		return -1
+	}
+	open := content[pos]
+	var close byte
+	switch open {
+	case '(':
+		close = ')'
+	case '{':
+		close = '}'
+	default:
+		return -1
+	}
+
+	depth := 0
+	inString := false
+	for i := pos; i < len(content); i++ {
+		ch := content[i]
+		if ch == '"' && content[i-1] != '\\' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+		switch ch {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// joinPaths joins path segments with single slashes.
+func joinPaths(base, path string) string {
+	base = strings.Trim(base, "/")
+	path = strings.Trim(path, "/")
+	switch {
+	case base == "" && path == "":
+		return "/"
+	case base == "":
+		return "/" + path
+	case path == "":
+		return "/" + base
+	}
+	return "/" + base + "/" + path
+}
+
+// countLines counts the number of lines up to a position.
+func countLines(s string) int {
+	if s == "" {
+		return 1
+	}
+	return strings.Count(s, "\n") + 1
+}
+
+// convertPathParams converts :param and <param> to OpenAPI {param}.
+func convertPathParams(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	path = angleParamRegex.ReplaceAllString(path, "{$1}")
+	return colonParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// handlerName returns the method name of a Class.method handler.
+func handlerName(handler string) string {
+	return handler[strings.LastIndex(handler, ".")+1:]
+}
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(cleanPath)
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions from Java records and DTOs and
+// from Kotlin data classes.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		switch file.Language {
+		case "java":
+			pf := p.javaParser.Parse(file.Path, file.Content)
+			for _, class := range pf.Classes {
+				if class.IsRecord || isSchemaName(class.Name) {
+					schemas = append(schemas, *javaClassToSchema(class))
+				}
+			}
+		case "kotlin":
+			pf := p.kotlinParser.Parse(file.Path, file.Content)
+			for _, class := range pf.Classes {
+				if isSchemaName(class.Name) {
+					schemas = append(schemas, *kotlinClassToSchema(class))
+				}
+			}
+		}
+	}
+
+	return schemas, nil
+}
+
+// isSchemaName checks if a class name follows DTO naming conventions.
+func isSchemaName(name string) bool {
+	for _, suffix := range []string{"Dto", "DTO", "Request", "Response"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// javaClassToSchema converts a Java record or class to an OpenAPI schema.
+func javaClassToSchema(class parser.JavaClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, field := range class.Fields {
+		openAPIType, format := parser.JavaTypeToOpenAPI(field.Type)
+		schema.Properties[field.Name] = &types.Schema{Type: openAPIType, Format: format}
+		if class.IsRecord {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+// kotlinClassToSchema converts a Kotlin data class to an OpenAPI schema using
+// its primary constructor.
+func kotlinClassToSchema(class parser.KotlinClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, fn := range class.Functions {
+		if fn.Name != class.Name {
+			continue
+		}
+		for _, param := range fn.Parameters {
+			openAPIType, format := parser.KotlinTypeToOpenAPI(param.Type)
+			propSchema := &types.Schema{Type: openAPIType, Format: format}
+
+			isOptional := strings.HasSuffix(param.Type, "?")
+			if isOptional {
+				propSchema.Nullable = true
+			}
+			schema.Properties[param.Name] = propSchema
+			if !isOptional && param.Default == "" {
+				schema.Required = append(schema.Required, param.Name)
+			}
+		}
+	}
+
+	return schema
+}
+
+// Register registers the Javalin plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
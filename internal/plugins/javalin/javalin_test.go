@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package javalin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// javalinAppCode registers routes directly and through the ApiBuilder.
+const javalinAppCode = `
+package com.example;
+
+import io.javalin.Javalin;
+import static io.javalin.apibuilder.ApiBuilder.*;
+
+public class App {
+    public static void main(String[] args) {
+        var app = Javalin.create(config -> {
+            config.router.apiBuilder(() -> {
+                path("api/users", () -> {
+                    get(UserController::getAll);
+                    post(UserController::create);
+                    path("{id}", () -> {
+                        get(UserController::getOne);
+                        delete(UserController::delete);
+                    });
+                });
+                crud("teams/{team-id}", new TeamController());
+            });
+        });
+
+        app.get("/health", ctx -> ctx.result("ok"));
+        app.post("/login", ctx -> {
+            LoginRequest req = ctx.bodyAsClass(LoginRequest.class);
+            ctx.json(auth.login(req));
+        });
+        app.start(7070);
+    }
+}
+`
+
+// userControllerCode holds the handlers referenced by the ApiBuilder routes.
+const userControllerCode = `
+package com.example;
+
+public class UserController {
+    public static void getAll(Context ctx) {
+        ctx.json(users);
+    }
+
+    public static void create(Context ctx) {
+        CreateUserRequest user = ctx.bodyValidator(CreateUserRequest.class).get();
+        ctx.status(201).json(user);
+    }
+
+    public static void getOne(Context ctx) {
+        ctx.json(users.get(ctx.pathParam("id")));
+    }
+
+    public static Handler delete = ctx -> {
+        users.remove(ctx.pathParam("id"));
+    };
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	assert.Equal(t, "javalin", New().Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+	detected, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build.gradle.kts"), []byte(`implementation("io.javalin:javalin:6.1.3")`), 0644))
+	detected, err = New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "App.java", Language: "java", Content: []byte(javalinAppCode)},
+		{Path: "UserController.java", Language: "java", Content: []byte(userControllerCode)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+	assert.Len(t, byKey, 11)
+
+	health := byKey["GET /health"]
+	assert.Equal(t, "getHealth", health.OperationID)
+	assert.Nil(t, health.RequestBody)
+
+	login := byKey["POST /login"]
+	require.NotNil(t, login.RequestBody)
+	assert.Equal(t, "#/components/schemas/LoginRequest", login.RequestBody.Content["application/json"].Schema.Ref)
+
+	list := byKey["GET /api/users"]
+	assert.Equal(t, "UserController.getAll", list.Handler)
+	assert.Equal(t, "getGetAll", list.OperationID)
+	assert.Equal(t, []string{"users"}, list.Tags)
+
+	create := byKey["POST /api/users"]
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUserRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	one := byKey["GET /api/users/{id}"]
+	require.Len(t, one.Parameters, 1)
+	assert.Equal(t, "id", one.Parameters[0].Name)
+	assert.Contains(t, byKey, "DELETE /api/users/{id}")
+
+	for _, key := range []string{"GET /teams", "POST /teams", "GET /teams/{team-id}", "PATCH /teams/{team-id}", "DELETE /teams/{team-id}"} {
+		assert.Contains(t, byKey, key)
+	}
+}
+
+func TestPlugin_ExtractRoutes_Kotlin(t *testing.T) {
+	code := `
+fun main() {
+    val app = Javalin.create().start(7070)
+    app.get("/users/:id") { ctx -> ctx.json(repo.find(ctx.pathParam("id"))) }
+    app.put("/users/<id>") { ctx ->
+        val user = ctx.bodyAsClass<UserDto>()
+        repo.save(user)
+    }
+    val response = client.get("/users/1")
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "Main.kt", Language: "kotlin", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	assert.Equal(t, "GET", routes[0].Method)
+	assert.Equal(t, "/users/{id}", routes[0].Path)
+	assert.Equal(t, 4, routes[0].SourceLine)
+
+	assert.Equal(t, "PUT", routes[1].Method)
+	assert.Equal(t, "/users/{id}", routes[1].Path)
+	require.NotNil(t, routes[1].RequestBody)
+	assert.Equal(t, "#/components/schemas/UserDto", routes[1].RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestJoinPaths(t *testing.T) {
+	assert.Equal(t, "/", joinPaths("", ""))
+	assert.Equal(t, "/users", joinPaths("", "users"))
+	assert.Equal(t, "/api/users/{id}", joinPaths("/api/users", "{id}"))
+	assert.Equal(t, "/api", joinPaths("api/", ""))
+}
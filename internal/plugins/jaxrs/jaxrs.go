@@ -0,0 +1,694 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package jaxrs provides a plugin for extracting routes from JAX-RS
+// applications, such as Quarkus, Jersey, RESTEasy and Micronaut JAX-RS services.
+package jaxrs
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps JAX-RS method designator annotations to HTTP methods.
+var httpMethods = map[string]string{
+	"GET":     "GET",
+	"POST":    "POST",
+	"PUT":     "PUT",
+	"DELETE":  "DELETE",
+	"PATCH":   "PATCH",
+	"HEAD":    "HEAD",
+	"OPTIONS": "OPTIONS",
+}
+
+// paramLocations maps JAX-RS parameter annotations to OpenAPI locations.
+var paramLocations = map[string]string{
+	"PathParam":   "path",
+	"QueryParam":  "query",
+	"HeaderParam": "header",
+	"CookieParam": "cookie",
+	"RestPath":    "path",
+	"RestQuery":   "query",
+	"RestHeader":  "header",
+	"RestCookie":  "cookie",
+}
+
+// nonBodyAnnotations mark parameters that are injected rather than read from
+// the request body.
+var nonBodyAnnotations = map[string]bool{
+	"Context":     true,
+	"Suspended":   true,
+	"BeanParam":   true,
+	"FormParam":   true,
+	"MatrixParam": true,
+	"RestForm":    true,
+	"RestMatrix":  true,
+}
+
+// detectMarkers are build file entries that indicate a JAX-RS implementation.
+var detectMarkers = []string{
+	"jakarta.ws.rs",
+	"javax.ws.rs",
+	"quarkus-resteasy",
+	"quarkus-rest",
+	"jersey",
+	"resteasy",
+	"micronaut-jaxrs",
+}
+
+// maxLocatorDepth bounds how deep subresource locators are followed, which
+// also stops locators that return their own resource class.
+const maxLocatorDepth = 8
+
+// Plugin implements the FrameworkPlugin interface for JAX-RS.
+type Plugin struct {
+	javaParser *parser.JavaParser
+}
+
+// New creates a new JAX-RS plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		javaParser: parser.NewJavaParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "jaxrs"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".java"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "jaxrs",
+		Version:     "1.0.0",
+		Description: "Extracts routes from JAX-RS resources (Quarkus, Jersey, RESTEasy, Micronaut JAX-RS)",
+		SupportedFrameworks: []string{
+			"jakarta.ws.rs",
+			"javax.ws.rs",
+			"Quarkus",
+			"Jersey",
+			"RESTEasy",
+		},
+	}
+}
+
+// Detect checks if a JAX-RS implementation is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range []string{"pom.xml", "build.gradle", "build.gradle.kts"} {
+		if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, name)); found {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkFileForDependency checks if a build file mentions a JAX-RS implementation.
+func (p *Plugin) checkFileForDependency(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		for _, marker := range detectMarkers {
+			if strings.Contains(line, marker) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// resource is a parsed class together with the file it came from.
+type resource struct {
+	class parser.JavaClass
+	file  string
+}
+
+// ExtractRoutes parses source files and extracts JAX-RS route definitions.
+// Root resources are classes annotated with @Path; subresource classes are
+// reached through locator methods, which carry @Path but no HTTP method.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var roots []resource
+	classes := make(map[string]resource)
+
+	for _, file := range files {
+		if file.Language != "java" {
+			continue
+		}
+
+		pf := p.javaParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			res := resource{class: class, file: file.Path}
+			classes[class.Name] = res
+			if class.HasAnnotation("Path") {
+				roots = append(roots, res)
+			}
+		}
+	}
+
+	var routes []types.Route
+	for _, root := range roots {
+		basePath := annotationPath(root.class.GetAnnotation("Path"))
+		routes = append(routes, p.extractResourceRoutes(root, basePath, nil, classes, 0)...)
+	}
+
+	return routes, nil
+}
+
+// extractResourceRoutes extracts the routes of a resource class mounted at
+// basePath, following its subresource locators. Locators pass down the
+// schemas of the path parameters they declare.
+func (p *Plugin) extractResourceRoutes(res resource, basePath string, pathSchemas map[string]*types.Schema, classes map[string]resource, depth int) []types.Route {
+	var routes []types.Route
+
+	class := res.class
+	classProduces := mediaTypes(class.GetAnnotation("Produces"))
+	classConsumes := mediaTypes(class.GetAnnotation("Consumes"))
+	tag := strings.TrimSuffix(strings.TrimSuffix(class.Name, "Resource"), "Controller")
+
+	for _, method := range class.Methods {
+		fullPath := combinePaths(basePath, annotationPath(method.GetAnnotation("Path")))
+
+		httpMethod := ""
+		for _, anno := range method.Annotations {
+			if m, ok := httpMethods[anno.Name]; ok {
+				httpMethod = m
+				break
+			}
+		}
+
+		if httpMethod == "" {
+			// A @Path method without a designator is a subresource locator
+			if !method.HasAnnotation("Path") || depth >= maxLocatorDepth {
+				continue
+			}
+			sub, ok := classes[simpleTypeName(method.ReturnType)]
+			if !ok {
+				continue
+			}
+			subSchemas := make(map[string]*types.Schema, len(pathSchemas))
+			for name, schema := range pathSchemas {
+				subSchemas[name] = schema
+			}
+			for _, param := range extractParameters(fullPath, method, pathSchemas) {
+				if param.In == "path" {
+					subSchemas[param.Name] = param.Schema
+				}
+			}
+			routes = append(routes, p.extractResourceRoutes(sub, fullPath, subSchemas, classes, depth+1)...)
+			continue
+		}
+
+		route := types.Route{
+			Method:      httpMethod,
+			Path:        convertPathParams(fullPath),
+			Handler:     class.Name + "." + method.Name,
+			OperationID: generateOperationID(httpMethod, fullPath, method.Name),
+			Tags:        []string{tag},
+			Parameters:  extractParameters(fullPath, method, pathSchemas),
+			SourceFile:  res.file,
+			SourceLine:  method.Line,
+			Deprecated:  method.HasAnnotation("Deprecated"),
+		}
+
+		consumes := mediaTypes(method.GetAnnotation("Consumes"))
+		if len(consumes) == 0 {
+			consumes = classConsumes
+		}
+		route.RequestBody = extractRequestBody(httpMethod, method, consumes)
+
+		produces := mediaTypes(method.GetAnnotation("Produces"))
+		if len(produces) == 0 {
+			produces = classProduces
+		}
+		route.Responses = extractResponses(method.ReturnType, produces)
+
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// annotationPath returns the path of a @Path annotation.
+func annotationPath(anno *parser.JavaAnnotation) string {
+	if anno == nil {
+		return ""
+	}
+	if anno.Value != "" {
+		return anno.Value
+	}
+	return anno.Attributes["value"]
+}
+
+// mediaTypes returns the media types of a @Produces or @Consumes annotation,
+// resolving MediaType constants such as MediaType.APPLICATION_JSON.
+func mediaTypes(anno *parser.JavaAnnotation) []string {
+	if anno == nil {
+		return nil
+	}
+	value := anno.Value
+	if value == "" {
+		value = anno.Attributes["value"]
+	}
+	return plugins.MediaTypes(value)
+}
+
+// templateParamRegex matches JAX-RS path template parameters, which may
+// carry a regular expression as in {id: \d+}.
+var templateParamRegex = regexp.MustCompile(`\{\s*([a-zA-Z_][a-zA-Z0-9_.-]*)\s*(?::[^{}]*(?:\{[^{}]*\}[^{}]*)*)?\}`)
+
+// convertPathParams strips regular expressions from path template parameters.
+func convertPathParams(path string) string {
+	return templateParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractParameters extracts path, query, header and cookie parameters from
+// the path template and the method's annotated parameters. Path parameters
+// the method does not declare take their schema from pathSchemas.
+func extractParameters(path string, method parser.JavaMethod, pathSchemas map[string]*types.Schema) []types.Parameter {
+	var params []types.Parameter
+	seen := make(map[string]bool)
+
+	declared := make(map[string]parser.JavaParameter)
+	for _, param := range method.Parameters {
+		for _, anno := range param.Annotations {
+			if anno.Name == "PathParam" || anno.Name == "RestPath" {
+				declared[paramName(anno, param)] = param
+			}
+		}
+	}
+
+	for _, match := range templateParamRegex.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		if seen["path:"+name] {
+			continue
+		}
+		seen["path:"+name] = true
+
+		schema := &types.Schema{Type: "string"}
+		if param, ok := declared[name]; ok {
+			schema = typeSchema(param.Type)
+		} else if inherited, ok := pathSchemas[name]; ok {
+			schema = inherited
+		}
+		params = append(params, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	for _, param := range method.Parameters {
+		for _, anno := range param.Annotations {
+			in, ok := paramLocations[anno.Name]
+			if !ok || in == "path" {
+				continue
+			}
+			name := paramName(anno, param)
+			if seen[in+":"+name] {
+				continue
+			}
+			seen[in+":"+name] = true
+
+			schema := typeSchema(param.Type)
+			required := false
+			for _, a := range param.Annotations {
+				switch a.Name {
+				case "DefaultValue":
+					schema.Default = defaultValue(a.Value, schema.Type)
+				case "NotNull", "NotBlank", "NotEmpty":
+					required = true
+				}
+			}
+			params = append(params, types.Parameter{
+				Name:     name,
+				In:       in,
+				Required: required,
+				Schema:   schema,
+			})
+		}
+	}
+
+	return params
+}
+
+// defaultValue converts a @DefaultValue string to the parameter's type.
+func defaultValue(value, schemaType string) interface{} {
+	switch schemaType {
+	case "integer":
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// paramName returns the name given to a parameter annotation, falling back to
+// the Java parameter name as RESTEasy Reactive does for @RestQuery and friends.
+func paramName(anno parser.JavaAnnotation, param parser.JavaParameter) string {
+	if anno.Value != "" {
+		return anno.Value
+	}
+	if v, ok := anno.Attributes["value"]; ok {
+		return v
+	}
+	return param.Name
+}
+
+// extractRequestBody returns the request body of a method: its single
+// parameter without a JAX-RS injection annotation.
+func extractRequestBody(httpMethod string, method parser.JavaMethod, consumes []string) *types.RequestBody {
+	if httpMethod == "OPTIONS" {
+		return nil
+	}
+
+	for _, param := range method.Parameters {
+		if !isEntityParameter(param) {
+			continue
+		}
+		if len(consumes) == 0 {
+			consumes = []string{"application/json"}
+		}
+		content := make(map[string]types.MediaType, len(consumes))
+		for _, mt := range consumes {
+			content[mt] = types.MediaType{Schema: typeSchema(param.Type)}
+		}
+		return &types.RequestBody{
+			Required: true,
+			Content:  content,
+		}
+	}
+
+	return nil
+}
+
+// isEntityParameter reports whether a parameter is the request entity.
+func isEntityParameter(param parser.JavaParameter) bool {
+	for _, anno := range param.Annotations {
+		if _, ok := paramLocations[anno.Name]; ok {
+			return false
+		}
+		if nonBodyAnnotations[anno.Name] {
+			return false
+		}
+	}
+	return param.Type != ""
+}
+
+// extractResponses returns the success response implied by a method's
+// return type. Response and RestResponse without a type argument carry no
+// entity information, so they yield no response.
+func extractResponses(returnType string, produces []string) map[string]types.Response {
+	returnType = unwrapAsync(strings.TrimSpace(returnType))
+
+	switch simpleTypeName(returnType) {
+	case "void", "Void":
+		return map[string]types.Response{
+			"204": {Description: "No Content"},
+		}
+	case "Response", "":
+		return nil
+	}
+	if strings.HasPrefix(returnType, "RestResponse<") {
+		returnType = extractGenericType(returnType)
+	}
+
+	if len(produces) == 0 {
+		produces = []string{"application/json"}
+	}
+	content := make(map[string]types.MediaType, len(produces))
+	for _, mt := range produces {
+		content[mt] = types.MediaType{Schema: typeSchema(returnType)}
+	}
+	return map[string]types.Response{
+		"200": {
+			Description: "OK",
+			Content:     content,
+		},
+	}
+}
+
+// unwrapAsync removes reactive and asynchronous wrappers such as Uni<T> and
+// CompletionStage<T>.
+func unwrapAsync(javaType string) string {
+	for _, wrapper := range []string{"Uni<", "CompletionStage<", "CompletableFuture<"} {
+		if strings.HasPrefix(javaType, wrapper) {
+			return unwrapAsync(extractGenericType(javaType))
+		}
+	}
+	return javaType
+}
+
+// typeSchema converts a Java type to a schema, referencing component
+// schemas for application classes.
+func typeSchema(javaType string) *types.Schema {
+	javaType = strings.TrimSpace(javaType)
+	if javaType == "" {
+		return &types.Schema{Type: "string"}
+	}
+
+	if strings.HasSuffix(javaType, "[]") && javaType != "byte[]" {
+		return &types.Schema{
+			Type:  "array",
+			Items: typeSchema(strings.TrimSuffix(javaType, "[]")),
+		}
+	}
+	for _, collection := range []string{"List<", "Set<", "Collection<", "Multi<"} {
+		if strings.HasPrefix(javaType, collection) {
+			return &types.Schema{
+				Type:  "array",
+				Items: typeSchema(extractGenericType(javaType)),
+			}
+		}
+	}
+	if strings.HasPrefix(javaType, "Optional<") {
+		return typeSchema(extractGenericType(javaType))
+	}
+
+	if mapped, ok := typemap.Schema(javaType); ok {
+		return mapped
+	}
+
+	openAPIType, format := parser.JavaTypeToOpenAPI(javaType)
+	if openAPIType == "object" && isClassName(javaType) {
+		return &types.Schema{Ref: "#/components/schemas/" + simpleTypeName(javaType)}
+	}
+	return &types.Schema{Type: openAPIType, Format: format}
+}
+
+// isClassName reports whether a type looks like an application class rather
+// than a map or a JDK type.
+func isClassName(javaType string) bool {
+	name := simpleTypeName(javaType)
+	if name == "" || strings.Contains(javaType, "<") {
+		return false
+	}
+	switch name {
+	case "Object", "JsonObject", "JsonNode", "Map", "HashMap":
+		return false
+	}
+	return unicode.IsUpper(rune(name[0]))
+}
+
+// simpleTypeName strips generics and package qualifiers from a type.
+func simpleTypeName(javaType string) string {
+	javaType = strings.TrimSpace(javaType)
+	if idx := strings.Index(javaType, "<"); idx >= 0 {
+		javaType = javaType[:idx]
+	}
+	if idx := strings.LastIndex(javaType, "."); idx >= 0 {
+		javaType = javaType[idx+1:]
+	}
+	return strings.TrimSpace(javaType)
+}
+
+// extractGenericType extracts the inner type from a generic like List<String>.
+func extractGenericType(s string) string {
+	start := strings.Index(s, "<")
+	end := strings.LastIndex(s, ">")
+	if start == -1 || end == -1 || end <= start {
+		return ""
+	}
+	return strings.TrimSpace(s[start+1 : end])
+}
+
+// combinePaths combines a base path and a relative path.
+func combinePaths(base, relative string) string {
+	base = strings.Trim(base, "/")
+	relative = strings.Trim(relative, "/")
+
+	switch {
+	case base == "" && relative == "":
+		return "/"
+	case base == "":
+		return "/" + relative
+	case relative == "":
+		return "/" + base
+	}
+	return "/" + base + "/" + relative
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(convertPathParams(path), "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// ExtractSchemas extracts schema definitions from Java DTOs and records.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if file.Language != "java" {
+			continue
+		}
+
+		pf := p.javaParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			if class.HasAnnotation("Path") || !isSchemaClass(class, file.Path) {
+				continue
+			}
+			schemas = append(schemas, *classToSchema(class))
+		}
+	}
+
+	return schemas, nil
+}
+
+// isSchemaClass determines if a class should be extracted as a schema.
+func isSchemaClass(class parser.JavaClass, filePath string) bool {
+	if class.IsRecord {
+		return true
+	}
+
+	for _, suffix := range []string{"Dto", "DTO", "Request", "Response", "Entity"} {
+		if strings.HasSuffix(class.Name, suffix) {
+			return true
+		}
+	}
+
+	pathLower := strings.ToLower(filepath.ToSlash(filePath))
+	for _, dir := range []string{"/model/", "/domain/", "/dto/", "/entity/", "/entities/"} {
+		if strings.Contains(pathLower, dir) {
+			return true
+		}
+	}
+
+	// JPA entities, including Quarkus Panache ones
+	return class.HasAnnotation("Entity") ||
+		class.Extends == "PanacheEntity" ||
+		class.Extends == "PanacheEntityBase"
+}
+
+// classToSchema converts a Java class or record to an OpenAPI schema.
+func classToSchema(class parser.JavaClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, field := range class.Fields {
+		schema.Properties[field.Name] = typeSchema(field.Type)
+		if class.IsRecord {
+			schema.Required = append(schema.Required, field.Name)
+			continue
+		}
+		for _, anno := range field.Annotations {
+			if anno.Name == "NotNull" || anno.Name == "NonNull" ||
+				anno.Name == "NotBlank" || anno.Name == "NotEmpty" {
+				schema.Required = append(schema.Required, field.Name)
+				break
+			}
+		}
+	}
+	if len(class.Fields) > 0 {
+		return schema
+	}
+
+	// Fallback: infer from getter methods
+	for _, method := range class.Methods {
+		if strings.HasPrefix(method.Name, "get") && len(method.Name) > 3 && len(method.Parameters) == 0 {
+			propName := strings.ToLower(method.Name[3:4]) + method.Name[4:]
+			schema.Properties[propName] = typeSchema(method.ReturnType)
+		}
+	}
+
+	return schema
+}
+
+// Register registers the JAX-RS plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package jaxrs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// bookResourceCode is a Quarkus style root resource with a subresource locator.
+const bookResourceCode = `
+package org.acme;
+
+import jakarta.ws.rs.*;
+import jakarta.ws.rs.core.MediaType;
+import jakarta.ws.rs.core.Response;
+import io.smallrye.mutiny.Uni;
+
+@Path("/api/books")
+@Produces(MediaType.APPLICATION_JSON)
+public class BookResource {
+
+    @GET
+    public List<Book> list(@QueryParam("author") String author, @QueryParam("limit") @DefaultValue("20") int limit) {
+        return Book.listAll();
+    }
+
+    @GET
+    @Path("/{isbn: [0-9-]+}")
+    public Uni<Book> get(@PathParam("isbn") String isbn, @HeaderParam("X-Request-Id") String requestId) {
+        return Book.findById(isbn);
+    }
+
+    @POST
+    @Consumes({MediaType.APPLICATION_JSON, "application/xml"})
+    public Response create(@Context UriInfo uriInfo, CreateBookRequest request) {
+        return Response.created(null).build();
+    }
+
+    @DELETE
+    @Path("{isbn}")
+    @Deprecated
+    public void delete(@PathParam("isbn") String isbn) {
+    }
+
+    @Path("{isbn}/reviews")
+    public ReviewResource reviews(@PathParam("isbn") String isbn) {
+        return new ReviewResource(isbn);
+    }
+}
+`
+
+// reviewResourceCode is a subresource class without a @Path of its own.
+const reviewResourceCode = `
+package org.acme;
+
+import jakarta.ws.rs.*;
+
+public class ReviewResource {
+
+    @GET
+    public List<Review> list(@CookieParam("session") String session) {
+        return List.of();
+    }
+
+    @PUT
+    @Path("{reviewId}")
+    public Review update(@PathParam("reviewId") long reviewId, Review review) {
+        return review;
+    }
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	assert.Equal(t, "jaxrs", New().Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected bool
+	}{
+		{"quarkus pom", "pom.xml", "<artifactId>quarkus-rest-jackson</artifactId>", true},
+		{"jersey gradle", "build.gradle", "implementation 'org.glassfish.jersey.core:jersey-server:3.1.0'", true},
+		{"jakarta api", "build.gradle.kts", `implementation("jakarta.ws.rs:jakarta.ws.rs-api:3.1.0")`, true},
+		{"spring only", "pom.xml", "<artifactId>spring-boot-starter-web</artifactId>", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0644))
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "BookResource.java", Language: "java", Content: []byte(bookResourceCode)},
+		{Path: "ReviewResource.java", Language: "java", Content: []byte(reviewResourceCode)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 6)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	list := byKey["GET /api/books"]
+	assert.Equal(t, "BookResource.list", list.Handler)
+	assert.Equal(t, []string{"Book"}, list.Tags)
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "author", list.Parameters[0].Name)
+	assert.Equal(t, "query", list.Parameters[0].In)
+	assert.Equal(t, "integer", list.Parameters[1].Schema.Type)
+	assert.Equal(t, int64(20), list.Parameters[1].Schema.Default)
+	require.Contains(t, list.Responses, "200")
+	listSchema := list.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", listSchema.Type)
+	assert.Equal(t, "#/components/schemas/Book", listSchema.Items.Ref)
+
+	get, ok := byKey["GET /api/books/{isbn}"]
+	require.True(t, ok, "regex in path template should be stripped")
+	require.Len(t, get.Parameters, 2)
+	assert.Equal(t, "path", get.Parameters[0].In)
+	assert.Equal(t, "X-Request-Id", get.Parameters[1].Name)
+	assert.Equal(t, "header", get.Parameters[1].In)
+	assert.Equal(t, "#/components/schemas/Book", get.Responses["200"].Content["application/json"].Schema.Ref)
+
+	create := byKey["POST /api/books"]
+	require.NotNil(t, create.RequestBody)
+	assert.Contains(t, create.RequestBody.Content, "application/json")
+	assert.Contains(t, create.RequestBody.Content, "application/xml")
+	assert.Equal(t, "#/components/schemas/CreateBookRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Nil(t, create.Responses)
+
+	del := byKey["DELETE /api/books/{isbn}"]
+	assert.True(t, del.Deprecated)
+	assert.Contains(t, del.Responses, "204")
+
+	reviews := byKey["GET /api/books/{isbn}/reviews"]
+	assert.Equal(t, "ReviewResource.list", reviews.Handler)
+	assert.Equal(t, "ReviewResource.java", reviews.SourceFile)
+	require.Len(t, reviews.Parameters, 2)
+	assert.Equal(t, "isbn", reviews.Parameters[0].Name)
+	assert.Equal(t, "session", reviews.Parameters[1].Name)
+	assert.Equal(t, "cookie", reviews.Parameters[1].In)
+
+	update := byKey["PUT /api/books/{isbn}/reviews/{reviewId}"]
+	require.Len(t, update.Parameters, 2)
+	assert.Equal(t, "integer", update.Parameters[1].Schema.Type)
+	require.NotNil(t, update.RequestBody)
+	assert.Equal(t, "#/components/schemas/Review", update.RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestPlugin_ExtractRoutes_RecursiveLocator(t *testing.T) {
+	code := `
+@Path("/nodes")
+public class NodeResource {
+    @GET
+    public Node get() {
+        return null;
+    }
+
+    @Path("{child}")
+    public NodeResource child(@PathParam("child") String child) {
+        return this;
+    }
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "NodeResource.java", Language: "java", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	assert.Len(t, routes, maxLocatorDepth+1)
+}
+
+func TestCombinePaths(t *testing.T) {
+	assert.Equal(t, "/", combinePaths("", ""))
+	assert.Equal(t, "/users", combinePaths("users", ""))
+	assert.Equal(t, "/users/{id}", combinePaths("/users/", "/{id}"))
+	assert.Equal(t, "/{id}", combinePaths("", "{id}"))
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	code := `
+public record Book(String isbn, String title, List<String> authors) {}
+
+public class CreateBookRequest {
+    public String getTitle() { return title; }
+    public int getPages() { return pages; }
+}
+`
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "model/Book.java", Language: "java", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+	book := byName["Book"]
+	assert.Equal(t, []string{"isbn", "title", "authors"}, book.Required)
+	assert.Equal(t, "array", book.Properties["authors"].Type)
+
+	req := byName["CreateBookRequest"]
+	assert.Equal(t, "integer", req.Properties["pages"].Type)
+}
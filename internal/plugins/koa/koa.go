@@ -5,6 +5,7 @@
 package koa
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
@@ -74,6 +76,10 @@ func (p *Plugin) Info() plugins.PluginInfo {
 	}
 }
 
+// decoratorFrameworks are the decorator controller frameworks built on
+// Koa, whose projects are left to the routingcontrollers plugin.
+var decoratorFrameworks = []string{"routing-controllers", "@tsed/common", "@tsed/platform-http", "@tsed/schema", "tsoa"}
+
 // Detect checks if Koa is used in the project by looking at package.json.
 func (p *Plugin) Detect(projectRoot string) (bool, error) {
 	packageJSONPath := filepath.Join(projectRoot, "package.json")
@@ -95,6 +101,17 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 		return false, fmt.Errorf("failed to parse package.json: %w", err)
 	}
 
+	// Decorator controller projects run on Koa but are left to the
+	// routingcontrollers plugin
+	for _, dep := range decoratorFrameworks {
+		if _, ok := pkg.Dependencies[dep]; ok {
+			return false, nil
+		}
+		if _, ok := pkg.DevDependencies[dep]; ok {
+			return false, nil
+		}
+	}
+
 	// Check for koa in dependencies or devDependencies
 	if _, ok := pkg.Dependencies["koa"]; ok {
 		return true, nil
@@ -108,14 +125,25 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Koa route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
+	// First pass: build a map of file paths to their mount paths
+	fileMountPaths := p.buildFileMountMap(ctx, files)
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, fileMountPaths[file.Path])
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -133,9 +161,141 @@ type routerInfo struct {
 	prefix string
 }
 
-// extractRoutesFromFile extracts routes from a single TypeScript/JavaScript file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+// importInfo tracks an import statement.
+type importInfo struct {
+	localName  string // The local variable name (e.g., "usersRouter")
+	sourcePath string // The relative source path (e.g., "./users.js")
+}
+
+// fileMount records where a file's router is mounted.
+type fileMount struct {
+	parent string // The absolute path of the file that mounts it
+	prefix string // The mount path within the parent file (e.g., "/api/v1")
+}
+
+// buildFileMountMap builds a map from absolute file paths to their mount
+// paths by following imported routers mounted with
+// router.use('/path', imported.routes()). Mount paths compose across files,
+// so a router mounted by a mounted router gets both prefixes.
+func (p *Plugin) buildFileMountMap(ctx context.Context, files []scanner.SourceFile) map[string]string {
+	parents := make(map[string]fileMount)
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		routers := p.findRouterVariables(pf.RootNode, file.Content)
+		prefixes := resolveMountPrefixes(p.findRouterMounts(pf.RootNode, file.Content, routers), routers)
+
+		fileDir := filepath.Dir(file.Path)
+		for _, imp := range p.findImports(pf.RootNode, file.Content) {
+			if prefix, ok := prefixes[imp.localName]; ok {
+				if resolvedPath := resolveImportPath(fileDir, imp.sourcePath); resolvedPath != "" {
+					parents[resolvedPath] = fileMount{parent: file.Path, prefix: prefix}
+				}
+			}
+		}
+
+		pf.Close()
+	}
+
+	fileMountPaths := make(map[string]string, len(parents))
+	for path := range parents {
+		fileMountPaths[path] = resolveFileMount(parents, path, 0)
+	}
+	return fileMountPaths
+}
+
+// resolveFileMount returns the full mount path of a file by following the
+// files that mount it. Cycles stop after as many steps as there are files.
+func resolveFileMount(parents map[string]fileMount, path string, depth int) string {
+	mount, ok := parents[path]
+	if !ok || depth > len(parents) {
+		return ""
+	}
+	return joinMountPath(resolveFileMount(parents, mount.parent, depth+1), mount.prefix)
+}
+
+// findImports finds default imports and `const x = require('./x')` calls.
+func (p *Plugin) findImports(rootNode *sitter.Node, content []byte) []importInfo {
+	var imports []importInfo
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		var imp importInfo
+		switch node.Type() {
+		case "import_statement":
+			for i := 0; i < int(node.ChildCount()); i++ {
+				child := node.Child(i)
+				if child.Type() == "string" {
+					imp.sourcePath = strings.Trim(child.Content(content), `"'`)
+				}
+				if child.Type() == "import_clause" {
+					for j := 0; j < int(child.ChildCount()); j++ {
+						if clauseChild := child.Child(j); clauseChild.Type() == "identifier" {
+							imp.localName = clauseChild.Content(content)
+						}
+					}
+				}
+			}
+		case "variable_declarator":
+			name := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if name != nil && value != nil && name.Type() == "identifier" && value.Type() == "call_expression" &&
+				p.tsParser.GetCalleeText(value, content) == "require" {
+				if args := p.tsParser.GetCallArguments(value, content); len(args) == 1 && args[0].Type() == "string" {
+					imp.localName = name.Content(content)
+					imp.sourcePath = strings.Trim(args[0].Content(content), `"'`)
+				}
+			}
+		}
+		if imp.localName != "" && imp.sourcePath != "" {
+			imports = append(imports, imp)
+		}
+		return true
+	})
+
+	return imports
+}
+
+// resolveImportPath resolves a relative import path to an absolute file path.
+func resolveImportPath(fromDir, importPath string) string {
+	if !strings.HasPrefix(importPath, "./") && !strings.HasPrefix(importPath, "../") {
+		return ""
+	}
+
+	// TypeScript sources are imported with the .js extension they compile to
+	importPath = strings.TrimSuffix(importPath, ".js")
+	basePath := filepath.Join(fromDir, importPath)
+
+	extensions := []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
+	var candidates []string
+	for _, ext := range extensions {
+		candidates = append(candidates, basePath+ext)
+	}
+	for _, ext := range extensions {
+		candidates = append(candidates, filepath.Join(basePath, "index"+ext))
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			if absPath, err := filepath.Abs(candidate); err == nil {
+				return absPath
+			}
+		}
+	}
+
+	return ""
+}
+
+// extractRoutesFromFile extracts routes from a single TypeScript/JavaScript
+// file. mountPath is the prefix the file's routers are mounted at by other files.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, mountPath string) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +303,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports Koa or koa-router
 	if !p.hasKoaImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no Koa import")
 		return nil, nil
 	}
 
@@ -157,17 +318,16 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 	// Track router variables and their prefixes
 	routers := p.findRouterVariables(pf.RootNode, file.Content)
 
-	// Track router.use() calls for nested routers
-	routerMounts := p.findRouterMounts(pf.RootNode, file.Content, routers)
+	// Track router.use() calls for nested routers, composed transitively
+	routerMounts := resolveMountPrefixes(p.findRouterMounts(pf.RootNode, file.Content, routers), routers)
 
 	// Find all call expressions
 	calls := p.tsParser.FindCallExpressions(pf.RootNode, file.Content)
 
 	for _, call := range calls {
-		route := p.extractRouteFromCall(call, file.Content, routers, routerMounts, zodSchemas)
-		if route != nil {
+		for _, route := range p.extractRoutesFromCall(call, file.Content, routers, routerMounts, zodSchemas, mountPath) {
 			route.SourceFile = file.Path
-			routes = append(routes, *route)
+			routes = append(routes, route)
 		}
 	}
 
@@ -325,9 +485,17 @@ func (p *Plugin) extractPrefixFromObject(objNode *sitter.Node, content []byte) s
 	return prefix
 }
 
-// findRouterMounts finds router.use('/prefix', nestedRouter.routes()) calls.
-func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]string {
-	mounts := make(map[string]string)
+// mountInfo records that a router is mounted on another router.
+type mountInfo struct {
+	parent string // The router it is mounted on
+	path   string // The mount path, empty for router.use(nested.routes())
+}
+
+// findRouterMounts finds router.use('/prefix', nestedRouter.routes()) and
+// router.use(nestedRouter.routes()) calls and returns the parent and mount
+// path of each nested router.
+func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]mountInfo {
+	mounts := make(map[string]mountInfo)
 
 	calls := p.tsParser.FindCallExpressions(rootNode, content)
 
@@ -348,29 +516,27 @@ func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers
 		}
 
 		args := p.tsParser.GetCallArguments(call, content)
-		if len(args) < 2 {
+		if len(args) == 0 {
 			continue
 		}
 
-		// First arg should be the path prefix
-		pathArg := args[0]
-		path := ""
-		if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-			path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
-		}
-
-		if path == "" {
-			continue
+		// First arg may be the path prefix
+		path, _, isPath := p.tsParser.ResolveStringExpression(args[0], content)
+		if isPath {
+			if path == "" || len(args) < 2 {
+				continue
+			}
+			args = args[1:]
 		}
 
-		// Second arg should be nestedRouter.routes()
-		routesArg := args[1]
+		// Next arg should be nestedRouter.routes()
+		routesArg := args[0]
 		if routesArg.Type() == "call_expression" {
 			routesCallee := routesArg.Child(0)
 			if routesCallee != nil && routesCallee.Type() == "member_expression" {
 				routerName, methodName := p.tsParser.GetMemberExpressionParts(routesCallee, content)
-				if methodName == "routes" {
-					mounts[routerName] = path
+				if methodName == "routes" && routerName != object {
+					mounts[routerName] = mountInfo{parent: object, path: path}
 				}
 			}
 		}
@@ -379,15 +545,44 @@ func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers
 	return mounts
 }
 
-// extractRouteFromCall extracts a route from a call expression.
+// resolveMountPrefixes composes mount paths transitively. A nested router's
+// prefix includes each ancestor's mount path and its own prefix option, so
+// with api = new Router({ prefix: '/api' }) and api.use('/v1', v1.routes())
+// the prefix of v1 is /api/v1.
+func resolveMountPrefixes(mounts map[string]mountInfo, routers map[string]*routerInfo) map[string]string {
+	prefixes := make(map[string]string, len(mounts))
+	for name := range mounts {
+		prefixes[name] = resolveMountPrefix(mounts, routers, name, 0)
+	}
+	return prefixes
+}
+
+// resolveMountPrefix returns the full prefix of a mounted router, excluding
+// its own prefix option. Cycles stop after as many steps as there are mounts.
+func resolveMountPrefix(mounts map[string]mountInfo, routers map[string]*routerInfo, name string, depth int) string {
+	mount, ok := mounts[name]
+	if !ok || depth > len(mounts) {
+		return ""
+	}
+	prefix := resolveMountPrefix(mounts, routers, mount.parent, depth+1)
+	if parent, ok := routers[mount.parent]; ok && parent.prefix != "" {
+		prefix = joinMountPath(prefix, parent.prefix)
+	}
+	return joinMountPath(prefix, mount.path)
+}
+
+// extractRoutesFromCall extracts routes from a router.method(path, ...)
+// call or a router.register(path, methods, ...) call. The path may be an
+// array of paths; each path and method pair yields a route.
 // TODO: Use routers for prefix tracking in nested routes.
-func (p *Plugin) extractRouteFromCall(
+func (p *Plugin) extractRoutesFromCall(
 	node *sitter.Node,
 	content []byte,
 	routers map[string]*routerInfo,
 	routerMounts map[string]string,
 	zodSchemas map[string]*sitter.Node,
-) *types.Route {
+	fileMountPath string,
+) []types.Route {
 	// Get the callee (function being called)
 	callee := node.Child(0)
 	if callee == nil {
@@ -405,12 +600,6 @@ func (p *Plugin) extractRouteFromCall(
 		return nil
 	}
 
-	// Check if method is an HTTP method
-	httpMethod, isHTTPMethod := httpMethods[strings.ToLower(method)]
-	if !isHTTPMethod {
-		return nil
-	}
-
 	// Check if object is a known router
 	routerInf, isRouter := routers[object]
 	if !isRouter {
@@ -424,36 +613,31 @@ func (p *Plugin) extractRouteFromCall(
 		return nil
 	}
 
-	// First argument should be the path
-	pathArg := args[0]
-	path := ""
-	if pathArg.Type() == "string" || pathArg.Type() == "template_string" {
-		path, _ = p.tsParser.ExtractStringLiteral(pathArg, content)
+	// router.register(path, methods, ...middleware) takes the methods as an
+	// array; the HTTP method helpers take the path first
+	var methods []string
+	middleware := args[1:]
+	if method == "register" {
+		if len(args) < 2 {
+			return nil
+		}
+		registered, _ := p.tsParser.ResolveStringArray(args[1], content)
+		for _, m := range registered {
+			if httpMethod, ok := httpMethods[strings.ToLower(m)]; ok {
+				methods = append(methods, httpMethod)
+			}
+		}
+		middleware = args[2:]
+	} else if httpMethod, isHTTPMethod := httpMethods[strings.ToLower(method)]; isHTTPMethod {
+		methods = []string{httpMethod}
 	}
-
-	if path == "" {
+	if len(methods) == 0 {
 		return nil
 	}
 
-	// Combine prefix from router and any mount prefix
-	fullPath := path
-	if routerInf.prefix != "" {
-		fullPath = combinePaths(routerInf.prefix, path)
-	}
-	if mountPrefix, ok := routerMounts[object]; ok {
-		fullPath = combinePaths(mountPrefix, fullPath)
-	}
-
-	// Convert Koa path parameters (:param) to OpenAPI format ({param})
-	fullPath = convertPathParams(fullPath)
-
-	// Extract path parameters
-	params := extractPathParams(fullPath)
-
 	// Look for validation middleware to determine request body schema
 	var requestBody *types.RequestBody
-	for i := 1; i < len(args); i++ {
-		arg := args[i]
+	for _, arg := range middleware {
 		if arg.Type() == "call_expression" {
 			schemaRef := p.extractValidatorSchema(arg, content, zodSchemas)
 			if schemaRef != nil {
@@ -470,23 +654,70 @@ func (p *Plugin) extractRouteFromCall(
 		}
 	}
 
-	// Generate operation ID
-	operationID := generateOperationID(httpMethod, fullPath, "")
+	// The path is a string, possibly built from constants, or an array of them
+	pathArgs := []*sitter.Node{args[0]}
+	if args[0].Type() == "array" {
+		pathArgs = nil
+		for i := 0; i < int(args[0].NamedChildCount()); i++ {
+			pathArgs = append(pathArgs, args[0].NamedChild(i))
+		}
+	}
 
-	// Infer tags from path
-	tags := inferTags(fullPath)
+	var routes []types.Route
+	for _, pathArg := range pathArgs {
+		path, unresolved, _ := p.tsParser.ResolveStringExpression(pathArg, content)
+		if path == "" {
+			continue
+		}
 
-	route := &types.Route{
-		Method:      httpMethod,
-		Path:        fullPath,
-		OperationID: operationID,
-		Tags:        tags,
-		Parameters:  params,
-		RequestBody: requestBody,
-		SourceLine:  int(node.StartPoint().Row) + 1,
+		// Combine prefix from router and any mount prefix
+		fullPath := path
+		if routerInf.prefix != "" {
+			fullPath = combinePaths(routerInf.prefix, path)
+		}
+		if mountPrefix, ok := routerMounts[object]; ok {
+			fullPath = combinePaths(mountPrefix, fullPath)
+		}
+		if fileMountPath != "" {
+			fullPath = combinePaths(fileMountPath, fullPath)
+		}
+		mount := convertPathParams(combinePaths(fileMountPath, routerMounts[object]))
+
+		// Convert Koa path parameters (:param) to OpenAPI format ({param})
+		wildcard := plugins.IsCatchAll(fullPath)
+		fullPath = convertPathParams(fullPath)
+
+		// Extract path parameters
+		params := extractPathParams(fullPath)
+
+		// Infer tags from path
+		tags := inferTags(fullPath)
+
+		for _, httpMethod := range methods {
+			route := types.Route{
+				Method:      httpMethod,
+				Path:        fullPath,
+				OperationID: generateOperationID(httpMethod, fullPath, ""),
+				Tags:        tags,
+				Parameters:  params,
+				RequestBody: requestBody,
+				Mount:       mount,
+				SourceLine:  int(node.StartPoint().Row) + 1,
+			}
+			plugins.SetMountNames(&route, func(method, path string) string {
+				return generateOperationID(method, path, "")
+			}, inferTags)
+			if len(unresolved) > 0 {
+				route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+			}
+			if wildcard {
+				plugins.MarkWildcard(&route)
+			}
+			routes = append(routes, route)
+		}
 	}
 
-	return route
+	return routes
 }
 
 // extractValidatorSchema extracts the schema reference from validation middleware.
@@ -521,29 +752,27 @@ func (p *Plugin) extractValidatorSchema(
 
 // walkNodes walks all nodes in the tree.
 func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
-		return
-	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	parser.Walk(node, fn)
 }
 
 // ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	tsExtractor := schema.NewTypeScriptSchemaExtractor()
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -552,6 +781,9 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		for _, iface := range pf.Interfaces {
 			tsExtractor.ExtractAndRegister(iface)
 		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
 
 		// Extract Zod schemas (if any)
 		for _, zs := range pf.ZodSchemas {
@@ -573,9 +805,10 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts Koa-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts Koa-style path params (:id) to OpenAPI format ({id}),
+// and catch-alls (*) to a templated {path}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -620,6 +853,15 @@ func combinePaths(prefix, path string) string {
 	return prefix + path
 }
 
+// joinMountPath appends a mount path to a prefix. Unlike combinePaths, an
+// empty or root mount path leaves the prefix unchanged.
+func joinMountPath(prefix, path string) string {
+	if path == "" || path == "/" {
+		return prefix
+	}
+	return combinePaths(prefix, path)
+}
+
 // generateOperationID generates an operation ID from method and path.
 func generateOperationID(method, path, handler string) string {
 	// If we have a handler name, use it
@@ -313,6 +313,90 @@ func TestPlugin_ExtractRoutes_NestedRouters(t *testing.T) {
 	assert.GreaterOrEqual(t, len(routes), 3)
 }
 
+func TestPlugin_ExtractRoutes_MultiLevelMounting(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "app.js",
+			Language: "javascript",
+			Content: []byte(`
+const Koa = require('koa')
+const Router = require('@koa/router')
+
+const app = new Koa()
+const api = new Router({ prefix: '/api' })
+const v1 = new Router()
+const users = new Router({ prefix: '/users' })
+const health = new Router()
+
+users.get('/:id', ctx => {})
+health.get('/health', ctx => {})
+
+v1.use(users.routes())
+api.use('/v1', v1.routes(), v1.allowedMethods())
+api.use(health.routes())
+app.use(api.routes())
+`),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	route := findRoute(routes, "GET", "/api/v1/users/{id}")
+	require.NotNil(t, route)
+	assert.Equal(t, "id", route.Parameters[0].Name)
+	assert.Equal(t, "/api/v1", route.Mount)
+	assert.Equal(t, generateOperationID("GET", "/users/{id}", ""), route.MountOperationID)
+	assert.NotNil(t, findRoute(routes, "GET", "/api/health"))
+}
+
+func TestPlugin_ExtractRoutes_MountingAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+	sources := map[string]string{
+		"app.ts": `
+import Koa from 'koa'
+import Router from '@koa/router'
+import v1 from './v1'
+const app = new Koa()
+const api = new Router({ prefix: '/api' })
+api.use('/v1', v1.routes())
+app.use(api.routes())
+`,
+		"v1/index.js": `
+const Router = require('@koa/router')
+const users = require('../users')
+const router = new Router()
+router.use('/users', users.routes())
+module.exports = router
+`,
+		"users.ts": `
+import Router from '@koa/router'
+const router = new Router()
+router.get('/:id', ctx => {})
+export default router
+`,
+	}
+
+	var files []scanner.SourceFile
+	for name, content := range sources {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		language := "typescript"
+		if filepath.Ext(name) == ".js" {
+			language = "javascript"
+		}
+		files = append(files, scanner.SourceFile{Path: path, Language: language, Content: []byte(content)})
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	assert.NotNil(t, findRoute(routes, "GET", "/api/v1/users/{id}"))
+}
+
 func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	p := New()
 
@@ -343,6 +427,39 @@ func TestPlugin_ExtractRoutes_AllHTTPMethods(t *testing.T) {
 	assert.True(t, methods["OPTIONS"])
 }
 
+func TestPlugin_ExtractRoutes_Register(t *testing.T) {
+	code := `
+import Router from '@koa/router'
+
+const router = new Router({ prefix: '/api' })
+
+router.register('/items/:id', ['GET', 'put', 'CONNECT'], (ctx) => { ctx.body = {} })
+router.register(['/health', '/healthz'], ['HEAD'], (ctx) => { ctx.status = 200 })
+router.get(['/a', '/b'], (ctx) => { ctx.body = 'ok' })
+`
+
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "routes.ts", Language: "typescript", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	var keys []string
+	for _, r := range routes {
+		keys = append(keys, r.Method+" "+r.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"GET /api/items/{id}",
+		"PUT /api/items/{id}",
+		"HEAD /api/health",
+		"HEAD /api/healthz",
+		"GET /api/a",
+		"GET /api/b",
+	}, keys)
+	assert.Equal(t, "putApiItemsByid", routes[1].OperationID)
+	require.Len(t, routes[1].Parameters, 1)
+	assert.Equal(t, 6, routes[1].SourceLine)
+}
+
 func TestPlugin_ExtractRoutes_AtKoaRouter(t *testing.T) {
 	p := New()
 
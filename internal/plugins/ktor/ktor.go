@@ -17,6 +17,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -103,7 +104,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		}
 
 		// Extract routes with proper nested route handling
-		fileRoutes := p.extractRoutesFromContent(string(file.Content), file.Path)
+		fileRoutes := p.extractRoutesFromContent(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, fileRoutes...)
 	}
 
@@ -6,9 +6,11 @@ package laravel
 
 import (
 	"bufio"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -17,6 +19,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -99,6 +102,9 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 	// Track route groups for prefix application
 	var currentPrefixes []string
 
+	// Controller actions are inspected for response headers
+	actions := p.collectControllerActions(files)
+
 	for _, file := range files {
 		if file.Language != "php" {
 			continue
@@ -115,6 +121,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		for _, route := range pf.Routes {
 			r := p.convertRoute(route, currentPrefixes, file.Path)
 			if r != nil {
+				applyActionResponses(r, actions[controllerAction(route)])
 				routes = append(routes, *r)
 			}
 		}
@@ -125,6 +132,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 			for _, route := range expandedRoutes {
 				r := p.convertRoute(route, currentPrefixes, file.Path)
 				if r != nil {
+					applyActionResponses(r, actions[controllerAction(route)])
 					routes = append(routes, *r)
 				}
 			}
@@ -164,6 +172,157 @@ func (p *Plugin) convertRoute(route parser.PHPRoute, prefixes []string, filePath
 	}
 }
 
+// collectControllerActions maps "Controller@action" to controller method bodies.
+func (p *Plugin) collectControllerActions(files []scanner.SourceFile) map[string]string {
+	actions := make(map[string]string)
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		pf := p.phpParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			for _, method := range class.Methods {
+				if method.Body != "" {
+					actions[class.Name+"@"+method.Name] = method.Body
+				}
+			}
+		}
+	}
+
+	return actions
+}
+
+// controllerAction returns the "Controller@action" key of a route, without namespace.
+func controllerAction(route parser.PHPRoute) string {
+	controller := route.Controller
+	if idx := strings.LastIndex(controller, "\\"); idx >= 0 {
+		controller = controller[idx+1:]
+	}
+	return controller + "@" + route.Action
+}
+
+// headerCallRegex matches ->header('Name', ...) calls.
+var headerCallRegex = regexp.MustCompile(`->header\(\s*['"]([^'"]+)['"]`)
+
+// withHeadersRegex matches ->withHeaders([...]) calls.
+var withHeadersRegex = regexp.MustCompile(`->withHeaders\(\s*\[([^\]]*)\]`)
+
+// arrayKeyRegex matches 'Name' => keys in a PHP array literal.
+var arrayKeyRegex = regexp.MustCompile(`['"]([^'"]+)['"]\s*=>`)
+
+// createdStatusRegex matches an explicit 201 status in a response.
+var createdStatusRegex = regexp.MustCompile(`,\s*(?:201|Response::HTTP_CREATED)\s*[,)]|setStatusCode\(\s*(?:201|Response::HTTP_CREATED)\s*\)`)
+
+// redirectStatementRegex matches statements that return a redirect:
+// redirect(), to_route(), back(), Redirect::to(), or new RedirectResponse().
+var redirectStatementRegex = regexp.MustCompile(`(?:\bredirect\(|\bto_route\(|\bback\(|Redirect::\w+\(|new\s+RedirectResponse\()[^;]*`)
+
+// redirectStatusRegex matches an explicit 3xx redirect status.
+var redirectStatusRegex = regexp.MustCompile(`\b(30[12378])\b`)
+
+// abortRegex matches abort(404), abort_if($cond, 403), and abort_unless($cond, 403).
+var abortRegex = regexp.MustCompile(`\babort\(\s*(\d{3}|Response::HTTP_\w+)|\babort_(?:if|unless)\([^;]*?,\s*(\d{3}|Response::HTTP_\w+)`)
+
+// responseStatusRegex matches an explicit status passed to response() or
+// ->json(), e.g. response()->json($data, 404) or response("", 204).
+var responseStatusRegex = regexp.MustCompile(`(?:\bresponse|->json)\([^;]*?,\s*(\d{3}|Response::HTTP_\w+)\s*[,)]`)
+
+// actionStatuses returns the status codes a controller action responds with
+// on any branch. response()->noContent() is a 204.
+func actionStatuses(body string) []int {
+	var values []string
+	for _, match := range abortRegex.FindAllStringSubmatch(body, -1) {
+		values = append(values, match[1]+match[2])
+	}
+	for _, match := range responseStatusRegex.FindAllStringSubmatch(body, -1) {
+		values = append(values, match[1])
+	}
+
+	var codes []int
+	for _, value := range values {
+		if code := plugins.StatusCode(value); code > 0 {
+			codes = append(codes, code)
+		}
+	}
+	if strings.Contains(body, "->noContent(") {
+		codes = append(codes, http.StatusNoContent)
+	}
+	return codes
+}
+
+// applyActionResponses documents the responses of a controller action:
+// every status it responds with, redirects as 3xx responses with a Location
+// header, and headers set with
+// ->header() or ->withHeaders() are attached to the 201 response when the
+// action returns one, otherwise to the 200 response.
+func applyActionResponses(route *types.Route, body string) {
+	if body == "" {
+		return
+	}
+
+	for _, statement := range redirectStatementRegex.FindAllString(body, -1) {
+		code := http.StatusFound
+		if match := redirectStatusRegex.FindStringSubmatch(statement); match != nil {
+			code, _ = strconv.Atoi(match[1])
+		} else if strings.Contains(statement, "permanent") {
+			code = http.StatusMovedPermanently
+		}
+		if route.Responses == nil {
+			route.Responses = make(map[string]types.Response)
+		}
+		route.Responses[strconv.Itoa(code)] = types.Response{
+			Description: http.StatusText(code),
+			Headers: map[string]types.Header{
+				"Location": {
+					Description: "Redirect target",
+					Schema:      &types.Schema{Type: "string"},
+				},
+			},
+		}
+	}
+
+	plugins.ApplyStatuses(route, actionStatuses(body))
+
+	var names []string
+	for _, match := range headerCallRegex.FindAllStringSubmatch(body, -1) {
+		names = append(names, match[1])
+	}
+	for _, match := range withHeadersRegex.FindAllStringSubmatch(body, -1) {
+		for _, key := range arrayKeyRegex.FindAllStringSubmatch(match[1], -1) {
+			names = append(names, key[1])
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	status := "200"
+	if createdStatusRegex.MatchString(body) {
+		status = "201"
+	}
+
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	resp, ok := route.Responses[status]
+	if !ok {
+		resp = types.Response{Description: "Successful response"}
+	}
+	if resp.Headers == nil {
+		resp.Headers = make(map[string]types.Header)
+	}
+	for _, name := range names {
+		header := types.Header{Schema: &types.Schema{Type: "string"}}
+		if strings.EqualFold(name, "Location") && status == "201" {
+			header.Description = "URL of the created resource"
+		}
+		resp.Headers[name] = header
+	}
+	route.Responses[status] = resp
+}
+
 // braceParamRegex matches path parameters like {param}.
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
@@ -347,6 +506,32 @@ func (p *Plugin) classToSchema(class parser.PHPClass) *types.Schema {
 				schema.Properties[field] = propSchema
 			}
 		}
+
+		// Eloquent maintains the timestamps; clients never set them
+		if class.Timestamps {
+			for _, field := range []string{"created_at", "updated_at"} {
+				if _, exists := schema.Properties[field]; !exists {
+					schema.Properties[field] = &types.Schema{Type: "string", Format: "date-time"}
+				}
+				schema.Properties[field].ReadOnly = true
+			}
+		}
+
+		// Hidden attributes are accepted but never serialized
+		for _, field := range class.Hidden {
+			if propSchema, exists := schema.Properties[field]; exists {
+				propSchema.WriteOnly = true
+			}
+		}
+
+		// Defaults from $attributes
+		for field, literal := range class.Attributes {
+			if propSchema, exists := schema.Properties[field]; exists {
+				if value, ok := parser.DefaultValue(literal, propSchema.Type); ok {
+					propSchema.Default = value
+				}
+			}
+		}
 	}
 
 	// Handle plain PHP classes with properties (including constructor promoted)
@@ -366,6 +551,20 @@ func (p *Plugin) classToSchema(class parser.PHPClass) *types.Schema {
 		if prop.IsNullable {
 			propSchema.Nullable = true
 		}
+		if prop.Description != "" {
+			propSchema.Description = prop.Description
+		}
+		if value, ok := parser.DefaultValue(prop.Default, openAPIType); ok {
+			propSchema.Default = value
+		}
+		if valueType, ok := parser.PHPArrayValueType(prop.DocType); ok {
+			valueOpenAPIType, valueFormat := parser.PHPTypeToOpenAPI(valueType)
+			propSchema.Type = "object"
+			propSchema.AdditionalProperties = &types.Schema{
+				Type:   valueOpenAPIType,
+				Format: valueFormat,
+			}
+		}
 
 		schema.Properties[prop.Name] = propSchema
 
@@ -379,6 +578,11 @@ func (p *Plugin) classToSchema(class parser.PHPClass) *types.Schema {
 
 // castTypeToOpenAPI converts a Laravel cast type to OpenAPI type.
 func castTypeToOpenAPI(castType string) (string, string) {
+	// Configured type mappings take precedence, e.g. for custom cast classes
+	if t, f, ok := typemap.OpenAPI(castType); ok {
+		return t, f
+	}
+
 	castType = strings.ToLower(castType)
 
 	switch castType {
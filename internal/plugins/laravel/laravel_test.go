@@ -6,6 +6,7 @@ package laravel
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,6 +81,76 @@ Route::get('/users/{id?}', [UserController::class, 'show']);
 Route::get('/posts/{post}/comments/{comment?}', [CommentController::class, 'show']);
 `
 
+// laravelHeaderControllerCode is a controller that sets response headers.
+const laravelHeaderControllerCode = `
+<?php
+
+namespace App\Http\Controllers;
+
+class UserController extends Controller
+{
+    public function index(): JsonResponse
+    {
+        return response()->json(User::all())
+            ->withHeaders(['X-Total-Count' => User::count(), 'Cache-Control' => 'no-store']);
+    }
+
+    public function store(Request $request): JsonResponse
+    {
+        $user = User::create($request->all());
+        return response()->json($user, 201)->header('Location', route('users.show', $user));
+    }
+
+    public function show($id)
+    {
+        return User::findOrFail($id);
+    }
+
+    public function update(Request $request, $id)
+    {
+        User::findOrFail($id)->update($request->all());
+        return redirect()->route('users.show', $id);
+    }
+
+    public function destroy($id)
+    {
+        User::destroy($id);
+        return Redirect::permanent('/users');
+    }
+}
+`
+
+// laravelMultiStatusControllerCode is a controller whose actions respond with several statuses.
+const laravelMultiStatusControllerCode = `
+<?php
+
+namespace App\Http\Controllers;
+
+class UserController extends Controller
+{
+    public function show($id)
+    {
+        $user = User::find($id);
+        abort_if($user === null, 404);
+        return $user;
+    }
+
+    public function update(Request $request, $id)
+    {
+        if (! $request->user()->isAdmin()) {
+            return response()->json(['message' => 'Forbidden'], Response::HTTP_FORBIDDEN);
+        }
+        return User::findOrFail($id)->update($request->all());
+    }
+
+    public function destroy($id)
+    {
+        User::destroy($id);
+        return response()->noContent();
+    }
+}
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "laravel", p.Name())
@@ -213,6 +284,22 @@ func TestPlugin_ExtractRoutes_BasicRoutes(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_CRLF(t *testing.T) {
+	p := New()
+
+	lf, err := p.ExtractRoutes([]scanner.SourceFile{
+		{Path: "routes/api.php", Language: "php", Content: []byte(laravelRoutesCode)},
+	})
+	require.NoError(t, err)
+	crlf, err := p.ExtractRoutes([]scanner.SourceFile{
+		{Path: "routes/api.php", Language: "php", Content: []byte(strings.ReplaceAll(laravelRoutesCode, "\n", "\r\n"))},
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, lf)
+	assert.Equal(t, lf, crlf)
+}
+
 func TestPlugin_ExtractRoutes_GroupedRoutes(t *testing.T) {
 	p := New()
 
@@ -309,6 +396,76 @@ func TestPlugin_ExtractRoutes_OptionalParams(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_ResponseHeaders(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "routes/api.php", Language: "php", Content: []byte(laravelRoutesCode)},
+		{Path: "app/Http/Controllers/UserController.php", Language: "php", Content: []byte(laravelHeaderControllerCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	index := findRoute(routes, "GET", "/users")
+	require.NotNil(t, index)
+	require.Contains(t, index.Responses, "200")
+	assert.Contains(t, index.Responses["200"].Headers, "X-Total-Count")
+	assert.Contains(t, index.Responses["200"].Headers, "Cache-Control")
+
+	// Location lands on the 201 response
+	store := findRoute(routes, "POST", "/users")
+	require.NotNil(t, store)
+	require.Contains(t, store.Responses, "201")
+	location, ok := store.Responses["201"].Headers["Location"]
+	require.True(t, ok)
+	assert.Equal(t, "string", location.Schema.Type)
+
+	// Actions without headers keep default responses
+	show := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, show)
+	assert.Empty(t, show.Responses)
+
+	// redirect() defaults to 302 Found
+	update := findRoute(routes, "PUT", "/users/{id}")
+	require.NotNil(t, update)
+	require.Contains(t, update.Responses, "302")
+	assert.Contains(t, update.Responses["302"].Headers, "Location")
+
+	// Permanent redirects are 301
+	destroy := findRoute(routes, "DELETE", "/users/{id}")
+	require.NotNil(t, destroy)
+	require.Contains(t, destroy.Responses, "301")
+	assert.Equal(t, "Moved Permanently", destroy.Responses["301"].Description)
+}
+
+func TestPlugin_ExtractRoutes_MultiStatus(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "routes/api.php", Language: "php", Content: []byte(laravelRoutesCode)},
+		{Path: "app/Http/Controllers/UserController.php", Language: "php", Content: []byte(laravelMultiStatusControllerCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	show := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, show)
+	assert.Contains(t, show.Responses, "200")
+	assert.Contains(t, show.Responses, "404")
+
+	update := findRoute(routes, "PUT", "/users/{id}")
+	require.NotNil(t, update)
+	assert.Contains(t, update.Responses, "200")
+	assert.Contains(t, update.Responses, "403")
+
+	destroy := findRoute(routes, "DELETE", "/users/{id}")
+	require.NotNil(t, destroy)
+	require.Len(t, destroy.Responses, 1)
+	assert.Contains(t, destroy.Responses, "204")
+}
+
 func TestPlugin_ExtractRoutes_IgnoresNonPHP(t *testing.T) {
 	p := New()
 
@@ -362,6 +519,163 @@ func TestPlugin_ExtractSchemas(t *testing.T) {
 	assert.Empty(t, schemas)
 }
 
+func TestPlugin_ExtractSchemas_EloquentAccessModes(t *testing.T) {
+	files := []scanner.SourceFile{
+		{
+			Path:     "app/Models/User.php",
+			Language: "php",
+			Content: []byte(`<?php
+namespace App\Models;
+
+class User extends Model
+{
+    protected $fillable = ['name', 'email', 'password'];
+    protected $hidden = ['password', 'remember_token'];
+}
+
+class Tag extends Model
+{
+    public $timestamps = false;
+    protected $fillable = ['name'];
+}
+`),
+		},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	user := schemas[0]
+	assert.Equal(t, "User", user.Title)
+	assert.True(t, user.Properties["password"].WriteOnly)
+	assert.False(t, user.Properties["email"].WriteOnly)
+	assert.NotContains(t, user.Properties, "remember_token")
+	require.Contains(t, user.Properties, "created_at")
+	assert.True(t, user.Properties["created_at"].ReadOnly)
+	assert.Equal(t, "date-time", user.Properties["updated_at"].Format)
+
+	tag := schemas[1]
+	assert.NotContains(t, tag.Properties, "created_at")
+}
+
+func TestPlugin_ExtractSchemas_AssociativeArrays(t *testing.T) {
+	files := []scanner.SourceFile{
+		{
+			Path:     "app/Data/Settings.php",
+			Language: "php",
+			Content: []byte(`<?php
+namespace App\Data;
+
+class SettingsData
+{
+    /** @var array<string, int> */
+    public array $limits;
+
+    /**
+     * @var int[]
+     */
+    public array $ids;
+}
+`),
+		},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	limits := schemas[0].Properties["limits"]
+	require.NotNil(t, limits)
+	assert.Equal(t, "object", limits.Type)
+	require.NotNil(t, limits.AdditionalProperties)
+	assert.Equal(t, "integer", limits.AdditionalProperties.Type)
+
+	assert.Equal(t, "array", schemas[0].Properties["ids"].Type)
+	assert.Nil(t, schemas[0].Properties["ids"].AdditionalProperties)
+}
+
+func TestPlugin_ExtractSchemas_Defaults(t *testing.T) {
+	files := []scanner.SourceFile{
+		{
+			Path:     "app/Models/Post.php",
+			Language: "php",
+			Content: []byte(`<?php
+namespace App\Models;
+
+class Post extends Model
+{
+    protected $fillable = ['title', 'status', 'views'];
+    protected $casts = ['views' => 'integer'];
+    protected $attributes = [
+        'status' => 'draft',
+        'views' => 0,
+    ];
+}
+
+class PostFilter
+{
+    public string $sort = 'newest';
+    public int $perPage = 15;
+    public ?string $query = null;
+
+    public function __construct(public bool $withDrafts = false) {}
+}
+`),
+		},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	post := schemas[0]
+	assert.Equal(t, "draft", post.Properties["status"].Default)
+	assert.Equal(t, int64(0), post.Properties["views"].Default)
+	assert.Nil(t, post.Properties["title"].Default)
+
+	filter := schemas[1]
+	assert.Equal(t, "newest", filter.Properties["sort"].Default)
+	assert.Equal(t, int64(15), filter.Properties["perPage"].Default)
+	assert.Nil(t, filter.Properties["query"].Default)
+	assert.Equal(t, false, filter.Properties["withDrafts"].Default)
+}
+
+func TestPlugin_ExtractSchemas_PropertyDescriptions(t *testing.T) {
+	files := []scanner.SourceFile{
+		{
+			Path:     "app/Data/Invoice.php",
+			Language: "php",
+			Content: []byte(`<?php
+namespace App\Data;
+
+class InvoiceData
+{
+    /**
+     * Invoice number shown to customers.
+     *
+     * @var string
+     */
+    public string $number;
+
+    public int $total; // amount in cents
+
+    public string $currency;
+}
+`),
+		},
+	}
+
+	schemas, err := New().ExtractSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	props := schemas[0].Properties
+	assert.Equal(t, "Invoice number shown to customers.", props["number"].Description)
+	assert.Equal(t, "amount in cents", props["total"].Description)
+	assert.Empty(t, props["currency"].Description)
+}
+
 func TestExtractPathParams(t *testing.T) {
 	tests := []struct {
 		path       string
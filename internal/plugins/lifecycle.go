@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// LifecycleExtension documents the release stage and audience of an
+// operation.
+const LifecycleExtension = "x-lifecycle"
+
+var (
+	// lifecycleDirective matches api2spec:lifecycle beta, @stage beta or
+	// @audience partner: group 1 is the field and group 2 its value
+	lifecycleDirective = regexp.MustCompile(`(?i)(?:@|\bapi2spec:)(lifecycle|stage|audience)\s+(\w+)`)
+
+	// lifecycleTag matches a bare @beta or @partner tag
+	lifecycleTag = regexp.MustCompile(`(?i)(?:^|\s)@(alpha|beta|experimental|ga|stable|internal|partner|public)\b`)
+)
+
+// lifecycleValues maps annotation values to the stage or audience they
+// set.
+var lifecycleValues = map[string]types.Lifecycle{
+	"alpha":        {Stage: "alpha"},
+	"experimental": {Stage: "alpha"},
+	"beta":         {Stage: "beta"},
+	"ga":           {Stage: "ga"},
+	"stable":       {Stage: "ga"},
+	"internal":     {Audience: "internal"},
+	"partner":      {Audience: "partner"},
+	"public":       {Audience: "public"},
+}
+
+// MarkLifecycle sets x-lifecycle on routes from the comments above their
+// definition or handler, such as // @beta, # api2spec:audience partner or
+// a JSDoc @stage alpha tag. Annotations on the comment of an enclosing
+// class, such as a NestJS controller, cover its routes unless a route sets
+// its own.
+func MarkLifecycle(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+	}
+	for i := range routes {
+		route := &routes[i]
+		lines, start := sources[route.SourceFile], route.SourceLine-1
+		if start < 0 || start >= len(lines) {
+			continue
+		}
+		lifecycle := commentLifecycle(lines, start)
+		if class := enclosingClass(lines, start); class >= 0 {
+			inherited := commentLifecycle(lines, class)
+			if lifecycle.Stage == "" {
+				lifecycle.Stage = inherited.Stage
+			}
+			if lifecycle.Audience == "" {
+				lifecycle.Audience = inherited.Audience
+			}
+		}
+		if lifecycle == (types.Lifecycle{}) {
+			continue
+		}
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions[LifecycleExtension] = lifecycle
+	}
+}
+
+// commentLifecycle returns the lifecycle annotated in the comment above the
+// line at index n and its decorators.
+func commentLifecycle(lines []string, n int) types.Lifecycle {
+	var lifecycle types.Lifecycle
+	set := func(v types.Lifecycle) {
+		// The annotation nearest the line wins
+		if lifecycle.Stage == "" {
+			lifecycle.Stage = v.Stage
+		}
+		if lifecycle.Audience == "" {
+			lifecycle.Audience = v.Audience
+		}
+	}
+	for m := decoratorStart(lines, n) - 1; m >= 0 && isComment(lines[m]); m-- {
+		for _, d := range lifecycleDirective.FindAllStringSubmatch(lines[m], -1) {
+			v := lifecycleValues[strings.ToLower(d[2])]
+			if strings.EqualFold(d[1], "audience") {
+				v.Stage = ""
+			} else {
+				v.Audience = ""
+			}
+			set(v)
+		}
+		for _, t := range lifecycleTag.FindAllStringSubmatch(lines[m], -1) {
+			set(lifecycleValues[strings.ToLower(t[1])])
+		}
+	}
+	return lifecycle
+}
+
+// isComment reports whether a line is part of a comment.
+func isComment(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, marker := range []string{"//", "#", "/*", "*"} {
+		if strings.HasPrefix(line, marker) {
+			return true
+		}
+	}
+	return false
+}
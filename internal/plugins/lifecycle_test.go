@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkLifecycle_Comments(t *testing.T) {
+	code := `// Lists orders.
+// @beta
+router.get('/orders', listOrders)
+
+/**
+ * Creates an order.
+ * @stage alpha
+ * @audience partner
+ */
+router.post('/orders', createOrder)
+
+// api2spec:lifecycle ga
+// Deletes an order.
+
+router.delete('/orders/:id', deleteOrder)
+`
+	files := []scanner.SourceFile{{Path: "orders.js", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "orders.js", SourceLine: 3},
+		{Method: "POST", Path: "/orders", SourceFile: "orders.js", SourceLine: 10},
+		{Method: "DELETE", Path: "/orders/{id}", SourceFile: "orders.js", SourceLine: 15},
+	}
+
+	MarkLifecycle(routes, files)
+
+	assert.Equal(t, types.Lifecycle{Stage: "beta"}, routes[0].Extensions[LifecycleExtension])
+	assert.Equal(t, types.Lifecycle{Stage: "alpha", Audience: "partner"}, routes[1].Extensions[LifecycleExtension])
+	assert.Nil(t, routes[2].Extensions, "a blank line ends the comment above a route")
+}
+
+func TestMarkLifecycle_ClassComment(t *testing.T) {
+	code := `/** @internal */
+@Controller('admin')
+export class AdminController {
+  @Get()
+  findAll() {}
+
+  // @public @experimental
+  @Public()
+  @Get('status')
+  status() {}
+}
+`
+	files := []scanner.SourceFile{{Path: "admin.controller.ts", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/admin", SourceFile: "admin.controller.ts", SourceLine: 5},
+		{Method: "GET", Path: "/admin/status", SourceFile: "admin.controller.ts", SourceLine: 10},
+	}
+
+	MarkLifecycle(routes, files)
+
+	assert.Equal(t, types.Lifecycle{Audience: "internal"}, routes[0].Extensions[LifecycleExtension], "controller annotations cover its handlers")
+	assert.Equal(t, types.Lifecycle{Stage: "alpha", Audience: "public"}, routes[1].Extensions[LifecycleExtension])
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+// MarkerProvider is an optional interface for plugins that only find routes
+// in files containing one of a few substrings, such as the import path of
+// their framework, and find them file by file. Route extraction skips the
+// other files before parsing them.
+type MarkerProvider interface {
+	// RouteMarkers returns the substrings, one of which every file
+	// defining routes contains.
+	RouteMarkers() []string
+}
+
+// RouteCandidates returns the files plugin may find routes in: all of them,
+// unless plugin declares route markers. Skipped files the plugin handles
+// are recorded in the decisions log.
+func RouteCandidates(plugin FrameworkPlugin, files []scanner.SourceFile) []scanner.SourceFile {
+	mp, ok := plugin.(MarkerProvider)
+	if !ok {
+		return files
+	}
+	markers := mp.RouteMarkers()
+	if len(markers) == 0 {
+		return files
+	}
+
+	candidates := make([]scanner.SourceFile, 0, len(files))
+	var reason string
+	for _, file := range files {
+		if file.ContainsAny(markers) {
+			candidates = append(candidates, file)
+			continue
+		}
+		if !slices.Contains(plugin.Extensions(), strings.ToLower(filepath.Ext(file.Path))) {
+			continue
+		}
+		if reason == "" {
+			quoted := make([]string, len(markers))
+			for i, marker := range markers {
+				quoted[i] = strconv.Quote(marker)
+			}
+			reason = "contains none of " + strings.Join(quoted, ", ")
+		}
+		decisions.Skip(plugin.Name(), file.Path, reason)
+	}
+	return candidates
+}
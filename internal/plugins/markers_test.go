@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// markerPlugin records the files it extracts routes from.
+type markerPlugin struct {
+	mockPlugin
+	markers []string
+	files   []string
+}
+
+func (m *markerPlugin) RouteMarkers() []string {
+	return m.markers
+}
+
+func (m *markerPlugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	for _, f := range files {
+		m.files = append(m.files, f.Path)
+	}
+	return nil, nil
+}
+
+func TestExtractRoutes_RouteMarkers(t *testing.T) {
+	decisions.Enable()
+	decisions.Skips()
+
+	files := []scanner.SourceFile{
+		{Path: "server.ts", Content: []byte(`import Fastify from 'fastify'`)},
+		{Path: "util.ts", Content: []byte(`export const add = (a, b) => a + b`)},
+		{Path: "plugin.js", Content: []byte(`module.exports = require('@fastify/cors')`)},
+		{Path: "README.md", Content: []byte(`# Notes`)},
+	}
+	plugin := &markerPlugin{mockPlugin: mockPlugin{name: "fastify", extensions: []string{".ts", ".js"}}, markers: []string{"fastify"}}
+
+	_, err := ExtractRoutes(context.Background(), plugin, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"server.ts", "plugin.js"}, plugin.files)
+
+	skips := decisions.Skips()
+	require.Len(t, skips, 1)
+	assert.Equal(t, decisions.Assumption{Plugin: "fastify", File: "util.ts", Message: `contains none of "fastify"`}, skips[0])
+
+	// Plugins without markers see every file
+	plugin.markers, plugin.files = nil, nil
+	_, err = ExtractRoutes(context.Background(), plugin, files)
+	require.NoError(t, err)
+	assert.Len(t, plugin.files, 4)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import "strings"
+
+// dotnetSubtypes are the .NET MediaTypeNames subtypes whose media type is
+// not their lowercase name.
+var dotnetSubtypes = map[string]string{
+	"octet":          "octet-stream",
+	"problemjson":    "problem+json",
+	"problemxml":     "problem+xml",
+	"jsonpatch":      "json-patch+json",
+	"formurlencoded": "x-www-form-urlencoded",
+}
+
+// MediaTypes returns the media types of a content negotiation annotation
+// value, such as "application/json", {"text/csv", MediaType.APPLICATION_JSON_VALUE}
+// or MediaTypeNames.Application.Xml, resolving Java MediaType and .NET
+// MediaTypeNames constants.
+func MediaTypes(value string) []string {
+	value = strings.Trim(strings.TrimSpace(value), "{}[]")
+
+	var result []string
+	for _, part := range strings.Split(value, ",") {
+		part = strings.Trim(strings.TrimSpace(part), "\"'`")
+		if part == "" {
+			continue
+		}
+		if !strings.Contains(part, "/") {
+			part = mediaTypeConstant(part)
+		}
+		result = append(result, part)
+	}
+	return result
+}
+
+// mediaTypeConstant converts a media type constant, such as Java's
+// MediaType.APPLICATION_JSON or .NET's MediaTypeNames.Application.Json, to
+// its value.
+func mediaTypeConstant(constant string) string {
+	segments := strings.Split(constant, ".")
+	if n := len(segments); n >= 3 && segments[n-3] == "MediaTypeNames" {
+		subtype := strings.ToLower(segments[n-1])
+		if mapped, ok := dotnetSubtypes[subtype]; ok {
+			subtype = mapped
+		}
+		return strings.ToLower(segments[n-2]) + "/" + subtype
+	}
+
+	name := strings.TrimSuffix(strings.TrimSuffix(segments[len(segments)-1], "_VALUE"), "_TYPE")
+	switch name {
+	case "WILDCARD", "ALL":
+		return "*/*"
+	case "APPLICATION_FORM_URLENCODED":
+		return "application/x-www-form-urlencoded"
+	case "SERVER_SENT_EVENTS":
+		return "text/event-stream"
+	case "APPLICATION_PROBLEM_JSON":
+		return "application/problem+json"
+	case "APPLICATION_NDJSON":
+		return "application/x-ndjson"
+	}
+	parts := strings.SplitN(strings.ToLower(name), "_", 2)
+	if len(parts) != 2 {
+		return strings.ToLower(name)
+	}
+	return parts[0] + "/" + strings.ReplaceAll(parts[1], "_", "-")
+}
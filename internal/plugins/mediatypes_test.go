@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaTypes(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+	}{
+		{`"application/xml"`, []string{"application/xml"}},
+		{"MediaType.APPLICATION_JSON", []string{"application/json"}},
+		{"MediaType.TEXT_PLAIN_TYPE", []string{"text/plain"}},
+		{"MediaType.APPLICATION_OCTET_STREAM", []string{"application/octet-stream"}},
+		{"MediaType.APPLICATION_FORM_URLENCODED", []string{"application/x-www-form-urlencoded"}},
+		{"MediaType.SERVER_SENT_EVENTS", []string{"text/event-stream"}},
+		{"MediaType.WILDCARD", []string{"*/*"}},
+		{`{MediaType.APPLICATION_JSON_VALUE, "text/csv"}`, []string{"application/json", "text/csv"}},
+		{"MediaTypeNames.Application.Json, MediaTypeNames.Text.Csv", []string{"application/json", "text/csv"}},
+		{"System.Net.Mime.MediaTypeNames.Application.Octet", []string{"application/octet-stream"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MediaTypes(tt.value))
+		})
+	}
+}
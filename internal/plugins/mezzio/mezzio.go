@@ -0,0 +1,519 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package mezzio provides a plugin for extracting routes from Laminas Mezzio
+// (PSR-15) applications.
+package mezzio
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for Mezzio.
+type Plugin struct{}
+
+// New creates a new Mezzio plugin instance.
+func New() *Plugin {
+	return &Plugin{}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "mezzio"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".php"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "mezzio",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Laminas Mezzio applications",
+		SupportedFrameworks: []string{
+			"mezzio/mezzio",
+			"zendframework/zend-expressive",
+		},
+	}
+}
+
+// Detect checks if Mezzio, or its predecessor Zend Expressive, is used in
+// the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	composerPath := filepath.Join(projectRoot, "composer.json")
+	for _, dep := range []string{"mezzio/mezzio", "zendframework/zend-expressive"} {
+		if found, _ := p.checkFileForDependency(composerPath, dep); found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// anyMethods are the methods a route without a method restriction is
+// documented for.
+var anyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// Regex patterns for Mezzio route extraction
+var (
+	// Matches $app->get(, $app->route(, etc.
+	mezzioRouteRegex = regexp.MustCompile(`\$(\w+)\s*->\s*(get|post|put|patch|delete|any|route)\s*\(`)
+
+	// Matches the 'path' key of a route configuration array
+	mezzioPathKeyRegex = regexp.MustCompile(`['"]path['"]\s*=>\s*['"]([^'"]*)['"]`)
+
+	// Matches the 'middleware' key of a route configuration array
+	mezzioMiddlewareKeyRegex = regexp.MustCompile(`['"]middleware['"]\s*=>\s*`)
+
+	// Matches the 'allowed_methods' key of a route configuration array
+	mezzioMethodsKeyRegex = regexp.MustCompile(`['"]allowed_methods['"]\s*=>\s*`)
+
+	// Matches a class reference like App\Handler\PingHandler::class
+	classRefRegex = regexp.MustCompile(`\\?([\w\\]+)::class`)
+)
+
+// skipVars are common non-route variable names (test code, internal objects)
+// whose get/post calls are not route definitions.
+var skipVars = map[string]bool{
+	"this":     true,
+	"response": true,
+	"request":  true,
+	"client":   true,
+	"http":     true,
+}
+
+// ExtractRoutes parses source files and extracts Mezzio route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		content := util.NormalizeNewlines(string(file.Content))
+		routes = append(routes, p.extractApplicationRoutes(content, file.Path)...)
+		routes = append(routes, p.extractConfigRoutes(content, file.Path)...)
+	}
+
+	return routes, nil
+}
+
+// extractApplicationRoutes extracts routes registered on the application,
+// as in config/routes.php:
+//
+//	$app->get('/api/ping', App\Handler\PingHandler::class, 'api.ping');
+//	$app->route('/users/{id}', UserHandler::class, ['GET', 'PATCH'], 'user');
+func (p *Plugin) extractApplicationRoutes(content, filePath string) []types.Route {
+	var routes []types.Route
+
+	for _, match := range mezzioRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		if skipVars[content[match[2]:match[3]]] {
+			continue
+		}
+
+		args := splitArgs(content, match[1]-1)
+		if len(args) < 2 {
+			continue
+		}
+		// Container and config lookups share get(); route paths are absolute
+		path, ok := phpString(args[0])
+		if !ok || !strings.HasPrefix(path, "/") {
+			continue
+		}
+
+		method := strings.ToUpper(content[match[4]:match[5]])
+		methods := []string{method}
+		switch method {
+		case "ANY":
+			methods = anyMethods
+		case "ROUTE":
+			methods = anyMethods
+			if len(args) > 2 {
+				if listed := parseMethodsList(args[2]); len(listed) > 0 {
+					methods = listed
+				}
+			}
+		}
+
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
+		routes = append(routes, p.createRoutes(methods, path, middlewareHandler(args[1]), filePath, lineNum)...)
+	}
+
+	return routes
+}
+
+// extractConfigRoutes extracts routes from configuration arrays:
+//
+//	'routes' => [
+//	    [
+//	        'path'            => '/api/ping',
+//	        'middleware'      => App\Handler\PingHandler::class,
+//	        'allowed_methods' => ['GET'],
+//	    ],
+//	],
+func (p *Plugin) extractConfigRoutes(content, filePath string) []types.Route {
+	var routes []types.Route
+
+	spans := arraySpans(content)
+	for _, match := range mezzioPathKeyRegex.FindAllStringSubmatchIndex(content, -1) {
+		start, end, ok := innermostSpan(spans, match[0])
+		if !ok {
+			continue
+		}
+		entry := content[start:end]
+
+		// Other configuration uses 'path' keys too, routes have middleware
+		middleware := mezzioMiddlewareKeyRegex.FindStringIndex(entry)
+		if middleware == nil {
+			continue
+		}
+
+		methods := anyMethods
+		if loc := mezzioMethodsKeyRegex.FindStringIndex(entry); loc != nil {
+			if listed := parseMethodsList(phpValue(entry[loc[1]:])); len(listed) > 0 {
+				methods = listed
+			}
+		}
+
+		path := content[match[2]:match[3]]
+		handler := middlewareHandler(phpValue(entry[middleware[1]:]))
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
+		routes = append(routes, p.createRoutes(methods, path, handler, filePath, lineNum)...)
+	}
+
+	return routes
+}
+
+// arraySpan is the [start, end) offsets of a bracketed array literal.
+type arraySpan struct {
+	start, end int
+}
+
+// arraySpans returns the spans of all bracketed array literals, skipping
+// brackets inside string literals.
+func arraySpans(content string) []arraySpan {
+	var spans []arraySpan
+	var stack []int
+	var quote byte
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			stack = append(stack, i)
+		case c == ']' && len(stack) > 0:
+			spans = append(spans, arraySpan{start: stack[len(stack)-1], end: i + 1})
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return spans
+}
+
+// innermostSpan returns the smallest array span containing an offset.
+func innermostSpan(spans []arraySpan, offset int) (int, int, bool) {
+	best := arraySpan{start: -1}
+	for _, s := range spans {
+		if s.start < offset && offset < s.end && (best.start < 0 || s.end-s.start < best.end-best.start) {
+			best = s
+		}
+	}
+	return best.start, best.end, best.start >= 0
+}
+
+// splitArgs returns the top-level arguments of the call whose opening
+// parenthesis is at open.
+func splitArgs(content string, open int) []string {
+	var args []string
+	depth := 0
+	start := open + 1
+	var quote byte
+
+	for i := open; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				if arg := strings.TrimSpace(content[start:i]); arg != "" {
+					args = append(args, arg)
+				}
+				return args
+			}
+		case c == ',' && depth == 1:
+			args = append(args, strings.TrimSpace(content[start:i]))
+			start = i + 1
+		}
+	}
+
+	return args
+}
+
+// phpValue returns the value expression at the start of s, up to the next
+// top-level comma or the end of the enclosing array.
+func phpValue(s string) string {
+	args := splitArgs("("+s, 0)
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// phpString returns the value of a quoted PHP string literal.
+func phpString(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	return s[1 : len(s)-1], true
+}
+
+// middlewareHandler returns the handler of a middleware expression without
+// namespace: the class, service name, or last entry of a pipeline.
+func middlewareHandler(expr string) string {
+	if strings.HasPrefix(expr, "[") {
+		items := splitArgs(expr, 0)
+		if len(items) == 0 {
+			return ""
+		}
+		expr = items[len(items)-1]
+	}
+
+	name := expr
+	if match := classRefRegex.FindStringSubmatch(expr); match != nil {
+		name = match[1]
+	} else if value, ok := phpString(expr); ok {
+		name = value
+	}
+	if idx := strings.LastIndex(name, "\\"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// createRoutes creates the routes of a path for each method and each
+// variant of its optional segments.
+func (p *Plugin) createRoutes(methods []string, path, handler, filePath string, lineNum int) []types.Route {
+	var routes []types.Route
+	for _, method := range methods {
+		for _, variant := range optionalPaths(path) {
+			routes = append(routes, p.createRoute(method, variant, handler, filePath, lineNum))
+		}
+	}
+	return routes
+}
+
+// createRoute creates a route from the extracted information.
+func (p *Plugin) createRoute(method, path, handler, filePath string, lineNum int) types.Route {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	params := extractPathParams(path)
+	fullPath := convertPathParams(path)
+
+	return types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, ""),
+		Tags:        inferTags(fullPath),
+		Parameters:  params,
+		SourceFile:  filePath,
+		SourceLine:  lineNum,
+	}
+}
+
+// optionalPaths expands FastRoute optional segments: /users[/{id}] yields
+// /users and /users/{id}. Brackets inside {param:regex} are not segments.
+func optionalPaths(path string) []string {
+	depth := 0
+	for i, c := range path {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '[':
+			if depth > 0 {
+				continue
+			}
+			end := strings.LastIndex(path, "]")
+			if end < i {
+				return []string{path}
+			}
+			rest := optionalPaths(path[:i] + path[i+1:end] + path[end+1:])
+			return append([]string{path[:i]}, rest...)
+		}
+	}
+	return []string{path}
+}
+
+// methodRegex matches quoted HTTP method names and RequestMethodInterface
+// constants in a methods list.
+var methodRegex = regexp.MustCompile(`['"]([A-Za-z]+)['"]|METHOD_([A-Z]+)`)
+
+// parseMethodsList parses a methods list such as ['GET', 'POST'].
+func parseMethodsList(s string) []string {
+	var methods []string
+	for _, match := range methodRegex.FindAllStringSubmatch(s, -1) {
+		methods = append(methods, strings.ToUpper(match[1]+match[2]))
+	}
+	return methods
+}
+
+// pathParamRegex matches FastRoute path parameters like {id} or {id:\d+}.
+var pathParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::((?:[^{}]|\{[^{}]*\})+))?\}`)
+
+// numericRegexes are the parameter regexes that only match integers.
+var numericRegexes = map[string]bool{`\d+`: true, `[0-9]+`: true}
+
+// convertPathParams strips regexes from path parameters: {id:\d+} -> {id}.
+func convertPathParams(path string) string {
+	return pathParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters, typing numeric regexes as
+// integers.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		schema := &types.Schema{Type: "string"}
+		if numericRegexes[match[2]] {
+			schema.Type = "integer"
+		}
+
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	return params
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}:]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions (Mezzio doesn't have standard schemas).
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	// Mezzio doesn't have a standard schema definition pattern
+	return []types.Schema{}, nil
+}
+
+// Register registers the Mezzio plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
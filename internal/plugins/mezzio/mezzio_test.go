@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package mezzio
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// mezzioRoutesCode is a config/routes.php test fixture.
+const mezzioRoutesCode = `<?php
+
+declare(strict_types=1);
+
+use Mezzio\Application;
+use Mezzio\MiddlewareFactory;
+use Psr\Container\ContainerInterface;
+
+return static function (Application $app, MiddlewareFactory $factory, ContainerInterface $container): void {
+    $app->get('/', App\Handler\HomePageHandler::class, 'home');
+    $app->get('/api/ping', App\Handler\PingHandler::class, 'api.ping');
+    $app->post('/api/users', [
+        Mezzio\Helper\BodyParams\BodyParamsMiddleware::class,
+        App\Handler\CreateUserHandler::class,
+    ], 'api.users.create');
+    $app->route('/api/users/{id:\d+}', App\Handler\UserHandler::class, ['GET', 'PATCH'], 'api.user');
+    $app->get('/api/posts[/{slug}]', App\Handler\PostHandler::class, 'api.posts');
+
+    $config = $container->get('config');
+};
+`
+
+// mezzioConfigCode is a route configuration array test fixture.
+const mezzioConfigCode = `<?php
+
+return [
+    'dependencies' => [
+        'invokables' => [],
+    ],
+    'templates' => [
+        'paths' => ['app' => ['templates/app']],
+    ],
+    'cache' => [
+        'path' => 'data/cache',
+    ],
+    'routes' => [
+        [
+            'name'            => 'orders',
+            'path'            => '/api/orders',
+            'middleware'      => App\Handler\OrderHandler::class,
+            'allowed_methods' => ['GET', 'POST'],
+        ],
+        [
+            'name'       => 'webhook',
+            'path'       => '/webhook',
+            'middleware' => 'webhook.handler',
+        ],
+    ],
+];
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "mezzio", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		composer string
+		expected bool
+	}{
+		{"mezzio", `{"require": {"mezzio/mezzio": "^3.0"}}`, true},
+		{"zend expressive", `{"require": {"zendframework/zend-expressive": "^3.0"}}`, true},
+		{"slim", `{"require": {"slim/slim": "^4.0"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "composer.json"), []byte(tt.composer), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes_Application(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "config/routes.php", Language: "php", Content: []byte(mezzioRoutesCode)},
+	})
+	require.NoError(t, err)
+
+	home := findRoute(routes, "GET", "/")
+	require.NotNil(t, home)
+	assert.Equal(t, "HomePageHandler", home.Handler)
+	assert.Equal(t, 10, home.SourceLine)
+
+	// The last middleware of a pipeline handles the request
+	createUser := findRoute(routes, "POST", "/api/users")
+	require.NotNil(t, createUser)
+	assert.Equal(t, "CreateUserHandler", createUser.Handler)
+
+	getUser := findRoute(routes, "GET", "/api/users/{id}")
+	require.NotNil(t, getUser)
+	assert.Equal(t, "integer", getUser.Parameters[0].Schema.Type)
+	require.NotNil(t, findRoute(routes, "PATCH", "/api/users/{id}"))
+
+	require.NotNil(t, findRoute(routes, "GET", "/api/posts"))
+	require.NotNil(t, findRoute(routes, "GET", "/api/posts/{slug}"))
+
+	assert.Len(t, routes, 7)
+}
+
+func TestPlugin_ExtractRoutes_Config(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "config/autoload/routes.global.php", Language: "php", Content: []byte(mezzioConfigCode)},
+	})
+	require.NoError(t, err)
+
+	orders := findRoute(routes, "GET", "/api/orders")
+	require.NotNil(t, orders)
+	assert.Equal(t, "OrderHandler", orders.Handler)
+	assert.Equal(t, []string{"orders"}, orders.Tags)
+	require.NotNil(t, findRoute(routes, "POST", "/api/orders"))
+
+	// Routes without allowed_methods accept any method
+	webhook := findRoute(routes, "DELETE", "/webhook")
+	require.NotNil(t, webhook)
+	assert.Equal(t, "webhook.handler", webhook.Handler)
+
+	assert.Len(t, routes, 7)
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,806 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package micro provides a plugin for extracting routes from micro
+// services. Their routes are registered with microrouter, or matched by
+// hand in the request handler by comparing req.url and req.method, which
+// are recognized by pattern.
+package micro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// routerMethods maps microrouter's route functions to HTTP methods.
+var routerMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"patch":   "PATCH",
+	"del":     "DELETE",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// httpMethods are the methods a handler may compare req.method with.
+var httpMethods = map[string]bool{
+	"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true,
+	"HEAD": true, "OPTIONS": true, "TRACE": true,
+}
+
+// anyMethod are the methods documented for URLs matched without checking
+// the method.
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// bodyParsers maps micro's body parsing helpers to the media types they
+// read.
+var bodyParsers = map[string]string{
+	"json":   "application/json",
+	"text":   "text/plain",
+	"buffer": "application/octet-stream",
+}
+
+// helpers are micro functions that are not handlers when called in a
+// matched branch.
+var helpers = map[string]bool{
+	"send":        true,
+	"sendError":   true,
+	"createError": true,
+	"json":        true,
+	"text":        true,
+	"buffer":      true,
+	"parse":       true,
+}
+
+// Plugin implements the FrameworkPlugin interface for micro.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new micro plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "micro"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js", ".mts", ".mjs", ".cjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "micro",
+		Version:     "1.0.0",
+		Description: "Extracts routes from micro services using microrouter or matching req.url by hand",
+		SupportedFrameworks: []string{
+			"micro",
+			"microrouter",
+		},
+	}
+}
+
+// Detect checks if micro is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	for _, name := range []string{"micro", "microrouter"} {
+		if _, ok := pkg.Dependencies[name]; ok {
+			return true, nil
+		}
+		if _, ok := pkg.DevDependencies[name]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractRoutes parses source files and extracts the routes of microrouter
+// routers and of handlers matching the URL by hand.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" && f.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+
+		e := &extraction{
+			content:  pf.Content,
+			file:     pf.Path,
+			patterns: make(map[string]*sitter.Node),
+			seen:     make(map[string]bool),
+		}
+		e.collectDecls(pf.RootNode)
+		if importsRouter(pf.RootNode, pf.Content) {
+			e.routerRoutes(pf.RootNode)
+		}
+		e.matchedRoutes(pf.RootNode)
+		routes = append(routes, e.routes...)
+
+		pf.Close()
+	}
+
+	return routes, nil
+}
+
+// extraction extracts the routes of one file.
+type extraction struct {
+	content []byte
+	file    string
+	routes  []types.Route
+
+	// patterns maps the variables holding URL patterns (new
+	// UrlPattern('/users/:id')), the results of matching them and
+	// microrouter namespaces to the expressions they hold
+	patterns map[string]*sitter.Node
+
+	// seen are the methods and paths already extracted, as "GET /path"
+	seen map[string]bool
+}
+
+// collectDecls indexes the const declarations of URL patterns, match
+// results and namespaces.
+func (e *extraction) collectDecls(root *sitter.Node) {
+	parser.Walk(root, func(node *sitter.Node) bool {
+		if node.Type() != "variable_declarator" {
+			return true
+		}
+		name := node.ChildByFieldName("name")
+		value := parser.UnwrapExpression(node.ChildByFieldName("value"))
+		if name == nil || value == nil || name.Type() != "identifier" {
+			return true
+		}
+		if value.Type() == "await_expression" && value.NamedChildCount() > 0 {
+			value = parser.UnwrapExpression(value.NamedChild(0))
+		}
+		switch value.Type() {
+		case "new_expression", "call_expression":
+			e.patterns[name.Content(e.content)] = value
+		}
+		return true
+	})
+}
+
+// importsRouter reports whether a file imports microrouter.
+func importsRouter(root *sitter.Node, content []byte) bool {
+	found := false
+	parser.Walk(root, func(node *sitter.Node) bool {
+		if found {
+			return false
+		}
+		if node.Type() == "string" && parser.StringValue(node, content) == "microrouter" {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// routerRoutes extracts the routes of microrouter route functions:
+// router(get('/users/:id', handler)), with the prefixes of enclosing
+// withNamespace('/api')(...) calls, also through namespace variables.
+func (e *extraction) routerRoutes(root *sitter.Node) {
+	parser.Walk(root, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" {
+			return true
+		}
+		fn := node.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "identifier" {
+			return true
+		}
+		method, ok := routerMethods[fn.Content(e.content)]
+		if !ok {
+			return true
+		}
+		args := parser.CallArguments(node)
+		if len(args) < 2 {
+			return true
+		}
+		rawPath, ok := parser.StringLiteral(args[0], e.content)
+		if !ok || !strings.HasPrefix(rawPath, "/") {
+			return true
+		}
+
+		for ancestor := node.Parent(); ancestor != nil; ancestor = ancestor.Parent() {
+			if ancestor.Type() != "call_expression" {
+				continue
+			}
+			namespace := ancestor.ChildByFieldName("function")
+			if namespace != nil && namespace.Type() == "identifier" {
+				// const api = withNamespace('/api')
+				namespace = e.patterns[namespace.Content(e.content)]
+			}
+			if namespace == nil || namespace.Type() != "call_expression" || parser.CalledFunction(namespace, e.content) != "withNamespace" {
+				continue
+			}
+			if nsArgs := parser.CallArguments(namespace); len(nsArgs) > 0 {
+				if prefix, ok := parser.StringLiteral(nsArgs[0], e.content); ok {
+					rawPath = joinPath(prefix, rawPath)
+				}
+			}
+		}
+
+		handler := parser.UnwrapExpression(args[len(args)-1])
+		e.add(method, rawPath, handlerName(handler, e.content), node, bodyTypes(handler, e.content))
+		return true
+	})
+}
+
+// matchedRoutes extracts the routes of branches matching the URL by hand:
+// if statements comparing req.url (or a pathname parsed from it) with a
+// path, checking url.startsWith(prefix) or matching a URL pattern, and
+// the cases of switch statements on the URL. The methods are those the
+// branch, or the branches enclosing or within it, compare req.method with.
+func (e *extraction) matchedRoutes(root *sitter.Node) {
+	parser.Walk(root, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "if_statement":
+			condition := node.ChildByFieldName("condition")
+			consequence := node.ChildByFieldName("consequence")
+			if condition == nil || consequence == nil {
+				return true
+			}
+			matches, methods := e.conditionMatches(condition)
+			if len(matches) == 0 || e.matchesWithin(consequence) {
+				// Conditions around other URL checks only guard them
+				return true
+			}
+			if len(methods) == 0 {
+				methods = e.methods(node, consequence)
+			}
+			for _, m := range matches {
+				for _, method := range methods {
+					e.add(method, m, e.branchHandler(consequence), node, bodyTypes(consequence, e.content))
+				}
+			}
+		case "switch_statement":
+			value := node.ChildByFieldName("value")
+			body := node.ChildByFieldName("body")
+			if value == nil || body == nil || !isURL(value, e.content) {
+				return true
+			}
+			var paths []string
+			for i := 0; i < int(body.NamedChildCount()); i++ {
+				c := body.NamedChild(i)
+				if c.Type() != "switch_case" {
+					continue
+				}
+				if caseValue := c.ChildByFieldName("value"); caseValue != nil {
+					if p, ok := parser.StringLiteral(caseValue, e.content); ok && strings.HasPrefix(p, "/") {
+						paths = append(paths, p)
+					}
+				}
+				if c.NamedChildCount() < 2 {
+					// Empty cases fall through to the next
+					continue
+				}
+				for _, p := range paths {
+					for _, method := range e.methods(c, c) {
+						e.add(method, p, e.branchHandler(c), c, bodyTypes(c, e.content))
+					}
+				}
+				paths = nil
+			}
+		}
+		return true
+	})
+}
+
+// conditionMatches returns the paths a condition matches the URL with, and
+// the methods it compares req.method with.
+func (e *extraction) conditionMatches(condition *sitter.Node) (paths, methods []string) {
+	e.walkCondition(condition, func(leaf *sitter.Node) {
+		switch leaf.Type() {
+		case "binary_expression":
+			left, right := leaf.ChildByFieldName("left"), leaf.ChildByFieldName("right")
+			if left == nil || right == nil {
+				return
+			}
+			for _, pair := range [][2]*sitter.Node{{left, right}, {right, left}} {
+				literal, ok := parser.StringLiteral(pair[0], e.content)
+				if !ok {
+					continue
+				}
+				switch {
+				case strings.HasPrefix(literal, "/") && isURL(pair[1], e.content):
+					paths = append(paths, literal)
+				case httpMethods[literal] && isMethod(pair[1], e.content):
+					methods = append(methods, literal)
+				}
+			}
+		case "call_expression":
+			switch parser.CalledFunction(leaf, e.content) {
+			case "startsWith":
+				receiver := leaf.ChildByFieldName("function").ChildByFieldName("object")
+				args := parser.CallArguments(leaf)
+				if receiver != nil && len(args) == 1 && isURL(receiver, e.content) {
+					if prefix, ok := parser.StringLiteral(args[0], e.content); ok && strings.HasPrefix(prefix, "/") {
+						paths = append(paths, strings.TrimSuffix(prefix, "/")+"/*")
+					}
+				}
+			case "includes":
+				// ['GET', 'HEAD'].includes(req.method)
+				list := parser.UnwrapExpression(leaf.ChildByFieldName("function").ChildByFieldName("object"))
+				args := parser.CallArguments(leaf)
+				if list != nil && list.Type() == "array" && len(args) == 1 && isMethod(args[0], e.content) {
+					for i := 0; i < int(list.NamedChildCount()); i++ {
+						if m, ok := parser.StringLiteral(list.NamedChild(i), e.content); ok && httpMethods[m] {
+							methods = append(methods, m)
+						}
+					}
+				}
+			default:
+				p, m := e.patternMatch(leaf, 0)
+				paths = append(paths, p...)
+				methods = append(methods, m...)
+			}
+		case "identifier":
+			// const match = pattern.match(req.url); if (match) ...
+			if value, ok := e.patterns[leaf.Content(e.content)]; ok && value.Type() == "call_expression" {
+				p, m := e.patternMatch(value, 0)
+				paths = append(paths, p...)
+				methods = append(methods, m...)
+			}
+		}
+	})
+	return paths, methods
+}
+
+// patternMatch returns the path and methods of a URL pattern match:
+// match(req, '/users/:id', 'GET') as in micro-route, or pattern.match(url)
+// for a new UrlPattern('/users/:id').
+func (e *extraction) patternMatch(call *sitter.Node, depth int) (paths, methods []string) {
+	if depth > 2 || call.Type() != "call_expression" {
+		return nil, nil
+	}
+	name := parser.CalledFunction(call, e.content)
+	if name != "match" && name != "matches" {
+		return nil, nil
+	}
+
+	fn := call.ChildByFieldName("function")
+	if fn.Type() == "member_expression" {
+		receiver := parser.UnwrapExpression(fn.ChildByFieldName("object"))
+		if receiver.Type() == "identifier" {
+			if value, ok := e.patterns[receiver.Content(e.content)]; ok {
+				receiver = value
+			}
+		}
+		if receiver.Type() == "new_expression" {
+			if args := parser.CallArguments(receiver); len(args) > 0 {
+				if p, ok := parser.StringLiteral(args[0], e.content); ok && strings.HasPrefix(p, "/") {
+					paths = append(paths, p)
+				}
+			}
+			return paths, nil
+		}
+	}
+
+	for _, arg := range parser.CallArguments(call) {
+		for _, s := range parser.StringLiterals(arg, e.content) {
+			switch {
+			case strings.HasPrefix(s, "/"):
+				paths = append(paths, s)
+			case httpMethods[strings.ToUpper(s)]:
+				methods = append(methods, strings.ToUpper(s))
+			}
+		}
+	}
+	return paths, methods
+}
+
+// walkCondition calls fn with the comparisons, calls and identifiers
+// combined by && and || in a condition.
+func (e *extraction) walkCondition(node *sitter.Node, fn func(*sitter.Node)) {
+	node = parser.UnwrapExpression(node)
+	if node == nil {
+		return
+	}
+	if node.Type() == "binary_expression" {
+		operator := node.ChildByFieldName("operator")
+		if operator == nil {
+			return
+		}
+		switch operator.Content(e.content) {
+		case "&&", "||":
+			e.walkCondition(node.ChildByFieldName("left"), fn)
+			e.walkCondition(node.ChildByFieldName("right"), fn)
+		case "===", "==":
+			fn(node)
+		}
+		return
+	}
+	switch node.Type() {
+	case "call_expression", "identifier":
+		fn(node)
+	}
+}
+
+// matchesWithin reports whether a branch matches the URL again within.
+func (e *extraction) matchesWithin(branch *sitter.Node) bool {
+	found := false
+	parser.Walk(branch, func(node *sitter.Node) bool {
+		if found {
+			return false
+		}
+		switch node.Type() {
+		case "if_statement":
+			if condition := node.ChildByFieldName("condition"); condition != nil {
+				if paths, _ := e.conditionMatches(condition); len(paths) > 0 {
+					found = true
+				}
+			}
+		case "switch_statement":
+			if value := node.ChildByFieldName("value"); value != nil && isURL(value, e.content) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// methods returns the methods of a matched branch: those the branches
+// enclosing node check, or else those the branch checks within, or else
+// every method.
+func (e *extraction) methods(node, branch *sitter.Node) []string {
+	for child, ancestor := node, node.Parent(); ancestor != nil; child, ancestor = ancestor, ancestor.Parent() {
+		switch ancestor.Type() {
+		case "if_statement":
+			consequence := ancestor.ChildByFieldName("consequence")
+			if consequence == nil || !sameNode(consequence, child) {
+				continue
+			}
+			if _, methods := e.conditionMatches(ancestor.ChildByFieldName("condition")); len(methods) > 0 {
+				return methods
+			}
+		case "switch_case":
+			if methods := e.caseMethods(ancestor); len(methods) > 0 {
+				return methods
+			}
+		}
+	}
+
+	var methods []string
+	parser.Walk(branch, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "if_statement":
+			_, m := e.conditionMatches(node.ChildByFieldName("condition"))
+			methods = append(methods, m...)
+		case "switch_case":
+			methods = append(methods, e.caseMethods(node)...)
+		}
+		return true
+	})
+	if methods = unique(methods); len(methods) > 0 {
+		return methods
+	}
+	return anyMethod
+}
+
+// caseMethods returns the method of a case of a switch on req.method.
+func (e *extraction) caseMethods(c *sitter.Node) []string {
+	switchStatement := c.Parent()
+	if switchStatement != nil {
+		switchStatement = switchStatement.Parent()
+	}
+	if switchStatement == nil || switchStatement.Type() != "switch_statement" {
+		return nil
+	}
+	if value := switchStatement.ChildByFieldName("value"); value == nil || !isMethod(value, e.content) {
+		return nil
+	}
+	if caseValue := c.ChildByFieldName("value"); caseValue != nil {
+		if m, ok := parser.StringLiteral(caseValue, e.content); ok && httpMethods[m] {
+			return []string{m}
+		}
+	}
+	return nil
+}
+
+// branchHandler names the function a matched branch hands the request to,
+// as in return getUsers(req, res), skipping micro's helpers and response
+// methods.
+func (e *extraction) branchHandler(branch *sitter.Node) string {
+	handler := ""
+	parser.Walk(branch, func(node *sitter.Node) bool {
+		if handler != "" {
+			return false
+		}
+		switch node.Type() {
+		case "function_expression", "arrow_function", "function_declaration":
+			return false
+		case "call_expression":
+			fn := node.ChildByFieldName("function")
+			if fn == nil || (fn.Type() != "identifier" && fn.Type() != "member_expression") {
+				return true
+			}
+			name := fn.Content(e.content)
+			if helpers[name] || strings.HasPrefix(name, "res.") || strings.HasPrefix(name, "req.") || strings.Contains(name, "console.") {
+				return true
+			}
+			for _, arg := range parser.CallArguments(node) {
+				if arg.Content(e.content) == "req" {
+					handler = name
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return handler
+}
+
+// add adds a route, once per method and path.
+func (e *extraction) add(method, rawPath, handler string, node *sitter.Node, bodies []string) {
+	openAPIPath := convertPath(rawPath)
+	key := method + " " + openAPIPath
+	if e.seen[key] {
+		return
+	}
+	e.seen[key] = true
+
+	route := types.Route{
+		Method:      method,
+		Path:        openAPIPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, openAPIPath, handler),
+		Tags:        inferTags(openAPIPath),
+		Parameters:  extractPathParams(openAPIPath),
+		SourceFile:  e.file,
+		SourceLine:  int(node.StartPoint().Row) + 1,
+	}
+	if plugins.IsCatchAll(rawPath) {
+		plugins.MarkWildcard(&route)
+	}
+	if len(bodies) > 0 {
+		route.RequestBody = &types.RequestBody{Required: true, Content: make(map[string]types.MediaType)}
+		for _, mediaType := range bodies {
+			route.RequestBody.Content[mediaType] = types.MediaType{Schema: &types.Schema{}}
+		}
+	}
+	e.routes = append(e.routes, route)
+}
+
+// bodyTypes returns the media types of the request bodies a handler reads
+// with micro's json(req), text(req) and buffer(req).
+func bodyTypes(node *sitter.Node, content []byte) []string {
+	var mediaTypes []string
+	parser.Walk(node, func(n *sitter.Node) bool {
+		if n.Type() != "call_expression" {
+			return true
+		}
+		fn := n.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "identifier" {
+			return true
+		}
+		if mediaType, ok := bodyParsers[fn.Content(content)]; ok {
+			if args := parser.CallArguments(n); len(args) > 0 && args[0].Content(content) == "req" {
+				mediaTypes = append(mediaTypes, mediaType)
+			}
+		}
+		return true
+	})
+	return unique(mediaTypes)
+}
+
+// ExtractSchemas extracts TypeScript interfaces and type aliases.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		pf.Close()
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// urlNameRegex matches the names of expressions holding a request URL or
+// its path: req.url, pathname, url.pathname, path.
+var urlNameRegex = regexp.MustCompile(`(?i)(url|path|pathname)$`)
+
+// isURL reports whether an expression holds the request URL or its path.
+func isURL(node *sitter.Node, content []byte) bool {
+	node = parser.UnwrapExpression(node)
+	switch node.Type() {
+	case "identifier", "member_expression":
+		return urlNameRegex.MatchString(node.Content(content))
+	}
+	return false
+}
+
+// isMethod reports whether an expression holds the request method.
+func isMethod(node *sitter.Node, content []byte) bool {
+	node = parser.UnwrapExpression(node)
+	switch node.Type() {
+	case "identifier", "member_expression":
+		return strings.HasSuffix(strings.ToLower(node.Content(content)), "method")
+	}
+	return false
+}
+
+// colonParamRegex matches path parameters in the format :param.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPath converts a path or URL pattern to OpenAPI format, dropping
+// any query string.
+func convertPath(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// handlerName names a route handler: a function identifier or member
+// expression. Inline functions have no name.
+func handlerName(node *sitter.Node, content []byte) string {
+	switch node = parser.UnwrapExpression(node); node.Type() {
+	case "identifier", "member_expression":
+		return node.Content(content)
+	}
+	return ""
+}
+
+// joinPath joins a namespace prefix and a path.
+func joinPath(prefix, p string) string {
+	joined := "/" + strings.Trim(prefix, "/") + "/" + strings.TrimPrefix(p, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return strings.ReplaceAll(joined, "//", "/")
+}
+
+// generateOperationID generates an operation ID from the handler name, or
+// else from the method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		parts := strings.Split(handler, ".")
+		return strings.ToLower(method) + cases.Title(language.English, cases.NoLower).String(parts[len(parts)-1])
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	words := strings.Fields(strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(path))
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+	return sb.String()
+}
+
+// inferTags tags a route with its first static path segment that is not
+// an API or version prefix.
+func inferTags(path string) []string {
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		switch {
+		case segment == "", strings.HasPrefix(segment, "{"), segment == "api", versionSegmentRegex.MatchString(segment):
+			continue
+		}
+		return []string{segment}
+	}
+	return nil
+}
+
+// versionSegmentRegex matches version path segments like v1.
+var versionSegmentRegex = regexp.MustCompile(`^v\d+$`)
+
+// unique returns the distinct strings of a slice in order.
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// sameNode reports whether two nodes are the same node of a tree.
+func sameNode(a, b *sitter.Node) bool {
+	return a.StartByte() == b.StartByte() && a.EndByte() == b.EndByte() && a.Type() == b.Type()
+}
+
+// Register registers the micro plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
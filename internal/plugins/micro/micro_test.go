@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package micro
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// manualFixture is a micro handler matching the URL by hand.
+const manualFixture = `
+const { send, json } = require('micro');
+const { parse } = require('url');
+const UrlPattern = require('url-pattern');
+
+const userPattern = new UrlPattern('/users/:id');
+
+module.exports = async (req, res) => {
+  const { pathname } = parse(req.url);
+
+  if (pathname === '/users' && req.method === 'GET') {
+    return send(res, 200, await listUsers(req));
+  }
+
+  if (req.url === '/users' && req.method === 'POST') {
+    const body = await json(req);
+    return send(res, 201, await createUser(req, body));
+  }
+
+  const match = userPattern.match(pathname);
+  if (match) {
+    switch (req.method) {
+      case 'GET':
+        return getUser(req, res, match.id);
+      case 'DELETE':
+        return deleteUser(req, res, match.id);
+    }
+  }
+
+  if (req.method === 'GET') {
+    switch (req.url) {
+      case '/status':
+      case '/healthz':
+        return send(res, 200, { ok: true });
+    }
+  }
+
+  if (req.url.startsWith('/static/')) {
+    return serveStatic(req, res);
+  }
+
+  if (pathname === '/echo') {
+    return json(req);
+  }
+
+  if (req.url.startsWith('/admin')) {
+    if (req.url === '/admin/stats' && ['GET', 'HEAD'].includes(req.method)) {
+      return stats(req, res);
+    }
+  }
+
+  if (req.url !== '/') {
+    return send(res, 404);
+  }
+};
+`
+
+// routerFixture is a microrouter service.
+const routerFixture = `
+const { router, get, post, del, withNamespace } = require('microrouter');
+
+const api = withNamespace('/api');
+
+module.exports = router(
+  get('/', () => 'Welcome'),
+  api(get('/todos/:id', todos.show)),
+  withNamespace('/api/v2')(post('/todos', createTodo), del('/todos/:id', removeTodo)),
+);
+`
+
+func extract(t *testing.T, files ...scanner.SourceFile) []types.Route {
+	t.Helper()
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	return routes
+}
+
+func source(path, content string) scanner.SourceFile {
+	return scanner.SourceFile{Path: path, Language: "javascript", Content: []byte(content)}
+}
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"micro": "^10.0.1"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_ManualMatching(t *testing.T) {
+	routes := extract(t, source("index.js", manualFixture))
+
+	list := findRoute(routes, "GET", "/users")
+	require.NotNil(t, list)
+	assert.Equal(t, "listUsers", list.Handler)
+	assert.Equal(t, "getListUsers", list.OperationID)
+	assert.Equal(t, []string{"users"}, list.Tags)
+	assert.Equal(t, "index.js", list.SourceFile)
+	assert.Nil(t, list.RequestBody)
+
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.Equal(t, "createUser", create.Handler)
+	require.NotNil(t, create.RequestBody)
+	assert.Contains(t, create.RequestBody.Content, "application/json", "json(req) reads a JSON body")
+
+	show := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, show, "URL patterns are matched, with methods from a switch on req.method")
+	assert.Equal(t, "getUser", show.Handler)
+	require.Len(t, show.Parameters, 1)
+	assert.NotNil(t, findRoute(routes, "DELETE", "/users/{id}"))
+	assert.Nil(t, findRoute(routes, "POST", "/users/{id}"))
+
+	assert.NotNil(t, findRoute(routes, "GET", "/status"), "enclosing method checks apply to switch cases")
+	assert.NotNil(t, findRoute(routes, "GET", "/healthz"), "fall-through cases share their branch")
+	assert.Nil(t, findRoute(routes, "POST", "/status"))
+
+	static := findRoute(routes, "GET", "/static/{path}")
+	require.NotNil(t, static, "prefix checks match any method")
+	assert.Equal(t, true, static.Extensions["x-wildcard"])
+	assert.NotNil(t, findRoute(routes, "DELETE", "/static/{path}"))
+
+	echo := findRoute(routes, "POST", "/echo")
+	require.NotNil(t, echo)
+	assert.NotNil(t, echo.RequestBody)
+
+	assert.Nil(t, findRoute(routes, "GET", "/admin/{path}"), "prefix checks guarding other checks are not routes")
+	assert.NotNil(t, findRoute(routes, "GET", "/admin/stats"))
+	assert.NotNil(t, findRoute(routes, "HEAD", "/admin/stats"))
+	assert.Nil(t, findRoute(routes, "POST", "/admin/stats"))
+
+	assert.Nil(t, findRoute(routes, "GET", "/"), "negated comparisons are not matches")
+}
+
+func TestPlugin_ExtractRoutes_Microrouter(t *testing.T) {
+	routes := extract(t, source("router.js", routerFixture))
+
+	root := findRoute(routes, "GET", "/")
+	require.NotNil(t, root)
+	assert.Empty(t, root.Handler)
+
+	create := findRoute(routes, "POST", "/api/v2/todos")
+	require.NotNil(t, create, "withNamespace prefixes the routes it wraps")
+	assert.Equal(t, "createTodo", create.Handler)
+	assert.NotNil(t, findRoute(routes, "DELETE", "/api/v2/todos/{id}"))
+
+	show := findRoute(routes, "GET", "/api/todos/{id}")
+	require.NotNil(t, show, "namespaces bound to variables are followed")
+	assert.Equal(t, "todos.show", show.Handler)
+}
+
+func TestPlugin_ExtractRoutes_RouterFunctionsNeedMicrorouter(t *testing.T) {
+	routes := extract(t, source("client.js", `const { get } = require('axios'); get('/users', handler);`))
+	assert.Empty(t, routes)
+}
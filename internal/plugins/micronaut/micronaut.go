@@ -17,6 +17,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -137,7 +138,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 // extractRoutesFromFile extracts routes from a single Java/Kotlin file.
 func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	content := string(file.Content)
+	content := util.NormalizeNewlines(string(file.Content))
 	lines := strings.Split(content, "\n")
 
 	// Find controller base path
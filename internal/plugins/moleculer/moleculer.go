@@ -0,0 +1,740 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package moleculer provides a plugin for extracting routes from Moleculer
+// API gateway (moleculer-web) services.
+package moleculer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// anyMethod are the methods an alias without a method is documented under;
+// moleculer-web matches it for every method.
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// restActions are the aliases a "REST path" alias expands to, with the
+// action of the target service each one calls.
+var restActions = []struct {
+	method string
+	suffix string
+	action string
+}{
+	{"GET", "", "list"},
+	{"GET", "/:id", "get"},
+	{"POST", "", "create"},
+	{"PUT", "/:id", "update"},
+	{"PATCH", "/:id", "patch"},
+	{"DELETE", "/:id", "remove"},
+}
+
+// Plugin implements the FrameworkPlugin interface for Moleculer.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new Moleculer plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "moleculer"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".js", ".ts", ".mjs", ".cjs", ".mts"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "moleculer",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Moleculer API gateway aliases and action REST definitions",
+		SupportedFrameworks: []string{
+			"moleculer-web",
+		},
+	}
+}
+
+// Detect checks if moleculer-web is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["moleculer-web"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["moleculer-web"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// action is a service action and its declared REST routes and parameters.
+type action struct {
+	name   string
+	rest   []restRoute
+	params map[string]*paramRule
+}
+
+// restRoute is a method and path declared by an action's rest property.
+type restRoute struct {
+	method string
+	path   string
+}
+
+// paramRule is a fastest-validator rule of an action parameter.
+type paramRule struct {
+	schema   *types.Schema
+	optional bool
+}
+
+// service is a Moleculer service schema.
+type service struct {
+	// name is the full service name, with its version prefix (v2.users)
+	name string
+
+	// restPath is the service's base path for action rest routes
+	restPath string
+
+	actions map[string]*action
+
+	// gateway settings of an API gateway service
+	gatewayPath string
+	routes      []*sitter.Node
+
+	file    string
+	content []byte
+}
+
+// ExtractRoutes parses source files and extracts the routes the API
+// gateways expose: their aliases and, for routes with autoAliases, the
+// rest routes of every action.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var parsed []*parser.ParsedTSFile
+	defer func() {
+		for _, pf := range parsed {
+			pf.Close()
+		}
+	}()
+
+	services := make(map[string]*service)
+	var gateways []*service
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, pf)
+
+		for _, svc := range p.findServices(pf.RootNode, file.Path, file.Content) {
+			services[svc.name] = svc
+			if len(svc.routes) > 0 {
+				gateways = append(gateways, svc)
+			}
+		}
+	}
+
+	var routes []types.Route
+	for _, gw := range gateways {
+		for _, route := range gw.routes {
+			routes = append(routes, p.routesOf(gw, route, services)...)
+		}
+	}
+
+	return routes, nil
+}
+
+// findServices finds the service schemas in a file: object literals with a
+// name and actions or settings.
+func (p *Plugin) findServices(root *sitter.Node, file string, content []byte) []*service {
+	var services []*service
+	parser.Walk(root, func(node *sitter.Node) bool {
+		if node.Type() != "object" {
+			return true
+		}
+		nameNode := parser.ObjectProperty(node, "name", content)
+		if nameNode == nil || (parser.ObjectProperty(node, "actions", content) == nil && parser.ObjectProperty(node, "settings", content) == nil) {
+			return true
+		}
+		name, ok := p.tsParser.ExtractStringLiteral(nameNode, content)
+		if !ok {
+			return true
+		}
+
+		svc := &service{
+			name:    name,
+			actions: make(map[string]*action),
+			file:    file,
+			content: content,
+		}
+		if version := parser.ObjectProperty(node, "version", content); version != nil {
+			v := strings.Trim(version.Content(content), `"'`)
+			if _, err := strconv.Atoi(v); err == nil {
+				v = "v" + v
+			}
+			svc.name = v + "." + name
+		}
+		svc.restPath = "/" + strings.ReplaceAll(svc.name, ".", "/")
+
+		if settings := parser.ObjectProperty(node, "settings", content); settings != nil && settings.Type() == "object" {
+			if rest := parser.ObjectProperty(settings, "rest", content); rest != nil {
+				if value, ok := p.tsParser.ExtractStringLiteral(rest, content); ok {
+					svc.restPath = value
+				}
+			}
+			if routes := parser.ObjectProperty(settings, "routes", content); routes != nil && routes.Type() == "array" {
+				if path := parser.ObjectProperty(settings, "path", content); path != nil {
+					svc.gatewayPath, _ = p.tsParser.ExtractStringLiteral(path, content)
+				}
+				for i := 0; i < int(routes.NamedChildCount()); i++ {
+					if route := routes.NamedChild(i); route.Type() == "object" {
+						svc.routes = append(svc.routes, route)
+					}
+				}
+			}
+		}
+
+		if actions := parser.ObjectProperty(node, "actions", content); actions != nil && actions.Type() == "object" {
+			for i := 0; i < int(actions.NamedChildCount()); i++ {
+				if act := p.parseAction(actions.NamedChild(i), content); act != nil {
+					svc.actions[act.name] = act
+				}
+			}
+		}
+
+		services = append(services, svc)
+		return false
+	})
+	return services
+}
+
+// parseAction parses an action definition: a handler function or an object
+// with rest, params and handler properties.
+func (p *Plugin) parseAction(node *sitter.Node, content []byte) *action {
+	switch node.Type() {
+	case "method_definition":
+		if name := node.ChildByFieldName("name"); name != nil {
+			return &action{name: strings.Trim(name.Content(content), `"'`)}
+		}
+	case "pair":
+		key := node.ChildByFieldName("key")
+		value := node.ChildByFieldName("value")
+		if key == nil || value == nil {
+			return nil
+		}
+		act := &action{name: strings.Trim(key.Content(content), `"'`)}
+		if value.Type() != "object" {
+			return act
+		}
+		if rest := parser.ObjectProperty(value, "rest", content); rest != nil {
+			act.rest = p.parseRest(rest, content)
+		}
+		if params := parser.ObjectProperty(value, "params", content); params != nil && params.Type() == "object" {
+			act.params = p.parseParams(params, content)
+		}
+		return act
+	}
+	return nil
+}
+
+// parseRest reads an action's rest property: "GET /:id", "/:id" (every
+// method), { method, path }, or an array of those.
+func (p *Plugin) parseRest(node *sitter.Node, content []byte) []restRoute {
+	switch node.Type() {
+	case "array":
+		var routes []restRoute
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			routes = append(routes, p.parseRest(node.NamedChild(i), content)...)
+		}
+		return routes
+	case "object":
+		route := restRoute{}
+		if method := parser.ObjectProperty(node, "method", content); method != nil {
+			route.method, _ = p.tsParser.ExtractStringLiteral(method, content)
+		}
+		if path := parser.ObjectProperty(node, "path", content); path != nil {
+			route.path, _ = p.tsParser.ExtractStringLiteral(path, content)
+		}
+		return []restRoute{route}
+	}
+	if value, ok := p.tsParser.ExtractStringLiteral(node, content); ok {
+		method, path := splitAlias(value)
+		return []restRoute{{method: method, path: path}}
+	}
+	return nil
+}
+
+// parseParams converts an action's fastest-validator params to schemas.
+func (p *Plugin) parseParams(node *sitter.Node, content []byte) map[string]*paramRule {
+	params := make(map[string]*paramRule)
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		pair := node.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		key := pair.ChildByFieldName("key")
+		value := pair.ChildByFieldName("value")
+		if key == nil || value == nil {
+			continue
+		}
+		name := strings.Trim(key.Content(content), `"'`)
+		if strings.HasPrefix(name, "$$") {
+			continue
+		}
+		if rule := p.parseRule(value, content); rule != nil {
+			params[name] = rule
+		}
+	}
+	return params
+}
+
+// parseRule converts a fastest-validator rule: a shorthand string
+// ("string|min:3|optional"), a rule object, or an array of alternatives.
+func (p *Plugin) parseRule(node *sitter.Node, content []byte) *paramRule {
+	if shorthand, ok := p.tsParser.ExtractStringLiteral(node, content); ok {
+		parts := strings.Split(shorthand, "|")
+		rule := &paramRule{schema: validatorSchema(strings.TrimSpace(parts[0]))}
+		for _, modifier := range parts[1:] {
+			if strings.TrimSpace(modifier) == "optional" || strings.TrimSpace(modifier) == "optional:true" {
+				rule.optional = true
+			}
+		}
+		return rule
+	}
+
+	switch node.Type() {
+	case "array":
+		if node.NamedChildCount() > 0 {
+			return p.parseRule(node.NamedChild(0), content)
+		}
+	case "object":
+		ruleType := "any"
+		if t := parser.ObjectProperty(node, "type", content); t != nil {
+			ruleType, _ = p.tsParser.ExtractStringLiteral(t, content)
+		}
+		if ruleType == "forbidden" {
+			return nil
+		}
+		rule := &paramRule{schema: validatorSchema(ruleType)}
+		if optional := parser.ObjectProperty(node, "optional", content); optional != nil && optional.Type() == "true" {
+			rule.optional = true
+		}
+		if def := parser.ObjectProperty(node, "default", content); def != nil {
+			rule.optional = true
+		}
+		if values := parser.ObjectProperty(node, "values", content); values != nil && ruleType == "enum" {
+			if enum, ok := p.tsParser.ResolveStringArray(values, content); ok {
+				for _, v := range enum {
+					rule.schema.Enum = append(rule.schema.Enum, v)
+				}
+			}
+		}
+		if items := parser.ObjectProperty(node, "items", content); items != nil && rule.schema.Type == "array" {
+			if item := p.parseRule(items, content); item != nil {
+				rule.schema.Items = item.schema
+			}
+		}
+		if props := parser.ObjectProperty(node, "props", content); props != nil && props.Type() == "object" && rule.schema.Type == "object" {
+			objectSchema(rule.schema, p.parseParams(props, content))
+		}
+		return rule
+	}
+	return nil
+}
+
+// validatorSchema returns the schema of a fastest-validator type.
+func validatorSchema(ruleType string) *types.Schema {
+	switch ruleType {
+	case "string":
+		return &types.Schema{Type: "string"}
+	case "email":
+		return &types.Schema{Type: "string", Format: "email"}
+	case "url":
+		return &types.Schema{Type: "string", Format: "uri"}
+	case "uuid":
+		return &types.Schema{Type: "string", Format: "uuid"}
+	case "date":
+		return &types.Schema{Type: "string", Format: "date-time"}
+	case "enum":
+		return &types.Schema{Type: "string"}
+	case "number":
+		return &types.Schema{Type: "number"}
+	case "boolean":
+		return &types.Schema{Type: "boolean"}
+	case "array":
+		return &types.Schema{Type: "array", Items: &types.Schema{}}
+	case "object":
+		return &types.Schema{Type: "object"}
+	default:
+		return &types.Schema{}
+	}
+}
+
+// objectSchema sets the properties and required list of an object schema.
+func objectSchema(schema *types.Schema, params map[string]*paramRule) {
+	schema.Properties = make(map[string]*types.Schema)
+	for _, name := range sortedNames(params) {
+		schema.Properties[name] = params[name].schema
+		if !params[name].optional {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// routesOf returns the routes of an API gateway route definition.
+func (p *Plugin) routesOf(gw *service, route *sitter.Node, services map[string]*service) []types.Route {
+	content := gw.content
+	prefix := gw.gatewayPath
+	if path := parser.ObjectProperty(route, "path", content); path != nil {
+		value, _ := p.tsParser.ExtractStringLiteral(path, content)
+		prefix = joinPath(prefix, value)
+	}
+
+	var routes []types.Route
+	if aliases := parser.ObjectProperty(route, "aliases", content); aliases != nil && aliases.Type() == "object" {
+		for i := 0; i < int(aliases.NamedChildCount()); i++ {
+			routes = append(routes, p.aliasRoutes(gw, prefix, aliases.NamedChild(i), services)...)
+		}
+	}
+
+	if auto := parser.ObjectProperty(route, "autoAliases", content); auto != nil && auto.Type() == "true" {
+		for _, name := range sortedNames(services) {
+			svc := services[name]
+			for _, actionName := range sortedNames(svc.actions) {
+				act := svc.actions[actionName]
+				for _, rest := range act.rest {
+					path := joinPath(prefix, joinPath(svc.restPath, rest.path))
+					routes = append(routes, p.actionRoutes(rest.method, path, svc.name+"."+act.name, "", services, svc.file, 0)...)
+				}
+			}
+		}
+	}
+
+	return routes
+}
+
+// aliasRoutes returns the routes of one alias: "METHOD path": target, or a
+// handler method named after the alias.
+func (p *Plugin) aliasRoutes(gw *service, prefix string, node *sitter.Node, services map[string]*service) []types.Route {
+	content := gw.content
+	var key, target, bodyType string
+	switch node.Type() {
+	case "pair":
+		keyNode := node.ChildByFieldName("key")
+		value := node.ChildByFieldName("value")
+		if keyNode == nil || value == nil {
+			return nil
+		}
+		key = strings.Trim(keyNode.Content(content), `"'`)
+		target = p.aliasTarget(value, content)
+	case "method_definition":
+		if name := node.ChildByFieldName("name"); name != nil {
+			key = strings.Trim(name.Content(content), `"'`)
+		}
+	default:
+		return nil
+	}
+	if key == "" {
+		return nil
+	}
+
+	// multipart: and stream: targets receive the raw request body
+	if rest, ok := strings.CutPrefix(target, "multipart:"); ok {
+		target, bodyType = rest, "multipart/form-data"
+	} else if rest, ok := strings.CutPrefix(target, "stream:"); ok {
+		target, bodyType = rest, "application/octet-stream"
+	}
+
+	line := int(node.StartPoint().Row) + 1
+	method, path := splitAlias(key)
+	path = joinPath(prefix, path)
+
+	if method == "REST" {
+		var routes []types.Route
+		for _, rest := range restActions {
+			routes = append(routes, p.actionRoutes(rest.method, path+rest.suffix, target+"."+rest.action, bodyType, services, gw.file, line)...)
+		}
+		return routes
+	}
+	return p.actionRoutes(method, path, target, bodyType, services, gw.file, line)
+}
+
+// aliasTarget returns the action an alias calls: a string, the last
+// element of a middleware array, or the action of an alias object. Aliases
+// handled by a function have no action.
+func (p *Plugin) aliasTarget(value *sitter.Node, content []byte) string {
+	switch value.Type() {
+	case "array":
+		if n := value.NamedChildCount(); n > 0 {
+			return p.aliasTarget(value.NamedChild(int(n)-1), content)
+		}
+	case "object":
+		if act := parser.ObjectProperty(value, "action", content); act != nil {
+			return p.aliasTarget(act, content)
+		}
+	}
+	target, _ := p.tsParser.ExtractStringLiteral(value, content)
+	return target
+}
+
+// actionRoutes returns the routes of a path served by an action, one per
+// method, documenting the action's params as path, query or body
+// parameters.
+func (p *Plugin) actionRoutes(method, path, target, bodyType string, services map[string]*service, file string, line int) []types.Route {
+	methods := []string{strings.ToUpper(method)}
+	if method == "" || method == "*" || strings.EqualFold(method, "ALL") {
+		methods = anyMethod
+	}
+
+	wildcard := plugins.IsCatchAll(path)
+	fullPath := convertPathParams(path)
+
+	var params map[string]*paramRule
+	serviceName := ""
+	if idx := strings.LastIndex(target, "."); idx > 0 {
+		serviceName = target[:idx]
+		if svc, ok := services[serviceName]; ok {
+			if act, ok := svc.actions[target[idx+1:]]; ok {
+				params = act.params
+			}
+		}
+	}
+
+	var routes []types.Route
+	for _, m := range methods {
+		route := types.Route{
+			Method:      m,
+			Path:        fullPath,
+			Handler:     target,
+			OperationID: operationID(m, fullPath, target),
+			Tags:        inferTags(serviceName, fullPath),
+			SourceFile:  file,
+			SourceLine:  line,
+		}
+		applyParams(&route, params, bodyType)
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// applyParams documents an action's params: those named in the path as
+// path parameters, the others as query parameters of GET, HEAD and DELETE
+// routes and as the JSON body of the others.
+func applyParams(route *types.Route, params map[string]*paramRule, bodyType string) {
+	inPath := make(map[string]bool)
+	for _, match := range braceParamRegex.FindAllStringSubmatch(route.Path, -1) {
+		name := match[1]
+		inPath[name] = true
+		schema := &types.Schema{Type: "string"}
+		if rule, ok := params[name]; ok {
+			schema = rule.schema
+		}
+		route.Parameters = append(route.Parameters, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	if bodyType != "" {
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				bodyType: {Schema: &types.Schema{Type: "string", Format: "binary"}},
+			},
+		}
+		return
+	}
+
+	rest := make(map[string]*paramRule)
+	for name, rule := range params {
+		if !inPath[name] {
+			rest[name] = rule
+		}
+	}
+	if len(rest) == 0 {
+		return
+	}
+
+	switch route.Method {
+	case "GET", "HEAD", "DELETE":
+		for _, name := range sortedNames(rest) {
+			route.Parameters = append(route.Parameters, types.Parameter{
+				Name:     name,
+				In:       "query",
+				Required: !rest[name].optional,
+				Schema:   rest[name].schema,
+			})
+		}
+	default:
+		body := &types.Schema{Type: "object"}
+		objectSchema(body, rest)
+		route.RequestBody = &types.RequestBody{
+			Required: len(body.Required) > 0,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: body},
+			},
+		}
+	}
+}
+
+// ExtractSchemas extracts schema definitions.
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	// Action params are validator rules, documented inline on each route
+	return []types.Schema{}, nil
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but returns ctx's error once
+// ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return p.ExtractSchemas(files)
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts path params (:id) to OpenAPI format ({id}),
+// and wildcards (*) to a templated {path}.
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// splitAlias splits an alias key ("GET users/:id") into its method and
+// path. Keys without a method match every method.
+func splitAlias(key string) (method, path string) {
+	key = strings.TrimSpace(key)
+	if before, after, ok := strings.Cut(key, " "); ok {
+		return strings.ToUpper(before), strings.TrimSpace(after)
+	}
+	return "", key
+}
+
+// joinPath joins a path prefix and a path.
+func joinPath(prefix, path string) string {
+	var parts []string
+	for _, part := range []string{prefix, path} {
+		if part = strings.Trim(part, "/"); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// operationID derives an operation ID from the action name (users.get ->
+// usersGet), or from the method and path for aliases without an action.
+func operationID(method, path, target string) string {
+	source := target
+	if source == "" {
+		source = braceParamRegex.ReplaceAllString(path, "By $1")
+	}
+	words := strings.FieldsFunc(source, func(r rune) bool {
+		return r == '.' || r == '/' || r == '-' || r == '_' || r == ' ' || r == '$'
+	})
+
+	var sb strings.Builder
+	if target == "" {
+		sb.WriteString(strings.ToLower(method))
+	}
+	for _, word := range words {
+		if sb.Len() == 0 {
+			sb.WriteString(word)
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return sb.String()
+}
+
+// inferTags tags a route with the name of its service, without a version
+// prefix, or else with the first static path segment.
+func inferTags(serviceName, path string) []string {
+	if serviceName != "" && !strings.HasPrefix(serviceName, "$") {
+		parts := strings.Split(serviceName, ".")
+		return []string{parts[len(parts)-1]}
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part != "" && part != "api" && !strings.HasPrefix(part, "{") {
+			return []string{part}
+		}
+	}
+	return nil
+}
+
+// sortedNames returns the keys of a map in sorted order.
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Register registers the Moleculer plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package moleculer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// gatewayFixture is an API gateway service with explicit aliases and an
+// autoAliases route.
+const gatewayFixture = `
+const ApiGateway = require("moleculer-web");
+
+module.exports = {
+  name: "api",
+  mixins: [ApiGateway],
+  settings: {
+    port: 3000,
+    path: "/api",
+    routes: [
+      {
+        path: "/admin",
+        aliases: {
+          "GET users": "v2.users.list",
+          "POST users/:id/ban": "v2.users.ban",
+          "REST posts": "posts",
+          "POST upload": "multipart:files.save",
+          "health": "health.check"
+        }
+      },
+      { path: "/", autoAliases: true }
+    ]
+  }
+};
+`
+
+// usersFixture is a versioned service whose actions declare rest routes
+// and fastest-validator params.
+const usersFixture = `
+module.exports = {
+  name: "users",
+  version: 2,
+  actions: {
+    list: {
+      rest: "GET /",
+      params: { limit: "number|optional", q: { type: "string", optional: true } },
+      handler(ctx) {}
+    },
+    ban: {
+      params: { id: "number", reason: "string" },
+      handler(ctx) {}
+    },
+    get: {
+      rest: "GET /:id",
+      params: { id: "number" },
+      async handler(ctx) {}
+    },
+    create: {
+      rest: { method: "POST", path: "/" },
+      params: {
+        email: "email",
+        tags: { type: "array", items: "string" },
+        role: { type: "enum", values: ["admin", "member"] },
+        $$strict: true
+      },
+      handler(ctx) {}
+    }
+  }
+};
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"moleculer": "^0.14.0", "moleculer-web": "^0.10.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"moleculer": "^0.14.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected, "services without a gateway expose no HTTP routes")
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_Aliases(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "services/api.service.js", Language: "javascript", Content: []byte(gatewayFixture)},
+		{Path: "services/users.service.js", Language: "javascript", Content: []byte(usersFixture)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/api/admin/users")
+	require.NotNil(t, list)
+	assert.Equal(t, "v2UsersList", list.OperationID)
+	assert.Equal(t, []string{"users"}, list.Tags)
+	assert.Equal(t, "services/api.service.js", list.SourceFile)
+	require.Len(t, list.Parameters, 2)
+	assert.Equal(t, "query", list.Parameters[0].In)
+	assert.False(t, list.Parameters[0].Required)
+
+	ban := findRoute(routes, "POST", "/api/admin/users/{id}/ban")
+	require.NotNil(t, ban)
+	require.Len(t, ban.Parameters, 1)
+	assert.Equal(t, "path", ban.Parameters[0].In)
+	assert.Equal(t, "number", ban.Parameters[0].Schema.Type)
+	require.NotNil(t, ban.RequestBody)
+	body := ban.RequestBody.Content["application/json"].Schema
+	assert.Contains(t, body.Properties, "reason")
+	assert.Equal(t, []string{"reason"}, body.Required)
+
+	for _, route := range []struct{ method, path, operation string }{
+		{"GET", "/api/admin/posts", "postsList"},
+		{"GET", "/api/admin/posts/{id}", "postsGet"},
+		{"POST", "/api/admin/posts", "postsCreate"},
+		{"PUT", "/api/admin/posts/{id}", "postsUpdate"},
+		{"PATCH", "/api/admin/posts/{id}", "postsPatch"},
+		{"DELETE", "/api/admin/posts/{id}", "postsRemove"},
+	} {
+		r := findRoute(routes, route.method, route.path)
+		require.NotNil(t, r, route.method+" "+route.path)
+		assert.Equal(t, route.operation, r.OperationID)
+	}
+
+	upload := findRoute(routes, "POST", "/api/admin/upload")
+	require.NotNil(t, upload)
+	require.NotNil(t, upload.RequestBody)
+	assert.Contains(t, upload.RequestBody.Content, "multipart/form-data")
+
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		assert.NotNil(t, findRoute(routes, method, "/api/admin/health"), "aliases without a method match "+method)
+	}
+}
+
+func TestPlugin_ExtractRoutes_AutoAliases(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "services/api.service.js", Language: "javascript", Content: []byte(gatewayFixture)},
+		{Path: "services/users.service.js", Language: "javascript", Content: []byte(usersFixture)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/api/v2/users")
+	require.NotNil(t, list)
+	assert.Equal(t, "v2UsersList", list.OperationID)
+
+	get := findRoute(routes, "GET", "/api/v2/users/{id}")
+	require.NotNil(t, get)
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "number", get.Parameters[0].Schema.Type)
+
+	create := findRoute(routes, "POST", "/api/v2/users")
+	require.NotNil(t, create)
+	require.NotNil(t, create.RequestBody)
+	body := create.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "email", body.Properties["email"].Format)
+	assert.Equal(t, "array", body.Properties["tags"].Type)
+	assert.Equal(t, "string", body.Properties["tags"].Items.Type)
+	assert.Equal(t, []any{"admin", "member"}, body.Properties["role"].Enum)
+	assert.NotContains(t, body.Properties, "$$strict")
+	assert.Equal(t, []string{"email", "role", "tags"}, body.Required)
+
+	assert.Nil(t, findRoute(routes, "POST", "/api/v2/users/ban"), "actions without rest are not auto-aliased")
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// SetMountNames records the operationId and tags a route registered on a
+// mounted router gets from its path below the mount, using the plugin's own
+// naming. Only names generated from the full path are replaced, so that
+// mount prefixes turn /admin/users into adminGetUsers rather than
+// adminGetAdminUsers; explicit names are left alone.
+func SetMountNames(route *types.Route, operationID func(method, path string) string, tags func(path string) []string) {
+	if route.Mount == "" || route.Mount == "/" {
+		return
+	}
+	rest, ok := strings.CutPrefix(route.Path, strings.TrimSuffix(route.Mount, "/"))
+	if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return
+	}
+	if rest == "" {
+		rest = "/"
+	}
+
+	if route.OperationID == operationID(route.Method, route.Path) {
+		route.MountOperationID = operationID(route.Method, rest)
+	}
+	if slices.Equal(route.Tags, tags(route.Path)) {
+		route.MountTags = tags(rest)
+	}
+}
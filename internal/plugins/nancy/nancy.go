@@ -132,7 +132,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 // extractRoutesFromFile extracts routes from a single C# file.
 func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	content := string(file.Content)
+	content := util.NormalizeNewlines(string(file.Content))
 	lines := strings.Split(content, "\n")
 
 	// Find module class name and base path
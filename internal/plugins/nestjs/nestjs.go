@@ -5,21 +5,27 @@
 package nestjs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/api2spec/api2spec/internal/decisions"
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
 	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -33,6 +39,7 @@ var httpMethodDecorators = map[string]string{
 	"Head":    "HEAD",
 	"Options": "OPTIONS",
 	"All":     "ALL",
+	"Sse":     "GET",
 }
 
 // Plugin implements the FrameworkPlugin interface for NestJS framework.
@@ -110,14 +117,27 @@ func (p *Plugin) Detect(projectRoot string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts NestJS route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
+	// Response classes and base controllers may be declared in other files
+	ser := p.collectSerialization(ctx, files)
+	hierarchy := p.collectClassHierarchy(ctx, files)
+	defer hierarchy.close()
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, ser, hierarchy)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -136,11 +156,35 @@ type controllerInfo struct {
 	version    string
 	classNode  *sitter.Node
 	sourceLine int
+
+	// groups holds controller-level @SerializeOptions({ groups })
+	groups []string
+
+	// produces holds controller-level @ApiProduces media types
+	produces []string
+
+	// typeArgs binds the type parameters of an inherited generic base
+	// class to the subclass's type arguments
+	typeArgs map[string]string
+}
+
+// resolveType substitutes the bound type arguments in a type annotation of
+// an inherited method (e.g., Promise<T[]> becomes Promise<User[]>).
+func (c *controllerInfo) resolveType(typeText string) string {
+	if len(c.typeArgs) == 0 {
+		return typeText
+	}
+	return typeIdentifierRegex.ReplaceAllStringFunc(typeText, func(name string) string {
+		if arg, ok := c.typeArgs[name]; ok {
+			return arg
+		}
+		return name
+	})
 }
 
 // extractRoutesFromFile extracts routes from a single TypeScript file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.tsParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, ser *serialization, hierarchy *classHierarchy) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +192,7 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 
 	// Check if this file imports NestJS
 	if !p.hasNestJSImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no NestJS import")
 		return nil, nil
 	}
 
@@ -157,11 +202,12 @@ func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route,
 	controllers := p.findControllers(pf.RootNode, file.Content)
 
 	for _, ctrl := range controllers {
-		controllerRoutes := p.extractRoutesFromController(ctrl, file.Content)
+		controllerRoutes := p.extractRoutesFromController(ctrl, file.Content, ser)
 		for i := range controllerRoutes {
 			controllerRoutes[i].SourceFile = file.Path
 		}
 		routes = append(routes, controllerRoutes...)
+		routes = append(routes, p.inheritedRoutes(ctrl, file.Content, hierarchy, ser)...)
 	}
 
 	return routes, nil
@@ -292,6 +338,15 @@ func (p *Plugin) parseControllerClassWithDecorators(classNode *sitter.Node, deco
 	// Extract base path from @Controller decorator
 	ctrl.basePath, ctrl.version = p.extractControllerPath(controllerDecorator, content)
 
+	for _, dec := range decorators {
+		if groups := p.serializeGroups(dec, content); groups != nil {
+			ctrl.groups = groups
+		}
+		if decoratorName(dec, content) == "ApiProduces" {
+			ctrl.produces = p.decoratorStrings(dec, content)
+		}
+	}
+
 	return ctrl
 }
 
@@ -370,7 +425,7 @@ func (p *Plugin) extractPairKeyValue(node *sitter.Node, content []byte) (key, va
 }
 
 // extractRoutesFromController extracts routes from a controller class.
-func (p *Plugin) extractRoutesFromController(ctrl *controllerInfo, content []byte) []types.Route {
+func (p *Plugin) extractRoutesFromController(ctrl *controllerInfo, content []byte, ser *serialization) []types.Route {
 	var routes []types.Route
 
 	// Find class body
@@ -401,7 +456,7 @@ func (p *Plugin) extractRoutesFromController(ctrl *controllerInfo, content []byt
 		}
 
 		if child.Type() == "method_definition" || child.Type() == "public_field_definition" {
-			methodRoutes := p.extractRoutesFromMethodWithDecorators(child, pendingDecorators, ctrl, content)
+			methodRoutes := p.extractRoutesFromMethodWithDecorators(child, pendingDecorators, ctrl, content, ser)
 			routes = append(routes, methodRoutes...)
 			pendingDecorators = nil // Reset decorators after use
 		}
@@ -410,14 +465,313 @@ func (p *Plugin) extractRoutesFromController(ctrl *controllerInfo, content []byt
 	return routes
 }
 
+// baseClass is a class that controllers can inherit routes from: a class
+// declaration or the class a mixin function returns.
+type baseClass struct {
+	node       *sitter.Node
+	content    []byte
+	path       string
+	typeParams []string
+}
+
+// classHierarchy indexes classes and mixin functions across all files, so
+// controllers can inherit routes from base classes declared elsewhere.
+type classHierarchy struct {
+	classes map[string]*baseClass
+	mixins  map[string]*baseClass
+	files   []*parser.ParsedTSFile
+}
+
+// close releases the parse trees the hierarchy refers to.
+func (h *classHierarchy) close() {
+	for _, pf := range h.files {
+		pf.Close()
+	}
+}
+
+// collectClassHierarchy indexes the classes and mixin functions of all files.
+// Parse trees stay open until the hierarchy is closed.
+func (p *Plugin) collectClassHierarchy(ctx context.Context, files []scanner.SourceFile) *classHierarchy {
+	h := &classHierarchy{
+		classes: make(map[string]*baseClass),
+		mixins:  make(map[string]*baseClass),
+	}
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		indexed := false
+		p.walkNodes(pf.RootNode, func(node *sitter.Node) bool {
+			switch node.Type() {
+			case "class_declaration", "abstract_class_declaration":
+				if name := node.ChildByFieldName("name"); name != nil {
+					if _, ok := h.classes[name.Content(file.Content)]; !ok {
+						h.classes[name.Content(file.Content)] = &baseClass{
+							node:       node,
+							content:    file.Content,
+							path:       file.Path,
+							typeParams: typeParameterNames(node, file.Content),
+						}
+						indexed = true
+					}
+				}
+				return false
+			case "function_declaration", "variable_declarator":
+				// Mixins: function CrudController<T>(entity: Type<T>) { class Host { ... } return Host; }
+				fn := node
+				if node.Type() == "variable_declarator" {
+					fn = node.ChildByFieldName("value")
+				}
+				name := node.ChildByFieldName("name")
+				if fn == nil || name == nil || (fn.Type() != "function_declaration" && fn.Type() != "arrow_function" && fn.Type() != "function_expression" && fn.Type() != "function") {
+					return true
+				}
+				if class := returnedClass(fn); class != nil {
+					h.mixins[name.Content(file.Content)] = &baseClass{
+						node:       class,
+						content:    file.Content,
+						path:       file.Path,
+						typeParams: typeParameterNames(fn, file.Content),
+					}
+					indexed = true
+				}
+				return false
+			}
+			return true
+		})
+
+		if indexed {
+			h.files = append(h.files, pf)
+		} else {
+			pf.Close()
+		}
+	}
+
+	return h
+}
+
+// implementsFramework reports whether a class implements one of
+// frameworkInterfaces.
+func implementsFramework(classNode *sitter.Node, content []byte) bool {
+	for i := 0; i < int(classNode.NamedChildCount()); i++ {
+		heritage := classNode.NamedChild(i)
+		if heritage.Type() != "class_heritage" {
+			continue
+		}
+		for j := 0; j < int(heritage.NamedChildCount()); j++ {
+			clause := heritage.NamedChild(j)
+			if clause.Type() != "implements_clause" {
+				continue
+			}
+			// PipeTransform<string, number> or common.CanActivate
+			for k := 0; k < int(clause.NamedChildCount()); k++ {
+				name, _, _ := strings.Cut(clause.NamedChild(k).Content(content), "<")
+				if frameworkInterfaces[name[strings.LastIndex(name, ".")+1:]] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// superclass returns the class classNode extends and the type arguments it
+// passes: BaseController<User> or a mixin call such as CrudController(User),
+// whose arguments bind the mixin's type parameters when no explicit type
+// arguments are given.
+func (h *classHierarchy) superclass(classNode *sitter.Node, content []byte) (*baseClass, []string) {
+	var extends *sitter.Node
+	for i := 0; i < int(classNode.NamedChildCount()); i++ {
+		if heritage := classNode.NamedChild(i); heritage.Type() == "class_heritage" {
+			for j := 0; j < int(heritage.NamedChildCount()); j++ {
+				if clause := heritage.NamedChild(j); clause.Type() == "extends_clause" {
+					extends = clause
+				}
+			}
+		}
+	}
+	if extends == nil {
+		return nil, nil
+	}
+
+	value := extends.ChildByFieldName("value")
+	if value == nil {
+		return nil, nil
+	}
+
+	if value.Type() == "call_expression" {
+		base := h.mixins[lastIdentifier(value.ChildByFieldName("function"), content)]
+		if base == nil {
+			return nil, nil
+		}
+		if args := typeArgumentTexts(value.ChildByFieldName("type_arguments"), content); len(args) > 0 {
+			return base, args
+		}
+		var args []string
+		if callArgs := value.ChildByFieldName("arguments"); callArgs != nil {
+			for i := 0; i < int(callArgs.NamedChildCount()); i++ {
+				args = append(args, callArgs.NamedChild(i).Content(content))
+			}
+		}
+		return base, args
+	}
+
+	base := h.classes[lastIdentifier(value, content)]
+	if base == nil || base.node == classNode {
+		return nil, nil
+	}
+	return base, typeArgumentTexts(extends.ChildByFieldName("type_arguments"), content)
+}
+
+// inheritedRoutes returns the routes ctrl inherits from its base classes and
+// mixins, mounted under ctrl's own path. Methods a subclass overrides are
+// not inherited.
+func (p *Plugin) inheritedRoutes(ctrl *controllerInfo, content []byte, h *classHierarchy, ser *serialization) []types.Route {
+	var routes []types.Route
+
+	overridden := p.methodNames(ctrl.classNode, content)
+	seen := map[*sitter.Node]bool{ctrl.classNode: true}
+	node, src, bound := ctrl.classNode, content, ctrl.typeArgs
+
+	for {
+		base, args := h.superclass(node, src)
+		if base == nil || seen[base.node] {
+			break
+		}
+		seen[base.node] = true
+
+		// Resolve arguments that are themselves type parameters of the subclass
+		current := &controllerInfo{typeArgs: bound}
+		typeArgs := make(map[string]string, len(base.typeParams))
+		for i, param := range base.typeParams {
+			if i < len(args) {
+				typeArgs[param] = current.resolveType(args[i])
+			}
+		}
+
+		inherited := *ctrl
+		inherited.classNode = base.node
+		inherited.typeArgs = typeArgs
+		for _, route := range p.extractRoutesFromController(&inherited, base.content, ser) {
+			if overridden[strings.TrimPrefix(route.Handler, ctrl.name+".")] {
+				continue
+			}
+			route.SourceFile = base.path
+			routes = append(routes, route)
+		}
+
+		for name := range p.methodNames(base.node, base.content) {
+			overridden[name] = true
+		}
+		node, src, bound = base.node, base.content, typeArgs
+	}
+
+	return routes
+}
+
+// methodNames returns the names of the methods a class declares.
+func (p *Plugin) methodNames(classNode *sitter.Node, content []byte) map[string]bool {
+	names := make(map[string]bool)
+	body := classNode.ChildByFieldName("body")
+	if body == nil {
+		return names
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() != "method_definition" && child.Type() != "public_field_definition" {
+			continue
+		}
+		if name := child.ChildByFieldName("name"); name != nil {
+			names[name.Content(content)] = true
+		}
+	}
+	return names
+}
+
+// returnedClass returns the class a mixin function declares in its body.
+func returnedClass(fn *sitter.Node) *sitter.Node {
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var class *sitter.Node
+	parser.Walk(body, func(n *sitter.Node) bool {
+		if class != nil {
+			return false
+		}
+		switch n.Type() {
+		case "class_declaration", "abstract_class_declaration", "class":
+			class = n
+			return false
+		}
+		return true
+	})
+	return class
+}
+
+// typeParameterNames returns the names of a class or function's type parameters.
+func typeParameterNames(node *sitter.Node, content []byte) []string {
+	params := node.ChildByFieldName("type_parameters")
+	if params == nil {
+		return nil
+	}
+	var names []string
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		if name := params.NamedChild(i).ChildByFieldName("name"); name != nil {
+			names = append(names, name.Content(content))
+		}
+	}
+	return names
+}
+
+// typeArgumentTexts returns the source text of each type argument.
+func typeArgumentTexts(args *sitter.Node, content []byte) []string {
+	if args == nil {
+		return nil
+	}
+	var texts []string
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		texts = append(texts, args.NamedChild(i).Content(content))
+	}
+	return texts
+}
+
+// lastIdentifier returns the name an expression refers to, taking the
+// property of a member expression (e.g., BaseController in base.BaseController).
+func lastIdentifier(node *sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+	if node.Type() == "member_expression" {
+		if prop := node.ChildByFieldName("property"); prop != nil {
+			return prop.Content(content)
+		}
+	}
+	return node.Content(content)
+}
+
 // extractRoutesFromMethodWithDecorators extracts routes from a method with its decorators.
-func (p *Plugin) extractRoutesFromMethodWithDecorators(methodNode *sitter.Node, decorators []*sitter.Node, ctrl *controllerInfo, content []byte) []types.Route {
+func (p *Plugin) extractRoutesFromMethodWithDecorators(methodNode *sitter.Node, decorators []*sitter.Node, ctrl *controllerInfo, content []byte, ser *serialization) []types.Route {
 	var routes []types.Route
 
 	// Find HTTP method decorators and HttpCode
 	var httpDecorators []*sitter.Node
 	var httpCode int
 
+	// Method-level @SerializeOptions overrides the controller's
+	groups := ctrl.groups
+	var headers []string
+	var redirectStatus int
+	var sse bool
+	produces := ctrl.produces
+
 	for _, dec := range decorators {
 		decoratorText := dec.Content(content)
 		// Check for HTTP method decorators
@@ -432,6 +786,33 @@ func (p *Plugin) extractRoutesFromMethodWithDecorators(methodNode *sitter.Node,
 		if strings.Contains(decoratorText, "@HttpCode(") {
 			httpCode = p.extractHttpCode(dec, content)
 		}
+		if g := p.serializeGroups(dec, content); g != nil {
+			groups = g
+		}
+		// Check for @Header('Name', 'value') response headers
+		if decoratorName(dec, content) == "Header" {
+			if name := p.extractDecoratorArgString(dec, content); name != "" {
+				headers = append(headers, name)
+			}
+		}
+		// Check for @Redirect(url, status) decorator
+		if decoratorName(dec, content) == "Redirect" {
+			redirectStatus = p.extractRedirectStatus(dec, content)
+		}
+		// @Sse() handlers stream Server-Sent Events
+		if decoratorName(dec, content) == "Sse" {
+			sse = true
+		}
+		// @ApiProduces('application/json', 'text/csv') negotiated media types
+		if decoratorName(dec, content) == "ApiProduces" {
+			produces = p.decoratorStrings(dec, content)
+		}
+	}
+
+	// Document the declared return type as the success response
+	var responseSchema *types.Schema
+	if returnType := methodNode.ChildByFieldName("return_type"); returnType != nil && returnType.NamedChildCount() > 0 {
+		responseSchema = ser.responseSchema(ctrl.resolveType(returnType.NamedChild(0).Content(content)), groups)
 	}
 
 	// Get method name from method_definition
@@ -453,10 +834,58 @@ func (p *Plugin) extractRoutesFromMethodWithDecorators(methodNode *sitter.Node,
 					fmt.Sprintf("%d", httpCode): {Description: "Success response"},
 				}
 			}
+			status := httpCode
+			if status == 0 {
+				status = 200
+				if route.Method == "POST" {
+					status = 201
+				}
+			}
+			if responseSchema != nil {
+				route.Responses = map[string]types.Response{
+					fmt.Sprintf("%d", status): {
+						Description: "Success response",
+						Content: map[string]types.MediaType{
+							"application/json": {Schema: responseSchema},
+						},
+					},
+				}
+			}
+			if sse {
+				// The Observable's MessageEvent payloads are framed as an event stream
+				route.Responses = map[string]types.Response{
+					"200": {
+						Description: "Server-Sent Events stream",
+						Content: map[string]types.MediaType{
+							"text/event-stream": {Schema: &types.Schema{Type: "string"}},
+						},
+					},
+				}
+				route.Extensions = types.Extensions{"x-sse": true}
+			} else {
+				route.Produces = produces
+			}
+			applyResponseHeaders(route, fmt.Sprintf("%d", status), headers)
+			applyThrownStatuses(route, fmt.Sprintf("%d", status), thrownStatuses(methodNode, content))
+
+			// A redirecting handler never returns its success response
+			if redirectStatus > 0 {
+				route.Responses = map[string]types.Response{
+					fmt.Sprintf("%d", redirectStatus): {
+						Description: http.StatusText(redirectStatus),
+						Headers: map[string]types.Header{
+							"Location": {
+								Description: "Redirect target",
+								Schema:      &types.Schema{Type: "string"},
+							},
+						},
+					},
+				}
+			}
 			route.SourceLine = int(methodNode.StartPoint().Row) + 1
 
 			// Extract request body info from @Body decorator in method parameters
-			requestBody := p.extractRequestBodyFromMethod(methodNode, content)
+			requestBody := p.extractRequestBodyFromMethod(methodNode, ctrl, content)
 			if requestBody != nil {
 				route.RequestBody = requestBody
 			}
@@ -497,6 +926,7 @@ func (p *Plugin) extractRouteFromDecorator(decorator *sitter.Node, methodName st
 	fullPath := buildPath(ctrl.basePath, ctrl.version, decoratorPath)
 
 	// Convert path parameters to OpenAPI format
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertPathParams(fullPath)
 
 	// Extract path parameters
@@ -508,7 +938,7 @@ func (p *Plugin) extractRouteFromDecorator(decorator *sitter.Node, methodName st
 	// Infer tags from controller name or path
 	tags := inferTags(ctrl.name, fullPath)
 
-	return &types.Route{
+	route := &types.Route{
 		Method:      httpMethod,
 		Path:        fullPath,
 		Handler:     ctrl.name + "." + methodName,
@@ -516,6 +946,11 @@ func (p *Plugin) extractRouteFromDecorator(decorator *sitter.Node, methodName st
 		Tags:        tags,
 		Parameters:  params,
 	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	return route
 }
 
 // extractPathFromDecorator extracts the path from an HTTP decorator.
@@ -578,8 +1013,33 @@ func (p *Plugin) extractHttpCode(decorator *sitter.Node, content []byte) int {
 	return 0
 }
 
+// extractRedirectStatus extracts the status code from a @Redirect decorator,
+// which defaults to 302 Found.
+func (p *Plugin) extractRedirectStatus(decorator *sitter.Node, content []byte) int {
+	var callExpr *sitter.Node
+	p.walkNodes(decorator, func(n *sitter.Node) bool {
+		if n.Type() == "call_expression" {
+			callExpr = n
+			return false
+		}
+		return true
+	})
+
+	if callExpr != nil {
+		args := p.tsParser.GetCallArguments(callExpr, content)
+		if len(args) > 1 && args[1].Type() == "number" {
+			var code int
+			if _, err := fmt.Sscanf(args[1].Content(content), "%d", &code); err == nil {
+				return code
+			}
+		}
+	}
+
+	return http.StatusFound
+}
+
 // extractRequestBodyFromMethod looks for @Body decorator in method parameters.
-func (p *Plugin) extractRequestBodyFromMethod(methodNode *sitter.Node, content []byte) *types.RequestBody {
+func (p *Plugin) extractRequestBodyFromMethod(methodNode *sitter.Node, ctrl *controllerInfo, content []byte) *types.RequestBody {
 	// Find formal_parameters
 	var formalParams *sitter.Node
 	p.walkNodes(methodNode, func(n *sitter.Node) bool {
@@ -633,7 +1093,7 @@ func (p *Plugin) extractRequestBodyFromMethod(methodNode *sitter.Node, content [
 		Required: true,
 		Content: map[string]types.MediaType{
 			"application/json": {
-				Schema: schema.SchemaRef(bodyType),
+				Schema: schema.SchemaRef(ctrl.resolveType(bodyType)),
 			},
 		},
 	}
@@ -738,31 +1198,505 @@ func (p *Plugin) extractDecoratorArgString(decorator *sitter.Node, content []byt
 	return ""
 }
 
-// walkNodes walks all nodes in the tree.
-func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
+// serializerInterceptor is the interceptor that applies class-transformer
+// decorators when NestJS serializes controller responses.
+const serializerInterceptor = "ClassSerializerInterceptor"
+
+// frameworkClassDecorators mark classes NestJS instantiates itself, which
+// are never serialized: controllers, modules, providers and exception
+// filters.
+var frameworkClassDecorators = map[string]bool{
+	"Controller": true,
+	"Module":     true,
+	"Injectable": true,
+	"Catch":      true,
+}
+
+// frameworkInterfaces are the interfaces of guards, interceptors, pipes,
+// middleware and exception filters.
+var frameworkInterfaces = map[string]bool{
+	"CanActivate":     true,
+	"NestInterceptor": true,
+	"PipeTransform":   true,
+	"NestMiddleware":  true,
+	"ExceptionFilter": true,
+}
+
+// classProperty is a class field together with its class-transformer metadata.
+type classProperty struct {
+	parser.TSProperty
+
+	// exclude is set by @Exclude()
+	exclude bool
+
+	// writeOnly is set by @Exclude({ toPlainOnly: true }): the field is
+	// accepted in requests but never serialized
+	writeOnly bool
+
+	// expose is set by @Expose()
+	expose bool
+
+	// exposeAs is the serialized name from @Expose({ name })
+	exposeAs string
+
+	// groups restricts the field to the @Expose({ groups }) serialization groups
+	groups []string
+
+	// accessor marks an exposed getter, which only exists in the serialized view
+	accessor bool
+}
+
+// classInfo describes a DTO or entity class.
+type classInfo struct {
+	name string
+
+	// excludeAll is set by a class-level @Exclude(): only @Expose fields are serialized
+	excludeAll bool
+
+	properties []classProperty
+}
+
+// grouped reports whether any property is restricted to serialization groups.
+func (c *classInfo) grouped() bool {
+	for _, prop := range c.properties {
+		if len(prop.groups) > 0 {
+			return true
+		}
 	}
+	return false
+}
 
-	if !fn(node) {
-		return
+// serialization holds project-wide class-transformer information.
+type serialization struct {
+	// enabled reports whether ClassSerializerInterceptor is used anywhere
+	enabled bool
+
+	// classes maps class names to their definitions
+	classes map[string]*classInfo
+
+	// groupSets lists the distinct @SerializeOptions({ groups }) combinations
+	groupSets [][]string
+}
+
+// viewName returns the component name for a class serialized with the given
+// groups. Classes without group-restricted fields share a single view.
+func (s *serialization) viewName(name string, groups []string) string {
+	cls, ok := s.classes[name]
+	if !s.enabled || len(groups) == 0 || !ok || !cls.grouped() {
+		return name
+	}
+	return name + "_" + strings.Join(groups, "_")
+}
+
+// collectSerialization gathers DTO/entity classes, serialization groups, and
+// ClassSerializerInterceptor usage across all files.
+func (p *Plugin) collectSerialization(ctx context.Context, files []scanner.SourceFile) *serialization {
+	ser := &serialization{classes: make(map[string]*classInfo)}
+	seenSets := make(map[string]bool)
+
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		if strings.Contains(string(file.Content), serializerInterceptor) {
+			ser.enabled = true
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		p.walkNodes(pf.RootNode, func(node *sitter.Node) bool {
+			switch node.Type() {
+			case "class_declaration":
+				if cls := p.parseClass(node, file.Content); cls != nil {
+					ser.classes[cls.name] = cls
+				}
+			case "decorator":
+				groups := p.serializeGroups(node, file.Content)
+				if key := strings.Join(groups, ","); len(groups) > 0 && !seenSets[key] {
+					seenSets[key] = true
+					ser.groupSets = append(ser.groupSets, groups)
+				}
+			}
+			return true
+		})
+
+		pf.Close()
+	}
+
+	return ser
+}
+
+// parseClass reads the fields and class-transformer decorators of a class.
+// Controllers, modules, providers and other framework classes are skipped.
+func (p *Plugin) parseClass(classNode *sitter.Node, content []byte) *classInfo {
+	nameNode := classNode.ChildByFieldName("name")
+	bodyNode := classNode.ChildByFieldName("body")
+	if nameNode == nil || bodyNode == nil {
+		return nil
+	}
+
+	cls := &classInfo{name: nameNode.Content(content)}
+
+	var classDecorators []*sitter.Node
+	for i := 0; i < int(classNode.ChildCount()); i++ {
+		if child := classNode.Child(i); child.Type() == "decorator" {
+			classDecorators = append(classDecorators, child)
+		}
+	}
+	if parent := classNode.Parent(); parent != nil {
+		for i := 0; i < int(parent.ChildCount()); i++ {
+			sibling := parent.Child(i)
+			if sibling == classNode {
+				break
+			}
+			if sibling.Type() == "decorator" {
+				classDecorators = append(classDecorators, sibling)
+			}
+		}
+	}
+	for _, dec := range classDecorators {
+		name := decoratorName(dec, content)
+		if frameworkClassDecorators[name] {
+			return nil
+		}
+		if name == "Exclude" {
+			cls.excludeAll = true
+		}
+	}
+	if implementsFramework(classNode, content) {
+		return nil
 	}
 
+	// Decorators on getters appear as class_body siblings before the method
+	var pending []*sitter.Node
+	for i := 0; i < int(bodyNode.ChildCount()); i++ {
+		child := bodyNode.Child(i)
+		switch child.Type() {
+		case "decorator":
+			pending = append(pending, child)
+		case "public_field_definition":
+			if prop := p.parseClassField(child, content); prop != nil {
+				cls.properties = append(cls.properties, *prop)
+			}
+			pending = nil
+		case "method_definition":
+			if prop := p.parseClassAccessor(child, pending, content); prop != nil {
+				cls.properties = append(cls.properties, *prop)
+			}
+			pending = nil
+		}
+	}
+
+	return cls
+}
+
+// parseClassField parses a public_field_definition and its decorators.
+func (p *Plugin) parseClassField(node *sitter.Node, content []byte) *classProperty {
+	prop := &classProperty{}
+	prop.Description = p.tsParser.DocComment(node, content)
+
 	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
+		child := node.Child(i)
+		switch child.Type() {
+		case "static":
+			return nil
+		case "property_identifier":
+			prop.Name = child.Content(content)
+		case "?":
+			prop.IsOptional = true
+		case "readonly":
+			prop.IsReadonly = true
+		case "type_annotation":
+			if child.NamedChildCount() > 0 {
+				prop.Type = child.NamedChild(0).Content(content)
+			}
+		case "decorator":
+			p.applyTransformDecorator(prop, child, content)
+		}
+	}
+
+	if prop.Name == "" {
+		return nil
+	}
+	return prop
+}
+
+// parseClassAccessor parses a getter exposed with @Expose(); other methods are ignored.
+func (p *Plugin) parseClassAccessor(node *sitter.Node, decorators []*sitter.Node, content []byte) *classProperty {
+	isGetter := false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == "get" {
+			isGetter = true
+		}
+	}
+	nameNode := node.ChildByFieldName("name")
+	if !isGetter || nameNode == nil {
+		return nil
+	}
+
+	prop := &classProperty{accessor: true}
+	prop.Name = nameNode.Content(content)
+	prop.IsReadonly = true
+	if returnType := node.ChildByFieldName("return_type"); returnType != nil && returnType.NamedChildCount() > 0 {
+		prop.Type = returnType.NamedChild(0).Content(content)
+	}
+	for _, dec := range decorators {
+		p.applyTransformDecorator(prop, dec, content)
+	}
+
+	if !prop.expose {
+		return nil
+	}
+	return prop
+}
+
+// applyTransformDecorator records @Exclude({ toPlainOnly }) and
+// @Expose({ name, groups }) on a property.
+func (p *Plugin) applyTransformDecorator(prop *classProperty, decorator *sitter.Node, content []byte) {
+	switch decoratorName(decorator, content) {
+	case "Exclude":
+		if p.optionTrue(decorator, content, "toPlainOnly") {
+			prop.writeOnly = true
+		} else {
+			prop.exclude = true
+		}
+	case "Expose":
+		prop.expose = true
+		options := p.decoratorOptions(decorator, content)
+		if options == nil {
+			return
+		}
+		for i := 0; i < int(options.NamedChildCount()); i++ {
+			pair := options.NamedChild(i)
+			if pair.Type() != "pair" {
+				continue
+			}
+			key := pair.ChildByFieldName("key")
+			value := pair.ChildByFieldName("value")
+			if key == nil || value == nil {
+				continue
+			}
+			switch key.Content(content) {
+			case "name":
+				prop.exposeAs, _ = p.tsParser.ExtractStringLiteral(value, content)
+			case "groups":
+				prop.groups = p.stringArray(value, content)
+			}
+		}
+	}
+}
+
+// serializeGroups returns the sorted groups of a @SerializeOptions({ groups }) decorator.
+func (p *Plugin) serializeGroups(decorator *sitter.Node, content []byte) []string {
+	if decoratorName(decorator, content) != "SerializeOptions" {
+		return nil
+	}
+	options := p.decoratorOptions(decorator, content)
+	if options == nil {
+		return nil
+	}
+
+	for i := 0; i < int(options.NamedChildCount()); i++ {
+		pair := options.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		key := pair.ChildByFieldName("key")
+		value := pair.ChildByFieldName("value")
+		if key != nil && value != nil && key.Content(content) == "groups" {
+			groups := p.stringArray(value, content)
+			sort.Strings(groups)
+			return groups
+		}
+	}
+	return nil
+}
+
+// decoratorOptions returns the object literal passed as a decorator's first argument.
+func (p *Plugin) decoratorOptions(decorator *sitter.Node, content []byte) *sitter.Node {
+	for i := 0; i < int(decorator.NamedChildCount()); i++ {
+		callExpr := decorator.NamedChild(i)
+		if callExpr.Type() != "call_expression" {
+			continue
+		}
+		args := p.tsParser.GetCallArguments(callExpr, content)
+		if len(args) > 0 && args[0].Type() == "object" {
+			return args[0]
+		}
+	}
+	return nil
+}
+
+// optionTrue reports whether the decorator's options object sets key to true.
+func (p *Plugin) optionTrue(decorator *sitter.Node, content []byte, key string) bool {
+	options := p.decoratorOptions(decorator, content)
+	if options == nil {
+		return false
+	}
+	for i := 0; i < int(options.NamedChildCount()); i++ {
+		pair := options.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		k := pair.ChildByFieldName("key")
+		value := pair.ChildByFieldName("value")
+		if k != nil && value != nil && k.Content(content) == key {
+			return value.Content(content) == "true"
+		}
+	}
+	return false
+}
+
+// decoratorStrings returns the string literal arguments of a decorator
+// call, as in @ApiProduces('application/json', 'text/csv').
+func (p *Plugin) decoratorStrings(decorator *sitter.Node, content []byte) []string {
+	if decorator.NamedChildCount() == 0 || decorator.NamedChild(0).Type() != "call_expression" {
+		return nil
+	}
+	var values []string
+	for _, arg := range p.tsParser.GetCallArguments(decorator.NamedChild(0), content) {
+		if value, ok := p.tsParser.ExtractStringLiteral(arg, content); ok {
+			values = append(values, value)
+		}
 	}
+	return values
 }
 
-// ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
+// stringArray returns the string literals of an array node.
+func (p *Plugin) stringArray(node *sitter.Node, content []byte) []string {
+	if node.Type() != "array" {
+		return nil
+	}
+	var values []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if value, ok := p.tsParser.ExtractStringLiteral(node.NamedChild(i), content); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// classSchema builds the schema of a class. When ClassSerializerInterceptor is
+// in use the serialized view is produced: excluded fields are removed, exposed
+// names are applied, and group-restricted fields are kept only for matching groups.
+func (s *serialization) classSchema(cls *classInfo, groups []string, tsExtractor *schema.TypeScriptSchemaExtractor) *types.Schema {
+	result := &types.Schema{
+		Type:       "object",
+		Title:      s.viewName(cls.name, groups),
+		Properties: make(map[string]*types.Schema),
+	}
+
+	for _, prop := range cls.properties {
+		name := prop.Name
+		if s.enabled {
+			if prop.exclude || (cls.excludeAll && !prop.expose) {
+				continue
+			}
+			if len(prop.groups) > 0 && !intersects(prop.groups, groups) {
+				continue
+			}
+			if prop.exposeAs != "" {
+				name = prop.exposeAs
+			}
+		} else if prop.accessor {
+			continue
+		}
+
+		propSchema := &types.Schema{}
+		if prop.Type != "" {
+			propSchema = tsExtractor.TypeToSchema(prop.Type)
+		}
+		if prop.IsReadonly {
+			propSchema.ReadOnly = true
+		}
+		if s.enabled && prop.writeOnly {
+			propSchema.WriteOnly = true
+		}
+		if prop.Description != "" {
+			propSchema.Description = prop.Description
+		}
+		s.applyViewRefs(propSchema, groups)
+
+		result.Properties[name] = propSchema
+		if !prop.IsOptional {
+			result.Required = append(result.Required, name)
+		}
+	}
+
+	return result
+}
+
+// applyViewRefs points references to grouped classes at their group view.
+func (s *serialization) applyViewRefs(sch *types.Schema, groups []string) {
+	if sch == nil {
+		return
+	}
+	if name, ok := strings.CutPrefix(sch.Ref, "#/components/schemas/"); ok {
+		sch.Ref = schema.SchemaRef(s.viewName(name, groups)).Ref
+	}
+	s.applyViewRefs(sch.Items, groups)
+}
+
+// responseSchema converts a handler return type to a response schema,
+// unwrapping Promise<T> and Observable<T>. Classes are referenced through
+// their serialization view for the given groups.
+func (s *serialization) responseSchema(returnType string, groups []string) *types.Schema {
+	returnType = strings.TrimSpace(returnType)
+	for _, wrapper := range []string{"Promise<", "Observable<"} {
+		if strings.HasPrefix(returnType, wrapper) && strings.HasSuffix(returnType, ">") {
+			returnType = strings.TrimSpace(returnType[len(wrapper) : len(returnType)-1])
+		}
+	}
+
+	switch returnType {
+	case "", "void", "any", "unknown", "undefined", "never":
+		return nil
+	}
+
+	result := schema.NewTypeScriptSchemaExtractor().TypeToSchema(returnType)
+	s.applyViewRefs(result, groups)
+	return result
+}
+
+// walkNodes walks all nodes in the tree.
+func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	parser.Walk(node, fn)
+}
+
+// ExtractSchemas extracts schema definitions from classes, TypeScript interfaces, and Zod schemas.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	tsExtractor := schema.NewTypeScriptSchemaExtractor()
 
+	// Register DTO and entity classes, plus one view per serialization group set
+	ser := p.collectSerialization(ctx, files)
+	for _, cls := range ser.classes {
+		tsExtractor.Registry().Add(cls.name, ser.classSchema(cls, nil, tsExtractor))
+		if !ser.enabled || !cls.grouped() {
+			continue
+		}
+		for _, groups := range ser.groupSets {
+			tsExtractor.Registry().Add(ser.viewName(cls.name, groups), ser.classSchema(cls, groups, tsExtractor))
+		}
+	}
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "typescript" && file.Language != "javascript" {
 			continue
 		}
 
-		pf, err := p.tsParser.Parse(file.Path, file.Content)
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
@@ -771,6 +1705,9 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 		for _, iface := range pf.Interfaces {
 			tsExtractor.ExtractAndRegister(iface)
 		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
 
 		// Extract Zod schemas (if any)
 		for _, zs := range pf.ZodSchemas {
@@ -788,15 +1725,19 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 
 // --- Helper Functions ---
 
+// typeIdentifierRegex matches identifiers in a type annotation.
+var typeIdentifierRegex = regexp.MustCompile(`[A-Za-z_$][A-Za-z0-9_$]*`)
+
 // colonParamRegex matches path parameters in the format :param.
 var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 
 // braceParamRegex matches path parameters in the format {param}.
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
-// convertPathParams converts NestJS-style path params (:id) to OpenAPI format ({id}).
+// convertPathParams converts NestJS-style path params (:id) to OpenAPI format ({id}),
+// and wildcards (*) to a templated {path}.
 func convertPathParams(path string) string {
-	return colonParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -929,6 +1870,10 @@ func inferTags(controllerName, path string) []string {
 
 // mapTypeScriptToOpenAPI maps TypeScript types to OpenAPI types.
 func mapTypeScriptToOpenAPI(tsType string) string {
+	if openAPIType, _, ok := typemap.OpenAPI(tsType); ok {
+		return openAPIType
+	}
+
 	switch strings.TrimSpace(tsType) {
 	case "string":
 		return "string"
@@ -943,6 +1888,154 @@ func mapTypeScriptToOpenAPI(tsType string) string {
 	}
 }
 
+// applyResponseHeaders documents @Header() response headers on the success response.
+func applyResponseHeaders(route *types.Route, status string, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	resp, ok := route.Responses[status]
+	if !ok {
+		resp = types.Response{Description: "Success response"}
+	}
+	if resp.Headers == nil {
+		resp.Headers = make(map[string]types.Header)
+	}
+	for _, name := range names {
+		header := types.Header{Schema: &types.Schema{Type: "string"}}
+		if strings.EqualFold(name, "Location") && status == "201" {
+			header.Description = "URL of the created resource"
+		}
+		resp.Headers[name] = header
+	}
+	route.Responses[status] = resp
+}
+
+// exceptionStatuses maps NestJS built-in HTTP exceptions to their status codes.
+var exceptionStatuses = map[string]int{
+	"BadRequestException":           http.StatusBadRequest,
+	"UnauthorizedException":         http.StatusUnauthorized,
+	"ForbiddenException":            http.StatusForbidden,
+	"NotFoundException":             http.StatusNotFound,
+	"MethodNotAllowedException":     http.StatusMethodNotAllowed,
+	"NotAcceptableException":        http.StatusNotAcceptable,
+	"RequestTimeoutException":       http.StatusRequestTimeout,
+	"ConflictException":             http.StatusConflict,
+	"GoneException":                 http.StatusGone,
+	"PreconditionFailedException":   http.StatusPreconditionFailed,
+	"PayloadTooLargeException":      http.StatusRequestEntityTooLarge,
+	"UnsupportedMediaTypeException": http.StatusUnsupportedMediaType,
+	"ImATeapotException":            http.StatusTeapot,
+	"UnprocessableEntityException":  http.StatusUnprocessableEntity,
+	"InternalServerErrorException":  http.StatusInternalServerError,
+	"NotImplementedException":       http.StatusNotImplemented,
+	"BadGatewayException":           http.StatusBadGateway,
+	"ServiceUnavailableException":   http.StatusServiceUnavailable,
+	"GatewayTimeoutException":       http.StatusGatewayTimeout,
+}
+
+// throwRegex matches `throw new SomeException(args`.
+var throwRegex = regexp.MustCompile(`throw\s+new\s+(\w+)\(([^;]*)`)
+
+// httpStatusRegex matches a status given as HttpStatus.NOT_FOUND or a 3-digit number.
+var httpStatusRegex = regexp.MustCompile(`HttpStatus\.(\w+)|\b([1-5]\d\d)\b`)
+
+// thrownStatuses returns the status codes of HTTP exceptions thrown in a method body.
+func thrownStatuses(methodNode *sitter.Node, content []byte) []int {
+	body := methodNode.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	var codes []int
+	for _, match := range throwRegex.FindAllStringSubmatch(body.Content(content), -1) {
+		if code, ok := exceptionStatuses[match[1]]; ok {
+			codes = append(codes, code)
+			continue
+		}
+		if match[1] != "HttpException" {
+			continue
+		}
+		if status := httpStatusRegex.FindStringSubmatch(match[2]); status != nil {
+			if status[2] != "" {
+				code, _ := strconv.Atoi(status[2])
+				codes = append(codes, code)
+			} else if code := httpStatusByName(status[1]); code > 0 {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// httpStatusByName resolves a HttpStatus enum member (NOT_FOUND) to its code.
+func httpStatusByName(name string) int {
+	for code := 100; code < 600; code++ {
+		text := http.StatusText(code)
+		if text == "" {
+			continue
+		}
+		if strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(strings.ToUpper(text)) == name {
+			return code
+		}
+	}
+	return 0
+}
+
+// applyThrownStatuses documents the statuses of exceptions a handler throws
+// next to its success response.
+func applyThrownStatuses(route *types.Route, successStatus string, codes []int) {
+	if len(codes) == 0 {
+		return
+	}
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+	if _, ok := route.Responses[successStatus]; !ok {
+		route.Responses[successStatus] = types.Response{Description: "Success response"}
+	}
+	for _, code := range codes {
+		status := strconv.Itoa(code)
+		if _, ok := route.Responses[status]; !ok {
+			route.Responses[status] = types.Response{Description: http.StatusText(code)}
+		}
+	}
+}
+
+// decoratorName returns the unqualified name of a decorator (@Expose() -> Expose).
+func decoratorName(decorator *sitter.Node, content []byte) string {
+	if decorator.NamedChildCount() == 0 {
+		return ""
+	}
+	target := decorator.NamedChild(0)
+	if target.Type() == "call_expression" {
+		target = target.ChildByFieldName("function")
+		if target == nil {
+			return ""
+		}
+	}
+	name := target.Content(content)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// intersects reports whether two string slices share an element.
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Register registers the NestJS plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
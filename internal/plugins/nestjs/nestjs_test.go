@@ -173,6 +173,156 @@ const app = express();
 app.get('/users', (req, res) => res.json([]));
 `
 
+// nestjsSerializationEntity is an entity using class-transformer decorators.
+const nestjsSerializationEntity = `
+import { Exclude, Expose } from 'class-transformer';
+
+export class ProfileEntity {
+  bio: string;
+
+  @Expose({ groups: ['admin'] })
+  internalNotes?: string;
+}
+
+export class UserEntity {
+  /** Primary key. */
+  id: number;
+
+  @Expose({ name: 'full_name' })
+  fullName: string;
+
+  @Exclude()
+  password: string;
+
+  @Exclude({ toPlainOnly: true })
+  pin: string;
+
+  @Expose({ groups: ['admin'] })
+  email?: string;
+
+  profile: ProfileEntity;
+
+  @Expose()
+  get initials(): string {
+    return '';
+  }
+}
+`
+
+// nestjsSerializationController serializes responses with ClassSerializerInterceptor.
+const nestjsSerializationController = `
+import { ClassSerializerInterceptor, Controller, Get, Post, SerializeOptions, UseInterceptors } from '@nestjs/common';
+
+@Controller('users')
+@UseInterceptors(ClassSerializerInterceptor)
+export class UsersController {
+  @Get()
+  async findAll(): Promise<UserEntity[]> {
+    return [];
+  }
+
+  @Get(':id')
+  @SerializeOptions({ groups: ['admin'] })
+  findOne(): Observable<UserEntity> {
+    return of(new UserEntity());
+  }
+
+  @Post()
+  create(): UserEntity {
+    return new UserEntity();
+  }
+}
+`
+
+// nestjsHeaderController tests @Header() response headers.
+const nestjsHeaderController = `
+import { Controller, Get, Header, Post } from '@nestjs/common';
+
+@Controller('files')
+export class FilesController {
+  @Get()
+  @Header('Cache-Control', 'none')
+  @Header('X-Total-Count', '0')
+  findAll() {
+    return [];
+  }
+
+  @Post()
+  @Header('Location', '/files/1')
+  create() {
+    return {};
+  }
+
+  @Get('latest')
+  @Redirect('/files/1')
+  latest() {}
+
+  @Get('archive')
+  @Redirect('https://archive.example.com', 301)
+  archive() {}
+}
+`
+
+// nestjsSseController tests @Sse() Server-Sent Events endpoints.
+const nestjsSseController = `
+import { Controller, MessageEvent, Sse } from '@nestjs/common';
+import { Observable, interval, map } from 'rxjs';
+
+@Controller('notifications')
+export class NotificationsController {
+  @Sse('stream')
+  stream(): Observable<MessageEvent> {
+    return interval(1000).pipe(map(() => ({ data: { hello: 'world' } })));
+  }
+}
+`
+
+// nestjsProducesController tests media types declared with @ApiProduces.
+const nestjsProducesController = `
+import { Controller, Get, Param } from '@nestjs/common';
+import { ApiProduces } from '@nestjs/swagger';
+
+@ApiProduces('application/json')
+@Controller('reports')
+export class ReportsController {
+  @Get()
+  findAll(): Report[] {
+    return [];
+  }
+
+  @Get(':id')
+  @ApiProduces('application/json', 'application/xml', 'text/csv')
+  findOne(@Param('id') id: string): Report {
+    return this.reports.get(id);
+  }
+}
+`
+
+// nestjsExceptionController tests statuses inferred from thrown exceptions.
+const nestjsExceptionController = `
+import { Controller, Get, Post, Param, Body, NotFoundException, HttpException, HttpStatus } from '@nestjs/common';
+
+@Controller('orders')
+export class OrdersController {
+  @Get(':id')
+  findOne(@Param('id') id: string) {
+    const order = this.orders.get(id);
+    if (!order) {
+      throw new NotFoundException('Order not found');
+    }
+    return order;
+  }
+
+  @Post()
+  create(@Body() dto: CreateOrderDto) {
+    if (this.orders.has(dto.id)) {
+      throw new HttpException('Duplicate order', HttpStatus.CONFLICT);
+    }
+    return this.orders.add(dto);
+  }
+}
+`
+
 func TestPlugin_Name(t *testing.T) {
 	p := New()
 	assert.Equal(t, "nestjs", p.Name())
@@ -425,6 +575,256 @@ func TestPlugin_ExtractRoutes_HttpCode(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_ResponseSerializationGroups(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "user.entity.ts", Language: "typescript", Content: []byte(nestjsSerializationEntity)},
+		{Path: "users.controller.ts", Language: "typescript", Content: []byte(nestjsSerializationController)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	// Promise<T[]> unwraps to an array of the default view
+	findAll := findRoute(routes, "GET", "/users")
+	require.NotNil(t, findAll)
+	require.Contains(t, findAll.Responses, "200")
+	listSchema := findAll.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", listSchema.Type)
+	assert.Equal(t, "#/components/schemas/UserEntity", listSchema.Items.Ref)
+
+	// @SerializeOptions groups select the grouped view
+	findOne := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, findOne)
+	require.Contains(t, findOne.Responses, "200")
+	assert.Equal(t, "#/components/schemas/UserEntity_admin", findOne.Responses["200"].Content["application/json"].Schema.Ref)
+
+	// POST defaults to 201
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.Contains(t, create.Responses, "201")
+}
+
+func TestPlugin_ExtractSchemas_SerializedView(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "user.entity.ts", Language: "typescript", Content: []byte(nestjsSerializationEntity)},
+		{Path: "users.controller.ts", Language: "typescript", Content: []byte(nestjsSerializationController)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+
+	// Default view: excluded and group-only fields removed, names exposed
+	user, ok := byName["UserEntity"]
+	require.True(t, ok)
+	require.Contains(t, user.Properties, "id")
+	assert.Equal(t, "Primary key.", user.Properties["id"].Description)
+	assert.Contains(t, user.Properties, "full_name")
+	assert.NotContains(t, user.Properties, "fullName")
+	assert.NotContains(t, user.Properties, "password")
+	assert.NotContains(t, user.Properties, "email")
+	require.Contains(t, user.Properties, "pin")
+	assert.True(t, user.Properties["pin"].WriteOnly)
+	require.Contains(t, user.Properties, "initials")
+	assert.True(t, user.Properties["initials"].ReadOnly)
+	assert.Equal(t, "#/components/schemas/ProfileEntity", user.Properties["profile"].Ref)
+
+	// Admin view: group fields included, nested classes use their admin view
+	admin, ok := byName["UserEntity_admin"]
+	require.True(t, ok)
+	assert.Contains(t, admin.Properties, "email")
+	assert.NotContains(t, admin.Properties, "password")
+	assert.Equal(t, "#/components/schemas/ProfileEntity_admin", admin.Properties["profile"].Ref)
+
+	profileAdmin, ok := byName["ProfileEntity_admin"]
+	require.True(t, ok)
+	assert.Contains(t, profileAdmin.Properties, "internalNotes")
+
+	// Controllers are not registered as schemas
+	assert.NotContains(t, byName, "UsersController")
+}
+
+func TestPlugin_ExtractSchemas_SkipsFrameworkClasses(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "user.entity.ts", Language: "typescript", Content: []byte(nestjsSerializationEntity)},
+		{Path: "app.module.ts", Language: "typescript", Content: []byte(`
+import { Module } from '@nestjs/common';
+
+@Module({ controllers: [UsersController], providers: [UsersService] })
+export class AppModule {}
+`)},
+		{Path: "users.service.ts", Language: "typescript", Content: []byte(`
+import { Injectable, Logger } from '@nestjs/common';
+
+@Injectable()
+export class UsersService {
+  private readonly logger = new Logger(UsersService.name);
+  users: UserEntity[] = [];
+}
+`)},
+		{Path: "parse-id.pipe.ts", Language: "typescript", Content: []byte(`
+export class ParseIdPipe implements PipeTransform<string, number> {
+  radix: number = 10;
+  transform(value: string) { return parseInt(value, this.radix); }
+}
+`)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	var names []string
+	for _, s := range schemas {
+		names = append(names, s.Title)
+	}
+	assert.Contains(t, names, "UserEntity")
+	assert.NotContains(t, names, "AppModule")
+	assert.NotContains(t, names, "UsersService")
+	assert.NotContains(t, names, "ParseIdPipe")
+}
+
+func TestPlugin_ExtractSchemas_RawClassWithoutInterceptor(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "user.entity.ts", Language: "typescript", Content: []byte(nestjsSerializationEntity)},
+	}
+
+	schemas, err := p.ExtractSchemas(files)
+	require.NoError(t, err)
+
+	var user *types.Schema
+	for i := range schemas {
+		if schemas[i].Title == "UserEntity" {
+			user = &schemas[i]
+		}
+	}
+	require.NotNil(t, user)
+
+	// Without ClassSerializerInterceptor the raw class is serialized as-is
+	assert.Contains(t, user.Properties, "fullName")
+	assert.Contains(t, user.Properties, "password")
+	assert.Contains(t, user.Properties, "email")
+	assert.NotContains(t, user.Properties, "initials")
+	assert.NotContains(t, user.Required, "email")
+}
+
+func TestPlugin_ExtractRoutes_ResponseHeaders(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "files.controller.ts",
+			Language: "typescript",
+			Content:  []byte(nestjsHeaderController),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 4)
+
+	findAll := findRoute(routes, "GET", "/files")
+	require.NotNil(t, findAll)
+	require.Contains(t, findAll.Responses, "200")
+	assert.Contains(t, findAll.Responses["200"].Headers, "Cache-Control")
+	assert.Contains(t, findAll.Responses["200"].Headers, "X-Total-Count")
+
+	// POST responds with 201 by default
+	create := findRoute(routes, "POST", "/files")
+	require.NotNil(t, create)
+	require.Contains(t, create.Responses, "201")
+	assert.Contains(t, create.Responses["201"].Headers, "Location")
+
+	// @Redirect replaces the default response and defaults to 302
+	latest := findRoute(routes, "GET", "/files/latest")
+	require.NotNil(t, latest)
+	require.Len(t, latest.Responses, 1)
+	require.Contains(t, latest.Responses, "302")
+	assert.Contains(t, latest.Responses["302"].Headers, "Location")
+
+	archive := findRoute(routes, "GET", "/files/archive")
+	require.NotNil(t, archive)
+	require.Contains(t, archive.Responses, "301")
+}
+
+func TestPlugin_ExtractRoutes_ThrownExceptions(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "orders.controller.ts",
+			Language: "typescript",
+			Content:  []byte(nestjsExceptionController),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	findOne := findRoute(routes, "GET", "/orders/{id}")
+	require.NotNil(t, findOne)
+	assert.Contains(t, findOne.Responses, "200")
+	require.Contains(t, findOne.Responses, "404")
+	assert.Equal(t, "Not Found", findOne.Responses["404"].Description)
+
+	create := findRoute(routes, "POST", "/orders")
+	require.NotNil(t, create)
+	assert.Contains(t, create.Responses, "201")
+	assert.Contains(t, create.Responses, "409")
+}
+
+func TestPlugin_ExtractRoutes_Sse(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "notifications.controller.ts",
+			Language: "typescript",
+			Content:  []byte(nestjsSseController),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	route := routes[0]
+	assert.Equal(t, "GET", route.Method)
+	assert.Equal(t, "/notifications/stream", route.Path)
+	require.Contains(t, route.Responses, "200")
+	assert.Contains(t, route.Responses["200"].Content, "text/event-stream")
+	assert.NotContains(t, route.Responses["200"].Content, "application/json")
+	assert.Equal(t, true, route.Extensions["x-sse"])
+}
+
+func TestPlugin_ExtractRoutes_Produces(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "reports.controller.ts", Language: "typescript", Content: []byte(nestjsProducesController)},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	findAll := findRoute(routes, "GET", "/reports")
+	require.NotNil(t, findAll)
+	assert.Equal(t, []string{"application/json"}, findAll.Produces)
+
+	findOne := findRoute(routes, "GET", "/reports/{id}")
+	require.NotNil(t, findOne)
+	assert.Equal(t, []string{"application/json", "application/xml", "text/csv"}, findOne.Produces)
+}
+
 func TestPlugin_ExtractRoutes_AllMethods(t *testing.T) {
 	p := New()
 
@@ -523,6 +923,122 @@ func TestPlugin_ExtractRoutes_SourceInfo(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_InheritedRoutes(t *testing.T) {
+	p := New()
+
+	base := `import { Get, Post, Body, Param } from '@nestjs/common';
+
+export abstract class BaseController<T, D> {
+  @Get()
+  findAll(): Promise<T[]> {
+    return this.service.findAll();
+  }
+
+  @Get(':id')
+  findOne(@Param('id') id: string): Promise<T> {
+    return this.service.findOne(id);
+  }
+
+  @Post()
+  create(@Body() dto: D): Promise<T> {
+    return this.service.create(dto);
+  }
+}
+`
+	controller := `import { Controller, Get, Param } from '@nestjs/common';
+import { BaseController } from './base.controller';
+
+@Controller('users')
+export class UsersController extends BaseController<User, CreateUserDto> {
+  @Get(':id')
+  findOne(@Param('id') id: string): Promise<UserDetail> {
+    return this.service.findDetail(id);
+  }
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "users.controller.ts", Language: "typescript", Content: []byte(controller)},
+		{Path: "base.controller.ts", Language: "typescript", Content: []byte(base)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	list, ok := byKey["GET /users"]
+	require.True(t, ok)
+	assert.Equal(t, "base.controller.ts", list.SourceFile)
+	assert.Equal(t, "UsersController.findAll", list.Handler)
+	require.NotNil(t, list.Responses["200"].Content["application/json"].Schema.Items)
+	assert.Equal(t, "#/components/schemas/User", list.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+	// The override replaces the inherited handler
+	detail, ok := byKey["GET /users/{id}"]
+	require.True(t, ok)
+	assert.Equal(t, "users.controller.ts", detail.SourceFile)
+	assert.Equal(t, "#/components/schemas/UserDetail", detail.Responses["200"].Content["application/json"].Schema.Ref)
+
+	create, ok := byKey["POST /users"]
+	require.True(t, ok)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUserDto", create.RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestPlugin_ExtractRoutes_MixinRoutes(t *testing.T) {
+	p := New()
+
+	mixin := `import { Get, Delete, Param, Type } from '@nestjs/common';
+
+export function CrudController<T>(entity: Type<T>) {
+  abstract class CrudHost {
+    @Get()
+    findAll(): Promise<T[]> {
+      return null;
+    }
+
+    @Delete(':id')
+    remove(@Param('id') id: string): Promise<void> {
+      return null;
+    }
+  }
+  return CrudHost;
+}
+`
+	controller := `import { Controller } from '@nestjs/common';
+import { CrudController } from './crud';
+
+@Controller('posts')
+export class PostsController extends CrudController(Post) {}
+`
+	files := []scanner.SourceFile{
+		{Path: "crud.ts", Language: "typescript", Content: []byte(mixin)},
+		{Path: "posts.controller.ts", Language: "typescript", Content: []byte(controller)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	list, ok := byKey["GET /posts"]
+	require.True(t, ok)
+	assert.Equal(t, "PostsController.findAll", list.Handler)
+	require.NotNil(t, list.Responses["200"].Content["application/json"].Schema.Items)
+	assert.Equal(t, "#/components/schemas/Post", list.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+	_, ok = byKey["DELETE /posts/{id}"]
+	assert.True(t, ok)
+}
+
 func TestConvertPathParams(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -0,0 +1,717 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package oak provides a plugin for extracting routes from Oak applications on Deno.
+package oak
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps HTTP method names to their uppercase forms.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+	"all":     "ALL",
+}
+
+// Plugin implements the FrameworkPlugin interface for Oak.
+type Plugin struct {
+	tsParser  *parser.TypeScriptParser
+	zodParser *schema.ZodParser
+}
+
+// New creates a new Oak plugin instance.
+func New() *Plugin {
+	tsParser := parser.NewTypeScriptParser()
+	return &Plugin{
+		tsParser:  tsParser,
+		zodParser: schema.NewZodParser(tsParser),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "oak"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".tsx", ".js", ".jsx", ".mts", ".mjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "oak",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Oak applications on Deno",
+		SupportedFrameworks: []string{
+			"oak",
+			"@oak/oak",
+		},
+	}
+}
+
+// oakModules are the import specifiers Oak is published under: JSR, npm
+// and deno.land/x.
+var oakModules = []string{"@oak/oak", "@oakserver/oak", "deno.land/x/oak"}
+
+// Detect checks if Oak is used in the project. Deno projects declare
+// dependencies in the imports of deno.json or an import map, or re-export
+// them from deps.ts, rather than in package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range []string{"deno.json", "deno.jsonc", "import_map.json", "deps.ts"} {
+		if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, name), oakModules); found {
+			return true, nil
+		}
+	}
+
+	// Oak also runs on Node.js and Bun from npm
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		return false, nil
+	}
+	var pkg struct {
+		Dependencies map[string]string `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, nil
+	}
+	_, ok := pkg.Dependencies["@oakserver/oak"]
+	return ok, nil
+}
+
+// checkFileForDependency checks if a file mentions one of the modules.
+func (p *Plugin) checkFileForDependency(path string, modules []string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, nil
+	}
+
+	content := string(data)
+	for _, module := range modules {
+		if strings.Contains(content, module) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// routerInfo tracks information about an Oak router variable.
+type routerInfo struct {
+	name   string
+	prefix string
+}
+
+// ExtractRoutes parses source files and extracts Oak route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	// First pass: build a map of file paths to their mount paths
+	fileMountPaths := p.buildFileMountMap(ctx, files)
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, fileMountPaths[file.Path])
+		if err != nil {
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// buildFileMountMap maps files to the prefix their routers are mounted at
+// by router.use('/path', imported.routes()) in another file. Deno imports
+// name the file they load, extension included.
+func (p *Plugin) buildFileMountMap(ctx context.Context, files []scanner.SourceFile) map[string]string {
+	known := make(map[string]bool, len(files))
+	for _, file := range files {
+		known[file.Path] = true
+	}
+
+	parents := make(map[string]string)
+	prefixes := make(map[string]string)
+	for _, file := range files {
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		routers := p.findRouterVariables(pf.RootNode, file.Content)
+		mounts := p.findRouterMounts(pf.RootNode, file.Content, routers)
+		for local, source := range p.findImports(pf.RootNode, file.Content) {
+			target := filepath.Join(filepath.Dir(file.Path), source)
+			if mount, ok := mounts[local]; ok && known[target] {
+				parents[target] = file.Path
+				prefixes[target] = mount
+			}
+		}
+
+		pf.Close()
+	}
+
+	fileMountPaths := make(map[string]string, len(parents))
+	for path := range parents {
+		prefix := ""
+		for cur, depth := path, 0; depth <= len(parents); depth++ {
+			parent, ok := parents[cur]
+			if !ok {
+				break
+			}
+			prefix = combinePaths(prefixes[cur], prefix)
+			cur = parent
+		}
+		fileMountPaths[path] = prefix
+	}
+	return fileMountPaths
+}
+
+// findImports maps the local names of default and named imports to the
+// relative module they come from.
+func (p *Plugin) findImports(rootNode *sitter.Node, content []byte) map[string]string {
+	imports := make(map[string]string)
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if node.Type() != "import_statement" {
+			return true
+		}
+		source := node.ChildByFieldName("source")
+		if source == nil {
+			return false
+		}
+		path := strings.Trim(source.Content(content), `"'`)
+		if !strings.HasPrefix(path, "./") && !strings.HasPrefix(path, "../") {
+			return false
+		}
+		p.walkNodes(node, func(n *sitter.Node) bool {
+			switch n.Type() {
+			case "import_clause":
+				for i := 0; i < int(n.ChildCount()); i++ {
+					if child := n.Child(i); child.Type() == "identifier" {
+						imports[child.Content(content)] = path
+					}
+				}
+			case "import_specifier":
+				name := n.ChildByFieldName("alias")
+				if name == nil {
+					name = n.ChildByFieldName("name")
+				}
+				if name != nil {
+					imports[name.Content(content)] = path
+				}
+			}
+			return true
+		})
+		return false
+	})
+
+	return imports
+}
+
+// extractRoutesFromFile extracts routes from a single file. mountPath is the
+// prefix the file's routers are mounted at by other files.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, mountPath string) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	if !p.hasOakImport(pf.RootNode, file.Content) {
+		decisions.Skip(p.Name(), file.Path, "no Oak import")
+		return nil, nil
+	}
+
+	routers := p.findRouterVariables(pf.RootNode, file.Content)
+	routerMounts := p.findRouterMounts(pf.RootNode, file.Content, routers)
+
+	var routes []types.Route
+	for _, call := range p.tsParser.FindCallExpressions(pf.RootNode, file.Content) {
+		route := p.extractRouteFromCall(call, pf.RootNode, file.Content, routers, routerMounts, mountPath)
+		if route != nil {
+			route.SourceFile = file.Path
+			routes = append(routes, *route)
+		}
+	}
+
+	return routes, nil
+}
+
+// hasOakImport checks if the file imports Oak, directly or from a deps.ts
+// module re-exporting it.
+func (p *Plugin) hasOakImport(rootNode *sitter.Node, content []byte) bool {
+	hasImport := false
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if node.Type() != "import_statement" {
+			return !hasImport
+		}
+		if source := node.ChildByFieldName("source"); source != nil {
+			module := strings.Trim(source.Content(content), `"'`)
+			if strings.HasSuffix(module, "/deps.ts") {
+				hasImport = true
+			}
+			for _, oak := range oakModules {
+				if strings.Contains(module, oak) {
+					hasImport = true
+				}
+			}
+		}
+		return false
+	})
+
+	return hasImport
+}
+
+// findRouterVariables finds variables holding new Router() instances.
+func (p *Plugin) findRouterVariables(rootNode *sitter.Node, content []byte) map[string]*routerInfo {
+	routers := make(map[string]*routerInfo)
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if node.Type() != "variable_declarator" {
+			return true
+		}
+		name := node.ChildByFieldName("name")
+		value := node.ChildByFieldName("value")
+		if name == nil || value == nil || value.Type() != "new_expression" {
+			return true
+		}
+		constructor := value.ChildByFieldName("constructor")
+		if constructor == nil || constructor.Content(content) != "Router" {
+			return true
+		}
+
+		info := &routerInfo{name: name.Content(content)}
+		if args := value.ChildByFieldName("arguments"); args != nil {
+			info.prefix = p.extractPrefixFromObject(args, content)
+		}
+		routers[info.name] = info
+		return true
+	})
+
+	return routers
+}
+
+// extractPrefixFromObject extracts the prefix property from router options.
+func (p *Plugin) extractPrefixFromObject(node *sitter.Node, content []byte) string {
+	var prefix string
+
+	p.walkNodes(node, func(n *sitter.Node) bool {
+		if n.Type() != "pair" {
+			return true
+		}
+		key := n.ChildByFieldName("key")
+		value := n.ChildByFieldName("value")
+		if key != nil && value != nil && key.Content(content) == "prefix" {
+			prefix, _ = p.tsParser.ExtractStringLiteral(value, content)
+			return false
+		}
+		return true
+	})
+
+	return prefix
+}
+
+// rootObject returns the router a possibly chained call is made on, so
+// router.get(...).post(...) resolves to router.
+func (p *Plugin) rootObject(callee *sitter.Node, content []byte) string {
+	object := callee.ChildByFieldName("object")
+	for object != nil && object.Type() == "call_expression" {
+		inner := object.ChildByFieldName("function")
+		if inner == nil || inner.Type() != "member_expression" {
+			return ""
+		}
+		object = inner.ChildByFieldName("object")
+	}
+	if object == nil || object.Type() != "identifier" {
+		return ""
+	}
+	return object.Content(content)
+}
+
+// findRouterMounts finds router.use('/prefix', nested.routes()) calls and
+// returns the full mount prefix of each nested router.
+func (p *Plugin) findRouterMounts(rootNode *sitter.Node, content []byte, routers map[string]*routerInfo) map[string]string {
+	parents := make(map[string]string)
+	paths := make(map[string]string)
+
+	for _, call := range p.tsParser.FindCallExpressions(rootNode, content) {
+		callee := call.ChildByFieldName("function")
+		if callee == nil || callee.Type() != "member_expression" {
+			continue
+		}
+		_, method := p.tsParser.GetMemberExpressionParts(callee, content)
+		object := p.rootObject(callee, content)
+		if method != "use" || routers[object] == nil {
+			continue
+		}
+
+		args := p.tsParser.GetCallArguments(call, content)
+		if len(args) < 2 {
+			continue
+		}
+		path, ok := p.tsParser.ExtractStringLiteral(args[0], content)
+		if !ok || args[1].Type() != "call_expression" {
+			continue
+		}
+		routesCallee := args[1].ChildByFieldName("function")
+		if routesCallee == nil || routesCallee.Type() != "member_expression" {
+			continue
+		}
+		nested, methodName := p.tsParser.GetMemberExpressionParts(routesCallee, content)
+		if methodName == "routes" && nested != object {
+			parents[nested] = object
+			paths[nested] = path
+		}
+	}
+
+	// Compose prefixes of routers mounted on mounted routers
+	mounts := make(map[string]string, len(parents))
+	for name := range parents {
+		prefix := ""
+		for cur, depth := name, 0; depth <= len(parents); depth++ {
+			parent, ok := parents[cur]
+			if !ok {
+				break
+			}
+			prefix = combinePaths(paths[cur], prefix)
+			if info := routers[parent]; info != nil {
+				prefix = combinePaths(info.prefix, prefix)
+			}
+			cur = parent
+		}
+		mounts[name] = prefix
+	}
+	return mounts
+}
+
+// extractRouteFromCall extracts a route from a router.method(path, ...handlers) call.
+func (p *Plugin) extractRouteFromCall(
+	node *sitter.Node,
+	rootNode *sitter.Node,
+	content []byte,
+	routers map[string]*routerInfo,
+	routerMounts map[string]string,
+	fileMountPath string,
+) *types.Route {
+	callee := node.ChildByFieldName("function")
+	if callee == nil || callee.Type() != "member_expression" {
+		return nil
+	}
+
+	_, method := p.tsParser.GetMemberExpressionParts(callee, content)
+	httpMethod, ok := httpMethods[strings.ToLower(method)]
+	if !ok {
+		return nil
+	}
+	object := p.rootObject(callee, content)
+	routerInf, ok := routers[object]
+	if !ok {
+		return nil
+	}
+
+	args := p.tsParser.GetCallArguments(node, content)
+	if len(args) == 0 {
+		return nil
+	}
+
+	// Named routes pass the route name first: router.get("user", "/users/:id", ...)
+	pathIdx := 0
+	if len(args) > 2 {
+		if second, ok := p.tsParser.ExtractStringLiteral(args[1], content); ok && strings.HasPrefix(second, "/") {
+			pathIdx = 1
+		}
+	}
+	path, unresolved, ok := p.tsParser.ResolveStringExpression(args[pathIdx], content)
+	if !ok || path == "" {
+		return nil
+	}
+
+	fullPath := combinePaths(routerInf.prefix, path)
+	fullPath = combinePaths(routerMounts[object], fullPath)
+	fullPath = combinePaths(fileMountPath, fullPath)
+	wildcard := plugins.IsCatchAll(fullPath)
+	fullPath = convertPathParams(fullPath)
+
+	route := &types.Route{
+		Method:      httpMethod,
+		Path:        fullPath,
+		OperationID: generateOperationID(httpMethod, fullPath, ""),
+		Tags:        inferTags(fullPath),
+		Parameters:  extractPathParams(fullPath),
+		SourceLine:  int(node.StartPoint().Row) + 1,
+	}
+	if len(unresolved) > 0 {
+		route.Diagnostics = append(route.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+	}
+	if wildcard {
+		plugins.MarkWildcard(route)
+	}
+
+	// The last handler reads the request body
+	if len(args) > pathIdx+1 {
+		handler := args[len(args)-1]
+		if handler.Type() == "identifier" {
+			route.Handler = handler.Content(content)
+			route.OperationID = generateOperationID(httpMethod, fullPath, route.Handler)
+			handler = p.findFunction(rootNode, content, route.Handler)
+		}
+		if handler != nil {
+			route.RequestBody = requestBody(handler.Content(content))
+		}
+	}
+
+	return route
+}
+
+// findFunction returns the declaration of a named function or a const
+// holding one.
+func (p *Plugin) findFunction(rootNode *sitter.Node, content []byte, name string) *sitter.Node {
+	var found *sitter.Node
+
+	p.walkNodes(rootNode, func(node *sitter.Node) bool {
+		if found != nil {
+			return false
+		}
+		switch node.Type() {
+		case "function_declaration", "variable_declarator":
+			if n := node.ChildByFieldName("name"); n != nil && n.Content(content) == name {
+				found = node
+				return false
+			}
+		}
+		return true
+	})
+
+	return found
+}
+
+// Regex patterns for request body reads in handlers
+var (
+	// Matches a typed read: const user: User = await ctx.request.body.json()
+	typedBodyRegex = regexp.MustCompile(`:\s*([A-Z]\w*)\s*=\s*await\s+\w+\.request\.body`)
+
+	// Matches a cast read: await ctx.request.body.json() as User
+	castBodyRegex = regexp.MustCompile(`\.request\.body(?:\.json\(\)|\([^)]*\)\.value)\)?\s+as\s+([A-Z]\w*)`)
+
+	// Matches any JSON body read: ctx.request.body.json() or ctx.request.body({ type: "json" })
+	bodyReadRegex = regexp.MustCompile(`\.request\.body(?:\.json\(\)|\(\s*(?:\{[^}]*json[^}]*\})?\s*\))`)
+)
+
+// requestBody infers the JSON request body read by a handler.
+func requestBody(handler string) *types.RequestBody {
+	var bodySchema *types.Schema
+	if m := typedBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if m := castBodyRegex.FindStringSubmatch(handler); m != nil {
+		bodySchema = schema.SchemaRef(m[1])
+	} else if bodyReadRegex.MatchString(handler) {
+		bodySchema = &types.Schema{Type: "object"}
+	} else {
+		return nil
+	}
+
+	return &types.RequestBody{
+		Required: true,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: bodySchema},
+		},
+	}
+}
+
+// walkNodes walks all nodes in the tree.
+func (p *Plugin) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
+	parser.Walk(node, fn)
+}
+
+// ExtractSchemas extracts schema definitions from TypeScript interfaces and Zod schemas.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		for _, zs := range pf.ZodSchemas {
+			p.zodParser.ExtractAndRegister(zs.Name, zs.Node, file.Content)
+		}
+
+		pf.Close()
+	}
+
+	tsExtractor.Registry().Merge(p.zodParser.Registry())
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// urlPatternParamRegex matches named parameters with an optional regex
+// group and modifier: :id, :id(\d+), :id?, :path*.
+var urlPatternParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)(?:\([^)]*\))?[?*+]?`)
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts URLPattern-style path params (:id) to OpenAPI
+// format ({id}).
+func convertPathParams(path string) string {
+	return urlPatternParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// combinePaths combines a prefix and path, handling slashes correctly.
+func combinePaths(prefix, path string) string {
+	if prefix == "" || prefix == "/" {
+		return path
+	}
+	if path == "" || path == "/" {
+		return prefix
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return prefix + path
+}
+
+// generateOperationID generates an operation ID from method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// Register registers the Oak plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package oak
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const oakMainCode = `
+import { Application, Router } from "jsr:@oak/oak";
+import { usersRouter } from "./users.ts";
+
+const router = new Router();
+router
+  .get("/health", (ctx) => { ctx.response.body = "ok"; })
+  .get("/files/:path*", (ctx) => { ctx.response.body = ""; });
+
+const api = new Router({ prefix: "/api" });
+api.use("/users", usersRouter.routes());
+
+const app = new Application();
+app.use(router.routes());
+app.use(api.routes());
+
+await app.listen({ port: 8000 });
+`
+
+const oakUsersCode = `
+import { Router, type RouterContext } from "jsr:@oak/oak";
+
+interface CreateUser {
+  name: string;
+  email?: string;
+}
+
+export const usersRouter = new Router();
+
+usersRouter.get("/", (ctx) => {
+  ctx.response.body = [];
+});
+
+usersRouter.get("/:id(\\d+)", (ctx) => {
+  ctx.response.body = { id: ctx.params.id };
+});
+
+usersRouter.post("/", createUser);
+
+usersRouter.patch("/:id", async (ctx) => {
+  const patch = await ctx.request.body.json();
+  ctx.response.body = patch;
+});
+
+async function createUser(ctx: RouterContext<"/">) {
+  const user: CreateUser = await ctx.request.body.json();
+  ctx.response.status = 201;
+  ctx.response.body = user;
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "oak", p.Name())
+	assert.Contains(t, p.Extensions(), ".ts")
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected bool
+	}{
+		{"deno.json jsr", "deno.json", `{"imports": {"@oak/oak": "jsr:@oak/oak@^17"}}`, true},
+		{"deps.ts", "deps.ts", `export { Application, Router } from "https://deno.land/x/oak@v12.6.1/mod.ts";`, true},
+		{"package.json", "package.json", `{"dependencies": {"@oakserver/oak": "^14.0.0"}}`, true},
+		{"other deno project", "deno.json", `{"imports": {"hono": "jsr:@hono/hono@^4"}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0o644))
+
+			found, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/main.ts", Language: "typescript", Content: []byte(oakMainCode)},
+		{Path: "/app/users.ts", Language: "typescript", Content: []byte(oakUsersCode)},
+	})
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	assert.Len(t, routes, 6)
+	for _, key := range []string{
+		"GET /health",
+		"GET /files/{path}",
+		"GET /api/users",
+		"GET /api/users/{id}",
+		"POST /api/users",
+		"PATCH /api/users/{id}",
+	} {
+		assert.Contains(t, byKey, key)
+	}
+
+	create := byKey["POST /api/users"]
+	assert.Equal(t, "createUser", create.Handler)
+	assert.Equal(t, "postCreateUser", create.OperationID)
+	assert.Equal(t, "/app/users.ts", create.SourceFile)
+	assert.Equal(t, 19, create.SourceLine)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUser", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	patch := byKey["PATCH /api/users/{id}"]
+	require.NotNil(t, patch.RequestBody)
+	assert.Equal(t, "object", patch.RequestBody.Content["application/json"].Schema.Type)
+	require.Len(t, patch.Parameters, 1)
+	assert.Equal(t, "id", patch.Parameters[0].Name)
+
+	assert.Nil(t, byKey["GET /api/users"].RequestBody)
+	assert.Equal(t, []string{"files"}, byKey["GET /files/{path}"].Tags)
+}
+
+func TestPlugin_ExtractRoutes_RequiresOakImport(t *testing.T) {
+	files := []scanner.SourceFile{{
+		Path:     "/app/other.ts",
+		Language: "typescript",
+		Content:  []byte("const router = new Router();\nrouter.get('/x', () => {});\n"),
+	}}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+}
+
+func TestConvertPathParams(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"/users/:id", "/users/{id}"},
+		{"/users/:id(\\d+)", "/users/{id}"},
+		{"/books/:id?", "/books/{id}"},
+		{"/files/:path*", "/files/{path}"},
+		{"/static", "/static"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, convertPathParams(tt.input))
+		})
+	}
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "/app/users.ts", Language: "typescript", Content: []byte(oakUsersCode)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, schemas, 1)
+	assert.Equal(t, "CreateUser", schemas[0].Title)
+	assert.Equal(t, []string{"name"}, schemas[0].Required)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -131,7 +133,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 			continue
 		}
 
-		fileRoutes := p.extractEndpoints(string(file.Content), file.Path)
+		fileRoutes := p.extractEndpoints(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, fileRoutes...)
 	}
 
@@ -377,7 +379,7 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 			continue
 		}
 
-		fileSchemas := p.extractDTOs(string(file.Content))
+		fileSchemas := p.extractDTOs(util.NormalizeNewlines(string(file.Content)))
 		schemas = append(schemas, fileSchemas...)
 	}
 
@@ -481,6 +483,11 @@ func oatppTypeToOpenAPI(oatppType string) (openAPIType string, format string) {
 		return "array", ""
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(oatppType); ok {
+		return t, f
+	}
+
 	switch oatppType {
 	case "String", "string":
 		return "string", ""
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	// requestPaths match the paths of requests in tests and HTTP files:
+	// quoted paths and URLs, as in get("/users/42") or
+	// fetch(`http://localhost/users/42`), and request lines such as
+	// GET /users/42 HTTP/1.1
+	requestPaths = []*regexp.Regexp{
+		regexp.MustCompile("[\"'`](?:https?://[^/\"'`\\s]+)?(/[^\"'`\\s?#]+)"),
+		regexp.MustCompile(`(?m)^\s*(?:GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS)\s+(?:https?://[^/\s]+)?(/[^\s?#]+)`),
+	}
+
+	// uuidValue matches a UUID
+	uuidValue = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// exampleValue matches the plain values a parameter example can take,
+	// excluding interpolations and placeholders such as ${id}, :id or %d
+	exampleValue = regexp.MustCompile(`^[\w.~-]+$`)
+)
+
+// MarkParameterExamples sets the example of path parameters that have none
+// to the value the requests in files, such as tests and HTTP files, most
+// often use for them: GET /users/3fa85f64-5717-4562-b3fc-2c963f66afa6 in a
+// test documents the id of /users/{id}. Parameters no request matches take
+// the value seed data assigns to a field of the same name, such as
+// slug: "hello-world" for {slug}. Values must fit the parameter's schema.
+func MarkParameterExamples(routes []types.Route, files []scanner.SourceFile) {
+	if len(files) == 0 {
+		return
+	}
+	var paths []string
+	for _, f := range files {
+		for _, re := range requestPaths {
+			for _, match := range re.FindAllStringSubmatch(string(f.Content), -1) {
+				paths = append(paths, strings.TrimSuffix(match[1], "/"))
+			}
+		}
+	}
+	// Literal routes such as /users/me are not values of /users/{id}
+	literal := make(map[string]bool)
+	for _, route := range routes {
+		if !strings.Contains(route.Path, "{") {
+			literal[route.Path] = true
+		}
+	}
+
+	for i := range routes {
+		route := &routes[i]
+		var pending []int
+		for j, param := range route.Parameters {
+			if param.In == "path" && param.Example == nil && (param.Schema == nil || param.Schema.Example == nil) {
+				pending = append(pending, j)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		matcher, names := pathMatcher(route.Path)
+		if matcher == nil {
+			continue
+		}
+
+		values := make(map[string]*valueCounts)
+		for _, path := range paths {
+			if literal[path] {
+				continue
+			}
+			match := matcher.FindStringSubmatch(path)
+			if match == nil {
+				continue
+			}
+			for k, name := range names {
+				if values[name] == nil {
+					values[name] = &valueCounts{counts: make(map[string]int)}
+				}
+				values[name].add(match[k+1])
+			}
+		}
+
+		for _, j := range pending {
+			param := &route.Parameters[j]
+			if example, ok := values[param.Name].best(param.Schema); ok {
+				param.Example = example
+			} else if example, ok := seedValues(files, param.Name).best(param.Schema); ok {
+				param.Example = example
+			}
+		}
+	}
+}
+
+// pathMatcher returns a regexp matching the concrete paths of a route path
+// template, capturing its parameters, and the parameter names in order.
+func pathMatcher(path string) (*regexp.Regexp, []string) {
+	var pattern strings.Builder
+	var names []string
+	pattern.WriteString("^")
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		pattern.WriteString("/")
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, segment[1:len(segment)-1])
+			pattern.WriteString("([^/]+)")
+			continue
+		}
+		if strings.ContainsAny(segment, "{}*") {
+			return nil, nil
+		}
+		pattern.WriteString(regexp.QuoteMeta(segment))
+	}
+	pattern.WriteString("$")
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return regexp.MustCompile(pattern.String()), names
+}
+
+// seedValues returns the values files assign to a field named name, in
+// camelCase or snake_case, as in slug: "hello-world" or "user_id" => 42.
+// Identifiers named id are too ambiguous to take from seeds.
+func seedValues(files []scanner.SourceFile, name string) *valueCounts {
+	if strings.EqualFold(name, "id") {
+		return nil
+	}
+	keys := regexp.QuoteMeta(name)
+	if snake := toSnakeCase(name); snake != name {
+		keys += "|" + regexp.QuoteMeta(snake)
+	}
+	re := regexp.MustCompile(`["']?\b(?:` + keys + `)\b["']?\s*(?::|=>?)\s*(?:["']([^"'\n]+)["']|(\d+)\b)`)
+	values := &valueCounts{counts: make(map[string]int)}
+	for _, f := range files {
+		for _, match := range re.FindAllStringSubmatch(string(f.Content), -1) {
+			values.add(match[1] + match[2])
+		}
+	}
+	return values
+}
+
+// toSnakeCase converts a camelCase name to snake_case.
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// valueCounts counts the values seen for a parameter in the order first
+// seen.
+type valueCounts struct {
+	order  []string
+	counts map[string]int
+}
+
+func (v *valueCounts) add(value string) {
+	if v.counts[value] == 0 {
+		v.order = append(v.order, value)
+	}
+	v.counts[value]++
+}
+
+// best returns the most frequent value that fits schema, the first seen on
+// ties, converted to an integer for integer parameters.
+func (v *valueCounts) best(schema *types.Schema) (any, bool) {
+	if v == nil {
+		return nil, false
+	}
+	best := ""
+	for _, value := range v.order {
+		if fitsSchema(value, schema) && (best == "" || v.counts[value] > v.counts[best]) {
+			best = value
+		}
+	}
+	if best == "" {
+		return nil, false
+	}
+	if schema != nil && schema.Type == "integer" {
+		n, _ := strconv.Atoi(best)
+		return n, true
+	}
+	return best, true
+}
+
+// fitsSchema reports whether value is a plain value of the type and format
+// of schema.
+func fitsSchema(value string, schema *types.Schema) bool {
+	if !exampleValue.MatchString(value) {
+		return false
+	}
+	if schema == nil {
+		return true
+	}
+	switch {
+	case schema.Type == "integer":
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case schema.Type == "number":
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case schema.Format == "uuid":
+		return uuidValue.MatchString(value)
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func pathParam(name string, schema *types.Schema) types.Parameter {
+	return types.Parameter{Name: name, In: "path", Required: true, Schema: schema}
+}
+
+func TestMarkParameterExamples(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{id}", Parameters: []types.Parameter{pathParam("id", &types.Schema{Type: "string", Format: "uuid"})}},
+		{Method: "GET", Path: "/users/me"},
+		{Method: "GET", Path: "/orders/{orderId}/items/{itemId}", Parameters: []types.Parameter{
+			pathParam("orderId", &types.Schema{Type: "integer"}),
+			pathParam("itemId", &types.Schema{Type: "string"}),
+		}},
+		{Method: "GET", Path: "/posts/{slug}", Parameters: []types.Parameter{pathParam("slug", &types.Schema{Type: "string"})}},
+		{Method: "GET", Path: "/tags/{tag}", Parameters: []types.Parameter{{Name: "tag", In: "path", Example: "go"}}},
+	}
+	files := []scanner.SourceFile{
+		{Path: "users.test.ts", Content: []byte(`
+it("gets a user", async () => {
+  await request(app).get("/users/me");
+  await request(app).get("/users/3fa85f64-5717-4562-b3fc-2c963f66afa6");
+  await request(app).get(` + "`/users/${id}`" + `);
+  await request(app).get("/users/3fa85f64-5717-4562-b3fc-2c963f66afa6");
+  await request(app).get("/users/not-a-uuid");
+});
+`)},
+		{Path: "orders.http", Content: []byte(`GET http://localhost:8080/orders/42/items/sku-1 HTTP/1.1
+GET /orders/abc/items/sku-2
+`)},
+		{Path: "db/seeds/posts.json", Content: []byte(`[{"title": "Hello", "slug": "hello-world"}]`)},
+	}
+
+	MarkParameterExamples(routes, files)
+
+	assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", routes[0].Parameters[0].Example)
+	// Integer parameters only take integer values
+	assert.Equal(t, 42, routes[2].Parameters[0].Example)
+	assert.Equal(t, "sku-1", routes[2].Parameters[1].Example)
+	// Seed data documents parameters no request uses
+	assert.Equal(t, "hello-world", routes[3].Parameters[0].Example)
+	// Existing examples are kept
+	assert.Equal(t, "go", routes[4].Parameters[0].Example)
+}
+
+func TestMarkParameterExamples_NoMatch(t *testing.T) {
+	routes := []types.Route{
+		{Method: "GET", Path: "/users/{id}", Parameters: []types.Parameter{pathParam("id", &types.Schema{Type: "integer"})}},
+	}
+	files := []scanner.SourceFile{
+		{Path: "seed.sql", Content: []byte(`INSERT INTO users (id, name) VALUES (1, 'Ada');`)},
+		{Path: "users_test.go", Content: []byte(`req := httptest.NewRequest("GET", "/users/:id", nil)`)},
+	}
+
+	MarkParameterExamples(routes, files)
+
+	assert.Nil(t, routes[0].Parameters[0].Example)
+}
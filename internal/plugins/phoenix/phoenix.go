@@ -17,6 +17,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -340,7 +341,9 @@ func (p *Plugin) convertEctoSchema(ecto parser.EctoSchema) *types.Schema {
 
 		// Set default value if present
 		if field.HasDefault && field.Default != "" {
-			propSchema.Default = p.parseEctoDefault(field.Default, field.Type)
+			if value, ok := parser.DefaultValue(field.Default, propSchema.Type); ok {
+				propSchema.Default = value
+			}
 		}
 
 		properties[field.Name] = propSchema
@@ -361,6 +364,10 @@ func (p *Plugin) convertEctoSchema(ecto parser.EctoSchema) *types.Schema {
 
 // ectoTypeToJSONSchema converts an Ecto type to a JSON Schema type.
 func (p *Plugin) ectoTypeToJSONSchema(ectoType string) *types.Schema {
+	if mapped, ok := typemap.Schema(ectoType); ok {
+		return mapped
+	}
+
 	switch ectoType {
 	case "string":
 		return &types.Schema{Type: "string"}
@@ -388,31 +395,6 @@ func (p *Plugin) ectoTypeToJSONSchema(ectoType string) *types.Schema {
 	}
 }
 
-// parseEctoDefault parses an Ecto default value.
-func (p *Plugin) parseEctoDefault(defaultVal, ectoType string) interface{} {
-	defaultVal = strings.TrimSpace(defaultVal)
-
-	switch ectoType {
-	case "boolean":
-		return defaultVal == "true"
-	case "integer", "id":
-		// Try to parse as integer
-		if defaultVal == "0" {
-			return 0
-		}
-		return defaultVal
-	case "float", "decimal":
-		if defaultVal == "0" || defaultVal == "0.0" {
-			return 0.0
-		}
-		return defaultVal
-	default:
-		// Return as string, removing quotes if present
-		defaultVal = strings.Trim(defaultVal, "\"'")
-		return defaultVal
-	}
-}
-
 // Register registers the Phoenix plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
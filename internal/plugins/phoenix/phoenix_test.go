@@ -437,6 +437,7 @@ end
 	assert.Contains(t, schema.Required, "age")
 	// active has a default, so not required
 	assert.NotContains(t, schema.Required, "active")
+	assert.Equal(t, true, schema.Properties["active"].Default)
 }
 
 func TestPlugin_ExtractSchemas_Empty(t *testing.T) {
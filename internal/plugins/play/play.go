@@ -126,7 +126,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 	// Also check any routes files that might have been passed in directly
 	for _, file := range files {
 		// Check for conf/routes file (in case it was included)
-		if strings.HasSuffix(file.Path, "conf/routes") || strings.HasSuffix(file.Path, "/routes") {
+		if slashPath := filepath.ToSlash(file.Path); strings.HasSuffix(slashPath, "conf/routes") || strings.HasSuffix(slashPath, "/routes") {
 			// Skip if we already processed this file
 			if len(routes) > 0 {
 				continue
@@ -5,6 +5,9 @@
 package plugins
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/api2spec/api2spec/internal/scanner"
 	"github.com/api2spec/api2spec/pkg/types"
 )
@@ -60,3 +63,13 @@ type RouteExtractor interface {
 type SchemaExtractor interface {
 	ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error)
 }
+
+// UnresolvedPathDiagnostic describes path expressions that could not be
+// resolved to constants and were emitted as path parameters instead.
+func UnresolvedPathDiagnostic(unresolved []string) string {
+	quoted := make([]string, len(unresolved))
+	for i, expr := range unresolved {
+		quoted[i] = "${" + expr + "}"
+	}
+	return fmt.Sprintf("path expression %s could not be resolved; emitted as a path parameter", strings.Join(quoted, ", "))
+}
@@ -0,0 +1,568 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package polka provides a plugin for extracting routes from Polka
+// applications, including sub-applications mounted with app.use.
+package polka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps Polka's route methods to HTTP methods.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"patch":   "PATCH",
+	"delete":  "DELETE",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+	"trace":   "TRACE",
+	"all":     "ALL",
+}
+
+// anyMethod are the methods documented for routes registered with all().
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// Plugin implements the FrameworkPlugin interface for Polka.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new Polka plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "polka"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js", ".mts", ".mjs", ".cjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "polka",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Polka applications and mounted sub-applications",
+		SupportedFrameworks: []string{
+			"polka",
+		},
+	}
+}
+
+// Detect checks if Polka is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["polka"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["polka"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// file is a parsed source file and the applications it creates.
+type file struct {
+	pf     *parser.ParsedTSFile
+	module string
+
+	// apps are the variables holding Polka applications
+	apps map[string]bool
+
+	// imports maps the variables bound to relative imports to the modules
+	// they import
+	imports map[string]string
+
+	// exported is the application variable the file exports, if any
+	exported string
+}
+
+// mount is a sub-application mounted on an application at a prefix.
+type mount struct {
+	parent string
+	prefix string
+}
+
+// ExtractRoutes parses source files and extracts the routes of Polka
+// applications. Sub-applications mounted with app.use(prefix, sub) get
+// the prefixes of every application they are mounted under, also across
+// files.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var parsed []*file
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" && f.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, p.collectFile(pf))
+	}
+	defer func() {
+		for _, f := range parsed {
+			f.pf.Close()
+		}
+	}()
+
+	exports := make(map[string]string, len(parsed))
+	for _, f := range parsed {
+		if f.exported != "" {
+			exports[f.module] = appKey(f.module, f.exported)
+		}
+	}
+	mounts := make(map[string]mount)
+	for _, f := range parsed {
+		p.collectMounts(f, exports, mounts)
+	}
+
+	var routes []types.Route
+	for _, f := range parsed {
+		routes = append(routes, p.fileRoutes(f, mounts)...)
+	}
+
+	return routes, nil
+}
+
+// appKey identifies an application by its module and variable; chains on
+// polka() itself have no variable.
+func appKey(module, name string) string {
+	return module + "#" + name
+}
+
+// collectFile indexes the applications, relative imports and export of a
+// file.
+func (p *Plugin) collectFile(pf *parser.ParsedTSFile) *file {
+	f := &file{
+		pf:      pf,
+		module:  moduleName(pf.Path),
+		apps:    make(map[string]bool),
+		imports: make(map[string]string),
+	}
+	content := pf.Content
+
+	parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "variable_declarator":
+			name := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if name == nil || value == nil || name.Type() != "identifier" {
+				return true
+			}
+			value = parser.UnwrapExpression(value)
+			if value.Type() != "call_expression" {
+				return true
+			}
+			if chainRoot(value, content) != nil {
+				f.apps[name.Content(content)] = true
+				return true
+			}
+			switch p.tsParser.GetCalleeText(value, content) {
+			case "require":
+				if args := parser.CallArguments(value); len(args) == 1 {
+					if target := resolveImport(pf.Path, parser.StringValue(args[0], content)); target != "" {
+						f.imports[name.Content(content)] = target
+					}
+				}
+			}
+		case "import_statement":
+			source := node.ChildByFieldName("source")
+			if source == nil {
+				return false
+			}
+			target := resolveImport(pf.Path, parser.StringValue(source, content))
+			if target == "" {
+				return false
+			}
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				if clause := node.NamedChild(i); clause.Type() == "import_clause" {
+					if id := clause.NamedChild(0); id != nil && id.Type() == "identifier" {
+						f.imports[id.Content(content)] = target
+					}
+				}
+			}
+			return false
+		case "assignment_expression":
+			// module.exports = app
+			left := node.ChildByFieldName("left")
+			right := node.ChildByFieldName("right")
+			if left != nil && right != nil && left.Content(content) == "module.exports" && right.Type() == "identifier" {
+				f.exported = right.Content(content)
+			}
+		case "export_statement":
+			// export default app
+			if value := node.ChildByFieldName("value"); value != nil && value.Type() == "identifier" {
+				f.exported = value.Content(content)
+			}
+		}
+		return true
+	})
+
+	return f
+}
+
+// collectMounts records the sub-applications a file mounts with
+// app.use(prefix, ...middleware, sub).
+func (p *Plugin) collectMounts(f *file, exports map[string]string, mounts map[string]mount) {
+	content := f.pf.Content
+	parser.Walk(f.pf.RootNode, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" || parser.CalledMethod(node, content) != "use" {
+			return true
+		}
+		parent, ok := f.receiver(node, content)
+		if !ok {
+			return true
+		}
+		args := parser.CallArguments(node)
+		if len(args) < 2 {
+			return true
+		}
+		prefix, ok := parser.StringLiteral(args[0], content)
+		if !ok {
+			return true
+		}
+		sub := parser.UnwrapExpression(args[len(args)-1])
+		if sub.Type() != "identifier" {
+			return true
+		}
+		name := sub.Content(content)
+		var child string
+		if f.apps[name] {
+			child = appKey(f.module, name)
+		} else if target, ok := f.imports[name]; ok {
+			child = exports[target]
+		}
+		if child != "" {
+			mounts[child] = mount{parent: appKey(f.module, parent), prefix: "/" + strings.Trim(prefix, "/")}
+		}
+		return true
+	})
+}
+
+// receiver returns the application variable a method is called on,
+// following chained calls (app.use(a).get(...)). Chains starting from
+// polka() belong to the variable they are assigned to, if any.
+func (f *file) receiver(call *sitter.Node, content []byte) (string, bool) {
+	if root := chainRoot(call, content); root != nil {
+		return chainVariable(root, content), true
+	}
+	fn := call.ChildByFieldName("function")
+	if fn == nil || fn.Type() != "member_expression" {
+		return "", false
+	}
+	object := parser.UnwrapExpression(fn.ChildByFieldName("object"))
+	for object != nil && object.Type() == "call_expression" {
+		callee := object.ChildByFieldName("function")
+		if callee == nil || callee.Type() != "member_expression" {
+			return "", false
+		}
+		object = parser.UnwrapExpression(callee.ChildByFieldName("object"))
+	}
+	if object == nil || object.Type() != "identifier" {
+		return "", false
+	}
+	name := object.Content(content)
+	return name, f.apps[name]
+}
+
+// chainRoot returns the polka() call a chain of method calls starts from,
+// or nil.
+func chainRoot(call *sitter.Node, content []byte) *sitter.Node {
+	for call != nil && call.Type() == "call_expression" {
+		callee := call.ChildByFieldName("function")
+		if callee == nil {
+			return nil
+		}
+		if callee.Type() == "identifier" {
+			if callee.Content(content) == "polka" {
+				return call
+			}
+			return nil
+		}
+		if callee.Type() != "member_expression" {
+			return nil
+		}
+		call = parser.UnwrapExpression(callee.ChildByFieldName("object"))
+	}
+	return nil
+}
+
+// chainVariable returns the variable a chain starting from a polka() call
+// is assigned to, or "".
+func chainVariable(root *sitter.Node, content []byte) string {
+	node := root
+	for node.Parent() != nil {
+		switch node.Parent().Type() {
+		case "member_expression", "call_expression", "parenthesized_expression":
+			node = node.Parent()
+			continue
+		case "variable_declarator":
+			if name := node.Parent().ChildByFieldName("name"); name != nil && name.Type() == "identifier" {
+				return name.Content(content)
+			}
+		}
+		break
+	}
+	return ""
+}
+
+// prefix returns the full mount prefix of an application.
+func prefix(key string, mounts map[string]mount, depth int) string {
+	m, ok := mounts[key]
+	if !ok || depth > len(mounts) {
+		return ""
+	}
+	return prefix(m.parent, mounts, depth+1) + m.prefix
+}
+
+// fileRoutes extracts the routes registered on the applications of a file.
+func (p *Plugin) fileRoutes(f *file, mounts map[string]mount) []types.Route {
+	content := f.pf.Content
+	var routes []types.Route
+
+	parser.Walk(f.pf.RootNode, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" {
+			return true
+		}
+		method, ok := httpMethods[parser.CalledMethod(node, content)]
+		if !ok {
+			return true
+		}
+		app, ok := f.receiver(node, content)
+		if !ok {
+			return true
+		}
+		args := parser.CallArguments(node)
+		if len(args) < 2 {
+			return true
+		}
+		rawPath, ok := parser.StringLiteral(args[0], content)
+		if !ok {
+			return true
+		}
+
+		fullPath := joinPath(prefix(appKey(f.module, app), mounts, 0), rawPath)
+		openAPIPath := convertPath(fullPath)
+		handler := handlerName(args[len(args)-1], content)
+
+		methods := []string{method}
+		if method == "ALL" {
+			methods = anyMethod
+		}
+		for _, m := range methods {
+			route := types.Route{
+				Method:      m,
+				Path:        openAPIPath,
+				Handler:     handler,
+				OperationID: generateOperationID(m, openAPIPath, handler),
+				Tags:        inferTags(openAPIPath),
+				Parameters:  extractPathParams(openAPIPath),
+				SourceFile:  f.pf.Path,
+				SourceLine:  int(node.StartPoint().Row) + 1,
+			}
+			if plugins.IsCatchAll(rawPath) {
+				plugins.MarkWildcard(&route)
+			}
+			routes = append(routes, route)
+		}
+		return true
+	})
+
+	return routes
+}
+
+// ExtractSchemas extracts TypeScript interfaces and type aliases.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		pf.Close()
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param, and
+// optional parameters in the format :param?.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)\??`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPath converts a Polka path to OpenAPI format.
+func convertPath(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// handlerName names a route handler: a function identifier or member
+// expression. Inline functions have no name.
+func handlerName(node *sitter.Node, content []byte) string {
+	switch node = parser.UnwrapExpression(node); node.Type() {
+	case "identifier", "member_expression":
+		return node.Content(content)
+	}
+	return ""
+}
+
+// resolveImport resolves a relative import to the module name of the
+// imported file, or "" for package imports.
+func resolveImport(from, source string) string {
+	if !strings.HasPrefix(source, "./") && !strings.HasPrefix(source, "../") {
+		return ""
+	}
+	return moduleName(path.Join(path.Dir(filepath.ToSlash(from)), source))
+}
+
+// moduleName returns the name a file is imported by: its path without the
+// extension, and without /index for index files.
+func moduleName(filePath string) string {
+	name := filepath.ToSlash(filePath)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	return strings.TrimSuffix(name, "/index")
+}
+
+// joinPath joins a mount prefix and a path.
+func joinPath(prefix, p string) string {
+	if prefix == "" {
+		return p
+	}
+	joined := "/" + strings.Trim(prefix, "/") + "/" + strings.TrimPrefix(p, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return joined
+}
+
+// generateOperationID generates an operation ID from the handler name, or
+// else from the method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		parts := strings.Split(handler, ".")
+		return strings.ToLower(method) + cases.Title(language.English, cases.NoLower).String(parts[len(parts)-1])
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	words := strings.Fields(strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(path))
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+	return sb.String()
+}
+
+// inferTags tags a route with its first static path segment that is not
+// an API or version prefix.
+func inferTags(path string) []string {
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		switch {
+		case segment == "", strings.HasPrefix(segment, "{"), segment == "api", versionSegmentRegex.MatchString(segment):
+			continue
+		}
+		return []string{segment}
+	}
+	return nil
+}
+
+// versionSegmentRegex matches version path segments like v1.
+var versionSegmentRegex = regexp.MustCompile(`^v\d+$`)
+
+// Register registers the Polka plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
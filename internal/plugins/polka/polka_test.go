@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package polka
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const serverFixture = `
+const polka = require('polka');
+const { json } = require('body-parser');
+const users = require('./users');
+
+const items = polka()
+  .get('/', listItems)
+  .get('/:id', (req, res) => res.end(req.params.id));
+
+polka()
+  .use(json())
+  .use('/api/users', auth, users)
+  .use('items', items)
+  .get('/files/*', serveFile)
+  .all('/ping', ping)
+  .listen(3000);
+
+fetch.get('/not-a-route', handler);
+`
+
+const usersFixture = `
+import polka from 'polka';
+import * as handlers from './handlers';
+
+const app = polka();
+
+app.get('/', handlers.list);
+app.post('/', handlers.create);
+app.put('/:id/:field?', handlers.update);
+
+export default app;
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"polka": "^0.5.2"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.js", Language: "javascript", Content: []byte(serverFixture)},
+	})
+	require.NoError(t, err)
+
+	files := findRoute(routes, "GET", "/files/{path}")
+	require.NotNil(t, files)
+	assert.Equal(t, "serveFile", files.Handler)
+	assert.Equal(t, "getServeFile", files.OperationID)
+	assert.Equal(t, "/app/server.js", files.SourceFile)
+	assert.Equal(t, true, files.Extensions["x-wildcard"])
+
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		assert.NotNil(t, findRoute(routes, method, "/ping"), method)
+	}
+
+	assert.Nil(t, findRoute(routes, "GET", "/not-a-route"), "only Polka applications register routes")
+}
+
+func TestPlugin_ExtractRoutes_SubApplications(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.js", Language: "javascript", Content: []byte(serverFixture)},
+		{Path: "/app/users/index.ts", Language: "typescript", Content: []byte(usersFixture)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/items")
+	require.NotNil(t, list, "mount prefixes are normalized")
+	assert.Equal(t, "listItems", list.Handler)
+	assert.Equal(t, []string{"items"}, list.Tags)
+
+	show := findRoute(routes, "GET", "/items/{id}")
+	require.NotNil(t, show)
+	assert.Empty(t, show.Handler)
+	assert.Equal(t, "getItemsByid", show.OperationID)
+
+	create := findRoute(routes, "POST", "/api/users")
+	require.NotNil(t, create, "imported sub-applications get the prefix they are mounted at")
+	assert.Equal(t, "handlers.create", create.Handler)
+	assert.Equal(t, "/app/users/index.ts", create.SourceFile)
+	assert.Equal(t, []string{"users"}, create.Tags)
+
+	update := findRoute(routes, "PUT", "/api/users/{id}/{field}")
+	require.NotNil(t, update, "optional parameters are converted")
+	require.Len(t, update.Parameters, 2)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/plugins/ruby"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -171,13 +172,14 @@ func (p *Plugin) convertRoute(route parser.RubyRoute, prefix, filePath string) *
 	fullPath := combinePaths(prefix, route.Path)
 
 	// Convert :param to {param} format
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertRailsPathParams(fullPath)
 
 	params := extractPathParams(fullPath)
 	operationID := generateOperationID(route.Method, fullPath, route.Action)
 	tags := inferTags(fullPath)
 
-	return &types.Route{
+	r := &types.Route{
 		Method:      route.Method,
 		Path:        fullPath,
 		Handler:     route.Controller + "#" + route.Action,
@@ -187,15 +189,21 @@ func (p *Plugin) convertRoute(route parser.RubyRoute, prefix, filePath string) *
 		SourceFile:  filePath,
 		SourceLine:  route.Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(r)
+	}
+
+	return r
 }
 
 // railsParamRegex matches Rails path parameters like :param.
 var railsParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
 
 
-// convertRailsPathParams converts Rails-style path params (:id) to OpenAPI format ({id}).
+// convertRailsPathParams converts Rails-style path params (:id) to OpenAPI format ({id}),
+// and route globbing (*path) to a templated {path}.
 func convertRailsPathParams(path string) string {
-	return railsParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(railsParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -330,7 +338,7 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 			continue
 		}
 
-		content := string(file.Content)
+		content := util.NormalizeNewlines(string(file.Content))
 
 		// Extract schemas from controller files
 		if strings.Contains(file.Path, "controller") {
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+var (
+	// rawBodyRead matches handlers reading the raw request body: Go's
+	// io.ReadAll(r.Body) and json.NewDecoder(r.Body), Node's
+	// req.on('data') and raw-body, fetch-style req.text(), and Python's
+	// request.body, await request.body(), request.get_data() and
+	// await request.read()
+	rawBodyRead = regexp.MustCompile(`\b(?:io|ioutil)\.ReadAll\(\s*\w+\.Body\s*\)|\bjson\.NewDecoder\(\s*\w+\.Body\s*\)|` +
+		`\.on\(\s*['"]data['"]|\bgetRawBody\(|\brawBody\b|\breq(?:uest)?\.(?:text|arrayBuffer)\(\)|` +
+		`\brequest\.(?:body|data|stream)\b|\brequest\.get_data\(|\bawait\s+request\.(?:read|text)\(\)`)
+
+	// goUnmarshalTarget matches the variable Go code unmarshals the body
+	// into, as in json.Unmarshal(body, &req) or Decode(&req)
+	goUnmarshalTarget = regexp.MustCompile(`\bjson\.Unmarshal\(\s*[\w.]+\s*,\s*&?(\w+)\s*\)|\.Decode\(\s*&?(\w+)\s*\)`)
+
+	// parsedTypes match the type JavaScript and Python code parses the body
+	// as: JSON.parse(raw) as T, const x: T = JSON.parse(raw),
+	// <T>JSON.parse(raw), T.parse_raw(raw), T.model_validate_json(raw),
+	// T.model_validate(data), T(**data) and x: T = json.loads(raw)
+	parsedTypes = []*regexp.Regexp{
+		regexp.MustCompile(`\bJSON\.parse\([^;\n]*\)\s+as\s+([A-Z]\w*)`),
+		regexp.MustCompile(`:\s*([A-Z]\w*)\s*=\s*(?:await\s+)?(?:JSON\.parse|json\.loads)\(`),
+		regexp.MustCompile(`<([A-Z]\w*)>\s*JSON\.parse\(`),
+		regexp.MustCompile(`\b([A-Z]\w*)\.(?:parse_raw|model_validate_json|parse_obj|model_validate)\(`),
+		regexp.MustCompile(`\b([A-Z]\w*)\(\s*\*\*\w`),
+	}
+
+	// declaredTypes match the declarations of types a body can be parsed
+	// into: Go structs, TypeScript interfaces, classes and object types, and
+	// Python classes
+	declaredTypes = regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:type\s+(\w+)\s+struct\b|(?:interface|class)\s+(\w+)|type\s+(\w+)\s*=\s*\{)`)
+)
+
+// MarkRawBodies sets the request body of routes without one whose handler
+// reads the raw body and parses it into a declared type, such as
+// io.ReadAll(r.Body) followed by json.Unmarshal(body, &req), req.on('data')
+// followed by JSON.parse(raw) as CreateUser, or await request.body()
+// followed by CreateUser.parse_raw(raw). Codebases that skip validation
+// middleware document their bodies this way.
+func MarkRawBodies(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	declared := make(map[string]bool)
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+		for _, match := range declaredTypes.FindAllStringSubmatch(string(f.Content), -1) {
+			for _, name := range match[1:] {
+				if name != "" {
+					declared[name] = true
+				}
+			}
+		}
+	}
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+	// Declarations are looked up by lowercase handler name
+	declarations := make(map[string][][]string)
+	for name, decls := range indexDeclarations(sources) {
+		key := strings.ToLower(name)
+		declarations[key] = append(declarations[key], decls...)
+	}
+
+	for i := range routes {
+		route := &routes[i]
+		switch strings.ToUpper(route.Method) {
+		case "GET", "HEAD", "OPTIONS":
+			continue
+		}
+		if route.RequestBody != nil {
+			continue
+		}
+		for _, lines := range routeSource(route, routeLines[route.SourceFile], sources, declarations) {
+			source := strings.Join(lines, "\n")
+			if !rawBodyRead.MatchString(source) {
+				continue
+			}
+			if name := parsedBodyType(source, declared); name != "" {
+				route.RequestBody = &types.RequestBody{
+					Required: true,
+					Content: map[string]types.MediaType{
+						"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/" + name}},
+					},
+				}
+				break
+			}
+		}
+	}
+}
+
+// parsedBodyType returns the declared type source parses the raw body into,
+// or "".
+func parsedBodyType(source string, declared map[string]bool) string {
+	for _, match := range goUnmarshalTarget.FindAllStringSubmatch(source, -1) {
+		variable := match[1] + match[2]
+		if name := goVariableType(source, variable); declared[name] {
+			return name
+		}
+	}
+	for _, re := range parsedTypes {
+		for _, match := range re.FindAllStringSubmatch(source, -1) {
+			if declared[match[1]] {
+				return match[1]
+			}
+		}
+	}
+	return ""
+}
+
+// goVariableType returns the type Go source declares variable with, without
+// its package, as in var req CreateUser, req := &dto.CreateUser{} or
+// req := new(CreateUser); "" if it declares none.
+func goVariableType(source, variable string) string {
+	quoted := regexp.QuoteMeta(variable)
+	re := regexp.MustCompile(`\bvar\s+` + quoted + `\s+\*?([\w.]+)|\b` + quoted + `\s*:=\s*(?:&?([\w.]+)\{|new\(([\w.]+)\))`)
+	match := re.FindStringSubmatch(source)
+	if match == nil {
+		return ""
+	}
+	name := match[1] + match[2] + match[3]
+	if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
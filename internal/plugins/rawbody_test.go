@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func bodyRef(route types.Route) string {
+	if route.RequestBody == nil {
+		return ""
+	}
+	return route.RequestBody.Content["application/json"].Schema.Ref
+}
+
+func TestMarkRawBodies_Go(t *testing.T) {
+	code := `package main
+
+type CreateOrder struct {
+	SKU string ` + "`json:\"sku\"`" + `
+}
+
+func createOrder(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return
+	}
+	var order CreateOrder
+	if err := json.Unmarshal(body, &order); err != nil {
+		return
+	}
+}
+
+func updateOrder(w http.ResponseWriter, r *http.Request) {
+	order := &models.CreateOrder{}
+	json.NewDecoder(r.Body).Decode(order)
+}
+
+func importOrders(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var rows map[string]any
+	json.Unmarshal(body, &rows)
+}
+`
+	files := []scanner.SourceFile{{Path: "orders.go", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "POST", Path: "/orders", Handler: "createOrder", SourceFile: "main.go", SourceLine: 1},
+		{Method: "PUT", Path: "/orders/{id}", Handler: "updateOrder", SourceFile: "main.go", SourceLine: 2},
+		{Method: "POST", Path: "/orders/import", Handler: "importOrders", SourceFile: "main.go", SourceLine: 3},
+		{Method: "GET", Path: "/orders", Handler: "createOrder", SourceFile: "main.go", SourceLine: 4},
+	}
+
+	MarkRawBodies(routes, files)
+
+	require.NotNil(t, routes[0].RequestBody)
+	assert.True(t, routes[0].RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/CreateOrder", bodyRef(routes[0]))
+	assert.Equal(t, "#/components/schemas/CreateOrder", bodyRef(routes[1]))
+	assert.Nil(t, routes[2].RequestBody)
+	assert.Nil(t, routes[3].RequestBody)
+}
+
+func TestMarkRawBodies_Node(t *testing.T) {
+	code := `interface CreateUser {
+  name: string;
+}
+
+app.post('/users', (req, res) => {
+  let raw = '';
+  req.on('data', (chunk) => { raw += chunk; });
+  req.on('end', () => {
+    const user = JSON.parse(raw) as CreateUser;
+    res.status(201).json(user);
+  });
+});
+app.post('/events', (req, res) => {
+  req.on('data', () => {});
+});
+`
+	files := []scanner.SourceFile{{Path: "server.ts", Content: []byte(code)}}
+	existing := &types.RequestBody{Content: map[string]types.MediaType{"application/json": {Schema: &types.Schema{Type: "object"}}}}
+	routes := []types.Route{
+		{Method: "POST", Path: "/users", SourceFile: "server.ts", SourceLine: 5},
+		{Method: "POST", Path: "/events", SourceFile: "server.ts", SourceLine: 13},
+		{Method: "PUT", Path: "/users", SourceFile: "server.ts", SourceLine: 5, RequestBody: existing},
+	}
+
+	MarkRawBodies(routes, files)
+
+	assert.Equal(t, "#/components/schemas/CreateUser", bodyRef(routes[0]))
+	assert.Nil(t, routes[1].RequestBody)
+	assert.Same(t, existing, routes[2].RequestBody)
+}
+
+func TestMarkRawBodies_Python(t *testing.T) {
+	code := `class CreateItem(BaseModel):
+    name: str
+
+
+@app.post("/items")
+async def create_item(request: Request):
+    raw = await request.body()
+    item = CreateItem.parse_raw(raw)
+    return item
+
+
+def update_item(request):
+    data = json.loads(request.body)
+    item = CreateItem(**data)
+`
+	files := []scanner.SourceFile{{Path: "main.py", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "POST", Path: "/items", Handler: "create_item", SourceFile: "main.py", SourceLine: 5},
+		{Method: "PATCH", Path: "/items/{id}", Handler: "update_item", SourceFile: "urls.py", SourceLine: 1},
+	}
+
+	MarkRawBodies(routes, files)
+
+	assert.Equal(t, "#/components/schemas/CreateItem", bodyRef(routes[0]))
+	assert.Equal(t, "#/components/schemas/CreateItem", bodyRef(routes[1]))
+}
@@ -65,22 +65,33 @@ func (r *Registry) Get(name string) FrameworkPlugin {
 }
 
 // Detect attempts to auto-detect which framework is being used in the project.
-// It iterates through all registered plugins and returns the first one that
-// successfully detects its framework. Returns an error if no framework is detected.
+// It iterates through the registered plugins by name and returns the first one
+// that successfully detects its framework, without running the detection of
+// the rest. Returns an error if no framework is detected.
 func (r *Registry) Detect(projectRoot string) (FrameworkPlugin, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	// Get sorted names for deterministic detection order
-	names := make([]string, 0, len(r.plugins))
-	for name := range r.plugins {
-		names = append(names, name)
+	// With multiple frameworks the first one wins; in practice the caller
+	// should use an explicit --framework flag
+	for _, name := range r.sortedNames() {
+		plugin := r.plugins[name]
+		if detected, err := plugin.Detect(projectRoot); err == nil && detected {
+			return plugin, nil
+		}
 	}
-	sort.Strings(names)
+	return nil, fmt.Errorf("no framework detected in project %s", projectRoot)
+}
+
+// DetectAll returns every plugin that detects its framework in the project,
+// sorted by name.
+func (r *Registry) DetectAll(projectRoot string) []FrameworkPlugin {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
 	var detectedPlugins []FrameworkPlugin
 
-	for _, name := range names {
+	for _, name := range r.sortedNames() {
 		plugin := r.plugins[name]
 		detected, err := plugin.Detect(projectRoot)
 		if err != nil {
@@ -92,21 +103,7 @@ func (r *Registry) Detect(projectRoot string) (FrameworkPlugin, error) {
 		}
 	}
 
-	if len(detectedPlugins) == 0 {
-		return nil, fmt.Errorf("no framework detected in project %s", projectRoot)
-	}
-
-	if len(detectedPlugins) > 1 {
-		names := make([]string, len(detectedPlugins))
-		for i, p := range detectedPlugins {
-			names[i] = p.Name()
-		}
-		// Return the first detected plugin but warn about multiple frameworks
-		// In practice, the caller should use explicit --framework flag
-		return detectedPlugins[0], nil
-	}
-
-	return detectedPlugins[0], nil
+	return detectedPlugins
 }
 
 // List returns a sorted list of registered plugin names.
@@ -114,6 +111,12 @@ func (r *Registry) List() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	return r.sortedNames()
+}
+
+// sortedNames returns the plugin names in detection order; the caller holds
+// the lock.
+func (r *Registry) sortedNames() []string {
 	names := make([]string, 0, len(r.plugins))
 	for name := range r.plugins {
 		names = append(names, name)
@@ -153,6 +156,19 @@ func (r *Registry) Unregister(name string) error {
 	return nil
 }
 
+// Clone returns a registry with the plugins of r, which can be extended
+// without affecting r.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clone := NewRegistry()
+	for name, plugin := range r.plugins {
+		clone.plugins[name] = plugin
+	}
+	return clone
+}
+
 // Clear removes all plugins from the registry.
 func (r *Registry) Clear() {
 	r.mu.Lock()
@@ -183,6 +199,12 @@ func Detect(projectRoot string) (FrameworkPlugin, error) {
 	return globalRegistry.Detect(projectRoot)
 }
 
+// DetectAll returns every plugin in the global registry that detects its
+// framework in the project.
+func DetectAll(projectRoot string) []FrameworkPlugin {
+	return globalRegistry.DetectAll(projectRoot)
+}
+
 // List returns all registered plugin names from the global registry.
 func List() []string {
 	return globalRegistry.List()
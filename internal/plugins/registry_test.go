@@ -186,6 +186,22 @@ func TestRegistry_Detect(t *testing.T) {
 	})
 }
 
+func TestRegistry_DetectAll(t *testing.T) {
+	reg := NewRegistry()
+
+	reg.Register(&mockPlugin{name: "zebra", detected: true})
+	reg.Register(&mockPlugin{name: "middle", detected: false})
+	reg.Register(&mockPlugin{name: "broken", detected: true, detectErr: assert.AnError})
+	reg.Register(&mockPlugin{name: "alpha", detected: true})
+
+	detected := reg.DetectAll("/project")
+	require.Len(t, detected, 2)
+	assert.Equal(t, "alpha", detected[0].Name())
+	assert.Equal(t, "zebra", detected[1].Name())
+
+	assert.Empty(t, NewRegistry().DetectAll("/project"))
+}
+
 func TestRegistry_Count(t *testing.T) {
 	reg := NewRegistry()
 
@@ -252,3 +268,40 @@ func TestGlobalRegistryFunctions(t *testing.T) {
 	assert.NotNil(t, Get("global-test"))
 	assert.Contains(t, List(), "global-test")
 }
+
+// countingPlugin counts its Detect calls.
+type countingPlugin struct {
+	mockPlugin
+	calls int
+}
+
+func (c *countingPlugin) Detect(projectRoot string) (bool, error) {
+	c.calls++
+	return c.detected, c.detectErr
+}
+
+func TestRegistry_Detect_StopsAtFirst(t *testing.T) {
+	reg := NewRegistry()
+
+	alpha := &countingPlugin{mockPlugin: mockPlugin{name: "alpha", detected: true}}
+	zebra := &countingPlugin{mockPlugin: mockPlugin{name: "zebra", detected: true}}
+	reg.Register(zebra)
+	reg.Register(alpha)
+
+	plugin, err := reg.Detect("/project")
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", plugin.Name())
+	assert.Equal(t, 1, alpha.calls)
+	assert.Equal(t, 0, zebra.calls, "plugins after the detected one are not run")
+}
+
+func TestRegistry_Clone(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register(&mockPlugin{name: "one"})
+
+	clone := reg.Clone()
+	require.NoError(t, clone.Register(&mockPlugin{name: "two"}))
+
+	assert.Equal(t, []string{"one", "two"}, clone.List())
+	assert.Equal(t, []string{"one"}, reg.List())
+}
@@ -0,0 +1,630 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package restify provides a plugin for extracting routes from Restify
+// servers and restify-router routers, including versioned routes.
+package restify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps Restify's route methods to HTTP methods.
+var httpMethods = map[string]string{
+	"get":   "GET",
+	"post":  "POST",
+	"put":   "PUT",
+	"patch": "PATCH",
+	"del":   "DELETE",
+	"head":  "HEAD",
+	"opts":  "OPTIONS",
+}
+
+// receiverNames are the conventional names of servers and routers passed
+// to route modules as parameters, whose calls are routes even though the
+// file does not create them.
+var receiverNames = map[string]bool{
+	"server": true,
+	"router": true,
+	"app":    true,
+}
+
+// Plugin implements the FrameworkPlugin interface for Restify.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new Restify plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "restify"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".js", ".mts", ".mjs", ".cjs"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "restify",
+		Version:     "1.0.0",
+		Description: "Extracts versioned routes from Restify servers and restify-router routers",
+		SupportedFrameworks: []string{
+			"restify",
+			"restify-router",
+		},
+	}
+}
+
+// Detect checks if Restify is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["restify"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["restify"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// file is a parsed source file and the servers and routers it creates.
+type file struct {
+	pf *parser.ParsedTSFile
+
+	// receivers are the variables holding servers and routers
+	receivers map[string]bool
+
+	// imports maps the variables bound to relative imports to the files
+	// they import
+	imports map[string]string
+
+	// exported is the router variable the file exports, if any
+	exported string
+}
+
+// route is a route and the versions it serves.
+type route struct {
+	types.Route
+	receiver string
+	versions []string
+
+	// named is set for routes given a name, which is their operation ID
+	named bool
+}
+
+// ExtractRoutes parses source files and extracts routes registered on
+// Restify servers and routers. Routers applied with
+// router.applyRoutes(server, prefix) get the prefix, also across files.
+// Routes registered for several versions of the same method and path are
+// documented as one operation selected by the Accept-Version header.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	parsed := make(map[string]*file)
+	var paths []string
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" && f.Language != "javascript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+		parsed[f.Path] = p.collectFile(pf)
+		paths = append(paths, f.Path)
+	}
+	defer func() {
+		for _, f := range parsed {
+			f.pf.Close()
+		}
+	}()
+
+	// Prefixes of routers by file and variable, and of the routers other
+	// files export
+	prefixes := make(map[string]map[string]string)
+	filePrefixes := make(map[string]string)
+	for _, filePath := range paths {
+		f := parsed[filePath]
+		prefixes[filePath] = make(map[string]string)
+		for receiver, prefix := range p.applyRoutes(f) {
+			if target, ok := f.imports[receiver]; ok {
+				filePrefixes[target] = prefix
+				continue
+			}
+			prefixes[filePath][receiver] = prefix
+		}
+	}
+
+	var routes []route
+	for _, filePath := range paths {
+		f := parsed[filePath]
+		for _, r := range p.fileRoutes(f) {
+			prefix, ok := prefixes[filePath][r.receiver]
+			if !ok && r.receiver == f.exported {
+				prefix = filePrefixes[moduleName(filePath)]
+			}
+			if prefix != "" {
+				r.Path = joinPath(prefix, r.Path)
+				r.Parameters = extractPathParams(r.Path)
+				r.Tags = inferTags(r.Path)
+				if !r.named {
+					r.OperationID = generateOperationID(r.Method, r.Path, r.Handler)
+				}
+			}
+			routes = append(routes, r)
+		}
+	}
+
+	return mergeVersions(routes), nil
+}
+
+// collectFile indexes the servers, routers and relative imports of a file.
+func (p *Plugin) collectFile(pf *parser.ParsedTSFile) *file {
+	f := &file{
+		pf:        pf,
+		receivers: make(map[string]bool),
+		imports:   make(map[string]string),
+	}
+	content := pf.Content
+
+	parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+		switch node.Type() {
+		case "variable_declarator":
+			name := node.ChildByFieldName("name")
+			value := node.ChildByFieldName("value")
+			if name == nil || value == nil || name.Type() != "identifier" {
+				return true
+			}
+			value = parser.UnwrapExpression(value)
+			switch {
+			case isServerOrRouter(value, content):
+				f.receivers[name.Content(content)] = true
+			case value.Type() == "call_expression" && p.tsParser.GetCalleeText(value, content) == "require":
+				if args := parser.CallArguments(value); len(args) == 1 {
+					f.imports[name.Content(content)] = resolveImport(pf.Path, parser.StringValue(args[0], content))
+				}
+			}
+		case "import_statement":
+			source := node.ChildByFieldName("source")
+			if source == nil {
+				return false
+			}
+			target := resolveImport(pf.Path, parser.StringValue(source, content))
+			for i := 0; i < int(node.NamedChildCount()); i++ {
+				if clause := node.NamedChild(i); clause.Type() == "import_clause" {
+					if id := clause.NamedChild(0); id != nil && id.Type() == "identifier" {
+						f.imports[id.Content(content)] = target
+					}
+				}
+			}
+			return false
+		case "assignment_expression":
+			// module.exports = router
+			left := node.ChildByFieldName("left")
+			right := node.ChildByFieldName("right")
+			if left != nil && right != nil && left.Content(content) == "module.exports" && right.Type() == "identifier" {
+				f.exported = right.Content(content)
+			}
+		case "export_statement":
+			// export default router
+			if value := node.ChildByFieldName("value"); value != nil && value.Type() == "identifier" {
+				f.exported = value.Content(content)
+			}
+		}
+		return true
+	})
+
+	for name, target := range f.imports {
+		if target == "" {
+			delete(f.imports, name)
+		}
+	}
+	return f
+}
+
+// isServerOrRouter reports whether an expression creates a Restify server
+// (restify.createServer()) or a restify-router router (new Router()).
+func isServerOrRouter(node *sitter.Node, content []byte) bool {
+	switch node.Type() {
+	case "call_expression":
+		fn := node.ChildByFieldName("function")
+		return fn != nil && (fn.Content(content) == "createServer" || strings.HasSuffix(fn.Content(content), ".createServer"))
+	case "new_expression":
+		constructor := node.ChildByFieldName("constructor")
+		return constructor != nil && (constructor.Content(content) == "Router" || strings.HasSuffix(constructor.Content(content), ".Router"))
+	}
+	return false
+}
+
+// applyRoutes returns the prefixes routers are applied with, by router
+// variable: router.applyRoutes(server, '/prefix').
+func (p *Plugin) applyRoutes(f *file) map[string]string {
+	content := f.pf.Content
+	result := make(map[string]string)
+	parser.Walk(f.pf.RootNode, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" || parser.CalledMethod(node, content) != "applyRoutes" {
+			return true
+		}
+		args := parser.CallArguments(node)
+		receiver := node.ChildByFieldName("function").ChildByFieldName("object")
+		if len(args) < 2 || receiver == nil || receiver.Type() != "identifier" {
+			return true
+		}
+		if prefix, ok := parser.StringLiteral(args[1], content); ok {
+			result[receiver.Content(content)] = prefix
+		}
+		return true
+	})
+	return result
+}
+
+// fileRoutes extracts the routes registered in a file, before router
+// prefixes are applied.
+func (p *Plugin) fileRoutes(f *file) []route {
+	content := f.pf.Content
+	var routes []route
+
+	parser.Walk(f.pf.RootNode, func(node *sitter.Node) bool {
+		if node.Type() != "call_expression" {
+			return true
+		}
+		method, ok := httpMethods[parser.CalledMethod(node, content)]
+		if !ok {
+			return true
+		}
+		receiver := node.ChildByFieldName("function").ChildByFieldName("object")
+		if receiver == nil || receiver.Type() != "identifier" {
+			return true
+		}
+		name := receiver.Content(content)
+		if !f.receivers[name] && !receiverNames[name] {
+			return true
+		}
+
+		args := parser.CallArguments(node)
+		if len(args) == 0 {
+			return true
+		}
+		r, ok := parseRoute(method, args, content)
+		if !ok {
+			return true
+		}
+		r.receiver = name
+		r.SourceFile = f.pf.Path
+		r.SourceLine = int(node.StartPoint().Row) + 1
+		routes = append(routes, r)
+		return true
+	})
+
+	return routes
+}
+
+// parseRoute reads the arguments of a route call: a path or an options
+// object ({ path, name, version, versions }), and the handler chain.
+func parseRoute(method string, args []*sitter.Node, content []byte) (route, bool) {
+	var (
+		rawPath  string
+		name     string
+		versions []string
+	)
+
+	first := parser.UnwrapExpression(args[0])
+	switch first.Type() {
+	case "string", "template_string":
+		p, ok := parser.StringLiteral(first, content)
+		if !ok {
+			return route{}, false
+		}
+		rawPath = p
+		if rawPath == "" {
+			// routers mount empty paths at their prefix
+			rawPath = "/"
+		}
+	case "object":
+		for _, key := range []string{"path", "url"} {
+			if value := parser.ObjectProperty(first, key, content); value != nil {
+				rawPath, _ = parser.StringLiteral(value, content)
+			}
+		}
+		if value := parser.ObjectProperty(first, "name", content); value != nil {
+			name, _ = parser.StringLiteral(value, content)
+		}
+		for _, key := range []string{"version", "versions"} {
+			if value := parser.ObjectProperty(first, key, content); value != nil {
+				versions = append(versions, parser.StringLiterals(value, content)...)
+			}
+		}
+	default:
+		// RegExp routes have no OpenAPI path
+		return route{}, false
+	}
+	if !strings.HasPrefix(rawPath, "/") {
+		return route{}, false
+	}
+
+	handler := ""
+	for _, arg := range args[1:] {
+		arg = parser.UnwrapExpression(arg)
+		if arg.Type() == "array" && arg.NamedChildCount() > 0 {
+			arg = arg.NamedChild(int(arg.NamedChildCount()) - 1)
+		}
+		switch arg.Type() {
+		case "identifier", "member_expression":
+			handler = arg.Content(content)
+		case "call_expression":
+			// restify.plugins.conditionalHandler([{ version, handler }])
+			if parser.CalledMethod(arg, content) == "conditionalHandler" {
+				versions = append(versions, conditionalVersions(arg, content)...)
+			}
+			handler = ""
+		default:
+			handler = ""
+		}
+	}
+
+	openAPIPath := plugins.CatchAllPath(colonParamRegex.ReplaceAllString(rawPath, "{$1}"))
+	r := route{
+		Route: types.Route{
+			Method:     method,
+			Path:       openAPIPath,
+			Handler:    handler,
+			Tags:       inferTags(openAPIPath),
+			Parameters: extractPathParams(openAPIPath),
+		},
+		versions: versions,
+	}
+	if name != "" {
+		r.OperationID = name
+		r.named = true
+	} else {
+		r.OperationID = generateOperationID(method, openAPIPath, handler)
+	}
+	if plugins.IsCatchAll(rawPath) {
+		plugins.MarkWildcard(&r.Route)
+	}
+	return r, true
+}
+
+// conditionalVersions returns the versions of a conditionalHandler's
+// candidates.
+func conditionalVersions(call *sitter.Node, content []byte) []string {
+	args := parser.CallArguments(call)
+	if len(args) == 0 {
+		return nil
+	}
+	candidates := parser.UnwrapExpression(args[0])
+	if candidates.Type() == "object" {
+		return parser.StringLiterals(parser.ObjectProperty(candidates, "version", content), content)
+	}
+	var versions []string
+	for i := 0; i < int(candidates.NamedChildCount()); i++ {
+		if candidate := candidates.NamedChild(i); candidate.Type() == "object" {
+			versions = append(versions, parser.StringLiterals(parser.ObjectProperty(candidate, "version", content), content)...)
+		}
+	}
+	return versions
+}
+
+// mergeVersions merges the routes registered for several versions of the
+// same method and path, and documents the versions of versioned routes as
+// the values of the Accept-Version header.
+func mergeVersions(routes []route) []types.Route {
+	index := make(map[string]int)
+	var merged []route
+	for _, r := range routes {
+		key := r.Method + " " + r.Path
+		if i, ok := index[key]; ok && (len(r.versions) > 0 || len(merged[i].versions) > 0) {
+			merged[i].versions = append(merged[i].versions, r.versions...)
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, r)
+	}
+
+	result := make([]types.Route, 0, len(merged))
+	for _, r := range merged {
+		if versions := uniqueSorted(r.versions); len(versions) > 0 {
+			enum := make([]any, len(versions))
+			for i, v := range versions {
+				enum[i] = v
+			}
+			r.Parameters = append(r.Parameters, types.Parameter{
+				Name:        "Accept-Version",
+				In:          "header",
+				Description: "Version of the route to use, as a semver range",
+				Schema:      &types.Schema{Type: "string", Enum: enum},
+			})
+		}
+		result = append(result, r.Route)
+	}
+	return result
+}
+
+// ExtractSchemas extracts TypeScript interfaces and type aliases.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if f.Language != "typescript" {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, f.Path, f.Content)
+		if err != nil {
+			continue
+		}
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		pf.Close()
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// resolveImport resolves a relative import to the module name of the
+// imported file, or "" for package imports.
+func resolveImport(from, source string) string {
+	if !strings.HasPrefix(source, "./") && !strings.HasPrefix(source, "../") {
+		return ""
+	}
+	return moduleName(path.Join(path.Dir(filepath.ToSlash(from)), source))
+}
+
+// moduleName returns the name a file is imported by: its path without the
+// extension, and without /index for index files.
+func moduleName(filePath string) string {
+	name := filepath.ToSlash(filePath)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	return strings.TrimSuffix(name, "/index")
+}
+
+// joinPath joins a path prefix and a path.
+func joinPath(prefix, p string) string {
+	joined := "/" + strings.Trim(prefix, "/") + "/" + strings.TrimPrefix(p, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return strings.ReplaceAll(joined, "//", "/")
+}
+
+// generateOperationID generates an operation ID from the handler name, or
+// else from the method and path.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		parts := strings.Split(handler, ".")
+		return strings.ToLower(method) + cases.Title(language.English, cases.NoLower).String(parts[len(parts)-1])
+	}
+
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	words := strings.Fields(strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(path))
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+	return sb.String()
+}
+
+// inferTags tags a route with its first static path segment that is not
+// an API or version prefix.
+func inferTags(path string) []string {
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		switch {
+		case segment == "", strings.HasPrefix(segment, "{"), segment == "api", versionSegmentRegex.MatchString(segment):
+			continue
+		}
+		return []string{segment}
+	}
+	return nil
+}
+
+// versionSegmentRegex matches version path segments like v1.
+var versionSegmentRegex = regexp.MustCompile(`^v\d+$`)
+
+// uniqueSorted returns the distinct strings of a slice in sorted order.
+func uniqueSorted(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Register registers the Restify plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package restify
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const serverFixture = `
+const restify = require('restify');
+const users = require('./routes/users');
+const pets = require('./controllers/pets');
+
+const server = restify.createServer({ name: 'petstore' });
+server.use(restify.plugins.bodyParser());
+
+server.get('/pets', pets.list);
+server.post('/pets', restify.plugins.bodyParser(), pets.create);
+server.del('/pets/:petId', pets.remove);
+server.opts('/pets', pets.options);
+server.get({ path: '/pets/:petId', name: 'getPet' }, pets.show);
+
+server.get({ path: '/status', version: '1.0.0' }, statusV1);
+server.get({ path: '/status', versions: ['2.0.0', '2.1.0'] }, statusV2);
+server.get('/legacy/:id', restify.plugins.conditionalHandler([
+  { version: '1.1.3', handler: legacyV1 },
+  { version: ['2.0.0', '2.1.0'], handler: legacyV2 },
+]));
+
+server.get('/public/*', restify.plugins.serveStatic({ directory: './public' }));
+server.get(/^\/regex\/(.*)/, regexHandler);
+
+users.applyRoutes(server, '/api/users');
+
+axios.get('/not-a-route');
+`
+
+const usersFixture = `
+const Router = require('restify-router').Router;
+const router = new Router();
+
+router.get('/', listUsers);
+router.get({ path: '/:id', name: 'getUser' }, showUser);
+router.patch('/:id', updateUser);
+router.post('', createUser);
+
+module.exports = router;
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"restify": "^11.1.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.js", Language: "javascript", Content: []byte(serverFixture)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/pets")
+	require.NotNil(t, list)
+	assert.Equal(t, "pets.list", list.Handler)
+	assert.Equal(t, "getList", list.OperationID)
+	assert.Equal(t, []string{"pets"}, list.Tags)
+	assert.Equal(t, "/app/server.js", list.SourceFile)
+
+	create := findRoute(routes, "POST", "/pets")
+	require.NotNil(t, create)
+	assert.Equal(t, "pets.create", create.Handler, "the last handler of the chain handles the route")
+
+	remove := findRoute(routes, "DELETE", "/pets/{petId}")
+	require.NotNil(t, remove)
+	require.Len(t, remove.Parameters, 1)
+	assert.Equal(t, "petId", remove.Parameters[0].Name)
+
+	assert.NotNil(t, findRoute(routes, "OPTIONS", "/pets"))
+
+	show := findRoute(routes, "GET", "/pets/{petId}")
+	require.NotNil(t, show)
+	assert.Equal(t, "getPet", show.OperationID, "route names are operation IDs")
+
+	static := findRoute(routes, "GET", "/public/{path}")
+	require.NotNil(t, static)
+	assert.Equal(t, true, static.Extensions["x-wildcard"])
+
+	assert.Nil(t, findRoute(routes, "GET", "/not-a-route"), "only servers and routers register routes")
+	for _, r := range routes {
+		assert.NotContains(t, r.Path, "regex", "RegExp routes are skipped")
+	}
+}
+
+func TestPlugin_ExtractRoutes_Versions(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.js", Language: "javascript", Content: []byte(serverFixture)},
+	})
+	require.NoError(t, err)
+
+	var status []types.Route
+	for _, r := range routes {
+		if r.Path == "/status" {
+			status = append(status, r)
+		}
+	}
+	require.Len(t, status, 1, "versions of a route are one operation")
+	assert.Equal(t, "statusV1", status[0].Handler)
+	require.Len(t, status[0].Parameters, 1)
+	header := status[0].Parameters[0]
+	assert.Equal(t, "Accept-Version", header.Name)
+	assert.Equal(t, "header", header.In)
+	assert.False(t, header.Required)
+	assert.Equal(t, []any{"1.0.0", "2.0.0", "2.1.0"}, header.Schema.Enum)
+
+	legacy := findRoute(routes, "GET", "/legacy/{id}")
+	require.NotNil(t, legacy)
+	require.Len(t, legacy.Parameters, 2)
+	assert.Equal(t, []any{"1.1.3", "2.0.0", "2.1.0"}, legacy.Parameters[1].Schema.Enum, "conditionalHandler versions are documented")
+
+	for _, r := range routes {
+		if r.Path == "/pets" && r.Method == "GET" {
+			assert.Len(t, r.Parameters, 0, "unversioned routes have no version header")
+		}
+	}
+}
+
+func TestPlugin_ExtractRoutes_Routers(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/server.js", Language: "javascript", Content: []byte(serverFixture)},
+		{Path: "/app/routes/users.js", Language: "javascript", Content: []byte(usersFixture)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/api/users")
+	require.NotNil(t, list, "applyRoutes prefixes the routes of imported routers")
+	assert.Equal(t, "listUsers", list.Handler)
+	assert.Equal(t, []string{"users"}, list.Tags)
+	assert.Equal(t, "/app/routes/users.js", list.SourceFile)
+
+	show := findRoute(routes, "GET", "/api/users/{id}")
+	require.NotNil(t, show)
+	assert.Equal(t, "getUser", show.OperationID)
+
+	update := findRoute(routes, "PATCH", "/api/users/{id}")
+	require.NotNil(t, update)
+	assert.Equal(t, "patchUpdateUser", update.OperationID)
+
+	create := findRoute(routes, "POST", "/api/users")
+	require.NotNil(t, create, "empty router paths are mounted at the prefix")
+	assert.Equal(t, "createUser", create.Handler)
+}
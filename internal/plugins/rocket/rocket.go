@@ -6,6 +6,7 @@ package rocket
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -108,14 +109,22 @@ func (p *Plugin) checkCargoForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Rocket route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "rust" {
 			continue
 		}
 
-		fileRoutes, err := p.extractRoutesFromFile(file)
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
 		if err != nil {
 			// Log error but continue with other files
 			continue
@@ -124,12 +133,20 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 		routes = append(routes, fileRoutes...)
 	}
 
+	// Overlay #[utoipa::path(...)] metadata on annotated handlers
+	paths := p.rustParser.UtoipaPaths(ctx, files)
+	for i := range routes {
+		if op, ok := paths[handlerFunction(routes[i].Handler)]; ok {
+			op.Apply(&routes[i])
+		}
+	}
+
 	return routes, nil
 }
 
 // extractRoutesFromFile extracts routes from a single Rust file.
-func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) ([]types.Route, error) {
-	pf, err := p.rustParser.Parse(file.Path, file.Content)
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.rustParser.ParseContext(ctx, file.Path, file.Content)
 	if err != nil {
 		return nil, err
 	}
@@ -391,90 +408,64 @@ func inferTags(path string) []string {
 	return []string{tagPart}
 }
 
-// ExtractSchemas extracts schema definitions from Rust structs with serde.
+// ExtractSchemas extracts schema definitions from Rust structs and enums
+// with serde.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
 	var schemas []types.Schema
 
+	// Flattened fields may refer to structs declared in any file
+	structs := make(map[string]parser.RustStruct)
+	var derived []parser.RustStruct
+	var enums []parser.RustEnum
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if file.Language != "rust" {
 			continue
 		}
 
-		pf, err := p.rustParser.Parse(file.Path, file.Content)
+		pf, err := p.rustParser.ParseContext(ctx, file.Path, file.Content)
 		if err != nil {
 			continue
 		}
 
 		for _, s := range pf.Structs {
-			// Only extract structs with Serialize or Deserialize derives
-			if s.HasDeriveAttribute("Serialize") || s.HasDeriveAttribute("Deserialize") {
-				schema := p.structToSchema(s)
-				if schema != nil {
-					schemas = append(schemas, *schema)
-				}
+			structs[s.Name] = s
+			// Only extract structs with serde, utoipa, or schemars derives
+			if s.IsSchema() {
+				derived = append(derived, s)
+			}
+		}
+		for _, e := range pf.Enums {
+			if e.IsSchema() {
+				enums = append(enums, e)
 			}
 		}
 
 		pf.Close()
 	}
 
-	return schemas, nil
-}
-
-// structToSchema converts a Rust struct to an OpenAPI schema.
-func (p *Plugin) structToSchema(s parser.RustStruct) *types.Schema {
-	schema := &types.Schema{
-		Title:      s.Name,
-		Type:       "object",
-		Properties: make(map[string]*types.Schema),
-		Required:   []string{},
-	}
-
-	for _, field := range s.Fields {
-		propSchema := &types.Schema{}
-
-		// Get the field name (possibly renamed by serde)
-		fieldName := field.Name
-		if rename := field.GetSerdeRename(); rename != "" {
-			fieldName = rename
-		}
-
-		// Convert Rust type to OpenAPI type
-		isOptional := strings.HasPrefix(field.Type, "Option<")
-		openAPIType, format := parser.RustTypeToOpenAPI(field.Type)
-		propSchema.Type = openAPIType
-		if format != "" {
-			propSchema.Format = format
-		}
-
-		// Handle Vec<T> types
-		if strings.HasPrefix(field.Type, "Vec<") {
-			propSchema.Type = "array"
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.RustTypeToOpenAPI(innerType)
-			propSchema.Items = &types.Schema{
-				Type:   innerOpenAPIType,
-				Format: innerFormat,
-			}
-		}
-
-		// Handle Option<T> types
-		if isOptional {
-			propSchema.Nullable = true
-			innerType := extractGenericType(field.Type)
-			innerOpenAPIType, innerFormat := parser.RustTypeToOpenAPI(innerType)
-			propSchema.Type = innerOpenAPIType
-			propSchema.Format = innerFormat
+	for _, s := range derived {
+		schema := parser.RustStructSchema(s, structs)
+		if schema != nil {
+			schemas = append(schemas, *schema)
 		}
-
-		schema.Properties[fieldName] = propSchema
-
-		if !isOptional {
-			schema.Required = append(schema.Required, fieldName)
+	}
+	for _, e := range enums {
+		schema := parser.RustEnumSchema(e, structs)
+		if schema != nil {
+			schemas = append(schemas, *schema)
 		}
 	}
 
-	return schema
+	return schemas, nil
 }
 
 // extractGenericType extracts the inner type from a generic like Vec<String>.
@@ -487,6 +478,15 @@ func extractGenericType(s string) string {
 	return strings.TrimSpace(s[start+1 : end])
 }
 
+// handlerFunction returns the function name of a handler path such as
+// handlers::get_user.
+func handlerFunction(handler string) string {
+	if i := strings.LastIndex(handler, "::"); i >= 0 {
+		return handler[i+2:]
+	}
+	return handler
+}
+
 // Register registers the Rocket plugin with the global registry.
 func Register() {
 	plugins.MustRegister(New())
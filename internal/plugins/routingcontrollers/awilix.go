@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package routingcontrollers
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// builderMethods maps the route methods of an awilix-express controller
+// builder to HTTP methods.
+var builderMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"patch":   "PATCH",
+	"delete":  "DELETE",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+	"all":     "ALL",
+}
+
+// isCreateController reports whether node is an awilix-express
+// createController(API) call.
+func isCreateController(node *sitter.Node, content []byte) bool {
+	if node.Type() != "call_expression" {
+		return false
+	}
+	fn := node.ChildByFieldName("function")
+	return fn != nil && fn.Type() == "identifier" && fn.Content(content) == "createController"
+}
+
+// extractControllerBuilder extracts the routes registered on an
+// awilix-express controller builder, as in
+// createController(TodoAPI).prefix('/todos').get('/:id', 'find'). The API
+// is resolved from the container per request; its methods handle the
+// routes.
+func (p *Plugin) extractControllerBuilder(call *sitter.Node, content []byte) []types.Route {
+	apiName := ""
+	if args := p.tsParser.GetCallArguments(call, content); len(args) > 0 && args[0].Type() == "identifier" {
+		apiName = args[0].Content(content)
+	}
+
+	var routes []types.Route
+	prefix := ""
+	for node := call; ; {
+		member := node.Parent()
+		if member == nil || member.Type() != "member_expression" {
+			break
+		}
+		next := member.Parent()
+		property := member.ChildByFieldName("property")
+		if next == nil || next.Type() != "call_expression" || property == nil {
+			break
+		}
+		node = next
+
+		args := p.tsParser.GetCallArguments(next, content)
+		name := property.Content(content)
+		if name == "prefix" && len(args) > 0 {
+			prefix = p.pathArgument(args[0], content)
+			continue
+		}
+		method, ok := builderMethods[name]
+		if !ok || len(args) < 2 {
+			continue
+		}
+		path, ok := p.tsParser.ExtractStringLiteral(args[0], content)
+		if !ok {
+			continue
+		}
+		handler, _ := p.tsParser.ExtractStringLiteral(args[1], content)
+
+		fullPath := joinPath(prefix, path)
+		wildcard := plugins.IsCatchAll(fullPath)
+		fullPath = convertPathParams(fullPath)
+		route := types.Route{
+			Method:      method,
+			Path:        fullPath,
+			OperationID: strings.ToLower(method) + handler,
+			Tags:        inferTags("", fullPath),
+			Parameters:  extractPathParams(fullPath),
+			Responses:   buildResponses(0, 0, "", nil),
+			SourceLine:  int(property.StartPoint().Row) + 1,
+		}
+		if apiName != "" && handler != "" {
+			route.Handler = apiName + "." + handler
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
@@ -0,0 +1,997 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package routingcontrollers provides a plugin for extracting routes from
+// decorator-based TypeScript controllers running on Express or Koa:
+// routing-controllers, Ts.ED and tsoa, and controllers resolved through
+// dependency injection containers: inversify-express-utils and
+// awilix-express.
+package routingcontrollers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Dependencies are the package.json dependencies of the supported frameworks.
+var Dependencies = []string{
+	"routing-controllers",
+	"@tsed/common",
+	"@tsed/platform-http",
+	"@tsed/schema",
+	"tsoa",
+	"inversify-express-utils",
+	"awilix-express",
+}
+
+// frameworkModules are the modules whose import marks a controller file.
+var frameworkModules = map[string]bool{
+	"routing-controllers":     true,
+	"tsoa":                    true,
+	"inversify-express-utils": true,
+	"awilix-express":          true,
+	"awilix-router-core":      true,
+}
+
+// httpMethodDecorators maps method decorator names to HTTP methods.
+var httpMethodDecorators = map[string]string{
+	"Get":     "GET",
+	"Post":    "POST",
+	"Put":     "PUT",
+	"Patch":   "PATCH",
+	"Delete":  "DELETE",
+	"Head":    "HEAD",
+	"Options": "OPTIONS",
+	"All":     "ALL",
+
+	// inversify-express-utils
+	"httpGet":     "GET",
+	"httpPost":    "POST",
+	"httpPut":     "PUT",
+	"httpPatch":   "PATCH",
+	"httpDelete":  "DELETE",
+	"httpHead":    "HEAD",
+	"httpOptions": "OPTIONS",
+	"all":         "ALL",
+
+	// awilix-express, with the path set by @route()
+	"GET":     "GET",
+	"POST":    "POST",
+	"PUT":     "PUT",
+	"PATCH":   "PATCH",
+	"DELETE":  "DELETE",
+	"HEAD":    "HEAD",
+	"OPTIONS": "OPTIONS",
+	"ALL":     "ALL",
+}
+
+// controllerDecorators are the class decorators that declare a controller
+// and its base path: @JsonController and @Controller (routing-controllers,
+// Ts.ED), @Route (tsoa), @controller (inversify-express-utils) and @route
+// (awilix-express).
+var controllerDecorators = map[string]bool{
+	"JsonController": true,
+	"Controller":     true,
+	"Route":          true,
+	"controller":     true,
+	"route":          true,
+}
+
+// parameterDecorators maps parameter decorator names to where the value is
+// read from. Decorators without a name argument bind the whole location.
+var parameterDecorators = map[string]string{
+	// routing-controllers
+	"Param":       "path",
+	"QueryParam":  "query",
+	"QueryParams": "query",
+	"HeaderParam": "header",
+	"CookieParam": "cookie",
+	"Body":        "body",
+	"BodyParam":   "body",
+
+	// Ts.ED
+	"PathParams":    "path",
+	"HeaderParams":  "header",
+	"CookiesParams": "cookie",
+	"BodyParams":    "body",
+
+	// tsoa
+	"Path":   "path",
+	"Query":  "query",
+	"Header": "header",
+
+	// inversify-express-utils
+	"requestParam":   "path",
+	"queryParam":     "query",
+	"requestHeaders": "header",
+	"cookies":        "cookie",
+	"requestBody":    "body",
+}
+
+// tsoaParameters are the tsoa parameter decorators, whose parameters are
+// required unless declared optional.
+var tsoaParameters = map[string]bool{
+	"Path":   true,
+	"Query":  true,
+	"Header": true,
+}
+
+// inversifyParameters are the inversify-express-utils parameter decorators,
+// which bind the whole location without a name argument.
+var inversifyParameters = map[string]bool{
+	"requestParam":   true,
+	"queryParam":     true,
+	"requestHeaders": true,
+	"cookies":        true,
+}
+
+// Plugin implements the FrameworkPlugin interface for routing-controllers,
+// Ts.ED and tsoa.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new routing-controllers plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "routingcontrollers"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".ts", ".tsx", ".mts", ".js", ".mjs", ".cjs"}
+}
+
+// RouteMarkers returns the framework modules, one of which every controller
+// file imports.
+func (p *Plugin) RouteMarkers() []string {
+	return append(slices.Sorted(maps.Keys(frameworkModules)), "@tsed/")
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:                "routingcontrollers",
+		Version:             "1.0.0",
+		Description:         "Extracts routes from routing-controllers, Ts.ED, tsoa, inversify-express-utils and awilix-express controllers",
+		SupportedFrameworks: Dependencies,
+	}
+}
+
+// Detect checks if a decorator controller framework is used in the project
+// by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	for _, dep := range Dependencies {
+		if _, ok := pkg.Dependencies[dep]; ok {
+			return true, nil
+		}
+		if _, ok := pkg.DevDependencies[dep]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractRoutes parses source files and extracts controller route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// awilix-express controllers are often plain JavaScript
+		if file.Language != "typescript" && file.Language != "javascript" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file)
+		if err != nil {
+			// Log error but continue with other files
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// controllerInfo holds information about a controller class.
+type controllerInfo struct {
+	name      string
+	basePath  string
+	classNode *sitter.Node
+}
+
+// extractRoutesFromFile extracts routes from a single TypeScript file.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile) ([]types.Route, error) {
+	pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	if !hasFrameworkImport(pf.RootNode, file.Content) {
+		return nil, nil
+	}
+
+	var routes []types.Route
+	parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+		var found []types.Route
+		switch {
+		case node.Type() == "class_declaration":
+			if ctrl := p.parseController(node, file.Content); ctrl != nil {
+				found = p.extractRoutesFromController(ctrl, file.Content)
+			}
+		case isCreateController(node, file.Content):
+			found = p.extractControllerBuilder(node, file.Content)
+		default:
+			return true
+		}
+		for _, route := range found {
+			route.SourceFile = file.Path
+			routes = append(routes, route)
+		}
+		return false
+	})
+
+	return routes, nil
+}
+
+// hasFrameworkImport checks if the file imports or requires one of the
+// supported frameworks.
+func hasFrameworkImport(rootNode *sitter.Node, content []byte) bool {
+	found := false
+	parser.Walk(rootNode, func(node *sitter.Node) bool {
+		if found {
+			return false
+		}
+		var source *sitter.Node
+		switch node.Type() {
+		case "import_statement":
+			source = node.ChildByFieldName("source")
+		case "call_expression":
+			// const { createController } = require('awilix-express')
+			fn, args := node.ChildByFieldName("function"), node.ChildByFieldName("arguments")
+			if fn == nil || fn.Content(content) != "require" || args == nil || args.NamedChildCount() == 0 {
+				return true
+			}
+			source = args.NamedChild(0)
+		default:
+			return true
+		}
+		if source == nil {
+			return false
+		}
+		module := strings.Trim(source.Content(content), "\"'`")
+		if frameworkModules[module] || strings.HasPrefix(module, "@tsed/") {
+			found = true
+		}
+		return false
+	})
+	return found
+}
+
+// classDecorators returns the decorators of a class. tree-sitter attaches
+// decorators of an exported class to the export statement.
+func classDecorators(classNode *sitter.Node) []*sitter.Node {
+	var decorators []*sitter.Node
+	if parent := classNode.Parent(); parent != nil && parent.Type() == "export_statement" {
+		for i := 0; i < int(parent.ChildCount()); i++ {
+			if child := parent.Child(i); child.Type() == "decorator" {
+				decorators = append(decorators, child)
+			}
+		}
+	}
+	for i := 0; i < int(classNode.ChildCount()); i++ {
+		if child := classNode.Child(i); child.Type() == "decorator" {
+			decorators = append(decorators, child)
+		}
+	}
+	return decorators
+}
+
+// parseController returns the controller declared by a class, or nil when
+// the class has no controller decorator.
+func (p *Plugin) parseController(classNode *sitter.Node, content []byte) *controllerInfo {
+	nameNode := classNode.ChildByFieldName("name")
+	if nameNode == nil {
+		return nil
+	}
+
+	for _, dec := range classDecorators(classNode) {
+		if !controllerDecorators[decoratorName(dec, content)] {
+			continue
+		}
+		ctrl := &controllerInfo{
+			name:      nameNode.Content(content),
+			classNode: classNode,
+		}
+		if args := p.decoratorArgs(dec, content); len(args) > 0 {
+			ctrl.basePath = p.pathArgument(args[0], content)
+		}
+		return ctrl
+	}
+
+	return nil
+}
+
+// pathArgument reads a path from a string literal or from the path option
+// of a Ts.ED options object (@Controller({ path: '/users' })).
+func (p *Plugin) pathArgument(arg *sitter.Node, content []byte) string {
+	if value, ok := p.tsParser.ExtractStringLiteral(arg, content); ok {
+		return value
+	}
+	if arg.Type() == "object" {
+		if value := objectProperty(arg, "path", content); value != nil {
+			path, _ := p.tsParser.ExtractStringLiteral(value, content)
+			return path
+		}
+	}
+	return ""
+}
+
+// extractRoutesFromController extracts the routes of a controller's methods.
+// Method decorators appear as class_body siblings before the method.
+func (p *Plugin) extractRoutesFromController(ctrl *controllerInfo, content []byte) []types.Route {
+	body := ctrl.classNode.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	var routes []types.Route
+	var pending []*sitter.Node
+	for i := 0; i < int(body.ChildCount()); i++ {
+		child := body.Child(i)
+		switch child.Type() {
+		case "decorator":
+			pending = append(pending, child)
+		case "method_definition":
+			routes = append(routes, p.extractRoutesFromMethod(child, pending, ctrl, content)...)
+			pending = nil
+		case "public_field_definition":
+			pending = nil
+		}
+	}
+
+	return routes
+}
+
+// responseDecl is a response declared by a method decorator.
+type responseDecl struct {
+	status   int
+	typeName string
+}
+
+// extractRoutesFromMethod extracts one route per HTTP method decorator on a
+// controller method.
+func (p *Plugin) extractRoutesFromMethod(methodNode *sitter.Node, decorators []*sitter.Node, ctrl *controllerInfo, content []byte) []types.Route {
+	nameNode := methodNode.ChildByFieldName("name")
+	if nameNode == nil {
+		return nil
+	}
+	methodName := nameNode.Content(content)
+
+	type endpoint struct {
+		method string
+		path   string
+	}
+	var endpoints []endpoint
+	var routePath string
+	var successStatus, undefinedStatus int
+	var declared []responseDecl
+	var produces []string
+
+	for _, dec := range decorators {
+		name := decoratorName(dec, content)
+		args := p.decoratorArgs(dec, content)
+		if method, ok := httpMethodDecorators[name]; ok {
+			ep := endpoint{method: method}
+			if len(args) > 0 {
+				ep.path = p.pathArgument(args[0], content)
+			}
+			endpoints = append(endpoints, ep)
+			continue
+		}
+
+		switch name {
+		case "httpMethod":
+			// inversify-express-utils' @httpMethod('get', '/path')
+			if len(args) > 0 {
+				if method, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+					ep := endpoint{method: strings.ToUpper(method)}
+					if len(args) > 1 {
+						ep.path = p.pathArgument(args[1], content)
+					}
+					endpoints = append(endpoints, ep)
+				}
+			}
+		case "route":
+			// awilix-express' @route('/:id') sets the path of @GET() and the like
+			if len(args) > 0 {
+				routePath = p.pathArgument(args[0], content)
+			}
+		case "HttpCode", "Status", "SuccessResponse":
+			// @HttpCode(201), @Status(201), @SuccessResponse('201', 'Created')
+			if len(args) > 0 {
+				successStatus = p.statusArgument(args[0], content)
+			}
+		case "OnUndefined":
+			// @OnUndefined(204) is sent when the handler returns undefined
+			if len(args) > 0 {
+				undefinedStatus = p.statusArgument(args[0], content)
+			}
+		case "Returns", "Response":
+			// @Returns(201, User) and tsoa's @Response<ErrorBody>(404, 'Not found')
+			if len(args) == 0 {
+				continue
+			}
+			decl := responseDecl{status: p.statusArgument(args[0], content)}
+			if name == "Returns" && len(args) > 1 && args[1].Type() == "identifier" {
+				decl.typeName = args[1].Content(content)
+			} else if typeArgs := decoratorTypeArguments(dec); typeArgs != nil && typeArgs.NamedChildCount() > 0 {
+				decl.typeName = typeArgs.NamedChild(0).Content(content)
+			}
+			if decl.status > 0 {
+				declared = append(declared, decl)
+			}
+		case "ContentType", "Produces":
+			// @ContentType('text/csv'), tsoa's @Produces('text/csv')
+			if len(args) > 0 {
+				if mediaType, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+					produces = append(produces, mediaType)
+				}
+			}
+		}
+	}
+
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	var returnType string
+	if rt := methodNode.ChildByFieldName("return_type"); rt != nil && rt.NamedChildCount() > 0 {
+		returnType = unwrapPromise(rt.NamedChild(0).Content(content))
+	}
+
+	params, body := p.extractParameters(methodNode, content)
+
+	var routes []types.Route
+	for _, ep := range endpoints {
+		if ep.path == "" {
+			ep.path = routePath
+		}
+		fullPath := joinPath(ctrl.basePath, ep.path)
+		wildcard := plugins.IsCatchAll(fullPath)
+		fullPath = convertPathParams(fullPath)
+
+		route := types.Route{
+			Method:      ep.method,
+			Path:        fullPath,
+			Handler:     ctrl.name + "." + methodName,
+			OperationID: strings.ToLower(ep.method) + methodName,
+			Tags:        inferTags(ctrl.name, fullPath),
+			Parameters:  mergePathParams(extractPathParams(fullPath), params),
+			RequestBody: body,
+			Responses:   buildResponses(successStatus, undefinedStatus, returnType, declared),
+			Produces:    produces,
+			SourceLine:  int(methodNode.StartPoint().Row) + 1,
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// buildResponses documents the success response, with the schema of the
+// @Returns type or else the declared return type, and the other declared
+// responses. Handlers returning void default to 204 No Content.
+func buildResponses(successStatus, undefinedStatus int, returnType string, declared []responseDecl) map[string]types.Response {
+	extractor := schema.NewTypeScriptSchemaExtractor()
+
+	var successSchema *types.Schema
+	for _, decl := range declared {
+		if decl.status < 300 && decl.typeName != "" && (successStatus == 0 || decl.status == successStatus) {
+			successStatus = decl.status
+			successSchema = extractor.TypeToSchema(decl.typeName)
+			break
+		}
+	}
+	if successSchema == nil {
+		switch returnType {
+		case "", "any", "unknown", "never":
+		case "void", "undefined":
+			if successStatus == 0 {
+				successStatus = http.StatusNoContent
+			}
+		default:
+			successSchema = extractor.TypeToSchema(returnType)
+		}
+	}
+	if successStatus == 0 {
+		successStatus = http.StatusOK
+	}
+
+	responses := map[string]types.Response{
+		strconv.Itoa(successStatus): jsonResponse(successStatus, successSchema),
+	}
+	if undefinedStatus > 0 && undefinedStatus != successStatus {
+		responses[strconv.Itoa(undefinedStatus)] = types.Response{Description: http.StatusText(undefinedStatus)}
+	}
+	for _, decl := range declared {
+		code := strconv.Itoa(decl.status)
+		if _, ok := responses[code]; ok {
+			continue
+		}
+		var declSchema *types.Schema
+		if decl.typeName != "" {
+			declSchema = extractor.TypeToSchema(decl.typeName)
+		}
+		responses[code] = jsonResponse(decl.status, declSchema)
+	}
+
+	return responses
+}
+
+// jsonResponse returns a response with an optional JSON body.
+func jsonResponse(status int, body *types.Schema) types.Response {
+	description := http.StatusText(status)
+	if status < 300 {
+		description = "Success response"
+	}
+	response := types.Response{Description: description}
+	if body != nil {
+		response.Content = map[string]types.MediaType{
+			"application/json": {Schema: body},
+		}
+	}
+	return response
+}
+
+// extractParameters reads the decorated parameters of a controller method:
+// path, query, header and cookie parameters, and the request body. A body
+// bound field by field (@BodyParam('name')) is documented as an object of
+// those fields.
+func (p *Plugin) extractParameters(methodNode *sitter.Node, content []byte) ([]types.Parameter, *types.RequestBody) {
+	formalParams := methodNode.ChildByFieldName("parameters")
+	if formalParams == nil {
+		return nil, nil
+	}
+
+	extractor := schema.NewTypeScriptSchemaExtractor()
+	var params []types.Parameter
+	var bodySchema *types.Schema
+	bodyRequired := false
+
+	for i := 0; i < int(formalParams.NamedChildCount()); i++ {
+		param := formalParams.NamedChild(i)
+		if param.Type() != "required_parameter" && param.Type() != "optional_parameter" {
+			continue
+		}
+
+		var paramName, paramType string
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			paramName = pattern.Content(content)
+		}
+		if annotation := param.ChildByFieldName("type"); annotation != nil && annotation.NamedChildCount() > 0 {
+			paramType = annotation.NamedChild(0).Content(content)
+		}
+		optional := param.Type() == "optional_parameter"
+
+		for j := 0; j < int(param.NamedChildCount()); j++ {
+			dec := param.NamedChild(j)
+			if dec.Type() != "decorator" {
+				continue
+			}
+			name := decoratorName(dec, content)
+			location, ok := parameterDecorators[name]
+			if !ok {
+				continue
+			}
+
+			args := p.decoratorArgs(dec, content)
+			var key string
+			if len(args) > 0 {
+				key = p.pathArgument(args[0], content)
+			}
+			// tsoa parameters are required unless optional in the signature
+			required := !optional && (location == "path" || tsoaParameters[name] || p.requiredOption(args, content))
+
+			if location == "body" {
+				if key == "" {
+					bodySchema = extractor.TypeToSchema(orAny(paramType))
+					bodyRequired = !optional
+					continue
+				}
+				if bodySchema == nil || bodySchema.Ref != "" {
+					bodySchema = &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+				}
+				bodySchema.Properties[key] = extractor.TypeToSchema(orAny(paramType))
+				if required {
+					bodySchema.Required = append(bodySchema.Required, key)
+					bodyRequired = true
+				}
+				continue
+			}
+
+			// routing-controllers' @Param and @QueryParam name the parameter
+			// explicitly; tsoa's @Path() and @Query() take the argument name
+			if key == "" {
+				if name == "QueryParams" || name == "HeaderParams" || inversifyParameters[name] {
+					// The whole query string or header set bound to one object
+					continue
+				}
+				key = paramName
+			}
+			if key == "" {
+				continue
+			}
+			params = append(params, types.Parameter{
+				Name:     key,
+				In:       location,
+				Required: required,
+				Schema:   extractor.TypeToSchema(orAny(paramType)),
+			})
+		}
+	}
+
+	if bodySchema == nil {
+		return params, nil
+	}
+	return params, &types.RequestBody{
+		Required: bodyRequired,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: bodySchema},
+		},
+	}
+}
+
+// requiredOption reports whether a parameter decorator's options set
+// required: true, as in @QueryParam('limit', { required: true }).
+func (p *Plugin) requiredOption(args []*sitter.Node, content []byte) bool {
+	for _, arg := range args {
+		if arg.Type() != "object" {
+			continue
+		}
+		if value := objectProperty(arg, "required", content); value != nil {
+			return value.Type() == "true"
+		}
+	}
+	return false
+}
+
+// decoratorArgs returns the arguments of a decorator call, or nil for a
+// bare decorator.
+func (p *Plugin) decoratorArgs(decorator *sitter.Node, content []byte) []*sitter.Node {
+	if decorator.NamedChildCount() == 0 {
+		return nil
+	}
+	call := decorator.NamedChild(0)
+	if call.Type() != "call_expression" {
+		return nil
+	}
+	return p.tsParser.GetCallArguments(call, content)
+}
+
+// statusArgument reads a status code from a number or numeric string.
+func (p *Plugin) statusArgument(arg *sitter.Node, content []byte) int {
+	text := arg.Content(content)
+	if value, ok := p.tsParser.ExtractStringLiteral(arg, content); ok {
+		text = value
+	}
+	code, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+// ExtractSchemas extracts schema definitions from TypeScript interfaces,
+// type aliases and DTO classes.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	tsExtractor := schema.NewTypeScriptSchemaExtractor()
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "typescript" {
+			continue
+		}
+
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, iface := range pf.Interfaces {
+			tsExtractor.ExtractAndRegister(iface)
+		}
+		for _, alias := range pf.TypeAliases {
+			tsExtractor.ExtractAndRegisterAlias(alias)
+		}
+		parser.Walk(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() != "class_declaration" {
+				return true
+			}
+			if dto := p.parseDTOClass(node, file.Path, file.Content); dto != nil {
+				tsExtractor.ExtractAndRegister(*dto)
+			}
+			return false
+		})
+
+		pf.Close()
+	}
+
+	return tsExtractor.Registry().ToSlice(), nil
+}
+
+// parseDTOClass reads the fields of a DTO or entity class as an interface.
+// Controllers are skipped, and fields marked with class-validator's
+// @IsOptional() are optional.
+func (p *Plugin) parseDTOClass(classNode *sitter.Node, file string, content []byte) *parser.TSInterface {
+	nameNode := classNode.ChildByFieldName("name")
+	body := classNode.ChildByFieldName("body")
+	if nameNode == nil || body == nil {
+		return nil
+	}
+	for _, dec := range classDecorators(classNode) {
+		if controllerDecorators[decoratorName(dec, content)] {
+			return nil
+		}
+	}
+
+	dto := &parser.TSInterface{
+		Name:        nameNode.Content(content),
+		Description: p.tsParser.DocComment(classNode, content),
+		Line:        int(classNode.StartPoint().Row) + 1,
+		File:        file,
+	}
+	if parent := classNode.Parent(); parent != nil && parent.Type() == "export_statement" {
+		dto.IsExported = true
+	}
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		field := body.NamedChild(i)
+		if field.Type() != "public_field_definition" {
+			continue
+		}
+		prop := parser.TSProperty{
+			Description: p.tsParser.DocComment(field, content),
+			Line:        int(field.StartPoint().Row) + 1,
+		}
+		static := false
+		for j := 0; j < int(field.ChildCount()); j++ {
+			child := field.Child(j)
+			switch child.Type() {
+			case "static":
+				static = true
+			case "accessibility_modifier":
+				static = static || child.Content(content) != "public"
+			case "property_identifier":
+				prop.Name = child.Content(content)
+			case "?":
+				prop.IsOptional = true
+			case "readonly":
+				prop.IsReadonly = true
+			case "type_annotation":
+				if child.NamedChildCount() > 0 {
+					prop.Type = child.NamedChild(0).Content(content)
+				}
+			case "decorator":
+				if decoratorName(child, content) == "IsOptional" {
+					prop.IsOptional = true
+				}
+			}
+		}
+		if prop.Name != "" && !static {
+			dto.Properties = append(dto.Properties, prop)
+		}
+	}
+
+	return dto
+}
+
+// --- Helper Functions ---
+
+// colonParamRegex matches path parameters in the format :param, with an
+// optional routing-controllers regex constraint (:id(\d+)).
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)(\([^)]*\))?`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts Express-style path params (:id) to OpenAPI
+// format ({id}), and wildcards (*) to a templated {path}. tsoa paths are
+// already templated.
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// mergePathParams types the path parameters with their decorated handler
+// parameters and appends the other decorated parameters.
+func mergePathParams(pathParams, decorated []types.Parameter) []types.Parameter {
+	params := pathParams
+	for _, param := range decorated {
+		merged := false
+		if param.In == "path" {
+			for i := range params {
+				if params[i].Name == param.Name {
+					params[i].Schema = param.Schema
+					merged = true
+				}
+			}
+		}
+		if !merged && param.In != "path" {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// joinPath joins a controller base path and a method path.
+func joinPath(basePath, methodPath string) string {
+	var parts []string
+	for _, part := range []string{basePath, methodPath} {
+		if part = strings.Trim(part, "/"); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// inferTags infers tags from the controller name, falling back to the
+// first static path segment.
+func inferTags(controllerName, path string) []string {
+	if name := strings.TrimSuffix(controllerName, "Controller"); name != "" {
+		return []string{strings.ToLower(name)}
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part != "" && part != "api" && !strings.HasPrefix(part, "{") {
+			return []string{part}
+		}
+	}
+	return nil
+}
+
+// unwrapPromise strips a Promise<T> wrapper from a return type.
+func unwrapPromise(returnType string) string {
+	returnType = strings.TrimSpace(returnType)
+	if strings.HasPrefix(returnType, "Promise<") && strings.HasSuffix(returnType, ">") {
+		returnType = strings.TrimSpace(returnType[len("Promise<") : len(returnType)-1])
+	}
+	return returnType
+}
+
+// orAny defaults an unannotated parameter type to any.
+func orAny(tsType string) string {
+	if tsType == "" {
+		return "any"
+	}
+	return tsType
+}
+
+// objectProperty returns the value of a key in an object literal, or nil.
+func objectProperty(object *sitter.Node, key string, content []byte) *sitter.Node {
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		pair := object.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		keyNode := pair.ChildByFieldName("key")
+		if keyNode != nil && strings.Trim(keyNode.Content(content), `"'`) == key {
+			return pair.ChildByFieldName("value")
+		}
+	}
+	return nil
+}
+
+// decoratorName returns the unqualified name of a decorator (@Get('/') -> Get).
+func decoratorName(decorator *sitter.Node, content []byte) string {
+	if decorator.NamedChildCount() == 0 {
+		return ""
+	}
+	target := decorator.NamedChild(0)
+	if target.Type() == "call_expression" {
+		target = target.ChildByFieldName("function")
+		if target == nil {
+			return ""
+		}
+	}
+	name := target.Content(content)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// decoratorTypeArguments returns the type arguments of a decorator call
+// (@Response<ErrorBody>(404)), or nil.
+func decoratorTypeArguments(decorator *sitter.Node) *sitter.Node {
+	if decorator.NamedChildCount() == 0 {
+		return nil
+	}
+	call := decorator.NamedChild(0)
+	if call.Type() != "call_expression" {
+		return nil
+	}
+	return call.ChildByFieldName("type_arguments")
+}
+
+// Register registers the routing-controllers plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
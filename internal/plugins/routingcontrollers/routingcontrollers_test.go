@@ -0,0 +1,388 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package routingcontrollers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// routingControllersFixture is a routing-controllers JSON controller.
+const routingControllersFixture = `
+import { JsonController, Get, Post, Put, Delete, Param, QueryParam, Body, BodyParam, HeaderParam, HttpCode, OnUndefined } from 'routing-controllers';
+
+@JsonController('/users')
+export class UserController {
+  @Get('/')
+  getAll(@QueryParam('limit') limit: number, @QueryParam('sort', { required: true }) sort: string): Promise<User[]> {
+    return this.users.find();
+  }
+
+  @Get('/:id')
+  @OnUndefined(404)
+  getOne(@Param('id') id: number, @HeaderParam('x-tenant') tenant?: string): Promise<User> {
+    return this.users.findOne(id);
+  }
+
+  @Post('/')
+  @HttpCode(201)
+  create(@Body() user: CreateUserDto): Promise<User> {
+    return this.users.save(user);
+  }
+
+  @Put('/:id/name')
+  rename(@Param('id') id: number, @BodyParam('name', { required: true }) name: string, @BodyParam('reason') reason: string) {
+    return {};
+  }
+
+  @Delete('/:id')
+  async remove(@Param('id') id: number): Promise<void> {
+    await this.users.delete(id);
+  }
+}
+
+export class CreateUserDto {
+  /** Login email */
+  @IsEmail()
+  email: string;
+
+  @IsOptional()
+  name: string;
+
+  private secret: string;
+}
+`
+
+// tsedFixture is a Ts.ED controller.
+const tsedFixture = `
+import { Controller } from '@tsed/di';
+import { Get, Post, Returns, Status } from '@tsed/schema';
+import { PathParams, QueryParams, BodyParams } from '@tsed/platform-params';
+
+@Controller({ path: '/orders' })
+export class OrdersController {
+  @Get('/:orderId')
+  @Returns(200, Order)
+  @Returns(404)
+  get(@PathParams('orderId') orderId: string, @QueryParams('expand') expand?: boolean) {
+    return this.orders.get(orderId);
+  }
+
+  @Post()
+  @Status(201)
+  create(@BodyParams() order: Order, @QueryParams() query: any): Order {
+    return order;
+  }
+}
+`
+
+// tsoaFixture is a tsoa controller.
+const tsoaFixture = `
+import { Controller, Route, Get, Post, Path, Query, Body, SuccessResponse, Response, Produces } from 'tsoa';
+
+@Route('items')
+export class ItemsController extends Controller {
+  @Get('{itemId}')
+  @Response<ErrorBody>(404, 'Not found')
+  public async getItem(@Path() itemId: number, @Query() verbose?: boolean): Promise<Item> {
+    return this.service.get(itemId);
+  }
+
+  @Get('export')
+  @Produces('text/csv')
+  public async exportItems(@Query() format: string): Promise<string> {
+    return '';
+  }
+
+  @SuccessResponse('201', 'Created')
+  @Post()
+  public async createItem(@Body() body: ItemCreation): Promise<void> {
+    this.setStatus(201);
+  }
+}
+`
+
+func extract(t *testing.T, path, content string) []types.Route {
+	t.Helper()
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{{Path: path, Language: "typescript", Content: []byte(content)}})
+	require.NoError(t, err)
+	return routes
+}
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func findParam(route *types.Route, in, name string) *types.Parameter {
+	for i := range route.Parameters {
+		if route.Parameters[i].In == in && route.Parameters[i].Name == name {
+			return &route.Parameters[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	for _, dep := range []string{"routing-controllers", "@tsed/common", "tsoa", "inversify-express-utils", "awilix-express"} {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0", "`+dep+`": "1.0.0"}}`), 0o644))
+		detected, err := p.Detect(dir)
+		require.NoError(t, err)
+		assert.True(t, detected, dep)
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_RoutingControllers(t *testing.T) {
+	routes := extract(t, "src/user.controller.ts", routingControllersFixture)
+	require.Len(t, routes, 5)
+
+	list := findRoute(routes, "GET", "/users")
+	require.NotNil(t, list)
+	assert.Equal(t, "UserController.getAll", list.Handler)
+	assert.Equal(t, "getgetAll", list.OperationID)
+	assert.Equal(t, []string{"user"}, list.Tags)
+	assert.Equal(t, "src/user.controller.ts", list.SourceFile)
+	limit := findParam(list, "query", "limit")
+	require.NotNil(t, limit)
+	assert.False(t, limit.Required)
+	assert.Equal(t, "number", limit.Schema.Type)
+	sort := findParam(list, "query", "sort")
+	require.NotNil(t, sort)
+	assert.True(t, sort.Required)
+	assert.Equal(t, "array", list.Responses["200"].Content["application/json"].Schema.Type)
+	assert.Equal(t, "#/components/schemas/User", list.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+	get := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, get)
+	id := findParam(get, "path", "id")
+	require.NotNil(t, id)
+	assert.True(t, id.Required)
+	assert.Equal(t, "number", id.Schema.Type)
+	assert.NotNil(t, findParam(get, "header", "x-tenant"))
+	assert.Contains(t, get.Responses, "200")
+	assert.Equal(t, "Not Found", get.Responses["404"].Description)
+
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	require.NotNil(t, create.RequestBody)
+	assert.True(t, create.RequestBody.Required)
+	assert.Equal(t, "#/components/schemas/CreateUserDto", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/User", create.Responses["201"].Content["application/json"].Schema.Ref)
+
+	rename := findRoute(routes, "PUT", "/users/{id}/name")
+	require.NotNil(t, rename)
+	require.NotNil(t, rename.RequestBody)
+	body := rename.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "object", body.Type)
+	assert.Contains(t, body.Properties, "name")
+	assert.Contains(t, body.Properties, "reason")
+	assert.Equal(t, []string{"name"}, body.Required)
+
+	remove := findRoute(routes, "DELETE", "/users/{id}")
+	require.NotNil(t, remove)
+	assert.Contains(t, remove.Responses, "204")
+}
+
+func TestPlugin_ExtractRoutes_TsED(t *testing.T) {
+	routes := extract(t, "orders.controller.ts", tsedFixture)
+	require.Len(t, routes, 2)
+
+	get := findRoute(routes, "GET", "/orders/{orderId}")
+	require.NotNil(t, get)
+	assert.NotNil(t, findParam(get, "path", "orderId"))
+	expand := findParam(get, "query", "expand")
+	require.NotNil(t, expand)
+	assert.Equal(t, "boolean", expand.Schema.Type)
+	assert.Equal(t, "#/components/schemas/Order", get.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, get.Responses, "404")
+
+	create := findRoute(routes, "POST", "/orders")
+	require.NotNil(t, create)
+	assert.Empty(t, create.Parameters, "@QueryParams() without a name binds the whole query")
+	assert.Equal(t, "#/components/schemas/Order", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/Order", create.Responses["201"].Content["application/json"].Schema.Ref)
+}
+
+func TestPlugin_ExtractRoutes_Tsoa(t *testing.T) {
+	routes := extract(t, "items.controller.ts", tsoaFixture)
+	require.Len(t, routes, 3)
+
+	get := findRoute(routes, "GET", "/items/{itemId}")
+	require.NotNil(t, get)
+	itemID := findParam(get, "path", "itemId")
+	require.NotNil(t, itemID)
+	assert.Equal(t, "number", itemID.Schema.Type)
+	verbose := findParam(get, "query", "verbose")
+	require.NotNil(t, verbose)
+	assert.False(t, verbose.Required)
+	assert.Equal(t, "#/components/schemas/ErrorBody", get.Responses["404"].Content["application/json"].Schema.Ref)
+
+	export := findRoute(routes, "GET", "/items/export")
+	require.NotNil(t, export)
+	assert.True(t, findParam(export, "query", "format").Required)
+	assert.Equal(t, []string{"text/csv"}, export.Produces)
+
+	create := findRoute(routes, "POST", "/items")
+	require.NotNil(t, create)
+	assert.Equal(t, []string{"201"}, keys(create.Responses))
+	assert.Equal(t, "#/components/schemas/ItemCreation", create.RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestPlugin_ExtractRoutes_IgnoresOtherFrameworks(t *testing.T) {
+	routes := extract(t, "users.controller.ts", `
+import { Controller, Get } from '@nestjs/common';
+
+@Controller('users')
+export class UsersController {
+  @Get()
+  findAll() {}
+}
+`)
+	assert.Empty(t, routes)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{{Path: "user.controller.ts", Language: "typescript", Content: []byte(routingControllersFixture)}})
+	require.NoError(t, err)
+
+	var dto *types.Schema
+	for i := range schemas {
+		assert.NotEqual(t, "UserController", schemas[i].Title)
+		if schemas[i].Title == "CreateUserDto" {
+			dto = &schemas[i]
+		}
+	}
+	require.NotNil(t, dto)
+	assert.Contains(t, dto.Properties, "email")
+	assert.Contains(t, dto.Properties, "name")
+	assert.NotContains(t, dto.Properties, "secret")
+	assert.Equal(t, "Login email", dto.Properties["email"].Description)
+	assert.Equal(t, []string{"email"}, dto.Required)
+}
+
+func keys(responses map[string]types.Response) []string {
+	var codes []string
+	for code := range responses {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func TestPlugin_ExtractRoutes_Inversify(t *testing.T) {
+	routes := extract(t, "user.controller.ts", `
+import { controller, httpGet, httpPost, httpMethod, requestParam, queryParam, requestBody, request } from 'inversify-express-utils';
+import { inject } from 'inversify';
+
+@controller('/users', TYPES.AuthMiddleware)
+export class UserController {
+  constructor(@inject(TYPES.UserService) private readonly users: UserService) {}
+
+  @httpGet('/:id')
+  public get(@requestParam('id') id: number, @queryParam('expand') expand?: string): Promise<User> {
+    return this.users.get(id);
+  }
+
+  @httpPost('/')
+  public create(@requestBody() body: CreateUser, @request() req: Request): Promise<User> {
+    return this.users.create(body);
+  }
+
+  @httpMethod('patch', '/:id')
+  public update(@requestParam() params: any) {}
+}
+`)
+	require.Len(t, routes, 3)
+
+	get := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, get)
+	assert.Equal(t, "UserController.get", get.Handler)
+	assert.Equal(t, "number", findParam(get, "path", "id").Schema.Type)
+	assert.NotNil(t, findParam(get, "query", "expand"))
+	assert.Equal(t, "#/components/schemas/User", get.Responses["200"].Content["application/json"].Schema.Ref)
+
+	create := findRoute(routes, "POST", "/users")
+	require.NotNil(t, create)
+	assert.Equal(t, "#/components/schemas/CreateUser", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	update := findRoute(routes, "PATCH", "/users/{id}")
+	require.NotNil(t, update)
+	assert.Len(t, update.Parameters, 1)
+}
+
+func TestPlugin_ExtractRoutes_Awilix(t *testing.T) {
+	routes := extract(t, "routes/todos.ts", `
+import { route, GET, POST, before } from 'awilix-express';
+
+@route('/todos')
+export default class TodoAPI {
+  constructor(private readonly todoService: TodoService) {}
+
+  @GET()
+  async list(req, res) {}
+
+  @route('/:id')
+  @GET()
+  async get(req, res) {}
+
+  @route('/:id')
+  @before([authenticate()])
+  @POST()
+  async update(req, res) {}
+}
+`)
+	require.Len(t, routes, 3)
+	assert.NotNil(t, findRoute(routes, "GET", "/todos"))
+	assert.NotNil(t, findRoute(routes, "GET", "/todos/{id}"))
+	update := findRoute(routes, "POST", "/todos/{id}")
+	require.NotNil(t, update)
+	assert.Equal(t, "TodoAPI.update", update.Handler)
+
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{{Path: "routes/users.js", Language: "javascript", Content: []byte(`
+const { createController } = require('awilix-express');
+
+const API = ({ userService }) => ({
+  find: async (req, res) => res.send(await userService.find()),
+  get: async (req, res) => res.send(await userService.get(req.params.id)),
+});
+
+module.exports = createController(API)
+  .prefix('/users')
+  .get('', 'find')
+  .get('/:id', 'get', { before: [authenticate()] });
+`)}})
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	get := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, get)
+	assert.Equal(t, "API.get", get.Handler)
+	assert.Equal(t, []string{"users"}, get.Tags)
+	assert.Equal(t, "routes/users.js", get.SourceFile)
+	assert.Equal(t, 12, get.SourceLine)
+	assert.NotNil(t, findParam(get, "path", "id"))
+	assert.NotNil(t, findRoute(routes, "GET", "/users"))
+}
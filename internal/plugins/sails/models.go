@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package sails
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// model is a Waterline model of api/models.
+type model struct {
+	// name is the model's file name (User), which its schema is named after
+	name string
+
+	// identity is the lowercased name (user) its blueprint routes use
+	identity string
+
+	attributes   map[string]*types.Schema
+	required     map[string]bool
+	associations []association
+	file         string
+	line         int
+}
+
+// association is an attribute associating a model with another: a model
+// association (owner: { model: 'user' }) or a collection (pets: {
+// collection: 'pet', via: 'owner' }).
+type association struct {
+	name       string
+	collection bool
+}
+
+// addAttributes adds the attributes of a model definition, overriding the
+// default attributes of config/models.js.
+func (m *model) addAttributes(attributes *sitter.Node, content []byte, identities map[string]string) {
+	if m.attributes == nil {
+		m.attributes = make(map[string]*types.Schema)
+		m.required = make(map[string]bool)
+	}
+	for _, pair := range parser.ObjectPairs(attributes) {
+		name := parser.PairKey(pair, content)
+		value := pair.ChildByFieldName("value")
+		if value == nil || value.Type() != "object" {
+			// Setting a default attribute to false removes it
+			delete(m.attributes, name)
+			delete(m.required, name)
+			continue
+		}
+
+		s, required := attributeSchema(value, content, identities)
+		m.attributes[name] = s
+		m.required[name] = required
+
+		if parser.ObjectProperty(value, "collection", content) != nil {
+			m.associations = append(m.associations, association{name: name, collection: true})
+		} else if parser.ObjectProperty(value, "model", content) != nil {
+			m.associations = append(m.associations, association{name: name})
+		}
+	}
+}
+
+// schema returns the schema of the model's records.
+func (m *model) schema() *types.Schema {
+	s := &types.Schema{
+		Type:       "object",
+		Title:      m.name,
+		Properties: m.attributes,
+		Source:     &types.SourceLocation{File: m.file, Line: m.line},
+	}
+	for name, required := range m.required {
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+// attributeSchema converts a model attribute or actions2 input definition.
+// required is set for definitions with required: true.
+func attributeSchema(definition *sitter.Node, content []byte, identities map[string]string) (*types.Schema, bool) {
+	s := &types.Schema{}
+	required := false
+
+	for _, pair := range parser.ObjectPairs(definition) {
+		value := pair.ChildByFieldName("value")
+		if value == nil {
+			continue
+		}
+		number := func() *float64 {
+			n, err := strconv.ParseFloat(value.Content(content), 64)
+			if err != nil {
+				return nil
+			}
+			return &n
+		}
+		length := func() *int {
+			if n := number(); n != nil {
+				length := int(*n)
+				return &length
+			}
+			return nil
+		}
+
+		switch parser.PairKey(pair, content) {
+		case "type":
+			switch t := parser.StringValue(value, content); t {
+			case "string", "number", "boolean":
+				s.Type = t
+			}
+		case "required":
+			required = value.Type() == "true"
+		case "description":
+			s.Description = parser.StringValue(value, content)
+		case "example":
+			s.Example = literalValue(value, content)
+		case "defaultsTo":
+			s.Default = literalValue(value, content)
+		case "allowNull":
+			s.Nullable = value.Type() == "true"
+		case "isIn":
+			for i := 0; i < int(value.NamedChildCount()); i++ {
+				s.Enum = append(s.Enum, literalValue(value.NamedChild(i), content))
+			}
+		case "isEmail":
+			s.Format = "email"
+		case "isURL":
+			s.Format = "uri"
+		case "isUUID":
+			s.Format = "uuid"
+		case "isInteger":
+			s.Type = "integer"
+		case "min":
+			s.Minimum = number()
+		case "max":
+			s.Maximum = number()
+		case "minLength":
+			s.MinLength = length()
+		case "maxLength":
+			s.MaxLength = length()
+		case "regex":
+			if pattern := value.ChildByFieldName("pattern"); pattern != nil {
+				s.Pattern = pattern.Content(content)
+			}
+		case "autoIncrement", "autoCreatedAt", "autoUpdatedAt":
+			s.ReadOnly = value.Type() == "true"
+		case "model":
+			s = associated(parser.StringValue(value, content), identities)
+		case "collection":
+			s = &types.Schema{Type: "array", Items: associated(parser.StringValue(value, content), identities)}
+		}
+	}
+
+	return s, required
+}
+
+// associated returns the schema of an associated model's records.
+func associated(identity string, identities map[string]string) *types.Schema {
+	if name, ok := identities[strings.ToLower(identity)]; ok {
+		return schema.SchemaRef(name)
+	}
+	return &types.Schema{}
+}
+
+// literalValue returns the value of a string, number or boolean literal.
+func literalValue(node *sitter.Node, content []byte) any {
+	switch node.Type() {
+	case "number":
+		if n, err := strconv.ParseFloat(node.Content(content), 64); err == nil {
+			return n
+		}
+	case "true", "false":
+		return node.Type() == "true"
+	}
+	return parser.StringValue(node, content)
+}
+
+// blueprintRoutes returns the RESTful blueprint routes of a model: find,
+// findOne, create, update and destroy, and the populate, add, remove and
+// replace routes of its associations.
+func (a *app) blueprintRoutes(m *model) []types.Route {
+	base := "/" + m.identity
+	if a.blueprints.pluralize {
+		base += "s"
+	}
+	base = joinPath(joinPath(a.blueprints.prefix, a.blueprints.restPrefix), base)
+
+	record := schema.SchemaRef(m.name)
+	records := &types.Schema{Type: "array", Items: record}
+	id := &types.Schema{Type: "string"}
+
+	route := func(method, path, action string, response *types.Schema, body *types.Schema) types.Route {
+		r := types.Route{
+			Method:      method,
+			Path:        path,
+			Handler:     m.identity + "/" + action,
+			OperationID: operationID(method, m.identity+"/"+action, false),
+			Tags:        []string{m.identity},
+			Parameters:  extractPathParams(path),
+			SourceFile:  m.file,
+			SourceLine:  m.line,
+			Responses: map[string]types.Response{"200": {
+				Description: "Success response",
+				Content:     map[string]types.MediaType{"application/json": {Schema: response}},
+			}},
+		}
+		if body != nil {
+			r.RequestBody = &types.RequestBody{
+				Required: true,
+				Content:  map[string]types.MediaType{"application/json": {Schema: body}},
+			}
+		}
+		return r
+	}
+
+	find := route("GET", base, "find", records, nil)
+	find.Parameters = []types.Parameter{
+		{Name: "where", In: "query", Description: "Waterline criteria as JSON", Schema: &types.Schema{Type: "string"}},
+		{Name: "limit", In: "query", Description: "Maximum number of records", Schema: &types.Schema{Type: "integer"}},
+		{Name: "skip", In: "query", Description: "Number of records to skip", Schema: &types.Schema{Type: "integer"}},
+		{Name: "sort", In: "query", Description: "Sort order, such as createdAt DESC", Schema: &types.Schema{Type: "string"}},
+	}
+
+	routes := []types.Route{
+		find,
+		route("GET", base+"/{id}", "findOne", record, nil),
+		route("POST", base, "create", record, record),
+		route("PATCH", base+"/{id}", "update", record, record),
+		route("DELETE", base+"/{id}", "destroy", record, nil),
+	}
+
+	for _, assoc := range m.associations {
+		suffix := strings.ToUpper(assoc.name[:1]) + assoc.name[1:]
+		associationPath := base + "/{id}/" + assoc.name
+		routes = append(routes, route("GET", associationPath, "populate"+suffix, m.attributes[assoc.name], nil))
+		if assoc.collection {
+			routes = append(routes,
+				route("PUT", associationPath+"/{childid}", "add"+suffix, record, nil),
+				route("DELETE", associationPath+"/{childid}", "remove"+suffix, record, nil),
+				route("PUT", associationPath, "replace"+suffix, record, &types.Schema{Type: "array", Items: id}),
+			)
+		}
+	}
+
+	return routes
+}
+
+// joinPath joins a path prefix and a path.
+func joinPath(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	joined := "/" + strings.Trim(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return joined
+}
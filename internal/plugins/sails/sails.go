@@ -0,0 +1,715 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package sails provides a plugin for extracting routes from Sails.js
+// applications: the custom routes in config/routes.js, documented from the
+// inputs and exits of the actions they target, and the RESTful blueprint
+// routes of every model.
+package sails
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/schema"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// anyMethod are the methods a route address without a verb is documented
+// under; Sails matches it for every verb.
+var anyMethod = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// exitStatus are the status codes of the standard exit response types.
+var exitStatus = map[string]string{
+	"ok":          "200",
+	"badRequest":  "400",
+	"forbidden":   "403",
+	"notFound":    "404",
+	"serverError": "500",
+}
+
+// Plugin implements the FrameworkPlugin interface for Sails.
+type Plugin struct {
+	tsParser *parser.TypeScriptParser
+}
+
+// New creates a new Sails plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		tsParser: parser.NewTypeScriptParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "sails"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".js", ".ts"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "sails",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Sails config/routes.js and model blueprints",
+		SupportedFrameworks: []string{
+			"sails",
+		},
+	}
+}
+
+// Detect checks if Sails is used in the project by looking at package.json.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return false, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	if _, ok := pkg.Dependencies["sails"]; ok {
+		return true, nil
+	}
+	if _, ok := pkg.DevDependencies["sails"]; ok {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// app holds what routes are documented from across the project's files.
+type app struct {
+	// routes are the custom route definitions of config/routes.js
+	routes []customRoute
+
+	// actions are the actions by identity (user/create)
+	actions map[string]*action
+
+	// models are the models by identity (user), in file order
+	models []*model
+
+	// blueprints is the blueprint configuration of config/blueprints.js
+	blueprints blueprints
+}
+
+// customRoute is a route address and the action it targets.
+type customRoute struct {
+	methods []string
+	path    string
+	target  string
+	file    string
+	line    int
+}
+
+// action is a controller action. Actions2 definitions declare their
+// inputs and exits.
+type action struct {
+	handler string
+	inputs  map[string]input
+	exits   map[string]types.Response
+}
+
+// input is an actions2 input.
+type input struct {
+	schema   *types.Schema
+	required bool
+}
+
+// blueprints is the blueprint configuration.
+type blueprints struct {
+	rest       bool
+	prefix     string
+	restPrefix string
+	pluralize  bool
+}
+
+// ExtractRoutes parses source files and extracts the custom routes and the
+// RESTful blueprint routes of models.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	a := p.collectApp(ctx, files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var routes []types.Route
+	explicit := make(map[string]bool)
+	for _, cr := range a.routes {
+		for _, method := range cr.methods {
+			route := a.customRoute(method, cr)
+			explicit[route.Method+" "+route.Path] = true
+			routes = append(routes, route)
+		}
+	}
+
+	if a.blueprints.rest {
+		for _, m := range a.models {
+			for _, route := range a.blueprintRoutes(m) {
+				// Custom routes take precedence over blueprints
+				if !explicit[route.Method+" "+route.Path] {
+					routes = append(routes, route)
+				}
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// collectApp parses the project's routes, actions, models and blueprint
+// configuration, which Sails loads from conventional locations.
+func (p *Plugin) collectApp(ctx context.Context, files []scanner.SourceFile) *app {
+	a := &app{
+		actions:    make(map[string]*action),
+		blueprints: blueprints{rest: true},
+	}
+
+	var defaultAttributes *sitter.Node
+	var defaultContent []byte
+	type modelFile struct {
+		name       string
+		attributes *sitter.Node
+		content    []byte
+		file       string
+		line       int
+	}
+	var modelFiles []modelFile
+
+	var parsed []*parser.ParsedTSFile
+	defer func() {
+		for _, pf := range parsed {
+			pf.Close()
+		}
+	}()
+
+	for _, file := range files {
+		if file.Language != "javascript" && file.Language != "typescript" {
+			continue
+		}
+		rel, ok := appPath(file.Path)
+		if !ok {
+			continue
+		}
+		pf, err := p.tsParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, pf)
+		content := file.Content
+
+		switch {
+		case rel == "config/routes.js" || rel == "config/routes.ts":
+			if routes := exported(pf.RootNode, "routes", content); routes != nil {
+				a.routes = append(a.routes, p.parseRoutes(routes, file.Path, content)...)
+			}
+		case rel == "config/blueprints.js" || rel == "config/blueprints.ts":
+			if config := exported(pf.RootNode, "blueprints", content); config != nil {
+				a.blueprints = parseBlueprints(config, content)
+			}
+		case rel == "config/models.js" || rel == "config/models.ts":
+			if config := exported(pf.RootNode, "models", content); config != nil {
+				defaultAttributes = parser.ObjectProperty(config, "attributes", content)
+				defaultContent = content
+			}
+		case strings.HasPrefix(rel, "api/models/"):
+			definition := exported(pf.RootNode, "", content)
+			if definition == nil {
+				continue
+			}
+			name := strings.TrimSuffix(path.Base(rel), path.Ext(rel))
+			modelFiles = append(modelFiles, modelFile{
+				name:       name,
+				attributes: parser.ObjectProperty(definition, "attributes", content),
+				content:    content,
+				file:       file.Path,
+				line:       int(definition.StartPoint().Row) + 1,
+			})
+		case strings.HasPrefix(rel, "api/controllers/"):
+			p.parseController(a, strings.TrimPrefix(rel, "api/controllers/"), pf.RootNode, content)
+		}
+	}
+
+	identities := make(map[string]string, len(modelFiles))
+	for _, mf := range modelFiles {
+		identities[strings.ToLower(mf.name)] = mf.name
+	}
+	for _, mf := range modelFiles {
+		m := &model{name: mf.name, identity: strings.ToLower(mf.name), file: mf.file, line: mf.line}
+		if defaultAttributes != nil {
+			m.addAttributes(defaultAttributes, defaultContent, identities)
+		}
+		if mf.attributes != nil {
+			m.addAttributes(mf.attributes, mf.content, identities)
+		}
+		a.models = append(a.models, m)
+	}
+
+	return a
+}
+
+// parseRoutes reads the route addresses of config/routes.js:
+// 'GET /users/:id': 'UserController.findOne' or { action: 'user/find' }.
+func (p *Plugin) parseRoutes(routes *sitter.Node, file string, content []byte) []customRoute {
+	var result []customRoute
+	for _, pair := range parser.ObjectPairs(routes) {
+		methods, routePath := splitAddress(parser.PairKey(pair, content))
+		if routePath == "" {
+			continue
+		}
+		target, ok := routeTarget(pair.ChildByFieldName("value"), content)
+		if !ok {
+			continue
+		}
+		result = append(result, customRoute{
+			methods: methods,
+			path:    routePath,
+			target:  target,
+			file:    file,
+			line:    int(pair.StartPoint().Row) + 1,
+		})
+	}
+	return result
+}
+
+// routeTarget returns the action identity a route targets. Views,
+// redirects and responses are pages rather than API operations; inline
+// functions have no identity.
+func routeTarget(value *sitter.Node, content []byte) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+	switch value.Type() {
+	case "string", "template_string":
+		target := parser.StringValue(value, content)
+		if strings.HasPrefix(target, "/") || strings.Contains(target, "://") {
+			return "", false
+		}
+		return actionIdentity(target), true
+	case "object":
+		if parser.ObjectProperty(value, "view", content) != nil || parser.ObjectProperty(value, "redirect", content) != nil || parser.ObjectProperty(value, "response", content) != nil {
+			return "", false
+		}
+		if target := parser.ObjectProperty(value, "action", content); target != nil {
+			name := parser.StringValue(target, content)
+			if controller := parser.ObjectProperty(value, "controller", content); controller != nil {
+				name = parser.StringValue(controller, content) + "." + name
+			}
+			return actionIdentity(name), true
+		}
+		if blueprint := parser.ObjectProperty(value, "blueprint", content); blueprint != nil {
+			if model := parser.ObjectProperty(value, "model", content); model != nil {
+				return parser.StringValue(model, content) + "/" + parser.StringValue(blueprint, content), true
+			}
+		}
+		return "", false
+	case "function_expression", "function", "arrow_function":
+		return "", true
+	}
+	return "", false
+}
+
+// parseController indexes the actions of a controller file: a classic
+// UserController.js exporting action functions, or an actions2 file like
+// user/create.js exporting inputs, exits and fn.
+func (p *Plugin) parseController(a *app, rel string, root *sitter.Node, content []byte) {
+	definition := exported(root, "", content)
+	if definition == nil {
+		return
+	}
+	rel = strings.TrimSuffix(rel, path.Ext(rel))
+
+	if name := path.Base(rel); strings.HasSuffix(name, "Controller") {
+		controller := strings.TrimSuffix(name, "Controller")
+		dir := path.Dir(rel)
+		for i := 0; i < int(definition.NamedChildCount()); i++ {
+			member := definition.NamedChild(i)
+			var actionName string
+			var value *sitter.Node
+			switch member.Type() {
+			case "pair":
+				actionName = parser.PairKey(member, content)
+				value = member.ChildByFieldName("value")
+			case "method_definition":
+				if n := member.ChildByFieldName("name"); n != nil {
+					actionName = n.Content(content)
+				}
+				value = member
+			default:
+				continue
+			}
+			act := &action{handler: name + "." + actionName}
+			if value != nil && value.Type() == "object" {
+				parseActions2(act, value, content)
+			}
+			identity := actionIdentity(path.Join(dir, controller) + "." + actionName)
+			a.actions[identity] = act
+		}
+		return
+	}
+
+	act := &action{handler: rel}
+	parseActions2(act, definition, content)
+	a.actions[actionIdentity(rel)] = act
+}
+
+// parseActions2 reads the inputs and exits of an actions2 definition.
+func parseActions2(act *action, definition *sitter.Node, content []byte) {
+	if inputs := parser.ObjectProperty(definition, "inputs", content); inputs != nil && inputs.Type() == "object" {
+		act.inputs = make(map[string]input)
+		for _, pair := range parser.ObjectPairs(inputs) {
+			if value := pair.ChildByFieldName("value"); value != nil && value.Type() == "object" {
+				s, required := attributeSchema(value, content, nil)
+				act.inputs[parser.PairKey(pair, content)] = input{schema: s, required: required}
+			}
+		}
+	}
+
+	if exits := parser.ObjectProperty(definition, "exits", content); exits != nil && exits.Type() == "object" {
+		act.exits = make(map[string]types.Response)
+		for _, pair := range parser.ObjectPairs(exits) {
+			value := pair.ChildByFieldName("value")
+			if value == nil || value.Type() != "object" {
+				continue
+			}
+			name := parser.PairKey(pair, content)
+			status := ""
+			if code := parser.ObjectProperty(value, "statusCode", content); code != nil {
+				status = code.Content(content)
+			} else if responseType := parser.ObjectProperty(value, "responseType", content); responseType != nil {
+				status = exitStatus[parser.StringValue(responseType, content)]
+			} else if name == "success" {
+				status = "200"
+			}
+			if status == "" {
+				continue
+			}
+			description := "Success response"
+			if d := parser.ObjectProperty(value, "description", content); d != nil {
+				description = parser.StringValue(d, content)
+			} else if name != "success" {
+				description = name
+			}
+			act.exits[status] = types.Response{Description: description}
+		}
+	}
+}
+
+// customRoute returns the route of a custom route address for a method,
+// documenting the inputs and exits of the action it targets.
+func (a *app) customRoute(method string, cr customRoute) types.Route {
+	wildcard := plugins.IsCatchAll(cr.path)
+	fullPath := convertPathParams(cr.path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     cr.target,
+		OperationID: operationID(method, cr.target, len(cr.methods) > 1),
+		Tags:        inferTags(fullPath),
+		Parameters:  extractPathParams(fullPath),
+		SourceFile:  cr.file,
+		SourceLine:  cr.line,
+	}
+
+	if act, ok := a.actions[cr.target]; ok {
+		route.Handler = act.handler
+		applyInputs(&route, act.inputs)
+		if len(act.exits) > 0 {
+			route.Responses = act.exits
+		}
+	}
+	if route.OperationID == "" {
+		route.OperationID = pathOperationID(method, fullPath)
+	}
+
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	return route
+}
+
+// applyInputs documents an action's inputs: those named in the path as
+// path parameters, the others as query parameters of GET, HEAD and DELETE
+// routes and as the JSON body of the others.
+func applyInputs(route *types.Route, inputs map[string]input) {
+	if len(inputs) == 0 {
+		return
+	}
+
+	inPath := make(map[string]int)
+	for i, param := range route.Parameters {
+		inPath[param.Name] = i
+	}
+
+	body := &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+	for _, name := range sortedKeys(inputs) {
+		in := inputs[name]
+		if i, ok := inPath[name]; ok {
+			route.Parameters[i].Schema = in.schema
+			continue
+		}
+		switch route.Method {
+		case "GET", "HEAD", "DELETE":
+			route.Parameters = append(route.Parameters, types.Parameter{
+				Name:     name,
+				In:       "query",
+				Required: in.required,
+				Schema:   in.schema,
+			})
+		default:
+			body.Properties[name] = in.schema
+			if in.required {
+				body.Required = append(body.Required, name)
+			}
+		}
+	}
+
+	if len(body.Properties) > 0 {
+		route.RequestBody = &types.RequestBody{
+			Required: len(body.Required) > 0,
+			Content:  map[string]types.MediaType{"application/json": {Schema: body}},
+		}
+	}
+}
+
+// ExtractSchemas extracts a schema for every model.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	a := p.collectApp(ctx, files)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	registry := schema.NewTypeScriptSchemaExtractor().Registry()
+	for _, m := range a.models {
+		registry.Add(m.name, m.schema())
+	}
+
+	return registry.ToSlice(), nil
+}
+
+// --- Helper Functions ---
+
+// appPath returns a file's path relative to the Sails app root, found by
+// its api/ or config/ directory.
+func appPath(file string) (string, bool) {
+	file = filepath.ToSlash(file)
+	for _, dir := range []string{"api/", "config/"} {
+		if strings.HasPrefix(file, dir) {
+			return file, true
+		}
+		if i := strings.LastIndex(file, "/"+dir); i >= 0 {
+			return file[i+1:], true
+		}
+	}
+	return "", false
+}
+
+// exported returns the object a file exports under name, assigned as
+// module.exports.name = {...} or as a key of module.exports = {...}. An
+// empty name returns the module.exports object itself.
+func exported(root *sitter.Node, name string, content []byte) *sitter.Node {
+	var named, module *sitter.Node
+	parser.Walk(root, func(node *sitter.Node) bool {
+		if node.Type() != "assignment_expression" {
+			return true
+		}
+		left := node.ChildByFieldName("left")
+		right := node.ChildByFieldName("right")
+		if left == nil || right == nil || right.Type() != "object" {
+			return true
+		}
+		switch left.Content(content) {
+		case "module.exports":
+			module = right
+		case "module.exports." + name:
+			named = right
+		}
+		return false
+	})
+
+	switch {
+	case name == "":
+		return module
+	case named != nil:
+		return named
+	case module != nil:
+		if value := parser.ObjectProperty(module, name, content); value != nil && value.Type() == "object" {
+			return value
+		}
+	}
+	return nil
+}
+
+// parseBlueprints reads the blueprint configuration.
+func parseBlueprints(config *sitter.Node, content []byte) blueprints {
+	b := blueprints{rest: true}
+	if rest := parser.ObjectProperty(config, "rest", content); rest != nil {
+		b.rest = rest.Type() != "false"
+	}
+	if prefix := parser.ObjectProperty(config, "prefix", content); prefix != nil {
+		b.prefix = parser.StringValue(prefix, content)
+	}
+	if restPrefix := parser.ObjectProperty(config, "restPrefix", content); restPrefix != nil {
+		b.restPrefix = parser.StringValue(restPrefix, content)
+	}
+	if pluralize := parser.ObjectProperty(config, "pluralize", content); pluralize != nil {
+		b.pluralize = pluralize.Type() == "true"
+	}
+	return b
+}
+
+// addressRegex matches a route address: an optional verb and a path.
+var addressRegex = regexp.MustCompile(`^(?:([a-zA-Z]+)\s+)?(/\S*)$`)
+
+// splitAddress splits a route address ("GET /users/:id") into its methods
+// and path. Addresses without a verb, or with "all", match every method.
+func splitAddress(address string) ([]string, string) {
+	match := addressRegex.FindStringSubmatch(strings.TrimSpace(address))
+	if match == nil {
+		return nil, ""
+	}
+	verb := strings.ToUpper(match[1])
+	if verb == "" || verb == "ALL" {
+		return anyMethod, match[2]
+	}
+	return []string{verb}, match[2]
+}
+
+// actionIdentity returns the identity of an action target:
+// UserController.find -> user/find, admin/UserController.find ->
+// admin/user/find, and user/find unchanged.
+func actionIdentity(target string) string {
+	if controller, name, ok := strings.Cut(target, "."); ok {
+		dir, base := path.Split(controller)
+		return strings.ToLower(dir + strings.TrimSuffix(base, "Controller") + "/" + name)
+	}
+	return strings.ToLower(target)
+}
+
+// colonParamRegex matches path parameters in the format :param or :param?.
+var colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)\??`)
+
+// braceParamRegex matches path parameters in the format {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// convertPathParams converts Sails path params (:id) to OpenAPI format
+// ({id}), and wildcards (*) to a templated {path}.
+func convertPathParams(path string) string {
+	return plugins.CatchAllPath(colonParamRegex.ReplaceAllString(path, "{$1}"))
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// operationID generates an operation ID from an action identity
+// (user/find -> userFind). shared is set for actions serving several
+// methods, whose IDs are prefixed with the method.
+func operationID(method, identity string, shared bool) string {
+	if identity == "" {
+		return ""
+	}
+	var sb strings.Builder
+	if shared {
+		sb.WriteString(strings.ToLower(method))
+	}
+	for _, word := range strings.FieldsFunc(identity, func(r rune) bool { return r == '/' || r == '-' || r == '_' || r == '.' }) {
+		if sb.Len() == 0 {
+			sb.WriteString(word)
+		} else {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
+
+// pathOperationID generates an operation ID from a method and path, for
+// routes handled inline.
+func pathOperationID(method, path string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	for _, part := range strings.Split(braceParamRegex.ReplaceAllString(path, "By${1}"), "/") {
+		for _, word := range strings.FieldsFunc(part, func(r rune) bool { return r == '-' || r == '_' }) {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || part == "api" || strings.HasPrefix(part, "{") || versionRegex.MatchString(part) {
+			continue
+		}
+		return []string{part}
+	}
+	return nil
+}
+
+// versionRegex matches version path segments like v1.
+var versionRegex = regexp.MustCompile(`^v[0-9]+$`)
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Register registers the Sails plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package sails
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const routesFixture = `
+module.exports.routes = {
+  '/': { view: 'pages/homepage' },
+  'GET /login': '/signin',
+  'POST /api/v1/users': { action: 'user/signup' },
+  'GET /api/v1/users/:id/profile': 'UserController.profile',
+  'PATCH /pet/:id': 'pet/rename',
+  '/api/v1/ping': function (req, res) { return res.ok(); },
+};
+`
+
+const blueprintsFixture = `
+module.exports.blueprints = {
+  shortcuts: false,
+  prefix: '/api',
+  restPrefix: '/v1',
+};
+`
+
+const blueprintsDisabledFixture = `
+module.exports.blueprints = { rest: false };
+`
+
+const modelsConfigFixture = `
+module.exports.models = {
+  schema: true,
+  attributes: {
+    createdAt: { type: 'number', autoCreatedAt: true },
+    updatedAt: { type: 'number', autoUpdatedAt: true },
+    id: { type: 'number', autoIncrement: true },
+  },
+};
+`
+
+const userModelFixture = `
+module.exports = {
+  attributes: {
+    emailAddress: { type: 'string', required: true, unique: true, isEmail: true, maxLength: 200 },
+    role: { type: 'string', isIn: ['admin', 'member'], defaultsTo: 'member' },
+    pets: { collection: 'pet', via: 'owner' },
+  },
+};
+`
+
+const petModelFixture = `
+module.exports = {
+  attributes: {
+    name: { type: 'string', required: true, description: 'Pet name' },
+    owner: { model: 'user' },
+  },
+};
+`
+
+const signupFixture = `
+module.exports = {
+  friendlyName: 'Signup',
+  inputs: {
+    emailAddress: { type: 'string', required: true, isEmail: true },
+    password: { type: 'string', required: true, minLength: 8 },
+    fullName: { type: 'string' },
+  },
+  exits: {
+    success: { statusCode: 201, description: 'The account was created.' },
+    emailAlreadyInUse: { statusCode: 409, description: 'The email address is already in use.' },
+    invalid: { responseType: 'badRequest' },
+  },
+  fn: async function (inputs) {},
+};
+`
+
+const userControllerFixture = `
+module.exports = {
+  profile: {
+    inputs: {
+      id: { type: 'number', required: true },
+      expand: { type: 'boolean' },
+    },
+    fn: async function (inputs) {},
+  },
+};
+`
+
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	p := New()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"sails": "^1.5.0", "sails-hook-orm": "^4.0.0"}}`), 0o644))
+	detected, err := p.Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	dir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"express": "^4.18.0"}}`), 0o644))
+	detected, err = p.Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	detected, err = p.Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes_CustomRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/config/routes.js", Language: "javascript", Content: []byte(routesFixture)},
+		{Path: "/app/api/controllers/user/signup.js", Language: "javascript", Content: []byte(signupFixture)},
+		{Path: "/app/api/controllers/UserController.js", Language: "javascript", Content: []byte(userControllerFixture)},
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, findRoute(routes, "GET", "/"), "views are not API operations")
+	assert.Nil(t, findRoute(routes, "GET", "/login"), "redirects are not API operations")
+
+	signup := findRoute(routes, "POST", "/api/v1/users")
+	require.NotNil(t, signup)
+	assert.Equal(t, "user/signup", signup.Handler)
+	assert.Equal(t, "userSignup", signup.OperationID)
+	assert.Equal(t, []string{"users"}, signup.Tags)
+	assert.Equal(t, "/app/config/routes.js", signup.SourceFile)
+	require.NotNil(t, signup.RequestBody)
+	body := signup.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, []string{"emailAddress", "password"}, body.Required)
+	assert.Equal(t, "email", body.Properties["emailAddress"].Format)
+	assert.Equal(t, 8, *body.Properties["password"].MinLength)
+	assert.Contains(t, body.Properties, "fullName")
+	assert.Equal(t, "The account was created.", signup.Responses["201"].Description)
+	assert.Contains(t, signup.Responses, "409")
+	assert.Contains(t, signup.Responses, "400")
+
+	profile := findRoute(routes, "GET", "/api/v1/users/{id}/profile")
+	require.NotNil(t, profile)
+	assert.Equal(t, "UserController.profile", profile.Handler)
+	require.Len(t, profile.Parameters, 2)
+	assert.Equal(t, "path", profile.Parameters[0].In)
+	assert.Equal(t, "number", profile.Parameters[0].Schema.Type)
+	assert.Equal(t, "expand", profile.Parameters[1].Name)
+	assert.Equal(t, "query", profile.Parameters[1].In)
+
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		ping := findRoute(routes, method, "/api/v1/ping")
+		require.NotNil(t, ping, "addresses without a verb match "+method)
+		assert.NotEmpty(t, ping.OperationID)
+	}
+}
+
+func TestPlugin_ExtractRoutes_Blueprints(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/config/routes.js", Language: "javascript", Content: []byte(routesFixture)},
+		{Path: "/app/config/blueprints.js", Language: "javascript", Content: []byte(blueprintsFixture)},
+		{Path: "/app/config/models.js", Language: "javascript", Content: []byte(modelsConfigFixture)},
+		{Path: "/app/api/models/User.js", Language: "javascript", Content: []byte(userModelFixture)},
+		{Path: "/app/api/models/Pet.js", Language: "javascript", Content: []byte(petModelFixture)},
+	})
+	require.NoError(t, err)
+
+	find := findRoute(routes, "GET", "/api/v1/user")
+	require.NotNil(t, find)
+	assert.Equal(t, "userFind", find.OperationID)
+	assert.Equal(t, "/app/api/models/User.js", find.SourceFile)
+	assert.Equal(t, "#/components/schemas/User", find.Responses["200"].Content["application/json"].Schema.Items.Ref)
+	assert.Len(t, find.Parameters, 4)
+
+	for _, route := range []struct{ method, path, operation string }{
+		{"GET", "/api/v1/user/{id}", "userFindOne"},
+		{"POST", "/api/v1/user", "userCreate"},
+		{"PATCH", "/api/v1/user/{id}", "userUpdate"},
+		{"DELETE", "/api/v1/user/{id}", "userDestroy"},
+		{"GET", "/api/v1/user/{id}/pets", "userPopulatePets"},
+		{"PUT", "/api/v1/user/{id}/pets/{childid}", "userAddPets"},
+		{"DELETE", "/api/v1/user/{id}/pets/{childid}", "userRemovePets"},
+		{"PUT", "/api/v1/user/{id}/pets", "userReplacePets"},
+		{"GET", "/api/v1/pet/{id}/owner", "petPopulateOwner"},
+	} {
+		r := findRoute(routes, route.method, route.path)
+		require.NotNil(t, r, route.method+" "+route.path)
+		assert.Equal(t, route.operation, r.OperationID)
+	}
+
+	create := findRoute(routes, "POST", "/api/v1/user")
+	assert.Equal(t, "#/components/schemas/User", create.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Nil(t, findRoute(routes, "PUT", "/api/v1/pet/{id}/owner/{childid}"), "model associations have no add route")
+
+	rename := findRoute(routes, "PATCH", "/pet/{id}")
+	require.NotNil(t, rename, "custom routes outside the blueprint prefix are kept")
+}
+
+func TestPlugin_ExtractRoutes_BlueprintsDisabled(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "/app/config/routes.js", Language: "javascript", Content: []byte(routesFixture)},
+		{Path: "/app/config/blueprints.js", Language: "javascript", Content: []byte(blueprintsDisabledFixture)},
+		{Path: "/app/config/models.js", Language: "javascript", Content: []byte(modelsConfigFixture)},
+		{Path: "/app/api/models/User.js", Language: "javascript", Content: []byte(userModelFixture)},
+		{Path: "/app/api/models/Pet.js", Language: "javascript", Content: []byte(petModelFixture)},
+	})
+	require.NoError(t, err)
+
+	for _, route := range routes {
+		assert.Equal(t, "/app/config/routes.js", route.SourceFile, route.Method+" "+route.Path)
+	}
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "/app/config/models.js", Language: "javascript", Content: []byte(modelsConfigFixture)},
+		{Path: "/app/api/models/User.js", Language: "javascript", Content: []byte(userModelFixture)},
+		{Path: "/app/api/models/Pet.js", Language: "javascript", Content: []byte(petModelFixture)},
+	})
+	require.NoError(t, err)
+
+	byName := make(map[string]types.Schema)
+	for _, s := range schemas {
+		byName[s.Title] = s
+	}
+
+	user, ok := byName["User"]
+	require.True(t, ok)
+	assert.Equal(t, []string{"emailAddress"}, user.Required)
+	assert.Equal(t, "email", user.Properties["emailAddress"].Format)
+	assert.Equal(t, 200, *user.Properties["emailAddress"].MaxLength)
+	assert.Equal(t, []any{"admin", "member"}, user.Properties["role"].Enum)
+	assert.Equal(t, "member", user.Properties["role"].Default)
+	assert.True(t, user.Properties["id"].ReadOnly, "default attributes come from config/models.js")
+	assert.Equal(t, "#/components/schemas/Pet", user.Properties["pets"].Items.Ref)
+
+	pet, ok := byName["Pet"]
+	require.True(t, ok)
+	assert.Equal(t, "Pet name", pet.Properties["name"].Description)
+	assert.Equal(t, "#/components/schemas/User", pet.Properties["owner"].Ref)
+}
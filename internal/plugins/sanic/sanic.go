@@ -0,0 +1,653 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package sanic provides a plugin for extracting routes from Sanic applications.
+package sanic
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps Sanic method names to their uppercase forms.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// Plugin implements the FrameworkPlugin interface for Sanic.
+type Plugin struct {
+	pyParser *parser.PythonParser
+}
+
+// New creates a new Sanic plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		pyParser: parser.NewPythonParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "sanic"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".py"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "sanic",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Sanic applications",
+		SupportedFrameworks: []string{
+			"sanic",
+		},
+	}
+}
+
+// dependencyFiles are the files that declare Python dependencies.
+var dependencyFiles = []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile", "poetry.lock"}
+
+// Detect checks if Sanic is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range dependencyFiles {
+		if ok, _ := p.checkFileForDependency(filepath.Join(projectRoot, name), "sanic"); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanner.Scan() {
+		line := strings.ToLower(scanner.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// blueprint is a Blueprint or blueprint group and the group it belongs to.
+type blueprint struct {
+	prefix string
+	group  string
+}
+
+// project holds the blueprints and class-based views of all files, since
+// blueprints are usually grouped and registered in another module.
+type project struct {
+	blueprints map[string]*blueprint
+	views      map[string][]string
+}
+
+// ExtractRoutes parses source files and extracts Sanic route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	proj := p.collectProject(ctx, files)
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, proj)
+		if err != nil {
+			// Log error but continue with other files
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// collectProject indexes Blueprint definitions, Blueprint.group calls,
+// app.blueprint(bp, url_prefix=...) overrides, and HTTPMethodView classes.
+func (p *Plugin) collectProject(ctx context.Context, files []scanner.SourceFile) *project {
+	proj := &project{
+		blueprints: make(map[string]*blueprint),
+		views:      make(map[string][]string),
+	}
+	bp := func(name string) *blueprint {
+		if proj.blueprints[name] == nil {
+			proj.blueprints[name] = &blueprint{}
+		}
+		return proj.blueprints[name]
+	}
+
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, cls := range pf.Classes {
+			if !isMethodView(cls) {
+				continue
+			}
+			var methods []string
+			for _, method := range cls.Methods {
+				if _, ok := httpMethods[method.Name]; ok {
+					methods = append(methods, method.Name)
+				}
+			}
+			proj.views[cls.Name] = methods
+		}
+
+		for _, call := range p.pyParser.FindCallExpressions(pf.RootNode, file.Content) {
+			callee := p.pyParser.GetCalleeText(call, file.Content)
+			args, kwargs := callArguments(call, file.Content)
+			prefix := ""
+			if value := kwargs["url_prefix"]; value != nil {
+				prefix, _ = p.pyParser.ExtractStringLiteral(value, file.Content)
+			}
+
+			switch {
+			case callee == "Blueprint" || strings.HasSuffix(callee, ".Blueprint"):
+				if name := assignedName(call, file.Content); name != "" {
+					bp(name).prefix = prefix
+				}
+			case strings.HasSuffix(callee, "Blueprint.group"):
+				group := assignedName(call, file.Content)
+				if group == "" {
+					group = fmt.Sprintf("%s:%d", file.Path, call.StartByte())
+				}
+				bp(group).prefix = prefix
+				for _, arg := range args {
+					if arg.Type() == "identifier" {
+						bp(arg.Content(file.Content)).group = group
+					}
+				}
+			case strings.HasSuffix(callee, ".blueprint") && len(args) > 0 && kwargs["url_prefix"] != nil:
+				bp(args[0].Content(file.Content)).prefix = prefix
+			}
+		}
+
+		pf.Close()
+	}
+
+	return proj
+}
+
+// isMethodView reports whether a class derives from HTTPMethodView.
+func isMethodView(cls parser.PythonClass) bool {
+	for _, base := range cls.Bases {
+		if lastSegment(base) == "HTTPMethodView" {
+			return true
+		}
+	}
+	return false
+}
+
+// assignedName returns the variable a call is assigned to, if any.
+func assignedName(call *sitter.Node, content []byte) string {
+	parent := call.Parent()
+	if parent == nil || parent.Type() != "assignment" {
+		return ""
+	}
+	if left := parent.ChildByFieldName("left"); left != nil && left.Type() == "identifier" {
+		return left.Content(content)
+	}
+	return ""
+}
+
+// prefix returns the full url_prefix of a blueprint through its groups.
+func (proj *project) prefix(name string, depth int) string {
+	bp, ok := proj.blueprints[name]
+	if !ok || depth > 8 {
+		return ""
+	}
+	return combinePaths(proj.prefix(bp.group, depth+1), bp.prefix)
+}
+
+// extractRoutesFromFile extracts routes from a single Python file.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, proj *project) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	imported := p.hasSanicImport(pf)
+
+	var routes []types.Route
+
+	// @app.route("/path", methods=[...]), @bp.get("/path")
+	for _, fn := range pf.DecoratedFunctions {
+		for _, dec := range fn.Decorators {
+			object, method, ok := strings.Cut(dec.Name, ".")
+			if !ok {
+				continue
+			}
+			if _, isBlueprint := proj.blueprints[object]; !imported && !isBlueprint {
+				continue
+			}
+
+			uri, ok := dec.KeywordArguments["uri"]
+			path := strings.Trim(uri, `"'`)
+			if len(dec.Arguments) > 0 {
+				path, ok = dec.Arguments[0], true
+			}
+			if !ok {
+				continue
+			}
+
+			var methods []string
+			if method == "route" {
+				methods = []string{"GET"}
+				if list, ok := dec.KeywordArguments["methods"]; ok {
+					methods = parseMethodsList(list)
+				}
+			} else if upper, ok := httpMethods[method]; ok {
+				methods = []string{upper}
+			}
+
+			fullPath := combinePaths(proj.prefix(object, 0), path)
+			for _, m := range methods {
+				routes = append(routes, newRoute(m, fullPath, fn.Name, fn.Line))
+			}
+		}
+	}
+
+	// app.add_route(handler, "/path", methods=[...]) and View.as_view()
+	if imported {
+		for _, call := range p.pyParser.FindCallExpressions(pf.RootNode, file.Content) {
+			callee := p.pyParser.GetCalleeText(call, file.Content)
+			object, ok := strings.CutSuffix(callee, ".add_route")
+			if !ok {
+				continue
+			}
+			routes = append(routes, p.addRouteRoutes(call, file.Content, proj.prefix(object, 0), proj)...)
+		}
+	}
+
+	for i := range routes {
+		routes[i].SourceFile = file.Path
+	}
+
+	return routes, nil
+}
+
+// addRouteRoutes builds the routes of an add_route call.
+func (p *Plugin) addRouteRoutes(call *sitter.Node, content []byte, prefix string, proj *project) []types.Route {
+	args, kwargs := callArguments(call, content)
+	if len(args) == 0 {
+		return nil
+	}
+	uri := kwargs["uri"]
+	if len(args) > 1 {
+		uri = args[1]
+	}
+	if uri == nil {
+		return nil
+	}
+	path, ok := p.pyParser.ExtractStringLiteral(uri, content)
+	if !ok {
+		return nil
+	}
+
+	fullPath := combinePaths(prefix, path)
+	line := int(call.StartPoint().Row) + 1
+	handler := args[0].Content(content)
+
+	var routes []types.Route
+	if view, ok := strings.CutSuffix(handler, ".as_view()"); ok {
+		view = lastSegment(view)
+		for _, m := range proj.views[view] {
+			routes = append(routes, newRoute(httpMethods[m], fullPath, view+"."+m, line))
+		}
+		return routes
+	}
+
+	methods := []string{"GET"}
+	if list := kwargs["methods"]; list != nil {
+		methods = parseMethodsList(list.Content(content))
+	}
+	for _, m := range methods {
+		routes = append(routes, newRoute(m, fullPath, lastSegment(handler), line))
+	}
+	return routes
+}
+
+// hasSanicImport checks if the file imports Sanic.
+func (p *Plugin) hasSanicImport(pf *parser.ParsedPythonFile) bool {
+	for _, imp := range pf.Imports {
+		if strings.HasPrefix(strings.ToLower(imp.Module), "sanic") {
+			return true
+		}
+	}
+	return false
+}
+
+// newRoute builds a route for a Sanic path, typing path parameters by their
+// type or regex.
+func newRoute(method, path, handler string, line int) types.Route {
+	params, wildcard := extractPathParams(path)
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, strings.ReplaceAll(handler, ".", "_")),
+		Tags:        inferTags(fullPath),
+		Parameters:  params,
+		SourceLine:  line,
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	return route
+}
+
+// callArguments splits the arguments of a call into positional arguments
+// and keyword arguments by name.
+func callArguments(call *sitter.Node, content []byte) ([]*sitter.Node, map[string]*sitter.Node) {
+	var args []*sitter.Node
+	kwargs := make(map[string]*sitter.Node)
+
+	list := call.ChildByFieldName("arguments")
+	if list == nil {
+		return nil, kwargs
+	}
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		arg := list.NamedChild(i)
+		switch arg.Type() {
+		case "keyword_argument":
+			name, value := arg.ChildByFieldName("name"), arg.ChildByFieldName("value")
+			if name != nil && value != nil {
+				kwargs[name.Content(content)] = value
+			}
+		case "comment":
+			continue
+		default:
+			args = append(args, arg)
+		}
+	}
+	return args, kwargs
+}
+
+// lastSegment returns the last segment of a dotted name such as views.UserView.
+func lastSegment(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// ExtractSchemas extracts schema definitions from Pydantic models and
+// dataclasses.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, model := range pf.PydanticModels {
+			schema := p.pydanticModelToSchema(model)
+			if schema != nil {
+				schemas = append(schemas, *schema)
+			}
+		}
+
+		pf.Close()
+	}
+
+	return schemas, nil
+}
+
+// pydanticModelToSchema converts a Pydantic model to an OpenAPI schema.
+func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema {
+	schema := &types.Schema{
+		Title:      model.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+		Source:     model.Source(),
+	}
+
+	for _, field := range model.Fields {
+		// Convert Python type to OpenAPI type
+		propSchema := parser.PythonTypeSchema(field.Type)
+
+		// Handle array types
+		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
+			propSchema.Type = "array"
+			innerType := extractGenericType(field.Type)
+			propSchema.Items = parser.PythonTypeSchema(innerType)
+		}
+
+		// Emit constant defaults typed for the property
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
+		}
+
+		if field.Description != "" {
+			propSchema.Description = field.Description
+		}
+
+		schema.Properties[field.Name] = propSchema
+
+		if !field.IsOptional && !parser.PythonHasDefault(field.Default) {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+// --- Helper Functions ---
+
+// sanicParamRegex matches Sanic path parameters like <id>, <id:int> or
+// <id:\d+>.
+var sanicParamRegex = regexp.MustCompile(`<([a-zA-Z_][a-zA-Z0-9_]*)(?::([^>]+))?>`)
+
+// numericRegexes are the parameter regexes that only match integers.
+var numericRegexes = map[string]bool{`\d+`: true, `[0-9]+`: true}
+
+// convertPathParams converts Sanic path parameters to OpenAPI format.
+func convertPathParams(path string) string {
+	return sanicParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters typed by their Sanic type and
+// reports whether one is a path parameter matching several segments.
+func extractPathParams(path string) ([]types.Parameter, bool) {
+	var params []types.Parameter
+	wildcard := false
+
+	for _, match := range sanicParamRegex.FindAllStringSubmatch(path, -1) {
+		schema := &types.Schema{Type: "string"}
+		switch {
+		case match[2] == "int" || numericRegexes[match[2]]:
+			schema.Type = "integer"
+		case match[2] == "float" || match[2] == "number":
+			schema.Type = "number"
+		case match[2] == "uuid":
+			schema.Format = "uuid"
+		case match[2] == "ymd":
+			schema.Format = "date"
+		case match[2] == "path":
+			wildcard = true
+		}
+
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	return params, wildcard
+}
+
+// combinePaths combines a prefix and path, handling slashes correctly.
+func combinePaths(prefix, path string) string {
+	if prefix == "" {
+		if path == "" {
+			return "/"
+		}
+		return path
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if path == "" || path == "/" {
+		return prefix
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return prefix + path
+}
+
+// braceParamRegex matches OpenAPI-style path parameters like {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	// Generate from path
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// methodRegex matches quoted HTTP method names in a methods list.
+var methodRegex = regexp.MustCompile(`['"]([A-Za-z]+)['"]`)
+
+// parseMethodsList parses a methods list such as ["GET", "POST"].
+func parseMethodsList(s string) []string {
+	var methods []string
+	for _, match := range methodRegex.FindAllStringSubmatch(s, -1) {
+		methods = append(methods, strings.ToUpper(match[1]))
+	}
+	return methods
+}
+
+// extractGenericType extracts the inner type from a generic like List[str].
+func extractGenericType(s string) string {
+	start := strings.Index(s, "[")
+	end := strings.LastIndex(s, "]")
+	if start == -1 || end == -1 || end <= start {
+		return ""
+	}
+	return strings.TrimSpace(s[start+1 : end])
+}
+
+// Register registers the Sanic plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package sanic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// sanicAppCode is a test fixture covering app routes, class-based views,
+// and blueprint groups.
+const sanicAppCode = `
+from sanic import Blueprint, Sanic
+from sanic.views import HTTPMethodView
+
+from .users import users_bp
+
+app = Sanic("api")
+
+
+@app.route("/health", methods=["GET", "HEAD"])
+async def health(request):
+    ...
+
+
+@app.get("/files/<rest:path>")
+async def serve_file(request, rest):
+    ...
+
+
+class OrderView(HTTPMethodView):
+    async def get(self, request, order_id):
+        ...
+
+    async def put(self, request, order_id):
+        ...
+
+
+async def search(request):
+    ...
+
+
+app.add_route(OrderView.as_view(), "/orders/<order_id:uuid>")
+app.add_route(search, "/search", methods=["POST"])
+
+api = Blueprint.group(users_bp, url_prefix="/api/v1")
+app.blueprint(api)
+`
+
+// sanicUsersCode defines a blueprint in its own module.
+const sanicUsersCode = `
+from sanic import Blueprint
+
+users_bp = Blueprint("users", url_prefix="/users")
+
+
+@users_bp.get("/")
+async def list_users(request):
+    ...
+
+
+@users_bp.route("/<user_id:int>", methods=["PUT", "DELETE"])
+async def user(request, user_id):
+    ...
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "sanic", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte("sanic==23.12.0\n"), 0644)
+	require.NoError(t, err)
+
+	detected, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+
+	detected, err = New().Detect(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(sanicAppCode)},
+		{Path: "users.py", Language: "python", Content: []byte(sanicUsersCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	health := findRoute(routes, "GET", "/health")
+	require.NotNil(t, health)
+	assert.Equal(t, "health", health.Handler)
+	assert.Equal(t, "app.py", health.SourceFile)
+	require.NotNil(t, findRoute(routes, "HEAD", "/health"))
+
+	serveFile := findRoute(routes, "GET", "/files/{rest}")
+	require.NotNil(t, serveFile)
+	assert.Equal(t, true, serveFile.Extensions["x-wildcard"])
+
+	// Class-based views contribute their method handlers
+	getOrder := findRoute(routes, "GET", "/orders/{order_id}")
+	require.NotNil(t, getOrder)
+	assert.Equal(t, "OrderView.get", getOrder.Handler)
+	assert.Equal(t, "uuid", getOrder.Parameters[0].Schema.Format)
+	require.NotNil(t, findRoute(routes, "PUT", "/orders/{order_id}"))
+	require.NotNil(t, findRoute(routes, "POST", "/search"))
+
+	// Blueprint routes take the blueprint and group prefixes
+	listUsers := findRoute(routes, "GET", "/api/v1/users")
+	require.NotNil(t, listUsers)
+	assert.Equal(t, "users.py", listUsers.SourceFile)
+	assert.Equal(t, []string{"users"}, listUsers.Tags)
+
+	updateUser := findRoute(routes, "PUT", "/api/v1/users/{user_id}")
+	require.NotNil(t, updateUser)
+	assert.Equal(t, "integer", updateUser.Parameters[0].Schema.Type)
+	require.NotNil(t, findRoute(routes, "DELETE", "/api/v1/users/{user_id}"))
+
+	assert.Len(t, routes, 9)
+}
+
+func TestConvertPathParams(t *testing.T) {
+	assert.Equal(t, "/users/{id}/{name}", convertPathParams("/users/<id:int>/<name>"))
+	assert.Equal(t, "/codes/{code}", convertPathParams(`/codes/<code:\d+>`))
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// scopeStatementWindow is the most lines a route definition or route group
+// opening is searched for scope checks.
+const scopeStatementWindow = 20
+
+var (
+	// scopeMiddleware matches middleware checking OAuth scopes, such as
+	// requireScope('orders:write'), requiredScopes('read write'),
+	// scopeIncludesAny('a b') or jwtAuthz(['admin']): group 1 is the
+	// function and group 2 its arguments
+	scopeMiddleware = regexp.MustCompile(`(?i)\b(require[ds]?_?scopes?|scopes?_?required|check_?scopes?|has_?scopes?|scope_?includes_?(?:any|all)|jwt_?authz)\s*\(([^()]*)\)`)
+
+	// scopesDecorator matches a NestJS @Scopes('orders:read') decorator
+	scopesDecorator = regexp.MustCompile(`@Scopes\s*\(([^()]*)\)`)
+
+	// tokenAbilities matches Laravel Passport scope and Sanctum ability
+	// middleware: scopes:a,b and abilities:a,b require every scope,
+	// scope:a,b and ability:a,b any one of them
+	tokenAbilities = regexp.MustCompile(`['"](scopes?|abilit(?:y|ies)):([^'"]+)['"]`)
+
+	// checkAllScopes matches the express-jwt-authz option requiring every
+	// scope instead of any one
+	checkAllScopes = regexp.MustCompile(`(?i)checkAllScopes\s*:\s*true`)
+
+	// quotedScopes matches a quoted string of space-separated scopes
+	quotedScopes = regexp.MustCompile(`['"]([^'"]*)['"]`)
+
+	// scopeRegistration matches a line registering middleware for the
+	// routes of a file, or those under the path prefix it captures
+	scopeRegistration = regexp.MustCompile(`\buse\w*\s*\(\s*(?:['"](/[^'"]*)['"])?`)
+
+	// routeGroupOpening matches the call opening a route group, such as
+	// Route::middleware(['auth:api', 'scopes:admin'])->group(function () {
+	routeGroupOpening = regexp.MustCompile(`\bgroup\s*\(`)
+
+	// classDeclaration matches the line declaring a class
+	classDeclaration = regexp.MustCompile(`^\s*(?:export\s+)?(?:default\s+)?(?:abstract\s+)?class\s+\w`)
+)
+
+// scopeCheck is a check of the scopes granted to a request's token: every
+// scope is required, or any one of them.
+type scopeCheck struct {
+	scopes []string
+	all    bool
+}
+
+// MarkScopes sets Route.Scopes from the OAuth scope checks covering each
+// route: scope middleware on its definition (requireScope('orders:write'),
+// Laravel scopes:/abilities: middleware), NestJS @Scopes() decorators on
+// its handler or controller, the route groups it is defined in, and scope
+// middleware registered for its file or a path prefix it lies under.
+func MarkScopes(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+	}
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+
+	// Middleware registrations, by file ("" for every route) or path prefix
+	registered := make(map[string][]scopeCheck)
+	mounted := make(map[string][]scopeCheck)
+	for _, f := range files {
+		lines := sources[f.Path]
+		definitions := make([]bool, len(lines))
+		for _, line := range routeLines[f.Path] {
+			if line > 0 && line <= len(lines) {
+				for n := line - 1; n < statementEnd(lines, line-1, routeLines[f.Path]); n++ {
+					definitions[n] = true
+				}
+			}
+		}
+		scope := f.Path
+		if len(routeLines[f.Path]) == 0 {
+			scope = ""
+		}
+		for n, line := range lines {
+			m := scopeRegistration.FindStringSubmatch(line)
+			if m == nil || definitions[n] {
+				continue
+			}
+			checks := lineScopes(line)
+			if m[1] != "" {
+				mounted[m[1]] = append(mounted[m[1]], checks...)
+			} else {
+				registered[scope] = append(registered[scope], checks...)
+			}
+		}
+	}
+
+	for i := range routes {
+		route := &routes[i]
+
+		var checks []scopeCheck
+		checks = append(checks, registered[""]...)
+		checks = append(checks, registered[route.SourceFile]...)
+		for prefix, mountChecks := range mounted {
+			if hasPathPrefix(route.Path, prefix) {
+				checks = append(checks, mountChecks...)
+			}
+		}
+		if lines, start := sources[route.SourceFile], route.SourceLine-1; start >= 0 && start < len(lines) {
+			checks = append(checks, groupScopes(lines, start)...)
+			if class := enclosingClass(lines, start); class >= 0 {
+				checks = append(checks, blockScopes(lines[decoratorStart(lines, class):class])...)
+			}
+			checks = append(checks, blockScopes(lines[decoratorStart(lines, start):start])...)
+			checks = append(checks, blockScopes(lines[start:statementEnd(lines, start, routeLines[route.SourceFile])])...)
+		}
+
+		for _, check := range checks {
+			requireScopes(route, check)
+		}
+		slices.SortFunc(route.Scopes, func(a, b []string) int {
+			return strings.Compare(strings.Join(a, " "), strings.Join(b, " "))
+		})
+	}
+}
+
+// statementEnd returns the end of the statement starting at line start,
+// with its bracketed arguments and chained calls, stopping at the next
+// route definition and after scopeStatementWindow lines.
+func statementEnd(lines []string, start int, routeLines []int) int {
+	limit := min(len(lines), start+scopeStatementWindow)
+	for _, line := range routeLines {
+		if line-1 > start && line-1 < limit {
+			limit = line - 1
+		}
+	}
+	depth := 0
+	for n := start; n < limit; n++ {
+		if n > start && depth <= 0 && !isChained(lines[n]) {
+			return n
+		}
+		depth += bracketDepth(lines[n])
+	}
+	return limit
+}
+
+// groupScopes returns the scope checks of the route groups the line at
+// index n is defined in, from the statement opening each group.
+func groupScopes(lines []string, n int) []scopeCheck {
+	var checks []scopeCheck
+	for g := 0; g < n; g++ {
+		if !routeGroupOpening.MatchString(lines[g]) {
+			continue
+		}
+		depth, end := 0, len(lines)
+		for m := g; m < len(lines); m++ {
+			depth += strings.Count(lines[m], "{") - strings.Count(lines[m], "}")
+			if depth <= 0 && m > g {
+				end = m
+				break
+			}
+		}
+		if n > end {
+			continue
+		}
+		start := g
+		for start > 0 && isChained(lines[start]) {
+			start--
+		}
+		checks = append(checks, blockScopes(lines[start:g+1])...)
+	}
+	return checks
+}
+
+// enclosingClass returns the index of the last class declaration before
+// the line at index n, or -1.
+func enclosingClass(lines []string, n int) int {
+	for m := n - 1; m >= 0; m-- {
+		if classDeclaration.MatchString(lines[m]) {
+			return m
+		}
+	}
+	return -1
+}
+
+// decoratorStart returns the index of the first line of the decorators
+// above the line at index n, which is n when there are none.
+func decoratorStart(lines []string, n int) int {
+	depth := 0
+	for m := n - 1; m >= 0; m-- {
+		depth -= bracketDepth(lines[m])
+		if depth == 0 && strings.HasPrefix(strings.TrimSpace(lines[m]), "@") {
+			n = m
+		} else if depth <= 0 {
+			break
+		}
+	}
+	return n
+}
+
+// bracketDepth returns the number of brackets a line opens, less those it
+// closes.
+func bracketDepth(line string) int {
+	return strings.Count(line, "(") + strings.Count(line, "[") + strings.Count(line, "{") -
+		strings.Count(line, ")") - strings.Count(line, "]") - strings.Count(line, "}")
+}
+
+// isChained reports whether a line continues a call chain, as in
+// ->middleware('scopes:orders:write') or .use(requireScope('admin')).
+func isChained(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "->") || strings.HasPrefix(line, ".")
+}
+
+// blockScopes returns the scope checks on lines.
+func blockScopes(lines []string) []scopeCheck {
+	var checks []scopeCheck
+	for _, line := range lines {
+		checks = append(checks, lineScopes(line)...)
+	}
+	return checks
+}
+
+// lineScopes returns the scope checks on a line.
+func lineScopes(line string) []scopeCheck {
+	var checks []scopeCheck
+	for _, m := range scopeMiddleware.FindAllStringSubmatch(line, -1) {
+		name := strings.ToLower(m[1])
+		all := !strings.Contains(name, "any")
+		if strings.HasPrefix(name, "jwt") {
+			// express-jwt-authz accepts any scope unless told otherwise
+			all = checkAllScopes.MatchString(m[2])
+		}
+		checks = appendScopeCheck(checks, quotedList(m[2]), all)
+	}
+	for _, m := range scopesDecorator.FindAllStringSubmatch(line, -1) {
+		checks = appendScopeCheck(checks, quotedList(m[1]), true)
+	}
+	for _, m := range tokenAbilities.FindAllStringSubmatch(line, -1) {
+		checks = appendScopeCheck(checks, strings.Split(m[2], ","), strings.HasSuffix(m[1], "s"))
+	}
+	return checks
+}
+
+// quotedList returns the space-separated scopes of the quoted strings in
+// args.
+func quotedList(args string) []string {
+	var scopes []string
+	for _, m := range quotedScopes.FindAllStringSubmatch(args, -1) {
+		scopes = append(scopes, strings.Fields(m[1])...)
+	}
+	return scopes
+}
+
+// appendScopeCheck appends a check of scopes to checks unless it names
+// none.
+func appendScopeCheck(checks []scopeCheck, scopes []string, all bool) []scopeCheck {
+	var names []string
+	for _, scope := range scopes {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			names = append(names, scope)
+		}
+	}
+	if len(names) == 0 {
+		return checks
+	}
+	return append(checks, scopeCheck{scopes: names, all: all})
+}
+
+// requireScopes narrows the scope sets a route accepts to the tokens that
+// also pass check.
+func requireScopes(route *types.Route, check scopeCheck) {
+	sets := route.Scopes
+	if len(sets) == 0 {
+		sets = [][]string{nil}
+	}
+
+	var narrowed [][]string
+	seen := make(map[string]bool)
+	add := func(set []string) {
+		slices.Sort(set)
+		set = slices.Compact(set)
+		if key := strings.Join(set, " "); !seen[key] {
+			seen[key] = true
+			narrowed = append(narrowed, set)
+		}
+	}
+	for _, set := range sets {
+		if check.all {
+			add(append(slices.Clone(set), check.scopes...))
+			continue
+		}
+		for _, scope := range check.scopes {
+			add(append(slices.Clone(set), scope))
+		}
+	}
+	route.Scopes = narrowed
+}
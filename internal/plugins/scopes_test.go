@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkScopes_Middleware(t *testing.T) {
+	code := `const { requiredScopes, scopeIncludesAny } = require('express-oauth2-jwt-bearer');
+
+router.use(requiredScopes('api'))
+router.get('/orders', listOrders)
+router.post('/orders', requireScope('orders:write'), createOrder)
+router.delete(
+  '/orders/:id',
+  scopeIncludesAny('orders:admin admin'),
+  deleteOrder,
+)
+router.get('/reports', jwtAuthz(['reports:read', 'reports:export'], { checkAllScopes: true }), reports)
+`
+	app := `app.use('/admin', requireScope('admin'), adminRouter)
+`
+	files := []scanner.SourceFile{
+		{Path: "orders.js", Content: []byte(code)},
+		{Path: "app.js", Content: []byte(app)},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "orders.js", SourceLine: 4},
+		{Method: "POST", Path: "/orders", SourceFile: "orders.js", SourceLine: 5},
+		{Method: "DELETE", Path: "/orders/{id}", SourceFile: "orders.js", SourceLine: 6},
+		{Method: "GET", Path: "/reports", SourceFile: "orders.js", SourceLine: 11},
+		{Method: "GET", Path: "/admin/users", SourceFile: "admin.js", SourceLine: 1},
+		{Method: "GET", Path: "/administrators", SourceFile: "admin.js", SourceLine: 2},
+	}
+
+	MarkScopes(routes, files)
+
+	assert.Equal(t, [][]string{{"api"}}, routes[0].Scopes)
+	assert.Equal(t, [][]string{{"api", "orders:write"}}, routes[1].Scopes)
+	assert.Equal(t, [][]string{{"admin", "api"}, {"api", "orders:admin"}}, routes[2].Scopes, "any one scope is an alternative")
+	assert.Equal(t, [][]string{{"api", "reports:export", "reports:read"}}, routes[3].Scopes)
+	assert.Equal(t, [][]string{{"admin"}}, routes[4].Scopes, "middleware mounted at a prefix covers the routes under it")
+	assert.Nil(t, routes[5].Scopes)
+}
+
+func TestMarkScopes_NestJSDecorators(t *testing.T) {
+	code := `@Controller('orders')
+@Scopes('orders')
+export class OrdersController {
+  @Get()
+  findAll() {
+    return this.orders.findAll();
+  }
+
+  @Post()
+  @ApiResponse({
+    status: 201,
+  })
+  @Scopes('orders:write')
+  create(@Body() dto: CreateOrderDto) {
+    return this.orders.create(dto);
+  }
+}
+`
+	files := []scanner.SourceFile{{Path: "orders.controller.ts", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "orders.controller.ts", SourceLine: 5},
+		{Method: "POST", Path: "/orders", SourceFile: "orders.controller.ts", SourceLine: 14},
+	}
+
+	MarkScopes(routes, files)
+
+	assert.Equal(t, [][]string{{"orders"}}, routes[0].Scopes, "controller scopes cover its handlers")
+	assert.Equal(t, [][]string{{"orders", "orders:write"}}, routes[1].Scopes)
+}
+
+func TestMarkScopes_LaravelAbilities(t *testing.T) {
+	code := `<?php
+
+Route::get('/orders', [OrderController::class, 'index']);
+Route::post('/orders', [OrderController::class, 'store'])
+    ->middleware(['auth:sanctum', 'abilities:orders:write,orders:create']);
+
+Route::middleware(['auth:api', 'scope:admin,support'])
+    ->prefix('admin')
+    ->group(function () {
+        Route::get('/users', [UserController::class, 'index']);
+    });
+
+Route::get('/status', [StatusController::class, 'show']);
+`
+	files := []scanner.SourceFile{{Path: "routes/api.php", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: "routes/api.php", SourceLine: 3},
+		{Method: "POST", Path: "/orders", SourceFile: "routes/api.php", SourceLine: 4},
+		{Method: "GET", Path: "/admin/users", SourceFile: "routes/api.php", SourceLine: 10},
+		{Method: "GET", Path: "/status", SourceFile: "routes/api.php", SourceLine: 13},
+	}
+
+	MarkScopes(routes, files)
+
+	assert.Nil(t, routes[0].Scopes)
+	assert.Equal(t, [][]string{{"orders:create", "orders:write"}}, routes[1].Scopes)
+	assert.Equal(t, [][]string{{"admin"}, {"support"}}, routes[2].Scopes, "group middleware covers its routes")
+	assert.Nil(t, routes[3].Scopes)
+}
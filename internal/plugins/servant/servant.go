@@ -18,6 +18,7 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -111,7 +112,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 			continue
 		}
 
-		fileRoutes := p.extractServantEndpoints(string(file.Content), file.Path)
+		fileRoutes := p.extractServantEndpoints(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, fileRoutes...)
 	}
 
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// composeFiles are the Docker Compose file names looked for at the project root.
+var composeFiles = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
+
+var (
+	// listenRegexes match calls that serve a router on an address, capturing
+	// the router variable (recv) and the address or port (addr)
+	listenRegexes = []*regexp.Regexp{
+		// net/http: http.ListenAndServe(":8080", mux)
+		regexp.MustCompile(`http\.ListenAndServe(?:TLS)?\(\s*"(?P<addr>[^"]*)"\s*,\s*&?(?P<recv>\w+)`),
+		// http.Server{Addr: ":8080", Handler: mux}, in either order
+		regexp.MustCompile(`(?s)Addr:\s*"(?P<addr>[^"]*)"[^{}]*?Handler:\s*&?(?P<recv>\w+)`),
+		regexp.MustCompile(`(?s)Handler:\s*&?(?P<recv>\w+)[^{}]*?Addr:\s*"(?P<addr>[^"]*)"`),
+		// gin r.Run(":8080"), echo e.Start(":8080"), fiber app.Listen(":3000"), express app.listen(3000)
+		regexp.MustCompile("\\b(?P<recv>\\w+)\\.(?:Run|Start|Listen|listen)\\(\\s*[\"'`]?(?P<addr>[\\w.\\-]*:?\\d+)\\b"),
+		// uvicorn.run(app, port=8000), uvicorn.run("main:app", port=8000)
+		regexp.MustCompile(`uvicorn\.run\(\s*(?:["'][\w.]*:)?(?P<recv>\w+)[^)]*?\bport\s*=\s*(?P<addr>\d+)`),
+		// flask app.run(port=5000)
+		regexp.MustCompile(`\b(?P<recv>\w+)\.run\([^)]*?\bport\s*=\s*(?P<addr>\d+)`),
+	}
+
+	// listenAddrRegex matches a port or host:port address
+	listenAddrRegex = regexp.MustCompile(`^(?:([\w.\-]*):)?(\d+)$`)
+
+	// routeReceiverRegex matches the router variable a route is registered on
+	routeReceiverRegex = regexp.MustCompile(`^\s*@?(\w+)\s*\.\s*\w+`)
+)
+
+// AssignServers sets Route.Servers when the routes are served from more than
+// one address. A route whose source file lies in a Docker Compose service's
+// build context gets the service's published port; otherwise, a route
+// registered on a router that its file passes to a listen call gets that
+// call's address. Routes mounted from other files keep the global servers.
+func AssignServers(routes []types.Route, files []scanner.SourceFile, root string) {
+	services := composeServers(root)
+	listeners := make(map[string]map[string]types.Server)
+	sources := make(map[string][]string)
+	for _, f := range files {
+		content := string(f.Content)
+		if servers := listenServers(content); len(servers) > 0 {
+			listeners[f.Path] = servers
+			sources[f.Path] = strings.Split(content, "\n")
+		}
+	}
+
+	assigned := make([]*types.Server, len(routes))
+	distinct := make(map[string]bool)
+	for i, route := range routes {
+		server, ok := serviceServer(services, route.SourceFile)
+		if !ok {
+			server, ok = listenerServer(listeners[route.SourceFile], sources[route.SourceFile], route.SourceLine)
+		}
+		if ok {
+			assigned[i] = &server
+			distinct[server.URL] = true
+		}
+	}
+	if len(distinct) < 2 {
+		return
+	}
+
+	for i, server := range assigned {
+		if server != nil {
+			routes[i].Servers = []types.Server{*server}
+		}
+	}
+}
+
+// listenServers maps each router variable a file serves to its server.
+func listenServers(content string) map[string]types.Server {
+	servers := make(map[string]types.Server)
+	for _, re := range listenRegexes {
+		for _, match := range re.FindAllStringSubmatch(content, -1) {
+			recv := match[re.SubexpIndex("recv")]
+			if _, seen := servers[recv]; seen {
+				continue
+			}
+			if url, ok := addressURL(match[re.SubexpIndex("addr")]); ok {
+				servers[recv] = types.Server{URL: url}
+			}
+		}
+	}
+	return servers
+}
+
+// listenerServer returns the server of the router a route's definition line
+// registers on.
+func listenerServer(servers map[string]types.Server, lines []string, line int) (types.Server, bool) {
+	if len(servers) == 0 || line < 1 || line > len(lines) {
+		return types.Server{}, false
+	}
+	match := routeReceiverRegex.FindStringSubmatch(lines[line-1])
+	if match == nil {
+		return types.Server{}, false
+	}
+	server, ok := servers[match[1]]
+	return server, ok
+}
+
+// addressURL converts a listen address such as :8080, 0.0.0.0:8080 or 8080
+// to a server URL.
+func addressURL(addr string) (string, bool) {
+	match := listenAddrRegex.FindStringSubmatch(addr)
+	if match == nil {
+		return "", false
+	}
+	host := match[1]
+	if host == "" || host == "0.0.0.0" || host == "127.0.0.1" {
+		host = "localhost"
+	}
+	return "http://" + host + ":" + match[2], true
+}
+
+// composeService is the part of a Docker Compose service that places it.
+type composeService struct {
+	Build yaml.Node   `yaml:"build"`
+	Ports []yaml.Node `yaml:"ports"`
+}
+
+// serviceContext is a Compose service's build context and published server.
+type serviceContext struct {
+	dir    string
+	server types.Server
+}
+
+// composeServers returns the services in the project's Compose file that are
+// built from a directory and publish a port, most specific directory first.
+func composeServers(root string) []serviceContext {
+	var compose struct {
+		Services map[string]composeService `yaml:"services"`
+	}
+	for _, name := range composeFiles {
+		data, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		if yaml.Unmarshal(data, &compose) == nil {
+			break
+		}
+	}
+
+	var services []serviceContext
+	for name, service := range compose.Services {
+		context := service.Build.Value
+		if service.Build.Kind == yaml.MappingNode {
+			var build struct {
+				Context string `yaml:"context"`
+			}
+			if service.Build.Decode(&build) != nil {
+				continue
+			}
+			context = build.Context
+		}
+		if context == "" {
+			continue
+		}
+		if !filepath.IsAbs(context) {
+			context = filepath.Join(root, context)
+		}
+		for _, port := range service.Ports {
+			if url, ok := publishedURL(port); ok {
+				services = append(services, serviceContext{
+					dir:    context,
+					server: types.Server{URL: url, Description: name + " service"},
+				})
+				break
+			}
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		if len(services[i].dir) != len(services[j].dir) {
+			return len(services[i].dir) > len(services[j].dir)
+		}
+		return services[i].server.URL < services[j].server.URL
+	})
+	return services
+}
+
+// publishedURL returns the host URL of a Compose port mapping: "8081:8080",
+// "127.0.0.1:8081:8080/tcp" or {published: 8081, target: 8080}. A bare
+// container port is published on a random host port and has no URL.
+func publishedURL(port yaml.Node) (string, bool) {
+	if port.Kind == yaml.MappingNode {
+		var long struct {
+			Published string `yaml:"published"`
+			HostIP    string `yaml:"host_ip"`
+		}
+		if port.Decode(&long) != nil || long.Published == "" {
+			return "", false
+		}
+		if long.HostIP != "" {
+			return addressURL(long.HostIP + ":" + long.Published)
+		}
+		return addressURL(long.Published)
+	}
+
+	mapping, _, _ := strings.Cut(port.Value, "/")
+	idx := strings.LastIndex(mapping, ":")
+	if idx < 0 {
+		return "", false
+	}
+	return addressURL(mapping[:idx])
+}
+
+// serviceServer returns the server of the Compose service whose build
+// context contains file.
+func serviceServer(services []serviceContext, file string) (types.Server, bool) {
+	for _, service := range services {
+		if rel, err := filepath.Rel(service.dir, file); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return service.server, true
+		}
+	}
+	return types.Server{}, false
+}
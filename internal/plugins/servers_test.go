@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestAssignServers_ListenCalls(t *testing.T) {
+	code := `func main() {
+	api := chi.NewRouter()
+	api.Get("/users", listUsers)
+	admin := chi.NewRouter()
+	admin.Get("/stats", stats)
+	go http.ListenAndServe(":8080", api)
+	srv := &http.Server{
+		Addr:    "0.0.0.0:9090",
+		Handler: admin,
+	}
+	srv.ListenAndServe()
+}
+`
+	root := t.TempDir()
+	file := filepath.Join(root, "main.go")
+	files := []scanner.SourceFile{{Path: file, Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: file, SourceLine: 3},
+		{Method: "GET", Path: "/stats", SourceFile: file, SourceLine: 5},
+		{Method: "GET", Path: "/mounted", SourceFile: filepath.Join(root, "other.go"), SourceLine: 1},
+	}
+
+	AssignServers(routes, files, root)
+
+	assert.Equal(t, []types.Server{{URL: "http://localhost:8080"}}, routes[0].Servers)
+	assert.Equal(t, []types.Server{{URL: "http://localhost:9090"}}, routes[1].Servers)
+	assert.Nil(t, routes[2].Servers)
+}
+
+func TestAssignServers_SingleServer(t *testing.T) {
+	code := `const app = express()
+app.get('/users', listUsers)
+app.post('/users', createUser)
+app.listen(3000)
+`
+	root := t.TempDir()
+	file := filepath.Join(root, "app.js")
+	files := []scanner.SourceFile{{Path: file, Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: file, SourceLine: 2},
+		{Method: "POST", Path: "/users", SourceFile: file, SourceLine: 3},
+	}
+
+	AssignServers(routes, files, root)
+
+	// One address is the global server list's job
+	assert.Nil(t, routes[0].Servers)
+	assert.Nil(t, routes[1].Servers)
+}
+
+func TestAssignServers_Compose(t *testing.T) {
+	compose := `services:
+  orders:
+    build: ./services/orders
+    ports:
+      - "8081:8080"
+  billing:
+    build:
+      context: services/billing
+    ports:
+      - target: 8080
+        published: 8082
+  worker:
+    build: ./services/worker
+    ports:
+      - "8080"
+`
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "docker-compose.yml"), []byte(compose), 0o644))
+
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders", SourceFile: filepath.Join(root, "services/orders/app.py")},
+		{Method: "GET", Path: "/invoices", SourceFile: filepath.Join(root, "services/billing/src/routes.ts")},
+		{Method: "GET", Path: "/jobs", SourceFile: filepath.Join(root, "services/worker/main.go")},
+	}
+
+	AssignServers(routes, nil, root)
+
+	assert.Equal(t, []types.Server{{URL: "http://localhost:8081", Description: "orders service"}}, routes[0].Servers)
+	assert.Equal(t, []types.Server{{URL: "http://localhost:8082", Description: "billing service"}}, routes[1].Servers)
+	// A container port without a published host port has no address
+	assert.Nil(t, routes[2].Servers)
+}
+
+func TestAddressURL(t *testing.T) {
+	tests := []struct {
+		addr     string
+		expected string
+		ok       bool
+	}{
+		{":8080", "http://localhost:8080", true},
+		{"8080", "http://localhost:8080", true},
+		{"0.0.0.0:3000", "http://localhost:3000", true},
+		{"api.internal:9000", "http://api.internal:9000", true},
+		{"PORT", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			url, ok := addressURL(tt.addr)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, url)
+		})
+	}
+}
@@ -0,0 +1,587 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package shelf provides a plugin for extracting routes from Dart shelf_router applications.
+package shelf
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for shelf_router.
+type Plugin struct {
+	dartParser *parser.DartParser
+}
+
+// New creates a new shelf plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		dartParser: parser.NewDartParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "shelf"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".dart"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "shelf",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Dart shelf_router applications",
+		SupportedFrameworks: []string{
+			"shelf",
+			"shelf_router",
+		},
+	}
+}
+
+// Detect checks if shelf_router is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	pubspecPath := filepath.Join(projectRoot, "pubspec.yaml")
+	if found, _ := p.checkFileForDependency(pubspecPath, "shelf_router"); found {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// httpMethods maps Router methods and Route annotations to HTTP methods.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// Regex patterns for shelf_router extraction
+var (
+	// Matches router declarations: final app = Router();
+	routerDeclRegex = regexp.MustCompile(`(\w+)\s*=\s*Router\s*\(`)
+
+	// Matches instance declarations: final api = UserApi(repo);
+	instanceDeclRegex = regexp.MustCompile(`(\w+)\s*=\s*([A-Z]\w*)\s*\(`)
+
+	// Matches router calls: app.get('/users', handler) or ..get('/users', handler)
+	routeCallRegex = regexp.MustCompile(`(\w+)?\s*\.\.?\s*(get|post|put|delete|patch|head|options|mount)\s*\(\s*(['"])([^'"]*)['"]\s*,\s*`)
+
+	// Matches a mounted getter: @Route.mount('/users/') Router get _users => UsersApi().router;
+	mountGetterRegex = regexp.MustCompile(`@Route\.mount\(\s*['"]([^'"]*)['"]\s*\)\s*(?:Router|Handler)\s+get\s+\w+\s*=>\s*([A-Z]\w*)\s*\(`)
+
+	// Matches a class declaration
+	classDeclRegex = regexp.MustCompile(`\bclass\s+(\w+)`)
+
+	// Matches path parameters like <id> or <id|[0-9]+>
+	shelfParamRegex = regexp.MustCompile(`<(\w+)(?:\|[^>]*)?>`)
+
+	// Matches path parameter in brace format
+	braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+	// Matches a model built from the request body: User.fromJson(...)
+	fromJSONRegex = regexp.MustCompile(`\b([A-Z]\w*)\.fromJson\s*\(`)
+)
+
+// routeCall is a route or mount call found in a file.
+type routeCall struct {
+	file     *parser.ParsedDartFile
+	owner    string
+	receiver string
+	method   string
+	path     string
+	handler  string
+	line     int
+}
+
+// ExtractRoutes parses source files and extracts shelf_router route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var parsed []*parser.ParsedDartFile
+	for _, file := range files {
+		if file.Language != "dart" {
+			continue
+		}
+		parsed = append(parsed, p.dartParser.Parse(file.Path, file.Content))
+	}
+
+	// Instance variables and mounted classes resolve mount targets to the
+	// classes whose routes they serve
+	instances := make(map[string]string)
+	classPrefixes := make(map[string]string)
+	var calls []routeCall
+	for _, pf := range parsed {
+		for _, m := range instanceDeclRegex.FindAllStringSubmatch(pf.Content, -1) {
+			if m[2] != "Router" {
+				instances[m[1]] = m[2]
+			}
+		}
+		for _, m := range mountGetterRegex.FindAllStringSubmatchIndex(pf.Content, -1) {
+			calls = append(calls, routeCall{
+				file:    pf,
+				owner:   enclosingClass(pf.Content, m[0]),
+				method:  "mount",
+				path:    pf.Content[m[2]:m[3]],
+				handler: pf.Content[m[4]:m[5]] + "()",
+			})
+		}
+		calls = append(calls, findRouteCalls(pf)...)
+	}
+
+	// Router variables take the prefix they are mounted at, within the
+	// prefix of the class declaring them. Mounts may chain, so resolve
+	// until nothing changes.
+	varPrefixes := make(map[string]string)
+	for range 8 {
+		changed := false
+		for _, c := range calls {
+			if c.method != "mount" {
+				continue
+			}
+			prefix := joinPaths(prefixOf(c, classPrefixes, varPrefixes), c.path)
+			target, isClass := mountTarget(c.handler, instances)
+			if target == "" {
+				continue
+			}
+			prefixes := varPrefixes
+			if isClass {
+				prefixes = classPrefixes
+			}
+			if prefixes[target] != prefix {
+				prefixes[target] = prefix
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	var routes []types.Route
+	for _, c := range calls {
+		httpMethod, ok := httpMethods[c.method]
+		if !ok {
+			continue
+		}
+		path := joinPaths(prefixOf(c, classPrefixes, varPrefixes), c.path)
+		body := handlerBody(c.file, parsed, c.handler)
+		routes = append(routes, buildRoute(httpMethod, path, handlerName(c.handler), body, c.file.Path, c.line))
+	}
+
+	// Annotated handlers of shelf_router_generator classes
+	for _, pf := range parsed {
+		for _, class := range pf.Classes {
+			for _, method := range class.Methods {
+				for _, anno := range method.Annotations {
+					httpMethod, path := routeAnnotation(anno)
+					if httpMethod == "" {
+						continue
+					}
+					fullPath := joinPaths(classPrefixes[class.Name], path)
+					routes = append(routes, buildRoute(httpMethod, fullPath, method.Name, method.Body, pf.Path, method.Line))
+				}
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// findRouteCalls finds the route and mount calls on Router variables.
+func findRouteCalls(pf *parser.ParsedDartFile) []routeCall {
+	src := pf.Content
+	decls := routerDeclRegex.FindAllStringSubmatchIndex(src, -1)
+	routers := make(map[string]bool)
+	for _, d := range decls {
+		routers[src[d[2]:d[3]]] = true
+	}
+
+	var calls []routeCall
+	for _, m := range routeCallRegex.FindAllStringSubmatchIndex(src, -1) {
+		receiver := ""
+		if m[2] >= 0 {
+			receiver = src[m[2]:m[3]]
+		}
+		if strings.Contains(src[m[0]:m[5]], "..") && receiver == "" {
+			// A cascade applies to the most recently declared router
+			for _, d := range decls {
+				if d[0] < m[0] {
+					receiver = src[d[2]:d[3]]
+				}
+			}
+		}
+		if !routers[receiver] {
+			continue
+		}
+
+		calls = append(calls, routeCall{
+			file:     pf,
+			owner:    enclosingClass(src, m[0]),
+			receiver: receiver,
+			method:   src[m[4]:m[5]],
+			path:     src[m[8]:m[9]],
+			handler:  handlerArgument(src, m[1]),
+			line:     strings.Count(src[:m[0]], "\n") + 1,
+		})
+	}
+	return calls
+}
+
+// handlerArgument returns the source of the argument starting at pos,
+// up to the comma or parenthesis that ends it.
+func handlerArgument(src string, pos int) string {
+	depth := 0
+	var quote byte
+	for i := pos; i < len(src); i++ {
+		ch := src[i]
+		if quote != 0 {
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch ch {
+		case '\'', '"':
+			quote = ch
+		case '(', '{', '[':
+			depth++
+		case ')', '}', ']':
+			if depth == 0 {
+				return strings.TrimSpace(src[pos:i])
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				return strings.TrimSpace(src[pos:i])
+			}
+		}
+	}
+	return strings.TrimSpace(src[pos:])
+}
+
+// enclosingClass returns the name of the last class declared before pos.
+func enclosingClass(src string, pos int) string {
+	name := ""
+	for _, m := range classDeclRegex.FindAllStringSubmatchIndex(src[:pos], -1) {
+		name = src[m[2]:m[3]]
+	}
+	return name
+}
+
+// prefixOf returns the path prefix of the router a call is made on.
+func prefixOf(c routeCall, classPrefixes, varPrefixes map[string]string) string {
+	prefix := classPrefixes[c.owner]
+	if p, ok := varPrefixes[c.receiver]; ok {
+		prefix = p
+	}
+	return prefix
+}
+
+// mountTarget resolves the handler mounted by a mount call to a class, for
+// UsersApi().router or an instance's .router, or to a router variable.
+func mountTarget(handler string, instances map[string]string) (string, bool) {
+	handler = strings.TrimSuffix(handler, ".call")
+	name, _, _ := strings.Cut(handler, ".")
+	if strings.HasSuffix(name, "()") || strings.Contains(name, "(") {
+		return name[:strings.Index(name, "(")], true
+	}
+	if class, ok := instances[name]; ok {
+		return class, true
+	}
+	return name, false
+}
+
+// routeAnnotation returns the method and path of a @Route annotation.
+func routeAnnotation(anno parser.DartAnnotation) (string, string) {
+	args := splitArguments(anno.Arguments)
+	switch {
+	case strings.HasPrefix(anno.Name, "Route.") && len(args) > 0:
+		return httpMethods[strings.TrimPrefix(anno.Name, "Route.")], unquote(args[0])
+	case anno.Name == "Route" && len(args) > 1:
+		return httpMethods[strings.ToLower(unquote(args[0]))], unquote(args[1])
+	}
+	return "", ""
+}
+
+// splitArguments splits an argument list on commas.
+func splitArguments(args string) []string {
+	var parts []string
+	for _, part := range strings.Split(args, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// unquote strips the quotes around a string literal.
+func unquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), `'"`)
+}
+
+// handlerBody returns the body of an inline handler, or of the function or
+// method a handler argument names, preferring the calling file.
+func handlerBody(pf *parser.ParsedDartFile, parsed []*parser.ParsedDartFile, handler string) string {
+	if strings.HasPrefix(handler, "(") {
+		return handler
+	}
+	name := handlerName(handler)
+	if name == "" {
+		return ""
+	}
+
+	ordered := append([]*parser.ParsedDartFile{pf}, parsed...)
+	for _, f := range ordered {
+		for _, fn := range f.Functions {
+			if fn.Name == name {
+				return fn.Body
+			}
+		}
+		for _, class := range f.Classes {
+			for _, fn := range class.Methods {
+				if fn.Name == name {
+					return fn.Body
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// handlerName returns the function named by a handler argument, such as
+// getUser for _api.getUser; inline closures have no name.
+func handlerName(handler string) string {
+	if handler == "" || strings.ContainsAny(handler, "(){} ") {
+		return ""
+	}
+	if i := strings.LastIndex(handler, "."); i != -1 {
+		return handler[i+1:]
+	}
+	return handler
+}
+
+// buildRoute creates a route, inferring the request body of methods that
+// carry one from a Model.fromJson call in the handler.
+func buildRoute(method, path, handler, body, filePath string, line int) types.Route {
+	path = convertPathParams(path)
+	route := types.Route{
+		Method:      method,
+		Path:        path,
+		Handler:     strings.TrimPrefix(handler, "_"),
+		OperationID: generateOperationID(method, path, strings.TrimPrefix(handler, "_")),
+		Tags:        inferTags(path),
+		Parameters:  extractPathParams(path),
+		SourceFile:  filePath,
+		SourceLine:  line,
+	}
+
+	if method == "POST" || method == "PUT" || method == "PATCH" {
+		if m := fromJSONRegex.FindStringSubmatch(body); m != nil {
+			route.RequestBody = &types.RequestBody{
+				Required: true,
+				Content: map[string]types.MediaType{
+					"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/" + m[1]}},
+				},
+			}
+		}
+	}
+
+	return route
+}
+
+// joinPaths joins a prefix and a path into a normalized path.
+func joinPaths(prefix, path string) string {
+	joined := strings.Trim(prefix, "/") + "/" + strings.Trim(path, "/")
+	joined = "/" + strings.Trim(joined, "/")
+	return joined
+}
+
+// convertPathParams converts shelf_router <param> segments to {param}.
+func convertPathParams(path string) string {
+	return shelfParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.ReplaceAll(cleanPath, "/", " ")
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions from JSON-serializable Dart
+// classes: json_serializable and freezed models, and classes declaring
+// fromJson or toJson.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if file.Language != "dart" {
+			continue
+		}
+
+		pf := p.dartParser.Parse(file.Path, file.Content)
+		for _, class := range pf.Classes {
+			if !isModel(class) {
+				continue
+			}
+			schemas = append(schemas, *classToSchema(class))
+		}
+	}
+
+	return schemas, nil
+}
+
+// isModel reports whether a class is serialized to JSON.
+func isModel(class parser.DartClass) bool {
+	return class.HasJSONMethods ||
+		class.HasAnnotation("JsonSerializable") ||
+		class.HasAnnotation("freezed") ||
+		class.HasAnnotation("Freezed")
+}
+
+// classToSchema converts a Dart class to an OpenAPI schema.
+func classToSchema(class parser.DartClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, field := range class.Fields {
+		name := field.Name
+		if field.JSONKey != "" {
+			name = field.JSONKey
+		}
+
+		openAPIType, format := parser.DartTypeToOpenAPI(field.Type)
+		propSchema := &types.Schema{
+			Type:   openAPIType,
+			Format: format,
+		}
+		if field.IsOptional {
+			propSchema.Nullable = true
+		} else {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = propSchema
+	}
+
+	return schema
+}
+
+// Register registers the shelf plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
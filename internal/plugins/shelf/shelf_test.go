@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package shelf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const shelfServerCode = `
+import 'package:shelf/shelf.dart';
+import 'package:shelf_router/shelf_router.dart';
+
+import 'users_api.dart';
+
+void main() async {
+  final api = UsersApi();
+  final app = Router()
+    ..get('/health', (Request request) => Response.ok('ok'))
+    ..mount('/api/users', api.router);
+
+  final admin = Router();
+  admin.delete('/cache/<key|[a-z]+>', _clearCache);
+  app.mount('/admin', admin);
+
+  await serve(app, 'localhost', 8080);
+}
+
+Future<Response> _clearCache(Request request, String key) async {
+  return Response.ok('');
+}
+`
+
+const shelfUsersAPICode = `
+import 'dart:convert';
+
+import 'package:shelf/shelf.dart';
+import 'package:shelf_router/shelf_router.dart';
+
+part 'users_api.g.dart';
+
+class UsersApi {
+  @Route.get('/')
+  Future<Response> listUsers(Request request) async {
+    return Response.ok('[]');
+  }
+
+  @Route.get('/<id>')
+  Future<Response> getUser(Request request, String id) async {
+    return Response.ok('{}');
+  }
+
+  @Route.post('/')
+  Future<Response> createUser(Request request) async {
+    final user = User.fromJson(jsonDecode(await request.readAsString()));
+    return Response.ok(jsonEncode(user.toJson()));
+  }
+
+  @Route.mount('/<id>/posts')
+  Router get _posts => PostsApi().router;
+
+  Router get router => _$UsersApiRouter(this);
+}
+
+class PostsApi {
+  @Route('GET', '/')
+  Future<Response> listPosts(Request request, String id) async {
+    return Response.ok('[]');
+  }
+
+  Router get router => _$PostsApiRouter(this);
+}
+
+class User {
+  final int id;
+  final String name;
+  final String? email;
+
+  User({required this.id, required this.name, this.email});
+
+  factory User.fromJson(Map<String, dynamic> json) => User(
+        id: json['id'] as int,
+        name: json['name'] as String,
+        email: json['email'] as String?,
+      );
+
+  Map<String, dynamic> toJson() => {'id': id, 'name': name, 'email': email};
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "shelf", p.Name())
+	assert.Equal(t, []string{".dart"}, p.Extensions())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+
+	found, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	pubspec := "name: server\ndependencies:\n  shelf: ^1.4.0\n  shelf_router: ^1.1.4\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0o644))
+
+	found, err = New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, found)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "bin/server.dart", Language: "dart", Content: []byte(shelfServerCode)},
+		{Path: "lib/users_api.dart", Language: "dart", Content: []byte(shelfUsersAPICode)},
+	})
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	assert.Len(t, routes, 6)
+	for _, key := range []string{
+		"GET /health",
+		"DELETE /admin/cache/{key}",
+		"GET /api/users",
+		"GET /api/users/{id}",
+		"POST /api/users",
+		"GET /api/users/{id}/posts",
+	} {
+		assert.Contains(t, byKey, key)
+	}
+
+	clear := byKey["DELETE /admin/cache/{key}"]
+	assert.Equal(t, "clearCache", clear.Handler)
+	assert.Equal(t, "bin/server.dart", clear.SourceFile)
+	assert.Equal(t, 14, clear.SourceLine)
+	require.Len(t, clear.Parameters, 1)
+	assert.Equal(t, "key", clear.Parameters[0].Name)
+
+	create := byKey["POST /api/users"]
+	assert.Equal(t, "postCreateUser", create.OperationID)
+	assert.Equal(t, []string{"users"}, create.Tags)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/User", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	assert.Equal(t, "getHealth", byKey["GET /health"].OperationID)
+	assert.Nil(t, byKey["GET /api/users/{id}"].RequestBody)
+}
+
+func TestPlugin_ExtractSchemas(t *testing.T) {
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "lib/users_api.dart", Language: "dart", Content: []byte(shelfUsersAPICode)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, schemas, 1)
+	user := schemas[0]
+	assert.Equal(t, "User", user.Title)
+	assert.Equal(t, []string{"id", "name"}, user.Required)
+	assert.Equal(t, "integer", user.Properties["id"].Type)
+	assert.True(t, user.Properties["email"].Nullable)
+}
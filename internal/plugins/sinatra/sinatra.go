@@ -19,6 +19,7 @@ import (
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/plugins/ruby"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -146,6 +147,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 // isTestFile checks if a file path indicates a test/spec file.
 func (p *Plugin) isTestFile(path string) bool {
 	// Check for spec/ or test/ directory
+	path = filepath.ToSlash(path)
 	if strings.Contains(path, "/spec/") || strings.Contains(path, "/test/") {
 		return true
 	}
@@ -172,13 +174,14 @@ func (p *Plugin) convertRoute(route parser.RubyRoute, filePath string) *types.Ro
 	}
 
 	// Convert :param to {param} format
+	wildcard := plugins.IsCatchAll(fullPath)
 	fullPath = convertSinatraPathParams(fullPath)
 
 	params := extractPathParams(fullPath)
 	operationID := generateOperationID(route.Method, fullPath, "")
 	tags := inferTags(fullPath)
 
-	return &types.Route{
+	r := &types.Route{
 		Method:      route.Method,
 		Path:        fullPath,
 		OperationID: operationID,
@@ -187,6 +190,11 @@ func (p *Plugin) convertRoute(route parser.RubyRoute, filePath string) *types.Ro
 		SourceFile:  filePath,
 		SourceLine:  route.Line,
 	}
+	if wildcard {
+		plugins.MarkWildcard(r)
+	}
+
+	return r
 }
 
 // sinatraParamRegex matches Sinatra path parameters like :param.
@@ -321,7 +329,7 @@ func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, err
 			continue
 		}
 
-		content := string(file.Content)
+		content := util.NormalizeNewlines(string(file.Content))
 
 		// Extract schemas from constant definitions
 		p.extractConstantSchemas(content, schemas)
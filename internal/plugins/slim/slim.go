@@ -16,6 +16,7 @@ import (
 
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -83,19 +84,31 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 
 // Regex patterns for Slim route extraction
 var (
-	// Matches $app->get('/path', ...), $app->post('/path', ...), etc.
-	slimRouteRegex = regexp.MustCompile(`\$(app|group)\s*->\s*(get|post|put|patch|delete|options|any)\s*\(\s*['"]([^'"]+)['"]`)
+	// Matches $app->get('/path', ...), $group->post('/path', ...), etc.
+	slimRouteRegex = regexp.MustCompile(`\$(\w+)\s*->\s*(get|post|put|patch|delete|options|any)\s*\(\s*['"]([^'"]*)['"]`)
 
-	// Matches $group->get('/path', ...)
-	slimGroupRouteRegex = regexp.MustCompile(`\$(\w+)\s*->\s*(get|post|put|patch|delete|options|any)\s*\(\s*['"]([^'"]+)['"]`)
+	// Matches ->group('/prefix', function ($group) {
+	slimGroupRegex = regexp.MustCompile(`->\s*group\s*\(\s*['"]([^'"]*)['"]\s*,\s*(?:static\s+)?function\s*\([^)]*\)\s*(?:use\s*\([^)]*\)\s*)?(?::\s*\??\w+\s*)?\{`)
 
-	// Matches ->group('/prefix', function ($group) { ... })
-	slimGroupRegex = regexp.MustCompile(`->\s*group\s*\(\s*['"]([^'"]+)['"]`)
+	// Matches $app->map(['GET', 'POST'], '/path', ...)
+	slimMapRegex = regexp.MustCompile(`\$(\w+)\s*->\s*map\s*\(\s*\[([^\]]+)\]\s*,\s*['"]([^'"]*)['"]`)
 
-	// Matches map(['GET', 'POST'], '/path', ...)
-	slimMapRegex = regexp.MustCompile(`->\s*map\s*\(\s*\[([^\]]+)\]\s*,\s*['"]([^'"]+)['"]`)
+	// Matches the handler following a route path: [UserController::class, 'show'],
+	// UserController::class . ':show', 'UserController:show', or UserController::class
+	slimHandlerRegex = regexp.MustCompile(`^\s*,\s*(?:\[\s*\\?([\w\\]+)::class\s*,\s*['"](\w+)['"]\s*\]|\\?([\w\\]+)::class\s*\.\s*['"]:(\w+)['"]|['"]\\?([\w\\]+):(\w+)['"]|\\?([\w\\]+)::class)`)
 )
 
+// skipVars are common non-route variable names (test code, internal objects)
+// whose get/post calls are not route definitions.
+var skipVars = map[string]bool{
+	"this":     true, // PHPUnit test methods like $this->get()
+	"response": true,
+	"request":  true,
+	"client":   true,
+	"http":     true,
+	"browser":  true,
+}
+
 // ExtractRoutes parses source files and extracts Slim route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
@@ -115,128 +128,175 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 // extractRoutesFromFile extracts routes from a single PHP file.
 func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	content := string(file.Content)
-	lines := strings.Split(content, "\n")
-
-	// Track group prefixes
-	groupPrefixes := p.findGroupPrefixes(content)
-	currentPrefix := ""
+	content := util.NormalizeNewlines(string(file.Content))
 
-	for i, line := range lines {
-		lineNum := i + 1
+	// Group closures apply their prefix to the routes inside them
+	groups := p.findGroups(content)
 
-		// Check for group definitions to track prefix
-		if match := slimGroupRegex.FindStringSubmatch(line); len(match) > 1 {
-			currentPrefix = match[1]
-		}
-
-		// Check for $app->method('/path', ...)
-		if match := slimRouteRegex.FindStringSubmatch(line); len(match) > 3 {
-			method := strings.ToUpper(match[2])
-			path := match[3]
-
-			// Handle 'any' method
-			if method == "ANY" {
-				// Create routes for common methods
-				for _, m := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
-					route := p.createRoute(m, path, currentPrefix, file.Path, lineNum)
-					routes = append(routes, route)
-				}
-			} else {
-				route := p.createRoute(method, path, currentPrefix, file.Path, lineNum)
-				routes = append(routes, route)
-			}
+	// Check for $app->method('/path', ...) and $group->method('/path', ...)
+	for _, match := range slimRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		if skipVars[content[match[2]:match[3]]] {
+			continue
 		}
 
-		// Check for $group->method('/path', ...)
-		if match := slimGroupRouteRegex.FindStringSubmatch(line); len(match) > 3 {
-			varName := match[1]
-			method := strings.ToUpper(match[2])
-			path := match[3]
-
-			// Skip if it's the app variable (already handled above)
-			if varName == "app" {
-				continue
-			}
+		method := strings.ToUpper(content[match[4]:match[5]])
+		path := content[match[6]:match[7]]
+		prefix := groupPrefix(groups, match[0])
+		handler := slimHandler(content[match[1]:])
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
 
-			// Skip common non-route variable names (test code, internal objects)
-			skipVars := map[string]bool{
-				"this":     true, // PHPUnit test methods like $this->get()
-				"response": true,
-				"request":  true,
-				"client":   true,
-				"http":     true,
-				"browser":  true,
-			}
-			if skipVars[varName] {
-				continue
-			}
+		methods := []string{method}
+		if method == "ANY" {
+			// Create routes for common methods
+			methods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+		}
+		for _, m := range methods {
+			routes = append(routes, p.createRoutes(m, path, prefix, handler, file.Path, lineNum)...)
+		}
+	}
 
-			// Use the group prefix if we can find it
-			prefix := p.findPrefixForVariable(varName, groupPrefixes)
-
-			if method == "ANY" {
-				for _, m := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
-					route := p.createRoute(m, path, prefix, file.Path, lineNum)
-					routes = append(routes, route)
-				}
-			} else {
-				route := p.createRoute(method, path, prefix, file.Path, lineNum)
-				routes = append(routes, route)
-			}
+	// Check for ->map(['GET', 'POST'], '/path', ...)
+	for _, match := range slimMapRegex.FindAllStringSubmatchIndex(content, -1) {
+		if skipVars[content[match[2]:match[3]]] {
+			continue
 		}
 
-		// Check for ->map(['GET', 'POST'], '/path', ...)
-		if match := slimMapRegex.FindStringSubmatch(line); len(match) > 2 {
-			methodsStr := match[1]
-			path := match[2]
+		methods := parseMethodsList(content[match[4]:match[5]])
+		path := content[match[6]:match[7]]
+		prefix := groupPrefix(groups, match[0])
+		handler := slimHandler(content[match[1]:])
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
 
-			methods := parseMethodsList(methodsStr)
-			for _, method := range methods {
-				route := p.createRoute(method, path, currentPrefix, file.Path, lineNum)
-				routes = append(routes, route)
-			}
+		for _, method := range methods {
+			routes = append(routes, p.createRoutes(method, path, prefix, handler, file.Path, lineNum)...)
 		}
 	}
 
 	return routes
 }
 
-// groupPrefixInfo tracks group prefix information.
-type groupPrefixInfo struct {
-	varName string
-	prefix  string
+// slimGroup is a route group closure and the span of its body.
+type slimGroup struct {
+	prefix     string
+	start, end int
 }
 
-// findGroupPrefixes finds all group prefix definitions in the content.
-func (p *Plugin) findGroupPrefixes(content string) []groupPrefixInfo {
-	var prefixes []groupPrefixInfo
+// findGroups finds all group closures in the content.
+func (p *Plugin) findGroups(content string) []slimGroup {
+	var groups []slimGroup
 
-	// Match patterns like: ->group('/api', function ($group) { ... })
-	re := regexp.MustCompile(`->\s*group\s*\(\s*['"]([^'"]+)['"]\s*,\s*function\s*\(\s*\$(\w+)`)
-	matches := re.FindAllStringSubmatch(content, -1)
-	for _, match := range matches {
-		if len(match) > 2 {
-			prefixes = append(prefixes, groupPrefixInfo{
-				varName: match[2],
-				prefix:  match[1],
-			})
+	for _, match := range slimGroupRegex.FindAllStringSubmatchIndex(content, -1) {
+		open := match[1] - 1
+		groups = append(groups, slimGroup{
+			prefix: content[match[2]:match[3]],
+			start:  open,
+			end:    matchingBrace(content, open),
+		})
+	}
+
+	return groups
+}
+
+// matchingBrace returns the offset of the brace closing the one at open,
+// skipping braces inside string literals.
+func matchingBrace(content string, open int) int {
+	depth := 0
+	var quote byte
+	for i := open; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
 		}
 	}
+	return len(content)
+}
 
-	return prefixes
+// groupPrefix combines the prefixes of the groups enclosing an offset,
+// outermost first.
+func groupPrefix(groups []slimGroup, offset int) string {
+	prefix := ""
+	for _, g := range groups {
+		if g.start < offset && offset < g.end {
+			prefix = strings.TrimSuffix(prefix, "/") + g.prefix
+		}
+	}
+	return prefix
 }
 
-// findPrefixForVariable finds the prefix associated with a group variable.
-func (p *Plugin) findPrefixForVariable(varName string, prefixes []groupPrefixInfo) string {
-	for _, pf := range prefixes {
-		if pf.varName == varName {
-			return pf.prefix
+// slimHandler returns the Controller:method handler following a route path,
+// without namespace, or "" for closures.
+func slimHandler(rest string) string {
+	match := slimHandlerRegex.FindStringSubmatch(rest)
+	if match == nil {
+		return ""
+	}
+	for i := 1; i < len(match); i += 2 {
+		if match[i] == "" {
+			continue
 		}
+		class := match[i]
+		if idx := strings.LastIndex(class, "\\"); idx >= 0 {
+			class = class[idx+1:]
+		}
+		if i+1 < len(match) && match[i+1] != "" {
+			return class + ":" + match[i+1]
+		}
+		return class
 	}
 	return ""
 }
 
+// createRoutes creates the routes of a path, one per variant of its
+// optional segments.
+func (p *Plugin) createRoutes(method, path, prefix, handler, filePath string, lineNum int) []types.Route {
+	var routes []types.Route
+	for _, variant := range optionalPaths(path) {
+		route := p.createRoute(method, variant, prefix, filePath, lineNum)
+		route.Handler = handler
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// optionalPaths expands FastRoute optional segments: /users[/{id}] yields
+// /users and /users/{id}. Brackets inside {param:regex} are not segments.
+func optionalPaths(path string) []string {
+	depth := 0
+	for i, c := range path {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '[':
+			if depth > 0 {
+				continue
+			}
+			end := strings.LastIndex(path, "]")
+			if end < i {
+				return []string{path}
+			}
+			rest := optionalPaths(path[:i] + path[i+1:end] + path[end+1:])
+			return append([]string{path[:i]}, rest...)
+		}
+	}
+	return []string{path}
+}
+
 // createRoute creates a route from the extracted information.
 func (p *Plugin) createRoute(method, path, prefix, filePath string, lineNum int) types.Route {
 	fullPath := combinePaths(prefix, path)
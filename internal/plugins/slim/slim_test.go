@@ -272,6 +272,60 @@ func TestPlugin_ExtractRoutes_GroupedRoutes(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_NestedGroups(t *testing.T) {
+	code := `<?php
+
+use App\Controller\UserController;
+use Slim\Routing\RouteCollectorProxy;
+
+$app->group('/api', function (RouteCollectorProxy $api) {
+    $api->group('/v1', function (RouteCollectorProxy $group) {
+        $group->get('/users[/{id}]', [UserController::class, 'show']);
+        $group->post(
+            '/users',
+            UserController::class . ':create'
+        );
+    });
+    $api->get('/status', 'App\Controller\StatusController:show');
+});
+
+$app->get('/health', HealthAction::class);
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "routes.php", Language: "php", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	// Optional segments yield a route per variant
+	listUsers := findRoute(routes, "GET", "/api/v1/users")
+	require.NotNil(t, listUsers)
+	assert.Equal(t, "UserController:show", listUsers.Handler)
+	require.NotNil(t, findRoute(routes, "GET", "/api/v1/users/{id}"))
+
+	createUser := findRoute(routes, "POST", "/api/v1/users")
+	require.NotNil(t, createUser)
+	assert.Equal(t, "UserController:create", createUser.Handler)
+	assert.Equal(t, 9, createUser.SourceLine)
+
+	status := findRoute(routes, "GET", "/api/status")
+	require.NotNil(t, status)
+	assert.Equal(t, "StatusController:show", status.Handler)
+
+	// Routes after a group closes take no prefix
+	health := findRoute(routes, "GET", "/health")
+	require.NotNil(t, health)
+	assert.Equal(t, "HealthAction", health.Handler)
+
+	assert.Len(t, routes, 5)
+}
+
+func TestOptionalPaths(t *testing.T) {
+	assert.Equal(t, []string{"/users"}, optionalPaths("/users"))
+	assert.Equal(t, []string{"/users", "/users/{id}"}, optionalPaths("/users[/{id}]"))
+	assert.Equal(t, []string{"/news", "/news/{year}", "/news/{year}/{month}"}, optionalPaths("/news[/{year}[/{month}]]"))
+	assert.Equal(t, []string{"/users/{id:[0-9]+}"}, optionalPaths("/users/{id:[0-9]+}"))
+}
+
 func TestPlugin_ExtractRoutes_MapRoutes(t *testing.T) {
 	p := New()
 
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package spring
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Regex patterns for the Kotlin functional router DSL of Spring WebFlux:
+//
+//	router {
+//	    "/api".nest {
+//	        GET("/users/{id}", handler::getUser)
+//	        POST("/users") { request -> ... }
+//	    }
+//	}
+var (
+	// Matches router { and coRouter { blocks
+	routerBlockRegex = regexp.MustCompile(`\b(?:router|coRouter)\s*\{`)
+
+	// Matches "/api".nest {, path("/api").nest { and ("/api" and accept(...)).nest {
+	nestBlockRegex = regexp.MustCompile(`"([^"]*)"\s*\)?(?:\s+and\s+[^{}]*?)?\)?\s*\.nest\s*\{`)
+
+	// Matches a route predicate such as GET("/users/{id}" or GET(handler::list)
+	functionalRouteRegex = regexp.MustCompile(`\b(GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS)\s*\(\s*(?:"([^"]*)")?`)
+
+	// Matches a handler method reference such as handler::getUser
+	handlerRefRegex = regexp.MustCompile(`(\w+)\s*::\s*(\w+)`)
+
+	// Matches body extraction in a handler: bodyToMono<T>(), awaitBody<T>(),
+	// bodyToMono(T::class.java)
+	bodyTypeRegex = regexp.MustCompile(`\b(?:bodyToMono|bodyToFlux|awaitBody|awaitBodyOrNull)\s*(?:<\s*(\w+)\s*>|\(\s*(\w+)::class)`)
+
+	// Matches the start of the next function declaration
+	nextFunRegex = regexp.MustCompile(`\n\s*(?:(?:private|public|internal|protected|suspend|override)\s+)*fun\s`)
+)
+
+// block is a brace-delimited region of source with the path prefix it adds.
+type block struct {
+	prefix   string
+	startPos int
+	endPos   int
+}
+
+// extractFunctionalRoutes extracts routes declared with the router DSL in a
+// Kotlin file. Handlers referenced as handler::method are looked up in all
+// Kotlin files to find the request body type.
+func extractFunctionalRoutes(file scanner.SourceFile, kotlinFiles []scanner.SourceFile) []types.Route {
+	content := string(file.Content)
+
+	routers := findBlocks(content, routerBlockRegex, false)
+	if len(routers) == 0 {
+		return nil
+	}
+	nests := findBlocks(content, nestBlockRegex, true)
+
+	var routes []types.Route
+	for _, match := range functionalRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		if !insideAny(routers, match[0]) {
+			continue
+		}
+
+		httpMethod := content[match[2]:match[3]]
+		path := ""
+		if match[4] >= 0 {
+			path = content[match[4]:match[5]]
+		}
+		springPath := combinePaths(containingPrefix(nests, match[0]), path)
+		fullPath := convertSpringPathParams(springPath)
+
+		// The handler is a method reference among the arguments or a
+		// trailing lambda
+		openParen := strings.Index(content[match[0]:], "(") + match[0]
+		closeParen := matchingDelimiter(content, openParen)
+		if closeParen == -1 {
+			continue
+		}
+		var ref []string
+		for _, m := range handlerRefRegex.FindAllStringSubmatch(content[openParen:closeParen], -1) {
+			if m[2] != "class" {
+				ref = m
+			}
+		}
+		var handler, body string
+		if ref != nil {
+			handler = ref[2]
+			body = findFunctionBody(kotlinFiles, toTitleCase(ref[1]), ref[2])
+		} else if rest := strings.TrimLeft(content[closeParen+1:], " \t"); strings.HasPrefix(rest, "{") {
+			lambdaStart := len(content) - len(rest)
+			if end := matchingDelimiter(content, lambdaStart); end != -1 {
+				body = content[lambdaStart : end+1]
+			}
+		}
+
+		route := types.Route{
+			Method:      httpMethod,
+			Path:        fullPath,
+			Handler:     handler,
+			OperationID: generateOperationID(httpMethod, fullPath, handler),
+			Tags:        inferTags(fullPath),
+			Parameters:  extractPathParams(fullPath),
+			SourceFile:  file.Path,
+			SourceLine:  strings.Count(content[:match[0]], "\n") + 1,
+		}
+		if m := bodyTypeRegex.FindStringSubmatch(body); m != nil {
+			typeName := m[1] + m[2]
+			route.RequestBody = &types.RequestBody{
+				Required: true,
+				Content: map[string]types.MediaType{
+					"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/" + typeName}},
+				},
+			}
+		}
+		if plugins.IsCatchAll(springPath) {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// findFunctionBody returns the body of the Kotlin function name, preferring
+// the file that declares owner. Expression bodies run to the next
+// declaration.
+func findFunctionBody(files []scanner.SourceFile, owner, name string) string {
+	funRegex := regexp.MustCompile(`\bfun\s+` + regexp.QuoteMeta(name) + `\s*\(`)
+	ownerRegex := regexp.MustCompile(`\b(?:class|object)\s+` + regexp.QuoteMeta(owner) + `\b`)
+
+	ordered := make([]scanner.SourceFile, 0, len(files))
+	var rest []scanner.SourceFile
+	for _, f := range files {
+		if ownerRegex.Match(f.Content) {
+			ordered = append(ordered, f)
+		} else {
+			rest = append(rest, f)
+		}
+	}
+	ordered = append(ordered, rest...)
+
+	for _, f := range ordered {
+		content := string(f.Content)
+		loc := funRegex.FindStringIndex(content)
+		if loc == nil {
+			continue
+		}
+		closeParen := matchingDelimiter(content, loc[1]-1)
+		if closeParen == -1 {
+			continue
+		}
+		after := content[closeParen+1:]
+		next := len(after)
+		if m := nextFunRegex.FindStringIndex(after); m != nil {
+			next = m[0]
+		}
+		if brace := strings.IndexAny(after, "{="); brace != -1 && brace < next && after[brace] == '{' {
+			if end := matchingDelimiter(content, closeParen+1+brace); end != -1 {
+				return content[closeParen+1+brace : end+1]
+			}
+		}
+		return after[:next]
+	}
+	return ""
+}
+
+// findBlocks finds the brace-delimited blocks opened by matches of re,
+// whose first group is the block's path prefix when withPrefix is set.
+func findBlocks(content string, re *regexp.Regexp, withPrefix bool) []block {
+	var blocks []block
+	for _, match := range re.FindAllStringSubmatchIndex(content, -1) {
+		end := matchingDelimiter(content, match[1]-1)
+		if end == -1 {
+			continue
+		}
+		b := block{startPos: match[0], endPos: end}
+		if withPrefix {
+			b.prefix = content[match[2]:match[3]]
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks
+}
+
+// insideAny reports whether pos lies within one of the blocks.
+func insideAny(blocks []block, pos int) bool {
+	for _, b := range blocks {
+		if pos > b.startPos && pos < b.endPos {
+			return true
+		}
+	}
+	return false
+}
+
+// containingPrefix joins the prefixes of the blocks enclosing pos.
+func containingPrefix(blocks []block, pos int) string {
+	prefix := ""
+	for _, b := range blocks {
+		if pos > b.startPos && pos < b.endPos {
+			prefix = combinePaths(prefix, b.prefix)
+		}
+	}
+	return prefix
+}
+
+// matchingDelimiter returns the index of the ')' or '}' closing the
+// delimiter at pos, skipping string literals, or -1.
+func matchingDelimiter(content string, pos int) int {
+	if pos < 0 || pos >= len(content) {
+		return -1
+	}
+	open := content[pos]
+	var close byte
+	switch open {
+	case '(':
+		close = ')'
+	case '{':
+		close = '}'
+	default:
+		return -1
+	}
+
+	depth := 0
+	inString := false
+	for i := pos; i < len(content); i++ {
+		ch := content[i]
+		if ch == '"' && content[i-1] != '\\' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+		switch ch {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// inferTags infers tags from the first static path segment, skipping
+// common API prefixes.
+func inferTags(path string) []string {
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		switch {
+		case part == "", part == "api", strings.HasPrefix(part, "{"):
+			continue
+		case len(part) == 2 && part[0] == 'v' && part[1] >= '0' && part[1] <= '9':
+			continue
+		}
+		return []string{part}
+	}
+	return nil
+}
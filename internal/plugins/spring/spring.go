@@ -49,7 +49,7 @@ func (p *Plugin) Name() string {
 
 // Extensions returns the file extensions this plugin handles.
 func (p *Plugin) Extensions() []string {
-	return []string{".java"}
+	return []string{".java", ".kt"}
 }
 
 // Info returns plugin metadata.
@@ -62,6 +62,7 @@ func (p *Plugin) Info() plugins.PluginInfo {
 			"spring-boot",
 			"Spring Boot",
 			"Spring MVC",
+			"Spring WebFlux",
 		},
 	}
 }
@@ -109,10 +110,21 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 	return false, nil
 }
 
-// ExtractRoutes parses source files and extracts Spring Boot route definitions.
+// ExtractRoutes parses source files and extracts Spring Boot route
+// definitions from Java controllers and Kotlin functional routers.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
 	var routes []types.Route
 
+	var kotlinFiles []scanner.SourceFile
+	for _, file := range files {
+		if file.Language == "kotlin" {
+			kotlinFiles = append(kotlinFiles, file)
+		}
+	}
+	for _, file := range kotlinFiles {
+		routes = append(routes, extractFunctionalRoutes(file, kotlinFiles)...)
+	}
+
 	for _, file := range files {
 		if file.Language != "java" {
 			continue
@@ -156,11 +168,17 @@ func (p *Plugin) extractRoutesFromController(class parser.JavaClass, filePath st
 		}
 	}
 
+	// Media types negotiated by every handler of the controller
+	var produces []string
+	if reqMapping := class.GetAnnotation("RequestMapping"); reqMapping != nil {
+		produces = plugins.MediaTypes(reqMapping.Attributes["produces"])
+	}
+
 	controllerName := strings.TrimSuffix(class.Name, "Controller")
 
 	// Extract routes from methods
 	for _, method := range class.Methods {
-		methodRoutes := p.extractRoutesFromMethod(method, basePath, controllerName, filePath)
+		methodRoutes := p.extractRoutesFromMethod(method, basePath, controllerName, filePath, produces)
 		routes = append(routes, methodRoutes...)
 	}
 
@@ -168,7 +186,7 @@ func (p *Plugin) extractRoutesFromController(class parser.JavaClass, filePath st
 }
 
 // extractRoutesFromMethod extracts routes from a controller method.
-func (p *Plugin) extractRoutesFromMethod(method parser.JavaMethod, basePath, controllerName, filePath string) []types.Route {
+func (p *Plugin) extractRoutesFromMethod(method parser.JavaMethod, basePath, controllerName, filePath string, classProduces []string) []types.Route {
 	var routes []types.Route
 
 	for _, anno := range method.Annotations {
@@ -202,6 +220,7 @@ func (p *Plugin) extractRoutesFromMethod(method parser.JavaMethod, basePath, con
 		fullPath := combinePaths(basePath, path)
 
 		// Convert to OpenAPI format
+		wildcard := plugins.IsCatchAll(fullPath)
 		fullPath = convertSpringPathParams(fullPath)
 
 		// Extract path parameters
@@ -221,7 +240,7 @@ func (p *Plugin) extractRoutesFromMethod(method parser.JavaMethod, basePath, con
 		// Use controller name as tag
 		tags := []string{controllerName}
 
-		routes = append(routes, types.Route{
+		route := types.Route{
 			Method:      httpMethod,
 			Path:        fullPath,
 			Handler:     controllerName + "." + method.Name,
@@ -230,7 +249,16 @@ func (p *Plugin) extractRoutesFromMethod(method parser.JavaMethod, basePath, con
 			Parameters:  params,
 			SourceFile:  filePath,
 			SourceLine:  method.Line,
-		})
+		}
+		if produces := plugins.MediaTypes(anno.Attributes["produces"]); len(produces) > 0 {
+			route.Produces = produces
+		} else {
+			route.Produces = classProduces
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
 	}
 
 	return routes
@@ -263,11 +291,12 @@ func hasRequestBodyAnnotation(param parser.JavaParameter) bool {
 var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
 
 // convertSpringPathParams converts Spring-style path params to OpenAPI format.
-// Spring already uses {param} format, but we clean up any regex patterns.
+// Spring already uses {param} format, but we clean up any regex patterns
+// and template catch-alls ({*path} or /**) as {path}.
 func convertSpringPathParams(path string) string {
 	// Remove regex patterns like {id:\\d+}
 	regexParamRegex := regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*):[^}]+\}`)
-	return regexParamRegex.ReplaceAllString(path, "{$1}")
+	return plugins.CatchAllPath(regexParamRegex.ReplaceAllString(path, "{$1}"))
 }
 
 // extractPathParams extracts path parameters from a route path.
@@ -405,7 +434,7 @@ func (p *Plugin) isSchemaClass(class parser.JavaClass, filePath string) bool {
 	}
 
 	// Check if file is in a model/domain/dto/entity directory
-	pathLower := strings.ToLower(filePath)
+	pathLower := strings.ToLower(filepath.ToSlash(filePath))
 	if strings.Contains(pathLower, "/model/") ||
 		strings.Contains(pathLower, "/domain/") ||
 		strings.Contains(pathLower, "/dto/") ||
@@ -398,6 +398,39 @@ func TestPlugin_ExtractRoutes_RequestMapping(t *testing.T) {
 	}
 }
 
+func TestPlugin_ExtractRoutes_Produces(t *testing.T) {
+	code := `
+package com.example.demo.controller;
+
+@RestController
+@RequestMapping(value = "/api/reports", produces = MediaType.APPLICATION_JSON_VALUE)
+public class ReportController {
+
+    @GetMapping
+    public List<Report> list() {
+        return List.of();
+    }
+
+    @GetMapping(value = "/{id}", produces = {MediaType.APPLICATION_JSON_VALUE, MediaType.APPLICATION_XML_VALUE, "text/csv"})
+    public Report get(@PathVariable Long id) {
+        return null;
+    }
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "ReportController.java", Language: "java", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	list := findRoute(routes, "GET", "/api/reports")
+	require.NotNil(t, list)
+	assert.Equal(t, []string{"application/json"}, list.Produces)
+
+	get := findRoute(routes, "GET", "/api/reports/{id}")
+	require.NotNil(t, get)
+	assert.Equal(t, []string{"application/json", "application/xml", "text/csv"}, get.Produces)
+}
+
 func TestPlugin_ExtractRoutes_AllMethods(t *testing.T) {
 	p := New()
 
@@ -588,6 +621,86 @@ func TestPlugin_ExtractSchemas_ModelDirectory(t *testing.T) {
 	assert.True(t, schemaNames["Item"], "Item class from model directory should be extracted")
 }
 
+func TestPlugin_ExtractRoutes_KotlinRouterDSL(t *testing.T) {
+	routerCode := `
+package com.example
+
+@Configuration
+class RouterConfig {
+    @Bean
+    fun routes(userHandler: UserHandler) = coRouter {
+        GET("/health") { ok().bodyValueAndAwait("ok") }
+        "/api".nest {
+            ("/users" and accept(APPLICATION_JSON)).nest {
+                GET("", userHandler::list)
+                GET("/{id}", userHandler::get)
+                POST("", userHandler::create)
+                PUT("/{id}") { request ->
+                    val user = request.awaitBody<UpdateUserRequest>()
+                    ok().bodyValueAndAwait(user)
+                }
+            }
+        }
+    }
+
+    fun client() = webClient.get().uri("/remote")
+}
+`
+	handlerCode := `
+package com.example
+
+@Component
+class UserHandler(private val repo: UserRepository) {
+    suspend fun list(request: ServerRequest): ServerResponse =
+        ok().bodyAndAwait(repo.findAll())
+
+    suspend fun get(request: ServerRequest): ServerResponse {
+        return ok().bodyValueAndAwait(repo.find(request.pathVariable("id")))
+    }
+
+    suspend fun create(request: ServerRequest): ServerResponse {
+        val user = request.bodyToMono(CreateUserRequest::class.java).awaitSingle()
+        return ok().bodyValueAndAwait(repo.save(user))
+    }
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "RouterConfig.kt", Language: "kotlin", Content: []byte(routerCode)},
+		{Path: "UserHandler.kt", Language: "kotlin", Content: []byte(handlerCode)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 5)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+
+	health := byKey["GET /health"]
+	assert.Equal(t, 8, health.SourceLine)
+	assert.Nil(t, health.RequestBody)
+
+	list := byKey["GET /api/users"]
+	assert.Equal(t, "list", list.Handler)
+	assert.Equal(t, "getList", list.OperationID)
+	assert.Equal(t, []string{"users"}, list.Tags)
+	assert.Nil(t, list.RequestBody)
+
+	get := byKey["GET /api/users/{id}"]
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "id", get.Parameters[0].Name)
+
+	create := byKey["POST /api/users"]
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUserRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	update := byKey["PUT /api/users/{id}"]
+	require.NotNil(t, update.RequestBody)
+	assert.Equal(t, "#/components/schemas/UpdateUserRequest", update.RequestBody.Content["application/json"].Schema.Ref)
+}
+
 func TestConvertSpringPathParams(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -0,0 +1,622 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package starlette provides a plugin for extracting routes from Starlette applications.
+package starlette
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/decisions"
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps HTTPEndpoint method names to their uppercase forms.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// Plugin implements the FrameworkPlugin interface for Starlette.
+type Plugin struct {
+	pyParser *parser.PythonParser
+}
+
+// New creates a new Starlette plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		pyParser: parser.NewPythonParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "starlette"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".py"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "starlette",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Starlette applications",
+		SupportedFrameworks: []string{
+			"starlette",
+		},
+	}
+}
+
+// dependencyFiles are the files that declare Python dependencies.
+var dependencyFiles = []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile", "poetry.lock"}
+
+// Detect checks if Starlette is used in the project. FastAPI is built on
+// Starlette, so FastAPI projects are left to the fastapi plugin.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	found := false
+	for _, name := range dependencyFiles {
+		path := filepath.Join(projectRoot, name)
+		if ok, _ := p.checkFileForDependency(path, "fastapi"); ok {
+			return false, nil
+		}
+		if ok, _ := p.checkFileForDependency(path, "starlette"); ok {
+			found = true
+		}
+	}
+	return found, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanner.Scan() {
+		line := strings.ToLower(scanner.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ExtractRoutes parses source files and extracts Starlette route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	endpoints := p.collectEndpointClasses(ctx, files)
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, endpoints)
+		if err != nil {
+			// Log error but continue with other files
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// extractRoutesFromFile extracts routes from a single Python file.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, endpoints map[string][]string) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	if !p.hasStarletteImport(pf) {
+		decisions.Skip(p.Name(), file.Path, "no Starlette import")
+		return nil, nil
+	}
+
+	calls := p.pyParser.FindCallExpressions(pf.RootNode, file.Content)
+
+	// Route lists mounted by name: Mount("/api", routes=api_routes)
+	mounts := make(map[string][]*sitter.Node)
+	for _, call := range calls {
+		if calleeName(p.pyParser.GetCalleeText(call, file.Content)) != "Mount" {
+			continue
+		}
+		_, kwargs := callArguments(call, file.Content)
+		if list := kwargs["routes"]; list != nil && list.Type() == "identifier" {
+			name := list.Content(file.Content)
+			mounts[name] = append(mounts[name], call)
+		}
+	}
+
+	var routes []types.Route
+
+	// Route("/path", endpoint, methods=[...]) entries of route tables
+	for _, call := range calls {
+		if calleeName(p.pyParser.GetCalleeText(call, file.Content)) != "Route" {
+			continue
+		}
+		for _, prefix := range p.mountPrefixes(call, file.Content, mounts, 0) {
+			routes = append(routes, p.parseRoute(call, prefix, file.Content, endpoints)...)
+		}
+	}
+
+	// @app.route("/path", methods=[...]) decorators
+	for _, fn := range pf.DecoratedFunctions {
+		for _, dec := range fn.Decorators {
+			if !strings.HasSuffix(dec.Name, ".route") || len(dec.Arguments) == 0 {
+				continue
+			}
+			methods := []string{"GET"}
+			if list, ok := dec.KeywordArguments["methods"]; ok {
+				methods = parseMethodsList(list)
+			}
+			for _, method := range methods {
+				routes = append(routes, newRoute(method, dec.Arguments[0], fn.Name, fn.Line))
+			}
+		}
+	}
+
+	for i := range routes {
+		routes[i].SourceFile = file.Path
+	}
+
+	return routes, nil
+}
+
+// hasStarletteImport checks if the file imports Starlette.
+func (p *Plugin) hasStarletteImport(pf *parser.ParsedPythonFile) bool {
+	for _, imp := range pf.Imports {
+		if strings.HasPrefix(strings.ToLower(imp.Module), "starlette") {
+			return true
+		}
+	}
+	return false
+}
+
+// collectEndpointClasses maps the HTTPEndpoint subclasses of all files to
+// the HTTP methods they handle, since route tables often import them.
+func (p *Plugin) collectEndpointClasses(ctx context.Context, files []scanner.SourceFile) map[string][]string {
+	endpoints := make(map[string][]string)
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		addEndpointClasses(endpoints, pf.Classes)
+		pf.Close()
+	}
+	return endpoints
+}
+
+// addEndpointClasses records the HTTP methods of HTTPEndpoint subclasses.
+func addEndpointClasses(endpoints map[string][]string, classes []parser.PythonClass) {
+	for _, cls := range classes {
+		isEndpoint := false
+		for _, base := range cls.Bases {
+			if calleeName(base) == "HTTPEndpoint" {
+				isEndpoint = true
+			}
+		}
+		if !isEndpoint {
+			continue
+		}
+		var methods []string
+		for _, method := range cls.Methods {
+			if _, ok := httpMethods[method.Name]; ok {
+				methods = append(methods, method.Name)
+			}
+		}
+		endpoints[cls.Name] = methods
+	}
+}
+
+// mountPrefixes returns the path prefixes of the Mounts enclosing a route
+// table entry, following route lists that are mounted by name. A list
+// mounted twice yields both prefixes.
+func (p *Plugin) mountPrefixes(node *sitter.Node, content []byte, mounts map[string][]*sitter.Node, depth int) []string {
+	if depth > 8 {
+		return []string{""}
+	}
+
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Type() {
+		case "call":
+			if calleeName(p.pyParser.GetCalleeText(parent, content)) != "Mount" {
+				continue
+			}
+			return p.joinPrefixes(parent, content, mounts, depth)
+		case "assignment":
+			left := parent.ChildByFieldName("left")
+			if left == nil || left.Type() != "identifier" {
+				return []string{""}
+			}
+			var prefixes []string
+			for _, mount := range mounts[left.Content(content)] {
+				prefixes = append(prefixes, p.joinPrefixes(mount, content, mounts, depth)...)
+			}
+			if len(prefixes) == 0 {
+				return []string{""}
+			}
+			return prefixes
+		}
+	}
+
+	return []string{""}
+}
+
+// joinPrefixes returns the full prefixes of a Mount call.
+func (p *Plugin) joinPrefixes(mount *sitter.Node, content []byte, mounts map[string][]*sitter.Node, depth int) []string {
+	path := ""
+	if args, _ := callArguments(mount, content); len(args) > 0 {
+		path, _ = p.pyParser.ExtractStringLiteral(args[0], content)
+	}
+
+	var prefixes []string
+	for _, outer := range p.mountPrefixes(mount, content, mounts, depth+1) {
+		prefixes = append(prefixes, combinePaths(outer, path))
+	}
+	return prefixes
+}
+
+// parseRoute converts a Route(...) entry to routes, one per method. Class
+// endpoints contribute the methods they implement.
+func (p *Plugin) parseRoute(call *sitter.Node, prefix string, content []byte, endpoints map[string][]string) []types.Route {
+	args, kwargs := callArguments(call, content)
+	if len(args) == 0 {
+		return nil
+	}
+	path, ok := p.pyParser.ExtractStringLiteral(args[0], content)
+	if !ok {
+		return nil
+	}
+	if include := kwargs["include_in_schema"]; include != nil && include.Content(content) == "False" {
+		return nil
+	}
+
+	endpoint := kwargs["endpoint"]
+	if endpoint == nil && len(args) > 1 {
+		endpoint = args[1]
+	}
+	handler := ""
+	if endpoint != nil {
+		handler = endpoint.Content(content)
+	}
+
+	fullPath := combinePaths(prefix, path)
+	line := int(call.StartPoint().Row) + 1
+
+	var routes []types.Route
+	if methods, ok := endpoints[calleeName(handler)]; ok {
+		for _, method := range methods {
+			routes = append(routes, newRoute(httpMethods[method], fullPath, calleeName(handler)+"."+method, line))
+		}
+		return routes
+	}
+
+	methods := []string{"GET"}
+	if list := kwargs["methods"]; list != nil {
+		methods = parseMethodsList(list.Content(content))
+	}
+	for _, method := range methods {
+		routes = append(routes, newRoute(method, fullPath, handler, line))
+	}
+	return routes
+}
+
+// newRoute builds a route for a Starlette path, typing path parameters by
+// their convertor.
+func newRoute(method, path, handler string, line int) types.Route {
+	wildcard := plugins.IsCatchAll(path)
+	params := extractPathParams(path)
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      strings.ToUpper(method),
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, calleeName(strings.ReplaceAll(handler, ".", "_"))),
+		Tags:        inferTags(fullPath),
+		Parameters:  params,
+		SourceLine:  line,
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+	return route
+}
+
+// callArguments splits the arguments of a call into positional arguments
+// and keyword arguments by name.
+func callArguments(call *sitter.Node, content []byte) ([]*sitter.Node, map[string]*sitter.Node) {
+	var args []*sitter.Node
+	kwargs := make(map[string]*sitter.Node)
+
+	list := call.ChildByFieldName("arguments")
+	if list == nil {
+		return nil, kwargs
+	}
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		arg := list.NamedChild(i)
+		switch arg.Type() {
+		case "keyword_argument":
+			name, value := arg.ChildByFieldName("name"), arg.ChildByFieldName("value")
+			if name != nil && value != nil {
+				kwargs[name.Content(content)] = value
+			}
+		case "comment":
+			continue
+		default:
+			args = append(args, arg)
+		}
+	}
+	return args, kwargs
+}
+
+// calleeName returns the last segment of a dotted name such as routing.Route.
+func calleeName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// ExtractSchemas extracts schema definitions from Pydantic models and
+// dataclasses.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	return p.ExtractSchemasContext(context.Background(), files)
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+
+		for _, model := range pf.PydanticModels {
+			schema := p.pydanticModelToSchema(model)
+			if schema != nil {
+				schemas = append(schemas, *schema)
+			}
+		}
+
+		pf.Close()
+	}
+
+	return schemas, nil
+}
+
+// pydanticModelToSchema converts a Pydantic model to an OpenAPI schema.
+func (p *Plugin) pydanticModelToSchema(model parser.PydanticModel) *types.Schema {
+	schema := &types.Schema{
+		Title:      model.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+		Source:     model.Source(),
+	}
+
+	for _, field := range model.Fields {
+		// Convert Python type to OpenAPI type
+		propSchema := parser.PythonTypeSchema(field.Type)
+
+		// Handle array types
+		if strings.HasPrefix(field.Type, "List[") || strings.HasPrefix(field.Type, "list[") {
+			propSchema.Type = "array"
+			innerType := extractGenericType(field.Type)
+			propSchema.Items = parser.PythonTypeSchema(innerType)
+		}
+
+		// Emit constant defaults typed for the property
+		if value, ok := parser.DefaultValue(parser.PythonDefaultExpr(field.Default), propSchema.Type); ok {
+			propSchema.Default = value
+		}
+
+		if field.Description != "" {
+			propSchema.Description = field.Description
+		}
+
+		schema.Properties[field.Name] = propSchema
+
+		if !field.IsOptional && !parser.PythonHasDefault(field.Default) {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+// --- Helper Functions ---
+
+// pathParamRegex matches Starlette path parameters like {id} or {id:int}.
+var pathParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)(?::([a-z]+))?\}`)
+
+// convertPathParams strips convertors from path parameters: {id:int} -> {id}.
+func convertPathParams(path string) string {
+	return pathParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// extractPathParams extracts path parameters, typed by their convertor.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		schema := &types.Schema{Type: "string"}
+		switch match[2] {
+		case "int":
+			schema.Type = "integer"
+		case "float":
+			schema.Type = "number"
+		case "uuid":
+			schema.Format = "uuid"
+		}
+
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+
+	return params
+}
+
+// combinePaths combines a prefix and path, handling slashes correctly.
+func combinePaths(prefix, path string) string {
+	if prefix == "" {
+		if path == "" {
+			return "/"
+		}
+		return path
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if path == "" || path == "/" {
+		return prefix
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return prefix + path
+}
+
+// braceParamRegex matches OpenAPI-style path parameters like {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	// Generate from path
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// methodRegex matches quoted HTTP method names in a methods list.
+var methodRegex = regexp.MustCompile(`['"]([A-Za-z]+)['"]`)
+
+// parseMethodsList parses a methods list such as ["GET", "POST"].
+func parseMethodsList(s string) []string {
+	var methods []string
+	for _, match := range methodRegex.FindAllStringSubmatch(s, -1) {
+		methods = append(methods, strings.ToUpper(match[1]))
+	}
+	return methods
+}
+
+// extractGenericType extracts the inner type from a generic like List[str].
+func extractGenericType(s string) string {
+	start := strings.Index(s, "[")
+	end := strings.LastIndex(s, "]")
+	if start == -1 || end == -1 || end <= start {
+		return ""
+	}
+	return strings.TrimSpace(s[start+1 : end])
+}
+
+// Register registers the Starlette plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package starlette
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// starletteAppCode is a test fixture covering route tables, mounts, and
+// class endpoints.
+const starletteAppCode = `
+from starlette.applications import Starlette
+from starlette.endpoints import HTTPEndpoint
+from starlette.routing import Mount, Route, WebSocketRoute
+
+
+async def list_users(request):
+    ...
+
+async def get_user(request):
+    ...
+
+async def create_user(request):
+    ...
+
+
+class ItemEndpoint(HTTPEndpoint):
+    async def get(self, request):
+        ...
+
+    async def delete(self, request):
+        ...
+
+    def helper(self):
+        ...
+
+
+admin_routes = [
+    Route("/stats", endpoint=stats),
+]
+
+routes = [
+    Route("/users", list_users),
+    Route("/users", create_user, methods=["POST"]),
+    Route("/users/{id:int}", get_user, methods=["GET"]),
+    Route("/health", health, include_in_schema=False),
+    Route("/files/{rest:path}", serve_file),
+    Mount("/api/v1", routes=[
+        Route("/items/{item_id:uuid}", ItemEndpoint),
+    ]),
+    Mount("/admin", routes=admin_routes),
+    WebSocketRoute("/ws", websocket_endpoint),
+]
+
+app = Starlette(routes=routes)
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "starlette", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements string
+		expected     bool
+	}{
+		{"starlette", "starlette==0.37.0\nuvicorn==0.29.0\n", true},
+		{"fastapi project", "fastapi==0.110.0\nstarlette==0.37.0\n", false},
+		{"no starlette", "flask==2.0.0\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte(tt.requirements), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(starletteAppCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	listUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, listUsers)
+	assert.Equal(t, "list_users", listUsers.Handler)
+	assert.Equal(t, "app.py", listUsers.SourceFile)
+	assert.Equal(t, []string{"users"}, listUsers.Tags)
+
+	require.NotNil(t, findRoute(routes, "POST", "/users"))
+
+	getUser := findRoute(routes, "GET", "/users/{id}")
+	require.NotNil(t, getUser)
+	require.Len(t, getUser.Parameters, 1)
+	assert.Equal(t, "id", getUser.Parameters[0].Name)
+	assert.Equal(t, "integer", getUser.Parameters[0].Schema.Type)
+
+	// Class endpoints contribute their method handlers
+	getItem := findRoute(routes, "GET", "/api/v1/items/{item_id}")
+	require.NotNil(t, getItem)
+	assert.Equal(t, "ItemEndpoint.get", getItem.Handler)
+	assert.Equal(t, "uuid", getItem.Parameters[0].Schema.Format)
+	require.NotNil(t, findRoute(routes, "DELETE", "/api/v1/items/{item_id}"))
+	assert.Nil(t, findRoute(routes, "POST", "/api/v1/items/{item_id}"))
+
+	// Route lists mounted by name take the mount prefix
+	require.NotNil(t, findRoute(routes, "GET", "/admin/stats"))
+	assert.Nil(t, findRoute(routes, "GET", "/stats"))
+
+	serveFile := findRoute(routes, "GET", "/files/{rest}")
+	require.NotNil(t, serveFile)
+	assert.Equal(t, true, serveFile.Extensions["x-wildcard"])
+
+	assert.Nil(t, findRoute(routes, "GET", "/health"))
+	assert.Nil(t, findRoute(routes, "GET", "/ws"))
+	assert.Len(t, routes, 7)
+}
+
+func TestPlugin_ExtractRoutes_RouteDecorator(t *testing.T) {
+	code := `
+from starlette.applications import Starlette
+
+app = Starlette()
+
+@app.route("/ping", methods=["GET", "HEAD"])
+async def ping(request):
+    ...
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, routes, 2)
+	assert.NotNil(t, findRoute(routes, "GET", "/ping"))
+	assert.NotNil(t, findRoute(routes, "HEAD", "/ping"))
+}
+
+func TestPlugin_ExtractRoutes_IgnoresNonStarlette(t *testing.T) {
+	code := `
+from flask import Flask
+
+routes = [Route("/users", list_users)]
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "app.py", Language: "python", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+}
+
+func TestConvertPathParams(t *testing.T) {
+	assert.Equal(t, "/users/{id}/files/{rest}", convertPathParams("/users/{id:int}/files/{rest:path}"))
+	assert.Equal(t, "/users/{id}", convertPathParams("/users/{id}"))
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
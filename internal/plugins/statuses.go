@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// ApplyStatuses adds a response for each status a handler responds with
+// across its branches. Unless one of them is a success or redirect, the
+// handler's implicit 200 is documented as well. When the handler succeeds
+// only with other 2xx statuses, the body and links inferred for the
+// implicit 200 move to the lowest of them.
+func ApplyStatuses(route *types.Route, codes []int) {
+	if len(codes) == 0 {
+		return
+	}
+	if route.Responses == nil {
+		route.Responses = make(map[string]types.Response)
+	}
+
+	if success := SuccessStatus(codes); success != http.StatusOK {
+		if implicit, ok := route.Responses["200"]; ok {
+			status := strconv.Itoa(success)
+			resp, ok := route.Responses[status]
+			if !ok {
+				resp = types.Response{Description: http.StatusText(success)}
+			}
+			if resp.Content == nil {
+				resp.Content = implicit.Content
+			}
+			if resp.Links == nil {
+				resp.Links = implicit.Links
+			}
+			if resp.Headers == nil {
+				resp.Headers = implicit.Headers
+			}
+			route.Responses[status] = resp
+			delete(route.Responses, "200")
+		}
+	}
+
+	hasSuccess := false
+	for status := range route.Responses {
+		if status[0] == '2' || status[0] == '3' {
+			hasSuccess = true
+		}
+	}
+
+	for _, code := range codes {
+		if code >= 200 && code < 400 {
+			hasSuccess = true
+		}
+		status := strconv.Itoa(code)
+		if _, ok := route.Responses[status]; ok {
+			continue
+		}
+		route.Responses[status] = types.Response{Description: http.StatusText(code)}
+	}
+
+	if !hasSuccess {
+		route.Responses["200"] = types.Response{Description: "Successful response"}
+	}
+}
+
+// SuccessStatus returns the status a handler responding with codes
+// succeeds with: the lowest 2xx of them, or 200 when it responds with 200
+// or no 2xx at all.
+func SuccessStatus(codes []int) int {
+	success := 0
+	for _, code := range codes {
+		if code == http.StatusOK {
+			return http.StatusOK
+		}
+		if code > 200 && code < 300 && (success == 0 || code < success) {
+			success = code
+		}
+	}
+	if success == 0 {
+		return http.StatusOK
+	}
+	return success
+}
+
+// statusNumberRegex matches status constants that spell out their code,
+// e.g. status.HTTP_301_MOVED_PERMANENTLY.
+var statusNumberRegex = regexp.MustCompile(`HTTP_(\d{3})`)
+
+// statusNames maps the constant names of status codes, as in
+// StatusCodes.CREATED or Response::HTTP_NO_CONTENT, to their codes.
+var statusNames = func() map[string]int {
+	names := make(map[string]int)
+	replacer := strings.NewReplacer(" ", "_", "-", "_", "'", "")
+	for code := 100; code < 600; code++ {
+		if text := http.StatusText(code); text != "" {
+			names[replacer.Replace(strings.ToUpper(text))] = code
+		}
+	}
+	return names
+}()
+
+// StatusCode resolves a status argument to its code: a number, a constant
+// spelling out the code such as status.HTTP_201_CREATED, or one naming
+// the status such as StatusCodes.CREATED or Response::HTTP_CREATED. It
+// returns 0 if the status is unknown.
+func StatusCode(value string) int {
+	value = strings.TrimSpace(value)
+	if code, err := strconv.Atoi(value); err == nil {
+		return code
+	}
+	if match := statusNumberRegex.FindStringSubmatch(value); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		return code
+	}
+	if i := strings.LastIndexAny(value, ".:"); i >= 0 {
+		return statusNames[strings.TrimPrefix(value[i+1:], "HTTP_")]
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestApplyStatuses(t *testing.T) {
+	body := map[string]types.MediaType{"application/json": {Schema: &types.Schema{Ref: "#/components/schemas/User"}}}
+
+	// The inferred body moves to the only success status
+	route := types.Route{Responses: map[string]types.Response{"200": {Description: "Successful response", Content: body}}}
+	ApplyStatuses(&route, []int{201, 404})
+	assert.NotContains(t, route.Responses, "200")
+	assert.Equal(t, "Created", route.Responses["201"].Description)
+	assert.Equal(t, body, route.Responses["201"].Content)
+	assert.Equal(t, "Not Found", route.Responses["404"].Description)
+
+	// A handler that also responds with 200 keeps it
+	route = types.Route{Responses: map[string]types.Response{"200": {Content: body}}}
+	ApplyStatuses(&route, []int{200, 201})
+	assert.Equal(t, body, route.Responses["200"].Content)
+	assert.Nil(t, route.Responses["201"].Content)
+
+	// Handlers that only branch to errors succeed implicitly
+	route = types.Route{}
+	ApplyStatuses(&route, []int{400, 404})
+	assert.Equal(t, []string{"200", "400", "404"}, slices.Sorted(maps.Keys(route.Responses)))
+
+	route = types.Route{}
+	ApplyStatuses(&route, nil)
+	assert.Nil(t, route.Responses)
+}
+
+func TestStatusCode(t *testing.T) {
+	tests := map[string]int{
+		"201":                                 201,
+		" 404 ":                               404,
+		"status.HTTP_301_MOVED_PERMANENTLY":   301,
+		"HTTPStatus.HTTP_418_IM_A_TEAPOT":     418,
+		"StatusCodes.CREATED":                 201,
+		"HttpStatus.NO_CONTENT":               204,
+		"Response::HTTP_UNPROCESSABLE_ENTITY": 422,
+		"status":                              0,
+		"StatusCodes.UNKNOWN":                 0,
+	}
+	for value, want := range tests {
+		assert.Equal(t, want, StatusCode(value), value)
+	}
+}
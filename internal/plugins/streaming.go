@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// StreamingExtension documents how an operation streams its response.
+const StreamingExtension = "x-streaming"
+
+// streamingDirective matches @streaming, @sse, @long-poll or
+// api2spec:streaming, optionally followed by a mode or media type as in
+// @streaming application/x-ndjson: group 1 is the directive and group 2 the
+// argument
+var streamingDirective = regexp.MustCompile(`(?i)(?:@|\bapi2spec:)(streaming|stream|chunked|sse|long-?poll(?:ing)?)\b(?:[ \t]+([\w.+-]+(?:/[\w.+-]+)?))?`)
+
+// streamingModes maps directives and their arguments to the mode they set.
+var streamingModes = map[string]string{
+	"streaming":    "stream",
+	"stream":       "stream",
+	"chunked":      "stream",
+	"sse":          "sse",
+	"longpoll":     "long-poll",
+	"long-poll":    "long-poll",
+	"longpolling":  "long-poll",
+	"long-polling": "long-poll",
+}
+
+// MarkStreaming sets x-streaming on routes from the comments above their
+// definition or handler, such as // @sse, # @long-poll or
+// // api2spec:streaming application/x-ndjson.
+func MarkStreaming(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+	}
+	for i := range routes {
+		route := &routes[i]
+		lines, start := sources[route.SourceFile], route.SourceLine-1
+		if start < 0 || start >= len(lines) {
+			continue
+		}
+		streaming, ok := commentStreaming(lines, start)
+		if !ok {
+			continue
+		}
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions[StreamingExtension] = streaming
+	}
+}
+
+// commentStreaming returns the streaming mode annotated in the comment
+// above the line at index n and its decorators; the annotation nearest the
+// line wins.
+func commentStreaming(lines []string, n int) (types.Streaming, bool) {
+	for m := decoratorStart(lines, n) - 1; m >= 0 && isComment(lines[m]); m-- {
+		d := streamingDirective.FindStringSubmatch(lines[m])
+		if d == nil {
+			continue
+		}
+		streaming := types.Streaming{Mode: streamingModes[strings.ToLower(d[1])]}
+		arg := strings.ToLower(d[2])
+		if strings.Contains(arg, "/") {
+			streaming.MediaType = arg
+		} else if mode, ok := streamingModes[arg]; ok && streaming.Mode == "stream" {
+			// @streaming sse
+			streaming.Mode = mode
+		}
+		return streaming, true
+	}
+	return types.Streaming{}, false
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkStreaming_Comments(t *testing.T) {
+	code := `// Streams order updates.
+// @sse
+router.get('/orders/events', orderEvents)
+
+/**
+ * Exports every order.
+ * @streaming application/x-ndjson
+ */
+router.get('/orders/export', exportOrders)
+
+// Waits for the next order.
+// @long-poll
+router.get('/orders/next', nextOrder)
+
+# api2spec:streaming sse
+@app.get("/ticks")
+def ticks():
+    pass
+
+// Lists orders.
+router.get('/orders', listOrders)
+`
+	files := []scanner.SourceFile{{Path: "orders.js", Content: []byte(code)}}
+	routes := []types.Route{
+		{Method: "GET", Path: "/orders/events", SourceFile: "orders.js", SourceLine: 3},
+		{Method: "GET", Path: "/orders/export", SourceFile: "orders.js", SourceLine: 9},
+		{Method: "GET", Path: "/orders/next", SourceFile: "orders.js", SourceLine: 13},
+		{Method: "GET", Path: "/ticks", SourceFile: "orders.js", SourceLine: 17},
+		{Method: "GET", Path: "/orders", SourceFile: "orders.js", SourceLine: 21},
+	}
+
+	MarkStreaming(routes, files)
+
+	assert.Equal(t, types.Streaming{Mode: "sse"}, routes[0].Extensions[StreamingExtension])
+	assert.Equal(t, types.Streaming{Mode: "stream", MediaType: "application/x-ndjson"}, routes[1].Extensions[StreamingExtension])
+	assert.Equal(t, types.Streaming{Mode: "long-poll"}, routes[2].Extensions[StreamingExtension])
+	assert.Equal(t, types.Streaming{Mode: "sse"}, routes[3].Extensions[StreamingExtension], "decorators sit between the comment and the function")
+	assert.Nil(t, routes[4].Extensions)
+}
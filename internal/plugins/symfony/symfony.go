@@ -16,6 +16,7 @@ import (
 
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -126,7 +127,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 // extractRoutesFromFile extracts routes from a single PHP file.
 func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	content := string(file.Content)
+	content := util.NormalizeNewlines(string(file.Content))
 	lines := strings.Split(content, "\n")
 
 	// Find class name
@@ -237,7 +238,7 @@ var (
 // extractRoutesFromYAML extracts routes from a Symfony YAML routes file.
 func (p *Plugin) extractRoutesFromYAML(file scanner.SourceFile) []types.Route {
 	var routes []types.Route
-	content := string(file.Content)
+	content := util.NormalizeNewlines(string(file.Content))
 	lines := strings.Split(content, "\n")
 
 	// Parse YAML routes - simple line-by-line parsing
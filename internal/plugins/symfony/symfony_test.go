@@ -6,6 +6,7 @@ package symfony
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -565,6 +566,28 @@ func TestExtractRoutesFromYAML_BasicRoute(t *testing.T) {
 	assert.True(t, route.Parameters[0].Required)
 }
 
+func TestExtractRoutesFromYAML_CRLF(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{
+			Path:     "config/routes.yaml",
+			Language: "yaml",
+			Content:  []byte(strings.ReplaceAll(symfonyYAMLMultipleRoutes, "\n", "\r\n")),
+		},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	assert.Equal(t, "/users", routes[0].Path)
+	assert.Equal(t, "App\\Controller\\UserController::index", routes[0].Handler)
+	assert.Equal(t, "POST", routes[1].Method)
+	assert.Equal(t, "/users/{id}", routes[2].Path)
+	assert.Equal(t, 11, routes[2].SourceLine)
+}
+
 func TestExtractRoutesFromYAML_MultipleRoutes(t *testing.T) {
 	p := New()
 
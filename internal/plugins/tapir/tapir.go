@@ -17,6 +17,8 @@ import (
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
+	"github.com/api2spec/api2spec/internal/util"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -141,7 +143,7 @@ func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error
 			continue
 		}
 
-		fileRoutes := p.extractTapirEndpoints(string(file.Content), file.Path)
+		fileRoutes := p.extractTapirEndpoints(util.NormalizeNewlines(string(file.Content)), file.Path)
 		routes = append(routes, fileRoutes...)
 	}
 
@@ -364,6 +366,11 @@ func scalaTypeToOpenAPI(scalaType string) (openAPIType string, format string) {
 		scalaType = extractScalaGenericType(scalaType)
 	}
 
+	// Configured type mappings take precedence
+	if t, f, ok := typemap.OpenAPI(scalaType); ok {
+		return t, f
+	}
+
 	switch scalaType {
 	case "String":
 		return "string", ""
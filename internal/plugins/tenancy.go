@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+// TenantHost is a per-tenant host, such as {tenant}.api.example.com,
+// detected from subdomain routing or tenancy middleware.
+type TenantHost struct {
+	// Host is the host template, or only the variable ({tenant}) when the
+	// middleware does not name the domain
+	Host string
+
+	// Variable is the tenant variable of the host
+	Variable string
+
+	// Values are the tenants the host pattern enumerates, if any
+	Values []string
+
+	// Source names the routing call or package the host was detected from
+	Source string
+
+	// File and Line locate the detection
+	File string
+	Line int
+}
+
+// hostPattern matches a host template in routing code.
+type hostPattern struct {
+	source string
+	re     *regexp.Regexp
+}
+
+var (
+	// hostPatterns capture the host template (host) of subdomain routing
+	hostPatterns = []hostPattern{
+		{"mux Host", regexp.MustCompile(`\.Host\(\s*"(?P<host>[^"]*\{[^"]*)"`)},
+		{"Route::domain", regexp.MustCompile(`(?:Route::|->)domain\(\s*['"](?P<host>[^'"]*\{[^'"]*)['"]`)},
+		{"@Controller host", regexp.MustCompile(`\bhost:\s*['"](?P<host>[^'"]*:\w+[^'"]*)['"]`)},
+		{"Flask subdomain", regexp.MustCompile(`\bsubdomain\s*=\s*['"](?P<host><\w+>)['"]`)},
+		{"vhost", regexp.MustCompile(`\bvhost\(\s*['"](?P<host>\*\.[^'"]+)['"]`)},
+		{"django-hosts", regexp.MustCompile(`\bhost\(\s*r?['"](?P<host>[^'"]*\(\?P<\w+>[^'"]*)['"]`)},
+		{"Finbuckle host strategy", regexp.MustCompile(`WithHostStrategy\(\s*"(?P<host>[^"]*__tenant__[^"]*)"`)},
+	}
+
+	// tenancyMarkers identify tenancy packages that resolve the tenant from
+	// the subdomain without naming the domain
+	tenancyMarkers = []hostPattern{
+		{"stancl/tenancy", regexp.MustCompile(`InitializeTenancyBy(?:Subdomain|Domain(?:OrSubdomain)?)\b`)},
+		{"apartment", regexp.MustCompile(`Apartment::Elevators::(?:Subdomain|FirstSubdomain)\b`)},
+		{"acts_as_tenant", regexp.MustCompile(`\bset_current_tenant_by_subdomain\b`)},
+		{"django-tenants", regexp.MustCompile(`django_tenants\.middleware|TenantMainMiddleware\b`)},
+		{"Finbuckle host strategy", regexp.MustCompile(`WithHostStrategy\(\s*\)`)},
+		{"subdomain constraint", regexp.MustCompile(`constraints\(?\s*subdomain:`)},
+	}
+
+	// flaskServerName captures the SERVER_NAME that Flask subdomains are relative to
+	flaskServerName = regexp.MustCompile(`SERVER_NAME['"]?\]?\s*=\s*['"](?P<host>[\w.\-]+(?::\d+)?)['"]`)
+
+	// hostVariable matches {name} and gorilla/mux {name:pattern} variables
+	hostVariable = regexp.MustCompile(`\{(\w+)(?::([^{}]*))?\}`)
+
+	// namedGroup matches the (?P<name>...) groups of django-hosts patterns
+	namedGroup = regexp.MustCompile(`\(\?P<(\w+)>[^)]*\)`)
+
+	// colonVariable matches the :name variables of NestJS hosts
+	colonVariable = regexp.MustCompile(`:(\w+)`)
+
+	// literalAlternation matches patterns enumerating literal values, (a|b)
+	literalAlternation = regexp.MustCompile(`^\(?([\w-]+(?:\|[\w-]+)+)\)?$`)
+)
+
+// DetectTenantHost returns the per-tenant host of the project: the host
+// template of subdomain routing such as r.Host("{tenant}.example.com") or
+// Route::domain('{account}.example.com'), or, failing that, the tenant
+// subdomain resolved by tenancy middleware. It returns nil if neither is
+// found.
+func DetectTenantHost(files []scanner.SourceFile) *TenantHost {
+	var marker *TenantHost
+	for _, f := range files {
+		content := string(f.Content)
+		for _, p := range hostPatterns {
+			loc := p.re.FindStringSubmatchIndex(content)
+			if loc == nil {
+				continue
+			}
+			group := p.re.SubexpIndex("host")
+			host, values := normalizeHost(content[loc[2*group]:loc[2*group+1]])
+			if host == "" {
+				continue
+			}
+			detected := &TenantHost{
+				Host:     host,
+				Variable: hostVariable.FindStringSubmatch(host)[1],
+				Values:   values,
+				Source:   p.source,
+				File:     f.Path,
+				Line:     strings.Count(content[:loc[0]], "\n") + 1,
+			}
+			if p.source == "Flask subdomain" {
+				if serverName := findServerName(files); serverName != "" {
+					detected.Host += "." + serverName
+				}
+			}
+			return detected
+		}
+		if marker != nil {
+			continue
+		}
+		for _, p := range tenancyMarkers {
+			if loc := p.re.FindStringIndex(content); loc != nil {
+				marker = &TenantHost{
+					Host:     "{tenant}",
+					Variable: "tenant",
+					Source:   p.source,
+					File:     f.Path,
+					Line:     strings.Count(content[:loc[0]], "\n") + 1,
+				}
+				break
+			}
+		}
+	}
+	return marker
+}
+
+// findServerName returns the Flask SERVER_NAME set in any of files.
+func findServerName(files []scanner.SourceFile) string {
+	for _, f := range files {
+		if m := flaskServerName.FindSubmatch(f.Content); m != nil {
+			return string(m[1])
+		}
+	}
+	return ""
+}
+
+// normalizeHost converts a host pattern in framework syntax, such as
+// :tenant.example.com, <tenant>, *.example.com, (?P<tenant>\w+)\.example\.com
+// or __tenant__.*, to a {tenant}.example.com template. It also returns the
+// values a {name:a|b} pattern enumerates.
+func normalizeHost(pattern string) (string, []string) {
+	host := pattern
+	switch {
+	case strings.Contains(host, "(?P<"):
+		host = namedGroup.ReplaceAllString(host, "{$1}")
+		host = strings.NewReplacer(`\.`, ".", "^", "", "$", "").Replace(host)
+	case strings.Contains(host, "__tenant__"):
+		host = strings.TrimSuffix(strings.TrimSuffix(host, ".*"), "*")
+		host = strings.ReplaceAll(host, "__tenant__", "{tenant}")
+	case strings.HasPrefix(host, "*."):
+		host = "{tenant}" + host[1:]
+	case strings.HasPrefix(host, "<"):
+		host = "{" + strings.Trim(host, "<>") + "}"
+	case !strings.Contains(host, "{"):
+		host = colonVariable.ReplaceAllString(host, "{$1}")
+	}
+
+	var values []string
+	host = hostVariable.ReplaceAllStringFunc(host, func(v string) string {
+		m := hostVariable.FindStringSubmatch(v)
+		if alt := literalAlternation.FindStringSubmatch(m[2]); alt != nil && values == nil {
+			values = strings.Split(alt[1], "|")
+		}
+		return "{" + m[1] + "}"
+	})
+	if !strings.Contains(host, "{") || strings.ContainsAny(host, "/\\ ") {
+		return "", nil
+	}
+	return host, values
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+)
+
+func TestDetectTenantHost(t *testing.T) {
+	tests := []struct {
+		name, code, host string
+		values           []string
+	}{
+		{"mux", `r.Host("{tenant:acme|globex}.api.example.com").Subrouter()`, "{tenant}.api.example.com", []string{"acme", "globex"}},
+		{"laravel", `Route::domain('{account}.example.com')->group(function () {`, "{account}.example.com", nil},
+		{"nestjs", `@Controller({ host: ':tenant.example.com' })`, "{tenant}.example.com", nil},
+		{"vhost", `app.use(vhost('*.example.com', tenantApp))`, "{tenant}.example.com", nil},
+		{"django-hosts", `host(r'(?P<tenant>\w+)\.example\.com', 'tenant.urls', name='tenant')`, "{tenant}.example.com", nil},
+		{"finbuckle", `.WithHostStrategy("__tenant__.example.com")`, "{tenant}.example.com", nil},
+		{"stancl", `Route::middleware([InitializeTenancyBySubdomain::class])->group(`, "{tenant}", nil},
+		{"apartment", `config.middleware.use Apartment::Elevators::Subdomain`, "{tenant}", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := DetectTenantHost([]scanner.SourceFile{{Path: "app.src", Content: []byte("\n" + tt.code + "\n")}})
+			require.NotNil(t, host)
+			assert.Equal(t, tt.host, host.Host)
+			assert.Equal(t, tt.values, host.Values)
+			assert.Equal(t, 2, host.Line)
+		})
+	}
+}
+
+func TestDetectTenantHost_Flask(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "config.py", Content: []byte(`app.config["SERVER_NAME"] = "example.com"`)},
+		{Path: "views.py", Content: []byte(`@app.route("/", subdomain="<tenant>")`)},
+	}
+	host := DetectTenantHost(files)
+	require.NotNil(t, host)
+	assert.Equal(t, "{tenant}.example.com", host.Host)
+	assert.Equal(t, "tenant", host.Variable)
+	assert.Equal(t, "views.py", host.File)
+}
+
+func TestDetectTenantHost_RoutingBeforeMiddleware(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "app/Http/Kernel.php", Content: []byte(`InitializeTenancyByDomain::class,`)},
+		{Path: "routes/tenant.php", Content: []byte(`Route::domain('{team}.example.com')->group(`)},
+	}
+	host := DetectTenantHost(files)
+	require.NotNil(t, host)
+	assert.Equal(t, "{team}.example.com", host.Host)
+
+	assert.Nil(t, DetectTenantHost([]scanner.SourceFile{{Path: "main.go", Content: []byte(`r.Host("api.example.com")`)}}))
+}
@@ -0,0 +1,631 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// TimeoutExtension documents the time in milliseconds after which a request
+// to an operation times out.
+const TimeoutExtension = "x-timeout-ms"
+
+// durationExpr matches a duration argument: '5s', 5000, 5_000,
+// 60 * time.Second
+const durationExpr = "[\\w.'\"` *]+"
+
+var (
+	// timeoutMiddleware match server-side request timeouts, capturing their
+	// duration: chi middleware.Timeout, gin-contrib timeout.WithTimeout,
+	// echo TimeoutConfig and net/http http.TimeoutHandler
+	timeoutMiddleware = []*regexp.Regexp{
+		regexp.MustCompile(`\bmiddleware\.Timeout\(\s*(` + durationExpr + `)\s*\)`),
+		regexp.MustCompile(`\btimeout\.WithTimeout\(\s*(` + durationExpr + `)\s*\)`),
+		regexp.MustCompile(`\bTimeoutConfig\{[^}]*?\bTimeout:\s*(` + durationExpr + `)`),
+		regexp.MustCompile(`\bhttp\.TimeoutHandler\(\s*[^,]+,\s*(` + durationExpr + `)\s*,`),
+	}
+
+	// timeoutCall matches a call to a function named timeout, as rxjs
+	// timeout(5000) in interceptors, capturing its duration
+	timeoutCall = regexp.MustCompile(`(?:^|[^.\w])timeout\(\s*(` + durationExpr + `)\s*[,)]`)
+
+	// connectTimeoutImport matches the import of connect-timeout, capturing
+	// the name it is bound to
+	connectTimeoutImport = regexp.MustCompile(`\b(?:const|let|var|import)\s+(\w+)\s*(?:=\s*require\(\s*['"]connect-timeout['"]\s*\)|from\s+['"]connect-timeout['"])`)
+
+	// appWideTimeout matches the lines registering middleware or
+	// interceptors for a whole router or application
+	appWideTimeout = regexp.MustCompile(`\.(?:use|Use|useGlobalInterceptors)\(|\buseClass:|\bhttp\.TimeoutHandler\(`)
+
+	// classDecl matches a class declaration, capturing its name
+	classDecl = regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:default\s+)?class\s+(\w+)`)
+
+	// interceptorNew, interceptorUse and interceptorProv match the uses of
+	// NestJS interceptors: new TimeoutInterceptor(5000),
+	// @UseInterceptors(TimeoutInterceptor) and
+	// { provide: APP_INTERCEPTOR, useClass: TimeoutInterceptor }
+	interceptorNew  = regexp.MustCompile(`\bnew\s+(\w+)\(\s*([^()]*)\)`)
+	interceptorUse  = regexp.MustCompile(`@UseInterceptors\(([^)]*)\)`)
+	interceptorProv = regexp.MustCompile(`\buseClass:\s*(\w+)`)
+
+	// axiosCreate matches an axios instance, capturing its variable
+	axiosCreate = regexp.MustCompile(`\b(\w+)\s*=\s*axios\.create\(`)
+
+	// axiosDefaults matches a default timeout set on axios or an instance
+	axiosDefaults = regexp.MustCompile(`\b(\w+)\.defaults\.timeout\s*=\s*(` + durationExpr + `)`)
+
+	// clientCall matches a request made through a client, capturing the
+	// client, the method, the path and what is concatenated to it
+	clientCall = regexp.MustCompile("\\b(\\w+)\\.(get|post|put|patch|delete|head|options)\\(\\s*[\"'`]([^\"'`]+)[\"'`]([^,()]*)")
+
+	// fetchCall matches a fetch call with options, capturing the path and
+	// what is concatenated to it
+	fetchCall = regexp.MustCompile("\\bfetch\\(\\s*[\"'`]([^\"'`]+)[\"'`]([^,()]*),\\s*")
+
+	optionTimeout = regexp.MustCompile(`\btimeout:\s*(` + durationExpr + `)`)
+	optionBaseURL = regexp.MustCompile("\\bbaseURL:\\s*[\"'`]([^\"'`]+)[\"'`]")
+	optionMethod  = regexp.MustCompile(`\bmethod:\s*["'](\w+)["']`)
+	signalTimeout = regexp.MustCompile(`\bAbortSignal\.timeout\(\s*(` + durationExpr + `)\s*\)`)
+
+	// clientPathPrefix matches the origin or interpolated base URL a
+	// request path starts with, as in https://api.example.com/users or
+	// ${API_URL}/users
+	clientPathPrefix = regexp.MustCompile(`^(?:https?://[^/]+|\$\{[^}]*\})`)
+
+	// goDurationUnits are the time package's duration constants in
+	// milliseconds
+	goDurationUnits = map[string]float64{
+		"time.Nanosecond":  1e-6,
+		"time.Microsecond": 1e-3,
+		"time.Millisecond": 1,
+		"time.Second":      1e3,
+		"time.Minute":      60e3,
+		"time.Hour":        3600e3,
+	}
+)
+
+// Gateway configuration files looked for at the project root.
+var (
+	kongFiles       = []string{"kong.yml", "kong.yaml"}
+	serverlessFiles = []string{"serverless.yml", "serverless.yaml"}
+)
+
+// timeoutPolicy is a server-side timeout configured in a source file.
+type timeoutPolicy struct {
+	file    string
+	line    int
+	appWide bool
+	ms      int
+}
+
+// MarkTimeouts documents in x-timeout-ms the shortest timeout a request to
+// each route is subject to. Server timeouts come from timeout middleware
+// (connect-timeout, chi, gin, echo, http.TimeoutHandler) and NestJS
+// interceptors piping to timeout(); as with CORS, one on a route
+// definition covers that route, one in a file defining routes covers the
+// file's routes and one elsewhere covers every route. Client timeouts come
+// from axios instances and calls and from fetch calls with
+// AbortSignal.timeout, matched to routes by method and path; gateway
+// timeouts from the Kong declarative configuration and serverless.yml at
+// the project root.
+func MarkTimeouts(routes []types.Route, files []scanner.SourceFile, root string) {
+	server := serverTimeouts(routes, files)
+	client := clientTimeouts(routes, files)
+	gateway := gatewayTimeouts(routes, root)
+	for i := range routes {
+		ms := 0
+		for _, timeouts := range [][]int{server, client, gateway} {
+			if timeouts != nil && timeouts[i] > 0 && (ms == 0 || timeouts[i] < ms) {
+				ms = timeouts[i]
+			}
+		}
+		if ms == 0 {
+			continue
+		}
+		route := &routes[i]
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		route.Extensions[TimeoutExtension] = ms
+	}
+}
+
+// serverTimeouts returns the timeout of the most specific server-side
+// policy covering each route, the shortest on ties.
+func serverTimeouts(routes []types.Route, files []scanner.SourceFile) []int {
+	policies := detectTimeouts(files)
+	if len(policies) == 0 {
+		return nil
+	}
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(string(f.Content), "\n")
+	}
+	windows := routeWindows(routes, sources)
+	routeFiles := make(map[string]bool)
+	for _, route := range routes {
+		routeFiles[route.SourceFile] = true
+	}
+
+	timeouts := make([]int, len(routes))
+	scores := make([]int, len(routes))
+	apply := func(i, score, ms int) {
+		if timeouts[i] == 0 || score > scores[i] || score == scores[i] && ms < timeouts[i] {
+			timeouts[i], scores[i] = ms, score
+		}
+	}
+	for _, p := range policies {
+		if !routeFiles[p.file] {
+			for i := range routes {
+				apply(i, 0, p.ms)
+			}
+			continue
+		}
+		covered := false
+		if !p.appWide {
+			for i, route := range routes {
+				if route.SourceFile == p.file && p.line >= windows[i][0] && p.line < windows[i][1] {
+					apply(i, 2, p.ms)
+					covered = true
+				}
+			}
+		}
+		if covered {
+			continue
+		}
+		for i, route := range routes {
+			if route.SourceFile == p.file {
+				apply(i, 1, p.ms)
+			}
+		}
+	}
+	return timeouts
+}
+
+// routeWindows returns the lines of each route's definition, from the
+// decorators above it up to the next route of its file or
+// webhookSourceWindow lines, as [start, end) line numbers.
+func routeWindows(routes []types.Route, sources map[string][]string) [][2]int {
+	starts := make([]int, len(routes))
+	for i, route := range routes {
+		start := route.SourceLine
+		lines := sources[route.SourceFile]
+		for start > 1 && start-2 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[start-2]), "@") {
+			start--
+		}
+		starts[i] = start
+	}
+	windows := make([][2]int, len(routes))
+	for i, route := range routes {
+		end := route.SourceLine + webhookSourceWindow
+		for j, other := range routes {
+			if other.SourceFile == route.SourceFile && other.SourceLine > route.SourceLine && starts[j] < end {
+				end = starts[j]
+			}
+		}
+		windows[i] = [2]int{starts[i], end}
+	}
+	return windows
+}
+
+// detectTimeouts returns the server-side timeouts configured in files.
+func detectTimeouts(files []scanner.SourceFile) []timeoutPolicy {
+	interceptors := timeoutInterceptors(files)
+	var policies []timeoutPolicy
+	add := func(f scanner.SourceFile, offset, ms int) {
+		content := string(f.Content)
+		line := lineAt(content, offset)
+		text := strings.Split(content, "\n")[line-1]
+		policies = append(policies, timeoutPolicy{file: f.Path, line: line, appWide: appWideTimeout.MatchString(text), ms: ms})
+	}
+
+	for _, f := range files {
+		content := string(f.Content)
+		for _, re := range connectTimeouts(content) {
+			for _, m := range re.FindAllStringSubmatchIndex(content, -1) {
+				if ms, ok := durationMillis(content[m[2]:m[3]]); ok {
+					add(f, m[2], ms)
+				}
+			}
+		}
+		if len(interceptors) == 0 {
+			continue
+		}
+		for _, m := range interceptorNew.FindAllStringSubmatchIndex(content, -1) {
+			ms, known := interceptors[content[m[2]:m[3]]]
+			if !known {
+				continue
+			}
+			if arg, ok := durationMillis(content[m[4]:m[5]]); ok {
+				ms = arg
+			}
+			if ms > 0 {
+				add(f, m[0], ms)
+			}
+		}
+		for _, m := range interceptorUse.FindAllStringSubmatchIndex(content, -1) {
+			for _, name := range strings.Split(content[m[2]:m[3]], ",") {
+				if ms := interceptors[strings.TrimSpace(name)]; ms > 0 {
+					add(f, m[0], ms)
+				}
+			}
+		}
+		for _, m := range interceptorProv.FindAllStringSubmatchIndex(content, -1) {
+			if ms := interceptors[content[m[2]:m[3]]]; ms > 0 {
+				add(f, m[0], ms)
+			}
+		}
+	}
+	return policies
+}
+
+// connectTimeouts returns timeoutMiddleware along with the calls to
+// connect-timeout when content imports it. Other functions named timeout,
+// such as sleep helpers, are not middleware.
+func connectTimeouts(content string) []*regexp.Regexp {
+	res := timeoutMiddleware
+	for _, m := range connectTimeoutImport.FindAllStringSubmatch(content, -1) {
+		re := regexp.MustCompile(`(?:^|[^.\w])` + m[1] + `\(\s*(` + durationExpr + `)\s*[,)]`)
+		res = append(res[:len(res):len(res)], re)
+	}
+	return res
+}
+
+// timeoutInterceptors maps the NestJS interceptors piping responses to
+// rxjs timeout() to their timeout, 0 when it is passed to their
+// constructor.
+func timeoutInterceptors(files []scanner.SourceFile) map[string]int {
+	interceptors := make(map[string]int)
+	for _, f := range files {
+		content := string(f.Content)
+		classes := classDecl.FindAllStringSubmatchIndex(content, -1)
+		for i, m := range classes {
+			end := len(content)
+			if i+1 < len(classes) {
+				end = classes[i+1][0]
+			}
+			body := content[m[1]:end]
+			if !strings.Contains(body, "intercept(") {
+				continue
+			}
+			match := timeoutCall.FindStringSubmatch(body)
+			if match == nil {
+				continue
+			}
+			ms, _ := durationMillis(match[1])
+			interceptors[content[m[2]:m[3]]] = ms
+		}
+	}
+	return interceptors
+}
+
+// axiosClient is an axios instance's default timeout and base URL path.
+type axiosClient struct {
+	ms   int
+	base string
+}
+
+// clientTimeouts returns the shortest timeout of the axios and fetch
+// requests in files matching each route.
+func clientTimeouts(routes []types.Route, files []scanner.SourceFile) []int {
+	clients := make(map[string]axiosClient)
+	for _, f := range files {
+		content := string(f.Content)
+		for _, m := range axiosCreate.FindAllStringSubmatchIndex(content, -1) {
+			options := enclosed(content[m[1]-1:], '(', ')')
+			var client axiosClient
+			if match := optionTimeout.FindStringSubmatch(options); match != nil {
+				client.ms, _ = durationMillis(match[1])
+			}
+			if match := optionBaseURL.FindStringSubmatch(options); match != nil {
+				client.base, _ = clientPath(match[1], "")
+			}
+			clients[content[m[2]:m[3]]] = client
+		}
+	}
+	for _, f := range files {
+		for _, match := range axiosDefaults.FindAllStringSubmatch(string(f.Content), -1) {
+			if ms, ok := durationMillis(match[2]); ok {
+				client := clients[match[1]]
+				client.ms = ms
+				clients[match[1]] = client
+			}
+		}
+	}
+
+	matchers := make([]*regexp.Regexp, len(routes))
+	for i, route := range routes {
+		matchers[i], _ = pathMatcher(route.Path)
+	}
+	var timeouts []int
+	record := func(method, path string, ms int) {
+		for i, route := range routes {
+			if !strings.EqualFold(route.Method, method) {
+				continue
+			}
+			if route.Path != path && (matchers[i] == nil || !matchers[i].MatchString(path)) {
+				continue
+			}
+			if timeouts == nil {
+				timeouts = make([]int, len(routes))
+			}
+			if timeouts[i] == 0 || ms < timeouts[i] {
+				timeouts[i] = ms
+			}
+		}
+	}
+
+	for _, f := range files {
+		content := string(f.Content)
+		for _, m := range clientCall.FindAllStringSubmatchIndex(content, -1) {
+			client, known := clients[content[m[2]:m[3]]]
+			if match := optionTimeout.FindStringSubmatch(enclosed(content[m[5]:], '(', ')')); match != nil {
+				if ms, ok := durationMillis(match[1]); ok {
+					client.ms, known = ms, true
+				}
+			}
+			if !known || client.ms == 0 {
+				continue
+			}
+			if path, ok := clientPath(content[m[6]:m[7]], content[m[8]:m[9]]); ok {
+				record(content[m[4]:m[5]], strings.TrimSuffix(client.base, "/")+path, client.ms)
+			}
+		}
+		for _, m := range fetchCall.FindAllStringSubmatchIndex(content, -1) {
+			rest := content[m[1]:]
+			if !strings.HasPrefix(rest, "{") {
+				continue
+			}
+			options := enclosed(rest, '{', '}')
+			match := signalTimeout.FindStringSubmatch(options)
+			if match == nil {
+				continue
+			}
+			ms, ok := durationMillis(match[1])
+			path, isPath := clientPath(content[m[2]:m[3]], content[m[4]:m[5]])
+			if !ok || !isPath {
+				continue
+			}
+			method := "GET"
+			if match := optionMethod.FindStringSubmatch(options); match != nil {
+				method = match[1]
+			}
+			record(method, path, ms)
+		}
+	}
+	return timeouts
+}
+
+// clientPath returns the path of a request URL without its origin,
+// interpolated base URL, query and fragment. A URL followed by a
+// concatenation, as in '/users/' + id, ends with an interpolated segment.
+func clientPath(url, concatenated string) (string, bool) {
+	path := clientPathPrefix.ReplaceAllString(url, "")
+	if idx := strings.IndexAny(path, "?#"); idx >= 0 {
+		path = path[:idx]
+	} else if strings.HasPrefix(strings.TrimSpace(concatenated), "+") {
+		path += "${}"
+	}
+	if !strings.HasPrefix(path, "/") {
+		return "", false
+	}
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path, true
+}
+
+// gatewayTimeouts returns the timeouts the Kong declarative configuration
+// and serverless.yml at the project root set for each route.
+func gatewayTimeouts(routes []types.Route, root string) []int {
+	kong := kongTimeouts(routes, root)
+	serverless := serverlessTimeouts(routes, root)
+	if kong == nil {
+		return serverless
+	}
+	for i, ms := range serverless {
+		if ms > 0 && (kong[i] == 0 || ms < kong[i]) {
+			kong[i] = ms
+		}
+	}
+	return kong
+}
+
+// kongService is the part of a Kong service that sets its timeout.
+type kongService struct {
+	ReadTimeout int `yaml:"read_timeout"`
+	Routes      []struct {
+		Paths   []string `yaml:"paths"`
+		Methods []string `yaml:"methods"`
+	} `yaml:"routes"`
+}
+
+// kongTimeouts returns the read timeout of the Kong service whose route
+// matches each route by the longest path prefix.
+func kongTimeouts(routes []types.Route, root string) []int {
+	var kong struct {
+		Services []kongService `yaml:"services"`
+	}
+	if !readGatewayFile(root, kongFiles, &kong) {
+		return nil
+	}
+
+	var timeouts []int
+	for i, route := range routes {
+		longest := -1
+		for _, service := range kong.Services {
+			if service.ReadTimeout <= 0 {
+				continue
+			}
+			for _, kr := range service.Routes {
+				if len(kr.Methods) > 0 && !containsFold(kr.Methods, route.Method) {
+					continue
+				}
+				for _, prefix := range kr.Paths {
+					// Regex paths start with ~
+					if strings.HasPrefix(prefix, "~") || !hasPathPrefix(route.Path, prefix) || len(prefix) <= longest {
+						continue
+					}
+					if timeouts == nil {
+						timeouts = make([]int, len(routes))
+					}
+					timeouts[i], longest = service.ReadTimeout, len(prefix)
+				}
+			}
+		}
+	}
+	return timeouts
+}
+
+// serverlessFunction is the part of a Serverless Framework function that
+// sets its timeout and HTTP events.
+type serverlessFunction struct {
+	Timeout int                    `yaml:"timeout"`
+	Events  []map[string]yaml.Node `yaml:"events"`
+}
+
+// serverlessTimeouts returns the timeout, in seconds in serverless.yml, of
+// the function whose http or httpApi event matches each route.
+func serverlessTimeouts(routes []types.Route, root string) []int {
+	var serverless struct {
+		Provider struct {
+			Timeout int `yaml:"timeout"`
+		} `yaml:"provider"`
+		Functions map[string]serverlessFunction `yaml:"functions"`
+	}
+	if !readGatewayFile(root, serverlessFiles, &serverless) {
+		return nil
+	}
+
+	names := make([]string, 0, len(serverless.Functions))
+	for name := range serverless.Functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var timeouts []int
+	for _, name := range names {
+		fn := serverless.Functions[name]
+		seconds := fn.Timeout
+		if seconds == 0 {
+			seconds = serverless.Provider.Timeout
+		}
+		if seconds <= 0 {
+			continue
+		}
+		for _, event := range fn.Events {
+			for _, key := range []string{"http", "httpApi"} {
+				node, ok := event[key]
+				if !ok {
+					continue
+				}
+				method, path := serverlessEndpoint(node)
+				for i, route := range routes {
+					if route.Path != path || method != "*" && method != "ANY" && !strings.EqualFold(method, route.Method) {
+						continue
+					}
+					if timeouts == nil {
+						timeouts = make([]int, len(routes))
+					}
+					if ms := seconds * 1000; timeouts[i] == 0 || ms < timeouts[i] {
+						timeouts[i] = ms
+					}
+				}
+			}
+		}
+	}
+	return timeouts
+}
+
+// serverlessEndpoint returns the method and path of an http event, written
+// as "GET users/{id}" or {method: get, path: users/{id}}.
+func serverlessEndpoint(node yaml.Node) (string, string) {
+	var method, path string
+	if node.Kind == yaml.MappingNode {
+		var event struct {
+			Method string `yaml:"method"`
+			Path   string `yaml:"path"`
+		}
+		if node.Decode(&event) != nil {
+			return "", ""
+		}
+		method, path = event.Method, event.Path
+	} else {
+		method, path, _ = strings.Cut(strings.TrimSpace(node.Value), " ")
+	}
+	return strings.ToUpper(method), "/" + strings.Trim(strings.TrimSpace(path), "/")
+}
+
+// readGatewayFile decodes the first of names present at root into v.
+func readGatewayFile(root string, names []string, v any) bool {
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+		return yaml.Unmarshal(data, v) == nil
+	}
+	return false
+}
+
+// hasPathPrefix reports whether path is prefix or lies under it.
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// durationMillis converts a duration argument to milliseconds: strings
+// such as '5s', '500ms' or '2m', Go durations such as 60 * time.Second,
+// and numbers, which JavaScript APIs take in milliseconds.
+func durationMillis(expr string) (int, bool) {
+	expr = strings.TrimSpace(expr)
+	if unquoted := strings.Trim(expr, "'\"`"); unquoted != expr {
+		if n, err := strconv.Atoi(unquoted); err == nil && n > 0 {
+			return n, true
+		}
+		d, err := time.ParseDuration(strings.ReplaceAll(unquoted, " ", ""))
+		if err != nil || d <= 0 {
+			return 0, false
+		}
+		return int(d.Milliseconds()), true
+	}
+
+	ms := 1.0
+	for _, factor := range strings.Split(expr, "*") {
+		factor = strings.TrimSpace(factor)
+		if unit, ok := goDurationUnits[factor]; ok {
+			ms *= unit
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.ReplaceAll(factor, "_", ""), 64)
+		if err != nil {
+			return 0, false
+		}
+		ms *= n
+	}
+	if ms < 1 {
+		return 0, false
+	}
+	return int(ms), true
+}
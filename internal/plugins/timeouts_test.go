@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestDurationMillis(t *testing.T) {
+	tests := []struct {
+		expr string
+		want int
+		ok   bool
+	}{
+		{"'5s'", 5000, true},
+		{`"500ms"`, 500, true},
+		{"`2m`", 120000, true},
+		{"'3000'", 3000, true},
+		{"5000", 5000, true},
+		{"5_000", 5000, true},
+		{"5 * 1000", 5000, true},
+		{"60 * time.Second", 60000, true},
+		{"time.Minute", 60000, true},
+		{"100*time.Millisecond", 100, true},
+		{"this.ms", 0, false},
+		{"'soon'", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := durationMillis(tt.expr)
+		assert.Equal(t, tt.ok, ok, tt.expr)
+		assert.Equal(t, tt.want, got, tt.expr)
+	}
+}
+
+func TestMarkTimeouts_Middleware(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "main.go", Content: []byte("r := chi.NewRouter()\nr.Use(middleware.Timeout(60 * time.Second))\nr.Mount(\"/api\", api.Routes())\n")},
+		{Path: "app.js", Content: []byte("const timeout = require('connect-timeout')\napp.use(timeout('10s'))\napp.get('/reports', timeout('30s'), reports)\napp.get('/users', users)\n")},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/reports", SourceFile: "app.js", SourceLine: 3},
+		{Method: "GET", Path: "/users", SourceFile: "app.js", SourceLine: 4},
+		{Method: "GET", Path: "/orders", SourceFile: "api/orders.go", SourceLine: 5},
+	}
+
+	MarkTimeouts(routes, files, t.TempDir())
+
+	assert.Equal(t, 30000, routes[0].Extensions[TimeoutExtension])
+	assert.Equal(t, 10000, routes[1].Extensions[TimeoutExtension])
+	assert.Equal(t, 60000, routes[2].Extensions[TimeoutExtension])
+}
+
+func TestMarkTimeouts_SleepHelper(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "retry.js", Content: []byte("const timeout = ms => new Promise(r => setTimeout(r, ms))\nexport async function retry(fn) {\n  await timeout(250)\n  return fn()\n}\n")},
+		{Path: "app.js", Content: []byte("import connectTimeout from 'connect-timeout'\napp.use(connectTimeout('5s'))\napp.get('/users', users)\n")},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/users", SourceFile: "app.js", SourceLine: 3},
+	}
+
+	MarkTimeouts(routes, files, t.TempDir())
+
+	assert.Equal(t, 5000, routes[0].Extensions[TimeoutExtension])
+
+	routes = []types.Route{{Method: "GET", Path: "/users", SourceFile: "app.js", SourceLine: 3}}
+	MarkTimeouts(routes, files[:1], t.TempDir())
+
+	assert.Nil(t, routes[0].Extensions)
+}
+
+func TestMarkTimeouts_NestInterceptors(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "timeout.interceptor.ts", Content: []byte(`@Injectable()
+export class TimeoutInterceptor implements NestInterceptor {
+  intercept(context: ExecutionContext, next: CallHandler): Observable<any> {
+    return next.handle().pipe(timeout(5000));
+  }
+}
+`)},
+		{Path: "reports.controller.ts", Content: []byte(`@Controller('reports')
+export class ReportsController {
+  @Get()
+  findAll() {}
+
+  @Post()
+  @UseInterceptors(new TimeoutInterceptor(30000))
+  create() {}
+}
+`)},
+		{Path: "users.controller.ts", Content: []byte(`@UseInterceptors(TimeoutInterceptor)
+@Controller('users')
+export class UsersController {
+  @Get()
+  findAll() {}
+}
+`)},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/reports", SourceFile: "reports.controller.ts", SourceLine: 4},
+		{Method: "POST", Path: "/reports", SourceFile: "reports.controller.ts", SourceLine: 8},
+		{Method: "GET", Path: "/users", SourceFile: "users.controller.ts", SourceLine: 5},
+	}
+
+	MarkTimeouts(routes, files, t.TempDir())
+
+	assert.Nil(t, routes[0].Extensions)
+	assert.Equal(t, 30000, routes[1].Extensions[TimeoutExtension])
+	assert.Equal(t, 5000, routes[2].Extensions[TimeoutExtension])
+}
+
+func TestMarkTimeouts_Clients(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "web/api.ts", Content: []byte("export const api = axios.create({ baseURL: 'https://api.example.com/v1', timeout: 8000 })\n")},
+		{Path: "web/users.ts", Content: []byte(`export const getUser = (id: string) => api.get(` + "`/users/${id}`" + `)
+export const getOrder = (id: string) => api.get('/orders/' + id)
+export const importUsers = (file: Blob) => api.post('/users/import', file, { timeout: 60000 })
+export const search = (q: string) => fetch('/v1/search?q=' + q, { method: 'POST', signal: AbortSignal.timeout(2000) })
+`)},
+	}
+	routes := []types.Route{
+		{Method: "GET", Path: "/v1/users/{id}", SourceFile: "users.go", SourceLine: 10},
+		{Method: "POST", Path: "/v1/users/import", SourceFile: "users.go", SourceLine: 11},
+		{Method: "POST", Path: "/v1/search", SourceFile: "search.go", SourceLine: 3},
+		{Method: "DELETE", Path: "/v1/users/{id}", SourceFile: "users.go", SourceLine: 12},
+		{Method: "GET", Path: "/v1/orders", SourceFile: "orders.go", SourceLine: 4},
+		{Method: "GET", Path: "/v1/orders/{id}", SourceFile: "orders.go", SourceLine: 5},
+	}
+
+	MarkTimeouts(routes, files, t.TempDir())
+
+	assert.Equal(t, 8000, routes[0].Extensions[TimeoutExtension])
+	assert.Equal(t, 60000, routes[1].Extensions[TimeoutExtension])
+	assert.Equal(t, 2000, routes[2].Extensions[TimeoutExtension])
+	assert.Nil(t, routes[3].Extensions)
+	assert.Nil(t, routes[4].Extensions)
+	assert.Equal(t, 8000, routes[5].Extensions[TimeoutExtension])
+}
+
+func TestMarkTimeouts_Gateways(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "kong.yml"), []byte(`_format_version: "3.0"
+services:
+  - name: api
+    url: http://api:8080
+    read_timeout: 15000
+    routes:
+      - paths: ["/api"]
+  - name: exports
+    url: http://api:8080
+    read_timeout: 120000
+    routes:
+      - paths: ["/api/exports"]
+        methods: [GET]
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "serverless.yml"), []byte(`provider:
+  name: aws
+  timeout: 10
+functions:
+  getUser:
+    handler: users.get
+    timeout: 3
+    events:
+      - http: GET api/users/{id}
+  createUser:
+    handler: users.create
+    events:
+      - http:
+          path: api/users
+          method: post
+`), 0o644))
+	routes := []types.Route{
+		{Method: "GET", Path: "/api/exports/{id}"},
+		{Method: "GET", Path: "/api/users/{id}"},
+		{Method: "POST", Path: "/api/users"},
+		{Method: "GET", Path: "/health"},
+	}
+
+	MarkTimeouts(routes, nil, root)
+
+	assert.Equal(t, 120000, routes[0].Extensions[TimeoutExtension])
+	assert.Equal(t, 3000, routes[1].Extensions[TimeoutExtension])
+	assert.Equal(t, 10000, routes[2].Extensions[TimeoutExtension])
+	assert.Nil(t, routes[3].Extensions)
+}
@@ -0,0 +1,521 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package tornado provides a plugin for extracting routes from Tornado applications.
+package tornado
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// httpMethods maps RequestHandler method names to their uppercase forms.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
+
+// Plugin implements the FrameworkPlugin interface for Tornado.
+type Plugin struct {
+	pyParser *parser.PythonParser
+}
+
+// New creates a new Tornado plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		pyParser: parser.NewPythonParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "tornado"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".py"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "tornado",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Tornado applications",
+		SupportedFrameworks: []string{
+			"tornado",
+		},
+	}
+}
+
+// dependencyFiles are the files that declare Python dependencies.
+var dependencyFiles = []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile", "poetry.lock"}
+
+// serverFrameworks are the Python web frameworks whose projects commonly
+// pull in tornado through tooling such as Jupyter.
+var serverFrameworks = []string{"fastapi", "flask", "django"}
+
+// Detect checks if Tornado is used as the web framework of the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	found := false
+	for _, name := range dependencyFiles {
+		path := filepath.Join(projectRoot, name)
+		for _, framework := range serverFrameworks {
+			if ok, _ := p.checkFileForDependency(path, framework); ok {
+				return false, nil
+			}
+		}
+		if ok, _ := p.checkFileForDependency(path, "tornado"); ok {
+			found = true
+		}
+	}
+	return found, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanner.Scan() {
+		line := strings.ToLower(scanner.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// handlerMethod is an HTTP method implemented by a RequestHandler.
+type handlerMethod struct {
+	name string
+
+	// params are the method parameters after self, which receive the
+	// unnamed groups of the route pattern
+	params []string
+}
+
+// ExtractRoutes parses source files and extracts Tornado route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	handlers := p.collectHandlers(ctx, files)
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.Language != "python" {
+			continue
+		}
+
+		fileRoutes, err := p.extractRoutesFromFile(ctx, file, handlers)
+		if err != nil {
+			// Log error but continue with other files
+			continue
+		}
+
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// collectHandlers maps the RequestHandler subclasses of all files to their
+// HTTP methods. Handlers often derive from a project base handler, so
+// classes are resolved through their bases once every file is read.
+func (p *Plugin) collectHandlers(ctx context.Context, files []scanner.SourceFile) map[string][]handlerMethod {
+	bases := make(map[string][]string)
+	methods := make(map[string][]handlerMethod)
+
+	for _, file := range files {
+		if file.Language != "python" {
+			continue
+		}
+		pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		for _, cls := range pf.Classes {
+			bases[cls.Name] = cls.Bases
+			var handled []handlerMethod
+			for _, method := range cls.Methods {
+				if _, ok := httpMethods[method.Name]; !ok {
+					continue
+				}
+				m := handlerMethod{name: method.Name}
+				for _, param := range method.Parameters {
+					if param.Name != "self" {
+						m.params = append(m.params, param.Name)
+					}
+				}
+				handled = append(handled, m)
+			}
+			methods[cls.Name] = handled
+		}
+		pf.Close()
+	}
+
+	handlers := make(map[string][]handlerMethod)
+	for name := range bases {
+		if isRequestHandler(name, bases, 0) {
+			handlers[name] = methods[name]
+		}
+	}
+	return handlers
+}
+
+// isRequestHandler reports whether a class derives from RequestHandler.
+func isRequestHandler(name string, bases map[string][]string, depth int) bool {
+	if depth > 8 {
+		return false
+	}
+	for _, base := range bases[name] {
+		if lastSegment(base) == "RequestHandler" || isRequestHandler(lastSegment(base), bases, depth+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractRoutesFromFile extracts routes from a single Python file.
+func (p *Plugin) extractRoutesFromFile(ctx context.Context, file scanner.SourceFile, handlers map[string][]handlerMethod) ([]types.Route, error) {
+	pf, err := p.pyParser.ParseContext(ctx, file.Path, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	var routes []types.Route
+
+	// Rules are (pattern, Handler, ...) tuples or url(pattern, Handler, ...).
+	// Route modules often import only the handlers, so rules are recognized
+	// by their handler class rather than a tornado import.
+	p.pyParser.WalkNodes(pf.RootNode, func(node *sitter.Node) bool {
+		var pattern, handler *sitter.Node
+		switch node.Type() {
+		case "tuple":
+			if node.NamedChildCount() >= 2 {
+				pattern, handler = node.NamedChild(0), node.NamedChild(1)
+			}
+		case "call":
+			name := lastSegment(p.pyParser.GetCalleeText(node, file.Content))
+			if name != "url" && name != "URLSpec" {
+				return true
+			}
+			if args := p.pyParser.GetCallArguments(node, file.Content); len(args) >= 2 {
+				pattern, handler = args[0], args[1]
+			}
+		}
+		if pattern == nil {
+			return true
+		}
+
+		methods, ok := handlers[lastSegment(handler.Content(file.Content))]
+		if !ok {
+			return true
+		}
+		regex, ok := p.pyParser.ExtractStringLiteral(pattern, file.Content)
+		if !ok {
+			return true
+		}
+
+		line := int(node.StartPoint().Row) + 1
+		routes = append(routes, handlerRoutes(regex, lastSegment(handler.Content(file.Content)), methods, line)...)
+		return true
+	})
+
+	for i := range routes {
+		routes[i].SourceFile = file.Path
+	}
+
+	return routes, nil
+}
+
+// handlerRoutes builds one route per method of a handler. Unnamed groups
+// of the pattern take the parameter names of the first method that
+// receives them all.
+func handlerRoutes(regex, handler string, methods []handlerMethod, line int) []types.Route {
+	var names []string
+	groups := countGroups(regex)
+	for _, m := range methods {
+		if len(m.params) >= groups {
+			names = m.params
+			break
+		}
+	}
+
+	path, params, wildcard := convertPattern(regex, names)
+
+	var routes []types.Route
+	for _, m := range methods {
+		route := types.Route{
+			Method:      httpMethods[m.name],
+			Path:        path,
+			Handler:     handler + "." + m.name,
+			OperationID: generateOperationID(m.name, path, handler),
+			Tags:        inferTags(path),
+			Parameters:  params,
+			SourceLine:  line,
+		}
+		if wildcard {
+			plugins.MarkWildcard(&route)
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// patternGroup is a group of a route pattern.
+type patternGroup struct {
+	start, end int
+	name       string
+	body       string
+	capturing  bool
+}
+
+// scanGroups returns the top-level groups of a regex, skipping escapes and
+// character classes.
+func scanGroups(regex string) []patternGroup {
+	var groups []patternGroup
+	depth, start := 0, 0
+	inClass := false
+
+	for i := 0; i < len(regex); i++ {
+		switch c := regex[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+		case c == '(':
+			if depth == 0 {
+				start = i
+			}
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+			if depth > 0 {
+				continue
+			}
+			g := patternGroup{start: start, end: i + 1, body: regex[start+1 : i], capturing: true}
+			if m := namedGroupRegex.FindStringSubmatch(g.body); m != nil {
+				g.name, g.body = m[1], g.body[len(m[0]):]
+			} else if strings.HasPrefix(g.body, "?") {
+				g.capturing = false
+			}
+			groups = append(groups, g)
+		}
+	}
+
+	return groups
+}
+
+// namedGroupRegex matches the prefix of a named group: ?P<name>.
+var namedGroupRegex = regexp.MustCompile(`^\?P<([a-zA-Z_][a-zA-Z0-9_]*)>`)
+
+// countGroups counts the capturing groups of a route pattern.
+func countGroups(regex string) int {
+	n := 0
+	for _, g := range scanGroups(regex) {
+		if g.capturing {
+			n++
+		}
+	}
+	return n
+}
+
+// numericRegexes are the group regexes that only match integers.
+var numericRegexes = map[string]bool{`\d+`: true, `[0-9]+`: true, `\d*`: true, `[0-9]*`: true}
+
+// convertPattern converts a Tornado route regex to a templated path. Groups
+// become parameters named by the group name, the given names in order, or
+// their position, and escapes and anchors are dropped.
+func convertPattern(regex string, names []string) (string, []types.Parameter, bool) {
+	regex = strings.TrimPrefix(regex, "^")
+	regex = strings.TrimSuffix(regex, "$")
+	regex = strings.TrimSuffix(regex, "/?")
+
+	var sb strings.Builder
+	var params []types.Parameter
+	wildcard := false
+	last, index := 0, 0
+
+	for _, g := range scanGroups(regex) {
+		sb.WriteString(unescape(regex[last:g.start]))
+		last = g.end
+		if !g.capturing {
+			continue
+		}
+
+		name := g.name
+		if name == "" {
+			if index < len(names) {
+				name = names[index]
+			} else {
+				name = fmt.Sprintf("param%d", index+1)
+			}
+			index++
+		}
+
+		schema := &types.Schema{Type: "string"}
+		if numericRegexes[g.body] {
+			schema.Type = "integer"
+		}
+		if g.body == ".*" || g.body == ".+" {
+			wildcard = true
+		}
+
+		sb.WriteString("{" + name + "}")
+		params = append(params, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   schema,
+		})
+	}
+	sb.WriteString(unescape(regex[last:]))
+
+	path := sb.String()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path, params, wildcard
+}
+
+// unescape drops the backslashes of escaped literal characters like \. or \-.
+func unescape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// lastSegment returns the last segment of a dotted name such as handlers.UserHandler.
+func lastSegment(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// ExtractSchemas extracts schema definitions.
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	// Tornado doesn't have a standard schema definition pattern
+	return []types.Schema{}, nil
+}
+
+// ExtractSchemasContext is like ExtractSchemas, but returns ctx's error once
+// ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return p.ExtractSchemas(files)
+}
+
+// --- Helper Functions ---
+
+// braceParamRegex matches OpenAPI-style path parameters like {param}.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(strings.TrimSuffix(handler, "Handler"))
+	}
+
+	// Generate from path
+	path = braceParamRegex.ReplaceAllString(path, "By${1}")
+	path = strings.ReplaceAll(path, "/", " ")
+	path = strings.TrimSpace(path)
+
+	words := strings.Fields(path)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// Register registers the Tornado plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package tornado
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// tornadoHandlersCode defines request handlers, one through a base handler.
+const tornadoHandlersCode = `
+import tornado.web
+
+
+class BaseHandler(tornado.web.RequestHandler):
+    def prepare(self):
+        ...
+
+
+class UsersHandler(BaseHandler):
+    async def get(self):
+        ...
+
+    async def post(self):
+        ...
+
+
+class UserHandler(BaseHandler):
+    async def get(self, user_id):
+        ...
+
+    async def delete(self, user_id):
+        ...
+
+
+class PostHandler(tornado.web.RequestHandler):
+    def get(self, slug):
+        ...
+
+
+class FileHandler(tornado.web.RequestHandler):
+    def get(self, path):
+        ...
+`
+
+// tornadoAppCode registers the handlers from another module.
+const tornadoAppCode = `
+from tornado.web import Application, url
+
+from .handlers import FileHandler, PostHandler, UserHandler, UsersHandler
+
+
+def make_app():
+    return Application([
+        (r"/users", UsersHandler),
+        (r"/users/(\d+)", UserHandler),
+        url(r"/posts/(?P<slug>[a-z0-9-]+)\.json$", PostHandler, name="post"),
+        (r"/files/(.*)", FileHandler, {"root": "/srv"}),
+        ("/ignored", "not a handler"),
+    ])
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "tornado", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements string
+		expected     bool
+	}{
+		{"tornado", "tornado==6.4\n", true},
+		{"flask project", "flask==3.0.0\ntornado==6.4\n", false},
+		{"no tornado", "requests==2.28.0\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte(tt.requirements), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "handlers.py", Language: "python", Content: []byte(tornadoHandlersCode)},
+		{Path: "app.py", Language: "python", Content: []byte(tornadoAppCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	listUsers := findRoute(routes, "GET", "/users")
+	require.NotNil(t, listUsers)
+	assert.Equal(t, "UsersHandler.get", listUsers.Handler)
+	assert.Equal(t, "app.py", listUsers.SourceFile)
+	require.NotNil(t, findRoute(routes, "POST", "/users"))
+
+	// Unnamed groups take the handler method's parameter names
+	getUser := findRoute(routes, "GET", "/users/{user_id}")
+	require.NotNil(t, getUser)
+	require.Len(t, getUser.Parameters, 1)
+	assert.Equal(t, "user_id", getUser.Parameters[0].Name)
+	assert.Equal(t, "integer", getUser.Parameters[0].Schema.Type)
+	require.NotNil(t, findRoute(routes, "DELETE", "/users/{user_id}"))
+
+	getPost := findRoute(routes, "GET", "/posts/{slug}.json")
+	require.NotNil(t, getPost)
+	assert.Equal(t, "string", getPost.Parameters[0].Schema.Type)
+
+	getFile := findRoute(routes, "GET", "/files/{path}")
+	require.NotNil(t, getFile)
+	assert.Equal(t, true, getFile.Extensions["x-wildcard"])
+
+	assert.Len(t, routes, 6)
+}
+
+func TestConvertPattern(t *testing.T) {
+	tests := []struct {
+		regex    string
+		names    []string
+		expected string
+	}{
+		{`/users/(\d+)`, []string{"id"}, "/users/{id}"},
+		{`^/users/(?P<id>\d+)/?$`, nil, "/users/{id}"},
+		{`/a/([^/]+)/b/([^/]+)`, nil, "/a/{param1}/b/{param2}"},
+		{`/static/(.*)`, []string{"path"}, "/static/{path}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.regex, func(t *testing.T) {
+			path, _, _ := convertPattern(tt.regex, tt.names)
+			assert.Equal(t, tt.expected, path)
+		})
+	}
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
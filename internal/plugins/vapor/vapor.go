@@ -6,17 +6,20 @@ package vapor
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
+	sitter "github.com/smacker/go-tree-sitter"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
 	"github.com/api2spec/api2spec/internal/parser"
 	"github.com/api2spec/api2spec/internal/plugins"
 	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -91,250 +94,702 @@ func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
 
 // ExtractRoutes parses source files and extracts Vapor route definitions.
 func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
-	var routes []types.Route
+	return p.ExtractRoutesContext(context.Background(), files)
+}
+
+// ExtractRoutesContext is like ExtractRoutes, but stops once ctx is done.
+func (p *Plugin) ExtractRoutesContext(ctx context.Context, files []scanner.SourceFile) ([]types.Route, error) {
+	parsed := p.parseFiles(ctx, files)
+	defer closeFiles(parsed)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e := newExtractor(p.swiftParser, parsed)
+	e.run()
+
+	return deduplicateRoutes(e.routes), nil
+}
 
+// parseFiles parses the Swift files, skipping any that fail to parse.
+func (p *Plugin) parseFiles(ctx context.Context, files []scanner.SourceFile) []*parser.ParsedSwiftFile {
+	var parsed []*parser.ParsedSwiftFile
 	for _, file := range files {
 		if file.Language != "swift" {
 			continue
 		}
-
-		fileRoutes := p.extractVaporRoutes(string(file.Content), file.Path)
-		routes = append(routes, fileRoutes...)
+		pf, err := p.swiftParser.ParseContext(ctx, file.Path, file.Content)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, pf)
 	}
-
-	return routes, nil
+	return parsed
 }
 
-// Regex patterns for Vapor route extraction
-var (
-	// Matches Vapor app.get/post/put/delete/patch routes
-	vaporRouteRegex = regexp.MustCompile(`(?m)(\w+)\.(get|post|put|delete|patch|head|options)\s*\(\s*([^)]*)\s*\)\s*\{`)
+// closeFiles releases the parse trees of the files.
+func closeFiles(parsed []*parser.ParsedSwiftFile) {
+	for _, pf := range parsed {
+		pf.Close()
+	}
+}
 
-	// Matches Vapor grouped routes: app.grouped("api").get("users") { ... }
-	vaporGroupedRouteRegex = regexp.MustCompile(`(?m)(\w+)\.grouped\s*\(\s*"([^"]+)"\s*\)\s*\.\s*(get|post|put|delete|patch|head|options)\s*\(\s*([^)]*)\s*\)\s*\{`)
+// deduplicateRoutes removes duplicate routes based on method + path,
+// keeping the first.
+func deduplicateRoutes(routes []types.Route) []types.Route {
+	seen := make(map[string]bool)
+	var result []types.Route
 
-	// Matches route group assignment: let users = app.grouped("users")
-	vaporRouteGroupRegex = regexp.MustCompile(`(?m)let\s+(\w+)\s*=\s*(\w+)\.grouped\s*\(\s*"([^"]+)"\s*\)`)
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, route)
+		}
+	}
 
-	// Matches controller routes: routes.get("path", use: handler)
-	vaporControllerRouteRegex = regexp.MustCompile(`(?m)(\w+)\.(get|post|put|delete|patch|head|options)\s*\(\s*([^,)]*)\s*,\s*use\s*:\s*(\w+)\s*\)`)
+	return result
+}
 
-	// Matches path segments in route definitions
-	vaporPathSegmentRegex = regexp.MustCompile(`"([^"]+)"`)
+// httpMethods maps Vapor's RoutesBuilder verb methods to HTTP methods.
+var httpMethods = map[string]string{
+	"get":     "GET",
+	"post":    "POST",
+	"put":     "PUT",
+	"delete":  "DELETE",
+	"patch":   "PATCH",
+	"head":    "HEAD",
+	"options": "OPTIONS",
+}
 
-	// Matches path parameters like ":id", ":userId"
-	vaporPathParamRegex = regexp.MustCompile(`:(\w+)`)
+// routerTypes are the parameter types through which routes are registered.
+var routerTypes = map[string]bool{
+	"Application":        true,
+	"RoutesBuilder":      true,
+	"any RoutesBuilder":  true,
+	"some RoutesBuilder": true,
+	"Routes":             true,
+}
 
-	// Matches path parameter in brace format
-	braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
-)
+// declaration is a type declaration together with its file.
+type declaration struct {
+	file *parser.ParsedSwiftFile
+	decl *parser.SwiftStruct
+}
 
-// extractVaporRoutes extracts Vapor route definitions from Swift source code.
-func (p *Plugin) extractVaporRoutes(src, filePath string) []types.Route {
-	var routes []types.Route
+// function is a top-level function together with its file.
+type function struct {
+	file *parser.ParsedSwiftFile
+	fn   *parser.SwiftFunction
+}
 
-	// Extract route groups first
-	groupMap := p.extractRouteGroups(src)
+// extractor resolves route registrations across all files of a project.
+// Router values are tracked as path prefixes: parameters of router type,
+// variables assigned from grouped(...) and closure parameters of
+// group(...) { ... }. Route collections are followed from
+// register(collection:), and functions taking a router from their calls.
+type extractor struct {
+	swiftParser *parser.SwiftParser
+	files       []*parser.ParsedSwiftFile
+	types       map[string][]declaration
+	functions   map[string]function
+	content     map[string]bool
+	active      map[string]bool
+	routes      []types.Route
+}
 
-	// Extract grouped routes (app.grouped("api").get("users"))
-	routes = append(routes, p.extractGroupedRoutes(src, filePath)...)
+// newExtractor indexes the declarations of the parsed files.
+func newExtractor(swiftParser *parser.SwiftParser, files []*parser.ParsedSwiftFile) *extractor {
+	e := &extractor{
+		swiftParser: swiftParser,
+		files:       files,
+		types:       make(map[string][]declaration),
+		functions:   make(map[string]function),
+		content:     contentTypes(files),
+		active:      make(map[string]bool),
+	}
+	for _, pf := range files {
+		for i := range pf.Structs {
+			s := &pf.Structs[i]
+			e.types[s.Name] = append(e.types[s.Name], declaration{file: pf, decl: s})
+		}
+		for i := range pf.Functions {
+			fn := &pf.Functions[i]
+			if _, ok := e.functions[fn.Name]; !ok {
+				e.functions[fn.Name] = function{file: pf, fn: fn}
+			}
+		}
+	}
+	return e
+}
 
-	// Extract controller routes (routes.get("path", use: handler))
-	routes = append(routes, p.extractControllerRoutes(src, filePath, groupMap)...)
+// run walks every entry point: functions taking a router that no other
+// code calls, then route collections that are never registered.
+func (e *extractor) run() {
+	called := make(map[string]bool)
+	registered := make(map[string]bool)
+	for _, pf := range e.files {
+		e.swiftParser.WalkNodes(pf.RootNode, func(node *sitter.Node) bool {
+			if node.Type() != "call_expression" || node.NamedChildCount() == 0 {
+				return true
+			}
+			callee := node.NamedChild(0)
+			if callee.Type() == "simple_identifier" {
+				called[callee.Content(pf.Content)] = true
+			}
+			if e.methodName(callee, pf.Content) == "register" {
+				if name := e.collectionArgument(node, pf.Content); name != "" {
+					registered[name] = true
+				}
+			}
+			return true
+		})
+	}
 
-	// Extract simple routes (app.get("users"))
-	routes = append(routes, p.extractSimpleRoutes(src, filePath, groupMap)...)
+	for _, pf := range e.files {
+		for i := range pf.Functions {
+			fn := &pf.Functions[i]
+			if called[fn.Name] {
+				continue
+			}
+			env := make(map[string]string)
+			for _, param := range fn.Parameters {
+				if routerTypes[param.Type] {
+					env[param.Name] = ""
+				}
+			}
+			if len(env) > 0 {
+				e.walkBody(fn.Node.ChildByFieldName("body"), env, "", pf)
+			}
+		}
+	}
 
-	return routes
+	for _, pf := range e.files {
+		for _, s := range pf.Structs {
+			if !registered[s.Name] {
+				e.walkCollection(s.Name, "")
+			}
+		}
+	}
 }
 
-// extractRouteGroups extracts route group definitions.
-func (p *Plugin) extractRouteGroups(src string) map[string]string {
-	groupMap := make(map[string]string)
+// walkCollection walks the boot(routes:) method of a route collection.
+func (e *extractor) walkCollection(name, prefix string) {
+	if e.active[name] {
+		return
+	}
+	e.active[name] = true
+	defer delete(e.active, name)
 
-	matches := vaporRouteGroupRegex.FindAllStringSubmatch(src, -1)
-	for _, match := range matches {
-		if len(match) >= 4 {
-			groupName := match[1]
-			groupPrefix := match[3]
-			groupMap[groupName] = groupPrefix
+	for _, d := range e.types[name] {
+		for _, method := range d.decl.Methods {
+			if method.Name != "boot" || len(method.Parameters) != 1 || !routerTypes[method.Parameters[0].Type] {
+				continue
+			}
+			e.walkBody(method.Node.ChildByFieldName("body"), map[string]string{method.Parameters[0].Name: prefix}, name, d.file)
 		}
 	}
-
-	return groupMap
 }
 
-// extractGroupedRoutes extracts routes with inline grouping.
-func (p *Plugin) extractGroupedRoutes(src, filePath string) []types.Route {
-	var routes []types.Route
+// walkFunction walks a function called with router arguments.
+func (e *extractor) walkFunction(call *sitter.Node, name string, env map[string]string, content []byte) {
+	f, ok := e.functions[name]
+	if !ok || e.active["func "+name] {
+		return
+	}
 
-	matches := vaporGroupedRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 10 {
-			continue
+	inner := make(map[string]string)
+	for i, arg := range callArguments(call, content) {
+		if i >= len(f.fn.Parameters) {
+			break
+		}
+		if prefix, ok := e.resolveRouter(arg.value, env, content); ok {
+			inner[f.fn.Parameters[i].Name] = prefix
 		}
+	}
+	if len(inner) == 0 {
+		return
+	}
 
-		line := countLines(src[:match[0]])
+	e.active["func "+name] = true
+	defer delete(e.active, "func "+name)
+	e.walkBody(f.fn.Node.ChildByFieldName("body"), inner, "", f.file)
+}
 
-		route := types.Route{
-			SourceFile: filePath,
-			SourceLine: line,
+// walkBody walks a function or closure body, tracking router variables in
+// env and recording the routes registered on them.
+func (e *extractor) walkBody(node *sitter.Node, env map[string]string, owner string, pf *parser.ParsedSwiftFile) {
+	content := pf.Content
+	e.swiftParser.WalkNodes(node, func(n *sitter.Node) bool {
+		switch n.Type() {
+		case "function_declaration":
+			// Nested functions run only when called
+			return false
+		case "property_declaration":
+			pattern := n.ChildByFieldName("name")
+			value := n.ChildByFieldName("value")
+			if pattern == nil || value == nil {
+				return true
+			}
+			if prefix, ok := e.resolveRouter(value, env, content); ok {
+				if id := pattern.ChildByFieldName("bound_identifier"); id != nil {
+					env[id.Content(content)] = prefix
+				}
+			}
+			return true
+		case "call_expression":
+			return e.handleCall(n, env, owner, pf)
 		}
+		return true
+	})
+}
 
-		// Extract group prefix (group 2)
-		var groupPrefix string
-		if match[4] >= 0 && match[5] >= 0 {
-			groupPrefix = src[match[4]:match[5]]
-		}
+// handleCall records the route, group, collection or router function
+// registered by a call. It reports whether the call's children still need
+// to be walked.
+func (e *extractor) handleCall(call *sitter.Node, env map[string]string, owner string, pf *parser.ParsedSwiftFile) bool {
+	content := pf.Content
+	callee := call.NamedChild(0)
+	if callee == nil {
+		return true
+	}
 
-		// Extract HTTP method (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			route.Method = strings.ToUpper(src[match[6]:match[7]])
-		}
+	if callee.Type() == "simple_identifier" {
+		e.walkFunction(call, callee.Content(content), env, content)
+		return true
+	}
 
-		// Extract path segments (group 4)
-		if match[8] >= 0 && match[9] >= 0 {
-			pathStr := src[match[8]:match[9]]
-			route.Path = buildPath(groupPrefix, pathStr)
-		} else {
-			route.Path = "/" + groupPrefix
-		}
+	if callee.Type() != "navigation_expression" {
+		return true
+	}
+	prefix, ok := e.resolveRouter(callee.ChildByFieldName("target"), env, content)
+	if !ok {
+		return true
+	}
 
-		// Extract parameters from path
-		route.Parameters = extractPathParameters(route.Path)
+	args := callArguments(call, content)
+	method := e.methodName(callee, content)
+	switch method {
+	case "group":
+		lambda := trailingClosure(call)
+		if lambda == nil {
+			return true
+		}
+		inner := make(map[string]string, len(env)+1)
+		for k, v := range env {
+			inner[k] = v
+		}
+		for _, name := range closureParameters(lambda, content) {
+			inner[name] = groupPrefix(prefix, pathArguments(args, content))
+		}
+		e.walkBody(lambda, inner, owner, pf)
+		return false
+	case "register":
+		if name := e.collectionArgument(call, content); name != "" {
+			e.walkCollection(name, prefix)
+		}
+		return false
+	case "on":
+		if len(args) == 0 {
+			return true
+		}
+		verb := strings.TrimPrefix(args[0].value.Content(content), ".")
+		verb = strings.TrimPrefix(verb, "HTTPMethod.")
+		e.addRoute(call, strings.ToUpper(verb), prefix, args[1:], owner, pf)
+		return true
+	}
 
-		// Generate operation ID
-		route.OperationID = generateOperationID(route.Method, route.Path, route.Handler)
+	if httpMethod, ok := httpMethods[method]; ok {
+		e.addRoute(call, httpMethod, prefix, args, owner, pf)
+	}
+	return true
+}
 
-		// Infer tags
-		route.Tags = inferTags(route.Path)
+// addRoute records a route registered by call.
+func (e *extractor) addRoute(call *sitter.Node, httpMethod, prefix string, args []argument, owner string, pf *parser.ParsedSwiftFile) {
+	content := pf.Content
+	route := types.Route{
+		Method:     httpMethod,
+		Path:       buildPath(prefix, pathArguments(args, content)),
+		SourceFile: pf.Path,
+		SourceLine: int(call.StartPoint().Row) + 1,
+	}
 
-		if route.Path != "" {
-			routes = append(routes, route)
+	// The handler is either named by use: or a trailing closure
+	var body *sitter.Node
+	var bodyContent []byte
+	var returnType string
+	for _, arg := range args {
+		if arg.label == "use" {
+			route.Handler = handlerName(arg.value, content)
+			if fn, file := e.findHandler(arg.value, owner, content); fn != nil {
+				body, bodyContent, returnType = fn.Node, file.Content, fn.ReturnType
+			}
+		}
+	}
+	if lambda := trailingClosure(call); lambda != nil && route.Handler == "" {
+		body, bodyContent = lambda, content
+		if signature := lambda.ChildByFieldName("type"); signature != nil {
+			returnType = parser.SwiftReturnType(signature, content)
 		}
 	}
 
-	return routes
+	route.Parameters = extractPathParameters(route.Path)
+	route.OperationID = generateOperationID(route.Method, route.Path, route.Handler)
+	route.Tags = inferTags(route.Path)
+	if body != nil {
+		route.RequestBody = e.requestBody(body, bodyContent)
+	}
+	route.Responses = responses(returnType, e.content)
+
+	e.routes = append(e.routes, route)
 }
 
-// extractControllerRoutes extracts routes from controller definitions.
-func (p *Plugin) extractControllerRoutes(src, filePath string, groupMap map[string]string) []types.Route {
-	var routes []types.Route
+// resolveRouter reports the path prefix of a router expression.
+func (e *extractor) resolveRouter(node *sitter.Node, env map[string]string, content []byte) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	switch node.Type() {
+	case "simple_identifier":
+		prefix, ok := env[node.Content(content)]
+		return prefix, ok
+	case "try_expression", "await_expression":
+		return e.resolveRouter(node.ChildByFieldName("expr"), env, content)
+	case "navigation_expression":
+		// app.routes is the application's RoutesBuilder
+		if e.methodName(node, content) == "routes" {
+			return e.resolveRouter(node.ChildByFieldName("target"), env, content)
+		}
+	case "call_expression":
+		callee := node.NamedChild(0)
+		if callee == nil || callee.Type() != "navigation_expression" || e.methodName(callee, content) != "grouped" {
+			return "", false
+		}
+		prefix, ok := e.resolveRouter(callee.ChildByFieldName("target"), env, content)
+		if !ok {
+			return "", false
+		}
+		return groupPrefix(prefix, pathArguments(callArguments(node, content), content)), true
+	}
+	return "", false
+}
 
-	matches := vaporControllerRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 10 {
+// methodName returns the member name of a navigation expression such as
+// app.get.
+func (e *extractor) methodName(node *sitter.Node, content []byte) string {
+	if node == nil || node.Type() != "navigation_expression" {
+		return ""
+	}
+	suffix := node.ChildByFieldName("suffix")
+	if suffix == nil {
+		return ""
+	}
+	if name := suffix.ChildByFieldName("suffix"); name != nil {
+		return name.Content(content)
+	}
+	return ""
+}
+
+// collectionArgument returns the type name in register(collection: T()).
+func (e *extractor) collectionArgument(call *sitter.Node, content []byte) string {
+	for _, arg := range callArguments(call, content) {
+		if arg.label != "collection" {
 			continue
 		}
+		value := arg.value
+		if value.Type() == "call_expression" && value.NamedChildCount() > 0 {
+			value = value.NamedChild(0)
+		}
+		if value.Type() == "simple_identifier" {
+			return value.Content(content)
+		}
+	}
+	return ""
+}
 
-		line := countLines(src[:match[0]])
+// findHandler resolves the function named by a use: argument, looking in
+// the named or enclosing type first and in top-level functions next. It
+// also returns the file declaring the function.
+func (e *extractor) findHandler(value *sitter.Node, owner string, content []byte) (*parser.SwiftFunction, *parser.ParsedSwiftFile) {
+	name := handlerName(value, content)
+	if name == "" {
+		return nil, nil
+	}
 
-		route := types.Route{
-			SourceFile: filePath,
-			SourceLine: line,
+	if value.Type() == "navigation_expression" {
+		if target := value.ChildByFieldName("target"); target != nil {
+			if target.Type() == "call_expression" && target.NamedChildCount() > 0 {
+				target = target.NamedChild(0)
+			}
+			if typeName := target.Content(content); e.types[typeName] != nil {
+				owner = typeName
+			}
 		}
+	}
 
-		// Extract router variable name (group 1)
-		var routerName string
-		if match[2] >= 0 && match[3] >= 0 {
-			routerName = src[match[2]:match[3]]
+	for _, d := range e.types[owner] {
+		for i := range d.decl.Methods {
+			if d.decl.Methods[i].Name == name {
+				return &d.decl.Methods[i], d.file
+			}
 		}
+	}
+	if f, ok := e.functions[name]; ok {
+		return f.fn, f.file
+	}
+	return nil, nil
+}
 
-		// Extract HTTP method (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			route.Method = strings.ToUpper(src[match[4]:match[5]])
+// requestBody infers the request body from req.content.decode(T.self).
+func (e *extractor) requestBody(body *sitter.Node, content []byte) *types.RequestBody {
+	var decoded string
+	e.swiftParser.WalkNodes(body, func(n *sitter.Node) bool {
+		if decoded != "" {
+			return false
 		}
-
-		// Extract path segments (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			pathStr := src[match[6]:match[7]]
-			prefix := groupMap[routerName]
-			route.Path = buildPath(prefix, pathStr)
+		if n.Type() != "call_expression" || n.NamedChildCount() == 0 {
+			return true
 		}
-
-		// Extract handler name (group 4)
-		if match[8] >= 0 && match[9] >= 0 {
-			route.Handler = src[match[8]:match[9]]
+		callee := n.NamedChild(0)
+		if e.methodName(callee, content) != "decode" || e.methodName(callee.ChildByFieldName("target"), content) != "content" {
+			return true
 		}
-
-		// Extract parameters from path
-		route.Parameters = extractPathParameters(route.Path)
-
-		// Generate operation ID
-		route.OperationID = generateOperationID(route.Method, route.Path, route.Handler)
-
-		// Infer tags
-		route.Tags = inferTags(route.Path)
-
-		if route.Path != "" {
-			routes = append(routes, route)
+		if args := callArguments(n, content); len(args) > 0 {
+			decoded = strings.TrimSuffix(args[0].value.Content(content), ".self")
 		}
+		return false
+	})
+	if decoded == "" {
+		return nil
 	}
 
-	return routes
+	return &types.RequestBody{
+		Required: true,
+		Content: map[string]types.MediaType{
+			"application/json": {Schema: typeSchema(decoded, e.content)},
+		},
+	}
 }
 
-// extractSimpleRoutes extracts simple route definitions.
-func (p *Plugin) extractSimpleRoutes(src, filePath string, groupMap map[string]string) []types.Route {
-	var routes []types.Route
+// argument is a call argument with its optional label.
+type argument struct {
+	label string
+	value *sitter.Node
+}
 
-	matches := vaporRouteRegex.FindAllStringSubmatchIndex(src, -1)
-	for _, match := range matches {
-		if len(match) < 8 {
+// callArguments returns the arguments of a call expression, excluding
+// trailing closures.
+func callArguments(call *sitter.Node, content []byte) []argument {
+	suffix := callSuffix(call)
+	if suffix == nil {
+		return nil
+	}
+
+	var args []argument
+	for i := 0; i < int(suffix.NamedChildCount()); i++ {
+		list := suffix.NamedChild(i)
+		if list.Type() != "value_arguments" {
 			continue
 		}
+		for j := 0; j < int(list.NamedChildCount()); j++ {
+			arg := list.NamedChild(j)
+			if arg.Type() != "value_argument" {
+				continue
+			}
+			a := argument{value: arg.ChildByFieldName("value")}
+			if label := arg.ChildByFieldName("name"); label != nil {
+				a.label = label.Content(content)
+			}
+			if a.value != nil {
+				args = append(args, a)
+			}
+		}
+	}
+	return args
+}
 
-		line := countLines(src[:match[0]])
-
-		route := types.Route{
-			SourceFile: filePath,
-			SourceLine: line,
+// callSuffix returns the argument list and trailing closures of a call.
+func callSuffix(call *sitter.Node) *sitter.Node {
+	for i := int(call.NamedChildCount()) - 1; i >= 0; i-- {
+		if child := call.NamedChild(i); child.Type() == "call_suffix" {
+			return child
 		}
+	}
+	return nil
+}
 
-		// Extract router variable name (group 1)
-		var routerName string
-		if match[2] >= 0 && match[3] >= 0 {
-			routerName = src[match[2]:match[3]]
+// trailingClosure returns the trailing closure of a call, if any.
+func trailingClosure(call *sitter.Node) *sitter.Node {
+	suffix := callSuffix(call)
+	if suffix == nil {
+		return nil
+	}
+	for i := 0; i < int(suffix.NamedChildCount()); i++ {
+		if child := suffix.NamedChild(i); child.Type() == "lambda_literal" {
+			return child
 		}
+	}
+	return nil
+}
 
-		// Skip if this looks like a grouped route (already handled)
-		startIdx := match[0] - 50
-		if startIdx < 0 {
-			startIdx = 0
+// closureParameters returns the parameter names of a closure.
+func closureParameters(lambda *sitter.Node, content []byte) []string {
+	var names []string
+	signature := lambda.ChildByFieldName("type")
+	if signature == nil {
+		return nil
+	}
+	parser.Walk(signature, func(n *sitter.Node) bool {
+		if n.Type() == "lambda_parameter" {
+			if name := n.ChildByFieldName("name"); name != nil {
+				names = append(names, name.Content(content))
+			}
+			return false
 		}
-		if strings.Contains(src[startIdx:match[0]], ".grouped") {
+		return true
+	})
+	return names
+}
+
+// pathArguments returns the source of the unlabeled string and string
+// array arguments of a call, which Vapor treats as path components.
+func pathArguments(args []argument, content []byte) string {
+	var parts []string
+	for _, arg := range args {
+		if arg.label != "" {
 			continue
 		}
+		switch arg.value.Type() {
+		case "line_string_literal":
+			if isPlainString(arg.value) {
+				parts = append(parts, arg.value.Content(content))
+			}
+		case "array_literal":
+			for i := 0; i < int(arg.value.NamedChildCount()); i++ {
+				if el := arg.value.NamedChild(i); el.Type() == "line_string_literal" && isPlainString(el) {
+					parts = append(parts, el.Content(content))
+				}
+			}
+		}
+	}
+	return strings.Join(parts, ", ")
+}
 
-		// Extract HTTP method (group 2)
-		if match[4] >= 0 && match[5] >= 0 {
-			route.Method = strings.ToUpper(src[match[4]:match[5]])
+// isPlainString reports whether a string literal has no interpolation.
+func isPlainString(node *sitter.Node) bool {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if node.NamedChild(i).Type() == "interpolated_expression" {
+			return false
 		}
+	}
+	return true
+}
 
-		// Extract path segments (group 3)
-		if match[6] >= 0 && match[7] >= 0 {
-			pathStr := src[match[6]:match[7]]
-			prefix := groupMap[routerName]
-			route.Path = buildPath(prefix, pathStr)
+// groupPrefix appends path components to a group prefix.
+func groupPrefix(prefix, pathStr string) string {
+	return strings.TrimPrefix(buildPath(prefix, pathStr), "/")
+}
+
+// handlerName returns the function named by a use: argument, such as
+// index for use: index or use: self.index.
+func handlerName(value *sitter.Node, content []byte) string {
+	switch value.Type() {
+	case "simple_identifier":
+		return value.Content(content)
+	case "navigation_expression":
+		if suffix := value.ChildByFieldName("suffix"); suffix != nil {
+			if name := suffix.ChildByFieldName("suffix"); name != nil {
+				return name.Content(content)
+			}
 		}
+	}
+	return ""
+}
 
-		// Extract parameters from path
-		route.Parameters = extractPathParameters(route.Path)
+// responses infers the success response from a handler's return type.
+func responses(returnType string, content map[string]bool) map[string]types.Response {
+	returnType = strings.TrimSpace(returnType)
+	for _, wrapper := range []string{"EventLoopFuture<", "Future<"} {
+		if strings.HasPrefix(returnType, wrapper) && strings.HasSuffix(returnType, ">") {
+			returnType = strings.TrimSuffix(strings.TrimPrefix(returnType, wrapper), ">")
+		}
+	}
 
-		// Generate operation ID
-		route.OperationID = generateOperationID(route.Method, route.Path, route.Handler)
+	switch {
+	case returnType == "", returnType == "Response", returnType == "HTTPStatus", returnType == "HTTPResponseStatus",
+		returnType == "View", returnType == "Void", strings.HasPrefix(returnType, "some "), strings.HasPrefix(returnType, "any "):
+		return nil
+	case returnType == "String":
+		return map[string]types.Response{
+			"200": {
+				Description: "OK",
+				Content: map[string]types.MediaType{
+					"text/plain": {Schema: &types.Schema{Type: "string"}},
+				},
+			},
+		}
+	}
 
-		// Infer tags
-		route.Tags = inferTags(route.Path)
+	return map[string]types.Response{
+		"200": {
+			Description: "OK",
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: typeSchema(returnType, content)},
+			},
+		},
+	}
+}
+
+// typeSchema converts a Swift type to a schema, referencing Content types.
+func typeSchema(swiftType string, content map[string]bool) *types.Schema {
+	swiftType = strings.TrimRight(strings.TrimSpace(swiftType), "?!")
 
-		if route.Path != "" {
-			routes = append(routes, route)
+	if strings.HasPrefix(swiftType, "[") && strings.HasSuffix(swiftType, "]") {
+		inner := swiftType[1 : len(swiftType)-1]
+		if key, value, ok := strings.Cut(inner, ":"); ok && !strings.ContainsAny(key, "[<") {
+			return &types.Schema{Type: "object", AdditionalProperties: typeSchema(value, content)}
 		}
+		return &types.Schema{Type: "array", Items: typeSchema(inner, content)}
+	}
+
+	if mapped, ok := typemap.Schema(swiftType); ok {
+		return mapped
+	}
+	if content[swiftType] {
+		return &types.Schema{Ref: "#/components/schemas/" + swiftType}
 	}
 
-	return routes
+	openAPIType, format := parser.SwiftTypeToOpenAPI(swiftType)
+	return &types.Schema{Type: openAPIType, Format: format}
 }
 
+// contentTypes returns the names of the types declared to conform to
+// Content, directly or through an extension.
+func contentTypes(files []*parser.ParsedSwiftFile) map[string]bool {
+	content := make(map[string]bool)
+	for _, pf := range files {
+		for _, s := range pf.Structs {
+			if s.ConformsToContent {
+				content[s.Name] = true
+			}
+		}
+	}
+	return content
+}
+
+// Regex patterns for Vapor path components
+var (
+	// Matches path segments in route definitions
+	vaporPathSegmentRegex = regexp.MustCompile(`"([^"]+)"`)
+
+	// Matches path parameters like ":id", ":userId"
+	vaporPathParamRegex = regexp.MustCompile(`:(\w+)`)
+
+	// Matches path parameter in brace format
+	braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+)
+
 // buildPath builds a complete path from a prefix and path segments.
 func buildPath(prefix, pathStr string) string {
 	var segments []string
@@ -469,35 +924,45 @@ func countLines(s string) int {
 	return strings.Count(s, "\n") + 1
 }
 
-// ExtractSchemas extracts schema definitions from Swift structs conforming to Content.
+// ExtractSchemas extracts schema definitions from Swift types conforming to
+// Content, either in their declaration or through an extension.
 func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
-	var schemas []types.Schema
+	return p.ExtractSchemasContext(context.Background(), files)
+}
 
-	for _, file := range files {
-		if file.Language != "swift" {
-			continue
-		}
+// ExtractSchemasContext is like ExtractSchemas, but stops once ctx is done.
+func (p *Plugin) ExtractSchemasContext(ctx context.Context, files []scanner.SourceFile) ([]types.Schema, error) {
+	parsed := p.parseFiles(ctx, files)
+	defer closeFiles(parsed)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-		pf := p.swiftParser.Parse(file.Path, file.Content)
+	content := contentTypes(parsed)
+	seen := make(map[string]bool)
 
+	var schemas []types.Schema
+	for _, pf := range parsed {
 		for _, swiftStruct := range pf.Structs {
-			// Only include structs that conform to Content protocol
-			if !swiftStruct.ConformsToContent {
+			if !content[swiftStruct.Name] || seen[swiftStruct.Name] {
 				continue
 			}
-
-			schema := p.structToSchema(swiftStruct)
-			if schema != nil {
-				schemas = append(schemas, *schema)
+			if swiftStruct.Kind != "struct" && swiftStruct.Kind != "class" && swiftStruct.Kind != "actor" {
+				continue
 			}
+			seen[swiftStruct.Name] = true
+
+			schemas = append(schemas, *p.structToSchema(swiftStruct, content))
 		}
 	}
 
 	return schemas, nil
 }
 
-// structToSchema converts a Swift struct to an OpenAPI schema.
-func (p *Plugin) structToSchema(swiftStruct parser.SwiftStruct) *types.Schema {
+// structToSchema converts a Swift struct to an OpenAPI schema. Fluent
+// @Children and @Siblings relations are only encoded when eager loaded and
+// are left out.
+func (p *Plugin) structToSchema(swiftStruct parser.SwiftStruct, content map[string]bool) *types.Schema {
 	schema := &types.Schema{
 		Title:      swiftStruct.Name,
 		Type:       "object",
@@ -506,12 +971,12 @@ func (p *Plugin) structToSchema(swiftStruct parser.SwiftStruct) *types.Schema {
 	}
 
 	for _, field := range swiftStruct.Fields {
-		openAPIType, format := parser.SwiftTypeToOpenAPI(field.Type)
-		propSchema := &types.Schema{
-			Type:   openAPIType,
-			Format: format,
+		if field.Wrapper == "Children" || field.Wrapper == "Siblings" {
+			continue
 		}
 
+		propSchema := typeSchema(field.Type, content)
+
 		// Handle nullable/optional fields
 		if field.IsOptional {
 			propSchema.Nullable = true
@@ -605,6 +605,191 @@ func TestPlugin_ExtractSchemas_ContentStructs(t *testing.T) {
 	}
 }
 
+// vaporConfigureCode registers a collection under a group and calls a
+// routes function, as generated by the Vapor template.
+const vaporConfigureCode = `
+import Vapor
+
+public func configure(_ app: Application) async throws {
+    try routes(app)
+}
+
+func routes(_ app: Application) throws {
+    app.get { req async in
+        "It works!"
+    }
+
+    let api = app.grouped("api", "v1")
+    try api.register(collection: TodoController())
+
+    app.group("admin") { admin in
+        admin.on(.GET, "stats", use: stats)
+    }
+}
+
+func stats(req: Request) async throws -> [String: Int] {
+    return [:]
+}
+`
+
+// vaporTodoControllerCode is a route collection declared in its own file.
+const vaporTodoControllerCode = `
+import Fluent
+import Vapor
+
+struct TodoController: RouteCollection {
+    func boot(routes: RoutesBuilder) throws {
+        let todos = routes.grouped("todos")
+        todos.get(use: self.index)
+        todos.post(use: create)
+        todos.group(":todoID") { todo in
+            todo.get(use: show)
+            todo.delete(use: delete)
+        }
+        todos.get(["search", ":term"]) { req -> EventLoopFuture<[TodoDTO]> in
+            return search(req)
+        }
+    }
+
+    func index(req: Request) async throws -> [TodoDTO] {
+        try await Todo.query(on: req.db).all().map { $0.toDTO() }
+    }
+
+    func create(req: Request) async throws -> TodoDTO {
+        let todo = try req.content.decode(TodoDTO.self).toModel()
+        try await todo.save(on: req.db)
+        return todo.toDTO()
+    }
+
+    func show(req: Request) async throws -> TodoDTO {
+        throw Abort(.notFound)
+    }
+
+    func delete(req: Request) async throws -> HTTPStatus {
+        return .noContent
+    }
+}
+
+struct TodoDTO: Content {
+    var id: UUID?
+    var title: String
+}
+`
+
+func TestPlugin_ExtractRoutes_Collections(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "Sources/App/configure.swift", Language: "swift", Content: []byte(vaporConfigureCode)},
+		{Path: "Sources/App/Controllers/TodoController.swift", Language: "swift", Content: []byte(vaporTodoControllerCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	var keys []string
+	for _, r := range routes {
+		keys = append(keys, r.Method+" "+r.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"GET /",
+		"GET /api/v1/todos",
+		"POST /api/v1/todos",
+		"GET /api/v1/todos/{todoID}",
+		"DELETE /api/v1/todos/{todoID}",
+		"GET /api/v1/todos/search/{term}",
+		"GET /admin/stats",
+	}, keys)
+
+	root := findRoute(routes, "GET", "/")
+	require.NotNil(t, root)
+	assert.Equal(t, "get", root.OperationID)
+	assert.Nil(t, root.Responses, "closures without a declared return type have no inferred response")
+
+	index := findRoute(routes, "GET", "/api/v1/todos")
+	require.NotNil(t, index)
+	assert.Equal(t, "index", index.Handler)
+	assert.Equal(t, "Sources/App/Controllers/TodoController.swift", index.SourceFile)
+	assert.Equal(t, 8, index.SourceLine)
+	listSchema := index.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "array", listSchema.Type)
+	assert.Equal(t, "#/components/schemas/TodoDTO", listSchema.Items.Ref)
+
+	create := findRoute(routes, "POST", "/api/v1/todos")
+	require.NotNil(t, create)
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/TodoDTO", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	del := findRoute(routes, "DELETE", "/api/v1/todos/{todoID}")
+	require.NotNil(t, del)
+	assert.Nil(t, del.Responses)
+
+	search := findRoute(routes, "GET", "/api/v1/todos/search/{term}")
+	require.NotNil(t, search)
+	assert.Equal(t, "#/components/schemas/TodoDTO", search.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+	stats := findRoute(routes, "GET", "/admin/stats")
+	require.NotNil(t, stats)
+	assert.Equal(t, "stats", stats.Handler)
+	assert.Equal(t, "integer", stats.Responses["200"].Content["application/json"].Schema.AdditionalProperties.Type)
+}
+
+func TestPlugin_ExtractRoutes_IgnoresNonRouters(t *testing.T) {
+	code := `
+import Vapor
+
+func routes(_ app: Application) throws {
+    let client = HTTPClient()
+    client.get("https://example.com")
+    app.get("ok") { req -> String in "ok" }
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "routes.swift", Language: "swift", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "/ok", routes[0].Path)
+	assert.Contains(t, routes[0].Responses["200"].Content, "text/plain")
+}
+
+func TestPlugin_ExtractSchemas_FluentModels(t *testing.T) {
+	code := `
+import Fluent
+import Vapor
+
+final class Todo: Model, @unchecked Sendable {
+    static let schema = "todos"
+
+    @ID(key: .id) var id: UUID?
+    @Field(key: "title") var title: String
+    @Parent(key: "owner_id") var owner: Owner
+    @Children(for: \.$todo) var tags: [Tag]
+
+    var isDone: Bool { false }
+}
+
+extension Todo: Content {}
+
+struct Owner: Content {
+    var name: String
+}
+`
+	schemas, err := New().ExtractSchemas([]scanner.SourceFile{
+		{Path: "Models/Todo.swift", Language: "swift", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	todo := schemas[0]
+	assert.Equal(t, "Todo", todo.Title)
+	assert.Len(t, todo.Properties, 3)
+	assert.Equal(t, []string{"title", "owner"}, todo.Required)
+	assert.Equal(t, "#/components/schemas/Owner", todo.Properties["owner"].Ref)
+	assert.NotContains(t, todo.Properties, "tags")
+	assert.NotContains(t, todo.Properties, "isDone")
+}
+
 func TestGenerateOperationID(t *testing.T) {
 	tests := []struct {
 		method   string
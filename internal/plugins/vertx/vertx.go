@@ -0,0 +1,604 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package vertx provides a plugin for extracting routes from Vert.x Web applications.
+package vertx
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Plugin implements the FrameworkPlugin interface for Vert.x Web.
+type Plugin struct {
+	javaParser   *parser.JavaParser
+	kotlinParser *parser.KotlinParser
+}
+
+// New creates a new Vert.x plugin instance.
+func New() *Plugin {
+	return &Plugin{
+		javaParser:   parser.NewJavaParser(),
+		kotlinParser: parser.NewKotlinParser(),
+	}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "vertx"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".java", ".kt"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "vertx",
+		Version:     "1.0.0",
+		Description: "Extracts routes from Vert.x Web routers",
+		SupportedFrameworks: []string{
+			"io.vertx",
+			"Vert.x Web",
+		},
+	}
+}
+
+// Detect checks if Vert.x Web is used in the project.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	for _, name := range []string{"pom.xml", "build.gradle", "build.gradle.kts"} {
+		if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, name), "vertx-web"); found {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	for scanr.Scan() {
+		if strings.Contains(scanr.Text(), dep) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Regex patterns for Vert.x route extraction
+var (
+	// Matches router.get("/path")
+	verbRouteRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*(get|post|put|patch|delete|head|options)\s*\(\s*"([^"]*)"\s*\)`)
+
+	// Matches router.route(HttpMethod.GET, "/path")
+	methodRouteRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*route\s*\(\s*HttpMethod\.([A-Z]+)\s*,\s*"([^"]*)"\s*\)`)
+
+	// Matches router.route("/path") followed by .method(HttpMethod.X) calls
+	pathRouteRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*route\s*\(\s*"([^"]*)"\s*\)`)
+
+	// Matches .method(HttpMethod.POST) in a route chain
+	chainMethodRegex = regexp.MustCompile(`\.\s*method\s*\(\s*HttpMethod\.([A-Z]+)\s*\)`)
+
+	// Matches the handler registration that makes a chain a route
+	chainHandlerRegex = regexp.MustCompile(`\.\s*(?:handler|blockingHandler|coHandler|respond)\s*[({]`)
+
+	// Matches router.route("/api/*").subRouter(apiRouter)
+	subRouterRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*route\s*\(\s*"([^"]*)"\s*\)\s*\.\s*subRouter\s*\(\s*(\w+)\s*\)`)
+
+	// Matches router.mountSubRouter("/api", apiRouter) from Vert.x 3
+	mountSubRouterRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*mountSubRouter\s*\(\s*"([^"]*)"\s*,\s*(\w+)\s*\)`)
+
+	// Matches a method reference handler such as this::getUser
+	methodRefRegex = regexp.MustCompile(`([\w.]*)::(\w+)`)
+
+	// Matches ctx.body().asPojo(User.class), json.mapTo(User.class) and
+	// Json.decodeValue(ctx.body().asString(), User.class)
+	bodyClassRegex = regexp.MustCompile(`\b(?:asPojo|mapTo|decodeValue)\s*\([^;]*?(\w+)\s*(?:\.class|::class\.java)`)
+
+	// Matches :param path parameters
+	colonParamRegex = regexp.MustCompile(`:([a-zA-Z_][a-zA-Z0-9_]*)`)
+)
+
+// source is a normalized source file.
+type source struct {
+	path    string
+	content string
+}
+
+// mount records that a router variable is mounted below a prefix of another.
+type mount struct {
+	parent string
+	prefix string
+}
+
+// ExtractRoutes parses source files and extracts Vert.x route definitions.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var sources []source
+	for _, file := range files {
+		if file.Language != "java" && file.Language != "kotlin" {
+			continue
+		}
+		sources = append(sources, source{
+			path:    file.Path,
+			content: util.NormalizeNewlines(string(file.Content)),
+		})
+	}
+
+	var routes []types.Route
+	for _, src := range sources {
+		routes = append(routes, p.extractRoutesFromContent(src, sources)...)
+	}
+
+	return routes, nil
+}
+
+// extractRoutesFromContent extracts the routes of one file, prefixing routes
+// of sub-routers with the path they are mounted at in the same file.
+func (p *Plugin) extractRoutesFromContent(src source, sources []source) []types.Route {
+	var routes []types.Route
+	content := src.content
+
+	mounts := make(map[string]mount)
+	for _, re := range []*regexp.Regexp{subRouterRegex, mountSubRouterRegex} {
+		for _, match := range re.FindAllStringSubmatch(content, -1) {
+			mounts[match[3]] = mount{parent: match[1], prefix: match[2]}
+		}
+	}
+
+	type found struct {
+		pos     int
+		methods []string
+		router  string
+		path    string
+		chain   string
+	}
+	var candidates []found
+
+	for _, match := range verbRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		candidates = append(candidates, found{
+			pos:     match[0],
+			methods: []string{strings.ToUpper(content[match[4]:match[5]])},
+			router:  content[match[2]:match[3]],
+			path:    content[match[6]:match[7]],
+			chain:   content[match[1]:statementEnd(content, match[1])],
+		})
+	}
+	for _, match := range methodRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		candidates = append(candidates, found{
+			pos:     match[0],
+			methods: []string{content[match[4]:match[5]]},
+			router:  content[match[2]:match[3]],
+			path:    content[match[6]:match[7]],
+			chain:   content[match[1]:statementEnd(content, match[1])],
+		})
+	}
+	for _, match := range pathRouteRegex.FindAllStringSubmatchIndex(content, -1) {
+		chain := content[match[1]:statementEnd(content, match[1])]
+		var methods []string
+		for _, m := range chainMethodRegex.FindAllStringSubmatch(chain, -1) {
+			methods = append(methods, m[1])
+		}
+		// route("/path") without .method() matches every method; it is
+		// usually middleware such as BodyHandler, so it is not a route
+		if len(methods) == 0 {
+			continue
+		}
+		candidates = append(candidates, found{
+			pos:     match[0],
+			methods: methods,
+			router:  content[match[2]:match[3]],
+			path:    content[match[4]:match[5]],
+			chain:   chain,
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].pos < candidates[j].pos
+	})
+
+	for _, c := range candidates {
+		// A handler is what makes a route; this skips WebClient calls
+		loc := chainHandlerRegex.FindStringIndex(c.chain)
+		if loc == nil {
+			continue
+		}
+		handlerChain := c.chain[loc[0]:]
+
+		handler, body := "", handlerChain
+		if ref := lastMethodRef(handlerChain); ref != nil {
+			owner := ref[1][strings.LastIndex(ref[1], ".")+1:]
+			handler = ref[2]
+			if owner != "" && owner != "this" {
+				handler = owner + "." + ref[2]
+			} else {
+				owner = ""
+			}
+			body = findMethodBody(sources, owner, ref[2])
+		}
+
+		fullPath := joinPaths(routerPrefix(mounts, c.router), c.path)
+		line := countLines(content[:c.pos])
+		for _, method := range c.methods {
+			routes = append(routes, buildRoute(method, fullPath, handler, body, src.path, line))
+		}
+	}
+
+	return routes
+}
+
+// lastMethodRef returns the owner and method of the last method reference
+// in s, ignoring Kotlin class literals such as User::class.
+func lastMethodRef(s string) []string {
+	refs := methodRefRegex.FindAllStringSubmatch(s, -1)
+	for i := len(refs) - 1; i >= 0; i-- {
+		if refs[i][2] != "class" {
+			return refs[i]
+		}
+	}
+	return nil
+}
+
+// routerPrefix resolves the path a router variable is mounted at.
+func routerPrefix(mounts map[string]mount, router string) string {
+	prefix := ""
+	seen := make(map[string]bool)
+	for !seen[router] {
+		seen[router] = true
+		m, ok := mounts[router]
+		if !ok {
+			break
+		}
+		prefix = joinPaths(strings.TrimSuffix(m.prefix, "*"), prefix)
+		router = m.parent
+	}
+	if prefix == "/" {
+		return ""
+	}
+	return prefix
+}
+
+// buildRoute creates a route; body is the handler source used to find the
+// request body class.
+func buildRoute(method, path, handler, body, filePath string, line int) types.Route {
+	fullPath := convertPathParams(path)
+
+	route := types.Route{
+		Method:      method,
+		Path:        fullPath,
+		Handler:     handler,
+		OperationID: generateOperationID(method, fullPath, handler[strings.LastIndex(handler, ".")+1:]),
+		Tags:        inferTags(fullPath),
+		Parameters:  extractPathParams(fullPath),
+		SourceFile:  filePath,
+		SourceLine:  line,
+	}
+
+	if match := bodyClassRegex.FindStringSubmatch(body); match != nil {
+		route.RequestBody = &types.RequestBody{
+			Required: true,
+			Content: map[string]types.MediaType{
+				"application/json": {
+					Schema: &types.Schema{Ref: "#/components/schemas/" + match[1]},
+				},
+			},
+		}
+	}
+
+	return route
+}
+
+// statementEnd returns the end of the statement continuing at pos: the next
+// semicolon outside brackets and strings, or a line break that is not
+// followed by a chained call.
+func statementEnd(content string, pos int) int {
+	depth := 0
+	inString := false
+	for i := pos; i < len(content); i++ {
+		ch := content[i]
+		if ch == '"' && content[i-1] != '\\' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+		switch ch {
+		case '(', '{', '[':
+			depth++
+		case ')', '}', ']':
+			depth--
+			if depth < 0 {
+				return i
+			}
+		case ';':
+			if depth == 0 {
+				return i
+			}
+		case '\n':
+			if depth == 0 && !strings.HasPrefix(strings.TrimLeft(content[i+1:], " \t\n"), ".") {
+				return i
+			}
+		}
+	}
+	return len(content)
+}
+
+// findMethodBody returns the body of the method or handler field named name,
+// looking first in the file declaring class owner.
+func findMethodBody(sources []source, owner, name string) string {
+	methodRegex := regexp.MustCompile(`[\w>\]]\s+` + regexp.QuoteMeta(name) + `\s*(?:\([^)]*\)[^{;=]*\{|=\s*[^;{]*\{)`)
+	ordered := sources
+	if owner != "" {
+		ownerRegex := regexp.MustCompile(`\b(?:class|object)\s+` + regexp.QuoteMeta(owner) + `\b`)
+		ordered = make([]source, 0, len(sources))
+		var rest []source
+		for _, src := range sources {
+			if ownerRegex.MatchString(src.content) {
+				ordered = append(ordered, src)
+			} else {
+				rest = append(rest, src)
+			}
+		}
+		ordered = append(ordered, rest...)
+	}
+	for _, src := range ordered {
+		loc := methodRegex.FindStringIndex(src.content)
+		if loc == nil {
+			continue
+		}
+		if end := matchingBrace(src.content, loc[1]-1); end != -1 {
+			return src.content[loc[1]-1 : end+1]
+		}
+	}
+	return ""
+}
+
+// matchingBrace returns the position of the brace closing the one at pos,
+// skipping string literals, or -1.
+func matchingBrace(content string, pos int) int {
+	depth := 0
+	inString := false
+	for i := pos; i < len(content); i++ {
+		ch := content[i]
+		if ch == '"' && content[i-1] != '\\' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+		switch ch {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// joinPaths joins path segments with single slashes.
+func joinPaths(base, path string) string {
+	base = strings.Trim(base, "/")
+	path = strings.Trim(path, "/")
+	switch {
+	case base == "" && path == "":
+		return "/"
+	case base == "":
+		return "/" + path
+	case path == "":
+		return "/" + base
+	}
+	return "/" + base + "/" + path
+}
+
+// countLines counts the number of lines up to a position.
+func countLines(s string) int {
+	if s == "" {
+		return 1
+	}
+	return strings.Count(s, "\n") + 1
+}
+
+// convertPathParams converts :param to OpenAPI {param}.
+func convertPathParams(path string) string {
+	return colonParamRegex.ReplaceAllString(path, "{$1}")
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// extractPathParams extracts path parameters from a route path.
+func extractPathParams(path string) []types.Parameter {
+	var params []types.Parameter
+
+	for _, match := range braceParamRegex.FindAllStringSubmatch(path, -1) {
+		params = append(params, types.Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema: &types.Schema{
+				Type: "string",
+			},
+		})
+	}
+
+	return params
+}
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(cleanPath)
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		word = titleCaser.String(strings.ToLower(word))
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route path.
+func inferTags(path string) []string {
+	skipPrefixes := map[string]bool{
+		"api": true,
+		"v1":  true,
+		"v2":  true,
+		"v3":  true,
+	}
+
+	for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if part == "" || skipPrefixes[part] || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions from Java records and DTOs and
+// from Kotlin data classes.
+func (p *Plugin) ExtractSchemas(files []scanner.SourceFile) ([]types.Schema, error) {
+	var schemas []types.Schema
+
+	for _, file := range files {
+		switch file.Language {
+		case "java":
+			pf := p.javaParser.Parse(file.Path, file.Content)
+			for _, class := range pf.Classes {
+				if class.IsRecord || isSchemaName(class.Name) {
+					schemas = append(schemas, *javaClassToSchema(class))
+				}
+			}
+		case "kotlin":
+			pf := p.kotlinParser.Parse(file.Path, file.Content)
+			for _, class := range pf.Classes {
+				if isSchemaName(class.Name) {
+					schemas = append(schemas, *kotlinClassToSchema(class))
+				}
+			}
+		}
+	}
+
+	return schemas, nil
+}
+
+// isSchemaName checks if a class name follows DTO naming conventions.
+func isSchemaName(name string) bool {
+	for _, suffix := range []string{"Dto", "DTO", "Request", "Response"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// javaClassToSchema converts a Java record or class to an OpenAPI schema.
+func javaClassToSchema(class parser.JavaClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, field := range class.Fields {
+		openAPIType, format := parser.JavaTypeToOpenAPI(field.Type)
+		schema.Properties[field.Name] = &types.Schema{Type: openAPIType, Format: format}
+		if class.IsRecord {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+// kotlinClassToSchema converts a Kotlin data class to an OpenAPI schema using
+// its primary constructor.
+func kotlinClassToSchema(class parser.KotlinClass) *types.Schema {
+	schema := &types.Schema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*types.Schema),
+		Required:   []string{},
+	}
+
+	for _, fn := range class.Functions {
+		if fn.Name != class.Name {
+			continue
+		}
+		for _, param := range fn.Parameters {
+			openAPIType, format := parser.KotlinTypeToOpenAPI(param.Type)
+			propSchema := &types.Schema{Type: openAPIType, Format: format}
+
+			isOptional := strings.HasSuffix(param.Type, "?")
+			if isOptional {
+				propSchema.Nullable = true
+			}
+			schema.Properties[param.Name] = propSchema
+			if !isOptional && param.Default == "" {
+				schema.Required = append(schema.Required, param.Name)
+			}
+		}
+	}
+
+	return schema
+}
+
+// Register registers the Vert.x plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package vertx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// verticleCode registers routes on a root router and a mounted sub-router.
+const verticleCode = `
+package com.example;
+
+import io.vertx.core.AbstractVerticle;
+import io.vertx.ext.web.Router;
+import io.vertx.ext.web.handler.BodyHandler;
+
+public class MainVerticle extends AbstractVerticle {
+    @Override
+    public void start() {
+        Router router = Router.router(vertx);
+        Router api = Router.router(vertx);
+
+        router.route().handler(BodyHandler.create());
+        router.route("/api/v1/*").subRouter(api);
+
+        router.get("/health").handler(ctx -> ctx.end("ok"));
+
+        api.get("/users/:id").handler(this::getUser);
+        api.post("/users")
+            .handler(ctx -> {
+                CreateUserRequest req = ctx.body().asPojo(CreateUserRequest.class);
+                ctx.json(service.create(req));
+            });
+        api.route(HttpMethod.PUT, "/users/:id").handler(UserHandlers::update);
+        api.route("/users/:id/avatar").method(HttpMethod.PUT).method(HttpMethod.PATCH).handler(this::avatar);
+
+        WebClient client = WebClient.create(vertx);
+        client.get("/remote").send();
+
+        vertx.createHttpServer().requestHandler(router).listen(8080);
+    }
+
+    private void getUser(RoutingContext ctx) {
+        ctx.json(service.find(ctx.pathParam("id")));
+    }
+
+    private void avatar(RoutingContext ctx) {
+        ctx.end();
+    }
+}
+`
+
+// userHandlersCode holds a handler referenced from another file.
+const userHandlersCode = `
+package com.example;
+
+public class UserHandlers {
+    public static void update(RoutingContext ctx) {
+        UpdateUserRequest req = ctx.body().asJsonObject().mapTo(UpdateUserRequest.class);
+        ctx.json(service.update(ctx.pathParam("id"), req));
+    }
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	assert.Equal(t, "vertx", New().Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	dir := t.TempDir()
+	detected, err := New().Detect(dir)
+	require.NoError(t, err)
+	assert.False(t, detected)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pom.xml"), []byte("<artifactId>vertx-web</artifactId>"), 0644))
+	detected, err = New().Detect(dir)
+	require.NoError(t, err)
+	assert.True(t, detected)
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	files := []scanner.SourceFile{
+		{Path: "MainVerticle.java", Language: "java", Content: []byte(verticleCode)},
+		{Path: "UserHandlers.java", Language: "java", Content: []byte(userHandlersCode)},
+	}
+
+	routes, err := New().ExtractRoutes(files)
+	require.NoError(t, err)
+
+	byKey := make(map[string]types.Route)
+	for _, r := range routes {
+		byKey[r.Method+" "+r.Path] = r
+	}
+	assert.Len(t, byKey, 6)
+	assert.NotContains(t, byKey, "GET /remote")
+
+	assert.Contains(t, byKey, "GET /health")
+
+	get := byKey["GET /api/v1/users/{id}"]
+	assert.Equal(t, "getUser", get.Handler)
+	assert.Equal(t, []string{"users"}, get.Tags)
+	require.Len(t, get.Parameters, 1)
+	assert.Equal(t, "id", get.Parameters[0].Name)
+	assert.Nil(t, get.RequestBody)
+
+	create := byKey["POST /api/v1/users"]
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, "#/components/schemas/CreateUserRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+
+	update := byKey["PUT /api/v1/users/{id}"]
+	assert.Equal(t, "UserHandlers.update", update.Handler)
+	require.NotNil(t, update.RequestBody)
+	assert.Equal(t, "#/components/schemas/UpdateUserRequest", update.RequestBody.Content["application/json"].Schema.Ref)
+
+	assert.Contains(t, byKey, "PUT /api/v1/users/{id}/avatar")
+	assert.Contains(t, byKey, "PATCH /api/v1/users/{id}/avatar")
+}
+
+func TestPlugin_ExtractRoutes_Kotlin(t *testing.T) {
+	code := `
+class MainVerticle : CoroutineVerticle() {
+    override suspend fun start() {
+        val router = Router.router(vertx)
+        router.post("/orders").coHandler { ctx ->
+            val order = ctx.body().asJsonObject().mapTo(OrderDto::class.java)
+            ctx.json(repo.save(order))
+        }
+    }
+}
+`
+	routes, err := New().ExtractRoutes([]scanner.SourceFile{
+		{Path: "MainVerticle.kt", Language: "kotlin", Content: []byte(code)},
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "POST", routes[0].Method)
+	assert.Equal(t, "/orders", routes[0].Path)
+	assert.Equal(t, 5, routes[0].SourceLine)
+	require.NotNil(t, routes[0].RequestBody)
+	assert.Equal(t, "#/components/schemas/OrderDto", routes[0].RequestBody.Content["application/json"].Schema.Ref)
+}
+
+func TestRouterPrefix(t *testing.T) {
+	mounts := map[string]mount{
+		"api":   {parent: "root", prefix: "/api/*"},
+		"users": {parent: "api", prefix: "/users"},
+		"loop":  {parent: "loop", prefix: "/x"},
+	}
+	assert.Equal(t, "", routerPrefix(mounts, "root"))
+	assert.Equal(t, "/api/users", routerPrefix(mounts, "users"))
+	assert.Equal(t, "/x", routerPrefix(mounts, "loop"))
+}
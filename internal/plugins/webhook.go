@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// WebhookReceiverExtension marks operations that receive webhooks from a
+// third party rather than serve API consumers. Its value is the provider
+// (stripe, github, ...) when known, or true.
+const WebhookReceiverExtension = "x-webhook-receiver"
+
+// webhookSourceWindow is how many lines after a route definition or handler
+// declaration are searched for signature verification.
+const webhookSourceWindow = 30
+
+// signatureMarkers are lowercase source fragments that verify a webhook
+// signature, and the provider they identify ("" if generic).
+var signatureMarkers = []struct {
+	marker   string
+	provider string
+}{
+	{"stripe-signature", "stripe"},
+	{"constructevent", "stripe"},
+	{"construct_event", "stripe"},
+	{"x-hub-signature", "github"},
+	{"x-gitlab-token", "gitlab"},
+	{"x-slack-signature", "slack"},
+	{"x-shopify-hmac", "shopify"},
+	{"x-twilio-signature", "twilio"},
+	{"paypal-transmission-sig", "paypal"},
+	{"svix-signature", "svix"},
+	{"webhook-signature", ""},
+	{"verifysignature", ""},
+	{"verify_signature", ""},
+	{"verifywebhook", ""},
+	{"verify_webhook", ""},
+	{"validatewebhook", ""},
+	{"validate_webhook", ""},
+}
+
+// webhookProviders are path segments naming well-known webhook senders.
+var webhookProviders = map[string]bool{
+	"stripe":   true,
+	"github":   true,
+	"gitlab":   true,
+	"slack":    true,
+	"shopify":  true,
+	"twilio":   true,
+	"paypal":   true,
+	"svix":     true,
+	"sendgrid": true,
+	"mailgun":  true,
+	"clerk":    true,
+	"discord":  true,
+}
+
+// MarkWebhookReceivers sets x-webhook-receiver on POST routes that receive
+// webhooks: those whose route definition or handler verifies a webhook
+// signature, and those under a webhook path such as /webhooks/stripe or
+// /stripe-webhook. A bare /webhooks or /webhooks/{id} collection needs
+// signature evidence, since it is as likely to manage subscriptions.
+func MarkWebhookReceivers(routes []types.Route, files []scanner.SourceFile) {
+	sources := make(map[string][]string, len(files))
+	for _, f := range files {
+		sources[f.Path] = strings.Split(strings.ToLower(string(f.Content)), "\n")
+	}
+
+	// A route definition's source ends where the next route in its file starts
+	routeLines := make(map[string][]int)
+	for _, route := range routes {
+		routeLines[route.SourceFile] = append(routeLines[route.SourceFile], route.SourceLine)
+	}
+	declarations := indexDeclarations(sources)
+
+	for i := range routes {
+		route := &routes[i]
+		if !strings.EqualFold(route.Method, "POST") {
+			continue
+		}
+
+		provider, verified := signatureProvider(route, routeLines[route.SourceFile], sources, declarations)
+		if !verified && !isWebhookPath(route.Path) {
+			continue
+		}
+		if provider == "" {
+			provider = pathProvider(route.Path)
+		}
+
+		if route.Extensions == nil {
+			route.Extensions = make(types.Extensions)
+		}
+		if provider != "" {
+			route.Extensions[WebhookReceiverExtension] = provider
+		} else {
+			route.Extensions[WebhookReceiverExtension] = true
+		}
+	}
+}
+
+// isWebhookPath reports whether a path is a webhook endpoint by name.
+func isWebhookPath(path string) bool {
+	segments := strings.Split(strings.Trim(strings.ToLower(path), "/"), "/")
+	for i, segment := range segments {
+		if !strings.Contains(segment, "webhook") {
+			continue
+		}
+		if segment != "webhooks" {
+			return true
+		}
+		// /webhooks/stripe names a sender; /webhooks/{id} is a resource
+		if i+1 < len(segments) && !strings.HasPrefix(segments[i+1], "{") {
+			return true
+		}
+	}
+	return false
+}
+
+// pathProvider returns the webhook provider named in a path, if any.
+func pathProvider(path string) string {
+	for _, segment := range strings.Split(strings.ToLower(path), "/") {
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+			if webhookProviders[word] {
+				return word
+			}
+		}
+	}
+	return ""
+}
+
+var (
+	// declStartRegex matches the start of a function or method declaration
+	declStartRegex = regexp.MustCompile(`^\s*(?:(?:export|public|private|protected|internal|static|async|override|suspend)\s+)*(?:func|def|function|fn|fun|sub)\b|^\s*(?:public|private|protected|internal)\s`)
+
+	// goReceiverRegex matches the receiver of a Go method declaration
+	goReceiverRegex = regexp.MustCompile(`^\s*func\s*\([^)]*\)`)
+
+	// declNameRegex matches the name a declaration line declares, before
+	// its parameter list and any type parameters
+	declNameRegex = regexp.MustCompile(`([A-Za-z_$][\w$]*)\s*(?:<[^<>()]*>)?\s*\(`)
+
+	// defNameRegex matches the name of a Ruby method declared without a
+	// parameter list
+	defNameRegex = regexp.MustCompile(`^\s*def\s+(?:self\.)?(\w+)`)
+)
+
+// signatureProvider searches a route's definition, up to the next route in
+// its file, and its handler's declaration for signature verification.
+func signatureProvider(route *types.Route, fileRouteLines []int, sources map[string][]string, declarations map[string][][]string) (string, bool) {
+	for _, lines := range routeSource(route, fileRouteLines, sources, declarations) {
+		for _, line := range lines {
+			for _, m := range signatureMarkers {
+				if strings.Contains(line, m.marker) {
+					return m.provider, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// routeSource returns the source lines of a route's definition, up to the
+// next route in its file or webhookSourceWindow lines, and of its
+// handler's declarations.
+func routeSource(route *types.Route, fileRouteLines []int, sources map[string][]string, declarations map[string][][]string) [][]string {
+	var windows [][]string
+	if lines, ok := sources[route.SourceFile]; ok && route.SourceLine > 0 && route.SourceLine <= len(lines) {
+		end := route.SourceLine - 1 + webhookSourceWindow
+		for _, line := range fileRouteLines {
+			if line > route.SourceLine && line-1 < end {
+				end = line - 1
+			}
+		}
+		windows = append(windows, lines[route.SourceLine-1:min(end, len(lines))])
+	}
+	return append(windows, declarations[handlerName(route.Handler)]...)
+}
+
+// indexDeclarations maps each declared function or method name to the
+// source lines of its declarations, each up to the next declaration or
+// webhookSourceWindow lines.
+func indexDeclarations(sources map[string][]string) map[string][][]string {
+	declarations := make(map[string][][]string)
+	for _, lines := range sources {
+		for n, line := range lines {
+			if !declStartRegex.MatchString(line) {
+				continue
+			}
+			match := declNameRegex.FindStringSubmatch(goReceiverRegex.ReplaceAllString(line, "func "))
+			if match == nil {
+				match = defNameRegex.FindStringSubmatch(line)
+			}
+			if match == nil {
+				continue
+			}
+
+			end := n + 1
+			for end < len(lines) && end < n+webhookSourceWindow && !declStartRegex.MatchString(lines[end]) {
+				end++
+			}
+			declarations[match[1]] = append(declarations[match[1]], lines[n:end])
+		}
+	}
+	return declarations
+}
+
+// handlerName returns the lowercase function or method name of a handler:
+// Class.method and Controller::method handlers are declared by method name.
+func handlerName(handler string) string {
+	if idx := strings.LastIndexAny(handler, ".:#@"); idx >= 0 {
+		handler = handler[idx+1:]
+	}
+	return strings.ToLower(handler)
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestMarkWebhookReceivers(t *testing.T) {
+	routesCode := `const app = express()
+app.post('/orders', createOrder)
+app.post('/billing/events', express.raw({ type: 'application/json' }), (req, res) => {
+  const event = stripe.webhooks.constructEvent(req.body, req.headers['stripe-signature'], secret)
+  res.sendStatus(200)
+})
+app.post('/hooks/github', handleGitHub)
+app.post('/webhooks', createSubscription)
+app.post('/webhooks/{id}/ping', pingSubscription)
+app.post('/webhooks/sendgrid', handleSendgrid)
+app.post('/payments/stripe-webhook', handlePayment)
+app.get('/webhooks/stripe', verifyEndpoint)
+`
+	handlersCode := `package handlers
+
+func handleGitHub(w http.ResponseWriter, r *http.Request) {
+	sig := r.Header.Get("X-Hub-Signature-256")
+}
+
+func createOrder(w http.ResponseWriter, r *http.Request) {
+}
+`
+	files := []scanner.SourceFile{
+		{Path: "routes.js", Content: []byte(routesCode)},
+		{Path: "handlers.go", Content: []byte(handlersCode)},
+	}
+	routes := []types.Route{
+		{Method: "POST", Path: "/orders", Handler: "createOrder", SourceFile: "routes.js", SourceLine: 2},
+		{Method: "POST", Path: "/billing/events", SourceFile: "routes.js", SourceLine: 3},
+		{Method: "POST", Path: "/hooks/github", Handler: "handleGitHub", SourceFile: "routes.js", SourceLine: 7},
+		{Method: "POST", Path: "/webhooks", Handler: "createSubscription", SourceFile: "routes.js", SourceLine: 8},
+		{Method: "POST", Path: "/webhooks/{id}/ping", Handler: "pingSubscription", SourceFile: "routes.js", SourceLine: 9},
+		{Method: "POST", Path: "/webhooks/sendgrid", Handler: "handleSendgrid", SourceFile: "routes.js", SourceLine: 10},
+		{Method: "POST", Path: "/payments/stripe-webhook", Handler: "handlePayment", SourceFile: "routes.js", SourceLine: 11},
+		{Method: "GET", Path: "/webhooks/stripe", Handler: "verifyEndpoint", SourceFile: "routes.js", SourceLine: 12},
+	}
+
+	MarkWebhookReceivers(routes, files)
+
+	marked := make(map[string]any)
+	for _, route := range routes {
+		if value, ok := route.Extensions[WebhookReceiverExtension]; ok {
+			marked[route.Method+" "+route.Path] = value
+		}
+	}
+	assert.Equal(t, map[string]any{
+		// Signature verification in the inline handler or the named handler
+		"POST /billing/events": "stripe",
+		"POST /hooks/github":   "github",
+		// Webhook paths, with the provider they name
+		"POST /webhooks/sendgrid":       "sendgrid",
+		"POST /payments/stripe-webhook": "stripe",
+	}, marked)
+}
+
+func TestIsWebhookPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/webhook", true},
+		{"/api/webhooks/stripe", true},
+		{"/stripe_webhook", true},
+		{"/webhooks", false},
+		{"/webhooks/{id}", false},
+		{"/users", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isWebhookPath(tt.path))
+		})
+	}
+}
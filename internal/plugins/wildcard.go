@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// WildcardExtension marks operations whose path ends in a catch-all or
+// regex parameter that matches more than one path segment.
+const WildcardExtension = "x-wildcard"
+
+// catchAllMarkers are the framework path syntaxes that match the rest of a
+// URL: *, *name and {*name} globs, [...name] and {name...} spreads, (.*) and
+// .+ regexes, and Flask/Starlette path converters.
+var catchAllMarkers = []string{"*", "...", ".+", "<path:", ":path}", "..>"}
+
+var (
+	// constrainedParamRegex matches a converted param still followed by its
+	// regex, like {path}(.*) from Koa or {path}{.+} from Hono.
+	constrainedParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}(?:\([^)]*\)|\{[^}]*\})`)
+
+	// braceGlobRegex matches ASP.NET and Axum catch-alls like {*rest} or {**rest}.
+	braceGlobRegex = regexp.MustCompile(`\{\*{1,2}([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+	// braceSpreadRegex matches Ktor tailcards like {path...}.
+	braceSpreadRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\.\.\.\}`)
+
+	// braceRegexParamRegex matches catch-all regex params like {path:.*} or {file:path}.
+	braceRegexParamRegex = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*):(?:\.\*|\.\+|path)\}`)
+
+	// namedGlobRegex matches named globs like *path in Rails or Gin.
+	namedGlobRegex = regexp.MustCompile(`\*([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+	// bareGlobRegex matches anonymous globs like * or **.
+	bareGlobRegex = regexp.MustCompile(`\*+`)
+)
+
+// IsCatchAll reports whether a framework route path, before conversion to
+// OpenAPI syntax, contains a catch-all segment such as /files/*,
+// /:path(.*), *glob, [...slug] or {*rest}.
+func IsCatchAll(path string) bool {
+	for _, marker := range catchAllMarkers {
+		if strings.Contains(path, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// CatchAllPath rewrites catch-all syntax left in a converted path as a
+// templated parameter: {*rest}, {rest...} and *rest become {rest}, and a
+// bare * or ** becomes {path}. Regexes following a param are dropped.
+func CatchAllPath(path string) string {
+	path = constrainedParamRegex.ReplaceAllString(path, "{$1}")
+	path = braceGlobRegex.ReplaceAllString(path, "{$1}")
+	path = braceSpreadRegex.ReplaceAllString(path, "{$1}")
+	path = braceRegexParamRegex.ReplaceAllString(path, "{$1}")
+	path = namedGlobRegex.ReplaceAllString(path, "{$1}")
+	return bareGlobRegex.ReplaceAllString(path, "{path}")
+}
+
+// MarkWildcard sets x-wildcard on a route whose path has a catch-all
+// parameter, so generation.wildcards can keep or exclude it.
+func MarkWildcard(route *types.Route) {
+	if route.Extensions == nil {
+		route.Extensions = make(types.Extensions)
+	}
+	route.Extensions[WildcardExtension] = true
+}
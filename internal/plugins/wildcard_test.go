@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestIsCatchAll(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"/files/*", true},
+		{"/files/:path(.*)", true},
+		{"/files/*path", true},
+		{"/docs/[...slug]", true},
+		{"/files/{*rest}", true},
+		{"/static/{path...}", true},
+		{"/files/<path:name>", true},
+		{"/files/{file_path:path}", true},
+		{"/files/:name(.+\\.png)", true},
+		{"/users/:id", false},
+		{"/users/:id(\\d+)", false},
+		{"/users/{id:int}", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsCatchAll(tt.path))
+		})
+	}
+}
+
+func TestCatchAllPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/files/*", "/files/{path}"},
+		{"/assets/**", "/assets/{path}"},
+		{"/files/*path", "/files/{path}"},
+		{"*glob", "{glob}"},
+		{"/files/{*rest}", "/files/{rest}"},
+		{"/files/{**rest}", "/files/{rest}"},
+		{"/static/{path...}", "/static/{path}"},
+		{"/files/{name:.+}", "/files/{name}"},
+		{"/files/{name}", "/files/{name}"},
+		{"/files/{path}(.*)", "/files/{path}"},
+		{"/files/{path}{.+}", "/files/{path}"},
+		{"/users/{id}", "/users/{id}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CatchAllPath(tt.path))
+		})
+	}
+}
+
+func TestMarkWildcard(t *testing.T) {
+	route := types.Route{Method: "GET", Path: "/files/{path}"}
+	MarkWildcard(&route)
+	assert.Equal(t, true, route.Extensions[WildcardExtension])
+}
@@ -0,0 +1,891 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package wordpress provides a plugin for extracting WordPress REST API
+// routes registered with register_rest_route().
+package wordpress
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/internal/util"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// restPrefix is the default WordPress REST API URL prefix.
+const restPrefix = "/wp-json"
+
+// Plugin implements the FrameworkPlugin interface for the WordPress REST API.
+type Plugin struct{}
+
+// New creates a new WordPress plugin instance.
+func New() *Plugin {
+	return &Plugin{}
+}
+
+// Name returns the plugin identifier.
+func (p *Plugin) Name() string {
+	return "wordpress"
+}
+
+// Extensions returns the file extensions this plugin handles.
+func (p *Plugin) Extensions() []string {
+	return []string{".php"}
+}
+
+// Info returns plugin metadata.
+func (p *Plugin) Info() plugins.PluginInfo {
+	return plugins.PluginInfo{
+		Name:        "wordpress",
+		Version:     "1.0.0",
+		Description: "Extracts WordPress REST API routes",
+		SupportedFrameworks: []string{
+			"WordPress",
+		},
+	}
+}
+
+// Detect checks if the project is a WordPress site, plugin, or theme.
+func (p *Plugin) Detect(projectRoot string) (bool, error) {
+	composerPath := filepath.Join(projectRoot, "composer.json")
+	for _, dep := range []string{"johnpbloch/wordpress", "roots/wordpress", "wpackagist-"} {
+		if found, _ := p.checkFileForDependency(composerPath, dep); found {
+			return true, nil
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(projectRoot, "wp-config.php")); err == nil {
+		return true, nil
+	}
+
+	// Plugins declare a "Plugin Name:" header in a top-level PHP file
+	phpFiles, err := filepath.Glob(filepath.Join(projectRoot, "*.php"))
+	if err != nil {
+		return false, nil
+	}
+	for _, phpPath := range phpFiles {
+		if found, _ := p.checkFileForDependency(phpPath, "Plugin Name:"); found {
+			return true, nil
+		}
+	}
+
+	// Themes declare a "Theme Name:" header in style.css
+	if found, _ := p.checkFileForDependency(filepath.Join(projectRoot, "style.css"), "Theme Name:"); found {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkFileForDependency checks if a file contains a dependency.
+func (p *Plugin) checkFileForDependency(path, dep string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, nil
+	}
+	defer func() { _ = file.Close() }()
+
+	scanr := bufio.NewScanner(file)
+	depLower := strings.ToLower(dep)
+	for scanr.Scan() {
+		line := strings.ToLower(scanr.Text())
+		if strings.Contains(line, depLower) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// serverMethods maps WP_REST_Server method constants to HTTP methods.
+var serverMethods = map[string][]string{
+	"READABLE":   {"GET"},
+	"CREATABLE":  {"POST"},
+	"EDITABLE":   {"POST", "PUT", "PATCH"},
+	"DELETABLE":  {"DELETE"},
+	"ALLMETHODS": {"GET", "POST", "PUT", "PATCH", "DELETE"},
+}
+
+// Regex patterns for WordPress route extraction
+var (
+	// Matches register_rest_route(
+	registerRouteRegex = regexp.MustCompile(`\bregister_rest_route\s*\(`)
+
+	// Matches string properties, variables, and class constants like
+	// protected $namespace = 'myplugin/v1'; or const NS = 'myplugin/v1';
+	stringAssignRegex = regexp.MustCompile(`(?:\$(?:this->)?|\bconst\s+)(\w+)\s*=\s*(?:'([^'\\]*)'|"([^"\\$]*)")\s*;`)
+
+	// Matches define('MYPLUGIN_NS', 'myplugin/v1')
+	defineRegex = regexp.MustCompile(`\bdefine\s*\(\s*['"](\w+)['"]\s*,\s*(?:'([^'\\]*)'|"([^"\\$]*)")\s*\)`)
+
+	// Matches class declarations
+	classRegex = regexp.MustCompile(`\bclass\s+(\w+)`)
+
+	// Matches the last identifier of an expression
+	lastIdentRegex = regexp.MustCompile(`(\w+)\W*$`)
+
+	// Matches number literals
+	numberRegex = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// ExtractRoutes parses source files and extracts WordPress REST routes.
+func (p *Plugin) ExtractRoutes(files []scanner.SourceFile) ([]types.Route, error) {
+	var routes []types.Route
+
+	// Constants defined in one file are often used in another
+	constants := make(map[string]string)
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+		for _, match := range defineRegex.FindAllStringSubmatch(string(file.Content), -1) {
+			constants[match[1]] = match[2] + match[3]
+		}
+	}
+
+	for _, file := range files {
+		if file.Language != "php" {
+			continue
+		}
+
+		fileRoutes := p.extractRoutesFromFile(file, constants)
+		routes = append(routes, fileRoutes...)
+	}
+
+	return routes, nil
+}
+
+// extractRoutesFromFile extracts routes from a single PHP file.
+func (p *Plugin) extractRoutesFromFile(file scanner.SourceFile, constants map[string]string) []types.Route {
+	var routes []types.Route
+	content := util.NormalizeNewlines(string(file.Content))
+
+	if !strings.Contains(content, "register_rest_route") {
+		return nil
+	}
+
+	values := make(map[string]string, len(constants))
+	for name, value := range constants {
+		values[name] = value
+	}
+	for _, match := range stringAssignRegex.FindAllStringSubmatch(content, -1) {
+		values[match[1]] = match[2] + match[3]
+	}
+
+	for _, match := range registerRouteRegex.FindAllStringIndex(content, -1) {
+		args := splitArgs(content, match[1]-1)
+		if len(args) < 2 {
+			continue
+		}
+
+		namespace, nsUnresolved := evalString(args[0], values)
+		route, routeUnresolved := evalString(args[1], values)
+		unresolved := append(nsUnresolved, routeUnresolved...)
+
+		var options *phpValue
+		if len(args) > 2 {
+			options = parseValue(args[2])
+		}
+
+		lineNum := strings.Count(content[:match[0]], "\n") + 1
+		class := enclosingClass(content, match[0])
+
+		for _, endpoint := range endpoints(options) {
+			for _, variant := range optionalPaths(route) {
+				for _, method := range endpointMethods(endpoint) {
+					r := p.createRoute(method, namespace, variant, endpoint, class)
+					r.SourceFile = file.Path
+					r.SourceLine = lineNum
+					if len(unresolved) > 0 {
+						r.Diagnostics = append(r.Diagnostics, plugins.UnresolvedPathDiagnostic(unresolved))
+					}
+					routes = append(routes, r)
+				}
+			}
+		}
+	}
+
+	return routes
+}
+
+// endpoints returns the endpoint arrays of register_rest_route()'s third
+// argument, which is either one endpoint or a list of endpoints.
+func endpoints(options *phpValue) []*phpValue {
+	if options == nil || !options.isArray {
+		return []*phpValue{{isArray: true}}
+	}
+	for _, key := range []string{"methods", "callback", "args"} {
+		if options.get(key) != nil {
+			return []*phpValue{options}
+		}
+	}
+
+	var list []*phpValue
+	for _, entry := range options.entries {
+		if entry.key == "" && entry.value.isArray {
+			list = append(list, entry.value)
+		}
+	}
+	return list
+}
+
+// endpointMethods returns the HTTP methods of an endpoint. WordPress
+// defaults to GET.
+func endpointMethods(endpoint *phpValue) []string {
+	value := endpoint.get("methods")
+	if value == nil {
+		return []string{"GET"}
+	}
+
+	var methods []string
+	add := func(v *phpValue) {
+		if v.isString {
+			for _, method := range strings.FieldsFunc(v.text, func(r rune) bool { return r == ',' || r == ' ' || r == '|' }) {
+				methods = append(methods, strings.ToUpper(method))
+			}
+		} else if m := lastIdentRegex.FindStringSubmatch(v.text); m != nil {
+			methods = append(methods, serverMethods[m[1]]...)
+		}
+	}
+
+	if value.isArray {
+		for _, entry := range value.entries {
+			add(entry.value)
+		}
+	} else {
+		add(value)
+	}
+
+	if len(methods) == 0 {
+		return []string{"GET"}
+	}
+	return methods
+}
+
+// createRoute creates a route from the extracted information.
+func (p *Plugin) createRoute(method, namespace, pattern string, endpoint *phpValue, class string) types.Route {
+	routePath, pathParams, wildcard := convertPattern(pattern)
+	specPath := routePath
+	if namespace = strings.Trim(namespace, "/"); namespace != "" {
+		nsPath, nsParams, _ := convertPattern(namespace)
+		specPath = nsPath + routePath
+		pathParams = append(nsParams, pathParams...)
+	}
+
+	route := types.Route{
+		Method:      method,
+		Path:        restPrefix + specPath,
+		Handler:     callbackName(endpoint.get("callback"), class),
+		OperationID: generateOperationID(method, specPath, ""),
+		Tags:        inferTags(routePath, namespace),
+		SourceLine:  0,
+	}
+
+	args := endpoint.get("args")
+	for _, param := range pathParams {
+		if arg := args.get(param.Name); arg != nil {
+			schema := argSchema(arg)
+			if param.Schema.Type == "integer" && schema.Type == "string" {
+				schema.Type = "integer"
+			}
+			param.Schema = schema
+			param.Description = schema.Description
+			schema.Description = ""
+		}
+		route.Parameters = append(route.Parameters, param)
+	}
+	if wildcard {
+		plugins.MarkWildcard(&route)
+	}
+
+	// Remaining args are query parameters for reads and JSON body fields
+	// for writes; WordPress accepts either
+	var bodySchema *types.Schema
+	if args != nil && args.isArray {
+		for _, entry := range args.entries {
+			if entry.key == "" || hasParam(pathParams, entry.key) {
+				continue
+			}
+			schema := argSchema(entry.value)
+			required := entry.value.get("required").truthy()
+
+			switch method {
+			case "POST", "PUT", "PATCH":
+				if bodySchema == nil {
+					bodySchema = &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+				}
+				bodySchema.Properties[entry.key] = schema
+				if required {
+					bodySchema.Required = append(bodySchema.Required, entry.key)
+				}
+			default:
+				description := schema.Description
+				schema.Description = ""
+				route.Parameters = append(route.Parameters, types.Parameter{
+					Name:        entry.key,
+					In:          "query",
+					Description: description,
+					Required:    required,
+					Schema:      schema,
+				})
+			}
+		}
+	}
+	if bodySchema != nil {
+		route.RequestBody = &types.RequestBody{
+			Required: len(bodySchema.Required) > 0,
+			Content: map[string]types.MediaType{
+				"application/json": {Schema: bodySchema},
+			},
+		}
+	}
+
+	return route
+}
+
+// hasParam reports whether a parameter with the given name exists.
+func hasParam(params []types.Parameter, name string) bool {
+	for _, param := range params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// callbackName returns the handler name of a callback: 'my_function',
+// 'Class::method', [$this, 'method'] or [Class::class, 'method'].
+func callbackName(callback *phpValue, class string) string {
+	if callback == nil {
+		return ""
+	}
+	if callback.isString {
+		return callback.text
+	}
+	if !callback.isArray || len(callback.entries) != 2 || !callback.entries[1].value.isString {
+		return ""
+	}
+
+	method := callback.entries[1].value.text
+	target := callback.entries[0].value
+	switch {
+	case target.isString:
+		return target.text + "::" + method
+	case target.text == "$this" || target.text == "__CLASS__" || target.text == "self::class" || target.text == "static::class":
+		if class == "" {
+			return method
+		}
+		return class + "::" + method
+	case strings.HasSuffix(target.text, "::class"):
+		name := strings.TrimSuffix(target.text, "::class")
+		if idx := strings.LastIndex(name, "\\"); idx >= 0 {
+			name = name[idx+1:]
+		}
+		return name + "::" + method
+	}
+	return method
+}
+
+// enclosingClass returns the name of the last class declared before offset.
+func enclosingClass(content string, offset int) string {
+	matches := classRegex.FindAllStringSubmatch(content[:offset], -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[len(matches)-1][1]
+}
+
+// argSchema converts a register_rest_route() argument definition, which
+// uses JSON Schema keywords, to a schema.
+func argSchema(arg *phpValue) *types.Schema {
+	schema := &types.Schema{Type: "string"}
+	if arg == nil || !arg.isArray {
+		return schema
+	}
+
+	if t := arg.get("type"); t != nil {
+		if t.isString {
+			schema.Type = t.text
+		} else if t.isArray {
+			// A list of types like ['string', 'null']
+			for _, entry := range t.entries {
+				if entry.value.text == "null" {
+					schema.Nullable = true
+				} else if schema.Type == "string" || schema.Type == "" {
+					schema.Type = entry.value.text
+				}
+			}
+		}
+	}
+	schema.Format = arg.get("format").stringValue()
+	schema.Description = arg.get("description").stringValue()
+	schema.Pattern = arg.get("pattern").stringValue()
+
+	if v := arg.get("default"); v != nil {
+		schema.Default = v.scalar()
+	}
+	if enum := arg.get("enum"); enum != nil && enum.isArray {
+		for _, entry := range enum.entries {
+			schema.Enum = append(schema.Enum, entry.value.scalar())
+		}
+	}
+	if v, ok := arg.get("minimum").scalar().(int); ok {
+		f := float64(v)
+		schema.Minimum = &f
+	}
+	if v, ok := arg.get("maximum").scalar().(int); ok {
+		f := float64(v)
+		schema.Maximum = &f
+	}
+	if v, ok := arg.get("minLength").scalar().(int); ok {
+		schema.MinLength = &v
+	}
+	if v, ok := arg.get("maxLength").scalar().(int); ok {
+		schema.MaxLength = &v
+	}
+
+	switch schema.Type {
+	case "array":
+		if items := arg.get("items"); items != nil {
+			schema.Items = argSchema(items)
+		}
+	case "object":
+		if props := arg.get("properties"); props != nil && props.isArray {
+			schema.Properties = make(map[string]*types.Schema)
+			for _, entry := range props.entries {
+				if entry.key == "" {
+					continue
+				}
+				schema.Properties[entry.key] = argSchema(entry.value)
+				if entry.value.get("required").truthy() {
+					schema.Required = append(schema.Required, entry.key)
+				}
+			}
+			sort.Strings(schema.Required)
+		}
+	}
+
+	return schema
+}
+
+// convertPattern converts a WordPress route regex to an OpenAPI path.
+// Named groups like (?P<id>\d+) become path parameters, typed integer
+// when they match digits; groups matching .+ or .* are wildcards.
+func convertPattern(pattern string) (string, []types.Parameter, bool) {
+	pattern = strings.TrimPrefix(pattern, "^")
+	pattern = strings.TrimSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "/?")
+
+	var sb strings.Builder
+	var params []types.Parameter
+	wildcard := false
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			sb.WriteByte(pattern[i])
+			continue
+		case c != '(':
+			sb.WriteByte(c)
+			continue
+		}
+
+		end := closingParen(pattern, i)
+		group := pattern[i+1 : end]
+		name := ""
+		switch {
+		case strings.HasPrefix(group, "?P<"), strings.HasPrefix(group, "?<"):
+			nameEnd := strings.Index(group, ">")
+			name = group[strings.Index(group, "<")+1 : nameEnd]
+			group = group[nameEnd+1:]
+		case strings.HasPrefix(group, "?:"):
+			// Non-capturing groups are kept as their contents
+			inner, innerParams, innerWildcard := convertPattern(group[2:])
+			sb.WriteString(inner)
+			params = append(params, innerParams...)
+			wildcard = wildcard || innerWildcard
+			i = end
+			continue
+		default:
+			name = fmt.Sprintf("param%d", len(params)+1)
+		}
+
+		schemaType := "string"
+		switch group {
+		case `\d+`, `[0-9]+`, `[\d]+`:
+			schemaType = "integer"
+		case ".+", ".*":
+			wildcard = true
+		}
+		params = append(params, types.Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &types.Schema{Type: schemaType},
+		})
+		sb.WriteString("{" + name + "}")
+		i = end
+	}
+
+	path := sb.String()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path, params, wildcard
+}
+
+// optionalPaths expands optional non-capturing groups: /items(?:/(?P<id>\d+))?
+// yields /items and /items/(?P<id>\d+).
+func optionalPaths(pattern string) []string {
+	for i := 0; i+2 < len(pattern); i++ {
+		if pattern[i] == '\\' {
+			i++
+			continue
+		}
+		if !strings.HasPrefix(pattern[i:], "(?:") {
+			continue
+		}
+		end := closingParen(pattern, i)
+		if end+1 >= len(pattern) || pattern[end+1] != '?' {
+			continue
+		}
+
+		var paths []string
+		for _, rest := range optionalPaths(pattern[end+2:]) {
+			paths = append(paths, pattern[:i]+rest)
+		}
+		for _, rest := range optionalPaths(pattern[end+2:]) {
+			paths = append(paths, pattern[:i]+pattern[i+3:end]+rest)
+		}
+		return paths
+	}
+	return []string{pattern}
+}
+
+// closingParen returns the index of the parenthesis closing the group
+// opened at open, skipping escapes and character classes.
+func closingParen(pattern string, open int) int {
+	depth := 0
+	inClass := false
+	for i := open; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(pattern) - 1
+}
+
+// phpValue is a parsed PHP expression: a string literal, an array, or the
+// raw text of anything else.
+type phpValue struct {
+	text     string
+	isString bool
+	isArray  bool
+	entries  []phpEntry
+}
+
+// phpEntry is an array element; key is empty for list elements.
+type phpEntry struct {
+	key   string
+	value *phpValue
+}
+
+// get returns the value of a string key in an array.
+func (v *phpValue) get(key string) *phpValue {
+	if v == nil {
+		return nil
+	}
+	for _, entry := range v.entries {
+		if entry.key == key {
+			return entry.value
+		}
+	}
+	return nil
+}
+
+// stringValue returns the value of a string literal.
+func (v *phpValue) stringValue() string {
+	if v == nil || !v.isString {
+		return ""
+	}
+	return v.text
+}
+
+// truthy reports whether the value is the literal true.
+func (v *phpValue) truthy() bool {
+	return v != nil && strings.EqualFold(v.text, "true")
+}
+
+// scalar returns the Go value of a string, number, or boolean literal.
+func (v *phpValue) scalar() interface{} {
+	switch {
+	case v == nil || v.isArray:
+		return nil
+	case v.isString:
+		return v.text
+	case strings.EqualFold(v.text, "true"):
+		return true
+	case strings.EqualFold(v.text, "false"):
+		return false
+	case numberRegex.MatchString(v.text):
+		if n, err := strconv.Atoi(v.text); err == nil {
+			return n
+		}
+		f, _ := strconv.ParseFloat(v.text, 64)
+		return f
+	}
+	return nil
+}
+
+// parseValue parses a PHP expression, decomposing array literals.
+func parseValue(expr string) *phpValue {
+	expr = strings.TrimSpace(expr)
+
+	if s, ok := phpString(expr); ok {
+		return &phpValue{text: s, isString: true}
+	}
+
+	var inner string
+	switch {
+	case strings.HasPrefix(expr, "[") && strings.HasSuffix(expr, "]"):
+		inner = expr[1 : len(expr)-1]
+	case strings.HasPrefix(strings.ToLower(expr), "array") && strings.HasSuffix(expr, ")"):
+		open := strings.Index(expr, "(")
+		if open < 0 || strings.TrimSpace(expr[5:open]) != "" {
+			return &phpValue{text: expr}
+		}
+		inner = expr[open+1 : len(expr)-1]
+	default:
+		return &phpValue{text: expr}
+	}
+
+	value := &phpValue{text: expr, isArray: true}
+	for _, element := range splitTopLevel(inner, ",") {
+		if strings.TrimSpace(element) == "" {
+			continue
+		}
+		parts := splitTopLevel(element, "=>")
+		entry := phpEntry{value: parseValue(parts[len(parts)-1])}
+		if len(parts) == 2 {
+			if key, ok := phpString(strings.TrimSpace(parts[0])); ok {
+				entry.key = key
+			} else {
+				entry.key = strings.TrimSpace(parts[0])
+			}
+		}
+		value.entries = append(value.entries, entry)
+	}
+	return value
+}
+
+// evalString evaluates a string expression concatenated with ".", looking
+// up variables, properties, and constants by name. Unresolved operands
+// become {name} placeholders and are returned.
+func evalString(expr string, values map[string]string) (string, []string) {
+	var sb strings.Builder
+	var unresolved []string
+
+	for _, operand := range splitTopLevel(expr, ".") {
+		operand = strings.TrimSpace(operand)
+		if s, ok := phpString(operand); ok {
+			sb.WriteString(s)
+			continue
+		}
+		name := operand
+		if m := lastIdentRegex.FindStringSubmatch(operand); m != nil {
+			name = m[1]
+		}
+		if value, ok := values[name]; ok {
+			sb.WriteString(value)
+			continue
+		}
+		unresolved = append(unresolved, operand)
+		sb.WriteString("(?P<" + name + ">)")
+	}
+
+	return sb.String(), unresolved
+}
+
+// splitTopLevel splits s on sep outside quotes and brackets.
+func splitTopLevel(s, sep string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(s[i:], sep):
+			// A "." between digits is a decimal point
+			if sep == "." && i > 0 && i+1 < len(s) && isDigit(s[i-1]) && isDigit(s[i+1]) {
+				continue
+			}
+			parts = append(parts, s[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// splitArgs returns the top-level arguments of the call whose opening
+// parenthesis is at open.
+func splitArgs(content string, open int) []string {
+	depth := 0
+	var quote byte
+
+	for i := open; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+			if depth == 0 {
+				var args []string
+				for _, arg := range splitTopLevel(content[open+1:i], ",") {
+					if arg = strings.TrimSpace(arg); arg != "" {
+						args = append(args, arg)
+					}
+				}
+				return args
+			}
+		}
+	}
+
+	return nil
+}
+
+// phpString returns the value of a quoted PHP string literal.
+func phpString(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	inner := s[1 : len(s)-1]
+	if s[0] == '\'' {
+		inner = strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(inner)
+	}
+	return inner, true
+}
+
+// braceParamRegex matches OpenAPI-style path parameters.
+var braceParamRegex = regexp.MustCompile(`\{([^}:]+)\}`)
+
+// generateOperationID generates an operation ID from method, path, and handler.
+func generateOperationID(method, path, handler string) string {
+	if handler != "" {
+		return strings.ToLower(method) + toTitleCase(handler)
+	}
+
+	cleanPath := braceParamRegex.ReplaceAllString(path, "By${1}")
+	cleanPath = strings.NewReplacer("/", " ", "-", " ", "_", " ").Replace(cleanPath)
+	cleanPath = strings.TrimSpace(cleanPath)
+
+	words := strings.Fields(cleanPath)
+	if len(words) == 0 {
+		return strings.ToLower(method)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+
+	titleCaser := cases.Title(language.English)
+	for _, word := range words {
+		sb.WriteString(titleCaser.String(strings.ToLower(word)))
+	}
+
+	return sb.String()
+}
+
+// toTitleCase converts the first character to uppercase.
+func toTitleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// inferTags infers tags from the route, falling back to the namespace's
+// vendor segment.
+func inferTags(route, namespace string) []string {
+	for _, part := range strings.Split(strings.TrimPrefix(route, "/"), "/") {
+		if part == "" || strings.HasPrefix(part, "{") {
+			continue
+		}
+		return []string{part}
+	}
+
+	if vendor, _, _ := strings.Cut(namespace, "/"); vendor != "" {
+		return []string{vendor}
+	}
+	return nil
+}
+
+// ExtractSchemas extracts schema definitions (argument schemas are inlined
+// into the routes that declare them).
+func (p *Plugin) ExtractSchemas(_ []scanner.SourceFile) ([]types.Schema, error) {
+	return []types.Schema{}, nil
+}
+
+// Register registers the WordPress plugin with the global registry.
+func Register() {
+	plugins.MustRegister(New())
+}
+
+func init() {
+	Register()
+}
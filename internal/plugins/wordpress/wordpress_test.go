@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package wordpress
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/plugins"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// wpPluginCode is a test fixture covering a plugin's main file with
+// function callbacks and a namespace constant.
+const wpPluginCode = `<?php
+/**
+ * Plugin Name: My Plugin
+ */
+
+define( 'MYPLUGIN_NS', 'myplugin/v1' );
+
+add_action( 'rest_api_init', function () {
+	register_rest_route( MYPLUGIN_NS, '/items/(?P<id>\d+)', array(
+		'methods'             => 'GET',
+		'callback'            => 'myplugin_get_item',
+		'permission_callback' => '__return_true',
+		'args'                => array(
+			'id' => array(
+				'description' => 'Unique identifier for the item.',
+				'type'        => 'integer',
+			),
+			'context' => array(
+				'type'    => 'string',
+				'enum'    => array( 'view', 'edit' ),
+				'default' => 'view',
+			),
+		),
+	) );
+
+	register_rest_route( 'myplugin/v1', '/files/(?P<path>.+)', array(
+		'methods'  => 'GET, HEAD',
+		'callback' => 'myplugin_get_file',
+	) );
+} );
+`
+
+// wpControllerCode is a test fixture covering a WP_REST_Controller subclass
+// with several endpoints per route.
+const wpControllerCode = `<?php
+
+class Books_Controller extends WP_REST_Controller {
+	protected $namespace = 'library/v2';
+	protected $rest_base = 'books';
+
+	public function register_routes() {
+		register_rest_route( $this->namespace, '/' . $this->rest_base, [
+			[
+				'methods'  => WP_REST_Server::READABLE,
+				'callback' => [ $this, 'get_items' ],
+				'args'     => [
+					'per_page' => [ 'type' => 'integer', 'minimum' => 1, 'maximum' => 100 ],
+				],
+			],
+			[
+				'methods'  => WP_REST_Server::CREATABLE,
+				'callback' => [ $this, 'create_item' ],
+				'args'     => [
+					'title' => [ 'type' => 'string', 'required' => true ],
+					'tags'  => [ 'type' => 'array', 'items' => [ 'type' => 'string' ] ],
+				],
+			],
+			'schema' => [ $this, 'get_public_item_schema' ],
+		] );
+
+		register_rest_route( $this->namespace, '/' . $this->rest_base . '/(?P<id>[\d]+)', [
+			'methods'  => WP_REST_Server::EDITABLE,
+			'callback' => [ $this, 'update_item' ],
+		] );
+
+		register_rest_route( $this->namespace, '/' . $this->resource() . '/recent', [
+			'callback' => [ $this, 'recent' ],
+		] );
+	}
+}
+`
+
+func TestPlugin_Name(t *testing.T) {
+	p := New()
+	assert.Equal(t, "wordpress", p.Name())
+}
+
+func TestPlugin_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected bool
+	}{
+		{"plugin header", "my-plugin.php", "<?php\n/**\n * Plugin Name: My Plugin\n */\n", true},
+		{"theme header", "style.css", "/*\nTheme Name: My Theme\n*/\n", true},
+		{"bedrock", "composer.json", `{"require": {"roots/wordpress": "^6.4"}}`, true},
+		{"site", "wp-config.php", "<?php\n", true},
+		{"no wordpress", "composer.json", `{"require": {"slim/slim": "^4.0"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0644)
+			require.NoError(t, err)
+
+			detected, err := New().Detect(dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+}
+
+func TestPlugin_ExtractRoutes(t *testing.T) {
+	p := New()
+
+	files := []scanner.SourceFile{
+		{Path: "my-plugin.php", Language: "php", Content: []byte(wpPluginCode)},
+		{Path: "includes/class-books-controller.php", Language: "php", Content: []byte(wpControllerCode)},
+	}
+
+	routes, err := p.ExtractRoutes(files)
+	require.NoError(t, err)
+
+	// Named groups become typed path params, described by their args
+	getItem := findRoute(routes, "GET", "/wp-json/myplugin/v1/items/{id}")
+	require.NotNil(t, getItem)
+	assert.Equal(t, "myplugin_get_item", getItem.Handler)
+	assert.Equal(t, "my-plugin.php", getItem.SourceFile)
+	assert.Equal(t, 9, getItem.SourceLine)
+	assert.Equal(t, []string{"items"}, getItem.Tags)
+	require.Len(t, getItem.Parameters, 2)
+	assert.Equal(t, "path", getItem.Parameters[0].In)
+	assert.Equal(t, "integer", getItem.Parameters[0].Schema.Type)
+	assert.Equal(t, "Unique identifier for the item.", getItem.Parameters[0].Description)
+	assert.Equal(t, "query", getItem.Parameters[1].In)
+	assert.Equal(t, []interface{}{"view", "edit"}, getItem.Parameters[1].Schema.Enum)
+	assert.Equal(t, "view", getItem.Parameters[1].Schema.Default)
+
+	file := findRoute(routes, "HEAD", "/wp-json/myplugin/v1/files/{path}")
+	require.NotNil(t, file)
+	assert.Equal(t, true, file.Extensions[plugins.WildcardExtension])
+
+	// Properties resolve the namespace and route; endpoints share a route
+	listBooks := findRoute(routes, "GET", "/wp-json/library/v2/books")
+	require.NotNil(t, listBooks)
+	assert.Equal(t, "Books_Controller::get_items", listBooks.Handler)
+	require.Len(t, listBooks.Parameters, 1)
+	assert.Equal(t, "per_page", listBooks.Parameters[0].Name)
+	require.NotNil(t, listBooks.Parameters[0].Schema.Maximum)
+	assert.Equal(t, 100.0, *listBooks.Parameters[0].Schema.Maximum)
+
+	createBook := findRoute(routes, "POST", "/wp-json/library/v2/books")
+	require.NotNil(t, createBook)
+	require.NotNil(t, createBook.RequestBody)
+	body := createBook.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, []string{"title"}, body.Required)
+	assert.Equal(t, "string", body.Properties["tags"].Items.Type)
+
+	// EDITABLE is POST, PUT and PATCH
+	require.NotNil(t, findRoute(routes, "PUT", "/wp-json/library/v2/books/{id}"))
+	update := findRoute(routes, "PATCH", "/wp-json/library/v2/books/{id}")
+	require.NotNil(t, update)
+	assert.Equal(t, "integer", update.Parameters[0].Schema.Type)
+
+	// Unresolved expressions become parameters with a diagnostic
+	recent := findRoute(routes, "GET", "/wp-json/library/v2/{resource}/recent")
+	require.NotNil(t, recent)
+	require.Len(t, recent.Diagnostics, 1)
+	assert.Contains(t, recent.Diagnostics[0], "$this->resource()")
+
+	assert.Len(t, routes, 9)
+}
+
+func TestConvertPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		types    []string
+		wildcard bool
+	}{
+		{`/items/(?P<id>\d+)`, "/items/{id}", []string{"integer"}, false},
+		{`/posts/(?P<slug>[a-z0-9-]+)/comments/(?P<comment>[0-9]+)`, "/posts/{slug}/comments/{comment}", []string{"string", "integer"}, false},
+		{`^/export\.csv$`, "/export.csv", nil, false},
+		{`/media/(?P<path>.*)`, "/media/{path}", []string{"string"}, true},
+		{`/legacy/(\d+)`, "/legacy/{param1}", []string{"integer"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			path, params, wildcard := convertPattern(tt.pattern)
+			assert.Equal(t, tt.path, path)
+			var got []string
+			for _, param := range params {
+				got = append(got, param.Schema.Type)
+			}
+			assert.Equal(t, tt.types, got)
+			assert.Equal(t, tt.wildcard, wildcard)
+		})
+	}
+}
+
+func TestOptionalPaths(t *testing.T) {
+	assert.Equal(t, []string{"/items", `/items/(?P<id>\d+)`}, optionalPaths(`/items(?:/(?P<id>\d+))?`))
+	assert.Equal(t, []string{"/items"}, optionalPaths("/items"))
+}
+
+// findRoute finds a route by method and path.
+func findRoute(routes []types.Route, method, path string) *types.Route {
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			return &routes[i]
+		}
+	}
+	return nil
+}
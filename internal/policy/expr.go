@@ -0,0 +1,530 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Type is the type of a policy expression value.
+type Type int
+
+// Expression value types. Lists hold strings.
+const (
+	TypeBool Type = iota
+	TypeNumber
+	TypeString
+	TypeList
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeBool:
+		return "bool"
+	case TypeNumber:
+		return "number"
+	case TypeString:
+		return "string"
+	default:
+		return "list"
+	}
+}
+
+// Env holds the variable values an expression is evaluated with: bool,
+// float64, string or []string.
+type Env map[string]any
+
+// Expr is a compiled policy expression. The language is a small subset of
+// CEL: literals ("s", 1, true, ["a", "b"]), the comparison operators, &&,
+// ||, !, x in list, size(x), and the string methods startsWith, endsWith,
+// contains and matches.
+type Expr struct {
+	src  string
+	eval func(Env) any
+}
+
+// Compile parses src and type-checks it against vars, the variables of the
+// subject it is evaluated on. The expression must evaluate to a bool.
+func Compile(src string, vars map[string]Type) (*Expr, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks, vars: vars}
+	v, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at offset %d", tok.text, tok.pos)
+	}
+	if v.typ != TypeBool {
+		return nil, fmt.Errorf("expression is a %s, want bool", v.typ)
+	}
+	return &Expr{src: src, eval: v.eval}, nil
+}
+
+// Eval evaluates the expression. Variables missing from env have their
+// type's zero value.
+func (e *Expr) Eval(env Env) bool {
+	return e.eval(env).(bool)
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+}
+
+// operators are the punctuation tokens, longest first.
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ",", "."}
+
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(src) && rune(src[end]) != c {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(src) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			text := src[i+1 : end]
+			if c == '\'' {
+				text = singleQuoted(text)
+			}
+			value, err := strconv.Unquote(`"` + text + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d", i)
+			}
+			toks = append(toks, token{tokString, value, i})
+			i = end + 1
+		case unicode.IsDigit(c):
+			end := i
+			for end < len(src) && (unicode.IsDigit(rune(src[end])) || src[end] == '.') {
+				end++
+			}
+			toks = append(toks, token{tokNumber, src[i:end], i})
+			i = end
+		case unicode.IsLetter(c) || c == '_':
+			end := i
+			for end < len(src) && (unicode.IsLetter(rune(src[end])) || unicode.IsDigit(rune(src[end])) || src[end] == '_') {
+				end++
+			}
+			toks = append(toks, token{tokIdent, src[i:end], i})
+			i = end
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(src[i:], op) {
+					toks = append(toks, token{tokOp, op, i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+		}
+	}
+	return append(toks, token{tokEOF, "end of expression", len(src)}), nil
+}
+
+// singleQuoted rewrites the body of a single-quoted string as the body of
+// the equivalent double-quoted one.
+func singleQuoted(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text):
+			if text[i+1] != '\'' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(text[i+1])
+			i++
+		case text[i] == '"':
+			b.WriteString(`\"`)
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String()
+}
+
+// value is a type-checked subexpression. Constant string values keep their
+// text so matches can compile its pattern once.
+type value struct {
+	typ      Type
+	eval     func(Env) any
+	constant *string
+}
+
+type parser struct {
+	toks []token
+	pos  int
+	vars map[string]Type
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.toks[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the operator op if it is next.
+func (p *parser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == tokOp && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at offset %d, got %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+func (p *parser) or() (value, error) {
+	left, err := p.and()
+	if err != nil {
+		return value{}, err
+	}
+	for p.accept("||") {
+		right, err := p.and()
+		if err != nil {
+			return value{}, err
+		}
+		if err := checkTypes("||", TypeBool, left, right); err != nil {
+			return value{}, err
+		}
+		l, r := left.eval, right.eval
+		left = value{typ: TypeBool, eval: func(env Env) any { return l(env).(bool) || r(env).(bool) }}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (value, error) {
+	left, err := p.comparison()
+	if err != nil {
+		return value{}, err
+	}
+	for p.accept("&&") {
+		right, err := p.comparison()
+		if err != nil {
+			return value{}, err
+		}
+		if err := checkTypes("&&", TypeBool, left, right); err != nil {
+			return value{}, err
+		}
+		l, r := left.eval, right.eval
+		left = value{typ: TypeBool, eval: func(env Env) any { return l(env).(bool) && r(env).(bool) }}
+	}
+	return left, nil
+}
+
+func (p *parser) comparison() (value, error) {
+	left, err := p.unary()
+	if err != nil {
+		return value{}, err
+	}
+
+	tok := p.peek()
+	op := tok.text
+	switch {
+	case tok.kind == tokIdent && op == "in":
+	case tok.kind == tokOp && (op == "==" || op == "!=" || op == "<" || op == "<=" || op == ">" || op == ">="):
+	default:
+		return left, nil
+	}
+	p.next()
+	right, err := p.unary()
+	if err != nil {
+		return value{}, err
+	}
+
+	l, r := left.eval, right.eval
+	if op == "in" {
+		if left.typ != TypeString || right.typ != TypeList {
+			return value{}, fmt.Errorf("%s in %s: want a string in a list", left.typ, right.typ)
+		}
+		return value{typ: TypeBool, eval: func(env Env) any {
+			needle := l(env).(string)
+			for _, s := range r(env).([]string) {
+				if s == needle {
+					return true
+				}
+			}
+			return false
+		}}, nil
+	}
+
+	if left.typ != right.typ || left.typ == TypeList || (left.typ == TypeBool && op != "==" && op != "!=") {
+		return value{}, fmt.Errorf("cannot compare %s %s %s", left.typ, op, right.typ)
+	}
+	return value{typ: TypeBool, eval: func(env Env) any {
+		a, b := l(env), r(env)
+		if op == "==" {
+			return a == b
+		}
+		if op == "!=" {
+			return a != b
+		}
+		cmp := 0
+		switch x := a.(type) {
+		case float64:
+			if y := b.(float64); x < y {
+				cmp = -1
+			} else if x > y {
+				cmp = 1
+			}
+		case string:
+			cmp = strings.Compare(x, b.(string))
+		}
+		switch op {
+		case "<":
+			return cmp < 0
+		case "<=":
+			return cmp <= 0
+		case ">":
+			return cmp > 0
+		default:
+			return cmp >= 0
+		}
+	}}, nil
+}
+
+func (p *parser) unary() (value, error) {
+	if p.accept("!") {
+		operand, err := p.unary()
+		if err != nil {
+			return value{}, err
+		}
+		if operand.typ != TypeBool {
+			return value{}, fmt.Errorf("! needs a bool, got %s", operand.typ)
+		}
+		f := operand.eval
+		return value{typ: TypeBool, eval: func(env Env) any { return !f(env).(bool) }}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (value, error) {
+	v, err := p.primary()
+	if err != nil {
+		return value{}, err
+	}
+	for p.accept(".") {
+		tok := p.next()
+		if tok.kind != tokIdent {
+			return value{}, fmt.Errorf("expected a method name at offset %d", tok.pos)
+		}
+		args, err := p.args()
+		if err != nil {
+			return value{}, err
+		}
+		if v, err = method(v, tok.text, args); err != nil {
+			return value{}, err
+		}
+	}
+	return v, nil
+}
+
+func (p *parser) args() ([]value, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []value
+	for !p.accept(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func (p *parser) primary() (value, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return value{}, fmt.Errorf("invalid number %q at offset %d", tok.text, tok.pos)
+		}
+		return constant(TypeNumber, n), nil
+	case tokString:
+		v := constant(TypeString, tok.text)
+		v.constant = &tok.text
+		return v, nil
+	case tokIdent:
+		switch tok.text {
+		case "true", "false":
+			return constant(TypeBool, tok.text == "true"), nil
+		case "size":
+			args, err := p.args()
+			if err != nil {
+				return value{}, err
+			}
+			return size(args)
+		}
+		typ, ok := p.vars[tok.text]
+		if !ok {
+			return value{}, fmt.Errorf("unknown variable %q", tok.text)
+		}
+		name := tok.text
+		zero := zeroValue(typ)
+		return value{typ: typ, eval: func(env Env) any {
+			if v, ok := env[name]; ok {
+				return v
+			}
+			return zero
+		}}, nil
+	case tokOp:
+		switch tok.text {
+		case "(":
+			v, err := p.or()
+			if err != nil {
+				return value{}, err
+			}
+			return v, p.expect(")")
+		case "[":
+			var items []string
+			for !p.accept("]") {
+				if len(items) > 0 {
+					if err := p.expect(","); err != nil {
+						return value{}, err
+					}
+				}
+				item := p.next()
+				if item.kind != tokString {
+					return value{}, fmt.Errorf("list items must be strings, got %q at offset %d", item.text, item.pos)
+				}
+				items = append(items, item.text)
+			}
+			return constant(TypeList, items), nil
+		}
+	}
+	return value{}, fmt.Errorf("unexpected %q at offset %d", tok.text, tok.pos)
+}
+
+func constant(typ Type, v any) value {
+	return value{typ: typ, eval: func(Env) any { return v }}
+}
+
+func zeroValue(typ Type) any {
+	switch typ {
+	case TypeBool:
+		return false
+	case TypeNumber:
+		return float64(0)
+	case TypeString:
+		return ""
+	default:
+		return []string(nil)
+	}
+}
+
+func checkTypes(op string, want Type, operands ...value) error {
+	for _, operand := range operands {
+		if operand.typ != want {
+			return fmt.Errorf("%s needs %s operands, got %s", op, want, operand.typ)
+		}
+	}
+	return nil
+}
+
+// size returns the length of a string or list.
+func size(args []value) (value, error) {
+	if len(args) != 1 || (args[0].typ != TypeString && args[0].typ != TypeList) {
+		return value{}, fmt.Errorf("size takes one string or list")
+	}
+	f := args[0].eval
+	return value{typ: TypeNumber, eval: func(env Env) any {
+		if s, ok := f(env).(string); ok {
+			return float64(len(s))
+		}
+		return float64(len(f(env).([]string)))
+	}}, nil
+}
+
+// method applies a string method to recv.
+func method(recv value, name string, args []value) (value, error) {
+	if recv.typ != TypeString {
+		return value{}, fmt.Errorf("%s is not a method of %s", name, recv.typ)
+	}
+	switch name {
+	case "startsWith", "endsWith", "contains", "matches":
+	default:
+		return value{}, fmt.Errorf("unknown method %q", name)
+	}
+	if len(args) != 1 || args[0].typ != TypeString {
+		return value{}, fmt.Errorf("%s takes one string", name)
+	}
+	s, arg := recv.eval, args[0].eval
+
+	var test func(s, arg string) bool
+	switch name {
+	case "startsWith":
+		test = strings.HasPrefix
+	case "endsWith":
+		test = strings.HasSuffix
+	case "contains":
+		test = strings.Contains
+	case "matches":
+		if args[0].constant == nil {
+			return value{}, fmt.Errorf("matches takes a string literal pattern")
+		}
+		re, err := regexp.Compile(*args[0].constant)
+		if err != nil {
+			return value{}, fmt.Errorf("invalid matches pattern: %w", err)
+		}
+		test = func(s, _ string) bool { return re.MatchString(s) }
+	}
+	return value{typ: TypeBool, eval: func(env Env) any {
+		return test(s(env).(string), arg(env).(string))
+	}}, nil
+}
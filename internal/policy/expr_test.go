@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testVars = map[string]Type{
+	"method":     TypeString,
+	"path":       TypeString,
+	"responses":  TypeList,
+	"count":      TypeNumber,
+	"deprecated": TypeBool,
+}
+
+func TestCompile_Eval(t *testing.T) {
+	env := Env{
+		"method":     "POST",
+		"path":       "/api/v1/users",
+		"responses":  []string{"201", "422"},
+		"count":      float64(3),
+		"deprecated": false,
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`method == "POST"`, true},
+		{`method != 'POST'`, false},
+		{`"422" in responses`, true},
+		{`"404" in responses || deprecated`, false},
+		{`!deprecated && path.startsWith("/api")`, true},
+		{`path.endsWith("/users") && path.contains("v1")`, true},
+		{`path.matches("^/api/v[0-9]+/")`, true},
+		{`size(responses) >= 2 && size(method) == 4`, true},
+		{`count > 2.5 && count <= 3`, true},
+		{`method < "PUT"`, true},
+		{`method in ["GET", "HEAD"]`, false},
+		{`(method == "GET" || method == "POST") && !("500" in responses)`, true},
+		{`'it\'s' == "it's"`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := Compile(tt.expr, testVars)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expr.Eval(env))
+		})
+	}
+}
+
+func TestCompile_MissingVariables(t *testing.T) {
+	expr, err := Compile(`size(responses) == 0 && path == "" && !deprecated`, testVars)
+	require.NoError(t, err)
+	assert.True(t, expr.Eval(Env{}))
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := map[string]string{
+		`method`:                   "expression is a string, want bool",
+		`owner == "me"`:            `unknown variable "owner"`,
+		`method == 1`:              "cannot compare string == number",
+		`responses == ["201"]`:     "cannot compare list == list",
+		`method in "POST"`:         "want a string in a list",
+		`count && deprecated`:      "&& needs bool operands, got number",
+		`path.startsWith(1)`:       "startsWith takes one string",
+		`path.matches(method)`:     "matches takes a string literal pattern",
+		`path.matches("[")`:        "invalid matches pattern",
+		`responses.startsWith("")`: "startsWith is not a method of list",
+		`path.lower() == ""`:       `unknown method "lower"`,
+		`method == "POST`:          "unterminated string",
+		`method == "POST" )`:       `unexpected ")"`,
+		`method # "POST"`:          `unexpected '#'`,
+	}
+	for src, want := range tests {
+		t.Run(src, func(t *testing.T) {
+			_, err := Compile(src, testVars)
+			assert.ErrorContains(t, err, want)
+		})
+	}
+}
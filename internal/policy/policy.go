@@ -0,0 +1,452 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package policy evaluates organization API governance rules, written as
+// CEL-style expressions, against a generated OpenAPI document.
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Severities of rule violations, from most to least severe.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Subjects rules are evaluated on.
+const (
+	OnOperation = "operation"
+	OnParameter = "parameter"
+	OnSchema    = "schema"
+)
+
+// ExtIgnore lists the rules an operation or schema is exempt from.
+const ExtIgnore = "x-policy-ignore"
+
+// severityRank orders severities; higher is more severe.
+var severityRank = map[string]int{SeverityInfo: 1, SeverityWarning: 2, SeverityError: 3}
+
+// Variables are the variables available to the rules of each subject.
+var Variables = map[string]map[string]Type{
+	OnOperation: {
+		"method":         TypeString,
+		"path":           TypeString,
+		"operationId":    TypeString,
+		"summary":        TypeString,
+		"description":    TypeString,
+		"tags":           TypeList,
+		"responses":      TypeList,
+		"parameters":     TypeList,
+		"security":       TypeList,
+		"extensions":     TypeList,
+		"hasRequestBody": TypeBool,
+		"deprecated":     TypeBool,
+	},
+	OnParameter: {
+		"method":      TypeString,
+		"path":        TypeString,
+		"name":        TypeString,
+		"in":          TypeString,
+		"type":        TypeString,
+		"description": TypeString,
+		"required":    TypeBool,
+	},
+	OnSchema: {
+		"name":        TypeString,
+		"type":        TypeString,
+		"description": TypeString,
+		"properties":  TypeList,
+		"required":    TypeList,
+		"extensions":  TypeList,
+	},
+}
+
+// Rule is one governance rule: every subject of its kind that matches When
+// must satisfy Assert.
+type Rule struct {
+	// Name identifies the rule in output and suppressions (e.g., post-422)
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Message explains a violation (e.g., POST operations must define a 422 response)
+	Message string `mapstructure:"message" yaml:"message,omitempty" json:"message,omitempty"`
+
+	// Severity is error, warning (default) or info
+	Severity string `mapstructure:"severity" yaml:"severity,omitempty" json:"severity,omitempty"`
+
+	// On is the subject: operation (default), parameter or schema
+	On string `mapstructure:"on" yaml:"on,omitempty" json:"on,omitempty"`
+
+	// When limits the rule to subjects matching this expression
+	When string `mapstructure:"when" yaml:"when,omitempty" json:"when,omitempty"`
+
+	// Assert is the expression every selected subject must satisfy
+	Assert string `mapstructure:"assert" yaml:"assert" json:"assert"`
+}
+
+// Validate checks the rule and compiles its expressions.
+func (r Rule) Validate() error {
+	_, err := compile(r)
+	return err
+}
+
+// Suppression exempts operations or schemas from a rule.
+type Suppression struct {
+	// Rule is the suppressed rule name, or * for every rule
+	Rule string `mapstructure:"rule" yaml:"rule" json:"rule"`
+
+	// Operations are glob patterns of "METHOD /path" or "/path" (e.g., /legacy/**)
+	Operations []string `mapstructure:"operations" yaml:"operations,omitempty" json:"operations,omitempty"`
+
+	// Schemas are glob patterns of schema names
+	Schemas []string `mapstructure:"schemas" yaml:"schemas,omitempty" json:"schemas,omitempty"`
+
+	// Reason documents why the exemption exists
+	Reason string `mapstructure:"reason" yaml:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// Violation is a subject failing a rule.
+type Violation struct {
+	Rule     string
+	Severity string
+	Subject  string
+	Message  string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s [%s]", v.Subject, v.Message, v.Rule)
+}
+
+// Result is the outcome of evaluating the rules.
+type Result struct {
+	Violations []Violation
+
+	// Suppressed counts the violations suppressions exempted
+	Suppressed int
+}
+
+// Count returns the number of violations with the given severity.
+func (r *Result) Count(severity string) int {
+	n := 0
+	for _, v := range r.Violations {
+		if v.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// Failures returns the number of violations at least as severe as failOn.
+// A failOn of never, or any other unknown severity, has no failures.
+func (r *Result) Failures(failOn string) int {
+	rank, ok := severityRank[failOn]
+	if !ok {
+		return 0
+	}
+	n := 0
+	for _, v := range r.Violations {
+		if severityRank[v.Severity] >= rank {
+			n++
+		}
+	}
+	return n
+}
+
+// ValidSeverity reports whether s names a severity.
+func ValidSeverity(s string) bool {
+	_, ok := severityRank[s]
+	return ok
+}
+
+// compiledRule is a rule with its expressions compiled.
+type compiledRule struct {
+	Rule
+	when   *Expr
+	assert *Expr
+}
+
+func compile(r Rule) (*compiledRule, error) {
+	if r.Name == "" {
+		return nil, fmt.Errorf("rule name is required")
+	}
+	if r.Severity == "" {
+		r.Severity = SeverityWarning
+	}
+	if !ValidSeverity(r.Severity) {
+		return nil, fmt.Errorf("rule %s: unknown severity %q, must be error, warning or info", r.Name, r.Severity)
+	}
+	if r.On == "" {
+		r.On = OnOperation
+	}
+	vars, ok := Variables[r.On]
+	if !ok {
+		return nil, fmt.Errorf("rule %s: unknown subject %q, must be operation, parameter or schema", r.Name, r.On)
+	}
+	if r.Assert == "" {
+		return nil, fmt.Errorf("rule %s: assert is required", r.Name)
+	}
+	if r.Message == "" {
+		r.Message = "does not satisfy " + r.Assert
+	}
+
+	c := &compiledRule{Rule: r}
+	var err error
+	if r.When != "" {
+		if c.when, err = Compile(r.When, vars); err != nil {
+			return nil, fmt.Errorf("rule %s: when: %w", r.Name, err)
+		}
+	}
+	if c.assert, err = Compile(r.Assert, vars); err != nil {
+		return nil, fmt.Errorf("rule %s: assert: %w", r.Name, err)
+	}
+	return c, nil
+}
+
+// subject is an operation, parameter or schema of the document with the
+// variables rules see.
+type subject struct {
+	kind string
+	name string
+	env  Env
+
+	// operation is "METHOD /path" of operations and parameters, schema the
+	// name of schemas, for suppressions
+	operation string
+	path      string
+	schema    string
+	ignored   []string
+}
+
+// Evaluate runs rules against doc and returns the violations that
+// suppressions and x-policy-ignore extensions do not exempt.
+func Evaluate(doc *types.OpenAPI, rules []Rule, suppressions []Suppression) (*Result, error) {
+	compiled := make([]*compiledRule, 0, len(rules))
+	for _, r := range rules {
+		c, err := compile(r)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, c)
+	}
+
+	result := &Result{}
+	subjects := collect(doc)
+	for _, r := range compiled {
+		for _, s := range subjects {
+			if s.kind != r.On || (r.when != nil && !r.when.Eval(s.env)) || r.assert.Eval(s.env) {
+				continue
+			}
+			if s.exempt(r.Name, suppressions) {
+				result.Suppressed++
+				continue
+			}
+			result.Violations = append(result.Violations, Violation{
+				Rule:     r.Name,
+				Severity: r.Severity,
+				Subject:  s.name,
+				Message:  r.Message,
+			})
+		}
+	}
+	return result, nil
+}
+
+// exempt reports whether the subject is exempt from the named rule.
+func (s *subject) exempt(rule string, suppressions []Suppression) bool {
+	for _, name := range s.ignored {
+		if name == rule || name == "*" {
+			return true
+		}
+	}
+	for _, sup := range suppressions {
+		if sup.Rule != rule && sup.Rule != "*" {
+			continue
+		}
+		if s.operation != "" {
+			for _, pattern := range sup.Operations {
+				target := s.operation
+				if strings.HasPrefix(pattern, "/") {
+					target = s.path
+				}
+				if matched, _ := doublestar.Match(pattern, target); matched {
+					return true
+				}
+			}
+		}
+		if s.schema != "" {
+			for _, pattern := range sup.Schemas {
+				if matched, _ := doublestar.Match(pattern, s.schema); matched {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// collect returns the subjects of doc in a stable order.
+func collect(doc *types.OpenAPI) []*subject {
+	var subjects []*subject
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		item := doc.Paths[path]
+		for _, m := range []struct {
+			method string
+			op     *types.Operation
+		}{
+			{"GET", item.Get}, {"PUT", item.Put}, {"POST", item.Post}, {"DELETE", item.Delete},
+			{"OPTIONS", item.Options}, {"HEAD", item.Head}, {"PATCH", item.Patch}, {"TRACE", item.Trace},
+		} {
+			if m.op != nil {
+				subjects = append(subjects, operationSubjects(m.method, path, item.Parameters, m.op)...)
+			}
+		}
+	}
+
+	if doc.Components != nil {
+		names := make([]string, 0, len(doc.Components.Schemas))
+		for name := range doc.Components.Schemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if schema := doc.Components.Schemas[name]; schema != nil {
+				subjects = append(subjects, schemaSubject(name, schema))
+			}
+		}
+	}
+	return subjects
+}
+
+// operationSubjects returns the subjects of an operation and its parameters.
+func operationSubjects(method, path string, pathParams []types.Parameter, op *types.Operation) []*subject {
+	id := method + " " + path
+	ignored := ignoreList(op.Extensions)
+
+	params := append(append([]types.Parameter(nil), pathParams...), op.Parameters...)
+	var paramNames []string
+	for _, p := range params {
+		paramNames = append(paramNames, p.Name)
+	}
+	var responses []string
+	for code := range op.Responses {
+		responses = append(responses, code)
+	}
+	sort.Strings(responses)
+	var security []string
+	for _, req := range op.Security {
+		for scheme := range req {
+			security = append(security, scheme)
+		}
+	}
+	sort.Strings(security)
+
+	subjects := []*subject{{
+		kind: OnOperation,
+		name: id,
+		env: Env{
+			"method":         method,
+			"path":           path,
+			"operationId":    op.OperationID,
+			"summary":        op.Summary,
+			"description":    op.Description,
+			"tags":           op.Tags,
+			"responses":      responses,
+			"parameters":     paramNames,
+			"security":       security,
+			"extensions":     extensionNames(op.Extensions),
+			"hasRequestBody": op.RequestBody != nil,
+			"deprecated":     op.Deprecated,
+		},
+		operation: id,
+		path:      path,
+		ignored:   ignored,
+	}}
+	for _, p := range params {
+		typ := ""
+		if p.Schema != nil {
+			typ = p.Schema.Type
+		}
+		subjects = append(subjects, &subject{
+			kind: OnParameter,
+			name: fmt.Sprintf("%s %s parameter %s", id, p.In, p.Name),
+			env: Env{
+				"method":      method,
+				"path":        path,
+				"name":        p.Name,
+				"in":          p.In,
+				"type":        typ,
+				"description": p.Description,
+				"required":    p.Required,
+			},
+			operation: id,
+			path:      path,
+			ignored:   ignored,
+		})
+	}
+	return subjects
+}
+
+func schemaSubject(name string, schema *types.Schema) *subject {
+	var properties []string
+	for prop := range schema.Properties {
+		properties = append(properties, prop)
+	}
+	sort.Strings(properties)
+	return &subject{
+		kind: OnSchema,
+		name: "schema " + name,
+		env: Env{
+			"name":        name,
+			"type":        schema.Type,
+			"description": schema.Description,
+			"properties":  properties,
+			"required":    schema.Required,
+			"extensions":  extensionNames(schema.Extensions),
+		},
+		schema:  name,
+		ignored: ignoreList(schema.Extensions),
+	}
+}
+
+func extensionNames(ext types.Extensions) []string {
+	var names []string
+	for name := range ext {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ignoreList returns the rule names of an x-policy-ignore extension, which
+// is a rule name or a list of them.
+func ignoreList(ext types.Extensions) []string {
+	switch v := ext[ExtIgnore].(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		var names []string
+		for _, item := range v {
+			if name, ok := item.(string); ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func policyDoc() *types.OpenAPI {
+	return &types.OpenAPI{
+		Paths: map[string]types.PathItem{
+			"/api/users": {
+				Get: &types.Operation{
+					Parameters: []types.Parameter{{Name: "limit", In: "query", Description: "Page size"}},
+					Responses:  map[string]types.Response{"200": {}},
+				},
+				Post: &types.Operation{Responses: map[string]types.Response{"201": {}, "422": {}}},
+			},
+			"/api/orders": {
+				Post: &types.Operation{
+					Parameters: []types.Parameter{{Name: "dryRun", In: "query"}},
+					Responses:  map[string]types.Response{"201": {}},
+				},
+			},
+			"/legacy/orders": {
+				Post:   &types.Operation{Responses: map[string]types.Response{"200": {}}},
+				Delete: &types.Operation{Extensions: types.Extensions{ExtIgnore: []any{"post-422", "api-prefix"}}},
+			},
+		},
+		Components: &types.Components{Schemas: map[string]*types.Schema{
+			"User":  {Type: "object", Description: "A user"},
+			"Order": {Type: "object"},
+		}},
+	}
+}
+
+func violations(result *Result) []string {
+	var out []string
+	for _, v := range result.Violations {
+		out = append(out, v.String())
+	}
+	return out
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := []Rule{
+		{Name: "post-422", Severity: SeverityError, When: `method == "POST"`, Assert: `"422" in responses`, Message: "POST operations must define a 422 response"},
+		{Name: "api-prefix", Assert: `path.startsWith("/api")`},
+		{Name: "param-docs", On: OnParameter, Severity: SeverityInfo, When: `in == "query"`, Assert: `description != ""`, Message: "query parameters need a description"},
+		{Name: "schema-docs", On: OnSchema, Assert: `size(description) > 0`, Message: "schemas need a description"},
+	}
+
+	result, err := Evaluate(policyDoc(), rules, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`POST /api/orders: POST operations must define a 422 response [post-422]`,
+		`POST /legacy/orders: POST operations must define a 422 response [post-422]`,
+		`POST /legacy/orders: does not satisfy path.startsWith("/api") [api-prefix]`,
+		`POST /api/orders query parameter dryRun: query parameters need a description [param-docs]`,
+		`schema Order: schemas need a description [schema-docs]`,
+	}, violations(result))
+	assert.Equal(t, 1, result.Suppressed)
+
+	assert.Equal(t, 2, result.Count(SeverityError))
+	assert.Equal(t, 2, result.Failures(SeverityError))
+	assert.Equal(t, 4, result.Failures(SeverityWarning))
+	assert.Equal(t, 0, result.Failures("never"))
+}
+
+func TestEvaluate_Suppressions(t *testing.T) {
+	rules := []Rule{
+		{Name: "post-422", When: `method == "POST"`, Assert: `"422" in responses`},
+		{Name: "schema-docs", On: OnSchema, Assert: `description != ""`},
+	}
+	suppressions := []Suppression{
+		{Rule: "post-422", Operations: []string{"/legacy/**"}, Reason: "frozen API"},
+		{Rule: "*", Operations: []string{"POST /api/orders"}},
+		{Rule: "schema-docs", Schemas: []string{"Ord*"}},
+	}
+
+	result, err := Evaluate(policyDoc(), rules, suppressions)
+	require.NoError(t, err)
+	assert.Empty(t, result.Violations)
+	assert.Equal(t, 3, result.Suppressed)
+}
+
+func TestRule_Validate(t *testing.T) {
+	assert.NoError(t, Rule{Name: "ok", Assert: `deprecated || summary != ""`}.Validate())
+	assert.ErrorContains(t, Rule{Assert: "true"}.Validate(), "rule name is required")
+	assert.ErrorContains(t, Rule{Name: "r"}.Validate(), "assert is required")
+	assert.ErrorContains(t, Rule{Name: "r", Severity: "fatal", Assert: "true"}.Validate(), `unknown severity "fatal"`)
+	assert.ErrorContains(t, Rule{Name: "r", On: "path", Assert: "true"}.Validate(), `unknown subject "path"`)
+	assert.ErrorContains(t, Rule{Name: "r", On: OnSchema, Assert: `method == "GET"`}.Validate(), `rule r: assert: unknown variable "method"`)
+}
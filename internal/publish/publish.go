@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package publish uploads generated specifications to API registries and
+// documentation portals.
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Supported target types.
+const (
+	TypeSwaggerHub = "swaggerhub"
+	TypeStoplight  = "stoplight"
+	TypeReadme     = "readme"
+	TypeApigee     = "apigee"
+	TypeHTTP       = "http"
+)
+
+// Types lists the supported target types.
+var Types = []string{TypeSwaggerHub, TypeStoplight, TypeReadme, TypeApigee, TypeHTTP}
+
+// Default service base URLs, overridable per target with URL.
+var defaultBaseURLs = map[string]string{
+	TypeSwaggerHub: "https://api.swaggerhub.com",
+	TypeStoplight:  "https://api.stoplight.io",
+	TypeReadme:     "https://dash.readme.com",
+	TypeApigee:     "https://apigeeregistry.googleapis.com",
+}
+
+// Target describes where a specification is published. Token, URL, and
+// header values may reference environment variables as ${VAR} so secrets
+// stay out of the config file.
+type Target struct {
+	// Name identifies the target for --target selection
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Type is the registry type (swaggerhub, stoplight, readme, apigee, http)
+	Type string `mapstructure:"type" yaml:"type" json:"type"`
+
+	// URL is the endpoint for http targets and overrides the service base URL for others
+	URL string `mapstructure:"url" yaml:"url,omitempty" json:"url,omitempty"`
+
+	// Method is the HTTP method for http targets (default PUT)
+	Method string `mapstructure:"method" yaml:"method,omitempty" json:"method,omitempty"`
+
+	// Headers are extra request headers
+	Headers map[string]string `mapstructure:"headers" yaml:"headers,omitempty" json:"headers,omitempty"`
+
+	// Token is the API key or bearer token
+	Token string `mapstructure:"token" yaml:"token,omitempty" json:"token,omitempty"`
+
+	// Owner is the SwaggerHub owner or the Apigee project
+	Owner string `mapstructure:"owner" yaml:"owner,omitempty" json:"owner,omitempty"`
+
+	// API is the SwaggerHub or Apigee API name
+	API string `mapstructure:"api" yaml:"api,omitempty" json:"api,omitempty"`
+
+	// Version is the API version (SwaggerHub, Apigee, ReadMe docs version)
+	Version string `mapstructure:"version" yaml:"version,omitempty" json:"version,omitempty"`
+
+	// ID is the Stoplight version ID, ReadMe spec ID, or Apigee spec ID
+	ID string `mapstructure:"id" yaml:"id,omitempty" json:"id,omitempty"`
+
+	// Private publishes the SwaggerHub API as private
+	Private bool `mapstructure:"private" yaml:"private,omitempty" json:"private,omitempty"`
+}
+
+// Spec is the specification document being published.
+type Spec struct {
+	// Path is the spec file path, used for filenames and format detection
+	Path string
+
+	// Content is the raw spec document
+	Content []byte
+}
+
+// IsJSON reports whether the spec is a JSON document.
+func (s Spec) IsJSON() bool {
+	return strings.EqualFold(filepath.Ext(s.Path), ".json")
+}
+
+// contentType returns the media type of the spec document.
+func (s Spec) contentType() string {
+	if s.IsJSON() {
+		return "application/json"
+	}
+	return "application/yaml"
+}
+
+// Validate reports missing or invalid settings for the target type.
+func (t Target) Validate() error {
+	var missing []string
+	require := func(field, value string) {
+		if value == "" {
+			missing = append(missing, field)
+		}
+	}
+
+	switch t.Type {
+	case TypeSwaggerHub:
+		require("token", t.Token)
+		require("owner", t.Owner)
+		require("api", t.API)
+	case TypeStoplight:
+		require("token", t.Token)
+		require("id", t.ID)
+	case TypeReadme:
+		require("token", t.Token)
+	case TypeApigee:
+		require("token", t.Token)
+		require("owner", t.Owner)
+		require("api", t.API)
+		require("version", t.Version)
+		require("id", t.ID)
+	case TypeHTTP:
+		require("url", t.URL)
+	default:
+		return fmt.Errorf("unsupported target type %q, must be one of: %s", t.Type, strings.Join(Types, ", "))
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s target requires %s", t.Type, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Label returns the target's name, falling back to its type.
+func (t Target) Label() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.Type
+}
+
+// NewRequest builds the upload request for the target.
+func NewRequest(ctx context.Context, t Target, spec Spec) (*http.Request, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	token := os.ExpandEnv(t.Token)
+	base := strings.TrimSuffix(os.ExpandEnv(t.URL), "/")
+	if base == "" {
+		base = defaultBaseURLs[t.Type]
+	}
+
+	var req *http.Request
+	var err error
+
+	switch t.Type {
+	case TypeSwaggerHub:
+		query := url.Values{}
+		query.Set("isPrivate", fmt.Sprint(t.Private))
+		query.Set("force", "true")
+		if t.Version != "" {
+			query.Set("version", t.Version)
+		}
+		endpoint := fmt.Sprintf("%s/apis/%s/%s?%s", base, url.PathEscape(t.Owner), url.PathEscape(t.API), query.Encode())
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(spec.Content))
+		if err == nil {
+			req.Header.Set("Content-Type", spec.contentType())
+			req.Header.Set("Authorization", token)
+		}
+
+	case TypeStoplight:
+		body, _ := json.Marshal(map[string]string{"specData": string(spec.Content)})
+		endpoint := fmt.Sprintf("%s/v1/versions/%s/import", base, url.PathEscape(t.ID))
+		req, err = http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+	case TypeReadme:
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, _ := form.CreateFormFile("spec", filepath.Base(spec.Path))
+		_, _ = part.Write(spec.Content)
+		_ = form.Close()
+
+		// Without an ID ReadMe creates a new spec instead of updating one.
+		method, endpoint := http.MethodPost, base+"/api/v1/api-specification"
+		if t.ID != "" {
+			method, endpoint = http.MethodPut, endpoint+"/"+url.PathEscape(t.ID)
+		}
+		req, err = http.NewRequestWithContext(ctx, method, endpoint, &body)
+		if err == nil {
+			req.Header.Set("Content-Type", form.FormDataContentType())
+			req.SetBasicAuth(token, "")
+			if t.Version != "" {
+				req.Header.Set("x-readme-version", t.Version)
+			}
+		}
+
+	case TypeApigee:
+		mimeType := "application/x.openapi;version=3"
+		body, _ := json.Marshal(map[string]string{
+			"filename": filepath.Base(spec.Path),
+			"mimeType": mimeType,
+			"contents": base64.StdEncoding.EncodeToString(spec.Content),
+		})
+		endpoint := fmt.Sprintf("%s/v1/projects/%s/locations/global/apis/%s/versions/%s/specs?apiSpecId=%s",
+			base, url.PathEscape(t.Owner), url.PathEscape(t.API), url.PathEscape(t.Version), url.QueryEscape(t.ID))
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+	case TypeHTTP:
+		method := strings.ToUpper(t.Method)
+		if method == "" {
+			method = http.MethodPut
+		}
+		req, err = http.NewRequestWithContext(ctx, method, base, bytes.NewReader(spec.Content))
+		if err == nil {
+			req.Header.Set("Content-Type", spec.contentType())
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s request: %w", t.Type, err)
+	}
+
+	for name, value := range t.Headers {
+		req.Header.Set(name, os.ExpandEnv(value))
+	}
+	return req, nil
+}
+
+// Publish uploads spec to the target, failing on non-2xx responses.
+func Publish(ctx context.Context, client *http.Client, t Target, spec Spec) error {
+	req, err := NewRequest(ctx, t, spec)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", t.Label(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s %s returned %s: %s",
+			t.Label(), req.Method, req.URL.Redacted(), resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
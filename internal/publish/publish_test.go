@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package publish
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = "openapi: 3.0.3\ninfo:\n  title: Test\n  version: 1.0.0\npaths: {}\n"
+
+// recorded captures the last request received by the test server.
+type recorded struct {
+	method string
+	uri    string
+	header http.Header
+	body   []byte
+	form   []byte
+}
+
+func newServer(t *testing.T, status int) (*httptest.Server, *recorded) {
+	t.Helper()
+	rec := &recorded{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.method = r.Method
+		rec.uri = r.URL.RequestURI()
+		rec.header = r.Header.Clone()
+		if file, _, err := r.FormFile("spec"); err == nil {
+			rec.form, _ = io.ReadAll(file)
+		} else {
+			rec.body, _ = io.ReadAll(r.Body)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"done"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, rec
+}
+
+func TestPublish_SwaggerHub(t *testing.T) {
+	srv, rec := newServer(t, http.StatusCreated)
+	t.Setenv("SWAGGERHUB_API_KEY", "sh-key")
+
+	target := Target{
+		Type:    TypeSwaggerHub,
+		URL:     srv.URL,
+		Token:   "${SWAGGERHUB_API_KEY}",
+		Owner:   "acme",
+		API:     "orders",
+		Version: "1.2.0",
+		Private: true,
+	}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.yaml", Content: []byte(testSpec)})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, rec.method)
+	assert.Equal(t, "/apis/acme/orders?force=true&isPrivate=true&version=1.2.0", rec.uri)
+	assert.Equal(t, "sh-key", rec.header.Get("Authorization"))
+	assert.Equal(t, "application/yaml", rec.header.Get("Content-Type"))
+	assert.Equal(t, testSpec, string(rec.body))
+}
+
+func TestPublish_Stoplight(t *testing.T) {
+	srv, rec := newServer(t, http.StatusOK)
+
+	target := Target{Type: TypeStoplight, URL: srv.URL, Token: "sl-token", ID: "v123"}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.yaml", Content: []byte(testSpec)})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/v1/versions/v123/import", rec.uri)
+	assert.Equal(t, "Bearer sl-token", rec.header.Get("Authorization"))
+
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(rec.body, &body))
+	assert.Equal(t, testSpec, body["specData"])
+}
+
+func TestPublish_Readme(t *testing.T) {
+	srv, rec := newServer(t, http.StatusOK)
+
+	target := Target{Type: TypeReadme, URL: srv.URL, Token: "rdme", ID: "spec42", Version: "2.0"}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.json", Content: []byte(`{}`)})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/api/v1/api-specification/spec42", rec.uri)
+	assert.Equal(t, "2.0", rec.header.Get("x-readme-version"))
+	user, _, ok := (&http.Request{Header: rec.header}).BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "rdme", user)
+	assert.Equal(t, `{}`, string(rec.form))
+
+	// Without an ID a new spec is created
+	target.ID = ""
+	require.NoError(t, Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.json", Content: []byte(`{}`)}))
+	assert.Equal(t, http.MethodPost, rec.method)
+	assert.Equal(t, "/api/v1/api-specification", rec.uri)
+}
+
+func TestPublish_Apigee(t *testing.T) {
+	srv, rec := newServer(t, http.StatusOK)
+
+	target := Target{Type: TypeApigee, URL: srv.URL, Token: "gcp", Owner: "my-project", API: "orders", Version: "v1", ID: "openapi"}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.yaml", Content: []byte(testSpec)})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, rec.method)
+	assert.Equal(t, "/v1/projects/my-project/locations/global/apis/orders/versions/v1/specs?apiSpecId=openapi", rec.uri)
+	assert.Equal(t, "Bearer gcp", rec.header.Get("Authorization"))
+
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(rec.body, &body))
+	assert.Equal(t, "openapi.yaml", body["filename"])
+	contents, err := base64.StdEncoding.DecodeString(body["contents"])
+	require.NoError(t, err)
+	assert.Equal(t, testSpec, string(contents))
+}
+
+func TestPublish_HTTP(t *testing.T) {
+	srv, rec := newServer(t, http.StatusNoContent)
+	t.Setenv("SPEC_STORE_KEY", "s3cret")
+
+	target := Target{
+		Type:    TypeHTTP,
+		URL:     srv.URL + "/specs/orders.json",
+		Headers: map[string]string{"X-Api-Key": "${SPEC_STORE_KEY}"},
+	}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.json", Content: []byte(`{}`)})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, rec.method)
+	assert.Equal(t, "/specs/orders.json", rec.uri)
+	assert.Equal(t, "s3cret", rec.header.Get("X-Api-Key"))
+	assert.Equal(t, "application/json", rec.header.Get("Content-Type"))
+	assert.Empty(t, rec.header.Get("Authorization"))
+}
+
+func TestPublish_ErrorStatus(t *testing.T) {
+	srv, _ := newServer(t, http.StatusUnauthorized)
+
+	target := Target{Name: "store", Type: TypeHTTP, URL: srv.URL, Method: "post"}
+	err := Publish(context.Background(), srv.Client(), target, Spec{Path: "openapi.yaml", Content: []byte(testSpec)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "store: POST")
+	assert.Contains(t, err.Error(), "401 Unauthorized")
+}
+
+func TestTarget_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  Target
+		wantErr string
+	}{
+		{"swaggerhub missing fields", Target{Type: TypeSwaggerHub, Token: "k"}, "swaggerhub target requires owner, api"},
+		{"stoplight missing id", Target{Type: TypeStoplight, Token: "k"}, "stoplight target requires id"},
+		{"readme ok", Target{Type: TypeReadme, Token: "k"}, ""},
+		{"apigee missing token", Target{Type: TypeApigee, Owner: "p", API: "a", Version: "v", ID: "s"}, "apigee target requires token"},
+		{"http missing url", Target{Type: TypeHTTP}, "http target requires url"},
+		{"unknown type", Target{Type: "ftp"}, "unsupported target type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.target.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
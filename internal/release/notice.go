@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CheckInterval is how often the latest release is looked up for the
+// new-version notice.
+const CheckInterval = 24 * time.Hour
+
+// checkState is the cached result of the last lookup.
+type checkState struct {
+	// CheckedAt is when the latest release was looked up
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Latest is the latest release version
+	Latest string `json:"latest"`
+}
+
+// CachePath returns the file caching the latest release version.
+func CachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "api2spec", "version-check.json"), nil
+}
+
+// Cached returns the latest release version recorded at cachePath and
+// whether it is older than CheckInterval and due to be looked up again.
+func Cached(cachePath string) (latest string, stale bool) {
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		return "", true
+	}
+	var state checkState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return "", true
+	}
+	return state.Latest, time.Since(state.CheckedAt) > CheckInterval
+}
+
+// Refresh looks up the latest release and records its version at
+// cachePath. Failed lookups are recorded too, so an offline machine does
+// not retry on every run.
+func (c *Client) Refresh(ctx context.Context, cachePath string) (string, error) {
+	latest, _ := Cached(cachePath)
+	r, err := c.Get(ctx, "")
+	if err == nil {
+		latest = r.Version
+	}
+	data, _ := json.Marshal(checkState{CheckedAt: time.Now(), Latest: latest})
+	if mkErr := os.MkdirAll(filepath.Dir(cachePath), 0o755); mkErr == nil {
+		_ = os.WriteFile(cachePath, data, 0o644)
+	}
+	return latest, err
+}
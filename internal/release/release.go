@@ -0,0 +1,396 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package release looks up api2spec releases, verifies their artifacts and
+// replaces the running binary with a newer one.
+package release
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultURL is the GitHub releases endpoint of api2spec.
+const DefaultURL = "https://api.github.com/repos/api2spec/api2spec/releases"
+
+// Release artifacts besides the archives.
+const (
+	// ChecksumsAsset lists the SHA-256 digest of every archive
+	ChecksumsAsset = "checksums.txt"
+
+	// SignatureAsset is the cosign signature of the checksums
+	SignatureAsset = ChecksumsAsset + ".sig"
+
+	// CertificateAsset is the certificate of the keyless cosign signature
+	CertificateAsset = ChecksumsAsset + ".pem"
+)
+
+// Keyless signatures must come from the release workflow of the
+// repository.
+const (
+	certificateIdentity = `^https://github\.com/api2spec/api2spec/\.github/workflows/`
+	certificateIssuer   = "https://token.actions.githubusercontent.com"
+)
+
+// ErrNoSignature is returned by Verify when a release is not signed.
+var ErrNoSignature = errors.New("release has no checksum signature")
+
+// Release is a published release.
+type Release struct {
+	// Version is the release tag, e.g. v1.4.0
+	Version string `json:"tag_name"`
+
+	// URL is the release page
+	URL string `json:"html_url"`
+
+	// Assets are the release artifacts
+	Assets []Asset `json:"assets"`
+}
+
+// Asset is a release artifact.
+type Asset struct {
+	// Name is the file name
+	Name string `json:"name"`
+
+	// URL downloads the artifact
+	URL string `json:"browser_download_url"`
+}
+
+// Asset returns the artifact named name.
+func (r *Release) Asset(name string) (Asset, bool) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, true
+		}
+	}
+	return Asset{}, false
+}
+
+// Client reads release metadata and artifacts.
+type Client struct {
+	// URL is the releases endpoint
+	URL string
+
+	// HTTP is the client requests are made with
+	HTTP *http.Client
+}
+
+// NewClient returns a client of the releases endpoint at baseURL, or of
+// DefaultURL when empty.
+func NewClient(baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Client{
+		URL:  strings.TrimSuffix(baseURL, "/"),
+		HTTP: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+// Get returns the release tagged version, or the latest release when
+// version is empty.
+func (c *Client) Get(ctx context.Context, version string) (*Release, error) {
+	endpoint := c.URL + "/latest"
+	if version != "" {
+		if !strings.HasPrefix(version, "v") {
+			version = "v" + version
+		}
+		endpoint = c.URL + "/tags/" + url.PathEscape(version)
+	}
+	data, err := c.Download(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var r Release
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode release metadata: %w", err)
+	}
+	if r.Version == "" {
+		return nil, fmt.Errorf("release metadata from %s has no version", endpoint)
+	}
+	return &r, nil
+}
+
+// Download returns the content at rawURL.
+func (c *Client) Download(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json, application/octet-stream")
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", rawURL, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", rawURL, err)
+	}
+	return data, nil
+}
+
+// ArchiveName returns the name of the release archive for a platform, e.g.
+// api2spec_1.4.0_linux_amd64.tar.gz.
+func ArchiveName(version, goos, goarch string) string {
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("api2spec_%s_%s_%s%s", strings.TrimPrefix(version, "v"), goos, goarch, ext)
+}
+
+// Newer reports whether version latest is newer than current. Versions are
+// compared as semantic versions; a pre-release is older than its release.
+// Development builds are never older.
+func Newer(latest, current string) bool {
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	for i := range 3 {
+		if l.numbers[i] != c.numbers[i] {
+			return l.numbers[i] > c.numbers[i]
+		}
+	}
+	switch {
+	case l.pre == c.pre:
+		return false
+	case l.pre == "":
+		return true
+	case c.pre == "":
+		return false
+	}
+	return l.pre > c.pre
+}
+
+type version struct {
+	numbers [3]int
+	pre     string
+}
+
+func parseVersion(s string) (version, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	s, _, _ = strings.Cut(s, "+")
+	s, pre, _ := strings.Cut(s, "-")
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return version{}, false
+	}
+	var v version
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version{}, false
+		}
+		v.numbers[i] = n
+	}
+	v.pre = pre
+	return v, true
+}
+
+// VerifyChecksum checks data against the digest checksums lists for name,
+// in the sha256sum format.
+func VerifyChecksum(checksums []byte, name string, data []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+		sum := sha256.Sum256(data)
+		if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, fields[0]) {
+			return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", name, fields[0], got)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s lists no checksum for %s", ChecksumsAsset, name)
+}
+
+// Verify downloads the checksums of r, checks their cosign signature and
+// checks archive against them. It returns ErrNoSignature, having checked
+// the checksum, when r is not signed and allowUnsigned is set.
+func (c *Client) Verify(ctx context.Context, r *Release, name string, archive []byte, allowUnsigned bool) error {
+	asset, ok := r.Asset(ChecksumsAsset)
+	if !ok {
+		return fmt.Errorf("release %s has no %s", r.Version, ChecksumsAsset)
+	}
+	checksums, err := c.Download(ctx, asset.URL)
+	if err != nil {
+		return err
+	}
+
+	sig, hasSig := r.Asset(SignatureAsset)
+	cert, hasCert := r.Asset(CertificateAsset)
+	if !hasSig || !hasCert {
+		if !allowUnsigned {
+			return fmt.Errorf("%w %s", ErrNoSignature, r.Version)
+		}
+		if err := VerifyChecksum(checksums, name, archive); err != nil {
+			return err
+		}
+		return ErrNoSignature
+	}
+
+	dir, err := os.MkdirTemp("", "api2spec-release-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{ChecksumsAsset: "", SignatureAsset: sig.URL, CertificateAsset: cert.URL}
+	for file, assetURL := range files {
+		data := checksums
+		if assetURL != "" {
+			if data, err = c.Download(ctx, assetURL); err != nil {
+				return err
+			}
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file, err)
+		}
+	}
+	if err := verifySignature(dir); err != nil {
+		return err
+	}
+	return VerifyChecksum(checksums, name, archive)
+}
+
+// verifySignature checks the keyless cosign signature of the checksums in
+// dir.
+func verifySignature(dir string) error {
+	if _, err := exec.LookPath("cosign"); err != nil {
+		return fmt.Errorf("cosign not found in PATH, needed to verify the release signature: %w", err)
+	}
+	cmd := exec.Command("cosign", "verify-blob",
+		"--signature", filepath.Join(dir, SignatureAsset),
+		"--certificate", filepath.Join(dir, CertificateAsset),
+		"--certificate-identity-regexp", certificateIdentity,
+		"--certificate-oidc-issuer", certificateIssuer,
+		filepath.Join(dir, ChecksumsAsset))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("release signature verification failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// ExtractBinary returns the api2spec executable inside a .tar.gz or .zip
+// release archive.
+func ExtractBinary(archive []byte, name string) ([]byte, error) {
+	binary := "api2spec"
+	if strings.HasSuffix(name, ".zip") {
+		binary += ".exe"
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		for _, f := range zr.File {
+			if path.Base(f.Name) != binary {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract %s: %w", f.Name, err)
+			}
+			defer rc.Close()
+			return io.ReadAll(rc)
+		}
+		return nil, fmt.Errorf("%s contains no %s", name, binary)
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s contains no %s", name, binary)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if header.Typeflag == tar.TypeReg && path.Base(header.Name) == binary {
+			return io.ReadAll(tr)
+		}
+	}
+}
+
+// Replace atomically replaces the executable at path with binary, keeping
+// its permissions.
+func Replace(path string, binary []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".api2spec-upgrade-*")
+	if err != nil {
+		return fmt.Errorf("failed to write next to %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tmp.Name(), err)
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()|0o111); err != nil {
+		return fmt.Errorf("failed to make %s executable: %w", tmp.Name(), err)
+	}
+
+	// Windows cannot replace a running executable, only rename it
+	return swap(tmp.Name(), path, runtime.GOOS == "windows")
+}
+
+// swap renames next over path. With moveAside, path is first renamed to
+// path.old, and moved back when next cannot take its place.
+func swap(next, path string, moveAside bool) error {
+	if !moveAside {
+		if err := os.Rename(next, path); err != nil {
+			return fmt.Errorf("failed to replace %s: %w", path, err)
+		}
+		return nil
+	}
+
+	old := path + ".old"
+	_ = os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		return fmt.Errorf("failed to move %s aside: %w", path, err)
+	}
+	if err := os.Rename(next, path); err != nil {
+		if rerr := os.Rename(old, path); rerr != nil {
+			return fmt.Errorf("failed to replace %s: %w (restoring it from %s also failed: %v)", path, err, old, rerr)
+		}
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package release
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tarGz returns a .tar.gz archive holding files.
+func tarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// newServer serves the v1.5.0 release with archive and, when signed, a
+// checksum signature.
+func newServer(t *testing.T, archive []byte, signed bool) *httptest.Server {
+	sum := sha256.Sum256(archive)
+	checksums := hex.EncodeToString(sum[:]) + "  api2spec_1.5.0_linux_amd64.tar.gz\n"
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases/latest", "/releases/tags/v1.5.0":
+			assets := []Asset{
+				{Name: "api2spec_1.5.0_linux_amd64.tar.gz", URL: srv.URL + "/download/archive"},
+				{Name: ChecksumsAsset, URL: srv.URL + "/download/checksums"},
+			}
+			if signed {
+				assets = append(assets, Asset{Name: SignatureAsset, URL: srv.URL + "/download/sig"}, Asset{Name: CertificateAsset, URL: srv.URL + "/download/pem"})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"tag_name": "v1.5.0", "html_url": "https://example.com/v1.5.0", "assets": assets})
+		case "/download/archive":
+			_, _ = w.Write(archive)
+		case "/download/checksums":
+			_, _ = w.Write([]byte(checksums))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClient_Get(t *testing.T) {
+	srv := newServer(t, nil, false)
+	client := NewClient(srv.URL + "/releases/")
+
+	latest, err := client.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.5.0", latest.Version)
+	assert.Equal(t, "https://example.com/v1.5.0", latest.URL)
+	_, ok := latest.Asset(ChecksumsAsset)
+	assert.True(t, ok)
+
+	tagged, err := client.Get(context.Background(), "1.5.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.5.0", tagged.Version)
+
+	_, err = client.Get(context.Background(), "v9.9.9")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestClient_Verify(t *testing.T) {
+	archive := tarGz(t, map[string]string{"api2spec": "binary"})
+	srv := newServer(t, archive, false)
+	client := NewClient(srv.URL + "/releases")
+	r, err := client.Get(context.Background(), "")
+	require.NoError(t, err)
+	name := ArchiveName(r.Version, "linux", "amd64")
+
+	err = client.Verify(context.Background(), r, name, archive, false)
+	assert.ErrorIs(t, err, ErrNoSignature, "unsigned releases are refused by default")
+
+	err = client.Verify(context.Background(), r, name, archive, true)
+	assert.ErrorIs(t, err, ErrNoSignature)
+
+	err = client.Verify(context.Background(), r, name, []byte("tampered"), true)
+	assert.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestNewer(t *testing.T) {
+	tests := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"v1.5.0", "v1.4.9", true},
+		{"v1.10.0", "1.9.0", true},
+		{"v1.4.0", "v1.4.0", false},
+		{"v1.4.0", "v1.5.0", false},
+		{"v1.4.0", "v1.4.0-rc.1", true},
+		{"v1.4.0-rc.2", "v1.4.0-rc.1", true},
+		{"v1.4.0-rc.1", "v1.4.0", false},
+		{"v1.5.0", "dev", false},
+		{"", "v1.4.0", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Newer(tt.latest, tt.current), "%s over %s", tt.latest, tt.current)
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("archive")
+	sum := sha256.Sum256(data)
+	checksums := []byte("0000  other.tar.gz\n" + hex.EncodeToString(sum[:]) + " *api2spec.tar.gz\n")
+
+	assert.NoError(t, VerifyChecksum(checksums, "api2spec.tar.gz", data))
+	assert.ErrorContains(t, VerifyChecksum(checksums, "other.tar.gz", data), "checksum mismatch")
+	assert.ErrorContains(t, VerifyChecksum(checksums, "missing.tar.gz", data), "lists no checksum")
+}
+
+func TestExtractBinary(t *testing.T) {
+	archive := tarGz(t, map[string]string{"README.md": "readme", "api2spec_1.5.0/api2spec": "binary"})
+
+	binary, err := ExtractBinary(archive, "api2spec_1.5.0_linux_amd64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(binary))
+
+	_, err = ExtractBinary(tarGz(t, map[string]string{"README.md": "readme"}), "api2spec.tar.gz")
+	assert.ErrorContains(t, err, "contains no api2spec")
+}
+
+func TestReplace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api2spec")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o755))
+
+	require.NoError(t, Replace(path, []byte("new")))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+}
+
+func TestSwap_MoveAside(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api2spec")
+	next := filepath.Join(dir, "next")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o755))
+	require.NoError(t, os.WriteFile(next, []byte("new"), 0o755))
+
+	require.NoError(t, swap(next, path, true))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	data, err = os.ReadFile(path + ".old")
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+}
+
+func TestSwap_RestoresOldBinary(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api2spec")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o755))
+
+	err := swap(filepath.Join(dir, "missing"), path, true)
+	assert.ErrorContains(t, err, "failed to replace")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+	assert.NoFileExists(t, path+".old")
+}
+
+func TestRefresh(t *testing.T) {
+	srv := newServer(t, nil, false)
+	cachePath := filepath.Join(t.TempDir(), "api2spec", "version-check.json")
+
+	latest, stale := Cached(cachePath)
+	assert.Empty(t, latest)
+	assert.True(t, stale)
+
+	latest, err := NewClient(srv.URL+"/releases").Refresh(context.Background(), cachePath)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.5.0", latest)
+
+	latest, stale = Cached(cachePath)
+	assert.Equal(t, "v1.5.0", latest)
+	assert.False(t, stale)
+
+	_, err = NewClient(srv.URL+"/missing").Refresh(context.Background(), cachePath)
+	require.Error(t, err)
+	latest, stale = Cached(cachePath)
+	assert.Equal(t, "v1.5.0", latest, "failed lookups keep the last known version")
+	assert.False(t, stale)
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package scanner
+
+import (
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+const (
+	// IgnoreFile lists, in gitignore syntax, paths below its directory that
+	// are never scanned (vendored SDKs, examples, generated clients).
+	IgnoreFile = ".api2specignore"
+
+	// SkipFile marks a directory whose whole tree is never scanned.
+	SkipFile = ".api2spec-skip"
+)
+
+// ignoreRule is one pattern line of an ignore file.
+type ignoreRule struct {
+	// base is the slash-separated directory of the ignore file ("." for the
+	// scanned root) that pattern is relative to
+	base string
+
+	// pattern is a doublestar glob matched against paths relative to base
+	pattern string
+
+	// negate re-includes what earlier rules ignored (!pattern)
+	negate bool
+
+	// dirOnly matches directories only (pattern/)
+	dirOnly bool
+}
+
+// parseIgnore parses the lines of the ignore file of directory base.
+func parseIgnore(base string, data []byte) []ignoreRule {
+	var rules []ignoreRule
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := ignoreRule{base: base}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\#`) || strings.HasPrefix(line, `\!`) {
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+
+		// Patterns without an inner slash match at any depth
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+		if !doublestar.ValidatePattern(line) {
+			continue
+		}
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// ignored reports whether the last of rules matching the slash-separated
+// path name, relative to the scanned root, ignores it.
+func ignored(rules []ignoreRule, name string, isDir bool) bool {
+	result := false
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		rel := name
+		if rule.base != "." {
+			rel = strings.TrimPrefix(name, rule.base+"/")
+		}
+		if matched, _ := doublestar.Match(rule.pattern, rel); !matched {
+			continue
+		}
+		// A trailing /** matches what is inside a directory, not itself
+		if dir, ok := strings.CutSuffix(rule.pattern, "/**"); ok {
+			if self, _ := doublestar.Match(dir, rel); self {
+				continue
+			}
+		}
+		result = !rule.negate
+	}
+	return result
+}
+
+// ignoreMatcher applies the ignore and skip files of a tree, whether on disk
+// or in a revision, caching the rules in effect in each directory.
+type ignoreMatcher struct {
+	// read returns the content of the ignore file of a directory, if any
+	read func(dir string) ([]byte, bool)
+
+	// marked reports whether a directory holds a skip file
+	marked func(dir string) bool
+
+	rules map[string][]ignoreRule
+}
+
+func newIgnoreMatcher(read func(dir string) ([]byte, bool), marked func(dir string) bool) *ignoreMatcher {
+	return &ignoreMatcher{read: read, marked: marked, rules: make(map[string][]ignoreRule)}
+}
+
+// rulesFor returns the rules in effect in the slash-separated directory dir:
+// those of its ancestors followed by those of its own ignore file.
+func (m *ignoreMatcher) rulesFor(dir string) []ignoreRule {
+	if rules, ok := m.rules[dir]; ok {
+		return rules
+	}
+	var rules []ignoreRule
+	if dir != "." {
+		rules = m.rulesFor(path.Dir(dir))
+	}
+	if data, ok := m.read(dir); ok {
+		rules = append(append([]ignoreRule(nil), rules...), parseIgnore(dir, data)...)
+	}
+	m.rules[dir] = rules
+	return rules
+}
+
+// skipsDir reports whether the directory dir holds a skip file or is
+// ignored by the rules of its parent.
+func (m *ignoreMatcher) skipsDir(dir string) bool {
+	if m.marked(dir) {
+		return true
+	}
+	return dir != "." && ignored(m.rulesFor(path.Dir(dir)), dir, true)
+}
+
+// ignoresFile reports whether the rules of its directory ignore the file
+// name. Its ancestors are not checked.
+func (m *ignoreMatcher) ignoresFile(name string) bool {
+	return ignored(m.rulesFor(path.Dir(name)), name, false)
+}
+
+// excludes reports whether the file name or any directory containing it is
+// skipped or ignored.
+func (m *ignoreMatcher) excludes(name string) bool {
+	var dirs []string
+	for dir := path.Dir(name); ; dir = path.Dir(dir) {
+		dirs = append(dirs, dir)
+		if dir == "." {
+			break
+		}
+	}
+	// Outermost first, so the rules of skipped directories are never read
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if m.skipsDir(dirs[i]) {
+			return true
+		}
+	}
+	return m.ignoresFile(name)
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package scanner
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ignoreTestFiles is a project with a vendored SDK, examples and a
+// generated client that must not contribute routes.
+var ignoreTestFiles = map[string]string{
+	IgnoreFile: "# not ours\n" +
+		"sdk/\n" +
+		"/examples/*.go\n" +
+		"!/examples/keep.go\n" +
+		"*.gen.go\n",
+	"main.go":                "package main",
+	"api/users.go":           "package api",
+	"api/users.gen.go":       "package api",
+	"sdk/client.go":          "package sdk",
+	"lib/sdk/client.go":      "package sdk",
+	"examples/demo.go":       "package examples",
+	"examples/keep.go":       "package examples",
+	"examples/nested/run.go": "package nested",
+	"clients/" + SkipFile:    "",
+	"clients/go/client.go":   "package client",
+	"web/" + IgnoreFile:      "legacy/**\n",
+	"web/routes.ts":          "export {}",
+	"web/legacy/routes.ts":   "export {}",
+	"legacy/routes.ts":       "export {}",
+}
+
+var ignoreTestScanned = []string{
+	"api/users.go",
+	"examples/keep.go",
+	"examples/nested/run.go",
+	"legacy/routes.ts",
+	"main.go",
+	"web/routes.ts",
+}
+
+func TestScanner_Scan_IgnoreFiles(t *testing.T) {
+	dir := setupTestDir(t, ignoreTestFiles)
+
+	files, err := New(Config{BasePath: dir}).Scan()
+	require.NoError(t, err)
+	assert.Equal(t, ignoreTestScanned, relPaths(t, dir, files))
+
+	count, err := New(Config{BasePath: dir}).FileCount()
+	require.NoError(t, err)
+	assert.Equal(t, len(ignoreTestScanned), count)
+}
+
+func TestScanner_ScanTree_IgnoreFiles(t *testing.T) {
+	var names []string
+	for name := range ignoreTestFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	base := t.TempDir()
+	files, err := New(Config{BasePath: base}).ScanTree(context.Background(), names, func(name string) ([]byte, error) {
+		return []byte(ignoreTestFiles[name]), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ignoreTestScanned, relPaths(t, base, files))
+}
+
+func TestScanner_Scan_SkipFileAtRoot(t *testing.T) {
+	dir := setupTestDir(t, map[string]string{
+		SkipFile:  "",
+		"main.go": "package main",
+	})
+
+	files, err := New(Config{BasePath: dir}).Scan()
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestIgnored(t *testing.T) {
+	rules := parseIgnore(".", []byte("build/\n/docs\n**/testdata/**\n!build.go\n\\#hash.go\n"))
+
+	assert.True(t, ignored(rules, "build", true))
+	assert.True(t, ignored(rules, "pkg/build", true))
+	assert.False(t, ignored(rules, "build", false))
+	assert.True(t, ignored(rules, "docs", true))
+	assert.False(t, ignored(rules, "pkg/docs", true))
+	assert.True(t, ignored(rules, "pkg/testdata/routes.go", false))
+	assert.False(t, ignored(rules, "pkg/testdata", true))
+	assert.True(t, ignored(rules, "#hash.go", false))
+	assert.False(t, ignored(rules, "main.go", false))
+
+	nested := parseIgnore("pkg", []byte("*.go\n!main.go\n"))
+	assert.True(t, ignored(nested, "pkg/routes.go", false))
+	assert.False(t, ignored(nested, "pkg/main.go", false))
+}
@@ -4,11 +4,14 @@
 package scanner
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/bmatcuk/doublestar/v4"
@@ -28,6 +31,28 @@ type Config struct {
 	// Extensions filters files by extension (e.g., []string{".go", ".ts"})
 	// If empty, all supported extensions are included
 	Extensions []string
+
+	// FollowSymlinks descends into symlinked directories (pnpm packages,
+	// bazel output trees). Symlinked files are always read.
+	FollowSymlinks bool
+
+	// LanguageExtensions maps languages to additional file extensions or
+	// multi-part suffixes (e.g., "php": {".phtml"}, "rust": {".rs.in"}).
+	// They take precedence over the built-in extensions.
+	LanguageExtensions map[string][]string
+
+	// LanguageOverrides force a language for files matching a glob,
+	// checked in order before any extension mapping.
+	LanguageOverrides []LanguageOverride
+}
+
+// LanguageOverride forces the language of files matching a glob pattern.
+type LanguageOverride struct {
+	// Pattern is a glob relative to the scan base path (e.g., "legacy/**/*.inc")
+	Pattern string `mapstructure:"pattern" yaml:"pattern" json:"pattern"`
+
+	// Language is the language identifier to use (e.g., "php")
+	Language string `mapstructure:"language" yaml:"language" json:"language"`
 }
 
 // Scanner discovers source files in a project.
@@ -41,6 +66,16 @@ func New(config Config) *Scanner {
 	if config.BasePath == "" {
 		config.BasePath = "."
 	}
+	config.IncludePatterns = normalizePatterns(config.IncludePatterns)
+	config.ExcludePatterns = normalizePatterns(config.ExcludePatterns)
+	if len(config.LanguageOverrides) > 0 {
+		overrides := make([]LanguageOverride, len(config.LanguageOverrides))
+		for i, override := range config.LanguageOverrides {
+			override.Pattern = normalizePattern(override.Pattern)
+			overrides[i] = override
+		}
+		config.LanguageOverrides = overrides
+	}
 	if len(config.IncludePatterns) == 0 {
 		// Build default patterns from all supported extensions
 		exts := SupportedExtensions()
@@ -50,22 +85,75 @@ func New(config Config) *Scanner {
 		}
 	}
 
+	// Files mapped to a language explicitly are always candidates
+	if len(config.LanguageExtensions) > 0 || len(config.LanguageOverrides) > 0 {
+		patterns := append([]string(nil), config.IncludePatterns...)
+		var suffixes []string
+		for _, exts := range config.LanguageExtensions {
+			for _, ext := range exts {
+				suffixes = append(suffixes, strings.ToLower(ext))
+			}
+		}
+		sort.Strings(suffixes)
+		for _, suffix := range suffixes {
+			patterns = append(patterns, "**/*"+suffix)
+		}
+		for _, override := range config.LanguageOverrides {
+			patterns = append(patterns, override.Pattern)
+		}
+		config.IncludePatterns = patterns
+	}
+
 	return &Scanner{
 		config: config,
 	}
 }
 
+// normalizePattern converts a glob pattern to the slash-separated form file
+// paths are matched in: OS separators become slashes and a leading "./" is
+// dropped.
+func normalizePattern(pattern string) string {
+	pattern = filepath.ToSlash(pattern)
+	for strings.HasPrefix(pattern, "./") {
+		pattern = pattern[2:]
+	}
+	return pattern
+}
+
+func normalizePatterns(patterns []string) []string {
+	if len(patterns) == 0 {
+		return patterns
+	}
+	normalized := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		normalized[i] = normalizePattern(pattern)
+	}
+	return normalized
+}
+
 // Scan discovers all source files matching the configuration.
 func (s *Scanner) Scan() ([]SourceFile, error) {
+	return s.ScanContext(context.Background())
+}
+
+// ScanContext is like Scan, but stops walking and returns ctx's error once
+// ctx is done.
+func (s *Scanner) ScanContext(ctx context.Context) ([]SourceFile, error) {
 	basePath, err := filepath.Abs(s.config.BasePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve base path: %w", err)
 	}
-	return s.ScanPath(basePath)
+	return s.ScanPathContext(ctx, basePath)
 }
 
 // ScanPath scans a specific path for source files.
 func (s *Scanner) ScanPath(path string) ([]SourceFile, error) {
+	return s.ScanPathContext(context.Background(), path)
+}
+
+// ScanPathContext is like ScanPath, but stops walking and returns ctx's
+// error once ctx is done.
+func (s *Scanner) ScanPathContext(ctx context.Context, path string) ([]SourceFile, error) {
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve path: %w", err)
@@ -89,7 +177,7 @@ func (s *Scanner) ScanPath(path string) ([]SourceFile, error) {
 			return []SourceFile{
 				{
 					Path:     absPath,
-					Language: DetectLanguage(absPath),
+					Language: s.detectLanguage(absPath),
 					Content:  content,
 					ModTime:  info.ModTime(),
 				},
@@ -100,49 +188,245 @@ func (s *Scanner) ScanPath(path string) ([]SourceFile, error) {
 
 	// Walk the directory
 	var files []SourceFile
-	err = filepath.WalkDir(absPath, func(filePath string, d fs.DirEntry, err error) error {
+	err = s.walk(ctx, absPath, func(filePath, realPath string, info fs.FileInfo) {
+		if !s.shouldIncludeFile(filePath, info) {
+			return
+		}
+		content, err := os.ReadFile(realPath)
 		if err != nil {
-			// Skip inaccessible paths
-			return nil
+			// Skip files we can't read
+			return
 		}
+		files = append(files, SourceFile{
+			Path:     filePath,
+			Language: s.detectLanguage(filePath),
+			Content:  content,
+			ModTime:  info.ModTime(),
+		})
+	})
 
-		// Skip directories (but continue walking)
-		if d.IsDir() {
-			// Check if directory should be excluded
-			relPath, _ := filepath.Rel(absPath, filePath)
-			if s.shouldExcludeDir(relPath) {
-				return filepath.SkipDir
-			}
-			return nil
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	}
+
+	return files, nil
+}
+
+// ScanTree scans files that are not on disk, such as those of a past git
+// revision. names are slash-separated paths relative to the base path and
+// read returns their content. Ignore and skip files are honored when they
+// are among names.
+func (s *Scanner) ScanTree(ctx context.Context, names []string, read func(name string) ([]byte, error)) ([]SourceFile, error) {
+	basePath, err := filepath.Abs(s.config.BasePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve base path: %w", err)
+	}
+
+	listed := make(map[string]bool, len(names))
+	for _, name := range names {
+		listed[name] = true
+	}
+	ignores := newIgnoreMatcher(func(dir string) ([]byte, bool) {
+		name := path.Join(dir, IgnoreFile)
+		if !listed[name] {
+			return nil, false
 		}
+		data, err := read(name)
+		return data, err == nil
+	}, func(dir string) bool {
+		return listed[path.Join(dir, SkipFile)]
+	})
 
-		info, err := d.Info()
+	var files []SourceFile
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if s.inExcludedDir(name) || ignores.excludes(name) {
+			continue
+		}
+		filePath := filepath.Join(basePath, filepath.FromSlash(name))
+		if !s.includesFile(filePath) {
+			continue
+		}
+		content, err := read(name)
 		if err != nil {
-			return nil
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
 		}
+		files = append(files, SourceFile{
+			Path:     filePath,
+			Language: s.detectLanguage(filePath),
+			Content:  content,
+		})
+	}
+	return files, nil
+}
 
-		if s.shouldIncludeFile(filePath, info) {
-			content, err := os.ReadFile(filePath)
+// inExcludedDir reports whether a directory containing the slash-separated
+// relative path name is excluded.
+func (s *Scanner) inExcludedDir(name string) bool {
+	for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+		if s.shouldExcludeDir(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkEntry is a path reported under one name and read from another:
+// a directory tree queued for walking or a symlinked file.
+type walkEntry struct {
+	logical string
+	real    string
+	info    fs.FileInfo
+}
+
+// walk visits every non-directory entry below root, passing the path it is
+// reported under, the resolved path to read it from, and its file info.
+//
+// The tree itself is walked first; symlinked directories found along the
+// way (when FollowSymlinks is set) and symlinked files are visited
+// afterwards, so files reachable both directly and through a link keep
+// their direct path. Directories holding a SkipFile and paths matched by
+// an IgnoreFile are left out. Every resolved
+// directory is walked at most once, which also stops symlink loops, and a
+// file reached through several links is only visited the first time.
+func (s *Scanner) walk(ctx context.Context, root string, visit func(filePath, realPath string, info fs.FileInfo)) error {
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+
+	foldCase := isCaseInsensitive(realRoot)
+	key := func(path string) string {
+		if foldCase {
+			return strings.ToLower(path)
+		}
+		return path
+	}
+
+	ignores := newIgnoreMatcher(func(dir string) ([]byte, bool) {
+		data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(dir), IgnoreFile))
+		return data, err == nil
+	}, func(dir string) bool {
+		_, err := os.Lstat(filepath.Join(root, filepath.FromSlash(dir), SkipFile))
+		return err == nil
+	})
+
+	walkedDirs := make(map[string]bool)
+	seenFiles := make(map[string]bool)
+	queue := []walkEntry{{logical: root, real: realRoot}}
+	var links []walkEntry
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if walkedDirs[key(current.real)] {
+			continue
+		}
+
+		err := filepath.WalkDir(current.real, func(realPath string, d fs.DirEntry, err error) error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
 			if err != nil {
-				// Skip files we can't read
+				// Skip inaccessible paths
 				return nil
 			}
-			files = append(files, SourceFile{
-				Path:     filePath,
-				Language: DetectLanguage(filePath),
-				Content:  content,
-				ModTime:  info.ModTime(),
-			})
+
+			rel, _ := filepath.Rel(current.real, realPath)
+			filePath := filepath.Join(current.logical, rel)
+			relPath, _ := filepath.Rel(root, filePath)
+			relPath = filepath.ToSlash(relPath)
+
+			if d.IsDir() {
+				if s.shouldExcludeDir(relPath) || walkedDirs[key(realPath)] || ignores.skipsDir(relPath) {
+					return filepath.SkipDir
+				}
+				walkedDirs[key(realPath)] = true
+				return nil
+			}
+
+			if ignores.ignoresFile(relPath) {
+				return nil
+			}
+
+			if d.Type()&fs.ModeSymlink != 0 {
+				target, err := filepath.EvalSymlinks(realPath)
+				if err != nil {
+					// Dangling link
+					return nil
+				}
+				info, err := os.Stat(target)
+				if err != nil {
+					return nil
+				}
+				if !info.IsDir() {
+					links = append(links, walkEntry{logical: filePath, real: target, info: info})
+					return nil
+				}
+				if s.config.FollowSymlinks && !s.shouldExcludeDir(relPath) && !ignores.skipsDir(relPath) {
+					queue = append(queue, walkEntry{logical: filePath, real: target})
+				}
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+
+			if seenFiles[key(realPath)] {
+				return nil
+			}
+			seenFiles[key(realPath)] = true
+
+			visit(filePath, realPath, info)
+			return nil
+		})
+		if err != nil {
+			return err
 		}
+	}
 
-		return nil
-	})
+	for _, link := range links {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !seenFiles[key(link.real)] {
+			seenFiles[key(link.real)] = true
+			visit(link.logical, link.real, link.info)
+		}
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to walk directory: %w", err)
+	return nil
+}
+
+// isCaseInsensitive reports whether the filesystem holding dir ignores case,
+// by checking whether the case-swapped path names the same directory.
+func isCaseInsensitive(dir string) bool {
+	swapped := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return r
+	}, dir)
+	if swapped == dir {
+		return false
 	}
 
-	return files, nil
+	info, err := os.Stat(dir)
+	if err != nil {
+		return false
+	}
+	swappedInfo, err := os.Stat(swapped)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(info, swappedInfo)
 }
 
 // ScanPaths scans multiple paths for source files.
@@ -166,13 +450,57 @@ func (s *Scanner) ScanPaths(paths []string) ([]SourceFile, error) {
 	return allFiles, nil
 }
 
+// detectLanguage resolves the language of a file, applying the configured
+// glob overrides first, then the longest matching configured suffix, and
+// finally the built-in extension mapping.
+func (s *Scanner) detectLanguage(filePath string) string {
+	if len(s.config.LanguageOverrides) > 0 {
+		relPath := s.relPath(filePath)
+		for _, override := range s.config.LanguageOverrides {
+			if matched, _ := doublestar.Match(override.Pattern, relPath); matched {
+				return override.Language
+			}
+		}
+	}
+
+	name := strings.ToLower(filepath.Base(filePath))
+	language, longest := "", 0
+	for lang, exts := range s.config.LanguageExtensions {
+		for _, ext := range exts {
+			ext = strings.ToLower(ext)
+			if len(ext) > longest && strings.HasSuffix(name, ext) {
+				language, longest = lang, len(ext)
+			}
+		}
+	}
+	if language != "" {
+		return language
+	}
+
+	return DetectLanguage(filePath)
+}
+
+// relPath returns a file's slash-separated path relative to the base path.
+func (s *Scanner) relPath(filePath string) string {
+	basePath, _ := filepath.Abs(s.config.BasePath)
+	relPath, err := filepath.Rel(basePath, filePath)
+	if err != nil {
+		relPath = filepath.Base(filePath)
+	}
+	return filepath.ToSlash(relPath)
+}
+
 // shouldIncludeFile checks if a file should be included based on patterns and extensions.
 func (s *Scanner) shouldIncludeFile(filePath string, info fs.FileInfo) bool {
 	// Skip directories
 	if info.IsDir() {
 		return false
 	}
+	return s.includesFile(filePath)
+}
 
+// includesFile checks if a file path matches the patterns and extensions.
+func (s *Scanner) includesFile(filePath string) bool {
 	// Check extension filter
 	if len(s.config.Extensions) > 0 {
 		ext := strings.ToLower(filepath.Ext(filePath))
@@ -187,21 +515,14 @@ func (s *Scanner) shouldIncludeFile(filePath string, info fs.FileInfo) bool {
 			return false
 		}
 	} else {
-		// Use default supported extensions
-		if !IsSupportedFile(filePath) {
+		// Use default supported extensions and configured mappings
+		if s.detectLanguage(filePath) == "" {
 			return false
 		}
 	}
 
 	// Get relative path for pattern matching
-	basePath, _ := filepath.Abs(s.config.BasePath)
-	relPath, err := filepath.Rel(basePath, filePath)
-	if err != nil {
-		relPath = filepath.Base(filePath)
-	}
-
-	// Normalize path separators for pattern matching
-	relPath = filepath.ToSlash(relPath)
+	relPath := s.relPath(filePath)
 
 	// Check exclude patterns first
 	if s.matchesPatterns(relPath, s.config.ExcludePatterns) {
@@ -269,29 +590,10 @@ func (s *Scanner) FileCount() (int, error) {
 	}
 
 	count := 0
-	err = filepath.WalkDir(basePath, func(filePath string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		if d.IsDir() {
-			relPath, _ := filepath.Rel(basePath, filePath)
-			if s.shouldExcludeDir(relPath) {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		info, err := d.Info()
-		if err != nil {
-			return nil
-		}
-
+	err = s.walk(context.Background(), basePath, func(filePath, _ string, info fs.FileInfo) {
 		if s.shouldIncludeFile(filePath, info) {
 			count++
 		}
-
-		return nil
 	})
 
 	return count, err
@@ -4,8 +4,10 @@
 package scanner
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,6 +76,23 @@ func TestScanner_Scan_BasicFiles(t *testing.T) {
 	}
 }
 
+func TestScanner_ScanContext_Cancelled(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"main.go": "package main",
+	})
+
+	s := New(Config{
+		BasePath:        tmpDir,
+		IncludePatterns: []string{"**/*.go"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.ScanContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestScanner_Scan_ExcludePatterns(t *testing.T) {
 	tmpDir := setupTestDir(t, map[string]string{
 		"main.go":            "package main",
@@ -100,6 +119,65 @@ func TestScanner_Scan_ExcludePatterns(t *testing.T) {
 	}
 }
 
+func TestScanner_ScanTree(t *testing.T) {
+	contents := map[string]string{
+		"main.go":           "package main",
+		"api/users.go":      "package api",
+		"api/users_test.go": "package api",
+		"vendor/lib/lib.go": "package lib",
+		"docs/README.md":    "# docs",
+		"web/src/routes.ts": "export {}",
+	}
+	var names []string
+	for name := range contents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	base := t.TempDir()
+	scanner := New(Config{
+		BasePath:        base,
+		ExcludePatterns: []string{"vendor/**", "**/*_test.go"},
+	})
+	files, err := scanner.ScanTree(context.Background(), names, func(name string) ([]byte, error) {
+		return []byte(contents[name]), nil
+	})
+	require.NoError(t, err)
+
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+		rel, err := filepath.Rel(base, f.Path)
+		require.NoError(t, err)
+		assert.Equal(t, contents[filepath.ToSlash(rel)], string(f.Content))
+	}
+	assert.Equal(t, []string{
+		filepath.Join(base, "api", "users.go"),
+		filepath.Join(base, "main.go"),
+		filepath.Join(base, "web", "src", "routes.ts"),
+	}, paths)
+	assert.Equal(t, "typescript", files[2].Language)
+}
+
+func TestScanner_Scan_DotSlashPatterns(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"api/handlers.go":  "package api",
+		"api/gen/types.go": "package gen",
+		"cmd/main.go":      "package main",
+	})
+
+	scanner := New(Config{
+		BasePath:        tmpDir,
+		IncludePatterns: []string{"./api/**/*.go"},
+		ExcludePatterns: []string{"./api/gen/**"},
+	})
+
+	files, err := scanner.Scan()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, filepath.Join(tmpDir, "api", "handlers.go"), files[0].Path)
+}
+
 func TestScanner_Scan_MultipleLanguages(t *testing.T) {
 	tmpDir := setupTestDir(t, map[string]string{
 		"main.go":        "package main",
@@ -320,3 +398,126 @@ func TestScanner_Scan_TypeScriptFiles(t *testing.T) {
 		}
 	}
 }
+
+// relPaths returns the scanned file paths relative to dir, sorted.
+func relPaths(t *testing.T, dir string, files []SourceFile) []string {
+	t.Helper()
+
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		rel, err := filepath.Rel(dir, f.Path)
+		require.NoError(t, err)
+		paths = append(paths, filepath.ToSlash(rel))
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestScanner_Scan_SymlinkedDirectories(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"app/main.ts":                  "export {}",
+		"store/pkg@1.0.0/routes.ts":    "export {}",
+		"store/pkg@1.0.0/util/more.ts": "export {}",
+	})
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "store", "pkg@1.0.0"), filepath.Join(tmpDir, "app", "pkg")))
+
+	appDir := filepath.Join(tmpDir, "app")
+
+	// Symlinked directories are skipped by default
+	files, err := New(Config{BasePath: appDir, IncludePatterns: []string{"**/*.ts"}}).Scan()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main.ts"}, relPaths(t, appDir, files))
+
+	// Following them reports files under the link path
+	files, err = New(Config{BasePath: appDir, IncludePatterns: []string{"**/*.ts"}, FollowSymlinks: true}).Scan()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main.ts", "pkg/routes.ts", "pkg/util/more.ts"}, relPaths(t, appDir, files))
+
+	// Exclude patterns apply to the link path
+	files, err = New(Config{
+		BasePath:        appDir,
+		IncludePatterns: []string{"**/*.ts"},
+		ExcludePatterns: []string{"pkg/util/**"},
+		FollowSymlinks:  true,
+	}).Scan()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main.ts", "pkg/routes.ts"}, relPaths(t, appDir, files))
+}
+
+func TestScanner_Scan_SymlinkDuplicatesKeepDirectPath(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"src/routes.go": "package src",
+	})
+	// bazel-style convenience link back into the source tree
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "src"), filepath.Join(tmpDir, "bazel-src")))
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "src", "routes.go"), filepath.Join(tmpDir, "alias.go")))
+
+	files, err := New(Config{BasePath: tmpDir, IncludePatterns: []string{"**/*.go"}, FollowSymlinks: true}).Scan()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src/routes.go"}, relPaths(t, tmpDir, files))
+}
+
+func TestScanner_Scan_SymlinkLoop(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"a/main.go": "package a",
+	})
+	require.NoError(t, os.Symlink(tmpDir, filepath.Join(tmpDir, "a", "loop")))
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "missing"), filepath.Join(tmpDir, "dangling.go")))
+
+	s := New(Config{BasePath: tmpDir, IncludePatterns: []string{"**/*.go"}, FollowSymlinks: true})
+
+	files, err := s.Scan()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a/main.go"}, relPaths(t, tmpDir, files))
+
+	count, err := s.FileCount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestScanner_Scan_LanguageMappings(t *testing.T) {
+	tmpDir := setupTestDir(t, map[string]string{
+		"views/index.phtml":      "<?php echo 1;",
+		"stubs/api.pyi":          "def f() -> None: ...",
+		"gen/routes.rs.in":       "fn main() {}",
+		"legacy/Routes.inc":      "<?php",
+		"legacy/other.txt":       "text",
+		"src/user.service.ts":    "export {}",
+		"src/router.ts":          "export {}",
+		"scripts/build.template": "",
+	})
+
+	s := New(Config{
+		BasePath:        tmpDir,
+		IncludePatterns: []string{"**/*.ts"},
+		LanguageExtensions: map[string][]string{
+			"php":    {".phtml"},
+			"python": {".PYI"},
+			"rust":   {".rs.in"},
+			"cpp":    {".in"},
+		},
+		LanguageOverrides: []LanguageOverride{
+			{Pattern: "legacy/**/*.inc", Language: "php"},
+			{Pattern: "src/**/*.service.ts", Language: "javascript"},
+		},
+	})
+
+	files, err := s.Scan()
+	require.NoError(t, err)
+
+	languages := make(map[string]string)
+	for _, f := range files {
+		rel, err := filepath.Rel(tmpDir, f.Path)
+		require.NoError(t, err)
+		languages[filepath.ToSlash(rel)] = f.Language
+	}
+
+	assert.Equal(t, map[string]string{
+		"views/index.phtml":   "php",
+		"stubs/api.pyi":       "python",
+		"gen/routes.rs.in":    "rust",
+		"legacy/Routes.inc":   "php",
+		"src/user.service.ts": "javascript",
+		"src/router.ts":       "typescript",
+	}, languages)
+}
@@ -5,6 +5,7 @@
 package scanner
 
 import (
+	"bytes"
 	"path/filepath"
 	"strings"
 	"time"
@@ -58,6 +59,7 @@ var languageExtensions = map[string]string{
 	".swift": "swift",
 	".hs":    "haskell",
 	".lhs":   "haskell",
+	".dart":  "dart",
 	".yaml":  "yaml",
 	".yml":   "yaml",
 }
@@ -80,9 +82,30 @@ func SupportedExtensions() []string {
 	return exts
 }
 
+// IsKnownLanguage reports whether language is one of the detected language identifiers.
+func IsKnownLanguage(language string) bool {
+	for _, lang := range languageExtensions {
+		if lang == language {
+			return true
+		}
+	}
+	return false
+}
+
 // IsSupportedFile checks if a file path has a supported extension.
 func IsSupportedFile(path string) bool {
 	ext := strings.ToLower(filepath.Ext(path))
 	_, ok := languageExtensions[ext]
 	return ok
 }
+
+// ContainsAny reports whether the file content contains any of markers. The
+// substring scan is cheap enough to rule files out before parsing them.
+func (f SourceFile) ContainsAny(markers []string) bool {
+	for _, marker := range markers {
+		if bytes.Contains(f.Content, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
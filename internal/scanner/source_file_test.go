@@ -73,3 +73,10 @@ func TestIsSupportedFile(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceFile_ContainsAny(t *testing.T) {
+	f := SourceFile{Path: "main.go", Content: []byte(`import "github.com/go-chi/chi/v5"`)}
+	assert.True(t, f.ContainsAny([]string{"gin-gonic/gin", "go-chi/chi"}))
+	assert.False(t, f.ContainsAny([]string{"gofiber/fiber"}))
+	assert.False(t, f.ContainsAny(nil))
+}
@@ -5,10 +5,12 @@
 package schema
 
 import (
+	"go/token"
 	"strconv"
 	"strings"
 
 	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -32,6 +34,7 @@ func (e *GoSchemaExtractor) ExtractFromStruct(def parser.StructDefinition) *type
 		Title:       def.Name,
 		Description: def.Description,
 		Properties:  make(map[string]*types.Schema),
+		Source:      goSource(def.Position, def.Name),
 	}
 
 	var requiredFields []string
@@ -63,6 +66,12 @@ func (e *GoSchemaExtractor) ExtractFromStruct(def parser.StructDefinition) *type
 	return schema
 }
 
+// FieldSchema converts a struct field to a JSON Schema, e.g. for a
+// parameter bound from the field.
+func (e *GoSchemaExtractor) FieldSchema(field parser.StructField) *types.Schema {
+	return e.fieldToSchema(field)
+}
+
 // fieldToSchema converts a struct field to a JSON Schema.
 func (e *GoSchemaExtractor) fieldToSchema(field parser.StructField) *types.Schema {
 	schema := e.typeToSchema(field)
@@ -75,11 +84,48 @@ func (e *GoSchemaExtractor) fieldToSchema(field parser.StructField) *types.Schem
 	// Apply validation constraints
 	e.applyValidationTags(schema, field)
 
+	if field.ReadOnly {
+		schema.ReadOnly = true
+	}
+	if field.WriteOnly {
+		schema.WriteOnly = true
+	}
+	if field.Default != "" && schema.Ref == "" {
+		if value, ok := parser.TagDefault(field.Default, schema.Type); ok {
+			schema.Default = value
+		}
+	}
+
+	schema.Source = goSource(field.Position, field.Type)
+
 	return schema
 }
 
+// goSource returns the provenance of a declaration at pos, or nil when
+// the position is unknown.
+func goSource(pos token.Position, typeExpr string) *types.SourceLocation {
+	if !pos.IsValid() {
+		return nil
+	}
+	return &types.SourceLocation{
+		File: pos.Filename,
+		Line: pos.Line,
+		Type: typeExpr,
+	}
+}
+
 // typeToSchema converts a Go type to a JSON Schema.
 func (e *GoSchemaExtractor) typeToSchema(field parser.StructField) *types.Schema {
+	// Configured type mappings take precedence over the built-in ones
+	if field.TypeKind != parser.KindSlice && field.TypeKind != parser.KindMap {
+		if mapped, ok := typemap.Schema(field.Type); ok {
+			if field.IsPointer && mapped.Ref == "" {
+				mapped.Nullable = true
+			}
+			return mapped
+		}
+	}
+
 	// Handle pointer types - the underlying type determines the schema,
 	// but the field becomes nullable/optional
 	if field.IsPointer && field.TypeKind == parser.KindPointer {
@@ -204,6 +250,12 @@ func (e *GoSchemaExtractor) primitiveToSchema(typeName string) *types.Schema {
 
 // elementTypeToSchema converts an element type (for slices/maps) to a schema.
 func (e *GoSchemaExtractor) elementTypeToSchema(elementType string) *types.Schema {
+	if !strings.HasPrefix(elementType, "[]") {
+		if mapped, ok := typemap.Schema(elementType); ok {
+			return mapped
+		}
+	}
+
 	// Handle pointer elements
 	if strings.HasPrefix(elementType, "*") {
 		underlyingType := strings.TrimPrefix(elementType, "*")
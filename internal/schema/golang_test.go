@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -610,3 +611,95 @@ func TestGoSchemaExtractor_FullExample(t *testing.T) {
 	assert.Equal(t, "string", createdAt.Type)
 	assert.Equal(t, "date-time", createdAt.Format)
 }
+
+func TestGoSchemaExtractor_Source(t *testing.T) {
+	const source = `package models
+
+type User struct {
+	ID        string     ` + "`json:\"id\"`" + `
+	DeletedAt *time.Time ` + "`json:\"deletedAt,omitempty\"`" + `
+}
+`
+	p := parser.NewGoParser()
+	pf, err := p.ParseSource("models/user.go", source)
+	require.NoError(t, err)
+	defs := p.ExtractStructs(pf)
+	require.Len(t, defs, 1)
+
+	schema := NewGoSchemaExtractor().ExtractFromStruct(defs[0])
+	assert.Equal(t, &types.SourceLocation{File: "models/user.go", Line: 3, Type: "User"}, schema.Source)
+	assert.Equal(t, &types.SourceLocation{File: "models/user.go", Line: 5, Type: "*time.Time"}, schema.Properties["deletedAt"].Source)
+
+	// Positionless definitions carry no provenance
+	synthetic := NewGoSchemaExtractor().ExtractFromStruct(parser.StructDefinition{Name: "Empty"})
+	assert.Nil(t, synthetic.Source)
+}
+
+func TestGoSchemaExtractor_TypeMappings(t *testing.T) {
+	typemap.Set([]typemap.Mapping{
+		{Name: "decimal.Decimal", Type: "string", Format: "decimal"},
+		{Name: "Money", Ref: "Money"},
+	})
+	t.Cleanup(func() { typemap.Set(nil) })
+
+	def := parser.StructDefinition{
+		Name: "Order",
+		Fields: []parser.StructField{
+			{Name: "Total", JSONName: "total", Type: "decimal.Decimal", TypeKind: parser.KindStruct},
+			{Name: "Discount", JSONName: "discount", Type: "*decimal.Decimal", TypeKind: parser.KindPointer, IsPointer: true},
+			{Name: "Prices", JSONName: "prices", Type: "[]Money", TypeKind: parser.KindSlice, ElementType: "Money"},
+		},
+	}
+
+	schema := NewGoSchemaExtractor().ExtractFromStruct(def)
+	assert.Equal(t, "string", schema.Properties["total"].Type)
+	assert.Equal(t, "decimal", schema.Properties["total"].Format)
+	assert.True(t, schema.Properties["discount"].Nullable)
+	require.NotNil(t, schema.Properties["prices"].Items)
+	assert.Equal(t, "#/components/schemas/Money", schema.Properties["prices"].Items.Ref)
+}
+
+func TestGoSchemaExtractor_AccessModeTags(t *testing.T) {
+	const source = `package models
+
+type Account struct {
+	Balance  int    ` + "`json:\"balance\" readonly:\"true\"`" + `
+	Password string ` + "`json:\"password\" writeonly:\"true\"`" + `
+}
+`
+	p := parser.NewGoParser()
+	pf, err := p.ParseSource("models/account.go", source)
+	require.NoError(t, err)
+	defs := p.ExtractStructs(pf)
+	require.Len(t, defs, 1)
+
+	schema := NewGoSchemaExtractor().ExtractFromStruct(defs[0])
+	assert.True(t, schema.Properties["balance"].ReadOnly)
+	assert.False(t, schema.Properties["balance"].WriteOnly)
+	assert.True(t, schema.Properties["password"].WriteOnly)
+}
+
+func TestGoSchemaExtractor_DefaultTags(t *testing.T) {
+	const source = `package models
+
+type ListQuery struct {
+	Limit  int     ` + "`json:\"limit\" default:\"20\"`" + `
+	Order  string  ` + "`json:\"order\" default:\"asc\"`" + `
+	Ratio  float64 ` + "`json:\"ratio\" default:\"0.5\"`" + `
+	Active bool    ` + "`json:\"active\" default:\"true\"`" + `
+	Page   int     ` + "`json:\"page\" default:\"first\"`" + `
+}
+`
+	p := parser.NewGoParser()
+	pf, err := p.ParseSource("models/query.go", source)
+	require.NoError(t, err)
+	defs := p.ExtractStructs(pf)
+	require.Len(t, defs, 1)
+
+	schema := NewGoSchemaExtractor().ExtractFromStruct(defs[0])
+	assert.Equal(t, int64(20), schema.Properties["limit"].Default)
+	assert.Equal(t, "asc", schema.Properties["order"].Default)
+	assert.Equal(t, 0.5, schema.Properties["ratio"].Default)
+	assert.Equal(t, true, schema.Properties["active"].Default)
+	assert.Nil(t, schema.Properties["page"].Default, "defaults that do not fit the type are dropped")
+}
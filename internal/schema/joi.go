@@ -0,0 +1,492 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// joiMaxDepth bounds chains of schema constants referring to each other.
+const joiMaxDepth = 16
+
+// JoiParser parses Joi schema definitions and converts them to OpenAPI schemas.
+type JoiParser struct {
+	tsParser *parser.TypeScriptParser
+	registry *Registry
+
+	// refs maps the identifiers of registered schemas to their component
+	// names, so that references to them become $refs
+	refs map[string]string
+}
+
+// NewJoiParser creates a new Joi parser.
+func NewJoiParser(tsParser *parser.TypeScriptParser) *JoiParser {
+	return &JoiParser{
+		tsParser: tsParser,
+		registry: NewRegistry(),
+		refs:     make(map[string]string),
+	}
+}
+
+// IsJoiSchema reports whether an expression is a Joi schema: a call chain
+// starting from Joi or joi, like Joi.string().email().
+func IsJoiSchema(node *sitter.Node, content []byte) bool {
+	for node != nil && node.Type() == "call_expression" {
+		fn := node.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "member_expression" {
+			return false
+		}
+		object := fn.ChildByFieldName("object")
+		if object == nil {
+			return false
+		}
+		if object.Type() == "identifier" {
+			return isJoi(object.Content(content))
+		}
+		node = object
+	}
+	return false
+}
+
+// ParseJoiSchema converts a Joi schema expression to an OpenAPI schema.
+// Plain objects of Joi schemas, as hapi accepts for its validate options,
+// are converted as Joi.object() schemas.
+func (p *JoiParser) ParseJoiSchema(node *sitter.Node, content []byte) (*types.Schema, error) {
+	if node == nil {
+		return &types.Schema{}, nil
+	}
+
+	schema, _ := p.parseJoi(node, content, 0)
+	return schema, nil
+}
+
+// ParseJoiField converts a Joi schema expression like an object key's, and
+// reports whether it is required. Joi keys are optional unless marked
+// required().
+func (p *JoiParser) ParseJoiField(node *sitter.Node, content []byte) (*types.Schema, bool) {
+	if node == nil {
+		return &types.Schema{}, false
+	}
+	return p.parseJoi(node, content, 0)
+}
+
+// parseJoi converts a Joi expression, resolving identifiers through the
+// registered schemas and the constants of the file.
+func (p *JoiParser) parseJoi(node *sitter.Node, content []byte, depth int) (*types.Schema, bool) {
+	node = unwrapExpression(node)
+	if depth > joiMaxDepth {
+		return &types.Schema{}, false
+	}
+
+	switch node.Type() {
+	case "identifier":
+		name := node.Content(content)
+		if component, ok := p.refs[name]; ok {
+			return SchemaRef(component), false
+		}
+		if value := findConstant(node, name, content); value != nil {
+			return p.parseJoi(value, content, depth+1)
+		}
+		return &types.Schema{}, false
+	case "object":
+		return p.parseJoiKeys(node, content, depth), false
+	case "call_expression":
+		return p.parseJoiCall(node, content, depth)
+	}
+	return &types.Schema{}, false
+}
+
+// parseJoiCall parses a Joi call chain, applying the chained modifiers to
+// the schema the chain starts from.
+func (p *JoiParser) parseJoiCall(node *sitter.Node, content []byte, depth int) (*types.Schema, bool) {
+	fn := node.ChildByFieldName("function")
+	if fn == nil || fn.Type() != "member_expression" {
+		return &types.Schema{}, false
+	}
+	object := fn.ChildByFieldName("object")
+	property := fn.ChildByFieldName("property")
+	if object == nil || property == nil {
+		return &types.Schema{}, false
+	}
+	method := property.Content(content)
+	args := p.tsParser.GetCallArguments(node, content)
+
+	if object.Type() == "identifier" && isJoi(object.Content(content)) {
+		return p.baseJoiSchema(method, args, content, depth), false
+	}
+
+	schema, required := p.parseJoi(object, content, depth)
+	if schema.Ref != "" {
+		// Modifiers on a referenced schema apply to the reference itself
+		switch method {
+		case "required":
+			return schema, true
+		case "optional":
+			return schema, false
+		}
+		return schema, required
+	}
+	return p.applyJoiModifier(schema, required, method, args, content, depth)
+}
+
+// baseJoiSchema returns the schema of the Joi method a chain starts from.
+func (p *JoiParser) baseJoiSchema(method string, args []*sitter.Node, content []byte, depth int) *types.Schema {
+	switch method {
+	case "string":
+		return &types.Schema{Type: "string"}
+	case "number":
+		return &types.Schema{Type: "number"}
+	case "boolean", "bool":
+		return &types.Schema{Type: "boolean"}
+	case "date":
+		return &types.Schema{Type: "string", Format: "date-time"}
+	case "binary":
+		return &types.Schema{Type: "string", Format: "binary"}
+	case "object":
+		if len(args) > 0 {
+			if keys := unwrapExpression(args[0]); keys.Type() == "object" {
+				return p.parseJoiKeys(keys, content, depth)
+			}
+		}
+		return &types.Schema{Type: "object"}
+	case "array":
+		return &types.Schema{Type: "array"}
+	case "alternatives", "alt":
+		return p.parseJoiAlternatives(args, content, depth)
+	case "valid", "allow", "equal":
+		return &types.Schema{Enum: p.joiValues(args, content)}
+	}
+	return &types.Schema{}
+}
+
+// parseJoiKeys converts an object of Joi schemas to an object schema.
+func (p *JoiParser) parseJoiKeys(object *sitter.Node, content []byte, depth int) *types.Schema {
+	schema := &types.Schema{Type: "object", Properties: make(map[string]*types.Schema)}
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		pair := object.NamedChild(i)
+		if pair.Type() != "pair" {
+			continue
+		}
+		key := pair.ChildByFieldName("key")
+		value := pair.ChildByFieldName("value")
+		if key == nil || value == nil {
+			continue
+		}
+		name := strings.Trim(key.Content(content), `"'`)
+		if isForbidden(value, content) {
+			continue
+		}
+		prop, required := p.parseJoi(value, content, depth+1)
+		schema.Properties[name] = prop
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// parseJoiAlternatives converts Joi.alternatives(a, b) or
+// Joi.alternatives().try(a, b) to a oneOf schema.
+func (p *JoiParser) parseJoiAlternatives(args []*sitter.Node, content []byte, depth int) *types.Schema {
+	schema := &types.Schema{}
+	for _, arg := range args {
+		arg = unwrapExpression(arg)
+		if arg.Type() == "array" {
+			for i := 0; i < int(arg.NamedChildCount()); i++ {
+				alt, _ := p.parseJoi(arg.NamedChild(i), content, depth+1)
+				schema.OneOf = append(schema.OneOf, alt)
+			}
+			continue
+		}
+		alt, _ := p.parseJoi(arg, content, depth+1)
+		schema.OneOf = append(schema.OneOf, alt)
+	}
+	return schema
+}
+
+// applyJoiModifier applies a chained Joi method to a schema.
+func (p *JoiParser) applyJoiModifier(schema *types.Schema, required bool, method string, args []*sitter.Node, content []byte, depth int) (*types.Schema, bool) {
+	number := func() *float64 {
+		if len(args) == 0 {
+			return nil
+		}
+		v, err := strconv.ParseFloat(args[0].Content(content), 64)
+		if err != nil {
+			return nil
+		}
+		return &v
+	}
+
+	switch method {
+	case "required", "exist":
+		required = true
+	case "optional":
+		required = false
+	case "allow":
+		for _, value := range p.joiValues(args, content) {
+			if value == nil {
+				schema.Nullable = true
+			}
+		}
+	case "valid", "equal", "only":
+		values := p.joiValues(args, content)
+		for _, value := range values {
+			if value == nil {
+				schema.Nullable = true
+			} else {
+				schema.Enum = append(schema.Enum, value)
+			}
+		}
+	case "keys", "append":
+		if len(args) > 0 {
+			if keys := unwrapExpression(args[0]); keys.Type() == "object" {
+				parsed := p.parseJoiKeys(keys, content, depth)
+				if schema.Properties == nil {
+					schema.Properties = make(map[string]*types.Schema)
+				}
+				for name, prop := range parsed.Properties {
+					schema.Properties[name] = prop
+				}
+				schema.Required = append(schema.Required, parsed.Required...)
+				schema.Type = "object"
+			}
+		}
+	case "unknown":
+		if len(args) == 0 || args[0].Type() != "false" {
+			schema.AdditionalProperties = &types.Schema{}
+		}
+	case "items":
+		var items []*types.Schema
+		for _, arg := range args {
+			item, _ := p.parseJoi(arg, content, depth+1)
+			items = append(items, item)
+		}
+		switch len(items) {
+		case 0:
+		case 1:
+			schema.Items = items[0]
+		default:
+			schema.Items = &types.Schema{OneOf: items}
+		}
+	case "try":
+		schema.OneOf = append(schema.OneOf, p.parseJoiAlternatives(args, content, depth).OneOf...)
+	case "unique":
+		schema.UniqueItems = true
+	case "min", "max", "length":
+		v := number()
+		if v == nil {
+			break
+		}
+		n := int(*v)
+		switch {
+		case schema.Type == "string" && method == "min":
+			schema.MinLength = &n
+		case schema.Type == "string" && method == "max":
+			schema.MaxLength = &n
+		case schema.Type == "string":
+			schema.MinLength, schema.MaxLength = &n, &n
+		case schema.Type == "array" && method == "min":
+			schema.MinItems = &n
+		case schema.Type == "array" && method == "max":
+			schema.MaxItems = &n
+		case schema.Type == "array":
+			schema.MinItems, schema.MaxItems = &n, &n
+		case schema.Type == "object" && method == "min":
+			schema.MinProperties = &n
+		case schema.Type == "object" && method == "max":
+			schema.MaxProperties = &n
+		case method == "min":
+			schema.Minimum = v
+		case method == "max":
+			schema.Maximum = v
+		}
+	case "greater":
+		schema.Minimum = number()
+		schema.ExclusiveMinimum = schema.Minimum != nil
+	case "less":
+		schema.Maximum = number()
+		schema.ExclusiveMaximum = schema.Maximum != nil
+	case "positive":
+		zero := 0.0
+		schema.Minimum = &zero
+		schema.ExclusiveMinimum = true
+	case "negative":
+		zero := 0.0
+		schema.Maximum = &zero
+		schema.ExclusiveMaximum = true
+	case "multiple":
+		schema.MultipleOf = number()
+	case "integer":
+		schema.Type = "integer"
+	case "port":
+		schema.Type = "integer"
+		low, high := 0.0, 65535.0
+		schema.Minimum, schema.Maximum = &low, &high
+	case "email":
+		schema.Format = "email"
+	case "uri":
+		schema.Format = "uri"
+	case "uuid", "guid":
+		schema.Format = "uuid"
+	case "isoDate":
+		schema.Format = "date-time"
+	case "hostname", "domain":
+		schema.Format = "hostname"
+	case "ip":
+		schema.Format = "ip"
+	case "base64":
+		schema.Format = "byte"
+	case "pattern", "regex":
+		if len(args) > 0 {
+			if pattern := args[0].ChildByFieldName("pattern"); pattern != nil {
+				schema.Pattern = pattern.Content(content)
+			}
+		}
+	case "description", "note":
+		if len(args) > 0 {
+			if value, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+				schema.Description = value
+			}
+		}
+	case "label":
+		if len(args) > 0 {
+			if value, ok := p.tsParser.ExtractStringLiteral(args[0], content); ok {
+				schema.Title = value
+			}
+		}
+	case "default":
+		if len(args) > 0 {
+			if value, ok := parser.DefaultValue(args[0].Content(content), schema.Type); ok {
+				schema.Default = value
+			}
+		}
+	case "example", "examples":
+		if len(args) > 0 {
+			schema.Example = joiValue(args[0], content)
+		}
+	}
+
+	return schema, required
+}
+
+// joiValues returns the literal values of valid() or allow() arguments,
+// with null as nil.
+func (p *JoiParser) joiValues(args []*sitter.Node, content []byte) []any {
+	var values []any
+	for _, arg := range args {
+		arg = unwrapExpression(arg)
+		if arg.Type() == "array" {
+			for i := 0; i < int(arg.NamedChildCount()); i++ {
+				values = append(values, joiValue(arg.NamedChild(i), content))
+			}
+			continue
+		}
+		values = append(values, joiValue(arg, content))
+	}
+	return values
+}
+
+// joiValue returns the value of a literal argument.
+func joiValue(node *sitter.Node, content []byte) any {
+	text := node.Content(content)
+	switch node.Type() {
+	case "null":
+		return nil
+	case "true", "false":
+		return node.Type() == "true"
+	case "number":
+		if v, err := strconv.ParseFloat(text, 64); err == nil {
+			return v
+		}
+	case "string", "template_string":
+		return strings.Trim(text, `"'`+"`")
+	}
+	return text
+}
+
+// isJoi reports whether an identifier is the Joi module.
+func isJoi(name string) bool {
+	return name == "Joi" || name == "joi"
+}
+
+// isForbidden reports whether a key's schema is marked forbidden(), so the
+// key must not be present.
+func isForbidden(node *sitter.Node, content []byte) bool {
+	for node = unwrapExpression(node); node != nil && node.Type() == "call_expression"; {
+		fn := node.ChildByFieldName("function")
+		if fn == nil || fn.Type() != "member_expression" {
+			return false
+		}
+		if property := fn.ChildByFieldName("property"); property != nil && property.Content(content) == "forbidden" {
+			return true
+		}
+		node = fn.ChildByFieldName("object")
+	}
+	return false
+}
+
+// findConstant returns the value of a const declared in the file of node.
+func findConstant(node *sitter.Node, name string, content []byte) *sitter.Node {
+	root := node
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	var value *sitter.Node
+	parser.Walk(root, func(n *sitter.Node) bool {
+		if value != nil {
+			return false
+		}
+		if n.Type() == "variable_declarator" {
+			if id := n.ChildByFieldName("name"); id != nil && id.Content(content) == name {
+				value = n.ChildByFieldName("value")
+			}
+		}
+		return value == nil
+	})
+	return value
+}
+
+// unwrapExpression strips parentheses and TypeScript assertions.
+func unwrapExpression(node *sitter.Node) *sitter.Node {
+	for node != nil {
+		switch node.Type() {
+		case "parenthesized_expression", "as_expression", "satisfies_expression", "non_null_expression":
+			if node.NamedChildCount() == 0 {
+				return node
+			}
+			node = node.NamedChild(0)
+		default:
+			return node
+		}
+	}
+	return node
+}
+
+// AddReference makes references to an identifier $refs to a component,
+// for schemas registered after the ones that refer to them.
+func (p *JoiParser) AddReference(identifier, component string) {
+	p.refs[identifier] = component
+}
+
+// Registry returns the schema registry.
+func (p *JoiParser) Registry() *Registry {
+	return p.registry
+}
+
+// ExtractAndRegister parses a Joi schema and registers it with the given
+// name. References to the identifier it was declared under become $refs.
+func (p *JoiParser) ExtractAndRegister(name string, node *sitter.Node, content []byte) *types.Schema {
+	schema, _ := p.ParseJoiSchema(node, content)
+	if schema != nil && name != "" {
+		schema.Title = name
+		p.registry.Add(name, schema)
+	}
+	return schema
+}
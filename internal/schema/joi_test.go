@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package schema
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/parser"
+)
+
+// joiDeclaration returns the value of a const declared in a parsed file.
+func joiDeclaration(t *testing.T, pf *parser.ParsedTSFile, name string) *sitter.Node {
+	t.Helper()
+	value := findConstant(pf.RootNode, name, pf.Content)
+	require.NotNil(t, value, name)
+	return value
+}
+
+func TestJoiParser_ParseJoiObject(t *testing.T) {
+	const testCode = `
+const Joi = require('joi');
+
+const userSchema = Joi.object({
+  id: Joi.string().guid().required(),
+  name: Joi.string().min(1).max(100).required(),
+  email: Joi.string().email(),
+  age: Joi.number().integer().positive(),
+  role: Joi.string().valid('admin', 'member').default('member'),
+  nickname: Joi.string().allow(null, ''),
+  password: Joi.forbidden(),
+  secret: Joi.string().forbidden(),
+}).unknown(true).label('User');
+`
+
+	tsParser := parser.NewTypeScriptParser()
+	defer tsParser.Close()
+
+	pf, err := tsParser.ParseSource("test.js", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	node := joiDeclaration(t, pf, "userSchema")
+	assert.True(t, IsJoiSchema(node, pf.Content))
+
+	schema, err := NewJoiParser(tsParser).ParseJoiSchema(node, pf.Content)
+	require.NoError(t, err)
+
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, "User", schema.Title)
+	assert.NotNil(t, schema.AdditionalProperties)
+	assert.Equal(t, []string{"id", "name"}, schema.Required)
+	assert.NotContains(t, schema.Properties, "password", "forbidden keys are dropped")
+	assert.NotContains(t, schema.Properties, "secret")
+	assert.Len(t, schema.Properties, 6)
+
+	assert.Equal(t, "uuid", schema.Properties["id"].Format)
+	assert.Equal(t, 1, *schema.Properties["name"].MinLength)
+	assert.Equal(t, 100, *schema.Properties["name"].MaxLength)
+	assert.Equal(t, "email", schema.Properties["email"].Format)
+
+	age := schema.Properties["age"]
+	assert.Equal(t, "integer", age.Type)
+	assert.Equal(t, 0.0, *age.Minimum)
+	assert.True(t, age.ExclusiveMinimum)
+
+	role := schema.Properties["role"]
+	assert.Equal(t, []any{"admin", "member"}, role.Enum)
+	assert.Equal(t, "member", role.Default)
+
+	assert.True(t, schema.Properties["nickname"].Nullable)
+}
+
+func TestJoiParser_ParseJoiNested(t *testing.T) {
+	const testCode = `
+import Joi from 'joi';
+
+const addressSchema = Joi.object().keys({
+  street: Joi.string().required(),
+  zip: Joi.string().pattern(/^[0-9]{5}$/),
+});
+
+const orderSchema = Joi.object({
+  items: Joi.array().items(Joi.object({ sku: Joi.string().required() })).min(1).unique(),
+  address: addressSchema.required(),
+  billing: addressSchema,
+  placedAt: Joi.date(),
+  payment: Joi.alternatives().try(Joi.string(), Joi.number()),
+});
+`
+
+	tsParser := parser.NewTypeScriptParser()
+	defer tsParser.Close()
+
+	pf, err := tsParser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	joiParser := NewJoiParser(tsParser)
+	schema, err := joiParser.ParseJoiSchema(joiDeclaration(t, pf, "orderSchema"), pf.Content)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"address"}, schema.Required)
+
+	items := schema.Properties["items"]
+	assert.Equal(t, "array", items.Type)
+	assert.Equal(t, 1, *items.MinItems)
+	assert.True(t, items.UniqueItems)
+	assert.Equal(t, []string{"sku"}, items.Items.Required)
+
+	address := schema.Properties["address"]
+	assert.Equal(t, "object", address.Type, "constants are resolved within the file")
+	assert.Equal(t, "^[0-9]{5}$", address.Properties["zip"].Pattern)
+	assert.Equal(t, []string{"street"}, address.Required)
+
+	assert.Equal(t, "date-time", schema.Properties["placedAt"].Format)
+	assert.Len(t, schema.Properties["payment"].OneOf, 2)
+
+	joiParser.AddReference("addressSchema", "Address")
+	schema, err = joiParser.ParseJoiSchema(joiDeclaration(t, pf, "orderSchema"), pf.Content)
+	require.NoError(t, err)
+	assert.Equal(t, "#/components/schemas/Address", schema.Properties["billing"].Ref)
+	assert.Equal(t, "#/components/schemas/Address", schema.Properties["address"].Ref)
+	assert.Equal(t, []string{"address"}, schema.Required)
+}
+
+func TestJoiParser_ExtractAndRegister(t *testing.T) {
+	const testCode = `
+const Joi = require('joi');
+
+const petSchema = Joi.object({ name: Joi.string().required() });
+`
+
+	tsParser := parser.NewTypeScriptParser()
+	defer tsParser.Close()
+
+	pf, err := tsParser.ParseSource("test.js", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+
+	joiParser := NewJoiParser(tsParser)
+	joiParser.ExtractAndRegister("Pet", joiDeclaration(t, pf, "petSchema"), pf.Content)
+
+	schemas := joiParser.Registry().ToSlice()
+	require.Len(t, schemas, 1)
+	assert.Equal(t, "Pet", schemas[0].Title)
+	assert.Equal(t, []string{"name"}, schemas[0].Required)
+}
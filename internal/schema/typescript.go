@@ -5,9 +5,12 @@
 package schema
 
 import (
+	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/api2spec/api2spec/internal/parser"
+	"github.com/api2spec/api2spec/internal/typemap"
 	"github.com/api2spec/api2spec/pkg/types"
 )
 
@@ -25,17 +28,43 @@ func NewTypeScriptSchemaExtractor() *TypeScriptSchemaExtractor {
 
 // ExtractFromInterface converts a TSInterface to a JSON Schema.
 func (e *TypeScriptSchemaExtractor) ExtractFromInterface(iface parser.TSInterface) *types.Schema {
+	schema := e.objectSchema(iface.Name, iface.Description, tsSource(iface.File, iface.Line, iface.Name), iface.Properties)
+	if iface.IndexType != "" {
+		schema.AdditionalProperties = e.typeToSchema(iface.IndexType)
+	}
+	return schema
+}
+
+// ExtractFromTypeAlias converts a type alias of an object type literal
+// (`type User = { id: string }`) to a JSON Schema. Other aliases return nil.
+func (e *TypeScriptSchemaExtractor) ExtractFromTypeAlias(alias parser.TSTypeAlias) *types.Schema {
+	if alias.Properties == nil && alias.IndexType == "" {
+		return nil
+	}
+	schema := e.objectSchema(alias.Name, alias.Description, tsSource(alias.File, alias.Line, alias.Name), alias.Properties)
+	if alias.IndexType != "" {
+		schema.AdditionalProperties = e.typeToSchema(alias.IndexType)
+	}
+	return schema
+}
+
+// objectSchema builds and registers an object schema from named properties.
+func (e *TypeScriptSchemaExtractor) objectSchema(name, description string, source *types.SourceLocation, props []parser.TSProperty) *types.Schema {
 	schema := &types.Schema{
 		Type:        "object",
-		Title:       iface.Name,
-		Description: iface.Description,
+		Title:       name,
+		Description: description,
 		Properties:  make(map[string]*types.Schema),
+		Source:      source,
 	}
 
 	var requiredFields []string
 
-	for _, prop := range iface.Properties {
+	for _, prop := range props {
 		propSchema := e.propertyToSchema(prop)
+		if source != nil {
+			propSchema.Source = tsSource(source.File, prop.Line, prop.Type)
+		}
 		schema.Properties[prop.Name] = propSchema
 
 		// Non-optional properties are required
@@ -49,13 +78,26 @@ func (e *TypeScriptSchemaExtractor) ExtractFromInterface(iface parser.TSInterfac
 	}
 
 	// Register the schema for reference
-	if iface.Name != "" {
-		e.registry.Add(iface.Name, schema)
+	if name != "" {
+		e.registry.Add(name, schema)
 	}
 
 	return schema
 }
 
+// tsSource returns the provenance of a declaration, or nil when the
+// declaring file is unknown.
+func tsSource(file string, line int, typeExpr string) *types.SourceLocation {
+	if file == "" {
+		return nil
+	}
+	return &types.SourceLocation{
+		File: file,
+		Line: line,
+		Type: typeExpr,
+	}
+}
+
 // propertyToSchema converts a TypeScript property to a JSON Schema.
 func (e *TypeScriptSchemaExtractor) propertyToSchema(prop parser.TSProperty) *types.Schema {
 	schema := e.typeToSchema(prop.Type)
@@ -71,10 +113,21 @@ func (e *TypeScriptSchemaExtractor) propertyToSchema(prop parser.TSProperty) *ty
 	return schema
 }
 
+// TypeToSchema converts a TypeScript type expression to a JSON Schema.
+// Named types that are not primitives become component references.
+func (e *TypeScriptSchemaExtractor) TypeToSchema(tsType string) *types.Schema {
+	return e.typeToSchema(tsType)
+}
+
 // typeToSchema converts a TypeScript type string to a JSON Schema.
 func (e *TypeScriptSchemaExtractor) typeToSchema(tsType string) *types.Schema {
 	tsType = strings.TrimSpace(tsType)
 
+	// Configured type mappings take precedence over the built-in ones
+	if mapped, ok := typemap.Schema(tsType); ok {
+		return mapped
+	}
+
 	// Handle union types (e.g., "string | number")
 	if strings.Contains(tsType, " | ") {
 		return e.unionTypeToSchema(tsType)
@@ -98,6 +151,14 @@ func (e *TypeScriptSchemaExtractor) typeToSchema(tsType string) *types.Schema {
 		}
 	}
 
+	// Handle Record<K, V>, Map<K, V> and { [key: string]: V } dictionaries
+	if valueType, ok := dictionaryValueType(tsType); ok {
+		return &types.Schema{
+			Type:                 "object",
+			AdditionalProperties: e.typeToSchema(valueType),
+		}
+	}
+
 	// Handle primitive types
 	switch tsType {
 	case "string":
@@ -124,7 +185,11 @@ func (e *TypeScriptSchemaExtractor) typeToSchema(tsType string) *types.Schema {
 				Enum: []any{val},
 			}
 		}
-		// Assume it's a reference to another type
+		// Assume it's a reference to another type. Namespace-qualified
+		// names (API.User from a .d.ts namespace) refer to the declared name.
+		if i := strings.LastIndex(tsType, "."); i >= 0 && isQualifiedName(tsType) {
+			tsType = tsType[i+1:]
+		}
 		return SchemaRef(tsType)
 	}
 }
@@ -153,6 +218,59 @@ func (e *TypeScriptSchemaExtractor) unionTypeToSchema(tsType string) *types.Sche
 	return &types.Schema{OneOf: oneOf}
 }
 
+// indexSignatureRegex matches an object type literal consisting of a single
+// index signature, capturing the value type.
+var indexSignatureRegex = regexp.MustCompile(`^\{\s*\[\s*\w+\s*:\s*(?:string|number)\s*\]\s*:\s*(.+?)\s*;?\s*\}$`)
+
+// dictionaryValueType returns the value type of a dictionary type such as
+// Record<string, User>, Map<string, number> or { [key: string]: boolean }.
+func dictionaryValueType(tsType string) (string, bool) {
+	if m := indexSignatureRegex.FindStringSubmatch(tsType); m != nil {
+		return m[1], true
+	}
+
+	var args string
+	for _, prefix := range []string{"Record<", "Map<"} {
+		if strings.HasPrefix(tsType, prefix) && strings.HasSuffix(tsType, ">") {
+			args = tsType[len(prefix) : len(tsType)-1]
+		}
+	}
+	if args == "" {
+		return "", false
+	}
+
+	// The value type follows the first top-level comma
+	depth := 0
+	for i, r := range args {
+		switch r {
+		case '<', '{', '[', '(':
+			depth++
+		case '>', '}', ']', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				return strings.TrimSpace(args[i+1:]), true
+			}
+		}
+	}
+	return "", false
+}
+
+// isQualifiedName reports whether s is a dotted identifier path such as API.User.
+func isQualifiedName(s string) bool {
+	for _, part := range strings.Split(s, ".") {
+		if part == "" {
+			return false
+		}
+		for i, r := range part {
+			if r != '_' && r != '$' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // Registry returns the schema registry.
 func (e *TypeScriptSchemaExtractor) Registry() *Registry {
 	return e.registry
@@ -162,3 +280,9 @@ func (e *TypeScriptSchemaExtractor) Registry() *Registry {
 func (e *TypeScriptSchemaExtractor) ExtractAndRegister(iface parser.TSInterface) *types.Schema {
 	return e.ExtractFromInterface(iface)
 }
+
+// ExtractAndRegisterAlias converts an object type alias and registers it.
+// Returns nil for aliases that are not object type literals.
+func (e *TypeScriptSchemaExtractor) ExtractAndRegisterAlias(alias parser.TSTypeAlias) *types.Schema {
+	return e.ExtractFromTypeAlias(alias)
+}
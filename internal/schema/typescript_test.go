@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/internal/parser"
+)
+
+func TestTypeScriptSchemaExtractor_Dictionaries(t *testing.T) {
+	e := NewTypeScriptSchemaExtractor()
+
+	tests := []struct {
+		tsType    string
+		valueType string
+		valueRef  string
+	}{
+		{"Record<string, number>", "number", ""},
+		{"Record<string, User>", "", "#/components/schemas/User"},
+		{"Map<string, Array<string>>", "array", ""},
+		{"{ [key: string]: boolean }", "boolean", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tsType, func(t *testing.T) {
+			s := e.TypeToSchema(tt.tsType)
+			assert.Equal(t, "object", s.Type)
+			require.NotNil(t, s.AdditionalProperties)
+			assert.Equal(t, tt.valueType, s.AdditionalProperties.Type)
+			assert.Equal(t, tt.valueRef, s.AdditionalProperties.Ref)
+		})
+	}
+
+	counters := e.ExtractFromInterface(parser.TSInterface{
+		Name:       "Counters",
+		Properties: []parser.TSProperty{{Name: "total", Type: "number"}},
+		IndexType:  "number",
+	})
+	assert.Contains(t, counters.Properties, "total")
+	require.NotNil(t, counters.AdditionalProperties)
+	assert.Equal(t, "number", counters.AdditionalProperties.Type)
+
+	flags := e.ExtractFromTypeAlias(parser.TSTypeAlias{Name: "Flags", IndexType: "boolean"})
+	require.NotNil(t, flags)
+	assert.Equal(t, "boolean", flags.AdditionalProperties.Type)
+}
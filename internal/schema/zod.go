@@ -617,13 +617,22 @@ func (p *ZodParser) applyZodModifier(schema *types.Schema, method string, callNo
 		}
 	case "describe":
 		if len(args) > 0 {
-			desc := args[0].Content(content)
-			desc = strings.Trim(desc, `"'`)
-			schema.Description = desc
+			if desc, ok := zodValue(args[0], content).(string); ok {
+				schema.Description = desc
+			}
+		}
+	case "meta", "openapi":
+		// Zod 4 .meta({...}) and the .openapi({...}) or .openapi('Name', {...})
+		// of zod-openapi and @asteasolutions/zod-to-openapi
+		if len(args) > 0 {
+			applyZodMetadata(schema, args[len(args)-1], content)
 		}
 	case "default":
+		// Only constant defaults; factories such as () => [] are skipped
 		if len(args) > 0 {
-			schema.Default = p.extractLiteralValue(args[0], content)
+			if value, ok := parser.DefaultValue(args[0].Content(content), schema.Type); ok {
+				schema.Default = value
+			}
 		}
 	case "trim", "toLowerCase", "toUpperCase":
 		// These are transformations, no schema impact
@@ -654,53 +663,90 @@ func (p *ZodParser) applyZodModifier(schema *types.Schema, method string, callNo
 	return schema
 }
 
-// extractNumber extracts a number from a node.
-func (p *ZodParser) extractNumber(node *sitter.Node, content []byte) *float64 {
-	if node == nil {
-		return nil
+// applyZodMetadata sets the title, description, example and deprecation of
+// a schema from a metadata object literal. Component names (id, ref) are
+// not applied: components keep the names of their variables.
+func applyZodMetadata(schema *types.Schema, node *sitter.Node, content []byte) {
+	if node.Type() != "object" {
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		pair := node.NamedChild(i)
+		key, value := pair.ChildByFieldName("key"), pair.ChildByFieldName("value")
+		if pair.Type() != "pair" || key == nil || value == nil {
+			continue
+		}
+		v := zodValue(value, content)
+		switch strings.Trim(key.Content(content), `"'`) {
+		case "title":
+			if title, ok := v.(string); ok {
+				schema.Title = title
+			}
+		case "description":
+			if desc, ok := v.(string); ok {
+				schema.Description = desc
+			}
+		case "example":
+			if v != nil {
+				schema.Example = v
+			}
+		case "examples":
+			// OpenAPI 3.0 schemas have a single example
+			if examples, ok := v.([]any); ok && len(examples) > 0 && schema.Example == nil {
+				schema.Example = examples[0]
+			}
+		case "deprecated":
+			if deprecated, ok := v.(bool); ok {
+				schema.Deprecated = deprecated
+			}
+		}
 	}
+}
 
-	text := node.Content(content)
-	if v, err := strconv.ParseFloat(text, 64); err == nil {
-		return &v
+// zodValue returns the value of a literal, including arrays and object
+// literals of literals, or nil.
+func zodValue(node *sitter.Node, content []byte) any {
+	switch node.Type() {
+	case "string", "template_string":
+		// Interpolated template strings are not constants
+		value, _ := parser.DefaultValue(node.Content(content), "string")
+		return value
+	case "number":
+		value, _ := parser.DefaultValue(node.Content(content), "")
+		return value
+	case "true", "false":
+		return node.Type() == "true"
+	case "array":
+		values := []any{}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			values = append(values, zodValue(node.NamedChild(i), content))
+		}
+		return values
+	case "object":
+		values := make(map[string]any)
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			pair := node.NamedChild(i)
+			key, value := pair.ChildByFieldName("key"), pair.ChildByFieldName("value")
+			if pair.Type() == "pair" && key != nil && value != nil {
+				values[strings.Trim(key.Content(content), `"'`)] = zodValue(value, content)
+			}
+		}
+		return values
 	}
 	return nil
 }
 
-// extractLiteralValue extracts a literal value from a node.
-func (p *ZodParser) extractLiteralValue(node *sitter.Node, content []byte) any {
+// extractNumber extracts a number from a node.
+func (p *ZodParser) extractNumber(node *sitter.Node, content []byte) *float64 {
 	if node == nil {
 		return nil
 	}
 
 	text := node.Content(content)
-	nodeType := node.Type()
-
-	switch nodeType {
-	case "string":
-		return strings.Trim(text, `"'`)
-	case "number":
-		if v, err := strconv.ParseFloat(text, 64); err == nil {
-			if strings.Contains(text, ".") {
-				return v
-			}
-			return int(v)
-		}
-	case "true":
-		return true
-	case "false":
-		return false
-	case "null":
-		return nil
-	case "array":
-		// Could recursively extract, but for now return as string
-		return text
-	case "object":
-		// Could recursively extract, but for now return as string
-		return text
+	if v, err := strconv.ParseFloat(text, 64); err == nil {
+		return &v
 	}
-
-	return text
+	return nil
 }
 
 // getCallArguments returns the arguments from a call_expression node.
@@ -731,17 +777,7 @@ func (p *ZodParser) getCallArguments(node *sitter.Node) []*sitter.Node {
 
 // walkNodes walks all nodes in the tree, calling fn for each node.
 func (p *ZodParser) walkNodes(node *sitter.Node, fn func(*sitter.Node) bool) {
-	if node == nil {
-		return
-	}
-
-	if !fn(node) {
-		return
-	}
-
-	for i := 0; i < int(node.ChildCount()); i++ {
-		p.walkNodes(node.Child(i), fn)
-	}
+	parser.Walk(node, fn)
 }
 
 // extractZodMethod extracts the Zod method name from a callee string.
@@ -310,3 +310,68 @@ func TestExtractZodMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestZodParser_Defaults(t *testing.T) {
+	const testCode = `
+import { z } from 'zod';
+
+const QuerySchema = z.object({
+  limit: z.number().int().default(20),
+  offset: z.number().default(-1),
+  order: z.enum(['asc', 'desc']).default('asc'),
+  tags: z.array(z.string()).default(['new']),
+  created: z.date().default(() => new Date()),
+});
+`
+
+	tsParser := parser.NewTypeScriptParser()
+	defer tsParser.Close()
+
+	pf, err := tsParser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.ZodSchemas, 1)
+
+	schema, err := NewZodParser(tsParser).ParseZodSchema(pf.ZodSchemas[0].Node, pf.Content)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(20), schema.Properties["limit"].Default)
+	assert.Equal(t, float64(-1), schema.Properties["offset"].Default)
+	assert.Equal(t, "asc", schema.Properties["order"].Default)
+	assert.Equal(t, []any{"new"}, schema.Properties["tags"].Default)
+	assert.Nil(t, schema.Properties["created"].Default)
+}
+
+func TestZodParser_Metadata(t *testing.T) {
+	const testCode = `
+import { z } from 'zod';
+
+export const User = registry.register('User', z.object({
+  id: z.string().uuid().openapi({ example: '3fa85f64-5717-4562-b3fc-2c963f66afa6' }),
+  name: z.string().describe("The user's display name"),
+  tags: z.array(z.string()).meta({ title: 'Tags', examples: [['admin', 'ops']] }),
+  legacyId: z.number().meta({ deprecated: true, description: ` + "`Use id`" + ` }),
+}).openapi('User', { description: 'A registered user', example: { id: '3fa85f64', name: 'Ada' } }));
+`
+
+	tsParser := parser.NewTypeScriptParser()
+	defer tsParser.Close()
+
+	pf, err := tsParser.ParseSource("test.ts", testCode)
+	require.NoError(t, err)
+	defer pf.Close()
+	require.Len(t, pf.ZodSchemas, 1, "registered schemas are extracted")
+
+	schema := NewZodParser(tsParser).ExtractAndRegister(pf.ZodSchemas[0].Name, pf.ZodSchemas[0].Node, pf.Content)
+
+	assert.Equal(t, "User", schema.Title)
+	assert.Equal(t, "A registered user", schema.Description)
+	assert.Equal(t, map[string]any{"id": "3fa85f64", "name": "Ada"}, schema.Example)
+	assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", schema.Properties["id"].Example)
+	assert.Equal(t, "uuid", schema.Properties["id"].Format)
+	assert.Equal(t, "The user's display name", schema.Properties["name"].Description)
+	assert.Equal(t, "Tags", schema.Properties["tags"].Title)
+	assert.Equal(t, []any{"admin", "ops"}, schema.Properties["tags"].Example)
+	assert.True(t, schema.Properties["legacyId"].Deprecated)
+	assert.Equal(t, "Use id", schema.Properties["legacyId"].Description)
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package sourcemap writes sidecar maps from the schemas of a generated
+// specification back to the source declarations they were extracted from,
+// so editors and tools can jump from the spec to the code.
+package sourcemap
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Suffix is appended to the spec path to name its source map file.
+const Suffix = ".sourcemap.json"
+
+// Version is the source map format version.
+const Version = 1
+
+// SourceMap maps JSON pointers into the spec to source locations.
+type SourceMap struct {
+	// Version is the source map format version
+	Version int `json:"version"`
+
+	// Spec is the spec file name, relative to the source map
+	Spec string `json:"spec"`
+
+	// Mappings maps JSON pointers (e.g., #/components/schemas/User/properties/email)
+	// to the declaration they were generated from
+	Mappings map[string]types.SourceLocation `json:"mappings"`
+}
+
+// PathFor returns the source map path for a spec file.
+func PathFor(specPath string) string {
+	return specPath + Suffix
+}
+
+// New collects the provenance of every component schema in doc and its
+// nested properties. Source files under root are recorded relative to it.
+func New(specPath, root string, doc *types.OpenAPI) *SourceMap {
+	m := &SourceMap{
+		Version:  Version,
+		Spec:     filepath.Base(specPath),
+		Mappings: make(map[string]types.SourceLocation),
+	}
+	if doc.Components == nil {
+		return m
+	}
+
+	for name, schema := range doc.Components.Schemas {
+		m.add("#/components/schemas/"+escape(name), schema, root)
+	}
+	return m
+}
+
+// add records schema at pointer and recurses into its subschemas.
+func (m *SourceMap) add(pointer string, schema *types.Schema, root string) {
+	if schema == nil {
+		return
+	}
+
+	if schema.Source != nil {
+		loc := *schema.Source
+		loc.File = relativePath(root, loc.File)
+		m.Mappings[pointer] = loc
+	}
+
+	for name, prop := range schema.Properties {
+		m.add(pointer+"/properties/"+escape(name), prop, root)
+	}
+	m.add(pointer+"/items", schema.Items, root)
+	m.add(pointer+"/additionalProperties", schema.AdditionalProperties, root)
+	m.add(pointer+"/not", schema.Not, root)
+	for i, sub := range schema.AllOf {
+		m.add(pointer+"/allOf/"+strconv.Itoa(i), sub, root)
+	}
+	for i, sub := range schema.OneOf {
+		m.add(pointer+"/oneOf/"+strconv.Itoa(i), sub, root)
+	}
+	for i, sub := range schema.AnyOf {
+		m.add(pointer+"/anyOf/"+strconv.Itoa(i), sub, root)
+	}
+}
+
+// Write writes the source map as indented JSON.
+func (m *SourceMap) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode source map: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write source map %s: %w", path, err)
+	}
+	return nil
+}
+
+// escape encodes a JSON pointer reference token (RFC 6901).
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// relativePath returns file relative to root when it lies inside it.
+func relativePath(root, file string) string {
+	if root != "" && filepath.IsAbs(file) {
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+	}
+	return filepath.ToSlash(file)
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package sourcemap
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestNew(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "src", "models.ts")
+
+	doc := &types.OpenAPI{
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"User": {
+					Type:   "object",
+					Source: &types.SourceLocation{File: file, Line: 3, Type: "User"},
+					Properties: map[string]*types.Schema{
+						"email": {Type: "string", Source: &types.SourceLocation{File: file, Line: 5, Type: "string"}},
+						"tags": {
+							Type:   "array",
+							Items:  &types.Schema{Type: "string"},
+							Source: &types.SourceLocation{File: file, Line: 6, Type: "string[]"},
+						},
+						"a/b": {Type: "string", Source: &types.SourceLocation{File: "/elsewhere/x.ts", Line: 7}},
+					},
+				},
+				"Merged": {Type: "object"},
+			},
+		},
+	}
+
+	m := New(filepath.Join(root, "openapi.yaml"), root, doc)
+	assert.Equal(t, "openapi.yaml", m.Spec)
+	assert.Equal(t, map[string]types.SourceLocation{
+		"#/components/schemas/User":                  {File: "src/models.ts", Line: 3, Type: "User"},
+		"#/components/schemas/User/properties/email": {File: "src/models.ts", Line: 5, Type: "string"},
+		"#/components/schemas/User/properties/tags":  {File: "src/models.ts", Line: 6, Type: "string[]"},
+		"#/components/schemas/User/properties/a~1b":  {File: "/elsewhere/x.ts", Line: 7},
+	}, m.Mappings)
+}
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "openapi.yaml")
+	path := PathFor(specPath)
+	assert.Equal(t, filepath.Join(dir, "openapi.yaml.sourcemap.json"), path)
+
+	m := New(specPath, dir, &types.OpenAPI{})
+	require.NoError(t, m.Write(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var decoded SourceMap
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, Version, decoded.Version)
+	assert.Equal(t, "openapi.yaml", decoded.Spec)
+	assert.Empty(t, decoded.Mappings)
+}
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// avroRecord is an Avro record schema.
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Doc       string      `json:"doc,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+// avroField is a field of an Avro record.
+type avroField struct {
+	Name    string          `json:"name"`
+	Type    any             `json:"type"`
+	Doc     string          `json:"doc,omitempty"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+// avroEnum is an Avro enum schema.
+type avroEnum struct {
+	Type      string   `json:"type"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace,omitempty"`
+	Doc       string   `json:"doc,omitempty"`
+	Symbols   []string `json:"symbols"`
+}
+
+// avroArray is an Avro array schema.
+type avroArray struct {
+	Type  string `json:"type"`
+	Items any    `json:"items"`
+}
+
+// avroMap is an Avro map schema.
+type avroMap struct {
+	Type   string `json:"type"`
+	Values any    `json:"values"`
+}
+
+// avroLogical is a primitive annotated with an Avro logical type.
+type avroLogical struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+}
+
+var avroNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// avroGen renders component schemas as Avro named types.
+type avroGen struct {
+	schemas   map[string]*types.Schema
+	namespace string
+	defined   map[string]bool
+	losses    []Loss
+	component string
+}
+
+// Avro renders component schemas as a JSON array of Avro named types.
+// Avro requires a named type to be defined before it is referenced, so a
+// component is defined inline at its first use and referenced by name
+// afterwards; components already defined that way are not repeated.
+func Avro(doc *types.OpenAPI, opts Options) (string, []Loss, error) {
+	schemas, names := componentSchemas(doc)
+	g := &avroGen{
+		schemas:   schemas,
+		namespace: packageName(opts.Package),
+		defined:   make(map[string]bool),
+	}
+
+	definitions := []any{}
+	for _, name := range names {
+		g.component = name
+		schema := flatten(schemas, schemas[name])
+		if !g.named(schema) {
+			g.loss("", "not a record or enum; inlined where referenced")
+			continue
+		}
+		if g.defined[name] {
+			continue
+		}
+		definitions = append(definitions, g.define(name, schema))
+	}
+
+	data, err := json.MarshalIndent(definitions, "", "  ")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode avro schema: %w", err)
+	}
+	return string(data) + "\n", g.losses, nil
+}
+
+func (g *avroGen) loss(path, reason string) {
+	g.losses = append(g.losses, Loss{Schema: g.component, Path: path, Reason: reason})
+}
+
+// named reports whether a component becomes an Avro named type.
+func (g *avroGen) named(schema *types.Schema) bool {
+	return schema != nil && (isStringEnum(schema) || len(schema.Properties) > 0 ||
+		(schema.Type == "object" && mapValues(schema) == nil && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0))
+}
+
+// define returns the named type declaration of a component.
+func (g *avroGen) define(name string, schema *types.Schema) any {
+	g.defined[name] = true
+	id := strings.ReplaceAll(Identifier(name), "$", "_")
+	if isStringEnum(schema) {
+		return g.enum(id, g.namespace, schema, "")
+	}
+	return g.record(id, g.namespace, schema, "")
+}
+
+// record renders an object schema as a record. Optional and nullable
+// fields become unions with null, defaulting to null.
+func (g *avroGen) record(name, namespace string, schema *types.Schema, path string) avroRecord {
+	if mapValues(schema) != nil && len(schema.Properties) > 0 {
+		g.loss(path, "additional properties dropped")
+	}
+
+	record := avroRecord{Type: "record", Name: name, Namespace: namespace, Doc: schema.Description, Fields: []avroField{}}
+	required := requiredSet(schema)
+	for _, prop := range sortedProperties(schema) {
+		propPath := joinPath(path, prop)
+		propSchema := schema.Properties[prop]
+
+		fieldName := prop
+		if !avroNameRegex.MatchString(fieldName) {
+			fieldName = snakeCase(prop)
+			g.loss(propPath, fmt.Sprintf("field renamed to %s", fieldName))
+		}
+
+		field := avroField{Name: fieldName, Type: g.typeOf(propSchema, name+Identifier(prop), propPath)}
+		if propSchema != nil {
+			field.Doc = propSchema.Description
+		}
+		if !required[prop] || (propSchema != nil && propSchema.Nullable) {
+			field.Type = nullable(field.Type)
+			field.Default = json.RawMessage("null")
+		}
+		record.Fields = append(record.Fields, field)
+	}
+	return record
+}
+
+// enum renders a string enum. Symbols that are not valid Avro names are
+// rewritten.
+func (g *avroGen) enum(name, namespace string, schema *types.Schema, path string) avroEnum {
+	enum := avroEnum{Type: "enum", Name: name, Namespace: namespace, Doc: schema.Description}
+	used := make(map[string]bool)
+	for _, value := range schema.Enum {
+		symbol := value.(string)
+		if !avroNameRegex.MatchString(symbol) {
+			rewritten := strings.ToUpper(snakeCase(symbol))
+			g.loss(path, fmt.Sprintf("enum value %q rewritten as symbol %s", symbol, rewritten))
+			symbol = rewritten
+		}
+		if used[symbol] {
+			continue
+		}
+		used[symbol] = true
+		enum.Symbols = append(enum.Symbols, symbol)
+	}
+	return enum
+}
+
+// typeOf returns the Avro type of a field schema. Inline records and enums
+// are named after their parent record and property.
+func (g *avroGen) typeOf(schema *types.Schema, inlineName, path string) any {
+	t := g.baseType(schema, inlineName, path)
+	if schema != nil && schema.Nullable {
+		t = nullable(t)
+	}
+	return t
+}
+
+func (g *avroGen) baseType(schema *types.Schema, inlineName, path string) any {
+	if schema == nil {
+		g.loss(path, "untyped value mapped to string")
+		return "string"
+	}
+
+	if schema.Ref != "" {
+		component := refComponent(schema.Ref)
+		target := flatten(g.schemas, g.schemas[component])
+		if target == nil {
+			g.loss(path, "unresolved reference "+schema.Ref+" mapped to string")
+			return "string"
+		}
+		if !g.named(target) {
+			return g.typeOf(target, inlineName, path)
+		}
+		if g.defined[component] {
+			return strings.ReplaceAll(Identifier(component), "$", "_")
+		}
+		// Define the component at its first use
+		outer := g.component
+		g.component = component
+		defer func() { g.component = outer }()
+		return g.define(component, target)
+	}
+
+	if len(schema.AllOf) > 0 {
+		if len(schema.AllOf) == 1 && schema.AllOf[0] != nil && schema.AllOf[0].Ref != "" {
+			return g.baseType(schema.AllOf[0], inlineName, path)
+		}
+		schema = flatten(g.schemas, schema)
+	}
+
+	if alternatives := append(append([]*types.Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		if len(schema.AnyOf) > 0 {
+			g.loss(path, "anyOf mapped to a union; only one branch can match")
+		}
+		var branches []any
+		for i, alt := range alternatives {
+			branch := g.typeOf(alt, fmt.Sprintf("%sOption%d", inlineName, i+1), path)
+			// Avro unions cannot nest
+			if nested, ok := branch.([]any); ok {
+				branches = append(branches, nested...)
+				continue
+			}
+			branches = append(branches, branch)
+		}
+		return dedupeUnion(branches)
+	}
+
+	g.losses = append(g.losses, constraintLoss(g.component, path, schema)...)
+
+	if len(schema.Enum) > 0 {
+		if isStringEnum(schema) {
+			return g.enum(inlineName, "", schema, path)
+		}
+		g.loss(path, "non-string enum values dropped")
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return avroLogical{Type: "long", LogicalType: "timestamp-millis"}
+		case "date":
+			return avroLogical{Type: "int", LogicalType: "date"}
+		case "uuid":
+			return avroLogical{Type: "string", LogicalType: "uuid"}
+		case "byte", "binary":
+			return "bytes"
+		}
+		return "string"
+	case "integer":
+		if schema.Format == "int32" {
+			return "int"
+		}
+		return "long"
+	case "number":
+		if schema.Format == "float" {
+			return "float"
+		}
+		return "double"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		return avroArray{Type: "array", Items: g.typeOf(schema.Items, inlineName+"Item", joinPath(path, "[]"))}
+	}
+
+	if len(schema.Properties) > 0 {
+		return g.record(inlineName, "", schema, path)
+	}
+	if values := mapValues(schema); values != nil {
+		return avroMap{Type: "map", Values: g.typeOf(values, inlineName+"Value", joinPath(path, "*"))}
+	}
+	if schema.Type == "object" {
+		g.loss(path, "free-form object mapped to map<string>")
+		return avroMap{Type: "map", Values: "string"}
+	}
+
+	g.loss(path, "untyped value mapped to string")
+	return "string"
+}
+
+// nullable makes t a union with null, placing null first so that a null
+// default is valid.
+func nullable(t any) any {
+	if union, ok := t.([]any); ok {
+		result := []any{"null"}
+		for _, branch := range union {
+			if branch != "null" {
+				result = append(result, branch)
+			}
+		}
+		return result
+	}
+	if t == "null" {
+		return t
+	}
+	return []any{"null", t}
+}
+
+// dedupeUnion drops repeated primitive branches, which Avro rejects.
+func dedupeUnion(branches []any) []any {
+	seen := make(map[string]bool)
+	result := make([]any, 0, len(branches))
+	for _, branch := range branches {
+		if s, ok := branch.(string); ok {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+		}
+		result = append(result, branch)
+	}
+	return result
+}
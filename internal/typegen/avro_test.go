@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestAvro(t *testing.T) {
+	out, losses, err := Avro(testDoc(), Options{Package: "acme.users"})
+	require.NoError(t, err)
+
+	var definitions []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &definitions))
+
+	// models.User is defined inline at its first use in Admin, so only
+	// Admin and Page are top-level definitions
+	require.Len(t, definitions, 2)
+	assert.Equal(t, "Admin", definitions[0]["name"])
+	assert.Equal(t, "acme.users", definitions[0]["namespace"])
+	assert.Equal(t, "Page", definitions[1]["name"])
+
+	fields := definitions[0]["fields"].([]any)
+	manager := fields[3].(map[string]any)
+	assert.Equal(t, "manager", manager["name"])
+	assert.Nil(t, manager["default"])
+	union := manager["type"].([]any)
+	assert.Equal(t, "null", union[0])
+	user := union[1].(map[string]any)
+	assert.Equal(t, "ModelsUser", user["name"])
+
+	// The recursive reference uses the name of the record being defined
+	userManager := user["fields"].([]any)[3].(map[string]any)
+	assert.Equal(t, []any{"null", "ModelsUser"}, userManager["type"])
+
+	created := user["fields"].([]any)[0].(map[string]any)
+	assert.Equal(t, "created_at", created["name"])
+	assert.Equal(t, []any{"null", map[string]any{"type": "long", "logicalType": "timestamp-millis"}}, created["type"])
+
+	items := definitions[1]["fields"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "array", "items": "ModelsUser"}, items["type"])
+	_, hasDefault := items["default"]
+	assert.False(t, hasDefault)
+
+	assert.ElementsMatch(t, []string{
+		"Admin.created-at: field renamed to created_at",
+		"Admin.nickname: validation constraints dropped (maxLength)",
+		"models.User.created-at: field renamed to created_at",
+		"models.User.nickname: validation constraints dropped (maxLength)",
+		"Id: not a record or enum; inlined where referenced",
+		"Labels: not a record or enum; inlined where referenced",
+		"Page.size: validation constraints dropped (minimum, maximum)",
+	}, lossStrings(losses))
+}
+
+func TestAvro_Enum(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Status": {Type: "string", Enum: []any{"active", "on-hold"}},
+		"Event": {
+			Type:     "object",
+			Required: []string{"status", "id"},
+			Properties: map[string]*types.Schema{
+				"id":     {OneOf: []*types.Schema{{Type: "string"}, {Type: "integer", Format: "int32"}, {Type: "string"}}},
+				"status": {Ref: "#/components/schemas/Status"},
+			},
+		},
+	}}}
+
+	out, losses, err := Avro(doc, Options{})
+	require.NoError(t, err)
+
+	var definitions []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &definitions))
+	require.Len(t, definitions, 1)
+
+	fields := definitions[0]["fields"].([]any)
+	assert.Equal(t, []any{"string", "int"}, fields[0].(map[string]any)["type"])
+	assert.Equal(t, map[string]any{
+		"type":      "enum",
+		"name":      "Status",
+		"namespace": "api",
+		"symbols":   []any{"active", "ON_HOLD"},
+	}, fields[1].(map[string]any)["type"])
+
+	assert.Equal(t, []string{`Status: enum value "on-hold" rewritten as symbol ON_HOLD`}, lossStrings(losses))
+}
@@ -0,0 +1,385 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Well-known protobuf types used for values proto3 cannot type precisely.
+const (
+	protoStructImport    = "google/protobuf/struct.proto"
+	protoTimestampImport = "google/protobuf/timestamp.proto"
+	protoValue           = "google.protobuf.Value"
+	protoStruct          = "google.protobuf.Struct"
+	protoListValue       = "google.protobuf.ListValue"
+	protoTimestamp       = "google.protobuf.Timestamp"
+)
+
+// protoField is the rendered type of a message field.
+type protoField struct {
+	typ      string
+	repeated bool
+	isMap    bool
+	scalar   bool
+}
+
+// protoGen renders component schemas as proto3 messages.
+type protoGen struct {
+	schemas   map[string]*types.Schema
+	imports   map[string]bool
+	losses    []Loss
+	component string
+}
+
+// Proto renders component schemas as proto3 messages and enums, and
+// reports the parts of the schemas proto3 cannot represent.
+func Proto(doc *types.OpenAPI, opts Options) (string, []Loss) {
+	schemas, names := componentSchemas(doc)
+	g := &protoGen{schemas: schemas, imports: make(map[string]bool)}
+
+	var body strings.Builder
+	for _, name := range names {
+		g.component = name
+		schema := flatten(schemas, schemas[name])
+		switch g.kind(schema) {
+		case "enum":
+			body.WriteString("\n")
+			body.WriteString(g.enum(Identifier(name), schema, ""))
+		case "message":
+			body.WriteString("\n")
+			body.WriteString(g.message(Identifier(name), schema, "", ""))
+		case "wrapper":
+			// Arrays and maps have no top-level proto form
+			kind := "map"
+			if schema.Type == "array" {
+				kind = "array"
+			}
+			g.loss("", "top-level "+kind+" wrapped in a message with a single field")
+			wrapper := &types.Schema{
+				Type:        "object",
+				Description: schema.Description,
+				Properties:  map[string]*types.Schema{"values": schema},
+			}
+			body.WriteString("\n")
+			body.WriteString(g.message(Identifier(name), wrapper, "", ""))
+		default:
+			g.loss("", "scalar component has no proto declaration; inlined where referenced")
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(header)
+	b.WriteString("\nsyntax = \"proto3\";\n\n")
+	fmt.Fprintf(&b, "package %s;\n", packageName(opts.Package))
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		b.WriteString("\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&b, "import %q;\n", imp)
+		}
+	}
+	b.WriteString(body.String())
+	return b.String(), g.losses
+}
+
+// packageName returns the proto package / Avro namespace, defaulting to "api".
+func packageName(pkg string) string {
+	if pkg == "" {
+		return "api"
+	}
+	return pkg
+}
+
+func (g *protoGen) loss(path, reason string) {
+	g.losses = append(g.losses, Loss{Schema: g.component, Path: path, Reason: reason})
+}
+
+// kind classifies how a top-level component is declared.
+func (g *protoGen) kind(schema *types.Schema) string {
+	switch {
+	case schema == nil:
+		return "scalar"
+	case isStringEnum(schema):
+		return "enum"
+	case len(schema.Properties) > 0 || len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		return "message"
+	case schema.Type == "array" || mapValues(schema) != nil:
+		return "wrapper"
+	case schema.Type == "object":
+		return "message"
+	default:
+		return "scalar"
+	}
+}
+
+// isStringEnum reports whether schema is an enum of string values.
+func isStringEnum(schema *types.Schema) bool {
+	if len(schema.Enum) == 0 {
+		return false
+	}
+	for _, value := range schema.Enum {
+		if _, ok := value.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// message renders a message declaration with its nested types. Fields are
+// numbered in property name order.
+func (g *protoGen) message(name string, schema *types.Schema, indent, path string) string {
+	inner := indent + "  "
+	var nested, fields strings.Builder
+	number := 1
+
+	if alternatives := append(append([]*types.Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		if len(schema.AnyOf) > 0 {
+			g.loss(path, "anyOf mapped to oneof; only one alternative can be set")
+		}
+		g.oneof("value", alternatives, &number, inner, path, &nested, &fields)
+	} else {
+		if mapValues(schema) != nil && len(schema.Properties) > 0 {
+			g.loss(path, "additional properties dropped")
+		}
+		required := requiredSet(schema)
+		for _, prop := range sortedProperties(schema) {
+			propPath := joinPath(path, prop)
+			propSchema := schema.Properties[prop]
+
+			if propSchema != nil && (len(propSchema.OneOf) > 0 || len(propSchema.AnyOf) > 0) {
+				if len(propSchema.AnyOf) > 0 {
+					g.loss(propPath, "anyOf mapped to oneof; only one alternative can be set")
+				}
+				alternatives := append(append([]*types.Schema{}, propSchema.OneOf...), propSchema.AnyOf...)
+				g.oneof(snakeCase(prop), alternatives, &number, inner, propPath, &nested, &fields)
+				continue
+			}
+
+			field := g.field(propSchema, Identifier(prop), inner, propPath, &nested)
+			writeProtoDoc(&fields, inner, propSchema)
+			fields.WriteString(inner)
+			switch {
+			case field.repeated:
+				fields.WriteString("repeated ")
+			case field.scalar && (!required[prop] || (propSchema != nil && propSchema.Nullable)):
+				fields.WriteString("optional ")
+			}
+			fmt.Fprintf(&fields, "%s %s = %d%s;\n", field.typ, snakeCase(prop), number, jsonNameOption(prop))
+			number++
+		}
+	}
+
+	var b strings.Builder
+	writeProtoDoc(&b, indent, schema)
+	fmt.Fprintf(&b, "%smessage %s {\n", indent, name)
+	b.WriteString(nested.String())
+	b.WriteString(fields.String())
+	fmt.Fprintf(&b, "%s}\n", indent)
+	return b.String()
+}
+
+// oneof renders a oneof group with one field per alternative.
+func (g *protoGen) oneof(name string, alternatives []*types.Schema, number *int, indent, path string, nested, fields *strings.Builder) {
+	inner := indent + "  "
+	fmt.Fprintf(fields, "%soneof %s {\n", indent, name)
+	used := make(map[string]bool)
+	for i, alt := range alternatives {
+		suffix := g.alternativeName(alt, i)
+		for used[suffix] {
+			suffix = fmt.Sprintf("%s_%d", suffix, i+1)
+		}
+		used[suffix] = true
+
+		field := g.field(alt, Identifier(name+"_"+suffix), indent, path, nested)
+		if field.repeated || field.isMap {
+			// oneof members cannot be repeated or maps
+			g.loss(path, "repeated or map alternative in oneof mapped to "+protoValue)
+			g.imports[protoStructImport] = true
+			field.typ = protoValue
+		}
+		fmt.Fprintf(fields, "%s%s %s_%s = %d;\n", inner, field.typ, name, suffix, *number)
+		*number++
+	}
+	fmt.Fprintf(fields, "%s}\n", indent)
+}
+
+// alternativeName names a oneof member after its type.
+func (g *protoGen) alternativeName(alt *types.Schema, index int) string {
+	switch {
+	case alt == nil:
+		return fmt.Sprintf("option%d", index+1)
+	case alt.Ref != "":
+		return snakeCase(refComponent(alt.Ref))
+	case alt.Type != "":
+		return alt.Type
+	default:
+		return fmt.Sprintf("option%d", index+1)
+	}
+}
+
+// field returns the proto type of a field schema, writing any nested
+// enum or message declarations it needs into nested.
+func (g *protoGen) field(schema *types.Schema, nestedName, indent, path string, nested *strings.Builder) protoField {
+	if schema == nil {
+		g.imports[protoStructImport] = true
+		return protoField{typ: protoValue}
+	}
+
+	if schema.Ref != "" {
+		component := refComponent(schema.Ref)
+		target := flatten(g.schemas, g.schemas[component])
+		if target == nil {
+			g.loss(path, "unresolved reference "+schema.Ref+" mapped to "+protoValue)
+			g.imports[protoStructImport] = true
+			return protoField{typ: protoValue}
+		}
+		if g.kind(target) == "scalar" {
+			return g.field(target, nestedName, indent, path, nested)
+		}
+		return protoField{typ: Identifier(component)}
+	}
+
+	if len(schema.AllOf) > 0 {
+		if len(schema.AllOf) == 1 && schema.AllOf[0] != nil && schema.AllOf[0].Ref != "" {
+			return g.field(schema.AllOf[0], nestedName, indent, path, nested)
+		}
+		schema = flatten(g.schemas, schema)
+	}
+
+	g.losses = append(g.losses, constraintLoss(g.component, path, schema)...)
+
+	if len(schema.Enum) > 0 {
+		if isStringEnum(schema) {
+			nested.WriteString(g.enum(nestedName, schema, indent))
+			return protoField{typ: nestedName}
+		}
+		g.loss(path, "non-string enum values dropped")
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			g.imports[protoTimestampImport] = true
+			return protoField{typ: protoTimestamp}
+		case "byte", "binary":
+			return protoField{typ: "bytes", scalar: true}
+		}
+		return protoField{typ: "string", scalar: true}
+	case "integer":
+		if schema.Format == "int32" {
+			return protoField{typ: "int32", scalar: true}
+		}
+		return protoField{typ: "int64", scalar: true}
+	case "number":
+		if schema.Format == "float" {
+			return protoField{typ: "float", scalar: true}
+		}
+		return protoField{typ: "double", scalar: true}
+	case "boolean":
+		return protoField{typ: "bool", scalar: true}
+	case "array":
+		item := g.field(schema.Items, nestedName, indent, joinPath(path, "[]"), nested)
+		switch {
+		case item.repeated:
+			g.loss(path, "nested arrays mapped to repeated "+protoListValue)
+			g.imports[protoStructImport] = true
+			return protoField{typ: protoListValue, repeated: true}
+		case item.isMap:
+			g.loss(path, "array of maps mapped to repeated "+protoStruct)
+			g.imports[protoStructImport] = true
+			return protoField{typ: protoStruct, repeated: true}
+		}
+		return protoField{typ: item.typ, repeated: true}
+	}
+
+	if len(schema.Properties) > 0 {
+		nested.WriteString(g.message(nestedName, schema, indent, path))
+		return protoField{typ: nestedName}
+	}
+	if values := mapValues(schema); values != nil {
+		value := g.field(values, nestedName+"Value", indent, joinPath(path, "*"), nested)
+		if value.repeated || value.isMap {
+			g.loss(path, "map of repeated or map values mapped to map<string, "+protoValue+">")
+			g.imports[protoStructImport] = true
+			value.typ = protoValue
+		}
+		return protoField{typ: "map<string, " + value.typ + ">", isMap: true}
+	}
+	if schema.Type == "object" {
+		g.imports[protoStructImport] = true
+		return protoField{typ: protoStruct}
+	}
+
+	g.loss(path, "untyped value mapped to "+protoValue)
+	g.imports[protoStructImport] = true
+	return protoField{typ: protoValue}
+}
+
+// enum renders a string enum. Values are prefixed with the enum name as
+// proto3 enum values share their parent's scope, and a zero value is added
+// because proto3 requires one.
+func (g *protoGen) enum(name string, schema *types.Schema, indent string) string {
+	prefix := strings.ToUpper(snakeCase(name))
+	inner := indent + "  "
+
+	var b strings.Builder
+	writeProtoDoc(&b, indent, schema)
+	fmt.Fprintf(&b, "%senum %s {\n", indent, name)
+	fmt.Fprintf(&b, "%s%s_UNSPECIFIED = 0;\n", inner, prefix)
+	used := map[string]bool{prefix + "_UNSPECIFIED": true}
+	for i, value := range schema.Enum {
+		symbol := prefix + "_" + strings.ToUpper(snakeCase(value.(string)))
+		for used[symbol] {
+			symbol = fmt.Sprintf("%s_%d", symbol, i+1)
+		}
+		used[symbol] = true
+		fmt.Fprintf(&b, "%s%s = %d;\n", inner, symbol, i+1)
+	}
+	fmt.Fprintf(&b, "%s}\n", indent)
+	return b.String()
+}
+
+// jsonNameOption preserves the original property name when proto3's
+// default JSON name (lowerCamelCase of the field name) would differ.
+func jsonNameOption(name string) string {
+	parts := words(name)
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	if strings.Join(parts, "") == name {
+		return ""
+	}
+	return fmt.Sprintf(" [json_name = %q]", name)
+}
+
+// joinPath appends a property to a dotted path.
+func joinPath(path, prop string) string {
+	if path == "" {
+		return prop
+	}
+	if prop == "[]" {
+		return path + prop
+	}
+	return path + "." + prop
+}
+
+// writeProtoDoc writes a schema description as // comments.
+func writeProtoDoc(b *strings.Builder, indent string, schema *types.Schema) {
+	if schema == nil || schema.Description == "" {
+		return
+	}
+	for _, line := range strings.Split(schema.Description, "\n") {
+		fmt.Fprintf(b, "%s//%s\n", indent, strings.TrimRight(" "+line, " "))
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func lossStrings(losses []Loss) []string {
+	result := make([]string, 0, len(losses))
+	for _, loss := range losses {
+		result = append(result, loss.String())
+	}
+	return result
+}
+
+func TestProto(t *testing.T) {
+	out, losses := Proto(testDoc(), Options{Package: "acme.users.v1"})
+
+	assert.Contains(t, out, "syntax = \"proto3\";\n\npackage acme.users.v1;\n\nimport \"google/protobuf/timestamp.proto\";\n")
+	assert.Contains(t, out, `// A registered user
+message ModelsUser {
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_ACTIVE = 1;
+    STATUS_DISABLED = 2;
+  }
+  google.protobuf.Timestamp created_at = 1 [json_name = "created-at"];
+  string email = 2;
+  int64 id = 3;
+  ModelsUser manager = 4;
+  optional string nickname = 5;
+  Status status = 6;
+  repeated string tags = 7;
+}
+`)
+	assert.Contains(t, out, "message Admin {\n")
+	assert.Contains(t, out, "  optional string role = 6;\n")
+	assert.Contains(t, out, `message Id {
+  oneof value {
+    string value_string = 1;
+    int64 value_integer = 2;
+  }
+}
+`)
+	assert.Contains(t, out, "message Labels {\n  map<string, string> values = 1;\n}\n")
+
+	assert.ElementsMatch(t, []string{
+		"Admin.nickname: validation constraints dropped (maxLength)",
+		"Labels: top-level map wrapped in a message with a single field",
+		"Page.size: validation constraints dropped (minimum, maximum)",
+		"models.User.nickname: validation constraints dropped (maxLength)",
+	}, lossStrings(losses))
+}
+
+func TestProto_Lossy(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Grid": {
+			Type: "object",
+			Properties: map[string]*types.Schema{
+				"cells":    {Type: "array", Items: &types.Schema{Type: "array", Items: &types.Schema{Type: "integer"}}},
+				"meta":     {},
+				"priority": {Type: "integer", Enum: []any{1, 2, 3}},
+				"shape":    {AnyOf: []*types.Schema{{Type: "string"}, {Type: "array", Items: &types.Schema{Type: "string"}}}},
+			},
+		},
+		"Slug": {Type: "string", Pattern: "^[a-z-]+$"},
+	}}}
+
+	out, losses := Proto(doc, Options{})
+
+	assert.Contains(t, out, "package api;\n\nimport \"google/protobuf/struct.proto\";\n")
+	assert.Contains(t, out, "  repeated google.protobuf.ListValue cells = 1;\n")
+	assert.Contains(t, out, "  google.protobuf.Value meta = 2;\n")
+	assert.Contains(t, out, "  optional int64 priority = 3;\n")
+	assert.Contains(t, out, "  oneof shape {\n    string shape_string = 4;\n    google.protobuf.Value shape_array = 5;\n  }\n")
+	assert.NotContains(t, out, "Slug")
+
+	assert.Equal(t, []string{
+		"Grid.cells: nested arrays mapped to repeated google.protobuf.ListValue",
+		"Grid.meta: untyped value mapped to google.protobuf.Value",
+		"Grid.priority: non-string enum values dropped",
+		"Grid.shape: anyOf mapped to oneof; only one alternative can be set",
+		"Grid.shape: repeated or map alternative in oneof mapped to google.protobuf.Value",
+		"Slug: scalar component has no proto declaration; inlined where referenced",
+	}, lossStrings(losses))
+}
+
+func TestSnakeCase(t *testing.T) {
+	assert.Equal(t, "created_at", snakeCase("createdAt"))
+	assert.Equal(t, "created_at", snakeCase("created-at"))
+	assert.Equal(t, "http_status", snakeCase("HTTPStatus"))
+	assert.Equal(t, "_2fa_enabled", snakeCase("2faEnabled"))
+	assert.Equal(t, "", jsonNameOption("createdAt"))
+	assert.Equal(t, ` [json_name = "created_at"]`, jsonNameOption("created_at"))
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Loss is a part of a schema that the target format cannot represent
+// faithfully.
+type Loss struct {
+	// Schema is the component the loss occurred in
+	Schema string
+
+	// Path is the property path within the component, if any
+	Path string
+
+	// Reason describes what was dropped or approximated
+	Reason string
+}
+
+// String formats the loss as "Schema.path: reason".
+func (l Loss) String() string {
+	if l.Path == "" {
+		return l.Schema + ": " + l.Reason
+	}
+	return l.Schema + "." + l.Path + ": " + l.Reason
+}
+
+// componentSchemas returns the component schemas of doc and their sorted names.
+func componentSchemas(doc *types.OpenAPI) (map[string]*types.Schema, []string) {
+	var schemas map[string]*types.Schema
+	if doc != nil && doc.Components != nil {
+		schemas = doc.Components.Schemas
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return schemas, names
+}
+
+// refComponent returns the component name a reference points at.
+func refComponent(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// flatten merges allOf parts, resolving references, into a single object
+// schema. Schemas without allOf are returned unchanged.
+func flatten(schemas map[string]*types.Schema, schema *types.Schema) *types.Schema {
+	return flattenDepth(schemas, schema, 0)
+}
+
+func flattenDepth(schemas map[string]*types.Schema, schema *types.Schema, depth int) *types.Schema {
+	if schema == nil || len(schema.AllOf) == 0 || depth > 16 {
+		return schema
+	}
+
+	merged := *schema
+	merged.AllOf = nil
+	merged.Properties = make(map[string]*types.Schema, len(schema.Properties))
+	for name, prop := range schema.Properties {
+		merged.Properties[name] = prop
+	}
+	merged.Required = append([]string{}, schema.Required...)
+	for _, part := range schema.AllOf {
+		if part != nil && part.Ref != "" {
+			part = schemas[refComponent(part.Ref)]
+		}
+		part = flattenDepth(schemas, part, depth+1)
+		if part == nil {
+			continue
+		}
+		if merged.Type == "" {
+			merged.Type = part.Type
+		}
+		for name, prop := range part.Properties {
+			if _, ok := merged.Properties[name]; !ok {
+				merged.Properties[name] = prop
+			}
+		}
+		merged.Required = append(merged.Required, part.Required...)
+	}
+	return &merged
+}
+
+// mapValues returns the schema of additional property values, or nil when
+// schema has none or forbids them.
+func mapValues(schema *types.Schema) *types.Schema {
+	if schema.AdditionalProperties.IsFalse() {
+		return nil
+	}
+	return schema.AdditionalProperties
+}
+
+// sortedProperties returns the property names of schema in sorted order.
+func sortedProperties(schema *types.Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// requiredSet returns the required property names of schema as a set.
+func requiredSet(schema *types.Schema) map[string]bool {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	return required
+}
+
+// droppedConstraints names the validation keywords set on schema that
+// proto and Avro cannot express.
+func droppedConstraints(schema *types.Schema) []string {
+	var dropped []string
+	if schema.MinLength != nil {
+		dropped = append(dropped, "minLength")
+	}
+	if schema.MaxLength != nil {
+		dropped = append(dropped, "maxLength")
+	}
+	if schema.Pattern != "" {
+		dropped = append(dropped, "pattern")
+	}
+	if schema.Minimum != nil {
+		dropped = append(dropped, "minimum")
+	}
+	if schema.Maximum != nil {
+		dropped = append(dropped, "maximum")
+	}
+	if schema.MultipleOf != nil {
+		dropped = append(dropped, "multipleOf")
+	}
+	if schema.MinItems != nil {
+		dropped = append(dropped, "minItems")
+	}
+	if schema.MaxItems != nil {
+		dropped = append(dropped, "maxItems")
+	}
+	if schema.UniqueItems {
+		dropped = append(dropped, "uniqueItems")
+	}
+	return dropped
+}
+
+// constraintLoss returns a loss for dropped validation keywords, if any.
+func constraintLoss(component, path string, schema *types.Schema) []Loss {
+	if schema == nil {
+		return nil
+	}
+	if dropped := droppedConstraints(schema); len(dropped) > 0 {
+		return []Loss{{Schema: component, Path: path, Reason: fmt.Sprintf("validation constraints dropped (%s)", strings.Join(dropped, ", "))}}
+	}
+	return nil
+}
+
+// words splits a name into lowercase words at punctuation and case changes.
+func words(name string) []string {
+	var result []string
+	var current []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				result = append(result, string(current))
+				current = nil
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				result = append(result, string(current))
+				current = nil
+			}
+		}
+		current = append(current, unicode.ToLower(r))
+	}
+	if len(current) > 0 {
+		result = append(result, string(current))
+	}
+	return result
+}
+
+// snakeCase converts a name such as "createdAt" or "created-at" to
+// "created_at".
+func snakeCase(name string) string {
+	s := strings.Join(words(name), "_")
+	if s == "" {
+		return "field"
+	}
+	if unicode.IsDigit(rune(s[0])) {
+		s = "_" + s
+	}
+	return s
+}
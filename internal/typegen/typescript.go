@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package typegen generates client-side type definitions from the
+// component schemas of an OpenAPI document.
+package typegen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Supported languages.
+const (
+	LangTypeScript = "ts"
+	LangProto      = "proto"
+	LangAvro       = "avro"
+)
+
+// Languages lists the supported target languages.
+var Languages = []string{LangTypeScript, LangProto, LangAvro}
+
+// Options controls type generation.
+type Options struct {
+	// Zod additionally emits a zod schema for every component (TypeScript)
+	Zod bool
+
+	// Package is the proto package or Avro namespace (default: api)
+	Package string
+}
+
+// header marks the output as generated.
+const header = "// Code generated by api2spec. DO NOT EDIT.\n"
+
+// Generate renders the component schemas of doc in lang and reports the
+// parts of the schemas the target cannot represent.
+func Generate(doc *types.OpenAPI, lang string, opts Options) (string, []Loss, error) {
+	switch lang {
+	case LangTypeScript, "typescript":
+		return TypeScript(doc, opts), nil, nil
+	case LangProto, "protobuf":
+		code, losses := Proto(doc, opts)
+		return code, losses, nil
+	case LangAvro:
+		code, losses, err := Avro(doc, opts)
+		return code, losses, err
+	default:
+		return "", nil, fmt.Errorf("unsupported language %q (supported: %s)", lang, strings.Join(Languages, ", "))
+	}
+}
+
+// TypeScript renders one exported interface or type alias per component
+// schema and, with opts.Zod, a matching zod schema typed against it.
+func TypeScript(doc *types.OpenAPI, opts Options) string {
+	schemas, names := componentSchemas(doc)
+
+	var b strings.Builder
+	b.WriteString(header)
+	if opts.Zod {
+		b.WriteString("\nimport { z } from \"zod\";\n")
+	}
+
+	for _, name := range names {
+		schema := schemas[name]
+		b.WriteString("\n")
+		writeDoc(&b, "", schema)
+		if isObject(schema) && !schema.Nullable && mapValues(schema) == nil {
+			fmt.Fprintf(&b, "export interface %s ", Identifier(name))
+			b.WriteString(objectType(schema, ""))
+			b.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&b, "export type %s = %s;\n", Identifier(name), tsType(schema, ""))
+	}
+
+	if opts.Zod {
+		for _, name := range names {
+			id := Identifier(name)
+			fmt.Fprintf(&b, "\nexport const %sSchema: z.ZodType<%s> = z.lazy(() =>\n  %s\n);\n", id, id, zodType(schemas[name], "  "))
+		}
+	}
+	return b.String()
+}
+
+// Identifier converts a component name such as "models.User" or
+// "user-profile" into a TypeScript identifier.
+func Identifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '$' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	id := b.String()
+	if id == "" {
+		return "Unnamed"
+	}
+	if unicode.IsDigit(rune(id[0])) {
+		id = "_" + id
+	}
+	return id
+}
+
+// refName returns the identifier a component reference points at.
+func refName(ref string) string {
+	return Identifier(ref[strings.LastIndex(ref, "/")+1:])
+}
+
+// isObject reports whether schema renders as an object literal type.
+func isObject(schema *types.Schema) bool {
+	return schema != nil && schema.Ref == "" && len(schema.Properties) > 0 &&
+		len(schema.AllOf) == 0 && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0
+}
+
+// tsType renders the TypeScript type of schema.
+func tsType(schema *types.Schema, indent string) string {
+	t := baseType(schema, indent)
+	if schema != nil && schema.Nullable && t != "unknown" {
+		t = union(t, "null")
+	}
+	return t
+}
+
+func baseType(schema *types.Schema, indent string) string {
+	if schema == nil {
+		return "unknown"
+	}
+	if schema.Ref != "" {
+		return refName(schema.Ref)
+	}
+	if len(schema.AllOf) > 0 {
+		return composite(schema.AllOf, " & ", indent)
+	}
+	if alternatives := append(append([]*types.Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		return composite(alternatives, " | ", indent)
+	}
+	if len(schema.Enum) > 0 {
+		literals := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			literals = append(literals, literal(value))
+		}
+		return strings.Join(literals, " | ")
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		item := tsType(schema.Items, indent)
+		if strings.ContainsAny(item, "|&") && !strings.HasPrefix(item, "{") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	}
+
+	if len(schema.Properties) > 0 {
+		return objectType(schema, indent)
+	}
+	if values := mapValues(schema); values != nil {
+		return "Record<string, " + tsType(values, indent) + ">"
+	}
+	if schema.Type == "object" {
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// composite joins the rendered parts of a composition with sep.
+func composite(parts []*types.Schema, sep, indent string) string {
+	rendered := make([]string, 0, len(parts))
+	for _, part := range parts {
+		t := tsType(part, indent)
+		if strings.Contains(t, " | ") && sep == " & " {
+			t = "(" + t + ")"
+		}
+		rendered = append(rendered, t)
+	}
+	return strings.Join(rendered, sep)
+}
+
+// objectType renders an object literal type with one property per line.
+func objectType(schema *types.Schema, indent string) string {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inner := indent + "  "
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range names {
+		prop := schema.Properties[name]
+		writeDoc(&b, inner, prop)
+		b.WriteString(inner)
+		if prop != nil && prop.ReadOnly {
+			b.WriteString("readonly ")
+		}
+		b.WriteString(propertyName(name))
+		if !required[name] {
+			b.WriteString("?")
+		}
+		b.WriteString(": ")
+		b.WriteString(tsType(prop, inner))
+		b.WriteString(";\n")
+	}
+	if mapValues(schema) != nil {
+		// Declared properties must be assignable to the index signature
+		fmt.Fprintf(&b, "%s[key: string]: unknown;\n", inner)
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// propertyName quotes property names that are not valid identifiers.
+func propertyName(name string) string {
+	if identifierRegex.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// literal renders an enum value as a TypeScript literal type.
+func literal(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// union appends member to t unless it is already present.
+func union(t, member string) string {
+	for _, part := range strings.Split(t, " | ") {
+		if part == member {
+			return t
+		}
+	}
+	return t + " | " + member
+}
+
+// writeDoc writes a JSDoc comment for the schema description and
+// deprecation, if any.
+func writeDoc(b *strings.Builder, indent string, schema *types.Schema) {
+	if schema == nil || (schema.Description == "" && !schema.Deprecated) {
+		return
+	}
+	var lines []string
+	if schema.Description != "" {
+		lines = strings.Split(strings.ReplaceAll(schema.Description, "*/", "*\\/"), "\n")
+	}
+	if schema.Deprecated {
+		lines = append(lines, "@deprecated")
+	}
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(b, "%s *%s\n", indent, strings.TrimRight(" "+line, " "))
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func intPtr(i int) *int { return &i }
+
+func floatPtr(f float64) *float64 { return &f }
+
+func testDoc() *types.OpenAPI {
+	return &types.OpenAPI{
+		Components: &types.Components{
+			Schemas: map[string]*types.Schema{
+				"models.User": {
+					Type:        "object",
+					Description: "A registered user",
+					Required:    []string{"id", "email"},
+					Properties: map[string]*types.Schema{
+						"id":         {Type: "integer", ReadOnly: true},
+						"email":      {Type: "string", Format: "email"},
+						"nickname":   {Type: "string", Nullable: true, MaxLength: intPtr(32)},
+						"status":     {Type: "string", Enum: []any{"active", "disabled"}},
+						"tags":       {Type: "array", Items: &types.Schema{Type: "string"}},
+						"manager":    {Ref: "#/components/schemas/models.User"},
+						"created-at": {Type: "string", Format: "date-time", Deprecated: true},
+					},
+				},
+				"Page": {
+					Type:     "object",
+					Required: []string{"items"},
+					Properties: map[string]*types.Schema{
+						"items": {Type: "array", Items: &types.Schema{Ref: "#/components/schemas/models.User"}},
+						"size":  {Type: "integer", Minimum: floatPtr(1), Maximum: floatPtr(100)},
+					},
+				},
+				"Labels": {
+					Type:                 "object",
+					AdditionalProperties: &types.Schema{Type: "string"},
+				},
+				"Admin": {
+					AllOf: []*types.Schema{
+						{Ref: "#/components/schemas/models.User"},
+						{Type: "object", Properties: map[string]*types.Schema{"role": {Type: "string"}}},
+					},
+				},
+				"Id": {
+					OneOf: []*types.Schema{{Type: "string"}, {Type: "integer"}},
+				},
+			},
+		},
+	}
+}
+
+func TestTypeScript(t *testing.T) {
+	out := TypeScript(testDoc(), Options{})
+
+	assert.Equal(t, `// Code generated by api2spec. DO NOT EDIT.
+
+export type Admin = ModelsUser & {
+  role?: string;
+};
+
+export type Id = string | number;
+
+export type Labels = Record<string, string>;
+
+export interface Page {
+  items: ModelsUser[];
+  size?: number;
+}
+
+/** A registered user */
+export interface ModelsUser {
+  /** @deprecated */
+  "created-at"?: string;
+  email: string;
+  readonly id: number;
+  manager?: ModelsUser;
+  nickname?: string | null;
+  status?: "active" | "disabled";
+  tags?: string[];
+}
+`, out)
+}
+
+func TestTypeScript_Zod(t *testing.T) {
+	out := TypeScript(testDoc(), Options{Zod: true})
+
+	assert.Contains(t, out, "import { z } from \"zod\";\n")
+	assert.Contains(t, out, `export const ModelsUserSchema: z.ZodType<ModelsUser> = z.lazy(() =>
+  z.object({
+    "created-at": z.string().datetime().optional(),
+    email: z.string().email(),
+    id: z.number().int(),
+    manager: ModelsUserSchema.optional(),
+    nickname: z.string().max(32).nullable().optional(),
+    status: z.enum(["active", "disabled"]).optional(),
+    tags: z.array(z.string()).optional(),
+  })
+);`)
+	assert.Contains(t, out, "size: z.number().int().gte(1).lte(100).optional()")
+	assert.Contains(t, out, "export const LabelsSchema: z.ZodType<Labels> = z.lazy(() =>\n  z.record(z.string(), z.string())\n);")
+	assert.Contains(t, out, "z.union([z.string(), z.number().int()])")
+	assert.Contains(t, out, "ModelsUserSchema.and(z.object({")
+}
+
+func TestTypeScript_Doc(t *testing.T) {
+	doc := &types.OpenAPI{Components: &types.Components{Schemas: map[string]*types.Schema{
+		"Note": {Type: "string", Description: "First line\n\nEnds a comment */ here"},
+	}}}
+
+	assert.Contains(t, TypeScript(doc, Options{}), `/**
+ * First line
+ *
+ * Ends a comment *\/ here
+ */
+export type Note = string;`)
+}
+
+func TestGenerate(t *testing.T) {
+	out, losses, err := Generate(testDoc(), "ts", Options{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "export interface Page")
+	assert.Empty(t, losses)
+
+	_, _, err = Generate(testDoc(), "swift", Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported language "swift"`)
+}
+
+func TestIdentifier(t *testing.T) {
+	assert.Equal(t, "ModelsUser", Identifier("models.User"))
+	assert.Equal(t, "UserProfile", Identifier("user-profile"))
+	assert.Equal(t, "_2fa", Identifier("2fa"))
+	assert.Equal(t, "Unnamed", Identifier("..."))
+}
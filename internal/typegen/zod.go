@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// zodType renders the zod schema expression for schema. References resolve
+// to the referenced component's exported schema constant; components are
+// wrapped in z.lazy so declaration order and cycles do not matter.
+func zodType(schema *types.Schema, indent string) string {
+	z := zodBase(schema, indent)
+	if schema != nil && schema.Nullable {
+		z += ".nullable()"
+	}
+	return z
+}
+
+func zodBase(schema *types.Schema, indent string) string {
+	if schema == nil {
+		return "z.unknown()"
+	}
+	if schema.Ref != "" {
+		return refName(schema.Ref) + "Schema"
+	}
+	if len(schema.AllOf) > 0 {
+		z := zodType(schema.AllOf[0], indent)
+		for _, part := range schema.AllOf[1:] {
+			z += ".and(" + zodType(part, indent) + ")"
+		}
+		return z
+	}
+	if alternatives := append(append([]*types.Schema{}, schema.OneOf...), schema.AnyOf...); len(alternatives) > 0 {
+		if len(alternatives) == 1 {
+			return zodType(alternatives[0], indent)
+		}
+		parts := make([]string, 0, len(alternatives))
+		for _, alt := range alternatives {
+			parts = append(parts, zodType(alt, indent))
+		}
+		return "z.union([" + strings.Join(parts, ", ") + "])"
+	}
+	if len(schema.Enum) > 0 {
+		return zodEnum(schema.Enum)
+	}
+
+	switch schema.Type {
+	case "string":
+		return "z.string()" + zodString(schema)
+	case "integer":
+		return "z.number().int()" + zodNumber(schema)
+	case "number":
+		return "z.number()" + zodNumber(schema)
+	case "boolean":
+		return "z.boolean()"
+	case "null":
+		return "z.null()"
+	case "array":
+		z := "z.array(" + zodType(schema.Items, indent) + ")"
+		if schema.MinItems != nil {
+			z += fmt.Sprintf(".min(%d)", *schema.MinItems)
+		}
+		if schema.MaxItems != nil {
+			z += fmt.Sprintf(".max(%d)", *schema.MaxItems)
+		}
+		return z
+	}
+
+	if len(schema.Properties) > 0 {
+		z := zodObject(schema, indent)
+		if values := mapValues(schema); values != nil {
+			z += ".catchall(" + zodType(values, indent) + ")"
+		} else if schema.AdditionalProperties.IsFalse() {
+			z += ".strict()"
+		}
+		return z
+	}
+	if values := mapValues(schema); values != nil {
+		return "z.record(z.string(), " + zodType(values, indent) + ")"
+	}
+	if schema.Type == "object" {
+		return "z.record(z.string(), z.unknown())"
+	}
+	return "z.unknown()"
+}
+
+// zodObject renders a z.object with one property per line.
+func zodObject(schema *types.Schema, indent string) string {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inner := indent + "  "
+	var b strings.Builder
+	b.WriteString("z.object({\n")
+	for _, name := range names {
+		z := zodType(schema.Properties[name], inner)
+		if !required[name] {
+			z += ".optional()"
+		}
+		fmt.Fprintf(&b, "%s%s: %s,\n", inner, propertyName(name), z)
+	}
+	b.WriteString(indent + "})")
+	return b.String()
+}
+
+// zodEnum renders z.enum for string enums and a union of literals otherwise.
+func zodEnum(values []any) string {
+	allStrings := true
+	literals := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := value.(string); !ok {
+			allStrings = false
+		}
+		literals = append(literals, literal(value))
+	}
+	if allStrings {
+		return "z.enum([" + strings.Join(literals, ", ") + "])"
+	}
+	if len(literals) == 1 {
+		return zodLiteral(literals[0])
+	}
+	parts := make([]string, 0, len(literals))
+	for _, l := range literals {
+		parts = append(parts, zodLiteral(l))
+	}
+	return "z.union([" + strings.Join(parts, ", ") + "])"
+}
+
+func zodLiteral(l string) string {
+	if l == "null" {
+		return "z.null()"
+	}
+	return "z.literal(" + l + ")"
+}
+
+// zodString renders string format and length refinements.
+func zodString(schema *types.Schema) string {
+	var z string
+	switch schema.Format {
+	case "email":
+		z += ".email()"
+	case "uuid":
+		z += ".uuid()"
+	case "uri", "url":
+		z += ".url()"
+	case "date-time":
+		z += ".datetime()"
+	}
+	if schema.MinLength != nil {
+		z += fmt.Sprintf(".min(%d)", *schema.MinLength)
+	}
+	if schema.MaxLength != nil {
+		z += fmt.Sprintf(".max(%d)", *schema.MaxLength)
+	}
+	if schema.Pattern != "" {
+		z += ".regex(new RegExp(" + strconv.Quote(schema.Pattern) + "))"
+	}
+	return z
+}
+
+// zodNumber renders numeric bound refinements.
+func zodNumber(schema *types.Schema) string {
+	var z string
+	if schema.Minimum != nil {
+		method := "gte"
+		if schema.ExclusiveMinimum {
+			method = "gt"
+		}
+		z += fmt.Sprintf(".%s(%s)", method, strconv.FormatFloat(*schema.Minimum, 'f', -1, 64))
+	}
+	if schema.Maximum != nil {
+		method := "lte"
+		if schema.ExclusiveMaximum {
+			method = "lt"
+		}
+		z += fmt.Sprintf(".%s(%s)", method, strconv.FormatFloat(*schema.Maximum, 'f', -1, 64))
+	}
+	return z
+}
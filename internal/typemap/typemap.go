@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package typemap holds configured overrides of the schema generated for
+// source types. Every language's type converter consults it before its
+// built-in mapping, so a type such as decimal.Decimal, Money or Carbon maps
+// the same way regardless of the framework it appears in.
+package typemap
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// Mapping overrides the schema of one source type.
+type Mapping struct {
+	// Name is the source type (e.g., decimal.Decimal, Money, Carbon);
+	// unqualified names also match namespaced types with that name
+	Name string `mapstructure:"name" yaml:"name" json:"name"`
+
+	// Type is the OpenAPI type the source type maps to
+	Type string `mapstructure:"type" yaml:"type,omitempty" json:"type,omitempty"`
+
+	// Format is the OpenAPI format (e.g., decimal, date-time)
+	Format string `mapstructure:"format" yaml:"format,omitempty" json:"format,omitempty"`
+
+	// Ref references a shared component schema instead of a type
+	// (e.g., Money or #/components/schemas/Money)
+	Ref string `mapstructure:"ref" yaml:"ref,omitempty" json:"ref,omitempty"`
+}
+
+var (
+	mu       sync.RWMutex
+	mappings map[string]Mapping
+)
+
+// Set replaces the active mappings.
+func Set(list []Mapping) {
+	m := make(map[string]Mapping, len(list))
+	for _, mapping := range list {
+		m[strings.TrimSpace(mapping.Name)] = mapping
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	mappings = m
+}
+
+// Lookup returns the mapping for a source type. Pointer, reference,
+// nullable and leading namespace decorations are ignored, so
+// *decimal.Decimal, Decimal? and \Carbon\Carbon match mappings named
+// decimal.Decimal, Decimal and Carbon.
+func Lookup(typeName string) (Mapping, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if len(mappings) == 0 {
+		return Mapping{}, false
+	}
+
+	name := strings.TrimSpace(typeName)
+	name = strings.TrimLeft(name, "*&?\\")
+	name = strings.TrimRight(name, "?!")
+
+	if mapping, ok := mappings[name]; ok {
+		return mapping, true
+	}
+	if i := strings.LastIndexAny(name, `.\:`); i >= 0 {
+		if mapping, ok := mappings[name[i+1:]]; ok {
+			return mapping, true
+		}
+	}
+	return Mapping{}, false
+}
+
+// Schema returns a new schema for typeName when it is mapped.
+func Schema(typeName string) (*types.Schema, bool) {
+	mapping, ok := Lookup(typeName)
+	if !ok {
+		return nil, false
+	}
+	return mapping.Schema(), true
+}
+
+// OpenAPI returns the mapped type and format of typeName, for converters
+// that only produce a type and format. Mappings to a shared schema have no
+// type and are not reported.
+func OpenAPI(typeName string) (openAPIType, format string, ok bool) {
+	mapping, ok := Lookup(typeName)
+	if !ok || mapping.Type == "" {
+		return "", "", false
+	}
+	return mapping.Type, mapping.Format, true
+}
+
+// Schema returns the schema the mapping stands for.
+func (m Mapping) Schema() *types.Schema {
+	if m.Ref != "" {
+		ref := m.Ref
+		if !strings.HasPrefix(ref, "#") {
+			ref = "#/components/schemas/" + ref
+		}
+		return &types.Schema{Ref: ref}
+	}
+	return &types.Schema{
+		Type:   m.Type,
+		Format: m.Format,
+	}
+}
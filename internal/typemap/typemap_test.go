@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package typemap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func TestLookup(t *testing.T) {
+	Set([]Mapping{
+		{Name: "decimal.Decimal", Type: "string", Format: "decimal"},
+		{Name: "Money", Ref: "Money"},
+		{Name: "Carbon", Type: "string", Format: "date-time"},
+	})
+	t.Cleanup(func() { Set(nil) })
+
+	tests := []struct {
+		typeName string
+		expected *types.Schema
+	}{
+		{"decimal.Decimal", &types.Schema{Type: "string", Format: "decimal"}},
+		{"*decimal.Decimal", &types.Schema{Type: "string", Format: "decimal"}},
+		{"Money", &types.Schema{Ref: "#/components/schemas/Money"}},
+		{"Money?", &types.Schema{Ref: "#/components/schemas/Money"}},
+		{"\\Carbon\\Carbon", &types.Schema{Type: "string", Format: "date-time"}},
+		{"Illuminate\\Support\\Carbon", &types.Schema{Type: "string", Format: "date-time"}},
+		{"shopspring.Decimal", nil},
+		{"string", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			schema, ok := Schema(tt.typeName)
+			assert.Equal(t, tt.expected != nil, ok)
+			assert.Equal(t, tt.expected, schema)
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	Set([]Mapping{
+		{Name: "BigDecimal", Type: "string", Format: "decimal"},
+		{Name: "Money", Ref: "#/components/schemas/SharedMoney"},
+	})
+	t.Cleanup(func() { Set(nil) })
+
+	openAPIType, format, ok := OpenAPI("java.math.BigDecimal")
+	assert.True(t, ok)
+	assert.Equal(t, "string", openAPIType)
+	assert.Equal(t, "decimal", format)
+
+	// References have no type to report
+	_, _, ok = OpenAPI("Money")
+	assert.False(t, ok)
+	schema, ok := Schema("Money")
+	assert.True(t, ok)
+	assert.Equal(t, "#/components/schemas/SharedMoney", schema.Ref)
+}
@@ -29,3 +29,19 @@ func ExtractInnerType(t string) string {
 
 	return t
 }
+
+// NormalizeNewlines converts CRLF and lone CR line endings to LF, so that
+// line-anchored regular expressions and line counts behave the same for
+// files checked out with Windows line endings.
+func NormalizeNewlines(s string) string {
+	if !strings.Contains(s, "\r") {
+		return s
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
+
+// SplitLines splits s into lines, accepting LF, CRLF and CR line endings.
+func SplitLines(s string) []string {
+	return strings.Split(NormalizeNewlines(s), "\n")
+}
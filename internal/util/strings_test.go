@@ -54,3 +54,12 @@ func TestExtractInnerType(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeNewlines(t *testing.T) {
+	assert.Equal(t, "a\nb\nc\n", NormalizeNewlines("a\r\nb\rc\n"))
+	assert.Equal(t, "unchanged\n", NormalizeNewlines("unchanged\n"))
+}
+
+func TestSplitLines(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "", "c"}, SplitLines("a\r\nb\r\n\r\nc"))
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package vcs links source locations to their hosted repository view.
+package vcs
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SourceLinker builds URLs that point at a line of a file in the hosted
+// repository (GitHub, GitLab, Bitbucket, or a custom template).
+type SourceLinker struct {
+	// Root is the repository root that file paths are made relative to
+	Root string
+
+	// Commit is the revision substituted for {sha}
+	Commit string
+
+	// Template is the URL template with {sha}, {path}, and {line} placeholders
+	Template string
+}
+
+// Detect inspects the git repository containing dir and returns a linker
+// for the given remote at the current HEAD commit. A non-empty template
+// replaces the one derived from the remote URL.
+func Detect(dir, remote, template string) (*SourceLinker, error) {
+	root, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, fmt.Errorf("not a git repository: %w", err)
+	}
+	commit, err := Head(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	if template == "" {
+		remoteURL, err := git(dir, "remote", "get-url", remote)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read remote %q: %w", remote, err)
+		}
+		template, err = RemoteTemplate(remoteURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SourceLinker{
+		Root:     root,
+		Commit:   commit,
+		Template: template,
+	}, nil
+}
+
+// URL returns the link for line of file, or "" when the file lies outside
+// the repository root.
+func (l *SourceLinker) URL(file string, line int) string {
+	if file == "" {
+		return ""
+	}
+	path := file
+	if filepath.IsAbs(file) {
+		rel, ok := l.relative(file)
+		if !ok {
+			// git reports the root with symlinks resolved
+			resolved, err := filepath.EvalSymlinks(file)
+			if err != nil {
+				return ""
+			}
+			if rel, ok = l.relative(resolved); !ok {
+				return ""
+			}
+		}
+		path = rel
+	}
+
+	lineText := ""
+	if line > 0 {
+		lineText = strconv.Itoa(line)
+	}
+
+	link := strings.NewReplacer(
+		"{sha}", l.Commit,
+		"{path}", filepath.ToSlash(path),
+		"{line}", lineText,
+	).Replace(l.Template)
+	if line <= 0 {
+		// Drop a dangling line anchor such as "#L" or "#lines-".
+		if i := strings.LastIndex(link, "#"); i >= 0 && !strings.ContainsAny(link[i:], "0123456789") {
+			link = link[:i]
+		}
+	}
+	return link
+}
+
+// relative returns file relative to the repository root, reporting false
+// when it lies outside the root.
+func (l *SourceLinker) relative(file string) (string, bool) {
+	rel, err := filepath.Rel(l.Root, file)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// RemoteTemplate derives a source URL template from a git remote URL.
+// SSH (git@host:owner/repo.git, ssh://git@host/owner/repo) and HTTPS
+// remotes are supported. GitLab and Bitbucket hosts get their own blob
+// layout; any other host uses the GitHub layout.
+func RemoteTemplate(remote string) (string, error) {
+	remote = strings.TrimSpace(remote)
+
+	var host, repoPath string
+	switch {
+	case strings.Contains(remote, "://"):
+		u, err := url.Parse(remote)
+		if err != nil {
+			return "", fmt.Errorf("invalid remote URL %q: %w", remote, err)
+		}
+		host, repoPath = u.Hostname(), u.Path
+	case strings.Contains(remote, ":"):
+		// scp-like syntax: [user@]host:owner/repo.git
+		hostPart, pathPart, _ := strings.Cut(remote, ":")
+		if i := strings.LastIndex(hostPart, "@"); i >= 0 {
+			hostPart = hostPart[i+1:]
+		}
+		host, repoPath = hostPart, pathPart
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if host == "" || repoPath == "" {
+		return "", fmt.Errorf("unrecognized remote URL %q", remote)
+	}
+
+	base := "https://" + host + "/" + repoPath
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return base + "/-/blob/{sha}/{path}#L{line}", nil
+	case strings.Contains(host, "bitbucket"):
+		return base + "/src/{sha}/{path}#lines-{line}", nil
+	default:
+		return base + "/blob/{sha}/{path}#L{line}", nil
+	}
+}
+
+// Head returns the commit checked out in the repository containing dir.
+func Head(dir string) (string, error) {
+	return git(dir, "rev-parse", "HEAD")
+}
+
+// LatestTag returns the most recent tag reachable from revision ref in the
+// repository containing dir.
+func LatestTag(dir, ref string) (string, error) {
+	tag, err := git(dir, "describe", "--tags", "--abbrev=0", "--end-of-options", ref)
+	if err != nil {
+		return "", fmt.Errorf("no tag reachable from %s", ref)
+	}
+	return tag, nil
+}
+
+// Tree is the file tree of a past revision below a directory. It is read
+// with git plumbing commands, leaving the working tree untouched.
+type Tree struct {
+	// Commit is the commit the revision resolved to
+	Commit string
+
+	dir   string
+	blobs map[string]string
+}
+
+// ReadTree lists the files of revision ref (a commit, branch or tag) in the
+// directory of the repository that dir is in.
+func ReadTree(dir, ref string) (*Tree, error) {
+	commit, err := git(dir, "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		return nil, fmt.Errorf("unknown revision %q", ref)
+	}
+	prefix, err := git(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, fmt.Errorf("not a git repository: %w", err)
+	}
+	out, err := gitOutput(dir, "ls-tree", "-r", "-z", "--full-tree", commit+":"+prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%s does not exist at %s", strings.TrimSuffix(prefix, "/"), ref)
+	}
+
+	tree := &Tree{Commit: commit, dir: dir, blobs: make(map[string]string)}
+	for _, entry := range strings.Split(string(out), "\x00") {
+		// <mode> <type> <object>\t<path>
+		info, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(info)
+		if !ok || len(fields) != 3 || fields[1] != "blob" || fields[0] == "120000" {
+			continue
+		}
+		tree.blobs[path] = fields[2]
+	}
+	return tree, nil
+}
+
+// Files returns the slash-separated paths of the tree's files, sorted.
+func (t *Tree) Files() []string {
+	files := make([]string, 0, len(t.blobs))
+	for path := range t.blobs {
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// ReadFile returns the content of a file of the tree.
+func (t *Tree) ReadFile(name string) ([]byte, error) {
+	blob, ok := t.blobs[name]
+	if !ok {
+		return nil, fmt.Errorf("%s does not exist at %s", name, t.Commit)
+	}
+	return gitOutput(t.dir, "cat-file", "blob", blob)
+}
+
+// git runs a git command in dir and returns its trimmed output.
+func git(dir string, args ...string) (string, error) {
+	out, err := gitOutput(dir, args...)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gitOutput runs a git command in dir and returns its output.
+func gitOutput(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	return cmd.Output()
+}
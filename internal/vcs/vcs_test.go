@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package vcs
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteTemplate(t *testing.T) {
+	tests := []struct {
+		remote   string
+		expected string
+	}{
+		{"git@github.com:acme/api.git", "https://github.com/acme/api/blob/{sha}/{path}#L{line}"},
+		{"https://github.com/acme/api.git", "https://github.com/acme/api/blob/{sha}/{path}#L{line}"},
+		{"ssh://git@gitlab.com/group/sub/api.git", "https://gitlab.com/group/sub/api/-/blob/{sha}/{path}#L{line}"},
+		{"https://user@bitbucket.org/acme/api", "https://bitbucket.org/acme/api/src/{sha}/{path}#lines-{line}"},
+		{"git@git.internal.example:team/api.git\n", "https://git.internal.example/team/api/blob/{sha}/{path}#L{line}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.remote, func(t *testing.T) {
+			template, err := RemoteTemplate(tt.remote)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, template)
+		})
+	}
+
+	_, err := RemoteTemplate("/srv/git/api.git")
+	assert.Error(t, err)
+}
+
+func TestSourceLinker_URL(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "work", "api")
+	linker := &SourceLinker{
+		Root:     root,
+		Commit:   "abc123",
+		Template: "https://github.com/acme/api/blob/{sha}/{path}#L{line}",
+	}
+
+	assert.Equal(t,
+		"https://github.com/acme/api/blob/abc123/src/routes/users.ts#L42",
+		linker.URL(filepath.Join(root, "src", "routes", "users.ts"), 42))
+	assert.Equal(t,
+		"https://github.com/acme/api/blob/abc123/src/app.ts",
+		linker.URL(filepath.Join(root, "src", "app.ts"), 0))
+	assert.Equal(t,
+		"https://github.com/acme/api/blob/abc123/cmd/main.go#L7",
+		linker.URL("cmd/main.go", 7))
+	assert.Empty(t, linker.URL(filepath.Join(string(filepath.Separator), "elsewhere", "main.go"), 1))
+	assert.Empty(t, linker.URL("", 1))
+}
+
+func TestDetect(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "-q")
+	run("remote", "add", "origin", "git@github.com:acme/api.git")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+	run("add", "main.go")
+	run("commit", "-q", "-m", "init")
+
+	linker, err := Detect(dir, "origin", "")
+	require.NoError(t, err)
+	assert.Len(t, linker.Commit, 40)
+
+	head, err := Head(dir)
+	require.NoError(t, err)
+	assert.Equal(t, linker.Commit, head)
+
+	link := linker.URL(filepath.Join(linker.Root, "main.go"), 3)
+	assert.Equal(t, "https://github.com/acme/api/blob/"+linker.Commit+"/main.go#L3", link)
+
+	custom, err := Detect(dir, "origin", "https://code.example/{sha}/{path}?line={line}")
+	require.NoError(t, err)
+	assert.Equal(t, "https://code.example/"+custom.Commit+"/main.go?line=3", custom.URL(filepath.Join(custom.Root, "main.go"), 3))
+
+	_, err = Detect(dir, "upstream", "")
+	assert.Error(t, err)
+
+	_, err = Detect(t.TempDir(), "origin", "")
+	assert.Error(t, err)
+}
+
+func TestReadTree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	run("init", "-q")
+	write("api/routes.go", "package api // v1\n")
+	write("README.md", "docs\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "v1")
+	run("tag", "v1")
+	write("api/routes.go", "package api // v2\n")
+	write("api/users.go", "package api\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "v2")
+
+	tree, err := ReadTree(dir, "v1")
+	require.NoError(t, err)
+	assert.Len(t, tree.Commit, 40)
+	assert.Equal(t, []string{"README.md", "api/routes.go"}, tree.Files())
+	content, err := tree.ReadFile("api/routes.go")
+	require.NoError(t, err)
+	assert.Equal(t, "package api // v1\n", string(content))
+	_, err = tree.ReadFile("api/users.go")
+	assert.Error(t, err)
+
+	// A subdirectory sees the files below it
+	sub, err := ReadTree(filepath.Join(dir, "api"), "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"routes.go", "users.go"}, sub.Files())
+
+	_, err = ReadTree(dir, "v3")
+	assert.Error(t, err)
+}
+
+func TestLatestTag(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "-q")
+	run("commit", "-q", "--allow-empty", "-m", "init")
+
+	_, err := LatestTag(dir, "HEAD")
+	assert.Error(t, err)
+
+	run("tag", "v1.2.0")
+	run("commit", "-q", "--allow-empty", "-m", "fix")
+	run("tag", "-a", "v1.2.1", "-m", "release")
+	run("commit", "-q", "--allow-empty", "-m", "wip")
+
+	tag, err := LatestTag(dir, "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.1", tag)
+
+	tag, err = LatestTag(dir, "HEAD~2")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.0", tag)
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package golden provides a golden-file test harness for framework plugins.
+//
+// A fixture is a miniature example application together with the OpenAPI
+// document api2spec is expected to produce for it. Fixtures are laid out as:
+//
+//	<root>/<framework>/<case>/app/...        example application sources
+//	<root>/<framework>/<case>/openapi.yaml   expected (golden) specification
+//	<root>/<framework>/<case>/api2spec.yaml  optional generation config
+//
+// Generate builds a fixture's document with a plugin; package goldentest
+// runs the fixtures as tests and diffs each result against its golden file.
+// Setting the API2SPEC_UPDATE_GOLDEN environment variable rewrites the
+// golden files instead of comparing them.
+package golden
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/api2spec/api2spec/internal/config"
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/internal/scanner"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const (
+	// AppDir is the name of the directory holding a fixture's example application.
+	AppDir = "app"
+
+	// SpecFile is the name of a fixture's golden specification file.
+	SpecFile = "openapi.yaml"
+
+	// ConfigFile is the name of a fixture's optional generation config.
+	ConfigFile = "api2spec.yaml"
+
+	// UpdateEnv is the environment variable that switches goldentest into
+	// update mode.
+	UpdateEnv = "API2SPEC_UPDATE_GOLDEN"
+)
+
+// Fixture describes a single golden-file test case.
+type Fixture struct {
+	// Framework is the plugin name, taken from the first directory level
+	Framework string
+
+	// Name is the case name, taken from the second directory level
+	Name string
+
+	// Dir is the absolute path to the fixture directory
+	Dir string
+}
+
+// AppPath returns the path to the fixture's example application.
+func (f Fixture) AppPath() string {
+	return filepath.Join(f.Dir, AppDir)
+}
+
+// SpecPath returns the path to the fixture's golden specification.
+func (f Fixture) SpecPath() string {
+	return filepath.Join(f.Dir, SpecFile)
+}
+
+// ConfigPath returns the path to the fixture's config file, or "" if none exists.
+func (f Fixture) ConfigPath() string {
+	path := filepath.Join(f.Dir, ConfigFile)
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// GenerateFunc produces an OpenAPI document for a fixture.
+// Plugin authors outside this repository can supply their own implementation.
+type GenerateFunc func(f Fixture) (*types.OpenAPI, error)
+
+// SourceFile is a scanned source file of a fixture's application.
+type SourceFile = scanner.SourceFile
+
+// Extractor extracts the routes and schemas of a fixture's application.
+// Every framework plugin is one.
+type Extractor interface {
+	ExtractRoutes(files []SourceFile) ([]types.Route, error)
+	ExtractSchemas(files []SourceFile) ([]types.Schema, error)
+}
+
+// Discover finds all fixtures below root, sorted by framework and case name.
+// Directories without an app directory are ignored.
+func Discover(root string) ([]Fixture, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve fixture root: %w", err)
+	}
+
+	frameworks, err := os.ReadDir(absRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture root: %w", err)
+	}
+
+	var fixtures []Fixture
+	for _, fw := range frameworks {
+		if !fw.IsDir() {
+			continue
+		}
+
+		cases, err := os.ReadDir(filepath.Join(absRoot, fw.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixtures for %s: %w", fw.Name(), err)
+		}
+
+		for _, c := range cases {
+			if !c.IsDir() {
+				continue
+			}
+			dir := filepath.Join(absRoot, fw.Name(), c.Name())
+			if info, err := os.Stat(filepath.Join(dir, AppDir)); err != nil || !info.IsDir() {
+				continue
+			}
+			fixtures = append(fixtures, Fixture{
+				Framework: fw.Name(),
+				Name:      c.Name(),
+				Dir:       dir,
+			})
+		}
+	}
+
+	sort.Slice(fixtures, func(i, j int) bool {
+		if fixtures[i].Framework != fixtures[j].Framework {
+			return fixtures[i].Framework < fixtures[j].Framework
+		}
+		return fixtures[i].Name < fixtures[j].Name
+	})
+
+	return fixtures, nil
+}
+
+// Generate runs ex over the fixture's example application and builds the
+// resulting document.
+func Generate(f Fixture, ex Extractor) (*types.OpenAPI, error) {
+	cfg := config.Default()
+	if path := f.ConfigPath(); path != "" {
+		loaded, err := config.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fixture config: %w", err)
+		}
+		cfg = loaded
+	}
+
+	s := scanner.New(scanner.Config{
+		BasePath:           f.AppPath(),
+		IncludePatterns:    cfg.Source.Include,
+		ExcludePatterns:    cfg.Source.Exclude,
+		FollowSymlinks:     cfg.Source.FollowSymlinks,
+		LanguageExtensions: cfg.Source.LanguageExtensions,
+		LanguageOverrides:  cfg.Source.LanguageOverrides,
+	})
+	files, err := s.Scan()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan fixture: %w", err)
+	}
+
+	routes, err := ex.ExtractRoutes(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract routes: %w", err)
+	}
+
+	schemas, err := ex.ExtractSchemas(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract schemas: %w", err)
+	}
+
+	return openapi.NewBuilder(cfg).Build(routes, schemas)
+}
+
+// Render serializes a document the same way the generate command does.
+func Render(doc *types.OpenAPI) ([]byte, error) {
+	out, err := openapi.NewWriter().ToYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
+// Updating reports whether golden files should be rewritten.
+func Updating() bool {
+	return os.Getenv(UpdateEnv) != ""
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func writeFixture(t *testing.T, root, framework, name string, withApp bool) string {
+	t.Helper()
+	dir := filepath.Join(root, framework, name)
+	if withApp {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, AppDir), 0o755))
+	} else {
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+	}
+	return dir
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, "gin", "basic", true)
+	writeFixture(t, root, "chi", "nested", true)
+	writeFixture(t, root, "chi", "basic", true)
+	writeFixture(t, root, "chi", "no-app", false)
+
+	fixtures, err := Discover(root)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 3)
+
+	assert.Equal(t, "chi", fixtures[0].Framework)
+	assert.Equal(t, "basic", fixtures[0].Name)
+	assert.Equal(t, "chi", fixtures[1].Framework)
+	assert.Equal(t, "nested", fixtures[1].Name)
+	assert.Equal(t, "gin", fixtures[2].Framework)
+	assert.Equal(t, filepath.Join(root, "gin", "basic", AppDir), fixtures[2].AppPath())
+}
+
+func TestDiscover_MissingRoot(t *testing.T) {
+	_, err := Discover(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestFixture_ConfigPath(t *testing.T) {
+	root := t.TempDir()
+	dir := writeFixture(t, root, "chi", "basic", true)
+	f := Fixture{Framework: "chi", Name: "basic", Dir: dir}
+
+	assert.Empty(t, f.ConfigPath())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ConfigFile), []byte("framework: chi\n"), 0o644))
+	assert.Equal(t, filepath.Join(dir, ConfigFile), f.ConfigPath())
+}
+
+// stubExtractor returns no schemas and a route for every scanned file.
+type stubExtractor struct{}
+
+func (stubExtractor) ExtractRoutes(files []SourceFile) ([]types.Route, error) {
+	routes := make([]types.Route, 0, len(files))
+	for _, f := range files {
+		routes = append(routes, types.Route{Method: "GET", Path: "/" + filepath.Base(f.Path)})
+	}
+	return routes, nil
+}
+
+func (stubExtractor) ExtractSchemas([]SourceFile) ([]types.Schema, error) {
+	return nil, nil
+}
+
+func TestGenerate(t *testing.T) {
+	dir := writeFixture(t, t.TempDir(), "stub", "basic", true)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AppDir, "main.go"), []byte("package main\n"), 0o644))
+
+	doc, err := Generate(Fixture{Framework: "stub", Name: "basic", Dir: dir}, stubExtractor{})
+	require.NoError(t, err)
+	assert.Contains(t, doc.Paths, "/main.go")
+}
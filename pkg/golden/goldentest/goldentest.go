@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package goldentest checks golden-file fixtures from tests.
+//
+//	func TestGolden(t *testing.T) {
+//		goldentest.Run(t, "testdata/golden", func(f golden.Fixture) (*types.OpenAPI, error) {
+//			return golden.Generate(f, myplugin.New())
+//		})
+//	}
+package goldentest
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/api2spec/api2spec/pkg/golden"
+)
+
+// Check regenerates a single fixture and compares it against its golden file.
+// In update mode the golden file is rewritten instead.
+func Check(t *testing.T, f golden.Fixture, gen golden.GenerateFunc) {
+	t.Helper()
+
+	doc, err := gen(f)
+	if err != nil {
+		t.Fatalf("failed to generate %s/%s: %v", f.Framework, f.Name, err)
+	}
+
+	got, err := golden.Render(doc)
+	if err != nil {
+		t.Fatalf("failed to render %s/%s: %v", f.Framework, f.Name, err)
+	}
+
+	if golden.Updating() {
+		if err := os.WriteFile(f.SpecPath(), got, 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(f.SpecPath())
+	if err != nil {
+		t.Fatalf("failed to read golden file (set %s=1 to create it): %v", golden.UpdateEnv, err)
+	}
+
+	assert.Equal(t, string(want), string(got), "generated spec differs from %s (set %s=1 to update)", f.SpecPath(), golden.UpdateEnv)
+}
+
+// Run discovers all fixtures below root and checks each one in a subtest
+// named <framework>/<case>.
+func Run(t *testing.T, root string, gen golden.GenerateFunc) {
+	t.Helper()
+
+	fixtures, err := golden.Discover(root)
+	if err != nil {
+		t.Fatalf("failed to discover fixtures: %v", err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatalf("no fixtures found in %s", root)
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.Framework+"/"+f.Name, func(t *testing.T) {
+			Check(t, f, gen)
+		})
+	}
+}
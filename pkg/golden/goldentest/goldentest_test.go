@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package goldentest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/golden"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+func stubGenerate(f golden.Fixture) (*types.OpenAPI, error) {
+	return &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    types.Info{Title: f.Framework + "-" + f.Name, Version: "1.0.0"},
+	}, nil
+}
+
+func TestCheck_UpdateThenCompare(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "chi", "basic")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, golden.AppDir), 0o755))
+	f := golden.Fixture{Framework: "chi", Name: "basic", Dir: dir}
+
+	t.Setenv(golden.UpdateEnv, "1")
+	Check(t, f, stubGenerate)
+
+	data, err := os.ReadFile(f.SpecPath())
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "title: chi-basic")
+
+	t.Setenv(golden.UpdateEnv, "")
+	Check(t, f, stubGenerate)
+}
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+// Package spec merges and compares OpenAPI documents in memory, as the
+// generate and diff commands do with files, so tools such as CI bots can
+// compute pull request comments without touching the filesystem.
+//
+// Documents are the values of package types, or YAML or JSON bytes:
+//
+//	diff, err := spec.CompareJSON(baseSpec, headSpec)
+//	if err != nil {
+//		return err
+//	}
+//	if diff.Breaking {
+//		comment("Breaking API changes: " + diff.Summary)
+//	}
+//
+// Inputs are never modified.
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/api2spec/api2spec/internal/openapi"
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+// MergeOptions configures Merge; see DefaultMergeOptions.
+type MergeOptions = openapi.MergeOptions
+
+// MergeStrategy defines how Merge resolves conflicting values.
+type MergeStrategy = openapi.MergeStrategy
+
+// Merge strategies.
+const (
+	// MergeStrategyGeneratedWins overwrites with generated values on conflict
+	MergeStrategyGeneratedWins = openapi.MergeStrategyGeneratedWins
+
+	// MergeStrategyExistingWins keeps existing values on conflict
+	MergeStrategyExistingWins = openapi.MergeStrategyExistingWins
+
+	// MergeStrategyMerge merges values, keeping human-written ones
+	MergeStrategyMerge = openapi.MergeStrategyMerge
+)
+
+// FrozenContracts selects contracts Merge refuses to change; see
+// MergeOptions.Frozen.
+type FrozenContracts = openapi.FrozenContracts
+
+// FrozenError is returned by Merge when the generated document changes
+// frozen contracts.
+type FrozenError = openapi.FrozenError
+
+// FrozenChange is a change to a frozen contract.
+type FrozenChange = openapi.FrozenChange
+
+// DefaultMergeOptions returns the options api2spec generate merges with.
+func DefaultMergeOptions() MergeOptions {
+	return openapi.DefaultMergeOptions()
+}
+
+// MergeResult is the outcome of Merge. Name lists are sorted.
+type MergeResult struct {
+	// Document is the merged document
+	Document *types.OpenAPI `json:"document"`
+
+	// AddedPaths are the paths only the generated document has
+	AddedPaths []string `json:"addedPaths"`
+
+	// RemovedPaths are the paths only the existing document has; with
+	// MarkRemovedAsDeprecated they are kept and marked "(deprecated)"
+	RemovedPaths []string `json:"removedPaths"`
+
+	// UpdatedPaths are the paths both documents have
+	UpdatedPaths []string `json:"updatedPaths"`
+
+	// AddedSchemas are the component schemas only the generated document has
+	AddedSchemas []string `json:"addedSchemas"`
+
+	// RemovedSchemas are the component schemas only the existing document has
+	RemovedSchemas []string `json:"removedSchemas"`
+
+	// UpdatedSchemas are the component schemas both documents have
+	UpdatedSchemas []string `json:"updatedSchemas"`
+
+	// RenamedSchemas maps generated schema names to the existing names they
+	// were detected as renames of and kept
+	RenamedSchemas map[string]string `json:"renamedSchemas,omitempty"`
+}
+
+// Merge combines an existing, possibly hand-edited, document with a
+// generated one.
+func Merge(existing, generated *types.OpenAPI, opts MergeOptions) (*MergeResult, error) {
+	existing, err := clone(existing)
+	if err != nil {
+		return nil, err
+	}
+	generated, err = clone(generated)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := openapi.NewMerger(opts).MergeWithResult(existing, generated)
+	if err != nil {
+		return nil, err
+	}
+	return &MergeResult{
+		Document:       merged.Document,
+		AddedPaths:     sorted(merged.AddedPaths),
+		RemovedPaths:   sorted(merged.RemovedPaths),
+		UpdatedPaths:   sorted(merged.UpdatedPaths),
+		AddedSchemas:   sorted(merged.AddedSchemas),
+		RemovedSchemas: sorted(merged.RemovedSchemas),
+		UpdatedSchemas: sorted(merged.UpdatedSchemas),
+		RenamedSchemas: merged.RenamedSchemas,
+	}, nil
+}
+
+// MergeJSON is Merge on YAML or JSON documents.
+func MergeJSON(existing, generated []byte, opts MergeOptions) (*MergeResult, error) {
+	a, err := parse("existing", existing)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parse("generated", generated)
+	if err != nil {
+		return nil, err
+	}
+	return Merge(a, b, opts)
+}
+
+// ChangeType is the kind of a change between two documents.
+type ChangeType = openapi.DiffType
+
+// Change types.
+const (
+	// Added marks what only the newer document has
+	Added = openapi.DiffTypeAdded
+
+	// Removed marks what only the older document has
+	Removed = openapi.DiffTypeRemoved
+
+	// Modified marks what both documents have in different forms
+	Modified = openapi.DiffTypeModified
+)
+
+// PathChange is a change to an operation.
+type PathChange struct {
+	Type   ChangeType `json:"type"`
+	Path   string     `json:"path"`
+	Method string     `json:"method"`
+
+	// Description states the change (e.g., GET /users added)
+	Description string `json:"description"`
+
+	// Breaking reports whether clients of the older document may fail
+	Breaking bool `json:"breaking"`
+}
+
+// SchemaChange is a change to a component schema.
+type SchemaChange struct {
+	Type ChangeType `json:"type"`
+	Name string     `json:"name"`
+
+	// Description states the change
+	Description string `json:"description"`
+
+	// Breaking reports whether clients of the older document may fail
+	Breaking bool `json:"breaking"`
+
+	// Properties are the property changes of a modified schema
+	Properties []PropertyChange `json:"properties,omitempty"`
+}
+
+// PropertyChange is a change to a property of a component schema.
+type PropertyChange struct {
+	Type ChangeType `json:"type"`
+
+	// Property is the dotted path of the property (e.g., address.city,
+	// tags[].name)
+	Property string `json:"property"`
+
+	// Description states the change (e.g., string -> integer, now required)
+	Description string `json:"description"`
+}
+
+// Diff is the difference between two documents, as api2spec diff reports
+// it. Changes are sorted by path and method, or by name.
+type Diff struct {
+	Paths   []PathChange   `json:"paths"`
+	Schemas []SchemaChange `json:"schemas"`
+
+	// Breaking reports whether any change is breaking
+	Breaking bool `json:"breaking"`
+
+	// Summary counts the changes in one line
+	Summary string `json:"summary"`
+}
+
+// IsEmpty reports whether the documents are equivalent.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Paths) == 0 && len(d.Schemas) == 0
+}
+
+// Compare returns the changes from document base to head. Removed
+// operations and schemas are breaking.
+func Compare(base, head *types.OpenAPI) (*Diff, error) {
+	if base == nil || head == nil {
+		return nil, fmt.Errorf("cannot compare a nil document")
+	}
+	result, err := openapi.NewDiffer().Diff(base, head)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &Diff{
+		Paths:    []PathChange{},
+		Schemas:  []SchemaChange{},
+		Breaking: result.HasBreakingChanges,
+		Summary:  result.Summary,
+	}
+	for _, c := range result.PathChanges {
+		diff.Paths = append(diff.Paths, PathChange{
+			Type:        c.Type,
+			Path:        c.Path,
+			Method:      c.Method,
+			Description: c.Description,
+			Breaking:    c.Type == Removed,
+		})
+	}
+	for _, c := range result.SchemaChanges {
+		change := SchemaChange{
+			Type:        c.Type,
+			Name:        c.Name,
+			Description: c.Description,
+			Breaking:    c.Type == Removed,
+		}
+		if c.Type == Modified {
+			properties, err := openapi.DiffSchema(c.Name, base, head)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range properties {
+				change.Properties = append(change.Properties, PropertyChange(p))
+			}
+		}
+		diff.Schemas = append(diff.Schemas, change)
+	}
+
+	slices.SortFunc(diff.Paths, func(a, b PathChange) int {
+		if n := strings.Compare(a.Path, b.Path); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+	slices.SortFunc(diff.Schemas, func(a, b SchemaChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return diff, nil
+}
+
+// CompareJSON is Compare on YAML or JSON documents.
+func CompareJSON(base, head []byte) (*Diff, error) {
+	a, err := parse("base", base)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parse("head", head)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(a, b)
+}
+
+// Parse decodes a YAML or JSON document.
+func Parse(data []byte) (*types.OpenAPI, error) {
+	return openapi.Parse(data, "")
+}
+
+// parse decodes the document called name in errors.
+func parse(name string, data []byte) (*types.OpenAPI, error) {
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s document: %w", name, err)
+	}
+	return doc, nil
+}
+
+// clone returns a deep copy of doc, as the merge rewrites its inputs.
+func clone(doc *types.OpenAPI) (*types.OpenAPI, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy spec: %w", err)
+	}
+	var copied types.OpenAPI
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil, fmt.Errorf("failed to copy spec: %w", err)
+	}
+	copied.PathOrder = slices.Clone(doc.PathOrder)
+	return &copied, nil
+}
+
+// sorted returns names sorted, never nil.
+func sorted(names []string) []string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+	if names == nil {
+		return []string{}
+	}
+	return names
+}
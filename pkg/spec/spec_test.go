@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/api2spec/api2spec/pkg/types"
+)
+
+const baseSpec = `
+openapi: 3.0.3
+info:
+  title: Shop
+  version: 1.0.0
+paths:
+  /users:
+    get:
+      summary: List users
+      description: Hand-written description
+  /orders:
+    get:
+      summary: List orders
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        id:
+          type: string
+    Order:
+      type: object
+`
+
+const headSpec = `{
+  "openapi": "3.0.3",
+  "info": {"title": "Shop", "version": "1.0.0"},
+  "paths": {
+    "/users": {"get": {"summary": "List users"}, "post": {"summary": "Create user"}}
+  },
+  "components": {
+    "schemas": {
+      "User": {"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}
+    }
+  }
+}`
+
+func TestCompareJSON(t *testing.T) {
+	diff, err := CompareJSON([]byte(baseSpec), []byte(headSpec))
+	require.NoError(t, err)
+
+	assert.True(t, diff.Breaking)
+	assert.False(t, diff.IsEmpty())
+	operations := make(map[string]ChangeType)
+	for _, c := range diff.Paths {
+		operations[c.Method+" "+c.Path] = c.Type
+		assert.Equal(t, c.Type == Removed, c.Breaking, c.Description)
+	}
+	assert.Equal(t, map[string]ChangeType{"GET /orders": Removed, "GET /users": Modified, "POST /users": Added}, operations)
+
+	require.Len(t, diff.Schemas, 2)
+	assert.Equal(t, "Order", diff.Schemas[0].Name)
+	assert.True(t, diff.Schemas[0].Breaking)
+	user := diff.Schemas[1]
+	assert.Equal(t, Modified, user.Type)
+	assert.False(t, user.Breaking)
+	properties := make(map[string]ChangeType)
+	for _, p := range user.Properties {
+		properties[p.Property] = p.Type
+	}
+	assert.Equal(t, map[string]ChangeType{"id": Modified, "name": Added}, properties)
+
+	data, err := json.Marshal(diff)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"breaking":true`)
+	assert.Contains(t, string(data), `"type":"removed"`)
+}
+
+func TestCompare_NoChanges(t *testing.T) {
+	doc, err := Parse([]byte(baseSpec))
+	require.NoError(t, err)
+
+	diff, err := Compare(doc, doc)
+	require.NoError(t, err)
+	assert.True(t, diff.IsEmpty())
+	assert.False(t, diff.Breaking)
+
+	_, err = Compare(doc, nil)
+	assert.Error(t, err)
+}
+
+func TestMergeJSON(t *testing.T) {
+	result, err := MergeJSON([]byte(baseSpec), []byte(headSpec), DefaultMergeOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/users"}, result.UpdatedPaths)
+	assert.Equal(t, []string{"/orders"}, result.RemovedPaths)
+	assert.Equal(t, []string{}, result.AddedPaths)
+	assert.Equal(t, []string{"User"}, result.UpdatedSchemas)
+	assert.Equal(t, []string{}, result.RemovedSchemas, "hand-written schemas are kept")
+	assert.Contains(t, result.Document.Components.Schemas, "Order")
+
+	users := result.Document.Paths["/users"]
+	require.NotNil(t, users.Get)
+	assert.Equal(t, "Hand-written description", users.Get.Description)
+	require.NotNil(t, users.Post)
+
+	_, err = MergeJSON([]byte("{not: [valid"), []byte(headSpec), DefaultMergeOptions())
+	assert.ErrorContains(t, err, "existing document")
+}
+
+func TestMerge_KeepsInputs(t *testing.T) {
+	existing := &types.OpenAPI{
+		OpenAPI: "3.0.3",
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Summary: "List users", Description: "Kept"}},
+		},
+	}
+	generated := &types.OpenAPI{
+		OpenAPI:   "3.0.3",
+		PathOrder: []string{"/users"},
+		Paths: map[string]types.PathItem{
+			"/users": {Get: &types.Operation{Summary: "List users"}},
+		},
+	}
+
+	opts := DefaultMergeOptions()
+	opts.ConflictStrategy = MergeStrategyGeneratedWins
+	result, err := Merge(existing, generated, opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Kept", result.Document.Paths["/users"].Get.Description)
+	assert.Equal(t, []string{"/users"}, result.Document.PathOrder)
+	assert.Empty(t, generated.Paths["/users"].Get.Description, "inputs are not modified")
+
+	result.Document.Paths["/users"].Get.Summary = "Changed"
+	assert.Equal(t, "List users", existing.Paths["/users"].Get.Summary)
+}
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Extensions holds specification extensions (x-* fields).
+// In YAML they are inlined into the owning object; in JSON the owning
+// type's MarshalJSON/UnmarshalJSON merges them with the regular fields.
+type Extensions map[string]any
+
+// openAPIFields mirrors OpenAPI without its JSON methods.
+type openAPIFields OpenAPI
+
+// MarshalJSON encodes the document with its extensions appended after the
+// regular fields.
+func (o OpenAPI) MarshalJSON() ([]byte, error) {
+	if len(o.PathOrder) > 0 {
+		return marshalWithExtensions(o.ordered(), o.Extensions)
+	}
+	return marshalWithExtensions(openAPIFields(o), o.Extensions)
+}
+
+// MarshalYAML encodes the document with its paths in PathOrder order.
+func (o OpenAPI) MarshalYAML() (any, error) {
+	if len(o.PathOrder) > 0 {
+		return o.ordered(), nil
+	}
+	return openAPIFields(o), nil
+}
+
+// UnmarshalJSON decodes the document and collects any x-* fields into Extensions.
+func (o *OpenAPI) UnmarshalJSON(data []byte) error {
+	var fields openAPIFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data)
+	if err != nil {
+		return err
+	}
+	fields.Extensions = ext
+	*o = OpenAPI(fields)
+	return nil
+}
+
+// operationFields mirrors Operation without its JSON methods.
+type operationFields Operation
+
+// MarshalJSON encodes the operation with its extensions appended after the
+// regular fields.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	return marshalWithExtensions(operationFields(o), o.Extensions)
+}
+
+// UnmarshalJSON decodes the operation and collects any x-* fields into Extensions.
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	var fields operationFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data)
+	if err != nil {
+		return err
+	}
+	fields.Extensions = ext
+	*o = Operation(fields)
+	return nil
+}
+
+// parameterFields mirrors Parameter without its JSON methods.
+type parameterFields Parameter
+
+// MarshalJSON encodes the parameter with its extensions appended after the
+// regular fields.
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	return marshalWithExtensions(parameterFields(p), p.Extensions)
+}
+
+// UnmarshalJSON decodes the parameter and collects any x-* fields into Extensions.
+func (p *Parameter) UnmarshalJSON(data []byte) error {
+	var fields parameterFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data)
+	if err != nil {
+		return err
+	}
+	fields.Extensions = ext
+	*p = Parameter(fields)
+	return nil
+}
+
+// schemaFields mirrors Schema without its JSON methods.
+type schemaFields Schema
+
+// MarshalJSON encodes the schema with its extensions appended after the
+// regular fields, or as true/false for a boolean schema.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	if s.Bool != nil {
+		return json.Marshal(*s.Bool)
+	}
+	return marshalWithExtensions(schemaFields(s), s.Extensions)
+}
+
+// UnmarshalJSON decodes the schema and collects any x-* fields into Extensions.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	if v, ok := parseBool(bytes.TrimSpace(data)); ok {
+		*s = Schema{Bool: &v}
+		return nil
+	}
+
+	var fields schemaFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ext, err := unmarshalExtensions(data)
+	if err != nil {
+		return err
+	}
+	fields.Extensions = ext
+	*s = Schema(fields)
+	return nil
+}
+
+// MarshalYAML encodes a boolean schema as true/false and any other schema
+// as a mapping.
+func (s Schema) MarshalYAML() (any, error) {
+	if s.Bool != nil {
+		return *s.Bool, nil
+	}
+	return schemaFields(s), nil
+}
+
+// UnmarshalYAML decodes a boolean schema or a schema mapping.
+func (s *Schema) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!bool" {
+		if v, ok := parseBool([]byte(node.Value)); ok {
+			*s = Schema{Bool: &v}
+			return nil
+		}
+	}
+	return node.Decode((*schemaFields)(s))
+}
+
+// parseBool parses a JSON or YAML boolean literal.
+func parseBool(data []byte) (bool, bool) {
+	switch string(data) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+// marshalWithExtensions encodes v and splices the x-* entries of ext into
+// the resulting object. Keys without the x- prefix are ignored.
+func marshalWithExtensions(v any, ext Extensions) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make(map[string]any, len(ext))
+	for key, value := range ext {
+		if strings.HasPrefix(key, "x-") {
+			filtered[key] = value
+		}
+	}
+	if len(filtered) == 0 {
+		return data, nil
+	}
+
+	extData, err := json.Marshal(filtered)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	if len(data) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(extData[1:])
+	return buf.Bytes(), nil
+}
+
+// unmarshalExtensions returns the x-* fields of a JSON object, or nil if there are none.
+func unmarshalExtensions(data []byte) (Extensions, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var ext Extensions
+	for key, value := range raw {
+		if !strings.HasPrefix(key, "x-") {
+			continue
+		}
+		var decoded any
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, err
+		}
+		if ext == nil {
+			ext = make(Extensions)
+		}
+		ext[key] = decoded
+	}
+	return ext, nil
+}
@@ -28,6 +28,13 @@ type OpenAPI struct {
 
 	// ExternalDocs provides external documentation
 	ExternalDocs *ExternalDocs `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+
+	// Extensions holds x-* specification extensions
+	Extensions Extensions `json:"-" yaml:",inline"`
+
+	// PathOrder is the order paths are emitted in; paths it does not list
+	// follow in alphabetical order. Empty means alphabetical.
+	PathOrder []string `json:"-" yaml:"-"`
 }
 
 // Info provides metadata about the API.
@@ -178,6 +185,9 @@ type Operation struct {
 
 	// Servers is a list of servers
 	Servers []Server `json:"servers,omitempty" yaml:"servers,omitempty"`
+
+	// Extensions holds x-* specification extensions
+	Extensions Extensions `json:"-" yaml:",inline"`
 }
 
 // Components holds reusable objects.
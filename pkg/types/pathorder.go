@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// orderedOpenAPIFields mirrors OpenAPI with its paths in a fixed order.
+type orderedOpenAPIFields struct {
+	OpenAPI      string                `json:"openapi" yaml:"openapi"`
+	Info         Info                  `json:"info" yaml:"info"`
+	Servers      []Server              `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Paths        *orderedPaths         `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Components   *Components           `json:"components,omitempty" yaml:"components,omitempty"`
+	Security     []map[string][]string `json:"security,omitempty" yaml:"security,omitempty"`
+	Tags         []Tag                 `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ExternalDocs *ExternalDocs         `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	Extensions   Extensions            `json:"-" yaml:",inline"`
+}
+
+// ordered returns the fields of o with its paths in PathOrder order.
+func (o OpenAPI) ordered() orderedOpenAPIFields {
+	fields := orderedOpenAPIFields{
+		OpenAPI:      o.OpenAPI,
+		Info:         o.Info,
+		Servers:      o.Servers,
+		Components:   o.Components,
+		Security:     o.Security,
+		Tags:         o.Tags,
+		ExternalDocs: o.ExternalDocs,
+		Extensions:   o.Extensions,
+	}
+	if len(o.Paths) > 0 {
+		fields.Paths = &orderedPaths{keys: o.OrderedPaths(), items: o.Paths}
+	}
+	return fields
+}
+
+// OrderedPaths returns the paths of o in the order they are emitted: those
+// listed in PathOrder first, then the rest alphabetically.
+func (o *OpenAPI) OrderedPaths() []string {
+	keys := make([]string, 0, len(o.Paths))
+	seen := make(map[string]bool, len(o.Paths))
+	for _, path := range o.PathOrder {
+		if _, ok := o.Paths[path]; ok && !seen[path] {
+			keys = append(keys, path)
+			seen[path] = true
+		}
+	}
+	rest := len(keys)
+	for path := range o.Paths {
+		if !seen[path] {
+			keys = append(keys, path)
+		}
+	}
+	sort.Strings(keys[rest:])
+	return keys
+}
+
+// orderedPaths encodes a paths map with its keys in a fixed order.
+type orderedPaths struct {
+	keys  []string
+	items map[string]PathItem
+}
+
+// MarshalJSON encodes the paths as an object with keys in order.
+func (p *orderedPaths) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range p.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		itemData, err := json.Marshal(p.items[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(itemData)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML encodes the paths as a mapping with keys in order.
+func (p *orderedPaths) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range p.keys {
+		var value yaml.Node
+		if err := value.Encode(p.items[key]); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, &value)
+	}
+	return node, nil
+}
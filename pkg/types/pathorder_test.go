@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 api2spec
+// SPDX-License-Identifier: FSL-1.1-MIT
+
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestOrderedOpenAPIFields_MirrorsOpenAPI fails when a field is added to
+// OpenAPI without adding it to orderedOpenAPIFields.
+func TestOrderedOpenAPIFields_MirrorsOpenAPI(t *testing.T) {
+	doc := reflect.TypeFor[OpenAPI]()
+	ordered := reflect.TypeFor[orderedOpenAPIFields]()
+
+	var want []reflect.StructField
+	for i := range doc.NumField() {
+		if f := doc.Field(i); f.Name != "PathOrder" {
+			want = append(want, f)
+		}
+	}
+
+	require.Equal(t, len(want), ordered.NumField())
+	for i, f := range want {
+		got := ordered.Field(i)
+		assert.Equal(t, f.Name, got.Name)
+		assert.Equal(t, f.Tag, got.Tag, f.Name)
+		if f.Name != "Paths" {
+			assert.Equal(t, f.Type, got.Type, f.Name)
+		}
+	}
+}
+
+func TestOpenAPI_Marshal_PathOrder(t *testing.T) {
+	doc := OpenAPI{
+		OpenAPI:      "3.0.3",
+		Info:         Info{Title: "Pets", Version: "1.0.0"},
+		Servers:      []Server{{URL: "https://api.example.com"}},
+		Paths:        map[string]PathItem{"/b": {Summary: "b"}, "/a": {Summary: "a"}, "/c": {Summary: "c"}},
+		Components:   &Components{Schemas: map[string]*Schema{"Pet": {Type: "object"}}},
+		Security:     []map[string][]string{{"bearer": {}}},
+		Tags:         []Tag{{Name: "pets"}},
+		ExternalDocs: &ExternalDocs{URL: "https://example.com/docs"},
+		Extensions:   Extensions{"x-owner": "pets-team"},
+	}
+	alphabetical := doc
+	alphabetical.PathOrder = []string{"/a"}
+
+	// An order matching the alphabetical one encodes every field the same
+	want, err := json.Marshal(doc)
+	require.NoError(t, err)
+	got, err := json.Marshal(alphabetical)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+
+	wantYAML, err := yaml.Marshal(doc)
+	require.NoError(t, err)
+	gotYAML, err := yaml.Marshal(alphabetical)
+	require.NoError(t, err)
+	assert.Equal(t, string(wantYAML), string(gotYAML))
+
+	doc.PathOrder = []string{"/c", "/missing", "/a"}
+	assert.Equal(t, []string{"/c", "/a", "/b"}, doc.OrderedPaths())
+	gotYAML, err = yaml.Marshal(doc)
+	require.NoError(t, err)
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal(gotYAML, &node))
+	paths := node.Content[0].Content[7]
+	assert.Equal(t, "/c", paths.Content[0].Value)
+	assert.Equal(t, "/a", paths.Content[2].Value)
+	assert.Equal(t, "/b", paths.Content[4].Value)
+}
@@ -36,17 +36,139 @@ type Route struct {
 	// Responses maps status codes to response definitions
 	Responses map[string]Response `json:"responses,omitempty" yaml:"responses,omitempty"`
 
+	// Produces are the media types the route's handler negotiates its
+	// success responses in (e.g., application/json, text/csv), as declared
+	// by content negotiation annotations
+	Produces []string `json:"produces,omitempty" yaml:"produces,omitempty"`
+
 	// Security specifies the security requirements for this route
 	Security []map[string][]string `json:"security,omitempty" yaml:"security,omitempty"`
 
+	// Scopes are the OAuth scopes the route checks, as alternatives: a
+	// token granted every scope of any one set is accepted
+	Scopes [][]string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+
 	// Deprecated indicates if the route is deprecated
 	Deprecated bool `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 
+	// Servers overrides the global servers for a route served from its own
+	// address
+	Servers []Server `json:"servers,omitempty" yaml:"servers,omitempty"`
+
+	// Mount is the path prefix of the sub-router the route is registered on
+	// (e.g., /admin for a router mounted with app.use('/admin', admin));
+	// empty for routes of the main router
+	Mount string `json:"mount,omitempty" yaml:"mount,omitempty"`
+
+	// MountOperationID is the operationId generated from the path below
+	// Mount, which mount prefixes are applied to in place of an
+	// OperationID generated from the full path
+	MountOperationID string `json:"mountOperationId,omitempty" yaml:"mountOperationId,omitempty"`
+
+	// MountTags are the tags inferred from the path below Mount, which
+	// mount prefixes are applied to in place of Tags inferred from the full
+	// path
+	MountTags []string `json:"mountTags,omitempty" yaml:"mountTags,omitempty"`
+
+	// Conditions must all hold for the route to be registered, such as a Go
+	// build constraint or an environment variable check around it
+	Conditions []Condition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+
+	// Validators are the cache validators (ValidatorETag,
+	// ValidatorLastModified) the route checks in conditional requests
+	Validators []string `json:"validators,omitempty" yaml:"validators,omitempty"`
+
 	// SourceFile is the file where this route was defined
 	SourceFile string `json:"sourceFile,omitempty" yaml:"sourceFile,omitempty"`
 
 	// SourceLine is the line number where this route was defined
 	SourceLine int `json:"sourceLine,omitempty" yaml:"sourceLine,omitempty"`
+
+	// Extensions are x-* fields copied onto the generated operation
+	Extensions Extensions `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+
+	// Diagnostics describe approximations made while extracting the route,
+	// such as path expressions that could not be resolved
+	Diagnostics []string `json:"diagnostics,omitempty" yaml:"diagnostics,omitempty"`
+}
+
+// Condition is a build or environment check a route registration depends on.
+type Condition struct {
+	// Build is a Go build constraint expression (e.g., beta && !windows)
+	Build string `json:"build,omitempty" yaml:"build,omitempty"`
+
+	// Env is the environment variable checked
+	Env string `json:"env,omitempty" yaml:"env,omitempty"`
+
+	// Value is the value Env is compared with; empty means any non-empty value
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Negate inverts the environment check
+	Negate bool `json:"negate,omitempty" yaml:"negate,omitempty"`
+}
+
+// String formats the condition as build:<expr> or env:<NAME>[=<value>],
+// prefixed with ! when negated.
+func (c Condition) String() string {
+	if c.Build != "" {
+		return "build:" + c.Build
+	}
+	s := "env:" + c.Env
+	if c.Value != "" {
+		s += "=" + c.Value
+	}
+	if c.Negate {
+		s = "!" + s
+	}
+	return s
+}
+
+// Cache validators of conditional requests.
+const (
+	ValidatorETag         = "etag"
+	ValidatorLastModified = "last-modified"
+)
+
+// CORS is a cross-origin resource sharing policy, documented in the x-cors
+// extension.
+type CORS struct {
+	// AllowOrigins are the origins allowed to call the API; * allows any
+	AllowOrigins []string `json:"allowOrigins,omitempty" yaml:"allowOrigins,omitempty"`
+
+	// AllowMethods are the methods allowed in cross-origin requests
+	AllowMethods []string `json:"allowMethods,omitempty" yaml:"allowMethods,omitempty"`
+
+	// AllowHeaders are the request headers allowed in cross-origin requests
+	AllowHeaders []string `json:"allowHeaders,omitempty" yaml:"allowHeaders,omitempty"`
+
+	// ExposeHeaders are the response headers exposed to the browser
+	ExposeHeaders []string `json:"exposeHeaders,omitempty" yaml:"exposeHeaders,omitempty"`
+
+	// AllowCredentials indicates if credentialed requests are allowed
+	AllowCredentials bool `json:"allowCredentials,omitempty" yaml:"allowCredentials,omitempty"`
+
+	// MaxAge is how long, in seconds, preflight responses may be cached
+	MaxAge int `json:"maxAge,omitempty" yaml:"maxAge,omitempty"`
+}
+
+// Lifecycle is the release stage and audience of an operation, documented
+// in the x-lifecycle extension.
+type Lifecycle struct {
+	// Stage is alpha, beta or ga
+	Stage string `json:"stage,omitempty" yaml:"stage,omitempty"`
+
+	// Audience is internal, partner or public
+	Audience string `json:"audience,omitempty" yaml:"audience,omitempty"`
+}
+
+// Streaming is how an operation delivers its response over time,
+// documented in the x-streaming extension.
+type Streaming struct {
+	// Mode is stream (chunked), sse or long-poll
+	Mode string `json:"mode" yaml:"mode"`
+
+	// MediaType is the content type of the streamed response
+	MediaType string `json:"mediaType,omitempty" yaml:"mediaType,omitempty"`
 }
 
 // Parameter represents an OpenAPI parameter.
@@ -66,11 +188,20 @@ type Parameter struct {
 	// Deprecated indicates if the parameter is deprecated
 	Deprecated bool `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 
+	// Style is how the value is serialized, e.g. form or deepObject
+	Style string `json:"style,omitempty" yaml:"style,omitempty"`
+
+	// Explode serializes each array item or object property separately
+	Explode *bool `json:"explode,omitempty" yaml:"explode,omitempty"`
+
 	// Schema defines the type of the parameter
 	Schema *Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
 
 	// Example is an example value for the parameter
 	Example interface{} `json:"example,omitempty" yaml:"example,omitempty"`
+
+	// Extensions holds x-* specification extensions
+	Extensions Extensions `json:"-" yaml:",inline"`
 }
 
 // RequestBody represents an OpenAPI request body.
@@ -95,6 +226,9 @@ type Response struct {
 
 	// Content maps media types to their schemas
 	Content map[string]MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+
+	// Links map link names to operations the response leads to
+	Links map[string]Link `json:"links,omitempty" yaml:"links,omitempty"`
 }
 
 // Header represents an OpenAPI header.
@@ -92,7 +92,8 @@ type Schema struct {
 	// Required is a list of required property names
 	Required []string `json:"required,omitempty" yaml:"required,omitempty"`
 
-	// AdditionalProperties defines the schema for additional properties
+	// AdditionalProperties defines the schema for additional properties;
+	// BoolSchema(false) forbids them
 	AdditionalProperties *Schema `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
 
 	// MinProperties is the minimum number of properties
@@ -125,6 +126,45 @@ type Schema struct {
 
 	// ExternalDocs provides external documentation
 	ExternalDocs *ExternalDocs `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+
+	// Extensions holds x-* specification extensions
+	Extensions Extensions `json:"-" yaml:",inline"`
+
+	// Source records where the schema was declared; it is not serialized
+	// into the spec but feeds the sidecar source map
+	Source *SourceLocation `json:"-" yaml:"-"`
+
+	// Fallback is the source type expression a converter could not
+	// resolve and documented with this generic schema instead; it is not
+	// serialized but reported as a lossy conversion
+	Fallback string `json:"-" yaml:"-"`
+
+	// Bool, when set, makes this a JSON Schema boolean schema: true
+	// accepts any value and false none. All other fields are ignored.
+	Bool *bool `json:"-" yaml:"-"`
+}
+
+// BoolSchema returns the boolean schema v, as used for
+// additionalProperties: false.
+func BoolSchema(v bool) *Schema {
+	return &Schema{Bool: &v}
+}
+
+// IsFalse reports whether s is the boolean schema false.
+func (s *Schema) IsFalse() bool {
+	return s != nil && s.Bool != nil && !*s.Bool
+}
+
+// SourceLocation is the provenance of an extracted schema or property.
+type SourceLocation struct {
+	// File is the source file path
+	File string `json:"file"`
+
+	// Line is the 1-based line of the declaration
+	Line int `json:"line,omitempty"`
+
+	// Type is the original type expression (e.g., *time.Time, string[])
+	Type string `json:"type,omitempty"`
 }
 
 // Discriminator is used for polymorphic schemas.